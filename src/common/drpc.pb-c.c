@@ -174,7 +174,7 @@ const ProtobufCMessageDescriptor drpc__call__descriptor =
   (ProtobufCMessageInit) drpc__call__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor drpc__response__field_descriptors[3] =
+static const ProtobufCFieldDescriptor drpc__response__field_descriptors[5] =
 {
   {
     "sequence",
@@ -212,16 +212,42 @@ static const ProtobufCFieldDescriptor drpc__response__field_descriptors[3] =
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "error_class",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_ENUM,
+    0,   /* quantifier_offset */
+    offsetof(Drpc__Response, error_class),
+    &drpc__error_class__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "retry_after_ms",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Drpc__Response, retry_after_ms),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned drpc__response__field_indices_by_name[] = {
   2,   /* field[2] = body */
+  3,   /* field[3] = error_class */
+  4,   /* field[4] = retry_after_ms */
   0,   /* field[0] = sequence */
   1,   /* field[1] = status */
 };
 static const ProtobufCIntRange drpc__response__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 3 }
+  { 0, 5 }
 };
 const ProtobufCMessageDescriptor drpc__response__descriptor =
 {
@@ -231,7 +257,7 @@ const ProtobufCMessageDescriptor drpc__response__descriptor =
   "Drpc__Response",
   "drpc",
   sizeof(Drpc__Response),
-  3,
+  5,
   drpc__response__field_descriptors,
   drpc__response__field_indices_by_name,
   1,  drpc__response__number_ranges,
@@ -278,3 +304,37 @@ const ProtobufCEnumDescriptor drpc__status__descriptor =
   drpc__status__value_ranges,
   NULL,NULL,NULL,NULL   /* reserved[1234] */
 };
+static const ProtobufCEnumValue drpc__error_class__enum_values_by_number[5] =
+{
+  { "NONE", "DRPC__ERROR_CLASS__NONE", 0 },
+  { "TRANSIENT", "DRPC__ERROR_CLASS__TRANSIENT", 1 },
+  { "CONFIG", "DRPC__ERROR_CLASS__CONFIG", 2 },
+  { "AUTH", "DRPC__ERROR_CLASS__AUTH", 3 },
+  { "FATAL", "DRPC__ERROR_CLASS__FATAL", 4 },
+};
+static const ProtobufCIntRange drpc__error_class__value_ranges[] = {
+{0, 0},{0, 5}
+};
+static const ProtobufCEnumValueIndex drpc__error_class__enum_values_by_name[5] =
+{
+  { "AUTH", 3 },
+  { "CONFIG", 2 },
+  { "FATAL", 4 },
+  { "NONE", 0 },
+  { "TRANSIENT", 1 },
+};
+const ProtobufCEnumDescriptor drpc__error_class__descriptor =
+{
+  PROTOBUF_C__ENUM_DESCRIPTOR_MAGIC,
+  "drpc.ErrorClass",
+  "ErrorClass",
+  "Drpc__ErrorClass",
+  "drpc",
+  5,
+  drpc__error_class__enum_values_by_number,
+  5,
+  drpc__error_class__enum_values_by_name,
+  1,
+  drpc__error_class__value_ranges,
+  NULL,NULL,NULL,NULL   /* reserved[1234] */
+};
@@ -416,6 +416,20 @@ daos_prop_valid(daos_prop_t *prop, bool pool, bool input)
 				return false;
 			}
 			break;
+		case DAOS_PROP_PO_BW_LIMIT:
+			val = prop->dpp_entries[i].dpe_val;
+			if (val != 0 && val < DAOS_PROP_PO_BW_LIMIT_MIN) {
+				D_ERROR("invalid bw_limit " DF_U64 ".\n", val);
+				return false;
+			}
+			break;
+		case DAOS_PROP_PO_IOPS_LIMIT:
+			val = prop->dpp_entries[i].dpe_val;
+			if (val != 0 && val < DAOS_PROP_PO_IOPS_LIMIT_MIN) {
+				D_ERROR("invalid iops_limit " DF_U64 ".\n", val);
+				return false;
+			}
+			break;
 		/* container-only properties */
 		case DAOS_PROP_CO_LAYOUT_TYPE:
 			val = prop->dpp_entries[i].dpe_val;
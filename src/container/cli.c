@@ -33,19 +33,23 @@ int
 dc_cont_init(void)
 {
 	int		rc;
-	uint32_t        ver_array[2] = {DAOS_CONT_VERSION - 1, DAOS_CONT_VERSION};
+	uint32_t        ver_array[3] = {DAOS_CONT_VERSION - 2, DAOS_CONT_VERSION - 1,
+					DAOS_CONT_VERSION};
 
 	dc_cont_proto_version = 0;
-	rc = daos_rpc_proto_query(cont_proto_fmt_v7.cpf_base, ver_array, 2, &dc_cont_proto_version);
+	rc = daos_rpc_proto_query(cont_proto_fmt_v7.cpf_base, ver_array, 3, &dc_cont_proto_version);
 	if (rc)
 		return rc;
 
-	if (dc_cont_proto_version == DAOS_CONT_VERSION - 1) {
+	if (dc_cont_proto_version == DAOS_CONT_VERSION - 2) {
 		rc = daos_rpc_register(&cont_proto_fmt_v7, CONT_PROTO_CLI_COUNT, NULL,
 				       DAOS_CONT_MODULE);
-	} else if (dc_cont_proto_version == DAOS_CONT_VERSION) {
+	} else if (dc_cont_proto_version == DAOS_CONT_VERSION - 1) {
 		rc = daos_rpc_register(&cont_proto_fmt_v8, CONT_PROTO_CLI_COUNT, NULL,
 				       DAOS_CONT_MODULE);
+	} else if (dc_cont_proto_version == DAOS_CONT_VERSION) {
+		rc = daos_rpc_register(&cont_proto_fmt_v9, CONT_PROTO_CLI_COUNT, NULL,
+				       DAOS_CONT_MODULE);
 	} else {
 		D_ERROR("%d version cont RPC not supported.\n", dc_cont_proto_version);
 		rc = -DER_PROTO;
@@ -65,10 +69,12 @@ dc_cont_fini(void)
 {
 	int rc;
 
-	if (dc_cont_proto_version == DAOS_CONT_VERSION - 1)
+	if (dc_cont_proto_version == DAOS_CONT_VERSION - 2)
 		rc = daos_rpc_unregister(&cont_proto_fmt_v7);
-	else
+	else if (dc_cont_proto_version == DAOS_CONT_VERSION - 1)
 		rc = daos_rpc_unregister(&cont_proto_fmt_v8);
+	else
+		rc = daos_rpc_unregister(&cont_proto_fmt_v9);
 	if (rc != 0)
 		D_ERROR("failed to unregister %d version cont RPCs: "DF_RC"\n",
 			dc_cont_proto_version, DP_RC(rc));
@@ -135,6 +135,9 @@ CRT_RPC_DEFINE(cont_tgt_snapshot_notify, DAOS_ISEQ_CONT_TGT_SNAPSHOT_NOTIFY,
 CRT_RPC_DEFINE(cont_prop_set, DAOS_ISEQ_CONT_PROP_SET, DAOS_OSEQ_CONT_PROP_SET)
 CRT_RPC_DEFINE(cont_prop_set_v8, DAOS_ISEQ_CONT_PROP_SET_V8, DAOS_OSEQ_CONT_PROP_SET)
 CRT_RPC_DEFINE(cont_prop_set_bylabel, DAOS_ISEQ_CONT_PROP_SET_BYLABEL, DAOS_OSEQ_CONT_PROP_SET)
+CRT_RPC_DEFINE(cont_srv_create, DAOS_ISEQ_CONT_SRV_CREATE, DAOS_OSEQ_CONT_CREATE)
+CRT_RPC_DEFINE(cont_srv_destroy, DAOS_ISEQ_CONT_SRV_DESTROY, DAOS_OSEQ_CONT_DESTROY)
+CRT_RPC_DEFINE(cont_srv_query, DAOS_ISEQ_CONT_SRV_QUERY, DAOS_OSEQ_CONT_SRV_QUERY)
 CRT_RPC_DEFINE(cont_acl_update, DAOS_ISEQ_CONT_ACL_UPDATE, DAOS_OSEQ_CONT_ACL_UPDATE)
 CRT_RPC_DEFINE(cont_acl_update_v8, DAOS_ISEQ_CONT_ACL_UPDATE_V8, DAOS_OSEQ_CONT_ACL_UPDATE)
 CRT_RPC_DEFINE(cont_acl_delete, DAOS_ISEQ_CONT_ACL_DELETE, DAOS_OSEQ_CONT_ACL_DELETE)
@@ -151,6 +154,10 @@ CRT_RPC_DEFINE(cont_acl_delete_v8, DAOS_ISEQ_CONT_ACL_DELETE_V8, DAOS_OSEQ_CONT_
 	    .prf_co_ops  = NULL,                                                                   \
 	},
 
+static struct crt_proto_rpc_format cont_proto_rpc_fmt_v9[] = {
+    CONT_PROTO_CLI_RPC_LIST(9, ds_cont_op_handler_v9) CONT_PROTO_SRV_RPC_LIST
+	CONT_PROTO_SRV_RPC_LIST_V9};
+
 static struct crt_proto_rpc_format cont_proto_rpc_fmt_v8[] = {
     CONT_PROTO_CLI_RPC_LIST(8, ds_cont_op_handler_v8) CONT_PROTO_SRV_RPC_LIST};
 
@@ -159,6 +166,12 @@ static struct crt_proto_rpc_format cont_proto_rpc_fmt_v7[] = {
 
 #undef X
 
+struct crt_proto_format cont_proto_fmt_v9 = {.cpf_name  = "cont",
+					     .cpf_ver   = 9,
+					     .cpf_count = ARRAY_SIZE(cont_proto_rpc_fmt_v9),
+					     .cpf_prf   = cont_proto_rpc_fmt_v9,
+					     .cpf_base  = DAOS_RPC_OPCODE(0, DAOS_CONT_MODULE, 0)};
+
 struct crt_proto_format cont_proto_fmt_v8 = {.cpf_name  = "cont",
 					     .cpf_ver   = 8,
 					     .cpf_count = ARRAY_SIZE(cont_proto_rpc_fmt_v8),
@@ -24,12 +24,14 @@
  * These are for daos_rpc::dr_opc and DAOS_RPC_OPCODE(opc, ...) rather than
  * crt_req_create(..., opc, ...). See src/include/daos/rpc.h.
  */
-#define DAOS_CONT_VERSION              8
+#define DAOS_CONT_VERSION              9
 /* version in which metadata open/modify times, number of handles were added to open, query RPCs */
 #define CONT_PROTO_VER_WITH_MDTIMES    7
 #define CONT_PROTO_VER_WITH_NHANDLES   7
 /* version in which cont_op_in includes a client operation key */
 #define CONT_PROTO_VER_WITH_SVC_OP_KEY 8
+/* version in which the container create, destroy and query RPCs from a server were added */
+#define CONT_PROTO_VER_WITH_SRV_OPS    9
 
 /* LIST of internal RPCS in form of:
  * OPCODE, flags, FMT, handler, corpc_hdlr,
@@ -77,17 +79,27 @@
 	  ds_cont_tgt_snapshot_notify_handler, &ds_cont_tgt_snapshot_notify_co_ops)                \
 	X(CONT_PROP_SET_BYLABEL, 0, &CQF_cont_prop_set_bylabel, ds_cont_set_prop_srv_handler, NULL)
 
+/* Server RPCs added in CONT_PROTO_VER_WITH_SRV_OPS, only registered on that version and later.
+ * They must stay at the end of the protocol, after CONT_PROTO_SRV_RPC_LIST.
+ */
+#define CONT_PROTO_SRV_RPC_LIST_V9                                                                 \
+	X(CONT_SRV_CREATE, 0, &CQF_cont_srv_create, ds_cont_create_srv_handler, NULL)              \
+	X(CONT_SRV_DESTROY, 0, &CQF_cont_srv_destroy, ds_cont_destroy_srv_handler, NULL)           \
+	X(CONT_SRV_QUERY, 0, &CQF_cont_srv_query, ds_cont_query_srv_handler, NULL)
+
 /* Define for RPC enum population below */
 #define X(a, ...) a,
 
 enum cont_operation {
-	CONT_PROTO_CLI_RPC_LIST(DAOS_CONT_VERSION, ds_cont_op_handler_v8) CONT_PROTO_CLI_COUNT,
+	CONT_PROTO_CLI_RPC_LIST(DAOS_CONT_VERSION, ds_cont_op_handler_v9) CONT_PROTO_CLI_COUNT,
 	CONT_PROTO_CLI_LAST = CONT_PROTO_CLI_COUNT - 1,
 	CONT_PROTO_SRV_RPC_LIST
+	CONT_PROTO_SRV_RPC_LIST_V9
 };
 
 #undef X
 
+extern struct crt_proto_format cont_proto_fmt_v9;
 extern struct crt_proto_format cont_proto_fmt_v8;
 extern struct crt_proto_format cont_proto_fmt_v7;
 extern int dc_cont_proto_version;
@@ -139,6 +151,16 @@ CRT_RPC_DECLARE(cont_op_v8, DAOS_ISEQ_CONT_OP_V8, DAOS_OSEQ_CONT_OP)
 CRT_RPC_DECLARE(cont_create, DAOS_ISEQ_CONT_CREATE, DAOS_OSEQ_CONT_CREATE)
 CRT_RPC_DECLARE(cont_create_v8, DAOS_ISEQ_CONT_CREATE_V8, DAOS_OSEQ_CONT_CREATE)
 
+/* Container create from a server (admin/dRPC), without a pool handle.
+ * Must begin with what DAOS_ISEQ_CONT_CREATE_V8 has, for reusing
+ * cont_create_v8_in in the common code.
+ */
+#define DAOS_ISEQ_CONT_SRV_CREATE	/* input fields */		 \
+	DAOS_ISEQ_CONT_CREATE_V8					 \
+	((uuid_t)			(csci_pool_uuid)	CRT_VAR)
+
+CRT_RPC_DECLARE(cont_srv_create, DAOS_ISEQ_CONT_SRV_CREATE, DAOS_OSEQ_CONT_CREATE)
+
 /* clang-format on */
 
 static inline void
@@ -201,6 +223,17 @@ CRT_RPC_DECLARE(cont_destroy_v8, DAOS_ISEQ_CONT_DESTROY_V8, DAOS_OSEQ_CONT_DESTR
 CRT_RPC_DECLARE(cont_destroy_bylabel, DAOS_ISEQ_CONT_DESTROY_BYLABEL, DAOS_OSEQ_CONT_DESTROY)
 CRT_RPC_DECLARE(cont_destroy_bylabel_v8, DAOS_ISEQ_CONT_DESTROY_BYLABEL_V8, DAOS_OSEQ_CONT_DESTROY)
 
+/* Container destroy from a server (admin/dRPC), without a pool handle.
+ * Must begin with what DAOS_ISEQ_CONT_DESTROY_V8 has, for reusing
+ * cont_destroy_v8_in in the common code. cdli_label is NULL when the
+ * container is identified by cdi_op.ci_uuid.
+ */
+#define DAOS_ISEQ_CONT_SRV_DESTROY		/* input fields */	 \
+	DAOS_ISEQ_CONT_DESTROY_BYLABEL_V8				 \
+	((uuid_t)				(csdi_pool_uuid) CRT_VAR)
+
+CRT_RPC_DECLARE(cont_srv_destroy, DAOS_ISEQ_CONT_SRV_DESTROY, DAOS_OSEQ_CONT_DESTROY)
+
 /* clang-format on */
 
 static inline void
@@ -456,6 +489,23 @@ CRT_RPC_DECLARE(cont_close_v8, DAOS_ISEQ_CONT_CLOSE_V8, DAOS_OSEQ_CONT_CLOSE)
 CRT_RPC_DECLARE(cont_query, DAOS_ISEQ_CONT_QUERY, DAOS_OSEQ_CONT_QUERY)
 CRT_RPC_DECLARE(cont_query_v8, DAOS_ISEQ_CONT_QUERY_V8, DAOS_OSEQ_CONT_QUERY)
 
+/* Container query from a server (admin/dRPC), without a pool handle.
+ * csqi_label is NULL when the container is identified by csqi_op.ci_uuid.
+ */
+#define DAOS_ISEQ_CONT_SRV_QUERY	/* input fields */		 \
+	((struct cont_op_v8_in)	(csqi_op)		CRT_VAR) \
+	((uuid_t)		(csqi_pool_uuid)	CRT_VAR) \
+	((d_const_string_t)	(csqi_label)		CRT_VAR)
+
+#define DAOS_OSEQ_CONT_SRV_QUERY	/* output fields */		 \
+	((struct cont_op_out)	(csqo_op)		CRT_VAR) \
+	((uuid_t)		(csqo_uuid)		CRT_VAR) \
+	((daos_prop_t)		(csqo_prop)		CRT_PTR) \
+	((uint32_t)		(csqo_snap_count)	CRT_VAR) \
+	((uint32_t)		(csqo_nhandles)		CRT_VAR)
+
+CRT_RPC_DECLARE(cont_srv_query, DAOS_ISEQ_CONT_SRV_QUERY, DAOS_OSEQ_CONT_SRV_QUERY)
+
 /* clang-format on */
 
 static inline void
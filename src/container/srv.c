@@ -84,6 +84,10 @@ static struct crt_corpc_ops ds_cont_tgt_snapshot_notify_co_ops = {
 	    .dr_corpc_ops = e,                                                                     \
 	},
 
+static struct daos_rpc_handler cont_handlers_v9[] = {
+    CONT_PROTO_CLI_RPC_LIST(9, ds_cont_op_handler_v9) CONT_PROTO_SRV_RPC_LIST
+	CONT_PROTO_SRV_RPC_LIST_V9};
+
 static struct daos_rpc_handler cont_handlers_v8[] = {
     CONT_PROTO_CLI_RPC_LIST(8, ds_cont_op_handler_v8) CONT_PROTO_SRV_RPC_LIST};
 
@@ -150,12 +154,12 @@ struct dss_module cont_module = {
     .sm_name        = "cont",
     .sm_mod_id      = DAOS_CONT_MODULE,
     .sm_ver         = DAOS_CONT_VERSION,
-    .sm_proto_count = 2,
+    .sm_proto_count = 3,
     .sm_init        = init,
     .sm_fini        = fini,
-    .sm_proto_fmt   = {&cont_proto_fmt_v7, &cont_proto_fmt_v8},
-    .sm_cli_count   = {CONT_PROTO_CLI_COUNT, CONT_PROTO_CLI_COUNT},
-    .sm_handlers    = {cont_handlers_v7, cont_handlers_v8},
+    .sm_proto_fmt   = {&cont_proto_fmt_v7, &cont_proto_fmt_v8, &cont_proto_fmt_v9},
+    .sm_cli_count   = {CONT_PROTO_CLI_COUNT, CONT_PROTO_CLI_COUNT, CONT_PROTO_CLI_COUNT},
+    .sm_handlers    = {cont_handlers_v7, cont_handlers_v8, cont_handlers_v9},
     .sm_key         = &cont_module_key,
     .sm_metrics     = &cont_metrics,
 };
@@ -5591,6 +5591,9 @@ cont_cli_opc_name(crt_opcode_t opc)
 	case CONT_SNAP_OIT_OID_GET:	return "SNAP_OIT_OID_GET";
 	case CONT_SNAP_OIT_CREATE:	return "SNAP_OIT_CREATE";
 	case CONT_SNAP_OIT_DESTROY:	return "SNAP_OIT_DESTROY";
+	case CONT_SRV_CREATE:		return "SRV_CREATE";
+	case CONT_SRV_DESTROY:		return "SRV_DESTROY";
+	case CONT_SRV_QUERY:		return "SRV_QUERY";
 	default:			return "?";
 	}
 }
@@ -5685,6 +5688,12 @@ out:
 	daos_prop_free(prop);
 }
 
+void
+ds_cont_op_handler_v9(crt_rpc_t *rpc)
+{
+	return ds_cont_op_handler(rpc, 9);
+}
+
 void
 ds_cont_op_handler_v8(crt_rpc_t *rpc)
 {
@@ -5923,6 +5932,484 @@ out:
 	crt_reply_send(rpc);
 }
 
+/*
+ * Initialize a pool handle on the stack for a server RPC. Server RPCs don't
+ * carry a pool handle, so describe the internal server pool handle with
+ * admin capabilities and the current pool versions.
+ */
+static int
+cont_srv_pool_hdl_init(struct cont_svc *svc, struct ds_pool_hdl *pool_hdl)
+{
+	daos_prop_t            *prop = NULL;
+	struct daos_prop_entry *entry;
+	int                     rc;
+
+	rc = ds_pool_prop_fetch(svc->cs_pool,
+				DAOS_PO_QUERY_PROP_GLOBAL_VERSION | DAOS_PO_QUERY_PROP_OBJ_VERSION,
+				&prop);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to fetch pool versions", DP_UUID(svc->cs_pool_uuid));
+		return rc;
+	}
+
+	memset(pool_hdl, 0, sizeof(*pool_hdl));
+	uuid_copy(pool_hdl->sph_uuid, svc->cs_pool->sp_srv_pool_hdl);
+	pool_hdl->sph_sec_capas = ds_sec_get_admin_pool_capabilities();
+	pool_hdl->sph_pool      = svc->cs_pool;
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_PO_GLOBAL_VERSION);
+	D_ASSERT(entry != NULL);
+	pool_hdl->sph_global_ver = entry->dpe_val;
+	entry = daos_prop_entry_get(prop, DAOS_PROP_PO_OBJ_VERSION);
+	D_ASSERT(entry != NULL);
+	pool_hdl->sph_obj_ver = entry->dpe_val;
+
+	daos_prop_free(prop);
+	return 0;
+}
+
+/* Send the RPC from a DAOS server instance to the container service */
+int
+ds_cont_svc_create(uuid_t pool_uuid, d_rank_list_t *ranks, daos_prop_t *prop, uuid_t cont_uuid)
+{
+	int                        rc;
+	struct rsvc_client         client;
+	crt_endpoint_t             ep;
+	uuid_t                     null_uuid;
+	struct dss_module_info    *info = dss_get_module_info();
+	crt_rpc_t                 *rpc;
+	struct cont_srv_create_in *in;
+	struct cont_create_out    *out;
+
+	uuid_generate(cont_uuid);
+
+	D_DEBUG(DB_MGMT, DF_CONT ": Creating container\n", DP_CONT(pool_uuid, cont_uuid));
+
+	uuid_clear(null_uuid);
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL; /* primary group */
+	rc        = rsvc_client_choose(&client, &ep);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": cannot find pool service", DP_UUID(pool_uuid));
+		D_GOTO(out_client, rc);
+	}
+
+	rc = cont_req_create(info->dmi_ctx, &ep, CONT_SRV_CREATE, null_uuid, cont_uuid, null_uuid,
+			     NULL /* req_timep */, &rpc);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_CONT ": failed to create cont create rpc",
+			 DP_CONT(pool_uuid, cont_uuid));
+		D_GOTO(out_client, rc);
+	}
+
+	in           = crt_req_get(rpc);
+	in->cci_prop = prop;
+	uuid_copy(in->csci_pool_uuid, pool_uuid);
+
+	rc  = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+
+	rc = rsvc_client_complete_rpc(&client, &ep, rc, out->cco_op.co_rc, &out->cco_op.co_hint);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->cco_op.co_rc;
+	if (rc != 0)
+		DL_ERROR(rc, DF_CONT ": failed to create container", DP_CONT(pool_uuid, cont_uuid));
+
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
+void
+ds_cont_create_srv_handler(crt_rpc_t *rpc)
+{
+	struct cont_srv_create_in *in  = crt_req_get(rpc);
+	struct cont_create_out    *out = crt_reply_get(rpc);
+	struct cont_svc           *svc;
+	struct ds_pool_hdl         pool_hdl;
+	struct cont_pool_metrics  *metrics;
+	struct rdb_tx              tx;
+	int                        rc;
+
+	D_DEBUG(DB_MD, DF_CONT ": processing cont srv create rpc %p\n",
+		DP_CONT(in->csci_pool_uuid, in->cci_op.ci_uuid), rpc);
+
+	rc = cont_svc_lookup_leader(in->csci_pool_uuid, 0, &svc, &out->cco_op.co_hint);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to look up cont svc", DP_UUID(in->csci_pool_uuid));
+		D_GOTO(out, rc);
+	}
+
+	rc = cont_srv_pool_hdl_init(svc, &pool_hdl);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	rc = rdb_tx_begin(svc->cs_rsvc->s_db, svc->cs_rsvc->s_term, &tx);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to start RDB transaction",
+			 DP_UUID(in->csci_pool_uuid));
+		D_GOTO(out_svc, rc);
+	}
+
+	ABT_rwlock_wrlock(svc->cs_lock);
+
+	rc = cont_create(&tx, &pool_hdl, svc, rpc, DAOS_CONT_VERSION);
+	if (rc != 0)
+		D_GOTO(out_lock, rc);
+
+	rc = rdb_tx_commit(&tx);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_CONT ": unable to commit RDB transaction",
+			 DP_CONT(in->csci_pool_uuid, in->cci_op.ci_uuid));
+		D_GOTO(out_lock, rc);
+	}
+
+	metrics = svc->cs_pool->sp_metrics[DAOS_CONT_MODULE];
+	d_tm_inc_counter(metrics->create_total, 1);
+
+out_lock:
+	ABT_rwlock_unlock(svc->cs_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_rsvc_set_hint(svc->cs_rsvc, &out->cco_op.co_hint);
+	cont_svc_put_leader(svc);
+out:
+	D_DEBUG(DB_MD, DF_CONT ": replying rpc: %p rc=%d\n",
+		DP_CONT(in->csci_pool_uuid, in->cci_op.ci_uuid), rpc, rc);
+
+	out->cco_op.co_rc = rc;
+	crt_reply_send(rpc);
+}
+
+/* Send the RPC from a DAOS server instance to the container service */
+int
+ds_cont_svc_destroy(uuid_t pool_uuid, const char *cont_id, d_rank_list_t *ranks, bool force)
+{
+	int                         rc;
+	struct rsvc_client          client;
+	crt_endpoint_t              ep;
+	uuid_t                      cont_uuid;
+	uuid_t                      null_uuid;
+	const char                 *label = NULL;
+	struct dss_module_info     *info  = dss_get_module_info();
+	crt_rpc_t                  *rpc;
+	struct cont_srv_destroy_in *in;
+	struct cont_destroy_out    *out;
+
+	D_DEBUG(DB_MGMT, DF_UUID "/%s: Destroying container\n", DP_UUID(pool_uuid), cont_id);
+
+	/* cont_id may be a UUID or label */
+	uuid_clear(null_uuid);
+	if (uuid_parse(cont_id, cont_uuid) != 0) {
+		uuid_clear(cont_uuid);
+		label = cont_id;
+	}
+
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL; /* primary group */
+	rc        = rsvc_client_choose(&client, &ep);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": cannot find pool service", DP_UUID(pool_uuid));
+		D_GOTO(out_client, rc);
+	}
+
+	rc = cont_req_create(info->dmi_ctx, &ep, CONT_SRV_DESTROY, null_uuid, cont_uuid, null_uuid,
+			     NULL /* req_timep */, &rpc);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID "/%s: failed to create cont destroy rpc", DP_UUID(pool_uuid),
+			 cont_id);
+		D_GOTO(out_client, rc);
+	}
+
+	in             = crt_req_get(rpc);
+	in->cdi_force  = force;
+	in->cdli_label = label;
+	uuid_copy(in->csdi_pool_uuid, pool_uuid);
+
+	rc  = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+
+	rc = rsvc_client_complete_rpc(&client, &ep, rc, out->cdo_op.co_rc, &out->cdo_op.co_hint);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->cdo_op.co_rc;
+	if (rc != 0)
+		DL_ERROR(rc, DF_UUID ": failed to destroy container %s", DP_UUID(pool_uuid),
+			 cont_id);
+
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
+void
+ds_cont_destroy_srv_handler(crt_rpc_t *rpc)
+{
+	struct cont_srv_destroy_in *in  = crt_req_get(rpc);
+	struct cont_destroy_out    *out = crt_reply_get(rpc);
+	struct cont_svc            *svc;
+	struct ds_pool_hdl          pool_hdl;
+	struct cont_pool_metrics   *metrics;
+	struct rdb_tx               tx;
+	struct cont                *cont;
+	char                        cont_id[DAOS_PROP_MAX_LABEL_BUF_LEN] = {0};
+	int                         rc;
+
+	if (in->cdli_label != NULL)
+		strncpy(cont_id, in->cdli_label, sizeof(cont_id) - 1);
+	else
+		uuid_unparse(in->cdi_op.ci_uuid, cont_id);
+
+	D_DEBUG(DB_MD, DF_UUID "/%s: processing cont srv destroy rpc %p force=%u\n",
+		DP_UUID(in->csdi_pool_uuid), cont_id, rpc, in->cdi_force);
+
+	rc = cont_svc_lookup_leader(in->csdi_pool_uuid, 0, &svc, &out->cdo_op.co_hint);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID "/%s: failed to look up cont svc", DP_UUID(in->csdi_pool_uuid),
+			 cont_id);
+		D_GOTO(out, rc);
+	}
+
+	rc = cont_srv_pool_hdl_init(svc, &pool_hdl);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	rc = rdb_tx_begin(svc->cs_rsvc->s_db, svc->cs_rsvc->s_term, &tx);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID "/%s: failed to start RDB transaction",
+			 DP_UUID(in->csdi_pool_uuid), cont_id);
+		D_GOTO(out_svc, rc);
+	}
+
+	ABT_rwlock_wrlock(svc->cs_lock);
+
+	if (in->cdli_label != NULL)
+		rc = cont_lookup_bylabel(&tx, svc, in->cdli_label, &cont);
+	else
+		rc = cont_lookup(&tx, svc, in->cdi_op.ci_uuid, &cont);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to look up container '%s'",
+			 DP_UUID(in->csdi_pool_uuid), cont_id);
+		D_GOTO(out_lock, rc);
+	}
+
+	rc = cont_destroy(&tx, &pool_hdl, cont, rpc, DAOS_CONT_VERSION);
+	if (rc != 0)
+		D_GOTO(out_cont, rc);
+
+	rc = rdb_tx_commit(&tx);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_CONT ": unable to commit RDB transaction",
+			 DP_CONT(svc->cs_pool_uuid, cont->c_uuid));
+		D_GOTO(out_cont, rc);
+	}
+
+	metrics = svc->cs_pool->sp_metrics[DAOS_CONT_MODULE];
+	d_tm_inc_counter(metrics->destroy_total, 1);
+
+out_cont:
+	cont_put(cont);
+out_lock:
+	ABT_rwlock_unlock(svc->cs_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_rsvc_set_hint(svc->cs_rsvc, &out->cdo_op.co_hint);
+	cont_svc_put_leader(svc);
+out:
+	D_DEBUG(DB_MD, DF_UUID "/%s: replying rpc: %p rc=%d\n", DP_UUID(in->csdi_pool_uuid),
+		cont_id, rpc, rc);
+
+	out->cdo_op.co_rc = rc;
+	crt_reply_send(rpc);
+}
+
+/* Send the RPC from a DAOS server instance to the container service */
+int
+ds_cont_svc_query(uuid_t pool_uuid, const char *cont_id, d_rank_list_t *ranks,
+		  daos_cont_info_t *info, daos_prop_t **prop_out)
+{
+	int                        rc;
+	struct rsvc_client         client;
+	crt_endpoint_t             ep;
+	uuid_t                     cont_uuid;
+	uuid_t                     null_uuid;
+	const char                *label = NULL;
+	struct dss_module_info    *minfo = dss_get_module_info();
+	crt_rpc_t                 *rpc;
+	struct cont_srv_query_in  *in;
+	struct cont_srv_query_out *out;
+
+	D_DEBUG(DB_MGMT, DF_UUID "/%s: Querying container\n", DP_UUID(pool_uuid), cont_id);
+
+	/* cont_id may be a UUID or label */
+	uuid_clear(null_uuid);
+	if (uuid_parse(cont_id, cont_uuid) != 0) {
+		uuid_clear(cont_uuid);
+		label = cont_id;
+	}
+
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL; /* primary group */
+	rc        = rsvc_client_choose(&client, &ep);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": cannot find pool service", DP_UUID(pool_uuid));
+		D_GOTO(out_client, rc);
+	}
+
+	rc = cont_req_create(minfo->dmi_ctx, &ep, CONT_SRV_QUERY, null_uuid, cont_uuid, null_uuid,
+			     NULL /* req_timep */, &rpc);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID "/%s: failed to create cont query rpc", DP_UUID(pool_uuid),
+			 cont_id);
+		D_GOTO(out_client, rc);
+	}
+
+	in             = crt_req_get(rpc);
+	in->csqi_label = label;
+	uuid_copy(in->csqi_pool_uuid, pool_uuid);
+
+	rc  = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+
+	rc = rsvc_client_complete_rpc(&client, &ep, rc, out->csqo_op.co_rc, &out->csqo_op.co_hint);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->csqo_op.co_rc;
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to query container %s", DP_UUID(pool_uuid),
+			 cont_id);
+		D_GOTO(out_rpc, rc);
+	}
+
+	memset(info, 0, sizeof(*info));
+	uuid_copy(info->ci_uuid, out->csqo_uuid);
+	info->ci_nsnapshots = out->csqo_snap_count;
+	info->ci_nhandles   = out->csqo_nhandles;
+
+	*prop_out = daos_prop_dup(out->csqo_prop, false /* pool */, false /* input */);
+	if (*prop_out == NULL)
+		rc = -DER_NOMEM;
+
+out_rpc:
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
+void
+ds_cont_query_srv_handler(crt_rpc_t *rpc)
+{
+	struct cont_srv_query_in  *in   = crt_req_get(rpc);
+	struct cont_srv_query_out *out  = crt_reply_get(rpc);
+	struct cont_svc           *svc;
+	struct rdb_tx              tx;
+	struct cont               *cont;
+	daos_cont_info_t           info;
+	daos_prop_t               *prop = NULL;
+	char                       cont_id[DAOS_PROP_MAX_LABEL_BUF_LEN] = {0};
+	int                        rc;
+
+	if (in->csqi_label != NULL)
+		strncpy(cont_id, in->csqi_label, sizeof(cont_id) - 1);
+	else
+		uuid_unparse(in->csqi_op.ci_uuid, cont_id);
+
+	D_DEBUG(DB_MD, DF_UUID "/%s: processing cont srv query rpc %p\n",
+		DP_UUID(in->csqi_pool_uuid), cont_id, rpc);
+
+	rc = cont_svc_lookup_leader(in->csqi_pool_uuid, 0, &svc, &out->csqo_op.co_hint);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID "/%s: failed to look up cont svc", DP_UUID(in->csqi_pool_uuid),
+			 cont_id);
+		D_GOTO(out, rc);
+	}
+
+	rc = rdb_tx_begin(svc->cs_rsvc->s_db, svc->cs_rsvc->s_term, &tx);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID "/%s: failed to start RDB transaction",
+			 DP_UUID(in->csqi_pool_uuid), cont_id);
+		D_GOTO(out_svc, rc);
+	}
+
+	ABT_rwlock_rdlock(svc->cs_lock);
+
+	if (in->csqi_label != NULL)
+		rc = cont_lookup_bylabel(&tx, svc, in->csqi_label, &cont);
+	else
+		rc = cont_lookup(&tx, svc, in->csqi_op.ci_uuid, &cont);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to look up container '%s'",
+			 DP_UUID(in->csqi_pool_uuid), cont_id);
+		D_GOTO(out_lock, rc);
+	}
+
+	rc = cont_info_read(&tx, cont, DAOS_CONT_VERSION, &info);
+	if (rc != 0)
+		D_GOTO(out_cont, rc);
+
+	rc = cont_prop_read(&tx, cont,
+			    DAOS_CO_QUERY_PROP_LABEL | DAOS_CO_QUERY_PROP_LAYOUT_TYPE |
+				DAOS_CO_QUERY_PROP_OWNER | DAOS_CO_QUERY_PROP_OWNER_GROUP,
+			    &prop, true /* ignore_not_set */);
+	if (rc != 0)
+		D_GOTO(out_cont, rc);
+
+	uuid_copy(out->csqo_uuid, info.ci_uuid);
+	out->csqo_snap_count = info.ci_nsnapshots;
+	out->csqo_nhandles   = info.ci_nhandles;
+	out->csqo_prop       = prop;
+
+out_cont:
+	cont_put(cont);
+out_lock:
+	ABT_rwlock_unlock(svc->cs_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_rsvc_set_hint(svc->cs_rsvc, &out->csqo_op.co_hint);
+	cont_svc_put_leader(svc);
+out:
+	D_DEBUG(DB_MD, DF_UUID "/%s: replying rpc: %p rc=%d\n", DP_UUID(in->csqi_pool_uuid),
+		cont_id, rpc, rc);
+
+	out->csqo_op.co_rc = rc;
+	crt_reply_send(rpc);
+	daos_prop_free(prop);
+}
+
 int
 ds_cont_get_prop(uuid_t pool_uuid, uuid_t cont_uuid, daos_prop_t **prop_out)
 {
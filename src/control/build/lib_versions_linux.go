@@ -0,0 +1,134 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package build
+
+import "github.com/pkg/errors"
+
+/*
+#include <stdint.h>
+
+int
+get_fi_version(void *fn, int *major, int *minor, int *patch)
+{
+	uint32_t version;
+
+	if (fn == NULL || major == NULL || minor == NULL || patch == NULL) {
+		return -1;
+	}
+	// uint32_t fi_version(void);
+	version = ((uint32_t (*)(void))fn)();
+
+	if (version == 0) {
+		return -1;
+	}
+
+	// FI_MAJOR(version);
+	*major = (version >> 16);
+	// FI_MINOR(version);
+	*minor = (version & 0xFFFF);
+	// No way to get at revision number via ABI. :(
+
+	return 0;
+}
+
+int
+get_hg_version(void *fn, int *major, int *minor, int *patch)
+{
+	if (fn == NULL || major == NULL || minor == NULL || patch == NULL) {
+		return -1;
+	}
+	// int HG_Version_get(int *, int *, int *);
+	return ((int (*)(int *, int *, int *))fn)(major, minor, patch);
+}
+
+int
+get_daos_version(void *fn, int *major, int *minor, int *fix)
+{
+	if (fn == NULL || major == NULL || minor == NULL || fix == NULL) {
+		return -1;
+	}
+	// int daos_version_get(int *, int *, int *);
+	return ((int (*)(int *, int *, int *))fn)(major, minor, fix);
+}
+*/
+import "C"
+
+func getLibFabricVersion() (*Version, string, error) {
+	libPath, hdl, err := getLibHandle("libfabric")
+	if err != nil {
+		return nil, "", err
+	}
+	defer hdl.Close()
+
+	ptr, err := hdl.GetSymbolPointer("fi_version")
+	if err != nil {
+		return nil, "", err
+	}
+
+	var major, minor, patch C.int
+	rc := C.get_fi_version(ptr, &major, &minor, &patch)
+	if rc != 0 {
+		return nil, "", errors.Errorf("get_fi_version() failed: %d", rc)
+	}
+
+	return &Version{
+		Major: int(major),
+		Minor: int(minor),
+		Patch: int(patch),
+	}, libPath, nil
+}
+
+func getMercuryVersion() (*Version, string, error) {
+	libPath, hdl, err := getLibHandle("libmercury")
+	if err != nil {
+		return nil, "", err
+	}
+	defer hdl.Close()
+
+	ptr, err := hdl.GetSymbolPointer("HG_Version_get")
+	if err != nil {
+		return nil, "", err
+	}
+
+	var major, minor, patch C.int
+	rc := C.get_hg_version(ptr, &major, &minor, &patch)
+	if rc != 0 {
+		return nil, "", errors.Errorf("get_hg_version() failed: %d", rc)
+	}
+
+	return &Version{
+		Major: int(major),
+		Minor: int(minor),
+		Patch: int(patch),
+	}, libPath, nil
+}
+
+func getDAOSVersion() (*Version, string, error) {
+	libPath, hdl, err := getLibHandle("libdaos")
+	if err != nil {
+		return nil, "", err
+	}
+	defer hdl.Close()
+
+	ptr, err := hdl.GetSymbolPointer("daos_version_get")
+	if err != nil {
+		return nil, "", err
+	}
+
+	var major, minor, fix C.int
+	rc := C.get_daos_version(ptr, &major, &minor, &fix)
+	if rc != 0 {
+		return nil, "", errors.Errorf("get_daos_version() failed: %d", rc)
+	}
+
+	return &Version{
+		Major: int(major),
+		Minor: int(minor),
+		Patch: int(fix),
+	}, libPath, nil
+}
@@ -0,0 +1,31 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package build
+
+import (
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+func errLibVersionUnsupported(libName string) error {
+	return errors.Errorf("%s version lookup is not supported on %s", libName, runtime.GOOS)
+}
+
+func getLibFabricVersion() (*Version, string, error) {
+	return nil, "", errLibVersionUnsupported("libfabric")
+}
+
+func getMercuryVersion() (*Version, string, error) {
+	return nil, "", errLibVersionUnsupported("libmercury")
+}
+
+func getDAOSVersion() (*Version, string, error) {
+	return nil, "", errLibVersionUnsupported("libdaos")
+}
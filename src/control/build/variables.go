@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -39,6 +40,10 @@ var (
 	// DefaultSystemName defines the default DAOS system name.
 	DefaultSystemName = "daos_server"
 
+	// DefaultControlMaxMsgSize defines the default maximum size in bytes of
+	// a control plane gRPC message.
+	DefaultControlMaxMsgSize = 16 << 20
+
 	// VCS is the version control system used to build the binary.
 	VCS = ""
 	// Revision is the VCS revision of the binary.
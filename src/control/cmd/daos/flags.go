@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ui"
 )
 
 /*
@@ -96,13 +97,9 @@ type ChunkSizeFlag struct {
 }
 
 func (f *ChunkSizeFlag) UnmarshalFlag(fv string) error {
-	if fv == "" {
-		return errors.New("empty chunk size")
-	}
-
-	size, err := humanize.ParseBytes(fv)
+	size, err := ui.ParseByteSize(fv)
 	if err != nil {
-		return errors.Errorf("invalid chunk-size %q", fv)
+		return errors.Wrap(err, "chunk-size")
 	}
 	f.Size = C.uint64_t(size)
 
@@ -200,7 +200,7 @@ func TestFlags_ChunkSizeFlag(t *testing.T) {
 		expErr    error
 	}{
 		"unset": {
-			expErr: errors.New("empty chunk size"),
+			expErr: errors.New("chunk-size: no size specified"),
 		},
 		"bytes": {
 			arg: "1048576",
@@ -228,7 +228,7 @@ func TestFlags_ChunkSizeFlag(t *testing.T) {
 		},
 		"not a size": {
 			arg:    "snausages",
-			expErr: errors.New("invalid chunk-size \"snausages\""),
+			expErr: errors.New("chunk-size: invalid size \"snausages\""),
 		},
 		// TODO: More validation of allowed sizes?
 	} {
@@ -21,6 +21,7 @@ import (
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/lib/atm"
 	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -204,6 +205,8 @@ func main() {
 	var opts cliOptions
 	log := logging.NewCommandLineLogger()
 
+	txtfmt.ConfigureForTerminal(os.Stdout)
+
 	if err := parseOpts(os.Args[1:], &opts, log); err != nil {
 		if fe, ok := errors.Cause(err).(*flags.Error); ok && fe.Type == flags.ErrHelp {
 			log.Info(fe.Error())
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,13 +10,24 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/dustin/go-humanize"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 )
 
+// hlcAge returns a suffix describing the time elapsed since the HLC, or an
+// empty string if the HLC is zero.
+func hlcAge(hlc daos.HLC, now time.Time) string {
+	if hlc.IsZero() {
+		return ""
+	}
+	return "; " + common.FormatAge(hlc.ToTime(), now)
+}
+
 // PrintContainerInfo generates a human-readable representation of the supplied
 // ContainerInfo struct and writes it to the supplied io.Writer.
 func PrintContainerInfo(out io.Writer, ci *daos.ContainerInfo, verbose bool) error {
@@ -28,17 +40,18 @@ func PrintContainerInfo(out io.Writer, ci *daos.ContainerInfo, verbose bool) err
 	rows = append(rows, txtfmt.TableRow{"Container Type": ci.Type.String()})
 
 	if verbose {
+		now := time.Now()
 		rows = append(rows, []txtfmt.TableRow{
 			{"Pool UUID": ci.PoolUUID.String()},
 			{"Container redundancy factor": fmt.Sprintf("%d", ci.RedundancyFactor)},
 			{"Number of open handles": fmt.Sprintf("%d", ci.NumHandles)},
-			{"Latest open time": fmt.Sprintf("%s (%#x)", ci.OpenTime, uint64(ci.OpenTime))},
-			{"Latest close/modify time": fmt.Sprintf("%s (%#x)", ci.CloseModifyTime, uint64(ci.CloseModifyTime))},
+			{"Latest open time": fmt.Sprintf("%s (%#x%s)", ci.OpenTime, uint64(ci.OpenTime), hlcAge(ci.OpenTime, now))},
+			{"Latest close/modify time": fmt.Sprintf("%s (%#x%s)", ci.CloseModifyTime, uint64(ci.CloseModifyTime), hlcAge(ci.CloseModifyTime, now))},
 			{"Number of snapshots": fmt.Sprintf("%d", ci.NumSnapshots)},
 		}...)
 
 		if ci.LatestSnapshot != 0 {
-			rows = append(rows, txtfmt.TableRow{"Latest Persistent Snapshot": fmt.Sprintf("%#x (%s)", uint64(ci.LatestSnapshot), ci.LatestSnapshot)})
+			rows = append(rows, txtfmt.TableRow{"Latest Persistent Snapshot": fmt.Sprintf("%#x (%s%s)", uint64(ci.LatestSnapshot), ci.LatestSnapshot, hlcAge(ci.LatestSnapshot, now))})
 		}
 		if ci.ObjectClass != 0 {
 			rows = append(rows, txtfmt.TableRow{"Object Class": ci.ObjectClass.String()})
@@ -92,35 +105,3 @@ func PrintContainers(out io.Writer, poolID string, containers []*daos.ContainerI
 	tf.InitWriter(txtfmt.NewIndentWriter(out))
 	tf.Format(table)
 }
-
-// PrintContainerProperties generates a human-readable representation of the
-// supplied slice of container properties and writes it to the supplied io.Writer.
-func PrintContainerProperties(out io.Writer, header string, props ...*daos.ContainerProperty) {
-	fmt.Fprintf(out, "%s\n", header)
-
-	if len(props) == 0 {
-		fmt.Fprintln(out, "  No properties found.")
-		return
-	}
-
-	nameTitle := "Name"
-	valueTitle := "Value"
-	titles := []string{nameTitle}
-
-	table := []txtfmt.TableRow{}
-	for _, prop := range props {
-		row := txtfmt.TableRow{}
-		row[nameTitle] = fmt.Sprintf("%s (%s)", prop.Description, prop.Name)
-		if prop.StringValue() != "" {
-			row[valueTitle] = prop.StringValue()
-			if len(titles) == 1 {
-				titles = append(titles, valueTitle)
-			}
-		}
-		table = append(table, row)
-	}
-
-	tf := txtfmt.NewTableFormatter(titles...)
-	tf.InitWriter(out)
-	tf.Format(table)
-}
@@ -0,0 +1,50 @@
+//
+// (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux
+// +build linux
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// PrintContainerProperties generates a human-readable representation of the
+// supplied slice of container properties and writes it to the supplied io.Writer.
+func PrintContainerProperties(out io.Writer, header string, props ...*daos.ContainerProperty) {
+	fmt.Fprintf(out, "%s\n", header)
+
+	if len(props) == 0 {
+		fmt.Fprintln(out, "  No properties found.")
+		return
+	}
+
+	nameTitle := "Name"
+	valueTitle := "Value"
+	titles := []string{nameTitle}
+
+	table := []txtfmt.TableRow{}
+	for _, prop := range props {
+		row := txtfmt.TableRow{}
+		row[nameTitle] = fmt.Sprintf("%s (%s)", prop.Description, prop.Name)
+		if prop.StringValue() != "" {
+			row[valueTitle] = prop.StringValue()
+			if len(titles) == 1 {
+				titles = append(titles, valueTitle)
+			}
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(titles...)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
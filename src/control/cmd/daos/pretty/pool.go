@@ -12,10 +12,12 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 )
@@ -63,6 +65,49 @@ func printPoolTiersMdOnSsd(memFileBytes uint64, suss []*daos.StorageUsageStats,
 	}
 }
 
+// rebuildDuration returns a suffix describing how long the rebuild has been
+// running, or how long it took to complete, if known.
+func rebuildDuration(rs *daos.PoolRebuildStatus) string {
+	if rs.DurationSec == 0 {
+		return ""
+	}
+
+	dur := common.FormatDuration(time.Duration(rs.DurationSec) * time.Second)
+	switch rs.State {
+	case daos.PoolRebuildStateBusy:
+		return fmt.Sprintf(", started %s ago", dur)
+	case daos.PoolRebuildStateDone:
+		return fmt.Sprintf(", completed in %s", dur)
+	default:
+		return ""
+	}
+}
+
+// printRebuildDetail prints the phase, progress, throughput and estimated time
+// remaining of a rebuild in progress.
+func printRebuildDetail(rs *daos.PoolRebuildStatus, out io.Writer) {
+	phase := rs.Phase()
+	if phase == "" {
+		return
+	}
+
+	if rs.Version > 0 {
+		fmt.Fprintf(out, "  Phase: %s (map version %d)\n", phase, rs.Version)
+	} else {
+		fmt.Fprintf(out, "  Phase: %s\n", phase)
+	}
+	if progress, ok := rs.Progress(); ok {
+		fmt.Fprintf(out, "  Progress: %d/%d objs (%.1f%%), %d recs\n",
+			rs.Objects, rs.TotalObjects, progress*100, rs.Records)
+	}
+	if objsPerSec, recsPerSec, ok := rs.Throughput(); ok {
+		fmt.Fprintf(out, "  Throughput: %.1f objs/s, %.1f recs/s\n", objsPerSec, recsPerSec)
+	}
+	if eta, ok := rs.ETA(); ok {
+		fmt.Fprintf(out, "  ETA: %s\n", common.FormatDuration(eta))
+	}
+}
+
 // PrintPoolInfo generates a human-readable representation of the supplied
 // PoolInfo struct and writes it to the supplied io.Writer.
 func PrintPoolInfo(pi *daos.PoolInfo, out io.Writer) error {
@@ -74,6 +119,10 @@ func PrintPoolInfo(pi *daos.PoolInfo, out io.Writer) error {
 	// Maintain output compatibility with the `daos pool query` output.
 	fmt.Fprintf(w, "Pool %s, ntarget=%d, disabled=%d, leader=%d, version=%d, state=%s\n",
 		pi.UUID, pi.TotalTargets, pi.DisabledTargets, pi.ServiceLeader, pi.Version, pi.State)
+	if created := pi.CreationTime(); !created.IsZero() {
+		fmt.Fprintf(w, "Pool created %s (%s)\n", common.FormatTime(created),
+			common.FormatAge(created, time.Now()))
+	}
 
 	if pi.PoolLayoutVer != pi.UpgradeLayoutVer {
 		fmt.Fprintf(w, "Pool layout out of date (%d < %d) -- see `dmg pool upgrade` for details.\n",
@@ -92,8 +141,10 @@ func PrintPoolInfo(pi *daos.PoolInfo, out io.Writer) error {
 	}
 	if pi.Rebuild != nil {
 		if pi.Rebuild.Status == 0 {
-			fmt.Fprintf(w, "- Rebuild %s, %d objs, %d recs\n",
-				pi.Rebuild.State, pi.Rebuild.Objects, pi.Rebuild.Records)
+			fmt.Fprintf(w, "- Rebuild %s, %d objs, %d recs%s\n",
+				pi.Rebuild.State, pi.Rebuild.Objects, pi.Rebuild.Records,
+				rebuildDuration(pi.Rebuild))
+			printRebuildDetail(pi.Rebuild, w)
 		} else {
 			fmt.Fprintf(w, "- Rebuild failed, status=%d\n", pi.Rebuild.Status)
 		}
@@ -12,11 +12,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -25,6 +27,7 @@ import (
 func TestPretty_PrintPoolInfo(t *testing.T) {
 	poolUUID := test.MockPoolUUID()
 	backtickStr := "`" + "dmg pool upgrade" + "`"
+	created := time.Unix(time.Now().Add(-(214*24*time.Hour + 3*time.Hour + 30*time.Minute)).Unix(), 0)
 	for name, tc := range map[string]struct {
 		pi          *daos.PoolInfo
 		expPrintStr string
@@ -36,6 +39,64 @@ Pool %s, ntarget=0, disabled=0, leader=0, version=0, state=Creating
 Pool health info:
 - No rebuild status available.
 `, uuid.Nil.String()),
+		},
+		"creation time; rebuild in progress": {
+			pi: &daos.PoolInfo{
+				UUID:       poolUUID,
+				CreateTime: uint64(created.Unix()),
+				Rebuild: &daos.PoolRebuildStatus{
+					State:       daos.PoolRebuildStateBusy,
+					Objects:     42,
+					Records:     21,
+					DurationSec: 2*60*60 + 13*60 + 5,
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool %s, ntarget=0, disabled=0, leader=0, version=0, state=Creating
+Pool created %s (214d3h ago)
+Pool health info:
+- Rebuild busy, 42 objs, 21 recs, started 2h13m ago
+  Phase: scanning
+  Throughput: 0.0 objs/s, 0.0 recs/s
+`, poolUUID.String(), common.FormatTime(created)),
+		},
+		"rebuild in progress; detail": {
+			pi: &daos.PoolInfo{
+				UUID: poolUUID,
+				Rebuild: &daos.PoolRebuildStatus{
+					State:        daos.PoolRebuildStateBusy,
+					Objects:      250,
+					Records:      5000,
+					TotalObjects: 1000,
+					DurationSec:  100,
+					Version:      7,
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool %s, ntarget=0, disabled=0, leader=0, version=0, state=Creating
+Pool health info:
+- Rebuild busy, 250 objs, 5000 recs, started 1m40s ago
+  Phase: pulling (map version 7)
+  Progress: 250/1000 objs (25.0%%), 5000 recs
+  Throughput: 2.5 objs/s, 50.0 recs/s
+  ETA: 5m
+`, poolUUID.String()),
+		},
+		"rebuild completed": {
+			pi: &daos.PoolInfo{
+				UUID: poolUUID,
+				Rebuild: &daos.PoolRebuildStatus{
+					State:       daos.PoolRebuildStateDone,
+					Objects:     42,
+					Records:     21,
+					DurationSec: 45,
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool %s, ntarget=0, disabled=0, leader=0, version=0, state=Creating
+Pool health info:
+- Rebuild done, 42 objs, 21 recs, completed in 45s
+`, poolUUID.String()),
 		},
 		"normal response": {
 			pi: &daos.PoolInfo{
@@ -72,6 +133,7 @@ Pool %s, ntarget=2, disabled=1, leader=42, version=100, state=Degraded
 Pool layout out of date (1 < 2) -- see `+backtickStr+` for details.
 Pool health info:
 - Rebuild busy, 42 objs, 21 recs
+  Phase: scanning
 Pool space info:
 - Target count:1
 - Storage tier 0 (SCM):
@@ -119,6 +181,7 @@ Pool layout out of date (1 < 2) -- see `+backtickStr+` for details.
 Pool health info:
 - Enabled ranks: 0-2
 - Rebuild busy, 42 objs, 21 recs
+  Phase: scanning
 Pool space info:
 - Target count:1
 - Storage tier 0 (SCM):
@@ -166,6 +229,7 @@ Pool health info:
 - Disabled ranks: 0-1,3
 - Dead ranks: 2
 - Rebuild busy, 42 objs, 21 recs
+  Phase: scanning
 `, poolUUID.String()),
 		},
 		"normal response; disabled ranks": {
@@ -205,6 +269,7 @@ Pool layout out of date (1 < 2) -- see `+backtickStr+` for details.
 Pool health info:
 - Disabled ranks: 0-1,3
 - Rebuild busy, 42 objs, 21 recs
+  Phase: scanning
 Pool space info:
 - Target count:1
 - Storage tier 0 (SCM):
@@ -346,6 +411,7 @@ Pool %s, ntarget=2, disabled=1, leader=42, version=100, state=Degraded
 Pool layout out of date (1 < 2) -- see `+backtickStr+` for details.
 Pool health info:
 - Rebuild busy, 42 objs, 21 recs
+  Phase: scanning
 Pool space info:
 - Target count:1
 - Total memory-file size: 1.1 GB
@@ -0,0 +1,235 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+// AccessControlConfig defines the users and groups that may attach to a DAOS
+// system through the agent. A peer matching any of the deny lists is rejected. If any of
+// the allow lists is non-empty, the peer must match at least one entry in
+// them; otherwise all peers not denied are accepted.
+type AccessControlConfig struct {
+	AllowUIDs []uint32 `yaml:"allow_uids,omitempty"`
+	AllowGIDs []uint32 `yaml:"allow_gids,omitempty"`
+	DenyUIDs  []uint32 `yaml:"deny_uids,omitempty"`
+	DenyGIDs  []uint32 `yaml:"deny_gids,omitempty"`
+}
+
+func hasID(ids []uint32, id uint32) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnyID(ids []uint32, candidates []uint32) (uint32, bool) {
+	for _, id := range candidates {
+		if hasID(ids, id) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// Validate checks that no ID is both allowed and denied.
+func (ac *AccessControlConfig) Validate() error {
+	if ac == nil {
+		return nil
+	}
+
+	if id, found := hasAnyID(ac.DenyUIDs, ac.AllowUIDs); found {
+		return fmt.Errorf("uid %d is in both allow_uids and deny_uids", id)
+	}
+	if id, found := hasAnyID(ac.DenyGIDs, ac.AllowGIDs); found {
+		return fmt.Errorf("gid %d is in both allow_gids and deny_gids", id)
+	}
+
+	return nil
+}
+
+// Enabled returns true if any access control rules are configured.
+func (ac *AccessControlConfig) Enabled() bool {
+	if ac == nil {
+		return false
+	}
+	return len(ac.AllowUIDs)+len(ac.AllowGIDs)+len(ac.DenyUIDs)+len(ac.DenyGIDs) > 0
+}
+
+// CheckAccess returns an error if a peer with the given user ID and group IDs
+// is not permitted to connect.
+func (ac *AccessControlConfig) CheckAccess(uid uint32, gids []uint32) error {
+	if !ac.Enabled() {
+		return nil
+	}
+
+	if hasID(ac.DenyUIDs, uid) {
+		return errors.Errorf("uid %d is denied", uid)
+	}
+	if gid, found := hasAnyID(ac.DenyGIDs, gids); found {
+		return errors.Errorf("uid %d: gid %d is denied", uid, gid)
+	}
+
+	if len(ac.AllowUIDs) == 0 && len(ac.AllowGIDs) == 0 {
+		return nil
+	}
+	if hasID(ac.AllowUIDs, uid) {
+		return nil
+	}
+	if _, found := hasAnyID(ac.AllowGIDs, gids); found {
+		return nil
+	}
+
+	return errors.Errorf("uid %d is not in allowed users or groups", uid)
+}
+
+// procGroups returns the supplementary group IDs of a process, as reported by
+// /proc/<pid>/status.
+func procGroups(procRoot string, pid int32) ([]uint32, error) {
+	f, err := os.Open(fmt.Sprintf("%s/%d/status", procRoot, pid))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "Groups:" {
+			continue
+		}
+
+		gids := make([]uint32, 0, len(fields)-1)
+		for _, field := range fields[1:] {
+			gid, err := strconv.ParseUint(field, 10, 32)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid group ID %q", field)
+			}
+			gids = append(gids, uint32(gid))
+		}
+		return gids, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// systemAccess enforces the access control rules of the DAOS systems that
+// clients of the agent may attach to.
+type systemAccess struct {
+	log      logging.Logger
+	procRoot string
+	rules    map[string]*AccessControlConfig
+}
+
+// newSystemAccess returns the access control rules for the agent's own system
+// and for each additional system, or nil if no rules are configured. An
+// additional system without its own rules uses those of the agent's system.
+func newSystemAccess(log logging.Logger, cfg *Config) *systemAccess {
+	sa := &systemAccess{
+		log:      log,
+		procRoot: "/proc",
+		rules:    map[string]*AccessControlConfig{cfg.SystemName: cfg.AccessControl},
+	}
+	enabled := cfg.AccessControl.Enabled()
+	for _, sys := range cfg.Systems {
+		ac := sys.AccessControl
+		if ac == nil {
+			ac = cfg.AccessControl
+		}
+		sa.rules[sys.Name] = ac
+		enabled = enabled || ac.Enabled()
+	}
+
+	if !enabled {
+		return nil
+	}
+	return sa
+}
+
+// hasGroupRules returns true if any of the rules refer to group IDs.
+func (sa *systemAccess) hasGroupRules() bool {
+	for _, ac := range sa.rules {
+		if ac != nil && len(ac.AllowGIDs)+len(ac.DenyGIDs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// peerIDs returns the user ID of the peer process and its primary group ID.
+// Its supplementary group IDs are included if any rules refer to group IDs.
+func (sa *systemAccess) peerIDs(info *security.DomainInfo) (uint32, []uint32, error) {
+	gids := []uint32{info.Gid()}
+	if sa.hasGroupRules() {
+		suppGids, err := procGroups(sa.procRoot, info.Pid())
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "unable to get groups of pid %d", info.Pid())
+		}
+		gids = append(gids, suppGids...)
+	}
+
+	return info.Uid(), gids, nil
+}
+
+// CheckSystem returns an error if the peer process may not attach to the
+// system.
+func (sa *systemAccess) CheckSystem(info *security.DomainInfo, sys string) error {
+	if sa == nil {
+		return nil
+	}
+
+	uid, gids, err := sa.peerIDs(info)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrapf(sa.rules[sys].CheckAccess(uid, gids), "pid %d: system %s", info.Pid(), sys)
+}
+
+// ConnFilter rejects connections to the agent socket from processes that may
+// not attach to any of the systems. The system a client attaches to is checked
+// with CheckSystem when it requests the attach info.
+func (sa *systemAccess) ConnFilter(conn net.Conn) error {
+	uConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return errors.Errorf("unexpected connection type %T", conn)
+	}
+
+	info, err := security.DomainInfoFromUnixConn(sa.log, uConn)
+	if err != nil {
+		return errors.Wrap(err, "unable to get peer credentials")
+	}
+
+	uid, gids, err := sa.peerIDs(info)
+	if err != nil {
+		return err
+	}
+
+	for _, ac := range sa.rules {
+		if ac.CheckAccess(uid, gids) == nil {
+			return nil
+		}
+	}
+
+	return errors.Errorf("pid %d: uid %d may not attach to any system", info.Pid(), uid)
+}
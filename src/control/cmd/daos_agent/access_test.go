@@ -0,0 +1,321 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestAgent_AccessControlConfig_CheckAccess(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ac     *AccessControlConfig
+		uid    uint32
+		gids   []uint32
+		expErr error
+	}{
+		"nil config": {
+			uid:  1000,
+			gids: []uint32{1000},
+		},
+		"no rules": {
+			ac:   &AccessControlConfig{},
+			uid:  1000,
+			gids: []uint32{1000},
+		},
+		"uid denied": {
+			ac:     &AccessControlConfig{DenyUIDs: []uint32{1000}},
+			uid:    1000,
+			gids:   []uint32{1000},
+			expErr: errors.New("uid 1000 is denied"),
+		},
+		"supplementary gid denied": {
+			ac:     &AccessControlConfig{DenyGIDs: []uint32{500}},
+			uid:    1000,
+			gids:   []uint32{1000, 500},
+			expErr: errors.New("gid 500 is denied"),
+		},
+		"not denied": {
+			ac:   &AccessControlConfig{DenyUIDs: []uint32{1001}, DenyGIDs: []uint32{500}},
+			uid:  1000,
+			gids: []uint32{1000},
+		},
+		"uid allowed": {
+			ac:   &AccessControlConfig{AllowUIDs: []uint32{1000}},
+			uid:  1000,
+			gids: []uint32{1000},
+		},
+		"gid allowed": {
+			ac:   &AccessControlConfig{AllowUIDs: []uint32{1001}, AllowGIDs: []uint32{500}},
+			uid:  1000,
+			gids: []uint32{1000, 500},
+		},
+		"not allowed": {
+			ac:     &AccessControlConfig{AllowUIDs: []uint32{1001}, AllowGIDs: []uint32{500}},
+			uid:    1000,
+			gids:   []uint32{1000},
+			expErr: errors.New("not in allowed users or groups"),
+		},
+		"deny takes precedence": {
+			ac:     &AccessControlConfig{AllowUIDs: []uint32{1000}, DenyGIDs: []uint32{500}},
+			uid:    1000,
+			gids:   []uint32{1000, 500},
+			expErr: errors.New("gid 500 is denied"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.ac.CheckAccess(tc.uid, tc.gids))
+		})
+	}
+}
+
+func TestAgent_AccessControlConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ac     *AccessControlConfig
+		expErr error
+	}{
+		"nil": {},
+		"valid": {
+			ac: &AccessControlConfig{
+				AllowUIDs: []uint32{1000},
+				AllowGIDs: []uint32{500},
+				DenyUIDs:  []uint32{1001},
+				DenyGIDs:  []uint32{501},
+			},
+		},
+		"uid allowed and denied": {
+			ac: &AccessControlConfig{
+				AllowUIDs: []uint32{1000, 1001},
+				DenyUIDs:  []uint32{1001},
+			},
+			expErr: errors.New("uid 1001 is in both"),
+		},
+		"gid allowed and denied": {
+			ac: &AccessControlConfig{
+				AllowGIDs: []uint32{500},
+				DenyGIDs:  []uint32{500},
+			},
+			expErr: errors.New("gid 500 is in both"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.ac.Validate())
+		})
+	}
+}
+
+func TestAgent_newSystemAccess(t *testing.T) {
+	agentRules := &AccessControlConfig{AllowUIDs: []uint32{1000}}
+	sysRules := &AccessControlConfig{DenyUIDs: []uint32{1000}}
+
+	for name, tc := range map[string]struct {
+		cfg      *Config
+		expRules map[string]*AccessControlConfig
+	}{
+		"no rules": {
+			cfg: &Config{
+				SystemName: "daos_server",
+				Systems:    []*SystemConfig{{Name: "other"}},
+			},
+		},
+		"agent system rules inherited": {
+			cfg: &Config{
+				SystemName:    "daos_server",
+				AccessControl: agentRules,
+				Systems:       []*SystemConfig{{Name: "other"}},
+			},
+			expRules: map[string]*AccessControlConfig{
+				"daos_server": agentRules,
+				"other":       agentRules,
+			},
+		},
+		"per-system rules": {
+			cfg: &Config{
+				SystemName:    "daos_server",
+				AccessControl: agentRules,
+				Systems: []*SystemConfig{
+					{Name: "other", AccessControl: sysRules},
+					{Name: "third"},
+				},
+			},
+			expRules: map[string]*AccessControlConfig{
+				"daos_server": agentRules,
+				"other":       sysRules,
+				"third":       agentRules,
+			},
+		},
+		"additional system rules only": {
+			cfg: &Config{
+				SystemName: "daos_server",
+				Systems:    []*SystemConfig{{Name: "other", AccessControl: sysRules}},
+			},
+			expRules: map[string]*AccessControlConfig{
+				"daos_server": nil,
+				"other":       sysRules,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			sa := newSystemAccess(log, tc.cfg)
+			if tc.expRules == nil {
+				if sa != nil {
+					t.Fatalf("expected nil, got rules %+v", sa.rules)
+				}
+				return
+			}
+			if sa == nil {
+				t.Fatal("expected rules, got nil")
+			}
+			if diff := cmp.Diff(tc.expRules, sa.rules); diff != "" {
+				t.Fatalf("unexpected rules (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_systemAccess_CheckSystem(t *testing.T) {
+	procRoot, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pidDir := filepath.Join(procRoot, "42")
+	if err := os.Mkdir(pidDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(pidDir, "status"), []byte("Groups:\t10 500\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		sa     *systemAccess
+		pid    int32
+		sys    string
+		expErr error
+	}{
+		"nil": {
+			pid: 42,
+			sys: "daos_server",
+		},
+		"allowed": {
+			sa: &systemAccess{
+				rules: map[string]*AccessControlConfig{
+					"daos_server": {AllowUIDs: []uint32{1000}},
+				},
+			},
+			pid: 42,
+			sys: "daos_server",
+		},
+		"denied on system": {
+			sa: &systemAccess{
+				rules: map[string]*AccessControlConfig{
+					"daos_server": {AllowUIDs: []uint32{1000}},
+					"other":       {DenyUIDs: []uint32{1000}},
+				},
+			},
+			pid:    42,
+			sys:    "other",
+			expErr: errors.New("pid 42: system other: uid 1000 is denied"),
+		},
+		"supplementary gid denied": {
+			sa: &systemAccess{
+				rules: map[string]*AccessControlConfig{
+					"daos_server": {DenyGIDs: []uint32{500}},
+				},
+			},
+			pid:    42,
+			sys:    "daos_server",
+			expErr: errors.New("gid 500 is denied"),
+		},
+		"supplementary gid allowed on other system": {
+			sa: &systemAccess{
+				rules: map[string]*AccessControlConfig{
+					"daos_server": {DenyGIDs: []uint32{500}},
+					"other":       {AllowGIDs: []uint32{500}},
+				},
+			},
+			pid: 42,
+			sys: "other",
+		},
+		"groups unavailable": {
+			sa: &systemAccess{
+				rules: map[string]*AccessControlConfig{
+					"daos_server": {AllowGIDs: []uint32{500}},
+				},
+			},
+			pid:    43,
+			sys:    "daos_server",
+			expErr: errors.New("unable to get groups of pid 43"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if tc.sa != nil {
+				tc.sa.procRoot = procRoot
+			}
+			cred := security.InitDomainInfo(&security.Ucred{Pid: tc.pid, Uid: 1000, Gid: 1000}, "")
+
+			test.CmpErr(t, tc.expErr, tc.sa.CheckSystem(cred, tc.sys))
+		})
+	}
+}
+
+func TestAgent_procGroups(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status  string
+		expGids []uint32
+		expErr  error
+	}{
+		"no status file": {
+			expErr: errors.New("no such file"),
+		},
+		"no groups line": {
+			status: "Name:\ttest\nUid:\t1000\t1000\t1000\t1000\n",
+		},
+		"no supplementary groups": {
+			status:  "Name:\ttest\nGroups:\t\n",
+			expGids: []uint32{},
+		},
+		"supplementary groups": {
+			status:  "Name:\ttest\nGid:\t1000\t1000\t1000\t1000\nGroups:\t10 500 1000 \nNStgid:\t42\n",
+			expGids: []uint32{10, 500, 1000},
+		},
+		"bad group": {
+			status: "Groups:\t10 bad\n",
+			expErr: errors.New("invalid group ID"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			procRoot, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			if tc.status != "" {
+				pidDir := filepath.Join(procRoot, "42")
+				if err := os.Mkdir(pidDir, 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(pidDir, "status"), []byte(tc.status), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gids, err := procGroups(procRoot, 42)
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expGids, gids); diff != "" {
+				t.Fatalf("unexpected groups (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"net"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// activatedListener returns a listener for the agent socket passed by systemd
+// socket activation, or nil if no socket was passed. The socket must be a
+// sequential packet socket (ListenSequentialPacket= in the socket unit). The
+// supplied files are closed.
+func activatedListener(log logging.Logger, sockPath string, files []*os.File) (*net.UnixListener, error) {
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+
+	switch len(files) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, errors.Errorf("expected 1 socket from systemd socket activation, got %d", len(files))
+	}
+
+	f := files[0]
+	if err := checkSeqPacketSocket(f); err != nil {
+		return nil, err
+	}
+
+	lis, err := net.FileListener(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "using activated socket %s", f.Name())
+	}
+	ul, ok := lis.(*net.UnixListener)
+	if !ok {
+		lis.Close()
+		return nil, errors.Errorf("activated socket %s is not a unix socket", f.Name())
+	}
+
+	if addr := ul.Addr().String(); addr != sockPath {
+		log.Noticef("activated socket %s does not match agent socket path %s; clients may be unable to connect",
+			addr, sockPath)
+	}
+
+	return ul, nil
+}
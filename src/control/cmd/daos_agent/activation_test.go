@@ -0,0 +1,120 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_activatedListener(t *testing.T) {
+	sockFile := func(t *testing.T, network, path string) *os.File {
+		t.Helper()
+
+		lis, err := net.ListenUnix(network, &net.UnixAddr{Name: path, Net: network})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { lis.Close() })
+
+		f, err := lis.File()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return f
+	}
+
+	for name, tc := range map[string]struct {
+		files      func(t *testing.T, dir string) []*os.File
+		sockName   string
+		expNil     bool
+		expErr     error
+		expLogText string
+	}{
+		"not activated": {
+			files:  func(*testing.T, string) []*os.File { return nil },
+			expNil: true,
+		},
+		"too many sockets": {
+			files: func(t *testing.T, dir string) []*os.File {
+				return []*os.File{
+					sockFile(t, "unixpacket", filepath.Join(dir, "one.sock")),
+					sockFile(t, "unixpacket", filepath.Join(dir, "two.sock")),
+				}
+			},
+			expErr: errors.New("expected 1 socket"),
+		},
+		"stream socket": {
+			files: func(t *testing.T, dir string) []*os.File {
+				return []*os.File{sockFile(t, "unix", filepath.Join(dir, agentSockName))}
+			},
+			expErr: errors.New("not a sequential packet socket"),
+		},
+		"not a socket": {
+			files: func(t *testing.T, dir string) []*os.File {
+				f, err := os.Create(filepath.Join(dir, agentSockName))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return []*os.File{f}
+			},
+			expErr: errors.New("checking type"),
+		},
+		"different path": {
+			files: func(t *testing.T, dir string) []*os.File {
+				return []*os.File{sockFile(t, "unixpacket", filepath.Join(dir, "other.sock"))}
+			},
+			expLogText: "does not match agent socket path",
+		},
+		"success": {
+			files: func(t *testing.T, dir string) []*os.File {
+				return []*os.File{sockFile(t, "unixpacket", filepath.Join(dir, agentSockName))}
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			sockPath := filepath.Join(dir, agentSockName)
+			lis, err := activatedListener(log, sockPath, tc.files(t, dir))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if tc.expNil {
+				if lis != nil {
+					t.Fatal("expected nil listener")
+				}
+				return
+			}
+			defer lis.Close()
+
+			if tc.expLogText != "" && !strings.Contains(buf.String(), tc.expLogText) {
+				t.Fatalf("expected %q in log output", tc.expLogText)
+			}
+
+			conn, err := net.DialUnix("unixpacket", nil, lis.Addr().(*net.UnixAddr))
+			if err != nil {
+				t.Fatalf("unable to connect to activated socket: %s", err)
+			}
+			conn.Close()
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -26,6 +27,10 @@ type attachInfoCmd struct {
 }
 
 func (cmd *attachInfoCmd) getAttachInfo(ctx context.Context) (*control.GetAttachInfoResp, error) {
+	if cmd.cfg.AttachInfoFile != "" {
+		return loadStaticAttachInfo(cmd.cfg.AttachInfoFile)
+	}
+
 	req := &control.GetAttachInfoReq{
 		AllRanks: true,
 	}
@@ -0,0 +1,262 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// attachInfoCacheFile is the name of the file in the agent's runtime
+	// directory that holds the last attach info received from the MS.
+	attachInfoCacheFile = "attach_info_cache.json"
+
+	// baseMSConnectBackoff is the initial delay between attempts to contact
+	// the MS after startup.
+	baseMSConnectBackoff = time.Second
+	// maxMSConnectBackoffFactor limits the delay between attempts to
+	// contact the MS after startup.
+	maxMSConnectBackoffFactor = 6 // 64s
+)
+
+type (
+	attachInfoCacheData struct {
+		System     string                     `json:"system"`
+		SavedAt    time.Time                  `json:"saved_at"`
+		AttachInfo *control.GetAttachInfoResp `json:"attach_info"`
+	}
+
+	// attachInfoStore persists the last attach info received from the MS for
+	// the agent's system to a local file, so that an agent started while the
+	// MS is unreachable can serve it to clients until the MS can be contacted.
+	attachInfoStore struct {
+		sync.Mutex
+		log    logging.Logger
+		path   string
+		system string
+	}
+
+	// msConnStatus describes the state of the agent's connection to the MS.
+	msConnStatus struct {
+		Ready           bool     `json:"ready"`
+		MSConnected     bool     `json:"ms_connected"`
+		StaleAttachInfo []string `json:"stale_attach_info,omitempty"`
+		Error           string   `json:"error,omitempty"`
+	}
+)
+
+func newAttachInfoStore(log logging.Logger, path, system string) *attachInfoStore {
+	return &attachInfoStore{
+		log:    log,
+		path:   path,
+		system: system,
+	}
+}
+
+// load returns the attach info saved in the cache file.
+func (s *attachInfoStore) load() (*attachInfoCacheData, error) {
+	buf, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	data := new(attachInfoCacheData)
+	if err := json.Unmarshal(buf, data); err != nil {
+		return nil, errors.Wrapf(err, "decoding %s", s.path)
+	}
+	if data.System != s.system {
+		return nil, errors.Errorf("saved attach info is for system %q, not %q", data.System, s.system)
+	}
+	if data.AttachInfo == nil || data.AttachInfo.ClientNetHint.Provider == "" {
+		return nil, errors.New("saved attach info contains no provider")
+	}
+
+	return data, nil
+}
+
+// save writes the attach info to the cache file if it is for the agent's
+// system.
+func (s *attachInfoStore) save(system string, resp *control.GetAttachInfoResp) error {
+	if s == nil || resp == nil || system != s.system {
+		return nil
+	}
+
+	buf, err := json.Marshal(&attachInfoCacheData{
+		System:     system,
+		SavedAt:    time.Now(),
+		AttachInfo: resp,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	return common.WriteFileAtomic(s.path, buf, 0600)
+}
+
+// setMSConnected records the outcome of the last request to the MS.
+func (c *InfoCache) setMSConnected(err error) {
+	c.msConnMutex.Lock()
+	defer c.msConnMutex.Unlock()
+
+	c.msConnected = err == nil
+	c.msConnErr = err
+}
+
+// LoadSavedAttachInfo populates the attach info cache with the attach info
+// saved by a previous agent, if any. The saved attach info is served to
+// clients, and reported as stale, until it has been refreshed from the MS.
+func (c *InfoCache) LoadSavedAttachInfo() {
+	if c == nil || c.attachInfoStore == nil || !c.IsAttachInfoCacheEnabled() {
+		return
+	}
+
+	data, err := c.attachInfoStore.load()
+	if err != nil {
+		if !os.IsNotExist(err) {
+			c.log.Noticef("not using saved attach info: %s", err)
+		}
+		return
+	}
+
+	item := newCachedAttachInfo(c.attachInfoRefresh, data.System, c.client, c.getAttachInfo)
+	item.metrics = c.metrics
+	item.lastResponse = data.AttachInfo
+	item.lastCached = time.Now()
+	item.persisted.SetTrue()
+	c.addTelemetrySettings(item.lastResponse)
+	if err := c.cache.Set(item); err != nil {
+		c.log.Errorf("error setting saved attach info: %s", err)
+		return
+	}
+
+	c.msConnMutex.Lock()
+	if c.savedAttachInfo == nil {
+		c.savedAttachInfo = make(map[string]*cachedAttachInfo)
+	}
+	c.savedAttachInfo[data.System] = item
+	c.msConnMutex.Unlock()
+
+	c.log.Noticef("loaded attach info for system %s saved %s ago; serving it as stale until the MS is contacted",
+		data.System, time.Since(data.SavedAt).Round(time.Second))
+}
+
+// staleAttachInfo returns the systems for which saved attach info that has not
+// yet been refreshed from the MS is being served.
+func (c *InfoCache) staleAttachInfo() []string {
+	c.msConnMutex.Lock()
+	defer c.msConnMutex.Unlock()
+
+	var systems []string
+	for sys, item := range c.savedAttachInfo {
+		if item.persisted.IsFalse() {
+			delete(c.savedAttachInfo, sys)
+			continue
+		}
+		systems = append(systems, sys)
+	}
+	sort.Strings(systems)
+
+	return systems
+}
+
+// MSConnStatus returns the state of the agent's connection to the MS. The agent
+// is ready once it has successfully contacted the MS.
+func (c *InfoCache) MSConnStatus() *msConnStatus {
+	if c == nil {
+		return &msConnStatus{Error: "InfoCache is nil"}
+	}
+
+	status := &msConnStatus{
+		StaleAttachInfo: c.staleAttachInfo(),
+	}
+
+	c.msConnMutex.Lock()
+	defer c.msConnMutex.Unlock()
+
+	status.MSConnected = c.msConnected
+	status.Ready = c.msConnected
+	if c.msConnErr != nil {
+		status.Error = c.msConnErr.Error()
+	}
+
+	return status
+}
+
+// connectMS fetches the attach info for the system from the MS and, if the
+// attach info cache is enabled, replaces any cached attach info with it. The
+// cached item is not locked while the MS is contacted, so that clients can be
+// served the saved attach info in the meantime.
+func (c *InfoCache) connectMS(ctx context.Context, sys string) error {
+	req := &control.GetAttachInfoReq{System: sys, AllRanks: true}
+	resp, err := c.getAttachInfo(ctx, c.client, req)
+	if err != nil {
+		return err
+	}
+
+	if !c.IsAttachInfoCacheEnabled() {
+		return nil
+	}
+
+	item := newCachedAttachInfo(c.attachInfoRefresh, sys, c.client, c.getAttachInfo)
+	item.metrics = c.metrics
+	item.lastResponse = resp
+	item.lastCached = time.Now()
+	if err := c.cache.Set(item); err != nil {
+		return errors.Wrap(err, "caching attach info")
+	}
+
+	c.msConnMutex.Lock()
+	if saved, found := c.savedAttachInfo[sys]; found {
+		saved.persisted.SetFalse()
+	}
+	c.msConnMutex.Unlock()
+
+	return nil
+}
+
+// ConnectMS contacts the MS for the attach info of the system, retrying with an
+// exponential backoff until it succeeds or the context is canceled.
+func (c *InfoCache) ConnectMS(ctx context.Context, sys string) {
+	if c == nil {
+		return
+	}
+
+	for try := uint64(1); ; try++ {
+		err := c.connectMS(ctx, sys)
+		if err == nil {
+			if try > 1 {
+				c.log.Noticef("contacted the MS for system %s after %d attempts", sys, try)
+			}
+			return
+		}
+
+		backoff := common.ExpBackoff(baseMSConnectBackoff, try, maxMSConnectBackoffFactor)
+		if try == 1 {
+			c.log.Noticef("unable to contact the MS for system %s, retrying in the background: %s", sys, err)
+		}
+		c.log.Debugf("retrying MS request for system %s in %s: %s", sys, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+	}
+}
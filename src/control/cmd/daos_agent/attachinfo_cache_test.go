@@ -0,0 +1,215 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func testAttachInfoResp(uri string) *control.GetAttachInfoResp {
+	return &control.GetAttachInfoResp{
+		System:       "daos_server",
+		ServiceRanks: []*control.PrimaryServiceRank{{Rank: 1, Uri: uri}},
+		MSRanks:      []uint32{0, 1, 2},
+		ClientNetHint: control.ClientNetworkHint{
+			Provider: "ofi+tcp",
+		},
+	}
+}
+
+func TestAgent_attachInfoStore(t *testing.T) {
+	for name, tc := range map[string]struct {
+		system  string
+		resp    *control.GetAttachInfoResp
+		corrupt bool
+		expResp *control.GetAttachInfoResp
+		expErr  error
+	}{
+		"nothing saved": {
+			expErr: os.ErrNotExist,
+		},
+		"other system not saved": {
+			system: "other",
+			resp:   testAttachInfoResp("saved"),
+			expErr: os.ErrNotExist,
+		},
+		"no provider": {
+			system: "daos_server",
+			resp:   &control.GetAttachInfoResp{System: "daos_server"},
+			expErr: errors.New("no provider"),
+		},
+		"corrupt cache file": {
+			corrupt: true,
+			expErr:  errors.New("decoding"),
+		},
+		"success": {
+			system:  "daos_server",
+			resp:    testAttachInfoResp("saved"),
+			expResp: testAttachInfoResp("saved"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			store := newAttachInfoStore(log, filepath.Join(dir, attachInfoCacheFile), "daos_server")
+			if err := store.save(tc.system, tc.resp); err != nil {
+				t.Fatal(err)
+			}
+			if tc.corrupt {
+				if err := os.WriteFile(store.path, []byte("{bad"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			data, err := store.load()
+			if errors.Is(tc.expErr, os.ErrNotExist) {
+				if !os.IsNotExist(err) {
+					t.Fatalf("expected not exist error, got %v", err)
+				}
+				return
+			}
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, "daos_server", data.System, "unexpected system")
+			if diff := cmp.Diff(tc.expResp, data.AttachInfo); diff != "" {
+				t.Fatalf("unexpected attach info (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_InfoCache_SavedAttachInfo(t *testing.T) {
+	savedResp := testAttachInfoResp("saved")
+	remoteResp := testAttachInfoResp("remote")
+
+	for name, tc := range map[string]struct {
+		saved         *control.GetAttachInfoResp
+		remoteErr     error
+		expFirstResp  *control.GetAttachInfoResp
+		expFirstErr   error
+		expFirstCalls int
+		expConnErr    error
+		expStatus     *msConnStatus
+		expResp       *control.GetAttachInfoResp
+		expSaved      *control.GetAttachInfoResp
+	}{
+		"nothing saved; MS unreachable": {
+			remoteErr:     errors.New("mock remote"),
+			expFirstErr:   errors.New("mock remote"),
+			expFirstCalls: 1,
+			expConnErr:    errors.New("mock remote"),
+			expStatus: &msConnStatus{
+				Error: "mock remote",
+			},
+		},
+		"nothing saved; MS reachable": {
+			expFirstResp:  remoteResp,
+			expFirstCalls: 1,
+			expStatus: &msConnStatus{
+				Ready:       true,
+				MSConnected: true,
+			},
+			expResp:  remoteResp,
+			expSaved: remoteResp,
+		},
+		"saved; MS unreachable": {
+			saved:        savedResp,
+			remoteErr:    errors.New("mock remote"),
+			expFirstResp: savedResp,
+			expConnErr:   errors.New("mock remote"),
+			expStatus: &msConnStatus{
+				StaleAttachInfo: []string{"daos_server"},
+				Error:           "mock remote",
+			},
+			expResp:  savedResp,
+			expSaved: savedResp,
+		},
+		"saved; MS reachable": {
+			saved:        savedResp,
+			expFirstResp: savedResp,
+			expStatus: &msConnStatus{
+				Ready:       true,
+				MSConnected: true,
+			},
+			expResp:  remoteResp,
+			expSaved: remoteResp,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			store := newAttachInfoStore(log, filepath.Join(dir, attachInfoCacheFile), "daos_server")
+			if err := store.save("daos_server", tc.saved); err != nil {
+				t.Fatal(err)
+			}
+
+			calls := 0
+			ic := newTestInfoCache(t, log, testInfoCacheParams{
+				mockGetAttachInfo: func(_ context.Context, _ control.UnaryInvoker, _ *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+					calls++
+					if tc.remoteErr != nil {
+						return nil, tc.remoteErr
+					}
+					return copyGetAttachInfoResp(remoteResp), nil
+				},
+			})
+			ic.attachInfoStore = store
+			ic.LoadSavedAttachInfo()
+
+			resp, err := ic.GetAttachInfo(test.Context(t), "daos_server")
+			test.CmpErr(t, tc.expFirstErr, err)
+			test.AssertEqual(t, tc.expFirstCalls, calls, "unexpected number of MS requests")
+			if diff := cmp.Diff(tc.expFirstResp, resp); diff != "" {
+				t.Fatalf("unexpected first response (-want, +got):\n%s\n", diff)
+			}
+
+			test.CmpErr(t, tc.expConnErr, ic.connectMS(test.Context(t), "daos_server"))
+			if diff := cmp.Diff(tc.expStatus, ic.MSConnStatus()); diff != "" {
+				t.Fatalf("unexpected status (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expResp != nil {
+				resp, err = ic.GetAttachInfo(test.Context(t), "daos_server")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(tc.expResp, resp); diff != "" {
+					t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+				}
+			}
+
+			var saved *control.GetAttachInfoResp
+			if data, err := store.load(); err == nil {
+				saved = data.AttachInfo
+			}
+			if diff := cmp.Diff(tc.expSaved, saved); diff != "" {
+				t.Fatalf("unexpected saved attach info (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
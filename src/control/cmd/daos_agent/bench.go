@@ -0,0 +1,454 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// benchAttachInfo simulates the GetAttachInfo request sent by a client
+	// to fetch the rank URIs of the system.
+	benchAttachInfo = "attach-info"
+	// benchFabricDevice simulates the GetAttachInfo request sent by a
+	// client at initialization to select its fabric device, which does not
+	// request the rank URIs.
+	benchFabricDevice = "fabric-device"
+	benchAll          = "all"
+
+	cacheRefreshMetric  = "agent_cache_refresh_seconds"
+	cacheErrorsMetric   = "agent_cache_refresh_errors_total"
+	msDroppedReqsMetric = "agent_ms_requests_dropped_total"
+)
+
+type (
+	// benchRequestStats contains the latencies of the requests of one type
+	// sent during a benchmark.
+	benchRequestStats struct {
+		Request   string        `json:"request"`
+		Count     int           `json:"count"`
+		Errors    int           `json:"errors"`
+		First     time.Duration `json:"first"`
+		P50       time.Duration `json:"p50"`
+		P90       time.Duration `json:"p90"`
+		P99       time.Duration `json:"p99"`
+		Max       time.Duration `json:"max"`
+		latencies []time.Duration
+	}
+
+	// benchCacheStats contains the activity of the agent's cache during a
+	// benchmark, as reported by the agent's telemetry.
+	benchCacheStats struct {
+		Refreshes         map[string]uint64 `json:"refreshes"`
+		RefreshErrors     map[string]uint64 `json:"refresh_errors"`
+		MSRequestsDropped uint64            `json:"ms_requests_dropped"`
+	}
+
+	// benchReport contains the results of a benchmark.
+	benchReport struct {
+		Clients   uint                 `json:"clients"`
+		Duration  time.Duration        `json:"duration"`
+		Requests  []*benchRequestStats `json:"requests"`
+		Cache     *benchCacheStats     `json:"cache,omitempty"`
+		CacheNote string               `json:"cache_note,omitempty"`
+	}
+
+	// benchRunner simulates the dRPC load of many concurrent client
+	// processes on a running agent.
+	benchRunner struct {
+		log          logging.Logger
+		sys          string
+		sockPath     string
+		clients      uint
+		rate         float64
+		requests     []string
+		newClient    func(sockPath string) drpc.DomainSocketClient
+		queryMetrics func(ctx context.Context) (*control.MetricsQueryResp, error)
+	}
+
+	benchSample struct {
+		request string
+		start   time.Time
+		latency time.Duration
+		err     error
+	}
+)
+
+// add records a sample.
+func (s *benchRequestStats) add(sample *benchSample) {
+	s.Count++
+	if sample.err != nil {
+		s.Errors++
+	}
+	s.latencies = append(s.latencies, sample.latency)
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies.
+func percentile(sorted []time.Duration, pct int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := (len(sorted)*pct + 99) / 100
+	if idx < 1 {
+		idx = 1
+	}
+	return sorted[idx-1]
+}
+
+// summarize calculates the latency percentiles.
+func (s *benchRequestStats) summarize() {
+	sorted := make([]time.Duration, len(s.latencies))
+	copy(sorted, s.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	s.P50 = percentile(sorted, 50)
+	s.P90 = percentile(sorted, 90)
+	s.P99 = percentile(sorted, 99)
+	if len(sorted) > 0 {
+		s.Max = sorted[len(sorted)-1]
+	}
+}
+
+func benchRequests(requests string) []string {
+	if requests == benchAll {
+		return []string{benchAttachInfo, benchFabricDevice}
+	}
+	return []string{requests}
+}
+
+// sendRequest sends a single request on the connection.
+func (r *benchRunner) sendRequest(ctx context.Context, conn drpc.DomainSocketClient, request string) error {
+	body, err := proto.Marshal(&mgmtpb.GetAttachInfoReq{
+		Sys:      r.sys,
+		AllRanks: request == benchAttachInfo,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.SendMsg(ctx, &drpc.Call{
+		Module: drpc.MethodGetAttachInfo.Module().ID(),
+		Method: drpc.MethodGetAttachInfo.ID(),
+		Body:   body,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Status != drpc.Status_SUCCESS {
+		return errors.Errorf("dRPC status %s", resp.Status)
+	}
+
+	pbResp := new(mgmtpb.GetAttachInfoResp)
+	if err := proto.Unmarshal(resp.Body, pbResp); err != nil {
+		return err
+	}
+	if pbResp.Status != 0 {
+		return daos.Status(pbResp.Status)
+	}
+
+	return nil
+}
+
+// runClient sends requests on a single connection until the context is
+// canceled, cycling through the request types.
+func (r *benchRunner) runClient(ctx context.Context, idx uint) []*benchSample {
+	var samples []*benchSample
+
+	conn := r.newClient(r.sockPath)
+	if err := conn.Connect(ctx); err != nil {
+		r.log.Errorf("client %d: unable to connect to agent: %s", idx, err)
+		return []*benchSample{{request: r.requests[0], start: time.Now(), err: err}}
+	}
+	defer conn.Close()
+
+	var throttle <-chan time.Time
+	if r.rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / r.rate))
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	for i := int(idx); ; i++ {
+		request := r.requests[i%len(r.requests)]
+		start := time.Now()
+		err := r.sendRequest(ctx, conn, request)
+		if ctx.Err() != nil {
+			// Discard requests interrupted by the end of the run.
+			return samples
+		}
+		if err != nil {
+			r.log.Debugf("client %d: %s request failed: %s", idx, request, err)
+		}
+		samples = append(samples, &benchSample{
+			request: request,
+			start:   start,
+			latency: time.Since(start),
+			err:     err,
+		})
+
+		if throttle == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return samples
+		case <-throttle:
+		}
+	}
+}
+
+// getCacheStats reads the agent's cache telemetry, if it is available.
+func (r *benchRunner) getCacheStats(ctx context.Context) (*benchCacheStats, error) {
+	if r.queryMetrics == nil {
+		return nil, nil
+	}
+
+	resp, err := r.queryMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &benchCacheStats{
+		Refreshes:     make(map[string]uint64),
+		RefreshErrors: make(map[string]uint64),
+	}
+	for _, ms := range resp.MetricSets {
+		for _, m := range ms.Metrics {
+			switch metric := m.(type) {
+			case *daos.HistogramMetric:
+				if ms.Name == cacheRefreshMetric {
+					stats.Refreshes[metric.Labels["item"]] += metric.SampleCount
+				}
+			case *daos.SimpleMetric:
+				switch ms.Name {
+				case cacheErrorsMetric:
+					stats.RefreshErrors[metric.Labels["item"]] += uint64(metric.Value)
+				case msDroppedReqsMetric:
+					stats.MSRequestsDropped += uint64(metric.Value)
+				}
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+// diff returns the change in the cache statistics since the earlier sample.
+func (s *benchCacheStats) diff(before *benchCacheStats) *benchCacheStats {
+	d := &benchCacheStats{
+		Refreshes:         make(map[string]uint64),
+		RefreshErrors:     make(map[string]uint64),
+		MSRequestsDropped: s.MSRequestsDropped - before.MSRequestsDropped,
+	}
+	for item, count := range s.Refreshes {
+		d.Refreshes[item] = count - before.Refreshes[item]
+	}
+	for item, count := range s.RefreshErrors {
+		d.RefreshErrors[item] = count - before.RefreshErrors[item]
+	}
+	return d
+}
+
+// run simulates the client load for the given duration and reports the
+// results.
+func (r *benchRunner) run(parent context.Context, duration time.Duration) (*benchReport, error) {
+	if r.clients == 0 {
+		return nil, errors.New("at least one client is required")
+	}
+	if r.rate < 0 {
+		return nil, errors.New("rate may not be negative")
+	}
+	if duration <= 0 {
+		return nil, errors.New("duration must be positive")
+	}
+
+	report := &benchReport{
+		Clients:  r.clients,
+		Duration: duration,
+	}
+
+	cacheBefore, err := r.getCacheStats(parent)
+	if err != nil {
+		r.log.Debugf("unable to read agent telemetry: %s", err)
+		report.CacheNote = "cache statistics unavailable: unable to read agent telemetry"
+	} else if r.queryMetrics == nil {
+		report.CacheNote = "cache statistics unavailable: agent telemetry_port is not set"
+	}
+
+	ctx, cancel := context.WithTimeout(parent, duration)
+	defer cancel()
+
+	results := make([][]*benchSample, r.clients)
+	var wg sync.WaitGroup
+	for i := uint(0); i < r.clients; i++ {
+		wg.Add(1)
+		go func(idx uint) {
+			defer wg.Done()
+			results[idx] = r.runClient(ctx, idx)
+		}(i)
+	}
+	wg.Wait()
+
+	var samples []*benchSample
+	for _, clientSamples := range results {
+		samples = append(samples, clientSamples...)
+	}
+	sort.SliceStable(samples, func(i, j int) bool {
+		return samples[i].start.Before(samples[j].start)
+	})
+
+	statsMap := make(map[string]*benchRequestStats)
+	for _, request := range r.requests {
+		stats := &benchRequestStats{Request: request}
+		statsMap[request] = stats
+		report.Requests = append(report.Requests, stats)
+	}
+	for _, sample := range samples {
+		stats := statsMap[sample.request]
+		if stats.Count == 0 {
+			stats.First = sample.latency
+		}
+		stats.add(sample)
+	}
+	for _, stats := range report.Requests {
+		stats.summarize()
+	}
+
+	if cacheBefore != nil {
+		cacheAfter, err := r.getCacheStats(parent)
+		if err != nil {
+			r.log.Debugf("unable to read agent telemetry: %s", err)
+			report.CacheNote = "cache statistics unavailable: unable to read agent telemetry"
+		} else {
+			report.Cache = cacheAfter.diff(cacheBefore)
+		}
+	}
+
+	return report, nil
+}
+
+func formatBenchLatency(d time.Duration) string {
+	return d.Round(time.Microsecond).String()
+}
+
+// printBenchReport writes a human-readable representation of the benchmark
+// results.
+func printBenchReport(out io.Writer, report *benchReport) {
+	fmt.Fprintf(out, "Simulated %d clients for %s\n\n", report.Clients, report.Duration)
+
+	reqTitle := "Request"
+	countTitle := "Count"
+	rateTitle := "Rate (req/s)"
+	errTitle := "Errors"
+	firstTitle := "First"
+	p50Title := "p50"
+	p90Title := "p90"
+	p99Title := "p99"
+	maxTitle := "Max"
+	formatter := txtfmt.NewTableFormatter(reqTitle, countTitle, rateTitle, errTitle, firstTitle,
+		p50Title, p90Title, p99Title, maxTitle)
+
+	var table []txtfmt.TableRow
+	for _, s := range report.Requests {
+		table = append(table, txtfmt.TableRow{
+			reqTitle:   s.Request,
+			countTitle: fmt.Sprintf("%d", s.Count),
+			rateTitle:  fmt.Sprintf("%.1f", float64(s.Count)/report.Duration.Seconds()),
+			errTitle:   fmt.Sprintf("%d", s.Errors),
+			firstTitle: formatBenchLatency(s.First),
+			p50Title:   formatBenchLatency(s.P50),
+			p90Title:   formatBenchLatency(s.P90),
+			p99Title:   formatBenchLatency(s.P99),
+			maxTitle:   formatBenchLatency(s.Max),
+		})
+	}
+	fmt.Fprintln(out, formatter.Format(table))
+
+	if report.Cache == nil {
+		if report.CacheNote != "" {
+			fmt.Fprintln(out, report.CacheNote)
+		}
+		return
+	}
+
+	fmt.Fprintln(out, "Agent cache activity during the run:")
+	for _, item := range []string{attachInfoMetricItem, fabricMetricItem} {
+		fmt.Fprintf(out, "  %s refreshes: %d (%d failed)\n", strings.ReplaceAll(item, "_", " "),
+			report.Cache.Refreshes[item], report.Cache.RefreshErrors[item])
+	}
+	fmt.Fprintf(out, "  MS requests dropped by rate limiter: %d\n", report.Cache.MSRequestsDropped)
+}
+
+// benchCmd simulates the load of many concurrent client processes on the
+// running agent, to help size the cache and MS rate limit settings.
+type benchCmd struct {
+	configCmd
+	cmdutil.LogCmd
+	cmdutil.JSONOutputCmd
+	Clients  uint            `long:"clients" short:"c" default:"16" description:"Number of concurrent simulated client processes"`
+	Rate     float64         `long:"rate" short:"r" default:"10" description:"Requests per second sent by each simulated client (0 for no limit)"`
+	Duration ui.DurationFlag `long:"duration" short:"t" default:"10s" description:"Length of the benchmark"`
+	Requests string          `long:"requests" choice:"attach-info" choice:"fabric-device" choice:"all" default:"all" description:"Type of request sent by the simulated clients"`
+}
+
+func (cmd *benchCmd) Execute(_ []string) error {
+	runner := &benchRunner{
+		log:       cmd.Logger,
+		sys:       cmd.cfg.SystemName,
+		sockPath:  filepath.Join(cmd.cfg.RuntimeDir, agentSockName),
+		clients:   cmd.Clients,
+		rate:      cmd.Rate,
+		requests:  benchRequests(cmd.Requests),
+		newClient: drpc.NewClientConnection,
+	}
+	if cmd.cfg.TelemetryPort > 0 {
+		runner.queryMetrics = func(ctx context.Context) (*control.MetricsQueryResp, error) {
+			return control.MetricsQuery(ctx, &control.MetricsQueryReq{
+				Host: "localhost",
+				Port: uint32(cmd.cfg.TelemetryPort),
+			})
+		}
+	}
+
+	if !cmd.JSONOutputEnabled() {
+		cmd.Infof("simulating %d clients against %s for %s", cmd.Clients, runner.sockPath,
+			cmd.Duration.Duration)
+	}
+
+	report, err := runner.run(cmd.MustLogCtx(), cmd.Duration.Duration)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(report, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	printBenchReport(&out, report)
+	cmd.Info(out.String())
+
+	return nil
+}
@@ -0,0 +1,273 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockBenchClient struct {
+	sync.Mutex
+	connectErr error
+	status     daos.Status
+	allRanks   []bool
+}
+
+func (m *mockBenchClient) IsConnected() bool {
+	return m.connectErr == nil
+}
+
+func (m *mockBenchClient) Connect(_ context.Context) error {
+	return m.connectErr
+}
+
+func (m *mockBenchClient) Close() error {
+	return nil
+}
+
+func (m *mockBenchClient) SendMsg(_ context.Context, call *drpc.Call) (*drpc.Response, error) {
+	req := new(mgmtpb.GetAttachInfoReq)
+	if err := proto.Unmarshal(call.Body, req); err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	m.allRanks = append(m.allRanks, req.AllRanks)
+	m.Unlock()
+
+	body, err := proto.Marshal(&mgmtpb.GetAttachInfoResp{Status: int32(m.status)})
+	if err != nil {
+		return nil, err
+	}
+	return &drpc.Response{Body: body}, nil
+}
+
+func (m *mockBenchClient) GetSocketPath() string {
+	return "mock.sock"
+}
+
+func TestAgent_percentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	for name, tc := range map[string]struct {
+		sorted []time.Duration
+		pct    int
+		exp    time.Duration
+	}{
+		"empty": {
+			pct: 50,
+		},
+		"single": {
+			sorted: []time.Duration{time.Second},
+			pct:    99,
+			exp:    time.Second,
+		},
+		"p50": {
+			sorted: sorted,
+			pct:    50,
+			exp:    50 * time.Millisecond,
+		},
+		"p99": {
+			sorted: sorted,
+			pct:    99,
+			exp:    99 * time.Millisecond,
+		},
+		"p0": {
+			sorted: sorted,
+			pct:    0,
+			exp:    time.Millisecond,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.exp, percentile(tc.sorted, tc.pct), "unexpected percentile")
+		})
+	}
+}
+
+func TestAgent_benchRunner_run(t *testing.T) {
+	mockMetrics := func(refreshes uint64, dropped float64) *control.MetricsQueryResp {
+		return &control.MetricsQueryResp{
+			MetricSets: []*daos.MetricSet{
+				{
+					Name: cacheRefreshMetric,
+					Metrics: []daos.Metric{
+						&daos.HistogramMetric{
+							Labels:      daos.MetricLabelMap{"item": attachInfoMetricItem},
+							SampleCount: refreshes,
+						},
+						&daos.HistogramMetric{
+							Labels:      daos.MetricLabelMap{"item": fabricMetricItem},
+							SampleCount: 1,
+						},
+					},
+				},
+				{
+					Name: msDroppedReqsMetric,
+					Metrics: []daos.Metric{
+						&daos.SimpleMetric{Value: dropped},
+					},
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		clients      uint
+		rate         float64
+		requests     string
+		connectErr   error
+		status       daos.Status
+		metrics      []*control.MetricsQueryResp
+		metricsErr   error
+		expErr       error
+		expRequests  []string
+		expAllErrors bool
+		expCache     *benchCacheStats
+		expCacheNote string
+		expMixed     bool
+	}{
+		"no clients": {
+			requests: benchAll,
+			expErr:   errors.New("at least one client"),
+		},
+		"negative rate": {
+			clients:  1,
+			rate:     -1,
+			requests: benchAll,
+			expErr:   errors.New("may not be negative"),
+		},
+		"connect fails": {
+			clients:      2,
+			requests:     benchAttachInfo,
+			connectErr:   errors.New("mock connect"),
+			expRequests:  []string{benchAttachInfo},
+			expAllErrors: true,
+			expCacheNote: "telemetry_port is not set",
+		},
+		"request status error": {
+			clients:      1,
+			rate:         1000,
+			requests:     benchFabricDevice,
+			status:       daos.Unreachable,
+			expRequests:  []string{benchFabricDevice},
+			expAllErrors: true,
+			expCacheNote: "telemetry_port is not set",
+		},
+		"all requests": {
+			clients:     4,
+			rate:        1000,
+			requests:    benchAll,
+			expRequests: []string{benchAttachInfo, benchFabricDevice},
+			expMixed:    true,
+			metrics:     []*control.MetricsQueryResp{mockMetrics(1, 0), mockMetrics(3, 2)},
+			expCache: &benchCacheStats{
+				Refreshes: map[string]uint64{
+					attachInfoMetricItem: 2,
+					fabricMetricItem:     0,
+				},
+				RefreshErrors:     map[string]uint64{},
+				MSRequestsDropped: 2,
+			},
+		},
+		"telemetry unavailable": {
+			clients:      1,
+			rate:         1000,
+			requests:     benchAttachInfo,
+			metricsErr:   errors.New("mock metrics"),
+			expRequests:  []string{benchAttachInfo},
+			expCacheNote: "unable to read agent telemetry",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := &mockBenchClient{
+				connectErr: tc.connectErr,
+				status:     tc.status,
+			}
+			runner := &benchRunner{
+				log:      log,
+				sys:      "daos_server",
+				clients:  tc.clients,
+				rate:     tc.rate,
+				requests: benchRequests(tc.requests),
+				newClient: func(_ string) drpc.DomainSocketClient {
+					return client
+				},
+			}
+			if tc.metrics != nil || tc.metricsErr != nil {
+				var queries int
+				runner.queryMetrics = func(_ context.Context) (*control.MetricsQueryResp, error) {
+					if tc.metricsErr != nil {
+						return nil, tc.metricsErr
+					}
+					queries++
+					return tc.metrics[queries-1], nil
+				}
+			}
+
+			report, err := runner.run(test.Context(t), 50*time.Millisecond)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotRequests []string
+			for _, stats := range report.Requests {
+				gotRequests = append(gotRequests, stats.Request)
+				if stats.Count == 0 {
+					t.Fatalf("no %s requests recorded", stats.Request)
+				}
+				if tc.expAllErrors {
+					test.AssertEqual(t, stats.Count, stats.Errors, "expected all requests to fail")
+				} else {
+					test.AssertEqual(t, 0, stats.Errors, "unexpected errors")
+				}
+				test.AssertTrue(t, stats.P50 <= stats.P90 && stats.P90 <= stats.P99 &&
+					stats.P99 <= stats.Max, "percentiles out of order")
+			}
+			if diff := cmp.Diff(tc.expRequests, gotRequests); diff != "" {
+				t.Fatalf("unexpected requests (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expMixed {
+				var allRanks, noRanks bool
+				for _, ar := range client.allRanks {
+					allRanks = allRanks || ar
+					noRanks = noRanks || !ar
+				}
+				test.AssertTrue(t, allRanks && noRanks, "expected requests with and without all ranks")
+			}
+
+			if diff := cmp.Diff(tc.expCache, report.Cache); diff != "" {
+				t.Fatalf("unexpected cache stats (-want, +got):\n%s\n", diff)
+			}
+			if !strings.Contains(report.CacheNote, tc.expCacheNote) {
+				t.Fatalf("expected cache note to contain %q, got %q", tc.expCacheNote, report.CacheNote)
+			}
+		})
+	}
+}
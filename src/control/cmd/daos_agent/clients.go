@@ -0,0 +1,180 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+type (
+	// clientPoolHandles is the number of handles a client has open to a pool.
+	clientPoolHandles struct {
+		PoolUUID string `json:"pool_uuid"`
+		Handles  uint32 `json:"handles"`
+	}
+
+	// clientProcess describes a local client process known to the agent.
+	clientProcess struct {
+		PID       int32                `json:"pid"`
+		Name      string               `json:"name"`
+		UID       uint32               `json:"uid"`
+		User      string               `json:"user"`
+		Pools     []*clientPoolHandles `json:"pools"`
+		Interface string               `json:"interface"`
+		Domain    string               `json:"domain"`
+		Provider  string               `json:"provider"`
+		NUMANode  uint32               `json:"numa_node"`
+	}
+)
+
+// queryClients requests the local client processes from the running agent.
+func queryClients(ctx context.Context, conn drpc.DomainSocketClient) ([]*clientProcess, error) {
+	if err := conn.Connect(ctx); err != nil {
+		return nil, errors.Wrap(err, "connecting to agent")
+	}
+	defer conn.Close()
+
+	body, err := proto.Marshal(new(mgmtpb.ListClientsReq))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := conn.SendMsg(ctx, &drpc.Call{
+		Module: drpc.MethodListClients.Module().ID(),
+		Method: drpc.MethodListClients.ID(),
+		Body:   body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status != drpc.Status_SUCCESS {
+		return nil, errors.Errorf("dRPC status %s", resp.Status)
+	}
+
+	pbResp := new(mgmtpb.ListClientsResp)
+	if err := proto.Unmarshal(resp.Body, pbResp); err != nil {
+		return nil, err
+	}
+	if pbResp.Status != 0 {
+		return nil, daos.Status(pbResp.Status)
+	}
+
+	clients := make([]*clientProcess, 0, len(pbResp.Clients))
+	for _, pbClient := range pbResp.Clients {
+		client := &clientProcess{
+			PID:       pbClient.Pid,
+			Name:      pbClient.Name,
+			UID:       pbClient.Uid,
+			User:      pbClient.User,
+			Pools:     []*clientPoolHandles{},
+			Interface: pbClient.Interface,
+			Domain:    pbClient.Domain,
+			Provider:  pbClient.Provider,
+			NUMANode:  pbClient.NumaNode,
+		}
+		for _, pool := range pbClient.Pools {
+			client.Pools = append(client.Pools, &clientPoolHandles{
+				PoolUUID: pool.PoolUuid,
+				Handles:  pool.Handles,
+			})
+		}
+		clients = append(clients, client)
+	}
+
+	return clients, nil
+}
+
+// printClients writes a human-readable table of the local client processes.
+func printClients(out io.Writer, clients []*clientProcess) {
+	if len(clients) == 0 {
+		fmt.Fprintln(out, "No client processes known to the agent")
+		return
+	}
+
+	pidTitle := "PID"
+	nameTitle := "Name"
+	userTitle := "User"
+	ifaceTitle := "Interface"
+	domainTitle := "Domain"
+	numaTitle := "NUMA"
+	poolsTitle := "Pool Handles"
+	formatter := txtfmt.NewTableFormatter(pidTitle, nameTitle, userTitle, ifaceTitle, domainTitle,
+		numaTitle, poolsTitle)
+
+	var table []txtfmt.TableRow
+	for _, client := range clients {
+		user := client.User
+		if user == "" {
+			user = fmt.Sprintf("%d", client.UID)
+		}
+
+		iface, domain, numa := "-", "-", "-"
+		if client.Interface != "" {
+			iface = client.Interface
+			domain = client.Domain
+			numa = fmt.Sprintf("%d", client.NUMANode)
+		}
+
+		pools := make([]string, 0, len(client.Pools))
+		for _, pool := range client.Pools {
+			pools = append(pools, fmt.Sprintf("%s (%d)", pool.PoolUUID, pool.Handles))
+		}
+		if len(pools) == 0 {
+			pools = append(pools, "-")
+		}
+
+		table = append(table, txtfmt.TableRow{
+			pidTitle:    fmt.Sprintf("%d", client.PID),
+			nameTitle:   client.Name,
+			userTitle:   user,
+			ifaceTitle:  iface,
+			domainTitle: domain,
+			numaTitle:   numa,
+			poolsTitle:  strings.Join(pools, ", "),
+		})
+	}
+
+	fmt.Fprint(out, formatter.Format(table))
+}
+
+// listClientsCmd lists the local client processes known to the running agent.
+type listClientsCmd struct {
+	configCmd
+	cmdutil.LogCmd
+	cmdutil.JSONOutputCmd
+}
+
+func (cmd *listClientsCmd) Execute(_ []string) error {
+	conn := drpc.NewClientConnection(filepath.Join(cmd.cfg.RuntimeDir, agentSockName))
+
+	clients, err := queryClients(cmd.MustLogCtx(), conn)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(clients, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	printClients(&out, clients)
+	cmd.Info(out.String())
+
+	return nil
+}
@@ -0,0 +1,301 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"math"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+type mockListClientsConn struct {
+	sync.Mutex
+	connectErr error
+	resp       *mgmtpb.ListClientsResp
+}
+
+func (m *mockListClientsConn) IsConnected() bool {
+	return m.connectErr == nil
+}
+
+func (m *mockListClientsConn) Connect(_ context.Context) error {
+	return m.connectErr
+}
+
+func (m *mockListClientsConn) Close() error {
+	return nil
+}
+
+func (m *mockListClientsConn) GetSocketPath() string {
+	return ""
+}
+
+func (m *mockListClientsConn) SendMsg(_ context.Context, call *drpc.Call) (*drpc.Response, error) {
+	if call.Method != drpc.MethodListClients.ID() {
+		return nil, errors.Errorf("unexpected method %d", call.Method)
+	}
+
+	body, err := proto.Marshal(m.resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &drpc.Response{
+		Status: drpc.Status_SUCCESS,
+		Body:   body,
+	}, nil
+}
+
+func TestAgent_procMon_ListClients(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx, cancel := context.WithCancel(test.Context(t))
+	defer cancel()
+
+	pm := NewProcMon(log, nil, "daos_server")
+	go pm.handleRequests(ctx)
+
+	pid := int32(os.Getpid())
+	pm.RecordAttach(ctx, pid, &clientAttach{
+		name:     "test",
+		numaNode: 1,
+		iface:    "ib0",
+		domain:   "mlx5_0",
+		provider: "ofi+verbs",
+	})
+	// Not a valid PID, so the client should be pruned.
+	pm.RecordAttach(ctx, math.MaxInt32, &clientAttach{name: "gone"})
+	pm.AddPoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{
+		PoolUUID:       test.MockUUID(1),
+		PoolHandleUUID: test.MockUUID(2),
+	})
+	pm.AddPoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{
+		PoolUUID:       test.MockUUID(1),
+		PoolHandleUUID: test.MockUUID(3),
+	})
+
+	expClients := []*clientInfo{
+		{
+			clientAttach: clientAttach{
+				name:     "test",
+				numaNode: 1,
+				iface:    "ib0",
+				domain:   "mlx5_0",
+				provider: "ofi+verbs",
+			},
+			pid:   pid,
+			uid:   uint32(os.Getuid()),
+			pools: map[string]int{test.MockUUID(1): 2},
+		},
+	}
+	cmpOpts := cmp.AllowUnexported(clientInfo{}, clientAttach{})
+	if diff := cmp.Diff(expClients, pm.ListClients(ctx), cmpOpts); diff != "" {
+		t.Fatalf("unexpected clients (-want, +got):\n%s\n", diff)
+	}
+
+	for _, handle := range []int32{2, 3} {
+		pm.RemovePoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{
+			PoolUUID:       test.MockUUID(1),
+			PoolHandleUUID: test.MockUUID(handle),
+		})
+	}
+	pm.NotifyExit(ctx, pid)
+	if diff := cmp.Diff([]*clientInfo{}, pm.ListClients(ctx), cmpOpts); diff != "" {
+		t.Fatalf("unexpected clients after exit (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestAgent_mgmtModule_handleListClients(t *testing.T) {
+	for name, tc := range map[string]struct {
+		uid       uint32
+		expStatus daos.Status
+	}{
+		"agent user": {
+			uid: uint32(os.Getuid()),
+		},
+		"other user": {
+			uid:       uint32(os.Getuid()) + 1,
+			expStatus: daos.NoPermission,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			defer cancel()
+
+			pm := NewProcMon(log, nil, "daos_server")
+			go pm.handleRequests(ctx)
+			pm.RecordAttach(ctx, int32(os.Getpid()), &clientAttach{name: "test", iface: "ib0"})
+
+			mod := &mgmtModule{
+				log:     log,
+				monitor: pm,
+			}
+			cred := security.InitDomainInfo(&security.Ucred{Uid: tc.uid}, "")
+
+			respBytes, err := mod.handleListClients(ctx, cred)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp := new(mgmtpb.ListClientsResp)
+			if err := proto.Unmarshal(respBytes, resp); err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, int32(tc.expStatus), resp.Status, "unexpected status")
+			if tc.expStatus != 0 {
+				test.AssertEqual(t, 0, len(resp.Clients), "expected no clients")
+				return
+			}
+			test.AssertEqual(t, 1, len(resp.Clients), "unexpected client count")
+			test.AssertEqual(t, int32(os.Getpid()), resp.Clients[0].Pid, "unexpected pid")
+			test.AssertEqual(t, "ib0", resp.Clients[0].Interface, "unexpected interface")
+		})
+	}
+}
+
+func TestAgent_queryClients(t *testing.T) {
+	for name, tc := range map[string]struct {
+		conn       *mockListClientsConn
+		expClients []*clientProcess
+		expErr     error
+	}{
+		"connect fails": {
+			conn: &mockListClientsConn{
+				connectErr: errors.New("no agent"),
+			},
+			expErr: errors.New("connecting to agent"),
+		},
+		"not permitted": {
+			conn: &mockListClientsConn{
+				resp: &mgmtpb.ListClientsResp{Status: int32(daos.NoPermission)},
+			},
+			expErr: daos.NoPermission,
+		},
+		"no clients": {
+			conn: &mockListClientsConn{
+				resp: &mgmtpb.ListClientsResp{},
+			},
+			expClients: []*clientProcess{},
+		},
+		"clients": {
+			conn: &mockListClientsConn{
+				resp: &mgmtpb.ListClientsResp{
+					Clients: []*mgmtpb.ClientProcess{
+						{
+							Pid:  100,
+							Name: "ior",
+							Uid:  1000,
+							User: "jdoe",
+							Pools: []*mgmtpb.ClientPoolHandles{
+								{PoolUuid: test.MockUUID(1), Handles: 2},
+							},
+							Interface: "ib0",
+							Domain:    "mlx5_0",
+							Provider:  "ofi+verbs",
+							NumaNode:  1,
+						},
+					},
+				},
+			},
+			expClients: []*clientProcess{
+				{
+					PID:  100,
+					Name: "ior",
+					UID:  1000,
+					User: "jdoe",
+					Pools: []*clientPoolHandles{
+						{PoolUUID: test.MockUUID(1), Handles: 2},
+					},
+					Interface: "ib0",
+					Domain:    "mlx5_0",
+					Provider:  "ofi+verbs",
+					NUMANode:  1,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			clients, err := queryClients(test.Context(t), tc.conn)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expClients, clients); diff != "" {
+				t.Fatalf("unexpected clients (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_printClients(t *testing.T) {
+	for name, tc := range map[string]struct {
+		clients []*clientProcess
+		expOut  string
+	}{
+		"no clients": {
+			expOut: `
+No client processes known to the agent
+`,
+		},
+		"clients": {
+			clients: []*clientProcess{
+				{
+					PID:  100,
+					Name: "ior",
+					UID:  1000,
+					User: "jdoe",
+					Pools: []*clientPoolHandles{
+						{PoolUUID: test.MockUUID(1), Handles: 2},
+						{PoolUUID: test.MockUUID(2), Handles: 1},
+					},
+					Interface: "ib0",
+					Domain:    "mlx5_0",
+					NUMANode:  1,
+				},
+				{
+					PID:  200,
+					Name: "dfuse",
+					UID:  1001,
+				},
+			},
+			expOut: `
+PID Name  User Interface Domain NUMA Pool Handles                                                                       
+--- ----  ---- --------- ------ ---- ------------                                                                       
+100 ior   jdoe ib0       mlx5_0 1    00000001-0001-0001-0001-000000000001 (2), 00000002-0002-0002-0002-000000000002 (1) 
+200 dfuse 1001 -         -      -    -                                                                                  
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			printClients(&out, tc.clients)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
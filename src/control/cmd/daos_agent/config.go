@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,7 +9,6 @@ package main
 
 import (
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/pkg/errors"
@@ -36,61 +36,213 @@ func (rm *refreshMinutes) UnmarshalYAML(unmarshal func(interface{}) error) error
 	return nil
 }
 
+// MarshalYAML converts the duration back into the number of minutes, as set in
+// the config file.
+func (rm refreshMinutes) MarshalYAML() (interface{}, error) {
+	return uint(rm.Duration() / time.Minute), nil
+}
+
 func (rm refreshMinutes) Duration() time.Duration {
 	return time.Duration(rm)
 }
 
 // Config defines the agent configuration.
 type Config struct {
-	SystemName          string                     `yaml:"name"`
-	AccessPoints        []string                   `yaml:"access_points"`
-	ControlPort         int                        `yaml:"port"`
-	RuntimeDir          string                     `yaml:"runtime_dir"`
-	LogFile             string                     `yaml:"log_file"`
-	LogLevel            common.ControlLogLevel     `yaml:"control_log_mask,omitempty"`
-	CredentialConfig    *security.CredentialConfig `yaml:"credential_config"`
-	TransportConfig     *security.TransportConfig  `yaml:"transport_config"`
-	DisableCache        bool                       `yaml:"disable_caching,omitempty"`
-	CacheExpiration     refreshMinutes             `yaml:"cache_expiration,omitempty"`
-	DisableAutoEvict    bool                       `yaml:"disable_auto_evict,omitempty"`
-	EvictOnStart        bool                       `yaml:"enable_evict_on_start,omitempty"`
-	ExcludeFabricIfaces common.StringSet           `yaml:"exclude_fabric_ifaces,omitempty"`
-	IncludeFabricIfaces common.StringSet           `yaml:"include_fabric_ifaces,omitempty"`
-	FabricInterfaces    []*NUMAFabricConfig        `yaml:"fabric_ifaces,omitempty"`
-	ProviderIdx         uint                       // TODO SRS-31: Enable with multiprovider functionality
-	TelemetryPort       int                        `yaml:"telemetry_port,omitempty"`
-	TelemetryEnabled    bool                       `yaml:"telemetry_enabled,omitempty"`
-	TelemetryRetain     time.Duration              `yaml:"telemetry_retain,omitempty"`
+	SystemName          string                            `yaml:"name"`
+	AccessPoints        []string                          `yaml:"access_points"`
+	AccessPointsRefresh time.Duration                     `yaml:"access_points_refresh_interval,omitempty"`
+	ControlPort         int                               `yaml:"port"`
+	RuntimeDir          string                            `yaml:"runtime_dir"`
+	LogFile             string                            `yaml:"log_file"`
+	LogLevel            common.ControlLogLevel            `yaml:"control_log_mask,omitempty"`
+	LogModules          map[string]common.ControlLogLevel `yaml:"control_log_modules,omitempty"`
+	CredentialConfig    *security.CredentialConfig        `yaml:"credential_config"`
+	TransportConfig     *security.TransportConfig         `yaml:"transport_config"`
+	DisableCache        bool                              `yaml:"disable_caching,omitempty"`
+	CacheExpiration     refreshMinutes                    `yaml:"cache_expiration,omitempty"`
+	DisableAutoEvict    bool                              `yaml:"disable_auto_evict,omitempty"`
+	EvictOnStart        bool                              `yaml:"enable_evict_on_start,omitempty"`
+	EvictOnShutdown     bool                              `yaml:"enable_evict_on_shutdown,omitempty"`
+	EnableCPUHints      bool                              `yaml:"enable_cpu_hints,omitempty"`
+	ExcludeFabricIfaces common.StringSet                  `yaml:"exclude_fabric_ifaces,omitempty"`
+	IncludeFabricIfaces common.StringSet                  `yaml:"include_fabric_ifaces,omitempty"`
+	FabricInterfaces    []*NUMAFabricConfig               `yaml:"fabric_ifaces,omitempty"`
+	FabricFallback      FabricFallbackPolicy              `yaml:"fabric_fallback,omitempty"`
+	FabricCheckInterval time.Duration                     `yaml:"fabric_check_interval,omitempty"`
+	FabricGPUAffinity   bool                              `yaml:"fabric_gpu_affinity,omitempty"`
+	UsePrivilegedHelper bool                              `yaml:"use_privileged_helper,omitempty"`
+	PoolChangeInterval  time.Duration                     `yaml:"pool_change_interval,omitempty"`
+	AdvisePoolReconnect bool                              `yaml:"advise_pool_reconnect,omitempty"`
+	SysChangeInterval   time.Duration                     `yaml:"system_change_interval,omitempty"`
+	HeartbeatInterval   time.Duration                     `yaml:"heartbeat_interval,omitempty"`
+	ProviderPriority    []string                          `yaml:"provider_priority,omitempty"`
+	ProviderIdx         uint                              // TODO SRS-31: Enable with multiprovider functionality
+	TelemetryPort       int                               `yaml:"telemetry_port,omitempty"`
+	TelemetryEnabled    bool                              `yaml:"telemetry_enabled,omitempty"`
+	TelemetryRetain     time.Duration                     `yaml:"telemetry_retain,omitempty"`
+	TelemetryOTLPTraces common.OTLPConfig                 `yaml:"telemetry_otlp_traces,omitempty"`
+	TelemetryPush       *TelemetryPushConfig              `yaml:"telemetry_push,omitempty"`
+	MSRateLimit         float64                           `yaml:"ms_rate_limit,omitempty"`
+	MSRateBurst         int                               `yaml:"ms_rate_burst,omitempty"`
+	MSMaxConcurrent     int                               `yaml:"ms_max_concurrent,omitempty"`
+	MSQueueTimeout      time.Duration                     `yaml:"ms_queue_timeout,omitempty"`
+	ControlMaxMsgSize   string                            `yaml:"control_max_msg_size,omitempty"`
+	AccessControl       *AccessControlConfig              `yaml:"access_control,omitempty"`
+	Systems             []*SystemConfig                   `yaml:"systems,omitempty"`
+	Standalone          bool                              `yaml:"standalone,omitempty"`
+	AttachInfoFile      string                            `yaml:"standalone_attach_info,omitempty"`
 }
 
 // Validate performs basic validation of the configuration.
 func (c *Config) Validate() error {
+	if errs := c.validationErrors(); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// validationErrors returns all of the problems found with the configuration,
+// rather than just the first.
+func (c *Config) validationErrors() (errs []error) {
 	if c == nil {
-		return errors.New("config is nil")
+		return []error{errors.New("config is nil")}
 	}
 
 	if !daos.SystemNameIsValid(c.SystemName) {
-		return fmt.Errorf("invalid system name: %s", c.SystemName)
+		errs = append(errs, fmt.Errorf("invalid system name: %s", c.SystemName))
+	}
+
+	if c.TelemetryRetain > 0 && !c.TelemetryExportEnabled() {
+		errs = append(errs, errors.New("telemetry_retain requires telemetry_port or telemetry_push"))
 	}
 
-	if c.TelemetryRetain > 0 && c.TelemetryPort == 0 {
-		return errors.New("telemetry_retain requires telemetry_port")
+	if c.TelemetryEnabled && !c.TelemetryExportEnabled() {
+		errs = append(errs, errors.New("telemetry_enabled requires telemetry_port or telemetry_push"))
 	}
 
-	if c.TelemetryEnabled && c.TelemetryPort == 0 {
-		return errors.New("telemetry_enabled requires telemetry_port")
+	if err := c.TelemetryPush.Validate(); err != nil {
+		errs = append(errs, errors.Wrap(err, "telemetry_push"))
+	}
+
+	if err := c.TelemetryOTLPTraces.Validate(); err != nil {
+		errs = append(errs, errors.Wrap(err, "telemetry_otlp_traces"))
 	}
 
 	if len(c.ExcludeFabricIfaces) > 0 && len(c.IncludeFabricIfaces) > 0 {
-		return errors.New("cannot specify both exclude_fabric_ifaces and include_fabric_ifaces")
+		errs = append(errs, errors.New("cannot specify both exclude_fabric_ifaces and include_fabric_ifaces"))
 	}
 
-	return nil
+	if c.MSRateLimit < 0 || c.MSRateBurst < 0 || c.MSMaxConcurrent < 0 || c.MSQueueTimeout < 0 {
+		errs = append(errs, errors.New("ms_rate_limit, ms_rate_burst, ms_max_concurrent and ms_queue_timeout may not be negative"))
+	}
+
+	if _, err := common.ParseControlMaxMsgSize(c.ControlMaxMsgSize); err != nil {
+		errs = append(errs, errors.Wrap(err, "control_max_msg_size"))
+	}
+
+	if c.AccessPointsRefresh < 0 {
+		errs = append(errs, errors.New("access_points_refresh_interval may not be negative"))
+	}
+
+	if c.FabricCheckInterval < 0 {
+		errs = append(errs, errors.New("fabric_check_interval may not be negative"))
+	}
+
+	if c.PoolChangeInterval < 0 {
+		errs = append(errs, errors.New("pool_change_interval may not be negative"))
+	}
+
+	if c.AdvisePoolReconnect && c.PoolChangeInterval == 0 {
+		errs = append(errs, errors.New("advise_pool_reconnect requires pool_change_interval"))
+	}
+
+	if c.SysChangeInterval < 0 {
+		errs = append(errs, errors.New("system_change_interval may not be negative"))
+	}
+
+	if c.HeartbeatInterval < 0 {
+		errs = append(errs, errors.New("heartbeat_interval may not be negative"))
+	} else if c.HeartbeatInterval > 0 && c.HeartbeatInterval < time.Second {
+		errs = append(errs, errors.New("heartbeat_interval may not be less than 1s"))
+	}
+
+	if c.MSRateBurst > 0 && c.MSRateLimit == 0 {
+		errs = append(errs, errors.New("ms_rate_burst requires ms_rate_limit"))
+	}
+
+	if err := c.AccessControl.Validate(); err != nil {
+		errs = append(errs, errors.Wrap(err, "access_control"))
+	}
+
+	errs = append(errs, c.systemsErrors()...)
+
+	if c.AttachInfoFile != "" && !c.Standalone {
+		errs = append(errs, errors.New("standalone_attach_info requires standalone"))
+	}
+	if c.Standalone && len(c.Systems) > 0 {
+		errs = append(errs, errors.New("systems may not be used in standalone mode"))
+	}
+
+	seen := common.NewStringSet()
+	for _, prov := range c.ProviderPriority {
+		if prov == "" {
+			errs = append(errs, errors.New("provider_priority may not contain empty provider names"))
+			continue
+		}
+		if seen.Has(prov) {
+			errs = append(errs, fmt.Errorf("duplicate provider %q in provider_priority", prov))
+		}
+		seen.Add(prov)
+	}
+
+	return
 }
 
-// TelemetryExportEnabled returns true if client telemetry export is enabled.
+// systemsErrors returns the problems found with the additional systems.
+func (c *Config) systemsErrors() (errs []error) {
+	names := common.NewStringSet(c.SystemName)
+	for _, sys := range c.Systems {
+		if sys == nil {
+			continue
+		}
+		if !daos.SystemNameIsValid(sys.Name) {
+			errs = append(errs, fmt.Errorf("systems: invalid system name: %s", sys.Name))
+			continue
+		}
+		if names.Has(sys.Name) {
+			errs = append(errs, fmt.Errorf("systems: duplicate system name %q", sys.Name))
+			continue
+		}
+		names.Add(sys.Name)
+
+		if len(sys.AccessPoints) == 0 {
+			errs = append(errs, fmt.Errorf("systems: no access_points for system %s", sys.Name))
+		}
+		if err := sys.AccessControl.Validate(); err != nil {
+			errs = append(errs, errors.Wrapf(err, "systems: access_control for system %s", sys.Name))
+		}
+	}
+
+	return
+}
+
+// TelemetryExportEnabled returns true if client telemetry export is enabled,
+// either for scraping or by pushing to a Pushgateway.
 func (c *Config) TelemetryExportEnabled() bool {
-	return c.TelemetryPort > 0
+	return c.TelemetryPort > 0 || c.TelemetryPush.Enabled()
+}
+
+// SystemConfig defines an additional DAOS system that clients of the agent may
+// attach to, with its own access points and certificates. The port, transport
+// and access control configuration of the agent's own system are used if not
+// set.
+type SystemConfig struct {
+	Name            string                    `yaml:"name"`
+	AccessPoints    []string                  `yaml:"access_points"`
+	ControlPort     int                       `yaml:"port,omitempty"`
+	TransportConfig *security.TransportConfig `yaml:"transport_config,omitempty"`
+	AccessControl   *AccessControlConfig      `yaml:"access_control,omitempty"`
 }
 
 // NUMAFabricConfig defines a list of fabric interfaces that belong to a NUMA
@@ -106,26 +258,35 @@ type FabricInterfaceConfig struct {
 	Domain    string `yaml:"domain"`
 }
 
-// LoadConfig reads a config file and uses it to populate a Config.
+// LoadConfig reads a config file and uses it to populate a Config. Environment
+// variable references are expanded and included files merged, see
+// common.ReadConfigFile.
 func LoadConfig(cfgPath string) (*Config, error) {
+	cfg, _, err := loadConfig(cfgPath)
+	return cfg, err
+}
+
+// loadConfig loads the config and also returns the paths of the files that it
+// was merged from.
+func loadConfig(cfgPath string) (*Config, []string, error) {
 	if cfgPath == "" {
-		return nil, errors.New("no config path supplied")
+		return nil, nil, errors.New("no config path supplied")
 	}
-	data, err := os.ReadFile(cfgPath)
+	data, sources, err := common.ReadConfigFile(cfgPath)
 	if err != nil {
-		return nil, errors.Wrap(err, "reading config file")
+		return nil, nil, errors.Wrap(err, "reading config file")
 	}
 
 	cfg := DefaultConfig()
 	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
-		return nil, errors.Wrapf(err, "parsing config: %s", cfgPath)
+		return nil, nil, errors.Wrapf(err, "parsing config: %s", cfgPath)
 	}
 
 	if err := cfg.Validate(); err != nil {
-		return nil, errors.Wrap(err, "agent config validation failed")
+		return nil, nil, errors.Wrap(err, "agent config validation failed")
 	}
 
-	return cfg, nil
+	return cfg, sources, nil
 }
 
 // DefaultConfig creates a basic default configuration.
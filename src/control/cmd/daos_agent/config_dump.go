@@ -0,0 +1,43 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+)
+
+// configDumpCmd prints the agent configuration as it is loaded: with the
+// included files merged, environment variables expanded and defaults applied.
+type configDumpCmd struct {
+	cmdutil.LogCmd
+	configCmd
+}
+
+// Execute is run when configDumpCmd activates.
+func (cmd *configDumpCmd) Execute(_ []string) error {
+	if cmd.cfgPath == "" {
+		return errors.New("no config file found")
+	}
+
+	cfg, sources, err := loadConfig(cmd.cfgPath)
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := common.WriteEffectiveConfig(&bld, cfg, sources); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
@@ -0,0 +1,94 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestAgent_dumpEffectiveConfig(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	commonCfg := writeFile("common.yml", `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+control_log_mask: debug
+cache_expiration: 30
+ms_queue_timeout: 5s
+telemetry_port: 9191
+telemetry_retain: 10m
+access_control:
+  allow_gids: [500]
+credential_config:
+  cache_expiration: 10m
+transport_config:
+  allow_insecure: true
+`)
+	mainCfg := writeFile("daos_agent.yml", `
+include: common.yml
+port: 4243
+runtime_dir: ${DAOS_TEST_AGENT_RUNTIME_DIR:-/tmp/runtime}
+log_file: /tmp/agent.log
+exclude_fabric_ifaces: ["ib3"]
+`)
+
+	cfg, sources, err := loadConfig(mainCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{commonCfg, mainCfg}, sources); diff != "" {
+		t.Fatalf("unexpected sources (-want, +got):\n%s", diff)
+	}
+	test.AssertEqual(t, "shire", cfg.SystemName, "name not included")
+	test.AssertEqual(t, 4243, cfg.ControlPort, "port not overridden")
+	test.AssertEqual(t, "/tmp/runtime", cfg.RuntimeDir, "default not applied")
+	test.AssertEqual(t, 30*time.Minute, cfg.CacheExpiration.Duration(), "cache_expiration not included")
+
+	var bld strings.Builder
+	if err := common.WriteEffectiveConfig(&bld, cfg, sources); err != nil {
+		t.Fatal(err)
+	}
+	out := bld.String()
+
+	expHeader := "# Effective configuration merged from:\n#   " + commonCfg + "\n#   " + mainCfg + "\n"
+	if !strings.HasPrefix(out, expHeader) {
+		t.Fatalf("expected output to start with %q, got:\n%s", expHeader, out)
+	}
+	if strings.Contains(out, "include:") {
+		t.Fatalf("unexpected include in output:\n%s", out)
+	}
+
+	// The dumped config must load to the same configuration.
+	dumped, err := LoadConfig(writeFile("dumped.yml", out))
+	if err != nil {
+		t.Fatalf("dumped config failed to load: %s\n%s", err, out)
+	}
+	if diff := cmp.Diff(cfg, dumped, cmpopts.IgnoreUnexported(security.CertificateConfig{})); diff != "" {
+		t.Fatalf("dumped config differs (-want, +got):\n%s", diff)
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -43,9 +44,30 @@ port: 4242
 runtime_dir: /tmp/runtime
 log_file: /home/frodo/logfile
 control_log_mask: debug
+control_log_modules:
+  agent.cache: trace
 disable_caching: true
 cache_expiration: 30
 disable_auto_evict: true
+enable_evict_on_shutdown: true
+enable_cpu_hints: true
+ms_rate_limit: 50
+ms_rate_burst: 100
+ms_max_concurrent: 8
+ms_queue_timeout: 5s
+fabric_check_interval: 30s
+pool_change_interval: 1m
+advise_pool_reconnect: true
+system_change_interval: 30s
+heartbeat_interval: 1m
+telemetry_enabled: true
+telemetry_push:
+  url: http://pushgateway:9091
+  job: compute
+  interval: 30s
+access_control:
+  allow_gids: [500]
+  deny_uids: [1001]
 credential_config:
   cache_expiration: 10m
   client_user_map:
@@ -56,6 +78,22 @@ credential_config:
 transport_config:
   allow_insecure: true
 exclude_fabric_ifaces: ["ib3"]
+fabric_fallback: nearest-numa
+fabric_gpu_affinity: true
+use_privileged_helper: true
+provider_priority: ["ofi+verbs", "ucx+dc", "ofi+tcp"]
+systems:
+-
+  name: mordor
+  access_points: ["three:10001"]
+  transport_config:
+    allow_insecure: true
+-
+  name: rivendell
+  access_points: ["four"]
+  port: 4343
+  access_control:
+    allow_gids: [600]
 fabric_ifaces:
 -
   numa_node: 0
@@ -100,6 +138,182 @@ include_fabric_ifaces: ["ib0"]
 exclude_fabric_ifaces: ["ib3"]
 `)
 
+	badFallbackCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+runtime_dir: /tmp/runtime
+log_file: /home/frodo/logfile
+transport_config:
+  allow_insecure: true
+fabric_fallback: closest
+`)
+
+	badRateBurstCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+ms_rate_burst: 10
+`)
+
+	dupProviderCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+provider_priority: ["ofi+verbs", "ofi+tcp", "ofi+verbs"]
+`)
+
+	dupSystemCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+systems:
+-
+  name: shire
+  access_points: ["three:10001"]
+`)
+
+	noSystemAPsCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+systems:
+-
+  name: mordor
+`)
+
+	attachInfoNoStandaloneCfg := test.CreateTestFile(t, dir, `
+name: shire
+standalone_attach_info: /tmp/attach_info.json
+`)
+
+	standaloneSystemsCfg := test.CreateTestFile(t, dir, `
+name: shire
+standalone: true
+systems:
+-
+  name: mordor
+  access_points: ["three:10001"]
+`)
+
+	negativeRateCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+ms_rate_limit: -1
+`)
+
+	badMaxMsgSizeCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+control_max_msg_size: 1KiB
+`)
+
+	negativeCheckCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+fabric_check_interval: -10s
+`)
+
+	negativeAPRefreshCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["srv:_daos._tcp.example.com"]
+port: 4242
+transport_config:
+  allow_insecure: true
+access_points_refresh_interval: -1m
+`)
+
+	adviseWithoutIntervalCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+advise_pool_reconnect: true
+`)
+
+	negativeSysChangeCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+system_change_interval: -1m
+`)
+
+	shortHeartbeatCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+heartbeat_interval: 500ms
+`)
+
+	badOTLPTracesCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+telemetry_otlp_traces:
+  endpoint: collector:4318
+`)
+
+	badTelemetryPushCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+telemetry_push:
+  url: http://pushgateway:9091
+  interval: 10ms
+`)
+
+	badAccessControlCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+access_control:
+  allow_uids: [1000]
+  deny_uids: [1000]
+`)
+
+	badSystemAccessControlCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+transport_config:
+  allow_insecure: true
+systems:
+-
+  name: mordor
+  access_points: ["three:10001"]
+  access_control:
+    allow_gids: [600]
+    deny_gids: [600]
+`)
+
 	for name, tc := range map[string]struct {
 		path      string
 		expResult *Config
@@ -144,18 +358,114 @@ exclude_fabric_ifaces: ["ib3"]
 			path:   badFilterCfg,
 			expErr: errors.New("cannot specify both exclude_fabric_ifaces and include_fabric_ifaces"),
 		},
+		"bad fabric fallback": {
+			path:   badFallbackCfg,
+			expErr: errors.New("invalid fabric_fallback \"closest\""),
+		},
+		"ms rate burst without rate": {
+			path:   badRateBurstCfg,
+			expErr: errors.New("ms_rate_burst requires ms_rate_limit"),
+		},
+		"negative ms rate limit": {
+			path:   negativeRateCfg,
+			expErr: errors.New("may not be negative"),
+		},
+		"control max message size too small": {
+			path:   badMaxMsgSizeCfg,
+			expErr: errors.New("control_max_msg_size"),
+		},
+		"negative fabric check interval": {
+			path:   negativeCheckCfg,
+			expErr: errors.New("fabric_check_interval may not be negative"),
+		},
+		"negative access points refresh interval": {
+			path:   negativeAPRefreshCfg,
+			expErr: errors.New("access_points_refresh_interval may not be negative"),
+		},
+		"advise pool reconnect without interval": {
+			path:   adviseWithoutIntervalCfg,
+			expErr: errors.New("advise_pool_reconnect requires pool_change_interval"),
+		},
+		"negative system change interval": {
+			path:   negativeSysChangeCfg,
+			expErr: errors.New("system_change_interval may not be negative"),
+		},
+		"heartbeat interval too short": {
+			path:   shortHeartbeatCfg,
+			expErr: errors.New("heartbeat_interval may not be less than 1s"),
+		},
+		"bad OTLP traces endpoint": {
+			path:   badOTLPTracesCfg,
+			expErr: errors.New("telemetry_otlp_traces: invalid OTLP endpoint"),
+		},
+		"bad telemetry push interval": {
+			path:   badTelemetryPushCfg,
+			expErr: errors.New("telemetry_push: interval may not be less than 1s"),
+		},
+		"duplicate provider priority": {
+			path:   dupProviderCfg,
+			expErr: errors.New("duplicate provider \"ofi+verbs\""),
+		},
+		"duplicate system name": {
+			path:   dupSystemCfg,
+			expErr: errors.New("systems: duplicate system name \"shire\""),
+		},
+		"additional system without access points": {
+			path:   noSystemAPsCfg,
+			expErr: errors.New("systems: no access_points for system mordor"),
+		},
+		"standalone attach info without standalone": {
+			path:   attachInfoNoStandaloneCfg,
+			expErr: errors.New("standalone_attach_info requires standalone"),
+		},
+		"standalone with additional systems": {
+			path:   standaloneSystemsCfg,
+			expErr: errors.New("systems may not be used in standalone mode"),
+		},
+		"uid both allowed and denied": {
+			path:   badAccessControlCfg,
+			expErr: errors.New("uid 1000 is in both allow_uids and deny_uids"),
+		},
+		"system gid both allowed and denied": {
+			path:   badSystemAccessControlCfg,
+			expErr: errors.New("access_control for system mordor: gid 600 is in both allow_gids and deny_gids"),
+		},
 		"all options": {
 			path: optCfg,
 			expResult: &Config{
-				SystemName:       "shire",
-				AccessPoints:     []string{"one:10001", "two:10001"},
-				ControlPort:      4242,
-				RuntimeDir:       "/tmp/runtime",
-				LogFile:          "/home/frodo/logfile",
-				LogLevel:         common.ControlLogLevelDebug,
-				DisableCache:     true,
-				CacheExpiration:  refreshMinutes(30 * time.Minute),
-				DisableAutoEvict: true,
+				SystemName:   "shire",
+				AccessPoints: []string{"one:10001", "two:10001"},
+				ControlPort:  4242,
+				RuntimeDir:   "/tmp/runtime",
+				LogFile:      "/home/frodo/logfile",
+				LogLevel:     common.ControlLogLevelDebug,
+				LogModules: map[string]common.ControlLogLevel{
+					"agent.cache": common.ControlLogLevelTrace,
+				},
+				DisableCache:        true,
+				CacheExpiration:     refreshMinutes(30 * time.Minute),
+				DisableAutoEvict:    true,
+				EvictOnShutdown:     true,
+				EnableCPUHints:      true,
+				MSRateLimit:         50,
+				MSRateBurst:         100,
+				MSMaxConcurrent:     8,
+				MSQueueTimeout:      5 * time.Second,
+				FabricCheckInterval: 30 * time.Second,
+				PoolChangeInterval:  time.Minute,
+				AdvisePoolReconnect: true,
+				SysChangeInterval:   30 * time.Second,
+				HeartbeatInterval:   time.Minute,
+				TelemetryEnabled:    true,
+				TelemetryPush: &TelemetryPushConfig{
+					URL:      "http://pushgateway:9091",
+					Job:      "compute",
+					Interval: 30 * time.Second,
+				},
+				AccessControl: &AccessControlConfig{
+					AllowGIDs: []uint32{500},
+					DenyUIDs:  []uint32{1001},
+				},
 				CredentialConfig: &security.CredentialConfig{
 					CacheExpiration: time.Minute * 10,
 					ClientUserMap: map[uint32]*security.MappedClientUser{
@@ -171,6 +481,27 @@ exclude_fabric_ifaces: ["ib3"]
 					CertificateConfig: DefaultConfig().TransportConfig.CertificateConfig,
 				},
 				ExcludeFabricIfaces: common.NewStringSet("ib3"),
+				FabricFallback:      FabricFallbackNearestNUMA,
+				FabricGPUAffinity:   true,
+				UsePrivilegedHelper: true,
+				ProviderPriority:    []string{"ofi+verbs", "ucx+dc", "ofi+tcp"},
+				Systems: []*SystemConfig{
+					{
+						Name:         "mordor",
+						AccessPoints: []string{"three:10001"},
+						TransportConfig: &security.TransportConfig{
+							AllowInsecure: true,
+						},
+					},
+					{
+						Name:         "rivendell",
+						AccessPoints: []string{"four"},
+						ControlPort:  4343,
+						AccessControl: &AccessControlConfig{
+							AllowGIDs: []uint32{600},
+						},
+					},
+				},
 				FabricInterfaces: []*NUMAFabricConfig{
 					{
 						NUMANode: 0,
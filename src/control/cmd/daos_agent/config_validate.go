@@ -0,0 +1,266 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
+)
+
+const (
+	diagError   = "error"
+	diagWarning = "warning"
+)
+
+var (
+	yamlErrLineRE      = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+	yamlUnknownFieldRE = regexp.MustCompile(`^field (\S+) not found in type`)
+)
+
+type (
+	// configDiagnostic describes a problem found with the agent configuration.
+	configDiagnostic struct {
+		Severity string `json:"severity"`
+		Line     int    `json:"line,omitempty"`
+		Key      string `json:"key,omitempty"`
+		Message  string `json:"message"`
+	}
+
+	// configValidateResult is the result of validating an agent
+	// configuration file.
+	configValidateResult struct {
+		Path        string              `json:"path"`
+		Valid       bool                `json:"valid"`
+		Diagnostics []*configDiagnostic `json:"diagnostics"`
+	}
+)
+
+func (d *configDiagnostic) String() string {
+	var loc string
+	if d.Line > 0 {
+		loc = fmt.Sprintf("line %d: ", d.Line)
+	}
+	return fmt.Sprintf("%s: %s%s", d.Severity, loc, d.Message)
+}
+
+func (r *configValidateResult) add(severity string, err error) {
+	r.Diagnostics = append(r.Diagnostics, &configDiagnostic{
+		Severity: severity,
+		Message:  err.Error(),
+	})
+}
+
+func (r *configValidateResult) numErrors() (count int) {
+	for _, d := range r.Diagnostics {
+		if d.Severity == diagError {
+			count++
+		}
+	}
+	return
+}
+
+// yamlDiagnostics converts an error returned by the YAML parser into
+// diagnostics, one per problem found in the file.
+func yamlDiagnostics(err error) []*configDiagnostic {
+	msgs := []string{err.Error()}
+	if te, ok := err.(*yaml.TypeError); ok {
+		msgs = te.Errors
+	}
+
+	diags := make([]*configDiagnostic, 0, len(msgs))
+	for _, msg := range msgs {
+		diag := &configDiagnostic{
+			Severity: diagError,
+			Message:  msg,
+		}
+		if m := yamlErrLineRE.FindStringSubmatch(msg); m != nil {
+			diag.Line, _ = strconv.Atoi(m[1])
+			diag.Message = m[2]
+		}
+		if m := yamlUnknownFieldRE.FindStringSubmatch(diag.Message); m != nil {
+			diag.Key = m[1]
+			diag.Message = fmt.Sprintf("unknown key %q", m[1])
+		}
+		diags = append(diags, diag)
+	}
+
+	return diags
+}
+
+// fabricDiagnostics cross-checks the fabric interfaces referenced by the
+// configuration against the results of a local fabric scan.
+func fabricDiagnostics(cfg *Config, fis *hardware.FabricInterfaceSet) (diags []*configDiagnostic) {
+	found := make(map[string]*hardware.FabricInterface)
+	for _, name := range fis.Names() {
+		fi, err := fis.GetInterface(name)
+		if err != nil {
+			continue
+		}
+		found[fi.Name] = fi
+		if fi.OSName != "" {
+			found[fi.OSName] = fi
+		}
+		for _, netIF := range fi.NetInterfaces.ToSlice() {
+			found[netIF] = fi
+		}
+	}
+
+	missing := func(severity, key, iface string) {
+		diags = append(diags, &configDiagnostic{
+			Severity: severity,
+			Key:      key,
+			Message:  fmt.Sprintf("fabric interface %q not found on this host", iface),
+		})
+	}
+
+	for _, iface := range cfg.IncludeFabricIfaces.ToSlice() {
+		if _, exists := found[iface]; !exists {
+			missing(diagError, "include_fabric_ifaces", iface)
+		}
+	}
+	for _, iface := range cfg.ExcludeFabricIfaces.ToSlice() {
+		if _, exists := found[iface]; !exists {
+			missing(diagWarning, "exclude_fabric_ifaces", iface)
+		}
+	}
+	for _, nfc := range cfg.FabricInterfaces {
+		for _, ifc := range nfc.Interfaces {
+			fi, exists := found[ifc.Interface]
+			if !exists {
+				missing(diagError, "fabric_ifaces", ifc.Interface)
+				continue
+			}
+			if int(fi.NUMANode) != nfc.NUMANode {
+				diags = append(diags, &configDiagnostic{
+					Severity: diagWarning,
+					Key:      "fabric_ifaces",
+					Message: fmt.Sprintf("fabric interface %q is on NUMA node %d, not %d",
+						ifc.Interface, fi.NUMANode, nfc.NUMANode),
+				})
+			}
+		}
+	}
+
+	return
+}
+
+// agentConfigCmd is the struct representing the top-level config subcommand.
+type agentConfigCmd struct {
+	Validate      configValidateCmd `command:"validate" description:"Validate the agent configuration file"`
+	DumpEffective configDumpCmd     `command:"dump-effective" description:"Print the agent configuration with included files merged and environment variables expanded"`
+}
+
+// configValidateCmd validates the agent configuration file, reporting every
+// problem found rather than stopping at the first.
+type configValidateCmd struct {
+	cmdutil.LogCmd
+	cmdutil.JSONOutputCmd
+	configCmd
+	SkipFabricCheck bool `long:"skip-fabric-check" description:"Don't cross-check configured fabric interfaces against a local fabric scan"`
+	scanFabric      func() (*hardware.FabricInterfaceSet, error)
+}
+
+// validate returns the result of validating the configuration file.
+func (cmd *configValidateCmd) validate() *configValidateResult {
+	result := &configValidateResult{
+		Path:        cmd.cfgPath,
+		Diagnostics: []*configDiagnostic{},
+	}
+	defer func() {
+		result.Valid = result.numErrors() == 0
+	}()
+
+	if cmd.cfgPath == "" {
+		result.add(diagError, errors.New("no config file found"))
+		return result
+	}
+	data, _, err := common.ReadConfigFile(cmd.cfgPath)
+	if err != nil {
+		result.add(diagError, err)
+		return result
+	}
+
+	// A strict unmarshal reports unknown keys and values of the wrong type
+	// but carries on decoding the rest of the file, so the remainder can
+	// still be checked.
+	cfg := DefaultConfig()
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		result.Diagnostics = append(result.Diagnostics, yamlDiagnostics(err)...)
+		if _, ok := err.(*yaml.TypeError); !ok {
+			return result
+		}
+	}
+
+	for _, err := range cfg.validationErrors() {
+		result.add(diagError, err)
+	}
+	if _, err := control.ParseHostList(cfg.AccessPoints, cfg.ControlPort); err != nil {
+		result.add(diagError, errors.Wrap(err, "access_points"))
+	}
+	// Certificates may not be present where the config is validated, e.g.
+	// in a CI pipeline, so failing to load them is only a warning.
+	if err := cfg.TransportConfig.PreLoadCertData(); err != nil {
+		result.add(diagWarning, errors.Wrap(err, "transport_config"))
+	}
+
+	if cmd.SkipFabricCheck || (len(cfg.IncludeFabricIfaces) == 0 &&
+		len(cfg.ExcludeFabricIfaces) == 0 && len(cfg.FabricInterfaces) == 0) {
+		return result
+	}
+	fis, err := cmd.scanFabric()
+	if err != nil {
+		result.add(diagError, errors.Wrap(err, "fabric scan"))
+		return result
+	}
+	result.Diagnostics = append(result.Diagnostics, fabricDiagnostics(cfg, fis)...)
+
+	return result
+}
+
+// Execute is run when configValidateCmd activates.
+func (cmd *configValidateCmd) Execute(_ []string) error {
+	if cmd.scanFabric == nil {
+		cmd.scanFabric = func() (*hardware.FabricInterfaceSet, error) {
+			return network.DefaultFabricScanner(cmd.Logger).Scan(cmd.MustLogCtx())
+		}
+	}
+
+	result := cmd.validate()
+
+	var err error
+	if !result.Valid {
+		err = errors.Errorf("config validation failed with %d error(s)", result.numErrors())
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(result, err)
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "%s: ", result.Path)
+	if len(result.Diagnostics) == 0 {
+		bld.WriteString("OK")
+	} else {
+		bld.WriteString(fmt.Sprintf("%d problem(s) found", len(result.Diagnostics)))
+	}
+	for _, d := range result.Diagnostics {
+		bld.WriteString("\n  " + d.String())
+	}
+	cmd.Info(bld.String())
+
+	return err
+}
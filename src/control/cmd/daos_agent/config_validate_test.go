@@ -0,0 +1,231 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_configValidateCmd(t *testing.T) {
+	scanResult := hardware.NewFabricInterfaceSet(
+		&hardware.FabricInterface{
+			Name:          "mlx5_0",
+			OSName:        "ib0",
+			NetInterfaces: common.NewStringSet("ib0"),
+			DeviceClass:   hardware.Infiniband,
+			NUMANode:      1,
+		},
+	)
+
+	baseCfg := `
+name: shire
+access_points: ["one:10001"]
+transport_config:
+  allow_insecure: true
+`
+
+	for name, tc := range map[string]struct {
+		cfg       string
+		noCfg     bool
+		skipCheck bool
+		scanErr   error
+		expDiags  []*configDiagnostic
+		expErr    error
+	}{
+		"no config file": {
+			noCfg: true,
+			expDiags: []*configDiagnostic{
+				{Severity: diagError, Message: "no config file found"},
+			},
+			expErr: errors.New("1 error(s)"),
+		},
+		"valid": {
+			cfg:      baseCfg,
+			expDiags: []*configDiagnostic{},
+		},
+		"syntax error": {
+			cfg: "name: shire\n  access_points: [\n",
+			expDiags: []*configDiagnostic{
+				{
+					Severity: diagError,
+					Line:     2,
+					Message:  "mapping values are not allowed in this context",
+				},
+			},
+			expErr: errors.New("1 error(s)"),
+		},
+		"unknown keys and bad durations": {
+			cfg: baseCfg + `
+fabric_check_intervl: 10s
+pool_change_interval: forever
+exclude_fabric_ifaces: ["ib0"]
+include_fabric_ifaces: ["ib1"]
+ms_rate_burst: 10
+`,
+			skipCheck: true,
+			expDiags: []*configDiagnostic{
+				{
+					Severity: diagError,
+					Line:     7,
+					Key:      "fabric_check_intervl",
+					Message:  `unknown key "fabric_check_intervl"`,
+				},
+				{
+					Severity: diagError,
+					Line:     8,
+					Message:  "cannot unmarshal !!str `forever` into time.Duration",
+				},
+				{
+					Severity: diagError,
+					Message:  "cannot specify both exclude_fabric_ifaces and include_fabric_ifaces",
+				},
+				{
+					Severity: diagError,
+					Message:  "ms_rate_burst requires ms_rate_limit",
+				},
+			},
+			expErr: errors.New("4 error(s)"),
+		},
+		"bad access point": {
+			cfg: `
+name: shire
+access_points: ["10.0.0.0/8"]
+transport_config:
+  allow_insecure: true
+`,
+			expDiags: []*configDiagnostic{
+				{
+					Severity: diagError,
+					Message:  `access_points: host range "10.0.0.0/8" is larger than /22`,
+				},
+			},
+			expErr: errors.New("1 error(s)"),
+		},
+		"fabric interfaces cross-checked": {
+			cfg: baseCfg + `
+fabric_ifaces:
+- numa_node: 0
+  devices:
+  - iface: ib0
+    domain: mlx5_0
+  - iface: ib1
+    domain: mlx5_1
+`,
+			expDiags: []*configDiagnostic{
+				{
+					Severity: diagWarning,
+					Key:      "fabric_ifaces",
+					Message:  `fabric interface "ib0" is on NUMA node 1, not 0`,
+				},
+				{
+					Severity: diagError,
+					Key:      "fabric_ifaces",
+					Message:  `fabric interface "ib1" not found on this host`,
+				},
+			},
+			expErr: errors.New("1 error(s)"),
+		},
+		"missing excluded interface is a warning": {
+			cfg: baseCfg + "exclude_fabric_ifaces: [\"mlx5_0\", \"eth9\"]\n",
+			expDiags: []*configDiagnostic{
+				{
+					Severity: diagWarning,
+					Key:      "exclude_fabric_ifaces",
+					Message:  `fabric interface "eth9" not found on this host`,
+				},
+			},
+		},
+		"fabric check skipped": {
+			cfg:       baseCfg + "include_fabric_ifaces: [\"eth9\"]\n",
+			skipCheck: true,
+			expDiags:  []*configDiagnostic{},
+		},
+		"fabric scan fails": {
+			cfg:     baseCfg + "include_fabric_ifaces: [\"ib0\"]\n",
+			scanErr: errors.New("mock scan"),
+			expDiags: []*configDiagnostic{
+				{Severity: diagError, Message: "fabric scan: mock scan"},
+			},
+			expErr: errors.New("1 error(s)"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			var cfgPath string
+			if !tc.noCfg {
+				cfgPath = test.CreateTestFile(t, dir, tc.cfg)
+			}
+
+			var out bytes.Buffer
+			var wroteJSON atm.Bool
+			cmd := &configValidateCmd{
+				SkipFabricCheck: tc.skipCheck,
+				scanFabric: func() (*hardware.FabricInterfaceSet, error) {
+					return scanResult, tc.scanErr
+				},
+			}
+			cmd.SetLog(log)
+			cmd.EnableJSONOutput(&out, &wroteJSON)
+			cmd.setConfigPath(cfgPath)
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			var gotJSON struct {
+				Response *configValidateResult `json:"response"`
+			}
+			if err := json.Unmarshal(out.Bytes(), &gotJSON); err != nil {
+				t.Fatal(err)
+			}
+			gotResult := gotJSON.Response
+			if gotResult == nil {
+				t.Fatal("no result in JSON output")
+			}
+
+			test.AssertEqual(t, cfgPath, gotResult.Path, "unexpected path")
+			test.AssertEqual(t, tc.expErr == nil, gotResult.Valid, "unexpected validity")
+			if diff := cmp.Diff(tc.expDiags, gotResult.Diagnostics); diff != "" {
+				t.Fatalf("unexpected diagnostics (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_configValidateCmd_TextOutput(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+	cfgPath := filepath.Join(dir, "missing.yml")
+
+	cmd := &configValidateCmd{}
+	cmd.SetLog(log)
+	cmd.setConfigPath(cfgPath)
+
+	test.CmpErr(t, errors.New("config validation failed"), cmd.Execute(nil))
+	test.AssertTrue(t, strings.Contains(buf.String(), cfgPath+": 1 problem(s) found"),
+		"expected problem count in output")
+}
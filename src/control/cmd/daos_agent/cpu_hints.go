@@ -0,0 +1,91 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+const defaultSysRoot = "/sys"
+
+// cpuSetProvider looks up the CPUs that are local to a NUMA node.
+type cpuSetProvider interface {
+	GetNUMANodeCPUs(numaNode uint) (string, error)
+}
+
+// sysfsCPUSetProvider looks up the CPUs local to a NUMA node via sysfs.
+type sysfsCPUSetProvider struct {
+	root string
+}
+
+// GetNUMANodeCPUs returns the CPUs local to the NUMA node, in cpulist format.
+func (p *sysfsCPUSetProvider) GetNUMANodeCPUs(numaNode uint) (string, error) {
+	root := p.root
+	if root == "" {
+		root = defaultSysRoot
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, "devices", "system", "node",
+		fmt.Sprintf("node%d", numaNode), "cpulist"))
+	if err != nil {
+		return "", errors.Wrapf(err, "reading CPU list of NUMA node %d", numaNode)
+	}
+
+	cpus := strings.TrimSpace(string(data))
+	if cpus == "" {
+		return "", errors.Errorf("no CPUs on NUMA node %d", numaNode)
+	}
+
+	return cpus, nil
+}
+
+// getIfaceNUMANode returns the NUMA node of the named interface in the response's NUMA
+// fabric map.
+func getIfaceNUMANode(resp *mgmtpb.GetAttachInfoResp, iface string) (uint32, bool) {
+	for _, numaFIs := range resp.NumaFabricInterfaces {
+		for _, fi := range numaFIs.Ifaces {
+			if fi.Interface == iface {
+				return numaFIs.NumaNode, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// setCPUHints adds a recommended CPU and memory binding to the client network hint,
+// covering the NUMA node of the selected fabric interface. Hints are only generated if
+// enabled in the agent configuration, and are omitted if the NUMA node of the interface
+// is unknown.
+func (mod *mgmtModule) setCPUHints(resp *mgmtpb.GetAttachInfoResp) {
+	if mod.cpuSetGetter == nil || resp.ClientNetHint == nil {
+		return
+	}
+
+	numaNode, found := getIfaceNUMANode(resp, resp.ClientNetHint.Interface)
+	if !found {
+		mod.log.Debugf("no CPU hints: NUMA node of interface %q unknown",
+			resp.ClientNetHint.Interface)
+		return
+	}
+
+	cpus, err := mod.cpuSetGetter.GetNUMANodeCPUs(uint(numaNode))
+	if err != nil {
+		mod.log.Debugf("no CPU hints: %s", err)
+		return
+	}
+
+	resp.ClientNetHint.CpusetCpus = cpus
+	resp.ClientNetHint.CpusetMems = fmt.Sprintf("%d", numaNode)
+}
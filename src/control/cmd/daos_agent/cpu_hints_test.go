@@ -0,0 +1,164 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockCPUSetProvider struct {
+	cpus map[uint]string
+}
+
+func (m *mockCPUSetProvider) GetNUMANodeCPUs(numaNode uint) (string, error) {
+	cpus, found := m.cpus[numaNode]
+	if !found {
+		return "", errors.Errorf("no NUMA node %d", numaNode)
+	}
+	return cpus, nil
+}
+
+func TestAgent_sysfsCPUSetProvider_GetNUMANodeCPUs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		numaNode uint
+		expCPUs  string
+		expErr   error
+	}{
+		"no such node": {
+			numaNode: 3,
+			expErr:   errors.New("reading CPU list of NUMA node 3"),
+		},
+		"no CPUs": {
+			numaNode: 2,
+			expErr:   errors.New("no CPUs on NUMA node 2"),
+		},
+		"success": {
+			numaNode: 1,
+			expCPUs:  "16-31,48-63",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			root, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			for node, cpuList := range map[int]string{
+				0: "0-15,32-47\n",
+				1: "16-31,48-63\n",
+				2: "\n",
+			} {
+				nodeDir := filepath.Join(root, "devices", "system", "node", fmt.Sprintf("node%d", node))
+				if err := os.MkdirAll(nodeDir, 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(nodeDir, "cpulist"), []byte(cpuList), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			provider := &sysfsCPUSetProvider{root: root}
+			gotCPUs, gotErr := provider.GetNUMANodeCPUs(tc.numaNode)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expCPUs, gotCPUs, "")
+		})
+	}
+}
+
+func TestAgent_mgmtModule_setCPUHints(t *testing.T) {
+	testResp := func(iface string) *mgmtpb.GetAttachInfoResp {
+		return &mgmtpb.GetAttachInfoResp{
+			ClientNetHint: &mgmtpb.ClientNetHint{
+				Provider:  "ofi+tcp",
+				Interface: iface,
+			},
+			NumaFabricInterfaces: []*mgmtpb.FabricInterfaces{
+				{
+					NumaNode: 0,
+					Ifaces: []*mgmtpb.FabricInterface{
+						{NumaNode: 0, Interface: "eth0", Provider: "ofi+tcp"},
+					},
+				},
+				{
+					NumaNode: 1,
+					Ifaces: []*mgmtpb.FabricInterface{
+						{NumaNode: 1, Interface: "eth1", Provider: "ofi+tcp"},
+					},
+				},
+			},
+		}
+	}
+	withHints := func(resp *mgmtpb.GetAttachInfoResp, cpus, mems string) *mgmtpb.GetAttachInfoResp {
+		resp.ClientNetHint.CpusetCpus = cpus
+		resp.ClientNetHint.CpusetMems = mems
+		return resp
+	}
+	provider := &mockCPUSetProvider{
+		cpus: map[uint]string{
+			1: "16-31",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		getter  cpuSetProvider
+		resp    *mgmtpb.GetAttachInfoResp
+		expResp *mgmtpb.GetAttachInfoResp
+	}{
+		"disabled": {
+			resp:    testResp("eth1"),
+			expResp: testResp("eth1"),
+		},
+		"no net hint": {
+			getter:  provider,
+			resp:    &mgmtpb.GetAttachInfoResp{},
+			expResp: &mgmtpb.GetAttachInfoResp{},
+		},
+		"interface not in NUMA map": {
+			getter:  provider,
+			resp:    testResp("ib0"),
+			expResp: testResp("ib0"),
+		},
+		"CPUs unknown": {
+			getter:  provider,
+			resp:    testResp("eth0"),
+			expResp: testResp("eth0"),
+		},
+		"success": {
+			getter:  provider,
+			resp:    testResp("eth1"),
+			expResp: withHints(testResp("eth1"), "16-31", "1"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mod := &mgmtModule{
+				log:          log,
+				cpuSetGetter: tc.getter,
+			}
+
+			mod.setCPUHints(tc.resp)
+			if diff := cmp.Diff(tc.expResp, tc.resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"math"
 	"net"
 	"sort"
 	"sync"
@@ -66,6 +68,9 @@ func (f *FabricInterface) HasProvider(provider string) bool {
 // supplied by the user.
 const FabricDevClassManual = hardware.NetDevClass(1 << 31)
 
+// fabricLogModule is the name of the log module used by the NUMA fabric.
+const fabricLogModule = "agent.fabric"
+
 // addrFI is a fabric interface that can provide its addresses.
 type addrFI interface {
 	Addrs() ([]net.Addr, error)
@@ -116,6 +121,39 @@ func newDeviceFilter(deviceSet common.StringSet, mode filterMode) *deviceFilter
 	}
 }
 
+// FabricFallbackPolicy determines how a fabric interface is selected for a client when no
+// suitable interface exists on the client's NUMA node.
+type FabricFallbackPolicy string
+
+const (
+	// FabricFallbackAny selects an interface on any NUMA node, in round-robin order.
+	FabricFallbackAny FabricFallbackPolicy = "any"
+	// FabricFallbackNearestNUMA selects an interface on the nearest NUMA node that has one.
+	FabricFallbackNearestNUMA FabricFallbackPolicy = "nearest-numa"
+	// FabricFallbackFail fails the request rather than selecting an interface on another
+	// NUMA node.
+	FabricFallbackFail FabricFallbackPolicy = "fail"
+)
+
+// UnmarshalYAML validates the fallback policy read from the configuration.
+func (p *FabricFallbackPolicy) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+
+	policy := FabricFallbackPolicy(str)
+	switch policy {
+	case FabricFallbackAny, FabricFallbackNearestNUMA, FabricFallbackFail:
+	default:
+		return errors.Errorf("invalid fabric_fallback %q (valid: %s, %s, %s)", str,
+			FabricFallbackNearestNUMA, FabricFallbackAny, FabricFallbackFail)
+	}
+
+	*p = policy
+	return nil
+}
+
 // NUMAFabric represents a set of fabric interfaces organized by NUMA node.
 type NUMAFabric struct {
 	log   logging.Logger
@@ -123,9 +161,15 @@ type NUMAFabric struct {
 
 	numaMap NUMAFabricMap
 
-	currentNumaDevIdx map[int]int   // current device idx to use on each NUMA node
-	currentNUMANode   int           // current NUMA node to search
-	ifaceFilter       *deviceFilter // set of interface names for filtering
+	currentNumaDevIdx map[int]int            // current device idx to use on each NUMA node
+	currentNUMANode   int                    // current NUMA node to search
+	ifaceFilter       *deviceFilter          // set of interface names for filtering
+	fallback          FabricFallbackPolicy   // how to select a device on another NUMA node
+	numaDistances     hardware.NUMADistances // relative distances between NUMA nodes
+	unhealthy         common.StringSet       // interfaces detected as down
+	allowUnhealthy    bool                   // select interfaces detected as down
+	gpuAffine         common.StringSet       // interfaces preferred for their affinity to accelerators
+	requireGPUAffine  bool                   // only select interfaces preferred for accelerator affinity
 
 	getAddrInterface func(name string) (addrFI, error)
 }
@@ -153,6 +197,76 @@ func (n *NUMAFabric) WithDeviceFilter(filter *deviceFilter) *NUMAFabric {
 	return n
 }
 
+// WithFallbackPolicy sets the policy used to select a device when none is available on the
+// requested NUMA node. The NUMA distances are used to order the NUMA nodes for the
+// nearest-numa policy, and may be nil if unknown.
+func (n *NUMAFabric) WithFallbackPolicy(policy FabricFallbackPolicy, distances hardware.NUMADistances) *NUMAFabric {
+	if policy != "" {
+		n.fallback = policy
+		n.log.Tracef("fabric fallback policy: %s", n.fallback)
+	}
+	n.numaDistances = distances
+	return n
+}
+
+// WithGPUAffinity sets the fabric interfaces that are preferred for their affinity to
+// the accelerators on their NUMA node. When selecting a device on a NUMA node, these
+// interfaces are selected before any others.
+func (n *NUMAFabric) WithGPUAffinity(ifaces common.StringSet) *NUMAFabric {
+	if len(ifaces) > 0 {
+		n.gpuAffine = ifaces
+		n.log.Tracef("fabric interfaces with accelerator affinity: %s", n.gpuAffine)
+	}
+	return n
+}
+
+// SetInterfaceHealth records whether a fabric interface is healthy. Interfaces that are not
+// healthy are only selected by GetDevice if no healthy interface is suitable. Returns true if
+// the health of the interface has changed.
+func (n *NUMAFabric) SetInterfaceHealth(name string, healthy bool) bool {
+	if n == nil {
+		return false
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if healthy {
+		if !n.unhealthy.Has(name) {
+			return false
+		}
+		delete(n.unhealthy, name)
+		return true
+	}
+
+	if n.unhealthy.Has(name) {
+		return false
+	}
+	if n.unhealthy == nil {
+		n.unhealthy = common.NewStringSet()
+	}
+	n.unhealthy.Add(name)
+	return true
+}
+
+// InterfaceNUMANodes gets the NUMA node of each fabric interface, keyed by interface name.
+func (n *NUMAFabric) InterfaceNUMANodes() map[string]int {
+	if n == nil {
+		return nil
+	}
+
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	nodes := make(map[string]int)
+	for numa, fis := range n.numaMap {
+		for _, fi := range fis {
+			nodes[fi.Name] = numa
+		}
+	}
+	return nodes
+}
+
 // NumDevices gets the number of devices on a given NUMA node.
 func (n *NUMAFabric) NumDevices(numaNode int) int {
 	if n == nil {
@@ -223,9 +337,11 @@ type FabricIfaceParams struct {
 	Provider  string
 	DevClass  hardware.NetDevClass
 	NUMANode  int
+	Visible   common.StringSet // if set, only interfaces with these names may be selected
 }
 
-// GetDevice selects the next available interface device on the requested NUMA node.
+// GetDevice selects the next available interface device on the requested NUMA node. Interfaces
+// detected as down are only selected if no healthy interface is suitable.
 func (n *NUMAFabric) GetDevice(params *FabricIfaceParams) (*FabricInterface, error) {
 	if n == nil {
 		return nil, errors.New("nil NUMAFabric")
@@ -242,12 +358,38 @@ func (n *NUMAFabric) GetDevice(params *FabricIfaceParams) (*FabricInterface, err
 	n.mutex.Lock()
 	defer n.mutex.Unlock()
 
-	fi, err := n.getDeviceFromNUMA(params.NUMANode, params.DevClass, params.Provider)
+	fi, err := n.getDevice(params)
+	if err == nil || len(n.unhealthy) == 0 {
+		return fi, err
+	}
+
+	n.allowUnhealthy = true
+	defer func() {
+		n.allowUnhealthy = false
+	}()
+	fi, uerr := n.getDevice(params)
+	if uerr != nil {
+		return nil, err
+	}
+	n.log.Noticef("device %s: selected although down (no healthy interface available)", fi)
+
+	return fi, nil
+}
+
+func (n *NUMAFabric) getDevice(params *FabricIfaceParams) (*FabricInterface, error) {
+	fi, err := n.getDeviceFromNUMA(params.NUMANode, params.DevClass, params.Provider, params.Visible)
 	if err == nil {
 		return copyFI(fi), nil
 	}
 
-	fi, err = n.findOnAnyNUMA(params.DevClass, params.Provider)
+	switch n.fallback {
+	case FabricFallbackFail:
+		return nil, errors.Wrapf(err, "NUMA node %d (fabric_fallback: %s)", params.NUMANode, n.fallback)
+	case FabricFallbackNearestNUMA:
+		fi, err = n.findOnNearestNUMA(params.NUMANode, params.DevClass, params.Provider, params.Visible)
+	default:
+		fi, err = n.findOnAnyNUMA(params.DevClass, params.Provider, params.Visible)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -261,7 +403,22 @@ func copyFI(fi *FabricInterface) *FabricInterface {
 	return fiCopy
 }
 
-func (n *NUMAFabric) getDeviceFromNUMA(numaNode int, netDevClass hardware.NetDevClass, provider string) (*FabricInterface, error) {
+// getDeviceFromNUMA selects the next suitable device on the NUMA node, preferring those
+// with affinity to accelerators.
+func (n *NUMAFabric) getDeviceFromNUMA(numaNode int, netDevClass hardware.NetDevClass, provider string, visible common.StringSet) (*FabricInterface, error) {
+	if len(n.gpuAffine) > 0 {
+		n.requireGPUAffine = true
+		fi, err := n.selectDeviceFromNUMA(numaNode, netDevClass, provider, visible)
+		n.requireGPUAffine = false
+		if err == nil {
+			return fi, nil
+		}
+	}
+
+	return n.selectDeviceFromNUMA(numaNode, netDevClass, provider, visible)
+}
+
+func (n *NUMAFabric) selectDeviceFromNUMA(numaNode int, netDevClass hardware.NetDevClass, provider string, visible common.StringSet) (*FabricInterface, error) {
 	for checked := 0; checked < n.getNumDevices(numaNode); checked++ {
 		fabricIF := n.getNextDevice(numaNode)
 
@@ -270,6 +427,21 @@ func (n *NUMAFabric) getDeviceFromNUMA(numaNode int, netDevClass hardware.NetDev
 			continue
 		}
 
+		if !n.allowUnhealthy && n.unhealthy.Has(fabricIF.Name) {
+			n.log.Tracef("device %s: excluded (interface is down)", fabricIF)
+			continue
+		}
+
+		if visible != nil && !visible.Has(fabricIF.Name) {
+			n.log.Tracef("device %s: excluded (not visible to client)", fabricIF)
+			continue
+		}
+
+		if n.requireGPUAffine && !n.gpuAffine.Has(fabricIF.Name) {
+			n.log.Tracef("device %s: excluded (no accelerator affinity)", fabricIF)
+			continue
+		}
+
 		// Manually-provided interfaces can be assumed to support what's needed by the system.
 		if fabricIF.NetDevClass != FabricDevClassManual {
 			if fabricIF.NetDevClass != netDevClass {
@@ -329,13 +501,13 @@ func (n *NUMAFabric) getNextDevice(numaNode int) *FabricInterface {
 	return n.numaMap[numaNode][idx]
 }
 
-func (n *NUMAFabric) findOnAnyNUMA(netDevClass hardware.NetDevClass, provider string) (*FabricInterface, error) {
+func (n *NUMAFabric) findOnAnyNUMA(netDevClass hardware.NetDevClass, provider string, visible common.StringSet) (*FabricInterface, error) {
 	nodes := n.getNUMANodes()
 	numNodes := len(nodes)
 
 	for i := 0; i < numNodes; i++ {
 		n.currentNUMANode = (n.currentNUMANode + 1) % numNodes
-		fi, err := n.getDeviceFromNUMA(nodes[n.currentNUMANode], netDevClass, provider)
+		fi, err := n.getDeviceFromNUMA(nodes[n.currentNUMANode], netDevClass, provider, visible)
 		if err == nil {
 			n.log.Tracef("device %s: selected on NUMA node %d)", fi, n.currentNUMANode)
 			return fi, nil
@@ -344,6 +516,48 @@ func (n *NUMAFabric) findOnAnyNUMA(netDevClass hardware.NetDevClass, provider st
 	return nil, FabricNotFoundErr(netDevClass)
 }
 
+func (n *NUMAFabric) findOnNearestNUMA(numaNode int, netDevClass hardware.NetDevClass, provider string, visible common.StringSet) (*FabricInterface, error) {
+	for _, node := range n.getNUMANodesByDistance(numaNode) {
+		if node == numaNode {
+			continue
+		}
+
+		fi, err := n.getDeviceFromNUMA(node, netDevClass, provider, visible)
+		if err == nil {
+			n.log.Tracef("device %s: selected on nearest NUMA node %d", fi, node)
+			return fi, nil
+		}
+	}
+	return nil, FabricNotFoundErr(netDevClass)
+}
+
+// getNUMANodesByDistance returns the NUMA nodes ordered by increasing distance from the given
+// node. Nodes at the same distance are ordered by ID.
+func (n *NUMAFabric) getNUMANodesByDistance(numaNode int) []int {
+	nodes := n.getNUMANodes()
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return n.getNUMADistance(numaNode, nodes[i]) < n.getNUMADistance(numaNode, nodes[j])
+	})
+	return nodes
+}
+
+// getNUMADistance returns the relative distance between two NUMA nodes. If the NUMA
+// distances are unknown, the difference between the node IDs is used as an approximation.
+// Nodes missing from known NUMA distances are considered the most distant.
+func (n *NUMAFabric) getNUMADistance(from, to int) uint64 {
+	if n.numaDistances == nil {
+		if from > to {
+			return uint64(from - to)
+		}
+		return uint64(to - from)
+	}
+
+	if dist, found := n.numaDistances.Distance(uint(from), uint(to)); found {
+		return dist
+	}
+	return math.MaxUint64
+}
+
 func (n *NUMAFabric) getNUMANodes() []int {
 	keys := make([]int, 0)
 	for k := range n.numaMap {
@@ -446,7 +660,7 @@ func filterProvider(provider string, fiList []*FabricInterface) []*FabricInterfa
 
 func newNUMAFabric(log logging.Logger) *NUMAFabric {
 	return &NUMAFabric{
-		log:               log,
+		log:               logging.ForModule(log, fabricLogModule),
 		numaMap:           make(map[int][]*FabricInterface),
 		currentNumaDevIdx: make(map[int]int),
 	}
@@ -455,6 +669,7 @@ func newNUMAFabric(log logging.Logger) *NUMAFabric {
 // NUMAFabricFromScan generates a NUMAFabric from a fabric scan result.
 func NUMAFabricFromScan(ctx context.Context, log logging.Logger, scan *hardware.FabricInterfaceSet) *NUMAFabric {
 	fabric := newNUMAFabric(log)
+	log = fabric.log
 
 	for _, name := range scan.Names() {
 		fi, err := scan.GetInterface(name)
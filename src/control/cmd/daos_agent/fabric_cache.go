@@ -0,0 +1,174 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/hardware/helper"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// fabricScanCacheFile is the name of the file in the agent's runtime directory
+// that holds the results of the last fabric scan.
+const fabricScanCacheFile = "fabric_scan_cache.json"
+
+type (
+	hwScanFn        func(ctx context.Context, providers ...string) (*hardware.FabricInterfaceSet, error)
+	hwFingerprintFn func(providers ...string) (string, error)
+
+	fabricScanCacheData struct {
+		Fingerprint string                    `json:"fingerprint"`
+		Interfaces  []*helper.FabricInterface `json:"interfaces"`
+	}
+
+	// fabricScanCache persists the results of a fabric scan to a local file,
+	// so that an agent restarted on unchanged hardware can skip the scan.
+	// The file is only consulted for the first scan after startup, and only
+	// if the fingerprint of the hardware matches the one it was saved with.
+	fabricScanCache struct {
+		log         logging.Logger
+		path        string
+		fingerprint hwFingerprintFn
+		consulted   atm.Bool
+	}
+)
+
+func newFabricScanCache(log logging.Logger, path string) *fabricScanCache {
+	libDirs := searchLibDirs()
+	return &fabricScanCache{
+		log:  log,
+		path: path,
+		fingerprint: func(providers ...string) (string, error) {
+			return hardwareFingerprint("/sys", "/proc", fabricLibFingerprint("/proc", libDirs), providers...)
+		},
+	}
+}
+
+// hardwareFingerprint generates a hash of the properties of the node that
+// determine the results of a fabric scan: the PCI devices, the kernel version,
+// the DAOS version, the fabric provider libraries and the requested providers.
+func hardwareFingerprint(sysRoot, procRoot, libs string, providers ...string) (string, error) {
+	kernel, err := os.ReadFile(filepath.Join(procRoot, "sys", "kernel", "osrelease"))
+	if err != nil {
+		return "", errors.Wrap(err, "reading kernel version")
+	}
+
+	pciDir := filepath.Join(sysRoot, "bus", "pci", "devices")
+	entries, err := os.ReadDir(pciDir)
+	if err != nil {
+		return "", errors.Wrap(err, "reading PCI devices")
+	}
+
+	provs := append([]string{}, providers...)
+	sort.Strings(provs)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "daos=%s\nkernel=%s\nlibs=%s\nproviders=%s\n", build.DaosVersion,
+		strings.TrimSpace(string(kernel)), libs, strings.Join(provs, ","))
+	for _, entry := range entries {
+		fmt.Fprintf(h, "pci=%s", entry.Name())
+		for _, attr := range []string{"vendor", "device", "class"} {
+			val, err := os.ReadFile(filepath.Join(pciDir, entry.Name(), attr))
+			if err != nil && !os.IsNotExist(err) {
+				return "", errors.Wrapf(err, "reading PCI device %s %s", entry.Name(), attr)
+			}
+			fmt.Fprintf(h, " %s", strings.TrimSpace(string(val)))
+		}
+		fmt.Fprintln(h)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// load returns the cached scan results if they were saved with the supplied
+// fingerprint.
+func (c *fabricScanCache) load(fingerprint string) (*hardware.FabricInterfaceSet, error) {
+	buf, err := os.ReadFile(c.path)
+	if err != nil {
+		return nil, err
+	}
+
+	data := new(fabricScanCacheData)
+	if err := json.Unmarshal(buf, data); err != nil {
+		return nil, errors.Wrapf(err, "decoding %s", c.path)
+	}
+	if data.Fingerprint != fingerprint {
+		return nil, errors.New("hardware fingerprint has changed")
+	}
+
+	return helper.FabricInterfaceSetFrom(data.Interfaces), nil
+}
+
+// save writes the scan results to the cache file.
+func (c *fabricScanCache) save(fingerprint string, fis *hardware.FabricInterfaceSet) error {
+	ifaces, err := helper.FabricInterfacesFromSet(fis)
+	if err != nil {
+		return err
+	}
+	data := &fabricScanCacheData{
+		Fingerprint: fingerprint,
+		Interfaces:  ifaces,
+	}
+
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return common.WriteFileAtomic(c.path, buf, 0600)
+}
+
+// wrap returns a scan function that uses the cached results for the first
+// scan if they are still valid, and otherwise scans and caches the results.
+func (c *fabricScanCache) wrap(scan hwScanFn) hwScanFn {
+	return func(ctx context.Context, providers ...string) (*hardware.FabricInterfaceSet, error) {
+		fingerprint, err := c.fingerprint(providers...)
+		if err != nil {
+			c.log.Noticef("unable to fingerprint hardware, fabric scan will not be cached: %s", err)
+			return scan(ctx, providers...)
+		}
+
+		// Scans are serialized by the info cache, so there is no race here.
+		if c.consulted.IsFalse() {
+			c.consulted.SetTrue()
+			fis, err := c.load(fingerprint)
+			if err == nil {
+				c.log.Debugf("using cached fabric scan from %s", c.path)
+				return fis, nil
+			}
+			if !os.IsNotExist(err) {
+				c.log.Debugf("not using cached fabric scan: %s", err)
+			}
+		}
+
+		fis, err := scan(ctx, providers...)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.save(fingerprint, fis); err != nil {
+			c.log.Noticef("unable to cache fabric scan in %s: %s", c.path, err)
+		}
+
+		return fis, nil
+	}
+}
@@ -0,0 +1,269 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_hardwareFingerprint(t *testing.T) {
+	setup := func(t *testing.T) (string, string) {
+		t.Helper()
+
+		root, cleanup := test.CreateTestDir(t)
+		t.Cleanup(cleanup)
+
+		sysRoot := filepath.Join(root, "sys")
+		procRoot := filepath.Join(root, "proc")
+		writeFile := func(path, content string) {
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		writeFile(filepath.Join(procRoot, "sys", "kernel", "osrelease"), "5.14.0\n")
+		for _, dev := range []string{"0000:00:01.0", "0000:18:00.0"} {
+			devDir := filepath.Join(sysRoot, "bus", "pci", "devices", dev)
+			writeFile(filepath.Join(devDir, "vendor"), "0x15b3\n")
+			writeFile(filepath.Join(devDir, "device"), "0x101b\n")
+			writeFile(filepath.Join(devDir, "class"), "0x020700\n")
+		}
+
+		return sysRoot, procRoot
+	}
+
+	sysRoot, procRoot := setup(t)
+	baseline, err := hardwareFingerprint(sysRoot, procRoot, "libs1", "ofi+tcp", "ofi+verbs")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		modify     func(t *testing.T, sysRoot, procRoot string)
+		libs       string
+		providers  []string
+		expChanged bool
+		expErr     error
+	}{
+		"unchanged": {
+			providers: []string{"ofi+verbs", "ofi+tcp"},
+		},
+		"different providers": {
+			providers:  []string{"ofi+tcp"},
+			expChanged: true,
+		},
+		"libraries changed": {
+			libs:       "libs2",
+			providers:  []string{"ofi+tcp", "ofi+verbs"},
+			expChanged: true,
+		},
+		"kernel changed": {
+			modify: func(t *testing.T, _, procRoot string) {
+				if err := os.WriteFile(filepath.Join(procRoot, "sys", "kernel", "osrelease"), []byte("6.1.0\n"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			providers:  []string{"ofi+tcp", "ofi+verbs"},
+			expChanged: true,
+		},
+		"PCI device replaced": {
+			modify: func(t *testing.T, sysRoot, _ string) {
+				path := filepath.Join(sysRoot, "bus", "pci", "devices", "0000:18:00.0", "device")
+				if err := os.WriteFile(path, []byte("0x1021\n"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			providers:  []string{"ofi+tcp", "ofi+verbs"},
+			expChanged: true,
+		},
+		"PCI device removed": {
+			modify: func(t *testing.T, sysRoot, _ string) {
+				if err := os.RemoveAll(filepath.Join(sysRoot, "bus", "pci", "devices", "0000:18:00.0")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			providers:  []string{"ofi+tcp", "ofi+verbs"},
+			expChanged: true,
+		},
+		"no kernel version": {
+			modify: func(t *testing.T, _, procRoot string) {
+				if err := os.RemoveAll(procRoot); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expErr: errors.New("kernel version"),
+		},
+		"no PCI devices": {
+			modify: func(t *testing.T, sysRoot, _ string) {
+				if err := os.RemoveAll(sysRoot); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expErr: errors.New("PCI devices"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sysRoot, procRoot := setup(t)
+			if tc.modify != nil {
+				tc.modify(t, sysRoot, procRoot)
+			}
+
+			if tc.libs == "" {
+				tc.libs = "libs1"
+			}
+
+			fp, err := hardwareFingerprint(sysRoot, procRoot, tc.libs, tc.providers...)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expChanged, fp != baseline, "unexpected fingerprint change")
+		})
+	}
+}
+
+func TestAgent_fabricScanCache_wrap(t *testing.T) {
+	scanResult := func(numa uint) *hardware.FabricInterfaceSet {
+		return hardware.NewFabricInterfaceSet(
+			&hardware.FabricInterface{
+				Name:          "mlx5_0",
+				OSName:        "ib0",
+				NetInterfaces: common.NewStringSet("ib0"),
+				Providers: hardware.NewFabricProviderSet(
+					&hardware.FabricProvider{Name: "ofi+verbs", Priority: 0},
+					&hardware.FabricProvider{Name: "ofi+tcp", Priority: 1},
+				),
+				DeviceClass: hardware.Infiniband,
+				NUMANode:    numa,
+			},
+		)
+	}
+
+	for name, tc := range map[string]struct {
+		cached         *hardware.FabricInterfaceSet
+		cachedFP       string
+		corrupt        bool
+		fingerprintErr error
+		scanErr        error
+		expResult      *hardware.FabricInterfaceSet
+		expErr         error
+		expScans       int
+		expSaved       bool
+	}{
+		"no cache file": {
+			expResult: scanResult(1),
+			expScans:  1,
+			expSaved:  true,
+		},
+		"cache hit": {
+			cached:    scanResult(0),
+			cachedFP:  "fp",
+			expResult: scanResult(0),
+		},
+		"hardware changed": {
+			cached:    scanResult(0),
+			cachedFP:  "old",
+			expResult: scanResult(1),
+			expScans:  1,
+			expSaved:  true,
+		},
+		"corrupt cache file": {
+			corrupt:   true,
+			expResult: scanResult(1),
+			expScans:  1,
+			expSaved:  true,
+		},
+		"fingerprint fails": {
+			cached:         scanResult(0),
+			cachedFP:       "fp",
+			fingerprintErr: errors.New("no sysfs"),
+			expResult:      scanResult(1),
+			expScans:       1,
+		},
+		"scan fails": {
+			scanErr:  errors.New("scan failed"),
+			expErr:   errors.New("scan failed"),
+			expScans: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			fsc := newFabricScanCache(log, filepath.Join(dir, fabricScanCacheFile))
+			fsc.fingerprint = func(...string) (string, error) {
+				return "fp", tc.fingerprintErr
+			}
+			if tc.cached != nil {
+				if err := fsc.save(tc.cachedFP, tc.cached); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.corrupt {
+				if err := os.WriteFile(fsc.path, []byte("{bad"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			scans := 0
+			scan := fsc.wrap(func(context.Context, ...string) (*hardware.FabricInterfaceSet, error) {
+				scans++
+				if tc.scanErr != nil {
+					return nil, tc.scanErr
+				}
+				return scanResult(1), nil
+			})
+
+			result, err := scan(test.Context(t), "ofi+verbs")
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expScans, scans, "unexpected number of scans")
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.AllowUnexported(hardware.FabricInterfaceSet{}, hardware.FabricProviderSet{}),
+			}
+			if diff := cmp.Diff(tc.expResult, result, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expSaved {
+				saved, err := fsc.load("fp")
+				if err != nil {
+					t.Fatalf("expected scan to be cached: %s", err)
+				}
+				if diff := cmp.Diff(tc.expResult, saved, cmpOpts...); diff != "" {
+					t.Fatalf("unexpected cached result (-want, +got):\n%s\n", diff)
+				}
+			}
+
+			// The cache is only consulted for the first scan.
+			if _, err := scan(test.Context(t), "ofi+verbs"); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expScans+1, scans, "expected subsequent scan to bypass the cache")
+		})
+	}
+}
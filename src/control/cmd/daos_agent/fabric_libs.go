@@ -0,0 +1,175 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fabricLibCheckInterval is the minimum time between checks of the fabric
+// provider libraries for changes.
+const fabricLibCheckInterval = 10 * time.Second
+
+// fabricLibNames are the shared libraries used to scan the fabric providers.
+var fabricLibNames = []string{
+	"libfabric.so.1",
+	"libucp.so.0",
+	"libuct.so.0",
+	"libucs.so.0",
+}
+
+// defaultLibDirs are the directories searched for the fabric provider
+// libraries if they are not loaded and not found in LD_LIBRARY_PATH.
+var defaultLibDirs = []string{
+	"/usr/lib64",
+	"/lib64",
+	"/usr/lib",
+	"/lib",
+	"/usr/lib/x86_64-linux-gnu",
+	"/usr/lib/aarch64-linux-gnu",
+}
+
+// mappedLibs returns the paths of the shared libraries mapped into the
+// process, keyed by file name.
+func mappedLibs(procRoot string) map[string]string {
+	libs := make(map[string]string)
+
+	f, err := os.Open(filepath.Join(procRoot, "self", "maps"))
+	if err != nil {
+		return libs
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 || !strings.HasPrefix(fields[5], "/") {
+			continue
+		}
+		libs[filepath.Base(fields[5])] = fields[5]
+	}
+
+	return libs
+}
+
+// findLib returns the path of the named library, or an empty string if it
+// is not found. A library that is loaded by the process is preferred, as the
+// loaded version may be in a different location than the installed one.
+func findLib(name string, mapped map[string]string, libDirs []string) string {
+	for mappedName, path := range mapped {
+		if mappedName == name || strings.HasPrefix(mappedName, name+".") {
+			return path
+		}
+	}
+
+	for _, dir := range libDirs {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// fabricLibFingerprint generates a hash of the location, size and
+// modification time of the fabric provider libraries, which changes if any
+// of the libraries is installed, removed or upgraded.
+func fabricLibFingerprint(procRoot string, libDirs []string) string {
+	mapped := mappedLibs(procRoot)
+
+	h := sha256.New()
+	for _, name := range fabricLibNames {
+		path := findLib(name, mapped, libDirs)
+		if path == "" {
+			fmt.Fprintf(h, "%s=none\n", name)
+			continue
+		}
+
+		// Follow the symlink from the soname to the versioned library.
+		if realPath, err := filepath.EvalSymlinks(path); err == nil {
+			path = realPath
+		}
+		fi, err := os.Stat(path)
+		if err != nil {
+			fmt.Fprintf(h, "%s=%s missing\n", name, path)
+			continue
+		}
+		fmt.Fprintf(h, "%s=%s %d %d\n", name, path, fi.Size(), fi.ModTime().UnixNano())
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// searchLibDirs returns the directories to search for the fabric provider
+// libraries.
+func searchLibDirs() []string {
+	var dirs []string
+	for _, dir := range filepath.SplitList(os.Getenv("LD_LIBRARY_PATH")) {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return append(dirs, defaultLibDirs...)
+}
+
+// fabricLibWatcher detects changes to the fabric provider libraries, e.g. due
+// to a package upgrade, so that fabric information derived from the previous
+// libraries is not served to clients.
+type fabricLibWatcher struct {
+	sync.Mutex
+	fingerprint func() string
+	interval    time.Duration
+	last        string
+	lastCheck   time.Time
+}
+
+func newFabricLibWatcher() *fabricLibWatcher {
+	libDirs := searchLibDirs()
+	w := &fabricLibWatcher{
+		fingerprint: func() string {
+			return fabricLibFingerprint("/proc", libDirs)
+		},
+		interval: fabricLibCheckInterval,
+	}
+	w.last = w.fingerprint()
+	w.lastCheck = time.Now()
+
+	return w
+}
+
+// changed returns true if the libraries have changed since the last check.
+// Checks are made at most once per interval.
+func (w *fabricLibWatcher) changed() bool {
+	if w == nil {
+		return false
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	if time.Since(w.lastCheck) < w.interval {
+		return false
+	}
+	w.lastCheck = time.Now()
+
+	fp := w.fingerprint()
+	if fp == w.last {
+		return false
+	}
+	w.last = fp
+
+	return true
+}
@@ -0,0 +1,173 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestAgent_fabricLibFingerprint(t *testing.T) {
+	setup := func(t *testing.T) (string, string, string) {
+		t.Helper()
+
+		root, cleanup := test.CreateTestDir(t)
+		t.Cleanup(cleanup)
+
+		procRoot := filepath.Join(root, "proc")
+		libDir := filepath.Join(root, "lib64")
+		loadedDir := filepath.Join(root, "opt", "lib")
+		for _, dir := range []string{filepath.Join(procRoot, "self"), libDir, loadedDir} {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := os.WriteFile(filepath.Join(libDir, "libfabric.so.1.18.0"), []byte("v1.18"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink("libfabric.so.1.18.0", filepath.Join(libDir, "libfabric.so.1")); err != nil {
+			t.Fatal(err)
+		}
+
+		return procRoot, libDir, loadedDir
+	}
+
+	for name, tc := range map[string]struct {
+		modify     func(t *testing.T, procRoot, libDir, loadedDir string)
+		expChanged bool
+	}{
+		"unchanged": {},
+		"library upgraded": {
+			modify: func(t *testing.T, _, libDir, _ string) {
+				if err := os.WriteFile(filepath.Join(libDir, "libfabric.so.1.19.0"), []byte("v1.19"), 0755); err != nil {
+					t.Fatal(err)
+				}
+				link := filepath.Join(libDir, "libfabric.so.1")
+				if err := os.Remove(link); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Symlink("libfabric.so.1.19.0", link); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expChanged: true,
+		},
+		"library replaced in place": {
+			modify: func(t *testing.T, _, libDir, _ string) {
+				if err := os.WriteFile(filepath.Join(libDir, "libfabric.so.1.18.0"), []byte("v1.18-rebuilt"), 0755); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expChanged: true,
+		},
+		"library installed": {
+			modify: func(t *testing.T, _, libDir, _ string) {
+				if err := os.WriteFile(filepath.Join(libDir, "libucp.so.0"), []byte("ucx"), 0755); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expChanged: true,
+		},
+		"library removed": {
+			modify: func(t *testing.T, _, libDir, _ string) {
+				if err := os.Remove(filepath.Join(libDir, "libfabric.so.1")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expChanged: true,
+		},
+		"loaded library preferred": {
+			modify: func(t *testing.T, procRoot, _, loadedDir string) {
+				path := filepath.Join(loadedDir, "libfabric.so.1.20.0")
+				if err := os.WriteFile(path, []byte("v1.20"), 0755); err != nil {
+					t.Fatal(err)
+				}
+				maps := fmt.Sprintf("7f0000000000-7f0000001000 r-xp 00000000 fd:00 1234 %s\n", path)
+				if err := os.WriteFile(filepath.Join(procRoot, "self", "maps"), []byte(maps), 0644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expChanged: true,
+		},
+		"unrelated library loaded": {
+			modify: func(t *testing.T, procRoot, _, _ string) {
+				maps := "7f0000000000-7f0000001000 r-xp 00000000 fd:00 1234 /usr/lib64/libc.so.6\n" +
+					"7ffc00000000-7ffc00021000 rw-p 00000000 00:00 0 [stack]\n"
+				if err := os.WriteFile(filepath.Join(procRoot, "self", "maps"), []byte(maps), 0644); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			procRoot, libDir, loadedDir := setup(t)
+			baseline := fabricLibFingerprint(procRoot, []string{libDir})
+			if tc.modify != nil {
+				tc.modify(t, procRoot, libDir, loadedDir)
+			}
+
+			fp := fabricLibFingerprint(procRoot, []string{libDir})
+
+			test.AssertEqual(t, tc.expChanged, fp != baseline, "unexpected fingerprint change")
+		})
+	}
+}
+
+func TestAgent_fabricLibWatcher_changed(t *testing.T) {
+	for name, tc := range map[string]struct {
+		watcher    *fabricLibWatcher
+		expChanged bool
+		expLast    string
+	}{
+		"nil": {},
+		"unchanged": {
+			watcher: &fabricLibWatcher{
+				fingerprint: func() string { return "old" },
+				last:        "old",
+			},
+			expLast: "old",
+		},
+		"changed": {
+			watcher: &fabricLibWatcher{
+				fingerprint: func() string { return "new" },
+				last:        "old",
+			},
+			expChanged: true,
+			expLast:    "new",
+		},
+		"checked recently": {
+			watcher: &fabricLibWatcher{
+				fingerprint: func() string { return "new" },
+				interval:    time.Hour,
+				last:        "old",
+				lastCheck:   time.Now(),
+			},
+			expLast: "old",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expChanged, tc.watcher.changed(), "")
+
+			if tc.watcher == nil {
+				return
+			}
+			test.AssertEqual(t, tc.expLast, tc.watcher.last, "")
+
+			if tc.expChanged {
+				// A subsequent check should not report the same change again.
+				tc.watcher.lastCheck = time.Time{}
+				test.AssertEqual(t, false, tc.watcher.changed(), "")
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+const (
+	fabricMapSourceConfig = "config"
+	fabricMapSourceScan   = "scan"
+)
+
+type (
+	// fabricMapInterface describes a fabric interface in the NUMA fabric map, and
+	// the decisions made about it by the interface selection logic.
+	fabricMapInterface struct {
+		Name        string   `json:"name"`
+		Domain      string   `json:"domain"`
+		DeviceClass string   `json:"device_class"`
+		Providers   []string `json:"providers"`
+		GPUAffine   bool     `json:"gpu_affine"`
+		Down        bool     `json:"down"`
+		Excluded    string   `json:"excluded,omitempty"`
+	}
+
+	// fabricMapNUMANode describes the fabric interfaces on a NUMA node.
+	fabricMapNUMANode struct {
+		NUMANode   int                   `json:"numa_node"`
+		Interfaces []*fabricMapInterface `json:"interfaces"`
+	}
+
+	// fabricMap describes the NUMA fabric map used by the agent to select fabric
+	// interfaces for clients.
+	fabricMap struct {
+		Source            string                 `json:"source"`
+		FallbackPolicy    FabricFallbackPolicy   `json:"fallback_policy"`
+		IncludeInterfaces []string               `json:"include_interfaces,omitempty"`
+		ExcludeInterfaces []string               `json:"exclude_interfaces,omitempty"`
+		NUMADistances     hardware.NUMADistances `json:"numa_distances,omitempty"`
+		NUMANodes         []*fabricMapNUMANode   `json:"numa_nodes"`
+	}
+)
+
+func fabricMapDeviceClass(fi *FabricInterface) string {
+	if fi.NetDevClass == FabricDevClassManual {
+		return "MANUAL"
+	}
+	return fi.NetDevClass.String()
+}
+
+// fabricMapExclusion returns the reason the interface is never selected for a
+// client, if any.
+func (n *NUMAFabric) fabricMapExclusion(fi *FabricInterface) string {
+	if n.ifaceFilter.ShouldIgnore(fi.Name) {
+		return "ignored by interface filter"
+	}
+	if err := n.validateDevice(fi); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// fabricMap generates a description of the NUMA fabric map.
+func (n *NUMAFabric) fabricMap(source string) *fabricMap {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	fm := &fabricMap{
+		Source:         source,
+		FallbackPolicy: n.fallback,
+		NUMADistances:  n.numaDistances,
+		NUMANodes:      []*fabricMapNUMANode{},
+	}
+	if fm.FallbackPolicy == "" {
+		fm.FallbackPolicy = FabricFallbackAny
+	}
+	if n.ifaceFilter != nil && n.ifaceFilter.deviceSet != nil {
+		if n.ifaceFilter.mode == filterModeExclude {
+			fm.ExcludeInterfaces = n.ifaceFilter.deviceSet.ToSlice()
+		} else {
+			fm.IncludeInterfaces = n.ifaceFilter.deviceSet.ToSlice()
+		}
+	}
+
+	for _, numa := range n.getNUMANodes() {
+		node := &fabricMapNUMANode{
+			NUMANode:   numa,
+			Interfaces: []*fabricMapInterface{},
+		}
+		for _, fi := range n.numaMap[numa] {
+			providers := []string{}
+			if fi.hw != nil {
+				providers = fi.Providers()
+			}
+			node.Interfaces = append(node.Interfaces, &fabricMapInterface{
+				Name:        fi.Name,
+				Domain:      fi.Domain,
+				DeviceClass: fabricMapDeviceClass(fi),
+				Providers:   providers,
+				GPUAffine:   n.gpuAffine.Has(fi.Name),
+				Down:        n.unhealthy.Has(fi.Name),
+				Excluded:    n.fabricMapExclusion(fi),
+			})
+		}
+		sort.Slice(node.Interfaces, func(i, j int) bool {
+			if node.Interfaces[i].Name == node.Interfaces[j].Name {
+				return node.Interfaces[i].Domain < node.Interfaces[j].Domain
+			}
+			return node.Interfaces[i].Name < node.Interfaces[j].Name
+		})
+		fm.NUMANodes = append(fm.NUMANodes, node)
+	}
+
+	return fm
+}
+
+// printFabricMap writes a human-readable description of the NUMA fabric map.
+func printFabricMap(out io.Writer, fm *fabricMap) {
+	fmt.Fprintf(out, "Source: %s\n", fm.Source)
+	fmt.Fprintf(out, "Fallback policy: %s\n", fm.FallbackPolicy)
+	if len(fm.IncludeInterfaces) > 0 {
+		fmt.Fprintf(out, "Include interfaces: %s\n", strings.Join(fm.IncludeInterfaces, ", "))
+	}
+	if len(fm.ExcludeInterfaces) > 0 {
+		fmt.Fprintf(out, "Exclude interfaces: %s\n", strings.Join(fm.ExcludeInterfaces, ", "))
+	}
+
+	if len(fm.NUMANodes) == 0 {
+		fmt.Fprintln(out, "No fabric interfaces found")
+		return
+	}
+	fmt.Fprintln(out)
+
+	numaTitle := "NUMA"
+	ifaceTitle := "Interface"
+	domainTitle := "Domain"
+	classTitle := "Class"
+	provTitle := "Providers"
+	statusTitle := "Status"
+	formatter := txtfmt.NewTableFormatter(numaTitle, ifaceTitle, domainTitle, classTitle,
+		provTitle, statusTitle)
+
+	var table []txtfmt.TableRow
+	for _, node := range fm.NUMANodes {
+		for _, fi := range node.Interfaces {
+			status := []string{}
+			if fi.Excluded != "" {
+				status = append(status, "excluded: "+fi.Excluded)
+			}
+			if fi.Down {
+				status = append(status, "down")
+			}
+			if fi.GPUAffine {
+				status = append(status, "GPU affine")
+			}
+			if len(status) == 0 {
+				status = append(status, "ok")
+			}
+
+			providers := "-"
+			if len(fi.Providers) > 0 {
+				providers = strings.Join(fi.Providers, ", ")
+			}
+
+			table = append(table, txtfmt.TableRow{
+				numaTitle:   fmt.Sprintf("%d", node.NUMANode),
+				ifaceTitle:  fi.Name,
+				domainTitle: fi.Domain,
+				classTitle:  fi.DeviceClass,
+				provTitle:   providers,
+				statusTitle: strings.Join(status, "; "),
+			})
+		}
+	}
+
+	fmt.Fprint(out, formatter.Format(table))
+}
+
+// fabricMapCmd shows the NUMA fabric map that the agent builds from its
+// configuration and the local fabric scan to select interfaces for clients.
+type fabricMapCmd struct {
+	configCmd
+	cmdutil.LogCmd
+	cmdutil.JSONOutputCmd
+	Providers []string `short:"p" long:"provider" description:"Include the given OFI provider in the fabric scan, in addition to those in provider_priority (may be repeated)"`
+}
+
+func (cmd *fabricMapCmd) Execute(_ []string) error {
+	ctx := cmd.MustLogCtx()
+	numaDistGetter := getNUMADistanceProvider(cmd.Logger, cmd.cfg)
+	accelTopoGetter := topology.DefaultAcceleratorTopologyProvider(cmd.Logger)
+
+	var nf *NUMAFabric
+	source := fabricMapSourceConfig
+	if len(cmd.cfg.FabricInterfaces) > 0 {
+		nf = configuredNUMAFabric(ctx, cmd.Logger, cmd.cfg, numaDistGetter, accelTopoGetter)
+	} else {
+		source = fabricMapSourceScan
+		provs := append(append([]string{}, cmd.cfg.ProviderPriority...), cmd.Providers...)
+		fis, err := getFabricScanner(cmd.Logger, cmd.cfg)(ctx, provs...)
+		if err != nil {
+			return err
+		}
+		nf = scannedNUMAFabric(ctx, cmd.Logger, cmd.cfg, fis, numaDistGetter, accelTopoGetter)
+	}
+
+	devStateGetter := network.DefaultNetDevStateProvider(cmd.Logger)
+	for name := range nf.InterfaceNUMANodes() {
+		state, err := devStateGetter.GetNetDevState(name)
+		if err != nil {
+			cmd.Debugf("unable to get state of fabric interface %s: %s", name, err)
+			continue
+		}
+		nf.SetInterfaceHealth(name, state != hardware.NetDevStateDown)
+	}
+
+	fm := nf.fabricMap(source)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(fm, nil)
+	}
+
+	var bld strings.Builder
+	printFabricMap(&bld, fm)
+	cmd.Info(bld.String())
+
+	return nil
+}
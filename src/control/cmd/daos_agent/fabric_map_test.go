@@ -0,0 +1,245 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_NUMAFabric_fabricMap(t *testing.T) {
+	testFI := func(name string) *FabricInterface {
+		return fabricInterfacesFromHardware(&hardware.FabricInterface{
+			NetInterfaces: common.NewStringSet(name),
+			Name:          name,
+			DeviceClass:   hardware.Ether,
+			Providers:     testFabricProviderSet("ofi+tcp", "ofi+verbs"),
+		})[0]
+	}
+	noAddrs := func(name string) (addrFI, error) {
+		if name == "e2" {
+			return nil, errors.New("no such interface")
+		}
+		return getMockNetInterfaceSuccess(name)
+	}
+
+	for name, tc := range map[string]struct {
+		nf     func(logging.Logger) *NUMAFabric
+		source string
+		expMap *fabricMap
+	}{
+		"empty": {
+			nf:     newNUMAFabric,
+			source: fabricMapSourceScan,
+			expMap: &fabricMap{
+				Source:         fabricMapSourceScan,
+				FallbackPolicy: FabricFallbackAny,
+				NUMANodes:      []*fabricMapNUMANode{},
+			},
+		},
+		"scanned with selection decisions": {
+			nf: func(log logging.Logger) *NUMAFabric {
+				nf := newNUMAFabric(log).
+					WithDeviceFilter(newDeviceFilter(common.NewStringSet("e1"), filterModeExclude)).
+					WithFallbackPolicy(FabricFallbackNearestNUMA, hardware.NUMADistances{
+						0: {0: 10, 1: 20},
+						1: {0: 20, 1: 10},
+					}).
+					WithGPUAffinity(common.NewStringSet("e3"))
+				nf.numaMap[0] = []*FabricInterface{testFI("e1"), testFI("e0")}
+				nf.numaMap[1] = []*FabricInterface{testFI("e3"), testFI("e2")}
+				nf.SetInterfaceHealth("e3", false)
+				nf.getAddrInterface = noAddrs
+				return nf
+			},
+			source: fabricMapSourceScan,
+			expMap: &fabricMap{
+				Source:            fabricMapSourceScan,
+				FallbackPolicy:    FabricFallbackNearestNUMA,
+				ExcludeInterfaces: []string{"e1"},
+				NUMADistances: hardware.NUMADistances{
+					0: {0: 10, 1: 20},
+					1: {0: 20, 1: 10},
+				},
+				NUMANodes: []*fabricMapNUMANode{
+					{
+						NUMANode: 0,
+						Interfaces: []*fabricMapInterface{
+							{
+								Name:        "e0",
+								Domain:      "e0",
+								DeviceClass: "ETHER",
+								Providers:   []string{"ofi+tcp", "ofi+verbs"},
+							},
+							{
+								Name:        "e1",
+								Domain:      "e1",
+								DeviceClass: "ETHER",
+								Providers:   []string{"ofi+tcp", "ofi+verbs"},
+								Excluded:    "ignored by interface filter",
+							},
+						},
+					},
+					{
+						NUMANode: 1,
+						Interfaces: []*fabricMapInterface{
+							{
+								Name:        "e2",
+								Domain:      "e2",
+								DeviceClass: "ETHER",
+								Providers:   []string{"ofi+tcp", "ofi+verbs"},
+								Excluded:    "no such interface",
+							},
+							{
+								Name:        "e3",
+								Domain:      "e3",
+								DeviceClass: "ETHER",
+								Providers:   []string{"ofi+tcp", "ofi+verbs"},
+								GPUAffine:   true,
+								Down:        true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"from config": {
+			nf: func(log logging.Logger) *NUMAFabric {
+				nf := NUMAFabricFromConfig(log, []*NUMAFabricConfig{
+					{
+						NUMANode: 1,
+						Interfaces: []*FabricInterfaceConfig{
+							{Interface: "ib1", Domain: "mlx5_1"},
+						},
+					},
+				}).WithFallbackPolicy(FabricFallbackFail, nil)
+				nf.getAddrInterface = getMockNetInterfaceSuccess
+				return nf
+			},
+			source: fabricMapSourceConfig,
+			expMap: &fabricMap{
+				Source:         fabricMapSourceConfig,
+				FallbackPolicy: FabricFallbackFail,
+				NUMANodes: []*fabricMapNUMANode{
+					{
+						NUMANode: 1,
+						Interfaces: []*fabricMapInterface{
+							{
+								Name:        "ib1",
+								Domain:      "mlx5_1",
+								DeviceClass: "MANUAL",
+								Providers:   []string{},
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			fm := tc.nf(log).fabricMap(tc.source)
+
+			if diff := cmp.Diff(tc.expMap, fm); diff != "" {
+				t.Fatalf("unexpected fabric map (-want, +got):\n%s", diff)
+			}
+
+			if _, err := json.Marshal(fm); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestAgent_printFabricMap(t *testing.T) {
+	for name, tc := range map[string]struct {
+		fm     *fabricMap
+		expOut string
+	}{
+		"no interfaces": {
+			fm: &fabricMap{
+				Source:         fabricMapSourceScan,
+				FallbackPolicy: FabricFallbackAny,
+			},
+			expOut: `
+Source: scan
+Fallback policy: any
+No fabric interfaces found
+`,
+		},
+		"interfaces": {
+			fm: &fabricMap{
+				Source:            fabricMapSourceScan,
+				FallbackPolicy:    FabricFallbackNearestNUMA,
+				IncludeInterfaces: []string{"e0", "e3"},
+				NUMANodes: []*fabricMapNUMANode{
+					{
+						NUMANode: 0,
+						Interfaces: []*fabricMapInterface{
+							{
+								Name:        "e0",
+								Domain:      "e0",
+								DeviceClass: "ETHER",
+								Providers:   []string{"ofi+tcp"},
+							},
+							{
+								Name:        "e1",
+								Domain:      "e1",
+								DeviceClass: "ETHER",
+								Providers:   []string{"ofi+tcp"},
+								Excluded:    "ignored by interface filter",
+							},
+						},
+					},
+					{
+						NUMANode: 1,
+						Interfaces: []*fabricMapInterface{
+							{
+								Name:        "e3",
+								Domain:      "e3",
+								DeviceClass: "MANUAL",
+								GPUAffine:   true,
+								Down:        true,
+							},
+						},
+					},
+				},
+			},
+			expOut: `
+Source: scan
+Fallback policy: nearest-numa
+Include interfaces: e0, e3
+
+NUMA Interface Domain Class  Providers Status                                
+---- --------- ------ -----  --------- ------                                
+0    e0        e0     ETHER  ofi+tcp   ok                                    
+0    e1        e1     ETHER  ofi+tcp   excluded: ignored by interface filter 
+1    e3        e3     MANUAL -         down; GPU affine                      
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			printFabricMap(&bld, tc.fm)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
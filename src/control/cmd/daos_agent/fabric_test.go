@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -406,6 +407,102 @@ func TestAgent_NUMAFabric_GetDevice(t *testing.T) {
 				},
 			},
 		},
+		"only visible devices": {
+			nf: &NUMAFabric{
+				numaMap: map[int][]*FabricInterface{
+					0: {
+						fabricInterfacesFromHardware(&hardware.FabricInterface{
+							NetInterfaces: common.NewStringSet("t1"),
+							Name:          "t1",
+							DeviceClass:   hardware.Ether,
+							Providers:     testFabricProviderSet("ofi+sockets"),
+						})[0],
+						fabricInterfacesFromHardware(&hardware.FabricInterface{
+							NetInterfaces: common.NewStringSet("t2"),
+							Name:          "t2",
+							DeviceClass:   hardware.Ether,
+							Providers:     testFabricProviderSet("ofi+sockets"),
+						})[0],
+					},
+				},
+			},
+			params: &FabricIfaceParams{
+				Provider: "ofi+sockets",
+				DevClass: hardware.Ether,
+				Visible:  common.NewStringSet("lo", "t2"),
+			},
+			expResults: []*FabricInterface{
+				{
+					Name:        "t2",
+					Domain:      "t2",
+					NetDevClass: hardware.Ether,
+				},
+				{
+					Name:        "t2",
+					Domain:      "t2",
+					NetDevClass: hardware.Ether,
+				},
+				{
+					Name:        "t2",
+					Domain:      "t2",
+					NetDevClass: hardware.Ether,
+				},
+			},
+		},
+		"visible device on other NUMA node": {
+			nf: &NUMAFabric{
+				numaMap: map[int][]*FabricInterface{
+					0: fabricInterfacesFromHardware(&hardware.FabricInterface{
+						NetInterfaces: common.NewStringSet("t1"),
+						Name:          "t1",
+						DeviceClass:   hardware.Ether,
+						Providers:     testFabricProviderSet("ofi+sockets"),
+					}),
+					1: fabricInterfacesFromHardware(&hardware.FabricInterface{
+						NetInterfaces: common.NewStringSet("t2"),
+						Name:          "t2",
+						DeviceClass:   hardware.Ether,
+						Providers:     testFabricProviderSet("ofi+sockets"),
+					}),
+				},
+			},
+			params: &FabricIfaceParams{
+				NUMANode: 1,
+				Provider: "ofi+sockets",
+				DevClass: hardware.Ether,
+				Visible:  common.NewStringSet("t1"),
+			},
+			expResults: []*FabricInterface{
+				{
+					Name:        "t1",
+					Domain:      "t1",
+					NetDevClass: hardware.Ether,
+				},
+				{
+					Name:        "t1",
+					Domain:      "t1",
+					NetDevClass: hardware.Ether,
+				},
+			},
+		},
+		"no visible devices": {
+			nf: &NUMAFabric{
+				numaMap: map[int][]*FabricInterface{
+					0: fabricInterfacesFromHardware(&hardware.FabricInterface{
+						NetInterfaces: common.NewStringSet("t1"),
+						Name:          "t1",
+						DeviceClass:   hardware.Ether,
+						Providers:     testFabricProviderSet("ofi+sockets"),
+					}),
+				},
+			},
+			params: &FabricIfaceParams{
+				Provider: "ofi+sockets",
+				DevClass: hardware.Ether,
+				Visible:  common.NewStringSet("lo", "eth0"),
+			},
+			expErr: errors.New("no suitable fabric interface"),
+		},
 		"nothing on NUMA node": {
 			nf: &NUMAFabric{
 				numaMap: map[int][]*FabricInterface{
@@ -878,6 +975,322 @@ func TestAgent_NUMAFabric_GetDevice(t *testing.T) {
 	}
 }
 
+func TestAgent_NUMAFabric_GetDevice_Fallback(t *testing.T) {
+	testFI := func(name string, devClass hardware.NetDevClass) *FabricInterface {
+		return fabricInterfacesFromHardware(&hardware.FabricInterface{
+			NetInterfaces: common.NewStringSet(name),
+			Name:          name,
+			DeviceClass:   devClass,
+			Providers:     testFabricProviderSet("ofi+tcp"),
+		})[0]
+	}
+	testNUMAFabric := func() *NUMAFabric {
+		return &NUMAFabric{
+			numaMap: map[int][]*FabricInterface{
+				0: {testFI("ib0", hardware.Infiniband)},
+				1: {testFI("e1", hardware.Ether)},
+				2: {testFI("e2", hardware.Ether)},
+				3: {testFI("e3", hardware.Ether)},
+			},
+		}
+	}
+	expFI := func(name string) *FabricInterface {
+		return &FabricInterface{
+			Name:        name,
+			Domain:      name,
+			NetDevClass: hardware.Ether,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		policy     FabricFallbackPolicy
+		distances  hardware.NUMADistances
+		numaNode   int
+		visible    common.StringSet
+		expErr     error
+		expResults []*FabricInterface
+	}{
+		"default policy": {
+			expResults: []*FabricInterface{expFI("e1"), expFI("e2"), expFI("e3")},
+		},
+		"any": {
+			policy:     FabricFallbackAny,
+			expResults: []*FabricInterface{expFI("e1"), expFI("e2"), expFI("e3")},
+		},
+		"fail": {
+			policy: FabricFallbackFail,
+			expErr: errors.New("NUMA node 0 (fabric_fallback: fail)"),
+		},
+		"fail; local device": {
+			policy:     FabricFallbackFail,
+			numaNode:   2,
+			expResults: []*FabricInterface{expFI("e2"), expFI("e2"), expFI("e2")},
+		},
+		"nearest-numa; local device": {
+			policy:     FabricFallbackNearestNUMA,
+			numaNode:   3,
+			expResults: []*FabricInterface{expFI("e3"), expFI("e3"), expFI("e3")},
+		},
+		"nearest-numa; distances": {
+			policy: FabricFallbackNearestNUMA,
+			distances: hardware.NUMADistances{
+				0: {0: 10, 1: 32, 2: 21, 3: 21},
+			},
+			expResults: []*FabricInterface{expFI("e2"), expFI("e2"), expFI("e2")},
+		},
+		"nearest-numa; no distances": {
+			policy:     FabricFallbackNearestNUMA,
+			expResults: []*FabricInterface{expFI("e1"), expFI("e1"), expFI("e1")},
+		},
+		"nearest-numa; unknown distances last": {
+			policy: FabricFallbackNearestNUMA,
+			distances: hardware.NUMADistances{
+				0: {0: 10, 3: 21},
+			},
+			expResults: []*FabricInterface{expFI("e3"), expFI("e3"), expFI("e3")},
+		},
+		"nearest-numa; nearest node has no suitable device": {
+			policy: FabricFallbackNearestNUMA,
+			distances: hardware.NUMADistances{
+				0: {0: 10, 1: 32, 2: 21, 3: 40},
+			},
+			visible:    common.NewStringSet("ib0", "e1", "e3"),
+			expResults: []*FabricInterface{expFI("e1"), expFI("e1"), expFI("e1")},
+		},
+		"nearest-numa; no suitable device": {
+			policy:  FabricFallbackNearestNUMA,
+			visible: common.NewStringSet("ib0"),
+			expErr:  errors.New("no suitable fabric interface"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			nf := testNUMAFabric()
+			nf.log = log
+			nf.getAddrInterface = getMockNetInterfaceSuccess
+			nf = nf.WithFallbackPolicy(tc.policy, tc.distances)
+
+			params := &FabricIfaceParams{
+				NUMANode: tc.numaNode,
+				Provider: "ofi+tcp",
+				DevClass: hardware.Ether,
+				Visible:  tc.visible,
+			}
+
+			var results []*FabricInterface
+			for i := 0; i < 3; i++ {
+				result, err := nf.GetDevice(params)
+				test.CmpErr(t, tc.expErr, err)
+				if tc.expErr != nil {
+					return
+				}
+				results = append(results, result)
+			}
+
+			if diff := cmp.Diff(tc.expResults, results, fiCmpOpt); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_NUMAFabric_GetDevice_Unhealthy(t *testing.T) {
+	testFI := func(name string) *FabricInterface {
+		return fabricInterfacesFromHardware(&hardware.FabricInterface{
+			NetInterfaces: common.NewStringSet(name),
+			Name:          name,
+			DeviceClass:   hardware.Ether,
+			Providers:     testFabricProviderSet("ofi+tcp"),
+		})[0]
+	}
+	expFI := func(name string) *FabricInterface {
+		return &FabricInterface{
+			Name:        name,
+			Domain:      name,
+			NetDevClass: hardware.Ether,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		down       []string
+		up         []string
+		expChanged []bool
+		expResults []*FabricInterface
+	}{
+		"all healthy": {
+			expResults: []*FabricInterface{expFI("e0"), expFI("e1"), expFI("e0")},
+		},
+		"local device down": {
+			down:       []string{"e1"},
+			expChanged: []bool{true},
+			expResults: []*FabricInterface{expFI("e0"), expFI("e0"), expFI("e0")},
+		},
+		"all local devices down": {
+			down:       []string{"e0", "e1"},
+			expChanged: []bool{true, true},
+			expResults: []*FabricInterface{expFI("e2"), expFI("e2"), expFI("e2")},
+		},
+		"all devices down": {
+			down:       []string{"e0", "e1", "e2"},
+			expChanged: []bool{true, true, true},
+			expResults: []*FabricInterface{expFI("e0"), expFI("e1"), expFI("e0")},
+		},
+		"device down twice": {
+			down:       []string{"e1", "e1"},
+			expChanged: []bool{true, false},
+			expResults: []*FabricInterface{expFI("e0"), expFI("e0"), expFI("e0")},
+		},
+		"device back up": {
+			down:       []string{"e1"},
+			up:         []string{"e1", "e1"},
+			expChanged: []bool{true, true, false},
+			expResults: []*FabricInterface{expFI("e0"), expFI("e1"), expFI("e0")},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			nf := &NUMAFabric{
+				log: log,
+				numaMap: map[int][]*FabricInterface{
+					0: {testFI("e0"), testFI("e1")},
+					1: {testFI("e2")},
+				},
+				getAddrInterface: getMockNetInterfaceSuccess,
+			}
+
+			var changed []bool
+			for _, iface := range tc.down {
+				changed = append(changed, nf.SetInterfaceHealth(iface, false))
+			}
+			for _, iface := range tc.up {
+				changed = append(changed, nf.SetInterfaceHealth(iface, true))
+			}
+			test.CmpAny(t, "health changed", tc.expChanged, changed)
+
+			params := &FabricIfaceParams{
+				Provider: "ofi+tcp",
+				DevClass: hardware.Ether,
+			}
+
+			var results []*FabricInterface
+			for i := 0; i < 3; i++ {
+				result, err := nf.GetDevice(params)
+				if err != nil {
+					t.Fatal(err)
+				}
+				results = append(results, result)
+			}
+
+			if diff := cmp.Diff(tc.expResults, results, fiCmpOpt); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_NUMAFabric_GetDevice_GPUAffinity(t *testing.T) {
+	testFI := func(name string) *FabricInterface {
+		return fabricInterfacesFromHardware(&hardware.FabricInterface{
+			NetInterfaces: common.NewStringSet(name),
+			Name:          name,
+			DeviceClass:   hardware.Ether,
+			Providers:     testFabricProviderSet("ofi+tcp"),
+		})[0]
+	}
+	expFI := func(name string) *FabricInterface {
+		return &FabricInterface{
+			Name:        name,
+			Domain:      name,
+			NetDevClass: hardware.Ether,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		affine     common.StringSet
+		visible    common.StringSet
+		expResults []*FabricInterface
+	}{
+		"no affinity": {
+			expResults: []*FabricInterface{expFI("e0"), expFI("e1"), expFI("e2")},
+		},
+		"single affine device": {
+			affine:     common.NewStringSet("e1"),
+			expResults: []*FabricInterface{expFI("e1"), expFI("e1"), expFI("e1")},
+		},
+		"multiple affine devices": {
+			affine:     common.NewStringSet("e0", "e2"),
+			expResults: []*FabricInterface{expFI("e0"), expFI("e2"), expFI("e0")},
+		},
+		"affine device not visible": {
+			affine:     common.NewStringSet("e1"),
+			visible:    common.NewStringSet("e0", "e2"),
+			expResults: []*FabricInterface{expFI("e0"), expFI("e2"), expFI("e0")},
+		},
+		"affine device on other NUMA node": {
+			affine:     common.NewStringSet("e3"),
+			expResults: []*FabricInterface{expFI("e0"), expFI("e1"), expFI("e2")},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			nf := &NUMAFabric{
+				log: log,
+				numaMap: map[int][]*FabricInterface{
+					0: {testFI("e0"), testFI("e1"), testFI("e2")},
+					1: {testFI("e3")},
+				},
+				getAddrInterface: getMockNetInterfaceSuccess,
+			}
+			nf = nf.WithGPUAffinity(tc.affine)
+
+			params := &FabricIfaceParams{
+				Provider: "ofi+tcp",
+				DevClass: hardware.Ether,
+				Visible:  tc.visible,
+			}
+
+			var results []*FabricInterface
+			for i := 0; i < 3; i++ {
+				result, err := nf.GetDevice(params)
+				if err != nil {
+					t.Fatal(err)
+				}
+				results = append(results, result)
+			}
+
+			if diff := cmp.Diff(tc.expResults, results, fiCmpOpt); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_NUMAFabric_InterfaceNUMANodes(t *testing.T) {
+	nf := NUMAFabricFromConfig(nil, []*NUMAFabricConfig{
+		{
+			NUMANode: 0,
+			Interfaces: []*FabricInterfaceConfig{
+				{Interface: "ib0", Domain: "mlx5_0"},
+			},
+		},
+		{
+			NUMANode: 1,
+			Interfaces: []*FabricInterfaceConfig{
+				{Interface: "ib1", Domain: "mlx5_1"},
+				{Interface: "ib1", Domain: "mlx5_2"},
+			},
+		},
+	})
+
+	test.CmpAny(t, "interface NUMA nodes", map[string]int{"ib0": 0, "ib1": 1}, nf.InterfaceNUMANodes())
+}
+
 func TestAgent_NUMAFabric_Find(t *testing.T) {
 	for name, tc := range map[string]struct {
 		nf        *NUMAFabric
@@ -0,0 +1,104 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security/auth"
+)
+
+type (
+	// fabricLister lists the fabric interfaces available to local clients.
+	fabricLister interface {
+		FabricInterfaceNames(ctx context.Context) ([]string, error)
+	}
+
+	// clientCounter counts the local client processes with open pool
+	// handles.
+	clientCounter interface {
+		NumClients(ctx context.Context) int
+	}
+
+	// heartbeatSender periodically notifies the MS that the agent on this
+	// machine is running, along with an inventory of the machine. The
+	// heartbeats make the machine visible in "dmg system list-clients" and
+	// allow pool handles left open by machines that have gone away to be
+	// found and evicted with "dmg system cleanup --all-stale".
+	heartbeatSender struct {
+		log            logging.Logger
+		sys            string
+		client         control.UnaryInvoker
+		fabric         fabricLister  // optional
+		clients        clientCounter // optional
+		getMachineName func() (string, error)
+	}
+)
+
+func newHeartbeatSender(log logging.Logger, sys string, client control.UnaryInvoker, fabric fabricLister, clients clientCounter) *heartbeatSender {
+	return &heartbeatSender{
+		log:            log,
+		sys:            sys,
+		client:         client,
+		fabric:         fabric,
+		clients:        clients,
+		getMachineName: auth.GetMachineName,
+	}
+}
+
+// send sends a single heartbeat to the MS.
+func (h *heartbeatSender) send(ctx context.Context, interval time.Duration) error {
+	machine, err := h.getMachineName()
+	if err != nil {
+		return errors.Wrap(err, "hostname lookup")
+	}
+
+	req := &control.AgentHeartbeatReq{
+		Machine:  machine,
+		Interval: interval,
+		Version:  build.DaosVersion,
+	}
+	req.SetSystem(h.sys)
+
+	if h.fabric != nil {
+		// The inventory is informational, so a heartbeat is still sent
+		// without it.
+		req.FabricInterfaces, err = h.fabric.FabricInterfaceNames(ctx)
+		if err != nil {
+			h.log.Debugf("unable to list fabric interfaces for heartbeat: %s", err)
+		}
+	}
+	if h.clients != nil {
+		req.ClientCount = uint32(h.clients.NumClients(ctx))
+	}
+
+	return control.AgentHeartbeat(ctx, h.client, req)
+}
+
+// run sends heartbeats at the given interval until the context is canceled.
+func (h *heartbeatSender) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := h.send(ctx, interval); err != nil {
+			h.log.Errorf("failed to send heartbeat: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
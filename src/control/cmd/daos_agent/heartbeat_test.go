@@ -0,0 +1,110 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockFabricLister struct {
+	names []string
+	err   error
+}
+
+func (m *mockFabricLister) FabricInterfaceNames(_ context.Context) ([]string, error) {
+	return m.names, m.err
+}
+
+type mockClientCounter int
+
+func (m mockClientCounter) NumClients(_ context.Context) int {
+	return int(m)
+}
+
+func TestAgent_heartbeatSender_send(t *testing.T) {
+	for name, tc := range map[string]struct {
+		machine         string
+		machineErr      error
+		fabric          fabricLister
+		clients         clientCounter
+		respErr         error
+		expErr          error
+		expSent         bool
+		expFabricIfaces []string
+		expClientCount  uint32
+	}{
+		"hostname lookup fails": {
+			machineErr: errors.New("mock hostname"),
+			expErr:     errors.New("mock hostname"),
+		},
+		"request fails": {
+			machine: "client1",
+			respErr: errors.New("mock failure"),
+			expErr:  errors.New("mock failure"),
+			expSent: true,
+		},
+		"no inventory": {
+			machine: "client1",
+			expSent: true,
+		},
+		"fabric listing fails": {
+			machine:        "client1",
+			fabric:         &mockFabricLister{err: errors.New("mock fabric")},
+			clients:        mockClientCounter(2),
+			expSent:        true,
+			expClientCount: 2,
+		},
+		"success": {
+			machine:         "client1",
+			fabric:          &mockFabricLister{names: []string{"ib0", "ib1"}},
+			clients:         mockClientCounter(3),
+			expSent:         true,
+			expFabricIfaces: []string{"ib0", "ib1"},
+			expClientCount:  3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", tc.respErr, &mgmtpb.DaosResp{}),
+			})
+
+			h := newHeartbeatSender(log, "test_sys", mi, tc.fabric, tc.clients)
+			h.getMachineName = func() (string, error) {
+				return tc.machine, tc.machineErr
+			}
+
+			err := h.send(test.Context(t), time.Minute)
+			test.CmpErr(t, tc.expErr, err)
+
+			if !tc.expSent {
+				test.AssertEqual(t, 0, len(mi.SentReqs), "unexpected request sent")
+				return
+			}
+			test.AssertEqual(t, 1, len(mi.SentReqs), "unexpected number of requests")
+			req, ok := mi.SentReqs[0].(*control.AgentHeartbeatReq)
+			test.AssertTrue(t, ok, "unexpected request type")
+			test.AssertEqual(t, tc.machine, req.Machine, "unexpected machine")
+			test.AssertEqual(t, time.Minute, req.Interval, "unexpected interval")
+			test.AssertEqual(t, build.DaosVersion, req.Version, "unexpected version")
+			test.CmpAny(t, "fabric interfaces", tc.expFabricIfaces, req.FabricInterfaces)
+			test.AssertEqual(t, tc.expClientCount, req.ClientCount, "unexpected client count")
+		})
+	}
+}
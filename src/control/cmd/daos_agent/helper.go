@@ -0,0 +1,41 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
+	"github.com/daos-stack/daos/src/control/lib/hardware/helper"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// The fabric scan and the hwloc probing may need capabilities that the agent
+// does not have when it runs under a strict MAC policy. If the agent is
+// configured to use the privileged helper, these requests are forwarded to
+// daos_agent_helper instead of being run in the agent process.
+
+func getFabricScanner(log logging.Logger, cfg *Config) hwScanFn {
+	if cfg.UsePrivilegedHelper {
+		return helper.NewForwarder(log).Scan
+	}
+	return network.DefaultFabricScanner(log).Scan
+}
+
+func getNUMADistanceProvider(log logging.Logger, cfg *Config) hardware.NUMADistanceProvider {
+	if cfg.UsePrivilegedHelper {
+		return helper.NewForwarder(log)
+	}
+	return topology.DefaultNUMADistanceProvider(log)
+}
+
+func getProcessNUMAProvider(log logging.Logger, cfg *Config) hardware.ProcessNUMAProvider {
+	if cfg.UsePrivilegedHelper {
+		return helper.NewForwarder(log)
+	}
+	return topology.DefaultProcessNUMAProvider(log)
+}
@@ -0,0 +1,46 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware/helper"
+	"github.com/daos-stack/daos/src/control/lib/hardware/hwloc"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_getHardwareProviders(t *testing.T) {
+	for name, tc := range map[string]struct {
+		usePrivilegedHelper bool
+	}{
+		"in process": {},
+		"privileged helper": {
+			usePrivilegedHelper: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := &Config{UsePrivilegedHelper: tc.usePrivilegedHelper}
+
+			test.AssertTrue(t, getFabricScanner(log, cfg) != nil, "nil fabric scanner")
+
+			_, isFwd := getNUMADistanceProvider(log, cfg).(*helper.Forwarder)
+			test.AssertEqual(t, tc.usePrivilegedHelper, isFwd, "unexpected NUMA distance provider")
+			_, isFwd = getProcessNUMAProvider(log, cfg).(*helper.Forwarder)
+			test.AssertEqual(t, tc.usePrivilegedHelper, isFwd, "unexpected process NUMA provider")
+
+			if !tc.usePrivilegedHelper {
+				_, isHwloc := getProcessNUMAProvider(log, cfg).(*hwloc.Provider)
+				test.AssertTrue(t, isHwloc, "expected hwloc provider")
+			}
+		})
+	}
+}
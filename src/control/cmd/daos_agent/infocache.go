@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,19 +11,25 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/atm"
 	"github.com/daos-stack/daos/src/control/lib/cache"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
 	"github.com/daos-stack/daos/src/control/lib/telemetry"
 	"github.com/daos-stack/daos/src/control/logging"
 )
@@ -30,6 +37,22 @@ import (
 const (
 	attachInfoKey = "GetAttachInfo"
 	fabricKey     = "NUMAFabric"
+
+	// cacheLogModule is the name of the log module used by the info cache.
+	cacheLogModule = "agent.cache"
+
+	// defaultFabricCheckInterval is the default interval between checks of
+	// the link state of the cached fabric interfaces.
+	defaultFabricCheckInterval = 10 * time.Second
+
+	// attachInfoFetchTimeout limits a fetch of the attach info that is shared by
+	// concurrent clients, as it is not canceled when the clients give up on it.
+	// It matches the default timeout of control requests.
+	attachInfoFetchTimeout = 5 * time.Minute
+
+	// Values of the item label in the cache refresh metrics.
+	attachInfoMetricItem = "attach_info"
+	fabricMetricItem     = "fabric"
 )
 
 type getAttachInfoFn func(ctx context.Context, rpcClient control.UnaryInvoker, req *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error)
@@ -37,16 +60,27 @@ type fabricScanFn func(ctx context.Context, providers ...string) (*NUMAFabric, e
 
 // NewInfoCache creates a new InfoCache with appropriate parameters set.
 func NewInfoCache(ctx context.Context, log logging.Logger, client control.UnaryInvoker, cfg *Config) *InfoCache {
+	numaDistGetter := getNUMADistanceProvider(log, cfg)
+	accelTopoGetter := topology.DefaultAcceleratorTopologyProvider(log)
+	cacheLog := logging.ForModule(log, cacheLogModule)
 	ic := &InfoCache{
-		log:             log,
+		log:             cacheLog,
 		ignoreIfaces:    cfg.ExcludeFabricIfaces,
 		client:          client,
-		cache:           cache.NewItemCache(log),
+		cache:           cache.NewItemCache(cacheLog),
 		getAttachInfoCb: control.GetAttachInfo,
-		fabricScan:      getFabricScanFn(log, cfg, network.DefaultFabricScanner(log)),
+		fabricScan:      getFabricScanFn(log, cfg, getFabricScanner(log, cfg), numaDistGetter, accelTopoGetter),
 		netIfaces:       net.Interfaces,
 		devClassGetter:  network.DefaultNetDevClassProvider(log),
 		devStateGetter:  network.DefaultNetDevStateProvider(log),
+		metrics:         newCacheRefreshMetrics(),
+	}
+	ic.attachInfoCalls.timeout = attachInfoFetchTimeout
+
+	// Make sure that the fabric scan includes all of the prioritized
+	// providers, as any of them may be selected for a client.
+	for _, prov := range cfg.ProviderPriority {
+		ic.AddProvider(prov)
 	}
 
 	ic.clientTelemetryEnabled.Store(cfg.TelemetryEnabled)
@@ -59,10 +93,14 @@ func NewInfoCache(ctx context.Context, log logging.Logger, client control.UnaryI
 	}
 
 	ic.EnableAttachInfoCache(time.Duration(cfg.CacheExpiration))
+	if cfg.RuntimeDir != "" {
+		ic.attachInfoStore = newAttachInfoStore(cacheLog, filepath.Join(cfg.RuntimeDir, attachInfoCacheFile), cfg.SystemName)
+	}
 	if len(cfg.FabricInterfaces) > 0 {
-		nf := NUMAFabricFromConfig(log, cfg.FabricInterfaces)
+		nf := configuredNUMAFabric(ctx, log, cfg, numaDistGetter, accelTopoGetter)
 		ic.EnableStaticFabricCache(ctx, nf)
 	} else {
+		ic.fabricLibs = newFabricLibWatcher()
 		ic.EnableFabricCache()
 	}
 
@@ -76,13 +114,144 @@ func fabricDeviceFilter(cfg *Config) *deviceFilter {
 	return newDeviceFilter(cfg.IncludeFabricIfaces, filterModeInclude)
 }
 
-func getFabricScanFn(log logging.Logger, cfg *Config, scanner *hardware.FabricScanner) fabricScanFn {
+// getNUMADistances fetches the NUMA node distances if they are needed by the configured
+// fabric fallback policy.
+func getNUMADistances(ctx context.Context, log logging.Logger, cfg *Config, getter hardware.NUMADistanceProvider) hardware.NUMADistances {
+	if cfg.FabricFallback != FabricFallbackNearestNUMA || getter == nil {
+		return nil
+	}
+
+	dists, err := getter.GetNUMADistances(ctx)
+	if err != nil {
+		log.Noticef("NUMA distances unavailable, ordering fallback NUMA nodes by ID: %s", err)
+		return nil
+	}
+	return dists
+}
+
+// getGPUAffineInterfaces fetches the fabric interfaces with the closest affinity to
+// accelerators if GPU affinity is enabled.
+func getGPUAffineInterfaces(ctx context.Context, log logging.Logger, cfg *Config, getter hardware.AcceleratorTopologyProvider) common.StringSet {
+	if !cfg.FabricGPUAffinity || getter == nil {
+		return nil
+	}
+
+	topo, err := getter.GetAcceleratorTopology(ctx)
+	if err != nil {
+		log.Noticef("accelerator topology unavailable, ignoring fabric_gpu_affinity: %s", err)
+		return nil
+	}
+	if len(topo.Accelerators) == 0 {
+		log.Noticef("no accelerators found, ignoring fabric_gpu_affinity")
+		return nil
+	}
+	return common.NewStringSet(topo.AffineNetInterfaces()...)
+}
+
+func getFabricScanFn(log logging.Logger, cfg *Config, scan hwScanFn, numaDistGetter hardware.NUMADistanceProvider, accelTopoGetter hardware.AcceleratorTopologyProvider) fabricScanFn {
+	// Persist the scan results across restarts unless fabric caching is disabled.
+	if !cfg.DisableCache && os.Getenv("DAOS_AGENT_DISABLE_OFI_CACHE") != "true" && cfg.RuntimeDir != "" {
+		scan = newFabricScanCache(log, filepath.Join(cfg.RuntimeDir, fabricScanCacheFile)).wrap(scan)
+	}
+
 	return func(ctx context.Context, provs ...string) (*NUMAFabric, error) {
-		fis, err := scanner.Scan(ctx, provs...)
+		fis, err := scan(ctx, provs...)
 		if err != nil {
 			return nil, err
 		}
-		return NUMAFabricFromScan(ctx, log, fis).WithDeviceFilter(fabricDeviceFilter(cfg)), nil
+		return scannedNUMAFabric(ctx, log, cfg, fis, numaDistGetter, accelTopoGetter), nil
+	}
+}
+
+// configuredNUMAFabric generates the NUMAFabric for the fabric interfaces set in the
+// config, with the selection settings from the config applied.
+func configuredNUMAFabric(ctx context.Context, log logging.Logger, cfg *Config, numaDistGetter hardware.NUMADistanceProvider, accelTopoGetter hardware.AcceleratorTopologyProvider) *NUMAFabric {
+	return NUMAFabricFromConfig(log, cfg.FabricInterfaces).
+		WithFallbackPolicy(cfg.FabricFallback, getNUMADistances(ctx, log, cfg, numaDistGetter)).
+		WithGPUAffinity(getGPUAffineInterfaces(ctx, log, cfg, accelTopoGetter))
+}
+
+// scannedNUMAFabric generates the NUMAFabric for the results of a fabric scan, with the
+// selection settings from the config applied.
+func scannedNUMAFabric(ctx context.Context, log logging.Logger, cfg *Config, fis *hardware.FabricInterfaceSet, numaDistGetter hardware.NUMADistanceProvider, accelTopoGetter hardware.AcceleratorTopologyProvider) *NUMAFabric {
+	return NUMAFabricFromScan(ctx, log, fis).
+		WithDeviceFilter(fabricDeviceFilter(cfg)).
+		WithFallbackPolicy(cfg.FabricFallback, getNUMADistances(ctx, log, cfg, numaDistGetter)).
+		WithGPUAffinity(getGPUAffineInterfaces(ctx, log, cfg, accelTopoGetter))
+}
+
+// cacheRefreshMetrics contains the telemetry for the refreshes of the items in
+// the agent's info cache.
+type cacheRefreshMetrics struct {
+	duration  *prometheus.HistogramVec
+	errors    *prometheus.CounterVec
+	coalesced *prometheus.CounterVec
+}
+
+func newCacheRefreshMetrics() *cacheRefreshMetrics {
+	m := &cacheRefreshMetrics{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "agent",
+			Subsystem: "cache",
+			Name:      "refresh_seconds",
+			Help:      "Time taken to refresh the items in the agent cache.",
+			Buckets:   []float64{.01, .05, .1, .5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"item"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "agent",
+			Subsystem: "cache",
+			Name:      "refresh_errors_total",
+			Help:      "Number of failed refreshes of the items in the agent cache.",
+		}, []string{"item"}),
+		coalesced: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "agent",
+			Subsystem: "cache",
+			Name:      "coalesced_requests_total",
+			Help:      "Number of requests for the items in the agent cache that were served by joining an identical request in flight.",
+		}, []string{"item"}),
+	}
+
+	// Export the error counters before the first failure.
+	for _, item := range []string{attachInfoMetricItem, fabricMetricItem} {
+		m.errors.WithLabelValues(item)
+	}
+	m.coalesced.WithLabelValues(attachInfoMetricItem)
+
+	return m
+}
+
+// observe records the duration and outcome of a refresh of the given item.
+func (m *cacheRefreshMetrics) observe(item string, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	m.duration.WithLabelValues(item).Observe(duration.Seconds())
+	if err != nil {
+		m.errors.WithLabelValues(item).Inc()
+	}
+}
+
+// observeCoalesced records a request for the given item that was coalesced
+// with an identical request in flight.
+func (m *cacheRefreshMetrics) observeCoalesced(item string) {
+	if m == nil {
+		return
+	}
+
+	m.coalesced.WithLabelValues(item).Inc()
+}
+
+// collectors returns the cache refresh telemetry collectors.
+func (m *cacheRefreshMetrics) collectors() []prometheus.Collector {
+	if m == nil {
+		return nil
+	}
+
+	return []prometheus.Collector{
+		m.duration,
+		m.errors,
+		m.coalesced,
 	}
 }
 
@@ -113,6 +282,10 @@ type cachedAttachInfo struct {
 	system       string
 	rpcClient    control.UnaryInvoker
 	lastResponse *control.GetAttachInfoResp
+	metrics      *cacheRefreshMetrics
+	// persisted is set while the cached data was loaded from the attach
+	// info saved by a previous agent and has not been refreshed since.
+	persisted atm.Bool
 }
 
 func newCachedAttachInfo(refreshInterval time.Duration, system string, rpcClient control.UnaryInvoker, fetchFn getAttachInfoFn) *cachedAttachInfo {
@@ -146,6 +319,11 @@ func (ci *cachedAttachInfo) needsRefresh() bool {
 	if ci == nil {
 		return false
 	}
+	// Saved attach info is served until the MS has been contacted, rather
+	// than blocking clients on a request to an unreachable MS.
+	if ci.persisted.IsTrue() {
+		return false
+	}
 	return !ci.isCached() || ci.isStale()
 }
 
@@ -162,11 +340,16 @@ func (ci *cachedAttachInfo) RefreshIfNeeded(ctx context.Context) (bool, error) {
 }
 
 // refresh implements the actual refresh logic.
-func (ci *cachedAttachInfo) refresh(ctx context.Context) error {
+func (ci *cachedAttachInfo) refresh(ctx context.Context) (err error) {
 	if ci == nil {
 		return errors.New("cachedAttachInfo is nil")
 	}
 
+	start := time.Now()
+	defer func() {
+		ci.metrics.observe(attachInfoMetricItem, time.Since(start), err)
+	}()
+
 	req := &control.GetAttachInfoReq{System: ci.system, AllRanks: true}
 	resp, err := ci.fetch(ctx, ci.rpcClient, req)
 	if err != nil {
@@ -175,6 +358,7 @@ func (ci *cachedAttachInfo) refresh(ctx context.Context) error {
 
 	ci.lastResponse = resp
 	ci.lastCached = time.Now()
+	ci.persisted.SetFalse()
 	return nil
 }
 
@@ -195,6 +379,7 @@ type cachedFabricInfo struct {
 	providers   []string
 	devClass    hardware.NetDevClass
 	lastResults *NUMAFabric
+	metrics     *cacheRefreshMetrics
 }
 
 func newCachedFabricInfo(fetchFn fabricScanFn, devClass hardware.NetDevClass, providers ...string) *cachedFabricInfo {
@@ -224,11 +409,16 @@ func (cfi *cachedFabricInfo) RefreshIfNeeded(ctx context.Context) (bool, error)
 }
 
 // refresh implements the actual refresh logic.
-func (cfi *cachedFabricInfo) refresh(ctx context.Context) error {
+func (cfi *cachedFabricInfo) refresh(ctx context.Context) (err error) {
 	if cfi == nil {
 		return errors.New("cachedFabricInfo is nil")
 	}
 
+	start := time.Now()
+	defer func() {
+		cfi.metrics.observe(fabricMetricItem, time.Since(start), err)
+	}()
+
 	results, err := cfi.fetch(ctx, cfi.providers...)
 	if err != nil {
 		return errors.Wrap(err, "refreshing cached fabric info")
@@ -289,14 +479,36 @@ type InfoCache struct {
 
 	getAttachInfoCb getAttachInfoFn
 	fabricScan      fabricScanFn
+	fabricLibs      *fabricLibWatcher
 	netIfaces       func() ([]net.Interface, error)
 	devClassGetter  hardware.NetDevClassProvider
 	devStateGetter  hardware.NetDevStateProvider
+	events          events.Publisher
+
+	fabricDownMutex sync.Mutex
+	fabricDown      common.StringSet // interfaces detected as down
+
+	attachInfoStore *attachInfoStore
+	msConnMutex     sync.Mutex
+	msConnected     bool
+	msConnErr       error
+	savedAttachInfo map[string]*cachedAttachInfo
+	attachInfoCalls flightGroup[*control.GetAttachInfoResp]
 
 	client            control.UnaryInvoker
+	sysClients        map[string]control.UnaryInvoker
 	attachInfoRefresh time.Duration
 	providers         common.StringSet
 	ignoreIfaces      common.StringSet
+	metrics           *cacheRefreshMetrics
+}
+
+// collectors returns the cache's telemetry collectors.
+func (c *InfoCache) collectors() []prometheus.Collector {
+	if c == nil {
+		return nil
+	}
+	return c.metrics.collectors()
 }
 
 // AddProvider adds a fabric provider to the scan list.
@@ -310,6 +522,26 @@ func (c *InfoCache) AddProvider(prov string) {
 	c.providers.Add(prov)
 }
 
+// SetSystemClient sets the client used to contact the MS of an additional
+// system, rather than that of the agent's own system.
+func (c *InfoCache) SetSystemClient(sys string, client control.UnaryInvoker) {
+	if c == nil || sys == "" {
+		return
+	}
+	if c.sysClients == nil {
+		c.sysClients = make(map[string]control.UnaryInvoker)
+	}
+	c.sysClients[sys] = client
+}
+
+// systemClient returns the client used to contact the MS of the given system.
+func (c *InfoCache) systemClient(sys string) control.UnaryInvoker {
+	if client, found := c.sysClients[sys]; found {
+		return client
+	}
+	return c.client
+}
+
 // IsAttachInfoEnabled checks whether the GetAttachInfo cache is enabled.
 func (c *InfoCache) IsAttachInfoCacheEnabled() bool {
 	if c == nil {
@@ -388,9 +620,18 @@ func (c *InfoCache) getAttachInfo(ctx context.Context, rpcClient control.UnaryIn
 	}
 
 	resp, err := c.getAttachInfoCb(ctx, rpcClient, req)
+	// The agent's readiness only depends on its own system's MS.
+	if _, found := c.sysClients[req.System]; !found {
+		c.setMSConnected(err)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if c.IsAttachInfoCacheEnabled() {
+		if err := c.attachInfoStore.save(req.System, resp); err != nil {
+			c.log.Noticef("unable to save attach info in %s: %s", c.attachInfoStore.path, err)
+		}
+	}
 	c.addTelemetrySettings(resp)
 	return resp, nil
 }
@@ -420,17 +661,40 @@ func (c *InfoCache) GetAttachInfo(ctx context.Context, sys string) (*control.Get
 		return nil, errors.New("InfoCache is nil")
 	}
 
-	if !c.IsAttachInfoCacheEnabled() {
-		return c.getAttachInfoRemote(ctx, sys)
-	}
-
 	// Use the default system if none is specified.
 	if sys == "" {
 		sys = build.DefaultSystemName
 	}
+
+	// When many clients (e.g. the ranks of a job starting up) request the
+	// attach info of a system at the same time, it is only fetched once and
+	// all of them share the result. The fetch continues for the others if the
+	// client that started it gives up.
+	resp, err, joined := c.attachInfoCalls.Do(ctx, sys, func(ctx context.Context) (*control.GetAttachInfoResp, error) {
+		if !c.IsAttachInfoCacheEnabled() {
+			return c.getAttachInfoRemote(ctx, sys)
+		}
+		return c.getCachedAttachInfo(ctx, sys)
+	})
+	if !joined {
+		return resp, err
+	}
+
+	c.metrics.observeCoalesced(attachInfoMetricItem)
+	if err != nil {
+		return nil, err
+	}
+	return copyGetAttachInfoResp(resp), nil
+}
+
+// getCachedAttachInfo fetches the attach info from the cache, and refreshes if
+// necessary.
+func (c *InfoCache) getCachedAttachInfo(ctx context.Context, sys string) (*control.GetAttachInfoResp, error) {
 	createItem := func() (cache.Item, error) {
 		c.log.Debugf("cache miss for %s", sysAttachInfoKey(sys))
-		return newCachedAttachInfo(c.attachInfoRefresh, sys, c.client, c.getAttachInfo), nil
+		item := newCachedAttachInfo(c.attachInfoRefresh, sys, c.systemClient(sys), c.getAttachInfo)
+		item.metrics = c.metrics
+		return item, nil
 	}
 
 	item, release, err := c.cache.GetOrCreate(ctx, sysAttachInfoKey(sys), createItem)
@@ -472,10 +736,9 @@ func (c *InfoCache) getAttachInfoRemote(ctx context.Context, sys string) (*contr
 	c.log.Debug("GetAttachInfo not cached, fetching directly from MS")
 	// Ask the MS for _all_ info, regardless of pbReq.AllRanks, so that the
 	// cache can serve future "pbReq.AllRanks == true" requests.
-	req := new(control.GetAttachInfoReq)
+	req := &control.GetAttachInfoReq{System: sys, AllRanks: true}
 	req.SetSystem(sys)
-	req.AllRanks = true
-	resp, err := c.getAttachInfo(ctx, c.client, req)
+	resp, err := c.getAttachInfo(ctx, c.systemClient(sys), req)
 	if err != nil {
 		return nil, errors.Wrapf(err, "GetAttachInfo %+v", req)
 	}
@@ -507,7 +770,24 @@ func (c *InfoCache) GetFabricDevice(ctx context.Context, params *FabricIfacePara
 	return nf.GetDevice(params)
 }
 
+// scanProviders returns the set of providers to be scanned for the requested providers,
+// including any providers that were added to the scan list.
+func (c *InfoCache) scanProviders(providers ...string) []string {
+	if len(c.providers) == 0 {
+		return providers
+	}
+
+	provs := common.NewStringSet(c.providers.ToSlice()...)
+	for _, prov := range providers {
+		if prov != "" {
+			provs.Add(prov)
+		}
+	}
+	return provs.ToSlice()
+}
+
 func (c *InfoCache) getNUMAFabric(ctx context.Context, netDevClass hardware.NetDevClass, providers ...string) (*NUMAFabric, error) {
+	providers = c.scanProviders(providers...)
 	if !c.IsFabricCacheEnabled() {
 		c.log.Debug("NUMAFabric not cached, rescanning")
 		if err := c.waitFabricReady(ctx, netDevClass); err != nil {
@@ -516,12 +796,21 @@ func (c *InfoCache) getNUMAFabric(ctx context.Context, netDevClass hardware.NetD
 		return c.fabricScan(ctx, providers...)
 	}
 
+	// The providers reported by a scan depend on the installed fabric
+	// libraries, so discard the cached scan if they have been upgraded.
+	if c.fabricLibs.changed() && c.cache.Has(fabricKey) {
+		c.log.Notice("fabric provider libraries have changed, discarding cached fabric scan")
+		c.cache.Delete(fabricKey)
+	}
+
 	createItem := func() (cache.Item, error) {
 		c.log.Debug("NUMAFabric cache miss")
 		if err := c.waitFabricReady(ctx, netDevClass); err != nil {
 			return nil, err
 		}
-		return newCachedFabricInfo(c.fabricScan, netDevClass, providers...), nil
+		item := newCachedFabricInfo(c.fabricScan, netDevClass, providers...)
+		item.metrics = c.metrics
+		return item, nil
 	}
 
 	item, release, err := c.cache.GetOrCreate(ctx, fabricKey, createItem)
@@ -581,6 +870,140 @@ func (c *InfoCache) waitFabricReady(ctx context.Context, netDevClass hardware.Ne
 	})
 }
 
+// cachedNUMAFabric returns the cached fabric scan results, or nil if the fabric cache has not yet
+// been populated.
+func (c *InfoCache) cachedNUMAFabric(ctx context.Context) (*NUMAFabric, error) {
+	if !c.IsFabricCacheEnabled() || !c.cache.Has(fabricKey) {
+		return nil, nil
+	}
+
+	item, release, err := c.cache.Get(ctx, fabricKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting fabric scan from cache")
+	}
+	cfi, ok := item.(*cachedFabricInfo)
+	release()
+	if !ok {
+		return nil, errors.Errorf("unexpected fabric data type %T", item)
+	}
+
+	return cfi.lastResults, nil
+}
+
+// CheckFabricHealth checks the link state of the cached fabric interfaces. Interfaces that are
+// down are marked as such in the cached NUMAFabric, so that healthy interfaces are preferred for
+// clients, and a RAS event is published when an interface goes down.
+func (c *InfoCache) CheckFabricHealth(ctx context.Context) error {
+	if c == nil {
+		return errors.New("InfoCache is nil")
+	}
+
+	nf, err := c.cachedNUMAFabric(ctx)
+	if err != nil || nf == nil {
+		return err
+	}
+
+	numaNodes := nf.InterfaceNUMANodes()
+	names := make([]string, 0, len(numaNodes))
+	for name := range numaNodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	c.fabricDownMutex.Lock()
+	defer c.fabricDownMutex.Unlock()
+
+	if c.fabricDown == nil {
+		c.fabricDown = common.NewStringSet()
+	}
+
+	for _, name := range names {
+		state, err := c.devStateGetter.GetNetDevState(name)
+		if err != nil {
+			c.log.Debugf("unable to get state of fabric interface %s: %s", name, err)
+			continue
+		}
+
+		down := state == hardware.NetDevStateDown
+		nf.SetInterfaceHealth(name, !down)
+
+		switch {
+		case down && !c.fabricDown.Has(name):
+			c.fabricDown.Add(name)
+			c.log.Noticef("fabric interface %s is down, preferring other interfaces for clients", name)
+			if c.events != nil {
+				c.events.Publish(events.NewFabricIfaceDownEvent(name, numaNodes[name]))
+			}
+		case !down && c.fabricDown.Has(name):
+			delete(c.fabricDown, name)
+			c.log.Noticef("fabric interface %s is up", name)
+		}
+	}
+
+	return nil
+}
+
+// FabricInterfaceNames returns the sorted names of the cached fabric interfaces. No fabric scan
+// is triggered if the cache has not yet been populated.
+func (c *InfoCache) FabricInterfaceNames(ctx context.Context) ([]string, error) {
+	if c == nil {
+		return nil, errors.New("InfoCache is nil")
+	}
+
+	nf, err := c.cachedNUMAFabric(ctx)
+	if err != nil || nf == nil {
+		return nil, err
+	}
+
+	numaNodes := nf.InterfaceNUMANodes()
+	names := make([]string, 0, len(numaNodes))
+	for name := range numaNodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// MonitorFabricHealth checks the link state of the cached fabric interfaces at the given
+// interval until the context is canceled.
+func (c *InfoCache) MonitorFabricHealth(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.CheckFabricHealth(ctx); err != nil {
+				c.log.Errorf("fabric interface health check failed: %s", err)
+			}
+		}
+	}
+}
+
+// CheckHealth returns an error if the cache cannot be accessed before the
+// context is done, e.g. because a refresh of the cached items is hung.
+func (c *InfoCache) CheckHealth(ctx context.Context) error {
+	if c == nil {
+		return errors.New("InfoCache is nil")
+	}
+
+	accessed := make(chan struct{})
+	go func() {
+		c.cache.Keys()
+		close(accessed)
+	}()
+
+	select {
+	case <-accessed:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "cache not responding")
+	}
+}
+
 // Refresh forces any enabled, refreshable caches to re-fetch their content immediately.
 func (c *InfoCache) Refresh(ctx context.Context) error {
 	if c == nil {
@@ -596,12 +1019,58 @@ func (c *InfoCache) Refresh(ctx context.Context) error {
 		keys = append(keys, fabricKey)
 	}
 	if c.IsAttachInfoCacheEnabled() {
-		for _, k := range c.cache.Keys() {
-			if strings.HasPrefix(k, attachInfoKey) {
-				keys = append(keys, k)
-			}
+		keys = append(keys, c.attachInfoKeys()...)
+	}
+	c.log.Debugf("refreshing cache keys: %+v", keys)
+	return c.cache.Refresh(ctx, keys...)
+}
+
+func (c *InfoCache) attachInfoKeys() []string {
+	keys := []string{}
+	for _, k := range c.cache.Keys() {
+		if strings.HasPrefix(k, attachInfoKey) {
+			keys = append(keys, k)
 		}
 	}
+	return keys
+}
+
+// RefreshAttachInfo forces the cached GetAttachInfo responses to be re-fetched immediately.
+// Nothing is refreshed if the attach info cache is disabled or empty.
+func (c *InfoCache) RefreshAttachInfo(ctx context.Context) error {
+	if c == nil {
+		return errors.New("InfoCache is nil")
+	}
+
+	if !c.IsAttachInfoCacheEnabled() {
+		return nil
+	}
+
+	keys := c.attachInfoKeys()
+	if len(keys) == 0 {
+		return nil
+	}
 	c.log.Debugf("refreshing cache keys: %+v", keys)
 	return c.cache.Refresh(ctx, keys...)
 }
+
+// RefreshSystemAttachInfo forces the cached GetAttachInfo response for a single
+// system to be re-fetched immediately, leaving the responses cached for other
+// systems untouched. Nothing is refreshed if the attach info cache is disabled
+// or no response has been cached for the system.
+func (c *InfoCache) RefreshSystemAttachInfo(ctx context.Context, sys string) error {
+	if c == nil {
+		return errors.New("InfoCache is nil")
+	}
+
+	if !c.IsAttachInfoCacheEnabled() {
+		return nil
+	}
+
+	key := sysAttachInfoKey(sys)
+	if !c.cache.Has(key) {
+		return nil
+	}
+	c.log.Debugf("refreshing cache key: %s", key)
+	return c.cache.Refresh(ctx, key)
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,16 +11,21 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/cache"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
@@ -317,6 +323,63 @@ func TestAgent_newCachedFabricInfo(t *testing.T) {
 	}
 }
 
+func TestAgent_cacheRefreshMetrics(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	var aiErr, fabricErr error
+	metrics := newCacheRefreshMetrics()
+	ai := newCachedAttachInfo(0, "test", nil,
+		func(context.Context, control.UnaryInvoker, *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+			return &control.GetAttachInfoResp{}, aiErr
+		})
+	ai.metrics = metrics
+	cfi := newCachedFabricInfo(func(context.Context, ...string) (*NUMAFabric, error) {
+		return newNUMAFabric(log), fabricErr
+	}, hardware.Ether)
+	cfi.metrics = metrics
+
+	checkMetrics := func(t *testing.T, item string, expRefreshes uint64, expErrors float64) {
+		t.Helper()
+
+		var m dto.Metric
+		if err := metrics.duration.WithLabelValues(item).(prometheus.Histogram).Write(&m); err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEqual(t, expRefreshes, m.GetHistogram().GetSampleCount(), item+": unexpected refreshes")
+
+		if err := metrics.errors.WithLabelValues(item).Write(&m); err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEqual(t, expErrors, m.GetCounter().GetValue(), item+": unexpected errors")
+	}
+
+	checkMetrics(t, attachInfoMetricItem, 0, 0)
+	checkMetrics(t, fabricMetricItem, 0, 0)
+
+	if err := ai.Refresh(test.Context(t)); err != nil {
+		t.Fatal(err)
+	}
+	aiErr = errors.New("mock attach info")
+	test.CmpErr(t, aiErr, ai.Refresh(test.Context(t)))
+	checkMetrics(t, attachInfoMetricItem, 2, 1)
+
+	fabricErr = errors.New("mock fabric scan")
+	test.CmpErr(t, fabricErr, cfi.Refresh(test.Context(t)))
+	checkMetrics(t, fabricMetricItem, 1, 1)
+	checkMetrics(t, attachInfoMetricItem, 2, 1)
+
+	test.AssertEqual(t, 3, len(metrics.collectors()), "unexpected collectors")
+
+	// Items created without metrics can still be refreshed.
+	cfi.metrics = nil
+	fabricErr = nil
+	if err := cfi.Refresh(test.Context(t)); err != nil {
+		t.Fatal(err)
+	}
+	checkMetrics(t, fabricMetricItem, 1, 1)
+}
+
 func TestAgent_cachedFabricInfo_Key(t *testing.T) {
 	for name, tc := range map[string]struct {
 		cfi *cachedFabricInfo
@@ -551,6 +614,130 @@ func TestAgent_NewInfoCache(t *testing.T) {
 	}
 }
 
+type mockNUMADistanceProvider struct {
+	GetNUMADistancesResult hardware.NUMADistances
+	GetNUMADistancesErr    error
+}
+
+func (m *mockNUMADistanceProvider) GetNUMADistances(_ context.Context) (hardware.NUMADistances, error) {
+	return m.GetNUMADistancesResult, m.GetNUMADistancesErr
+}
+
+func TestAgent_getNUMADistances(t *testing.T) {
+	dists := hardware.NUMADistances{
+		0: {0: 10, 1: 21},
+		1: {0: 21, 1: 10},
+	}
+
+	for name, tc := range map[string]struct {
+		policy    FabricFallbackPolicy
+		getter    hardware.NUMADistanceProvider
+		expResult hardware.NUMADistances
+	}{
+		"default policy": {
+			getter: &mockNUMADistanceProvider{GetNUMADistancesResult: dists},
+		},
+		"any": {
+			policy: FabricFallbackAny,
+			getter: &mockNUMADistanceProvider{GetNUMADistancesResult: dists},
+		},
+		"nearest-numa; no getter": {
+			policy: FabricFallbackNearestNUMA,
+		},
+		"nearest-numa; getter fails": {
+			policy: FabricFallbackNearestNUMA,
+			getter: &mockNUMADistanceProvider{GetNUMADistancesErr: errors.New("mock")},
+		},
+		"nearest-numa": {
+			policy:    FabricFallbackNearestNUMA,
+			getter:    &mockNUMADistanceProvider{GetNUMADistancesResult: dists},
+			expResult: dists,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := &Config{FabricFallback: tc.policy}
+			result := getNUMADistances(test.Context(t), log, cfg, tc.getter)
+
+			if diff := cmp.Diff(tc.expResult, result); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type mockAcceleratorTopologyProvider struct {
+	GetAcceleratorTopologyResult *hardware.AcceleratorTopology
+	GetAcceleratorTopologyErr    error
+}
+
+func (m *mockAcceleratorTopologyProvider) GetAcceleratorTopology(_ context.Context) (*hardware.AcceleratorTopology, error) {
+	return m.GetAcceleratorTopologyResult, m.GetAcceleratorTopologyErr
+}
+
+func TestAgent_getGPUAffineInterfaces(t *testing.T) {
+	topo := &hardware.AcceleratorTopology{
+		Accelerators: []*hardware.Accelerator{
+			{
+				AffinityDevice: hardware.AffinityDevice{
+					Name:    "gpu0",
+					PCIPath: hardware.PCIPath{"pci0000:00", "0000:00:01.0", "0000:01:00.0"},
+				},
+			},
+		},
+		NetInterfaces: []*hardware.AffinityDevice{
+			{
+				Name:    "ib0",
+				PCIPath: hardware.PCIPath{"pci0000:00", "0000:00:01.0", "0000:01:00.1"},
+			},
+			{
+				Name:    "ib1",
+				PCIPath: hardware.PCIPath{"pci0000:00", "0000:00:02.0", "0000:02:00.0"},
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		disabled  bool
+		getter    hardware.AcceleratorTopologyProvider
+		expResult common.StringSet
+	}{
+		"disabled": {
+			disabled: true,
+			getter:   &mockAcceleratorTopologyProvider{GetAcceleratorTopologyResult: topo},
+		},
+		"no getter": {},
+		"getter fails": {
+			getter: &mockAcceleratorTopologyProvider{GetAcceleratorTopologyErr: errors.New("mock")},
+		},
+		"no accelerators": {
+			getter: &mockAcceleratorTopologyProvider{
+				GetAcceleratorTopologyResult: &hardware.AcceleratorTopology{
+					NetInterfaces: topo.NetInterfaces,
+				},
+			},
+		},
+		"success": {
+			getter:    &mockAcceleratorTopologyProvider{GetAcceleratorTopologyResult: topo},
+			expResult: common.NewStringSet("ib0"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := &Config{FabricGPUAffinity: !tc.disabled}
+			result := getGPUAffineInterfaces(test.Context(t), log, cfg, tc.getter)
+
+			if diff := cmp.Diff(tc.expResult, result); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestAgent_InfoCache_EnableAttachInfoCache(t *testing.T) {
 	for name, tc := range map[string]struct {
 		ic              *InfoCache
@@ -970,6 +1157,161 @@ func TestAgent_InfoCache_GetAttachInfo(t *testing.T) {
 	}
 }
 
+func TestAgent_InfoCache_GetAttachInfo_Coalesced(t *testing.T) {
+	const numClients = 10
+
+	ctlResp := &control.GetAttachInfoResp{
+		System:       build.DefaultSystemName,
+		ServiceRanks: []*control.PrimaryServiceRank{{Rank: 1, Uri: "my uri"}},
+		MSRanks:      []uint32{0, 1, 2, 3},
+		ClientNetHint: control.ClientNetworkHint{
+			Provider:    "ofi+tcp",
+			NetDevClass: uint32(hardware.Ether),
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		disableCache bool
+		remoteErr    error
+		expErr       error
+		expResp      *control.GetAttachInfoResp
+	}{
+		"cache enabled": {
+			expResp: ctlResp,
+		},
+		"cache disabled": {
+			disableCache: true,
+			expResp:      ctlResp,
+		},
+		"fetch fails": {
+			remoteErr: errors.New("mock remote"),
+			expErr:    errors.New("mock remote"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ic := newTestInfoCache(t, log, testInfoCacheParams{
+				disableAttachInfoCache: tc.disableCache,
+			})
+			ic.metrics = newCacheRefreshMetrics()
+
+			// Block the MS request until all of the clients are waiting for it.
+			var waiting sync.WaitGroup
+			waiting.Add(numClients)
+			var remoteCalls atomic.Int32
+			ic.getAttachInfoCb = func(_ context.Context, _ control.UnaryInvoker, _ *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+				remoteCalls.Add(1)
+				waiting.Wait()
+				if tc.remoteErr != nil {
+					return nil, tc.remoteErr
+				}
+				return copyGetAttachInfoResp(ctlResp), nil
+			}
+
+			var wg sync.WaitGroup
+			resps := make([]*control.GetAttachInfoResp, numClients)
+			errs := make([]error, numClients)
+			for i := 0; i < numClients; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					ctx := &waitingCtx{Context: test.Context(t), waiting: waiting.Done}
+					resps[i], errs[i] = ic.GetAttachInfo(ctx, "")
+				}(i)
+			}
+			wg.Wait()
+
+			for i := 0; i < numClients; i++ {
+				test.CmpErr(t, tc.expErr, errs[i])
+				if diff := cmp.Diff(tc.expResp, resps[i]); diff != "" {
+					t.Fatalf("client %d: want-, got+:\n%s", i, diff)
+				}
+				for j := 0; j < i; j++ {
+					if resps[i] != nil && resps[i] == resps[j] {
+						t.Fatalf("clients %d and %d received the same response", i, j)
+					}
+				}
+			}
+			test.AssertEqual(t, int32(1), remoteCalls.Load(), "unexpected number of MS requests")
+
+			var m dto.Metric
+			if err := ic.metrics.coalesced.WithLabelValues(attachInfoMetricItem).Write(&m); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, float64(numClients-1), m.GetCounter().GetValue(), "unexpected coalesced requests")
+		})
+	}
+}
+
+func TestAgent_InfoCache_GetAttachInfo_SystemClients(t *testing.T) {
+	for name, tc := range map[string]struct {
+		disableCache bool
+		system       string
+		remoteErr    error
+		expOther     bool
+		expMSConn    bool
+	}{
+		"own system": {
+			system:    build.DefaultSystemName,
+			expMSConn: true,
+		},
+		"other system": {
+			system:   "mordor",
+			expOther: true,
+		},
+		"other system; cache disabled": {
+			disableCache: true,
+			system:       "mordor",
+			expOther:     true,
+		},
+		"other system fails": {
+			system:    "mordor",
+			remoteErr: errors.New("mock remote"),
+			expOther:  true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ownClient := control.NewMockInvoker(log, &control.MockInvokerConfig{})
+			otherClient := control.NewMockInvoker(log, &control.MockInvokerConfig{})
+
+			var gotClient control.UnaryInvoker
+			var gotSystem string
+			ic := newTestInfoCache(t, log, testInfoCacheParams{
+				ctlInvoker:             ownClient,
+				disableAttachInfoCache: tc.disableCache,
+				mockGetAttachInfo: func(_ context.Context, client control.UnaryInvoker, req *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+					gotClient = client
+					gotSystem = req.System
+					if tc.remoteErr != nil {
+						return nil, tc.remoteErr
+					}
+					return &control.GetAttachInfoResp{
+						ClientNetHint: control.ClientNetworkHint{Provider: "ofi+tcp"},
+					}, nil
+				},
+			})
+			ic.SetSystemClient("mordor", otherClient)
+
+			_, err := ic.GetAttachInfo(test.Context(t), tc.system)
+			test.CmpErr(t, tc.remoteErr, err)
+
+			test.AssertEqual(t, tc.system, gotSystem, "unexpected system requested")
+			if tc.expOther {
+				test.AssertTrue(t, gotClient == otherClient, "expected the other system's client")
+			} else {
+				test.AssertTrue(t, gotClient == ownClient, "expected the agent's own client")
+			}
+			test.AssertEqual(t, tc.expMSConn, ic.MSConnStatus().MSConnected,
+				"unexpected MS connection state")
+		})
+	}
+}
+
 func mockGetAddrInterface(name string) (addrFI, error) {
 	return &mockNetInterface{
 		addrs: []net.Addr{
@@ -1112,6 +1454,33 @@ func TestAgent_InfoCache_GetFabricDevice(t *testing.T) {
 			},
 			expCachedFabric: testSet,
 		},
+		"cached but fabric libraries changed": {
+			getInfoCache: func(l logging.Logger) *InfoCache {
+				ic := newTestInfoCache(t, l, testInfoCacheParams{})
+				ic.cache.Set(&cachedFabricInfo{
+					fetch: func(ctx context.Context, providers ...string) (*NUMAFabric, error) {
+						return nil, errors.New("shouldn't call cached fetch")
+					},
+					lastResults: NUMAFabricFromScan(test.Context(t), l, hardware.NewFabricInterfaceSet()),
+					cacheItem:   cacheItem{lastCached: time.Now()},
+				})
+				ic.fabricLibs = &fabricLibWatcher{
+					fingerprint: func() string { return "new" },
+					last:        "old",
+				}
+				return ic
+			},
+			devClass:   hardware.Ether,
+			provider:   "testprov",
+			fabricResp: testSet,
+			expScan:    true,
+			expResult: &FabricInterface{
+				Name:        "test0",
+				Domain:      "dev0",
+				NetDevClass: hardware.Ether,
+			},
+			expCachedFabric: testSet,
+		},
 		"requested not found": {
 			getInfoCache: func(l logging.Logger) *InfoCache {
 				ic := newTestInfoCache(t, l, testInfoCacheParams{})
@@ -1504,6 +1873,141 @@ func TestAgent_InfoCache_Refresh(t *testing.T) {
 	}
 }
 
+func TestAgent_InfoCache_RefreshAttachInfo(t *testing.T) {
+	ctlResp := &control.GetAttachInfoResp{
+		System:       "dontcare",
+		ServiceRanks: []*control.PrimaryServiceRank{{Rank: 1, Uri: "my uri"}},
+		MSRanks:      []uint32{0, 1, 2, 3},
+	}
+
+	testSys := "test_sys"
+	cachedItems := func() []cache.Item {
+		return []cache.Item{
+			newCachedAttachInfo(0, testSys, nil,
+				func(_ context.Context, _ control.UnaryInvoker, _ *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+					return ctlResp, nil
+				}),
+			newCachedFabricInfo(
+				func(_ context.Context, _ ...string) (*NUMAFabric, error) {
+					return nil, errors.New("shouldn't call fabric")
+				}, hardware.Ether),
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		getInfoCache        func(logging.Logger) *InfoCache
+		expErr              error
+		expCachedAttachInfo *control.GetAttachInfoResp
+	}{
+		"nil": {
+			expErr: errors.New("nil"),
+		},
+		"attach info disabled": {
+			getInfoCache: func(l logging.Logger) *InfoCache {
+				return newTestInfoCache(t, l, testInfoCacheParams{
+					disableAttachInfoCache: true,
+				})
+			},
+		},
+		"cache items not created": {
+			getInfoCache: func(l logging.Logger) *InfoCache {
+				return newTestInfoCache(t, l, testInfoCacheParams{})
+			},
+		},
+		"only attach info refreshed": {
+			getInfoCache: func(l logging.Logger) *InfoCache {
+				return newTestInfoCache(t, l, testInfoCacheParams{
+					cachedItems: cachedItems(),
+				})
+			},
+			expCachedAttachInfo: ctlResp,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var ic *InfoCache
+			if tc.getInfoCache != nil {
+				ic = tc.getInfoCache(log)
+			}
+
+			err := ic.RefreshAttachInfo(test.Context(t))
+			test.CmpErr(t, tc.expErr, err)
+
+			if tc.expCachedAttachInfo != nil {
+				data, unlock, err := ic.cache.Get(test.Context(t), sysAttachInfoKey(testSys))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer unlock()
+
+				cached, ok := data.(*cachedAttachInfo)
+				test.AssertTrue(t, ok, "bad cached data type")
+
+				if diff := cmp.Diff(tc.expCachedAttachInfo, cached.lastResponse); diff != "" {
+					t.Fatalf("want-, got+:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAgent_InfoCache_RefreshSystemAttachInfo(t *testing.T) {
+	for name, tc := range map[string]struct {
+		disableAttachInfoCache bool
+		nilCache               bool
+		sys                    string
+		expErr                 error
+		expFetched             []string
+	}{
+		"nil": {
+			nilCache: true,
+			expErr:   errors.New("nil"),
+		},
+		"attach info disabled": {
+			disableAttachInfoCache: true,
+			sys:                    "sys1",
+		},
+		"system not cached": {
+			sys: "sys3",
+		},
+		"only system refreshed": {
+			sys:        "sys2",
+			expFetched: []string{"sys2"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var fetched []string
+			mockFetch := func(_ context.Context, _ control.UnaryInvoker, req *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+				fetched = append(fetched, req.System)
+				return &control.GetAttachInfoResp{System: req.System}, nil
+			}
+
+			var ic *InfoCache
+			if !tc.nilCache {
+				ic = newTestInfoCache(t, log, testInfoCacheParams{
+					disableAttachInfoCache: tc.disableAttachInfoCache,
+					cachedItems: []cache.Item{
+						newCachedAttachInfo(0, "sys1", nil, mockFetch),
+						newCachedAttachInfo(0, "sys2", nil, mockFetch),
+					},
+				})
+			}
+
+			err := ic.RefreshSystemAttachInfo(test.Context(t), tc.sys)
+			test.CmpErr(t, tc.expErr, err)
+
+			if diff := cmp.Diff(tc.expFetched, fetched); diff != "" {
+				t.Fatalf("unexpected systems refreshed (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestAgent_InfoCache_waitFabricReady(t *testing.T) {
 	defaultNetIfaceFn := func() ([]net.Interface, error) {
 		return []net.Interface{
@@ -1615,3 +2119,251 @@ func TestAgent_InfoCache_waitFabricReady(t *testing.T) {
 		})
 	}
 }
+
+type mockEventPublisher struct {
+	published []*events.RASEvent
+}
+
+func (mp *mockEventPublisher) Publish(evt *events.RASEvent) {
+	mp.published = append(mp.published, evt)
+}
+
+func TestAgent_InfoCache_CheckFabricHealth(t *testing.T) {
+	cfg := []*NUMAFabricConfig{
+		{
+			NUMANode:   0,
+			Interfaces: []*FabricInterfaceConfig{{Interface: "ib0", Domain: "mlx5_0"}},
+		},
+		{
+			NUMANode:   1,
+			Interfaces: []*FabricInterfaceConfig{{Interface: "ib1", Domain: "mlx5_1"}},
+		},
+	}
+	ready := hardware.MockNetDevStateResult{State: hardware.NetDevStateReady}
+	down := hardware.MockNetDevStateResult{State: hardware.NetDevStateDown}
+
+	for name, tc := range map[string]struct {
+		nilCache     bool
+		disabled     bool
+		notCached    bool
+		states       []hardware.MockNetDevStateResult
+		expErr       error
+		expChecked   []string
+		expEventMsgs []string
+		expDown      []string
+	}{
+		"nil": {
+			nilCache: true,
+			expErr:   errors.New("InfoCache is nil"),
+		},
+		"fabric cache disabled": {
+			disabled: true,
+		},
+		"fabric not cached": {
+			notCached: true,
+		},
+		"all interfaces up": {
+			states:     []hardware.MockNetDevStateResult{ready},
+			expChecked: []string{"ib0", "ib1", "ib0", "ib1"},
+		},
+		"interface down": {
+			states:       []hardware.MockNetDevStateResult{ready, down, ready, down},
+			expChecked:   []string{"ib0", "ib1", "ib0", "ib1"},
+			expEventMsgs: []string{"Fabric interface ib1 on NUMA node 1 is down"},
+			expDown:      []string{"ib1"},
+		},
+		"interface down then up": {
+			states:       []hardware.MockNetDevStateResult{down, ready, ready, ready},
+			expChecked:   []string{"ib0", "ib1", "ib0", "ib1"},
+			expEventMsgs: []string{"Fabric interface ib0 on NUMA node 0 is down"},
+		},
+		"state unavailable": {
+			states: []hardware.MockNetDevStateResult{
+				{Err: errors.New("mock state")},
+				down,
+				{Err: errors.New("mock state")},
+				down,
+			},
+			expChecked:   []string{"ib0", "ib1", "ib0", "ib1"},
+			expEventMsgs: []string{"Fabric interface ib1 on NUMA node 1 is down"},
+			expDown:      []string{"ib1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			stateProv := &hardware.MockNetDevStateProvider{GetStateReturn: tc.states}
+			pub := &mockEventPublisher{}
+			nf := NUMAFabricFromConfig(log, cfg)
+
+			var ic *InfoCache
+			if !tc.nilCache {
+				ic = newTestInfoCache(t, log, testInfoCacheParams{
+					mockNetDevStateGetter: stateProv,
+				})
+				ic.events = pub
+				if !tc.notCached {
+					ic.EnableStaticFabricCache(ctx, nf)
+				}
+				if tc.disabled {
+					ic.DisableFabricCache()
+				}
+			}
+
+			// Check twice to verify that events are only published when an
+			// interface goes down.
+			for i := 0; i < 2; i++ {
+				err := ic.CheckFabricHealth(ctx)
+				test.CmpErr(t, tc.expErr, err)
+				if tc.expErr != nil {
+					return
+				}
+			}
+
+			test.CmpAny(t, "checked interfaces", tc.expChecked, stateProv.GetStateCalled)
+
+			var eventMsgs []string
+			for _, evt := range pub.published {
+				test.AssertEqual(t, events.RASFabricIfaceDown, evt.ID, "")
+				eventMsgs = append(eventMsgs, evt.Msg)
+			}
+			test.CmpAny(t, "published events", tc.expEventMsgs, eventMsgs)
+
+			test.CmpAny(t, "unhealthy interfaces", tc.expDown, nf.unhealthy.ToSlice(), cmpopts.EquateEmpty())
+			test.CmpAny(t, "down interfaces", tc.expDown, ic.fabricDown.ToSlice(), cmpopts.EquateEmpty())
+		})
+	}
+}
+
+// hungCacheItem is a cache item whose refresh does not complete until it is
+// released.
+type hungCacheItem struct {
+	sync.Mutex
+	started chan struct{}
+	release chan struct{}
+}
+
+func (item *hungCacheItem) Key() string {
+	return "hung"
+}
+
+func (item *hungCacheItem) Refresh(context.Context) error {
+	close(item.started)
+	<-item.release
+	return nil
+}
+
+func (item *hungCacheItem) RefreshIfNeeded(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func TestAgent_InfoCache_CheckHealth(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nilCache bool
+		hung     bool
+		expErr   error
+	}{
+		"nil": {
+			nilCache: true,
+			expErr:   errors.New("InfoCache is nil"),
+		},
+		"healthy": {},
+		"refresh hung": {
+			hung:   true,
+			expErr: errors.New("cache not responding"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var ic *InfoCache
+			if !tc.nilCache {
+				ic = newTestInfoCache(t, log, testInfoCacheParams{})
+			}
+
+			if tc.hung {
+				item := &hungCacheItem{
+					started: make(chan struct{}),
+					release: make(chan struct{}),
+				}
+				if err := ic.cache.Set(item); err != nil {
+					t.Fatal(err)
+				}
+				refreshed := make(chan struct{})
+				go func() {
+					defer close(refreshed)
+					ic.cache.Refresh(test.Context(t), item.Key())
+				}()
+				<-item.started
+				defer func() {
+					close(item.release)
+					<-refreshed
+				}()
+			}
+
+			ctx, cancel := context.WithTimeout(test.Context(t), 100*time.Millisecond)
+			defer cancel()
+			test.CmpErr(t, tc.expErr, ic.CheckHealth(ctx))
+		})
+	}
+}
+
+func TestAgent_InfoCache_FabricInterfaceNames(t *testing.T) {
+	cfg := []*NUMAFabricConfig{
+		{
+			NUMANode:   0,
+			Interfaces: []*FabricInterfaceConfig{{Interface: "ib1", Domain: "mlx5_1"}},
+		},
+		{
+			NUMANode:   1,
+			Interfaces: []*FabricInterfaceConfig{{Interface: "ib0", Domain: "mlx5_0"}},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		nilCache  bool
+		disabled  bool
+		notCached bool
+		expNames  []string
+		expErr    error
+	}{
+		"nil": {
+			nilCache: true,
+			expErr:   errors.New("InfoCache is nil"),
+		},
+		"fabric cache disabled": {
+			disabled: true,
+		},
+		"fabric not cached": {
+			notCached: true,
+		},
+		"success": {
+			expNames: []string{"ib0", "ib1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+
+			var ic *InfoCache
+			if !tc.nilCache {
+				ic = newTestInfoCache(t, log, testInfoCacheParams{})
+				if !tc.notCached {
+					ic.EnableStaticFabricCache(ctx, NUMAFabricFromConfig(log, cfg))
+				}
+				if tc.disabled {
+					ic.DisableFabricCache()
+				}
+			}
+
+			names, err := ic.FabricInterfaceNames(ctx)
+			test.CmpErr(t, tc.expErr, err)
+			test.CmpAny(t, "fabric interface names", tc.expNames, names)
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -33,13 +34,22 @@ type cliOptions struct {
 	Insecure      bool                    `short:"i" long:"insecure" description:"have agent attempt to connect without certificates"`
 	RuntimeDir    string                  `short:"s" long:"runtime_dir" description:"Path to agent communications socket"`
 	LogFile       string                  `short:"l" long:"logfile" description:"Full path and filename for daos agent log file"`
+	Standalone    bool                    `long:"standalone" description:"Run without certificates or a reachable MS quorum, for single-node development"`
+	AttachInfo    string                  `long:"attach-info-file" description:"Serve attach info from this file in standalone mode (implies --standalone)"`
 	Start         startCmd                `command:"start" description:"Start daos_agent daemon (default behavior)"`
 	Version       versionCmd              `command:"version" description:"Print daos_agent version"`
 	ServerVersion serverVersionCmd        `command:"server-version" description:"Print daos_server version"`
 	DumpInfo      dumpAttachInfoCmd       `command:"dump-attachinfo" description:"Dump system attachinfo"`
 	DumpTopo      cmdutil.DumpTopologyCmd `command:"dump-topology" description:"Dump system topology"`
 	NetScan       netScanCmd              `command:"net-scan" description:"Perform local network fabric scan"`
+	Topology      topologyCmd             `command:"topology" description:"Show NUMA, accelerator and fabric interface relationships"`
+	FabricMap     fabricMapCmd            `command:"fabric-map" description:"Show the NUMA fabric map used to select fabric interfaces for clients"`
 	Support       supportCmd              `command:"support" description:"Perform debug tasks to help support team"`
+	Config        agentConfigCmd          `command:"config" description:"Perform tasks related to the agent configuration"`
+	Bench         benchCmd                `command:"bench" description:"Simulate client load on the running agent and report request latencies"`
+	ListClients   listClientsCmd          `command:"list-clients" description:"List the client processes known to the running agent"`
+	TelemHistory  telemetryHistoryCmd     `command:"telemetry-history" description:"Query the client telemetry retained by the running agent"`
+	Completion    cmdutil.CompletionCmd   `command:"completion" description:"Generate a shell completion script for daos_agent"`
 }
 
 type (
@@ -61,8 +71,13 @@ type (
 		setConfig(*Config)
 	}
 
+	configPathSetter interface {
+		setConfigPath(string)
+	}
+
 	configCmd struct {
-		cfg *Config
+		cfg     *Config
+		cfgPath string
 	}
 )
 
@@ -70,6 +85,10 @@ func (cmd *configCmd) setConfig(cfg *Config) {
 	cmd.cfg = cfg
 }
 
+func (cmd *configCmd) setConfigPath(cfgPath string) {
+	cmd.cfgPath = cfgPath
+}
+
 func versionString() string {
 	return build.String(build.AgentName)
 }
@@ -141,6 +160,20 @@ func (cmd *supportAgentConfigCmd) getSupportConf() string {
 	return cmd.supportCfgPath
 }
 
+// findConfigPath returns the config path supplied on the command line, or the
+// default config path if the file exists.
+func findConfigPath(opts *cliOptions) string {
+	if opts.ConfigPath != "" {
+		return opts.ConfigPath
+	}
+
+	defaultConfigPath := path.Join(build.ConfigDir, defaultConfigFile)
+	if _, err := os.Stat(defaultConfigPath); err == nil {
+		return defaultConfigPath
+	}
+	return ""
+}
+
 func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *logging.LeveledLogger) error {
 	var wroteJSON atm.Bool
 	p := flags.NewParser(opts, flags.Default)
@@ -176,23 +209,27 @@ func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *lo
 			log.ClearLevel(logging.LogLevelInfo)
 		}
 
-		switch cmd.(type) {
-		case *versionCmd, *netScanCmd, *cmdutil.DumpTopologyCmd:
+		switch c := cmd.(type) {
+		case *versionCmd, *netScanCmd, *topologyCmd, *cmdutil.DumpTopologyCmd, *cmdutil.CompletionCmd:
 			// these commands don't need the rest of the setup
 			return cmd.Execute(args)
+		case *configValidateCmd:
+			// validation reports problems with the config rather than
+			// failing to load it
+			c.setConfigPath(findConfigPath(opts))
+			return cmd.Execute(args)
+		case *configDumpCmd:
+			// the config is printed as loaded from file, without any
+			// command-line overrides
+			c.setConfigPath(findConfigPath(opts))
+			return cmd.Execute(args)
 		}
 
 		if !opts.AllowProxy {
 			common.ScrubProxyVariables()
 		}
 
-		cfgPath := opts.ConfigPath
-		if cfgPath == "" {
-			defaultConfigPath := path.Join(build.ConfigDir, defaultConfigFile)
-			if _, err := os.Stat(defaultConfigPath); err == nil {
-				cfgPath = defaultConfigPath
-			}
-		}
+		cfgPath := findConfigPath(opts)
 
 		cfg, err := processConfig(log, cmd, opts, cfgPath)
 		if err != nil {
@@ -204,14 +241,7 @@ func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *lo
 		}
 
 		if ctlCmd, ok := cmd.(ctlInvoker); ok {
-			// Generate a control config based on the loaded agent config.
-			ctlCfg := control.DefaultConfig()
-			ctlCfg.TransportConfig = cfg.TransportConfig
-			ctlCfg.HostList = cfg.AccessPoints
-			ctlCfg.SystemName = cfg.SystemName
-			ctlCfg.ControlPort = cfg.ControlPort
-
-			invoker.SetConfig(ctlCfg)
+			invoker.SetConfig(newControlConfig(cfg, nil))
 			ctlCmd.setInvoker(invoker)
 		}
 
@@ -259,18 +289,53 @@ func processConfig(log logging.Logger, cmd flags.Commander, opts *cliOptions, cf
 		cfg.TransportConfig.AllowInsecure = true
 	}
 
+	if opts.Standalone || opts.AttachInfo != "" {
+		log.Debug("Overriding Standalone from config file with true")
+		cfg.Standalone = true
+	}
+	if opts.AttachInfo != "" {
+		log.Debugf("Overriding AttachInfoFile from config file with %s", opts.AttachInfo)
+		cfg.AttachInfoFile = opts.AttachInfo
+	}
+	if cfg.Standalone && len(cfg.Systems) > 0 {
+		return nil, errors.New("systems may not be used in standalone mode")
+	}
+	applyStandalone(log, cfg)
+
 	if err := cfg.TransportConfig.PreLoadCertData(); err != nil {
 		return nil, errors.Wrap(err, "Unable to load Certificate Data")
 	}
 
 	var err error
-	if cfg.AccessPoints, err = common.ParseHostList(cfg.AccessPoints, cfg.ControlPort); err != nil {
+	if cfg.AccessPoints, err = control.ParseHostList(cfg.AccessPoints, cfg.ControlPort); err != nil {
 		return nil, errors.Wrap(err, "Failed to parse config access_points")
 	}
 
+	for _, sys := range cfg.Systems {
+		if sys.ControlPort == 0 {
+			sys.ControlPort = cfg.ControlPort
+		}
+		if sys.TransportConfig == nil {
+			sys.TransportConfig = cfg.TransportConfig
+		} else {
+			if opts.Insecure {
+				sys.TransportConfig.AllowInsecure = true
+			}
+			if err := sys.TransportConfig.PreLoadCertData(); err != nil {
+				return nil, errors.Wrapf(err, "Unable to load Certificate Data for system %s", sys.Name)
+			}
+		}
+		if sys.AccessPoints, err = control.ParseHostList(sys.AccessPoints, sys.ControlPort); err != nil {
+			return nil, errors.Wrapf(err, "Failed to parse access_points for system %s", sys.Name)
+		}
+	}
+
 	if cfgCmd, ok := cmd.(configSetter); ok {
 		cfgCmd.setConfig(cfg)
 	}
+	if pathCmd, ok := cmd.(configPathSetter); ok {
+		pathCmd.setConfigPath(cfgPath)
+	}
 
 	if cfgPath != "" {
 		log.Infof("loaded agent config from path: %s", cfgPath)
@@ -279,14 +344,36 @@ func processConfig(log logging.Logger, cmd flags.Commander, opts *cliOptions, cf
 	return cfg, nil
 }
 
+// newControlConfig generates a control API configuration for the agent's own
+// system, or for one of the additional systems, based on the agent config.
+func newControlConfig(cfg *Config, sys *SystemConfig) *control.Config {
+	ctlCfg := control.DefaultConfig()
+	ctlCfg.TransportConfig = cfg.TransportConfig
+	ctlCfg.HostList = cfg.AccessPoints
+	ctlCfg.HostListRefreshInterval = cfg.AccessPointsRefresh
+	ctlCfg.SystemName = cfg.SystemName
+	ctlCfg.ControlPort = cfg.ControlPort
+	ctlCfg.MaxMsgSize = cfg.ControlMaxMsgSize
+
+	if sys != nil {
+		ctlCfg.TransportConfig = sys.TransportConfig
+		ctlCfg.HostList = sys.AccessPoints
+		ctlCfg.SystemName = sys.Name
+		ctlCfg.ControlPort = sys.ControlPort
+	}
+
+	return ctlCfg
+}
+
 func configureLogging(log logging.Logger, cmd flags.Commander, cfg *Config, opts *cliOptions) error {
 	if logCmd, ok := cmd.(cmdutil.LogSetter); ok {
 		logCmd.SetLog(log)
 
 		logCfg := cmdutil.LogConfig{
-			LogFile:  cfg.LogFile,
-			LogLevel: cfg.LogLevel,
-			JSON:     opts.JSONLogs,
+			LogFile:      cfg.LogFile,
+			LogLevel:     cfg.LogLevel,
+			ModuleLevels: cfg.LogModules,
+			JSON:         opts.JSONLogs,
 		}
 		if err := cmdutil.ConfigureLogger(log, logCfg); err != nil {
 			return err
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,11 +10,15 @@ package main
 import (
 	"fmt"
 	"net"
+	"os"
+	"os/user"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
-	"golang.org/x/sys/unix"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/daos-stack/daos/src/control/common"
@@ -30,6 +35,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/telemetry"
 	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 // mgmtModule represents the daos_agent dRPC module. It acts mostly as a
@@ -38,14 +44,20 @@ import (
 type mgmtModule struct {
 	log            logging.Logger
 	sys            string
+	otherSystems   common.StringSet // additional systems that clients may attach to
+	access         *systemAccess
 	ctlInvoker     control.Invoker
 	cache          *InfoCache
 	monitor        *procMon
 	cliMetricsSrc  *promexp.ClientSource
+	cliMetricsHist *promexp.ClientHistory
 	useDefaultNUMA atm.Bool
 
-	numaGetter  hardware.ProcessNUMAProvider
-	providerIdx uint
+	numaGetter       hardware.ProcessNUMAProvider
+	netNSGetter      netNSProvider
+	cpuSetGetter     cpuSetProvider
+	providerIdx      uint
+	providerPriority []string
 }
 
 func (mod *mgmtModule) HandleCall(ctx context.Context, session *drpc.Session, method drpc.Method, req []byte) ([]byte, error) {
@@ -54,37 +66,35 @@ func (mod *mgmtModule) HandleCall(ctx context.Context, session *drpc.Session, me
 		return nil, errors.Errorf("session.Conn type conversion failed")
 	}
 
-	file, err := uc.File()
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	fd := int(file.Fd())
-	cred, err := unix.GetsockoptUcred(fd, unix.SOL_SOCKET, unix.SO_PEERCRED)
+	cred, err := security.DomainInfoFromUnixConn(mod.log, uc)
 	if err != nil {
 		return nil, err
 	}
 
 	if agentIsShuttingDown(ctx) {
 		mod.log.Errorf("agent is shutting down, dropping %s", method)
-		return nil, drpc.NewFailureWithMessage("agent is shutting down")
+		return nil, drpc.NewClassifiedFailure("agent is shutting down",
+			drpc.ErrorClass_TRANSIENT, shutdownRetryAfter)
 	}
 
 	switch method {
 	case drpc.MethodGetAttachInfo:
-		return mod.handleGetAttachInfo(ctx, req, cred.Pid)
+		return mod.handleGetAttachInfo(ctx, req, cred)
 	case drpc.MethodSetupClientTelemetry:
 		return mod.handleSetupClientTelemetry(ctx, req, cred)
 	case drpc.MethodNotifyPoolConnect:
-		return nil, mod.handleNotifyPoolConnect(ctx, req, cred.Pid)
+		return nil, mod.handleNotifyPoolConnect(ctx, req, cred.Pid())
 	case drpc.MethodNotifyPoolDisconnect:
-		return nil, mod.handleNotifyPoolDisconnect(ctx, req, cred.Pid)
+		return nil, mod.handleNotifyPoolDisconnect(ctx, req, cred.Pid())
 	case drpc.MethodNotifyExit:
 		// There isn't anything we can do here if this fails so just
 		// call the disconnect handler and return success.
-		mod.handleNotifyExit(ctx, cred.Pid)
+		mod.handleNotifyExit(ctx, cred.Pid())
 		return nil, nil
+	case drpc.MethodListClients:
+		return mod.handleListClients(ctx, cred)
+	case drpc.MethodQueryClientTelemetry:
+		return mod.handleQueryClientTelemetry(ctx, req, cred)
 	}
 
 	return nil, drpc.UnknownMethodFailure()
@@ -99,18 +109,20 @@ func (mod *mgmtModule) ID() drpc.ModuleID {
 // server's provider, chooses a matching network interface and domain from the
 // client machine that has the same NUMA affinity.  It is considered an error if
 // the client application is bound to a NUMA node that does not have a network
-// device / provider combination with the same NUMA affinity.
+// device / provider combination with the same NUMA affinity. Clients that may
+// not attach to the requested system are refused with -DER_NO_PERM.
 //
 // The agent caches the local device data and all possible responses the first
 // time this dRPC is invoked. Subsequent calls receive the cached data.
 // The use of cached data may be disabled by exporting
 // "DAOS_AGENT_DISABLE_CACHE=true" in the environment running the daos_agent.
-func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid int32) ([]byte, error) {
+func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, cred *security.DomainInfo) ([]byte, error) {
 	pbReq := new(mgmtpb.GetAttachInfoReq)
 	if err := proto.Unmarshal(reqb, pbReq); err != nil {
 		return nil, drpc.UnmarshalingPayloadFailure()
 	}
 
+	pid := cred.Pid()
 	client := &procInfo{
 		pid: pid,
 	}
@@ -123,8 +135,9 @@ func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid
 	// Check the system name. Due to the special daos_init-dc_mgmt_net_cfg
 	// case, where the system name is not available, we let an empty
 	// system name indicates such, and hence skip the check.
-	if pbReq.Sys != "" && pbReq.Sys != mod.sys {
+	if pbReq.Sys != "" && pbReq.Sys != mod.sys && !mod.otherSystems.Has(pbReq.Sys) {
 		mod.log.Errorf("%s: %s: unknown system name", client, pbReq.Sys)
+		setStatusHint(ctx, daos.InvalidInput)
 		respb, err := proto.Marshal(&mgmtpb.GetAttachInfoResp{Status: int32(daos.InvalidInput)})
 		if err != nil {
 			return nil, drpc.MarshalingFailure()
@@ -132,6 +145,20 @@ func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid
 		return respb, err
 	}
 
+	sys := pbReq.Sys
+	if sys == "" {
+		sys = mod.sys
+	}
+	if err := mod.access.CheckSystem(cred, sys); err != nil {
+		mod.log.Noticef("%s: access denied: %s", client, err)
+		setStatusHint(ctx, daos.NoPermission)
+		respb, err := proto.Marshal(&mgmtpb.GetAttachInfoResp{Status: int32(daos.NoPermission)})
+		if err != nil {
+			return nil, drpc.MarshalingFailure()
+		}
+		return respb, nil
+	}
+
 	numaNode, err := mod.getNUMANode(ctx, pid)
 	if err != nil {
 		mod.log.Errorf("%s: unable to get NUMA node: %s", client, err)
@@ -139,7 +166,7 @@ func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid
 	}
 	mod.log.Tracef("%s: detected numa %d", client, numaNode)
 
-	resp, err := mod.getAttachInfo(ctx, int(numaNode), pbReq)
+	resp, err := mod.getAttachInfo(ctx, int(numaNode), pbReq, mod.getClientNetNS(client))
 	switch {
 	case fault.IsFaultCode(err, code.ServerWrongSystem):
 		resp = &mgmtpb.GetAttachInfoResp{Status: int32(daos.ControlIncompatible)}
@@ -147,6 +174,8 @@ func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid
 		resp = &mgmtpb.GetAttachInfoResp{Status: int32(daos.BadCert)}
 	case control.IsMSConnectionFailure(err):
 		resp = &mgmtpb.GetAttachInfoResp{Status: int32(daos.Unreachable)}
+	case errors.Is(err, errMSRequestDropped):
+		resp = &mgmtpb.GetAttachInfoResp{Status: int32(daos.TryAgain)}
 	case err != nil:
 		return nil, err
 	}
@@ -155,8 +184,18 @@ func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid
 		mod.log.Infof("%s: numa:%d iface:%s dom:%s prov:%s srx:%d", client, numaNode,
 			resp.ClientNetHint.Interface, resp.ClientNetHint.Domain,
 			resp.ClientNetHint.Provider, resp.ClientNetHint.SrvSrxSet)
+		if mod.monitor != nil {
+			mod.monitor.RecordAttach(ctx, pid, &clientAttach{
+				name:     client.name,
+				numaNode: numaNode,
+				iface:    resp.ClientNetHint.Interface,
+				domain:   resp.ClientNetHint.Domain,
+				provider: resp.ClientNetHint.Provider,
+			})
+		}
 	}
 	mod.log.Tracef("%s: %s", client, pblog.Debug(resp))
+	setStatusHint(ctx, daos.Status(resp.Status))
 	return proto.Marshal(resp)
 }
 
@@ -170,6 +209,10 @@ func (mod *mgmtModule) getNUMANode(ctx context.Context, pid int32) (uint, error)
 		mod.log.Debug("system is not NUMA-aware")
 		mod.useDefaultNUMA.SetTrue()
 		return 0, nil
+	} else if hardware.IsUnsupportedPlatform(err) {
+		mod.log.Debug("NUMA detection not supported; using default NUMA node")
+		mod.useDefaultNUMA.SetTrue()
+		return 0, nil
 	} else if err != nil {
 		return 0, errors.Wrapf(err, "failed to get NUMA node ID for pid %d", pid)
 	}
@@ -177,18 +220,16 @@ func (mod *mgmtModule) getNUMANode(ctx context.Context, pid int32) (uint, error)
 	return numaNode, nil
 }
 
-func (mod *mgmtModule) getAttachInfo(ctx context.Context, numaNode int, req *mgmtpb.GetAttachInfoReq) (*mgmtpb.GetAttachInfoResp, error) {
+// getAttachInfo builds the attach info response for a client on the given NUMA node. If the
+// client is in a different network namespace to the agent, only the fabric interfaces visible
+// in the client's namespace are offered.
+func (mod *mgmtModule) getAttachInfo(ctx context.Context, numaNode int, req *mgmtpb.GetAttachInfoReq, clientNS *netNSInfo) (*mgmtpb.GetAttachInfoResp, error) {
 	rawResp, err := mod.getAttachInfoResp(ctx, req.Sys)
 	if err != nil {
 		mod.log.Errorf("failed to fetch AttachInfo: %s", err.Error())
 		return nil, err
 	}
 
-	resp, err := mod.selectAttachInfo(ctx, rawResp, req.Interface, req.Domain)
-	if err != nil {
-		return nil, err
-	}
-
 	// Requested fabric interface/domain behave as a simple override. If we weren't able to
 	// validate them, we return them to the user with the understanding that perhaps the user
 	// knows what they're doing.
@@ -199,18 +240,44 @@ func (mod *mgmtModule) getAttachInfo(ctx context.Context, numaNode int, req *mgm
 		domain = iface
 	}
 
-	if req.Interface == "" {
-		fabricIF, err := mod.getFabricInterface(ctx, &FabricIfaceParams{
+	var visible common.StringSet
+	if clientNS != nil {
+		visible = clientNS.Ifaces
+		if iface != "" && !visible.Has(iface) {
+			mod.log.Noticef("requested fabric interface %q is not visible in client network namespace %s",
+				iface, clientNS.ID)
+		}
+	}
+
+	var resp *mgmtpb.GetAttachInfoResp
+	var fabricIF *FabricInterface
+	if req.Interface == "" && len(mod.providerPriority) > 0 && mod.providerIdx == 0 {
+		resp, fabricIF, err = mod.selectPriorityAttachInfo(ctx, rawResp, numaNode, visible)
+	} else {
+		resp, err = mod.selectAttachInfo(ctx, rawResp, req.Interface, req.Domain)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Interface == "" && fabricIF == nil {
+		fabricIF, err = mod.getFabricInterface(ctx, &FabricIfaceParams{
 			NUMANode: numaNode,
 			DevClass: hardware.NetDevClass(resp.ClientNetHint.NetDevClass),
 			Provider: resp.ClientNetHint.Provider,
+			Visible:  visible,
 		})
 		if err != nil {
+			if clientNS != nil {
+				err = errors.Wrapf(err, "client network namespace %s differs from agent's",
+					clientNS.ID)
+			}
 			mod.log.Errorf("failed to fetch fabric interface of type %s: %s",
 				hardware.NetDevClass(resp.ClientNetHint.NetDevClass), err.Error())
 			return nil, err
 		}
-
+	}
+	if fabricIF != nil {
 		iface = fabricIF.Name
 		domain = fabricIF.Domain
 	}
@@ -220,9 +287,10 @@ func (mod *mgmtModule) getAttachInfo(ctx context.Context, numaNode int, req *mgm
 	mod.log.Tracef("D_DOMAIN for %s has been detected as: %s",
 		resp.ClientNetHint.Interface, resp.ClientNetHint.Domain)
 
-	if err := mod.populateNUMAFabricMap(ctx, resp); err != nil {
+	if err := mod.populateNUMAFabricMap(ctx, resp, visible); err != nil {
 		return nil, err
 	}
+	mod.setCPUHints(resp)
 
 	return resp, nil
 }
@@ -262,6 +330,66 @@ func (mod *mgmtModule) selectAttachInfo(ctx context.Context, srvResp *mgmtpb.Get
 		resp.ClientNetHint.Provider, iface, domain, strings.Join(reqProviders.ToSlice(), ", "))
 }
 
+// selectPriorityAttachInfo selects the first provider in the configured priority order that is
+// supported by the system and has a usable local fabric interface for a client on the given
+// NUMA node. It returns the attach info for the selected provider along with the interface.
+func (mod *mgmtModule) selectPriorityAttachInfo(ctx context.Context, srvResp *mgmtpb.GetAttachInfoResp, numaNode int, visible common.StringSet) (*mgmtpb.GetAttachInfoResp, *FabricInterface, error) {
+	// Map each provider supported by the system to its provider index.
+	sysProviders := map[string]uint{
+		srvResp.ClientNetHint.Provider: 0,
+	}
+	for _, hint := range srvResp.SecondaryClientNetHints {
+		if _, found := sysProviders[hint.Provider]; !found {
+			sysProviders[hint.Provider] = uint(hint.ProviderIdx)
+		}
+	}
+
+	var provErrs []string
+	for _, prov := range mod.providerPriority {
+		provIdx, found := sysProviders[prov]
+		if !found {
+			mod.log.Tracef("prioritized provider %s is not supported by the system", prov)
+			continue
+		}
+
+		resp := srvResp
+		if provIdx > 0 {
+			var err error
+			if resp, err = mod.selectSecondaryAttachInfo(srvResp, provIdx); err != nil {
+				provErrs = append(provErrs, fmt.Sprintf("%s: %s", prov, err))
+				continue
+			}
+		}
+
+		fi, err := mod.getFabricInterface(ctx, &FabricIfaceParams{
+			NUMANode: numaNode,
+			DevClass: hardware.NetDevClass(resp.ClientNetHint.NetDevClass),
+			Provider: prov,
+			Visible:  visible,
+		})
+		if err != nil {
+			mod.log.Debugf("no fabric interface for prioritized provider %s: %s", prov, err)
+			provErrs = append(provErrs, fmt.Sprintf("%s: %s", prov, err))
+			continue
+		}
+
+		return resp, fi, nil
+	}
+
+	if len(provErrs) == 0 {
+		supported := make([]string, 0, len(sysProviders))
+		for prov := range sysProviders {
+			supported = append(supported, prov)
+		}
+		sort.Strings(supported)
+		return nil, nil, errors.Errorf("none of the providers in provider_priority (%s) are supported by the system (supports: %s)",
+			strings.Join(mod.providerPriority, ", "), strings.Join(supported, ", "))
+	}
+
+	return nil, nil, errors.Errorf("no fabric interface found for any prioritized provider: %s",
+		strings.Join(provErrs, "; "))
+}
+
 func (mod *mgmtModule) getIfaceProviders(ctx context.Context, iface, domain string, ndc hardware.NetDevClass) common.StringSet {
 	providers := common.NewStringSet()
 	if iface == "" {
@@ -332,7 +460,7 @@ func (mod *mgmtModule) getFabricInterface(ctx context.Context, params *FabricIfa
 	return mod.cache.GetFabricDevice(ctx, params)
 }
 
-func (mod *mgmtModule) populateNUMAFabricMap(ctx context.Context, resp *mgmtpb.GetAttachInfoResp) error {
+func (mod *mgmtModule) populateNUMAFabricMap(ctx context.Context, resp *mgmtpb.GetAttachInfoResp, visible common.StringSet) error {
 	numaMap, unlockMap, err := mod.cache.GetNUMAFabricMap(ctx, hardware.NetDevClass(resp.ClientNetHint.NetDevClass), resp.ClientNetHint.Provider)
 	if err != nil {
 		return err
@@ -350,6 +478,9 @@ func (mod *mgmtModule) populateNUMAFabricMap(ctx context.Context, resp *mgmtpb.G
 		if exists {
 			pbFIs.Ifaces = make([]*mgmtpb.FabricInterface, 0, len(fis))
 			for _, fi := range fis {
+				if visible != nil && !visible.Has(fi.Name) {
+					continue
+				}
 				if fi.HasProvider(resp.ClientNetHint.Provider) || fi.NetDevClass == FabricDevClassManual {
 					pbFIs.Ifaces = append(pbFIs.Ifaces, &mgmtpb.FabricInterface{
 						NumaNode:  uint32(numaNode),
@@ -367,7 +498,7 @@ func (mod *mgmtModule) populateNUMAFabricMap(ctx context.Context, resp *mgmtpb.G
 	return nil
 }
 
-func (mod *mgmtModule) handleSetupClientTelemetry(ctx context.Context, reqb []byte, cred *unix.Ucred) ([]byte, error) {
+func (mod *mgmtModule) handleSetupClientTelemetry(ctx context.Context, reqb []byte, cred *security.DomainInfo) ([]byte, error) {
 	if len(reqb) == 0 {
 		return nil, errors.New("empty request")
 	}
@@ -386,15 +517,16 @@ func (mod *mgmtModule) handleSetupClientTelemetry(ctx context.Context, reqb []by
 		return nil, errors.New("nil user credentials")
 	}
 
-	resp := &mgmtpb.ClientTelemetryResp{AgentUid: int32(unix.Getuid())}
-	if err := telemetry.SetupClientRoot(ctx, pbReq.Jobid, int(cred.Pid), int(pbReq.ShmKey)); err != nil {
+	resp := &mgmtpb.ClientTelemetryResp{AgentUid: int32(os.Getuid())}
+	if err := telemetry.SetupClientRoot(ctx, pbReq.Jobid, int(cred.Pid()), int(pbReq.ShmKey)); err != nil {
 		if cause, ok := errors.Cause(err).(daos.Status); ok {
 			resp.Status = int32(cause)
 		} else {
 			return nil, err
 		}
 	}
-	mod.log.Tracef("%d: %s", cred.Pid, pblog.Debug(resp))
+	mod.log.Tracef("%d: %s", cred.Pid(), pblog.Debug(resp))
+	setStatusHint(ctx, daos.Status(resp.Status))
 	return proto.Marshal(resp)
 }
 
@@ -424,6 +556,93 @@ func (mod *mgmtModule) handleNotifyExit(ctx context.Context, pid int32) {
 	mod.monitor.NotifyExit(ctx, pid)
 }
 
+// handleListClients reports the local client processes known to the agent. As
+// the details of other users' processes are exposed, only the agent's own user
+// and root may list them.
+func (mod *mgmtModule) handleListClients(ctx context.Context, cred *security.DomainInfo) ([]byte, error) {
+	resp := new(mgmtpb.ListClientsResp)
+	if cred.Uid() != 0 && cred.Uid() != uint32(os.Getuid()) {
+		mod.log.Errorf("uid %d: not permitted to list clients", cred.Uid())
+		resp.Status = int32(daos.NoPermission)
+		return proto.Marshal(resp)
+	}
+
+	for _, client := range mod.monitor.ListClients(ctx) {
+		pbClient := &mgmtpb.ClientProcess{
+			Pid:       client.pid,
+			Name:      client.name,
+			Uid:       client.uid,
+			Interface: client.iface,
+			Domain:    client.domain,
+			Provider:  client.provider,
+			NumaNode:  uint32(client.numaNode),
+		}
+		if usr, err := user.LookupId(strconv.Itoa(int(client.uid))); err == nil {
+			pbClient.User = usr.Username
+		}
+
+		pools := make([]string, 0, len(client.pools))
+		for pool := range client.pools {
+			pools = append(pools, pool)
+		}
+		sort.Strings(pools)
+		for _, pool := range pools {
+			pbClient.Pools = append(pbClient.Pools, &mgmtpb.ClientPoolHandles{
+				PoolUuid: pool,
+				Handles:  uint32(client.pools[pool]),
+			})
+		}
+
+		resp.Clients = append(resp.Clients, pbClient)
+	}
+
+	return proto.Marshal(resp)
+}
+
+// handleQueryClientTelemetry returns the client telemetry samples retained by the
+// agent. As the metrics of other users' processes are exposed, only the agent's own
+// user and root may query them.
+func (mod *mgmtModule) handleQueryClientTelemetry(ctx context.Context, reqb []byte, cred *security.DomainInfo) ([]byte, error) {
+	pbReq := new(mgmtpb.QueryClientTelemetryReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	resp := new(mgmtpb.QueryClientTelemetryResp)
+	if cred.Uid() != 0 && cred.Uid() != uint32(os.Getuid()) {
+		mod.log.Errorf("uid %d: not permitted to query client telemetry", cred.Uid())
+		resp.Status = int32(daos.NoPermission)
+		return proto.Marshal(resp)
+	}
+	if mod.cliMetricsHist == nil {
+		resp.Status = int32(daos.NotImpl)
+		return proto.Marshal(resp)
+	}
+
+	filter := &promexp.ClientHistoryFilter{
+		JobID:      pbReq.Jobid,
+		NamePrefix: pbReq.Metric,
+	}
+	if pbReq.Pid != 0 {
+		filter.PID = strconv.Itoa(int(pbReq.Pid))
+	}
+	if pbReq.Since != 0 {
+		filter.Since = time.Unix(0, int64(pbReq.Since))
+	}
+
+	resp.Retain = uint64(mod.cliMetricsHist.Retain())
+	for _, sample := range mod.cliMetricsHist.Query(filter) {
+		resp.Samples = append(resp.Samples, &mgmtpb.ClientTelemetrySample{
+			Timestamp: uint64(sample.Time.UnixNano()),
+			Name:      sample.Name,
+			Labels:    sample.Labels,
+			Value:     sample.Value,
+		})
+	}
+
+	return proto.Marshal(resp)
+}
+
 // RefreshCache triggers a refresh of all data that is currently cached. If nothing has been cached
 // yet, it does nothing.
 func (mod *mgmtModule) RefreshCache(ctx context.Context) error {
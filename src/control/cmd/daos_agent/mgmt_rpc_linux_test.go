@@ -0,0 +1,140 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/telemetry"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestAgent_handleSetupClientTelemetry(t *testing.T) {
+	testCreds := security.InitDomainInfo(&security.Ucred{
+		Uid: 123,
+		Gid: 456,
+	}, "")
+	testSysName := "test-sys"
+	testJobID := "test-job"
+	testShmKey := int32(42)
+
+	for name, tc := range map[string]struct {
+		clientBytes []byte
+		clientReq   *mgmtpb.ClientTelemetryReq
+		clientCred  *security.DomainInfo
+		expResp     *mgmtpb.ClientTelemetryResp
+		expErr      error
+	}{
+		"nil client request": {
+			clientReq:  nil,
+			clientCred: testCreds,
+			expErr:     errors.New("empty request"),
+		},
+		"garbage client request": {
+			clientBytes: []byte("invalid"),
+			clientCred:  testCreds,
+			expErr:      drpc.UnmarshalingPayloadFailure(),
+		},
+		"unset jobid": {
+			clientReq: &mgmtpb.ClientTelemetryReq{
+				Sys:    testSysName,
+				Jobid:  "",
+				ShmKey: testShmKey,
+			},
+			clientCred: testCreds,
+			expErr:     errors.New("empty jobid"),
+		},
+		"unset shm key": {
+			clientReq: &mgmtpb.ClientTelemetryReq{
+				Sys:    testSysName,
+				Jobid:  testJobID,
+				ShmKey: 0,
+			},
+			clientCred: testCreds,
+			expErr:     errors.New("unset shm key"),
+		},
+		"nil user creds": {
+			clientReq: &mgmtpb.ClientTelemetryReq{
+				Sys:    testSysName,
+				Jobid:  testJobID,
+				ShmKey: testShmKey,
+			},
+			clientCred: nil,
+			expErr:     errors.New("nil user credentials"),
+		},
+		"success": {
+			clientReq: &mgmtpb.ClientTelemetryReq{
+				Sys:    testSysName,
+				Jobid:  testJobID,
+				ShmKey: testShmKey,
+			},
+			clientCred: testCreds,
+			expResp: &mgmtpb.ClientTelemetryResp{
+				AgentUid: int32(unix.Getuid()),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			parent := test.MustLogContext(t)
+			log := logging.FromContext(parent)
+
+			mod := &mgmtModule{
+				log: log,
+			}
+
+			var reqBytes []byte
+			if len(tc.clientBytes) > 0 {
+				reqBytes = tc.clientBytes
+			} else {
+				var err error
+				reqBytes, err = proto.Marshal(tc.clientReq)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			testID := uint32(telemetry.NextTestID(telemetry.AgentIDBase))
+			telemetry.InitTestMetricsProducer(t, int(testID), 2048)
+			defer telemetry.CleanupTestMetricsProducer(t)
+
+			ctx, err := telemetry.Init(parent, testID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer telemetry.Fini()
+
+			gotResp, gotErr := mod.handleSetupClientTelemetry(ctx, reqBytes, tc.clientCred)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			expRespBytes, err := proto.Marshal(tc.expResp)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(expRespBytes, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
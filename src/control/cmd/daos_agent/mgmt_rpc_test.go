@@ -1,6 +1,7 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -15,7 +16,6 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
-	"golang.org/x/sys/unix"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 
@@ -24,15 +24,14 @@ import (
 	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
-	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
 	"github.com/daos-stack/daos/src/control/lib/atm"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
-	"github.com/daos-stack/daos/src/control/lib/telemetry"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 func hostResps(resps ...*mgmtpb.GetAttachInfoResp) []*control.HostResponse {
@@ -57,6 +56,26 @@ func TestAgent_mgmtModule_getAttachInfo(t *testing.T) {
 		},
 	}
 
+	multiProvResp := &control.GetAttachInfoResp{
+		System:       "dontcare",
+		ServiceRanks: []*control.PrimaryServiceRank{{Rank: 1, Uri: "my uri"}},
+		AlternateServiceRanks: []*control.PrimaryServiceRank{
+			{Rank: 1, Uri: "ucx uri", ProviderIdx: 1},
+		},
+		MSRanks: []uint32{0, 1, 2, 3},
+		ClientNetHint: control.ClientNetworkHint{
+			Provider:    "ofi+tcp",
+			NetDevClass: uint32(hardware.Ether),
+		},
+		AlternateClientNetHints: []control.ClientNetworkHint{
+			{
+				Provider:    "ucx+tcp",
+				NetDevClass: uint32(hardware.Ether),
+				ProviderIdx: 1,
+			},
+		},
+	}
+
 	testFIS := hardware.NewFabricInterfaceSet(
 		&hardware.FabricInterface{
 			Name:          "test0",
@@ -83,6 +102,14 @@ func TestAgent_mgmtModule_getAttachInfo(t *testing.T) {
 	testFabric := NUMAFabricFromScan(test.Context(t), logging.NewCommandLineLogger(), testFIS)
 	testFabric.getAddrInterface = mockGetAddrInterface
 
+	testSysAccess := &systemAccess{
+		rules: map[string]*AccessControlConfig{
+			testSys:     {DenyUIDs: []uint32{1000}},
+			"other_sys": nil,
+		},
+	}
+	testCred := security.InitDomainInfo(&security.Ucred{Pid: 123, Uid: 1000, Gid: 1000}, "")
+
 	reqBytes := func(req *mgmtpb.GetAttachInfoReq) []byte {
 		t.Helper()
 		bytes, err := proto.Marshal(req)
@@ -110,7 +137,10 @@ func TestAgent_mgmtModule_getAttachInfo(t *testing.T) {
 		mockFabricScan    fabricScanFn
 		mockGetNetIfaces  func() ([]net.Interface, error)
 		numaGetter        *mockNUMAProvider
+		netNSGetter       netNSProvider
 		fabricCfg         []*NUMAFabricConfig
+		providerPriority  []string
+		access            *systemAccess
 		reqBytes          []byte
 		expResp           *mgmtpb.GetAttachInfoResp
 		expErr            error
@@ -123,6 +153,31 @@ func TestAgent_mgmtModule_getAttachInfo(t *testing.T) {
 			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{Sys: "bad"}),
 			expResp:  &mgmtpb.GetAttachInfoResp{Status: int32(daos.InvalidInput)},
 		},
+		"other system": {
+			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{Sys: "other_sys"}),
+			mockGetAttachInfo: func(_ context.Context, _ control.UnaryInvoker, req *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+				return nil, errors.Errorf("mock GetAttachInfo for %s", req.System)
+			},
+			expErr: errors.New("mock GetAttachInfo for other_sys"),
+		},
+		"access denied": {
+			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{Sys: testSys}),
+			access:   testSysAccess,
+			expResp:  &mgmtpb.GetAttachInfoResp{Status: int32(daos.NoPermission)},
+		},
+		"access denied to default system": {
+			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{}),
+			access:   testSysAccess,
+			expResp:  &mgmtpb.GetAttachInfoResp{Status: int32(daos.NoPermission)},
+		},
+		"access allowed to other system": {
+			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{Sys: "other_sys"}),
+			access:   testSysAccess,
+			mockGetAttachInfo: func(_ context.Context, _ control.UnaryInvoker, req *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+				return nil, errors.Errorf("mock GetAttachInfo for %s", req.System)
+			},
+			expErr: errors.New("mock GetAttachInfo for other_sys"),
+		},
 		"get NUMA fails": {
 			reqBytes:   reqBytes(&mgmtpb.GetAttachInfoReq{Sys: testSys}),
 			numaGetter: &mockNUMAProvider{GetNUMANodeIDForPIDErr: errors.New("mock get NUMA")},
@@ -205,6 +260,76 @@ func TestAgent_mgmtModule_getAttachInfo(t *testing.T) {
 				},
 			}),
 		},
+		"client in same network namespace": {
+			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{Sys: testSys}),
+			netNSGetter: &mockNetNSProvider{
+				netNS: map[string]*netNSInfo{
+					procSelf: {ID: "net:[1]", Ifaces: common.NewStringSet("test0", "test1")},
+					"123":    {ID: "net:[1]", Ifaces: common.NewStringSet("test0", "test1")},
+				},
+			},
+			expResp: respWith(testResp, "test1", "dev1", []*mgmtpb.FabricInterfaces{
+				{
+					Ifaces: []*mgmtpb.FabricInterface{
+						{
+							Interface: "test0",
+							Domain:    "test0",
+							Provider:  "ofi+tcp",
+						},
+					},
+				},
+				{
+					NumaNode: 1,
+				},
+				{
+					NumaNode: 2,
+					Ifaces: []*mgmtpb.FabricInterface{
+						{
+							NumaNode:  2,
+							Interface: "test1",
+							Domain:    "dev1",
+							Provider:  "ofi+tcp",
+						},
+					},
+				},
+			}),
+		},
+		"client in other network namespace": {
+			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{Sys: testSys}),
+			netNSGetter: &mockNetNSProvider{
+				netNS: map[string]*netNSInfo{
+					procSelf: {ID: "net:[1]", Ifaces: common.NewStringSet("test0", "test1")},
+					"123":    {ID: "net:[2]", Ifaces: common.NewStringSet("lo", "test0")},
+				},
+			},
+			expResp: respWith(testResp, "test0", "test0", []*mgmtpb.FabricInterfaces{
+				{
+					Ifaces: []*mgmtpb.FabricInterface{
+						{
+							Interface: "test0",
+							Domain:    "test0",
+							Provider:  "ofi+tcp",
+						},
+					},
+				},
+				{
+					NumaNode: 1,
+				},
+				{
+					NumaNode: 2,
+				},
+			}),
+		},
+		"no interfaces in client network namespace": {
+			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{Sys: testSys}),
+			netNSGetter: &mockNetNSProvider{
+				netNS: map[string]*netNSInfo{
+					procSelf: {ID: "net:[1]", Ifaces: common.NewStringSet("test0", "test1")},
+					"123":    {ID: "net:[2]", Ifaces: common.NewStringSet("lo", "eth0")},
+				},
+			},
+			expErr: errors.New("client network namespace net:[2] differs"),
+		},
 		"req interface/domain": {
 			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{
 				Sys:       testSys,
@@ -296,6 +421,80 @@ func TestAgent_mgmtModule_getAttachInfo(t *testing.T) {
 				},
 			}),
 		},
+		"provider priority selects primary provider": {
+			reqBytes:         reqBytes(&mgmtpb.GetAttachInfoReq{Sys: testSys}),
+			providerPriority: []string{"ofi+verbs", "ofi+tcp", "ucx+tcp"},
+			mockGetAttachInfo: func(_ context.Context, _ control.UnaryInvoker, _ *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+				return multiProvResp, nil
+			},
+			expResp: respWith(multiProvResp, "test1", "dev1", []*mgmtpb.FabricInterfaces{
+				{
+					Ifaces: []*mgmtpb.FabricInterface{
+						{
+							Interface: "test0",
+							Domain:    "test0",
+							Provider:  "ofi+tcp",
+						},
+					},
+				},
+				{
+					NumaNode: 1,
+				},
+				{
+					NumaNode: 2,
+					Ifaces: []*mgmtpb.FabricInterface{
+						{
+							NumaNode:  2,
+							Interface: "test1",
+							Domain:    "dev1",
+							Provider:  "ofi+tcp",
+						},
+					},
+				},
+			}),
+		},
+		"provider priority selects secondary provider": {
+			reqBytes:         reqBytes(&mgmtpb.GetAttachInfoReq{Sys: testSys}),
+			providerPriority: []string{"ucx+tcp", "ofi+tcp"},
+			mockGetAttachInfo: func(_ context.Context, _ control.UnaryInvoker, _ *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+				return multiProvResp, nil
+			},
+			expResp: &mgmtpb.GetAttachInfoResp{
+				RankUris: []*mgmtpb.GetAttachInfoResp_RankUri{
+					{Rank: 1, Uri: "ucx uri", ProviderIdx: 1},
+				},
+				MsRanks: []uint32{0, 1, 2, 3},
+				ClientNetHint: &mgmtpb.ClientNetHint{
+					Provider:    "ucx+tcp",
+					Interface:   "test1",
+					Domain:      "dev1",
+					NetDevClass: uint32(hardware.Ether),
+					ProviderIdx: 1,
+				},
+				NumaFabricInterfaces: []*mgmtpb.FabricInterfaces{
+					{},
+					{
+						NumaNode: 1,
+					},
+					{
+						NumaNode: 2,
+						Ifaces: []*mgmtpb.FabricInterface{
+							{
+								NumaNode:  2,
+								Interface: "test1",
+								Domain:    "dev1",
+								Provider:  "ucx+tcp",
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider priority with no supported providers": {
+			reqBytes:         reqBytes(&mgmtpb.GetAttachInfoReq{Sys: testSys}),
+			providerPriority: []string{"ofi+verbs", "ucx+dc"},
+			expErr:           errors.New("none of the providers in provider_priority"),
+		},
 		"incompatible error": {
 			reqBytes: reqBytes(&mgmtpb.GetAttachInfoReq{}),
 			mockGetAttachInfo: func(_ context.Context, _ control.UnaryInvoker, _ *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
@@ -367,13 +566,17 @@ func TestAgent_mgmtModule_getAttachInfo(t *testing.T) {
 				ic.EnableStaticFabricCache(test.Context(t), nf)
 			}
 			mod := &mgmtModule{
-				log:        log,
-				sys:        testSys,
-				cache:      ic,
-				numaGetter: tc.numaGetter,
+				log:              log,
+				sys:              testSys,
+				otherSystems:     common.NewStringSet("other_sys"),
+				cache:            ic,
+				numaGetter:       tc.numaGetter,
+				netNSGetter:      tc.netNSGetter,
+				providerPriority: tc.providerPriority,
+				access:           tc.access,
 			}
 
-			respBytes, err := mod.handleGetAttachInfo(test.Context(t), tc.reqBytes, 123)
+			respBytes, err := mod.handleGetAttachInfo(test.Context(t), tc.reqBytes, testCred)
 
 			test.CmpErr(t, tc.expErr, err)
 
@@ -454,7 +657,7 @@ func TestAgent_mgmtModule_getAttachInfo_Parallel(t *testing.T) {
 			_, err := mod.getAttachInfo(test.Context(t), 0,
 				&mgmtpb.GetAttachInfoReq{
 					Sys: sysName,
-				})
+				}, nil)
 			if err != nil {
 				panic(errors.Wrapf(err, "thread %d", n))
 			}
@@ -501,6 +704,12 @@ func TestAgent_mgmtModule_getNUMANode(t *testing.T) {
 			},
 			expResult: 0,
 		},
+		"unsupported platform": {
+			numaGetter: &mockNUMAProvider{
+				GetNUMANodeIDForPIDErr: hardware.ErrUnsupportedPlatform,
+			},
+			expResult: 0,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -566,115 +775,3 @@ func TestAgent_mgmtModule_RefreshCache(t *testing.T) {
 		})
 	}
 }
-
-func TestAgent_handleSetupClientTelemetry(t *testing.T) {
-	testCreds := &unix.Ucred{
-		Uid: 123,
-		Gid: 456,
-	}
-	testSysName := "test-sys"
-	testJobID := "test-job"
-	testShmKey := int32(42)
-
-	for name, tc := range map[string]struct {
-		clientBytes []byte
-		clientReq   *mgmtpb.ClientTelemetryReq
-		clientCred  *unix.Ucred
-		expResp     *mgmtpb.ClientTelemetryResp
-		expErr      error
-	}{
-		"nil client request": {
-			clientReq:  nil,
-			clientCred: testCreds,
-			expErr:     errors.New("empty request"),
-		},
-		"garbage client request": {
-			clientBytes: []byte("invalid"),
-			clientCred:  testCreds,
-			expErr:      drpc.UnmarshalingPayloadFailure(),
-		},
-		"unset jobid": {
-			clientReq: &mgmtpb.ClientTelemetryReq{
-				Sys:    testSysName,
-				Jobid:  "",
-				ShmKey: testShmKey,
-			},
-			clientCred: testCreds,
-			expErr:     errors.New("empty jobid"),
-		},
-		"unset shm key": {
-			clientReq: &mgmtpb.ClientTelemetryReq{
-				Sys:    testSysName,
-				Jobid:  testJobID,
-				ShmKey: 0,
-			},
-			clientCred: testCreds,
-			expErr:     errors.New("unset shm key"),
-		},
-		"nil user creds": {
-			clientReq: &mgmtpb.ClientTelemetryReq{
-				Sys:    testSysName,
-				Jobid:  testJobID,
-				ShmKey: testShmKey,
-			},
-			clientCred: nil,
-			expErr:     errors.New("nil user credentials"),
-		},
-		"success": {
-			clientReq: &mgmtpb.ClientTelemetryReq{
-				Sys:    testSysName,
-				Jobid:  testJobID,
-				ShmKey: testShmKey,
-			},
-			clientCred: testCreds,
-			expResp: &mgmtpb.ClientTelemetryResp{
-				AgentUid: int32(unix.Getuid()),
-			},
-		},
-	} {
-		t.Run(name, func(t *testing.T) {
-			parent := test.MustLogContext(t)
-			log := logging.FromContext(parent)
-
-			mod := &mgmtModule{
-				log: log,
-			}
-
-			var reqBytes []byte
-			if len(tc.clientBytes) > 0 {
-				reqBytes = tc.clientBytes
-			} else {
-				var err error
-				reqBytes, err = proto.Marshal(tc.clientReq)
-				if err != nil {
-					t.Fatal(err)
-				}
-			}
-
-			testID := uint32(telemetry.NextTestID(telemetry.AgentIDBase))
-			telemetry.InitTestMetricsProducer(t, int(testID), 2048)
-			defer telemetry.CleanupTestMetricsProducer(t)
-
-			ctx, err := telemetry.Init(parent, testID)
-			if err != nil {
-				t.Fatal(err)
-			}
-			defer telemetry.Fini()
-
-			gotResp, gotErr := mod.handleSetupClientTelemetry(ctx, reqBytes, tc.clientCred)
-			test.CmpErr(t, tc.expErr, gotErr)
-			if tc.expErr != nil {
-				return
-			}
-
-			expRespBytes, err := proto.Marshal(tc.expResp)
-			if err != nil {
-				t.Fatal(err)
-			}
-
-			if diff := cmp.Diff(expRespBytes, gotResp, protocmp.Transform()); diff != "" {
-				t.Fatalf("-want, +got:\n%s", diff)
-			}
-		})
-	}
-}
@@ -0,0 +1,122 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+)
+
+const (
+	defaultProcRoot = "/proc"
+	procSelf        = "self"
+)
+
+// netNSInfo describes the network namespace of a process.
+type netNSInfo struct {
+	ID     string           // namespace identifier, e.g. "net:[4026531840]"
+	Ifaces common.StringSet // names of the network interfaces in the namespace
+}
+
+// netNSProvider looks up the network namespace of a process.
+type netNSProvider interface {
+	GetNetNS(pid string) (*netNSInfo, error)
+}
+
+// procNetNSProvider looks up the network namespace of a process via procfs. As the
+// /proc/<pid>/net directory reflects the network namespace of the process, the interfaces
+// visible to the process can be determined without entering its namespace.
+type procNetNSProvider struct {
+	root string
+}
+
+// GetNetNS returns the network namespace of the process with the given ID, or of the
+// calling process if the ID is "self".
+func (p *procNetNSProvider) GetNetNS(pid string) (*netNSInfo, error) {
+	root := p.root
+	if root == "" {
+		root = defaultProcRoot
+	}
+
+	id, err := os.Readlink(filepath.Join(root, pid, "ns", "net"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading network namespace of process %s", pid)
+	}
+
+	f, err := os.Open(filepath.Join(root, pid, "net", "dev"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading network devices of process %s", pid)
+	}
+	defer f.Close()
+
+	ifaces, err := parseNetDev(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing network devices of process %s", pid)
+	}
+
+	return &netNSInfo{
+		ID:     id,
+		Ifaces: ifaces,
+	}, nil
+}
+
+// parseNetDev returns the interface names listed in the contents of a /proc/net/dev file.
+func parseNetDev(r io.Reader) (common.StringSet, error) {
+	ifaces := common.NewStringSet()
+
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		if lineNum <= 2 {
+			continue // column headers
+		}
+
+		name, _, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			return nil, errors.Errorf("malformed line %d: %q", lineNum, scanner.Text())
+		}
+		ifaces.Add(strings.TrimSpace(name))
+	}
+
+	return ifaces, scanner.Err()
+}
+
+// getClientNetNS returns the network namespace of the client process if it differs from that
+// of the agent, or nil if it is the same or cannot be determined, e.g. because the client is
+// not in the agent's PID namespace.
+func (mod *mgmtModule) getClientNetNS(client *procInfo) *netNSInfo {
+	if mod.netNSGetter == nil || client.pid <= 0 {
+		return nil
+	}
+
+	agentNS, err := mod.netNSGetter.GetNetNS(procSelf)
+	if err != nil {
+		mod.log.Debugf("unable to determine agent network namespace: %s", err)
+		return nil
+	}
+
+	clientNS, err := mod.netNSGetter.GetNetNS(strconv.Itoa(int(client.pid)))
+	if err != nil {
+		mod.log.Debugf("%s: unable to determine network namespace: %s", client, err)
+		return nil
+	}
+
+	if clientNS.ID == agentNS.ID {
+		return nil
+	}
+
+	mod.log.Debugf("%s: network namespace %s differs from agent %s (interfaces: %s)", client,
+		clientNS.ID, agentNS.ID, clientNS.Ifaces)
+	return clientNS
+}
@@ -0,0 +1,217 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const testNetDev = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:  123456     789    0    0    0     0          0         0   123456     789    0    0    0     0       0          0
+  eth0: 9876543    4321    0    0    0     0          0         0  1234567    1234    0    0    0     0       0          0
+   ib0:       0       0    0    0    0     0          0         0        0       0    0    0    0     0       0          0
+`
+
+type mockNetNSProvider struct {
+	netNS map[string]*netNSInfo
+}
+
+func (m *mockNetNSProvider) GetNetNS(pid string) (*netNSInfo, error) {
+	ns, found := m.netNS[pid]
+	if !found {
+		return nil, errors.Errorf("no process %s", pid)
+	}
+	return ns, nil
+}
+
+func TestAgent_parseNetDev(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input     string
+		expIfaces common.StringSet
+		expErr    error
+	}{
+		"empty": {
+			expIfaces: common.NewStringSet(),
+		},
+		"headers only": {
+			input:     strings.Join(strings.Split(testNetDev, "\n")[:2], "\n"),
+			expIfaces: common.NewStringSet(),
+		},
+		"interfaces": {
+			input:     testNetDev,
+			expIfaces: common.NewStringSet("lo", "eth0", "ib0"),
+		},
+		"malformed": {
+			input:  testNetDev + "garbage\n",
+			expErr: errors.New("malformed line 6"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotIfaces, gotErr := parseNetDev(strings.NewReader(tc.input))
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expIfaces, gotIfaces); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_procNetNSProvider_GetNetNS(t *testing.T) {
+	mkProc := func(t *testing.T, root, pid, nsID, netDev string) {
+		t.Helper()
+
+		for _, dir := range []string{"ns", "net"} {
+			if err := os.MkdirAll(filepath.Join(root, pid, dir), 0755); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if nsID != "" {
+			if err := os.Symlink(nsID, filepath.Join(root, pid, "ns", "net")); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if netDev != "" {
+			if err := os.WriteFile(filepath.Join(root, pid, "net", "dev"), []byte(netDev), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		pid    string
+		expNS  *netNSInfo
+		expErr error
+	}{
+		"no such process": {
+			pid:    "42",
+			expErr: errors.New("reading network namespace of process 42"),
+		},
+		"no net dev": {
+			pid:    "2",
+			expErr: errors.New("reading network devices of process 2"),
+		},
+		"success": {
+			pid: "1",
+			expNS: &netNSInfo{
+				ID:     "net:[4026531840]",
+				Ifaces: common.NewStringSet("lo", "eth0", "ib0"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			root, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			mkProc(t, root, "1", "net:[4026531840]", testNetDev)
+			mkProc(t, root, "2", "net:[4026532000]", "")
+
+			provider := &procNetNSProvider{root: root}
+			gotNS, gotErr := provider.GetNetNS(tc.pid)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expNS, gotNS); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_mgmtModule_getClientNetNS(t *testing.T) {
+	hostNS := &netNSInfo{
+		ID:     "net:[4026531840]",
+		Ifaces: common.NewStringSet("lo", "eth0", "ib0"),
+	}
+	containerNS := &netNSInfo{
+		ID:     "net:[4026532000]",
+		Ifaces: common.NewStringSet("lo", "eth0"),
+	}
+
+	for name, tc := range map[string]struct {
+		getter netNSProvider
+		pid    int32
+		expNS  *netNSInfo
+	}{
+		"no getter": {
+			pid: 123,
+		},
+		"no pid": {
+			getter: &mockNetNSProvider{
+				netNS: map[string]*netNSInfo{
+					procSelf: hostNS,
+				},
+			},
+		},
+		"agent namespace unknown": {
+			getter: &mockNetNSProvider{
+				netNS: map[string]*netNSInfo{
+					"123": containerNS,
+				},
+			},
+			pid: 123,
+		},
+		"client namespace unknown": {
+			getter: &mockNetNSProvider{
+				netNS: map[string]*netNSInfo{
+					procSelf: hostNS,
+				},
+			},
+			pid: 123,
+		},
+		"same namespace": {
+			getter: &mockNetNSProvider{
+				netNS: map[string]*netNSInfo{
+					procSelf: hostNS,
+					"123":    hostNS,
+				},
+			},
+			pid: 123,
+		},
+		"different namespace": {
+			getter: &mockNetNSProvider{
+				netNS: map[string]*netNSInfo{
+					procSelf: hostNS,
+					"123":    containerNS,
+				},
+			},
+			pid:   123,
+			expNS: containerNS,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mod := &mgmtModule{
+				log:         log,
+				netNSGetter: tc.getter,
+			}
+
+			gotNS := mod.getClientNetNS(&procInfo{pid: tc.pid})
+			if diff := cmp.Diff(tc.expNS, gotNS); diff != "" {
+				t.Fatalf("-want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !windows
+// +build !windows
+
+package main
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// flushHandlesSignal signals the agent to flush the open pool handles.
+	flushHandlesSignal = syscall.SIGUSR1
+	// refreshCacheSignal signals the agent to refresh its caches.
+	refreshCacheSignal = syscall.SIGUSR2
+)
+
+// agentSignals are the signals handled by the agent.
+var agentSignals = []os.Signal{
+	syscall.SIGINT, syscall.SIGTERM, syscall.SIGPIPE,
+	flushHandlesSignal, refreshCacheSignal, syscall.SIGHUP,
+}
+
+// checkSeqPacketSocket returns an error if the socket is not a sequential
+// packet socket.
+func checkSeqPacketSocket(f *os.File) error {
+	sockType, err := syscall.GetsockoptInt(int(f.Fd()), syscall.SOL_SOCKET, syscall.SO_TYPE)
+	if err != nil {
+		return errors.Wrapf(err, "checking type of activated socket %s", f.Name())
+	}
+	if sockType != syscall.SOCK_SEQPACKET {
+		return errors.Errorf("activated socket %s is not a sequential packet socket", f.Name())
+	}
+	return nil
+}
+
+// fileOwnerUID returns the UID of the owner of the file.
+func fileOwnerUID(fi os.FileInfo) (uint32, bool) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, false
+	}
+	return st.Uid, true
+}
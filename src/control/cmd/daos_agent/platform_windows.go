@@ -0,0 +1,37 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// There are no user-defined signals on Windows, so the agent can't be signaled
+// to flush the open pool handles or to refresh its caches. These values are
+// never delivered.
+const (
+	flushHandlesSignal = syscall.Signal(-1)
+	refreshCacheSignal = syscall.Signal(-2)
+)
+
+// agentSignals are the signals handled by the agent.
+var agentSignals = []os.Signal{syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP}
+
+// checkSeqPacketSocket returns an error, as sockets are not passed to the agent
+// by socket activation on Windows.
+func checkSeqPacketSocket(f *os.File) error {
+	return errors.Errorf("socket activation is not supported on this platform (%s)", f.Name())
+}
+
+// fileOwnerUID returns false, as file ownership is not reported by UID on
+// Windows.
+func fileOwnerUID(fi os.FileInfo) (uint32, bool) {
+	return 0, false
+}
@@ -0,0 +1,131 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type (
+	// attachInfoRefresher refreshes cached attach info.
+	attachInfoRefresher interface {
+		RefreshAttachInfo(context.Context) error
+	}
+
+	// poolChangeAdvisor advises local clients of a pool membership change.
+	poolChangeAdvisor interface {
+		AdvisePoolChange(ctx context.Context, poolUUID string)
+	}
+
+	// poolChangeMonitor polls the MS for changes to the target membership
+	// of pools, e.g. due to extends or completed rebuilds, and refreshes the
+	// cached attach info so that the rank information served to clients is
+	// kept current.
+	poolChangeMonitor struct {
+		log     logging.Logger
+		sys     string
+		client  control.UnaryInvoker
+		cache   attachInfoRefresher
+		advisor poolChangeAdvisor // optional
+		lastSeq uint64
+		started bool
+	}
+)
+
+func newPoolChangeMonitor(log logging.Logger, sys string, client control.UnaryInvoker, cache attachInfoRefresher, advisor poolChangeAdvisor) *poolChangeMonitor {
+	return &poolChangeMonitor{
+		log:     log,
+		sys:     sys,
+		client:  client,
+		cache:   cache,
+		advisor: advisor,
+	}
+}
+
+// check requests the pool membership changes recorded since the last check and
+// acts on them. The first check only establishes the starting point.
+func (m *poolChangeMonitor) check(ctx context.Context) error {
+	req := &control.PoolMembershipChangesReq{AfterSeq: m.lastSeq}
+	if !m.started {
+		// Only the latest sequence number is needed.
+		req.AfterSeq = math.MaxUint64
+	}
+	req.SetSystem(m.sys)
+
+	resp, err := control.PoolMembershipChanges(ctx, m.client, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to get pool membership changes")
+	}
+
+	switch {
+	case !m.started:
+		m.started = true
+		m.lastSeq = resp.LastSeq
+		return nil
+	case resp.LastSeq < m.lastSeq:
+		// The recorded events have been reset, e.g. by a system erase,
+		// so any changes since the last check are unknown.
+		m.log.Noticef("pool membership change history reset, refreshing attach info")
+	case resp.Resync:
+		// Some changes since the last check were discarded before they
+		// could be seen, so any pool may have changed.
+		m.log.Noticef("pool membership changes missed, refreshing attach info")
+	case len(resp.Changes) == 0:
+		m.lastSeq = resp.LastSeq
+		return nil
+	default:
+		for _, change := range resp.Changes {
+			m.log.Noticef("pool %s: target membership changed by %s, refreshing attach info",
+				change.PoolUUID, change.Op)
+		}
+	}
+
+	if err := m.cache.RefreshAttachInfo(ctx); err != nil {
+		// Retry the same changes on the next check.
+		return errors.Wrap(err, "failed to refresh attach info")
+	}
+	m.lastSeq = resp.LastSeq
+
+	if m.advisor != nil {
+		pools := common.NewStringSet()
+		for _, change := range resp.Changes {
+			pools.Add(change.PoolUUID)
+		}
+		for _, poolUUID := range pools.ToSlice() {
+			m.advisor.AdvisePoolChange(ctx, poolUUID)
+		}
+	}
+
+	return nil
+}
+
+// run checks for pool membership changes at the given interval until the
+// context is canceled.
+func (m *poolChangeMonitor) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.check(ctx); err != nil {
+			m.log.Errorf("pool membership change check failed: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,193 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockAttachInfoRefresher struct {
+	refreshErr error
+	refreshed  int
+}
+
+func (m *mockAttachInfoRefresher) RefreshAttachInfo(_ context.Context) error {
+	m.refreshed++
+	return m.refreshErr
+}
+
+type mockPoolChangeAdvisor struct {
+	advised []string
+}
+
+func (m *mockPoolChangeAdvisor) AdvisePoolChange(_ context.Context, poolUUID string) {
+	m.advised = append(m.advised, poolUUID)
+}
+
+func TestAgent_poolChangeMonitor_check(t *testing.T) {
+	mockChange := func(seq uint64, idx int32, op string) *mgmtpb.PoolMembershipChange {
+		return &mgmtpb.PoolMembershipChange{
+			Seq:      seq,
+			PoolUuid: test.MockUUID(idx),
+			Op:       op,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		started      bool
+		lastSeq      uint64
+		noAdvisor    bool
+		resp         *mgmtpb.PoolMembershipChangesResp
+		respErr      error
+		refreshErr   error
+		expAfterSeq  uint64
+		expErr       error
+		expLastSeq   uint64
+		expRefreshed int
+		expAdvised   []string
+	}{
+		"first check sets starting point": {
+			resp:        &mgmtpb.PoolMembershipChangesResp{LastSeq: 10},
+			expAfterSeq: math.MaxUint64,
+			expLastSeq:  10,
+		},
+		"request fails": {
+			started:     true,
+			lastSeq:     10,
+			respErr:     errors.New("mock failure"),
+			expAfterSeq: 10,
+			expErr:      errors.New("mock failure"),
+			expLastSeq:  10,
+		},
+		"no changes": {
+			started:     true,
+			lastSeq:     10,
+			resp:        &mgmtpb.PoolMembershipChangesResp{LastSeq: 12},
+			expAfterSeq: 10,
+			expLastSeq:  12,
+		},
+		"changes": {
+			started: true,
+			lastSeq: 10,
+			resp: &mgmtpb.PoolMembershipChangesResp{
+				Changes: []*mgmtpb.PoolMembershipChange{
+					mockChange(11, 2, "extend"),
+					mockChange(12, 1, "rebuild"),
+					mockChange(13, 2, "rebuild"),
+				},
+				LastSeq: 14,
+			},
+			expAfterSeq:  10,
+			expLastSeq:   14,
+			expRefreshed: 1,
+			expAdvised:   []string{test.MockUUID(1), test.MockUUID(2)},
+		},
+		"changes without advisor": {
+			started:   true,
+			lastSeq:   10,
+			noAdvisor: true,
+			resp: &mgmtpb.PoolMembershipChangesResp{
+				Changes: []*mgmtpb.PoolMembershipChange{
+					mockChange(11, 1, "drain"),
+				},
+				LastSeq: 11,
+			},
+			expAfterSeq:  10,
+			expLastSeq:   11,
+			expRefreshed: 1,
+		},
+		"refresh fails": {
+			started: true,
+			lastSeq: 10,
+			resp: &mgmtpb.PoolMembershipChangesResp{
+				Changes: []*mgmtpb.PoolMembershipChange{
+					mockChange(11, 1, "extend"),
+				},
+				LastSeq: 11,
+			},
+			refreshErr:   errors.New("mock refresh"),
+			expAfterSeq:  10,
+			expErr:       errors.New("mock refresh"),
+			expLastSeq:   10,
+			expRefreshed: 1,
+		},
+		"changes discarded": {
+			started: true,
+			lastSeq: 10,
+			resp: &mgmtpb.PoolMembershipChangesResp{
+				Changes: []*mgmtpb.PoolMembershipChange{
+					mockChange(1500, 1, "extend"),
+				},
+				LastSeq: 2000,
+				Resync:  true,
+			},
+			expAfterSeq:  10,
+			expLastSeq:   2000,
+			expRefreshed: 1,
+			expAdvised:   []string{test.MockUUID(1)},
+		},
+		"changes discarded without retained changes": {
+			started:      true,
+			lastSeq:      10,
+			resp:         &mgmtpb.PoolMembershipChangesResp{LastSeq: 2000, Resync: true},
+			expAfterSeq:  10,
+			expLastSeq:   2000,
+			expRefreshed: 1,
+		},
+		"history reset": {
+			started:      true,
+			lastSeq:      10,
+			resp:         &mgmtpb.PoolMembershipChangesResp{LastSeq: 2},
+			expAfterSeq:  10,
+			expLastSeq:   2,
+			expRefreshed: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", tc.respErr, tc.resp),
+			})
+
+			refresher := &mockAttachInfoRefresher{refreshErr: tc.refreshErr}
+			advisor := &mockPoolChangeAdvisor{}
+			m := newPoolChangeMonitor(log, "test_sys", mi, refresher, advisor)
+			if tc.noAdvisor {
+				m.advisor = nil
+			}
+			m.started = tc.started
+			m.lastSeq = tc.lastSeq
+
+			err := m.check(test.Context(t))
+			test.CmpErr(t, tc.expErr, err)
+
+			test.AssertEqual(t, 1, len(mi.SentReqs), "unexpected number of requests")
+			req, ok := mi.SentReqs[0].(*control.PoolMembershipChangesReq)
+			test.AssertTrue(t, ok, "unexpected request type")
+			test.AssertEqual(t, tc.expAfterSeq, req.AfterSeq, "unexpected request sequence")
+
+			test.AssertTrue(t, m.started, "monitor not started")
+			test.AssertEqual(t, tc.expLastSeq, m.lastSeq, "unexpected last sequence")
+			test.AssertEqual(t, tc.expRefreshed, refresher.refreshed, "unexpected refresh count")
+			if diff := cmp.Diff(tc.expAdvised, advisor.advised); diff != "" {
+				t.Fatalf("unexpected advised pools (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
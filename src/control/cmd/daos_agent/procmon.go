@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,8 +11,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/drpc"
@@ -22,7 +26,12 @@ import (
 
 const (
 	// Agent-internal methods not linked to engine handlers.
-	flushAllHandles drpc.MgmtMethod = drpc.MgmtMethod(^uint32(0) >> 1)
+	flushAllHandles  drpc.MgmtMethod = drpc.MgmtMethod(^uint32(0) >> 1)
+	evictNodeHandles drpc.MgmtMethod = flushAllHandles - 1
+	advisePoolChange drpc.MgmtMethod = evictNodeHandles - 1
+	countClients     drpc.MgmtMethod = advisePoolChange - 1
+	recordAttach     drpc.MgmtMethod = countClients - 1
+	listClients      drpc.MgmtMethod = recordAttach - 1
 )
 
 // dbgId returns a truncated representation of the UUID string.
@@ -43,6 +52,12 @@ type procMonRequest struct {
 	// supply a channel to be closed when the request is
 	// complete.
 	doneChan chan struct{}
+	// Set to the number of monitored processes for a countClients request.
+	count *int
+	// The attach info supplied to the process for a recordAttach request.
+	attach *clientAttach
+	// Set to the known client processes for a listClients request.
+	clients *[]*clientInfo
 }
 
 type procMonResponse struct {
@@ -61,6 +76,23 @@ func (phm poolHandleMap) add(poolUUID, handleUUID string) {
 	phm[poolUUID].Add(handleUUID)
 }
 
+// clientAttach records the attach info that was supplied to a client process.
+type clientAttach struct {
+	name     string
+	numaNode uint
+	iface    string
+	domain   string
+	provider string
+}
+
+// clientInfo describes a local client process known to the agent.
+type clientInfo struct {
+	clientAttach
+	pid   int32
+	uid   uint32
+	pools map[string]int // number of open handles per pool
+}
+
 type procInfo struct {
 	log       logging.Logger
 	pid       int32
@@ -76,6 +108,21 @@ func checkProcPidExists(pid int32) error {
 	return err
 }
 
+// getProcUID returns the UID of the owner of the process.
+func getProcUID(pid int32) (uint32, error) {
+	fi, err := os.Stat(fmt.Sprintf("/proc/%d", pid))
+	if err != nil {
+		return 0, err
+	}
+
+	uid, ok := fileOwnerUID(fi)
+	if !ok {
+		return 0, errors.Errorf("unable to get owner of pid %d", pid)
+	}
+
+	return uid, nil
+}
+
 func (p *procInfo) sendResponse(ctx context.Context, pid int32, err error) {
 	response := &procMonResponse{
 		pid: pid,
@@ -126,6 +173,7 @@ func (p *procInfo) String() string {
 type procMon struct {
 	log        logging.Logger
 	procs      map[int32]*procInfo
+	attached   map[int32]*clientAttach
 	request    chan *procMonRequest
 	response   chan *procMonResponse
 	ctlInvoker control.Invoker
@@ -138,6 +186,7 @@ func NewProcMon(logger logging.Logger, ctlInvoker control.Invoker, systemName st
 	return &procMon{
 		log:        logger,
 		procs:      make(map[int32]*procInfo),
+		attached:   make(map[int32]*clientAttach),
 		request:    make(chan *procMonRequest),
 		response:   make(chan *procMonResponse),
 		ctlInvoker: ctlInvoker,
@@ -146,6 +195,14 @@ func NewProcMon(logger logging.Logger, ctlInvoker control.Invoker, systemName st
 }
 
 func (p *procMon) AddPoolHandle(ctx context.Context, Pid int32, poolReq *mgmtpb.PoolMonitorReq) {
+	// Leaked handles are only evicted from the pools of the agent's own
+	// system, so don't track handles to the pools of other systems.
+	if poolReq.Sys != "" && poolReq.Sys != p.systemName {
+		p.log.Debugf("pid %d: not tracking handle to pool %s in system %s", Pid,
+			dbgId(poolReq.PoolUUID), poolReq.Sys)
+		return
+	}
+
 	req := &procMonRequest{
 		pid:            Pid,
 		action:         drpc.MethodNotifyPoolConnect,
@@ -192,6 +249,84 @@ func (p *procMon) FlushAllHandles(ctx context.Context) {
 	<-done
 }
 
+// EvictNodeHandles submits a request to evict all pool handles associated
+// with this machine, including any that were opened by processes that are not
+// known to this agent instance. Blocks until the request has been completely
+// processed.
+func (p *procMon) EvictNodeHandles(ctx context.Context) {
+	var onShutdown string
+	if agentIsShuttingDown(ctx) {
+		onShutdown = " on shutdown"
+	}
+	p.log.Infof("evicting all pool handles for this machine%s", onShutdown)
+
+	done := make(chan struct{})
+	p.submitRequest(ctx, &procMonRequest{
+		action:   evictNodeHandles,
+		doneChan: done,
+	})
+
+	<-done
+}
+
+// AdvisePoolChange submits a request to advise the local DAOS client
+// processes with open handles on the pool that the pool's target membership
+// has changed, and that they may reconnect to rebalance their connections.
+func (p *procMon) AdvisePoolChange(ctx context.Context, poolUUID string) {
+	p.submitRequest(ctx, &procMonRequest{
+		action:   advisePoolChange,
+		poolUUID: poolUUID,
+	})
+}
+
+// NumClients returns the number of local DAOS client processes with open pool
+// handles.
+func (p *procMon) NumClients(ctx context.Context) int {
+	var count int
+	done := make(chan struct{})
+	p.submitRequest(ctx, &procMonRequest{
+		action:   countClients,
+		doneChan: done,
+		count:    &count,
+	})
+
+	select {
+	case <-ctx.Done():
+		return 0
+	case <-done:
+		return count
+	}
+}
+
+// RecordAttach submits a request to record the attach info that was supplied
+// to a local DAOS client process.
+func (p *procMon) RecordAttach(ctx context.Context, pid int32, attach *clientAttach) {
+	p.submitRequest(ctx, &procMonRequest{
+		pid:    pid,
+		action: recordAttach,
+		attach: attach,
+	})
+}
+
+// ListClients returns the local DAOS client processes that have been supplied
+// attach info or have open pool handles, ordered by PID.
+func (p *procMon) ListClients(ctx context.Context) []*clientInfo {
+	var clients []*clientInfo
+	done := make(chan struct{})
+	p.submitRequest(ctx, &procMonRequest{
+		action:   listClients,
+		doneChan: done,
+		clients:  &clients,
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-done:
+		return clients
+	}
+}
+
 func (p *procMon) submitRequest(ctx context.Context, request *procMonRequest) {
 	select {
 	case <-ctx.Done():
@@ -283,6 +418,8 @@ func (p *procMon) cleanupLeakedHandles(ctx context.Context, info *procInfo) {
 }
 
 func (p *procMon) handleNotifyExit(ctx context.Context, request *procMonRequest) {
+	delete(p.attached, request.pid)
+
 	info, found := p.procs[request.pid]
 	if found {
 		info.cancelCtx()
@@ -309,6 +446,77 @@ func (p *procMon) flushAllHandles(ctx context.Context) {
 	p.cleanupLeakedHandles(ctx, &procInfo{handles: allPoolHandles})
 }
 
+func (p *procMon) evictNodeHandles(ctx context.Context) {
+	p.cleanupServerHandles(ctx)
+
+	// The handles opened by local processes were included in the
+	// machine-wide eviction, so there is no need to keep tracking them.
+	for pid, info := range p.procs {
+		if info.cancelCtx != nil {
+			info.cancelCtx()
+		}
+		delete(p.procs, pid)
+	}
+}
+
+func (p *procMon) advisePoolChange(request *procMonRequest) {
+	for _, info := range p.procs {
+		handles, found := info.handles[request.poolUUID]
+		if !found || len(handles) == 0 {
+			continue
+		}
+		p.log.Noticef("pool %s: target membership changed, %s has %d open handle(s) and may reconnect to rebalance its connections",
+			dbgId(request.poolUUID), info, len(handles))
+	}
+}
+
+func (p *procMon) listClients() []*clientInfo {
+	clients := make(map[int32]*clientInfo)
+	for pid, attach := range p.attached {
+		// Processes without pool handles aren't monitored, so prune the
+		// ones that have exited.
+		if checkProcPidExists(pid) != nil {
+			delete(p.attached, pid)
+			continue
+		}
+		clients[pid] = &clientInfo{
+			clientAttach: *attach,
+			pid:          pid,
+		}
+	}
+
+	for pid, info := range p.procs {
+		client, found := clients[pid]
+		if !found {
+			client = &clientInfo{
+				clientAttach: clientAttach{name: info.name},
+				pid:          pid,
+			}
+			clients[pid] = client
+		}
+		client.pools = make(map[string]int)
+		for pool, handles := range info.handles {
+			client.pools[pool] = len(handles)
+		}
+	}
+
+	list := make([]*clientInfo, 0, len(clients))
+	for _, client := range clients {
+		uid, err := getProcUID(client.pid)
+		if err != nil {
+			p.log.Debugf("pid %d: not listing client: %s", client.pid, err)
+			continue
+		}
+		client.uid = uid
+		list = append(list, client)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].pid < list[j].pid
+	})
+
+	return list
+}
+
 func (p *procMon) handleRequests(ctx context.Context) {
 	for {
 		select {
@@ -324,6 +532,16 @@ func (p *procMon) handleRequests(ctx context.Context) {
 				p.handleNotifyExit(ctx, request)
 			case flushAllHandles:
 				p.flushAllHandles(ctx)
+			case evictNodeHandles:
+				p.evictNodeHandles(ctx)
+			case advisePoolChange:
+				p.advisePoolChange(request)
+			case countClients:
+				*request.count = len(p.procs)
+			case recordAttach:
+				p.attached[request.pid] = request.attach
+			case listClients:
+				*request.clients = p.listClients()
 			default:
 				p.log.Errorf("failed to handle request with invalid action type %s", request.action)
 			}
@@ -0,0 +1,88 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security/auth"
+)
+
+func TestAgent_procMon_EvictNodeHandles(t *testing.T) {
+	machine, err := auth.GetMachineName()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		numHandles int
+		respErr    error
+		resp       *mgmtpb.SystemCleanupResp
+	}{
+		"no tracked handles": {
+			resp: &mgmtpb.SystemCleanupResp{},
+		},
+		"tracked handles": {
+			numHandles: 2,
+			resp: &mgmtpb.SystemCleanupResp{
+				Results: []*mgmtpb.SystemCleanupResp_CleanupResult{
+					{PoolId: test.MockUUID(1), Count: 3},
+				},
+			},
+		},
+		"cleanup fails": {
+			numHandles: 2,
+			respErr:    errors.New("mock cleanup"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			defer cancel()
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", tc.respErr, tc.resp),
+			})
+			pm := NewProcMon(log, mi, "daos_server")
+			go pm.handleRequests(ctx)
+
+			pid := int32(os.Getpid())
+			for i := 0; i < tc.numHandles; i++ {
+				pm.AddPoolHandle(ctx, pid, &mgmtpb.PoolMonitorReq{
+					PoolUUID:       test.MockUUID(1),
+					PoolHandleUUID: test.MockUUID(int32(i + 2)),
+				})
+			}
+			if tc.numHandles > 0 {
+				test.AssertEqual(t, 1, pm.NumClients(ctx), "unexpected client count")
+			}
+
+			pm.EvictNodeHandles(ctx)
+
+			// A single machine-wide cleanup covers the tracked handles,
+			// so no per-pool evictions are sent.
+			test.AssertEqual(t, 1, len(mi.SentReqs), "unexpected number of requests")
+			req, ok := mi.SentReqs[0].(*control.SystemCleanupReq)
+			test.AssertTrue(t, ok, "unexpected request type")
+			test.AssertEqual(t, machine, req.Machine, "unexpected machine")
+
+			// The cleanup is best-effort, so the local processes are
+			// no longer tracked even if it failed.
+			test.AssertEqual(t, 0, pm.NumClients(ctx), "processes still tracked")
+		})
+	}
+}
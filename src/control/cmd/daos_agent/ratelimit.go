@@ -0,0 +1,261 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// defaultMSQueueTimeout is the default maximum time that a request may wait
+	// for the rate limiter before it is dropped.
+	defaultMSQueueTimeout = 10 * time.Second
+
+	dropReasonRateLimit    = "rate_limit"
+	dropReasonQueueTimeout = "queue_timeout"
+	dropReasonCanceled     = "canceled"
+)
+
+// errMSRequestDropped indicates that a request was not sent to the Management
+// Service because it could not be admitted by the agent's rate limiter in time.
+var errMSRequestDropped = errors.New("management service request dropped by agent rate limiter")
+
+// tokenBucket implements a token bucket which is refilled at a fixed rate up to
+// a maximum burst size. Tokens may be reserved ahead of their availability, so
+// that waiting callers are admitted in order.
+type tokenBucket struct {
+	sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+
+	tb := &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+	tb.last = tb.now()
+
+	return tb
+}
+
+// reserve takes a token from the bucket and returns the time until the token is
+// available. If the token would not be available within maxWait, it is not
+// taken and false is returned.
+func (tb *tokenBucket) reserve(maxWait time.Duration) (time.Duration, bool) {
+	tb.Lock()
+	defer tb.Unlock()
+
+	now := tb.now()
+	tb.tokens = math.Min(tb.burst, tb.tokens+now.Sub(tb.last).Seconds()*tb.rate)
+	tb.last = now
+
+	tb.tokens--
+	if tb.tokens >= 0 {
+		return 0, true
+	}
+
+	wait := time.Duration(-tb.tokens / tb.rate * float64(time.Second))
+	if wait > maxWait {
+		tb.tokens++
+		return wait, false
+	}
+
+	return wait, true
+}
+
+// cancel returns an unused reserved token to the bucket.
+func (tb *tokenBucket) cancel() {
+	tb.Lock()
+	defer tb.Unlock()
+
+	tb.tokens = math.Min(tb.burst, tb.tokens+1)
+}
+
+// msRateLimiterMetrics contains the telemetry for the Management Service rate
+// limiter.
+type msRateLimiterMetrics struct {
+	dropped   *prometheus.CounterVec
+	queueTime prometheus.Histogram
+	queued    prometheus.Gauge
+	inFlight  prometheus.Gauge
+}
+
+func newMSRateLimiterMetrics() *msRateLimiterMetrics {
+	return &msRateLimiterMetrics{
+		dropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "agent",
+			Subsystem: "ms",
+			Name:      "requests_dropped_total",
+			Help:      "Number of management service requests dropped by the agent rate limiter.",
+		}, []string{"reason"}),
+		queueTime: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "agent",
+			Subsystem: "ms",
+			Name:      "request_queue_seconds",
+			Help:      "Time spent by management service requests waiting for the agent rate limiter.",
+			Buckets:   []float64{.001, .005, .01, .05, .1, .5, 1, 5, 10, 30},
+		}),
+		queued: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "agent",
+			Subsystem: "ms",
+			Name:      "requests_queued",
+			Help:      "Number of management service requests waiting for the agent rate limiter.",
+		}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "agent",
+			Subsystem: "ms",
+			Name:      "requests_in_flight",
+			Help:      "Number of management service requests in progress.",
+		}),
+	}
+}
+
+// msRateLimiter limits the rate and concurrency of requests sent by the agent to
+// the Management Service, so that a large number of client processes starting
+// at once on a node cannot flood the service.
+type msRateLimiter struct {
+	log          logging.Logger
+	bucket       *tokenBucket
+	slots        chan struct{}
+	queueTimeout time.Duration
+	metrics      *msRateLimiterMetrics
+}
+
+// newMSRateLimiter creates a rate limiter based on the agent configuration, or
+// returns nil if neither a rate limit nor a concurrency cap is configured.
+func newMSRateLimiter(log logging.Logger, cfg *Config) *msRateLimiter {
+	if cfg.MSRateLimit <= 0 && cfg.MSMaxConcurrent <= 0 {
+		return nil
+	}
+
+	rl := &msRateLimiter{
+		log:          log,
+		queueTimeout: cfg.MSQueueTimeout,
+		metrics:      newMSRateLimiterMetrics(),
+	}
+	if rl.queueTimeout <= 0 {
+		rl.queueTimeout = defaultMSQueueTimeout
+	}
+	if cfg.MSRateLimit > 0 {
+		rl.bucket = newTokenBucket(cfg.MSRateLimit, cfg.MSRateBurst)
+	}
+	if cfg.MSMaxConcurrent > 0 {
+		rl.slots = make(chan struct{}, cfg.MSMaxConcurrent)
+	}
+
+	return rl
+}
+
+// collectors returns the rate limiter's telemetry collectors.
+func (rl *msRateLimiter) collectors() []prometheus.Collector {
+	if rl == nil {
+		return nil
+	}
+
+	return []prometheus.Collector{
+		rl.metrics.dropped,
+		rl.metrics.queueTime,
+		rl.metrics.queued,
+		rl.metrics.inFlight,
+	}
+}
+
+func (rl *msRateLimiter) drop(ctx context.Context, reason string, queued time.Duration) error {
+	if ctx.Err() != nil {
+		rl.metrics.dropped.WithLabelValues(dropReasonCanceled).Inc()
+		return ctx.Err()
+	}
+
+	rl.metrics.dropped.WithLabelValues(reason).Inc()
+	rl.log.Debugf("MS request dropped after %s (%s)", queued, reason)
+	return errors.Wrapf(errMSRequestDropped, "%s after %s", reason, queued)
+}
+
+// acquire waits until a request may be sent to the Management Service. On
+// success, the returned function must be called when the request has completed.
+func (rl *msRateLimiter) acquire(ctx context.Context) (func(), error) {
+	start := time.Now()
+	rl.metrics.queued.Inc()
+	defer rl.metrics.queued.Dec()
+
+	waitCtx, cancel := context.WithTimeout(ctx, rl.queueTimeout)
+	defer cancel()
+
+	if rl.bucket != nil {
+		wait, ok := rl.bucket.reserve(rl.queueTimeout)
+		if !ok {
+			return nil, rl.drop(ctx, dropReasonRateLimit, time.Since(start))
+		}
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+
+			select {
+			case <-waitCtx.Done():
+				rl.bucket.cancel()
+				return nil, rl.drop(ctx, dropReasonQueueTimeout, time.Since(start))
+			case <-timer.C:
+			}
+		}
+	}
+
+	if rl.slots != nil {
+		select {
+		case <-waitCtx.Done():
+			return nil, rl.drop(ctx, dropReasonQueueTimeout, time.Since(start))
+		case rl.slots <- struct{}{}:
+		}
+	}
+
+	rl.metrics.queueTime.Observe(time.Since(start).Seconds())
+	rl.metrics.inFlight.Inc()
+
+	return func() {
+		rl.metrics.inFlight.Dec()
+		if rl.slots != nil {
+			<-rl.slots
+		}
+	}, nil
+}
+
+// rateLimitedInvoker wraps a control.Invoker in order to apply the rate limiter
+// to each unary request.
+type rateLimitedInvoker struct {
+	control.Invoker
+	limiter *msRateLimiter
+}
+
+// InvokeUnaryRPC waits for the rate limiter before invoking the request.
+func (ri *rateLimitedInvoker) InvokeUnaryRPC(ctx context.Context, req control.UnaryRequest) (*control.UnaryResponse, error) {
+	release, err := ri.limiter.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return ri.Invoker.InvokeUnaryRPC(ctx, req)
+}
@@ -0,0 +1,223 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_tokenBucket_reserve(t *testing.T) {
+	start := time.Now()
+	now := start
+	tb := newTokenBucket(2, 0)
+	tb.now = func() time.Time { return now }
+	tb.last = now
+
+	// The burst defaults to the rate.
+	for i := 0; i < 2; i++ {
+		wait, ok := tb.reserve(time.Second)
+		if !ok || wait != 0 {
+			t.Fatalf("reservation %d: expected immediate token, got wait=%s ok=%t", i, wait, ok)
+		}
+	}
+
+	wait, ok := tb.reserve(time.Second)
+	if !ok || wait != 500*time.Millisecond {
+		t.Fatalf("expected to wait 500ms, got wait=%s ok=%t", wait, ok)
+	}
+
+	// The next token would only be available after 1s, which exceeds the max wait.
+	if wait, ok = tb.reserve(500 * time.Millisecond); ok {
+		t.Fatalf("expected reservation to fail, got wait=%s", wait)
+	}
+
+	wait, ok = tb.reserve(time.Second)
+	if !ok || wait != time.Second {
+		t.Fatalf("expected to wait 1s, got wait=%s ok=%t", wait, ok)
+	}
+	tb.cancel()
+
+	// After refilling for long enough, the bucket is capped at the burst size.
+	now = start.Add(time.Minute)
+	for i := 0; i < 2; i++ {
+		if wait, ok := tb.reserve(0); !ok || wait != 0 {
+			t.Fatalf("reservation %d after refill: expected immediate token, got wait=%s ok=%t", i, wait, ok)
+		}
+	}
+	if _, ok := tb.reserve(0); ok {
+		t.Fatal("expected bucket to be empty")
+	}
+}
+
+func TestAgent_newMSRateLimiter(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg             *Config
+		expNil          bool
+		expBucket       bool
+		expSlots        int
+		expQueueTimeout time.Duration
+	}{
+		"disabled": {
+			cfg:    DefaultConfig(),
+			expNil: true,
+		},
+		"rate only": {
+			cfg: &Config{
+				MSRateLimit: 10,
+			},
+			expBucket:       true,
+			expQueueTimeout: defaultMSQueueTimeout,
+		},
+		"concurrency only": {
+			cfg: &Config{
+				MSMaxConcurrent: 4,
+				MSQueueTimeout:  time.Second,
+			},
+			expSlots:        4,
+			expQueueTimeout: time.Second,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			rl := newMSRateLimiter(log, tc.cfg)
+			if tc.expNil {
+				if rl != nil {
+					t.Fatal("expected nil rate limiter")
+				}
+				if len(rl.collectors()) != 0 {
+					t.Fatal("expected no collectors for nil rate limiter")
+				}
+				return
+			}
+
+			test.AssertEqual(t, tc.expBucket, rl.bucket != nil, "unexpected bucket")
+			test.AssertEqual(t, tc.expSlots, cap(rl.slots), "unexpected slots")
+			test.AssertEqual(t, tc.expQueueTimeout, rl.queueTimeout, "unexpected queue timeout")
+			test.AssertEqual(t, 4, len(rl.collectors()), "unexpected collectors")
+		})
+	}
+}
+
+func getDroppedCount(t *testing.T, rl *msRateLimiter, reason string) float64 {
+	t.Helper()
+
+	var m dto.Metric
+	if err := rl.metrics.dropped.WithLabelValues(reason).Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetCounter().GetValue()
+}
+
+func TestAgent_msRateLimiter_acquire(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	rl := newMSRateLimiter(log, &Config{
+		MSMaxConcurrent: 1,
+		MSQueueTimeout:  10 * time.Millisecond,
+	})
+
+	release, err := rl.acquire(test.Context(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The only slot is in use, so the request is dropped after the queue timeout.
+	_, err = rl.acquire(test.Context(t))
+	test.CmpErr(t, errMSRequestDropped, err)
+	if !errors.Is(err, errMSRequestDropped) {
+		t.Fatalf("expected errMSRequestDropped, got %v", err)
+	}
+	test.AssertEqual(t, float64(1), getDroppedCount(t, rl, dropReasonQueueTimeout), "unexpected drops")
+
+	// A canceled request is counted separately.
+	ctx, cancel := context.WithCancel(test.Context(t))
+	cancel()
+	_, err = rl.acquire(ctx)
+	test.CmpErr(t, context.Canceled, err)
+	test.AssertEqual(t, float64(1), getDroppedCount(t, rl, dropReasonCanceled), "unexpected drops")
+
+	release()
+	release, err = rl.acquire(test.Context(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+
+	var m dto.Metric
+	if err := rl.metrics.queueTime.Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint64(2), m.GetHistogram().GetSampleCount(), "unexpected queue time samples")
+}
+
+func TestAgent_msRateLimiter_acquire_RateLimit(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	rl := newMSRateLimiter(log, &Config{
+		MSRateLimit:    1,
+		MSQueueTimeout: 10 * time.Millisecond,
+	})
+
+	release, err := rl.acquire(test.Context(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+
+	// The next token will not be available within the queue timeout.
+	_, err = rl.acquire(test.Context(t))
+	test.CmpErr(t, errMSRequestDropped, err)
+	test.AssertEqual(t, float64(1), getDroppedCount(t, rl, dropReasonRateLimit), "unexpected drops")
+}
+
+func TestAgent_rateLimitedInvoker(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+		UnaryResponse: control.MockMSResponse("host1", nil, nil),
+	})
+	rl := newMSRateLimiter(log, &Config{
+		MSMaxConcurrent: 1,
+		MSQueueTimeout:  10 * time.Millisecond,
+	})
+	ri := &rateLimitedInvoker{
+		Invoker: mi,
+		limiter: rl,
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := ri.InvokeUnaryRPC(test.Context(t), &control.SystemCleanupReq{}); err != nil {
+			t.Fatalf("request %d: %s", i, err)
+		}
+	}
+	test.AssertEqual(t, 3, len(mi.SentReqs), "unexpected sent requests")
+
+	// Hold the only slot so that the next request is dropped without being sent.
+	release, err := rl.acquire(test.Context(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	_, err = ri.InvokeUnaryRPC(test.Context(t), &control.SystemCleanupReq{})
+	test.CmpErr(t, errMSRequestDropped, err)
+	test.AssertEqual(t, 3, len(mi.SentReqs), "unexpected sent requests")
+}
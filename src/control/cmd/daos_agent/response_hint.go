@@ -0,0 +1,54 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+const (
+	// msRetryAfter is the delay suggested to clients before retrying a
+	// request that failed because the management service was unavailable.
+	msRetryAfter = 2 * time.Second
+	// busyRetryAfter is the delay suggested to clients before retrying a
+	// request that was dropped because the agent was busy.
+	busyRetryAfter = time.Second
+	// shutdownRetryAfter is the delay suggested to clients before retrying a
+	// request that failed because the agent was shutting down, allowing
+	// time for the agent to be restarted.
+	shutdownRetryAfter = 5 * time.Second
+)
+
+// statusHint classifies a DAOS status reported to a client, and suggests a
+// delay before retrying the request if the failure is transient.
+func statusHint(status daos.Status) (drpc.ErrorClass, time.Duration) {
+	switch status {
+	case daos.Success:
+		return drpc.ErrorClass_NONE, 0
+	case daos.Unreachable, daos.TimedOut, daos.NoService:
+		return drpc.ErrorClass_TRANSIENT, msRetryAfter
+	case daos.TryAgain, daos.Busy:
+		return drpc.ErrorClass_TRANSIENT, busyRetryAfter
+	case daos.BadCert, daos.NoPermission:
+		return drpc.ErrorClass_AUTH, 0
+	case daos.ControlIncompatible, daos.InvalidInput:
+		return drpc.ErrorClass_CONFIG, 0
+	default:
+		return drpc.ErrorClass_FATAL, 0
+	}
+}
+
+// setStatusHint sets the classification of a DAOS status reported in the body
+// of the response to the dRPC call being handled.
+func setStatusHint(ctx context.Context, status daos.Status) {
+	class, retryAfter := statusHint(status)
+	drpc.SetResponseHint(ctx, class, retryAfter)
+}
@@ -0,0 +1,62 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+func TestAgent_statusHint(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status        daos.Status
+		expClass      drpc.ErrorClass
+		expRetryAfter time.Duration
+	}{
+		"success": {
+			status:   daos.Success,
+			expClass: drpc.ErrorClass_NONE,
+		},
+		"MS unreachable": {
+			status:        daos.Unreachable,
+			expClass:      drpc.ErrorClass_TRANSIENT,
+			expRetryAfter: msRetryAfter,
+		},
+		"request dropped": {
+			status:        daos.TryAgain,
+			expClass:      drpc.ErrorClass_TRANSIENT,
+			expRetryAfter: busyRetryAfter,
+		},
+		"bad certificate": {
+			status:   daos.BadCert,
+			expClass: drpc.ErrorClass_AUTH,
+		},
+		"wrong system": {
+			status:   daos.ControlIncompatible,
+			expClass: drpc.ErrorClass_CONFIG,
+		},
+		"unknown system name": {
+			status:   daos.InvalidInput,
+			expClass: drpc.ErrorClass_CONFIG,
+		},
+		"other failure": {
+			status:   daos.MiscError,
+			expClass: drpc.ErrorClass_FATAL,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			class, retryAfter := statusHint(tc.status)
+
+			test.AssertEqual(t, tc.expClass, class, "unexpected error class")
+			test.AssertEqual(t, tc.expRetryAfter, retryAfter, "unexpected retry delay")
+		})
+	}
+}
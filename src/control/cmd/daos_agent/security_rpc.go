@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -169,14 +170,14 @@ func (m *SecurityModule) getCredential(ctx context.Context, session *drpc.Sessio
 	info, err := security.DomainInfoFromUnixConn(m.log, uConn)
 	if err != nil {
 		m.log.Errorf("Unable to get credentials for client socket: %s", err)
-		return m.credRespWithStatus(daos.MiscError)
+		return m.credRespWithStatus(ctx, daos.MiscError)
 	}
 
 	signingKey, err := m.config.transport.PrivateKey()
 	if err != nil {
 		m.log.Errorf("%s: failed to get signing key: %s", info, err)
 		// something is wrong with the cert config
-		return m.credRespWithStatus(daos.BadCert)
+		return m.credRespWithStatus(ctx, daos.BadCert)
 	}
 
 	req := auth.NewCredentialRequest(info, signingKey)
@@ -201,7 +202,7 @@ func (m *SecurityModule) getCredential(ctx context.Context, session *drpc.Sessio
 			return nil
 		}(); err != nil {
 			m.log.Errorf("%s: failed to get user credential: %s", info, err)
-			return m.credRespWithStatus(daos.MiscError)
+			return m.credRespWithStatus(ctx, daos.MiscError)
 		}
 	}
 
@@ -209,7 +210,8 @@ func (m *SecurityModule) getCredential(ctx context.Context, session *drpc.Sessio
 	return drpc.Marshal(resp)
 }
 
-func (m *SecurityModule) credRespWithStatus(status daos.Status) ([]byte, error) {
+func (m *SecurityModule) credRespWithStatus(ctx context.Context, status daos.Status) ([]byte, error) {
+	setStatusHint(ctx, status)
 	resp := &auth.GetCredResp{Status: int32(status)}
 	return drpc.Marshal(resp)
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,13 +11,12 @@ import (
 	"context"
 	"errors"
 	"net"
+	"os"
 	"os/user"
-	"syscall"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
-	"golang.org/x/sys/unix"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 
@@ -242,7 +242,7 @@ func TestAgent_SecurityRPC_getCredential(t *testing.T) {
 			responses: []signCredentialResp{
 				{
 					cred: nil,
-					err:  user.UnknownUserIdError(unix.Getuid()),
+					err:  user.UnknownUserIdError(os.Getuid()),
 				},
 			},
 			expBytes: miscErrBytes,
@@ -251,7 +251,7 @@ func TestAgent_SecurityRPC_getCredential(t *testing.T) {
 			secCfg: func() *securityConfig {
 				cfg := defaultTestSecurityConfig()
 				cfg.credentials.ClientUserMap = security.ClientUserMap{
-					uint32(unix.Getuid()): &security.MappedClientUser{
+					uint32(os.Getuid()): &security.MappedClientUser{
 						User: "test-user",
 					},
 				}
@@ -260,7 +260,7 @@ func TestAgent_SecurityRPC_getCredential(t *testing.T) {
 			responses: []signCredentialResp{
 				{
 					cred: nil,
-					err:  user.UnknownUserIdError(unix.Getuid()),
+					err:  user.UnknownUserIdError(os.Getuid()),
 				},
 				{
 					cred: testCred,
@@ -273,7 +273,7 @@ func TestAgent_SecurityRPC_getCredential(t *testing.T) {
 			secCfg: func() *securityConfig {
 				cfg := defaultTestSecurityConfig()
 				cfg.credentials.ClientUserMap = security.ClientUserMap{
-					uint32(unix.Getuid()): &security.MappedClientUser{
+					uint32(os.Getuid()): &security.MappedClientUser{
 						User: "test-user",
 					},
 				}
@@ -282,7 +282,7 @@ func TestAgent_SecurityRPC_getCredential(t *testing.T) {
 			responses: []signCredentialResp{
 				{
 					cred: nil,
-					err:  user.UnknownUserIdError(unix.Getuid()),
+					err:  user.UnknownUserIdError(os.Getuid()),
 				},
 				{
 					cred: nil,
@@ -345,7 +345,7 @@ func TestAgent_SecurityCachedCredentials(t *testing.T) {
 		"cache hit": {
 			lifetime: time.Second,
 			req: &auth.CredentialRequest{
-				DomainInfo: security.InitDomainInfo(&syscall.Ucred{Uid: 1234, Gid: 5678}, ""),
+				DomainInfo: security.InitDomainInfo(&security.Ucred{Uid: 1234, Gid: 5678}, ""),
 			},
 			responses: []signCredentialResp{
 				{
@@ -362,7 +362,7 @@ func TestAgent_SecurityCachedCredentials(t *testing.T) {
 		"expired entry": {
 			lifetime: time.Nanosecond,
 			req: &auth.CredentialRequest{
-				DomainInfo: security.InitDomainInfo(&syscall.Ucred{Uid: 1234, Gid: 5678}, ""),
+				DomainInfo: security.InitDomainInfo(&security.Ucred{Uid: 1234, Gid: 5678}, ""),
 			},
 			responses: []signCredentialResp{
 				{
@@ -0,0 +1,77 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// flightCall is a call of a flightGroup that is in flight.
+type flightCall[T any] struct {
+	done chan struct{}
+	val  T
+	err  error
+}
+
+// flightGroup coalesces concurrent calls that have the same key into a single
+// execution of the call, the results of which are shared by all of the callers.
+// Unlike serializing the callers on a lock, each of the callers that joined the
+// call receives its result (or error) as soon as the call completes.
+type flightGroup[T any] struct {
+	mutex   sync.Mutex
+	calls   map[string]*flightCall[T]
+	timeout time.Duration // limit on the execution of a call, if non-zero
+}
+
+// Do executes fn and returns its results, unless a call with the same key is
+// already in flight, in which case it waits for that call to complete and
+// returns the results of that call. The returned joined value is true if the
+// caller joined a call in flight. As the results of a call are shared by all of
+// the callers that joined it, they must not be modified by the callers.
+//
+// The call is not canceled when the context of the caller that started it is
+// done, as other callers may be waiting for it; fn is passed a context that is
+// only limited by the timeout of the group. Each caller, including the one that
+// started the call, stops waiting for the call if its own context is done first.
+func (g *flightGroup[T]) Do(ctx context.Context, key string, fn func(context.Context) (T, error)) (val T, err error, joined bool) {
+	g.mutex.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall[T])
+	}
+	call, joined := g.calls[key]
+	if !joined {
+		call = &flightCall[T]{done: make(chan struct{})}
+		g.calls[key] = call
+		go g.run(context.WithoutCancel(ctx), key, call, fn)
+	}
+	g.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call.val, call.err, joined
+	case <-ctx.Done():
+		return val, ctx.Err(), joined
+	}
+}
+
+// run executes fn for the call and signals its completion to the callers.
+func (g *flightGroup[T]) run(ctx context.Context, key string, call *flightCall[T], fn func(context.Context) (T, error)) {
+	if g.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, g.timeout)
+		defer cancel()
+	}
+
+	call.val, call.err = fn(ctx)
+
+	g.mutex.Lock()
+	delete(g.calls, key)
+	g.mutex.Unlock()
+	close(call.done)
+}
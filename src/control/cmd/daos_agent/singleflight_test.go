@@ -0,0 +1,210 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+// waitingCtx calls waiting when a caller of flightGroup.Do starts waiting on
+// it for the result of a call.
+type waitingCtx struct {
+	context.Context
+	once    sync.Once
+	waiting func()
+}
+
+func (c *waitingCtx) Done() <-chan struct{} {
+	c.once.Do(c.waiting)
+	return c.Context.Done()
+}
+
+func TestAgent_flightGroup_Do(t *testing.T) {
+	const numCallers = 16
+
+	for name, tc := range map[string]struct {
+		fnErr  error
+		expVal int
+	}{
+		"success": {
+			expVal: 42,
+		},
+		"failure": {
+			fnErr: errors.New("mock fn"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var group flightGroup[int]
+			var calls, joined atomic.Int32
+
+			// Complete the call once all of the callers are waiting for it.
+			var waiting sync.WaitGroup
+			waiting.Add(numCallers)
+			fn := func(context.Context) (int, error) {
+				calls.Add(1)
+				waiting.Wait()
+				return tc.expVal, tc.fnErr
+			}
+
+			var wg sync.WaitGroup
+			errs := make(chan error, numCallers)
+			for i := 0; i < numCallers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					ctx := &waitingCtx{Context: test.Context(t), waiting: waiting.Done}
+					val, err, j := group.Do(ctx, "key", fn)
+					if j {
+						joined.Add(1)
+					}
+					if val != tc.expVal {
+						errs <- errors.Errorf("want %d, got %d", tc.expVal, val)
+					}
+					if err != tc.fnErr {
+						errs <- errors.Errorf("want error %v, got %v", tc.fnErr, err)
+					}
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				t.Error(err)
+			}
+			test.AssertEqual(t, int32(1), calls.Load(), "unexpected number of calls")
+			test.AssertEqual(t, int32(numCallers-1), joined.Load(), "unexpected number of joined callers")
+
+			// Once the call has completed, the next call executes fn again.
+			waiting.Add(1)
+			ctx := &waitingCtx{Context: test.Context(t), waiting: waiting.Done}
+			if _, _, j := group.Do(ctx, "key", fn); j {
+				t.Fatal("unexpected join of completed call")
+			}
+			test.AssertEqual(t, int32(2), calls.Load(), "unexpected number of calls")
+		})
+	}
+}
+
+func TestAgent_flightGroup_Do_Keys(t *testing.T) {
+	var group flightGroup[string]
+	release := make(chan struct{})
+	started := make(chan struct{}, 2)
+
+	var wg sync.WaitGroup
+	results := make([]string, 2)
+	for i, key := range []string{"a", "b"} {
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			results[i], _, _ = group.Do(test.Context(t), key, func(context.Context) (string, error) {
+				started <- struct{}{}
+				<-release
+				return key, nil
+			})
+		}(i, key)
+	}
+
+	// Both calls must be in flight at the same time.
+	<-started
+	<-started
+	close(release)
+	wg.Wait()
+
+	test.AssertEqual(t, "a", results[0], "")
+	test.AssertEqual(t, "b", results[1], "")
+}
+
+func TestAgent_flightGroup_Do_Canceled(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cancelFirst bool // cancel the caller that started the call
+	}{
+		"joined caller canceled": {},
+		"first caller canceled":  {cancelFirst: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var group flightGroup[int]
+			release := make(chan struct{})
+			started := make(chan struct{})
+			fnCtxErr := make(chan error, 1)
+
+			firstCtx, cancelFirst := context.WithCancel(test.Context(t))
+			defer cancelFirst()
+			joinedBase, cancelJoined := context.WithCancel(test.Context(t))
+			defer cancelJoined()
+			joinedWaiting := make(chan struct{})
+			joinedCtx := &waitingCtx{
+				Context: joinedBase,
+				waiting: func() { close(joinedWaiting) },
+			}
+
+			type result struct {
+				val    int
+				err    error
+				joined bool
+			}
+			firstRes := make(chan result, 1)
+			go func() {
+				val, err, joined := group.Do(firstCtx, "key", func(ctx context.Context) (int, error) {
+					close(started)
+					<-release
+					fnCtxErr <- ctx.Err()
+					return 1, nil
+				})
+				firstRes <- result{val, err, joined}
+			}()
+			<-started
+
+			joinedRes := make(chan result, 1)
+			go func() {
+				val, err, joined := group.Do(joinedCtx, "key", func(context.Context) (int, error) {
+					t.Error("unexpected call")
+					return 0, nil
+				})
+				joinedRes <- result{val, err, joined}
+			}()
+
+			canceledRes, otherRes := joinedRes, firstRes
+			if tc.cancelFirst {
+				cancelFirst()
+				canceledRes, otherRes = firstRes, joinedRes
+			} else {
+				cancelJoined()
+			}
+
+			// The canceled caller stops waiting while the call is in flight.
+			res := <-canceledRes
+			test.CmpErr(t, context.Canceled, res.err)
+			test.AssertEqual(t, !tc.cancelFirst, res.joined, "unexpected joined value")
+
+			// The call continues for the other caller.
+			<-joinedWaiting
+			close(release)
+			test.CmpErr(t, nil, <-fnCtxErr)
+			res = <-otherRes
+			test.CmpErr(t, nil, res.err)
+			test.AssertEqual(t, 1, res.val, "unexpected value")
+		})
+	}
+}
+
+func TestAgent_flightGroup_Do_Timeout(t *testing.T) {
+	group := flightGroup[int]{timeout: time.Millisecond}
+
+	_, err, _ := group.Do(test.Context(t), "key", func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	test.CmpErr(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1,87 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// applyStandalone modifies the config so that the agent requires neither
+// certificates nor a reachable MS quorum. Unless a static attach info file is
+// served, the attach info is requested from the daos_server running on the
+// local node over the loopback interface.
+func applyStandalone(log logging.Logger, cfg *Config) {
+	if cfg == nil || !cfg.Standalone {
+		return
+	}
+
+	log.Debug("standalone mode: disabling certificate verification")
+	cfg.TransportConfig.AllowInsecure = true
+
+	localServer := fmt.Sprintf("localhost:%d", cfg.ControlPort)
+	log.Debugf("standalone mode: overriding access points %v with %s", cfg.AccessPoints, localServer)
+	cfg.AccessPoints = []string{localServer}
+}
+
+// loadStaticAttachInfo reads the attach info to be served in standalone mode
+// from a file. The file may contain either the attach info or the JSON output
+// of the dump-attachinfo command.
+func loadStaticAttachInfo(path string) (*control.GetAttachInfoResp, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading standalone attach info")
+	}
+
+	var wrapped struct {
+		Response *control.GetAttachInfoResp `json:"response"`
+	}
+	if err := json.Unmarshal(buf, &wrapped); err != nil {
+		return nil, errors.Wrapf(err, "decoding %s", path)
+	}
+	resp := wrapped.Response
+	if resp == nil {
+		resp = new(control.GetAttachInfoResp)
+		if err := json.Unmarshal(buf, resp); err != nil {
+			return nil, errors.Wrapf(err, "decoding %s", path)
+		}
+	}
+
+	if resp.ClientNetHint.Provider == "" {
+		return nil, errors.Errorf("attach info in %s contains no provider", path)
+	}
+	if len(resp.ServiceRanks) == 0 {
+		return nil, errors.Errorf("attach info in %s contains no rank URIs", path)
+	}
+
+	return resp, nil
+}
+
+// EnableStaticAttachInfo sets up the cache to serve the static attach info to
+// clients rather than requesting it from the MS. The attach info is not saved
+// in the runtime directory.
+func (c *InfoCache) EnableStaticAttachInfo(resp *control.GetAttachInfoResp) {
+	if c == nil || resp == nil {
+		return
+	}
+
+	c.getAttachInfoCb = func(_ context.Context, _ control.UnaryInvoker, req *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+		if resp.System != "" && req.System != "" && req.System != resp.System {
+			return nil, errors.Errorf("standalone attach info is for system %q, not %q",
+				resp.System, req.System)
+		}
+		return copyGetAttachInfoResp(resp), nil
+	}
+	c.attachInfoStore = nil
+}
@@ -0,0 +1,186 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestAgent_applyStandalone(t *testing.T) {
+	for name, tc := range map[string]struct {
+		standalone  bool
+		expInsecure bool
+		expAPs      []string
+	}{
+		"not standalone": {
+			expAPs: []string{"one:10001", "two:10001"},
+		},
+		"standalone": {
+			standalone:  true,
+			expInsecure: true,
+			expAPs:      []string{"localhost:4242"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := DefaultConfig()
+			cfg.ControlPort = 4242
+			cfg.AccessPoints = []string{"one:10001", "two:10001"}
+			cfg.TransportConfig = security.DefaultAgentTransportConfig()
+			cfg.TransportConfig.AllowInsecure = false
+			cfg.Standalone = tc.standalone
+
+			applyStandalone(log, cfg)
+
+			test.AssertEqual(t, tc.expInsecure, cfg.TransportConfig.AllowInsecure, "unexpected AllowInsecure")
+			if diff := cmp.Diff(tc.expAPs, cfg.AccessPoints); diff != "" {
+				t.Fatalf("unexpected access points (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_loadStaticAttachInfo(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	expResp := &control.GetAttachInfoResp{
+		System:       "daos_server",
+		ServiceRanks: []*control.PrimaryServiceRank{{Rank: 0, Uri: "tcp://127.0.0.1:31416"}},
+		MSRanks:      []uint32{0},
+		ClientNetHint: control.ClientNetworkHint{
+			Provider:  "ofi+tcp",
+			Interface: "lo",
+		},
+	}
+	rawInfo := `{
+  "sys": "daos_server",
+  "rank_uris": [{"rank": 0, "uri": "tcp://127.0.0.1:31416"}],
+  "ms_ranks": [0],
+  "client_net_hint": {"provider": "ofi+tcp", "interface": "lo"}
+}`
+
+	for name, tc := range map[string]struct {
+		content string
+		noFile  bool
+		expResp *control.GetAttachInfoResp
+		expErr  error
+	}{
+		"missing file": {
+			noFile: true,
+			expErr: errors.New("reading standalone attach info"),
+		},
+		"not JSON": {
+			content: "name daos_server\nsize 1\nall\n",
+			expErr:  errors.New("decoding"),
+		},
+		"no provider": {
+			content: `{"rank_uris": [{"rank": 0, "uri": "tcp://127.0.0.1:31416"}]}`,
+			expErr:  errors.New("contains no provider"),
+		},
+		"no ranks": {
+			content: `{"client_net_hint": {"provider": "ofi+tcp"}}`,
+			expErr:  errors.New("contains no rank URIs"),
+		},
+		"attach info": {
+			content: rawInfo,
+			expResp: expResp,
+		},
+		"dump-attachinfo JSON output": {
+			content: `{"response": ` + rawInfo + `, "error": null, "status": 0}`,
+			expResp: expResp,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := dir + "/missing.json"
+			if !tc.noFile {
+				path = test.CreateTestFile(t, dir, tc.content)
+			}
+
+			resp, err := loadStaticAttachInfo(path)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, resp); diff != "" {
+				t.Fatalf("unexpected attach info (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_InfoCache_EnableStaticAttachInfo(t *testing.T) {
+	staticResp := &control.GetAttachInfoResp{
+		System:       "daos_server",
+		ServiceRanks: []*control.PrimaryServiceRank{{Rank: 0, Uri: "tcp://127.0.0.1:31416"}},
+		MSRanks:      []uint32{0},
+		ClientNetHint: control.ClientNetworkHint{
+			Provider:    "ofi+tcp",
+			NetDevClass: uint32(hardware.Loopback),
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		disableCache bool
+		system       string
+		expResp      *control.GetAttachInfoResp
+		expErr       error
+	}{
+		"cached": {
+			expResp: staticResp,
+		},
+		"cache disabled": {
+			disableCache: true,
+			system:       "daos_server",
+			expResp:      staticResp,
+		},
+		"other system": {
+			system: "mordor",
+			expErr: errors.New("standalone attach info is for system \"daos_server\""),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ic := newTestInfoCache(t, log, testInfoCacheParams{
+				mockGetAttachInfo: func(_ context.Context, _ control.UnaryInvoker, _ *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+					return nil, errors.New("MS should not be contacted")
+				},
+				disableAttachInfoCache: tc.disableCache,
+			})
+			ic.attachInfoStore = newAttachInfoStore(log, t.TempDir()+"/cache.json", "daos_server")
+
+			ic.EnableStaticAttachInfo(staticResp)
+			test.AssertTrue(t, ic.attachInfoStore == nil, "expected attach info not to be saved")
+
+			resp, err := ic.GetAttachInfo(test.Context(t), tc.system)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, resp); diff != "" {
+				t.Fatalf("unexpected attach info (-want, +got):\n%s\n", diff)
+			}
+			test.AssertTrue(t, ic.MSConnStatus().Ready, "expected agent to be ready")
+		})
+	}
+}
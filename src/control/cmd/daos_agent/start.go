@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,19 +12,25 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/atm"
-	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hardware/hwloc"
 	"github.com/daos-stack/daos/src/control/lib/systemd"
 	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
+	"github.com/daos-stack/daos/src/control/pbin"
 )
 
 type ctxKey string
@@ -73,8 +80,55 @@ func (cmd *startCmd) Execute(_ []string) error {
 	}
 	cmd.Debugf("created dRPC server: %s", time.Since(createDrpcStart))
 
+	// If the agent was started by systemd socket activation, use the socket
+	// created by systemd, so that client connections are queued while the
+	// agent is restarted.
+	activatedFiles, err := systemd.ListenFDs(true)
+	if err != nil {
+		return errors.Wrap(err, "unable to get sockets from systemd")
+	}
+	activatedLis, err := activatedListener(cmd.Logger, sockPath, activatedFiles)
+	if err != nil {
+		return err
+	}
+	if activatedLis != nil {
+		cmd.Debugf("using socket %s passed by systemd", activatedLis.Addr())
+		drpcServer.UseListener(activatedLis)
+	}
+
+	sysAccess := newSystemAccess(cmd.Logger, cmd.cfg)
+	if sysAccess != nil {
+		drpcServer.SetConnFilter(sysAccess.ConnFilter)
+		cmd.Debug("agent socket access control enabled")
+	}
+
+	if cmd.cfg.TelemetryOTLPTraces.Enabled() {
+		if err := startSpanExport(ctx, cmd.Logger, cmd.ctlInvoker, cmd.cfg); err != nil {
+			return err
+		}
+		cmd.Debugf("exporting request traces to OTLP collector at %s", cmd.cfg.TelemetryOTLPTraces.Endpoint)
+	}
+
+	ctlInvoker := cmd.ctlInvoker
+	msLimiter := newMSRateLimiter(cmd.Logger, cmd.cfg)
+	if msLimiter != nil {
+		ctlInvoker = &rateLimitedInvoker{
+			Invoker: cmd.ctlInvoker,
+			limiter: msLimiter,
+		}
+		cmd.Debugf("MS requests limited to rate %.2f/s, max concurrent %d, queue timeout %s",
+			cmd.cfg.MSRateLimit, cmd.cfg.MSMaxConcurrent, msLimiter.queueTimeout)
+	}
+
+	if cmd.cfg.UsePrivilegedHelper {
+		if err := pbin.CheckHelper(cmd.Logger, pbin.DaosAgentHelperName); err != nil {
+			return err
+		}
+		cmd.Debugf("fabric scan and hwloc probing forwarded to %s", pbin.DaosAgentHelperName)
+	}
+
 	cacheStart := time.Now()
-	cache := NewInfoCache(ctx, cmd.Logger, cmd.ctlInvoker, cmd.cfg)
+	cache := NewInfoCache(ctx, cmd.Logger, ctlInvoker, cmd.cfg)
 	if cmd.attachInfoCacheDisabled() {
 		cache.DisableAttachInfoCache()
 		cmd.Debug("GetAttachInfo agent caching has been disabled")
@@ -86,23 +140,127 @@ func (cmd *startCmd) Execute(_ []string) error {
 	}
 	cmd.Debugf("created cache: %s", time.Since(cacheStart))
 
+	if cmd.cfg.AttachInfoFile != "" {
+		attachInfo, err := loadStaticAttachInfo(cmd.cfg.AttachInfoFile)
+		if err != nil {
+			return err
+		}
+		cache.EnableStaticAttachInfo(attachInfo)
+		cmd.Noticef("standalone mode: serving attach info from %s", cmd.cfg.AttachInfoFile)
+	} else if cmd.cfg.Standalone {
+		cmd.Noticef("standalone mode: requesting attach info from local daos_server at %s",
+			strings.Join(cmd.cfg.AccessPoints, ","))
+	}
+
+	otherSystems := common.NewStringSet()
+	sysInvokers := map[string]control.UnaryInvoker{cmd.cfg.SystemName: ctlInvoker}
+	for _, sys := range cmd.cfg.Systems {
+		var sysInvoker control.Invoker = control.NewClient(
+			control.WithClientLogger(cmd.Logger),
+			control.WithClientComponent(build.ComponentAgent),
+			control.WithConfig(newControlConfig(cmd.cfg, sys)),
+		)
+		if msLimiter != nil {
+			sysInvoker = &rateLimitedInvoker{
+				Invoker: sysInvoker,
+				limiter: msLimiter,
+			}
+		}
+		cache.SetSystemClient(sys.Name, sysInvoker)
+		sysInvokers[sys.Name] = sysInvoker
+		otherSystems.Add(sys.Name)
+		cmd.Debugf("clients may attach to system %s with access points %v", sys.Name,
+			sys.AccessPoints)
+	}
+
+	// Serve the attach info saved by a previous agent until the MS can be
+	// contacted, rather than failing client requests in the meantime.
+	cache.LoadSavedAttachInfo()
+	go cache.ConnectMS(ctx, cmd.cfg.SystemName)
+
+	// Log RAS events raised by the agent and forward them to the MS.
+	ps := events.NewPubSub(ctx, cmd.Logger)
+	defer ps.Close()
+	ps.Subscribe(events.RASTypeAny, control.NewEventLogger(cmd.Logger))
+	ps.Subscribe(events.RASTypeAny, control.NewEventForwarder(ctlInvoker, cmd.cfg.AccessPoints))
+	cache.events = ps
+
+	if cache.IsFabricCacheEnabled() {
+		interval := cmd.cfg.FabricCheckInterval
+		if interval == 0 {
+			interval = defaultFabricCheckInterval
+		}
+		go cache.MonitorFabricHealth(ctx, interval)
+		cmd.Debugf("monitoring fabric interface link state every %s", interval)
+	}
+
+	if !hardware.DiscoverySupported() {
+		cmd.Noticef("local hardware discovery not supported on %s; running in remote-only mode", runtime.GOOS)
+		if len(cmd.cfg.FabricInterfaces) == 0 {
+			cmd.Notice("no fabric_ifaces configured; clients must supply their own fabric interface")
+		}
+	}
+
 	procmonStart := time.Now()
-	procmon := NewProcMon(cmd.Logger, cmd.ctlInvoker, cmd.cfg.SystemName)
+	procmon := NewProcMon(cmd.Logger, ctlInvoker, cmd.cfg.SystemName)
 	procmon.startMonitoring(ctx, cmd.cfg.EvictOnStart)
 	cmd.Debugf("started process monitor: %s", time.Since(procmonStart))
 
+	if cmd.cfg.PoolChangeInterval > 0 {
+		var advisor poolChangeAdvisor
+		if cmd.cfg.AdvisePoolReconnect {
+			advisor = procmon
+		}
+		poolChanges := newPoolChangeMonitor(cmd.Logger, cmd.cfg.SystemName, ctlInvoker, cache, advisor)
+		go poolChanges.run(ctx, cmd.cfg.PoolChangeInterval)
+		cmd.Debugf("checking for pool membership changes every %s", cmd.cfg.PoolChangeInterval)
+	}
+
+	if cmd.cfg.SysChangeInterval > 0 {
+		for sys, client := range sysInvokers {
+			sysChanges := newSystemChangeMonitor(cmd.Logger, sys, client, cache)
+			go sysChanges.run(ctx, cmd.cfg.SysChangeInterval)
+		}
+		cmd.Debugf("checking for system changes every %s", cmd.cfg.SysChangeInterval)
+	}
+
+	if cmd.cfg.HeartbeatInterval > 0 {
+		heartbeat := newHeartbeatSender(cmd.Logger, cmd.cfg.SystemName, ctlInvoker, cache, procmon)
+		go heartbeat.run(ctx, cmd.cfg.HeartbeatInterval)
+		cmd.Debugf("sending heartbeats to the MS every %s", cmd.cfg.HeartbeatInterval)
+	}
+
 	var clientMetricSource *promexp.ClientSource
+	var clientMetricHistory *promexp.ClientHistory
 	if cmd.cfg.TelemetryExportEnabled() {
 		if ctx, clientMetricSource, err = promexp.NewClientSource(ctx); err != nil {
 			return errors.Wrap(err, "unable to create client metrics source")
 		}
+		if cmd.cfg.TelemetryRetain > 0 {
+			clientMetricHistory = promexp.NewClientHistory(cmd.cfg.TelemetryRetain,
+				promexp.DefaultClientHistorySize)
+		}
 		telemetryStart := time.Now()
-		shutdown, err := startPrometheusExporter(ctx, cmd, clientMetricSource, cmd.cfg)
-		if err != nil {
-			return errors.Wrap(err, "unable to start prometheus exporter")
+		agentCollectors := append(msLimiter.collectors(), cache.collectors()...)
+		if cmd.cfg.TelemetryPort > 0 {
+			shutdown, err := startPrometheusExporter(ctx, cmd, clientMetricSource,
+				clientMetricHistory, cmd.cfg, cache, agentCollectors...)
+			if err != nil {
+				return errors.Wrap(err, "unable to start prometheus exporter")
+			}
+			defer shutdown()
+			cmd.Debugf("telemetry exporter started: %s", time.Since(telemetryStart))
+		} else if err := registerTelemetry(clientMetricSource, clientMetricHistory, cmd.cfg, agentCollectors...)(ctx, cmd); err != nil {
+			return errors.Wrap(err, "failed to register client monitor")
+		}
+
+		if cmd.cfg.TelemetryPush.Enabled() {
+			stop, err := startPrometheusPusher(ctx, cmd, cmd.cfg)
+			if err != nil {
+				return errors.Wrap(err, "unable to start prometheus pusher")
+			}
+			defer stop()
 		}
-		defer shutdown()
-		cmd.Debugf("telemetry exporter started: %s", time.Since(telemetryStart))
 	}
 
 	drpcRegStart := time.Now()
@@ -112,29 +270,43 @@ func (cmd *startCmd) Execute(_ []string) error {
 	}
 	drpcServer.RegisterRPCModule(NewSecurityModule(cmd.Logger, secCfg))
 	mgmtMod := &mgmtModule{
-		log:           cmd.Logger,
-		sys:           cmd.cfg.SystemName,
-		ctlInvoker:    cmd.ctlInvoker,
-		cache:         cache,
-		numaGetter:    topology.DefaultProcessNUMAProvider(cmd.Logger),
-		monitor:       procmon,
-		providerIdx:   cmd.cfg.ProviderIdx,
-		cliMetricsSrc: clientMetricSource,
+		log:              cmd.Logger,
+		sys:              cmd.cfg.SystemName,
+		otherSystems:     otherSystems,
+		access:           sysAccess,
+		ctlInvoker:       ctlInvoker,
+		cache:            cache,
+		numaGetter:       getProcessNUMAProvider(cmd.Logger, cmd.cfg),
+		netNSGetter:      &procNetNSProvider{},
+		monitor:          procmon,
+		providerIdx:      cmd.cfg.ProviderIdx,
+		providerPriority: cmd.cfg.ProviderPriority,
+		cliMetricsSrc:    clientMetricSource,
+		cliMetricsHist:   clientMetricHistory,
+	}
+	if cmd.cfg.EnableCPUHints {
+		mgmtMod.cpuSetGetter = &sysfsCPUSetProvider{}
 	}
 	drpcServer.RegisterRPCModule(mgmtMod)
 	cmd.Debugf("registered dRPC modules: %s", time.Since(drpcRegStart))
 
-	hwlocStart := time.Now()
-	// Cache hwloc data in context on startup, since it'll be used extensively at runtime.
-	hwlocCtx, err := hwloc.CacheContext(ctx, cmd.Logger)
-	if err != nil {
-		return err
+	// The hwloc topology is only loaded in the agent process if the hwloc
+	// probing is not forwarded to the privileged helper.
+	drpcCtx := ctx
+	if !cmd.cfg.UsePrivilegedHelper {
+		hwlocStart := time.Now()
+		// Cache hwloc data in context on startup, since it'll be used extensively at runtime.
+		hwlocCtx, err := hwloc.CacheContext(ctx, cmd.Logger)
+		if err != nil {
+			return err
+		}
+		defer hwloc.Cleanup(hwlocCtx)
+		cmd.Debugf("cached hwloc content: %s", time.Since(hwlocStart))
+		drpcCtx = hwlocCtx
 	}
-	defer hwloc.Cleanup(hwlocCtx)
-	cmd.Debugf("cached hwloc content: %s", time.Since(hwlocStart))
 
 	drpcSrvStart := time.Now()
-	err = drpcServer.Start(hwlocCtx)
+	err = drpcServer.Start(drpcCtx)
 	if err != nil {
 		return errors.Wrap(err, "unable to start dRPC server")
 	}
@@ -146,12 +318,18 @@ func (cmd *startCmd) Execute(_ []string) error {
 		return errors.Wrap(err, "unable to notify systemd")
 	}
 	defer systemd.Stopping()
+	if _, err := systemd.StartWatchdog(ctx, cmd.Logger, systemd.HealthCheck{
+		Name:  "info cache",
+		Check: cache.CheckHealth,
+	}); err != nil {
+		return errors.Wrap(err, "unable to start systemd watchdog")
+	}
 
 	// Setup signal handlers so we can block till we get SIGINT or SIGTERM
-	signals := make(chan os.Signal)
+	signals := make(chan os.Signal, 1)
 	finish := make(chan struct{})
 
-	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGPIPE, syscall.SIGUSR1, syscall.SIGUSR2)
+	signal.Notify(signals, agentSignals...)
 	// Anonymous goroutine to wait on the signals channel and tell the
 	// program to finish when it receives a signal. Since we notify on
 	// SIGINT and SIGTERM we should only catch these on a kill or ctrl+c
@@ -164,17 +342,25 @@ func (cmd *startCmd) Execute(_ []string) error {
 			switch sig {
 			case syscall.SIGPIPE:
 				cmd.Infof("Signal received.  Caught non-fatal %s; continuing", sig)
-			case syscall.SIGUSR1:
+			case flushHandlesSignal:
 				cmd.Infof("Signal received.  Caught %s; flushing open pool handles", sig)
 				procmon.FlushAllHandles(ctx)
-			case syscall.SIGUSR2:
+			case refreshCacheSignal:
 				cmd.Infof("Signal received. Caught %s; refreshing caches", sig)
 				mgmtMod.RefreshCache(ctx)
+			case syscall.SIGHUP:
+				cmd.Infof("Signal received. Caught %s; reloading control log module levels", sig)
+				if err := systemd.Reload(cmd.reloadLogModules); err != nil {
+					cmd.Errorf("unable to notify systemd of reload: %s", err)
+				}
 			default:
 				shutdownRcvd = time.Now()
 				cmd.Infof("Signal received.  Caught %s; shutting down", sig)
 				shuttingDown.SetTrue()
-				if !cmd.cfg.DisableAutoEvict {
+				switch {
+				case cmd.cfg.EvictOnShutdown:
+					procmon.EvictNodeHandles(ctx)
+				case !cmd.cfg.DisableAutoEvict:
 					procmon.FlushAllHandles(ctx)
 				}
 				close(finish)
@@ -188,6 +374,27 @@ func (cmd *startCmd) Execute(_ []string) error {
 	return nil
 }
 
+// reloadLogModules re-reads the per-module control log levels from the agent
+// config file and applies them. Other config changes are ignored.
+func (cmd *startCmd) reloadLogModules() {
+	if cmd.cfgPath == "" {
+		cmd.Notice("no agent config file loaded; unable to reload control log module levels")
+		return
+	}
+
+	cfg, err := LoadConfig(cmd.cfgPath)
+	if err != nil {
+		cmd.Errorf("failed to reload agent config: %s", err)
+		return
+	}
+
+	if err := cmdutil.ConfigureModuleLevels(cmd.Logger, cfg.LogModules); err != nil {
+		cmd.Errorf("failed to set control log module levels: %s", err)
+		return
+	}
+	cmd.cfg.LogModules = cfg.LogModules
+}
+
 func (cmd *startCmd) attachInfoCacheDisabled() bool {
 	return cmd.cfg.DisableCache || os.Getenv("DAOS_AGENT_DISABLE_CACHE") == "true"
 }
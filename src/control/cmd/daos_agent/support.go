@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -35,17 +36,29 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 		return err
 	}
 
-	var LogCollection = map[int32][]string{
-		support.CopyAgentConfigEnum:  {""},
-		support.CollectAgentLogEnum:  {""},
-		support.CollectAgentCmdEnum:  support.AgentCmd,
-		support.CollectClientLogEnum: {""},
-		support.CollectSystemCmdEnum: support.SystemCmd,
+	var collectors []support.Collector
+	collectors = append(collectors, support.NewCollectors(support.CopyAgentConfigEnum)...)
+	collectors = append(collectors, support.NewCollectors(support.CollectAgentLogEnum)...)
+	collectors = append(collectors, support.NewCollectors(support.CollectAgentCmdEnum, support.AgentCmd...)...)
+	collectors = append(collectors, support.NewCollectors(support.CollectClientLogEnum)...)
+	collectors = append(collectors, support.NewCollectors(support.CollectSystemCmdEnum, support.SystemCmd...)...)
+
+	// Copy the custom log folder
+	if cmd.ExtraLogsDir != "" {
+		collectors = append(collectors, support.NewCollectors(support.CollectExtraLogsDirEnum)...)
+	}
+
+	// Run the site-specific collectors
+	if cmd.CollectorsManifest != "" {
+		siteCollectors, err := support.LoadCollectorManifest(cmd.CollectorsManifest)
+		if err != nil {
+			return err
+		}
+		collectors = append(collectors, siteCollectors...)
 	}
 
-	// Default 3 steps of log/conf collection.
 	progress := support.ProgressBar{
-		Total:     len(LogCollection),
+		Total:     support.EstimateSteps(collectors...),
 		NoDisplay: false,
 	}
 
@@ -53,12 +66,6 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 		progress.Total++
 	}
 
-	// Copy the custom log folder
-	if cmd.ExtraLogsDir != "" {
-		LogCollection[support.CollectExtraLogsDirEnum] = []string{""}
-		progress.Total++
-	}
-
 	if cmd.TargetFolder == "" {
 		folderName := fmt.Sprintf("daos_support_client_logs_%s", time.Now().Format(time.RFC3339))
 		cmd.TargetFolder = filepath.Join(os.TempDir(), folderName)
@@ -76,21 +83,19 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 	params.LogStartTime = cmd.LogStartTime
 	params.LogEndTime = cmd.LogEndTime
 
-	for logFunc, logCmdSet := range LogCollection {
-		for _, logCmd := range logCmdSet {
-			cmd.Debugf("Log Function Enum = %d -- Log Collect Cmd = %s ", logFunc, logCmd)
-			params.LogFunction = logFunc
-			params.LogCmd = logCmd
-
-			err := support.CollectSupportLog(cmd.Logger, params)
-			if err != nil {
-				fmt.Println(err)
-				if cmd.StopOnError {
-					return err
-				}
-			}
-		}
-		fmt.Print(progress.Display())
+	runner := &support.CollectorRunner{
+		Log:         cmd.Logger,
+		Report:      support.NewReport("daos_agent support collect-log"),
+		Progress:    &progress,
+		Output:      os.Stdout,
+		StopOnError: cmd.StopOnError,
+	}
+	if err := runner.Run(params, collectors...); err != nil {
+		return err
+	}
+
+	if err := runner.Report.Write(cmd.Logger, cmd.TargetFolder); err != nil {
+		cmd.Noticef("Failed to write the summary report: %s", err)
 	}
 
 	if cmd.Archive {
@@ -0,0 +1,112 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type (
+	// systemAttachInfoRefresher refreshes the cached attach info of a
+	// single system.
+	systemAttachInfoRefresher interface {
+		RefreshSystemAttachInfo(ctx context.Context, sys string) error
+	}
+
+	// systemChangeMonitor polls the MS of a system for the events that it
+	// has recorded which invalidate the system information cached by the
+	// agent, e.g. ranks joining or being excluded and pool service replica
+	// updates, and refreshes only the cached attach info of that system.
+	systemChangeMonitor struct {
+		log     logging.Logger
+		sys     string
+		client  control.UnaryInvoker
+		cache   systemAttachInfoRefresher
+		lastSeq uint64
+		started bool
+	}
+)
+
+func newSystemChangeMonitor(log logging.Logger, sys string, client control.UnaryInvoker, cache systemAttachInfoRefresher) *systemChangeMonitor {
+	return &systemChangeMonitor{
+		log:    log,
+		sys:    sys,
+		client: client,
+		cache:  cache,
+	}
+}
+
+// check requests the system changes recorded since the last check and acts on
+// them. The first check only establishes the starting point.
+func (m *systemChangeMonitor) check(ctx context.Context) error {
+	req := &control.SystemChangesReq{AfterSeq: m.lastSeq}
+	if !m.started {
+		// Only the latest sequence number is needed.
+		req.AfterSeq = math.MaxUint64
+	}
+	req.SetSystem(m.sys)
+
+	resp, err := control.SystemChanges(ctx, m.client, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to get system changes")
+	}
+
+	switch {
+	case !m.started:
+		m.started = true
+		m.lastSeq = resp.LastSeq
+		return nil
+	case resp.LastSeq < m.lastSeq:
+		// The recorded events have been reset, e.g. by a system erase,
+		// so any changes since the last check are unknown.
+		m.log.Noticef("system %s: change history reset, refreshing attach info", m.sys)
+	case len(resp.Changes) == 0:
+		m.lastSeq = resp.LastSeq
+		return nil
+	default:
+		for _, change := range resp.Changes {
+			m.log.Debugf("system %s: %s event for rank %d (pool %q, op %q)", m.sys,
+				change.Event, change.Rank, change.PoolUUID, change.Op)
+		}
+		m.log.Noticef("system %s: %d change(s) recorded by the MS, refreshing attach info",
+			m.sys, len(resp.Changes))
+	}
+
+	if err := m.cache.RefreshSystemAttachInfo(ctx, m.sys); err != nil {
+		// Retry the same changes on the next check.
+		return errors.Wrapf(err, "failed to refresh attach info for system %s", m.sys)
+	}
+	m.lastSeq = resp.LastSeq
+
+	return nil
+}
+
+// run checks for system changes at the given interval until the context is
+// canceled.
+func (m *systemChangeMonitor) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.check(ctx); err != nil {
+			m.log.Errorf("system change check failed: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,131 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockSystemAttachInfoRefresher struct {
+	refreshErr error
+	refreshed  []string
+}
+
+func (m *mockSystemAttachInfoRefresher) RefreshSystemAttachInfo(_ context.Context, sys string) error {
+	m.refreshed = append(m.refreshed, sys)
+	return m.refreshErr
+}
+
+func TestAgent_systemChangeMonitor_check(t *testing.T) {
+	for name, tc := range map[string]struct {
+		started      bool
+		lastSeq      uint64
+		resp         *mgmtpb.SystemChangesResp
+		respErr      error
+		refreshErr   error
+		expAfterSeq  uint64
+		expErr       error
+		expLastSeq   uint64
+		expRefreshed []string
+	}{
+		"first check sets starting point": {
+			resp:        &mgmtpb.SystemChangesResp{LastSeq: 10},
+			expAfterSeq: math.MaxUint64,
+			expLastSeq:  10,
+		},
+		"request fails": {
+			started:     true,
+			lastSeq:     10,
+			respErr:     errors.New("mock failure"),
+			expAfterSeq: 10,
+			expErr:      errors.New("mock failure"),
+			expLastSeq:  10,
+		},
+		"no changes": {
+			started:     true,
+			lastSeq:     10,
+			resp:        &mgmtpb.SystemChangesResp{LastSeq: 12},
+			expAfterSeq: 10,
+			expLastSeq:  12,
+		},
+		"changes": {
+			started: true,
+			lastSeq: 10,
+			resp: &mgmtpb.SystemChangesResp{
+				Changes: []*mgmtpb.SystemChange{
+					{Seq: 11, Event: "system_membership_changed", Rank: 1, Op: "join"},
+					{Seq: 13, Event: "pool_replicas_updated", Rank: 2, PoolUuid: test.MockUUID(1)},
+				},
+				LastSeq: 14,
+			},
+			expAfterSeq:  10,
+			expLastSeq:   14,
+			expRefreshed: []string{"test_sys"},
+		},
+		"refresh fails": {
+			started: true,
+			lastSeq: 10,
+			resp: &mgmtpb.SystemChangesResp{
+				Changes: []*mgmtpb.SystemChange{
+					{Seq: 11, Event: "system_membership_changed", Rank: 1, Op: "exclude"},
+				},
+				LastSeq: 11,
+			},
+			refreshErr:   errors.New("mock refresh"),
+			expAfterSeq:  10,
+			expErr:       errors.New("mock refresh"),
+			expLastSeq:   10,
+			expRefreshed: []string{"test_sys"},
+		},
+		"history reset": {
+			started:      true,
+			lastSeq:      10,
+			resp:         &mgmtpb.SystemChangesResp{LastSeq: 2},
+			expAfterSeq:  10,
+			expLastSeq:   2,
+			expRefreshed: []string{"test_sys"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", tc.respErr, tc.resp),
+			})
+
+			refresher := &mockSystemAttachInfoRefresher{refreshErr: tc.refreshErr}
+			m := newSystemChangeMonitor(log, "test_sys", mi, refresher)
+			m.started = tc.started
+			m.lastSeq = tc.lastSeq
+
+			err := m.check(test.Context(t))
+			test.CmpErr(t, tc.expErr, err)
+
+			test.AssertEqual(t, 1, len(mi.SentReqs), "unexpected number of requests")
+			req, ok := mi.SentReqs[0].(*control.SystemChangesReq)
+			test.AssertTrue(t, ok, "unexpected request type")
+			test.AssertEqual(t, tc.expAfterSeq, req.AfterSeq, "unexpected request sequence")
+
+			test.AssertTrue(t, m.started, "monitor not started")
+			test.AssertEqual(t, tc.expLastSeq, m.lastSeq, "unexpected last sequence")
+			if diff := cmp.Diff(tc.expRefreshed, refresher.refreshed); diff != "" {
+				t.Fatalf("unexpected systems refreshed (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
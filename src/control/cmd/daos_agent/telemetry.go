@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,29 +9,156 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
-func startPrometheusExporter(ctx context.Context, log logging.Logger, cs *promexp.ClientSource, cfg *Config) (func(), error) {
+const (
+	// defaultTelemetryPushJob is the default job label of the metrics pushed
+	// to a Prometheus Pushgateway.
+	defaultTelemetryPushJob = "daos_client"
+	// defaultTelemetryPushInterval is the default interval between pushes of
+	// metrics to a Prometheus Pushgateway.
+	defaultTelemetryPushInterval = time.Minute
+	// minTelemetryPushInterval is the minimum interval between pushes of
+	// metrics to a Prometheus Pushgateway.
+	minTelemetryPushInterval = time.Second
+)
+
+// TelemetryPushConfig defines the Prometheus Pushgateway to which the agent
+// pushes the client telemetry, for nodes that cannot be scraped.
+type TelemetryPushConfig struct {
+	URL      string        `yaml:"url"`
+	Job      string        `yaml:"job,omitempty"`
+	Interval time.Duration `yaml:"interval,omitempty"`
+}
+
+// Enabled returns true if a Pushgateway has been configured.
+func (tp *TelemetryPushConfig) Enabled() bool {
+	return tp != nil && tp.URL != ""
+}
+
+// Validate returns an error if the push configuration is invalid.
+func (tp *TelemetryPushConfig) Validate() error {
+	if tp == nil {
+		return nil
+	}
+
+	u, err := url.Parse(tp.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid url %q: must be an http:// or https:// URL", tp.URL)
+	}
+
+	if tp.Interval != 0 && tp.Interval < minTelemetryPushInterval {
+		return errors.Errorf("interval may not be less than %s", minTelemetryPushInterval)
+	}
+
+	return nil
+}
+
+// readyzHandler reports whether the agent has contacted the MS, and the systems
+// for which stale attach info is being served to clients.
+func readyzHandler(log logging.Logger, cache *InfoCache) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := cache.MSConnStatus()
+
+		w.Header().Set("Content-Type", "application/json")
+		if !status.Ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			log.Errorf("failed to write readiness status: %s", err)
+		}
+	})
+}
+
+// registerTelemetry returns a function that registers the client telemetry
+// collector and the agent's own collectors. If a history is supplied, the
+// collected client metrics are recorded in it.
+func registerTelemetry(cs *promexp.ClientSource, history *promexp.ClientHistory, cfg *Config, agentCollectors ...prometheus.Collector) promexp.RegMonFn {
+	return func(ctx context.Context, log logging.Logger) error {
+		c, err := promexp.NewClientCollector(ctx, log, cs, &promexp.CollectorOpts{
+			RetainDuration: cfg.TelemetryRetain,
+			History:        history,
+		})
+		if err != nil {
+			return err
+		}
+		prometheus.MustRegister(c)
+		prometheus.MustRegister(agentCollectors...)
+
+		return nil
+	}
+}
+
+func startPrometheusExporter(ctx context.Context, log logging.Logger, cs *promexp.ClientSource, history *promexp.ClientHistory, cfg *Config, cache *InfoCache, agentCollectors ...prometheus.Collector) (func(), error) {
 	expCfg := &promexp.ExporterConfig{
 		Port:  cfg.TelemetryPort,
 		Title: "DAOS Client Telemetry",
-		Register: func(ctx context.Context, log logging.Logger) error {
-			c, err := promexp.NewClientCollector(ctx, log, cs, &promexp.CollectorOpts{
-				RetainDuration: cfg.TelemetryRetain,
-			})
-			if err != nil {
-				return err
-			}
-			prometheus.MustRegister(c)
-
-			return nil
+		Handlers: map[string]http.Handler{
+			"/readyz": readyzHandler(log, cache),
 		},
+		Register: registerTelemetry(cs, history, cfg, agentCollectors...),
 	}
 
 	return promexp.StartExporter(ctx, log, expCfg)
 }
+
+// startPrometheusPusher starts pushing the registered telemetry to the
+// configured Prometheus Pushgateway, grouped by the agent's hostname.
+func startPrometheusPusher(ctx context.Context, log logging.Logger, cfg *Config) (func(), error) {
+	hostname, _ := os.Hostname()
+	pushCfg := &promexp.PushConfig{
+		URL:      cfg.TelemetryPush.URL,
+		Job:      cfg.TelemetryPush.Job,
+		Grouping: map[string]string{"instance": hostname},
+		Interval: cfg.TelemetryPush.Interval,
+	}
+	if pushCfg.Job == "" {
+		pushCfg.Job = defaultTelemetryPushJob
+	}
+	if pushCfg.Interval == 0 {
+		pushCfg.Interval = defaultTelemetryPushInterval
+	}
+
+	return promexp.StartPusher(ctx, log, pushCfg, prometheus.DefaultGatherer)
+}
+
+// spanExportSetter is an interface implemented by invokers that can export
+// trace spans for the RPCs they invoke.
+type spanExportSetter interface {
+	SetSpanExporter(*control.OTLPSpanExporter)
+}
+
+// startSpanExport starts exporting trace spans for the RPCs invoked by the
+// agent to the configured OTLP collector, until the context is canceled.
+func startSpanExport(ctx context.Context, log logging.Logger, invoker control.Invoker, cfg *Config) error {
+	ses, ok := invoker.(spanExportSetter)
+	if !ok {
+		return errors.New("trace export is not supported by the control client")
+	}
+
+	hostname, _ := os.Hostname()
+	exporter := control.NewOTLPSpanExporter(log, control.OTLPExporterConfig{
+		Endpoint:   cfg.TelemetryOTLPTraces.Endpoint,
+		Headers:    cfg.TelemetryOTLPTraces.Headers,
+		Component:  build.ComponentAgent,
+		SystemName: cfg.SystemName,
+		Hostname:   hostname,
+	})
+	exporter.Start(ctx)
+	ses.SetSpanExporter(exporter)
+
+	return nil
+}
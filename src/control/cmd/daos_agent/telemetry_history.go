@@ -0,0 +1,182 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+type (
+	// telemetrySample is a client metric sample retained by the agent.
+	telemetrySample struct {
+		Time   time.Time         `json:"time"`
+		Name   string            `json:"name"`
+		Labels map[string]string `json:"labels"`
+		Value  float64           `json:"value"`
+	}
+
+	// telemetryHistory is the client telemetry retained by the agent.
+	telemetryHistory struct {
+		Retain  time.Duration      `json:"retain"`
+		Samples []*telemetrySample `json:"samples"`
+	}
+)
+
+// queryTelemetryHistory requests the retained client telemetry from the running
+// agent.
+func queryTelemetryHistory(ctx context.Context, conn drpc.DomainSocketClient, req *mgmtpb.QueryClientTelemetryReq) (*telemetryHistory, error) {
+	if err := conn.Connect(ctx); err != nil {
+		return nil, errors.Wrap(err, "connecting to agent")
+	}
+	defer conn.Close()
+
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := conn.SendMsg(ctx, &drpc.Call{
+		Module: drpc.MethodQueryClientTelemetry.Module().ID(),
+		Method: drpc.MethodQueryClientTelemetry.ID(),
+		Body:   body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status != drpc.Status_SUCCESS {
+		return nil, errors.Errorf("dRPC status %s", resp.Status)
+	}
+
+	pbResp := new(mgmtpb.QueryClientTelemetryResp)
+	if err := proto.Unmarshal(resp.Body, pbResp); err != nil {
+		return nil, err
+	}
+	switch daos.Status(pbResp.Status) {
+	case daos.Success:
+	case daos.NotImpl:
+		return nil, errors.New("client telemetry is not retained by the agent (telemetry_retain is not set)")
+	default:
+		return nil, daos.Status(pbResp.Status)
+	}
+
+	history := &telemetryHistory{
+		Retain:  time.Duration(pbResp.Retain),
+		Samples: make([]*telemetrySample, 0, len(pbResp.Samples)),
+	}
+	for _, pbSample := range pbResp.Samples {
+		sample := &telemetrySample{
+			Time:   time.Unix(0, int64(pbSample.Timestamp)),
+			Name:   pbSample.Name,
+			Labels: pbSample.Labels,
+			Value:  pbSample.Value,
+		}
+		if sample.Labels == nil {
+			sample.Labels = map[string]string{}
+		}
+		history.Samples = append(history.Samples, sample)
+	}
+
+	return history, nil
+}
+
+// printTelemetryHistory writes a human-readable table of the retained client
+// telemetry samples.
+func printTelemetryHistory(out io.Writer, history *telemetryHistory) {
+	if len(history.Samples) == 0 {
+		fmt.Fprintf(out, "No client telemetry samples retained in the last %s\n", history.Retain)
+		return
+	}
+
+	timeTitle := "Time"
+	jobTitle := "Job ID"
+	pidTitle := "PID"
+	metricTitle := "Metric"
+	labelsTitle := "Labels"
+	valueTitle := "Value"
+	formatter := txtfmt.NewTableFormatter(timeTitle, jobTitle, pidTitle, metricTitle, labelsTitle,
+		valueTitle)
+
+	var table []txtfmt.TableRow
+	for _, sample := range history.Samples {
+		var labels []string
+		for key, value := range sample.Labels {
+			if key == "jobid" || key == "pid" {
+				continue
+			}
+			labels = append(labels, key+"="+value)
+		}
+		sort.Strings(labels)
+		if len(labels) == 0 {
+			labels = append(labels, "-")
+		}
+
+		table = append(table, txtfmt.TableRow{
+			timeTitle:   sample.Time.Format(time.RFC3339),
+			jobTitle:    sample.Labels["jobid"],
+			pidTitle:    sample.Labels["pid"],
+			metricTitle: sample.Name,
+			labelsTitle: strings.Join(labels, ","),
+			valueTitle:  fmt.Sprintf("%g", sample.Value),
+		})
+	}
+
+	fmt.Fprint(out, formatter.Format(table))
+}
+
+// telemetryHistoryCmd queries the client telemetry retained by the running agent.
+type telemetryHistoryCmd struct {
+	configCmd
+	cmdutil.LogCmd
+	cmdutil.JSONOutputCmd
+	JobID  string          `long:"jobid" description:"Only show samples for this job ID"`
+	PID    int32           `long:"pid" description:"Only show samples for this process ID"`
+	Metric string          `long:"metric" description:"Only show samples of metrics with this name prefix (e.g. client_pool_)"`
+	Since  ui.DurationFlag `long:"since" description:"Only show samples collected within this duration (e.g. 10m)"`
+}
+
+func (cmd *telemetryHistoryCmd) Execute(_ []string) error {
+	conn := drpc.NewClientConnection(filepath.Join(cmd.cfg.RuntimeDir, agentSockName))
+
+	req := &mgmtpb.QueryClientTelemetryReq{
+		Jobid:  cmd.JobID,
+		Pid:    cmd.PID,
+		Metric: cmd.Metric,
+	}
+	if cmd.Since.Duration > 0 {
+		req.Since = uint64(time.Now().Add(-cmd.Since.Duration).UnixNano())
+	}
+
+	history, err := queryTelemetryHistory(cmd.MustLogCtx(), conn, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(history, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	printTelemetryHistory(&out, history)
+	cmd.Info(out.String())
+
+	return nil
+}
@@ -0,0 +1,312 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+type mockTelemetryHistoryConn struct {
+	mockListClientsConn
+	telemResp *mgmtpb.QueryClientTelemetryResp
+	gotReq    *mgmtpb.QueryClientTelemetryReq
+}
+
+func (m *mockTelemetryHistoryConn) SendMsg(_ context.Context, call *drpc.Call) (*drpc.Response, error) {
+	if call.Method != drpc.MethodQueryClientTelemetry.ID() {
+		return nil, errors.Errorf("unexpected method %d", call.Method)
+	}
+
+	m.gotReq = new(mgmtpb.QueryClientTelemetryReq)
+	if err := proto.Unmarshal(call.Body, m.gotReq); err != nil {
+		return nil, err
+	}
+
+	body, err := proto.Marshal(m.telemResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &drpc.Response{
+		Status: drpc.Status_SUCCESS,
+		Body:   body,
+	}, nil
+}
+
+func TestAgent_mgmtModule_handleQueryClientTelemetry(t *testing.T) {
+	now := time.Now()
+	sample := func(offset time.Duration, pid string, value float64) promexp.ClientSample {
+		return promexp.ClientSample{
+			Time:   now.Add(offset),
+			Name:   "client_pool_ops_fetch",
+			Labels: map[string]string{"jobid": "job1", "pid": pid},
+			Value:  value,
+		}
+	}
+	pbSample := func(offset time.Duration, pid string, value float64) *mgmtpb.ClientTelemetrySample {
+		return &mgmtpb.ClientTelemetrySample{
+			Timestamp: uint64(now.Add(offset).UnixNano()),
+			Name:      "client_pool_ops_fetch",
+			Labels:    map[string]string{"jobid": "job1", "pid": pid},
+			Value:     value,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		uid        uint32
+		noHistory  bool
+		req        *mgmtpb.QueryClientTelemetryReq
+		expResp    *mgmtpb.QueryClientTelemetryResp
+		expErr     error
+		reqPayload []byte
+	}{
+		"bad payload": {
+			uid:        uint32(os.Getuid()),
+			reqPayload: []byte{0xff},
+			expErr:     drpc.UnmarshalingPayloadFailure(),
+		},
+		"other user": {
+			uid: uint32(os.Getuid()) + 1,
+			req: &mgmtpb.QueryClientTelemetryReq{},
+			expResp: &mgmtpb.QueryClientTelemetryResp{
+				Status: int32(daos.NoPermission),
+			},
+		},
+		"retention disabled": {
+			uid:       uint32(os.Getuid()),
+			noHistory: true,
+			req:       &mgmtpb.QueryClientTelemetryReq{},
+			expResp: &mgmtpb.QueryClientTelemetryResp{
+				Status: int32(daos.NotImpl),
+			},
+		},
+		"all samples": {
+			uid: uint32(os.Getuid()),
+			req: &mgmtpb.QueryClientTelemetryReq{},
+			expResp: &mgmtpb.QueryClientTelemetryResp{
+				Retain: uint64(time.Hour),
+				Samples: []*mgmtpb.ClientTelemetrySample{
+					pbSample(-2*time.Minute, "100", 1),
+					pbSample(-time.Minute, "200", 2),
+				},
+			},
+		},
+		"filtered samples": {
+			uid: uint32(os.Getuid()),
+			req: &mgmtpb.QueryClientTelemetryReq{
+				Pid:   200,
+				Since: uint64(now.Add(-90 * time.Second).UnixNano()),
+			},
+			expResp: &mgmtpb.QueryClientTelemetryResp{
+				Retain: uint64(time.Hour),
+				Samples: []*mgmtpb.ClientTelemetrySample{
+					pbSample(-time.Minute, "200", 2),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mod := &mgmtModule{
+				log: log,
+			}
+			if !tc.noHistory {
+				mod.cliMetricsHist = promexp.NewClientHistory(time.Hour, 16)
+				mod.cliMetricsHist.Add(sample(-2*time.Minute, "100", 1), sample(-time.Minute, "200", 2))
+			}
+			cred := security.InitDomainInfo(&security.Ucred{Uid: tc.uid}, "")
+
+			reqBytes := tc.reqPayload
+			if reqBytes == nil {
+				var err error
+				if reqBytes, err = proto.Marshal(tc.req); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			respBytes, err := mod.handleQueryClientTelemetry(test.Context(t), reqBytes, cred)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			resp := new(mgmtpb.QueryClientTelemetryResp)
+			if err := proto.Unmarshal(respBytes, resp); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expResp, resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_queryTelemetryHistory(t *testing.T) {
+	now := time.Now()
+
+	for name, tc := range map[string]struct {
+		conn       *mockTelemetryHistoryConn
+		expHistory *telemetryHistory
+		expErr     error
+	}{
+		"connect fails": {
+			conn: &mockTelemetryHistoryConn{
+				mockListClientsConn: mockListClientsConn{
+					connectErr: errors.New("no agent"),
+				},
+			},
+			expErr: errors.New("connecting to agent"),
+		},
+		"not permitted": {
+			conn: &mockTelemetryHistoryConn{
+				telemResp: &mgmtpb.QueryClientTelemetryResp{Status: int32(daos.NoPermission)},
+			},
+			expErr: daos.NoPermission,
+		},
+		"retention disabled": {
+			conn: &mockTelemetryHistoryConn{
+				telemResp: &mgmtpb.QueryClientTelemetryResp{Status: int32(daos.NotImpl)},
+			},
+			expErr: errors.New("telemetry_retain is not set"),
+		},
+		"no samples": {
+			conn: &mockTelemetryHistoryConn{
+				telemResp: &mgmtpb.QueryClientTelemetryResp{Retain: uint64(time.Hour)},
+			},
+			expHistory: &telemetryHistory{
+				Retain:  time.Hour,
+				Samples: []*telemetrySample{},
+			},
+		},
+		"samples": {
+			conn: &mockTelemetryHistoryConn{
+				telemResp: &mgmtpb.QueryClientTelemetryResp{
+					Retain: uint64(time.Hour),
+					Samples: []*mgmtpb.ClientTelemetrySample{
+						{
+							Timestamp: uint64(now.UnixNano()),
+							Name:      "client_pool_ops_fetch",
+							Labels:    map[string]string{"jobid": "job1", "pid": "100"},
+							Value:     42,
+						},
+						{
+							Timestamp: uint64(now.UnixNano()),
+							Name:      "client_started_at",
+							Value:     1,
+						},
+					},
+				},
+			},
+			expHistory: &telemetryHistory{
+				Retain: time.Hour,
+				Samples: []*telemetrySample{
+					{
+						Time:   now,
+						Name:   "client_pool_ops_fetch",
+						Labels: map[string]string{"jobid": "job1", "pid": "100"},
+						Value:  42,
+					},
+					{
+						Time:   now,
+						Name:   "client_started_at",
+						Labels: map[string]string{},
+						Value:  1,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := &mgmtpb.QueryClientTelemetryReq{Jobid: "job1"}
+			history, err := queryTelemetryHistory(test.Context(t), tc.conn, req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(req, tc.conn.gotReq, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected request (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expHistory, history); diff != "" {
+				t.Fatalf("unexpected history (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_printTelemetryHistory(t *testing.T) {
+	sampleTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		history *telemetryHistory
+		expOut  string
+	}{
+		"no samples": {
+			history: &telemetryHistory{Retain: 30 * time.Minute},
+			expOut: `
+No client telemetry samples retained in the last 30m0s
+`,
+		},
+		"samples": {
+			history: &telemetryHistory{
+				Retain: time.Hour,
+				Samples: []*telemetrySample{
+					{
+						Time: sampleTime,
+						Name: "client_pool_ops_fetch",
+						Labels: map[string]string{
+							"jobid": "job1",
+							"pid":   "100",
+							"pool":  "p1",
+						},
+						Value: 42,
+					},
+					{
+						Time:   sampleTime,
+						Name:   "client_started_at",
+						Labels: map[string]string{"jobid": "job1", "pid": "100"},
+						Value:  1.5,
+					},
+				},
+			},
+			expOut: `
+Time                 Job ID PID Metric                Labels  Value 
+----                 ------ --- ------                ------  ----- 
+2025-01-02T03:04:05Z job1   100 client_pool_ops_fetch pool=p1 42    
+2025-01-02T03:04:05Z job1   100 client_started_at     -       1.5   
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			printTelemetryHistory(&out, tc.history)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
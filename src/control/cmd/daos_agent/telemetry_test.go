@@ -0,0 +1,73 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_readyzHandler(t *testing.T) {
+	for name, tc := range map[string]struct {
+		connErr   error
+		saved     []string
+		expCode   int
+		expStatus *msConnStatus
+	}{
+		"MS connected": {
+			expCode: http.StatusOK,
+			expStatus: &msConnStatus{
+				Ready:       true,
+				MSConnected: true,
+			},
+		},
+		"MS unreachable; serving stale attach info": {
+			connErr: errors.New("mock remote"),
+			saved:   []string{"daos_server"},
+			expCode: http.StatusServiceUnavailable,
+			expStatus: &msConnStatus{
+				StaleAttachInfo: []string{"daos_server"},
+				Error:           "mock remote",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ic := newTestInfoCache(t, log, testInfoCacheParams{})
+			ic.setMSConnected(tc.connErr)
+			ic.savedAttachInfo = make(map[string]*cachedAttachInfo)
+			for _, sys := range tc.saved {
+				item := newCachedAttachInfo(0, sys, nil, nil)
+				item.persisted.SetTrue()
+				ic.savedAttachInfo[sys] = item
+			}
+
+			rec := httptest.NewRecorder()
+			readyzHandler(log, ic).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			test.AssertEqual(t, tc.expCode, rec.Code, "unexpected status code")
+			gotStatus := new(msConnStatus)
+			if err := json.Unmarshal(rec.Body.Bytes(), gotStatus); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expStatus, gotStatus); diff != "" {
+				t.Fatalf("unexpected status (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
+)
+
+// topologyCmd shows the relationships between the NUMA nodes, accelerators and
+// fabric interfaces of the local system.
+type topologyCmd struct {
+	cmdutil.LogCmd
+	cmdutil.JSONOutputCmd
+}
+
+func (cmd *topologyCmd) Execute(_ []string) error {
+	accelProv := topology.DefaultAcceleratorTopologyProvider(cmd.Logger)
+	topo, err := accelProv.GetAcceleratorTopology(cmd.MustLogCtx())
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(topo, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := hardware.PrintAcceleratorTopology(topo, &bld); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
@@ -0,0 +1,136 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
+	"github.com/daos-stack/daos/src/control/lib/hardware/helper"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/pbin"
+)
+
+func getNilRequestResp() *pbin.Response {
+	return pbin.NewResponseWithError(errors.New("nil request"))
+}
+
+// fabricScanner is the interface for scanning the fabric interfaces.
+type fabricScanner interface {
+	Scan(ctx context.Context, providers ...string) (*hardware.FabricInterfaceSet, error)
+}
+
+// fabricScanHandler implements the FabricScan method.
+type fabricScanHandler struct {
+	scanner fabricScanner
+}
+
+func (h *fabricScanHandler) setupProvider(log logging.Logger) {
+	if h.scanner == nil {
+		h.scanner = network.DefaultFabricScanner(log)
+	}
+}
+
+// Handle scans the fabric interfaces and returns the results in a pbin.Response.
+func (h *fabricScanHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var sReq helper.FabricScanRequest
+	if err := json.Unmarshal(req.Payload, &sReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	fis, err := h.scanner.Scan(context.Background(), sReq.Providers...)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	ifaces, err := helper.FabricInterfacesFromSet(fis)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(&helper.FabricScanResponse{
+		Interfaces: ifaces,
+	})
+}
+
+// numaDistancesHandler implements the NUMADistances method.
+type numaDistancesHandler struct {
+	provider hardware.NUMADistanceProvider
+}
+
+func (h *numaDistancesHandler) setupProvider(log logging.Logger) {
+	if h.provider == nil {
+		h.provider = topology.DefaultNUMADistanceProvider(log)
+	}
+}
+
+// Handle fetches the NUMA node distances and returns them in a pbin.Response.
+func (h *numaDistancesHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var dReq helper.NUMADistancesRequest
+	if err := json.Unmarshal(req.Payload, &dReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	dists, err := h.provider.GetNUMADistances(context.Background())
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(&helper.NUMADistancesResponse{
+		Distances: dists,
+	})
+}
+
+// processNUMANodeHandler implements the ProcessNUMANode method.
+type processNUMANodeHandler struct {
+	provider hardware.ProcessNUMAProvider
+}
+
+func (h *processNUMANodeHandler) setupProvider(log logging.Logger) {
+	if h.provider == nil {
+		h.provider = topology.DefaultProcessNUMAProvider(log)
+	}
+}
+
+// Handle fetches the NUMA node of a process and returns it in a pbin.Response.
+func (h *processNUMANodeHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var nReq helper.ProcessNUMANodeRequest
+	if err := json.Unmarshal(req.Payload, &nReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	node, err := h.provider.GetNUMANodeIDForPID(context.Background(), nReq.PID)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(&helper.ProcessNUMANodeResponse{
+		NUMANode: node,
+	})
+}
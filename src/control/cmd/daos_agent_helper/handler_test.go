@@ -0,0 +1,309 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/hardware/helper"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/pbin"
+)
+
+func expectPayload(t *testing.T, resp *pbin.Response, payload interface{}, expPayload interface{}) {
+	t.Helper()
+
+	err := json.Unmarshal(resp.Payload, payload)
+	if err != nil {
+		t.Fatalf("couldn't unmarshal response payload")
+	}
+
+	if diff := cmp.Diff(expPayload, payload); diff != "" {
+		t.Errorf("got wrong payload (-want, +got)\n%s\n", diff)
+	}
+}
+
+var nilPayloadErr = pbin.PrivilegedHelperRequestFailed("unexpected end of JSON input")
+
+type mockFabricScanner struct {
+	providers []string
+	fis       *hardware.FabricInterfaceSet
+	err       error
+}
+
+func (m *mockFabricScanner) Scan(_ context.Context, providers ...string) (*hardware.FabricInterfaceSet, error) {
+	m.providers = providers
+	return m.fis, m.err
+}
+
+type mockNUMAProvider struct {
+	pid   int32
+	node  uint
+	dists hardware.NUMADistances
+	err   error
+}
+
+func (m *mockNUMAProvider) GetNUMANodeIDForPID(_ context.Context, pid int32) (uint, error) {
+	m.pid = pid
+	return m.node, m.err
+}
+
+func (m *mockNUMAProvider) GetNUMADistances(_ context.Context) (hardware.NUMADistances, error) {
+	return m.dists, m.err
+}
+
+func TestDaosAgentHelper_FabricScanHandler(t *testing.T) {
+	reqPayload, err := json.Marshal(helper.FabricScanRequest{
+		Providers: []string{"ofi+tcp", "ofi+verbs"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		req        *pbin.Request
+		scanner    *mockFabricScanner
+		expProvs   []string
+		expPayload *helper.FabricScanResponse
+		expErr     *fault.Fault
+	}{
+		"nil request": {
+			expErr: pbin.PrivilegedHelperRequestFailed("nil request"),
+		},
+		"nil payload": {
+			req: &pbin.Request{
+				Method: helper.FabricScanMethod,
+			},
+			expErr: nilPayloadErr,
+		},
+		"scan failed": {
+			req: &pbin.Request{
+				Method:  helper.FabricScanMethod,
+				Payload: reqPayload,
+			},
+			scanner: &mockFabricScanner{
+				err: errors.New("mock Scan"),
+			},
+			expProvs: []string{"ofi+tcp", "ofi+verbs"},
+			expErr:   pbin.PrivilegedHelperRequestFailed("mock Scan"),
+		},
+		"success": {
+			req: &pbin.Request{
+				Method:  helper.FabricScanMethod,
+				Payload: reqPayload,
+			},
+			scanner: &mockFabricScanner{
+				fis: hardware.NewFabricInterfaceSet(
+					&hardware.FabricInterface{
+						Name:          "eth0",
+						OSName:        "eth0",
+						NetInterfaces: common.NewStringSet("eth0"),
+						Providers: hardware.NewFabricProviderSet(
+							&hardware.FabricProvider{Name: "ofi+tcp", Priority: 1},
+						),
+						DeviceClass: hardware.Ether,
+						NUMANode:    1,
+					},
+				),
+			},
+			expProvs: []string{"ofi+tcp", "ofi+verbs"},
+			expPayload: &helper.FabricScanResponse{
+				Interfaces: []*helper.FabricInterface{
+					{
+						Name:          "eth0",
+						OSName:        "eth0",
+						NetInterfaces: []string{"eth0"},
+						Providers: []*hardware.FabricProvider{
+							{Name: "ofi+tcp", Priority: 1},
+						},
+						DeviceClass: hardware.Ether,
+						NUMANode:    1,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.scanner == nil {
+				tc.scanner = &mockFabricScanner{}
+			}
+
+			handler := &fabricScanHandler{scanner: tc.scanner}
+
+			resp := handler.Handle(log, tc.req)
+
+			if diff := cmp.Diff(tc.expErr, resp.Error); diff != "" {
+				t.Errorf("got wrong fault (-want, +got)\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expProvs, tc.scanner.providers); diff != "" {
+				t.Errorf("got wrong providers (-want, +got)\n%s\n", diff)
+			}
+			if tc.expPayload == nil {
+				tc.expPayload = &helper.FabricScanResponse{}
+			}
+			expectPayload(t, resp, &helper.FabricScanResponse{}, tc.expPayload)
+		})
+	}
+}
+
+func TestDaosAgentHelper_NUMADistancesHandler(t *testing.T) {
+	reqPayload, err := json.Marshal(helper.NUMADistancesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		req        *pbin.Request
+		provider   *mockNUMAProvider
+		expPayload *helper.NUMADistancesResponse
+		expErr     *fault.Fault
+	}{
+		"nil request": {
+			expErr: pbin.PrivilegedHelperRequestFailed("nil request"),
+		},
+		"nil payload": {
+			req: &pbin.Request{
+				Method: helper.NUMADistancesMethod,
+			},
+			expErr: nilPayloadErr,
+		},
+		"failed": {
+			req: &pbin.Request{
+				Method:  helper.NUMADistancesMethod,
+				Payload: reqPayload,
+			},
+			provider: &mockNUMAProvider{
+				err: errors.New("mock GetNUMADistances"),
+			},
+			expErr: pbin.PrivilegedHelperRequestFailed("mock GetNUMADistances"),
+		},
+		"success": {
+			req: &pbin.Request{
+				Method:  helper.NUMADistancesMethod,
+				Payload: reqPayload,
+			},
+			provider: &mockNUMAProvider{
+				dists: hardware.NUMADistances{
+					0: {0: 10, 1: 20},
+					1: {0: 20, 1: 10},
+				},
+			},
+			expPayload: &helper.NUMADistancesResponse{
+				Distances: hardware.NUMADistances{
+					0: {0: 10, 1: 20},
+					1: {0: 20, 1: 10},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.provider == nil {
+				tc.provider = &mockNUMAProvider{}
+			}
+
+			handler := &numaDistancesHandler{provider: tc.provider}
+
+			resp := handler.Handle(log, tc.req)
+
+			if diff := cmp.Diff(tc.expErr, resp.Error); diff != "" {
+				t.Errorf("got wrong fault (-want, +got)\n%s\n", diff)
+			}
+			if tc.expPayload == nil {
+				tc.expPayload = &helper.NUMADistancesResponse{}
+			}
+			expectPayload(t, resp, &helper.NUMADistancesResponse{}, tc.expPayload)
+		})
+	}
+}
+
+func TestDaosAgentHelper_ProcessNUMANodeHandler(t *testing.T) {
+	reqPayload, err := json.Marshal(helper.ProcessNUMANodeRequest{
+		PID: 1234,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		req        *pbin.Request
+		provider   *mockNUMAProvider
+		expPID     int32
+		expPayload *helper.ProcessNUMANodeResponse
+		expErr     *fault.Fault
+	}{
+		"nil request": {
+			expErr: pbin.PrivilegedHelperRequestFailed("nil request"),
+		},
+		"nil payload": {
+			req: &pbin.Request{
+				Method: helper.ProcessNUMANodeMethod,
+			},
+			expErr: nilPayloadErr,
+		},
+		"failed": {
+			req: &pbin.Request{
+				Method:  helper.ProcessNUMANodeMethod,
+				Payload: reqPayload,
+			},
+			provider: &mockNUMAProvider{
+				err: errors.New("mock GetNUMANodeIDForPID"),
+			},
+			expPID: 1234,
+			expErr: pbin.PrivilegedHelperRequestFailed("mock GetNUMANodeIDForPID"),
+		},
+		"success": {
+			req: &pbin.Request{
+				Method:  helper.ProcessNUMANodeMethod,
+				Payload: reqPayload,
+			},
+			provider: &mockNUMAProvider{
+				node: 3,
+			},
+			expPID: 1234,
+			expPayload: &helper.ProcessNUMANodeResponse{
+				NUMANode: 3,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.provider == nil {
+				tc.provider = &mockNUMAProvider{}
+			}
+
+			handler := &processNUMANodeHandler{provider: tc.provider}
+
+			resp := handler.Handle(log, tc.req)
+
+			if diff := cmp.Diff(tc.expErr, resp.Error); diff != "" {
+				t.Errorf("got wrong fault (-want, +got)\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expPID, tc.provider.pid, "unexpected PID")
+			if tc.expPayload == nil {
+				tc.expPayload = &helper.ProcessNUMANodeResponse{}
+			}
+			expectPayload(t, resp, &helper.ProcessNUMANodeResponse{}, tc.expPayload)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+
+	"github.com/daos-stack/daos/src/control/lib/hardware/helper"
+	"github.com/daos-stack/daos/src/control/pbin"
+)
+
+func main() {
+	app := pbin.NewApp().
+		WithAllowedCallers("daos_agent")
+
+	if logPath, set := os.LookupEnv(pbin.DaosAgentHelperLogFileEnvVar); set {
+		app = app.WithLogFile(logPath)
+	}
+
+	addMethodHandlers(app)
+
+	err := app.Run()
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+// addMethodHandlers adds all of daos_agent_helper's supported handler functions.
+func addMethodHandlers(app *pbin.App) {
+	app.AddHandler(helper.FabricScanMethod, &fabricScanHandler{})
+	app.AddHandler(helper.NUMADistancesMethod, &numaDistancesHandler{})
+	app.AddHandler(helper.ProcessNUMANodeMethod, &processNUMANodeHandler{})
+}
@@ -0,0 +1,58 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+// configDumpCmd prints the server configuration as it is loaded: with the
+// included files merged, environment variables expanded and defaults applied.
+//
+// The config file is loaded by the command rather than through cfgCmd so that
+// nothing other than the configuration is written to stdout.
+type configDumpCmd struct {
+	cmdutil.LogCmd
+	ConfigPath string `short:"o" long:"config" description:"Server config file path"`
+}
+
+// Execute is run when configDumpCmd activates.
+func (cmd *configDumpCmd) Execute(_ []string) error {
+	cfgPath := cmd.ConfigPath
+	if cfgPath == "" {
+		cfgPath = path.Join(build.ConfigDir, defaultConfigFile)
+	}
+
+	cfg := config.DefaultServer()
+	if err := cfg.SetPath(cfgPath); err != nil {
+		return err
+	}
+	if err := cfg.Load(cmd.Logger); err != nil {
+		return errors.Wrapf(err, "failed to load config from %s", cfg.Path)
+	}
+
+	_, sources, err := common.ReadConfigFile(cfg.Path)
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := common.WriteEffectiveConfig(&bld, cfg, sources); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
@@ -0,0 +1,105 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestDaosServer_configDumpCmd(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	commonCfg := writeFile("common.yml", `
+name: daos_test
+port: 10001
+mgmt_svc_replicas: ["one", "two", "three"]
+transport_config:
+  allow_insecure: true
+`)
+	mainCfg := writeFile("daos_server.yml", `
+include: common.yml
+port: 10002
+control_log_file: ${DAOS_TEST_SERVER_LOG_DIR:-/tmp}/daos_server.log
+`)
+	badCfg := writeFile("bad.yml", `
+include: common.yml
+control_log_file: ${DAOS_TEST_SERVER_UNSET}
+`)
+
+	for name, tc := range map[string]struct {
+		cfgPath string
+		expErr  error
+	}{
+		"missing file": {
+			cfgPath: filepath.Join(dir, "missing.yml"),
+			expErr:  errors.New("no such file"),
+		},
+		"unset variable": {
+			cfgPath: badCfg,
+			expErr:  errors.New("not set: DAOS_TEST_SERVER_UNSET"),
+		},
+		"success": {
+			cfgPath: mainCfg,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestCommandLineLogger()
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cmd := &configDumpCmd{
+				LogCmd: cmdutil.LogCmd{
+					Logger: log,
+				},
+				ConfigPath: tc.cfgPath,
+			}
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			out := buf.String()
+			expHeader := "# Effective configuration merged from:\n#   " + commonCfg + "\n#   " + mainCfg + "\n"
+			if !strings.Contains(out, expHeader) {
+				t.Fatalf("expected output to contain %q", expHeader)
+			}
+			dumped := out[strings.Index(out, expHeader):]
+
+			// The dumped config must load to the same settings.
+			cfg := config.DefaultServer()
+			if err := cfg.SetPath(writeFile("dumped.yml", dumped)); err != nil {
+				t.Fatal(err)
+			}
+			if err := cfg.Load(log); err != nil {
+				t.Fatalf("dumped config failed to load: %s\n%s", err, dumped)
+			}
+			test.AssertEqual(t, "daos_test", cfg.SystemName, "name not included")
+			test.AssertEqual(t, 10002, cfg.ControlPort, "port not overridden")
+			test.AssertEqual(t, "/tmp/daos_server.log", cfg.ControlLogFile, "default not applied")
+			test.AssertEqual(t, []string{"one", "two", "three"}, cfg.MgmtSvcReplicas, "replicas not included")
+		})
+	}
+}
@@ -47,15 +47,16 @@ type mainOpts struct {
 	Syslog  bool `long:"syslog" description:"Enable logging to syslog"`
 
 	// Define subcommands
-	SCM      scmStorageCmd           `command:"scm" description:"Perform tasks related to locally-attached SCM storage"`
-	NVMe     nvmeStorageCmd          `command:"nvme" description:"Perform tasks related to locally-attached NVMe storage"`
-	Start    startCmd                `command:"start" description:"Start daos_server"`
-	Network  networkCmd              `command:"network" description:"Perform network device scan based on fabric provider"`
-	Version  versionCmd              `command:"version" description:"Print daos_server version"`
-	MgmtSvc  msCmdRoot               `command:"ms" description:"Perform tasks related to management service replicas"`
-	DumpTopo cmdutil.DumpTopologyCmd `command:"dump-topology" description:"Dump system topology"`
-	Support  supportCmd              `command:"support" description:"Perform debug tasks to help support team"`
-	Config   configCmd               `command:"config" alias:"cfg" description:"Perform tasks related to configuration of hardware on the local server"`
+	SCM        scmStorageCmd           `command:"scm" description:"Perform tasks related to locally-attached SCM storage"`
+	NVMe       nvmeStorageCmd          `command:"nvme" description:"Perform tasks related to locally-attached NVMe storage"`
+	Start      startCmd                `command:"start" description:"Start daos_server"`
+	Network    networkCmd              `command:"network" description:"Perform network device scan based on fabric provider"`
+	Version    versionCmd              `command:"version" description:"Print daos_server version"`
+	MgmtSvc    msCmdRoot               `command:"ms" description:"Perform tasks related to management service replicas"`
+	DumpTopo   cmdutil.DumpTopologyCmd `command:"dump-topology" description:"Dump system topology"`
+	Support    supportCmd              `command:"support" description:"Perform debug tasks to help support team"`
+	Config     configCmd               `command:"config" alias:"cfg" description:"Perform tasks related to configuration of hardware on the local server"`
+	Completion cmdutil.CompletionCmd   `command:"completion" description:"Generate a shell completion script for daos_server"`
 
 	// Allow a set of tests to be run before executing commands.
 	preExecTests []execTestFn
@@ -113,7 +114,7 @@ func parseOpts(args []string, opts *mainOpts, log *logging.LeveledLogger) error
 		}
 
 		switch cmd.(type) {
-		case *versionCmd:
+		case *versionCmd, *cmdutil.CompletionCmd:
 			// No pre-exec tests or setup needed for these commands; just
 			// execute them directly.
 			return cmd.Execute(nil)
@@ -85,9 +85,10 @@ func (cmd *startCmd) configureLogging() error {
 	}
 
 	return cmdutil.ConfigureLogger(cmd.Logger, cmdutil.LogConfig{
-		LogFile:  cmd.config.ControlLogFile,
-		LogLevel: cmd.config.ControlLogMask,
-		JSON:     cmd.config.ControlLogJSON,
+		LogFile:      cmd.config.ControlLogFile,
+		LogLevel:     cmd.config.ControlLogMask,
+		ModuleLevels: cmd.config.ControlLogModules,
+		JSON:         cmd.config.ControlLogJSON,
 	})
 }
 
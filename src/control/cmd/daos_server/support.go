@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
@@ -18,7 +20,8 @@ import (
 
 // supportCmd is the struct representing the top-level support subcommand.
 type supportCmd struct {
-	CollectLog collectLogCmd `command:"collect-log" description:"Collect logs from server"`
+	CollectLog  collectLogCmd  `command:"collect-log" description:"Collect logs from server"`
+	DiffBundles diffBundlesCmd `command:"diff-bundles" description:"Compare two collected support bundles"`
 }
 
 // collectLogCmd is the struct representing the command to collect the Logs/config for support purpose
@@ -30,32 +33,42 @@ type collectLogCmd struct {
 }
 
 func (cmd *collectLogCmd) Execute(_ []string) error {
-	var LogCollection = map[int32][]string{}
 	err := cmd.DateTimeValidate()
 	if err != nil {
 		return err
 	}
 
+	serverLogs, err := cmd.LogTypeValidate()
+	if err != nil {
+		return err
+	}
+
 	// Only collect the specific logs Admin,Control or Engine.
 	// This will ignore the system information collection.
-	if cmd.LogType != "" {
-		LogCollection[support.CollectServerLogEnum], err = cmd.LogTypeValidate()
-		if err != nil {
-			return err
-		}
-	} else {
-		LogCollection[support.CopyServerConfigEnum] = []string{""}
-		LogCollection[support.CollectSystemCmdEnum] = support.SystemCmd
-		LogCollection[support.CollectDaosServerCmdEnum] = support.DaosServerCmd
-		LogCollection[support.CollectServerLogEnum], err = cmd.LogTypeValidate()
+	var collectors []support.Collector
+	if cmd.LogType == "" {
+		collectors = append(collectors, support.NewCollectors(support.CopyServerConfigEnum)...)
+		collectors = append(collectors, support.NewCollectors(support.CollectSystemCmdEnum, support.SystemCmd...)...)
+		collectors = append(collectors, support.NewCollectors(support.CollectDaosServerCmdEnum, support.DaosServerCmd...)...)
+	}
+	collectors = append(collectors, support.NewCollectors(support.CollectServerLogEnum, serverLogs...)...)
+
+	// Copy custom log folder
+	if cmd.ExtraLogsDir != "" {
+		collectors = append(collectors, support.NewCollectors(support.CollectExtraLogsDirEnum)...)
+	}
+
+	// Run the site-specific collectors
+	if cmd.CollectorsManifest != "" {
+		siteCollectors, err := support.LoadCollectorManifest(cmd.CollectorsManifest)
 		if err != nil {
 			return err
 		}
+		collectors = append(collectors, siteCollectors...)
 	}
 
-	// Default 4 steps of log/conf collection.
 	progress := support.ProgressBar{
-		Total:     len(LogCollection),
+		Total:     support.EstimateSteps(collectors...),
 		NoDisplay: false,
 	}
 
@@ -63,12 +76,6 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 		progress.Total++
 	}
 
-	// Copy custom log folder
-	if cmd.ExtraLogsDir != "" {
-		LogCollection[support.CollectExtraLogsDirEnum] = []string{""}
-		progress.Total++
-	}
-
 	if cmd.TargetFolder == "" {
 		folderName := fmt.Sprintf("daos_support_server_logs_%s", time.Now().Format(time.RFC3339))
 		cmd.TargetFolder = filepath.Join(os.TempDir(), folderName)
@@ -86,21 +93,19 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 	params.LogEndTime = cmd.LogEndTime
 	params.FileTransferExecArgs = cmd.FileTransferExecArgs
 
-	for logFunc, logCmdSet := range LogCollection {
-		for _, logCmd := range logCmdSet {
-			cmd.Debugf("Log Function Enum = %d -- Log Collect Cmd = %s ", logFunc, logCmd)
-			params.LogFunction = logFunc
-			params.LogCmd = logCmd
-
-			err := support.CollectSupportLog(cmd.Logger, params)
-			if err != nil {
-				fmt.Println(err)
-				if cmd.StopOnError {
-					return err
-				}
-			}
-		}
-		fmt.Print(progress.Display())
+	runner := &support.CollectorRunner{
+		Log:         cmd.Logger,
+		Report:      support.NewReport("daos_server support collect-log"),
+		Progress:    &progress,
+		Output:      os.Stdout,
+		StopOnError: cmd.StopOnError,
+	}
+	if err := runner.Run(params, collectors...); err != nil {
+		return err
+	}
+
+	if err := runner.Report.Write(cmd.Logger, cmd.TargetFolder); err != nil {
+		cmd.Noticef("Failed to write the summary report: %s", err)
 	}
 
 	if cmd.Archive {
@@ -120,3 +125,36 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 
 	return nil
 }
+
+// diffBundlesCmd is the struct representing the command to compare two support
+// bundles, e.g. those collected in a working and a failing state.
+type diffBundlesCmd struct {
+	cmdutil.LogCmd
+	cmdutil.JSONOutputCmd
+	Args struct {
+		BundleA string `positional-arg-name:"bundle-a" required:"1" description:"Support bundle folder or archive to compare from"`
+		BundleB string `positional-arg-name:"bundle-b" required:"1" description:"Support bundle folder or archive to compare with"`
+	} `positional-args:"yes"`
+}
+
+func (cmd *diffBundlesCmd) Execute(_ []string) error {
+	bundleA, err := support.LoadBundle(cmd.Logger, cmd.Args.BundleA)
+	if err != nil {
+		return err
+	}
+	bundleB, err := support.LoadBundle(cmd.Logger, cmd.Args.BundleB)
+	if err != nil {
+		return err
+	}
+
+	diff := support.DiffBundles(bundleA, bundleB)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(diff, nil)
+	}
+
+	var out strings.Builder
+	support.PrintBundleDiff(&out, diff)
+	cmd.Info(out.String())
+
+	return nil
+}
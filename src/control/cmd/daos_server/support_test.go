@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,9 +10,33 @@ package main
 import (
 	"testing"
 
+	"github.com/pkg/errors"
+
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
+func TestDaosServer_Support_Commands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Diff bundles",
+			"support diff-bundles a.tar b.tar",
+			printCommand(t, func() *diffBundlesCmd {
+				cmd := &diffBundlesCmd{}
+				cmd.Args.BundleA = "a.tar"
+				cmd.Args.BundleB = "b.tar"
+				return cmd
+			}()),
+			nil,
+		},
+		{
+			"Diff bundles; missing bundle",
+			"support diff-bundles a.tar",
+			"",
+			errors.New("bundle-b"),
+		},
+	})
+}
+
 // TestDaosServer_Support_Commands_JSON verifies that the JSON-output flag is disabled for support
 // command syntax.
 func TestDaosServer_Support_Commands_JSON(t *testing.T) {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,8 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -24,9 +27,14 @@ import (
 
 type confGenRemoteFn func(ctx context.Context, req control.ConfGenerateRemoteReq) (*control.ConfGenerateRemoteResp, error)
 
-// Package-local function pointer for backend API call. Enables mocking out package-external calls
+type confGenInventoryFn func(ctx context.Context, req control.ConfGenerateRemoteReq) (*control.ConfGenerateInventoryResp, error)
+
+// Package-local function pointers for backend API calls. Enables mocking out package-external calls
 // in unit tests.
-var confGenRemoteCall confGenRemoteFn = control.ConfGenerateRemote
+var (
+	confGenRemoteCall    confGenRemoteFn    = control.ConfGenerateRemote
+	confGenInventoryCall confGenInventoryFn = control.ConfGenerateInventory
+)
 
 // configCmd is the struct representing the top-level config subcommand.
 type configCmd struct {
@@ -40,11 +48,12 @@ type configGenCmd struct {
 	hostListCmd
 	cmdutil.JSONOutputCmd
 	cmdutil.ConfGenCmd
+	estimateFlags
+	FromInventory bool `long:"from-inventory" description:"Scan the hardware of each host in the host list and generate a config per set of hosts with matching hardware, rather than requiring the hardware to be the same on all hosts"`
 }
 
-func (cmd *configGenCmd) confGen(ctx context.Context) (*config.Server, error) {
-	cmd.Debugf("ConfGen called with command parameters %+v", cmd)
-
+// confGenReq returns the request for config generation populated from the command line.
+func (cmd *configGenCmd) confGenReq() (control.ConfGenerateRemoteReq, error) {
 	// check cli then config for hostlist, default to localhost
 	hl := cmd.getHostList()
 	if len(hl) == 0 && cmd.config != nil {
@@ -62,9 +71,9 @@ func (cmd *configGenCmd) confGen(ctx context.Context) (*config.Server, error) {
 		HostList:        hl,
 	}
 	if err := convert.Types(&cmd.ConfGenCmd, &req.ConfGenerateReq); err != nil {
-		return nil, err
+		return req, err
 	}
-	cmd.Debugf("control API ConfGenerateRemote called with req: %+v", req)
+	cmd.Debugf("control API config generate called with req: %+v", req)
 
 	// Use a modified commandline logger to send all log messages to stderr in debug mode
 	// during the generation of server config file parameters so stdout can be reserved for
@@ -80,6 +89,34 @@ func (cmd *configGenCmd) confGen(ctx context.Context) (*config.Server, error) {
 	}
 	req.Log = logger
 
+	return req, nil
+}
+
+// printConfGenErr prints any host level errors from the config generation.
+func (cmd *configGenCmd) printConfGenErr(err error) error {
+	cge, ok := errors.Cause(err).(*control.ConfGenerateError)
+	if !ok {
+		// includes hardware validation errors e.g. hardware across hostset differs
+		return err
+	}
+
+	// host level errors e.g. unresponsive daos_server process
+	var bld strings.Builder
+	if err := pretty.PrintResponseErrors(cge, &bld); err != nil {
+		return err
+	}
+	cmd.Error(bld.String())
+	return err
+}
+
+func (cmd *configGenCmd) confGen(ctx context.Context) (*config.Server, error) {
+	cmd.Debugf("ConfGen called with command parameters %+v", cmd)
+
+	req, err := cmd.confGenReq()
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := confGenRemoteCall(ctx, req)
 
 	if cmd.JSONOutputEnabled() {
@@ -87,26 +124,127 @@ func (cmd *configGenCmd) confGen(ctx context.Context) (*config.Server, error) {
 	}
 
 	if err != nil {
-		cge, ok := errors.Cause(err).(*control.ConfGenerateError)
-		if !ok {
-			// includes hardware validation errors e.g. hardware across hostset differs
-			return nil, err
+		return nil, cmd.printConfGenErr(err)
+	}
+
+	cmd.Debugf("control API ConfGenerateRemote resp: %+v", resp)
+	return &resp.Server, nil
+}
+
+// confGenInventory generates a config for each set of hosts in the host list with matching
+// hardware.
+func (cmd *configGenCmd) confGenInventory(ctx context.Context) (*control.ConfGenerateInventoryResp, error) {
+	cmd.Debugf("ConfGen from inventory called with command parameters %+v", cmd)
+
+	req, err := cmd.confGenReq()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := confGenInventoryCall(ctx, req)
+
+	if cmd.JSONOutputEnabled() {
+		return nil, cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return nil, cmd.printConfGenErr(err)
+	}
+
+	cmd.Debugf("control API ConfGenerateInventory resp: %+v", resp)
+	return resp, nil
+}
+
+// printInventoryConfigs writes the configs generated from the hardware inventory as YAML
+// documents, preceded by comments describing any differences in the hardware of the hosts so that
+// the output remains valid YAML.
+func printInventoryConfigs(out io.Writer, resp *control.ConfGenerateInventoryResp) error {
+	if len(resp.Differences) > 0 {
+		fmt.Fprintln(out, "# WARNING: hardware differs between hosts, the following devices are")
+		fmt.Fprintln(out, "# present on only some of the hosts:")
+		for _, diff := range resp.Differences {
+			fmt.Fprintf(out, "#   %s %s: %s\n", diff.Kind, diff.Device, diff.Hosts)
+		}
+	}
+	if !resp.Homogeneous() {
+		fmt.Fprintf(out, "# A config has been generated for each of %d sets of hosts. The first\n",
+			len(resp.Configs))
+		fmt.Fprintln(out, "# is for the largest set of hosts and those following override it for")
+		fmt.Fprintln(out, "# the remaining hosts.")
+	}
+
+	for i, cfg := range resp.Configs {
+		if i > 0 {
+			fmt.Fprintln(out, "---")
+		}
+		if !resp.Homogeneous() {
+			fmt.Fprintf(out, "# hosts: %s\n", cfg.Hosts)
 		}
 
-		// host level errors e.g. unresponsive daos_server process
-		var bld strings.Builder
-		if err := pretty.PrintResponseErrors(cge, &bld); err != nil {
-			return nil, err
+		bytes, err := yaml.Marshal(&cfg.Server)
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(bytes); err != nil {
+			return err
 		}
-		cmd.Error(bld.String())
-		return nil, err
 	}
 
-	cmd.Debugf("control API ConfGenerateRemote resp: %+v", resp)
-	return &resp.Server, nil
+	return nil
+}
+
+// estimateComment returns a YAML comment block describing the storage
+// required for the workload given on the command line, or an empty string if
+// no workload was given.
+func (cmd *configGenCmd) estimateComment(ctx context.Context) (string, error) {
+	if !cmd.estimateFlags.isSet() {
+		return "", nil
+	}
+
+	est, err := cmd.estimate(ctx)
+	if err != nil {
+		return "", err
+	}
+	cmd.Debugf("storage estimate for %+v: %+v", cmd.workload(), est)
+
+	var bld strings.Builder
+	if err := pretty.PrintStorageEstimate(&bld, est, cmd.Headroom); err != nil {
+		return "", err
+	}
+	mdOnSSD := cmd.UseTmpfsSCM && cmd.ExtMetadataPath != ""
+	fmt.Fprintf(&bld, "Suggested pool create command:\n  dmg pool create %s <pool label>\n",
+		pretty.EstimatePoolCreateArgs(est, cmd.Headroom, mdOnSSD))
+
+	var comment strings.Builder
+	for _, line := range strings.Split(strings.TrimRight(bld.String(), "\n"), "\n") {
+		comment.WriteString(strings.TrimRight("# "+line, " ") + "\n")
+	}
+	return comment.String(), nil
 }
 
 func (cmd *configGenCmd) confGenPrint(ctx context.Context) error {
+	var comment string
+	if !cmd.JSONOutputEnabled() {
+		var err error
+		if comment, err = cmd.estimateComment(ctx); err != nil {
+			return err
+		}
+	}
+
+	if cmd.FromInventory {
+		resp, err := cmd.confGenInventory(ctx)
+		if cmd.JSONOutputEnabled() || err != nil {
+			return err
+		}
+
+		var out strings.Builder
+		if err := printInventoryConfigs(&out, resp); err != nil {
+			return err
+		}
+		cmd.Info(comment + out.String())
+		return nil
+	}
+
 	cfg, err := cmd.confGen(ctx)
 	if cmd.JSONOutputEnabled() || err != nil {
 		return err
@@ -117,8 +255,9 @@ func (cmd *configGenCmd) confGenPrint(ctx context.Context) error {
 		return err
 	}
 
-	// Print generated config yaml file contents to stdout.
-	cmd.Info(string(bytes))
+	// Print generated config yaml file contents to stdout, preceded by any
+	// storage estimate as comments so that the output remains valid YAML.
+	cmd.Info(comment + string(bytes))
 	return nil
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/ui"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -68,11 +69,19 @@ func TestAuto_ConfigCommands(t *testing.T) {
 		return &control.ConfGenerateRemoteResp{}, nil
 	}
 
+	mockConfGenInvCall := func(_ context.Context, req control.ConfGenerateRemoteReq) (*control.ConfGenerateInventoryResp, error) {
+		cgReqCalls = append(cgReqCalls, "inventory-"+printCGRReq(t, req))
+		return &control.ConfGenerateInventoryResp{}, nil
+	}
+
 	// Mock external API calls and restore after tests.
 	origGenRemCall := confGenRemoteCall
 	confGenRemoteCall = mockConfGenRemCall
+	origGenInvCall := confGenInventoryCall
+	confGenInventoryCall = mockConfGenInvCall
 	defer func() {
 		confGenRemoteCall = origGenRemCall
+		confGenInventoryCall = origGenInvCall
 	}()
 
 	runConfGenCmdTests(t, []cmdTest{
@@ -242,6 +251,19 @@ func TestAuto_ConfigCommands(t *testing.T) {
 			}()),
 			nil,
 		},
+		{
+			"Generate from inventory",
+			"config generate -a foo -l bar-[1-2] --from-inventory",
+			"inventory-" + printCGRReq(t, func() control.ConfGenerateRemoteReq {
+				req := control.ConfGenerateRemoteReq{
+					HostList: []string{"bar-1", "bar-2"},
+				}
+				req.ConfGenerateReq.NetClass = hardware.Infiniband
+				req.ConfGenerateReq.MgmtSvcReplicas = []string{"foo"}
+				return req
+			}()),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"network quack",
@@ -344,6 +366,7 @@ func TestAuto_confGen(t *testing.T) {
 		netClass         string
 		tmpfsSCM         bool
 		extMetadataPath  string
+		estimate         estimateFlags
 		uErr             error
 		hostResponsesSet [][]*control.HostResponse
 		expCfg           *config.Server
@@ -457,6 +480,28 @@ func TestAuto_confGen(t *testing.T) {
 				WithControlMetadata(controlMetadata),
 			expOutPrefix: "port: 10001",
 		},
+		"tmpfs scm; md-on-ssd; storage estimate": {
+			tmpfsSCM:        true,
+			extMetadataPath: metadataMountPath,
+			estimate: estimateFlags{
+				Objects:    1000,
+				ObjectSize: ui.ByteSizeFlag{Bytes: 1024},
+				Headroom:   20,
+			},
+			hostResponsesSet: [][]*control.HostResponse{
+				{netHostResp},
+				{storHostResp},
+			},
+			expOutPrefix: "# Storage estimate (vos backend)",
+		},
+		"storage estimate; bad redundancy": {
+			estimate: estimateFlags{
+				Objects:    1000,
+				ObjectSize: ui.ByteSizeFlag{Bytes: 1024},
+				Redundancy: "foo",
+			},
+			expErr: errors.New("unsupported object class"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(name)
@@ -476,6 +521,7 @@ func TestAuto_confGen(t *testing.T) {
 			cmd.NetClass = tc.netClass
 			cmd.UseTmpfsSCM = tc.tmpfsSCM
 			cmd.ExtMetadataPath = tc.extMetadataPath
+			cmd.estimateFlags = tc.estimate
 			log.SetLevel(logging.LogLevelInfo)
 			cmd.Logger = log
 			cmd.hostlist = tc.hostlist
@@ -491,10 +537,11 @@ func TestAuto_confGen(t *testing.T) {
 			mic.UnaryError = tc.uErr
 			cmd.ctlInvoker = control.NewMockInvoker(log, &mic)
 
-			if tc.expOutPrefix != "" {
+			if tc.expOutPrefix != "" || tc.estimate.isSet() {
 				gotErr := cmd.confGenPrint(test.Context(t))
-				if gotErr != nil {
-					t.Fatal(gotErr)
+				test.CmpErr(t, tc.expErr, gotErr)
+				if tc.expErr != nil {
+					return
 				}
 				if len(buf.String()) == 0 {
 					t.Fatal("no output from config generate print function")
@@ -665,3 +712,78 @@ hyperthreads: false
 		t.Fatalf("unexpected output config (-want, +got):\n%s\n", diff)
 	}
 }
+
+func TestAuto_printInventoryConfigs(t *testing.T) {
+	cfg := func(name string) config.Server {
+		return config.Server{SystemName: name}
+	}
+
+	for name, tc := range map[string]struct {
+		resp   *control.ConfGenerateInventoryResp
+		expOut string
+	}{
+		"homogeneous": {
+			resp: &control.ConfGenerateInventoryResp{
+				Configs: []*control.ConfGenerateHostsConfig{
+					{Hosts: "host[1-2]", Server: cfg("daos_server")},
+				},
+			},
+			expOut: "name: daos_server",
+		},
+		"homogeneous with differences": {
+			resp: &control.ConfGenerateInventoryResp{
+				Configs: []*control.ConfGenerateHostsConfig{
+					{Hosts: "host[1-2]", Server: cfg("daos_server")},
+				},
+				Differences: []*control.HardwareDifference{
+					{Kind: control.HardwareFabricInterface, Device: "eth1", Hosts: "host1"},
+				},
+			},
+			expOut: `# WARNING: hardware differs between hosts, the following devices are
+# present on only some of the hosts:
+#   fabric interface eth1: host1
+name: daos_server`,
+		},
+		"heterogeneous": {
+			resp: &control.ConfGenerateInventoryResp{
+				Configs: []*control.ConfGenerateHostsConfig{
+					{Hosts: "host[1-2]", Server: cfg("daos_server")},
+					{Hosts: "host3", Server: cfg("daos_server3")},
+				},
+				Differences: []*control.HardwareDifference{
+					{Kind: control.HardwareNvmeController, Device: "0000:81:00.0", Hosts: "host[1-2]"},
+				},
+			},
+			expOut: `# WARNING: hardware differs between hosts, the following devices are
+# present on only some of the hosts:
+#   nvme controller 0000:81:00.0: host[1-2]
+# A config has been generated for each of 2 sets of hosts. The first
+# is for the largest set of hosts and those following override it for
+# the remaining hosts.
+# hosts: host[1-2]
+name: daos_server
+---
+# hosts: host3
+name: daos_server3`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			if err := printInventoryConfigs(&out, tc.resp); err != nil {
+				t.Fatal(err)
+			}
+
+			// Only compare the comments and the system name of each config.
+			var got []string
+			for _, line := range strings.Split(out.String(), "\n") {
+				if strings.HasPrefix(line, "#") || strings.HasPrefix(line, "name:") ||
+					line == "---" {
+					got = append(got, line)
+				}
+			}
+			if diff := cmp.Diff(tc.expOut, strings.Join(got, "\n")); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
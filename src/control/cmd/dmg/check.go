@@ -257,7 +257,20 @@ func (cmd *checkSetPolicyCmd) Execute(_ []string) error {
 		return err
 	}
 
-	cmd.Info("system checker policies updated")
+	// Display the policies now stored in the MS so that the effect of the
+	// update is visible alongside the unchanged classes.
+	resp, err := control.SystemCheckGetPolicy(ctx, cmd.ctlInvoker, new(control.SystemCheckGetPolicyReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "system checker policies updated but could not be fetched")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "system checker policies updated")
+	pretty.PrintCheckerPolicies(&buf, resp.CheckerFlags, resp.Policies...)
+	cmd.Info(buf.String())
 
 	return nil
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -67,3 +68,67 @@ func TestCheckGetPolicyCommand(t *testing.T) {
 		},
 	})
 }
+
+func TestCheckSetPolicyCommand(t *testing.T) {
+	// Each set is followed by a get to display the resulting policies.
+	getPolicyReq := printRequest(t, &control.SystemCheckGetPolicyReq{
+		CheckGetPolicyReq: mgmtpb.CheckGetPolicyReq{Sys: "daos_server-unset"},
+	})
+	setPolicyReq := func(cls chkpb.CheckInconsistClass, act chkpb.CheckInconsistAction) string {
+		return printRequest(t, &control.SystemCheckSetPolicyReq{
+			Policies: []*control.SystemCheckPolicy{
+				{
+					FindingClass: control.SystemCheckFindingClass(cls),
+					RepairAction: control.SystemCheckRepairAction(act),
+				},
+			},
+			CheckSetPolicyReq: mgmtpb.CheckSetPolicyReq{
+				Sys: "daos_server-unset",
+				Policies: []*mgmtpb.CheckInconsistPolicy{
+					{InconsistCas: cls, InconsistAct: act},
+				},
+			},
+		}) + " " + getPolicyReq
+	}
+
+	runCmdTests(t, []cmdTest{
+		{
+			"Set policy with no arguments",
+			"check set-policy",
+			"",
+			errors.New("no policies specified"),
+		},
+		{
+			"Set policy action",
+			"check set-policy POOL_BAD_LABEL:TRUST_MS",
+			setPolicyReq(chkpb.CheckInconsistClass_CIC_POOL_BAD_LABEL,
+				chkpb.CheckInconsistAction_CIA_TRUST_MS),
+			nil,
+		},
+		{
+			"Set policy action is case-insensitive",
+			"check set-policy POOL_BAD_LABEL:interact",
+			setPolicyReq(chkpb.CheckInconsistClass_CIC_POOL_BAD_LABEL,
+				chkpb.CheckInconsistAction_CIA_INTERACT),
+			nil,
+		},
+		{
+			"Set policy repair is not an action",
+			"check set-policy CONT_BAD_LABEL:repair",
+			"",
+			errors.New("invalid inconsistency action"),
+		},
+		{
+			"Set policy with invalid action",
+			"check set-policy POOL_BAD_LABEL:garbage",
+			"",
+			errors.New("invalid inconsistency action"),
+		},
+		{
+			"Set policy with invalid class",
+			"check set-policy garbage:ignore",
+			"",
+			errors.New("settable property"),
+		},
+	})
+}
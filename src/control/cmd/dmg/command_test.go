@@ -151,6 +151,14 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemStartResp{})
 	case *control.SystemExcludeReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemExcludeResp{})
+	case *control.SystemListScheduledReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemListScheduledResp{})
+	case *control.SystemEventsReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemEventsResp{})
+	case *control.SystemOpLocksReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemOpLocksResp{})
+	case *control.SystemUsageReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemUsageResp{})
 	case *control.SystemDrainReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDrainResp{})
 	case *control.SystemQueryReq:
@@ -161,18 +169,34 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemQueryResp{})
 	case *control.SystemCleanupReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemCleanupResp{})
+	case *control.SystemListClientsReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemListClientsResp{})
 	case *control.LeaderQueryReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.LeaderQueryResp{})
 	case *control.ListPoolsReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ListPoolsResp{})
 	case *control.ContSetOwnerReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
+	case *control.ContCreateReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ContCreateResp{})
+	case *control.ContDestroyReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
+	case *control.ContQueryReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ContQueryResp{})
+	case *control.ListContainersReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ListContResp{})
 	case *control.PoolQueryReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryResp{})
 	case *control.PoolQueryTargetReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryTargetResp{})
 	case *control.PoolUpgradeReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolUpgradeResp{})
+	case *control.PoolRebalanceReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolRebalanceResp{})
+	case *control.PoolRenameLabelReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolRenameLabelResp{})
+	case *control.PoolUpdateAliasesReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolUpdateAliasesResp{})
 	case *control.PoolGetACLReq, *control.PoolOverwriteACLReq,
 		*control.PoolUpdateACLReq, *control.PoolDeleteACLReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ACLResp{})
@@ -180,6 +204,10 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolRanksResp{})
 	case *control.PoolExtendReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolExtendResp{})
+	case *control.PoolSetPolicyReq, *control.PoolRemovePolicyReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
+	case *control.PoolListPoliciesReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolListPoliciesResp{})
 	case *control.SystemCheckEnableReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.SystemCheckDisableReq:
@@ -204,6 +232,10 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.SystemGetPropReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetPropResp{})
+	case *control.SystemSetFaultDomainsReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemSetFaultDomainsResp{})
+	case *control.SystemReplaceHostReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemReplaceHostResp{})
 	case *control.GetAttachInfoReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.GetAttachInfoResp{})
 	case *control.NetworkScanReq:
@@ -310,3 +342,40 @@ func TestNoCommand(t *testing.T) {
 	err := parseOpts([]string{}, &opts, nil, log)
 	testExpectedError(t, fmt.Errorf("Please specify one command"), err)
 }
+
+func TestLogModules(t *testing.T) {
+	for name, tc := range map[string]struct {
+		modules  string
+		expLevel logging.LogLevel
+		expErr   error
+	}{
+		"bad module level": {
+			modules: "dmg.pool=loud",
+			expErr:  fmt.Errorf("not a valid log level"),
+		},
+		"module level set": {
+			modules:  "dmg.pool=error",
+			expLevel: logging.LogLevelError,
+		},
+		"parent module level set": {
+			modules:  "dmg=notice",
+			expLevel: logging.LogLevelNotice,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			err := runCmd(t, "--log-modules="+tc.modules+" system leader-query", log,
+				control.DefaultMockInvoker(log))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if got := log.ModuleLevel("dmg.pool"); got != tc.expLevel {
+				t.Fatalf("expected dmg.pool level %s, got %s", tc.expLevel, got)
+			}
+		})
+	}
+}
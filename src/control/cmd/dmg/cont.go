@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,9 +8,12 @@
 package main
 
 import (
+	"strings"
+
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/ui"
 )
@@ -30,6 +34,10 @@ type contCmd struct {
 
 // ContCmd is the struct representing the top-level container subcommand.
 type ContCmd struct {
+	Create   ContCreateCmd   `command:"create" description:"Create a DAOS container"`
+	Destroy  ContDestroyCmd  `command:"destroy" description:"Destroy a DAOS container"`
+	List     ContListCmd     `command:"list" alias:"ls" description:"List the containers in a DAOS pool"`
+	Query    ContQueryCmd    `command:"query" description:"Query a DAOS container"`
 	SetOwner ContSetOwnerCmd `command:"set-owner" description:"Change the owner for a DAOS container"`
 }
 
@@ -70,3 +78,122 @@ func (cmd *ContSetOwnerCmd) Execute(args []string) error {
 
 	return err
 }
+
+// ContCreateCmd is the struct representing the command to create a DAOS container.
+type ContCreateCmd struct {
+	poolCmd
+	Label     string              `short:"l" long:"label" description:"Label for the new container"`
+	GroupName ui.ACLPrincipalFlag `short:"g" long:"group" description:"Owner-group for the container, format name@domain"`
+	UserName  ui.ACLPrincipalFlag `short:"u" long:"user" description:"Owner-user for the container, format name@domain"`
+}
+
+// Execute runs the container create command.
+func (cmd *ContCreateCmd) Execute(args []string) error {
+	req := &control.ContCreateReq{
+		PoolID: cmd.PoolID().String(),
+		Label:  cmd.Label,
+		User:   cmd.UserName.String(),
+		Group:  cmd.GroupName.String(),
+	}
+
+	resp, err := control.ContCreate(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "container create failed")
+	}
+
+	cmd.Infof("Successfully created container %s\n", resp.UUID)
+
+	return nil
+}
+
+// ContDestroyCmd is the struct representing the command to destroy a DAOS container.
+type ContDestroyCmd struct {
+	contCmd
+	Force bool `short:"f" long:"force" description:"Forcibly destroy container with open handles"`
+}
+
+// Execute runs the container destroy command.
+func (cmd *ContDestroyCmd) Execute(args []string) error {
+	msg := "SUCCEEDED"
+	req := &control.ContDestroyReq{
+		ContID: cmd.Args.Cont.String(),
+		PoolID: cmd.poolCmd.Args.Pool.String(),
+		Force:  cmd.Force,
+	}
+
+	err := control.ContDestroy(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		msg = errors.WithMessage(err, "FAILED").Error()
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	cmd.Infof("Container-destroy command %s\n", msg)
+
+	return err
+}
+
+// ContListCmd is the struct representing the command to list the containers in a DAOS pool.
+type ContListCmd struct {
+	poolCmd
+}
+
+// Execute runs the container list command.
+func (cmd *ContListCmd) Execute(args []string) error {
+	req := &control.ListContainersReq{
+		PoolID: cmd.PoolID().String(),
+	}
+
+	resp, err := control.ListContainers(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "container list failed")
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintListContainersResponse(&bld, resp); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// ContQueryCmd is the struct representing the command to query a DAOS container.
+type ContQueryCmd struct {
+	contCmd
+}
+
+// Execute runs the container query command.
+func (cmd *ContQueryCmd) Execute(args []string) error {
+	req := &control.ContQueryReq{
+		ContID: cmd.Args.Cont.String(),
+		PoolID: cmd.poolCmd.Args.Pool.String(),
+	}
+
+	resp, err := control.ContQuery(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "container query failed")
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintContQueryResponse(&bld, resp); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
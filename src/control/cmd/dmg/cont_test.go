@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -85,3 +86,141 @@ func TestContSetOwnerCommand(t *testing.T) {
 		},
 	})
 }
+
+func TestContCreateCommand(t *testing.T) {
+	testPoolUUID := uuid.New()
+
+	runCmdTests(t, []cmdTest{
+		{
+			"Create with no arguments",
+			"cont create",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Create with defaults",
+			fmt.Sprintf("cont create %s", testPoolUUID),
+			strings.Join([]string{
+				printRequest(t, &control.ContCreateReq{
+					PoolID: testPoolUUID.String(),
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create with label and owner",
+			fmt.Sprintf("cont create --label=cont1 --user=testuser@ --group=testgroup@ %s",
+				testPoolUUID),
+			strings.Join([]string{
+				printRequest(t, &control.ContCreateReq{
+					PoolID: testPoolUUID.String(),
+					Label:  "cont1",
+					User:   "testuser@",
+					Group:  "testgroup@",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Bad owner principal",
+			fmt.Sprintf("cont create --user=bad@@ %s", testPoolUUID),
+			"",
+			errors.New("invalid ACL principal"),
+		},
+	})
+}
+
+func TestContDestroyCommand(t *testing.T) {
+	testPoolUUID := uuid.New()
+	testContUUID := uuid.New()
+
+	runCmdTests(t, []cmdTest{
+		{
+			"Destroy with no arguments",
+			"cont destroy",
+			"",
+			errors.New("required arguments"),
+		},
+		{
+			"Destroy",
+			fmt.Sprintf("cont destroy %s %s", testPoolUUID, testContUUID),
+			strings.Join([]string{
+				printRequest(t, &control.ContDestroyReq{
+					PoolID: testPoolUUID.String(),
+					ContID: testContUUID.String(),
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Destroy with force",
+			"cont destroy --force pool1 cont1",
+			strings.Join([]string{
+				printRequest(t, &control.ContDestroyReq{
+					PoolID: "pool1",
+					ContID: "cont1",
+					Force:  true,
+				}),
+			}, " "),
+			nil,
+		},
+	})
+}
+
+func TestContListCommand(t *testing.T) {
+	testPoolUUID := uuid.New()
+
+	runCmdTests(t, []cmdTest{
+		{
+			"List with no arguments",
+			"cont list",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"List",
+			fmt.Sprintf("cont list %s", testPoolUUID),
+			strings.Join([]string{
+				printRequest(t, &control.ListContainersReq{
+					PoolID: testPoolUUID.String(),
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"List with alias",
+			"cont ls pool1",
+			strings.Join([]string{
+				printRequest(t, &control.ListContainersReq{
+					PoolID: "pool1",
+				}),
+			}, " "),
+			nil,
+		},
+	})
+}
+
+func TestContQueryCommand(t *testing.T) {
+	testPoolUUID := uuid.New()
+	testContUUID := uuid.New()
+
+	runCmdTests(t, []cmdTest{
+		{
+			"Query with no arguments",
+			"cont query",
+			"",
+			errors.New("required arguments"),
+		},
+		{
+			"Query",
+			fmt.Sprintf("cont query %s %s", testPoolUUID, testContUUID),
+			strings.Join([]string{
+				printRequest(t, &control.ContQueryReq{
+					PoolID: testPoolUUID.String(),
+					ContID: testContUUID.String(),
+				}),
+			}, " "),
+			nil,
+		},
+	})
+}
@@ -0,0 +1,319 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// deprecationPolicyEnv is the environment variable that selects how dmg
+// handles deprecated commands and flags.
+const deprecationPolicyEnv = "DAOS_DMG_DEPRECATIONS"
+
+// deprecationPolicy determines how dmg handles the use of a deprecated command
+// or flag.
+type deprecationPolicy string
+
+const (
+	// deprecationPolicyWarn runs the replacement and prints a warning.
+	deprecationPolicyWarn deprecationPolicy = "warn"
+	// deprecationPolicyError fails without running the command.
+	deprecationPolicyError deprecationPolicy = "error"
+	// deprecationPolicyIgnore silently runs the replacement.
+	deprecationPolicyIgnore deprecationPolicy = "ignore"
+)
+
+// getDeprecationPolicy returns the policy selected in the environment, or
+// the default policy if none is set.
+func getDeprecationPolicy() (deprecationPolicy, error) {
+	policy := deprecationPolicy(strings.ToLower(os.Getenv(deprecationPolicyEnv)))
+	switch policy {
+	case "":
+		return deprecationPolicyWarn, nil
+	case deprecationPolicyWarn, deprecationPolicyError, deprecationPolicyIgnore:
+		return policy, nil
+	default:
+		return "", errors.Errorf("invalid %s value %q (must be %s, %s or %s)", deprecationPolicyEnv,
+			policy, deprecationPolicyWarn, deprecationPolicyError, deprecationPolicyIgnore)
+	}
+}
+
+// deprecation maps a deprecated dmg command, or a deprecated flag of a
+// command, to its replacement.
+type deprecation struct {
+	// Command is the deprecated command, or the command with the deprecated
+	// flag, e.g. "config generate".
+	Command string
+	// Flag is the long name of the deprecated flag, if any.
+	Flag string
+	// ShortFlag is the short name of the deprecated flag, if any.
+	ShortFlag rune
+	// Replacement is the command and any flags that replace a deprecated
+	// command, or the long name of the flag that replaces a deprecated flag.
+	Replacement string
+	// Since is the release in which the command or flag was deprecated.
+	Since string
+}
+
+// usage returns the deprecated form of the command line.
+func (d *deprecation) usage() string {
+	if d.Flag == "" {
+		return "dmg " + d.Command
+	}
+	return "dmg " + d.Command + " --" + d.Flag
+}
+
+// replacementUsage returns the replacement form of the command line.
+func (d *deprecation) replacementUsage() string {
+	if d.Flag == "" {
+		return "dmg " + d.Replacement
+	}
+	return "dmg " + d.Command + " --" + d.Replacement
+}
+
+func (d *deprecation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Deprecated  string `json:"deprecated"`
+		Replacement string `json:"replacement"`
+		Since       string `json:"since"`
+	}{d.usage(), d.replacementUsage(), d.Since})
+}
+
+// dmgDeprecations lists the deprecated dmg commands and flags that are still
+// accepted and mapped to their replacements.
+var dmgDeprecations = []*deprecation{
+	{
+		Command:     "storage query device-health",
+		Replacement: "storage query list-devices --health",
+		Since:       "2.4",
+	},
+	{
+		Command:     "config generate",
+		Flag:        "access-points",
+		ShortFlag:   'a',
+		Replacement: "ms-replicas",
+		Since:       "2.8",
+	},
+}
+
+// findCommandDeprecation returns the deprecation for the command named by
+// the words, if any.
+func findCommandDeprecation(deps []*deprecation, words []string) *deprecation {
+	cmd := strings.Join(words, " ")
+	for _, dep := range deps {
+		if dep.Flag == "" && dep.Command == cmd {
+			return dep
+		}
+	}
+	return nil
+}
+
+// findFlagDeprecation returns the deprecation for the named flag of the
+// command, if any.
+func findFlagDeprecation(deps []*deprecation, cmdPath []string, name string, long bool) *deprecation {
+	cmd := strings.Join(cmdPath, " ")
+	for _, dep := range deps {
+		if dep.Flag == "" || dep.Command != cmd {
+			continue
+		}
+		if (long && dep.Flag == name) || (!long && dep.ShortFlag != 0 && string(dep.ShortFlag) == name) {
+			return dep
+		}
+	}
+	return nil
+}
+
+// splitFlag splits a flag argument into its name and any inline value. Only
+// the first of a group of short flags is considered.
+func splitFlag(arg string) (name, value string, hasValue, long bool) {
+	if strings.HasPrefix(arg, "--") {
+		name, value, hasValue = strings.Cut(arg[2:], "=")
+		return name, value, hasValue, true
+	}
+
+	name = arg[1:2]
+	if len(arg) > 2 {
+		value, hasValue = arg[2:], true
+	}
+	return name, value, hasValue, false
+}
+
+func optTakesValue(opt *flags.Option) bool {
+	if opt == nil || opt.OptionalArgument {
+		return false
+	}
+	return opt.Field().Type.Kind() != reflect.Bool
+}
+
+// flagConsumesNext returns true if the flag argument requires a value which
+// is given in the next argument.
+func flagConsumesNext(cmd *flags.Command, arg string) bool {
+	if strings.HasPrefix(arg, "--") {
+		name, _, hasValue := strings.Cut(arg[2:], "=")
+		return !hasValue && optTakesValue(cmd.FindOptionByLongName(name))
+	}
+
+	// In a group of short flags, the first flag that takes a value
+	// consumes the rest of the group, or the next argument if it is last.
+	shorts := arg[1:]
+	for i, short := range shorts {
+		if optTakesValue(cmd.FindOptionByShortName(short)) {
+			return i == len(shorts)-1
+		}
+	}
+	return false
+}
+
+// applyDeprecations rewrites any deprecated commands and flags in the
+// arguments to their replacements. It returns the rewritten arguments and the
+// deprecations that were applied.
+func applyDeprecations(root *flags.Command, deps []*deprecation, args []string) ([]string, []*deprecation) {
+	args = append([]string{}, args...)
+	var applied []*deprecation
+
+	// Each deprecation can only be applied once, which also guards against
+	// replacements that are themselves deprecated.
+	for rewritten := true; rewritten && len(applied) <= len(deps); {
+		rewritten = false
+
+		cmd := root
+		var cmdPath, words []string
+		var wordIdxs []int
+	scan:
+		for i := 0; i < len(args); i++ {
+			arg := args[i]
+			switch {
+			case arg == "--":
+				break scan
+			case len(arg) > 1 && strings.HasPrefix(arg, "-"):
+				name, value, hasValue, long := splitFlag(arg)
+				if dep := findFlagDeprecation(deps, cmdPath, name, long); dep != nil {
+					applied = append(applied, dep)
+					args[i] = "--" + dep.Replacement
+					if hasValue {
+						args[i] += "=" + value
+					}
+				}
+				if flagConsumesNext(cmd, args[i]) {
+					i++
+				}
+			default:
+				// Deprecated commands may no longer exist, so all
+				// non-flag arguments are matched against them.
+				if sub := cmd.Find(arg); sub != nil && len(words) == len(cmdPath) {
+					cmd = sub
+					cmdPath = append(cmdPath, sub.Name)
+					arg = sub.Name
+				}
+				words = append(words, arg)
+				wordIdxs = append(wordIdxs, i)
+
+				dep := findCommandDeprecation(deps, words)
+				if dep == nil {
+					continue
+				}
+				applied = append(applied, dep)
+
+				// Replace the deprecated command with the replacement
+				// at the position of the first of its words, keeping
+				// any flags given between them.
+				newArgs := append([]string{}, args[:wordIdxs[0]]...)
+				newArgs = append(newArgs, strings.Fields(dep.Replacement)...)
+				for j := wordIdxs[0] + 1; j < len(args); j++ {
+					if !isWordIdx(wordIdxs, j) {
+						newArgs = append(newArgs, args[j])
+					}
+				}
+				args = newArgs
+				rewritten = true
+				break scan
+			}
+		}
+	}
+
+	return args, applied
+}
+
+func isWordIdx(idxs []int, idx int) bool {
+	for _, i := range idxs {
+		if i == idx {
+			return true
+		}
+	}
+	return false
+}
+
+// handleDeprecations rewrites any deprecated commands and flags in the
+// arguments according to the deprecation policy selected in the environment.
+func handleDeprecations(log logging.Logger, root *flags.Command, args []string) ([]string, error) {
+	policy, err := getDeprecationPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	newArgs, applied := applyDeprecations(root, dmgDeprecations, args)
+	for _, dep := range applied {
+		switch policy {
+		case deprecationPolicyError:
+			return nil, errors.Errorf("%q is deprecated since DAOS %s; use %q instead",
+				dep.usage(), dep.Since, dep.replacementUsage())
+		case deprecationPolicyWarn:
+			log.Noticef("%q is deprecated since DAOS %s and will be removed in a future release; "+
+				"use %q instead (set %s=%s to fail on deprecated usage)", dep.usage(), dep.Since,
+				dep.replacementUsage(), deprecationPolicyEnv, deprecationPolicyError)
+		}
+	}
+
+	return newArgs, nil
+}
+
+// deprecationsCmd is the struct representing the command to list the
+// deprecated commands and flags and their replacements.
+type deprecationsCmd struct {
+	baseCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when deprecationsCmd activates.
+func (cmd *deprecationsCmd) Execute(_ []string) error {
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(dmgDeprecations, nil)
+	}
+
+	depTitle := "Deprecated"
+	replTitle := "Replacement"
+	sinceTitle := "Since"
+
+	var out strings.Builder
+	tablePrint := txtfmt.NewTableFormatter(depTitle, replTitle, sinceTitle)
+	tablePrint.InitWriter(&out)
+	table := []txtfmt.TableRow{}
+	for _, dep := range dmgDeprecations {
+		table = append(table, txtfmt.TableRow{
+			depTitle:   dep.usage(),
+			replTitle:  dep.replacementUsage(),
+			sinceTitle: dep.Since,
+		})
+	}
+	tablePrint.Format(table)
+
+	cmd.Infof("%s", out.String())
+	cmd.Infof("Set %s to %s (default), %s or %s to control how deprecated usage is handled.",
+		deprecationPolicyEnv, deprecationPolicyWarn, deprecationPolicyError, deprecationPolicyIgnore)
+
+	return nil
+}
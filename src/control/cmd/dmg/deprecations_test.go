@@ -0,0 +1,186 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestDmg_applyDeprecations(t *testing.T) {
+	testDeps := []*deprecation{
+		{
+			Command:     "storage old-query",
+			Replacement: "storage query list-devices --health",
+			Since:       "2.4",
+		},
+		{
+			Command:     "pool gone removed",
+			Replacement: "pool list",
+			Since:       "2.6",
+		},
+		{
+			Command:     "config generate",
+			Flag:        "old-replicas",
+			ShortFlag:   'a',
+			Replacement: "ms-replicas",
+			Since:       "2.8",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		args       string
+		expArgs    string
+		expApplied []*deprecation
+	}{
+		"no deprecations": {
+			args:    "storage query list-devices --health",
+			expArgs: "storage query list-devices --health",
+		},
+		"deprecated command": {
+			args:       "-i storage old-query --uuid foo",
+			expArgs:    "-i storage query list-devices --health --uuid foo",
+			expApplied: testDeps[:1],
+		},
+		"deprecated command with alias": {
+			args:       "sto old-query",
+			expArgs:    "storage query list-devices --health",
+			expApplied: testDeps[:1],
+		},
+		"deprecated command after global flag value": {
+			args:       "-o storage storage old-query",
+			expArgs:    "-o storage storage query list-devices --health",
+			expApplied: testDeps[:1],
+		},
+		"removed command with flags between words": {
+			args:       "pool gone -v removed",
+			expArgs:    "pool list -v",
+			expApplied: testDeps[1:2],
+		},
+		"positional argument matches deprecated command": {
+			args:    "pool query old-query",
+			expArgs: "pool query old-query",
+		},
+		"deprecated long flag": {
+			args:       "config generate --old-replicas foo --scm-only",
+			expArgs:    "config generate --ms-replicas foo --scm-only",
+			expApplied: testDeps[2:],
+		},
+		"deprecated long flag with inline value": {
+			args:       "config gen --old-replicas=foo",
+			expArgs:    "config gen --ms-replicas=foo",
+			expApplied: testDeps[2:],
+		},
+		"deprecated short flag": {
+			args:       "config generate -a foo",
+			expArgs:    "config generate --ms-replicas foo",
+			expApplied: testDeps[2:],
+		},
+		"deprecated short flag with inline value": {
+			args:       "config generate -afoo",
+			expArgs:    "config generate --ms-replicas=foo",
+			expApplied: testDeps[2:],
+		},
+		"flag of other command": {
+			args:    "pool create -a foo",
+			expArgs: "pool create -a foo",
+		},
+		"flag value matches deprecated flag": {
+			args:    "config generate -f -a",
+			expArgs: "config generate -f -a",
+		},
+		"arguments after terminator": {
+			args:    "pool query -- old-query",
+			expArgs: "pool query -- old-query",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			p := flags.NewParser(&cliOptions{}, flags.Default)
+
+			gotArgs, gotApplied := applyDeprecations(p.Command, testDeps, strings.Split(tc.args, " "))
+			test.AssertEqual(t, tc.expArgs, strings.Join(gotArgs, " "), "unexpected args")
+			if diff := cmp.Diff(tc.expApplied, gotApplied); diff != "" {
+				t.Fatalf("unexpected deprecations (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_getDeprecationPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		env       string
+		expPolicy deprecationPolicy
+		expErr    error
+	}{
+		"unset": {
+			expPolicy: deprecationPolicyWarn,
+		},
+		"error": {
+			env:       "error",
+			expPolicy: deprecationPolicyError,
+		},
+		"ignore (mixed case)": {
+			env:       "Ignore",
+			expPolicy: deprecationPolicyIgnore,
+		},
+		"invalid": {
+			env:    "strict",
+			expErr: errors.New("invalid DAOS_DMG_DEPRECATIONS"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(deprecationPolicyEnv, tc.env)
+
+			gotPolicy, gotErr := getDeprecationPolicy()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expPolicy, gotPolicy, "unexpected policy")
+		})
+	}
+}
+
+func TestDmg_DeprecatedCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"deprecated device health query",
+			"storage query device-health --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			printRequest(t, &control.SmdQueryReq{
+				Rank:             ranklist.NilRank,
+				OmitPools:        true,
+				IncludeBioHealth: true,
+				UUID:             "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			}),
+			nil,
+		},
+		{
+			"list deprecations",
+			"deprecations",
+			"",
+			nil,
+		},
+	})
+
+	t.Setenv(deprecationPolicyEnv, string(deprecationPolicyError))
+	runCmdTests(t, []cmdTest{
+		{
+			"deprecated device health query; error policy",
+			"storage query device-health",
+			"",
+			errors.New(`"dmg storage query device-health" is deprecated`),
+		},
+	})
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -33,6 +34,7 @@ import (
 
 type faultsCmdRoot struct {
 	Faults faultCmd `command:"faults" description:"Inject system fault"`
+	Debug  debugCmd `command:"debug" description:"Trigger engine fault injection points for test automation"`
 }
 
 type faultCmd struct {
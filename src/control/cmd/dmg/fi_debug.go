@@ -0,0 +1,226 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build fault_injection
+// +build fault_injection
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// Fault injection modes and locations, mirroring the definitions in
+// src/include/daos/common.h.
+const (
+	faultIDMask = 0xffffff
+
+	faultModeOnce   = 0x1000000
+	faultModeSome   = 0x2000000
+	faultModeAlways = 0x4000000
+
+	faultUnitTestGroup = 1 << 16
+
+	faultNone = "none"
+)
+
+type engineFaultInfo struct {
+	loc  uint64
+	desc string
+}
+
+// engineFaults are the named engine fault injection points that may be
+// triggered by dmg debug inject-fault.
+var engineFaults = map[string]engineFaultInfo{
+	faultNone: {
+		desc: "Clear the fault injection point",
+	},
+	"csum-corrupt-update": {
+		loc:  faultUnitTestGroup | 0x20, // DAOS_CSUM_CORRUPT_UPDATE
+		desc: "Corrupt the data of object updates so that checksum verification fails",
+	},
+	"csum-corrupt-fetch": {
+		loc:  faultUnitTestGroup | 0x21, // DAOS_CSUM_CORRUPT_FETCH
+		desc: "Corrupt the data returned by object fetches",
+	},
+	"csum-corrupt-disk": {
+		loc:  faultUnitTestGroup | 0x26, // DAOS_CSUM_CORRUPT_DISK
+		desc: "Corrupt object data on disk so that checksum verification fails on fetch",
+	},
+	"nvme-faulty": {
+		loc:  faultUnitTestGroup | 0x50, // DAOS_NVME_FAULTY
+		desc: "Mark the NVMe device of the target given by --value as faulty",
+	},
+	"nvme-io-error": {
+		loc:  faultUnitTestGroup | 0x9f, // DAOS_OBJ_FAIL_NVME_IO
+		desc: "Fail object fetches with an NVMe I/O error",
+	},
+	"slow-update": {
+		loc:  faultUnitTestGroup | 0x48, // DAOS_DTX_RESEND_DELAY1
+		desc: "Delay object updates long enough for clients to time out and resend",
+	},
+}
+
+func engineFaultNames() []string {
+	names := make([]string, 0, len(engineFaults))
+	for name := range engineFaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// engineFaultFlag is either the name of an engine fault injection point or a
+// numeric fault location.
+type engineFaultFlag struct {
+	name string
+	loc  uint64
+}
+
+func (f engineFaultFlag) Complete(match string) (comps []flags.Completion) {
+	for _, name := range engineFaultNames() {
+		if strings.HasPrefix(name, match) {
+			comps = append(comps, flags.Completion{
+				Item:        name,
+				Description: engineFaults[name].desc,
+			})
+		}
+	}
+	return
+}
+
+func (f *engineFaultFlag) UnmarshalFlag(value string) error {
+	value = strings.TrimSpace(value)
+	if info, found := engineFaults[strings.ToLower(value)]; found {
+		f.name = strings.ToLower(value)
+		f.loc = info.loc
+		return nil
+	}
+
+	loc, err := strconv.ParseUint(value, 0, 64)
+	if err != nil {
+		return errors.Errorf("unknown fault %q (valid faults: %s, or a numeric fault location)",
+			value, strings.Join(engineFaultNames(), ", "))
+	}
+	if loc&^faultIDMask != 0 {
+		return errors.Errorf("invalid fault location %#x (must not exceed %#x)", loc, faultIDMask)
+	}
+	f.name = fmt.Sprintf("%#x", loc)
+	f.loc = loc
+
+	return nil
+}
+
+func (f engineFaultFlag) String() string {
+	return f.name
+}
+
+// faultFrequencyFlag is the mode in which a fault injection point triggers:
+// once, always or a given number of times.
+type faultFrequencyFlag struct {
+	mode  uint64
+	count uint64
+}
+
+func (f *faultFrequencyFlag) UnmarshalFlag(value string) error {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "once":
+		f.mode = faultModeOnce
+	case "always":
+		f.mode = faultModeAlways
+	default:
+		count, err := strconv.ParseUint(value, 10, 16)
+		if err != nil || count == 0 {
+			return errors.Errorf("invalid fault frequency %q (must be once, always or a count)", value)
+		}
+		f.mode = faultModeSome
+		f.count = count
+	}
+
+	return nil
+}
+
+type debugCmd struct {
+	InjectFault injectFaultCmd `command:"inject-fault" description:"Set a fault injection point on DAOS engines"`
+}
+
+// injectFaultCmd sets a fault injection point on one or all engine ranks.
+type injectFaultCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	rankCmd
+
+	Frequency faultFrequencyFlag `short:"f" long:"frequency" description:"How often the fault triggers: once, always or a number of times" default:"once"`
+	Value     uint64             `short:"v" long:"value" description:"Value passed to the fault injection point (e.g. the target index for nvme-faulty)"`
+
+	Args struct {
+		Fault engineFaultFlag `positional-arg-name:"<fault>" description:"Fault to inject, or none to clear" required:"1"`
+	} `positional-args:"yes"`
+}
+
+func (cmd *injectFaultCmd) getRequest() *mgmtpb.FaultInjectEngineReq {
+	req := &mgmtpb.FaultInjectEngineReq{
+		Rank: uint32(cmd.GetRank()),
+	}
+	if cmd.Args.Fault.loc == 0 {
+		return req
+	}
+
+	mode := cmd.Frequency.mode
+	if mode == 0 {
+		mode = faultModeOnce
+	}
+	req.FailLoc = cmd.Args.Fault.loc | mode
+	req.FailValue = cmd.Value
+	req.FailNum = cmd.Frequency.count
+
+	return req
+}
+
+func (cmd *injectFaultCmd) Execute(_ []string) error {
+	req := cmd.getRequest()
+
+	resp, err := control.InvokeFaultRPC(cmd.MustLogCtx(), cmd.ctlInvoker,
+		func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+			cmd.Debugf("injecting engine fault: %+v", req)
+			return mgmtpb.NewMgmtSvcClient(conn).FaultInjectEngine(ctx, req)
+		},
+	)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "inject engine fault")
+	}
+
+	target := "all ranks"
+	if rank := ranklist.Rank(req.Rank); !rank.Equals(ranklist.NilRank) {
+		target = "rank " + rank.String()
+	}
+	if req.FailLoc == 0 {
+		cmd.Infof("Engine fault injection cleared on %s", target)
+		return nil
+	}
+	cmd.Infof("Engine fault %s injected on %s", cmd.Args.Fault, target)
+
+	return nil
+}
@@ -0,0 +1,109 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build fault_injection
+// +build fault_injection
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestDmg_injectFaultCmd_getRequest(t *testing.T) {
+	rank := uint32(3)
+
+	for name, tc := range map[string]struct {
+		fault     string
+		frequency string
+		value     uint64
+		rank      *uint32
+		expErr    error
+		expReq    *mgmtpb.FaultInjectEngineReq
+	}{
+		"unknown fault": {
+			fault:  "bad-fault",
+			expErr: errors.New("unknown fault"),
+		},
+		"location out of range": {
+			fault:  "0x1000020",
+			expErr: errors.New("invalid fault location"),
+		},
+		"bad frequency": {
+			fault:     "csum-corrupt-disk",
+			frequency: "sometimes",
+			expErr:    errors.New("invalid fault frequency"),
+		},
+		"zero frequency": {
+			fault:     "csum-corrupt-disk",
+			frequency: "0",
+			expErr:    errors.New("invalid fault frequency"),
+		},
+		"named fault once on all ranks": {
+			fault:     "csum-corrupt-disk",
+			frequency: "once",
+			expReq: &mgmtpb.FaultInjectEngineReq{
+				Rank:    uint32(ranklist.NilRank),
+				FailLoc: 0x1010026,
+			},
+		},
+		"named fault always on rank": {
+			fault:     "NVME-FAULTY",
+			frequency: "always",
+			value:     2,
+			rank:      &rank,
+			expReq: &mgmtpb.FaultInjectEngineReq{
+				Rank:      3,
+				FailLoc:   0x4010050,
+				FailValue: 2,
+			},
+		},
+		"numeric location some times": {
+			fault:     "0x10019",
+			frequency: "5",
+			expReq: &mgmtpb.FaultInjectEngineReq{
+				Rank:    uint32(ranklist.NilRank),
+				FailLoc: 0x2010019,
+				FailNum: 5,
+			},
+		},
+		"clear": {
+			fault:     "none",
+			frequency: "always",
+			value:     2,
+			rank:      &rank,
+			expReq: &mgmtpb.FaultInjectEngineReq{
+				Rank: 3,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cmd := new(injectFaultCmd)
+			cmd.Rank = tc.rank
+			cmd.Value = tc.value
+
+			err := cmd.Args.Fault.UnmarshalFlag(tc.fault)
+			if err == nil {
+				err = cmd.Frequency.UnmarshalFlag(tc.frequency)
+			}
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReq, cmd.getRequest(), protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected request (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
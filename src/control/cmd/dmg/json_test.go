@@ -62,13 +62,18 @@ func TestDmg_JsonOutput(t *testing.T) {
 	defer cleanup()
 	aclContent := "A::OWNER@:rw\nA::user1@:rw\nA:g:group1@:r\n"
 	aclPath := test.CreateTestFile(t, testDir, aclContent)
+	validACLPath := test.CreateTestFile(t, testDir, "A::OWNER@:rw\nA:G:group1@:r\n")
+	fdContent := "fault_domains:\n  host1: /rack=r0/node=host1\n"
+	fdPath := test.CreateTestFile(t, testDir, fdContent)
+	tmplContent := "templates:\n  small:\n    size: 1TB\n"
+	tmplPath := test.CreateTestFile(t, testDir, tmplContent)
 
 	for _, args := range cmdArgs {
 		t.Run(strings.Join(args, " "), func(t *testing.T) {
 			testArgs := append([]string{"-i", "--json"}, args...)
 			switch strings.Join(args, " ") {
 			case "version", "telemetry config", "telemetry run", "config generate",
-				"manpage", "system set-prop", "support collect-log", "check repair":
+				"manpage", "completion", "system set-prop", "support collect-log", "check repair":
 				return
 			case "storage nvme-rebind":
 				testArgs = append(testArgs, "-l", "foo.com", "-a",
@@ -84,22 +89,45 @@ func TestDmg_JsonOutput(t *testing.T) {
 					test.MockUUID(), "--new-uuid", test.MockUUID())
 			case "storage led identify", "storage led check", "storage led clear":
 				testArgs = append(testArgs, test.MockUUID())
+			case "storage estimate":
+				testArgs = append(testArgs, "--objects", "1000", "--object-size", "1MiB")
 			case "pool create":
 				testArgs = append(testArgs, "-s", "1TB", "label")
-			case "pool destroy", "pool evict", "pool query", "pool get-acl", "pool upgrade":
+			case "pool destroy", "pool evict", "pool query", "pool get-acl", "pool upgrade",
+				"pool rebalance", "pool alias list":
 				testArgs = append(testArgs, test.MockUUID())
 			case "pool overwrite-acl", "pool update-acl":
 				testArgs = append(testArgs, test.MockUUID(), "-a", aclPath)
+			case "pool validate-acl":
+				testArgs = append(testArgs, "-f", validACLPath)
 			case "pool delete-acl":
 				testArgs = append(testArgs, test.MockUUID(), "-p", "foo@")
 			case "pool set-prop":
 				testArgs = append(testArgs, test.MockUUID(), "label:foo")
 			case "pool get-prop":
 				testArgs = append(testArgs, test.MockUUID(), "label")
+			case "pool rename-label":
+				testArgs = append(testArgs, test.MockUUID(), "newlabel")
+			case "pool alias add", "pool alias remove":
+				testArgs = append(testArgs, test.MockUUID(), "alias")
 			case "pool extend", "pool exclude", "pool drain", "pool reintegrate":
 				testArgs = append(testArgs, test.MockUUID(), "--ranks", "0")
+			case "pool template import":
+				testArgs = append(testArgs, tmplPath)
+			case "pool template delete":
+				return // Fails with the mock because the template does not exist
+			case "pool policy set":
+				testArgs = append(testArgs, "--user", "foo", "--max-pools", "1")
+			case "pool policy remove":
+				testArgs = append(testArgs, "--user", "foo")
+			case "storage map":
+				testArgs = append(testArgs, "--pool", test.MockUUID())
 			case "pool query-targets":
 				testArgs = append(testArgs, test.MockUUID(), "--rank", "0", "--target-idx", "1,3,5,7")
+			case "container create", "container list":
+				testArgs = append(testArgs, test.MockUUID())
+			case "container destroy", "container query":
+				testArgs = append(testArgs, test.MockUUID(), test.MockUUID())
 			case "container set-owner":
 				testArgs = append(testArgs, "--user", "foo", test.MockUUID(), test.MockUUID())
 			case "telemetry metrics list", "telemetry metrics query":
@@ -112,6 +140,12 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, "foo:bar")
 			case "system del-attr":
 				testArgs = append(testArgs, "foo")
+			case "system import-fault-domains":
+				testArgs = append(testArgs, "--file", fdPath)
+			case "system replace-host":
+				return // Waits for the replaced ranks to join
+			case "system rolling-restart":
+				return // Waits for the restarted ranks to join
 			case "system exclude", "system clear-exclude", "system drain",
 				"system reintegrate":
 				testArgs = append(testArgs, "--ranks", "0")
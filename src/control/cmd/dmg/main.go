@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,21 +8,25 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path"
+	"strings"
 
 	flags "github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/lib/atm"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/lib/ui"
 	"github.com/daos-stack/daos/src/control/logging"
 )
@@ -52,6 +57,18 @@ type (
 		ctlInvoker control.Invoker
 	}
 
+	// requestTracer is an interface implemented by invokers that can
+	// record the timing of the RPCs they invoke.
+	requestTracer interface {
+		EnableTracing() *control.RequestTracer
+	}
+
+	// spanExporter is an interface implemented by invokers that can
+	// export trace spans for the RPCs they invoke.
+	spanExporter interface {
+		EnableSpanExport(*control.OTLPSpanExporter, string) *control.Span
+	}
+
 	cmdLogger interface {
 		setLog(*logging.LeveledLogger)
 	}
@@ -92,29 +109,34 @@ func (cmd *cfgCmd) setConfig(cfg *control.Config) {
 }
 
 type cliOptions struct {
-	AllowProxy     bool             `long:"allow-proxy" description:"Allow proxy configuration via environment"`
-	HostList       ui.HostSetFlag   `short:"l" long:"host-list" hidden:"true" description:"DEPRECATED: A comma separated list of addresses <ipv4addr/hostname> to connect to"`
-	Insecure       bool             `short:"i" long:"insecure" description:"Have dmg attempt to connect without certificates"`
-	Debug          bool             `short:"d" long:"debug" description:"Enable debug output"`
-	LogFile        string           `long:"log-file" description:"Log command output to the specified file"`
-	JSON           bool             `short:"j" long:"json" description:"Enable JSON output"`
-	JSONLogs       bool             `short:"J" long:"json-logging" description:"Enable JSON-formatted log output"`
-	ConfigPath     string           `short:"o" long:"config-path" description:"Client config file path"`
-	Server         serverCmd        `command:"server" alias:"srv" description:"Perform tasks related to remote servers"`
-	Storage        storageCmd       `command:"storage" alias:"sto" description:"Perform tasks related to storage attached to remote servers"`
-	Config         configCmd        `command:"config" alias:"cfg" description:"Perform tasks related to configuration of hardware on remote servers"`
-	System         SystemCmd        `command:"system" alias:"sys" description:"Perform distributed tasks related to DAOS system"`
-	Network        NetCmd           `command:"network" alias:"net" description:"Perform tasks related to network devices attached to remote servers"`
-	Support        supportCmd       `command:"support" alias:"supp" description:"Perform debug tasks to help support team"`
-	Pool           PoolCmd          `command:"pool" description:"Perform tasks related to DAOS pools"`
-	Cont           ContCmd          `command:"container" alias:"cont" description:"Perform tasks related to DAOS containers"`
-	Version        versionCmd       `command:"version" description:"Print dmg version"`
-	ServerVersion  serverVersionCmd `command:"server-version" description:"Print server version"`
-	Telemetry      telemCmd         `command:"telemetry" alias:"telem" description:"Perform telemetry operations"`
-	Check          checkCmdRoot     `command:"check" description:"Check system health"`
-	ManPage        cmdutil.ManCmd   `command:"manpage" hidden:"true"`
-	faultsCmdRoot                   // compiled out for release builds
-	firmwareOption                  // build with tag "firmware" to enable
+	AllowProxy     bool                  `long:"allow-proxy" description:"Allow proxy configuration via environment"`
+	HostList       ui.HostSetFlag        `short:"l" long:"host-list" hidden:"true" description:"DEPRECATED: A comma separated list of addresses <ipv4addr/hostname> to connect to"`
+	Insecure       bool                  `short:"i" long:"insecure" description:"Have dmg attempt to connect without certificates"`
+	Debug          bool                  `short:"d" long:"debug" description:"Enable debug output"`
+	LogFile        string                `long:"log-file" description:"Log command output to the specified file"`
+	LogModules     string                `long:"log-modules" description:"Comma-separated list of module=level pairs setting log levels for individual modules, e.g. dmg.pool=debug"`
+	JSON           bool                  `short:"j" long:"json" description:"Enable JSON output"`
+	JSONLogs       bool                  `short:"J" long:"json-logging" description:"Enable JSON-formatted log output"`
+	Trace          bool                  `long:"trace" description:"Print a timing breakdown of the command's RPCs to stderr"`
+	ConfigPath     string                `short:"o" long:"config-path" description:"Client config file path"`
+	Server         serverCmd             `command:"server" alias:"srv" description:"Perform tasks related to remote servers"`
+	Storage        storageCmd            `command:"storage" alias:"sto" description:"Perform tasks related to storage attached to remote servers"`
+	Config         configCmd             `command:"config" alias:"cfg" description:"Perform tasks related to configuration of hardware on remote servers"`
+	System         SystemCmd             `command:"system" alias:"sys" description:"Perform distributed tasks related to DAOS system"`
+	Network        NetCmd                `command:"network" alias:"net" description:"Perform tasks related to network devices attached to remote servers"`
+	Support        supportCmd            `command:"support" alias:"supp" description:"Perform debug tasks to help support team"`
+	Pool           PoolCmd               `command:"pool" description:"Perform tasks related to DAOS pools"`
+	Cont           ContCmd               `command:"container" alias:"cont" description:"Perform tasks related to DAOS containers"`
+	Version        versionCmd            `command:"version" description:"Print dmg version"`
+	ServerVersion  serverVersionCmd      `command:"server-version" description:"Print server version"`
+	Telemetry      telemCmd              `command:"telemetry" alias:"telem" description:"Perform telemetry operations"`
+	Check          checkCmdRoot          `command:"check" description:"Check system health"`
+	Ops            opsCmd                `command:"ops" description:"Perform tasks related to operations in progress on the Management Service"`
+	Deprecations   deprecationsCmd       `command:"deprecations" description:"List deprecated commands and flags and their replacements"`
+	Completion     cmdutil.CompletionCmd `command:"completion" description:"Generate a shell completion script for dmg"`
+	ManPage        cmdutil.ManCmd        `command:"manpage" hidden:"true"`
+	faultsCmdRoot                        // compiled out for release builds
+	firmwareOption                       // build with tag "firmware" to enable
 }
 
 type versionCmd struct {
@@ -177,6 +199,51 @@ func exitWithError(log logging.Logger, err error) {
 	os.Exit(1)
 }
 
+// moduleLogger returns a logger for the module named after the active top-level
+// command, e.g. dmg.pool.
+func moduleLogger(log *logging.LeveledLogger, active *flags.Command) logging.Logger {
+	if active == nil {
+		return log
+	}
+
+	return logging.ForModule(log, "dmg"+logging.ModuleSeparator+active.Name)
+}
+
+// commandName returns the full name of the active command, e.g. "dmg pool create".
+func commandName(p *flags.Parser) string {
+	names := []string{p.Name}
+	for cmd := p.Command.Active; cmd != nil; cmd = cmd.Active {
+		names = append(names, cmd.Name)
+	}
+	return strings.Join(names, " ")
+}
+
+// startSpanExport starts exporting trace spans for the RPCs invoked by the
+// command to the configured collector, and returns the root span of the
+// trace. The returned function stops the exporter once any remaining spans
+// have been sent.
+func startSpanExport(log logging.Logger, se spanExporter, cfg *control.Config, cmdName string) (*control.Span, func()) {
+	hostname, _ := os.Hostname()
+	exporter := control.NewOTLPSpanExporter(log, control.OTLPExporterConfig{
+		Endpoint:   cfg.TelemetryOTLPTraces.Endpoint,
+		Headers:    cfg.TelemetryOTLPTraces.Headers,
+		Component:  build.ComponentAdmin,
+		SystemName: cfg.SystemName,
+		Hostname:   hostname,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	exporter.Start(ctx)
+
+	root := se.EnableSpanExport(exporter, cmdName)
+	log.Debugf("exporting trace %s to %s", root.TraceID(), cfg.TelemetryOTLPTraces.Endpoint)
+
+	return root, func() {
+		cancel()
+		exporter.Wait()
+	}
+}
+
 func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *logging.LeveledLogger) error {
 	var wroteJSON atm.Bool
 	p := flags.NewParser(opts, flags.Default)
@@ -238,12 +305,22 @@ and access control settings, along with system wide operations.`
 			log.ClearLevel(logging.LogLevelInfo)
 		}
 
+		if opts.LogModules != "" {
+			levels, err := logging.ParseModuleLevels(opts.LogModules)
+			if err != nil {
+				return errors.Wrap(err, "--log-modules")
+			}
+			if err := log.SetModuleLevels(levels); err != nil {
+				return err
+			}
+		}
+
 		if logCmd, ok := cmd.(cmdutil.LogSetter); ok {
-			logCmd.SetLog(log)
+			logCmd.SetLog(moduleLogger(log, p.Active))
 		}
 
 		switch cmd.(type) {
-		case *versionCmd:
+		case *versionCmd, *deprecationsCmd, *cmdutil.CompletionCmd:
 			// this command don't need the rest of the setup
 			return cmd.Execute(args)
 		}
@@ -268,6 +345,28 @@ and access control settings, along with system wide operations.`
 		}
 
 		invoker.SetConfig(ctlCfg)
+		if opts.Trace {
+			rt, ok := invoker.(requestTracer)
+			if !ok {
+				return errors.New("--trace is not supported by this client")
+			}
+			tracer := rt.EnableTracing()
+			defer func() {
+				if err := pretty.PrintRequestTrace(tracer.Finish(), os.Stderr); err != nil {
+					log.Errorf("failed to print request trace: %s", err)
+				}
+			}()
+		}
+		var rootSpan *control.Span
+		if ctlCfg.TelemetryOTLPTraces.Enabled() {
+			se, ok := invoker.(spanExporter)
+			if !ok {
+				return errors.New("trace export is not supported by this client")
+			}
+			var stopExport func()
+			rootSpan, stopExport = startSpanExport(log, se, ctlCfg, commandName(p))
+			defer stopExport()
+		}
 		if ctlCmd, ok := cmd.(ctlInvoker); ok {
 			ctlCmd.setInvoker(invoker)
 		}
@@ -301,14 +400,25 @@ and access control settings, along with system wide operations.`
 			}
 		}
 
-		if err := cmd.Execute(args); err != nil {
-			return err
-		}
+		err = cmd.Execute(args)
+		rootSpan.End(err)
+
+		return err
+	}
 
-		return nil
+	args, err := handleDeprecations(log, p.Command, args)
+	if err != nil {
+		return err
 	}
 
-	_, err := p.ParseArgs(args)
+	rest, err := p.ParseArgs(args)
+	if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrUnknownCommand && p.Active == nil && len(rest) > 0 {
+		// Unknown top-level commands are run as plugins if an
+		// executable implementing them is found in PATH.
+		if plugin, lookErr := findPlugin(rest[0]); lookErr == nil {
+			return runPlugin(log, opts, plugin, rest[1:], os.Stdout, os.Stderr)
+		}
+	}
 	if opts.JSON && wroteJSON.IsFalse() {
 		return cmdutil.OutputJSON(os.Stdout, nil, err)
 	}
@@ -324,11 +434,17 @@ func main() {
 		control.WithClientComponent(build.ComponentAdmin),
 	)
 
+	txtfmt.ConfigureForTerminal(os.Stdout)
+
 	if err := parseOpts(os.Args[1:], &opts, ctlInvoker, log); err != nil {
 		if fe, ok := errors.Cause(err).(*flags.Error); ok && fe.Type == flags.ErrHelp {
 			log.Info(fe.Error())
 			os.Exit(0)
 		}
+		if pe, ok := errors.Cause(err).(*pluginExitError); ok {
+			// The plugin has already reported its own errors.
+			os.Exit(pe.code)
+		}
 		exitWithError(log, err)
 	}
 }
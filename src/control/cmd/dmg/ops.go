@@ -0,0 +1,48 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// opsCmd is the struct representing the top-level ops subcommand.
+type opsCmd struct {
+	Locks opsLocksCmd `command:"locks" description:"List the operations holding locks on the Management Service"`
+}
+
+// opsLocksCmd is the struct representing the command to list the operation
+// locks held by the MS leader.
+type opsLocksCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when opsLocksCmd subcommand is activated.
+func (cmd *opsLocksCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "ops locks failed")
+	}()
+
+	resp, err := control.SystemOpLocks(cmd.MustLogCtx(), cmd.ctlInvoker, new(control.SystemOpLocksReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintOpLocksResponse(&out, resp)
+	cmd.Info(out.String())
+
+	return nil
+}
@@ -0,0 +1,31 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestDmg_OpsCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"List operation locks",
+			"ops locks",
+			printRequest(t, &control.SystemOpLocksReq{}),
+			nil,
+		},
+		{
+			"Unknown ops subcommand",
+			"ops foo",
+			"",
+			errors.New("Unknown command"),
+		},
+	})
+}
@@ -0,0 +1,110 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// pluginPrefix is prepended to an unknown dmg command name to find the
+// executable in PATH that implements it, e.g. "dmg foo" runs "dmg-foo".
+const pluginPrefix = "dmg-"
+
+// Environment variables used to pass the dmg context to plugins.
+const (
+	pluginEnvExe      = "DAOS_DMG_EXE"
+	pluginEnvConfig   = "DAOS_DMG_CONFIG"
+	pluginEnvSystem   = "DAOS_DMG_SYSTEM"
+	pluginEnvHostList = "DAOS_DMG_HOSTLIST"
+	pluginEnvInsecure = "DAOS_DMG_INSECURE"
+	pluginEnvJSON     = "DAOS_DMG_JSON"
+	pluginEnvDebug    = "DAOS_DMG_DEBUG"
+)
+
+// pluginExitError is returned when a plugin exits with a non-zero status, so
+// that dmg can exit with the same status.
+type pluginExitError struct {
+	name string
+	code int
+}
+
+func (e *pluginExitError) Error() string {
+	return fmt.Sprintf("plugin %s exited with status %d", e.name, e.code)
+}
+
+// findPlugin returns the path of the executable in PATH that implements the
+// named command.
+func findPlugin(name string) (string, error) {
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, `/\`) {
+		return "", errors.Errorf("invalid plugin name %q", name)
+	}
+
+	return exec.LookPath(pluginPrefix + name)
+}
+
+// pluginEnv returns the variables describing the dmg context to be added to
+// the environment of a plugin.
+func pluginEnv(opts *cliOptions, cfg *control.Config) []string {
+	hostList := cfg.HostList
+	if !opts.HostList.Empty() {
+		hostList = opts.HostList.Slice()
+	}
+	exe, _ := os.Executable()
+
+	return []string{
+		pluginEnvExe + "=" + exe,
+		pluginEnvConfig + "=" + cfg.Path,
+		pluginEnvSystem + "=" + cfg.SystemName,
+		pluginEnvHostList + "=" + strings.Join(hostList, ","),
+		pluginEnvInsecure + "=" + strconv.FormatBool(opts.Insecure || cfg.TransportConfig.AllowInsecure),
+		pluginEnvJSON + "=" + strconv.FormatBool(opts.JSON),
+		pluginEnvDebug + "=" + strconv.FormatBool(opts.Debug),
+	}
+}
+
+// runPlugin runs the plugin executable with the remaining command line
+// arguments, passing through the standard streams and the dmg context.
+func runPlugin(log logging.Logger, opts *cliOptions, path string, args []string, stdout, stderr io.Writer) error {
+	cfg, err := control.LoadConfig(opts.ConfigPath)
+	if err != nil {
+		if errors.Cause(err) != control.ErrNoConfigFile {
+			return errors.Wrap(err, "failed to load control configuration")
+		}
+		cfg = control.DefaultConfig()
+	}
+
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.Env = append(os.Environ(), pluginEnv(opts, cfg)...)
+
+	log.Debugf("running plugin %s %s", path, strings.Join(args, " "))
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return &pluginExitError{
+				name: filepath.Base(path),
+				code: exitErr.ExitCode(),
+			}
+		}
+		return errors.Wrapf(err, "failed to run plugin %s", path)
+	}
+
+	return nil
+}
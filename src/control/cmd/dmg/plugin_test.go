@@ -0,0 +1,154 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func createTestPlugin(t *testing.T, dir, name, script string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, pluginPrefix+name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestDmg_findPlugin(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pluginPath := createTestPlugin(t, dir, "hello", "exit 0\n")
+	if err := os.WriteFile(filepath.Join(dir, pluginPrefix+"noexec"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+
+	for name, tc := range map[string]struct {
+		name    string
+		expPath string
+		expErr  error
+	}{
+		"empty name": {
+			expErr: errors.New("invalid plugin name"),
+		},
+		"path in name": {
+			name:   "../hello",
+			expErr: errors.New("invalid plugin name"),
+		},
+		"option as name": {
+			name:   "-hello",
+			expErr: errors.New("invalid plugin name"),
+		},
+		"not found": {
+			name:   "goodbye",
+			expErr: errors.New("not found"),
+		},
+		"not executable": {
+			name:   "noexec",
+			expErr: errors.New("not found"),
+		},
+		"found": {
+			name:    "hello",
+			expPath: pluginPath,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotPath, gotErr := findPlugin(tc.name)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expPath, gotPath, "unexpected plugin path")
+		})
+	}
+}
+
+func TestDmg_parseOpts_Plugin(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	outPath := filepath.Join(dir, "out")
+	createTestPlugin(t, dir, "hello", `
+echo "$@" > `+outPath+`
+echo "$DAOS_DMG_CONFIG $DAOS_DMG_SYSTEM $DAOS_DMG_HOSTLIST" >> `+outPath+`
+echo "$DAOS_DMG_INSECURE $DAOS_DMG_JSON $DAOS_DMG_DEBUG" >> `+outPath+`
+`)
+	createTestPlugin(t, dir, "fail", "exit 3\n")
+	t.Setenv("PATH", dir)
+
+	cfgPath := test.CreateTestFile(t, dir, `
+name: shire
+hostlist: ['h1:10001', 'h2:10001']
+transport_config:
+  allow_insecure: true
+`)
+
+	for name, tc := range map[string]struct {
+		args   []string
+		expOut []string
+		expErr error
+	}{
+		"unknown command without plugin": {
+			args:   []string{"goodbye"},
+			expErr: errors.New("Unknown command `goodbye'"),
+		},
+		"unknown subcommand is not a plugin": {
+			args:   []string{"pool", "hello"},
+			expErr: errors.New("Unknown command `hello'"),
+		},
+		"plugin with dmg context": {
+			args: []string{"-j", "-o", cfgPath, "hello", "world", "--flag"},
+			expOut: []string{
+				"world --flag",
+				cfgPath + " shire h1:10001,h2:10001",
+				"true true false",
+			},
+		},
+		"plugin exit status": {
+			args:   []string{"fail"},
+			expErr: &pluginExitError{name: pluginPrefix + "fail", code: 3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			os.Remove(outPath)
+
+			var opts cliOptions
+			gotErr := parseOpts(tc.args, &opts, nil, log)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if pe, ok := tc.expErr.(*pluginExitError); ok {
+				test.AssertEqual(t, pe.code, gotErr.(*pluginExitError).code, "unexpected exit status")
+			}
+			if tc.expOut == nil {
+				return
+			}
+
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotOut := strings.Split(strings.TrimSpace(string(out)), "\n")
+			if diff := cmp.Diff(tc.expOut, gotOut); diff != "" {
+				t.Fatalf("unexpected plugin output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
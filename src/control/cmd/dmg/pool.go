@@ -9,14 +9,22 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"os"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/dustin/go-humanize/english"
+	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
@@ -46,9 +54,15 @@ type PoolCmd struct {
 	OverwriteACL poolOverwriteACLCmd `command:"overwrite-acl" description:"Overwrite a DAOS pool's Access Control List"`
 	UpdateACL    poolUpdateACLCmd    `command:"update-acl" description:"Update entries in a DAOS pool's Access Control List"`
 	DeleteACL    poolDeleteACLCmd    `command:"delete-acl" description:"Delete an entry from a DAOS pool's Access Control List"`
+	ValidateACL  poolValidateACLCmd  `command:"validate-acl" description:"Check an Access Control List file without applying it to a pool"`
 	SetProp      poolSetPropCmd      `command:"set-prop" description:"Set pool property"`
+	RenameLabel  poolRenameLabelCmd  `command:"rename-label" alias:"rename" description:"Change the label of a DAOS pool"`
+	Alias        poolAliasCmd        `command:"alias" description:"Manage the alias labels of a DAOS pool"`
 	GetProp      poolGetPropCmd      `command:"get-prop" description:"Get pool properties"`
 	Upgrade      poolUpgradeCmd      `command:"upgrade" description:"Upgrade pool to latest format"`
+	Rebalance    poolRebalanceCmd    `command:"rebalance" description:"Redistribute pool data across all pool targets"`
+	Template     poolTemplateCmd     `command:"template" description:"Manage pool templates stored on the Management Service"`
+	Policy       poolPolicyCmd       `command:"policy" description:"Manage pool creation policies stored on the Management Service"`
 }
 
 var (
@@ -56,6 +70,7 @@ var (
 	defaultTierRatios         = []float64{0.06, 0.94}
 	errPoolCreateIncompatOpts = errors.New("unsupported option combination, use (--scm-size and " +
 		"--nvme-size) or (--meta-size and --data-size) or (--size)")
+	errPoolCreateDenied = errors.New("pool create denied by policy")
 )
 
 type tierRatioFlag struct {
@@ -126,7 +141,7 @@ func (trf *tierRatioFlag) UnmarshalFlag(fv string) error {
 
 type poolSizeFlag struct {
 	ui.ByteSizeFlag
-	availRatio uint64
+	availRatio float64 // percentage of the available capacity
 }
 
 func (psf poolSizeFlag) IsRatio() bool {
@@ -139,7 +154,7 @@ func (psf poolSizeFlag) IsSet() bool {
 
 func (psf poolSizeFlag) String() string {
 	if psf.IsRatio() {
-		return fmt.Sprintf("%d%%", psf.availRatio)
+		return strconv.FormatFloat(psf.availRatio, 'f', -1, 64) + "%"
 	}
 
 	return psf.ByteSizeFlag.String()
@@ -149,11 +164,11 @@ func (psf *poolSizeFlag) UnmarshalFlag(fv string) error {
 	trimmed := strings.TrimSpace(fv)
 	if strings.HasSuffix(trimmed, "%") {
 		ratioStr := strings.TrimSpace(strings.TrimSuffix(trimmed, "%"))
-		ratio, err := strconv.ParseUint(ratioStr, 10, 64)
+		ratio, err := strconv.ParseFloat(ratioStr, 64)
 		if err != nil {
 			return errors.Wrapf(err, "invalid pool size ratio %q", fv)
 		}
-		if ratio <= 0 || ratio > 100 {
+		if !(ratio > 0 && ratio <= 100) {
 			return errors.Errorf("Creating DAOS pool with invalid full size ratio %s:"+
 				" allowed range 0 < ratio <= 100", fv)
 		}
@@ -170,20 +185,25 @@ type poolCreateCmd struct {
 	cfgCmd
 	ctlInvokerCmd
 	cmdutil.JSONOutputCmd
-	GroupName  ui.ACLPrincipalFlag `short:"g" long:"group" description:"DAOS pool to be owned by given group, format name@domain"`
-	UserName   ui.ACLPrincipalFlag `short:"u" long:"user" description:"DAOS pool to be owned by given user, format name@domain"`
-	Properties PoolSetPropsFlag    `short:"P" long:"properties" description:"Pool properties to be set"`
-	ACLFile    string              `short:"a" long:"acl-file" description:"Access Control List file path for DAOS pool"`
-	Size       poolSizeFlag        `short:"z" long:"size" description:"Total size of DAOS pool or its percentage ratio (auto)"`
-	TierRatio  tierRatioFlag       `short:"t" long:"tier-ratio" description:"Percentage of storage tiers for pool storage (auto; default: 6,94)"`
-	NumRanks   uint32              `short:"k" long:"nranks" description:"Number of ranks to use (auto)"`
-	NumSvcReps uint32              `short:"v" long:"nsvc" description:"Number of pool service replicas"`
-	ScmSize    ui.ByteSizeFlag     `short:"s" long:"scm-size" description:"Per-engine SCM allocation for DAOS pool (manual)"`
-	NVMeSize   ui.ByteSizeFlag     `short:"n" long:"nvme-size" description:"Per-engine NVMe allocation for DAOS pool (manual)"`
-	MetaSize   ui.ByteSizeFlag     `long:"meta-size" description:"Per-engine Metadata-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
-	DataSize   ui.ByteSizeFlag     `long:"data-size" description:"Per-engine Data-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
-	MemRatio   tierRatioFlag       `long:"mem-ratio" description:"Percentage of the pool metadata storage size (on SSD) that should be used as the memory file size (on ram-disk). Default value is 100% and only valid in MD-on-SSD mode"`
-	RankList   ui.RankSetFlag      `short:"r" long:"ranks" description:"Storage engine unique identifiers (ranks) for DAOS pool"`
+	GroupName    ui.ACLPrincipalFlag `short:"g" long:"group" description:"DAOS pool to be owned by given group, format name@domain"`
+	UserName     ui.ACLPrincipalFlag `short:"u" long:"user" description:"DAOS pool to be owned by given user, format name@domain"`
+	Properties   PoolSetPropsFlag    `short:"P" long:"properties" description:"Pool properties to be set"`
+	ACLFile      string              `short:"a" long:"acl-file" description:"Access Control List file path for DAOS pool"`
+	Size         poolSizeFlag        `short:"z" long:"size" description:"Total size of DAOS pool or its percentage ratio (auto)"`
+	MinSize      ui.ByteSizeFlag     `long:"min-size" description:"Minimum total data capacity of DAOS pool when --size is a percentage ratio; leave out ranks with little free space or fail if the available storage is insufficient"`
+	TierRatio    tierRatioFlag       `short:"t" long:"tier-ratio" description:"Percentage of storage tiers for pool storage (auto; default: 6,94)"`
+	NumRanks     uint32              `short:"k" long:"nranks" description:"Number of ranks to use (auto)"`
+	NumSvcReps   uint32              `short:"v" long:"nsvc" description:"Number of pool service replicas"`
+	ScmSize      ui.ByteSizeFlag     `short:"s" long:"scm-size" description:"Per-engine SCM allocation for DAOS pool (manual)"`
+	NVMeSize     ui.ByteSizeFlag     `short:"n" long:"nvme-size" description:"Per-engine NVMe allocation for DAOS pool (manual)"`
+	MetaSize     ui.ByteSizeFlag     `long:"meta-size" description:"Per-engine Metadata-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
+	DataSize     ui.ByteSizeFlag     `long:"data-size" description:"Per-engine Data-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
+	MemRatio     tierRatioFlag       `long:"mem-ratio" description:"Percentage of the pool metadata storage size (on SSD) that should be used as the memory file size (on ram-disk). Default value is 100% and only valid in MD-on-SSD mode"`
+	RankList     ui.RankSetFlag      `short:"r" long:"ranks" description:"Storage engine unique identifiers (ranks) for DAOS pool"`
+	Placement    string              `long:"placement" choice:"spread" choice:"pack" description:"Select ranks to spread the pool across fault domains or to pack it onto as few as possible"`
+	ExcludeDoms  string              `long:"exclude-domains" description:"Don't select ranks with fault domains matching this regular expression"`
+	Template     string              `long:"template" description:"Name of a pool template providing values for options that are not set"`
+	TemplateFile string              `long:"template-file" description:"YAML file defining pool templates (default: use templates stored on the Management Service)"`
 
 	Args struct {
 		PoolLabel string `positional-arg-name:"<pool label>" required:"1"`
@@ -233,8 +253,9 @@ func (cmd *poolCreateCmd) storageAutoPercentage(ctx context.Context, req *contro
 	}
 	cmd.Infof("Creating DAOS pool with %s of all storage", cmd.Size)
 
-	availFrac := float64(cmd.Size.availRatio) / 100.0
+	availFrac := cmd.Size.availRatio / 100.0
 	req.TierRatio = []float64{availFrac, availFrac}
+	req.MinTotalBytes = cmd.MinSize.Bytes
 
 	// Pass --mem-ratio or zero if unset.
 	if err := cmd.setMemRatio(req, 0.0); err != nil {
@@ -320,6 +341,23 @@ func (cmd *poolCreateCmd) storageManual(req *control.PoolCreateReq) error {
 
 // Execute is run when PoolCreateCmd subcommand is activated
 func (cmd *poolCreateCmd) Execute(args []string) error {
+	ctx := cmd.MustLogCtx()
+
+	var tmpl *control.PoolTemplate
+	switch {
+	case cmd.Template != "":
+		var err error
+		if tmpl, err = cmd.loadTemplate(ctx); err != nil {
+			return err
+		}
+		if err := cmd.applyTemplate(tmpl); err != nil {
+			return err
+		}
+		cmd.Debugf("applied pool template %q: %+v", cmd.Template, tmpl)
+	case cmd.TemplateFile != "":
+		return errors.New("--template-file requires --template")
+	}
+
 	if cmd.Args.PoolLabel != "" {
 		for _, prop := range cmd.Properties.ToSet {
 			if prop.Name == "label" {
@@ -331,7 +369,6 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 		}
 	}
 
-	ctx := cmd.MustLogCtx()
 	req := &control.PoolCreateReq{
 		User:       cmd.UserName.String(),
 		UserGroup:  cmd.GroupName.String(),
@@ -340,12 +377,25 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 		Ranks:      cmd.RankList.Ranks(),
 	}
 
+	if cmd.Placement != "" || cmd.ExcludeDoms != "" {
+		if !cmd.RankList.Empty() {
+			return errors.New("--placement and --exclude-domains may not be used with --ranks")
+		}
+		if _, err := regexp.Compile(cmd.ExcludeDoms); err != nil {
+			return errors.Wrap(err, "invalid --exclude-domains pattern")
+		}
+		req.PlacementPolicy = cmd.Placement
+		req.ExcludeDomains = cmd.ExcludeDoms
+	}
+
 	if cmd.ACLFile != "" {
 		var err error
 		req.ACL, err = control.ReadACLFile(cmd.ACLFile)
 		if err != nil {
 			return err
 		}
+	} else {
+		req.ACL = tmpl.GetACL()
 	}
 
 	// Refuse unsupported input value combinations.
@@ -360,6 +410,8 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 		return errPoolCreateIncompatOpts
 	case !pmemParams && !mdParams && !cmd.Size.IsSet():
 		return errPoolCreateIncompatOpts
+	case cmd.MinSize.IsSet() && !cmd.Size.IsRatio():
+		return errors.New("--min-size requires --size to be a percentage ratio")
 	}
 
 	// Validate supported input values and set request fields.
@@ -384,17 +436,24 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 		}
 	}
 
+	bar := terminalProgressBar(cmd.JSONOutputEnabled(), false)
+	req.SetProgressFunc(bar.updateFunc())
 	resp, err := control.PoolCreate(ctx, cmd.ctlInvoker, req)
+	bar.done()
 
 	if cmd.JSONOutputEnabled() {
 		return cmd.OutputJSON(resp, err)
 	}
 
+	var bld strings.Builder
 	if err != nil {
+		if pretty.PrintPoolCreateDenied(&bld, err) {
+			cmd.Error(bld.String())
+			return errPoolCreateDenied
+		}
 		return err
 	}
 
-	var bld strings.Builder
 	if err := pretty.PrintPoolCreateResponse(resp, &bld); err != nil {
 		return err
 	}
@@ -467,6 +526,61 @@ type PoolID struct {
 	ui.LabelOrUUIDFlag
 }
 
+// poolCompletionTimeout bounds the time spent asking the MS for pool labels
+// during shell completion, so that an unreachable system doesn't stall the
+// shell.
+const poolCompletionTimeout = 3 * time.Second
+
+// listPoolLabels returns the labels and aliases of the pools in the system,
+// contacting the MS with the default control configuration.
+var listPoolLabels = func(ctx context.Context) ([]string, error) {
+	ctlCfg, err := control.LoadConfig("")
+	if err != nil {
+		if errors.Cause(err) != control.ErrNoConfigFile {
+			return nil, err
+		}
+		ctlCfg = control.DefaultConfig()
+	}
+	if err := ctlCfg.TransportConfig.PreLoadCertData(); err != nil {
+		return nil, err
+	}
+
+	resp, err := control.ListPools(ctx, control.NewClient(control.WithConfig(ctlCfg)),
+		&control.ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]string, 0, len(resp.Pools))
+	for _, pool := range resp.Pools {
+		if pool.Label != "" {
+			labels = append(labels, pool.Label)
+		}
+		labels = append(labels, pool.Aliases...)
+	}
+	return labels, nil
+}
+
+// Complete returns the labels of the pools in the system that start with the
+// match. Nothing is returned if the MS can't be reached.
+func (p *PoolID) Complete(match string) (comps []flags.Completion) {
+	ctx, cancel := context.WithTimeout(context.Background(), poolCompletionTimeout)
+	defer cancel()
+
+	labels, err := listPoolLabels(ctx)
+	if err != nil {
+		return nil
+	}
+	sort.Strings(labels)
+
+	for _, label := range labels {
+		if strings.HasPrefix(label, match) {
+			comps = append(comps, flags.Completion{Item: label})
+		}
+	}
+	return
+}
+
 // poolCmd is the base struct for all pool commands that work with existing pools.
 type poolCmd struct {
 	baseCmd
@@ -486,12 +600,112 @@ func (cmd *poolCmd) PoolID() *PoolID {
 // poolDestroyCmd is the struct representing the command to destroy a DAOS pool.
 type poolDestroyCmd struct {
 	poolCmd
-	Recursive bool `short:"r" long:"recursive" description:"Remove pool with existing containers"`
-	Force     bool `short:"f" long:"force" description:"Forcibly remove pool with active client connections"`
+	cfgCmd
+	Recursive       bool   `short:"r" long:"recursive" description:"Remove pool with existing containers"`
+	Force           bool   `short:"f" long:"force" description:"Forcibly remove pool with active client connections"`
+	ForceLabelMatch string `long:"force-label-match" description:"Only destroy the pool if its label matches the supplied value, skipping any confirmation prompt"`
+
+	confirmIn  io.Reader
+	confirmOut io.Writer
+}
+
+// destroyTarget returns the label (or UUID if the pool is unlabeled) and total
+// size of the pool to be destroyed. If the pool can't be queried, the size is
+// returned as zero and the label is retrieved from the pool list instead.
+func (cmd *poolDestroyCmd) destroyTarget(ctx context.Context) (string, uint64, error) {
+	id := cmd.PoolID().String()
+	labelOrUUID := func(pi *daos.PoolInfo) string {
+		if pi.Label != "" {
+			return pi.Label
+		}
+		return pi.UUID.String()
+	}
+
+	qResp, err := control.PoolQuery(ctx, cmd.ctlInvoker, &control.PoolQueryReq{ID: id})
+	if err == nil {
+		var size uint64
+		for _, tier := range qResp.TierStats {
+			size += tier.Total
+		}
+		return labelOrUUID(&qResp.PoolInfo), size, nil
+	}
+	cmd.Debugf("pool query for destroy of %s failed: %s", id, err)
+
+	lResp, err := control.ListPools(ctx, cmd.ctlInvoker, &control.ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return "", 0, err
+	}
+	for _, p := range lResp.Pools {
+		if p.UUID.String() == id || p.HasLabel(id) {
+			return labelOrUUID(p), 0, nil
+		}
+	}
+
+	return "", 0, errors.Errorf("pool %s not found", id)
+}
+
+// checkDestroyInterlocks verifies that the pool to be destroyed is the one the
+// user intended to destroy. If --force-label-match is supplied, the pool label
+// must match it. Otherwise, if the pool is at least as large as the configured
+// confirmation threshold (or its size can't be determined), the user must type
+// in the pool label to confirm.
+func (cmd *poolDestroyCmd) checkDestroyInterlocks(ctx context.Context) error {
+	threshold, err := cmd.config.PoolDestroyConfirmBytes()
+	if err != nil {
+		return err
+	}
+	if cmd.ForceLabelMatch == "" && threshold == 0 {
+		return nil
+	}
+
+	name, size, err := cmd.destroyTarget(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to verify pool to be destroyed")
+	}
+
+	if cmd.ForceLabelMatch != "" {
+		if cmd.ForceLabelMatch != name {
+			return errors.Errorf("pool label %q does not match --force-label-match value %q",
+				name, cmd.ForceLabelMatch)
+		}
+		return nil
+	}
+
+	if size != 0 && size < threshold {
+		return nil
+	}
+
+	if cmd.confirmIn == nil {
+		cmd.confirmIn = os.Stdin
+	}
+	if cmd.confirmOut == nil {
+		cmd.confirmOut = os.Stderr
+	}
+
+	sizeStr := "unknown size"
+	if size != 0 {
+		sizeStr = humanize.Bytes(size)
+	}
+	fmt.Fprintf(cmd.confirmOut, "Pool %s (%s) is at or above the destroy confirmation threshold of %s.\n"+
+		"Type the pool label to confirm destruction: ", name, sizeStr, humanize.Bytes(threshold))
+
+	line, err := bufio.NewReader(cmd.confirmIn).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return errors.Wrap(err, "failed to read confirmation")
+	}
+	if strings.TrimSpace(line) != name {
+		return errors.Errorf("confirmation did not match pool label %q; pool not destroyed", name)
+	}
+
+	return nil
 }
 
 // Execute is run when PoolDestroyCmd subcommand is activated
 func (cmd *poolDestroyCmd) Execute(args []string) error {
+	if err := cmd.checkDestroyInterlocks(cmd.MustLogCtx()); err != nil {
+		return err
+	}
+
 	msg := "succeeded"
 
 	req := &control.PoolDestroyReq{
@@ -514,13 +728,21 @@ func (cmd *poolDestroyCmd) Execute(args []string) error {
 // poolEvictCmd is the struct representing the command to evict a DAOS pool.
 type poolEvictCmd struct {
 	poolCmd
+	User      string          `long:"user" description:"Only evict handles opened by this user"`
+	Group     string          `long:"group" description:"Only evict handles opened by members of this group"`
+	OlderThan ui.DurationFlag `long:"older-than" description:"Only evict handles that have been open for longer than this duration (e.g. 2h)"`
 }
 
 // Execute is run when PoolEvictCmd subcommand is activated
 func (cmd *poolEvictCmd) Execute(args []string) error {
 	msg := "succeeded"
 
-	req := &control.PoolEvictReq{ID: cmd.PoolID().String()}
+	req := &control.PoolEvictReq{
+		ID:        cmd.PoolID().String(),
+		User:      cmd.User,
+		Group:     cmd.Group,
+		OlderThan: cmd.OlderThan.Duration,
+	}
 
 	err := control.PoolEvict(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
@@ -685,8 +907,35 @@ func (cmd *poolReintegrateCmd) Execute(args []string) error {
 // poolQueryCmd is the struct representing the command to query a DAOS pool.
 type poolQueryCmd struct {
 	poolCmd
-	ShowEnabledRanks bool `short:"e" long:"show-enabled" description:"Show engine unique identifiers (ranks) which are enabled"`
-	HealthOnly       bool `short:"t" long:"health-only" description:"Only perform pool health related queries"`
+	ShowEnabledRanks bool   `short:"e" long:"show-enabled" description:"Show engine unique identifiers (ranks) which are enabled"`
+	HealthOnly       bool   `short:"t" long:"health-only" description:"Only perform pool health related queries"`
+	Aggregation      bool   `long:"aggregation" description:"Show aggregation and garbage collection statistics for each engine"`
+	TelemetryPort    uint32 `long:"telemetry-port" default:"9191" description:"Telemetry port on the engine hosts, used with --aggregation"`
+}
+
+// queryAggregation collects the aggregation and garbage collection statistics of
+// the pool from the telemetry published by each engine host in the system.
+func (cmd *poolQueryCmd) queryAggregation(ctx context.Context, poolUUID string) (*control.PoolAggregationQueryResp, error) {
+	sysResp, err := control.SystemQuery(ctx, cmd.ctlInvoker, &control.SystemQueryReq{})
+	if err != nil {
+		return nil, errors.Wrap(err, "system query failed")
+	}
+
+	hosts := make(common.StringSet)
+	for _, m := range sysResp.Members {
+		if m.Addr != nil {
+			hosts.Add(m.Addr.IP.String())
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, errors.New("no engine hosts found in system")
+	}
+
+	return control.PoolAggregationQuery(ctx, &control.PoolAggregationQueryReq{
+		Hosts:    hosts.ToSlice(),
+		Port:     cmd.TelemetryPort,
+		PoolUUID: poolUUID,
+	})
 }
 
 // Execute is run when PoolQueryCmd subcommand is activated
@@ -705,6 +954,24 @@ func (cmd *poolQueryCmd) Execute(args []string) error {
 	req.QueryMask.SetOptions(daos.PoolQueryOptionDisabledEngines)
 
 	resp, err := control.PoolQuery(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.Aggregation {
+		var aggResp *control.PoolAggregationQueryResp
+		if err == nil {
+			aggResp, err = cmd.queryAggregation(cmd.MustLogCtx(), resp.UUID.String())
+		}
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(aggResp, err)
+		}
+		if err != nil {
+			return errors.Wrap(err, "pool aggregation query failed")
+		}
+
+		var bld strings.Builder
+		pretty.PrintPoolAggregationStats(aggResp, &bld)
+		cmd.Info(bld.String())
+		return nil
+	}
+
 	if cmd.JSONOutputEnabled() {
 		var poolInfo *daos.PoolInfo
 		if resp != nil {
@@ -731,14 +998,64 @@ func (cmd *poolQueryCmd) Execute(args []string) error {
 type poolQueryTargetsCmd struct {
 	poolCmd
 
-	Rank    uint32         `long:"rank" required:"1" description:"Engine rank of the target(s) to be queried"`
-	Targets ui.RankSetFlag `long:"target-idx" description:"Comma-separated list of target index(es) to be queried (default: all)"`
+	Rank          *uint32        `long:"rank" description:"Engine rank of the target(s) to be queried"`
+	Targets       ui.RankSetFlag `long:"target-idx" description:"Comma-separated list of target index(es) to be queried (default: all)"`
+	AllRanks      bool           `long:"all-ranks" description:"Query the target states of all ranks in the pool"`
+	UnhealthyOnly bool           `long:"unhealthy-only" description:"With --all-ranks, only show ranks with targets that are not up_in"`
+}
+
+// queryAllRanks queries the target states of all ranks in the pool and displays
+// them as a matrix of ranks by target state.
+func (cmd *poolQueryTargetsCmd) queryAllRanks(ctx context.Context) error {
+	req := &control.PoolQueryTargetReq{
+		ID:            cmd.PoolID().String(),
+		AllRanks:      true,
+		UnhealthyOnly: cmd.UnhealthyOnly,
+	}
+
+	resp, err := control.PoolQueryTargets(ctx, cmd.ctlInvoker, req)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool query targets failed")
+	}
+	if resp.Status != 0 {
+		return errors.Wrap(daos.Status(resp.Status), "pool query targets failed")
+	}
+
+	if cmd.UnhealthyOnly && len(resp.Ranks) == 0 {
+		cmd.Info("All pool targets are up_in")
+		return nil
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintPoolQueryTargetRanks(resp, &bld); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+	return nil
 }
 
 // Execute is run when PoolQueryTargetsCmd subcommand is activated
 func (cmd *poolQueryTargetsCmd) Execute(args []string) error {
 	ctx := cmd.MustLogCtx()
 
+	if cmd.AllRanks {
+		if cmd.Rank != nil || cmd.Targets.RankSet.Count() > 0 {
+			return errors.New("--all-ranks may not be combined with --rank or --target-idx")
+		}
+		return cmd.queryAllRanks(ctx)
+	}
+	if cmd.UnhealthyOnly {
+		return errors.New("--unhealthy-only requires --all-ranks")
+	}
+	if cmd.Rank == nil {
+		return errors.New("either --rank or --all-ranks must be specified")
+	}
+
 	var tgtsList []uint32
 	if cmd.Targets.RankSet.Count() == 0 {
 		pi, err := control.PoolQuery(ctx, cmd.ctlInvoker, &control.PoolQueryReq{
@@ -764,7 +1081,7 @@ func (cmd *poolQueryTargetsCmd) Execute(args []string) error {
 
 	req := &control.PoolQueryTargetReq{
 		ID:      cmd.PoolID().String(),
-		Rank:    ranklist.Rank(cmd.Rank),
+		Rank:    ranklist.Rank(*cmd.Rank),
 		Targets: tgtsList,
 	}
 
@@ -788,13 +1105,47 @@ func (cmd *poolQueryTargetsCmd) Execute(args []string) error {
 
 // poolUpgradeCmd is the struct representing the command to update a DAOS pool.
 type poolUpgradeCmd struct {
-	poolCmd
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+
+	All          bool `short:"a" long:"all" description:"Upgrade all pools in the system that are not at the latest format"`
+	BatchSize    uint `short:"b" long:"batch-size" default:"1" description:"Number of pools to upgrade concurrently with --all"`
+	PauseOnError bool `short:"p" long:"pause-on-error" description:"Stop upgrading pools after a batch with a failed upgrade when used with --all"`
+
+	Args struct {
+		Pool PoolID `positional-arg-name:"<pool label or UUID>"`
+	} `positional-args:"yes"`
+}
+
+// poolUpgradeResult contains the outcome of a pool upgrade performed with --all.
+type poolUpgradeResult struct {
+	Pool        string `json:"pool"`
+	UUID        string `json:"uuid"`
+	FromVersion uint32 `json:"from_version"`
+	ToVersion   uint32 `json:"to_version"`
+	Error       string `json:"error,omitempty"`
 }
 
 // Execute is run when PoolUpgradeCmd subcommand is activated
 func (cmd *poolUpgradeCmd) Execute(args []string) error {
+	switch {
+	case cmd.All && !cmd.Args.Pool.Empty():
+		return errors.New("pool label or UUID may not be specified with --all")
+	case cmd.All:
+		if cmd.BatchSize == 0 {
+			return errors.New("--batch-size must be greater than 0")
+		}
+		return cmd.upgradeAll(cmd.MustLogCtx())
+	case cmd.Args.Pool.Empty():
+		return errors.New("pool label or UUID is required unless --all is specified")
+	case cmd.PauseOnError:
+		return errors.New("--pause-on-error may only be used with --all")
+	}
+
 	req := &control.PoolUpgradeReq{
-		ID: cmd.PoolID().String(),
+		ID: cmd.Args.Pool.String(),
 	}
 
 	err := control.PoolUpgrade(cmd.MustLogCtx(), cmd.ctlInvoker, req)
@@ -806,6 +1157,208 @@ func (cmd *poolUpgradeCmd) Execute(args []string) error {
 	return nil
 }
 
+// upgradeAll upgrades the pools in the system that are not at the latest format
+// version, in batches of concurrent upgrades. Pools that have already been upgraded
+// are skipped, so an interrupted or paused run may be resumed by running it again.
+func (cmd *poolUpgradeCmd) upgradeAll(ctx context.Context) error {
+	resp, err := control.ListPools(ctx, cmd.ctlInvoker, &control.ListPoolsReq{})
+	if err != nil {
+		return errors.Wrap(err, "listing pools")
+	}
+
+	var pending []*poolUpgradeResult
+	for _, p := range resp.Pools {
+		if qe, found := resp.QueryErrors[p.UUID]; found {
+			cmd.Noticef("skipping pool %s: unable to query pool: %s", p.Name(), qe)
+			continue
+		}
+		if p.PoolLayoutVer == p.UpgradeLayoutVer {
+			continue
+		}
+		pending = append(pending, &poolUpgradeResult{
+			Pool:        p.Name(),
+			UUID:        p.UUID.String(),
+			FromVersion: p.PoolLayoutVer,
+			ToVersion:   p.UpgradeLayoutVer,
+		})
+	}
+
+	var done []*poolUpgradeResult
+	failed := 0
+	for len(pending) > 0 {
+		batch := pending
+		if uint(len(batch)) > cmd.BatchSize {
+			batch = batch[:cmd.BatchSize]
+		}
+		pending = pending[len(batch):]
+
+		var wg sync.WaitGroup
+		for _, res := range batch {
+			wg.Add(1)
+			go func(res *poolUpgradeResult) {
+				defer wg.Done()
+				if err := control.PoolUpgrade(ctx, cmd.ctlInvoker, &control.PoolUpgradeReq{ID: res.UUID}); err != nil {
+					res.Error = err.Error()
+				}
+			}(res)
+		}
+		wg.Wait()
+
+		for _, res := range batch {
+			done = append(done, res)
+			msg := "upgraded"
+			if res.Error != "" {
+				msg = "failed: " + res.Error
+				failed++
+			}
+			if !cmd.JSONOutputEnabled() {
+				cmd.Infof("[%d/%d] pool %s (%d->%d): %s", len(done), len(done)+len(pending),
+					res.Pool, res.FromVersion, res.ToVersion, msg)
+			}
+		}
+
+		if failed > 0 && cmd.PauseOnError {
+			break
+		}
+	}
+
+	var outErr error
+	switch {
+	case failed > 0 && len(pending) > 0:
+		outErr = errors.Errorf("%s failed, paused with %s remaining; "+
+			"run the command again to resume", english.Plural(failed, "pool upgrade", ""),
+			english.Plural(len(pending), "pool", ""))
+	case failed > 0:
+		outErr = errors.Errorf("%d of %s failed", failed, english.Plural(len(done), "pool upgrade", ""))
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(done, outErr)
+	}
+
+	if len(done) == 0 {
+		cmd.Info("No pools need to be upgraded")
+		return nil
+	}
+	if outErr == nil {
+		cmd.Infof("Upgraded %s", english.Plural(len(done), "pool", ""))
+	}
+
+	return outErr
+}
+
+// poolRebalancePollInterval is the interval at which the rebuild status of a pool is queried
+// while waiting for a rebalance to complete.
+var poolRebalancePollInterval = 5 * time.Second
+
+// poolRebalanceCmd is the struct representing the command to rebalance a DAOS pool.
+type poolRebalanceCmd struct {
+	poolCmd
+	DryRun bool `long:"dry-run" description:"Only estimate the data movement, do not start the rebalance"`
+	Wait   bool `long:"wait" description:"Wait for the rebalance to complete, reporting progress"`
+}
+
+// poolRebalanceResult contains the JSON output of the pool rebalance command.
+type poolRebalanceResult struct {
+	Estimate *control.PoolRebalanceEstimate `json:"estimate"`
+	Rebuild  *daos.PoolRebuildStatus        `json:"rebuild,omitempty"`
+}
+
+// Execute is run when poolRebalanceCmd subcommand is activated
+func (cmd *poolRebalanceCmd) Execute(args []string) error {
+	ctx := cmd.MustLogCtx()
+	req := &control.PoolRebalanceReq{
+		ID: cmd.PoolID().String(),
+	}
+
+	est, err := control.EstimatePoolRebalance(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return errors.Wrap(err, "pool rebalance estimate failed")
+	}
+	result := &poolRebalanceResult{Estimate: est}
+
+	if !cmd.JSONOutputEnabled() {
+		var bld strings.Builder
+		pretty.PrintPoolRebalanceEstimate(est, &bld)
+		cmd.Info(bld.String())
+	}
+
+	if cmd.DryRun {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(result, nil)
+		}
+		return nil
+	}
+
+	if err := control.PoolRebalance(ctx, cmd.ctlInvoker, req); err != nil {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(result, err)
+		}
+		return errors.Wrap(err, "pool rebalance failed")
+	}
+
+	if cmd.Wait {
+		result.Rebuild, err = cmd.waitRebalance(ctx, req.ID)
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(result, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if cmd.Wait {
+		cmd.Info("Pool-rebalance command succeeded")
+	} else {
+		cmd.Info("Pool-rebalance command started; monitor progress with dmg pool query")
+	}
+	return nil
+}
+
+// waitRebalance polls the rebuild status of the pool until the rebalance is no longer in
+// progress, reporting each change in progress along the way.
+func (cmd *poolRebalanceCmd) waitRebalance(ctx context.Context, poolID string) (*daos.PoolRebuildStatus, error) {
+	req := &control.PoolQueryReq{
+		ID: poolID,
+	}
+	if err := req.QueryMask.SetOptions(daos.PoolQueryOptionRebuild); err != nil {
+		return nil, err
+	}
+
+	var last string
+	for {
+		resp, err := control.PoolQuery(ctx, cmd.ctlInvoker, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "pool query failed")
+		}
+		rs := resp.Rebuild
+
+		if !cmd.JSONOutputEnabled() {
+			var bld strings.Builder
+			pretty.PrintPoolRebalanceProgress(rs, &bld)
+			if progress := bld.String(); progress != last {
+				cmd.Info(strings.TrimSpace(progress))
+				last = progress
+			}
+		}
+
+		switch {
+		case rs == nil:
+			return nil, errors.New("pool query returned no rebuild status")
+		case rs.Status != 0:
+			return rs, errors.Errorf("pool rebalance failed: %s", daos.Status(rs.Status))
+		case rs.State != daos.PoolRebuildStateBusy:
+			return rs, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return rs, ctx.Err()
+		case <-time.After(poolRebalancePollInterval):
+		}
+	}
+}
+
 // poolSetPropCmd represents the command to set a property on a pool.
 type poolSetPropCmd struct {
 	poolCmd
@@ -850,6 +1403,38 @@ func (cmd *poolSetPropCmd) Execute(_ []string) error {
 	return nil
 }
 
+// poolRenameLabelCmd represents the command to change the label of a pool.
+type poolRenameLabelCmd struct {
+	poolCmd
+
+	Args struct {
+		NewLabel string `positional-arg-name:"<new label>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolRenameLabelCmd subcommand is activated.
+func (cmd *poolRenameLabelCmd) Execute(_ []string) error {
+	req := &control.PoolRenameLabelReq{
+		ID:       cmd.PoolID().String(),
+		NewLabel: cmd.Args.NewLabel,
+	}
+
+	resp, err := control.PoolRenameLabel(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool rename-label failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintPoolRenameLabelResp(resp, &bld)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
 // poolGetPropCmd represents the command to set a property on a pool.
 type poolGetPropCmd struct {
 	poolCmd
@@ -888,6 +1473,7 @@ type poolGetACLCmd struct {
 	File    string `short:"o" long:"outfile" required:"0" description:"Output ACL to file"`
 	Force   bool   `short:"f" long:"force" required:"0" description:"Allow to clobber output file"`
 	Verbose bool   `short:"v" long:"verbose" required:"0" description:"Add descriptive comments to ACL entries"`
+	Format  string `long:"format" choice:"text" choice:"json" choice:"yaml" default:"text" description:"Format of the ACL output (json and yaml use the structured ACL format)"`
 }
 
 // Execute is run when the PoolGetACLCmd subcommand is activated
@@ -905,7 +1491,10 @@ func (cmd *poolGetACLCmd) Execute(args []string) error {
 
 	cmd.Debugf("Pool-get-ACL command succeeded, ID: %s\n", cmd.PoolID())
 
-	acl := control.FormatACL(resp.ACL, cmd.Verbose)
+	acl, err := control.ExportACL(resp.ACL, cmd.Format, cmd.Verbose)
+	if err != nil {
+		return err
+	}
 
 	if cmd.File != "" {
 		err = cmd.writeACLToFile(acl)
@@ -984,27 +1573,72 @@ func (cmd *poolOverwriteACLCmd) Execute(args []string) error {
 // a DAOS pool.
 type poolUpdateACLCmd struct {
 	poolCmd
-	ACLFile string `short:"a" long:"acl-file" required:"0" description:"Path for new Access Control List file"`
-	Entry   string `short:"e" long:"entry" required:"0" description:"Single Access Control Entry to add or update"`
+	ACLFile  string `short:"a" long:"acl-file" required:"0" description:"Path for new Access Control List file"`
+	FromFile string `long:"from-file" required:"0" description:"Path for new Access Control List file in the structured JSON or YAML format"`
+	Entry    string `short:"e" long:"entry" required:"0" description:"Single Access Control Entry to add or update"`
+	DryRun   bool   `long:"dry-run" required:"0" description:"Show the changes to the Access Control List without applying them"`
 }
 
-// Execute is run when the PoolUpdateACLCmd subcommand is activated
-func (cmd *poolUpdateACLCmd) Execute(args []string) error {
-	if (cmd.ACLFile == "" && cmd.Entry == "") || (cmd.ACLFile != "" && cmd.Entry != "") {
-		return errors.New("either ACL file or entry parameter is required")
+func (cmd *poolUpdateACLCmd) getACL() (*control.AccessControlList, error) {
+	var numSet int
+	for _, opt := range []string{cmd.ACLFile, cmd.FromFile, cmd.Entry} {
+		if opt != "" {
+			numSet++
+		}
+	}
+	if numSet != 1 {
+		return nil, errors.New("exactly one of ACL file, structured ACL file or entry parameter is required")
 	}
 
-	var acl *control.AccessControlList
-	if cmd.ACLFile != "" {
-		aclFileResult, err := control.ReadACLFile(cmd.ACLFile)
-		if err != nil {
-			return err
-		}
-		acl = aclFileResult
-	} else {
-		acl = &control.AccessControlList{
+	switch {
+	case cmd.ACLFile != "":
+		return control.ReadACLFile(cmd.ACLFile)
+	case cmd.FromFile != "":
+		return control.ReadStructuredACLFile(cmd.FromFile)
+	default:
+		return &control.AccessControlList{
 			Entries: []string{cmd.Entry},
+		}, nil
+	}
+}
+
+// dryRun displays the changes that the update would make to the current
+// Access Control List of the pool.
+func (cmd *poolUpdateACLCmd) dryRun(acl *control.AccessControlList) error {
+	req := &control.PoolGetACLReq{ID: cmd.PoolID().String()}
+
+	resp, err := control.PoolGetACL(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(nil, err)
 		}
+		return errors.Wrap(err, "Pool-update-ACL dry run failed")
+	}
+
+	diff, err := control.DiffACLUpdate(resp.ACL, acl)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(diff, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	pretty.PrintACLDiff(cmd.PoolID().String(), diff, &bld)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// Execute is run when the PoolUpdateACLCmd subcommand is activated
+func (cmd *poolUpdateACLCmd) Execute(args []string) error {
+	acl, err := cmd.getACL()
+	if err != nil {
+		return err
+	}
+
+	if cmd.DryRun {
+		return cmd.dryRun(acl)
 	}
 
 	req := &control.PoolUpdateACLReq{
@@ -1057,3 +1691,33 @@ func (cmd *poolDeleteACLCmd) Execute(args []string) error {
 
 	return nil
 }
+
+// poolValidateACLCmd represents the command to check an Access Control List
+// file offline, without applying it to a pool.
+type poolValidateACLCmd struct {
+	baseCmd
+	cmdutil.JSONOutputCmd
+	ACLFile string `short:"f" long:"file" required:"1" description:"Path of Access Control List file to validate"`
+	Verbose bool   `short:"v" long:"verbose" required:"0" description:"Add descriptive comments to normalized ACL entries"`
+}
+
+// Execute is run when the PoolValidateACLCmd subcommand is activated
+func (cmd *poolValidateACLCmd) Execute(args []string) error {
+	result, err := control.ValidateACLFile(cmd.ACLFile)
+	if err == nil && !result.Valid() {
+		err = errors.Errorf("ACL file %s is not valid", cmd.ACLFile)
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(result, err)
+	}
+
+	if result == nil {
+		return err
+	}
+
+	var bld strings.Builder
+	pretty.PrintACLValidation(cmd.ACLFile, result, &bld, cmd.Verbose)
+	cmd.Info(bld.String())
+
+	return err
+}
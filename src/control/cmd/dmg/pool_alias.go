@@ -0,0 +1,86 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// poolAliasCmd is the struct representing the pool alias subcommands.
+type poolAliasCmd struct {
+	Add    poolAliasAddCmd    `command:"add" description:"Add alias labels to a DAOS pool"`
+	Remove poolAliasRemoveCmd `command:"remove" alias:"rm" description:"Remove alias labels from a DAOS pool"`
+	List   poolAliasListCmd   `command:"list" alias:"ls" description:"List the alias labels of a DAOS pool"`
+}
+
+// updateAliases adds and removes aliases of the command's pool and prints the
+// resulting aliases.
+func (cmd *poolCmd) updateAliases(op string, add, remove []string) error {
+	req := &control.PoolUpdateAliasesReq{
+		ID:     cmd.PoolID().String(),
+		Add:    add,
+		Remove: remove,
+	}
+
+	resp, err := control.PoolUpdateAliases(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "pool alias %s failed", op)
+	}
+
+	var bld strings.Builder
+	pretty.PrintPoolAliases(resp, &bld)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// poolAliasAddCmd represents the command to add aliases to a pool.
+type poolAliasAddCmd struct {
+	poolCmd
+
+	Args struct {
+		Aliases []string `positional-arg-name:"<alias>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolAliasAddCmd subcommand is activated.
+func (cmd *poolAliasAddCmd) Execute(_ []string) error {
+	return cmd.updateAliases("add", cmd.Args.Aliases, nil)
+}
+
+// poolAliasRemoveCmd represents the command to remove aliases from a pool.
+type poolAliasRemoveCmd struct {
+	poolCmd
+
+	Args struct {
+		Aliases []string `positional-arg-name:"<alias>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolAliasRemoveCmd subcommand is activated.
+func (cmd *poolAliasRemoveCmd) Execute(_ []string) error {
+	return cmd.updateAliases("remove", nil, cmd.Args.Aliases)
+}
+
+// poolAliasListCmd represents the command to list the aliases of a pool.
+type poolAliasListCmd struct {
+	poolCmd
+}
+
+// Execute is run when poolAliasListCmd subcommand is activated.
+func (cmd *poolAliasListCmd) Execute(_ []string) error {
+	return cmd.updateAliases("list", nil, nil)
+}
@@ -0,0 +1,140 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// poolPolicyCmd is the struct representing the pool policy subcommands.
+type poolPolicyCmd struct {
+	Set    poolPolicySetCmd    `command:"set" description:"Add or replace the pool creation policy for a user or group"`
+	List   poolPolicyListCmd   `command:"list" alias:"ls" description:"List the pool creation policies"`
+	Remove poolPolicyRemoveCmd `command:"remove" alias:"rm" description:"Remove the pool creation policy for a user or group"`
+}
+
+// poolPolicyPrincipal holds the options selecting the user or group that a
+// pool policy applies to.
+type poolPolicyPrincipal struct {
+	User  ui.ACLPrincipalFlag `short:"u" long:"user" description:"Policy applies to the pools owned by given user, format name@domain"`
+	Group ui.ACLPrincipalFlag `short:"g" long:"group" description:"Policy applies to the pools owned by given group, format name@domain"`
+}
+
+func (pp *poolPolicyPrincipal) get() (system.PoolPolicyType, string, error) {
+	switch {
+	case pp.User != "" && pp.Group != "":
+		return "", "", errors.New("--user and --group may not be combined")
+	case pp.User != "":
+		return system.PoolPolicyUser, pp.User.String(), nil
+	case pp.Group != "":
+		return system.PoolPolicyGroup, pp.Group.String(), nil
+	default:
+		return "", "", errors.New("one of --user or --group must be supplied")
+	}
+}
+
+// poolPolicySetCmd represents the command to add or replace a pool policy.
+type poolPolicySetCmd struct {
+	baseCtlCmd
+	poolPolicyPrincipal
+	MaxPools     uint32          `long:"max-pools" description:"Maximum number of pools owned (default: no limit)"`
+	MaxSize      ui.ByteSizeFlag `long:"max-size" description:"Maximum total size of the pools owned (default: no limit)"`
+	AllowedProps string          `long:"allowed-props" description:"Comma-separated list of the pool properties that may be set at creation (default: any)"`
+}
+
+// Execute is run when poolPolicySetCmd subcommand is activated.
+func (cmd *poolPolicySetCmd) Execute(_ []string) error {
+	typ, principal, err := cmd.get()
+	if err != nil {
+		return err
+	}
+
+	req := &control.PoolSetPolicyReq{
+		Policy: control.PoolPolicy{
+			Type:          string(typ),
+			Principal:     principal,
+			MaxPools:      cmd.MaxPools,
+			MaxTotalBytes: cmd.MaxSize.Bytes,
+		},
+	}
+	for _, name := range strings.Split(cmd.AllowedProps, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			req.Policy.AllowedProperties = append(req.Policy.AllowedProperties, name)
+		}
+	}
+
+	err = control.PoolSetPolicy(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool policy set failed")
+	}
+	cmd.Infof("Pool policy set for %s %s", typ, principal)
+
+	return nil
+}
+
+// poolPolicyListCmd represents the command to list the pool policies.
+type poolPolicyListCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when poolPolicyListCmd subcommand is activated.
+func (cmd *poolPolicyListCmd) Execute(_ []string) error {
+	resp, err := control.PoolListPolicies(cmd.MustLogCtx(), cmd.ctlInvoker, &control.PoolListPoliciesReq{})
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool policy list failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintPoolPolicies(&bld, resp.Policies)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// poolPolicyRemoveCmd represents the command to remove a pool policy.
+type poolPolicyRemoveCmd struct {
+	baseCtlCmd
+	poolPolicyPrincipal
+}
+
+// Execute is run when poolPolicyRemoveCmd subcommand is activated.
+func (cmd *poolPolicyRemoveCmd) Execute(_ []string) error {
+	typ, principal, err := cmd.get()
+	if err != nil {
+		return err
+	}
+
+	err = control.PoolRemovePolicy(cmd.MustLogCtx(), cmd.ctlInvoker, &control.PoolRemovePolicyReq{
+		Type:      string(typ),
+		Principal: principal,
+	})
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool policy remove failed")
+	}
+	cmd.Infof("Pool policy removed for %s %s", typ, principal)
+
+	return nil
+}
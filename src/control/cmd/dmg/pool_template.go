@@ -0,0 +1,267 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// poolTemplateCmd is the struct representing the pool template subcommands.
+type poolTemplateCmd struct {
+	List   poolTemplateListCmd   `command:"list" alias:"ls" description:"List pool templates stored on the Management Service"`
+	Import poolTemplateImportCmd `command:"import" description:"Store the pool templates defined in a YAML file on the Management Service"`
+	Delete poolTemplateDeleteCmd `command:"delete" alias:"rm" description:"Delete a pool template stored on the Management Service"`
+}
+
+// loadTemplate returns the named pool template from the template file if one was
+// specified, and otherwise from the templates stored on the Management Service.
+func (cmd *poolCreateCmd) loadTemplate(ctx context.Context) (*control.PoolTemplate, error) {
+	if cmd.TemplateFile == "" {
+		return control.GetPoolTemplate(ctx, cmd.ctlInvoker, cmd.Template)
+	}
+
+	templates, err := control.LoadPoolTemplates(cmd.TemplateFile)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, found := templates[cmd.Template]
+	if !found {
+		return nil, errors.Errorf("pool template %q not found in %s", cmd.Template,
+			cmd.TemplateFile)
+	}
+
+	return tmpl, nil
+}
+
+// applyTemplate uses the supplied pool template to fill in any pool create options
+// that were not set on the command line. The template's storage options are only
+// used if none were set on the command line, in order to avoid mixing incompatible
+// options.
+func (cmd *poolCreateCmd) applyTemplate(tmpl *control.PoolTemplate) error {
+	setOpt := func(name, val string, isSet bool, opt flags.Unmarshaler) error {
+		if isSet || val == "" {
+			return nil
+		}
+		return errors.Wrapf(opt.UnmarshalFlag(val), "pool template %s", name)
+	}
+
+	storageSet := cmd.Size.IsSet() || cmd.TierRatio.IsSet() || cmd.MemRatio.IsSet() ||
+		cmd.ScmSize.IsSet() || cmd.NVMeSize.IsSet() || cmd.MetaSize.IsSet() ||
+		cmd.DataSize.IsSet()
+	ranksSet := cmd.NumRanks > 0 || !cmd.RankList.Empty()
+
+	for _, opt := range []struct {
+		name  string
+		val   string
+		isSet bool
+		flag  flags.Unmarshaler
+	}{
+		{"size", tmpl.Size, storageSet, &cmd.Size},
+		{"tier_ratio", tmpl.TierRatio, storageSet, &cmd.TierRatio},
+		{"mem_ratio", tmpl.MemRatio, storageSet, &cmd.MemRatio},
+		{"scm_size", tmpl.ScmSize, storageSet, &cmd.ScmSize},
+		{"nvme_size", tmpl.NVMeSize, storageSet, &cmd.NVMeSize},
+		{"meta_size", tmpl.MetaSize, storageSet, &cmd.MetaSize},
+		{"data_size", tmpl.DataSize, storageSet, &cmd.DataSize},
+		{"ranks", tmpl.Ranks, ranksSet, &cmd.RankList},
+		{"user", tmpl.User, cmd.UserName.String() != "", &cmd.UserName},
+		{"group", tmpl.Group, cmd.GroupName.String() != "", &cmd.GroupName},
+	} {
+		if err := setOpt(opt.name, opt.val, opt.isSet, opt.flag); err != nil {
+			return err
+		}
+	}
+
+	if !storageSet && !ranksSet {
+		cmd.NumRanks = tmpl.NumRanks
+	}
+	if cmd.NumSvcReps == 0 {
+		cmd.NumSvcReps = tmpl.NumSvcReps
+	}
+
+	if tmpl.Properties != "" {
+		var tmplProps PoolSetPropsFlag
+		if err := tmplProps.UnmarshalFlag(tmpl.Properties); err != nil {
+			return errors.Wrap(err, "pool template properties")
+		}
+
+		// Properties set on the command line take precedence.
+		setProps := make(map[string]struct{})
+		for _, prop := range cmd.Properties.ToSet {
+			setProps[prop.Name] = struct{}{}
+		}
+		for _, prop := range tmplProps.ToSet {
+			if prop.Name == "label" {
+				return errors.New("pool template properties may not include a label")
+			}
+			if _, found := setProps[prop.Name]; !found {
+				cmd.Properties.ToSet = append(cmd.Properties.ToSet, prop)
+			}
+		}
+	}
+
+	return nil
+}
+
+func printPoolTemplates(out io.Writer, templates map[string]*control.PoolTemplate) {
+	if len(templates) == 0 {
+		fmt.Fprintln(out, "No pool templates found")
+		return
+	}
+
+	nameTitle := "Name"
+	storageTitle := "Storage"
+	ranksTitle := "Ranks"
+	svcTitle := "Svc Reps"
+	propsTitle := "Properties"
+	aclTitle := "ACL Entries"
+
+	table := []txtfmt.TableRow{}
+	for _, name := range control.PoolTemplateNames(templates) {
+		tmpl := templates[name]
+
+		var storage []string
+		for _, opt := range []struct{ name, val string }{
+			{"size", tmpl.Size},
+			{"tier-ratio", tmpl.TierRatio},
+			{"mem-ratio", tmpl.MemRatio},
+			{"scm-size", tmpl.ScmSize},
+			{"nvme-size", tmpl.NVMeSize},
+			{"meta-size", tmpl.MetaSize},
+			{"data-size", tmpl.DataSize},
+		} {
+			if opt.val != "" {
+				storage = append(storage, opt.name+"="+opt.val)
+			}
+		}
+
+		ranks := tmpl.Ranks
+		if ranks == "" && tmpl.NumRanks > 0 {
+			ranks = fmt.Sprintf("any %d", tmpl.NumRanks)
+		}
+
+		var svcReps string
+		if tmpl.NumSvcReps > 0 {
+			svcReps = fmt.Sprintf("%d", tmpl.NumSvcReps)
+		}
+
+		row := txtfmt.TableRow{
+			nameTitle:    name,
+			storageTitle: strings.Join(storage, " "),
+			ranksTitle:   ranks,
+			svcTitle:     svcReps,
+			propsTitle:   tmpl.Properties,
+			aclTitle:     fmt.Sprintf("%d", len(tmpl.ACL)),
+		}
+		for key, val := range row {
+			if val == "" {
+				row[key] = "-"
+			}
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(nameTitle, storageTitle, ranksTitle, svcTitle,
+		propsTitle, aclTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
+
+// poolTemplateListCmd represents the command to list the pool templates stored on
+// the Management Service.
+type poolTemplateListCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when poolTemplateListCmd subcommand is activated.
+func (cmd *poolTemplateListCmd) Execute(_ []string) error {
+	templates, err := control.GetPoolTemplates(cmd.MustLogCtx(), cmd.ctlInvoker)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(templates, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool template list failed")
+	}
+
+	var bld strings.Builder
+	printPoolTemplates(&bld, templates)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// poolTemplateImportCmd represents the command to store the pool templates defined
+// in a YAML file on the Management Service.
+type poolTemplateImportCmd struct {
+	baseCtlCmd
+	Args struct {
+		File string `positional-arg-name:"<template file>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolTemplateImportCmd subcommand is activated.
+func (cmd *poolTemplateImportCmd) Execute(_ []string) error {
+	templates, err := control.LoadPoolTemplates(cmd.Args.File)
+	if err != nil {
+		return err
+	}
+
+	err = control.SetPoolTemplates(cmd.MustLogCtx(), cmd.ctlInvoker, templates)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool template import failed")
+	}
+	cmd.Infof("Imported pool templates: %s",
+		strings.Join(control.PoolTemplateNames(templates), ", "))
+
+	return nil
+}
+
+// poolTemplateDeleteCmd represents the command to delete a pool template stored on
+// the Management Service.
+type poolTemplateDeleteCmd struct {
+	baseCtlCmd
+	Args struct {
+		Name string `positional-arg-name:"<template name>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolTemplateDeleteCmd subcommand is activated.
+func (cmd *poolTemplateDeleteCmd) Execute(_ []string) error {
+	ctx := cmd.MustLogCtx()
+
+	// Verify that the template exists, as deleting an unknown system
+	// attribute is not an error.
+	_, err := control.GetPoolTemplate(ctx, cmd.ctlInvoker, cmd.Args.Name)
+	if err == nil {
+		err = control.SetPoolTemplates(ctx, cmd.ctlInvoker,
+			map[string]*control.PoolTemplate{cmd.Args.Name: nil})
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool template delete failed")
+	}
+	cmd.Infof("Deleted pool template %s", cmd.Args.Name)
+
+	return nil
+}
@@ -9,16 +9,20 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"os/user"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
@@ -132,6 +136,62 @@ func Test_Dmg_PoolTierRatioFlag(t *testing.T) {
 	}
 }
 
+func Test_Dmg_PoolSizeFlag(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input     string
+		expBytes  uint64
+		expRatio  float64
+		expString string
+		expErr    error
+	}{
+		"bytes": {
+			input:     "10G",
+			expBytes:  10 * humanize.GByte,
+			expString: "10 GB",
+		},
+		"percentage": {
+			input:     "20%",
+			expRatio:  20,
+			expString: "20%",
+		},
+		"fractional percentage": {
+			input:     " 12.5 % ",
+			expRatio:  12.5,
+			expString: "12.5%",
+		},
+		"zero percentage": {
+			input:  "0%",
+			expErr: errors.New("allowed range 0 < ratio <= 100"),
+		},
+		"too large percentage": {
+			input:  "100.5%",
+			expErr: errors.New("allowed range 0 < ratio <= 100"),
+		},
+		"not a number": {
+			input:  "NaN%",
+			expErr: errors.New("allowed range 0 < ratio <= 100"),
+		},
+		"invalid percentage": {
+			input:  "ten%",
+			expErr: errors.New("invalid pool size ratio"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var psf poolSizeFlag
+			err := psf.UnmarshalFlag(tc.input)
+			test.CmpErr(t, tc.expErr, err)
+			if err != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expBytes, psf.Bytes, "unexpected size")
+			test.AssertEqual(t, tc.expRatio, psf.availRatio, "unexpected ratio")
+			test.AssertEqual(t, tc.expRatio > 0, psf.IsRatio(), "unexpected IsRatio()")
+			test.AssertEqual(t, tc.expString, psf.String(), "unexpected string")
+		})
+	}
+}
+
 func createACLFile(t *testing.T, dir string, acl *control.AccessControlList) string {
 	t.Helper()
 
@@ -171,6 +231,29 @@ func TestPoolCommands(t *testing.T) {
 
 	testEmptyFile := test.CreateTestFile(t, tmpDir, "")
 
+	testInvalidACLFile := test.CreateTestFile(t, tmpDir, "A::OWNER@:rw\nA::bob:r\n")
+
+	testStructuredACLFile := test.CreateTestFile(t, tmpDir, `
+entries:
+- principal: OWNER@
+  permissions: [Read, Write]
+- principal: GROUP@
+  flags: [Group]
+  permissions: [Read, Write]
+`)
+
+	testTemplateFile := test.CreateTestFile(t, tmpDir, `
+templates:
+  small:
+    scm_size: 512GiB
+    nsvc: 3
+    ranks: 0-3
+    properties: scrub:timed
+    acl:
+      - A::OWNER@:rw
+      - A:G:GROUP@:rw
+`)
+
 	// Subdirectory with no write perms
 	testNoPermDir := filepath.Join(tmpDir, "badpermsdir")
 	if err := os.Mkdir(testNoPermDir, 0444); err != nil {
@@ -278,6 +361,12 @@ func TestPoolCommands(t *testing.T) {
 			"",
 			errors.New("Creating DAOS pool with invalid full size ratio"),
 		},
+		{
+			"Create pool with minimum size but no size ratio",
+			fmt.Sprintf("pool create label --size %s --min-size 1T", testSizeStr),
+			"",
+			errors.New("--min-size requires --size to be a percentage ratio"),
+		},
 		{
 			"Create pool with incompatible rank arguments (auto)",
 			fmt.Sprintf("pool create label --size %s --nranks 16 --ranks 1,2,3", testSizeStr),
@@ -533,6 +622,44 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool with placement options",
+			fmt.Sprintf("pool create label --size %s --nranks 8 --placement spread --exclude-domains ^/rack0/", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateReq{
+					TotalBytes:      uint64(testSize),
+					TierRatio:       []float64{0.06, 0.94},
+					NumRanks:        8,
+					User:            eUsr.Username + "@",
+					UserGroup:       eGrp.Name + "@",
+					Ranks:           []ranklist.Rank{},
+					PlacementPolicy: "spread",
+					ExcludeDomains:  "^/rack0/",
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "label"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with invalid placement policy",
+			fmt.Sprintf("pool create label --size %s --placement scatter", testSizeStr),
+			"",
+			errors.New("Invalid value `scatter'"),
+		},
+		{
+			"Create pool with invalid exclude domains pattern",
+			fmt.Sprintf("pool create label --size %s --exclude-domains host(", testSizeStr),
+			"",
+			errors.New("invalid --exclude-domains pattern"),
+		},
+		{
+			"Create pool with placement options and manual ranks",
+			fmt.Sprintf("pool create label --size %s --ranks 1,2 --placement pack", testSizeStr),
+			"",
+			errors.New("may not be used with --ranks"),
+		},
 		{
 			"Create pool with user and group domains",
 			fmt.Sprintf("pool create label --scm-size %s --nsvc 3 --user foo@home --group bar@home", testSizeStr),
@@ -614,6 +741,156 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool with template from file",
+			fmt.Sprintf("pool create label --template small --template-file %s", testTemplateFile),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateReq{
+					NumSvcReps: 3,
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					Ranks:      []ranklist.Rank{0, 1, 2, 3},
+					TierBytes:  []uint64{uint64(testSize), 0},
+					Properties: []*daos.PoolProperty{
+						propWithVal("scrub", "timed"),
+						propWithVal("label", "label"),
+					},
+					ACL: testACL,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with template from file; options override template",
+			fmt.Sprintf("pool create label --template small --template-file %s --size %s --nsvc 5 --ranks 1 --properties scrub:lazy",
+				testTemplateFile, testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateReq{
+					TotalBytes: uint64(testSize),
+					TierRatio:  []float64{0.06, 0.94},
+					NumSvcReps: 5,
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					Ranks:      []ranklist.Rank{1},
+					Properties: []*daos.PoolProperty{
+						propWithVal("scrub", "lazy"),
+						propWithVal("label", "label"),
+					},
+					ACL: testACL,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with unknown template from file",
+			fmt.Sprintf("pool create label --template medium --template-file %s", testTemplateFile),
+			"",
+			errors.New(`pool template "medium" not found`),
+		},
+		{
+			"Create pool with template file but no template",
+			fmt.Sprintf("pool create label --template-file %s", testTemplateFile),
+			"",
+			errors.New("requires --template"),
+		},
+		{
+			"Create pool with unknown template from MS",
+			"pool create label --template small",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAttrReq{}),
+			}, " "),
+			errors.New(`pool template "small" not found`),
+		},
+		{
+			"Set pool policy for user",
+			"pool policy set --user alice --max-pools 2 --max-size 10TB --allowed-props reclaim,scrub",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetPolicyReq{
+					Policy: control.PoolPolicy{
+						Type:              "user",
+						Principal:         "alice@",
+						MaxPools:          2,
+						MaxTotalBytes:     10 * humanize.TByte,
+						AllowedProperties: []string{"reclaim", "scrub"},
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool policy for group",
+			"pool policy set -g hpc@example --max-pools 8",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetPolicyReq{
+					Policy: control.PoolPolicy{
+						Type:      "group",
+						Principal: "hpc@example",
+						MaxPools:  8,
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool policy without principal",
+			"pool policy set --max-pools 8",
+			"",
+			errors.New("one of --user or --group"),
+		},
+		{
+			"Set pool policy with user and group",
+			"pool policy set --user alice --group hpc --max-pools 8",
+			"",
+			errors.New("may not be combined"),
+		},
+		{
+			"List pool policies",
+			"pool policy list",
+			strings.Join([]string{
+				printRequest(t, &control.PoolListPoliciesReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"Remove pool policy",
+			"pool policy remove -g hpc",
+			strings.Join([]string{
+				printRequest(t, &control.PoolRemovePolicyReq{
+					Type:      "group",
+					Principal: "hpc@",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"List pool templates",
+			"pool template list",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAttrReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"Import pool templates",
+			fmt.Sprintf("pool template import %s", testTemplateFile),
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetAttrReq{
+					Attributes: map[string]string{
+						control.PoolTemplateAttrPrefix + "small": `{"scm_size":"512GiB","ranks":"0-3","nsvc":3,` +
+							`"properties":"scrub:timed","acl":["A::OWNER@:rw","A:G:GROUP@:rw"]}`,
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Delete unknown pool template",
+			"pool template delete small",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAttrReq{}),
+			}, " "),
+			errors.New(`pool template "small" not found`),
+		},
 		// Exclude testing with multiple ranks is verified at the control API layer.
 		{
 			"Exclude a target with single target idx",
@@ -789,6 +1066,19 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Evict pool with filters",
+			"pool evict 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --user alice --group users --older-than 2h",
+			strings.Join([]string{
+				printRequest(t, &control.PoolEvictReq{
+					ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					User:      "alice",
+					Group:     "users",
+					OlderThan: 2 * time.Hour,
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"List pools",
 			"pool list",
@@ -914,6 +1204,22 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Get pool ACL in YAML format",
+			"pool get-acl 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --format yaml",
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetACLReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Get pool ACL in unknown format",
+			"pool get-acl 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --format xml",
+			"",
+			errors.New("Invalid value `xml'"),
+		},
 		{
 			"Get pool ACL with output to bad file",
 			"pool get-acl 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --outfile /foo/bar/acl.txt",
@@ -1003,13 +1309,13 @@ func TestPoolCommands(t *testing.T) {
 			"Update pool ACL without file or entry",
 			"pool update-acl 12345678-1234-1234-1234-1234567890ab",
 			"",
-			dmgTestErr("either ACL file or entry parameter is required"),
+			dmgTestErr("exactly one of ACL file, structured ACL file or entry parameter is required"),
 		},
 		{
 			"Update pool ACL with both file and entry",
 			fmt.Sprintf("pool update-acl 12345678-1234-1234-1234-1234567890ab --acl-file %s --entry A::user@:rw", testACLFile),
 			"",
-			dmgTestErr("either ACL file or entry parameter is required"),
+			dmgTestErr("exactly one of ACL file, structured ACL file or entry parameter is required"),
 		},
 		{
 			"Update pool ACL with ACL file",
@@ -1022,6 +1328,39 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Update pool ACL with both file and structured file",
+			fmt.Sprintf("pool update-acl 12345678-1234-1234-1234-1234567890ab --acl-file %s --from-file %s", testACLFile, testStructuredACLFile),
+			"",
+			dmgTestErr("exactly one of ACL file, structured ACL file or entry parameter is required"),
+		},
+		{
+			"Update pool ACL with invalid structured ACL file",
+			fmt.Sprintf("pool update-acl 12345678-1234-1234-1234-1234567890ab --from-file %s", testACLFile),
+			"",
+			dmgTestErr("parsing structured ACL"),
+		},
+		{
+			"Update pool ACL with structured ACL file",
+			fmt.Sprintf("pool update-acl 12345678-1234-1234-1234-1234567890ab --from-file %s", testStructuredACLFile),
+			strings.Join([]string{
+				printRequest(t, &control.PoolUpdateACLReq{
+					ID:  "12345678-1234-1234-1234-1234567890ab",
+					ACL: testACL,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Update pool ACL dry run",
+			fmt.Sprintf("pool update-acl 12345678-1234-1234-1234-1234567890ab --from-file %s --dry-run", testStructuredACLFile),
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetACLReq{
+					ID: "12345678-1234-1234-1234-1234567890ab",
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Update pool ACL with entry",
 			"pool update-acl 12345678-1234-1234-1234-1234567890ab --entry A::user@:rw",
@@ -1050,17 +1389,88 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Validate ACL file",
+			fmt.Sprintf("pool validate-acl --file %s", testACLFile),
+			"",
+			nil,
+		},
+		{
+			"Validate ACL file with invalid entries",
+			fmt.Sprintf("pool validate-acl --file %s", testInvalidACLFile),
+			"",
+			dmgTestErr(fmt.Sprintf("ACL file %s is not valid", testInvalidACLFile)),
+		},
+		{
+			"Validate empty ACL file",
+			fmt.Sprintf("pool validate-acl --file %s", testEmptyFile),
+			"",
+			dmgTestErr(fmt.Sprintf("ACL file '%s': no entries found", testEmptyFile)),
+		},
+		{
+			"Validate missing ACL file",
+			"pool validate-acl --file /not/a/real/file",
+			"",
+			dmgTestErr("opening ACL file: open /not/a/real/file: no such file or directory"),
+		},
+		{
+			"Validate ACL file without file",
+			"pool validate-acl",
+			"",
+			errMissingFlag,
+		},
 		{
 			"Query pool targets no arguments",
 			"pool query-targets mypool",
 			"",
-			errMissingFlag,
+			errors.New("either --rank or --all-ranks"),
 		},
 		{
 			"Query pool targets no rank argument",
 			"pool query-targets mypool --target-idx=1",
 			"",
-			errMissingFlag,
+			errors.New("either --rank or --all-ranks"),
+		},
+		{
+			"Query pool targets all ranks",
+			"pool query-targets mypool --all-ranks",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryTargetReq{
+					ID:       "mypool",
+					AllRanks: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query pool targets all ranks; unhealthy only",
+			"pool query-targets mypool --all-ranks --unhealthy-only",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryTargetReq{
+					ID:            "mypool",
+					AllRanks:      true,
+					UnhealthyOnly: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query pool targets all ranks with rank",
+			"pool query-targets mypool --all-ranks --rank=1",
+			"",
+			errors.New("may not be combined"),
+		},
+		{
+			"Query pool targets all ranks with target indices",
+			"pool query-targets mypool --all-ranks --target-idx=1",
+			"",
+			errors.New("may not be combined"),
+		},
+		{
+			"Query pool targets unhealthy only without all ranks",
+			"pool query-targets mypool --rank=1 --unhealthy-only",
+			"",
+			errors.New("requires --all-ranks"),
 		},
 		{
 			"Query pool targets specific indices",
@@ -1140,6 +1550,18 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Query pool aggregation stats; no engines in system",
+			"pool query --aggregation test_label",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID:        "test_label",
+					QueryMask: daos.DefaultPoolQueryMask,
+				}),
+				printRequest(t, &control.SystemQueryReq{}),
+			}, " "),
+			errors.New("no engine hosts found"),
+		},
 		{
 			"Query pool with empty ID",
 			"pool query \"\"",
@@ -1156,6 +1578,117 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Rebalance pool dry run",
+			"pool rebalance mypool --dry-run",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID:        "mypool",
+					QueryMask: setQueryMask(func(qm *daos.PoolQueryMask) { qm.SetOptions(daos.PoolQueryOptionEnabledEngines) }),
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Rebalance pool",
+			"pool rebalance mypool",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID:        "mypool",
+					QueryMask: setQueryMask(func(qm *daos.PoolQueryMask) { qm.SetOptions(daos.PoolQueryOptionEnabledEngines) }),
+				}),
+				printRequest(t, &control.PoolRebalanceReq{
+					ID: "mypool",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Rebalance pool and wait; no rebuild status",
+			"pool rebalance mypool --wait",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID:        "mypool",
+					QueryMask: setQueryMask(func(qm *daos.PoolQueryMask) { qm.SetOptions(daos.PoolQueryOptionEnabledEngines) }),
+				}),
+				printRequest(t, &control.PoolRebalanceReq{
+					ID: "mypool",
+				}),
+				printRequest(t, &control.PoolQueryReq{
+					ID: "mypool",
+					QueryMask: setQueryMask(func(qm *daos.PoolQueryMask) {
+						qm.ClearAll()
+						qm.SetOptions(daos.PoolQueryOptionRebuild)
+					}),
+				}),
+			}, " "),
+			errors.New("no rebuild status"),
+		},
+		{
+			"Rename pool label",
+			"pool rename-label oldlabel newlabel",
+			printRequest(t, &control.PoolRenameLabelReq{
+				ID:       "oldlabel",
+				NewLabel: "newlabel",
+			}),
+			nil,
+		},
+		{
+			"Rename pool label by UUID",
+			"pool rename-label 031bcaf8-f0f5-42ef-b3c5-ee048676dceb newlabel",
+			printRequest(t, &control.PoolRenameLabelReq{
+				ID:       "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+				NewLabel: "newlabel",
+			}),
+			nil,
+		},
+		{
+			"Rename pool label; missing new label",
+			"pool rename-label oldlabel",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Rename pool",
+			"pool rename oldlabel newlabel",
+			printRequest(t, &control.PoolRenameLabelReq{
+				ID:       "oldlabel",
+				NewLabel: "newlabel",
+			}),
+			nil,
+		},
+		{
+			"Add pool aliases",
+			"pool alias add mypool alias1 alias2",
+			printRequest(t, &control.PoolUpdateAliasesReq{
+				ID:  "mypool",
+				Add: []string{"alias1", "alias2"},
+			}),
+			nil,
+		},
+		{
+			"Add pool alias; missing alias",
+			"pool alias add mypool",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Remove pool alias",
+			"pool alias rm mypool alias1",
+			printRequest(t, &control.PoolUpdateAliasesReq{
+				ID:     "mypool",
+				Remove: []string{"alias1"},
+			}),
+			nil,
+		},
+		{
+			"List pool aliases",
+			"pool alias list mypool",
+			printRequest(t, &control.PoolUpdateAliasesReq{
+				ID: "mypool",
+			}),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"pool quack",
@@ -1289,3 +1822,321 @@ func TestDmg_PoolListCmd_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestDmg_PoolUpgradeCmd_All(t *testing.T) {
+	listResp := func(n int) *mgmtpb.ListPoolsResp {
+		resp := new(mgmtpb.ListPoolsResp)
+		for i := 1; i <= n; i++ {
+			resp.Pools = append(resp.Pools, &mgmtpb.ListPoolsResp_Pool{
+				Uuid:    test.MockUUID(int32(i)),
+				Label:   fmt.Sprintf("pool%d", i),
+				SvcReps: []uint32{0},
+				State:   daos.PoolServiceStateReady.String(),
+			})
+		}
+		return resp
+	}
+	queryResp := func(i int, from, to uint32) *control.UnaryResponse {
+		return control.MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid:             test.MockUUID(int32(i)),
+			Label:            fmt.Sprintf("pool%d", i),
+			TierStats:        []*mgmtpb.StorageUsageStats{{}},
+			PoolLayoutVer:    from,
+			UpgradeLayoutVer: to,
+		})
+	}
+	upgradeOK := control.MockMSResponse("host1", nil, &mgmtpb.PoolUpgradeResp{})
+	upgradeFail := control.MockMSResponse("host1", errors.New("upgrade failed"), nil)
+
+	for name, tc := range map[string]struct {
+		cmd         *poolUpgradeCmd
+		responses   []*control.UnaryResponse
+		expUpgrades []string
+		expOut      string
+		expErr      error
+	}{
+		"no pools need upgrade": {
+			cmd: &poolUpgradeCmd{All: true, BatchSize: 1},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, listResp(1)),
+				queryResp(1, 2, 2),
+			},
+			expOut: "No pools need to be upgraded",
+		},
+		"upgrade in batches": {
+			cmd: &poolUpgradeCmd{All: true, BatchSize: 2},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, listResp(3)),
+				queryResp(1, 1, 2),
+				queryResp(2, 2, 2),
+				queryResp(3, 1, 2),
+				upgradeOK,
+				upgradeOK,
+			},
+			expUpgrades: []string{test.MockUUID(1), test.MockUUID(3)},
+			expOut:      "[2/2] pool pool3 (1->2): upgraded",
+		},
+		"failure continues": {
+			cmd: &poolUpgradeCmd{All: true, BatchSize: 1},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, listResp(2)),
+				queryResp(1, 1, 2),
+				queryResp(2, 1, 2),
+				upgradeFail,
+				upgradeOK,
+			},
+			expUpgrades: []string{test.MockUUID(1), test.MockUUID(2)},
+			expOut:      "[1/2] pool pool1 (1->2): failed",
+			expErr:      errors.New("1 of 2 pool upgrades failed"),
+		},
+		"pause on error": {
+			cmd: &poolUpgradeCmd{All: true, BatchSize: 1, PauseOnError: true},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, listResp(2)),
+				queryResp(1, 1, 2),
+				queryResp(2, 1, 2),
+				upgradeFail,
+			},
+			expUpgrades: []string{test.MockUUID(1)},
+			expErr:      errors.New("1 pool upgrade failed, paused with 1 pool remaining"),
+		},
+		"zero batch size": {
+			cmd:    &poolUpgradeCmd{All: true},
+			expErr: errors.New("--batch-size must be greater than 0"),
+		},
+		"no pool without all": {
+			cmd:    &poolUpgradeCmd{},
+			expErr: errors.New("pool label or UUID is required"),
+		},
+		"pause on error without all": {
+			cmd: func() *poolUpgradeCmd {
+				cmd := &poolUpgradeCmd{PauseOnError: true}
+				cmd.Args.Pool.Label = "pool1"
+				return cmd
+			}(),
+			expErr: errors.New("--pause-on-error may only be used with --all"),
+		},
+		"pool with all": {
+			cmd: func() *poolUpgradeCmd {
+				cmd := &poolUpgradeCmd{All: true, BatchSize: 1}
+				cmd.Args.Pool.Label = "pool1"
+				return cmd
+			}(),
+			expErr: errors.New("may not be specified with --all"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+			})
+
+			cmd := tc.cmd
+			cmd.setInvoker(mi)
+			cmd.SetLog(log)
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			var gotUpgrades []string
+			for _, req := range mi.SentReqs {
+				if ur, ok := req.(*control.PoolUpgradeReq); ok {
+					gotUpgrades = append(gotUpgrades, ur.ID)
+				}
+			}
+			sort.Strings(gotUpgrades)
+			if diff := cmp.Diff(tc.expUpgrades, gotUpgrades); diff != "" {
+				t.Fatalf("unexpected pool upgrades (-want, +got):\n%s\n", diff)
+			}
+
+			if !strings.Contains(buf.String(), tc.expOut) {
+				t.Fatalf("expected %q in output", tc.expOut)
+			}
+		})
+	}
+}
+
+func TestDmg_PoolDestroyCmd_Interlocks(t *testing.T) {
+	queryResp := func(label string, total uint64) *control.UnaryResponse {
+		return control.MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid:      test.MockUUID(1),
+			Label:     label,
+			TierStats: []*mgmtpb.StorageUsageStats{{Total: total}},
+		})
+	}
+	queryFail := control.MockMSResponse("host1", errors.New("query failed"), nil)
+	listResp := control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{
+				Uuid:    test.MockUUID(1),
+				Label:   "prod",
+				SvcReps: []uint32{0},
+				State:   daos.PoolServiceStateReady.String(),
+			},
+		},
+	})
+	destroyOK := control.MockMSResponse("host1", nil, &mgmtpb.PoolDestroyResp{})
+
+	for name, tc := range map[string]struct {
+		threshold  string
+		labelMatch string
+		input      string
+		responses  []*control.UnaryResponse
+		expPrompt  bool
+		expDestroy bool
+		expErr     error
+	}{
+		"no interlocks": {
+			responses:  []*control.UnaryResponse{destroyOK},
+			expDestroy: true,
+		},
+		"bad threshold": {
+			threshold: "lots",
+			expErr:    errors.New("invalid pool destroy confirm threshold"),
+		},
+		"label match": {
+			labelMatch: "prod",
+			responses:  []*control.UnaryResponse{queryResp("prod", humanize.TByte), destroyOK},
+			expDestroy: true,
+		},
+		"label mismatch": {
+			labelMatch: "test",
+			responses:  []*control.UnaryResponse{queryResp("prod", humanize.TByte)},
+			expErr:     errors.New("does not match --force-label-match"),
+		},
+		"label match skips prompt": {
+			threshold:  "1TB",
+			labelMatch: "prod",
+			responses:  []*control.UnaryResponse{queryResp("prod", humanize.TByte), destroyOK},
+			expDestroy: true,
+		},
+		"below threshold": {
+			threshold:  "1TB",
+			responses:  []*control.UnaryResponse{queryResp("prod", humanize.GByte), destroyOK},
+			expDestroy: true,
+		},
+		"above threshold; confirmed": {
+			threshold:  "1TB",
+			input:      "prod\n",
+			responses:  []*control.UnaryResponse{queryResp("prod", humanize.TByte), destroyOK},
+			expPrompt:  true,
+			expDestroy: true,
+		},
+		"above threshold; wrong label": {
+			threshold: "1TB",
+			input:     "prdo\n",
+			responses: []*control.UnaryResponse{queryResp("prod", humanize.TByte)},
+			expPrompt: true,
+			expErr:    errors.New("confirmation did not match"),
+		},
+		"above threshold; no input": {
+			threshold: "1TB",
+			responses: []*control.UnaryResponse{queryResp("prod", humanize.TByte)},
+			expPrompt: true,
+			expErr:    errors.New("confirmation did not match"),
+		},
+		"unlabeled pool; confirmed by uuid": {
+			threshold:  "1TB",
+			input:      test.MockUUID(1) + "\n",
+			responses:  []*control.UnaryResponse{queryResp("", humanize.TByte), destroyOK},
+			expPrompt:  true,
+			expDestroy: true,
+		},
+		"query fails; size unknown": {
+			threshold:  "1TB",
+			input:      "prod\n",
+			responses:  []*control.UnaryResponse{queryFail, listResp, destroyOK},
+			expPrompt:  true,
+			expDestroy: true,
+		},
+		"query fails; label match": {
+			labelMatch: "prod",
+			responses:  []*control.UnaryResponse{queryFail, listResp, destroyOK},
+			expDestroy: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+			})
+
+			var prompt strings.Builder
+			cmd := &poolDestroyCmd{
+				ForceLabelMatch: tc.labelMatch,
+				confirmIn:       strings.NewReader(tc.input),
+				confirmOut:      &prompt,
+			}
+			cmd.Args.Pool.UUID = test.MockPoolUUID(1)
+			cmd.setInvoker(mi)
+			cmd.SetLog(log)
+			cmd.setConfig(&control.Config{PoolDestroyConfirmThreshold: tc.threshold})
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			var gotDestroy bool
+			for _, req := range mi.SentReqs {
+				if _, ok := req.(*control.PoolDestroyReq); ok {
+					gotDestroy = true
+				}
+			}
+			test.AssertEqual(t, tc.expDestroy, gotDestroy, "unexpected pool destroy")
+			test.AssertEqual(t, tc.expPrompt, prompt.Len() > 0, "unexpected confirmation prompt")
+		})
+	}
+}
+
+func TestDmg_PoolID_Complete(t *testing.T) {
+	for name, tc := range map[string]struct {
+		labels   []string
+		listErr  error
+		match    string
+		expComps []flags.Completion
+	}{
+		"MS unreachable": {
+			listErr: errors.New("connection refused"),
+		},
+		"no pools": {},
+		"all": {
+			labels: []string{"tank", "scratch", "tank2"},
+			expComps: []flags.Completion{
+				{Item: "scratch"},
+				{Item: "tank"},
+				{Item: "tank2"},
+			},
+		},
+		"prefix": {
+			labels: []string{"tank", "scratch", "tank2"},
+			match:  "ta",
+			expComps: []flags.Completion{
+				{Item: "tank"},
+				{Item: "tank2"},
+			},
+		},
+		"no match": {
+			labels: []string{"tank", "scratch"},
+			match:  "x",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			orig := listPoolLabels
+			defer func() { listPoolLabels = orig }()
+			listPoolLabels = func(ctx context.Context) ([]string, error) {
+				if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+					t.Fatal("expected completion to be bounded by a timeout")
+				}
+				return tc.labels, tc.listErr
+			}
+
+			id := new(PoolID)
+			if diff := cmp.Diff(tc.expComps, id.Complete(tc.match)); diff != "" {
+				t.Fatalf("unexpected completions (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
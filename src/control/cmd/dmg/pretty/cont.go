@@ -0,0 +1,71 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+const msgNoContainers = "No containers in pool"
+
+// PrintListContainersResponse generates a human-readable representation of the
+// supplied ListContainersResp struct and writes it to the supplied io.Writer.
+func PrintListContainersResponse(out io.Writer, resp *control.ListContainersResp) error {
+	if resp == nil {
+		return errors.New("nil response")
+	}
+
+	if len(resp.Containers) == 0 {
+		_, err := fmt.Fprintln(out, msgNoContainers)
+		return err
+	}
+
+	uuidTitle := "UUID"
+	labelTitle := "Label"
+	table := make([]txtfmt.TableRow, 0, len(resp.Containers))
+	for _, cont := range resp.Containers {
+		if cont == nil {
+			continue
+		}
+		table = append(table, txtfmt.TableRow{
+			uuidTitle:  cont.UUID,
+			labelTitle: cont.Label,
+		})
+	}
+
+	tf := txtfmt.NewTableFormatter(uuidTitle, labelTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+
+	return nil
+}
+
+// PrintContQueryResponse generates a human-readable representation of the
+// supplied ContQueryResp struct and writes it to the supplied io.Writer.
+func PrintContQueryResponse(out io.Writer, resp *control.ContQueryResp, opts ...PrintConfigOption) error {
+	if resp == nil {
+		return errors.New("nil response")
+	}
+
+	rows := []txtfmt.TableRow{
+		{"Container UUID": resp.UUID},
+		{"Container Label": resp.Label},
+		{"Container Type": resp.LayoutType},
+		{"Owner User": resp.OwnerUser},
+		{"Owner Group": resp.OwnerGroup},
+		{"Open Handles": fmt.Sprintf("%d", resp.NumHandles)},
+		{"Snapshots": fmt.Sprintf("%d", resp.NumSnapshots)},
+	}
+
+	return printEntity(out, "", rows, opts...)
+}
@@ -0,0 +1,139 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+func TestPretty_PrintListContainersResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.ListContainersResp
+		expErr      error
+		expPrintStr string
+	}{
+		"nil response": {
+			expErr: errors.New("nil response"),
+		},
+		"no containers": {
+			resp: &control.ListContainersResp{},
+			expPrintStr: `
+No containers in pool
+`,
+		},
+		"multiple containers": {
+			resp: &control.ListContainersResp{
+				Containers: []*control.ContainerInfo{
+					{UUID: test.MockUUID(1), Label: "cont1"},
+					{UUID: test.MockUUID(2)},
+				},
+			},
+			expPrintStr: `
+UUID                                 Label 
+----                                 ----- 
+00000001-0001-0001-0001-000000000001 cont1 
+00000002-0002-0002-0002-000000000002       
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintListContainersResponse(&bld, tc.resp)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintContQueryResponse(t *testing.T) {
+	mockResp := &control.ContQueryResp{
+		UUID:         test.MockUUID(1),
+		Label:        "cont1",
+		OwnerUser:    "someuser@",
+		OwnerGroup:   "somegroup@",
+		LayoutType:   "POSIX",
+		NumHandles:   2,
+		NumSnapshots: 1,
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.ContQueryResp
+		opts        []PrintConfigOption
+		expErr      error
+		expPrintStr string
+	}{
+		"nil response": {
+			expErr: errors.New("nil response"),
+		},
+		"success": {
+			resp: mockResp,
+			expPrintStr: `
+  Container UUID : 00000001-0001-0001-0001-000000000001
+  Container Label: cont1                               
+  Container Type : POSIX                               
+  Owner User     : someuser@                           
+  Owner Group    : somegroup@                          
+  Open Handles   : 2                                   
+  Snapshots      : 1                                   
+
+`,
+		},
+		"key-value": {
+			resp: mockResp,
+			opts: []PrintConfigOption{PrintWithEntityFormat(txtfmt.EntityFormatKeyValue)},
+			expPrintStr: `
+container_uuid=00000001-0001-0001-0001-000000000001
+container_label=cont1
+container_type=POSIX
+owner_user=someuser@
+owner_group=somegroup@
+open_handles=2
+snapshots=1
+`,
+		},
+		"yaml": {
+			resp: mockResp,
+			opts: []PrintConfigOption{PrintWithEntityFormat(txtfmt.EntityFormatYAML)},
+			expPrintStr: `
+container_uuid: 00000001-0001-0001-0001-000000000001
+container_label: cont1
+container_type: POSIX
+owner_user: someuser@
+owner_group: somegroup@
+open_handles: "2"
+snapshots: "1"
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintContQueryResponse(&bld, tc.resp, tc.opts...)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/daos-stack/daos/src/control/lib/estimate"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// FormatSizeArg formats a byte size as a pool size argument, rounding up to
+// one decimal place so that the formatted size is never less than the
+// supplied size.
+func FormatSizeArg(size uint64) string {
+	for _, unit := range []struct {
+		name  string
+		bytes uint64
+	}{
+		{"TiB", humanize.TiByte},
+		{"GiB", humanize.GiByte},
+		{"MiB", humanize.MiByte},
+		{"KiB", humanize.KiByte},
+	} {
+		if size >= unit.bytes {
+			tenths := (size*10 + unit.bytes - 1) / unit.bytes
+			return fmt.Sprintf("%d.%d%s", tenths/10, tenths%10, unit.name)
+		}
+	}
+
+	return fmt.Sprintf("%dB", size)
+}
+
+// EstimatePoolCreateArgs returns the pool create arguments recommended by
+// the estimate. The mem-ratio argument is only included for MD-on-SSD pools
+// and if less than 100% of the metadata tier needs to be held in memory.
+func EstimatePoolCreateArgs(est *estimate.Estimate, headroom uint64, mdOnSSD bool) string {
+	meta, data := est.TierRatio()
+	args := []string{
+		"--size=" + FormatSizeArg(est.PoolSize(headroom)),
+		fmt.Sprintf("--tier-ratio=%d,%d", meta, data),
+	}
+	if mdOnSSD {
+		if memRatio := est.MemRatio(); memRatio < 100 {
+			args = append(args, fmt.Sprintf("--mem-ratio=%d", memRatio))
+		}
+	}
+
+	return strings.Join(args, " ")
+}
+
+// PrintStorageEstimate generates a human-readable representation of the
+// supplied storage estimate, including the recommended pool size with the
+// given percentage of headroom, and writes it to the supplied io.Writer.
+func PrintStorageEstimate(out io.Writer, est *estimate.Estimate, headroom uint64, opts ...PrintConfigOption) error {
+	if est == nil {
+		return errors.New("nil estimate")
+	}
+
+	meta, data := est.TierRatio()
+	rows := []txtfmt.TableRow{
+		{"Metadata": humanize.Bytes(est.MetadataBytes)},
+		{"Small Values": humanize.Bytes(est.SmallValueBytes)},
+		{"Bulk Data": humanize.Bytes(est.DataBytes)},
+		{"Total": humanize.Bytes(est.TotalBytes())},
+		{"Recommended Size": fmt.Sprintf("%s (%d%% headroom)",
+			humanize.Bytes(est.PoolSize(headroom)), headroom)},
+		{"Tier Ratio": fmt.Sprintf("%d,%d", meta, data)},
+		{"Mem Ratio (MD-on-SSD)": fmt.Sprintf("%d%%", est.MemRatio())},
+	}
+
+	title := fmt.Sprintf("Storage estimate (%s backend)", est.Backend)
+	return printEntity(out, title, rows, opts...)
+}
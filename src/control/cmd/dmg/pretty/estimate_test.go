@@ -0,0 +1,94 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/estimate"
+)
+
+func TestPretty_FormatSizeArg(t *testing.T) {
+	for size, exp := range map[uint64]string{
+		0:                  "0B",
+		1000:               "1000B",
+		1024:               "1.0KiB",
+		1025:               "1.1KiB",
+		3 << 30:            "3.0GiB",
+		(5 << 40) + 1<<30:  "5.1TiB",
+		(10 << 20) + 1<<19: "10.5MiB",
+	} {
+		test.AssertEqual(t, exp, FormatSizeArg(size), "unexpected size argument")
+	}
+}
+
+func TestPretty_PrintStorageEstimate(t *testing.T) {
+	est := &estimate.Estimate{
+		Backend:         "vos",
+		MetadataBytes:   250000000,
+		SmallValueBytes: 750000000,
+		DataBytes:       9000000000,
+	}
+
+	for name, tc := range map[string]struct {
+		est         *estimate.Estimate
+		mdOnSSD     bool
+		expErr      error
+		expArgs     string
+		expPrintStr string
+	}{
+		"nil estimate": {
+			expErr: errors.New("nil estimate"),
+		},
+		"estimate": {
+			est:     est,
+			expArgs: "--size=11.2GiB --tier-ratio=10,90",
+			expPrintStr: `
+Storage estimate (vos backend)
+------------------------------
+  Metadata              : 250 MB                
+  Small Values          : 750 MB                
+  Bulk Data             : 9.0 GB                
+  Total                 : 10 GB                 
+  Recommended Size      : 12 GB (20% headroom)  
+  Tier Ratio            : 10,90                 
+  Mem Ratio (MD-on-SSD) : 25%                   
+
+`,
+		},
+		"md-on-ssd": {
+			est:     est,
+			mdOnSSD: true,
+			expArgs: "--size=11.2GiB --tier-ratio=10,90 --mem-ratio=25",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintStorageEstimate(&bld, tc.est, estimate.DefaultHeadroom)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expArgs,
+				EstimatePoolCreateArgs(tc.est, estimate.DefaultHeadroom, tc.mdOnSSD),
+				"unexpected pool create args")
+
+			if tc.expPrintStr == "" {
+				return
+			}
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// PrintOpLocksResponse generates a human-readable representation of the
+// supplied SystemOpLocksResp struct and writes it to the supplied io.Writer.
+func PrintOpLocksResponse(out io.Writer, resp *control.SystemOpLocksResp) {
+	if len(resp.Locks) == 0 {
+		fmt.Fprintln(out, "No operations in progress")
+		return
+	}
+
+	resTitle := "Resource"
+	opTitle := "Operation"
+	userTitle := "User"
+	sinceTitle := "Since"
+	formatter := txtfmt.NewTableFormatter(resTitle, opTitle, userTitle, sinceTitle)
+
+	var table []txtfmt.TableRow
+	for _, lock := range resp.Locks {
+		row := txtfmt.TableRow{
+			resTitle:   "system",
+			opTitle:    lock.Operation,
+			userTitle:  lock.User,
+			sinceTitle: lock.Since,
+		}
+		switch {
+		case lock.PoolLabel != "":
+			row[resTitle] = "pool " + lock.PoolLabel
+		case lock.PoolUUID != "":
+			row[resTitle] = "pool " + lock.PoolUUID
+		}
+		if lock.Operation == "" {
+			row[opTitle] = "-"
+		}
+		if lock.User == "" {
+			row[userTitle] = "-"
+		}
+		if ts, err := common.ParseTime(lock.Since); err == nil {
+			row[sinceTitle] = ts.Local().Format(time.DateTime)
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
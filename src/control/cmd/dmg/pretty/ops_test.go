@@ -0,0 +1,83 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintOpLocksResponse(t *testing.T) {
+	ts1 := "2025-01-02T03:04:05.000000+00:00"
+	ts2 := "2025-01-02T04:04:05.000000+00:00"
+	localTime := func(ts string) string {
+		t, err := common.ParseTime(ts)
+		if err != nil {
+			panic(err)
+		}
+		return t.Local().Format(time.DateTime)
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemOpLocksResp
+		expPrintStr string
+	}{
+		"no locks": {
+			resp: &control.SystemOpLocksResp{},
+			expPrintStr: `
+No operations in progress
+`,
+		},
+		"locks": {
+			resp: &control.SystemOpLocksResp{
+				Locks: []*control.OpLock{
+					{
+						PoolUUID:  test.MockUUID(1),
+						PoolLabel: "pool1",
+						Operation: "PoolExtend",
+						User:      "admin@host1",
+						Since:     ts1,
+					},
+					{
+						PoolUUID: test.MockUUID(2),
+						Since:    ts1,
+					},
+					{
+						Operation: "SystemStop",
+						User:      "admin@host2",
+						Since:     ts2,
+					},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Resource                                  Operation  User        Since               
+--------                                  ---------  ----        -----               
+pool pool1                                PoolExtend admin@host1 %[1]s 
+pool 00000002-0002-0002-0002-000000000002 -          -           %[1]s 
+system                                    SystemStop admin@host2 %[2]s 
+
+`, localTime(ts1), localTime(ts2)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintOpLocksResponse(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
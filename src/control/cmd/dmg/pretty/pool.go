@@ -10,8 +10,11 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 
 	pretty "github.com/daos-stack/daos/src/control/cmd/daos/pretty"
@@ -46,6 +49,113 @@ func PrintPoolQueryTargetResponse(pqtr *control.PoolQueryTargetResp, out io.Writ
 	return nil
 }
 
+// poolTargetStateOrder defines the order of the target state columns in the
+// pool target health matrix.
+var poolTargetStateOrder = []daos.PoolQueryTargetState{
+	daos.PoolTargetStateUpIn,
+	daos.PoolTargetStateUp,
+	daos.PoolTargetStateNew,
+	daos.PoolTargetStateDrain,
+	daos.PoolTargetStateDown,
+	daos.PoolTargetStateDownOut,
+	daos.PoolTargetStateUnknown,
+}
+
+// PrintPoolQueryTargetRanks generates a table showing the number of targets in
+// each state on the ranks of a pool, along with the indices of any targets that
+// are not up_in, and writes it to the supplied io.Writer. Ranks with identical
+// target states are grouped into a single row.
+func PrintPoolQueryTargetRanks(pqtr *control.PoolQueryTargetResp, out io.Writer) error {
+	if pqtr == nil {
+		return errors.Errorf("nil %T", pqtr)
+	}
+
+	if len(pqtr.Ranks) == 0 {
+		fmt.Fprintln(out, "No pool ranks to display")
+		return nil
+	}
+
+	type rankGroup struct {
+		ranks     *ranklist.RankSet
+		targets   int
+		counts    map[daos.PoolQueryTargetState]int
+		unhealthy string
+	}
+
+	var groups []*rankGroup
+	groupIdx := make(map[string]*rankGroup)
+	shownStates := make(map[daos.PoolQueryTargetState]bool)
+	var failed []*control.PoolRankTargets
+
+	for _, rt := range pqtr.Ranks {
+		if rt.Status != 0 {
+			failed = append(failed, rt)
+			continue
+		}
+
+		counts := make(map[daos.PoolQueryTargetState]int)
+		var unhealthy []ranklist.Rank
+		for idx, state := range rt.States {
+			counts[state]++
+			shownStates[state] = true
+			if state != daos.PoolTargetStateUpIn {
+				unhealthy = append(unhealthy, ranklist.Rank(idx))
+			}
+		}
+		unhealthyStr := "-"
+		if len(unhealthy) > 0 {
+			unhealthyStr = ranklist.RankSetFromRanks(unhealthy).String()
+		}
+
+		key := fmt.Sprintf("%v/%s", counts, unhealthyStr)
+		if group, found := groupIdx[key]; found {
+			group.ranks.Add(rt.Rank)
+			continue
+		}
+		group := &rankGroup{
+			ranks:     ranklist.RankSetFromRanks([]ranklist.Rank{rt.Rank}),
+			targets:   len(rt.States),
+			counts:    counts,
+			unhealthy: unhealthyStr,
+		}
+		groupIdx[key] = group
+		groups = append(groups, group)
+	}
+
+	if len(groups) > 0 {
+		titles := []string{"Ranks", "Targets"}
+		var states []daos.PoolQueryTargetState
+		for _, state := range poolTargetStateOrder {
+			if shownStates[state] {
+				states = append(states, state)
+				titles = append(titles, state.String())
+			}
+		}
+		titles = append(titles, "Unhealthy Targets")
+
+		var table []txtfmt.TableRow
+		for _, group := range groups {
+			row := txtfmt.TableRow{
+				"Ranks":             group.ranks.String(),
+				"Targets":           fmt.Sprintf("%d", group.targets),
+				"Unhealthy Targets": group.unhealthy,
+			}
+			for _, state := range states {
+				row[state.String()] = fmt.Sprintf("%d", group.counts[state])
+			}
+			table = append(table, row)
+		}
+
+		fmt.Fprint(out, txtfmt.NewTableFormatter(titles...).Format(table))
+	}
+
+	for _, rt := range failed {
+		fmt.Fprintf(out, "Failed to query targets on rank %d: %s\n", rt.Rank, daos.Status(rt.Status))
+	}
+
+	return nil
+}
+
 // PrintTierRatio generates a human-readable representation of the supplied
 // tier ratio.
 func PrintTierRatio(ratio float64) string {
@@ -134,6 +244,14 @@ func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ..
 	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Service Leader": fmt.Sprintf("%d", pcr.Leader)})
 	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Service Ranks": pretty.PrintRanks(pcr.SvcReps)})
 	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Storage Ranks": pretty.PrintRanks(pcr.TgtRanks)})
+	if pcr.PlacementPolicy != "" {
+		fmtArgs = append(fmtArgs, txtfmt.TableRow{"Placement Policy": pcr.PlacementPolicy})
+	}
+	if len(pcr.TgtFaultDomains) > 0 {
+		fmtArgs = append(fmtArgs, txtfmt.TableRow{
+			"Fault Domains": strings.Join(pcr.TgtFaultDomains, ","),
+		})
+	}
 	fmtArgs = append(fmtArgs, txtfmt.TableRow{
 		"Total Size": humanize.Bytes(totalSize * uint64(numRanks)),
 	})
@@ -148,8 +266,7 @@ func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ..
 	}
 	fmtArgs = append(fmtArgs, tierRows...)
 
-	_, err := fmt.Fprintln(out, txtfmt.FormatEntity(title, fmtArgs))
-	return err
+	return printEntity(out, title, fmtArgs, opts...)
 }
 
 // PrintListPoolsResponse generates a human-readable representation of the
@@ -273,3 +390,186 @@ func PrintPoolRanksResps(out io.Writer, resps ...*control.PoolRanksResp) error {
 	fmt.Fprintln(out, formatter.Format(table))
 	return nil
 }
+
+// PrintPoolRebalanceEstimate generates a table showing the estimated data movement in each
+// storage tier for a pool rebalance.
+func PrintPoolRebalanceEstimate(est *control.PoolRebalanceEstimate, out io.Writer) {
+	if est == nil || len(est.Tiers) == 0 {
+		fmt.Fprintln(out, "No pool targets available for rebalance")
+		return
+	}
+
+	titles := []string{"Tier", "Targets", "Used", "Min Used", "Max Used", "Est. Moved"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+	var table []txtfmt.TableRow
+
+	for _, tier := range est.Tiers {
+		table = append(table, txtfmt.TableRow{
+			"Tier":       tier.MediaType.String(),
+			"Targets":    fmt.Sprintf("%d", tier.Targets),
+			"Used":       humanize.IBytes(tier.UsedBytes),
+			"Min Used":   humanize.IBytes(tier.MinUsed),
+			"Max Used":   humanize.IBytes(tier.MaxUsed),
+			"Est. Moved": humanize.IBytes(tier.MovedBytes),
+		})
+	}
+
+	fmt.Fprintln(out, "Estimated data movement:")
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintPoolRebalanceProgress generates a single-line summary of the progress of a pool
+// rebalance from the supplied rebuild status.
+func PrintPoolRebalanceProgress(rs *daos.PoolRebuildStatus, out io.Writer) {
+	switch {
+	case rs == nil:
+		fmt.Fprintln(out, "Rebalance status unavailable")
+	case rs.Status != 0:
+		fmt.Fprintf(out, "Rebalance failed, status=%d\n", rs.Status)
+	case rs.TotalObjects > 0:
+		fmt.Fprintf(out, "Rebalance %s, %d/%d objs, %d recs\n", rs.State, rs.Objects,
+			rs.TotalObjects, rs.Records)
+	default:
+		fmt.Fprintf(out, "Rebalance %s, %d objs, %d recs\n", rs.State, rs.Objects,
+			rs.Records)
+	}
+}
+
+// PrintPoolRenameLabelResp generates a human-readable representation of the supplied
+// pool label rename response, including the machines with open pool handles whose
+// clients may still refer to the pool by its old label.
+func PrintPoolRenameLabelResp(resp *control.PoolRenameLabelResp, out io.Writer) {
+	if resp == nil {
+		return
+	}
+
+	fmt.Fprintf(out, "Pool %s label changed from %q to %q\n", resp.UUID, resp.OldLabel,
+		resp.NewLabel)
+
+	switch {
+	case resp.HandlesUnknown:
+		fmt.Fprintln(out, "Unable to list open pool handles; clients that looked up the "+
+			"pool by its old label may need to reconnect")
+	case len(resp.ClientMachines) > 0:
+		fmt.Fprintf(out, "%s with open pool handles may still use the old label: %s\n",
+			english.Plural(len(resp.ClientMachines), "client machine", ""),
+			strings.Join(resp.ClientMachines, ", "))
+	default:
+		fmt.Fprintln(out, "No open pool handles")
+	}
+}
+
+// PrintPoolAliases generates a human-readable representation of the aliases of
+// the pool in the supplied response.
+func PrintPoolAliases(resp *control.PoolUpdateAliasesResp, out io.Writer) {
+	if resp == nil {
+		return
+	}
+
+	if len(resp.Aliases) == 0 {
+		fmt.Fprintf(out, "Pool %s (%s) has no aliases\n", resp.Label, resp.UUID)
+		return
+	}
+	fmt.Fprintf(out, "Pool %s (%s) aliases: %s\n", resp.Label, resp.UUID,
+		strings.Join(resp.Aliases, ", "))
+}
+
+// PrintACLValidation generates a human-readable representation of the results of
+// validating an ACL file, listing any invalid entries followed by the normalized ACL
+// that would be applied.
+func PrintACLValidation(aclFile string, v *control.ACLValidation, out io.Writer, verbose bool) {
+	if v == nil {
+		return
+	}
+
+	if v.Valid() {
+		fmt.Fprintf(out, "ACL file %s is valid\n", aclFile)
+	} else {
+		fmt.Fprintf(out, "ACL file %s has %s:\n", aclFile,
+			english.Plural(len(v.Errors), "invalid entry", "invalid entries"))
+		iw := txtfmt.NewIndentWriter(out)
+		for _, e := range v.Errors {
+			fmt.Fprintf(iw, "line %d: %s\n", e.Line, e.Entry)
+			fmt.Fprintf(txtfmt.NewIndentWriter(iw), "%s\n", e.Error)
+		}
+	}
+
+	if v.ACL.Empty() {
+		return
+	}
+	fmt.Fprintln(out)
+	if v.Valid() {
+		fmt.Fprintln(out, "Normalized ACL:")
+	} else {
+		fmt.Fprintln(out, "Normalized ACL from valid entries:")
+	}
+	fmt.Fprint(out, control.FormatACL(v.ACL, verbose))
+}
+
+// PrintACLDiff generates a human-readable representation of the changes that
+// an ACL update would make to the ACL of a pool.
+func PrintACLDiff(poolID string, d *control.ACLDiff, out io.Writer) {
+	if d == nil {
+		return
+	}
+
+	if !d.HasChanges() {
+		fmt.Fprintf(out, "ACL update would make no changes to pool %s\n", poolID)
+		return
+	}
+
+	fmt.Fprintf(out, "ACL update would make the following changes to pool %s:\n", poolID)
+	iw := txtfmt.NewIndentWriter(out)
+	for _, ace := range d.Added {
+		fmt.Fprintf(iw, "+ %s\n", ace)
+	}
+	for _, chg := range d.Changed {
+		fmt.Fprintf(iw, "~ %s -> %s\n", chg.Old, chg.New)
+	}
+	if len(d.Unchanged) > 0 {
+		fmt.Fprintf(out, "%s unchanged\n",
+			english.Plural(len(d.Unchanged), "entry", "entries"))
+	}
+}
+
+func formatAggregationTime(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return common.FormatTime(t)
+}
+
+// PrintPoolAggregationStats generates a table showing the aggregation and garbage
+// collection statistics of a pool on each engine rank.
+func PrintPoolAggregationStats(resp *control.PoolAggregationQueryResp, out io.Writer) {
+	if resp == nil {
+		return
+	}
+
+	if len(resp.Ranks) == 0 {
+		fmt.Fprintf(out, "No aggregation statistics available for pool %s\n", resp.PoolUUID)
+		return
+	}
+
+	fmt.Fprintf(out, "Aggregation statistics for pool %s:\n", resp.PoolUUID)
+
+	titles := []string{"Rank", "Reclaimed", "Merged", "Failures", "Lag", "Last Run",
+		"GC Records", "GC Last Run"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+	var table []txtfmt.TableRow
+
+	for _, rs := range resp.Ranks {
+		table = append(table, txtfmt.TableRow{
+			"Rank":        rs.Rank.String(),
+			"Reclaimed":   humanize.IBytes(rs.ReclaimedBytes),
+			"Merged":      humanize.IBytes(rs.MergedBytes),
+			"Failures":    fmt.Sprintf("%d", rs.Failures),
+			"Lag":         rs.Lag.String(),
+			"Last Run":    formatAggregationTime(rs.LastRun),
+			"GC Records":  fmt.Sprintf("%d", rs.GCReclaimedRecords),
+			"GC Last Run": formatAggregationTime(rs.GCLastRun),
+		})
+	}
+
+	fmt.Fprint(out, formatter.Format(table))
+}
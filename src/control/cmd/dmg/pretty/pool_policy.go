@@ -0,0 +1,82 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+const poolPolicyDeniedPrefix = "pool create denied by policy: "
+
+// PrintPoolPolicies generates a human-readable representation of the supplied
+// pool policies and writes it to the supplied io.Writer.
+func PrintPoolPolicies(out io.Writer, policies []*control.PoolPolicy) {
+	if len(policies) == 0 {
+		fmt.Fprintln(out, "No pool policies found")
+		return
+	}
+
+	typeTitle := "Type"
+	principalTitle := "Principal"
+	poolsTitle := "Max Pools"
+	sizeTitle := "Max Size"
+	propsTitle := "Allowed Properties"
+
+	table := []txtfmt.TableRow{}
+	for _, p := range policies {
+		row := txtfmt.TableRow{
+			typeTitle:      p.Type,
+			principalTitle: p.Principal,
+			poolsTitle:     "-",
+			sizeTitle:      "-",
+			propsTitle:     "-",
+		}
+		if p.MaxPools > 0 {
+			row[poolsTitle] = fmt.Sprintf("%d", p.MaxPools)
+		}
+		if p.MaxTotalBytes > 0 {
+			row[sizeTitle] = humanize.Bytes(p.MaxTotalBytes)
+		}
+		if len(p.AllowedProperties) > 0 {
+			row[propsTitle] = strings.Join(p.AllowedProperties, ",")
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(typeTitle, principalTitle, poolsTitle, sizeTitle, propsTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
+
+// PrintPoolCreateDenied writes a human-readable explanation of a pool create
+// request that was denied by a pool policy to the supplied io.Writer. False is
+// returned, and nothing is written, if the error is not a policy denial.
+func PrintPoolCreateDenied(out io.Writer, err error, opts ...PrintConfigOption) bool {
+	if !fault.IsFaultCode(err, code.ServerPoolPolicyDenied) {
+		return false
+	}
+	f := errors.Cause(err).(*fault.Fault)
+
+	attrs := []txtfmt.TableRow{
+		{"Reason": strings.TrimPrefix(f.Description, poolPolicyDeniedPrefix)},
+	}
+	if f.Resolution != "" {
+		attrs = append(attrs, txtfmt.TableRow{"Resolution": f.Resolution})
+	}
+
+	return printEntity(out, "Pool create denied by policy", attrs, opts...) == nil
+}
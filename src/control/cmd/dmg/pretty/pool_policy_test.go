@@ -0,0 +1,115 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+func TestPretty_PrintPoolPolicies(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policies    []*control.PoolPolicy
+		expPrintStr string
+	}{
+		"no policies": {
+			expPrintStr: `
+No pool policies found
+`,
+		},
+		"policies": {
+			policies: []*control.PoolPolicy{
+				{
+					Type:              "group",
+					Principal:         "hpc@",
+					MaxTotalBytes:     1 << 40,
+					AllowedProperties: []string{"reclaim", "scrub"},
+				},
+				{
+					Type:      "user",
+					Principal: "alice@",
+					MaxPools:  2,
+				},
+			},
+			expPrintStr: `
+Type  Principal Max Pools Max Size Allowed Properties 
+----  --------- --------- -------- ------------------ 
+group hpc@      -         1.1 TB   reclaim,scrub      
+user  alice@    2         -        -                  
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolPolicies(&bld, tc.policies)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolCreateDenied(t *testing.T) {
+	denied := &fault.Fault{
+		Code:        code.ServerPoolPolicyDenied,
+		Description: "pool create denied by policy: alice@ already owns 2 of a maximum of 2 pools",
+		Resolution:  "retry within the limits of the policy",
+	}
+
+	for name, tc := range map[string]struct {
+		err         error
+		expPrinted  bool
+		expPrintStr string
+	}{
+		"other error": {
+			err: errors.New("not a denial"),
+		},
+		"other fault": {
+			err: &fault.Fault{Code: code.ServerPoolNoLabel},
+		},
+		"denied": {
+			err:        denied,
+			expPrinted: true,
+			expPrintStr: `
+# Pool create denied by policy
+reason="alice@ already owns 2 of a maximum of 2 pools"
+resolution="retry within the limits of the policy"
+`,
+		},
+		"wrapped denial": {
+			err:        errors.Wrap(denied, "pool create"),
+			expPrinted: true,
+			expPrintStr: `
+# Pool create denied by policy
+reason="alice@ already owns 2 of a maximum of 2 pools"
+resolution="retry within the limits of the policy"
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			printed := PrintPoolCreateDenied(&bld, tc.err,
+				PrintWithEntityFormat(txtfmt.EntityFormatKeyValue))
+			if printed != tc.expPrinted {
+				t.Fatalf("expected printed=%t, got %t", tc.expPrinted, printed)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
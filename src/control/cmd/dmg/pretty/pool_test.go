@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -328,6 +329,61 @@ Target: type unknown, state up_in
 	}
 }
 
+func TestPretty_PrintPoolQueryTargetRanks(t *testing.T) {
+	upIn := daos.PoolTargetStateUpIn
+	downOut := daos.PoolTargetStateDownOut
+	drain := daos.PoolTargetStateDrain
+
+	for name, tc := range map[string]struct {
+		pqtr   *control.PoolQueryTargetResp
+		expErr error
+		expOut string
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no ranks": {
+			pqtr: &control.PoolQueryTargetResp{},
+			expOut: `
+No pool ranks to display
+`,
+		},
+		"mixed target states": {
+			pqtr: &control.PoolQueryTargetResp{
+				Ranks: []*control.PoolRankTargets{
+					{Rank: 0, States: []daos.PoolQueryTargetState{upIn, upIn, upIn, upIn}},
+					{Rank: 1, States: []daos.PoolQueryTargetState{upIn, downOut, upIn, downOut}},
+					{Rank: 2, States: []daos.PoolQueryTargetState{upIn, upIn, upIn, upIn}},
+					{Rank: 3, Status: int32(daos.Nonexistent)},
+					{Rank: 4, States: []daos.PoolQueryTargetState{upIn, upIn, drain, upIn}},
+					{Rank: 5, States: []daos.PoolQueryTargetState{upIn, upIn, upIn, upIn}},
+				},
+			},
+			expOut: fmt.Sprintf(`
+Ranks Targets up_in drain down_out Unhealthy Targets 
+----- ------- ----- ----- -------- ----------------- 
+0,2,5 4       4     0     0        -                 
+1     4       2     0     2        1,3               
+4     4       3     1     0        2                 
+Failed to query targets on rank 3: %s
+`, daos.Nonexistent),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintPoolQueryTargetRanks(tc.pqtr, &bld)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func mockRanks(ranks ...uint32) []uint32 {
 	return ranks
 }
@@ -367,6 +423,33 @@ Pool created with 5.66%%,94.34%% storage tier ratio
   Storage tier 0 (SCM) : 2.4 GB (600 MB / rank)              
   Storage tier 1 (NVMe): 40 GB (10 GB / rank)                
 
+`, test.MockPoolUUID()),
+		},
+		"basic; placement": {
+			pcr: &control.PoolCreateResp{
+				UUID:     test.MockUUID(),
+				SvcReps:  mockRanks(0, 1, 2),
+				TgtRanks: mockRanks(0, 1, 2, 3),
+				TierBytes: []uint64{
+					600 * humanize.MByte,
+					10 * humanize.GByte,
+				},
+				PlacementPolicy: "spread",
+				TgtFaultDomains: []string{"/rack0/host0", "/rack1/host1"},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool created with 5.66%%,94.34%% storage tier ratio
+-------------------------------------------------
+  UUID                 : %s
+  Service Leader       : 0                                   
+  Service Ranks        : [0-2]                               
+  Storage Ranks        : [0-3]                               
+  Placement Policy     : spread                              
+  Fault Domains        : /rack0/host0,/rack1/host1           
+  Total Size           : 42 GB                               
+  Storage tier 0 (SCM) : 2.4 GB (600 MB / rank)              
+  Storage tier 1 (NVMe): 40 GB (10 GB / rank)                
+
 `, test.MockPoolUUID()),
 		},
 		"basic; MD-on-SSD": {
@@ -752,3 +835,376 @@ Pool                                 Ranks Result Reason
 		})
 	}
 }
+
+func TestPretty_PrintPoolRebalanceEstimate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		est    *control.PoolRebalanceEstimate
+		expOut string
+	}{
+		"nil estimate": {
+			expOut: `
+No pool targets available for rebalance
+`,
+		},
+		"no tiers": {
+			est: &control.PoolRebalanceEstimate{},
+			expOut: `
+No pool targets available for rebalance
+`,
+		},
+		"estimate": {
+			est: &control.PoolRebalanceEstimate{
+				Tiers: []*control.PoolRebalanceTierEstimate{
+					{
+						MediaType:  daos.StorageMediaTypeScm,
+						Targets:    4,
+						UsedBytes:  2 * humanize.GiByte,
+						MaxUsed:    humanize.GiByte,
+						MovedBytes: humanize.GiByte,
+					},
+					{
+						MediaType:  daos.StorageMediaTypeNvme,
+						Targets:    4,
+						UsedBytes:  20 * humanize.GiByte,
+						MaxUsed:    10 * humanize.GiByte,
+						MovedBytes: 10 * humanize.GiByte,
+					},
+				},
+			},
+			expOut: `
+Estimated data movement:
+Tier Targets Used    Min Used Max Used Est. Moved 
+---- ------- ----    -------- -------- ---------- 
+scm  4       2.0 GiB 0 B      1.0 GiB  1.0 GiB    
+nvme 4       20 GiB  0 B      10 GiB   10 GiB     
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintPoolRebalanceEstimate(tc.est, &out)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolRebalanceProgress(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status *daos.PoolRebuildStatus
+		expOut string
+	}{
+		"nil status": {
+			expOut: "Rebalance status unavailable\n",
+		},
+		"failed": {
+			status: &daos.PoolRebuildStatus{
+				Status: -1007,
+				State:  daos.PoolRebuildStateDone,
+			},
+			expOut: "Rebalance failed, status=-1007\n",
+		},
+		"busy; total objects unknown": {
+			status: &daos.PoolRebuildStatus{
+				State:   daos.PoolRebuildStateBusy,
+				Objects: 5,
+				Records: 10,
+			},
+			expOut: "Rebalance busy, 5 objs, 10 recs\n",
+		},
+		"busy": {
+			status: &daos.PoolRebuildStatus{
+				State:        daos.PoolRebuildStateBusy,
+				Objects:      5,
+				Records:      10,
+				TotalObjects: 20,
+			},
+			expOut: "Rebalance busy, 5/20 objs, 10 recs\n",
+		},
+		"done": {
+			status: &daos.PoolRebuildStatus{
+				State:        daos.PoolRebuildStateDone,
+				Objects:      20,
+				Records:      40,
+				TotalObjects: 20,
+			},
+			expOut: "Rebalance done, 20/20 objs, 40 recs\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintPoolRebalanceProgress(tc.status, &out)
+
+			if diff := cmp.Diff(tc.expOut, out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolRenameLabelResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp   *control.PoolRenameLabelResp
+		expOut string
+	}{
+		"nil response": {},
+		"no open handles": {
+			resp: &control.PoolRenameLabelResp{
+				UUID:     test.MockUUID(),
+				OldLabel: "old",
+				NewLabel: "new",
+			},
+			expOut: fmt.Sprintf(`Pool %s label changed from "old" to "new"
+No open pool handles
+`, test.MockUUID()),
+		},
+		"open handles": {
+			resp: &control.PoolRenameLabelResp{
+				UUID:           test.MockUUID(),
+				OldLabel:       "old",
+				NewLabel:       "new",
+				ClientMachines: []string{"client1", "client2"},
+			},
+			expOut: fmt.Sprintf(`Pool %s label changed from "old" to "new"
+2 client machines with open pool handles may still use the old label: client1, client2
+`, test.MockUUID()),
+		},
+		"handles unknown": {
+			resp: &control.PoolRenameLabelResp{
+				UUID:           test.MockUUID(),
+				OldLabel:       "old",
+				NewLabel:       "new",
+				HandlesUnknown: true,
+			},
+			expOut: fmt.Sprintf(`Pool %s label changed from "old" to "new"
+Unable to list open pool handles; clients that looked up the pool by its old label may need to reconnect
+`, test.MockUUID()),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintPoolRenameLabelResp(tc.resp, &out)
+
+			if diff := cmp.Diff(tc.expOut, out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolAliases(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp   *control.PoolUpdateAliasesResp
+		expOut string
+	}{
+		"nil response": {},
+		"no aliases": {
+			resp: &control.PoolUpdateAliasesResp{
+				UUID:  test.MockUUID(),
+				Label: "label",
+			},
+			expOut: fmt.Sprintf("Pool label (%s) has no aliases\n", test.MockUUID()),
+		},
+		"aliases": {
+			resp: &control.PoolUpdateAliasesResp{
+				UUID:    test.MockUUID(),
+				Label:   "label",
+				Aliases: []string{"alias1", "alias2"},
+			},
+			expOut: fmt.Sprintf("Pool label (%s) aliases: alias1, alias2\n", test.MockUUID()),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintPoolAliases(tc.resp, &out)
+
+			if diff := cmp.Diff(tc.expOut, out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintACLValidation(t *testing.T) {
+	for name, tc := range map[string]struct {
+		result  *control.ACLValidation
+		verbose bool
+		expOut  string
+	}{
+		"nil result": {},
+		"valid": {
+			result: &control.ACLValidation{
+				ACL: &control.AccessControlList{
+					Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r"},
+				},
+			},
+			expOut: `
+ACL file acl.txt is valid
+
+Normalized ACL:
+# Entries:
+A::OWNER@:rw
+A:G:GROUP@:r
+`,
+		},
+		"valid verbose": {
+			result: &control.ACLValidation{
+				ACL: &control.AccessControlList{
+					Entries: []string{"A::OWNER@:rw"},
+				},
+			},
+			verbose: true,
+			expOut: `
+ACL file acl.txt is valid
+
+Normalized ACL:
+# Entries:
+# Allow::Owner:Read/Write
+A::OWNER@:rw
+`,
+		},
+		"invalid entries": {
+			result: &control.ACLValidation{
+				Errors: []*control.ACLEntryError{
+					{Line: 2, Entry: "A::bob:r", Error: "invalid principal"},
+					{Line: 4, Entry: "A::OWNER@:r", Error: "duplicate entry"},
+				},
+				ACL: &control.AccessControlList{
+					Entries: []string{"A::OWNER@:rw"},
+				},
+			},
+			expOut: `
+ACL file acl.txt has 2 invalid entries:
+  line 2: A::bob:r
+    invalid principal
+  line 4: A::OWNER@:r
+    duplicate entry
+
+Normalized ACL from valid entries:
+# Entries:
+A::OWNER@:rw
+`,
+		},
+		"no valid entries": {
+			result: &control.ACLValidation{
+				Errors: []*control.ACLEntryError{
+					{Line: 1, Entry: "A::bob:r", Error: "invalid principal"},
+				},
+				ACL: &control.AccessControlList{},
+			},
+			expOut: `
+ACL file acl.txt has 1 invalid entry:
+  line 1: A::bob:r
+    invalid principal
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintACLValidation("acl.txt", tc.result, &out, tc.verbose)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintACLDiff(t *testing.T) {
+	for name, tc := range map[string]struct {
+		diff   *control.ACLDiff
+		expOut string
+	}{
+		"nil diff": {},
+		"no changes": {
+			diff: &control.ACLDiff{
+				Unchanged: []string{"A::bob@:r"},
+			},
+			expOut: `
+ACL update would make no changes to pool tank
+`,
+		},
+		"changes": {
+			diff: &control.ACLDiff{
+				Added: []string{"A::kelsey@:r"},
+				Changed: []*control.ACLEntryChange{
+					{Old: "A::bob@:r", New: "A::bob@:rw"},
+				},
+				Unchanged: []string{"A::OWNER@:rw"},
+			},
+			expOut: `
+ACL update would make the following changes to pool tank:
+  + A::kelsey@:r
+  ~ A::bob@:r -> A::bob@:rw
+1 entry unchanged
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			PrintACLDiff("tank", tc.diff, &out)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolAggregationStats(t *testing.T) {
+	lastRun := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		resp   *control.PoolAggregationQueryResp
+		expOut string
+	}{
+		"nil response": {},
+		"no ranks": {
+			resp: &control.PoolAggregationQueryResp{
+				PoolUUID: test.MockUUID(1),
+			},
+			expOut: `
+No aggregation statistics available for pool 00000001-0001-0001-0001-000000000001
+`,
+		},
+		"multiple ranks": {
+			resp: &control.PoolAggregationQueryResp{
+				PoolUUID: test.MockUUID(1),
+				Ranks: []*control.PoolRankAggregationStats{
+					{
+						Rank:               0,
+						ReclaimedBytes:     humanize.GiByte,
+						MergedBytes:        512 * humanize.MiByte,
+						Lag:                30 * time.Second,
+						LastRun:            lastRun,
+						GCReclaimedRecords: 42,
+						GCLastRun:          lastRun,
+					},
+					{
+						Rank:     1,
+						Failures: 2,
+					},
+				},
+			},
+			expOut: `
+Aggregation statistics for pool 00000001-0001-0001-0001-000000000001:
+Rank Reclaimed Merged  Failures Lag Last Run                      GC Records GC Last Run                   
+---- --------- ------  -------- --- --------                      ---------- -----------                   
+0    1.0 GiB   512 MiB 0        30s 2025-03-04T05:06:07.000+00:00 42         2025-03-04T05:06:07.000+00:00 
+1    0 B       0 B     2        0s  never                         0          never                         
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolAggregationStats(tc.resp, &bld)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
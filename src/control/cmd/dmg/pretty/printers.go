@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,6 +13,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common"
@@ -37,6 +39,10 @@ type (
 		ShowHostPorts bool
 		// LEDInfoOnly indicates that the output should only include LED related info.
 		LEDInfoOnly bool
+		// LEDTable indicates that LED info should be displayed in a table.
+		LEDTable bool
+		// EntityFormat selects how single-entity output is rendered.
+		EntityFormat txtfmt.EntityFormat
 	}
 
 	// PrintConfigOption defines a config function.
@@ -70,6 +76,21 @@ func PrintOnlyLEDInfo() PrintConfigOption {
 	}
 }
 
+// PrintLEDTable enables display of device LED states in a table.
+func PrintLEDTable() PrintConfigOption {
+	return func(cfg *PrintConfig) {
+		cfg.LEDTable = true
+	}
+}
+
+// PrintWithEntityFormat selects the format used to render single-entity
+// output, e.g. key=value or YAML for consumption by scripts.
+func PrintWithEntityFormat(format txtfmt.EntityFormat) PrintConfigOption {
+	return func(cfg *PrintConfig) {
+		cfg.EntityFormat = format
+	}
+}
+
 // getPrintConfig is a helper that returns a format configuration
 // for a format function.
 func getPrintConfig(opts ...PrintConfigOption) *PrintConfig {
@@ -81,6 +102,22 @@ func getPrintConfig(opts ...PrintConfigOption) *PrintConfig {
 	return cfg
 }
 
+// printEntity writes the entity title and attributes to the supplied
+// io.Writer in the format selected by the print configuration.
+func printEntity(out io.Writer, title string, attrs []txtfmt.TableRow, opts ...PrintConfigOption) error {
+	format := getPrintConfig(opts...).EntityFormat
+	entity, err := txtfmt.FormatEntityAs(format, title, attrs)
+	if err != nil {
+		return err
+	}
+	if format == txtfmt.EntityFormatText {
+		entity += "\n"
+	}
+
+	_, err = fmt.Fprint(out, entity)
+	return err
+}
+
 // getPrintHosts is a helper that transforms the given list of
 // host strings according to the format configuration.
 func getPrintHosts(in string, opts ...PrintConfigOption) string {
@@ -104,13 +141,56 @@ func getPrintHosts(in string, opts ...PrintConfigOption) string {
 	return strings.Join(out, ",")
 }
 
+// maxUngroupedHostErrors is the maximum number of distinct host errors that
+// are printed individually before they are grouped by class.
+const maxUngroupedHostErrors = 8
+
+// printHostErrorClasses generates a human-readable representation of the
+// supplied HostErrorsMap with the errors grouped by class, so that failures on
+// large numbers of hosts remain readable. The most frequent error of each class
+// is shown as an example.
+func printHostErrorClasses(hem control.HostErrorsMap, out io.Writer, opts ...PrintConfigOption) {
+	classTitle := "Class"
+	setTitle := "Hosts"
+	countTitle := "Count"
+	errTitle := "Error"
+
+	tablePrint := txtfmt.NewTableFormatter(classTitle, setTitle, countTitle, errTitle)
+	tablePrint.InitWriter(out)
+	table := []txtfmt.TableRow{}
+
+	for _, hecs := range hem.Classify() {
+		errStr := hecs.Errors[0].Error
+		if len(hecs.Errors) > 1 {
+			errStr += fmt.Sprintf(" (+%d other %s)", len(hecs.Errors)-1,
+				english.PluralWord(len(hecs.Errors)-1, "error", "errors"))
+		}
+
+		table = append(table, txtfmt.TableRow{
+			classTitle: string(hecs.Class),
+			setTitle:   getPrintHosts(hecs.HostSet.RangedString(), opts...),
+			countTitle: fmt.Sprintf("%d", hecs.HostCount),
+			errTitle:   errStr,
+		})
+	}
+
+	tablePrint.Format(table)
+}
+
 // PrintHostErrorsMap generates a human-readable representation of the supplied
-// HostErrorsMap struct and writes it to the supplied io.Writer.
+// HostErrorsMap struct and writes it to the supplied io.Writer. If there are
+// too many distinct errors to print individually, they are grouped by class
+// unless verbose output is requested.
 func PrintHostErrorsMap(hem control.HostErrorsMap, out io.Writer, opts ...PrintConfigOption) error {
 	if len(hem) == 0 {
 		return nil
 	}
 
+	if len(hem) > maxUngroupedHostErrors && !getPrintConfig(opts...).Verbose {
+		printHostErrorClasses(hem, out, opts...)
+		return nil
+	}
+
 	setTitle := "Hosts"
 	errTitle := "Error"
 
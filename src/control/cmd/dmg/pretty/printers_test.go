@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package pretty
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -86,6 +88,26 @@ host[1-2]:1 whoops
 Hosts           Error  
 -----           -----  
 host1:1,host2:2 whoops 
+`,
+		},
+		"many errors grouped by class": {
+			hosts: func() (hosts []string) {
+				for i := 1; i <= 11; i++ {
+					hosts = append(hosts, fmt.Sprintf("host%d:10001", i))
+				}
+				return
+			}(),
+			errors: func() (errs []error) {
+				for i := 1; i <= 10; i++ {
+					errs = append(errs, control.FaultConnectionRefused(fmt.Sprintf("host%d:10001", i)))
+				}
+				return append(errs, errors.New("whoops"))
+			}(),
+			expPrintStr: `
+Class              Hosts            Count Error                                                               
+-----              -----            ----- -----                                                               
+connection refused host[1-10]:10001 10    the server at host10:10001 refused the connection (+9 other errors) 
+application error  host11:10001     1     whoops                                                              
 `,
 		},
 	} {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,9 +8,13 @@
 package pretty
 
 import (
+	"fmt"
 	"io"
+	"time"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 )
 
 // PrintSetEngineLogMasksResp generates a human-readable representation of the supplied response.
@@ -20,3 +25,56 @@ func PrintSetEngineLogMasksResp(resp *control.SetEngineLogMasksResp, out, outErr
 
 	return PrintHostStorageSuccesses("Engine log-masks updated", resp.HostStorage, out)
 }
+
+// PrintListEnginesResp generates a human-readable representation of the supplied response.
+func PrintListEnginesResp(resp *control.ListEnginesResp, out, outErr io.Writer) error {
+	if err := PrintResponseErrors(resp, outErr); err != nil {
+		return err
+	}
+
+	if len(resp.Engines) == 0 {
+		_, err := fmt.Fprintln(out, "No engines found")
+		return err
+	}
+
+	hostTitle := "Host"
+	indexTitle := "Index"
+	rankTitle := "Rank"
+	stateTitle := "State"
+	numaTitle := "NUMA"
+	ifaceTitle := "Interface"
+	storageTitle := "Storage"
+	uptimeTitle := "Uptime"
+	versionTitle := "Version"
+
+	table := make([]txtfmt.TableRow, 0, len(resp.Engines))
+	for _, ei := range resp.Engines {
+		rank := "None"
+		if r := ranklist.Rank(ei.Rank); !r.Equals(ranklist.NilRank) {
+			rank = r.String()
+		}
+		uptime := "-"
+		if ei.Uptime > 0 {
+			uptime = (time.Duration(ei.Uptime) * time.Second).String()
+		}
+
+		table = append(table, txtfmt.TableRow{
+			hostTitle:    ei.Host,
+			indexTitle:   fmt.Sprintf("%d", ei.Index),
+			rankTitle:    rank,
+			stateTitle:   ei.State,
+			numaTitle:    fmt.Sprintf("%d", ei.NumaNode),
+			ifaceTitle:   fmt.Sprintf("%s (%s)", ei.FabricIface, ei.Provider),
+			storageTitle: fmt.Sprintf("%s:%s, %d NVMe", ei.ScmClass, ei.ScmMount, ei.BdevCount),
+			uptimeTitle:  uptime,
+			versionTitle: ei.Version,
+		})
+	}
+
+	tf := txtfmt.NewTableFormatter(hostTitle, indexTitle, rankTitle, stateTitle, numaTitle,
+		ifaceTitle, storageTitle, uptimeTitle, versionTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+
+	return nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -15,6 +16,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 )
 
 func TestPretty_PrintSetEngineLogMasksResp(t *testing.T) {
@@ -130,3 +132,108 @@ Errors:
 		})
 	}
 }
+
+func TestPretty_PrintListEnginesResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp      *control.ListEnginesResp
+		expStdout string
+		expStderr string
+		expErr    error
+	}{
+		"empty response": {
+			resp: new(control.ListEnginesResp),
+			expStdout: `
+No engines found
+`,
+		},
+		"server error": {
+			resp: &control.ListEnginesResp{
+				HostErrorsResp: control.MockHostErrorsResp(t,
+					&control.MockHostError{
+						Hosts: "host1",
+						Error: "failed",
+					}),
+			},
+			expStdout: `
+No engines found
+`,
+			expStderr: `
+Errors:
+  Hosts Error  
+  ----- -----  
+  host1 failed 
+
+`,
+		},
+		"multiple hosts": {
+			resp: &control.ListEnginesResp{
+				Engines: []*control.EngineInfo{
+					{
+						Host:        "host1",
+						Index:       0,
+						Rank:        0,
+						State:       "Joined",
+						NumaNode:    0,
+						FabricIface: "ib0",
+						Provider:    "ofi+verbs",
+						ScmClass:    "dcpm",
+						ScmMount:    "/mnt/daos0",
+						BdevCount:   2,
+						Uptime:      3660,
+						Version:     "2.6.0",
+					},
+					{
+						Host:        "host1",
+						Index:       1,
+						Rank:        uint32(ranklist.NilRank),
+						State:       "Stopped",
+						NumaNode:    1,
+						FabricIface: "ib1",
+						Provider:    "ofi+verbs",
+						ScmClass:    "dcpm",
+						ScmMount:    "/mnt/daos1",
+						BdevCount:   2,
+						Version:     "2.6.0",
+					},
+					{
+						Host:        "host2",
+						Index:       0,
+						Rank:        1,
+						State:       "Joined",
+						NumaNode:    0,
+						FabricIface: "ib0",
+						Provider:    "ofi+verbs",
+						ScmClass:    "ram",
+						ScmMount:    "/mnt/daos",
+						Uptime:      30,
+						Version:     "2.6.0",
+					},
+				},
+			},
+			expStdout: `
+Host  Index Rank State   NUMA Interface       Storage                 Uptime Version 
+----  ----- ---- -----   ---- ---------       -------                 ------ ------- 
+host1 0     0    Joined  0    ib0 (ofi+verbs) dcpm:/mnt/daos0, 2 NVMe 1h1m0s 2.6.0   
+host1 1     None Stopped 1    ib1 (ofi+verbs) dcpm:/mnt/daos1, 2 NVMe -      2.6.0   
+host2 0     1    Joined  0    ib0 (ofi+verbs) ram:/mnt/daos, 0 NVMe   30s    2.6.0   
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out, outErr strings.Builder
+
+			gotErr := PrintListEnginesResp(tc.resp, &out, &outErr)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if gotErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStdout, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStderr, "\n"), outErr.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// storageMapShades are the characters used to shade a device cell, in order of
+// increasing utilization.
+var storageMapShades = []struct {
+	char  byte
+	limit int
+}{
+	{'.', 25},
+	{':', 50},
+	{'+', 75},
+	{'#', 90},
+	{'@', 101},
+}
+
+func storageMapShade(usedPct int) byte {
+	for _, shade := range storageMapShades {
+		if usedPct < shade.limit {
+			return shade.char
+		}
+	}
+	return storageMapShades[len(storageMapShades)-1].char
+}
+
+// storageMapCell returns a fixed-width cell for the device, shaded by its
+// utilization and with a marker for any fault or data movement.
+func storageMapCell(dev *control.StorageMapDevice) string {
+	shade := storageMapShade(dev.UsedPercent())
+	marker := shade
+	switch {
+	case dev.Faulty():
+		marker = 'F'
+	case dev.Excluded():
+		marker = 'X'
+	case dev.Rebuilding():
+		marker = 'R'
+	}
+	return fmt.Sprintf("[%c%c]", shade, marker)
+}
+
+// PrintStorageMap generates a human-readable grid of the storage devices of
+// each pool rank, shaded by utilization, and writes it to the supplied
+// io.Writer.
+func PrintStorageMap(sm *control.StorageMap, out io.Writer) {
+	if sm == nil || len(sm.Ranks) == 0 {
+		fmt.Fprintln(out, "No pool ranks found")
+		return
+	}
+
+	fmt.Fprintf(out, "Pool %s storage map\n\n", sm.Pool)
+
+	hostTitle := "Host"
+	rankTitle := "Rank"
+	usedTitle := "Used"
+	devsTitle := "Devices"
+
+	tablePrint := txtfmt.NewTableFormatter(hostTitle, rankTitle, usedTitle, devsTitle)
+	tablePrint.InitWriter(out)
+	table := []txtfmt.TableRow{}
+
+	for _, smr := range sm.Ranks {
+		host := smr.Host
+		if host == "" {
+			host = "-"
+		}
+
+		var usage control.StorageTierUsage
+		cells := make([]string, 0, len(smr.Devices))
+		for _, dev := range smr.Devices {
+			usage.TotalBytes += dev.Usage.TotalBytes
+			usage.FreeBytes += dev.Usage.FreeBytes
+			cells = append(cells, storageMapCell(dev))
+		}
+
+		table = append(table, txtfmt.TableRow{
+			hostTitle: host,
+			rankTitle: smr.Rank.String(),
+			usedTitle: common.PercentageString(usage.UsedBytes(), usage.TotalBytes),
+			devsTitle: strings.Join(cells, " "),
+		})
+	}
+
+	tablePrint.Format(table)
+
+	var shades []string
+	lower := 0
+	for _, shade := range storageMapShades {
+		upper := shade.limit - 1
+		if upper > 100 {
+			upper = 100
+		}
+		shades = append(shades, fmt.Sprintf("%c %d-%d%%", shade.char, lower, upper))
+		lower = shade.limit
+	}
+	fmt.Fprintf(out, "\nUsed: %s\n", strings.Join(shades, ", "))
+	fmt.Fprintln(out, "Markers: F faulty device, X all targets excluded, R targets rebuilding or draining")
+}
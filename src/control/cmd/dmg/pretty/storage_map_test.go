@@ -0,0 +1,107 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestPretty_PrintStorageMap(t *testing.T) {
+	mockDev := func(state storage.NvmeDevState, total, free uint64, tgtStates ...daos.PoolQueryTargetState) *control.StorageMapDevice {
+		dev := &control.StorageMapDevice{
+			UUID:  "dev",
+			State: state,
+			Usage: control.StorageTierUsage{TotalBytes: total, FreeBytes: free},
+		}
+		for i, tgtState := range tgtStates {
+			dev.Targets = append(dev.Targets, &control.StorageMapTarget{
+				Index: uint32(i),
+				State: tgtState,
+			})
+		}
+		return dev
+	}
+	upIn := daos.PoolTargetStateUpIn
+
+	for name, tc := range map[string]struct {
+		sm          *control.StorageMap
+		expPrintStr string
+	}{
+		"nil map": {
+			expPrintStr: `
+No pool ranks found
+`,
+		},
+		"no ranks": {
+			sm: &control.StorageMap{Pool: "pool1"},
+			expPrintStr: `
+No pool ranks found
+`,
+		},
+		"shading and markers": {
+			sm: &control.StorageMap{
+				Pool: "pool1",
+				Ranks: []*control.StorageMapRank{
+					{
+						Rank: 0,
+						Host: "host1",
+						Devices: []*control.StorageMapDevice{
+							mockDev(storage.NvmeStateNormal, 100, 90, upIn, upIn),
+							mockDev(storage.NvmeStateNormal, 100, 60, upIn, upIn),
+							mockDev(storage.NvmeStateNormal, 100, 40, upIn, upIn),
+						},
+					},
+					{
+						Rank: 1,
+						Host: "host1",
+						Devices: []*control.StorageMapDevice{
+							mockDev(storage.NvmeStateNormal, 100, 20, upIn, upIn),
+							mockDev(storage.NvmeStateNormal, 100, 5, upIn, daos.PoolTargetStateDrain),
+							mockDev(storage.NvmeStateFaulty, 100, 100,
+								daos.PoolTargetStateDownOut, daos.PoolTargetStateDownOut),
+						},
+					},
+					{
+						Rank: 2,
+						Devices: []*control.StorageMapDevice{
+							mockDev(storage.NvmeStateNormal, 0, 0,
+								daos.PoolTargetStateDownOut, daos.PoolTargetStateDownOut),
+						},
+					},
+				},
+			},
+			expPrintStr: `
+Pool pool1 storage map
+
+Host  Rank Used Devices        
+----  ---- ---- -------        
+host1 0    36 % [..] [::] [++] 
+host1 1    58 % [##] [@R] [.F] 
+-     2    N/A  [.X]           
+
+Used: . 0-24%, : 25-49%, + 50-74%, # 75-89%, @ 90-100%
+Markers: F faulty device, X all targets excluded, R targets rebuilding or draining
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintStorageMap(tc.sm, &bld)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
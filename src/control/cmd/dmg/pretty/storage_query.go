@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -71,6 +72,63 @@ func PrintHostStorageUsageMap(hsm control.HostStorageMap, out io.Writer) {
 	tablePrint.Format(table)
 }
 
+func printStorageUsageSummary(sus *control.StorageUsageSummary, nameTitle string, fmtName func(string) string, out io.Writer) {
+	if sus == nil || len(sus.Entries) == 0 {
+		fmt.Fprintf(out, "No %ss found\n", strings.ToLower(nameTitle))
+		return
+	}
+
+	scmTitle := "SCM-Total"
+	scmFreeTitle := "SCM-Free"
+	scmUsageTitle := "SCM-Used"
+	nvmeTitle := "NVMe-Total"
+	nvmeFreeTitle := "NVMe-Free"
+	nvmeUsageTitle := "NVMe-Used"
+
+	tablePrint := txtfmt.NewTableFormatter(nameTitle, scmTitle, scmFreeTitle,
+		scmUsageTitle, nvmeTitle, nvmeFreeTitle, nvmeUsageTitle)
+	tablePrint.InitWriter(out)
+	table := []txtfmt.TableRow{}
+
+	addRow := func(name string, entry *control.StorageUsageEntry) {
+		table = append(table, txtfmt.TableRow{
+			nameTitle:      name,
+			scmTitle:       humanize.Bytes(entry.Scm.TotalBytes),
+			scmFreeTitle:   humanize.Bytes(entry.Scm.FreeBytes),
+			scmUsageTitle:  common.PercentageString(entry.Scm.UsedBytes(), entry.Scm.TotalBytes),
+			nvmeTitle:      humanize.Bytes(entry.Nvme.TotalBytes),
+			nvmeFreeTitle:  humanize.Bytes(entry.Nvme.FreeBytes),
+			nvmeUsageTitle: common.PercentageString(entry.Nvme.UsedBytes(), entry.Nvme.TotalBytes),
+		})
+	}
+	for _, entry := range sus.Entries {
+		addRow(fmtName(entry.Name), entry)
+	}
+	if sus.Total != nil {
+		addRow(sus.Total.Name, sus.Total)
+	}
+
+	tablePrint.Format(table)
+}
+
+// PrintHostStorageUsageSummary generates a human-readable representation of the
+// supplied per-host StorageUsageSummary, with one row for each host followed by
+// a row with the totals.
+func PrintHostStorageUsageSummary(sus *control.StorageUsageSummary, out io.Writer, opts ...PrintConfigOption) {
+	printStorageUsageSummary(sus, "Host", func(name string) string {
+		return getPrintHosts(name, opts...)
+	}, out)
+}
+
+// PrintPoolStorageUsageSummary generates a human-readable representation of the
+// supplied per-pool StorageUsageSummary, with one row for each pool followed by
+// a row with the totals.
+func PrintPoolStorageUsageSummary(sus *control.StorageUsageSummary, out io.Writer) {
+	printStorageUsageSummary(sus, "Pool", func(name string) string {
+		return name
+	}, out)
+}
+
 const (
 	metaRole  = storage.BdevRoleMeta
 	dataRole  = storage.BdevRoleData
@@ -408,6 +466,45 @@ func PrintSmdInfoMap(omitDevs, omitPools bool, hsm control.HostStorageMap, out i
 	return w.Err
 }
 
+// printLEDTable displays the LED state of each device in the supplied map in a
+// single table.
+func printLEDTable(hsm control.HostStorageMap, out io.Writer, opts ...PrintConfigOption) error {
+	hostsTitle := "Hosts"
+	rankTitle := "Rank"
+	addrTitle := "TrAddr"
+	uuidTitle := "UUID"
+	ledTitle := "LED"
+
+	var table []txtfmt.TableRow
+	for _, key := range hsm.Keys() {
+		hss := hsm[key]
+		if hss.HostStorage.SmdInfo == nil {
+			continue
+		}
+		hosts := getPrintHosts(hss.HostSet.RangedString(), opts...)
+		for _, dev := range hss.HostStorage.SmdInfo.Devices {
+			table = append(table, txtfmt.TableRow{
+				hostsTitle: hosts,
+				rankTitle:  dev.Rank.String(),
+				addrTitle:  dev.Ctrlr.PciAddr,
+				uuidTitle:  dev.UUID,
+				ledTitle:   dev.Ctrlr.LedState.String(),
+			})
+		}
+	}
+
+	if len(table) == 0 {
+		_, err := fmt.Fprintln(out, "No devices found")
+		return err
+	}
+
+	tf := txtfmt.NewTableFormatter(hostsTitle, rankTitle, addrTitle, uuidTitle, ledTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+
+	return nil
+}
+
 // PrintSmdManageResp generates a human-readable representation of the supplied response.
 func PrintSmdManageResp(op control.SmdManageOpcode, resp *control.SmdResp, out, outErr io.Writer, opts ...PrintConfigOption) error {
 	switch op {
@@ -433,6 +530,9 @@ func PrintSmdManageResp(op control.SmdManageOpcode, resp *control.SmdResp, out,
 			return err
 		}
 
+		if getPrintConfig(opts...).LEDTable {
+			return printLEDTable(resp.HostStorage, out, opts...)
+		}
 		return PrintSmdInfoMap(false, true, resp.HostStorage, out, opts...)
 	default:
 		return errors.Errorf("unsupported opcode %d", op)
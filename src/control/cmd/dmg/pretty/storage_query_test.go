@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -143,6 +144,82 @@ host1 3.0 TB    750 GB   75 %     36 TB      27 TB     25 %
 	}
 }
 
+func TestPretty_PrintStorageUsageSummary(t *testing.T) {
+	mockEntry := func(name string, scmTotal, scmFree, nvmeTotal, nvmeFree uint64) *control.StorageUsageEntry {
+		return &control.StorageUsageEntry{
+			Name: name,
+			Scm:  control.StorageTierUsage{TotalBytes: scmTotal, FreeBytes: scmFree},
+			Nvme: control.StorageTierUsage{TotalBytes: nvmeTotal, FreeBytes: nvmeFree},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		summary     *control.StorageUsageSummary
+		byPool      bool
+		expPrintStr string
+	}{
+		"no hosts": {
+			summary: &control.StorageUsageSummary{},
+			expPrintStr: `
+No hosts found
+`,
+		},
+		"no pools": {
+			summary: &control.StorageUsageSummary{},
+			byPool:  true,
+			expPrintStr: `
+No pools found
+`,
+		},
+		"by host": {
+			summary: &control.StorageUsageSummary{
+				Entries: []*control.StorageUsageEntry{
+					mockEntry("host1:10001", 1e12, 25e10, 2e12, 1e12),
+					mockEntry("host2:10001", 1e12, 5e11, 2e12, 5e11),
+				},
+				Total: mockEntry("Total", 2e12, 75e10, 4e12, 15e11),
+			},
+			expPrintStr: `
+Host  SCM-Total SCM-Free SCM-Used NVMe-Total NVMe-Free NVMe-Used 
+----  --------- -------- -------- ---------- --------- --------- 
+host1 1.0 TB    250 GB   75 %     2.0 TB     1.0 TB    50 %      
+host2 1.0 TB    500 GB   50 %     2.0 TB     500 GB    75 %      
+Total 2.0 TB    750 GB   62 %     4.0 TB     1.5 TB    62 %      
+`,
+		},
+		"by pool": {
+			summary: &control.StorageUsageSummary{
+				Entries: []*control.StorageUsageEntry{
+					mockEntry("pool1", 1e12, 25e10, 2e12, 1e12),
+					mockEntry("pool2", 1e12, 5e11, 2e12, 5e11),
+				},
+				Total: mockEntry("Total", 2e12, 75e10, 4e12, 15e11),
+			},
+			byPool: true,
+			expPrintStr: `
+Pool  SCM-Total SCM-Free SCM-Used NVMe-Total NVMe-Free NVMe-Used 
+----  --------- -------- -------- ---------- --------- --------- 
+pool1 1.0 TB    250 GB   75 %     2.0 TB     1.0 TB    50 %      
+pool2 1.0 TB    500 GB   50 %     2.0 TB     500 GB    75 %      
+Total 2.0 TB    750 GB   62 %     4.0 TB     1.5 TB    62 %      
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if tc.byPool {
+				PrintPoolStorageUsageSummary(tc.summary, &bld)
+			} else {
+				PrintHostStorageUsageSummary(tc.summary, &bld)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestPretty_getTierRolesForHost(t *testing.T) {
 	for name, tc := range map[string]struct {
 		nvme          storage.NvmeControllers
@@ -918,6 +995,62 @@ host[1-2]
 ---------
   Devices
     TrAddr:0000:01:00.0 [UUID:00000001-0001-0001-0001-000000000001] LED:OFF
+`,
+		},
+		"led-check table": {
+			op:        control.LedCheckOp,
+			printOpts: PrintLEDTable(),
+			resp: &control.SmdResp{
+				HostStorage: func() control.HostStorageMap {
+					hsm := make(control.HostStorageMap)
+					for _, hss := range []*control.HostStorageSet{
+						{
+							HostSet: control.MockHostSet(t, "host[2-3]"),
+							HostStorage: &control.HostStorage{
+								SmdInfo: &control.SmdInfo{
+									Devices: []*storage.SmdDevice{
+										{
+											Rank: 1,
+											Ctrlr: storage.NvmeController{
+												PciAddr:  test.MockPCIAddr(2),
+												LedState: storage.LedStateIdentify,
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							HostSet: control.MockHostSet(t, "host1"),
+							HostStorage: &control.HostStorage{
+								SmdInfo: &control.SmdInfo{
+									Devices: []*storage.SmdDevice{
+										{
+											UUID: test.MockUUID(1),
+											Ctrlr: storage.NvmeController{
+												PciAddr:  test.MockPCIAddr(1),
+												LedState: storage.LedStateNormal,
+											},
+										},
+									},
+								},
+							},
+						},
+					} {
+						hk, err := hss.HostStorage.HashKey()
+						if err != nil {
+							t.Fatal(err)
+						}
+						hsm[hk] = hss
+					}
+					return hsm
+				}(),
+			},
+			expStdout: `
+Hosts     Rank TrAddr       UUID                                 LED         
+-----     ---- ------       ----                                 ---         
+host1     0    0000:01:00.0 00000001-0001-0001-0001-000000000001 OFF         
+host[2-3] 1    0000:02:00.0                                      QUICK_BLINK 
 `,
 		},
 	} {
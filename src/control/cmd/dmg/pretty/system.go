@@ -10,11 +10,15 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -140,6 +144,117 @@ func PrintSystemQueryResponse(out, outErr io.Writer, resp *control.SystemQueryRe
 	return nil
 }
 
+func domainTreeRollup(node *system.MemberDomainTree) string {
+	states := make([]string, 0, len(node.States))
+	for state := range node.States {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+
+	counts := make([]string, 0, len(states))
+	for _, state := range states {
+		counts = append(counts, fmt.Sprintf("%d %s", node.States[state], state))
+	}
+
+	rollup := fmt.Sprintf("%s (%d/%d %s available: %s)", node.Name, node.Available, node.Total,
+		english.PluralWord(node.Total, "rank", "ranks"), strings.Join(counts, ", "))
+	switch {
+	case node.Down():
+		rollup += " DOWN"
+	case node.Degraded():
+		rollup += " DEGRADED"
+	}
+
+	return rollup
+}
+
+func printDomainTreeNode(out io.Writer, node *system.MemberDomainTree, prefix string) {
+	var lines []string
+	var subtrees []*system.MemberDomainTree
+
+	groups := make(map[string]*ranklist.RankSet)
+	for _, m := range node.Members() {
+		state := strings.ToLower(m.State.String())
+		if _, found := groups[state]; !found {
+			groups[state] = ranklist.MustCreateRankSet("")
+		}
+		groups[state].Add(m.Rank)
+	}
+	for state, ranks := range groups {
+		lines = append(lines, fmt.Sprintf("%s %s: %s",
+			english.PluralWord(ranks.Count(), "rank", "ranks"), ranks.String(), state))
+	}
+	sort.Strings(lines)
+	for _, child := range node.Children {
+		lines = append(lines, domainTreeRollup(child))
+		subtrees = append(subtrees, child)
+	}
+
+	for i, line := range lines {
+		branch, indent := "|-- ", "|   "
+		if i == len(lines)-1 {
+			branch, indent = "`-- ", "    "
+		}
+		fmt.Fprintf(out, "%s%s%s\n", prefix, branch, line)
+
+		if childIdx := i - len(groups); childIdx >= 0 {
+			printDomainTreeNode(out, subtrees[childIdx], prefix+indent)
+		}
+	}
+}
+
+// printDomainTreeSummary prints the number of degraded and down domains at each
+// level of the tree, to show which levels have lost redundancy.
+func printDomainTreeSummary(out io.Writer, root *system.MemberDomainTree) {
+	level := root.Children
+	for depth := 1; len(level) > 0; depth++ {
+		name := fmt.Sprintf("level %d", depth)
+		if label := strings.SplitN(level[0].Name, system.FaultDomainLabelAssign, 2); len(label) == 2 {
+			name = label[0]
+		}
+
+		var degraded, down int
+		var next []*system.MemberDomainTree
+		for _, node := range level {
+			if node.Degraded() {
+				degraded++
+			}
+			if node.Down() {
+				down++
+			}
+			next = append(next, node.Children...)
+		}
+
+		fmt.Fprintf(out, "Fault domain %s: %d of %d degraded, %d down\n", name, degraded,
+			len(level), down)
+		level = next
+	}
+}
+
+// PrintSystemQueryTree generates a human-readable tree of the members in the
+// supplied SystemQueryResp struct grouped by fault domain, and writes it to the
+// supplied io.Writer.
+func PrintSystemQueryTree(out, outErr io.Writer, resp *control.SystemQueryResp) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	if len(resp.Members) == 0 {
+		fmt.Fprintln(out, "Query matches no ranks in system")
+	} else {
+		tree := system.NewMemberDomainTree(resp.Members)
+		fmt.Fprintln(out, domainTreeRollup(tree))
+		printDomainTreeNode(out, tree, "")
+		fmt.Fprintln(out)
+		printDomainTreeSummary(out, tree)
+	}
+
+	printAbsentHosts(outErr, &resp.AbsentHosts)
+	printAbsentRanks(outErr, &resp.AbsentRanks)
+
+	return nil
+}
+
 func printSystemResultTable(out io.Writer, results system.MemberResults, absentRanks *ranklist.RankSet) error {
 	groups := make(system.RankGroups)
 	if err := groups.FromMemberResults(results, rowFieldSep); err != nil {
@@ -186,20 +301,25 @@ func PrintSystemStopResponse(out, outErr io.Writer, resp *control.SystemStopResp
 	return printSystemResults(out, outErr, resp.Results, &resp.AbsentHosts, &resp.AbsentRanks)
 }
 
-func printSystemCleanupRespVerbose(out io.Writer, resp *control.SystemCleanupResp) {
+func printSystemCleanupRespVerbose(out io.Writer, resp *control.SystemCleanupResp, dryRun bool) {
 	if len(resp.Results) == 0 {
 		fmt.Fprintln(out, "no handles cleaned up")
 		return
 	}
 
-	titles := []string{"Pool", "Handles Revoked"}
+	countTitle := "Handles Revoked"
+	if dryRun {
+		countTitle = "Handles"
+	}
+	titles := []string{"Pool", "Machine", countTitle}
 	formatter := txtfmt.NewTableFormatter(titles...)
 
 	var table []txtfmt.TableRow
 	for _, r := range resp.Results {
 		row := txtfmt.TableRow{
-			"Pool":            r.PoolID,
-			"Handles Revoked": fmt.Sprintf("%d", r.Count),
+			"Pool":     r.PoolID,
+			"Machine":  r.Machine,
+			countTitle: fmt.Sprintf("%d", r.Count),
 		}
 		table = append(table, row)
 	}
@@ -207,18 +327,372 @@ func printSystemCleanupRespVerbose(out io.Writer, resp *control.SystemCleanupRes
 	fmt.Fprintln(out, formatter.Format(table))
 }
 
+func printSystemCleanupUnknownMachines(out io.Writer, resp *control.SystemCleanupResp) {
+	if len(resp.UnknownMachines) == 0 {
+		return
+	}
+
+	fmt.Fprintf(out, "Skipped %s with no heartbeat record: %s\n",
+		english.Plural(len(resp.UnknownMachines), "machine", ""),
+		strings.Join(resp.UnknownMachines, ", "))
+}
+
 // PrintSystemCleanupResponse generates a human-readable representation of the
 // supplied SystemCleanupResp struct and writes it to the supplied io.Writer.
-func PrintSystemCleanupResponse(out io.Writer, resp *control.SystemCleanupResp, verbose bool) {
+// In a dry run, the handles that would have been revoked are always listed.
+func PrintSystemCleanupResponse(out io.Writer, resp *control.SystemCleanupResp, verbose, dryRun bool) {
+	defer printSystemCleanupUnknownMachines(out, resp)
+
 	if len(resp.Results) == 0 {
 		fmt.Fprintln(out, "No handles cleaned up")
 		return
 	}
 
+	if dryRun {
+		printSystemCleanupRespVerbose(out, resp, dryRun)
+		fmt.Fprintln(out, "Dry run: no handles revoked")
+		return
+	}
+
 	if verbose {
-		printSystemCleanupRespVerbose(out, resp)
+		printSystemCleanupRespVerbose(out, resp, dryRun)
 		return
 	}
 
 	fmt.Fprintln(out, "System Cleanup Success")
 }
+
+// PrintSystemListClientsResponse generates a human-readable representation of
+// the supplied SystemListClientsResp struct and writes it to the supplied
+// io.Writer.
+func PrintSystemListClientsResponse(out io.Writer, resp *control.SystemListClientsResp) {
+	if len(resp.Clients) == 0 {
+		fmt.Fprintln(out, "No client machines found")
+		return
+	}
+
+	machineTitle := "Machine"
+	versionTitle := "Version"
+	clientsTitle := "Clients"
+	fabricTitle := "Fabric Interfaces"
+	lastSeenTitle := "Last Seen"
+	stateTitle := "State"
+	formatter := txtfmt.NewTableFormatter(machineTitle, versionTitle, clientsTitle, fabricTitle,
+		lastSeenTitle, stateTitle)
+
+	var table []txtfmt.TableRow
+	for _, c := range resp.Clients {
+		row := txtfmt.TableRow{
+			machineTitle:  c.Machine,
+			versionTitle:  c.Version,
+			clientsTitle:  fmt.Sprintf("%d", c.ClientCount),
+			fabricTitle:   "-",
+			lastSeenTitle: common.FormatTime(time.Unix(c.LastSeen, 0)),
+			stateTitle:    "active",
+		}
+		if len(c.FabricInterfaces) > 0 {
+			row[fabricTitle] = strings.Join(c.FabricInterfaces, ",")
+		}
+		if c.Stale {
+			row[stateTitle] = "stale"
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemSetFaultDomainsResponse generates a human-readable representation of the
+// supplied SystemSetFaultDomainsResp struct and writes it to the supplied io.Writer.
+func PrintSystemSetFaultDomainsResponse(out io.Writer, resp *control.SystemSetFaultDomainsResp, dryRun bool) {
+	if len(resp.Changes) == 0 {
+		fmt.Fprintln(out, "No fault domain changes")
+		return
+	}
+
+	rankTitle := "Rank"
+	addrTitle := "Address"
+	oldTitle := "Current Fault Domain"
+	newTitle := "New Fault Domain"
+	formatter := txtfmt.NewTableFormatter(rankTitle, addrTitle, oldTitle, newTitle)
+
+	var table []txtfmt.TableRow
+	for _, c := range resp.Changes {
+		table = append(table, txtfmt.TableRow{
+			rankTitle: c.Rank.String(),
+			addrTitle: c.Addr,
+			oldTitle:  c.OldDomain,
+			newTitle:  c.NewDomain,
+		})
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+
+	changes := english.Plural(len(resp.Changes), "fault domain change", "")
+	if dryRun {
+		fmt.Fprintf(out, "Dry run: %s not applied\n", changes)
+		return
+	}
+	fmt.Fprintf(out, "%s applied\n", changes)
+}
+
+// PrintSystemClearFaultDomainsResponse generates a human-readable representation of the
+// supplied SystemSetFaultDomainsResp struct, returned from a request to clear the imported
+// fault domains, and writes it to the supplied io.Writer.
+func PrintSystemClearFaultDomainsResponse(out io.Writer, resp *control.SystemSetFaultDomainsResp, dryRun bool) {
+	if resp.ClearedRanks.Count() == 0 {
+		fmt.Fprintln(out, "No imported fault domains")
+		return
+	}
+
+	if dryRun {
+		fmt.Fprintf(out, "Dry run: imported fault domains of ranks %s not cleared\n", resp.ClearedRanks)
+		return
+	}
+	fmt.Fprintf(out, "Imported fault domains of ranks %s cleared, configured fault domains will be used when the ranks next join\n",
+		resp.ClearedRanks)
+}
+
+func formatScheduleTime(secs uint64) string {
+	return common.FormatTime(time.Unix(int64(secs), 0))
+}
+
+// ScheduledRankActionString returns a human-readable description of the supplied scheduled
+// rank action.
+func ScheduledRankActionString(action *control.ScheduledRankAction) string {
+	op := "exclude"
+	if action.Clear {
+		op = "clear-exclude"
+	}
+	str := fmt.Sprintf("%s ranks %s at %s", op, action.Ranks.String(),
+		formatScheduleTime(action.Start))
+	if action.End != 0 {
+		str += fmt.Sprintf(", clear-exclude at %s", formatScheduleTime(action.End))
+	}
+	return str
+}
+
+// PrintSystemListScheduledResponse generates a human-readable representation of the
+// supplied SystemListScheduledResp struct and writes it to the supplied io.Writer.
+func PrintSystemListScheduledResponse(out io.Writer, resp *control.SystemListScheduledResp) {
+	if len(resp.Actions) == 0 {
+		fmt.Fprintln(out, "No scheduled rank actions")
+		return
+	}
+
+	ranksTitle := "Ranks"
+	actionTitle := "Action"
+	startTitle := "Start"
+	endTitle := "End"
+	stateTitle := "State"
+	formatter := txtfmt.NewTableFormatter(ranksTitle, actionTitle, startTitle, endTitle,
+		stateTitle)
+
+	var table []txtfmt.TableRow
+	for _, a := range resp.Actions {
+		row := txtfmt.TableRow{
+			ranksTitle:  a.Ranks.String(),
+			actionTitle: "exclude",
+			startTitle:  formatScheduleTime(a.Start),
+			endTitle:    "-",
+			stateTitle:  "pending",
+		}
+		if a.Clear {
+			row[actionTitle] = "clear-exclude"
+		}
+		if a.End != 0 {
+			row[endTitle] = formatScheduleTime(a.End)
+		}
+		if a.Active {
+			row[stateTitle] = "active"
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemEventsResponse generates a human-readable representation of the
+// supplied SystemEventsResp struct and writes it to the supplied io.Writer.
+func PrintSystemEventsResponse(out io.Writer, resp *control.SystemEventsResp, offset uint64) {
+	if len(resp.Events) == 0 {
+		fmt.Fprintln(out, "No events found")
+		return
+	}
+
+	timeTitle := "Time"
+	hostTitle := "Host"
+	rankTitle := "Rank"
+	sevTitle := "Severity"
+	eventTitle := "Event"
+	msgTitle := "Message"
+	formatter := txtfmt.NewTableFormatter(timeTitle, hostTitle, rankTitle, sevTitle,
+		eventTitle, msgTitle)
+
+	var table []txtfmt.TableRow
+	for _, evt := range resp.Events {
+		row := txtfmt.TableRow{
+			timeTitle:  evt.Timestamp,
+			hostTitle:  evt.Hostname,
+			rankTitle:  "-",
+			sevTitle:   evt.Severity.String(),
+			eventTitle: evt.ID.String(),
+			msgTitle:   evt.Msg,
+		}
+		if ts, err := evt.GetTimestamp(); err == nil {
+			row[timeTitle] = ts.Local().Format(time.DateTime)
+		}
+		if rank := ranklist.Rank(evt.Rank); rank != ranklist.NilRank {
+			row[rankTitle] = rank.String()
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+	if uint64(len(resp.Events)) < resp.Total {
+		fmt.Fprintf(out, "Showing events %d-%d of %d\n", offset+1, offset+uint64(len(resp.Events)),
+			resp.Total)
+	}
+}
+
+func formatTierBytes(tierBytes []uint64) string {
+	if len(tierBytes) == 0 {
+		return "-"
+	}
+
+	tiers := make([]string, 0, len(tierBytes))
+	for _, b := range tierBytes {
+		tiers = append(tiers, humanize.Bytes(b))
+	}
+	return strings.Join(tiers, " / ")
+}
+
+// PrintSystemUsageResponse generates a human-readable representation of the
+// pool storage allocated and reserved on each rank, followed by the
+// outstanding reservations of pools that are being created. Per-tier sizes
+// are separated by "/".
+func PrintSystemUsageResponse(out io.Writer, resp *control.SystemUsageResp) {
+	if len(resp.Ranks) == 0 {
+		fmt.Fprintln(out, "No ranks found")
+		return
+	}
+
+	rankTitle := "Rank"
+	poolsTitle := "Pools"
+	allocTitle := "Allocated"
+	resvTitle := "Reserved"
+	formatter := txtfmt.NewTableFormatter(rankTitle, poolsTitle, allocTitle, resvTitle)
+
+	var table []txtfmt.TableRow
+	for _, ru := range resp.Ranks {
+		table = append(table, txtfmt.TableRow{
+			rankTitle:  ru.Rank.String(),
+			poolsTitle: fmt.Sprintf("%d", ru.NumPools),
+			allocTitle: formatTierBytes(ru.AllocatedBytes),
+			resvTitle:  formatTierBytes(ru.ReservedBytes),
+		})
+	}
+	fmt.Fprintln(out, formatter.Format(table))
+
+	if len(resp.Reservations) == 0 {
+		return
+	}
+
+	poolTitle := "Pool"
+	ranksTitle := "Ranks"
+	sizeTitle := "Per-Rank Size"
+	sinceTitle := "Since"
+	formatter = txtfmt.NewTableFormatter(poolTitle, ranksTitle, sizeTitle, sinceTitle)
+
+	table = nil
+	for _, pr := range resp.Reservations {
+		row := txtfmt.TableRow{
+			poolTitle:  pr.UUID,
+			ranksTitle: pr.Ranks.String(),
+			sizeTitle:  formatTierBytes(pr.TierBytes),
+			sinceTitle: pr.Since,
+		}
+		if pr.Label != "" {
+			row[poolTitle] = pr.Label
+		}
+		if ts, err := common.ParseTime(pr.Since); err == nil {
+			row[sinceTitle] = ts.Local().Format(time.DateTime)
+		}
+		table = append(table, row)
+	}
+	fmt.Fprintf(out, "Pending pool reservations:\n%s\n", formatter.Format(table))
+}
+
+// PrintCompatMatrix generates a human-readable representation of the versions
+// of the DAOS components in the system, and whether each of them is able to
+// interoperate with each version of the server.
+func PrintCompatMatrix(out io.Writer, groups []*control.ComponentVersionGroup, verdicts []*control.CompatVerdict) {
+	compTitle := "Component"
+	versionTitle := "Version"
+	hostsTitle := "Hosts"
+	verFormatter := txtfmt.NewTableFormatter(compTitle, versionTitle, hostsTitle)
+
+	var verTable []txtfmt.TableRow
+	for _, group := range groups {
+		verTable = append(verTable, txtfmt.TableRow{
+			compTitle:    group.Component,
+			versionTitle: group.Version,
+			hostsTitle:   group.Hosts,
+		})
+	}
+	fmt.Fprintln(out, verFormatter.Format(verTable))
+
+	if len(verdicts) == 0 {
+		fmt.Fprintln(out, "No server versions found")
+		return
+	}
+
+	srvTitle := "Server Version"
+	verdictTitle := "Verdict"
+	compatFormatter := txtfmt.NewTableFormatter(compTitle, versionTitle, srvTitle, verdictTitle)
+
+	var compatTable []txtfmt.TableRow
+	for _, verdict := range verdicts {
+		row := txtfmt.TableRow{
+			compTitle:    verdict.Component,
+			versionTitle: verdict.Version,
+			srvTitle:     verdict.ServerVersion,
+			verdictTitle: "compatible",
+		}
+		if !verdict.Compatible {
+			row[verdictTitle] = "INCOMPATIBLE: " + verdict.Reason
+		}
+		compatTable = append(compatTable, row)
+	}
+	fmt.Fprintln(out, compatFormatter.Format(compatTable))
+}
+
+// PrintLogLine writes a line read from a server log to the supplied io.Writer,
+// prefixed with the host and the log it was read from. A note of any lines
+// skipped by the server's rate limit is written before the line.
+func PrintLogLine(out io.Writer, line *control.LogLine) {
+	source := "[" + line.Source + "]"
+	if line.Rank != nil {
+		source = fmt.Sprintf("[rank %d]", *line.Rank)
+	}
+
+	if line.Dropped > 0 {
+		fmt.Fprintf(out, "%s %s (%s skipped)\n", line.Host, source,
+			english.Plural(int(line.Dropped), "line", "lines"))
+	}
+	fmt.Fprintf(out, "%s %s %s\n", line.Host, source, line.Text)
+}
+
+// PrintTailLogResponse generates a human-readable representation of the lines
+// read from the server logs and writes it to the supplied io.Writer.
+func PrintTailLogResponse(out, outErr io.Writer, resp *control.TailLogResp) error {
+	if err := PrintResponseErrors(resp, outErr); err != nil {
+		return err
+	}
+
+	for _, line := range resp.Lines {
+		PrintLogLine(out, line)
+	}
+
+	return nil
+}
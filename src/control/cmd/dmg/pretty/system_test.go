@@ -12,10 +12,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -364,6 +367,99 @@ Unknown 3 ranks: 7-9
 	}
 }
 
+func TestPretty_PrintSystemQueryTree(t *testing.T) {
+	mockMember := func(t *testing.T, idx uint32, state MemberState, fd string) *Member {
+		return MockMember(t, idx, state).WithFaultDomain(MustCreateFaultDomainFromString(fd))
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemQueryResp
+		absentHosts string
+		absentRanks string
+		expPrintStr string
+	}{
+		"empty response": {
+			resp: &control.SystemQueryResp{},
+			expPrintStr: `
+Query matches no ranks in system
+`,
+		},
+		"no fault domains with missing ranks": {
+			resp: &control.SystemQueryResp{
+				Members: Members{
+					MockMember(t, 0, MemberStateJoined),
+					MockMember(t, 1, MemberStateJoined),
+				},
+			},
+			absentRanks: "7-9",
+			expPrintStr: `
+/ (2/2 ranks available: 2 joined)
+` + "`" + `-- ranks 0-1: joined
+
+Unknown 3 ranks: 7-9
+`,
+		},
+		"degraded and down domains": {
+			resp: &control.SystemQueryResp{
+				Members: Members{
+					mockMember(t, 0, MemberStateJoined, "/rack0/node0"),
+					mockMember(t, 1, MemberStateJoined, "/rack0/node0"),
+					mockMember(t, 2, MemberStateExcluded, "/rack1/node1"),
+					mockMember(t, 3, MemberStateJoined, "/rack1/node2"),
+				},
+			},
+			expPrintStr: `
+/ (3/4 ranks available: 1 excluded, 3 joined) DEGRADED
+|-- rack0 (2/2 ranks available: 2 joined)
+|   ` + "`" + `-- node0 (2/2 ranks available: 2 joined)
+|       ` + "`" + `-- ranks 0-1: joined
+` + "`" + `-- rack1 (1/2 ranks available: 1 excluded, 1 joined) DEGRADED
+    |-- node1 (0/1 rank available: 1 excluded) DOWN
+    |   ` + "`" + `-- rank 2: excluded
+    ` + "`" + `-- node2 (1/1 rank available: 1 joined)
+        ` + "`" + `-- rank 3: joined
+
+Fault domain level 1: 1 of 2 degraded, 0 down
+Fault domain level 2: 1 of 3 degraded, 1 down
+`,
+		},
+		"labelled domains": {
+			resp: &control.SystemQueryResp{
+				Members: Members{
+					mockMember(t, 0, MemberStateJoined, "/rack=r0/node=n0"),
+					mockMember(t, 1, MemberStateStopped, "/rack=r1/node=n1"),
+				},
+			},
+			expPrintStr: `
+/ (1/2 ranks available: 1 joined, 1 stopped) DEGRADED
+|-- rack=r0 (1/1 rank available: 1 joined)
+|   ` + "`" + `-- node=n0 (1/1 rank available: 1 joined)
+|       ` + "`" + `-- rank 0: joined
+` + "`" + `-- rack=r1 (0/1 rank available: 1 stopped) DOWN
+    ` + "`" + `-- node=n1 (0/1 rank available: 1 stopped) DOWN
+        ` + "`" + `-- rank 1: stopped
+
+Fault domain rack: 1 of 2 degraded, 1 down
+Fault domain node: 1 of 2 degraded, 1 down
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.resp.AbsentRanks = *MustCreateRankSet(tc.absentRanks)
+			tc.resp.AbsentHosts = *hostlist.MustCreateSet(tc.absentHosts)
+
+			var bld strings.Builder
+			if err := PrintSystemQueryTree(&bld, &bld, tc.resp); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestPretty_PrintSystemStartResp(t *testing.T) {
 	successResults := MemberResults{
 		NewMemberResult(1, nil, MemberStateReady, "start"),
@@ -612,3 +708,592 @@ Unknown 3 hosts: foo[7-9]
 		})
 	}
 }
+
+func TestPretty_PrintSystemSetFaultDomainsResponse(t *testing.T) {
+	changes := []*control.FaultDomainChange{
+		{
+			Rank:      0,
+			Addr:      "10.0.0.1:10001",
+			OldDomain: "/host1",
+			NewDomain: "/rack=r0/node=host1",
+		},
+		{
+			Rank:      1,
+			Addr:      "10.0.0.1:10001",
+			OldDomain: "/host1",
+			NewDomain: "/rack=r0/node=host1",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemSetFaultDomainsResp
+		dryRun      bool
+		expPrintStr string
+	}{
+		"no changes": {
+			resp: &control.SystemSetFaultDomainsResp{},
+			expPrintStr: `
+No fault domain changes
+`,
+		},
+		"dry run": {
+			resp: &control.SystemSetFaultDomainsResp{
+				Changes: changes,
+			},
+			dryRun: true,
+			expPrintStr: `
+Rank Address        Current Fault Domain New Fault Domain    
+---- -------        -------------------- ----------------    
+0    10.0.0.1:10001 /host1               /rack=r0/node=host1 
+1    10.0.0.1:10001 /host1               /rack=r0/node=host1 
+
+Dry run: 2 fault domain changes not applied
+`,
+		},
+		"changes applied": {
+			resp: &control.SystemSetFaultDomainsResp{
+				Changes: changes[:1],
+			},
+			expPrintStr: `
+Rank Address        Current Fault Domain New Fault Domain    
+---- -------        -------------------- ----------------    
+0    10.0.0.1:10001 /host1               /rack=r0/node=host1 
+
+1 fault domain change applied
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemSetFaultDomainsResponse(&bld, tc.resp, tc.dryRun)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemClearFaultDomainsResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemSetFaultDomainsResp
+		dryRun      bool
+		expPrintStr string
+	}{
+		"nothing cleared": {
+			resp: &control.SystemSetFaultDomainsResp{},
+			expPrintStr: `
+No imported fault domains
+`,
+		},
+		"dry run": {
+			resp: &control.SystemSetFaultDomainsResp{
+				ClearedRanks: ranklist.MustCreateRankSet("0-1"),
+			},
+			dryRun: true,
+			expPrintStr: `
+Dry run: imported fault domains of ranks 0-1 not cleared
+`,
+		},
+		"cleared": {
+			resp: &control.SystemSetFaultDomainsResp{
+				ClearedRanks: ranklist.MustCreateRankSet("0-1,3"),
+			},
+			expPrintStr: `
+Imported fault domains of ranks 0-1,3 cleared, configured fault domains will be used when the ranks next join
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemClearFaultDomainsResponse(&bld, tc.resp, tc.dryRun)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemCleanupResponse(t *testing.T) {
+	results := []*control.CleanupResult{
+		{PoolID: "pool-1", Machine: "client1", Count: 2},
+		{PoolID: "pool-2", Machine: "client1", Count: 10},
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemCleanupResp
+		verbose     bool
+		dryRun      bool
+		expPrintStr string
+	}{
+		"no results": {
+			resp: &control.SystemCleanupResp{},
+			expPrintStr: `
+No handles cleaned up
+`,
+		},
+		"success": {
+			resp: &control.SystemCleanupResp{Results: results},
+			expPrintStr: `
+System Cleanup Success
+`,
+		},
+		"verbose": {
+			resp:    &control.SystemCleanupResp{Results: results},
+			verbose: true,
+			expPrintStr: `
+Pool   Machine Handles Revoked 
+----   ------- --------------- 
+pool-1 client1 2               
+pool-2 client1 10              
+
+`,
+		},
+		"dry run": {
+			resp:   &control.SystemCleanupResp{Results: results},
+			dryRun: true,
+			expPrintStr: `
+Pool   Machine Handles 
+----   ------- ------- 
+pool-1 client1 2       
+pool-2 client1 10      
+
+Dry run: no handles revoked
+`,
+		},
+		"unknown machines": {
+			resp: &control.SystemCleanupResp{
+				UnknownMachines: []string{"client2", "client3"},
+			},
+			expPrintStr: `
+No handles cleaned up
+Skipped 2 machines with no heartbeat record: client2, client3
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemCleanupResponse(&bld, tc.resp, tc.verbose, tc.dryRun)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemListClientsResponse(t *testing.T) {
+	lastSeen := common.FormatTime(time.Unix(1735787045, 0))
+	staleSeen := common.FormatTime(time.Unix(1735780000, 0))
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemListClientsResp
+		expPrintStr string
+	}{
+		"no clients": {
+			resp: &control.SystemListClientsResp{},
+			expPrintStr: `
+No client machines found
+`,
+		},
+		"clients": {
+			resp: &control.SystemListClientsResp{
+				Clients: []*control.ClientNode{
+					{
+						Machine:          "client1",
+						Version:          "2.6.0",
+						FabricInterfaces: []string{"ib0", "ib1"},
+						ClientCount:      3,
+						LastSeen:         1735787045,
+						Interval:         60,
+					},
+					{
+						Machine:  "client2",
+						Version:  "2.4.0",
+						LastSeen: 1735780000,
+						Interval: 60,
+						Stale:    true,
+					},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Machine Version Clients Fabric Interfaces Last Seen                     State  
+------- ------- ------- ----------------- ---------                     -----  
+client1 2.6.0   3       ib0,ib1           %s active 
+client2 2.4.0   0       -                 %s stale  
+
+`, lastSeen, staleSeen),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemListClientsResponse(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_ScheduledRankActionString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		action *control.ScheduledRankAction
+		expStr string
+	}{
+		"exclude": {
+			action: &control.ScheduledRankAction{
+				Ranks: MustCreateRankSet("0-1"),
+				Start: 1000000,
+			},
+			expStr: fmt.Sprintf("exclude ranks 0-1 at %s", formatScheduleTime(1000000)),
+		},
+		"clear-exclude": {
+			action: &control.ScheduledRankAction{
+				Ranks: MustCreateRankSet("3"),
+				Clear: true,
+				Start: 1000000,
+			},
+			expStr: fmt.Sprintf("clear-exclude ranks 3 at %s", formatScheduleTime(1000000)),
+		},
+		"maintenance window": {
+			action: &control.ScheduledRankAction{
+				Ranks: MustCreateRankSet("0-1,3"),
+				Start: 1000000,
+				End:   1003600,
+			},
+			expStr: fmt.Sprintf("exclude ranks 0-1,3 at %s, clear-exclude at %s",
+				formatScheduleTime(1000000), formatScheduleTime(1003600)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expStr, ScheduledRankActionString(tc.action), "")
+		})
+	}
+}
+
+func TestPretty_PrintSystemListScheduledResponse(t *testing.T) {
+	start := formatScheduleTime(1000000)
+	end := formatScheduleTime(1003600)
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemListScheduledResp
+		expPrintStr string
+	}{
+		"none scheduled": {
+			resp: &control.SystemListScheduledResp{},
+			expPrintStr: `
+No scheduled rank actions
+`,
+		},
+		"scheduled": {
+			resp: &control.SystemListScheduledResp{
+				Actions: []*control.ScheduledRankAction{
+					{
+						Ranks:  MustCreateRankSet("0-1"),
+						Start:  1000000,
+						End:    1003600,
+						Active: true,
+					},
+					{
+						Ranks: MustCreateRankSet("3"),
+						Clear: true,
+						Start: 1000000,
+					},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Ranks Action        Start                         End                           State   
+----- ------        -----                         ---                           -----   
+0-1   exclude       %s %s active  
+3     clear-exclude %s -                             pending 
+
+`, start, end, start),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemListScheduledResponse(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemEventsResponse(t *testing.T) {
+	ts1 := "2025-01-02T03:04:05.000000+00:00"
+	ts2 := "2025-01-02T04:04:05.000000+00:00"
+	localTime := func(ts string) string {
+		t, err := common.ParseTime(ts)
+		if err != nil {
+			panic(err)
+		}
+		return t.Local().Format(time.DateTime)
+	}
+	mockEvents := []*events.RASEvent{
+		{
+			ID:        events.RASEngineDied,
+			Timestamp: ts1,
+			Severity:  events.RASSeverityError,
+			Msg:       "engine died",
+			Hostname:  "host1",
+			Rank:      1,
+		},
+		{
+			ID:        events.RASSwimRankDead,
+			Timestamp: ts2,
+			Severity:  events.RASSeverityWarning,
+			Msg:       "rank dead",
+			Hostname:  "host2",
+			Rank:      uint32(NilRank),
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemEventsResp
+		offset      uint64
+		expPrintStr string
+	}{
+		"no events": {
+			resp: &control.SystemEventsResp{},
+			expPrintStr: `
+No events found
+`,
+		},
+		"all events": {
+			resp: &control.SystemEventsResp{
+				Events: mockEvents,
+				Total:  2,
+			},
+			expPrintStr: fmt.Sprintf(`
+Time                Host  Rank Severity Event          Message     
+----                ----  ---- -------- -----          -------     
+%s host1 1    ERROR    engine_died    engine died 
+%s host2 -    WARNING  swim_rank_dead rank dead   
+
+`, localTime(ts1), localTime(ts2)),
+		},
+		"paginated": {
+			resp: &control.SystemEventsResp{
+				Events: mockEvents,
+				Total:  10,
+			},
+			offset: 4,
+			expPrintStr: fmt.Sprintf(`
+Time                Host  Rank Severity Event          Message     
+----                ----  ---- -------- -----          -------     
+%s host1 1    ERROR    engine_died    engine died 
+%s host2 -    WARNING  swim_rank_dead rank dead   
+
+Showing events 5-6 of 10
+`, localTime(ts1), localTime(ts2)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemEventsResponse(&bld, tc.resp, tc.offset)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemUsageResponse(t *testing.T) {
+	ts := "2025-01-02T03:04:05.000000+00:00"
+	localTime, err := common.ParseTime(ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemUsageResp
+		expPrintStr string
+	}{
+		"no ranks": {
+			resp: &control.SystemUsageResp{},
+			expPrintStr: `
+No ranks found
+`,
+		},
+		"no reservations": {
+			resp: &control.SystemUsageResp{
+				Ranks: []*control.RankUsage{
+					{Rank: 0, AllocatedBytes: []uint64{2e9, 1e11}, NumPools: 1},
+					{Rank: 1},
+				},
+			},
+			expPrintStr: `
+Rank Pools Allocated       Reserved 
+---- ----- ---------       -------- 
+0    1     2.0 GB / 100 GB -        
+1    0     -               -        
+
+`,
+		},
+		"with reservations": {
+			resp: &control.SystemUsageResp{
+				Ranks: []*control.RankUsage{
+					{
+						Rank:           0,
+						AllocatedBytes: []uint64{2e9, 1e11},
+						ReservedBytes:  []uint64{1e9, 5e10},
+						NumPools:       2,
+					},
+				},
+				Reservations: []*control.PoolReservation{
+					{
+						UUID:      test.MockUUID(1),
+						Label:     "pool1",
+						Ranks:     ranklist.MustCreateRankSet("0"),
+						TierBytes: []uint64{1e9, 5e10},
+						Since:     ts,
+					},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Rank Pools Allocated       Reserved       
+---- ----- ---------       --------       
+0    2     2.0 GB / 100 GB 1.0 GB / 50 GB 
+
+Pending pool reservations:
+Pool  Ranks Per-Rank Size  Since               
+----  ----- -------------  -----               
+pool1 0     1.0 GB / 50 GB %s 
+
+`, localTime.Local().Format(time.DateTime)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemUsageResponse(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintCompatMatrix(t *testing.T) {
+	for name, tc := range map[string]struct {
+		groups      []*control.ComponentVersionGroup
+		verdicts    []*control.CompatVerdict
+		expPrintStr string
+	}{
+		"no servers": {
+			groups: []*control.ComponentVersionGroup{
+				{Component: "admin", Version: "2.6.0", Hosts: "localhost"},
+			},
+			expPrintStr: `
+Component Version Hosts     
+--------- ------- -----     
+admin     2.6.0   localhost 
+
+No server versions found
+`,
+		},
+		"incompatible agent": {
+			groups: []*control.ComponentVersionGroup{
+				{Component: "admin", Version: "2.6.0", Hosts: "localhost"},
+				{Component: "agent", Version: "1.2.0", Hosts: "10.0.0.[1-2]"},
+				{Component: "server", Version: "2.6.0", Hosts: "host[1-2]"},
+			},
+			verdicts: []*control.CompatVerdict{
+				{Component: "admin", Version: "2.6.0", ServerVersion: "2.6.0", Compatible: true},
+				{Component: "agent", Version: "1.2.0", ServerVersion: "2.6.0", Reason: "components not compatible"},
+			},
+			expPrintStr: `
+Component Version Hosts        
+--------- ------- -----        
+admin     2.6.0   localhost    
+agent     1.2.0   10.0.0.[1-2] 
+server    2.6.0   host[1-2]    
+
+Component Version Server Version Verdict                                 
+--------- ------- -------------- -------                                 
+admin     2.6.0   2.6.0          compatible                              
+agent     1.2.0   2.6.0          INCOMPATIBLE: components not compatible 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintCompatMatrix(&bld, tc.groups, tc.verdicts)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintTailLogResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp           *control.TailLogResp
+		expPrintStr    string
+		expPrintErrStr string
+	}{
+		"no lines": {
+			resp: &control.TailLogResp{},
+		},
+		"engine and control plane lines": {
+			resp: &control.TailLogResp{
+				Lines: []*control.LogLine{
+					{Host: "host1", Source: "engine", Rank: NewRankPtr(3), Text: "first"},
+					{Host: "host1", Source: "engine", Rank: NewRankPtr(3), Text: "second", Dropped: 20},
+					{Host: "host2", Source: "engine", Text: "no rank"},
+					{Host: "host2", Source: "control", Text: "control", Dropped: 1},
+				},
+			},
+			expPrintStr: `
+host1 [rank 3] first
+host1 [rank 3] (20 lines skipped)
+host1 [rank 3] second
+host2 [engine] no rank
+host2 [control] (1 line skipped)
+host2 [control] control
+`,
+		},
+		"host errors": {
+			resp: &control.TailLogResp{
+				HostErrorsResp: control.MockHostErrorsResp(t, &control.MockHostError{
+					Hosts: "host2",
+					Error: "no engines with ranks 3 on this host",
+				}),
+				Lines: []*control.LogLine{
+					{Host: "host1", Source: "engine", Rank: NewRankPtr(3), Text: "first"},
+				},
+			},
+			expPrintStr: `
+host1 [rank 3] first
+`,
+			expPrintErrStr: `
+Errors:
+  Hosts Error                                
+  ----- -----                                
+  host2 no engines with ranks 3 on this host 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out, outErr strings.Builder
+			if err := PrintTailLogResponse(&out, &outErr, tc.resp); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected stdout (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintErrStr, "\n"), outErr.String()); diff != "" {
+				t.Fatalf("unexpected stderr (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+func formatTraceDuration(d time.Duration) string {
+	if d <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.3fms", float64(d)/float64(time.Millisecond))
+}
+
+// PrintRequestTrace formats the timing breakdown of a command's RPCs as a
+// table with a row for each RPC, followed by a summary of the overall time
+// taken. The network time is the part of the RPC's total time that is not
+// accounted for by the other phases, and is only shown if the server reported
+// its processing time.
+func PrintRequestTrace(trace *control.RequestTrace, out io.Writer) error {
+	if trace == nil {
+		return errors.New("nil trace")
+	}
+
+	fmt.Fprintln(out, "Request trace:")
+	iw := txtfmt.NewIndentWriter(out)
+
+	if len(trace.RPCs) > 0 {
+		hostTitle := "Host"
+		methodTitle := "Method"
+		connTitle := "Connect"
+		shakeTitle := "Handshake"
+		sendTitle := "Send"
+		srvTitle := "Server"
+		netTitle := "Network"
+		totalTitle := "Total"
+
+		tablePrint := txtfmt.NewTableFormatter(hostTitle, methodTitle, connTitle, shakeTitle,
+			sendTitle, srvTitle, netTitle, totalTitle)
+		tablePrint.InitWriter(iw)
+		table := []txtfmt.TableRow{}
+
+		for _, rpc := range trace.RPCs {
+			var network time.Duration
+			if rpc.Server > 0 {
+				network = rpc.Total - rpc.Connect - rpc.Handshake - rpc.Send - rpc.Server
+			}
+			method := path.Base(rpc.Method)
+			if rpc.Error != "" {
+				method += " (failed)"
+			}
+
+			table = append(table, txtfmt.TableRow{
+				hostTitle:   rpc.Host,
+				methodTitle: method,
+				connTitle:   formatTraceDuration(rpc.Connect),
+				shakeTitle:  formatTraceDuration(rpc.Handshake),
+				sendTitle:   formatTraceDuration(rpc.Send),
+				srvTitle:    formatTraceDuration(rpc.Server),
+				netTitle:    formatTraceDuration(network),
+				totalTitle:  formatTraceDuration(rpc.Total),
+			})
+		}
+
+		tablePrint.Format(table)
+		fmt.Fprintln(iw)
+	}
+
+	fmt.Fprintf(iw, "RPCs:      %d\n", len(trace.RPCs))
+	fmt.Fprintf(iw, "Post-RPC:  %s\n", formatTraceDuration(trace.AfterLastRPC))
+	fmt.Fprintf(iw, "Total:     %s\n", formatTraceDuration(trace.Elapsed))
+
+	return nil
+}
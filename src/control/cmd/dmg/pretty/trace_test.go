@@ -0,0 +1,91 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintRequestTrace(t *testing.T) {
+	for name, tc := range map[string]struct {
+		trace  *control.RequestTrace
+		expOut string
+		expErr error
+	}{
+		"nil trace": {
+			expErr: errors.New("nil trace"),
+		},
+		"no RPCs": {
+			trace: &control.RequestTrace{
+				Elapsed: time.Millisecond,
+			},
+			expOut: `
+Request trace:
+  RPCs:      0
+  Post-RPC:  -
+  Total:     1.000ms
+`,
+		},
+		"multiple hosts": {
+			trace: &control.RequestTrace{
+				RPCs: []*control.RPCTrace{
+					{
+						Host:      "host1:10001",
+						Method:    "/ctl.MgmtSvc/PoolQuery",
+						Connect:   400 * time.Microsecond,
+						Handshake: 2500 * time.Microsecond,
+						Send:      100 * time.Microsecond,
+						Server:    12 * time.Millisecond,
+						Total:     20 * time.Millisecond,
+					},
+					{
+						Host:      "host2:10001",
+						Method:    "/ctl.CtlSvc/StorageScan",
+						Connect:   300 * time.Microsecond,
+						Handshake: 2 * time.Millisecond,
+						Total:     5 * time.Millisecond,
+						Error:     "failed",
+					},
+				},
+				AfterLastRPC: 1500 * time.Microsecond,
+				Elapsed:      25 * time.Millisecond,
+			},
+			expOut: `
+Request trace:
+  Host        Method               Connect Handshake Send    Server   Network Total    
+  ----        ------               ------- --------- ----    ------   ------- -----    
+  host1:10001 PoolQuery            0.400ms 2.500ms   0.100ms 12.000ms 5.000ms 20.000ms 
+  host2:10001 StorageScan (failed) 0.300ms 2.000ms   -       -        -       5.000ms  
+
+  RPCs:      2
+  Post-RPC:  1.500ms
+  Total:     25.000ms
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintRequestTrace(tc.trace, &bld)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// progressBarWidth is the number of characters in the bar of a progress line.
+const progressBarWidth = 30
+
+// progressBar displays the updates of the progress of a request pushed by the
+// server on a single line, which is rewritten with each update.
+type progressBar struct {
+	sync.Mutex
+	out      io.Writer
+	showHost bool
+	start    time.Time
+	lastLen  int
+	now      func() time.Time
+}
+
+func newProgressBar(out io.Writer, showHost bool) *progressBar {
+	pb := &progressBar{
+		out:      out,
+		showHost: showHost,
+		now:      time.Now,
+	}
+	pb.start = pb.now()
+
+	return pb
+}
+
+// terminalProgressBar returns a progress bar writing to stdout, or nil if JSON
+// output is enabled or stdout is not a terminal.
+func terminalProgressBar(jsonOutput, showHost bool) *progressBar {
+	if jsonOutput {
+		return nil
+	}
+	fi, err := os.Stdout.Stat()
+	if err != nil || fi.Mode()&os.ModeCharDevice == 0 {
+		return nil
+	}
+
+	return newProgressBar(os.Stdout, showHost)
+}
+
+// formatProgress returns the line displaying the progress update. The bar is
+// omitted if the progress of the stage can not be measured.
+func formatProgress(update *control.ProgressUpdate, showHost bool, elapsed time.Duration) string {
+	var bld strings.Builder
+	if showHost && update.Host != "" {
+		fmt.Fprintf(&bld, "%s: ", update.Host)
+	}
+	bld.WriteString(update.Stage)
+
+	if update.Total > 0 {
+		filled := progressBarWidth * min(update.Completed, update.Total) / update.Total
+		fmt.Fprintf(&bld, " [%s%s] %d/%d", strings.Repeat("=", filled),
+			strings.Repeat(" ", progressBarWidth-filled), update.Completed, update.Total)
+	}
+	fmt.Fprintf(&bld, " (%s)", elapsed.Round(time.Second))
+
+	return bld.String()
+}
+
+// updateFunc returns the function to be set on the request to display its
+// progress, or nil if the progress bar is disabled.
+func (pb *progressBar) updateFunc() control.ProgressFunc {
+	if pb == nil {
+		return nil
+	}
+
+	return func(update *control.ProgressUpdate) {
+		pb.Lock()
+		defer pb.Unlock()
+
+		line := formatProgress(update, pb.showHost, pb.now().Sub(pb.start))
+		pad := ""
+		if len(line) < pb.lastLen {
+			pad = strings.Repeat(" ", pb.lastLen-len(line))
+		}
+		fmt.Fprintf(pb.out, "\r%s%s", line, pad)
+		pb.lastLen = len(line)
+	}
+}
+
+// done clears the progress line so that the response may be printed.
+func (pb *progressBar) done() {
+	if pb == nil {
+		return
+	}
+
+	pb.Lock()
+	defer pb.Unlock()
+
+	if pb.lastLen > 0 {
+		fmt.Fprintf(pb.out, "\r%s\r", strings.Repeat(" ", pb.lastLen))
+		pb.lastLen = 0
+	}
+}
@@ -0,0 +1,73 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestDmg_formatProgress(t *testing.T) {
+	for name, tc := range map[string]struct {
+		update   *control.ProgressUpdate
+		showHost bool
+		expLine  string
+	}{
+		"unmeasured stage": {
+			update:  &control.ProgressUpdate{Host: "host1", Stage: "creating pool on 8 ranks"},
+			expLine: "creating pool on 8 ranks (5s)",
+		},
+		"partial": {
+			update:  &control.ProgressUpdate{Stage: "stopping ranks", Completed: 1, Total: 3},
+			expLine: "stopping ranks [==========                    ] 1/3 (5s)",
+		},
+		"complete with host": {
+			update: &control.ProgressUpdate{
+				Host: "host1:10001", Stage: "formatting SCM", Completed: 2, Total: 2,
+			},
+			showHost: true,
+			expLine:  "host1:10001: formatting SCM [==============================] 2/2 (5s)",
+		},
+		"completed exceeds total": {
+			update:  &control.ProgressUpdate{Stage: "stopping ranks", Completed: 4, Total: 2},
+			expLine: "stopping ranks [==============================] 4/2 (5s)",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			line := formatProgress(tc.update, tc.showHost, 5*time.Second)
+			test.AssertEqual(t, tc.expLine, line, "unexpected progress line")
+		})
+	}
+}
+
+func TestDmg_progressBar(t *testing.T) {
+	var nilBar *progressBar
+	test.AssertTrue(t, nilBar.updateFunc() == nil, "expected no update function")
+	nilBar.done()
+
+	var out strings.Builder
+	bar := newProgressBar(&out, false)
+	bar.now = func() time.Time { return bar.start }
+
+	update := bar.updateFunc()
+	update(&control.ProgressUpdate{Stage: "preparing ranks for shutdown"})
+	update(&control.ProgressUpdate{Stage: "stopping ranks"})
+	bar.done()
+
+	expOut := "\rpreparing ranks for shutdown (0s)" +
+		"\rstopping ranks (0s)              " +
+		"\r" + strings.Repeat(" ", len("stopping ranks (0s)")) + "\r"
+	if diff := cmp.Diff(expOut, out.String()); diff != "" {
+		t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -19,6 +20,7 @@ import (
 // serverCmd is the struct representing the top-level server subcommand.
 type serverCmd struct {
 	SetLogMasks serverSetLogMasksCmd `command:"set-logmasks" alias:"slm" description:"Set log masks for a set of facilities to a given level and optionally specify debug streams to enable. Setting will be applied to all running DAOS I/O Engines present in the configured dmg hostlist."`
+	ListEngines serverListEnginesCmd `command:"list-engines" alias:"le" description:"List the DAOS I/O Engines on each host in the configured dmg hostlist, with their rank, state, NUMA affinity, fabric interface, storage tiers, uptime and version."`
 }
 
 // serverSetLogMasksCmd is the struct representing the command to set engine log
@@ -72,3 +74,44 @@ func (cmd *serverSetLogMasksCmd) Execute(_ []string) (errOut error) {
 
 	return resp.Errors()
 }
+
+// serverListEnginesCmd is the struct representing the command to list the engines
+// on a set of hosts.
+type serverListEnginesCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when serverListEnginesCmd activates.
+func (cmd *serverListEnginesCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "list engines failed")
+	}()
+
+	req := new(control.ListEnginesReq)
+	req.SetHostList(cmd.getHostList())
+
+	resp, err := control.ListEngines(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	cmd.Tracef("list engines response: %+v", resp)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintListEnginesResp(resp, &out, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+	cmd.Info(out.String())
+
+	return resp.Errors()
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -37,6 +38,18 @@ func TestDmg_ServerCommands(t *testing.T) {
 			"",
 			errors.New("unknown flag"),
 		},
+		{
+			"List engines",
+			"server list-engines",
+			printRequest(t, &control.ListEnginesReq{}),
+			nil,
+		},
+		{
+			"List engines with alias",
+			"server le",
+			printRequest(t, &control.ListEnginesReq{}),
+			nil,
+		},
 		{
 			"Set log masks with debug streams (DD_MASK)",
 			"server set-logmasks -m ERR,mgmt=DEBUG -d MGMT,IO",
@@ -19,14 +19,16 @@ import (
 
 // storageCmd is the struct representing the top-level storage subcommand.
 type storageCmd struct {
-	Scan          storageScanCmd    `command:"scan" description:"Scan SCM and NVMe storage attached to remote servers."`
-	Format        storageFormatCmd  `command:"format" description:"Format SCM and NVMe storage attached to remote servers."`
-	Query         storageQueryCmd   `command:"query" description:"Query storage commands, including raw NVMe SSD device health stats and internal blobstore health info."`
-	NvmeRebind    nvmeRebindCmd     `command:"nvme-rebind" description:"Detach NVMe SSD from kernel driver and rebind to userspace driver for use with DAOS."`
-	NvmeAddDevice nvmeAddDeviceCmd  `command:"nvme-add-device" description:"Add a hot-inserted NVMe SSD to a specific engine configuration to enable the new device to be used."`
-	Set           setFaultyCmd      `command:"set" description:"Manually set the device state."`
-	Replace       storageReplaceCmd `command:"replace" description:"Replace a storage device that has been hot-removed with a new device."`
-	LedManage     ledManageCmd      `command:"led" description:"Manage LED status for supported drives."`
+	Scan          storageScanCmd     `command:"scan" description:"Scan SCM and NVMe storage attached to remote servers."`
+	Format        storageFormatCmd   `command:"format" description:"Format SCM and NVMe storage attached to remote servers."`
+	Query         storageQueryCmd    `command:"query" description:"Query storage commands, including raw NVMe SSD device health stats and internal blobstore health info."`
+	NvmeRebind    nvmeRebindCmd      `command:"nvme-rebind" description:"Detach NVMe SSD from kernel driver and rebind to userspace driver for use with DAOS."`
+	NvmeAddDevice nvmeAddDeviceCmd   `command:"nvme-add-device" description:"Add a hot-inserted NVMe SSD to a specific engine configuration to enable the new device to be used."`
+	Set           setFaultyCmd       `command:"set" description:"Manually set the device state."`
+	Replace       storageReplaceCmd  `command:"replace" description:"Replace a storage device that has been hot-removed with a new device."`
+	LedManage     ledManageCmd       `command:"led" description:"Manage LED status for supported drives."`
+	Estimate      storageEstimateCmd `command:"estimate" description:"Estimate the storage required for a pool workload and recommend pool create parameters."`
+	Map           storageMapCmd      `command:"map" description:"Show the placement of a pool's targets on storage devices, shaded by utilization."`
 }
 
 // storageScanCmd is the struct representing the scan storage subcommand.
@@ -119,7 +121,10 @@ func (cmd *storageFormatCmd) Execute(args []string) (err error) {
 	req := &control.StorageFormatReq{Reformat: cmd.Force, Replace: cmd.Replace}
 	req.SetHostList(cmd.getHostList())
 
+	bar := terminalProgressBar(cmd.JSONOutputEnabled(), true)
+	req.SetProgressFunc(bar.updateFunc())
 	resp, err := control.StorageFormat(ctx, cmd.ctlInvoker, req)
+	bar.done()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,97 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/estimate"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+// estimateFlags describes the expected workload of a pool, from which storage
+// requirements are estimated.
+type estimateFlags struct {
+	Objects         uint64          `long:"objects" description:"Expected number of objects to be stored in the pool"`
+	ObjectSize      ui.ByteSizeFlag `long:"object-size" description:"Expected average size of each object"`
+	ValueSize       ui.ByteSizeFlag `long:"value-size" description:"Expected size of each value written to an object (default: object size, up to 1MiB)"`
+	DkeysPerObject  uint64          `long:"dkeys-per-object" description:"Expected number of distribution keys per object (default: one per value)"`
+	Redundancy      string          `long:"redundancy" description:"Object class used to store the objects, e.g. RP_3G1 or EC_8P2GX (default: no redundancy)"`
+	Headroom        uint64          `long:"headroom" default:"20" description:"Percentage of free space to add to the estimate when recommending a pool size"`
+	EstimateBackend string          `long:"estimate-backend" description:"Backend used to estimate storage requirements, either a built-in model or exec:<path> to run an external estimator (default: vos)"`
+}
+
+func (f *estimateFlags) isSet() bool {
+	return f.Objects != 0 || f.ObjectSize.IsSet()
+}
+
+func (f *estimateFlags) workload() *estimate.Workload {
+	return &estimate.Workload{
+		Objects:        f.Objects,
+		ObjectSize:     f.ObjectSize.Bytes,
+		ValueSize:      f.ValueSize.Bytes,
+		DkeysPerObject: f.DkeysPerObject,
+		Redundancy:     f.Redundancy,
+	}
+}
+
+func (f *estimateFlags) estimate(ctx context.Context) (*estimate.Estimate, error) {
+	backend, err := estimate.Get(f.EstimateBackend)
+	if err != nil {
+		return nil, err
+	}
+
+	return backend.Estimate(ctx, f.workload())
+}
+
+// storageEstimateCmd is the struct representing the estimate storage subcommand.
+type storageEstimateCmd struct {
+	baseCmd
+	cmdutil.JSONOutputCmd
+	estimateFlags
+	MdOnSsd bool `long:"md-on-ssd" description:"Recommend a mem-ratio for pools created in MD-on-SSD mode"`
+}
+
+// Execute is run when storageEstimateCmd activates.
+//
+// Estimates the storage required for the described workload and recommends
+// pool create parameters.
+func (cmd *storageEstimateCmd) Execute(_ []string) error {
+	est, err := cmd.estimate(cmd.MustLogCtx())
+	if err != nil {
+		return err
+	}
+	cmd.Debugf("storage estimate for %+v: %+v", cmd.workload(), est)
+
+	if cmd.JSONOutputEnabled() {
+		meta, data := est.TierRatio()
+		return cmd.OutputJSON(struct {
+			*estimate.Estimate
+			PoolSize  uint64   `json:"pool_size"`
+			TierRatio []uint64 `json:"tier_ratio"`
+			MemRatio  uint64   `json:"mem_ratio"`
+		}{
+			Estimate:  est,
+			PoolSize:  est.PoolSize(cmd.Headroom),
+			TierRatio: []uint64{meta, data},
+			MemRatio:  est.MemRatio(),
+		}, nil)
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintStorageEstimate(&out, est, cmd.Headroom); err != nil {
+		return err
+	}
+	out.WriteString("Suggested pool create command:\n  dmg pool create " +
+		pretty.EstimatePoolCreateArgs(est, cmd.Headroom, cmd.MdOnSsd) + " <pool label>\n")
+	cmd.Info(out.String())
+
+	return nil
+}
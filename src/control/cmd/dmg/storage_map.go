@@ -0,0 +1,51 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// storageMapCmd is the struct representing the map storage subcommand.
+type storageMapCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	Pool PoolID `short:"p" long:"pool" required:"1" description:"Label or UUID of the pool to map"`
+}
+
+// Execute is run when storageMapCmd activates.
+//
+// Displays the placement of a pool's targets on the storage devices of the
+// pool engines, shaded by utilization.
+func (cmd *storageMapCmd) Execute(_ []string) error {
+	req := &control.StorageMapReq{
+		ID:       cmd.Pool.String(),
+		HostList: cmd.getHostList(),
+	}
+
+	cmd.Debugf("storage map request: %+v", req)
+
+	sm, err := control.GetStorageMap(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(sm, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintStorageMap(sm, &out)
+	cmd.Infof("%s", out.String())
+
+	return nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,7 +9,9 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -16,7 +19,9 @@ import (
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/ui"
 )
 
 type rankCmd struct {
@@ -125,6 +130,40 @@ type usageQueryCmd struct {
 	cmdutil.JSONOutputCmd
 	ShowUsable bool          `short:"u" long:"show-usable" description:"Set to display potential data capacity of future pools by factoring in a new pool's metadata overhead. This can include the use of MD-on-SSD mem-ratio if specified to calculate meta-blob size when adjusting NVMe free capacity"`
 	MemRatio   tierRatioFlag `long:"mem-ratio" description:"Set the percentage of the pool metadata storage size (on SSD) that should be used as the memory file size (on ram-disk). Used to calculate data size for new MD-on-SSD phase-2 pools. Only valid with --show-usable flag"`
+	Aggregate  string        `short:"a" long:"aggregate" choice:"host" choice:"pool" description:"Roll up SCM & NVMe usage per host or per pool, with totals over all hosts or pools"`
+}
+
+// aggregateByPool displays the SCM & NVMe usage of each pool in the system.
+func (cmd *usageQueryCmd) aggregateByPool(ctx context.Context) error {
+	resp, err := control.ListPools(ctx, cmd.ctlInvoker, new(control.ListPoolsReq))
+	if err != nil {
+		return err
+	}
+
+	var queryErrs []string
+	pools := make([]*daos.PoolInfo, 0, len(resp.Pools))
+	for _, pool := range resp.Pools {
+		if qErr := resp.PoolQueryError(pool.UUID); qErr != nil {
+			queryErrs = append(queryErrs, fmt.Sprintf("%s: %s", pool.Name(), qErr))
+			continue
+		}
+		pools = append(pools, pool)
+	}
+	summary := control.UsageByPool(pools)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(summary, nil)
+	}
+
+	if len(queryErrs) > 0 {
+		cmd.Errorf("Query failed for %d pools:\n%s", len(queryErrs), strings.Join(queryErrs, "\n"))
+	}
+
+	var out strings.Builder
+	pretty.PrintPoolStorageUsageSummary(summary, &out)
+	cmd.Infof("%s", out.String())
+
+	return nil
 }
 
 // Execute is run when usageQueryCmd activates.
@@ -132,6 +171,13 @@ type usageQueryCmd struct {
 // Queries storage usage on hosts.
 func (cmd *usageQueryCmd) Execute(_ []string) error {
 	ctx := cmd.MustLogCtx()
+	if cmd.Aggregate != "" && cmd.ShowUsable {
+		return errors.New("--show-usable is not supported with --aggregate")
+	}
+	if cmd.Aggregate == "pool" {
+		return cmd.aggregateByPool(ctx)
+	}
+
 	req := &control.StorageScanReq{
 		Usage: true,
 	}
@@ -149,6 +195,9 @@ func (cmd *usageQueryCmd) Execute(_ []string) error {
 	resp, err := control.StorageScan(ctx, cmd.ctlInvoker, req)
 
 	if cmd.JSONOutputEnabled() {
+		if err == nil && cmd.Aggregate == "host" {
+			return cmd.OutputJSON(resp.HostStorage.UsageByHost(), resp.Errors())
+		}
 		return cmd.OutputJSON(resp, err)
 	}
 
@@ -165,7 +214,9 @@ func (cmd *usageQueryCmd) Execute(_ []string) error {
 	}
 
 	var out, dbg strings.Builder
-	if resp.HostStorage.IsMdOnSsdEnabled() {
+	if cmd.Aggregate == "host" {
+		pretty.PrintHostStorageUsageSummary(resp.HostStorage.UsageByHost(), &out)
+	} else if resp.HostStorage.IsMdOnSsdEnabled() {
 		if err := pretty.PrintHostStorageUsageMapMdOnSsd(resp.HostStorage, &out, &dbg, cmd.ShowUsable); err != nil {
 			cmd.Error(err.Error())
 		}
@@ -282,11 +333,25 @@ func (cmd *nvmeReplaceCmd) Execute(_ []string) error {
 type ledCmd struct {
 	smdManageCmd
 	hostListCmd
+	Pool string `short:"p" long:"pool" description:"Only select the SSDs backing the given pool (label or UUID)"`
 	Args struct {
-		IDs string `positional-arg-name:"ids" description:"Comma-separated list of identifiers which could be either VMD backing device (NVMe SSD) PCI addresses or device UUIDs. All SSDs selected if arg not provided."`
+		IDs string `positional-arg-name:"ids" description:"Comma-separated list of identifiers which could be either VMD backing device (NVMe SSD) PCI addresses or device UUIDs. Identifiers may contain glob patterns (e.g. 5d0505:*). All SSDs selected if arg not provided."`
 	} `positional-args:"yes"`
 }
 
+func (cmd *ledCmd) newRequest(op control.SmdManageOpcode) *control.SmdManageReq {
+	if cmd.Args.IDs == "" && cmd.Pool == "" {
+		cmd.Debugf("neither a pci address, a uuid or a pool has been supplied so select all")
+	}
+	req := &control.SmdManageReq{
+		Operation: op,
+		IDs:       cmd.Args.IDs,
+		Pool:      cmd.Pool,
+	}
+	req.SetHostList(cmd.getHostList())
+	return req
+}
+
 type ledManageCmd struct {
 	Check    ledCheckCmd    `command:"check" description:"Retrieve the current LED state of specified VMD device."`
 	Identify ledIdentifyCmd `command:"identify" description:"Blink the status LED on specified VMD device (for the purpose of visual SSD identification). Default duration is 2 minutes."`
@@ -294,47 +359,38 @@ type ledManageCmd struct {
 
 type ledIdentifyCmd struct {
 	ledCmd
-	Timeout uint32 `long:"timeout" description:"Number of minutes to blink the status LED for"`
-	Reset   bool   `long:"reset" description:"Reset blinking LED on specified VMD device back to previous state"`
+	Timeout ui.MinutesFlag `long:"timeout" description:"Time to blink the status LED for, rounded up to whole minutes (e.g. 90s, 2h); a number without a unit is in minutes"`
+	Reset   bool           `long:"reset" description:"Reset blinking LED on specified VMD device back to previous state"`
 }
 
 // Execute is run when ledIdentifyCmd activates.
 //
 // Runs SPDK VMD API commands to set the LED state on the VMD to "IDENTIFY" (4Hz blink).
 func (cmd *ledIdentifyCmd) Execute(_ []string) error {
-	if cmd.Args.IDs == "" {
-		cmd.Debugf("neither a pci address or a uuid has been supplied so select all")
-	}
-	req := &control.SmdManageReq{
-		Operation:       control.LedBlinkOp,
-		IDs:             cmd.Args.IDs,
-		IdentifyTimeout: cmd.Timeout,
-	}
+	req := cmd.newRequest(control.LedBlinkOp)
+	req.IdentifyTimeout = uint32((cmd.Timeout.Duration + time.Minute - 1) / time.Minute)
 	if cmd.Reset {
-		if cmd.Timeout != 0 {
+		if req.IdentifyTimeout != 0 {
 			return errors.New("timeout option can not be set at the same time as reset")
 		}
 		req.Operation = control.LedResetOp
 	}
-	req.SetHostList(cmd.getHostList())
 	return cmd.makeRequest(cmd.MustLogCtx(), req, pretty.PrintOnlyLEDInfo())
 }
 
 type ledCheckCmd struct {
 	ledCmd
+	Table bool `short:"t" long:"table" description:"Display the LED state of the selected SSDs in a table"`
 }
 
 // Execute is run when ledCheckCmd activates.
 //
 // Runs SPDK VMD API commands to query the LED state on VMD devices
 func (cmd *ledCheckCmd) Execute(_ []string) error {
-	if cmd.Args.IDs == "" {
-		cmd.Debugf("neither a pci address or a uuid has been supplied so select all")
+	req := cmd.newRequest(control.LedCheckOp)
+	if cmd.Table {
+		return cmd.makeRequest(cmd.MustLogCtx(), req, pretty.PrintOnlyLEDInfo(),
+			pretty.PrintLEDTable())
 	}
-	req := &control.SmdManageReq{
-		Operation: control.LedCheckOp,
-		IDs:       cmd.Args.IDs,
-	}
-	req.SetHostList(cmd.getHostList())
 	return cmd.makeRequest(cmd.MustLogCtx(), req, pretty.PrintOnlyLEDInfo())
 }
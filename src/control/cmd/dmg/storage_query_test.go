@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -157,6 +158,30 @@ func TestStorageQueryCommands(t *testing.T) {
 			printRequest(t, &control.StorageScanReq{Usage: true}),
 			nil,
 		},
+		{
+			"per-server storage space query aggregated by host",
+			"storage query usage --aggregate host",
+			printRequest(t, &control.StorageScanReq{Usage: true}),
+			nil,
+		},
+		{
+			"storage space query aggregated by pool",
+			"storage query usage -a pool",
+			printRequest(t, &control.ListPoolsReq{}),
+			nil,
+		},
+		{
+			"storage space query aggregated with --show-usable",
+			"storage query usage --aggregate host --show-usable",
+			"",
+			errors.New("not supported with --aggregate"),
+		},
+		{
+			"storage space query with invalid aggregation",
+			"storage query usage --aggregate rank",
+			"",
+			errors.New("Invalid value"),
+		},
 		{
 			"Set FAULTY device status (missing host)",
 			"storage set nvme-faulty --uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d -f",
@@ -261,6 +286,32 @@ func TestStorageQueryCommands(t *testing.T) {
 			}),
 			nil,
 		},
+		{
+			"Identify a device with human-readable timeout",
+			"storage led identify --timeout 1h30m 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			printRequest(t, &control.SmdManageReq{
+				Operation:       control.LedBlinkOp,
+				IDs:             "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+				IdentifyTimeout: 90,
+			}),
+			nil,
+		},
+		{
+			"Identify a device with sub-minute timeout rounded up",
+			"storage led identify --timeout 90s 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			printRequest(t, &control.SmdManageReq{
+				Operation:       control.LedBlinkOp,
+				IDs:             "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+				IdentifyTimeout: 2,
+			}),
+			nil,
+		},
+		{
+			"Identify a device with invalid timeout",
+			"storage led identify --timeout soon 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			"",
+			errors.New("invalid duration"),
+		},
 		{
 			"Reset LED on device",
 			"storage led identify --reset 842c739b-86b5-462f-a7ba-b4a91b674f3d",
@@ -311,6 +362,15 @@ func TestStorageQueryCommands(t *testing.T) {
 			}),
 			nil,
 		},
+		{
+			"check LED state of multiple devices in a table",
+			"storage led check --table 842c739b-86b5-462f-a7ba-b4a91b674f3d,d50505:01:00.0",
+			printRequest(t, &control.SmdManageReq{
+				Operation: control.LedCheckOp,
+				IDs:       "842c739b-86b5-462f-a7ba-b4a91b674f3d,d50505:01:00.0",
+			}),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"storage query quack",
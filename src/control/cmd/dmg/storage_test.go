@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -13,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
 func TestStorageCommands(t *testing.T) {
@@ -154,6 +156,45 @@ func TestStorageCommands(t *testing.T) {
 			printRequest(t, nvmeAddDeviceReq().WithStorageTierIndex(0)),
 			nil,
 		},
+		{
+			"Estimate",
+			"storage estimate --objects 1000 --object-size 1MiB --redundancy EC_4P2G1",
+			"",
+			nil,
+		},
+		{
+			"Estimate with external backend",
+			"storage estimate --objects 1000 --object-size 1MiB --estimate-backend exec:",
+			"",
+			errors.New("no program path"),
+		},
+		{
+			"Estimate without workload",
+			"storage estimate",
+			"",
+			errors.New("number of objects must be greater than zero"),
+		},
+		{
+			"Estimate with bad value size",
+			"storage estimate --objects 1000 --object-size 1KiB --value-size 1MiB",
+			"",
+			errors.New("value size"),
+		},
+		{
+			"Map without pool",
+			"storage map",
+			"",
+			errors.New("--pool"),
+		},
+		{
+			"Map",
+			"storage map --pool foo",
+			printRequest(t, &control.PoolQueryReq{
+				ID:        "foo",
+				QueryMask: daos.MustNewPoolQueryMask(daos.PoolQueryOptionEnabledEngines, daos.PoolQueryOptionDisabledEngines),
+			}),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"storage quack",
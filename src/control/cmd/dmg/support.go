@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,10 +34,23 @@ type collectLogCmd struct {
 	hostListCmd
 	cmdutil.JSONOutputCmd
 	support.CollectLogSubCmd
-	bld strings.Builder
+	bld    strings.Builder
+	report *support.Report
 	support.LogTypeSubCmd
 }
 
+// hostErrorStrings returns a description of each of the host errors in the
+// response, for the summary report.
+func hostErrorStrings(resp *control.CollectLogResp) []string {
+	var errStrs []string
+	for errStr, hes := range resp.GetHostErrors() {
+		errStrs = append(errStrs, fmt.Sprintf("%s: %s", hes.HostSet.RangedString(), errStr))
+	}
+	sort.Strings(errStrs)
+
+	return errStrs
+}
+
 // gRPC call to initiate the rsync and copy the logs to Admin (central location).
 func (cmd *collectLogCmd) rsyncLog() error {
 	hostName, err := support.GetHostName()
@@ -51,8 +66,13 @@ func (cmd *collectLogCmd) rsyncLog() error {
 	}
 	cmd.Debugf("Rsync logs from servers to %s:%s ", hostName, cmd.TargetFolder)
 	resp, err := control.CollectLog(cmd.MustLogCtx(), cmd.ctlInvoker, req)
-	if err != nil && cmd.StopOnError {
-		return err
+	if err != nil {
+		cmd.report.AddStepErr(support.StepName(support.RsyncLogEnum, ""), err)
+		if cmd.StopOnError {
+			return err
+		}
+	} else {
+		cmd.report.AddStep(support.StepName(support.RsyncLogEnum, ""), hostErrorStrings(resp)...)
 	}
 	if len(resp.GetHostErrors()) > 0 {
 		if err := pretty.UpdateErrorSummary(resp, "rsync", &cmd.bld); err != nil {
@@ -93,47 +113,58 @@ func (cmd *collectLogCmd) archLogsOnServer() error {
 
 // Execute is run when supportCmd activates.
 func (cmd *collectLogCmd) Execute(_ []string) error {
-	// Default log collection set
-	var LogCollection = map[int32][]string{}
-	var DmgInfoCollection = map[int32][]string{}
-
 	err := cmd.DateTimeValidate()
 	if err != nil {
 		return err
 	}
 
+	serverLogs, err := cmd.LogTypeValidate()
+	if err != nil {
+		return err
+	}
+
+	// Default log collection set, run on the servers.
+	type remoteStep struct {
+		logFunc int32
+		logCmds []string
+	}
+	var remoteSteps []remoteStep
+	var dmgCollectors []support.Collector
+
 	// Only collect the specific logs Admin,Control or Engine.
 	// This will ignore the system information collection.
-	if cmd.LogType != "" {
-		LogCollection[support.CollectServerLogEnum], err = cmd.LogTypeValidate()
-		if err != nil {
-			return err
-		}
-	} else {
+	if cmd.LogType == "" {
 		// Default collect everything from servers
-		LogCollection[support.CollectSystemCmdEnum] = support.SystemCmd
-		LogCollection[support.CollectDaosServerCmdEnum] = support.DaosServerCmd
-		LogCollection[support.CopyServerConfigEnum] = []string{""}
-		LogCollection[support.CollectServerLogEnum], err = cmd.LogTypeValidate()
-		if err != nil {
-			return err
-		}
+		remoteSteps = append(remoteSteps,
+			remoteStep{support.CollectSystemCmdEnum, support.SystemCmd},
+			remoteStep{support.CollectDaosServerCmdEnum, support.DaosServerCmd},
+			remoteStep{support.CopyServerConfigEnum, []string{""}},
+		)
 
 		// dmg command info collection set
-		DmgInfoCollection[support.CollectDmgCmdEnum] = support.DmgCmd
-		DmgInfoCollection[support.CollectDmgDiskInfoEnum] = []string{""}
+		dmgCollectors = append(dmgCollectors, support.NewCollectors(support.CollectDmgCmdEnum, support.DmgCmd...)...)
+		dmgCollectors = append(dmgCollectors, support.NewCollectors(support.CollectDmgDiskInfoEnum)...)
+	}
+	remoteSteps = append(remoteSteps, remoteStep{support.CollectServerLogEnum, serverLogs})
+
+	// Add custom log location
+	if cmd.ExtraLogsDir != "" {
+		remoteSteps = append(remoteSteps, remoteStep{support.CollectExtraLogsDirEnum, []string{""}})
+	}
+
+	// Run the site-specific collectors on the servers. The manifest is read
+	// from the given path on each server.
+	if cmd.CollectorsManifest != "" {
+		remoteSteps = append(remoteSteps, remoteStep{support.CollectManifestEnum, []string{cmd.CollectorsManifest}})
 	}
 
 	// set of support collection steps to show in progress bar
 	progress := support.ProgressBar{
-		Total:     len(LogCollection) + len(DmgInfoCollection) + 1, // Extra 1 is for rsync operation.
+		Total:     support.EstimateSteps(dmgCollectors...) + 1, // Extra 1 is for rsync operation.
 		NoDisplay: cmd.JSONOutputEnabled(),
 	}
-
-	// Add custom log location
-	if cmd.ExtraLogsDir != "" {
-		LogCollection[support.CollectExtraLogsDirEnum] = []string{""}
-		progress.Total++
+	for _, step := range remoteSteps {
+		progress.Total += len(step.logCmds)
 	}
 
 	// Increase progress counter for Archive if enabled
@@ -152,6 +183,7 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 	if err := os.Mkdir(cmd.TargetFolder, 0700); err != nil && !os.IsExist(err) {
 		return err
 	}
+	cmd.report = support.NewReport("dmg support collect-log")
 
 	// Check if DAOS Management Service is up and running
 	params := support.CollectLogsParams{}
@@ -170,8 +202,9 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 	}
 
 	// Copy log/config file to TargetFolder on all servers
-	for logFunc, logCmdSet := range LogCollection {
-		for _, logCmd := range logCmdSet {
+	for _, step := range remoteSteps {
+		logFunc := step.logFunc
+		for _, logCmd := range step.logCmds {
 			cmd.Debugf("Log Function %d -- Log Collect Cmd %s ", logFunc, logCmd)
 			ctx := cmd.MustLogCtx()
 			req := &control.CollectLogReq{
@@ -189,8 +222,13 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 			req.SetHostList(cmd.hostlist)
 
 			resp, err := control.CollectLog(ctx, cmd.ctlInvoker, req)
-			if err != nil && cmd.StopOnError {
-				return err
+			if err != nil {
+				cmd.report.AddStepErr(support.StepName(logFunc, logCmd), err)
+				if cmd.StopOnError {
+					return err
+				}
+			} else {
+				cmd.report.AddStep(support.StepName(logFunc, logCmd), hostErrorStrings(resp)...)
 			}
 			if len(resp.GetHostErrors()) > 0 {
 				if err := pretty.UpdateErrorSummary(resp, logCmd, &cmd.bld); err != nil {
@@ -201,8 +239,8 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 					return resp.Errors()
 				}
 			}
+			fmt.Print(progress.Display())
 		}
-		fmt.Print(progress.Display())
 	}
 
 	// Run dmg command info collection set
@@ -212,19 +250,15 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 	params.ExtraLogsDir = cmd.ExtraLogsDir
 	params.JsonOutput = cmd.JSONOutputEnabled()
 	params.Hostlist = strings.Join(cmd.hostlist, " ")
-	for logFunc, logCmdSet := range DmgInfoCollection {
-		for _, logCmd := range logCmdSet {
-			params.LogFunction = logFunc
-			params.LogCmd = logCmd
-
-			err := support.CollectSupportLog(cmd.Logger, params)
-			if err != nil {
-				if cmd.StopOnError {
-					return err
-				}
-			}
-		}
-		fmt.Print(progress.Display())
+	runner := &support.CollectorRunner{
+		Log:         cmd.Logger,
+		Report:      cmd.report,
+		Progress:    &progress,
+		Output:      os.Stdout,
+		StopOnError: cmd.StopOnError,
+	}
+	if err := runner.Run(params, dmgCollectors...); err != nil {
+		return err
 	}
 
 	params.FileTransferExecArgs = cmd.FileTransferExecArgs
@@ -235,6 +269,10 @@ func (cmd *collectLogCmd) Execute(_ []string) error {
 		return rsyncerr
 	}
 
+	if err := cmd.report.Write(cmd.Logger, cmd.TargetFolder); err != nil {
+		cmd.Noticef("Failed to write the summary report: %s", err)
+	}
+
 	// Archive the logs
 	if cmd.Archive {
 		// Archive the logs on Admin Node
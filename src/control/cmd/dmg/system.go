@@ -8,42 +8,64 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize/english"
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 
+	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 var errNoRanks = errors.New("no ranks or hosts specified")
 
 // SystemCmd is the struct representing the top-level system subcommand.
 type SystemCmd struct {
-	LeaderQuery  leaderQueryCmd        `command:"leader-query" description:"Query for current Management Service leader"`
-	Query        systemQueryCmd        `command:"query" description:"Query DAOS system status"`
-	Stop         systemStopCmd         `command:"stop" description:"Perform controlled shutdown of DAOS system"`
-	Start        systemStartCmd        `command:"start" description:"Perform start of stopped DAOS system"`
-	Exclude      systemExcludeCmd      `command:"exclude" description:"Exclude ranks from DAOS system"`
-	ClearExclude systemClearExcludeCmd `command:"clear-exclude" description:"Clear excluded state for ranks"`
-	Drain        systemDrainCmd        `command:"drain" description:"Drain ranks or hosts from all relevant pools in DAOS system"`
-	Reintegrate  systemReintegrateCmd  `command:"reintegrate" alias:"reint" description:"Reintegrate ranks or hosts into all relevant pools in DAOS system"`
-	Erase        systemEraseCmd        `command:"erase" description:"Erase system metadata prior to reformat"`
-	ListPools    poolListCmd           `command:"list-pools" description:"List all pools in the DAOS system"`
-	Cleanup      systemCleanupCmd      `command:"cleanup" description:"Clean up all resources associated with the specified machine"`
-	SetAttr      systemSetAttrCmd      `command:"set-attr" description:"Set system attributes"`
-	GetAttr      systemGetAttrCmd      `command:"get-attr" description:"Get system attributes"`
-	DelAttr      systemDelAttrCmd      `command:"del-attr" description:"Delete system attributes"`
-	SetProp      systemSetPropCmd      `command:"set-prop" description:"Set system properties"`
-	GetProp      systemGetPropCmd      `command:"get-prop" description:"Get system properties"`
+	LeaderQuery    leaderQueryCmd          `command:"leader-query" description:"Query for current Management Service leader"`
+	Query          systemQueryCmd          `command:"query" description:"Query DAOS system status"`
+	Stop           systemStopCmd           `command:"stop" description:"Perform controlled shutdown of DAOS system"`
+	Start          systemStartCmd          `command:"start" description:"Perform start of stopped DAOS system"`
+	Exclude        systemExcludeCmd        `command:"exclude" description:"Exclude ranks from DAOS system"`
+	ClearExclude   systemClearExcludeCmd   `command:"clear-exclude" description:"Clear excluded state for ranks"`
+	ListScheduled  systemListScheduledCmd  `command:"list-scheduled" description:"List pending scheduled rank exclusions"`
+	Drain          systemDrainCmd          `command:"drain" description:"Drain ranks or hosts from all relevant pools in DAOS system"`
+	Reintegrate    systemReintegrateCmd    `command:"reintegrate" alias:"reint" description:"Reintegrate ranks or hosts into all relevant pools in DAOS system"`
+	Erase          systemEraseCmd          `command:"erase" description:"Erase system metadata prior to reformat"`
+	ListPools      poolListCmd             `command:"list-pools" description:"List all pools in the DAOS system"`
+	Cleanup        systemCleanupCmd        `command:"cleanup" description:"Clean up all resources associated with the specified machine"`
+	ListClients    systemListClientsCmd    `command:"list-clients" description:"List the client machines whose agents send heartbeats to the Management Service"`
+	SetAttr        systemSetAttrCmd        `command:"set-attr" description:"Set system attributes"`
+	GetAttr        systemGetAttrCmd        `command:"get-attr" description:"Get system attributes"`
+	ListAttrs      systemListAttrsCmd      `command:"list-attr" alias:"list-attrs" alias:"lsattr" description:"List system attributes"`
+	DelAttr        systemDelAttrCmd        `command:"del-attr" description:"Delete system attributes"`
+	SetProp        systemSetPropCmd        `command:"set-prop" description:"Set system properties"`
+	GetProp        systemGetPropCmd        `command:"get-prop" description:"Get system properties"`
+	ImportFDs      systemImportFDsCmd      `command:"import-fault-domains" description:"Set member fault domains from a host to fault domain mapping file"`
+	ClearFDs       systemClearFDsCmd       `command:"clear-fault-domains" description:"Remove imported fault domains so that members use their configured fault domains"`
+	Events         systemEventsCmd         `command:"events" description:"List recent RAS events recorded by the Management Service"`
+	ReplaceHost    systemReplaceHostCmd    `command:"replace-host" description:"Move the ranks of a failed host to a replacement host"`
+	RollingRestart systemRollingRestartCmd `command:"rolling-restart" description:"Restart ranks in waves while keeping pools available"`
+	CheckCompat    systemCheckCompatCmd    `command:"check-compat" description:"Check that the versions of the DAOS components in the system are able to interoperate"`
+	Usage          systemUsageCmd          `command:"usage" description:"Show pool storage allocated and reserved on each rank"`
+	Logs           systemLogsCmd           `command:"logs" description:"Show and optionally follow the end of the engine or control plane logs on the servers"`
 }
 
 type baseCtlCmd struct {
@@ -123,6 +145,7 @@ type systemQueryCmd struct {
 	Verbose      bool                  `long:"verbose" short:"v" description:"Display more member details"`
 	NotOK        bool                  `long:"not-ok" description:"Display components in need of administrative investigation"`
 	WantedStates ui.MemberStateSetFlag `long:"with-states" description:"Only show engines in one of a set of comma-separated states"`
+	Tree         bool                  `long:"tree" description:"Display ranks grouped by fault domain, with a rollup of rank states for each domain"`
 }
 
 // Execute is run when systemQueryCmd activates.
@@ -134,6 +157,9 @@ func (cmd *systemQueryCmd) Execute(_ []string) (errOut error) {
 	if cmd.NotOK && !cmd.WantedStates.Empty() {
 		return errors.New("--not-ok and --with-states options cannot be set together")
 	}
+	if cmd.Tree && cmd.Verbose {
+		return errors.New("--tree and --verbose options cannot be set together")
+	}
 	if err := cmd.validateHostsRanks(); err != nil {
 		return err
 	}
@@ -148,6 +174,10 @@ func (cmd *systemQueryCmd) Execute(_ []string) (errOut error) {
 		return err // control api returned an error, disregard response
 	}
 
+	if cmd.Tree {
+		return cmd.printTree(resp)
+	}
+
 	if cmd.JSONOutputEnabled() {
 		return cmd.OutputJSON(resp, resp.Errors())
 	}
@@ -165,6 +195,24 @@ func (cmd *systemQueryCmd) Execute(_ []string) (errOut error) {
 	return resp.Errors()
 }
 
+// printTree displays the queried members grouped by fault domain.
+func (cmd *systemQueryCmd) printTree(resp *control.SystemQueryResp) error {
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(system.NewMemberDomainTree(resp.Members), resp.Errors())
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintSystemQueryTree(&out, &outErr, resp); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+	if outErr.String() != "" {
+		cmd.Error(outErr.String())
+	}
+
+	return resp.Errors()
+}
+
 type systemEraseCmd struct {
 	baseCmd
 	ctlInvokerCmd
@@ -179,11 +227,144 @@ func (cmd *systemEraseCmd) Execute(_ []string) error {
 	return resp.Errors()
 }
 
+// systemProgressPollInterval is the interval between queries of the states of
+// the ranks while reporting the progress of a system stop or start.
+var systemProgressPollInterval = 2 * time.Second
+
+// rebuildPollInterval is the interval between checks for the completion of the
+// rebuilds resulting from draining or reintegrating ranks.
+var rebuildPollInterval = 5 * time.Second
+
+// waitForRebuilds polls the given pools until none of them are rebuilding, or
+// until the timeout expires.
+func waitForRebuilds(ctx context.Context, log logging.Logger, invoker control.Invoker, poolIDs []string, timeout time.Duration) error {
+	if len(poolIDs) == 0 {
+		return nil
+	}
+
+	expired := time.After(timeout)
+	for {
+		// Give the rebuild a chance to start before checking its state.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-expired:
+			return errors.Errorf("timed out after %s waiting for rebuild to complete", timeout)
+		case <-time.After(rebuildPollInterval):
+		}
+
+		var rebuilding []string
+		for _, id := range poolIDs {
+			req := &control.PoolQueryReq{
+				ID:        id,
+				QueryMask: daos.HealthOnlyPoolQueryMask,
+			}
+			resp, err := control.PoolQuery(ctx, invoker, req)
+			if err != nil {
+				return errors.Wrapf(err, "querying pool %s", id)
+			}
+			if resp.Rebuild != nil && resp.Rebuild.State == daos.PoolRebuildStateBusy {
+				rebuilding = append(rebuilding, id)
+			}
+		}
+		if len(rebuilding) == 0 {
+			return nil
+		}
+		log.Debugf("waiting for rebuild to complete in pools %s", strings.Join(rebuilding, ","))
+	}
+}
+
+// drainedPoolIDs returns the IDs of the pools from which ranks were drained or
+// into which they were reintegrated.
+func drainedPoolIDs(resp *control.SystemDrainResp) []string {
+	ids := make([]string, 0, len(resp.Responses))
+	for _, pr := range resp.Responses {
+		ids = append(ids, pr.ID)
+	}
+	return ids
+}
+
+// reportRankProgress queries the states of the selected ranks at intervals and
+// reports each change of state, until the returned function is called.
+func reportRankProgress(ctx context.Context, log logging.Logger, rpcClient control.UnaryInvoker, hosts *hostlist.HostSet, ranks *ranklist.RankSet) func() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		states := make(map[ranklist.Rank]system.MemberState)
+		for {
+			req := new(control.SystemQueryReq)
+			req.Hosts.Replace(hosts)
+			req.Ranks.Replace(ranks)
+
+			resp, err := control.SystemQuery(ctx, rpcClient, req)
+			if err != nil {
+				log.Debugf("system query for progress failed: %s", err)
+			} else {
+				for _, m := range resp.Members {
+					prev, found := states[m.Rank]
+					if found && prev != m.State {
+						log.Infof("rank %d: %s -> %s", m.Rank,
+							strings.ToLower(prev.String()), strings.ToLower(m.State.String()))
+					}
+					states[m.Rank] = m.State
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-stop:
+				return
+			case <-time.After(systemProgressPollInterval):
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
 // systemStopCmd is the struct representing the command to shutdown DAOS system.
 type systemStopCmd struct {
 	liveRankListCmd
-	Force bool `long:"force" description:"Force stop DAOS system members"`
-	Full  bool `long:"full" hidden:"true" description:"Attempt a graceful shutdown of DAOS system. Experimental and not for use in production environments"`
+	Force        bool            `long:"force" description:"Force stop DAOS system members"`
+	Full         bool            `long:"full" hidden:"true" description:"Attempt a graceful shutdown of DAOS system. Experimental and not for use in production environments"`
+	DrainFirst   bool            `long:"drain-first" description:"Drain the selected ranks from their pools and wait for rebuild to complete before stopping them"`
+	DrainTimeout ui.DurationFlag `long:"drain-timeout" default:"30m" description:"Time to wait for rebuild to complete after draining the selected ranks"`
+	Progress     bool            `long:"progress" description:"Report changes to the state of each selected rank while stopping"`
+}
+
+// drainRanks drains the selected ranks from all of their pools and waits for
+// the resulting rebuilds to complete.
+func (cmd *systemStopCmd) drainRanks(ctx context.Context) error {
+	req := new(control.SystemDrainReq)
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+
+	resp, err := control.SystemDrain(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return errors.Wrap(err, "draining ranks")
+	}
+	if err := resp.Errors(); err != nil {
+		var out strings.Builder
+		pretty.PrintPoolRanksResps(&out, resp.Responses...)
+		cmd.Info(out.String())
+		return errors.Wrap(err, "draining ranks")
+	}
+	if len(resp.Responses) == 0 {
+		return nil
+	}
+	if !cmd.JSONOutputEnabled() {
+		cmd.Infof("Ranks drained from %s, waiting for rebuild to complete",
+			english.Plural(len(resp.Responses), "pool", "pools"))
+	}
+
+	return waitForRebuilds(ctx, cmd.Logger, cmd.ctlInvoker, drainedPoolIDs(resp), cmd.DrainTimeout.Duration)
 }
 
 // Execute is run when systemStopCmd activates.
@@ -202,10 +383,24 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 	if cmd.Full && !cmd.Ranks.Empty() {
 		return errIncompatFlags("full", "ranks")
 	}
+	if cmd.DrainFirst && cmd.Hosts.Empty() && cmd.Ranks.Empty() {
+		return errors.Wrap(errNoRanks, "drain-first")
+	}
+	if cmd.Progress && cmd.JSONOutputEnabled() {
+		return errIncompatFlags("json", "progress")
+	}
 
 	if err := cmd.validateHostsRanks(); err != nil {
 		return err
 	}
+	ctx := cmd.MustLogCtx()
+
+	if cmd.DrainFirst {
+		if err := cmd.drainRanks(ctx); err != nil {
+			return err
+		}
+	}
+
 	req := &control.SystemStopReq{
 		Force:               cmd.Force,
 		Full:                cmd.Full,
@@ -214,7 +409,20 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
 
-	resp, err := control.SystemStop(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	// The rank state changes reported with the progress option would break up
+	// the progress bar, so the bar is only displayed without the option.
+	var bar *progressBar
+	if cmd.Progress {
+		stopProgress := reportRankProgress(ctx, cmd.Logger, cmd.ctlInvoker, &cmd.Hosts.HostSet,
+			&cmd.Ranks.RankSet)
+		defer stopProgress()
+	} else {
+		bar = terminalProgressBar(cmd.JSONOutputEnabled(), false)
+	}
+
+	req.SetProgressFunc(bar.updateFunc())
+	resp, err := control.SystemStop(ctx, cmd.ctlInvoker, req)
+	bar.done()
 	if err != nil {
 		return err // control api returned an error, disregard response
 	}
@@ -238,6 +446,7 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 // systemStartCmd is the struct representing the command to start system.
 type systemStartCmd struct {
 	liveRankListCmd
+	Progress bool `long:"progress" description:"Report changes to the state of each selected rank while starting"`
 }
 
 // Execute is run when systemStartCmd activates.
@@ -246,9 +455,13 @@ func (cmd *systemStartCmd) Execute(_ []string) (errOut error) {
 		errOut = errors.Wrap(errOut, "system start failed")
 	}()
 
+	if cmd.Progress && cmd.JSONOutputEnabled() {
+		return errIncompatFlags("json", "progress")
+	}
 	if err := cmd.validateHostsRanks(); err != nil {
 		return err
 	}
+	ctx := cmd.MustLogCtx()
 
 	req := &control.SystemStartReq{
 		IgnoreAdminExcluded: cmd.IgnoreAdminExcluded,
@@ -256,7 +469,13 @@ func (cmd *systemStartCmd) Execute(_ []string) (errOut error) {
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
 
-	resp, err := control.SystemStart(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.Progress {
+		stopProgress := reportRankProgress(ctx, cmd.Logger, cmd.ctlInvoker, &cmd.Hosts.HostSet,
+			&cmd.Ranks.RankSet)
+		defer stopProgress()
+	}
+
+	resp, err := control.SystemStart(ctx, cmd.ctlInvoker, req)
 	if err != nil {
 		return err // control api returned an error, disregard response
 	}
@@ -277,21 +496,48 @@ func (cmd *systemStartCmd) Execute(_ []string) (errOut error) {
 	return resp.Errors()
 }
 
-type systemExcludeCmd struct {
+// scheduleTimeLayouts lists the accepted formats for the time at which to apply a scheduled
+// request, with local time assumed when no offset is given.
+var scheduleTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+func parseScheduleTime(value string) (time.Time, error) {
+	for _, layout := range scheduleTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("invalid time %q (expected e.g. 2025-01-31T02:00 or 2025-01-31T02:00:00Z)", value)
+}
+
+type baseSystemExcludeCmd struct {
 	baseRankListCmd
+	At string `long:"at" description:"Schedule the request to be applied by the management service at the given time instead of now (e.g. 2025-01-31T02:00)"`
 }
 
-func (cmd *systemExcludeCmd) execute(clear bool) error {
+func (cmd *baseSystemExcludeCmd) execute(clear bool, duration time.Duration) error {
 	if err := cmd.validateHostsRanks(); err != nil {
 		return err
 	}
 	if cmd.Ranks.Count() == 0 && cmd.Hosts.Count() == 0 {
 		return errNoRanks
 	}
+	if duration < 0 {
+		return errors.New("--duration cannot be negative")
+	}
 
-	req := &control.SystemExcludeReq{Clear: clear}
+	req := &control.SystemExcludeReq{Clear: clear, Duration: duration}
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
+	if cmd.At != "" {
+		start, err := parseScheduleTime(cmd.At)
+		if err != nil {
+			return err
+		}
+		if !start.After(time.Now()) {
+			return errors.Errorf("--at time %q is not in the future", cmd.At)
+		}
+		req.Start = start
+	}
 
 	resp, err := control.SystemExclude(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
@@ -302,6 +548,13 @@ func (cmd *systemExcludeCmd) execute(clear bool) error {
 		return cmd.OutputJSON(resp, resp.Errors())
 	}
 
+	if resp.Scheduled != nil {
+		cmd.Infof("scheduled: %s", pretty.ScheduledRankActionString(resp.Scheduled))
+		if len(resp.Results) == 0 {
+			return nil
+		}
+	}
+
 	updated := ranklist.NewRankSet()
 	for _, result := range resp.Results {
 		updated.Add(result.Rank)
@@ -315,16 +568,49 @@ func (cmd *systemExcludeCmd) execute(clear bool) error {
 	return resp.Errors()
 }
 
+type systemExcludeCmd struct {
+	baseSystemExcludeCmd
+	Duration ui.DurationFlag `long:"duration" description:"Clear the excluded state again once the given duration has elapsed (e.g. 4h)"`
+}
+
 func (cmd *systemExcludeCmd) Execute(_ []string) error {
-	return cmd.execute(false)
+	return cmd.execute(false, cmd.Duration.Duration)
 }
 
 type systemClearExcludeCmd struct {
-	systemExcludeCmd
+	baseSystemExcludeCmd
 }
 
 func (cmd *systemClearExcludeCmd) Execute(_ []string) error {
-	return cmd.execute(true)
+	return cmd.execute(true, 0)
+}
+
+// systemListScheduledCmd is the struct representing the command to list the pending scheduled
+// updates of the excluded state of ranks.
+type systemListScheduledCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when systemListScheduledCmd subcommand is activated.
+func (cmd *systemListScheduledCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system list-scheduled failed")
+	}()
+
+	resp, err := control.SystemListScheduled(cmd.MustLogCtx(), cmd.ctlInvoker,
+		new(control.SystemListScheduledReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemListScheduledResponse(&out, resp)
+	cmd.Info(out.String())
+
+	return nil
 }
 
 type systemDrainCmd struct {
@@ -386,9 +672,12 @@ func (cmd *systemReintegrateCmd) Execute(_ []string) error {
 type systemCleanupCmd struct {
 	baseCtlCmd
 	Args struct {
-		Machine string `positional-arg-name:"machine to cleanup" required:"1"`
+		Machine string `positional-arg-name:"machine to cleanup"`
 	} `positional-args:"yes"`
-	Verbose bool `long:"verbose" short:"v" description:"Output additional cleanup information"`
+	Machine  string `long:"machine" short:"m" description:"Machine whose pool handles should be evicted"`
+	AllStale bool   `long:"all-stale" short:"a" description:"Evict pool handles held by all machines whose agents have stopped sending heartbeats"`
+	DryRun   bool   `long:"dry-run" short:"n" description:"List the pool handles that would be evicted without evicting them"`
+	Verbose  bool   `long:"verbose" short:"v" description:"Output additional cleanup information"`
 }
 
 func (cmd *systemCleanupCmd) Execute(_ []string) (errOut error) {
@@ -396,9 +685,26 @@ func (cmd *systemCleanupCmd) Execute(_ []string) (errOut error) {
 		errOut = errors.Wrap(errOut, "system cleanup failed")
 	}()
 
+	machine := cmd.Machine
+	if cmd.Args.Machine != "" {
+		if machine != "" && machine != cmd.Args.Machine {
+			return errors.New("--machine and the machine argument specify different machines")
+		}
+		machine = cmd.Args.Machine
+	}
+
+	switch {
+	case machine == "" && !cmd.AllStale:
+		return errors.New("either --machine or --all-stale must be specified")
+	case machine != "" && cmd.AllStale:
+		return errors.New("--machine and --all-stale may not be specified together")
+	}
+
 	req := new(control.SystemCleanupReq)
 	req.SetSystem(cmd.config.SystemName)
-	req.Machine = cmd.Args.Machine
+	req.Machine = machine
+	req.AllStale = cmd.AllStale
+	req.DryRun = cmd.DryRun
 
 	resp, err := control.SystemCleanup(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
@@ -410,7 +716,7 @@ func (cmd *systemCleanupCmd) Execute(_ []string) (errOut error) {
 	}
 
 	var out strings.Builder
-	pretty.PrintSystemCleanupResponse(&out, resp, cmd.Verbose)
+	pretty.PrintSystemCleanupResponse(&out, resp, cmd.Verbose, cmd.DryRun)
 
 	if resp.Errors() != nil {
 		cmd.Error(resp.Errors().Error())
@@ -421,6 +727,36 @@ func (cmd *systemCleanupCmd) Execute(_ []string) (errOut error) {
 	return resp.Errors()
 }
 
+// systemListClientsCmd represents the command to list the client machines
+// known to the MS leader.
+type systemListClientsCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when systemListClientsCmd subcommand is activated.
+func (cmd *systemListClientsCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system list-clients failed")
+	}()
+
+	req := new(control.SystemListClientsReq)
+	req.SetSystem(cmd.config.SystemName)
+
+	resp, err := control.SystemListClients(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemListClientsResponse(&out, resp)
+	cmd.Info(out.String())
+
+	return nil
+}
+
 // systemSetAttrCmd represents the command to set system attributes.
 type systemSetAttrCmd struct {
 	baseCtlCmd
@@ -456,23 +792,28 @@ type systemGetAttrCmd struct {
 	} `positional-args:"yes"`
 }
 
-func prettyPrintAttrs(out io.Writer, attrs map[string]string) {
-	if len(attrs) == 0 {
+func prettyPrintAttrs(out io.Writer, resp *control.SystemGetAttrResp, withValues bool) {
+	if len(resp.Attributes) == 0 {
 		fmt.Fprintln(out, "No system attributes found.")
 		return
 	}
 
 	nameTitle := "Name"
 	valueTitle := "Value"
+	titles := []string{nameTitle}
+	if withValues {
+		titles = append(titles, valueTitle)
+	}
+
 	table := []txtfmt.TableRow{}
-	for key, val := range attrs {
+	for _, key := range resp.Names() {
 		row := txtfmt.TableRow{}
 		row[nameTitle] = key
-		row[valueTitle] = val
+		row[valueTitle] = resp.Attributes[key]
 		table = append(table, row)
 	}
 
-	tf := txtfmt.NewTableFormatter(nameTitle, valueTitle)
+	tf := txtfmt.NewTableFormatter(titles...)
 	tf.InitWriter(out)
 	tf.Format(table)
 }
@@ -493,7 +834,39 @@ func (cmd *systemGetAttrCmd) Execute(_ []string) error {
 	}
 
 	var bld strings.Builder
-	prettyPrintAttrs(&bld, resp.Attributes)
+	prettyPrintAttrs(&bld, resp, true)
+	cmd.Infof("%s", bld.String())
+
+	return nil
+}
+
+// systemListAttrsCmd represents the command to list system attributes.
+type systemListAttrsCmd struct {
+	baseCtlCmd
+	Prefix  string `short:"p" long:"prefix" description:"Only list attributes with names starting with this prefix"`
+	Verbose bool   `short:"V" long:"verbose" description:"Include values"`
+}
+
+// Execute is run when systemListAttrsCmd subcommand is activated.
+func (cmd *systemListAttrsCmd) Execute(_ []string) error {
+	req := &control.SystemGetAttrReq{
+		Prefix: cmd.Prefix,
+	}
+
+	resp, err := control.SystemGetAttr(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		if err != nil || cmd.Verbose {
+			return cmd.OutputJSON(resp, err)
+		}
+		return cmd.OutputJSON(resp.Names(), nil)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "system list-attr failed")
+	}
+
+	var bld strings.Builder
+	prettyPrintAttrs(&bld, resp, cmd.Verbose)
 	cmd.Infof("%s", bld.String())
 
 	return nil
@@ -692,3 +1065,679 @@ func (cmd *systemGetPropCmd) Execute(_ []string) error {
 
 	return nil
 }
+
+// faultDomainsFile describes the layout of a file mapping hosts to fault domains, e.g. as
+// exported from a site configuration management database.
+type faultDomainsFile struct {
+	FaultDomains map[string]string `yaml:"fault_domains"`
+}
+
+func readFaultDomainsFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fdf := new(faultDomainsFile)
+	if err := yaml.UnmarshalStrict(data, fdf); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %q", path)
+	}
+	if len(fdf.FaultDomains) == 0 {
+		return nil, errors.Errorf("no fault domains found in %q", path)
+	}
+
+	return fdf.FaultDomains, nil
+}
+
+// systemImportFDsCmd represents the command to set the fault domains of system members
+// from a file mapping hosts to fault domains.
+type systemImportFDsCmd struct {
+	baseCtlCmd
+	File   string `long:"file" short:"f" required:"1" description:"YAML file mapping hosts to fault domains"`
+	DryRun bool   `long:"dry-run" short:"n" description:"Display fault domain changes without applying them"`
+}
+
+// Execute is run when systemImportFDsCmd subcommand is activated.
+func (cmd *systemImportFDsCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system import-fault-domains failed")
+	}()
+
+	fds, err := readFaultDomainsFile(cmd.File)
+	if err != nil {
+		return err
+	}
+
+	req := &control.SystemSetFaultDomainsReq{
+		FaultDomains: fds,
+		DryRun:       cmd.DryRun,
+	}
+
+	resp, err := control.SystemSetFaultDomains(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemSetFaultDomainsResponse(&out, resp, cmd.DryRun)
+	cmd.Info(out.String())
+
+	return nil
+}
+
+// systemClearFDsCmd represents the command to remove the fault domains imported with the
+// import-fault-domains command.
+type systemClearFDsCmd struct {
+	baseCtlCmd
+	DryRun bool `long:"dry-run" short:"n" description:"Display the ranks whose imported fault domains would be removed without removing them"`
+}
+
+// Execute is run when systemClearFDsCmd subcommand is activated.
+func (cmd *systemClearFDsCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system clear-fault-domains failed")
+	}()
+
+	req := &control.SystemSetFaultDomainsReq{
+		Clear:  true,
+		DryRun: cmd.DryRun,
+	}
+
+	resp, err := control.SystemSetFaultDomains(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemClearFaultDomainsResponse(&out, resp, cmd.DryRun)
+	cmd.Info(out.String())
+
+	return nil
+}
+
+var eventSeverities = map[string]events.RASSeverityID{
+	"error":   events.RASSeverityError,
+	"warning": events.RASSeverityWarning,
+	"notice":  events.RASSeverityNotice,
+}
+
+// parseEventTime parses an absolute timestamp or a duration relative to the supplied time.
+func parseEventTime(in string, now time.Time) (time.Time, error) {
+	if t, err := common.ParseTime(in); err == nil {
+		return t, nil
+	}
+
+	d, err := ui.ParseDuration(in)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid time %q (expected RFC3339 timestamp or duration, e.g. 1h)", in)
+	}
+	return now.Add(-d), nil
+}
+
+// systemEventsCmd is the struct representing the command to list the RAS events recorded in
+// the system database.
+type systemEventsCmd struct {
+	baseCtlCmd
+	Severity string         `long:"severity" short:"s" choice:"error" choice:"warning" choice:"notice" description:"Only show events of at least the given severity"`
+	Ranks    ui.RankSetFlag `long:"ranks" short:"r" description:"Only show events for the given ranks"`
+	Since    string         `long:"since" description:"Only show events at or after the given RFC3339 time or duration ago (e.g. 1h)"`
+	Until    string         `long:"until" description:"Only show events at or before the given RFC3339 time or duration ago (e.g. 30m)"`
+	Offset   uint64         `long:"offset" description:"Number of matching events to skip"`
+	Limit    uint64         `long:"limit" short:"n" default:"100" description:"Maximum number of events to show (0 for all)"`
+}
+
+// Execute is run when systemEventsCmd subcommand is activated.
+func (cmd *systemEventsCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system events failed")
+	}()
+
+	req := &control.SystemEventsReq{
+		Severity: eventSeverities[cmd.Severity],
+		Ranks:    &cmd.Ranks.RankSet,
+		Offset:   cmd.Offset,
+		Limit:    cmd.Limit,
+	}
+
+	now := time.Now()
+	var err error
+	if cmd.Since != "" {
+		if req.Since, err = parseEventTime(cmd.Since, now); err != nil {
+			return errors.Wrap(err, "--since")
+		}
+	}
+	if cmd.Until != "" {
+		if req.Until, err = parseEventTime(cmd.Until, now); err != nil {
+			return errors.Wrap(err, "--until")
+		}
+	}
+
+	resp, err := control.SystemEvents(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemEventsResponse(&out, resp, cmd.Offset)
+	cmd.Info(out.String())
+
+	return nil
+}
+
+// systemUsageCmd is the struct representing the command to show the pool storage
+// allocated and reserved on each rank of the system.
+type systemUsageCmd struct {
+	baseCtlCmd
+}
+
+// Execute is run when systemUsageCmd subcommand is activated.
+func (cmd *systemUsageCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system usage failed")
+	}()
+
+	resp, err := control.SystemUsage(cmd.MustLogCtx(), cmd.ctlInvoker, new(control.SystemUsageReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemUsageResponse(&out, resp)
+	cmd.Info(out.String())
+
+	return nil
+}
+
+// rankJoinPollInterval is the interval between checks for restarted or
+// reassigned ranks to join the system.
+var rankJoinPollInterval = 5 * time.Second
+
+// waitForRanksJoined polls the system until all of the given ranks are joined, or
+// until the timeout expires.
+func waitForRanksJoined(ctx context.Context, log logging.Logger, invoker control.Invoker, ranks *ranklist.RankSet, timeout time.Duration) error {
+	expired := time.After(timeout)
+	for {
+		req := new(control.SystemQueryReq)
+		req.Ranks.Replace(ranks)
+
+		resp, err := control.SystemQuery(ctx, invoker, req)
+		if err != nil {
+			return err
+		}
+
+		notJoined := ranklist.MustCreateRankSet("")
+		notJoined.Replace(ranks)
+		for _, m := range resp.Members {
+			if m.State == system.MemberStateJoined {
+				notJoined.Delete(m.Rank)
+			}
+		}
+		if notJoined.Count() == 0 {
+			return nil
+		}
+		log.Debugf("waiting for ranks %s to join", notJoined)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-expired:
+			return errors.Errorf("timed out after %s waiting for ranks %s to join", timeout,
+				notJoined)
+		case <-time.After(rankJoinPollInterval):
+		}
+	}
+}
+
+// systemReplaceHostResult describes the outcome of each stage of a replace-host
+// operation.
+type systemReplaceHostResult struct {
+	Ranks       *ranklist.RankSet          `json:"ranks"`
+	Format      *control.StorageFormatResp `json:"format"`
+	Reintegrate *control.SystemDrainResp   `json:"reintegrate,omitempty"`
+}
+
+// systemReplaceHostCmd is the struct representing the command to move the ranks of a
+// failed host to a freshly provisioned replacement host.
+type systemReplaceHostCmd struct {
+	baseCtlCmd
+	OldHost     string          `long:"old" required:"1" description:"Failed host whose ranks are to be moved"`
+	NewHost     string          `long:"new" required:"1" description:"Replacement host to take over the ranks"`
+	JoinTimeout ui.DurationFlag `long:"join-timeout" default:"10m" description:"Time to wait for the ranks to join on the replacement host"`
+	SkipReint   bool            `long:"skip-reint" description:"Do not reintegrate the ranks into their pools once joined"`
+}
+
+// Execute is run when systemReplaceHostCmd subcommand is activated.
+func (cmd *systemReplaceHostCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system replace-host failed")
+	}()
+
+	ctx := cmd.MustLogCtx()
+	result := new(systemReplaceHostResult)
+
+	req := &control.SystemReplaceHostReq{
+		OldHost: cmd.OldHost,
+		NewHost: cmd.NewHost,
+	}
+
+	resp, err := control.SystemReplaceHost(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return err
+	}
+	result.Ranks = resp.Ranks
+	if !cmd.JSONOutputEnabled() {
+		cmd.Infof("Ranks %s moved from %s to %s", resp.Ranks, cmd.OldHost, cmd.NewHost)
+	}
+
+	// Format the replacement host in replace mode so that its engines join
+	// the system with the reassigned ranks.
+	fmtReq := &control.StorageFormatReq{Replace: true}
+	fmtReq.SetHostList([]string{cmd.NewHost})
+	result.Format, err = control.StorageFormat(ctx, cmd.ctlInvoker, fmtReq)
+	if err == nil {
+		err = result.Format.Errors()
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "formatting replacement host %s", cmd.NewHost)
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(result, err)
+		}
+		return err
+	}
+	if !cmd.JSONOutputEnabled() {
+		cmd.Infof("Storage formatted on %s, waiting for ranks %s to join", cmd.NewHost, resp.Ranks)
+	}
+
+	if err := waitForRanksJoined(ctx, cmd.Logger, cmd.ctlInvoker, resp.Ranks, cmd.JoinTimeout.Duration); err != nil {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(result, err)
+		}
+		return err
+	}
+
+	if !cmd.SkipReint {
+		// Reintegrating the ranks into their pools starts the rebuild of
+		// the data that was held on the failed host.
+		reintReq := new(control.SystemDrainReq)
+		reintReq.Ranks.Replace(resp.Ranks)
+		reintReq.Reint = true
+
+		result.Reintegrate, err = control.SystemDrain(ctx, cmd.ctlInvoker, reintReq)
+		if err == nil {
+			err = result.Reintegrate.Errors()
+		}
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(result, err)
+	}
+	if result.Reintegrate != nil {
+		var out strings.Builder
+		pretty.PrintPoolRanksResps(&out, result.Reintegrate.Responses...)
+		cmd.Info(out.String())
+	}
+	if err != nil {
+		return err
+	}
+	cmd.Infof("Host %s replaced by %s", cmd.OldHost, cmd.NewHost)
+
+	return nil
+}
+
+// systemRollingRestartResult describes the progress of a rolling restart.
+type systemRollingRestartResult struct {
+	Waves     []*ranklist.RankSet `json:"waves"`
+	Completed int                 `json:"completed"`
+}
+
+// systemRollingRestartCmd is the struct representing the command to restart the
+// ranks of the system in waves, while keeping the pools available.
+type systemRollingRestartCmd struct {
+	baseRankListCmd
+	Concurrency    uint            `long:"concurrency" short:"c" default:"1" description:"Number of ranks to restart in each wave"`
+	JoinTimeout    ui.DurationFlag `long:"join-timeout" default:"10m" description:"Time to wait for the ranks in a wave to join after being restarted"`
+	RebuildTimeout ui.DurationFlag `long:"rebuild-timeout" default:"1h" description:"Time to wait for pool rebuilds to complete after the ranks in a wave are drained or reintegrated"`
+	DryRun         bool            `long:"dry-run" short:"n" description:"Show the waves in which the ranks would be restarted without restarting them"`
+}
+
+// getWaves returns the selected ranks split into waves of the requested size.
+// All of the selected ranks must be joined.
+func (cmd *systemRollingRestartCmd) getWaves(ctx context.Context) ([]*ranklist.RankSet, error) {
+	req := new(control.SystemQueryReq)
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+
+	resp, err := control.SystemQuery(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.Errors(); err != nil {
+		return nil, err
+	}
+
+	notJoined := ranklist.MustCreateRankSet("")
+	ranks := ranklist.MustCreateRankSet("")
+	for _, m := range resp.Members {
+		if m.State != system.MemberStateJoined {
+			notJoined.Add(m.Rank)
+			continue
+		}
+		ranks.Add(m.Rank)
+	}
+	if notJoined.Count() > 0 {
+		return nil, errors.Errorf("ranks %s are not joined", notJoined)
+	}
+	if ranks.Count() == 0 {
+		return nil, errors.New("no ranks to restart")
+	}
+
+	var waves []*ranklist.RankSet
+	for i, rank := range ranks.Ranks() {
+		if i%int(cmd.Concurrency) == 0 {
+			waves = append(waves, ranklist.MustCreateRankSet(""))
+		}
+		waves[len(waves)-1].Add(rank)
+	}
+
+	return waves, nil
+}
+
+// checkPoolHealth returns an error if the redundancy of any pool in the system is
+// degraded, or if any pool is rebuilding.
+func (cmd *systemRollingRestartCmd) checkPoolHealth(ctx context.Context) error {
+	resp, err := control.ListPools(ctx, cmd.ctlInvoker, new(control.ListPoolsReq))
+	if err != nil {
+		return err
+	}
+
+	for _, p := range resp.Pools {
+		if err := resp.PoolQueryError(p.UUID); err != nil {
+			return errors.Wrapf(err, "unable to query pool %s", p.Name())
+		}
+		if p.Rebuild != nil && p.Rebuild.State == daos.PoolRebuildStateBusy {
+			return errors.Errorf("pool %s is rebuilding", p.Name())
+		}
+		if p.DisabledTargets > 0 {
+			return errors.Errorf("pool %s is degraded with %d disabled targets", p.Name(),
+				p.DisabledTargets)
+		}
+	}
+
+	return nil
+}
+
+// restartWave drains the ranks of a wave from their pools, restarts them and
+// reintegrates them once they have rejoined the system.
+func (cmd *systemRollingRestartCmd) restartWave(ctx context.Context, ranks *ranklist.RankSet) error {
+	drainReq := new(control.SystemDrainReq)
+	drainReq.Ranks.Replace(ranks)
+	drainResp, err := control.SystemDrain(ctx, cmd.ctlInvoker, drainReq)
+	if err == nil {
+		err = drainResp.Errors()
+	}
+	if err == nil {
+		err = waitForRebuilds(ctx, cmd.Logger, cmd.ctlInvoker, drainedPoolIDs(drainResp),
+			cmd.RebuildTimeout.Duration)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "draining ranks %s", ranks)
+	}
+
+	stopReq := new(control.SystemStopReq)
+	stopReq.Ranks.Replace(ranks)
+	stopResp, err := control.SystemStop(ctx, cmd.ctlInvoker, stopReq)
+	if err == nil {
+		err = stopResp.Errors()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "stopping ranks %s", ranks)
+	}
+
+	startReq := new(control.SystemStartReq)
+	startReq.Ranks.Replace(ranks)
+	startResp, err := control.SystemStart(ctx, cmd.ctlInvoker, startReq)
+	if err == nil {
+		err = startResp.Errors()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "starting ranks %s", ranks)
+	}
+	if err := waitForRanksJoined(ctx, cmd.Logger, cmd.ctlInvoker, ranks, cmd.JoinTimeout.Duration); err != nil {
+		return err
+	}
+
+	reintReq := new(control.SystemDrainReq)
+	reintReq.Ranks.Replace(ranks)
+	reintReq.Reint = true
+	reintResp, err := control.SystemDrain(ctx, cmd.ctlInvoker, reintReq)
+	if err == nil {
+		err = reintResp.Errors()
+	}
+	if err == nil {
+		err = waitForRebuilds(ctx, cmd.Logger, cmd.ctlInvoker, drainedPoolIDs(reintResp),
+			cmd.RebuildTimeout.Duration)
+	}
+
+	return errors.Wrapf(err, "reintegrating ranks %s", ranks)
+}
+
+// Execute is run when systemRollingRestartCmd subcommand is activated.
+//
+// Restart the selected ranks, or all ranks in the system, a wave at a time. The
+// ranks in each wave are drained from their pools before being stopped, and
+// reintegrated once they have rejoined. The restart is aborted if the redundancy
+// of any pool is degraded before a wave is started.
+func (cmd *systemRollingRestartCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system rolling-restart failed")
+	}()
+
+	if err := cmd.validateHostsRanks(); err != nil {
+		return err
+	}
+	if cmd.Concurrency == 0 {
+		return errors.New("--concurrency must be greater than zero")
+	}
+	ctx := cmd.MustLogCtx()
+
+	result := new(systemRollingRestartResult)
+	var err error
+	result.Waves, err = cmd.getWaves(ctx)
+	if err != nil {
+		return err
+	}
+
+	if cmd.DryRun {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(result, nil)
+		}
+		for i, wave := range result.Waves {
+			cmd.Infof("Wave %d: ranks %s", i+1, wave)
+		}
+		return nil
+	}
+
+	for i, wave := range result.Waves {
+		if err = cmd.checkPoolHealth(ctx); err != nil {
+			err = errors.Wrapf(err, "aborting before wave %d", i+1)
+			break
+		}
+		if !cmd.JSONOutputEnabled() {
+			cmd.Infof("Wave %d/%d: restarting ranks %s", i+1, len(result.Waves), wave)
+		}
+		if err = cmd.restartWave(ctx, wave); err != nil {
+			break
+		}
+		result.Completed++
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(result, err)
+	}
+	if err != nil {
+		return err
+	}
+	cmd.Infof("Restarted ranks in %s", english.Plural(result.Completed, "wave", ""))
+
+	return nil
+}
+
+// systemCheckCompatCmd is the struct representing the command to check the
+// interoperability of the versions of the DAOS components in the system.
+type systemCheckCompatCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+}
+
+// systemCheckCompatResult contains the results of a compatibility check.
+type systemCheckCompatResult struct {
+	*control.GetComponentVersionsResp
+	Groups   []*control.ComponentVersionGroup `json:"groups"`
+	Verdicts []*control.CompatVerdict         `json:"verdicts"`
+}
+
+// Execute is run when systemCheckCompatCmd activates.
+//
+// Query the version of each server in the hostlist, and of the agents that
+// have recently contacted them, and check each against the interoperability
+// rules enforced by the servers.
+func (cmd *systemCheckCompatCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system check-compat failed")
+	}()
+
+	req := new(control.GetComponentVersionsReq)
+	req.SetHostList(cmd.getHostList())
+
+	resp, err := control.GetComponentVersions(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	cmd.Tracef("get component versions response: %+v", resp)
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	groups, err := resp.Groups(&control.ComponentVersion{
+		Component: build.ComponentAdmin.String(),
+		Version:   build.DaosVersion,
+		Host:      hostname,
+	})
+	if err != nil {
+		return err
+	}
+
+	result := &systemCheckCompatResult{
+		GetComponentVersionsResp: resp,
+		Groups:                   groups,
+		Verdicts:                 control.CheckCompatibility(groups),
+	}
+
+	nrIncompat := 0
+	for _, verdict := range result.Verdicts {
+		if !verdict.Compatible {
+			nrIncompat++
+		}
+	}
+	err = resp.Errors()
+	if nrIncompat > 0 {
+		err = errors.Errorf("%d incompatible component %s found", nrIncompat,
+			english.PluralWord(nrIncompat, "version", ""))
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(result, err)
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+	pretty.PrintCompatMatrix(&out, result.Groups, result.Verdicts)
+	cmd.Info(out.String())
+
+	return err
+}
+
+// systemLogsCmd is the struct representing the command to show the lines at the
+// end of the engine or control plane logs on a set of hosts.
+type systemLogsCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	Ranks   ui.RankSetFlag `long:"rank" short:"r" description:"Only show the logs of the engines with the given ranks, on the hosts of those ranks unless a host list is given"`
+	Control bool           `long:"control" short:"c" description:"Show the control plane logs instead of the engine logs"`
+	Lines   uint           `long:"lines" short:"n" default:"10" description:"Number of lines to show from the end of each log"`
+	Follow  bool           `long:"follow" short:"f" description:"Keep showing lines as they are appended to the logs, until interrupted"`
+}
+
+// Execute is run when systemLogsCmd subcommand is activated.
+func (cmd *systemLogsCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system logs failed")
+	}()
+
+	if cmd.Control && cmd.Ranks.Count() > 0 {
+		return errors.New("--control and --rank options cannot be set together")
+	}
+	if cmd.Follow && cmd.JSONOutputEnabled() {
+		return errors.New("--follow option cannot be used with JSON output")
+	}
+
+	req := &control.TailLogReq{
+		Control: cmd.Control,
+		Lines:   int(cmd.Lines),
+		Follow:  cmd.Follow,
+	}
+	req.SetHostList(cmd.getHostList())
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+	if cmd.Follow {
+		req.SetLineFunc(func(line *control.LogLine) {
+			var out strings.Builder
+			pretty.PrintLogLine(&out, line)
+			cmd.Info(out.String())
+		})
+	}
+
+	resp, err := control.TailLog(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintTailLogResponse(&out, &outErr, resp); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+	if out.Len() > 0 {
+		cmd.Info(out.String())
+	}
+
+	return resp.Errors()
+}
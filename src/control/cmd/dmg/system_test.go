@@ -11,16 +11,20 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/ui"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -50,6 +54,20 @@ func TestDmg_SystemCommands(t *testing.T) {
 		return req
 	}
 
+	tmpDir, tmpCleanup := test.CreateTestDir(t)
+	defer tmpCleanup()
+
+	fdFile := test.CreateTestFile(t, tmpDir, `
+fault_domains:
+  host1: /rack=r0/node=host1
+  host2: /rack=r1/node=host2
+`)
+	badFDFile := test.CreateTestFile(t, tmpDir, `
+domains:
+  host1: /rack=r0/node=host1
+`)
+	emptyFDFile := test.CreateTestFile(t, tmpDir, "")
+
 	runCmdTests(t, []cmdTest{
 		{
 			"system query with no arguments",
@@ -149,6 +167,20 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system query tree",
+			"system query --tree",
+			strings.Join([]string{
+				printRequest(t, &control.SystemQueryReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system query with both tree and verbose specified",
+			"system query --tree --verbose",
+			"",
+			errors.New("--tree and --verbose options cannot be set together"),
+		},
 		{
 			"system stop with no arguments",
 			"system stop",
@@ -227,6 +259,12 @@ func TestDmg_SystemCommands(t *testing.T) {
 			"",
 			errors.New(`may not be mixed`),
 		},
+		{
+			"system stop with drain-first and no ranks",
+			"system stop --drain-first --force",
+			"",
+			errNoRanks,
+		},
 		{
 			"system stop with ignore-admin-excluded option",
 			"system stop --ignore-admin-excluded",
@@ -319,6 +357,181 @@ func TestDmg_SystemCommands(t *testing.T) {
 			"",
 			errNoRanks,
 		},
+		{
+			"system exclude with maintenance window",
+			"system exclude --ranks 0,1 --at 2099-01-31T02:00:00Z --duration 4h",
+			strings.Join([]string{
+				printRequest(t, withRanks(&control.SystemExcludeReq{
+					Start:    time.Date(2099, 1, 31, 2, 0, 0, 0, time.UTC),
+					Duration: 4 * time.Hour,
+				}, 0, 1)),
+			}, " "),
+			nil,
+		},
+		{
+			"system exclude with duration",
+			"system exclude --ranks 0 --duration 30m",
+			strings.Join([]string{
+				printRequest(t, withRanks(&control.SystemExcludeReq{
+					Duration: 30 * time.Minute,
+				}, 0)),
+			}, " "),
+			nil,
+		},
+		{
+			"system exclude at invalid time",
+			"system exclude --ranks 0 --at tomorrow",
+			"",
+			errors.New("invalid time"),
+		},
+		{
+			"system exclude at past time",
+			"system exclude --ranks 0 --at 2000-01-01T00:00",
+			"",
+			errors.New("not in the future"),
+		},
+		{
+			"system clear-exclude at time",
+			"system clear-exclude --ranks 2 --at 2099-01-31T06:00:00Z",
+			strings.Join([]string{
+				printRequest(t, withRanks(&control.SystemExcludeReq{
+					Clear: true,
+					Start: time.Date(2099, 1, 31, 6, 0, 0, 0, time.UTC),
+				}, 2)),
+			}, " "),
+			nil,
+		},
+		{
+			"system clear-exclude with duration",
+			"system clear-exclude --ranks 2 --duration 4h",
+			"",
+			errors.New("unknown flag"),
+		},
+		{
+			"system list-scheduled",
+			"system list-scheduled",
+			strings.Join([]string{
+				printRequest(t, &control.SystemListScheduledReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events defaults",
+			"system events",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsReq{
+					Ranks: ranklist.NewRankSet(),
+					Limit: 100,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events with filters",
+			"system events --severity warning --ranks 0-3 --since 2025-01-02T03:04:05Z --until 2025-01-03T00:00:00Z --offset 10 --limit 5",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsReq{
+					Severity: events.RASSeverityWarning,
+					Ranks:    ranklist.MustCreateRankSet("0-3"),
+					Since:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+					Until:    time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+					Offset:   10,
+					Limit:    5,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system usage",
+			"system usage",
+			printRequest(t, &control.SystemUsageReq{}),
+			nil,
+		},
+		{
+			"system check-compat",
+			"system check-compat",
+			printRequest(t, &control.GetComponentVersionsReq{}),
+			nil,
+		},
+		{
+			"system check-compat with hosts",
+			"system check-compat -l host1,host2",
+			printRequest(t, func() *control.GetComponentVersionsReq {
+				req := new(control.GetComponentVersionsReq)
+				req.SetHostList([]string{"host1", "host2"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"system logs",
+			"system logs",
+			printRequest(t, &control.TailLogReq{Lines: 10}),
+			nil,
+		},
+		{
+			"system logs of control plane with lines and hosts",
+			"system logs --control -n 50 -l host1,host2",
+			printRequest(t, func() *control.TailLogReq {
+				req := &control.TailLogReq{Control: true, Lines: 50}
+				req.SetHostList([]string{"host1", "host2"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"system logs of ranks on hosts",
+			"system logs --rank 1-2 -l host1",
+			printRequest(t, func() *control.TailLogReq {
+				req := &control.TailLogReq{Lines: 10}
+				req.SetHostList([]string{"host1"})
+				req.Ranks.Add(1)
+				req.Ranks.Add(2)
+				return req
+			}()),
+			nil,
+		},
+		{
+			"system logs of ranks not in system",
+			"system logs --rank 3",
+			"",
+			errors.New("no hosts found for ranks 3"),
+		},
+		{
+			"system logs follow",
+			"system logs -f -r 1 -l host1",
+			printRequest(t, func() *control.TailLogReq {
+				req := &control.TailLogReq{Lines: 10, Follow: true}
+				req.SetHostList([]string{"host1"})
+				req.Ranks.Add(1)
+				return req
+			}()),
+			nil,
+		},
+		{
+			"system logs follow with JSON output",
+			"--json system logs --follow",
+			"",
+			errors.New("cannot be used with JSON output"),
+		},
+		{
+			"system logs of control plane and ranks",
+			"system logs --control --rank 1",
+			"",
+			errors.New("cannot be set together"),
+		},
+		{
+			"system events with invalid severity",
+			"system events --severity info",
+			"",
+			errors.New("Invalid value"),
+		},
+		{
+			"system events with invalid since",
+			"system events --since yesterday",
+			"",
+			errors.New("invalid time"),
+		},
 		{
 			"system drain with multiple hosts",
 			"system drain --rank-hosts foo-[0,1,4]",
@@ -381,11 +594,52 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system cleanup with machine flag",
+			"system cleanup --machine foo1 --dry-run",
+			strings.Join([]string{
+				printRequest(t, withSystem(&control.SystemCleanupReq{
+					Machine: "foo1",
+					DryRun:  true,
+				}, "daos_server")),
+			}, " "),
+			nil,
+		},
+		{
+			"system cleanup with conflicting machine names",
+			"system cleanup --machine foo1 foo2",
+			"",
+			errors.New("different machines"),
+		},
+		{
+			"system cleanup all stale",
+			"system cleanup --all-stale",
+			strings.Join([]string{
+				printRequest(t, withSystem(&control.SystemCleanupReq{
+					AllStale: true,
+				}, "daos_server")),
+			}, " "),
+			nil,
+		},
+		{
+			"system cleanup with machine name and all stale",
+			"system cleanup foo1 --all-stale",
+			"",
+			errors.New("may not be specified together"),
+		},
 		{
 			"system cleanup without machine name",
 			"system cleanup -v",
 			"",
-			errors.New("not provided"),
+			errors.New("either --machine or --all-stale"),
+		},
+		{
+			"system list-clients",
+			"system list-clients",
+			strings.Join([]string{
+				printRequest(t, withSystem(&control.SystemListClientsReq{}, "daos_server")),
+			}, " "),
+			nil,
 		},
 		{
 			"leader query",
@@ -427,6 +681,24 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system list-attr",
+			"system list-attr",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAttrReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system list-attrs with prefix",
+			"system list-attrs --prefix site. --verbose",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAttrReq{
+					Prefix: "site.",
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"system del-attr multi attributes",
 			"system del-attr foo,baz",
@@ -453,6 +725,78 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system import-fault-domains",
+			"system import-fault-domains --file " + fdFile,
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetFaultDomainsReq{
+					FaultDomains: map[string]string{
+						"host1": "/rack=r0/node=host1",
+						"host2": "/rack=r1/node=host2",
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system import-fault-domains dry run",
+			"system import-fault-domains --dry-run --file " + fdFile,
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetFaultDomainsReq{
+					FaultDomains: map[string]string{
+						"host1": "/rack=r0/node=host1",
+						"host2": "/rack=r1/node=host2",
+					},
+					DryRun: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system clear-fault-domains",
+			"system clear-fault-domains",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetFaultDomainsReq{
+					Clear: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system clear-fault-domains dry run",
+			"system clear-fault-domains --dry-run",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetFaultDomainsReq{
+					Clear:  true,
+					DryRun: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system import-fault-domains without file",
+			"system import-fault-domains",
+			"",
+			errors.New("required flag"),
+		},
+		{
+			"system import-fault-domains missing file",
+			"system import-fault-domains --file /not/a/file",
+			"",
+			errors.New("no such file"),
+		},
+		{
+			"system import-fault-domains bad file",
+			"system import-fault-domains --file " + badFDFile,
+			"",
+			errors.New("failed to parse"),
+		},
+		{
+			"system import-fault-domains empty file",
+			"system import-fault-domains --file " + emptyFDFile,
+			"",
+			errors.New("no fault domains found"),
+		},
 		{
 			"Non-existent subcommand",
 			"system quack",
@@ -510,6 +854,79 @@ func TestDmg_leaderQueryCmd(t *testing.T) {
 	}
 }
 
+func TestDmg_systemListAttrsCmd(t *testing.T) {
+	attrs := map[string]string{
+		"site.owner":       "storage-team",
+		"site.maintenance": "Saturday 02:00",
+		"scheduler.hint":   "drain-first",
+	}
+
+	for name, tc := range map[string]struct {
+		prefix  string
+		verbose bool
+		resp    *mgmtpb.SystemGetAttrResp
+		msErr   error
+		expOut  string
+		expErr  error
+	}{
+		"ms failure": {
+			msErr:  errors.New("failed"),
+			expErr: errors.New("list-attr failed"),
+		},
+		"no attributes": {
+			resp:   &mgmtpb.SystemGetAttrResp{},
+			expOut: "No system attributes found.",
+		},
+		"names": {
+			resp: &mgmtpb.SystemGetAttrResp{Attributes: attrs},
+			expOut: `
+Name             
+----             
+scheduler.hint   
+site.maintenance 
+site.owner       
+`,
+		},
+		"prefix with values": {
+			prefix:  "site.",
+			verbose: true,
+			resp:    &mgmtpb.SystemGetAttrResp{Attributes: attrs},
+			expOut: `
+Name             Value          
+----             -----          
+site.maintenance Saturday 02:00 
+site.owner       storage-team   
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("10.0.0.1:10001",
+					tc.msErr, tc.resp),
+			})
+
+			cmd := new(systemListAttrsCmd)
+			cmd.setInvoker(mi)
+			cmd.SetLog(log)
+			cmd.Prefix = tc.prefix
+			cmd.Verbose = tc.verbose
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if !strings.Contains(buf.String(), strings.TrimLeft(tc.expOut, "\n")) {
+				t.Fatalf("expected output to contain:\n%s\ngot:\n%s", tc.expOut, buf.String())
+			}
+		})
+	}
+}
+
 // TestDmg_systemStartCmd covers case where duplicate rank result is detected when constructing
 // rank groups, this applies across start stop exclude and any other commands that receive rank
 // results in response but the test case isn't run individually for all those commands that share
@@ -555,3 +972,503 @@ func TestDmg_systemStartCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestDmg_systemStopCmd_drainFirst(t *testing.T) {
+	drainResp := func(errored bool, poolIDs ...string) *control.UnaryResponse {
+		pbResp := new(mgmtpb.SystemDrainResp)
+		for _, id := range poolIDs {
+			pbResp.Responses = append(pbResp.Responses, &mgmtpb.PoolRanksResp{
+				Id: id,
+				Results: []*sharedpb.RankResult{
+					{Rank: 1, Errored: errored, Msg: "drain failed"},
+				},
+			})
+		}
+		return control.MockMSResponse("", nil, pbResp)
+	}
+	queryResp := func(state mgmtpb.PoolRebuildStatus_State) *control.UnaryResponse {
+		return control.MockMSResponse("", nil, &mgmtpb.PoolQueryResp{
+			Uuid:    test.MockUUID(1),
+			Rebuild: &mgmtpb.PoolRebuildStatus{State: state},
+		})
+	}
+	stopResp := control.MockMSResponse("", nil, &mgmtpb.SystemStopResp{})
+
+	for name, tc := range map[string]struct {
+		responses   []*control.UnaryResponse
+		lastResp    *control.UnaryResponse
+		expReqTypes []string
+		expErr      error
+	}{
+		"drain fails": {
+			responses: []*control.UnaryResponse{
+				drainResp(true, test.MockUUID(1)),
+			},
+			expErr: errors.New("draining ranks"),
+		},
+		"no pools to drain": {
+			responses: []*control.UnaryResponse{
+				drainResp(false),
+				stopResp,
+			},
+			expReqTypes: []string{
+				"*control.SystemDrainReq",
+				"*control.SystemStopReq",
+			},
+		},
+		"rebuild times out": {
+			responses: []*control.UnaryResponse{
+				drainResp(false, test.MockUUID(1)),
+			},
+			lastResp: queryResp(mgmtpb.PoolRebuildStatus_BUSY),
+			expErr:   errors.New("waiting for rebuild"),
+		},
+		"stop after rebuild": {
+			responses: []*control.UnaryResponse{
+				drainResp(false, test.MockUUID(1)),
+				queryResp(mgmtpb.PoolRebuildStatus_BUSY),
+				queryResp(mgmtpb.PoolRebuildStatus_DONE),
+				stopResp,
+			},
+			expReqTypes: []string{
+				"*control.SystemDrainReq",
+				"*control.PoolQueryReq",
+				"*control.PoolQueryReq",
+				"*control.SystemStopReq",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			prevInterval := rebuildPollInterval
+			rebuildPollInterval = time.Millisecond
+			defer func() {
+				rebuildPollInterval = prevInterval
+			}()
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+				UnaryResponse:    tc.lastResp,
+			})
+
+			cmd := &systemStopCmd{
+				Force:        true,
+				DrainFirst:   true,
+				DrainTimeout: ui.DurationFlag{Duration: 50 * time.Millisecond},
+			}
+			cmd.Ranks.Replace(ranklist.MustCreateRankSet("1"))
+			cmd.setInvoker(mi)
+			cmd.SetLog(log)
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotReqTypes []string
+			for _, req := range mi.SentReqs {
+				gotReqTypes = append(gotReqTypes, fmt.Sprintf("%T", req))
+			}
+			test.CmpAny(t, "sent requests", tc.expReqTypes, gotReqTypes)
+		})
+	}
+}
+
+func TestDmg_reportRankProgress(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	prevInterval := systemProgressPollInterval
+	systemProgressPollInterval = time.Millisecond
+	defer func() {
+		systemProgressPollInterval = prevInterval
+	}()
+
+	queryResp := func(states ...system.MemberState) *control.UnaryResponse {
+		pbResp := new(mgmtpb.SystemQueryResp)
+		for i, state := range states {
+			pbResp.Members = append(pbResp.Members, &mgmtpb.SystemMember{
+				Rank:  uint32(i),
+				State: state.String(),
+			})
+		}
+		return control.MockMSResponse("", nil, pbResp)
+	}
+
+	mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+		UnaryResponseSet: []*control.UnaryResponse{
+			queryResp(system.MemberStateJoined, system.MemberStateJoined),
+			queryResp(system.MemberStateStopping, system.MemberStateJoined),
+		},
+		UnaryResponse: queryResp(system.MemberStateStopped, system.MemberStateStopped),
+	})
+
+	stop := reportRankProgress(test.Context(t), log, mi, hostlist.MustCreateSet(""),
+		ranklist.MustCreateRankSet(""))
+
+	expMsgs := []string{
+		"rank 0: joined -> stopping",
+		"rank 0: stopping -> stopped",
+		"rank 1: joined -> stopped",
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		found := 0
+		for _, msg := range expMsgs {
+			if strings.Contains(buf.String(), msg) {
+				found++
+			}
+		}
+		if found == len(expMsgs) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected progress messages %v not reported", expMsgs)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+
+	// Unchanged states are not reported again.
+	test.AssertEqual(t, 1, strings.Count(buf.String(), "rank 1: joined -> stopped"), "")
+}
+
+func TestDmg_systemRollingRestartCmd(t *testing.T) {
+	sysQueryResp := func(states ...system.MemberState) *control.UnaryResponse {
+		pbResp := new(mgmtpb.SystemQueryResp)
+		for i, state := range states {
+			pbResp.Members = append(pbResp.Members, &mgmtpb.SystemMember{
+				Rank:  uint32(i),
+				State: state.String(),
+			})
+		}
+		return control.MockMSResponse("", nil, pbResp)
+	}
+	listPoolsResp := func(poolIDs ...string) *control.UnaryResponse {
+		pbResp := new(mgmtpb.ListPoolsResp)
+		for _, id := range poolIDs {
+			pbResp.Pools = append(pbResp.Pools, &mgmtpb.ListPoolsResp_Pool{
+				Uuid:  id,
+				Label: "pool1",
+				State: daos.PoolServiceStateReady.String(),
+			})
+		}
+		return control.MockMSResponse("", nil, pbResp)
+	}
+	poolQueryResp := func(disabled uint32, state mgmtpb.PoolRebuildStatus_State) *control.UnaryResponse {
+		return control.MockMSResponse("", nil, &mgmtpb.PoolQueryResp{
+			Uuid:            test.MockUUID(1),
+			Label:           "pool1",
+			State:           mgmtpb.PoolServiceState_Ready,
+			DisabledTargets: disabled,
+			Rebuild:         &mgmtpb.PoolRebuildStatus{State: state},
+		})
+	}
+	drainResp := func(poolIDs ...string) *control.UnaryResponse {
+		pbResp := new(mgmtpb.SystemDrainResp)
+		for _, id := range poolIDs {
+			pbResp.Responses = append(pbResp.Responses, &mgmtpb.PoolRanksResp{Id: id})
+		}
+		return control.MockMSResponse("", nil, pbResp)
+	}
+	stopResp := control.MockMSResponse("", nil, &mgmtpb.SystemStopResp{})
+	startResp := control.MockMSResponse("", nil, &mgmtpb.SystemStartResp{})
+
+	// The requests sent to restart a single wave in a system without pools.
+	waveReqTypes := []string{
+		"*control.ListPoolsReq",
+		"*control.SystemDrainReq",
+		"*control.SystemStopReq",
+		"*control.SystemStartReq",
+		"*control.SystemQueryReq",
+		"*control.SystemDrainReq",
+	}
+
+	for name, tc := range map[string]struct {
+		concurrency uint
+		dryRun      bool
+		responses   []*control.UnaryResponse
+		lastResp    *control.UnaryResponse
+		expReqTypes []string
+		expErr      error
+	}{
+		"zero concurrency": {
+			expErr: errors.New("--concurrency must be greater than zero"),
+		},
+		"ranks not joined": {
+			concurrency: 1,
+			responses: []*control.UnaryResponse{
+				sysQueryResp(system.MemberStateJoined, system.MemberStateStopped),
+			},
+			expErr: errors.New("ranks 1 are not joined"),
+		},
+		"dry run": {
+			concurrency: 2,
+			dryRun:      true,
+			responses: []*control.UnaryResponse{
+				sysQueryResp(system.MemberStateJoined, system.MemberStateJoined,
+					system.MemberStateJoined),
+			},
+			expReqTypes: []string{"*control.SystemQueryReq"},
+		},
+		"degraded pool": {
+			concurrency: 1,
+			responses: []*control.UnaryResponse{
+				sysQueryResp(system.MemberStateJoined, system.MemberStateJoined),
+				listPoolsResp(test.MockUUID(1)),
+				poolQueryResp(8, mgmtpb.PoolRebuildStatus_IDLE),
+			},
+			expErr: errors.New("aborting before wave 1: pool pool1 is degraded with 8 disabled targets"),
+		},
+		"rebuilding pool": {
+			concurrency: 1,
+			responses: []*control.UnaryResponse{
+				sysQueryResp(system.MemberStateJoined, system.MemberStateJoined),
+				listPoolsResp(test.MockUUID(1)),
+				poolQueryResp(0, mgmtpb.PoolRebuildStatus_BUSY),
+			},
+			expErr: errors.New("pool pool1 is rebuilding"),
+		},
+		"drain rebuild times out": {
+			concurrency: 2,
+			responses: []*control.UnaryResponse{
+				sysQueryResp(system.MemberStateJoined, system.MemberStateJoined),
+				listPoolsResp(),
+				drainResp(test.MockUUID(1)),
+			},
+			lastResp: poolQueryResp(8, mgmtpb.PoolRebuildStatus_BUSY),
+			expErr:   errors.New("draining ranks 0-1: timed out"),
+		},
+		"ranks do not rejoin": {
+			concurrency: 2,
+			responses: []*control.UnaryResponse{
+				sysQueryResp(system.MemberStateJoined, system.MemberStateJoined),
+				listPoolsResp(),
+				drainResp(),
+				stopResp,
+				startResp,
+			},
+			lastResp: sysQueryResp(system.MemberStateJoined, system.MemberStateStopped),
+			expErr:   errors.New("waiting for ranks 1 to join"),
+		},
+		"success; single wave": {
+			concurrency: 2,
+			responses: []*control.UnaryResponse{
+				sysQueryResp(system.MemberStateJoined, system.MemberStateJoined),
+				listPoolsResp(test.MockUUID(1)),
+				poolQueryResp(0, mgmtpb.PoolRebuildStatus_DONE),
+				drainResp(test.MockUUID(1)),
+				poolQueryResp(8, mgmtpb.PoolRebuildStatus_BUSY),
+				poolQueryResp(8, mgmtpb.PoolRebuildStatus_DONE),
+				stopResp,
+				startResp,
+				sysQueryResp(system.MemberStateJoined, system.MemberStateJoined),
+				drainResp(test.MockUUID(1)),
+				poolQueryResp(0, mgmtpb.PoolRebuildStatus_DONE),
+			},
+			expReqTypes: []string{
+				"*control.SystemQueryReq",
+				"*control.ListPoolsReq",
+				"*control.PoolQueryReq",
+				"*control.SystemDrainReq",
+				"*control.PoolQueryReq",
+				"*control.PoolQueryReq",
+				"*control.SystemStopReq",
+				"*control.SystemStartReq",
+				"*control.SystemQueryReq",
+				"*control.SystemDrainReq",
+				"*control.PoolQueryReq",
+			},
+		},
+		"success; two waves": {
+			concurrency: 1,
+			responses: []*control.UnaryResponse{
+				sysQueryResp(system.MemberStateJoined, system.MemberStateJoined),
+				listPoolsResp(), drainResp(), stopResp, startResp,
+				sysQueryResp(system.MemberStateJoined), drainResp(),
+				listPoolsResp(), drainResp(), stopResp, startResp,
+				sysQueryResp(system.MemberStateJoined, system.MemberStateJoined), drainResp(),
+			},
+			expReqTypes: append(append([]string{"*control.SystemQueryReq"}, waveReqTypes...),
+				waveReqTypes...),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			prevJoinInterval := rankJoinPollInterval
+			prevRebuildInterval := rebuildPollInterval
+			rankJoinPollInterval = time.Millisecond
+			rebuildPollInterval = time.Millisecond
+			defer func() {
+				rankJoinPollInterval = prevJoinInterval
+				rebuildPollInterval = prevRebuildInterval
+			}()
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+				UnaryResponse:    tc.lastResp,
+			})
+
+			cmd := &systemRollingRestartCmd{
+				Concurrency:    tc.concurrency,
+				JoinTimeout:    ui.DurationFlag{Duration: 50 * time.Millisecond},
+				RebuildTimeout: ui.DurationFlag{Duration: 50 * time.Millisecond},
+				DryRun:         tc.dryRun,
+			}
+			cmd.setInvoker(mi)
+			cmd.SetLog(log)
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotReqTypes []string
+			for _, req := range mi.SentReqs {
+				gotReqTypes = append(gotReqTypes, fmt.Sprintf("%T", req))
+			}
+			test.CmpAny(t, "sent requests", tc.expReqTypes, gotReqTypes)
+		})
+	}
+}
+
+func TestDmg_systemReplaceHostCmd(t *testing.T) {
+	replaceResp := control.MockMSResponse("", nil, &mgmtpb.SystemReplaceHostResp{
+		Ranks: "0-1",
+	})
+	checkFormatResp := control.MockMSResponse("", system.ErrRaftUnavail, nil)
+	formatResp := &control.UnaryResponse{
+		Responses: []*control.HostResponse{
+			{
+				Addr:    "host5",
+				Message: &ctlpb.StorageFormatResp{},
+			},
+		},
+	}
+	queryResp := func(states ...system.MemberState) *control.UnaryResponse {
+		pbResp := new(mgmtpb.SystemQueryResp)
+		for i, state := range states {
+			pbResp.Members = append(pbResp.Members, &mgmtpb.SystemMember{
+				Rank:  uint32(i),
+				State: state.String(),
+			})
+		}
+		return control.MockMSResponse("", nil, pbResp)
+	}
+	drainResp := control.MockMSResponse("", nil, &mgmtpb.SystemDrainResp{})
+
+	for name, tc := range map[string]struct {
+		skipReint   bool
+		responses   []*control.UnaryResponse
+		lastResp    *control.UnaryResponse
+		expReqTypes []string
+		expErr      error
+	}{
+		"replace fails": {
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("", errors.New("no ranks found"), nil),
+			},
+			expErr: errors.New("no ranks found"),
+		},
+		"format fails": {
+			responses: []*control.UnaryResponse{
+				replaceResp,
+				checkFormatResp,
+				{
+					Responses: []*control.HostResponse{
+						{
+							Addr:  "host5",
+							Error: errors.New("format failed"),
+						},
+					},
+				},
+			},
+			expErr: errors.New("formatting replacement host host5"),
+		},
+		"ranks do not join": {
+			responses: []*control.UnaryResponse{
+				replaceResp,
+				checkFormatResp,
+				formatResp,
+			},
+			lastResp: queryResp(system.MemberStateJoined, system.MemberStateStopped),
+			expErr:   errors.New("waiting for ranks 1 to join"),
+		},
+		"success; skip reintegration": {
+			skipReint: true,
+			responses: []*control.UnaryResponse{
+				replaceResp,
+				checkFormatResp,
+				formatResp,
+				queryResp(system.MemberStateJoined, system.MemberStateJoined),
+			},
+			expReqTypes: []string{
+				"*control.SystemReplaceHostReq",
+				"*control.SystemQueryReq",
+				"*control.StorageFormatReq",
+				"*control.SystemQueryReq",
+			},
+		},
+		"success": {
+			responses: []*control.UnaryResponse{
+				replaceResp,
+				checkFormatResp,
+				formatResp,
+				queryResp(system.MemberStateJoined, system.MemberStateStopped),
+				queryResp(system.MemberStateJoined, system.MemberStateJoined),
+				drainResp,
+			},
+			expReqTypes: []string{
+				"*control.SystemReplaceHostReq",
+				"*control.SystemQueryReq",
+				"*control.StorageFormatReq",
+				"*control.SystemQueryReq",
+				"*control.SystemQueryReq",
+				"*control.SystemDrainReq",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			prevInterval := rankJoinPollInterval
+			rankJoinPollInterval = time.Millisecond
+			defer func() {
+				rankJoinPollInterval = prevInterval
+			}()
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+				UnaryResponse:    tc.lastResp,
+			})
+
+			cmd := &systemReplaceHostCmd{
+				OldHost:     "host1",
+				NewHost:     "host5",
+				JoinTimeout: ui.DurationFlag{Duration: 50 * time.Millisecond},
+				SkipReint:   tc.skipReint,
+			}
+			cmd.setInvoker(mi)
+			cmd.SetLog(log)
+
+			gotErr := cmd.Execute(nil)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotReqTypes []string
+			for _, req := range mi.SentReqs {
+				gotReqTypes = append(gotReqTypes, fmt.Sprintf("%T", req))
+			}
+			test.CmpAny(t, "sent requests", tc.expReqTypes, gotReqTypes)
+		})
+	}
+}
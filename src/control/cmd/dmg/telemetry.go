@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -22,7 +23,6 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	"golang.org/x/sys/unix"
 	yaml "gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
@@ -222,7 +222,7 @@ func (cmd *telemConfigCmd) loadPromCfg(cfgPath string) (*promCfg, error) {
 }
 
 func (cmd *telemConfigCmd) configurePrometheus() (*installInfo, error) {
-	if err := unix.Access(cmd.InstallDir, unix.W_OK); err != nil {
+	if err := checkDirWritable(cmd.InstallDir); err != nil {
 		return nil, errors.Wrapf(err,
 			"Install folder %s does not have write permission", cmd.InstallDir)
 	}
@@ -0,0 +1,16 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !windows
+// +build !windows
+
+package main
+
+import "golang.org/x/sys/unix"
+
+// checkDirWritable returns an error if the current user can't write to dir.
+func checkDirWritable(dir string) error {
+	return unix.Access(dir, unix.W_OK)
+}
@@ -0,0 +1,21 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import "os"
+
+// checkDirWritable returns an error if the current user can't write to dir.
+// Windows has no access(2) equivalent, so a temporary file is created instead.
+func checkDirWritable(dir string) error {
+	f, err := os.CreateTemp(dir, ".dmg-write-check-")
+	if err != nil {
+		return err
+	}
+	f.Close()
+
+	return os.Remove(f.Name())
+}
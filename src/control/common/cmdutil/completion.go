@@ -0,0 +1,156 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package cmdutil
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+)
+
+// The completion scripts call back into the program with GO_FLAGS_COMPLETION
+// set, so that the candidates are generated by the go-flags parser from the
+// current set of commands and flags, and by any Completer implementations
+// (e.g. for flag values or labels fetched from a running system).
+var completionScripts = map[string]string{
+	"bash": `# bash completion for {{.Name}}
+# Load with: source <({{.Name}} completion bash)
+
+_{{.Func}}_completion()
+{
+	local cur words cword
+	_get_comp_words_by_ref -n : cur words cword
+
+	local IFS=$'\n'
+	COMPREPLY=($(GO_FLAGS_COMPLETION=1 "${words[0]}" "${words[@]:1:$cword}" 2>/dev/null))
+	# Don't add a space after e.g. a property name that takes a value.
+	if [[ ${#COMPREPLY[@]} -eq 1 && ${COMPREPLY[0]} == *[:=,] ]]; then
+		compopt -o nospace
+	fi
+	__ltrim_colon_completions "$cur"
+
+	return 0
+}
+
+complete -F _{{.Func}}_completion {{.Name}}
+`,
+	"zsh": `#compdef {{.Name}}
+# zsh completion for {{.Name}}
+# Load with: source <({{.Name}} completion zsh)
+
+_{{.Func}}() {
+	local -a lines comps
+	local line item desc
+
+	lines=("${(@f)$(GO_FLAGS_COMPLETION=verbose "${words[1]}" "${(@)words[2,CURRENT]}" 2>/dev/null)}")
+	for line in $lines; do
+		[[ -z "$line" ]] && continue
+		item=${line%% *}
+		desc=
+		[[ "$line" == *"  # "* ]] && desc=${line#*  \# }
+		comps+=("${item//:/\\:}:${desc}")
+	done
+
+	_describe -t values '{{.Name}}' comps
+}
+
+if [[ "${funcstack[1]}" == "_{{.Func}}" ]]; then
+	_{{.Func}} "$@"
+else
+	compdef _{{.Func}} {{.Name}}
+fi
+`,
+	"fish": `# fish completion for {{.Name}}
+# Load with: {{.Name}} completion fish | source
+
+function __{{.Func}}_complete
+	set -l args (commandline -opc)
+	set -e args[1]
+	set -l cur (commandline -ct)
+	env GO_FLAGS_COMPLETION=verbose {{.Name}} $args "$cur" 2>/dev/null | string replace -r '^(\S+)\s+# (.*)$' '$1\t$2'
+end
+
+complete -c {{.Name}} -f -a '(__{{.Func}}_complete)'
+`,
+}
+
+var nonIdentRE = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+func completionShells() []string {
+	shells := make([]string, 0, len(completionScripts))
+	for shell := range completionScripts {
+		shells = append(shells, shell)
+	}
+	sort.Strings(shells)
+	return shells
+}
+
+// CompletionShellFlag is the name of a shell for which a completion script can
+// be generated.
+type CompletionShellFlag string
+
+// UnmarshalFlag validates the shell name.
+func (f *CompletionShellFlag) UnmarshalFlag(value string) error {
+	if _, found := completionScripts[value]; !found {
+		return errors.Errorf("unsupported shell %q (supported shells: %s)", value,
+			strings.Join(completionShells(), ", "))
+	}
+	*f = CompletionShellFlag(value)
+	return nil
+}
+
+// Complete returns the supported shells that start with the match.
+func (f CompletionShellFlag) Complete(match string) (comps []flags.Completion) {
+	for _, shell := range completionShells() {
+		if strings.HasPrefix(shell, match) {
+			comps = append(comps, flags.Completion{Item: shell})
+		}
+	}
+	return
+}
+
+// WriteCompletionScript writes the completion script for the named program to
+// the given writer.
+func WriteCompletionScript(out io.Writer, progName string, shell CompletionShellFlag) error {
+	script, found := completionScripts[string(shell)]
+	if !found {
+		return errors.Errorf("unsupported shell %q", shell)
+	}
+
+	tmpl, err := template.New(string(shell)).Parse(script)
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Execute(out, struct {
+		Name string
+		Func string
+	}{
+		Name: progName,
+		Func: nonIdentRE.ReplaceAllString(progName, "_"),
+	})
+}
+
+// CompletionCmd defines a go-flags subcommand handler for generating a shell
+// completion script.
+type CompletionCmd struct {
+	Args struct {
+		Shell CompletionShellFlag `positional-arg-name:"bash|zsh|fish" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute writes the completion script for the running program to stdout.
+func (cmd *CompletionCmd) Execute(_ []string) error {
+	return WriteCompletionScript(os.Stdout, filepath.Base(os.Args[0]), cmd.Args.Shell)
+}
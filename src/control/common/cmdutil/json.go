@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,7 +13,9 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
@@ -25,6 +28,12 @@ type (
 		JSONOutputEnabled() bool
 		OutputJSON(interface{}, error) error
 	}
+
+	// hostErrorClassesGetter is implemented by responses which can group
+	// their host errors by class.
+	hostErrorClassesGetter interface {
+		GetHostErrorClasses() []*control.HostErrorClassSet
+	}
 )
 
 // OutputJSON writes the given data or error to the given writer as JSON.
@@ -40,11 +49,19 @@ func OutputJSON(writer io.Writer, in interface{}, inErr error) error {
 		}
 	}
 
+	// Summarize the errors of fan-out requests by class, so that the
+	// failures of many hosts can be handled without parsing each error.
+	var errClasses []*control.HostErrorClassSet
+	if hecg, ok := in.(hostErrorClassesGetter); ok && !common.InterfaceIsNil(in) {
+		errClasses = hecg.GetHostErrorClasses()
+	}
+
 	data, err := json.MarshalIndent(struct {
-		Response interface{} `json:"response"`
-		Error    *string     `json:"error"`
-		Status   int         `json:"status"`
-	}{in, errStr, status}, "", "  ")
+		Response         interface{}                  `json:"response"`
+		Error            *string                      `json:"error"`
+		Status           int                          `json:"status"`
+		HostErrorClasses []*control.HostErrorClassSet `json:"host_error_classes,omitempty"`
+	}{in, errStr, status, errClasses}, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -33,9 +34,10 @@ type (
 
 	// LogConfig contains parameters used to configure the logger.
 	LogConfig struct {
-		LogFile  string
-		LogLevel common.ControlLogLevel
-		JSON     bool
+		LogFile      string
+		LogLevel     common.ControlLogLevel
+		ModuleLevels map[string]common.ControlLogLevel
+		JSON         bool
 	}
 )
 
@@ -59,6 +61,29 @@ func (cmd *LogCmd) MustLogCtx() context.Context {
 	return ctx
 }
 
+// ConfigureModuleLevels replaces the logger's per-module log levels with the
+// supplied levels.
+func ConfigureModuleLevels(logIn logging.Logger, levels map[string]common.ControlLogLevel) error {
+	log, ok := logIn.(*logging.LeveledLogger)
+	if !ok {
+		return errors.New("logger is not a LeveledLogger")
+	}
+
+	moduleLevels := make(map[string]logging.LogLevel, len(levels))
+	for name, level := range levels {
+		moduleLevels[name] = logging.LogLevel(level)
+	}
+	if err := log.SetModuleLevels(moduleLevels); err != nil {
+		return err
+	}
+
+	if len(moduleLevels) > 0 {
+		log.Debugf("configured module log levels: %s", log.ModuleLevelsString())
+	}
+
+	return nil
+}
+
 // ConfigureLogger configures the logger according to the requested config.
 func ConfigureLogger(logIn logging.Logger, cfg LogConfig) error {
 	log, ok := logIn.(*logging.LeveledLogger)
@@ -84,6 +109,10 @@ func ConfigureLogger(logIn logging.Logger, cfg LogConfig) error {
 			log.SetLevel(logging.LogLevelError)
 		}
 
+		if err := ConfigureModuleLevels(log, cfg.ModuleLevels); err != nil {
+			return err
+		}
+
 		if cfg.JSON {
 			log = log.WithJSONOutput()
 		}
@@ -0,0 +1,260 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package common
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// ConfigIncludeKey is the top-level key of a YAML configuration file that
+// lists the other configuration files to be merged into it.
+const ConfigIncludeKey = "include"
+
+// configEnvRefRE matches $${ (an escaped ${), ${NAME} and ${NAME:-default}.
+var configEnvRefRE = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// ExpandConfigEnv replaces the ${NAME} references to environment variables in
+// the given configuration file contents with the values of the variables.
+// A reference of the form ${NAME:-default} is replaced with the default if the
+// variable is unset or empty, and $${ is replaced with a literal ${. Lines that
+// are entirely comments are left unchanged. An error is returned if a variable
+// without a default is unset.
+func ExpandConfigEnv(data []byte, lookupEnv func(string) (string, bool)) ([]byte, error) {
+	var unset []string
+	lines := strings.SplitAfter(string(data), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		lines[i] = configEnvRefRE.ReplaceAllStringFunc(line, func(ref string) string {
+			if ref == "$${" {
+				return "${"
+			}
+			m := configEnvRefRE.FindStringSubmatch(ref)
+			val, set := lookupEnv(m[1])
+			switch {
+			case val != "":
+				return val
+			case strings.Contains(ref, ":-"):
+				return m[2]
+			case !set:
+				unset = append(unset, m[1])
+			}
+			return val
+		})
+	}
+
+	if len(unset) > 0 {
+		return nil, errors.Errorf("environment variable(s) referenced in config not set: %s",
+			strings.Join(unset, ", "))
+	}
+
+	return []byte(strings.Join(lines, "")), nil
+}
+
+// mergeConfigMaps merges the settings in src into dst. Settings present in
+// both are taken from src, except for mappings which are merged recursively.
+func mergeConfigMaps(dst, src yaml.MapSlice) yaml.MapSlice {
+	for _, item := range src {
+		idx := -1
+		for i := range dst {
+			if dst[i].Key == item.Key {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			dst = append(dst, item)
+			continue
+		}
+
+		dstMap, dstIsMap := dst[idx].Value.(yaml.MapSlice)
+		srcMap, srcIsMap := item.Value.(yaml.MapSlice)
+		if dstIsMap && srcIsMap {
+			dst[idx].Value = mergeConfigMaps(dstMap, srcMap)
+			continue
+		}
+		dst[idx].Value = item.Value
+	}
+
+	return dst
+}
+
+func hasConfigIncludes(cfg yaml.MapSlice) bool {
+	for _, item := range cfg {
+		if item.Key == ConfigIncludeKey {
+			return true
+		}
+	}
+	return false
+}
+
+// configIncludes removes the include key from the given configuration and
+// returns the paths of the files that it lists, relative paths being resolved
+// against the directory of the including file. Glob patterns are expanded in
+// lexical order.
+func configIncludes(cfg yaml.MapSlice, dir string) (yaml.MapSlice, []string, error) {
+	var patterns []string
+	for i, item := range cfg {
+		if item.Key != ConfigIncludeKey {
+			continue
+		}
+
+		switch val := item.Value.(type) {
+		case nil:
+		case string:
+			patterns = []string{val}
+		case []interface{}:
+			for _, elem := range val {
+				pattern, ok := elem.(string)
+				if !ok {
+					return nil, nil, errors.Errorf("%s: %v is not a file path", ConfigIncludeKey, elem)
+				}
+				patterns = append(patterns, pattern)
+			}
+		default:
+			return nil, nil, errors.Errorf("%s must be a file path or a list of file paths",
+				ConfigIncludeKey)
+		}
+		cfg = append(cfg[:i:i], cfg[i+1:]...)
+		break
+	}
+
+	var paths []string
+	for _, pattern := range patterns {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(dir, pattern)
+		}
+		if !strings.ContainsAny(pattern, "*?[") {
+			paths = append(paths, pattern)
+			continue
+		}
+
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "%s %q", ConfigIncludeKey, pattern)
+		}
+		sort.Strings(matches)
+		paths = append(paths, matches...)
+	}
+
+	return cfg, paths, nil
+}
+
+func readConfigFile(path string, lookupEnv func(string) (string, bool), parents []string) (yaml.MapSlice, []string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, parent := range parents {
+		if parent == absPath {
+			return nil, nil, errors.Errorf("config file %q is included recursively", path)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if data, err = ExpandConfigEnv(data, lookupEnv); err != nil {
+		return nil, nil, errors.Wrap(err, path)
+	}
+
+	var cfg yaml.MapSlice
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, nil, errors.Wrapf(err, "parsing %s", path)
+	}
+
+	cfg, includes, err := configIncludes(cfg, filepath.Dir(absPath))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, path)
+	}
+
+	var merged yaml.MapSlice
+	var sources []string
+	for _, include := range includes {
+		incCfg, incSources, err := readConfigFile(include, lookupEnv, append(parents, absPath))
+		if err != nil {
+			return nil, nil, err
+		}
+		merged = mergeConfigMaps(merged, incCfg)
+		sources = append(sources, incSources...)
+	}
+
+	return mergeConfigMaps(merged, cfg), append(sources, absPath), nil
+}
+
+// ReadConfigFile reads a YAML configuration file, expanding references to
+// environment variables (see ExpandConfigEnv) and merging in the files listed
+// under its include key. Included files are merged in the order listed, each
+// overriding the settings of those before it, and the settings of the
+// including file override those of all of its includes. Mappings are merged
+// key by key; lists and other values are replaced. Included files may
+// themselves include other files.
+//
+// The merged file contents are returned, along with the paths of the files
+// that were merged in the order that they were applied.
+func ReadConfigFile(path string) ([]byte, []string, error) {
+	return readConfigFileWithEnv(path, os.LookupEnv)
+}
+
+func readConfigFileWithEnv(path string, lookupEnv func(string) (string, bool)) ([]byte, []string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if data, err = ExpandConfigEnv(data, lookupEnv); err != nil {
+		return nil, nil, err
+	}
+
+	// Only re-encode files that include others, so that the positions
+	// reported by parse errors otherwise match the file contents.
+	var cfg yaml.MapSlice
+	if err := yaml.Unmarshal(data, &cfg); err != nil || !hasConfigIncludes(cfg) {
+		return data, []string{absPath}, nil
+	}
+
+	cfg, sources, err := readConfigFile(path, lookupEnv, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if data, err = yaml.Marshal(cfg); err != nil {
+		return nil, nil, errors.Wrapf(err, "encoding merged config %s", path)
+	}
+
+	return data, sources, nil
+}
+
+// WriteEffectiveConfig writes the given loaded configuration in YAML, preceded
+// by a comment listing the files that it was merged from.
+func WriteEffectiveConfig(out io.Writer, cfg interface{}, sources []string) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, "# Effective configuration merged from:")
+	for _, src := range sources {
+		fmt.Fprintf(out, "#   %s\n", src)
+	}
+	_, err = out.Write(data)
+
+	return err
+}
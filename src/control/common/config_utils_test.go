@@ -0,0 +1,219 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func testLookupEnv(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		val, set := env[key]
+		return val, set
+	}
+}
+
+func TestCommon_ExpandConfigEnv(t *testing.T) {
+	env := map[string]string{
+		"DAOS_NAME":  "daos_test",
+		"DAOS_PORT":  "10002",
+		"DAOS_EMPTY": "",
+	}
+
+	for name, tc := range map[string]struct {
+		in     string
+		expOut string
+		expErr error
+	}{
+		"no references": {
+			in:     "name: daos_server\nport: 10001\n",
+			expOut: "name: daos_server\nport: 10001\n",
+		},
+		"references": {
+			in:     "name: ${DAOS_NAME}\nport: ${DAOS_PORT}\naddr: host:${DAOS_PORT}\n",
+			expOut: "name: daos_test\nport: 10002\naddr: host:10002\n",
+		},
+		"defaults": {
+			in:     "name: ${DAOS_UNSET:-daos_server}\nlog_file: ${DAOS_EMPTY:-/tmp/a.log}\nport: ${DAOS_PORT:-1}\n",
+			expOut: "name: daos_server\nlog_file: /tmp/a.log\nport: 10002\n",
+		},
+		"set but empty": {
+			in:     "name: '${DAOS_EMPTY}'\n",
+			expOut: "name: ''\n",
+		},
+		"escaped": {
+			in:     "value: $${DAOS_NAME}\n",
+			expOut: "value: ${DAOS_NAME}\n",
+		},
+		"comment lines unchanged": {
+			in:     "# name: ${DAOS_UNSET}\n  # port: ${DAOS_PORT}\nname: ${DAOS_NAME}\n",
+			expOut: "# name: ${DAOS_UNSET}\n  # port: ${DAOS_PORT}\nname: daos_test\n",
+		},
+		"unset": {
+			in:     "name: ${DAOS_UNSET}\nport: ${DAOS_UNSET2}\n",
+			expErr: errors.New("not set: DAOS_UNSET, DAOS_UNSET2"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := ExpandConfigEnv([]byte(tc.in), testLookupEnv(env))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expOut, string(out)); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCommon_ReadConfigFile(t *testing.T) {
+	for name, tc := range map[string]struct {
+		files      map[string]string
+		env        map[string]string
+		expOut     string
+		expSources []string
+		expErr     error
+	}{
+		"no includes": {
+			files: map[string]string{
+				"main.yml": "# comment\nname: ${NAME}\nport: 10001\n",
+			},
+			env:        map[string]string{"NAME": "daos_test"},
+			expOut:     "# comment\nname: daos_test\nport: 10001\n",
+			expSources: []string{"main.yml"},
+		},
+		"single include": {
+			files: map[string]string{
+				"main.yml":   "include: common.yml\nport: 10002\n",
+				"common.yml": "name: daos_test\nport: 10001\n",
+			},
+			expOut:     "name: daos_test\nport: 10002\n",
+			expSources: []string{"common.yml", "main.yml"},
+		},
+		"ordered includes and nested mappings": {
+			files: map[string]string{
+				"main.yml": strings.Join([]string{
+					"include:",
+					"- a.yml",
+					"- b.yml",
+					"transport_config:",
+					"  cert: ${CERT:-main.crt}",
+					"access_points: [c]",
+				}, "\n"),
+				"a.yml": strings.Join([]string{
+					"name: a",
+					"access_points: [a1, a2]",
+					"transport_config:",
+					"  allow_insecure: false",
+					"  cert: a.crt",
+					"  key: a.key",
+				}, "\n"),
+				"b.yml": strings.Join([]string{
+					"name: b",
+					"transport_config:",
+					"  key: b.key",
+				}, "\n"),
+			},
+			expOut: strings.Join([]string{
+				"name: b",
+				"access_points:",
+				"- c",
+				"transport_config:",
+				"  allow_insecure: false",
+				"  cert: main.crt",
+				"  key: b.key",
+				"",
+			}, "\n"),
+			expSources: []string{"a.yml", "b.yml", "main.yml"},
+		},
+		"glob and nested include": {
+			files: map[string]string{
+				"main.yml":         "include: conf.d/*.yml\nname: main\n",
+				"conf.d/20-b.yml":  "include: ../base.yml\nport: 20\n",
+				"conf.d/10-a.yml":  "port: 10\nlog_file: a.log\n",
+				"conf.d/other.txt": "port: 30\n",
+				"base.yml":         "log_file: base.log\n",
+			},
+			expOut:     "port: 20\nlog_file: base.log\nname: main\n",
+			expSources: []string{"conf.d/10-a.yml", "base.yml", "conf.d/20-b.yml", "main.yml"},
+		},
+		"glob matches nothing": {
+			files: map[string]string{
+				"main.yml": "include: conf.d/*.yml\nname: main\n",
+			},
+			expOut:     "name: main\n",
+			expSources: []string{"main.yml"},
+		},
+		"missing include": {
+			files: map[string]string{
+				"main.yml": "include: missing.yml\nname: main\n",
+			},
+			expErr: errors.New("no such file"),
+		},
+		"include cycle": {
+			files: map[string]string{
+				"main.yml": "include: a.yml\n",
+				"a.yml":    "include: main.yml\n",
+			},
+			expErr: errors.New("included recursively"),
+		},
+		"bad include value": {
+			files: map[string]string{
+				"main.yml": "include:\n  a: b.yml\n",
+			},
+			expErr: errors.New("must be a file path"),
+		},
+		"unset variable in include": {
+			files: map[string]string{
+				"main.yml":   "include: common.yml\n",
+				"common.yml": "name: ${UNSET}\n",
+			},
+			expErr: errors.New("not set: UNSET"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			for file, content := range tc.files {
+				path := filepath.Join(dir, file)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			out, sources, err := readConfigFileWithEnv(filepath.Join(dir, "main.yml"),
+				testLookupEnv(tc.env))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expOut, string(out)); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+			var expSources []string
+			for _, src := range tc.expSources {
+				expSources = append(expSources, filepath.Join(dir, src))
+			}
+			if diff := cmp.Diff(expSources, sources); diff != "" {
+				t.Fatalf("unexpected sources (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,16 +9,42 @@ package common
 
 import (
 	"fmt"
+	"math"
 	"net"
 	"strconv"
 	"strings"
 
+	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 )
 
+// MinControlMaxMsgSize is the smallest accepted control plane message size
+// limit, below which routine requests would start to fail.
+const MinControlMaxMsgSize = humanize.MiByte
+
+// ParseControlMaxMsgSize returns the control plane message size limit in bytes
+// represented by the supplied string (e.g. "64MiB"), or the default limit if
+// the string is empty.
+func ParseControlMaxMsgSize(in string) (int, error) {
+	if in == "" {
+		return build.DefaultControlMaxMsgSize, nil
+	}
+
+	size, err := humanize.ParseBytes(in)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid message size limit %q", in)
+	}
+	if size < MinControlMaxMsgSize || size > math.MaxInt32 {
+		return 0, errors.Errorf("message size limit %q out of range (%s-%s)", in,
+			humanize.IBytes(MinControlMaxMsgSize), humanize.IBytes(math.MaxInt32))
+	}
+
+	return int(size), nil
+}
+
 // HasPort checks if addr specifies a port. This only works with IPv4
 // addresses at the moment.
 func HasPort(addr string) bool {
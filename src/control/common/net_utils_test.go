@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -14,9 +15,51 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/build"
 	. "github.com/daos-stack/daos/src/control/common/test"
 )
 
+func TestCommon_ParseControlMaxMsgSize(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in      string
+		expSize int
+		expErr  error
+	}{
+		"empty": {
+			expSize: build.DefaultControlMaxMsgSize,
+		},
+		"binary units": {
+			in:      "64MiB",
+			expSize: 64 << 20,
+		},
+		"decimal units": {
+			in:      "2MB",
+			expSize: 2000000,
+		},
+		"unparseable": {
+			in:     "big",
+			expErr: errors.New("invalid message size limit"),
+		},
+		"too small": {
+			in:     "64KiB",
+			expErr: errors.New("out of range"),
+		},
+		"too large": {
+			in:     "4GiB",
+			expErr: errors.New("out of range"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotSize, gotErr := ParseControlMaxMsgSize(tc.in)
+			CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			AssertEqual(t, tc.expSize, gotSize, "unexpected size")
+		})
+	}
+}
+
 func TestUtils_HasPort(t *testing.T) {
 	for name, tc := range map[string]struct {
 		addr   string
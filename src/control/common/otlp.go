@@ -0,0 +1,40 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package common
+
+import (
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// OTLPConfig defines the parameters for exporting telemetry to an
+// OpenTelemetry collector, using OTLP over HTTP with JSON encoding.
+type OTLPConfig struct {
+	Endpoint string            `yaml:"endpoint,omitempty"`
+	Headers  map[string]string `yaml:"headers,omitempty"`
+}
+
+// Enabled returns true if an endpoint has been configured.
+func (oc OTLPConfig) Enabled() bool {
+	return oc.Endpoint != ""
+}
+
+// Validate returns an error if the OTLP configuration is invalid. An empty
+// endpoint is valid and disables the export.
+func (oc OTLPConfig) Validate() error {
+	if !oc.Enabled() {
+		return nil
+	}
+
+	u, err := url.Parse(oc.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid OTLP endpoint %q: must be an http:// or https:// URL", oc.Endpoint)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package common
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestCommon_OTLPConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg        OTLPConfig
+		expEnabled bool
+		expErr     error
+	}{
+		"empty": {},
+		"http": {
+			cfg:        OTLPConfig{Endpoint: "http://collector:4318/v1/traces"},
+			expEnabled: true,
+		},
+		"https with headers": {
+			cfg: OTLPConfig{
+				Endpoint: "https://collector:4318/v1/logs",
+				Headers:  map[string]string{"Authorization": "Bearer token"},
+			},
+			expEnabled: true,
+		},
+		"bad scheme": {
+			cfg:        OTLPConfig{Endpoint: "grpc://collector:4317"},
+			expEnabled: true,
+			expErr:     errors.New("invalid OTLP endpoint"),
+		},
+		"no host": {
+			cfg:        OTLPConfig{Endpoint: "http:///v1/logs"},
+			expEnabled: true,
+			expErr:     errors.New("invalid OTLP endpoint"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expEnabled, tc.cfg.Enabled(), "unexpected enabled state")
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 )
 
 func unmarshalState(data []byte, toName map[int32]string, toValue map[string]int32) (int32, error) {
@@ -62,3 +64,51 @@ func (nc *NvmeController) IsScannable() bool {
 func (nc *NvmeController) CanSupplyHealthStats() bool {
 	return nc.DevState == NvmeDevState_NORMAL || nc.DevState == NvmeDevState_EVICTED
 }
+
+// SplitNvmeCtrlrs splits the response into a sequence of partial responses containing
+// at most maxCtrlrs NVMe controllers each, for transmission in separate messages. The
+// first partial response carries all other fields of the response. Use
+// MergeNvmeCtrlrs to reassemble the response.
+func (r *StorageScanResp) SplitNvmeCtrlrs(maxCtrlrs int) ([]*StorageScanResp, error) {
+	if r == nil {
+		return nil, errors.New("nil response")
+	}
+	if maxCtrlrs <= 0 {
+		return nil, errors.Errorf("invalid number of NVMe controllers per response: %d", maxCtrlrs)
+	}
+	if r.Nvme == nil || len(r.Nvme.Ctrlrs) <= maxCtrlrs {
+		return []*StorageScanResp{r}, nil
+	}
+
+	ctrlrs := r.Nvme.Ctrlrs
+	r.Nvme.Ctrlrs = nil
+	head := proto.Clone(r).(*StorageScanResp)
+	r.Nvme.Ctrlrs = ctrlrs
+
+	head.Nvme.Ctrlrs = ctrlrs[:maxCtrlrs:maxCtrlrs]
+	parts := []*StorageScanResp{head}
+	for i := maxCtrlrs; i < len(ctrlrs); i += maxCtrlrs {
+		end := i + maxCtrlrs
+		if end > len(ctrlrs) {
+			end = len(ctrlrs)
+		}
+		parts = append(parts, &StorageScanResp{
+			Nvme: &ScanNvmeResp{Ctrlrs: ctrlrs[i:end:end]},
+		})
+	}
+
+	return parts, nil
+}
+
+// MergeNvmeCtrlrs appends the NVMe controllers of a partial response produced by
+// SplitNvmeCtrlrs to the response.
+func (r *StorageScanResp) MergeNvmeCtrlrs(part *StorageScanResp) {
+	if r == nil || len(part.GetNvme().GetCtrlrs()) == 0 {
+		return
+	}
+
+	if r.Nvme == nil {
+		r.Nvme = new(ScanNvmeResp)
+	}
+	r.Nvme.Ctrlrs = append(r.Nvme.Ctrlrs, part.Nvme.Ctrlrs...)
+}
@@ -0,0 +1,99 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package ctl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestCtl_StorageScanResp_SplitNvmeCtrlrs(t *testing.T) {
+	testResp := func(numCtrlrs int) *StorageScanResp {
+		resp := &StorageScanResp{
+			Nvme: &ScanNvmeResp{State: &ResponseState{Info: "nvme"}},
+			Scm: &ScanScmResp{
+				Namespaces: []*ScmNamespace{{Blockdev: "pmem0"}},
+			},
+			MemInfo: &MemInfo{MemTotalKb: 1024},
+		}
+		for i := 0; i < numCtrlrs; i++ {
+			resp.Nvme.Ctrlrs = append(resp.Nvme.Ctrlrs, &NvmeController{
+				PciAddr: fmt.Sprintf("0000:%02x:00.0", i),
+			})
+		}
+		return resp
+	}
+
+	for name, tc := range map[string]struct {
+		resp      *StorageScanResp
+		maxCtrlrs int
+		expParts  int
+		expErr    error
+	}{
+		"nil": {
+			maxCtrlrs: 1,
+			expErr:    errors.New("nil response"),
+		},
+		"bad max controllers": {
+			resp:   testResp(1),
+			expErr: errors.New("invalid number"),
+		},
+		"no NVMe": {
+			resp:      &StorageScanResp{MemInfo: &MemInfo{}},
+			maxCtrlrs: 1,
+			expParts:  1,
+		},
+		"fits in one": {
+			resp:      testResp(2),
+			maxCtrlrs: 2,
+			expParts:  1,
+		},
+		"split": {
+			resp:      testResp(5),
+			maxCtrlrs: 2,
+			expParts:  3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var expResp *StorageScanResp
+			if tc.resp != nil {
+				expResp = proto.Clone(tc.resp).(*StorageScanResp)
+			}
+
+			parts, gotErr := tc.resp.SplitNvmeCtrlrs(tc.maxCtrlrs)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expParts, len(parts), "unexpected number of parts")
+			for i, part := range parts {
+				if numCtrlrs := len(part.GetNvme().GetCtrlrs()); numCtrlrs > tc.maxCtrlrs {
+					t.Fatalf("part %d has %d controllers (max %d)", i, numCtrlrs, tc.maxCtrlrs)
+				}
+				if i > 0 && part.MemInfo != nil {
+					t.Fatalf("part %d carries response fields", i)
+				}
+			}
+
+			merged := parts[0]
+			for _, part := range parts[1:] {
+				merged.MergeNvmeCtrlrs(part)
+			}
+			if diff := cmp.Diff(expResp, merged, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected merged response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
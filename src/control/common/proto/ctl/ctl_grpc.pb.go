@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -26,7 +27,9 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	CtlSvc_StorageScan_FullMethodName          = "/ctl.CtlSvc/StorageScan"
+	CtlSvc_StorageScanStream_FullMethodName    = "/ctl.CtlSvc/StorageScanStream"
 	CtlSvc_StorageFormat_FullMethodName        = "/ctl.CtlSvc/StorageFormat"
+	CtlSvc_StorageFormatStream_FullMethodName  = "/ctl.CtlSvc/StorageFormatStream"
 	CtlSvc_StorageNvmeRebind_FullMethodName    = "/ctl.CtlSvc/StorageNvmeRebind"
 	CtlSvc_StorageNvmeAddDevice_FullMethodName = "/ctl.CtlSvc/StorageNvmeAddDevice"
 	CtlSvc_NetworkScan_FullMethodName          = "/ctl.CtlSvc/NetworkScan"
@@ -35,11 +38,14 @@ const (
 	CtlSvc_SmdQuery_FullMethodName             = "/ctl.CtlSvc/SmdQuery"
 	CtlSvc_SmdManage_FullMethodName            = "/ctl.CtlSvc/SmdManage"
 	CtlSvc_SetEngineLogMasks_FullMethodName    = "/ctl.CtlSvc/SetEngineLogMasks"
+	CtlSvc_ListEngines_FullMethodName          = "/ctl.CtlSvc/ListEngines"
+	CtlSvc_GetComponentVersions_FullMethodName = "/ctl.CtlSvc/GetComponentVersions"
 	CtlSvc_PrepShutdownRanks_FullMethodName    = "/ctl.CtlSvc/PrepShutdownRanks"
 	CtlSvc_StopRanks_FullMethodName            = "/ctl.CtlSvc/StopRanks"
 	CtlSvc_ResetFormatRanks_FullMethodName     = "/ctl.CtlSvc/ResetFormatRanks"
 	CtlSvc_StartRanks_FullMethodName           = "/ctl.CtlSvc/StartRanks"
 	CtlSvc_CollectLog_FullMethodName           = "/ctl.CtlSvc/CollectLog"
+	CtlSvc_TailLog_FullMethodName              = "/ctl.CtlSvc/TailLog"
 )
 
 // CtlSvcClient is the client API for CtlSvc service.
@@ -54,8 +60,12 @@ const (
 type CtlSvcClient interface {
 	// Retrieve details of nonvolatile storage on server, including health info
 	StorageScan(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (*StorageScanResp, error)
+	// Retrieve the StorageScan details as a stream of partial responses
+	StorageScanStream(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StorageScanResp], error)
 	// Format nonvolatile storage devices for use with DAOS
 	StorageFormat(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (*StorageFormatResp, error)
+	// Format nonvolatile storage devices, streaming progress updates
+	StorageFormatStream(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StorageFormatStreamResp], error)
 	// Rebind SSD from kernel and bind instead to user-space for use with DAOS
 	StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq, opts ...grpc.CallOption) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
@@ -72,6 +82,10 @@ type CtlSvcClient interface {
 	SmdManage(ctx context.Context, in *SmdManageReq, opts ...grpc.CallOption) (*SmdManageResp, error)
 	// Set log level for DAOS I/O Engines on a host.
 	SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq, opts ...grpc.CallOption) (*SetLogMasksResp, error)
+	// List the DAOS I/O Engines on a host.
+	ListEngines(ctx context.Context, in *ListEnginesReq, opts ...grpc.CallOption) (*ListEnginesResp, error)
+	// Get the versions of the DAOS components known to a host.
+	GetComponentVersions(ctx context.Context, in *GetComponentVersionsReq, opts ...grpc.CallOption) (*GetComponentVersionsResp, error)
 	// Prepare DAOS I/O Engines on a host for controlled shutdown. (gRPC fanout)
 	PrepShutdownRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
 	// Stop DAOS I/O Engines on a host. (gRPC fanout)
@@ -82,6 +96,9 @@ type CtlSvcClient interface {
 	StartRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
 	// Perform a Log collection on Servers for support/debug purpose
 	CollectLog(ctx context.Context, in *CollectLogReq, opts ...grpc.CallOption) (*CollectLogResp, error)
+	// Read the end of the control plane or engine logs on a host, optionally
+	// streaming lines as they are appended.
+	TailLog(ctx context.Context, in *TailLogReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailLogResp], error)
 }
 
 type ctlSvcClient struct {
@@ -102,6 +119,25 @@ func (c *ctlSvcClient) StorageScan(ctx context.Context, in *StorageScanReq, opts
 	return out, nil
 }
 
+func (c *ctlSvcClient) StorageScanStream(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StorageScanResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CtlSvc_ServiceDesc.Streams[0], CtlSvc_StorageScanStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StorageScanReq, StorageScanResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CtlSvc_StorageScanStreamClient = grpc.ServerStreamingClient[StorageScanResp]
+
 func (c *ctlSvcClient) StorageFormat(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (*StorageFormatResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StorageFormatResp)
@@ -112,6 +148,25 @@ func (c *ctlSvcClient) StorageFormat(ctx context.Context, in *StorageFormatReq,
 	return out, nil
 }
 
+func (c *ctlSvcClient) StorageFormatStream(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StorageFormatStreamResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CtlSvc_ServiceDesc.Streams[1], CtlSvc_StorageFormatStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StorageFormatReq, StorageFormatStreamResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CtlSvc_StorageFormatStreamClient = grpc.ServerStreamingClient[StorageFormatStreamResp]
+
 func (c *ctlSvcClient) StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq, opts ...grpc.CallOption) (*NvmeRebindResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(NvmeRebindResp)
@@ -192,6 +247,26 @@ func (c *ctlSvcClient) SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq
 	return out, nil
 }
 
+func (c *ctlSvcClient) ListEngines(ctx context.Context, in *ListEnginesReq, opts ...grpc.CallOption) (*ListEnginesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEnginesResp)
+	err := c.cc.Invoke(ctx, CtlSvc_ListEngines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) GetComponentVersions(ctx context.Context, in *GetComponentVersionsReq, opts ...grpc.CallOption) (*GetComponentVersionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetComponentVersionsResp)
+	err := c.cc.Invoke(ctx, CtlSvc_GetComponentVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ctlSvcClient) PrepShutdownRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RanksResp)
@@ -242,6 +317,25 @@ func (c *ctlSvcClient) CollectLog(ctx context.Context, in *CollectLogReq, opts .
 	return out, nil
 }
 
+func (c *ctlSvcClient) TailLog(ctx context.Context, in *TailLogReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TailLogResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CtlSvc_ServiceDesc.Streams[2], CtlSvc_TailLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailLogReq, TailLogResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CtlSvc_TailLogClient = grpc.ServerStreamingClient[TailLogResp]
+
 // CtlSvcServer is the server API for CtlSvc service.
 // All implementations must embed UnimplementedCtlSvcServer
 // for forward compatibility.
@@ -254,8 +348,12 @@ func (c *ctlSvcClient) CollectLog(ctx context.Context, in *CollectLogReq, opts .
 type CtlSvcServer interface {
 	// Retrieve details of nonvolatile storage on server, including health info
 	StorageScan(context.Context, *StorageScanReq) (*StorageScanResp, error)
+	// Retrieve the StorageScan details as a stream of partial responses
+	StorageScanStream(*StorageScanReq, grpc.ServerStreamingServer[StorageScanResp]) error
 	// Format nonvolatile storage devices for use with DAOS
 	StorageFormat(context.Context, *StorageFormatReq) (*StorageFormatResp, error)
+	// Format nonvolatile storage devices, streaming progress updates
+	StorageFormatStream(*StorageFormatReq, grpc.ServerStreamingServer[StorageFormatStreamResp]) error
 	// Rebind SSD from kernel and bind instead to user-space for use with DAOS
 	StorageNvmeRebind(context.Context, *NvmeRebindReq) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
@@ -272,6 +370,10 @@ type CtlSvcServer interface {
 	SmdManage(context.Context, *SmdManageReq) (*SmdManageResp, error)
 	// Set log level for DAOS I/O Engines on a host.
 	SetEngineLogMasks(context.Context, *SetLogMasksReq) (*SetLogMasksResp, error)
+	// List the DAOS I/O Engines on a host.
+	ListEngines(context.Context, *ListEnginesReq) (*ListEnginesResp, error)
+	// Get the versions of the DAOS components known to a host.
+	GetComponentVersions(context.Context, *GetComponentVersionsReq) (*GetComponentVersionsResp, error)
 	// Prepare DAOS I/O Engines on a host for controlled shutdown. (gRPC fanout)
 	PrepShutdownRanks(context.Context, *RanksReq) (*RanksResp, error)
 	// Stop DAOS I/O Engines on a host. (gRPC fanout)
@@ -282,6 +384,9 @@ type CtlSvcServer interface {
 	StartRanks(context.Context, *RanksReq) (*RanksResp, error)
 	// Perform a Log collection on Servers for support/debug purpose
 	CollectLog(context.Context, *CollectLogReq) (*CollectLogResp, error)
+	// Read the end of the control plane or engine logs on a host, optionally
+	// streaming lines as they are appended.
+	TailLog(*TailLogReq, grpc.ServerStreamingServer[TailLogResp]) error
 	mustEmbedUnimplementedCtlSvcServer()
 }
 
@@ -295,9 +400,15 @@ type UnimplementedCtlSvcServer struct{}
 func (UnimplementedCtlSvcServer) StorageScan(context.Context, *StorageScanReq) (*StorageScanResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageScan not implemented")
 }
+func (UnimplementedCtlSvcServer) StorageScanStream(*StorageScanReq, grpc.ServerStreamingServer[StorageScanResp]) error {
+	return status.Errorf(codes.Unimplemented, "method StorageScanStream not implemented")
+}
 func (UnimplementedCtlSvcServer) StorageFormat(context.Context, *StorageFormatReq) (*StorageFormatResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageFormat not implemented")
 }
+func (UnimplementedCtlSvcServer) StorageFormatStream(*StorageFormatReq, grpc.ServerStreamingServer[StorageFormatStreamResp]) error {
+	return status.Errorf(codes.Unimplemented, "method StorageFormatStream not implemented")
+}
 func (UnimplementedCtlSvcServer) StorageNvmeRebind(context.Context, *NvmeRebindReq) (*NvmeRebindResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeRebind not implemented")
 }
@@ -322,6 +433,12 @@ func (UnimplementedCtlSvcServer) SmdManage(context.Context, *SmdManageReq) (*Smd
 func (UnimplementedCtlSvcServer) SetEngineLogMasks(context.Context, *SetLogMasksReq) (*SetLogMasksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetEngineLogMasks not implemented")
 }
+func (UnimplementedCtlSvcServer) ListEngines(context.Context, *ListEnginesReq) (*ListEnginesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEngines not implemented")
+}
+func (UnimplementedCtlSvcServer) GetComponentVersions(context.Context, *GetComponentVersionsReq) (*GetComponentVersionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComponentVersions not implemented")
+}
 func (UnimplementedCtlSvcServer) PrepShutdownRanks(context.Context, *RanksReq) (*RanksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrepShutdownRanks not implemented")
 }
@@ -337,6 +454,9 @@ func (UnimplementedCtlSvcServer) StartRanks(context.Context, *RanksReq) (*RanksR
 func (UnimplementedCtlSvcServer) CollectLog(context.Context, *CollectLogReq) (*CollectLogResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectLog not implemented")
 }
+func (UnimplementedCtlSvcServer) TailLog(*TailLogReq, grpc.ServerStreamingServer[TailLogResp]) error {
+	return status.Errorf(codes.Unimplemented, "method TailLog not implemented")
+}
 func (UnimplementedCtlSvcServer) mustEmbedUnimplementedCtlSvcServer() {}
 func (UnimplementedCtlSvcServer) testEmbeddedByValue()                {}
 
@@ -376,6 +496,17 @@ func _CtlSvc_StorageScan_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_StorageScanStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StorageScanReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CtlSvcServer).StorageScanStream(m, &grpc.GenericServerStream[StorageScanReq, StorageScanResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CtlSvc_StorageScanStreamServer = grpc.ServerStreamingServer[StorageScanResp]
+
 func _CtlSvc_StorageFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StorageFormatReq)
 	if err := dec(in); err != nil {
@@ -394,6 +525,17 @@ func _CtlSvc_StorageFormat_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_StorageFormatStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StorageFormatReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CtlSvcServer).StorageFormatStream(m, &grpc.GenericServerStream[StorageFormatReq, StorageFormatStreamResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CtlSvc_StorageFormatStreamServer = grpc.ServerStreamingServer[StorageFormatStreamResp]
+
 func _CtlSvc_StorageNvmeRebind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NvmeRebindReq)
 	if err := dec(in); err != nil {
@@ -538,6 +680,42 @@ func _CtlSvc_SetEngineLogMasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_ListEngines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnginesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).ListEngines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_ListEngines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).ListEngines(ctx, req.(*ListEnginesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_GetComponentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetComponentVersionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).GetComponentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_GetComponentVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).GetComponentVersions(ctx, req.(*GetComponentVersionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CtlSvc_PrepShutdownRanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RanksReq)
 	if err := dec(in); err != nil {
@@ -628,6 +806,17 @@ func _CtlSvc_CollectLog_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_TailLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailLogReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CtlSvcServer).TailLog(m, &grpc.GenericServerStream[TailLogReq, TailLogResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CtlSvc_TailLogServer = grpc.ServerStreamingServer[TailLogResp]
+
 // CtlSvc_ServiceDesc is the grpc.ServiceDesc for CtlSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -675,6 +864,14 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetEngineLogMasks",
 			Handler:    _CtlSvc_SetEngineLogMasks_Handler,
 		},
+		{
+			MethodName: "ListEngines",
+			Handler:    _CtlSvc_ListEngines_Handler,
+		},
+		{
+			MethodName: "GetComponentVersions",
+			Handler:    _CtlSvc_GetComponentVersions_Handler,
+		},
 		{
 			MethodName: "PrepShutdownRanks",
 			Handler:    _CtlSvc_PrepShutdownRanks_Handler,
@@ -696,6 +893,22 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CtlSvc_CollectLog_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StorageScanStream",
+			Handler:       _CtlSvc_StorageScanStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StorageFormatStream",
+			Handler:       _CtlSvc_StorageFormatStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TailLog",
+			Handler:       _CtlSvc_TailLog_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ctl/ctl.proto",
 }
@@ -14,6 +14,7 @@
 package ctl
 
 import (
+	shared "github.com/daos-stack/daos/src/control/common/proto/shared"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -374,6 +375,88 @@ func (x *StorageFormatResp) GetMrets() []*ScmMountResult {
 	return nil
 }
 
+// StorageFormatStreamResp is sent in the stream of a StorageFormatStream request,
+// as either a progress update or the final response.
+type StorageFormatStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Update:
+	//	*StorageFormatStreamResp_Progress
+	//	*StorageFormatStreamResp_Resp
+	Update isStorageFormatStreamResp_Update `protobuf_oneof:"update"`
+}
+
+func (x *StorageFormatStreamResp) Reset() {
+	*x = StorageFormatStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageFormatStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageFormatStreamResp) ProtoMessage() {}
+
+func (x *StorageFormatStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageFormatStreamResp.ProtoReflect.Descriptor instead.
+func (*StorageFormatStreamResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *StorageFormatStreamResp) GetUpdate() isStorageFormatStreamResp_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (x *StorageFormatStreamResp) GetProgress() *shared.Progress {
+	if x, ok := x.GetUpdate().(*StorageFormatStreamResp_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *StorageFormatStreamResp) GetResp() *StorageFormatResp {
+	if x, ok := x.GetUpdate().(*StorageFormatStreamResp_Resp); ok {
+		return x.Resp
+	}
+	return nil
+}
+
+type isStorageFormatStreamResp_Update interface {
+	isStorageFormatStreamResp_Update()
+}
+
+type StorageFormatStreamResp_Progress struct {
+	Progress *shared.Progress `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
+}
+
+type StorageFormatStreamResp_Resp struct {
+	Resp *StorageFormatResp `protobuf:"bytes,2,opt,name=resp,proto3,oneof"`
+}
+
+func (*StorageFormatStreamResp_Progress) isStorageFormatStreamResp_Update() {}
+
+func (*StorageFormatStreamResp_Resp) isStorageFormatStreamResp_Update() {}
+
 type NvmeRebindReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -385,7 +468,7 @@ type NvmeRebindReq struct {
 func (x *NvmeRebindReq) Reset() {
 	*x = NvmeRebindReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_proto_msgTypes[5]
+		mi := &file_ctl_storage_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -398,7 +481,7 @@ func (x *NvmeRebindReq) String() string {
 func (*NvmeRebindReq) ProtoMessage() {}
 
 func (x *NvmeRebindReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_proto_msgTypes[5]
+	mi := &file_ctl_storage_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +494,7 @@ func (x *NvmeRebindReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NvmeRebindReq.ProtoReflect.Descriptor instead.
 func (*NvmeRebindReq) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_proto_rawDescGZIP(), []int{5}
+	return file_ctl_storage_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NvmeRebindReq) GetPciAddr() string {
@@ -432,7 +515,7 @@ type NvmeRebindResp struct {
 func (x *NvmeRebindResp) Reset() {
 	*x = NvmeRebindResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_proto_msgTypes[6]
+		mi := &file_ctl_storage_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -445,7 +528,7 @@ func (x *NvmeRebindResp) String() string {
 func (*NvmeRebindResp) ProtoMessage() {}
 
 func (x *NvmeRebindResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_proto_msgTypes[6]
+	mi := &file_ctl_storage_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +541,7 @@ func (x *NvmeRebindResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NvmeRebindResp.ProtoReflect.Descriptor instead.
 func (*NvmeRebindResp) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_proto_rawDescGZIP(), []int{6}
+	return file_ctl_storage_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *NvmeRebindResp) GetState() *ResponseState {
@@ -481,7 +564,7 @@ type NvmeAddDeviceReq struct {
 func (x *NvmeAddDeviceReq) Reset() {
 	*x = NvmeAddDeviceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_proto_msgTypes[7]
+		mi := &file_ctl_storage_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -494,7 +577,7 @@ func (x *NvmeAddDeviceReq) String() string {
 func (*NvmeAddDeviceReq) ProtoMessage() {}
 
 func (x *NvmeAddDeviceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_proto_msgTypes[7]
+	mi := &file_ctl_storage_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +590,7 @@ func (x *NvmeAddDeviceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NvmeAddDeviceReq.ProtoReflect.Descriptor instead.
 func (*NvmeAddDeviceReq) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_proto_rawDescGZIP(), []int{7}
+	return file_ctl_storage_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *NvmeAddDeviceReq) GetPciAddr() string {
@@ -542,7 +625,7 @@ type NvmeAddDeviceResp struct {
 func (x *NvmeAddDeviceResp) Reset() {
 	*x = NvmeAddDeviceResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_proto_msgTypes[8]
+		mi := &file_ctl_storage_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -555,7 +638,7 @@ func (x *NvmeAddDeviceResp) String() string {
 func (*NvmeAddDeviceResp) ProtoMessage() {}
 
 func (x *NvmeAddDeviceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_proto_msgTypes[8]
+	mi := &file_ctl_storage_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +651,7 @@ func (x *NvmeAddDeviceResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NvmeAddDeviceResp.ProtoReflect.Descriptor instead.
 func (*NvmeAddDeviceResp) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_proto_rawDescGZIP(), []int{8}
+	return file_ctl_storage_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NvmeAddDeviceResp) GetState() *ResponseState {
@@ -586,81 +669,91 @@ var file_ctl_storage_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x76, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x15, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63,
 	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x63, 0x74, 0x6c, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x59, 0x0a, 0x0e, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x6e,
-	0x76, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x53, 0x63, 0x61, 0x6e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04, 0x6e, 0x76, 0x6d,
-	0x65, 0x12, 0x21, 0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x52,
-	0x03, 0x73, 0x63, 0x6d, 0x22, 0xcb, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x70,
-	0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x75, 0x67,
-	0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65,
-	0x12, 0x2d, 0x0a, 0x12, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x68, 0x75,
-	0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12,
-	0x2b, 0x0a, 0x11, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72,
-	0x70, 0x6c, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x68, 0x75, 0x67, 0x65,
-	0x70, 0x61, 0x67, 0x65, 0x73, 0x53, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10,
-	0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6b, 0x62,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x4b, 0x62, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x5f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x6b, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x65,
-	0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4b, 0x62, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x5f,
-	0x66, 0x72, 0x65, 0x65, 0x5f, 0x6b, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d,
-	0x65, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x4b, 0x62, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x5f,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6b, 0x62, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x4b, 0x62, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63,
-	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x4e,
-	0x76, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x12, 0x22, 0x0a,
-	0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x52, 0x03, 0x73, 0x63,
-	0x6d, 0x12, 0x27, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x26, 0x0a, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x63, 0x74, 0x6c, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65,
-	0x71, 0x52, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x52, 0x03, 0x73, 0x63, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x72, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x22, 0x6f, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x72, 0x65, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x05, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x72, 0x65, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63,
-	0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x6d, 0x72,
-	0x65, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x0d, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x22,
-	0x3a, 0x0a, 0x0e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x7e, 0x0a, 0x10, 0x4e,
-	0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x59, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x4e, 0x76, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x52, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e,
+	0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x52, 0x03, 0x73, 0x63, 0x6d, 0x22, 0xcb, 0x02, 0x0a, 0x07,
+	0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x65, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x25, 0x0a, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x66, 0x72,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x75, 0x67, 0x65, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x53, 0x75, 0x72, 0x70,
+	0x6c, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6b, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x68,
+	0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x62, 0x12, 0x20, 0x0a,
+	0x0c, 0x6d, 0x65, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6b, 0x62, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4b, 0x62, 0x12,
+	0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x6b, 0x62, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x4b, 0x62, 0x12,
+	0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x6b, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x62, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a,
+	0x04, 0x6e, 0x76, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x04,
+	0x6e, 0x76, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x52, 0x03, 0x73, 0x63, 0x6d, 0x12, 0x27, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x52, 0x03,
+	0x73, 0x63, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x6f, 0x0a, 0x11, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f,
+	0x0a, 0x05, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12,
+	0x29, 0x0a, 0x05, 0x6d, 0x72, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x05, 0x6d, 0x72, 0x65, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x04,
+	0x72, 0x65, 0x73, 0x70, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x2a,
+	0x0a, 0x0d, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12,
 	0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a,
-	0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x54, 0x69, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x11, 0x4e,
-	0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74,
-	0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x22, 0x3a, 0x0a, 0x0e, 0x4e, 0x76,
+	0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x7e, 0x0a, 0x10, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63,
+	0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63,
+	0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x65,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x11, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64,
+	0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -675,44 +768,48 @@ func file_ctl_storage_proto_rawDescGZIP() []byte {
 	return file_ctl_storage_proto_rawDescData
 }
 
-var file_ctl_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ctl_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_ctl_storage_proto_goTypes = []interface{}{
-	(*StorageScanReq)(nil),       // 0: ctl.StorageScanReq
-	(*MemInfo)(nil),              // 1: ctl.MemInfo
-	(*StorageScanResp)(nil),      // 2: ctl.StorageScanResp
-	(*StorageFormatReq)(nil),     // 3: ctl.StorageFormatReq
-	(*StorageFormatResp)(nil),    // 4: ctl.StorageFormatResp
-	(*NvmeRebindReq)(nil),        // 5: ctl.NvmeRebindReq
-	(*NvmeRebindResp)(nil),       // 6: ctl.NvmeRebindResp
-	(*NvmeAddDeviceReq)(nil),     // 7: ctl.NvmeAddDeviceReq
-	(*NvmeAddDeviceResp)(nil),    // 8: ctl.NvmeAddDeviceResp
-	(*ScanNvmeReq)(nil),          // 9: ctl.ScanNvmeReq
-	(*ScanScmReq)(nil),           // 10: ctl.ScanScmReq
-	(*ScanNvmeResp)(nil),         // 11: ctl.ScanNvmeResp
-	(*ScanScmResp)(nil),          // 12: ctl.ScanScmResp
-	(*FormatNvmeReq)(nil),        // 13: ctl.FormatNvmeReq
-	(*FormatScmReq)(nil),         // 14: ctl.FormatScmReq
-	(*NvmeControllerResult)(nil), // 15: ctl.NvmeControllerResult
-	(*ScmMountResult)(nil),       // 16: ctl.ScmMountResult
-	(*ResponseState)(nil),        // 17: ctl.ResponseState
+	(*StorageScanReq)(nil),          // 0: ctl.StorageScanReq
+	(*MemInfo)(nil),                 // 1: ctl.MemInfo
+	(*StorageScanResp)(nil),         // 2: ctl.StorageScanResp
+	(*StorageFormatReq)(nil),        // 3: ctl.StorageFormatReq
+	(*StorageFormatResp)(nil),       // 4: ctl.StorageFormatResp
+	(*StorageFormatStreamResp)(nil), // 5: ctl.StorageFormatStreamResp
+	(*NvmeRebindReq)(nil),           // 6: ctl.NvmeRebindReq
+	(*NvmeRebindResp)(nil),          // 7: ctl.NvmeRebindResp
+	(*NvmeAddDeviceReq)(nil),        // 8: ctl.NvmeAddDeviceReq
+	(*NvmeAddDeviceResp)(nil),       // 9: ctl.NvmeAddDeviceResp
+	(*ScanNvmeReq)(nil),             // 10: ctl.ScanNvmeReq
+	(*ScanScmReq)(nil),              // 11: ctl.ScanScmReq
+	(*ScanNvmeResp)(nil),            // 12: ctl.ScanNvmeResp
+	(*ScanScmResp)(nil),             // 13: ctl.ScanScmResp
+	(*FormatNvmeReq)(nil),           // 14: ctl.FormatNvmeReq
+	(*FormatScmReq)(nil),            // 15: ctl.FormatScmReq
+	(*NvmeControllerResult)(nil),    // 16: ctl.NvmeControllerResult
+	(*ScmMountResult)(nil),          // 17: ctl.ScmMountResult
+	(*shared.Progress)(nil),         // 18: shared.Progress
+	(*ResponseState)(nil),           // 19: ctl.ResponseState
 }
 var file_ctl_storage_proto_depIdxs = []int32{
-	9,  // 0: ctl.StorageScanReq.nvme:type_name -> ctl.ScanNvmeReq
-	10, // 1: ctl.StorageScanReq.scm:type_name -> ctl.ScanScmReq
-	11, // 2: ctl.StorageScanResp.nvme:type_name -> ctl.ScanNvmeResp
-	12, // 3: ctl.StorageScanResp.scm:type_name -> ctl.ScanScmResp
+	10, // 0: ctl.StorageScanReq.nvme:type_name -> ctl.ScanNvmeReq
+	11, // 1: ctl.StorageScanReq.scm:type_name -> ctl.ScanScmReq
+	12, // 2: ctl.StorageScanResp.nvme:type_name -> ctl.ScanNvmeResp
+	13, // 3: ctl.StorageScanResp.scm:type_name -> ctl.ScanScmResp
 	1,  // 4: ctl.StorageScanResp.mem_info:type_name -> ctl.MemInfo
-	13, // 5: ctl.StorageFormatReq.nvme:type_name -> ctl.FormatNvmeReq
-	14, // 6: ctl.StorageFormatReq.scm:type_name -> ctl.FormatScmReq
-	15, // 7: ctl.StorageFormatResp.crets:type_name -> ctl.NvmeControllerResult
-	16, // 8: ctl.StorageFormatResp.mrets:type_name -> ctl.ScmMountResult
-	17, // 9: ctl.NvmeRebindResp.state:type_name -> ctl.ResponseState
-	17, // 10: ctl.NvmeAddDeviceResp.state:type_name -> ctl.ResponseState
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	14, // 5: ctl.StorageFormatReq.nvme:type_name -> ctl.FormatNvmeReq
+	15, // 6: ctl.StorageFormatReq.scm:type_name -> ctl.FormatScmReq
+	16, // 7: ctl.StorageFormatResp.crets:type_name -> ctl.NvmeControllerResult
+	17, // 8: ctl.StorageFormatResp.mrets:type_name -> ctl.ScmMountResult
+	18, // 9: ctl.StorageFormatStreamResp.progress:type_name -> shared.Progress
+	4,  // 10: ctl.StorageFormatStreamResp.resp:type_name -> ctl.StorageFormatResp
+	19, // 11: ctl.NvmeRebindResp.state:type_name -> ctl.ResponseState
+	19, // 12: ctl.NvmeAddDeviceResp.state:type_name -> ctl.ResponseState
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_ctl_storage_proto_init() }
@@ -785,7 +882,7 @@ func file_ctl_storage_proto_init() {
 			}
 		}
 		file_ctl_storage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NvmeRebindReq); i {
+			switch v := v.(*StorageFormatStreamResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -797,7 +894,7 @@ func file_ctl_storage_proto_init() {
 			}
 		}
 		file_ctl_storage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NvmeRebindResp); i {
+			switch v := v.(*NvmeRebindReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -809,7 +906,7 @@ func file_ctl_storage_proto_init() {
 			}
 		}
 		file_ctl_storage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NvmeAddDeviceReq); i {
+			switch v := v.(*NvmeRebindResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -821,6 +918,18 @@ func file_ctl_storage_proto_init() {
 			}
 		}
 		file_ctl_storage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeAddDeviceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NvmeAddDeviceResp); i {
 			case 0:
 				return &v.state
@@ -833,13 +942,17 @@ func file_ctl_storage_proto_init() {
 			}
 		}
 	}
+	file_ctl_storage_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*StorageFormatStreamResp_Progress)(nil),
+		(*StorageFormatStreamResp_Resp)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_storage_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
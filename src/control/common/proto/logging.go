@@ -174,6 +174,9 @@ func Debug(msg proto.Message) string {
 			uriRanks.Add(ranklist.Rank(ru.Rank))
 		}
 		fmt.Fprintf(&bld, "%T@%d ms:%s ranks:%s client:%+v build:%s", m, m.DataVersion, msRanks.String(), uriRanks.String(), m.ClientNetHint, m.BuildInfo.BuildString())
+		if m.RankUrisEncoding != "" {
+			fmt.Fprintf(&bld, " packed:%s(%dB)", m.RankUrisEncoding, len(m.PackedRankUris))
+		}
 	case *mgmtpb.LeaderQueryResp:
 		fmt.Fprintf(&bld, "%T leader:%s Replica Set:%s Down Replicas:%s", m, m.CurrentLeader, strings.Join(m.Replicas, ","), strings.Join(m.DownReplicas, ","))
 	case *sharedpb.ClusterEventReq:
@@ -8,10 +8,15 @@
 package mgmt
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 )
 
 func (p *PoolProperty) UnmarshalJSON(b []byte) error {
@@ -71,6 +76,26 @@ func (r *PoolUpgradeReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
 }
 
+// SetUUID sets the request's ID to a UUID.
+func (r *PoolRebalanceReq) SetUUID(id uuid.UUID) {
+	r.Id = id.String()
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *PoolRebalanceReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's ID to a UUID.
+func (r *PoolListHandlesReq) SetUUID(id uuid.UUID) {
+	r.Id = id.String()
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *PoolListHandlesReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolSetPropReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
@@ -206,6 +231,51 @@ func (r *ContSetOwnerReq) GetId() string {
 	return r.PoolId
 }
 
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ContCreateReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's ID to a UUID.
+func (r *ContCreateReq) SetUUID(id uuid.UUID) {
+	r.PoolId = id.String()
+}
+
+// GetId fetches the pool ID.
+func (r *ContCreateReq) GetId() string {
+	return r.PoolId
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ContDestroyReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's ID to a UUID.
+func (r *ContDestroyReq) SetUUID(id uuid.UUID) {
+	r.PoolId = id.String()
+}
+
+// GetId fetches the pool ID.
+func (r *ContDestroyReq) GetId() string {
+	return r.PoolId
+}
+
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *ContQueryReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's ID to a UUID.
+func (r *ContQueryReq) SetUUID(id uuid.UUID) {
+	r.PoolId = id.String()
+}
+
+// GetId fetches the pool ID.
+func (r *ContQueryReq) GetId() string {
+	return r.PoolId
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *ListContReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
@@ -235,3 +305,141 @@ func (bi *BuildInfo) VersionString() string {
 
 	return fmt.Sprintf("%d.%d.%d", bi.Major, bi.Minor, bi.Patch)
 }
+
+// RankURIEncodingGzip indicates that the rank URI table of a GetAttachInfoResp is
+// compressed with gzip.
+const RankURIEncodingGzip = "gzip"
+
+// CompressRankURIs replaces the rank URI tables of the response with a gzip-compressed
+// copy. The response is left unchanged on error.
+func (r *GetAttachInfoResp) CompressRankURIs() error {
+	if r == nil {
+		return errors.New("nil response")
+	}
+	if r.RankUrisEncoding != "" {
+		return errors.Errorf("rank URIs already compressed with %s", r.RankUrisEncoding)
+	}
+
+	data, err := proto.Marshal(&GetAttachInfoResp{
+		RankUris:          r.RankUris,
+		SecondaryRankUris: r.SecondaryRankUris,
+	})
+	if err != nil {
+		return errors.Wrap(err, "packing rank URIs")
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return errors.Wrap(err, "compressing rank URIs")
+	}
+	if err := zw.Close(); err != nil {
+		return errors.Wrap(err, "compressing rank URIs")
+	}
+
+	r.RankUris = nil
+	r.SecondaryRankUris = nil
+	r.RankUrisEncoding = RankURIEncodingGzip
+	r.PackedRankUris = buf.Bytes()
+
+	return nil
+}
+
+// DecompressRankURIs restores the rank URI tables of the response from the compressed
+// copy, if any.
+func (r *GetAttachInfoResp) DecompressRankURIs() error {
+	if r == nil {
+		return errors.New("nil response")
+	}
+
+	switch r.RankUrisEncoding {
+	case "":
+		return nil
+	case RankURIEncodingGzip:
+	default:
+		return errors.Errorf("unsupported rank URI encoding %q", r.RankUrisEncoding)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(r.PackedRankUris))
+	if err != nil {
+		return errors.Wrap(err, "decompressing rank URIs")
+	}
+	defer zr.Close()
+
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return errors.Wrap(err, "decompressing rank URIs")
+	}
+
+	packed := new(GetAttachInfoResp)
+	if err := proto.Unmarshal(data, packed); err != nil {
+		return errors.Wrap(err, "unpacking rank URIs")
+	}
+
+	r.RankUris = packed.RankUris
+	r.SecondaryRankUris = packed.SecondaryRankUris
+	r.RankUrisEncoding = ""
+	r.PackedRankUris = nil
+
+	return nil
+}
+
+// SplitRankURIs splits the response into a sequence of partial responses containing
+// at most maxURIs rank URIs each, for transmission in separate messages. The first
+// partial response carries all other fields of the response. Use MergeRankURIs to
+// reassemble the response.
+func (r *GetAttachInfoResp) SplitRankURIs(maxURIs int) ([]*GetAttachInfoResp, error) {
+	if r == nil {
+		return nil, errors.New("nil response")
+	}
+	if r.RankUrisEncoding != "" {
+		return nil, errors.New("cannot split compressed rank URIs")
+	}
+	if maxURIs <= 0 {
+		return nil, errors.Errorf("invalid number of rank URIs per response: %d", maxURIs)
+	}
+
+	primary, secondary := r.RankUris, r.SecondaryRankUris
+	r.RankUris, r.SecondaryRankUris = nil, nil
+	head := proto.Clone(r).(*GetAttachInfoResp)
+	r.RankUris, r.SecondaryRankUris = primary, secondary
+
+	parts := []*GetAttachInfoResp{head}
+	cur, curURIs := head, 0
+	nextPart := func() {
+		if curURIs < maxURIs {
+			return
+		}
+		cur, curURIs = new(GetAttachInfoResp), 0
+		parts = append(parts, cur)
+	}
+	for _, uri := range primary {
+		nextPart()
+		cur.RankUris = append(cur.RankUris, uri)
+		curURIs++
+	}
+	for _, uri := range secondary {
+		nextPart()
+		cur.SecondaryRankUris = append(cur.SecondaryRankUris, uri)
+		curURIs++
+	}
+
+	return parts, nil
+}
+
+// MergeRankURIs appends the rank URIs of a partial response produced by
+// SplitRankURIs to the response.
+func (r *GetAttachInfoResp) MergeRankURIs(part *GetAttachInfoResp) {
+	if r == nil || part == nil {
+		return
+	}
+
+	r.RankUris = append(r.RankUris, part.RankUris...)
+	r.SecondaryRankUris = append(r.SecondaryRankUris, part.SecondaryRankUris...)
+}
+
+// GetStatus returns the DAOS status of the final response of a pool create
+// stream, or 0 for a progress update.
+func (r *PoolCreateStreamResp) GetStatus() int32 {
+	return r.GetResp().GetStatus()
+}
@@ -0,0 +1,227 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package mgmt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestMgmt_GetAttachInfoResp_CompressRankURIs(t *testing.T) {
+	testResp := func(numRanks int) *GetAttachInfoResp {
+		resp := &GetAttachInfoResp{
+			MsRanks: []uint32{0, 1, 2},
+			Sys:     "daos_server",
+		}
+		for i := 0; i < numRanks; i++ {
+			resp.RankUris = append(resp.RankUris, &GetAttachInfoResp_RankUri{
+				Rank:    uint32(i),
+				Uri:     fmt.Sprintf("ofi+tcp://10.0.%d.%d:31416", i/256, i%256),
+				NumCtxs: 16,
+			})
+			resp.SecondaryRankUris = append(resp.SecondaryRankUris, &GetAttachInfoResp_RankUri{
+				Rank:        uint32(i),
+				Uri:         fmt.Sprintf("ofi+verbs://10.1.%d.%d:31416", i/256, i%256),
+				ProviderIdx: 1,
+				NumCtxs:     16,
+			})
+		}
+		return resp
+	}
+
+	for name, tc := range map[string]struct {
+		resp   *GetAttachInfoResp
+		expErr error
+	}{
+		"nil": {
+			expErr: errors.New("nil response"),
+		},
+		"already compressed": {
+			resp: &GetAttachInfoResp{
+				RankUrisEncoding: RankURIEncodingGzip,
+			},
+			expErr: errors.New("already compressed"),
+		},
+		"empty": {
+			resp: testResp(0),
+		},
+		"many ranks": {
+			resp: testResp(4096),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var expResp *GetAttachInfoResp
+			if tc.resp != nil {
+				expResp = testResp(len(tc.resp.RankUris))
+			}
+
+			gotErr := tc.resp.CompressRankURIs()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, RankURIEncodingGzip, tc.resp.RankUrisEncoding, "")
+			if len(tc.resp.RankUris) != 0 || len(tc.resp.SecondaryRankUris) != 0 {
+				t.Fatal("rank URIs not removed from compressed response")
+			}
+			if len(expResp.RankUris) > 0 {
+				uncompressed := 0
+				for _, ru := range expResp.RankUris {
+					uncompressed += len(ru.Uri)
+				}
+				if len(tc.resp.PackedRankUris) >= uncompressed {
+					t.Fatalf("compressed size %d not smaller than URIs (%d)",
+						len(tc.resp.PackedRankUris), uncompressed)
+				}
+			}
+
+			if err := tc.resp.DecompressRankURIs(); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(expResp, tc.resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestMgmt_GetAttachInfoResp_DecompressRankURIs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp    *GetAttachInfoResp
+		expResp *GetAttachInfoResp
+		expErr  error
+	}{
+		"nil": {
+			expErr: errors.New("nil response"),
+		},
+		"not compressed": {
+			resp: &GetAttachInfoResp{
+				RankUris: []*GetAttachInfoResp_RankUri{{Rank: 1, Uri: "foo"}},
+			},
+			expResp: &GetAttachInfoResp{
+				RankUris: []*GetAttachInfoResp_RankUri{{Rank: 1, Uri: "foo"}},
+			},
+		},
+		"unsupported encoding": {
+			resp: &GetAttachInfoResp{
+				RankUrisEncoding: "snappy",
+				PackedRankUris:   []byte("foo"),
+			},
+			expErr: errors.New("unsupported rank URI encoding"),
+		},
+		"corrupt data": {
+			resp: &GetAttachInfoResp{
+				RankUrisEncoding: RankURIEncodingGzip,
+				PackedRankUris:   []byte("foo"),
+			},
+			expErr: errors.New("decompressing rank URIs"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.resp.DecompressRankURIs()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, tc.resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestMgmt_GetAttachInfoResp_SplitRankURIs(t *testing.T) {
+	testResp := func(numRanks int) *GetAttachInfoResp {
+		resp := &GetAttachInfoResp{
+			MsRanks:     []uint32{0},
+			Sys:         "daos_server",
+			DataVersion: 42,
+		}
+		for i := 0; i < numRanks; i++ {
+			resp.RankUris = append(resp.RankUris, &GetAttachInfoResp_RankUri{
+				Rank: uint32(i),
+				Uri:  fmt.Sprintf("ofi+tcp://10.0.0.%d:31416", i),
+			})
+			resp.SecondaryRankUris = append(resp.SecondaryRankUris, &GetAttachInfoResp_RankUri{
+				Rank:        uint32(i),
+				Uri:         fmt.Sprintf("ofi+verbs://10.1.0.%d:31416", i),
+				ProviderIdx: 1,
+			})
+		}
+		return resp
+	}
+
+	for name, tc := range map[string]struct {
+		resp     *GetAttachInfoResp
+		maxURIs  int
+		expParts int
+		expErr   error
+	}{
+		"nil": {
+			maxURIs: 1,
+			expErr:  errors.New("nil response"),
+		},
+		"compressed": {
+			resp:    &GetAttachInfoResp{RankUrisEncoding: RankURIEncodingGzip},
+			maxURIs: 1,
+			expErr:  errors.New("compressed"),
+		},
+		"bad max URIs": {
+			resp:   testResp(1),
+			expErr: errors.New("invalid number"),
+		},
+		"no URIs": {
+			resp:     testResp(0),
+			maxURIs:  2,
+			expParts: 1,
+		},
+		"fits in one": {
+			resp:     testResp(2),
+			maxURIs:  4,
+			expParts: 1,
+		},
+		"split across providers": {
+			resp:     testResp(5),
+			maxURIs:  3,
+			expParts: 4,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			parts, gotErr := tc.resp.SplitRankURIs(tc.maxURIs)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expParts, len(parts), "unexpected number of parts")
+			for i, part := range parts {
+				if numURIs := len(part.RankUris) + len(part.SecondaryRankUris); numURIs > tc.maxURIs {
+					t.Fatalf("part %d has %d URIs (max %d)", i, numURIs, tc.maxURIs)
+				}
+				if i > 0 && part.Sys != "" {
+					t.Fatalf("part %d carries response fields", i)
+				}
+			}
+
+			merged := parts[0]
+			for _, part := range parts[1:] {
+				merged.MergeRankURIs(part)
+			}
+			if diff := cmp.Diff(tc.resp, merged, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected merged response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
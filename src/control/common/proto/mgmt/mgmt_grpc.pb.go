@@ -30,8 +30,10 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	MgmtSvc_Join_FullMethodName                     = "/mgmt.MgmtSvc/Join"
 	MgmtSvc_ClusterEvent_FullMethodName             = "/mgmt.MgmtSvc/ClusterEvent"
+	MgmtSvc_AgentEvent_FullMethodName               = "/mgmt.MgmtSvc/AgentEvent"
 	MgmtSvc_LeaderQuery_FullMethodName              = "/mgmt.MgmtSvc/LeaderQuery"
 	MgmtSvc_PoolCreate_FullMethodName               = "/mgmt.MgmtSvc/PoolCreate"
+	MgmtSvc_PoolCreateStream_FullMethodName         = "/mgmt.MgmtSvc/PoolCreateStream"
 	MgmtSvc_PoolDestroy_FullMethodName              = "/mgmt.MgmtSvc/PoolDestroy"
 	MgmtSvc_PoolEvict_FullMethodName                = "/mgmt.MgmtSvc/PoolEvict"
 	MgmtSvc_PoolExclude_FullMethodName              = "/mgmt.MgmtSvc/PoolExclude"
@@ -47,13 +49,19 @@ const (
 	MgmtSvc_PoolUpdateACL_FullMethodName            = "/mgmt.MgmtSvc/PoolUpdateACL"
 	MgmtSvc_PoolDeleteACL_FullMethodName            = "/mgmt.MgmtSvc/PoolDeleteACL"
 	MgmtSvc_GetAttachInfo_FullMethodName            = "/mgmt.MgmtSvc/GetAttachInfo"
+	MgmtSvc_GetAttachInfoStream_FullMethodName      = "/mgmt.MgmtSvc/GetAttachInfoStream"
 	MgmtSvc_ListPools_FullMethodName                = "/mgmt.MgmtSvc/ListPools"
 	MgmtSvc_ListContainers_FullMethodName           = "/mgmt.MgmtSvc/ListContainers"
 	MgmtSvc_ContSetOwner_FullMethodName             = "/mgmt.MgmtSvc/ContSetOwner"
+	MgmtSvc_ContCreate_FullMethodName               = "/mgmt.MgmtSvc/ContCreate"
+	MgmtSvc_ContDestroy_FullMethodName              = "/mgmt.MgmtSvc/ContDestroy"
+	MgmtSvc_ContQuery_FullMethodName                = "/mgmt.MgmtSvc/ContQuery"
 	MgmtSvc_SystemQuery_FullMethodName              = "/mgmt.MgmtSvc/SystemQuery"
 	MgmtSvc_SystemStop_FullMethodName               = "/mgmt.MgmtSvc/SystemStop"
+	MgmtSvc_SystemStopStream_FullMethodName         = "/mgmt.MgmtSvc/SystemStopStream"
 	MgmtSvc_SystemStart_FullMethodName              = "/mgmt.MgmtSvc/SystemStart"
 	MgmtSvc_SystemExclude_FullMethodName            = "/mgmt.MgmtSvc/SystemExclude"
+	MgmtSvc_SystemListScheduled_FullMethodName      = "/mgmt.MgmtSvc/SystemListScheduled"
 	MgmtSvc_SystemDrain_FullMethodName              = "/mgmt.MgmtSvc/SystemDrain"
 	MgmtSvc_SystemErase_FullMethodName              = "/mgmt.MgmtSvc/SystemErase"
 	MgmtSvc_SystemCleanup_FullMethodName            = "/mgmt.MgmtSvc/SystemCleanup"
@@ -66,13 +74,29 @@ const (
 	MgmtSvc_SystemCheckGetPolicy_FullMethodName     = "/mgmt.MgmtSvc/SystemCheckGetPolicy"
 	MgmtSvc_SystemCheckRepair_FullMethodName        = "/mgmt.MgmtSvc/SystemCheckRepair"
 	MgmtSvc_PoolUpgrade_FullMethodName              = "/mgmt.MgmtSvc/PoolUpgrade"
+	MgmtSvc_PoolRebalance_FullMethodName            = "/mgmt.MgmtSvc/PoolRebalance"
+	MgmtSvc_PoolRenameLabel_FullMethodName          = "/mgmt.MgmtSvc/PoolRenameLabel"
+	MgmtSvc_PoolUpdateAliases_FullMethodName        = "/mgmt.MgmtSvc/PoolUpdateAliases"
 	MgmtSvc_SystemSetAttr_FullMethodName            = "/mgmt.MgmtSvc/SystemSetAttr"
 	MgmtSvc_SystemGetAttr_FullMethodName            = "/mgmt.MgmtSvc/SystemGetAttr"
 	MgmtSvc_SystemSetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemSetProp"
 	MgmtSvc_SystemGetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemGetProp"
+	MgmtSvc_SystemSetFaultDomains_FullMethodName    = "/mgmt.MgmtSvc/SystemSetFaultDomains"
+	MgmtSvc_SystemEvents_FullMethodName             = "/mgmt.MgmtSvc/SystemEvents"
+	MgmtSvc_SystemReplaceHost_FullMethodName        = "/mgmt.MgmtSvc/SystemReplaceHost"
+	MgmtSvc_SystemUsage_FullMethodName              = "/mgmt.MgmtSvc/SystemUsage"
+	MgmtSvc_PoolMembershipChanges_FullMethodName    = "/mgmt.MgmtSvc/PoolMembershipChanges"
+	MgmtSvc_SystemOpLocks_FullMethodName            = "/mgmt.MgmtSvc/SystemOpLocks"
+	MgmtSvc_AgentHeartbeat_FullMethodName           = "/mgmt.MgmtSvc/AgentHeartbeat"
+	MgmtSvc_SystemListClients_FullMethodName        = "/mgmt.MgmtSvc/SystemListClients"
+	MgmtSvc_SystemChanges_FullMethodName            = "/mgmt.MgmtSvc/SystemChanges"
+	MgmtSvc_PoolSetPolicy_FullMethodName            = "/mgmt.MgmtSvc/PoolSetPolicy"
+	MgmtSvc_PoolRemovePolicy_FullMethodName         = "/mgmt.MgmtSvc/PoolRemovePolicy"
+	MgmtSvc_PoolListPolicies_FullMethodName         = "/mgmt.MgmtSvc/PoolListPolicies"
 	MgmtSvc_FaultInjectReport_FullMethodName        = "/mgmt.MgmtSvc/FaultInjectReport"
 	MgmtSvc_FaultInjectPoolFault_FullMethodName     = "/mgmt.MgmtSvc/FaultInjectPoolFault"
 	MgmtSvc_FaultInjectMgmtPoolFault_FullMethodName = "/mgmt.MgmtSvc/FaultInjectMgmtPoolFault"
+	MgmtSvc_FaultInjectEngine_FullMethodName        = "/mgmt.MgmtSvc/FaultInjectEngine"
 )
 
 // MgmtSvcClient is the client API for MgmtSvc service.
@@ -90,11 +114,15 @@ type MgmtSvcClient interface {
 	Join(ctx context.Context, in *JoinReq, opts ...grpc.CallOption) (*JoinResp, error)
 	// ClusterEvent notify MS of a RAS event in the cluster.
 	ClusterEvent(ctx context.Context, in *shared.ClusterEventReq, opts ...grpc.CallOption) (*shared.ClusterEventResp, error)
+	// AgentEvent notify MS of a RAS event raised by a client agent.
+	AgentEvent(ctx context.Context, in *shared.ClusterEventReq, opts ...grpc.CallOption) (*shared.ClusterEventResp, error)
 	// LeaderQuery provides a mechanism for clients to discover
 	// the system's current Management Service leader
 	LeaderQuery(ctx context.Context, in *LeaderQueryReq, opts ...grpc.CallOption) (*LeaderQueryResp, error)
 	// Create a DAOS pool allocated across a number of ranks
 	PoolCreate(ctx context.Context, in *PoolCreateReq, opts ...grpc.CallOption) (*PoolCreateResp, error)
+	// Create a DAOS pool, streaming progress updates.
+	PoolCreateStream(ctx context.Context, in *PoolCreateReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PoolCreateStreamResp], error)
 	// Destroy a DAOS pool allocated across a number of ranks.
 	PoolDestroy(ctx context.Context, in *PoolDestroyReq, opts ...grpc.CallOption) (*PoolDestroyResp, error)
 	// Evict a DAOS pool's connections.
@@ -125,20 +153,32 @@ type MgmtSvcClient interface {
 	PoolDeleteACL(ctx context.Context, in *DeleteACLReq, opts ...grpc.CallOption) (*ACLResp, error)
 	// Get the information required by libdaos to attach to the system.
 	GetAttachInfo(ctx context.Context, in *GetAttachInfoReq, opts ...grpc.CallOption) (*GetAttachInfoResp, error)
+	// Get the GetAttachInfo details as a stream of partial responses.
+	GetAttachInfoStream(ctx context.Context, in *GetAttachInfoReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetAttachInfoResp], error)
 	// List all pools in a DAOS system: basic info: UUIDs, service ranks.
 	ListPools(ctx context.Context, in *ListPoolsReq, opts ...grpc.CallOption) (*ListPoolsResp, error)
 	// List all containers in a pool
 	ListContainers(ctx context.Context, in *ListContReq, opts ...grpc.CallOption) (*ListContResp, error)
 	// Change the owner of a DAOS container
 	ContSetOwner(ctx context.Context, in *ContSetOwnerReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Create a DAOS container
+	ContCreate(ctx context.Context, in *ContCreateReq, opts ...grpc.CallOption) (*ContCreateResp, error)
+	// Destroy a DAOS container
+	ContDestroy(ctx context.Context, in *ContDestroyReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Query a DAOS container
+	ContQuery(ctx context.Context, in *ContQueryReq, opts ...grpc.CallOption) (*ContQueryResp, error)
 	// Query DAOS system status
 	SystemQuery(ctx context.Context, in *SystemQueryReq, opts ...grpc.CallOption) (*SystemQueryResp, error)
 	// Stop DAOS system (shutdown data-plane instances)
 	SystemStop(ctx context.Context, in *SystemStopReq, opts ...grpc.CallOption) (*SystemStopResp, error)
+	// Stop DAOS system, streaming progress updates.
+	SystemStopStream(ctx context.Context, in *SystemStopReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SystemStopStreamResp], error)
 	// Start DAOS system (restart data-plane instances)
 	SystemStart(ctx context.Context, in *SystemStartReq, opts ...grpc.CallOption) (*SystemStartResp, error)
 	// Exclude DAOS ranks
 	SystemExclude(ctx context.Context, in *SystemExcludeReq, opts ...grpc.CallOption) (*SystemExcludeResp, error)
+	// List pending scheduled rank exclusions
+	SystemListScheduled(ctx context.Context, in *SystemListScheduledReq, opts ...grpc.CallOption) (*SystemListScheduledResp, error)
 	// Drain or reintegrate DAOS ranks from all pools
 	SystemDrain(ctx context.Context, in *SystemDrainReq, opts ...grpc.CallOption) (*SystemDrainResp, error)
 	// Erase DAOS system database prior to reformat
@@ -163,6 +203,12 @@ type MgmtSvcClient interface {
 	SystemCheckRepair(ctx context.Context, in *CheckActReq, opts ...grpc.CallOption) (*CheckActResp, error)
 	// PoolUpgrade queries a DAOS pool.
 	PoolUpgrade(ctx context.Context, in *PoolUpgradeReq, opts ...grpc.CallOption) (*PoolUpgradeResp, error)
+	// Redistribute existing data across all targets of a DAOS pool.
+	PoolRebalance(ctx context.Context, in *PoolRebalanceReq, opts ...grpc.CallOption) (*PoolRebalanceResp, error)
+	// Change the label of a DAOS pool.
+	PoolRenameLabel(ctx context.Context, in *PoolRenameLabelReq, opts ...grpc.CallOption) (*PoolRenameLabelResp, error)
+	// Add or remove alias labels of a DAOS pool.
+	PoolUpdateAliases(ctx context.Context, in *PoolUpdateAliasesReq, opts ...grpc.CallOption) (*PoolUpdateAliasesResp, error)
 	// Set a system attribute or attributes.
 	SystemSetAttr(ctx context.Context, in *SystemSetAttrReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Get a system attribute or attributes.
@@ -171,12 +217,38 @@ type MgmtSvcClient interface {
 	SystemSetProp(ctx context.Context, in *SystemSetPropReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(ctx context.Context, in *SystemGetPropReq, opts ...grpc.CallOption) (*SystemGetPropResp, error)
+	// Set the fault domains of system members by host.
+	SystemSetFaultDomains(ctx context.Context, in *SystemSetFaultDomainsReq, opts ...grpc.CallOption) (*SystemSetFaultDomainsResp, error)
+	// List RAS events recorded in the system database.
+	SystemEvents(ctx context.Context, in *SystemEventsReq, opts ...grpc.CallOption) (*SystemEventsResp, error)
+	// Reassign the ranks of a failed host to a replacement host.
+	SystemReplaceHost(ctx context.Context, in *SystemReplaceHostReq, opts ...grpc.CallOption) (*SystemReplaceHostResp, error)
+	// Get per-rank pool storage allocations and reservations.
+	SystemUsage(ctx context.Context, in *SystemUsageReq, opts ...grpc.CallOption) (*SystemUsageResp, error)
+	// List pool target membership changes recorded since a given point.
+	PoolMembershipChanges(ctx context.Context, in *PoolMembershipChangesReq, opts ...grpc.CallOption) (*PoolMembershipChangesResp, error)
+	// List the operation locks held by the MS leader.
+	SystemOpLocks(ctx context.Context, in *SystemOpLocksReq, opts ...grpc.CallOption) (*SystemOpLocksResp, error)
+	// Record a heartbeat from the agent on a client machine.
+	AgentHeartbeat(ctx context.Context, in *AgentHeartbeatReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// List the client machines known to the MS.
+	SystemListClients(ctx context.Context, in *SystemListClientsReq, opts ...grpc.CallOption) (*SystemListClientsResp, error)
+	// List changes to the system information cached by agents recorded since a given point.
+	SystemChanges(ctx context.Context, in *SystemChangesReq, opts ...grpc.CallOption) (*SystemChangesResp, error)
+	// Add or replace the pool creation policy for a user or group.
+	PoolSetPolicy(ctx context.Context, in *PoolSetPolicyReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Remove the pool creation policy for a user or group.
+	PoolRemovePolicy(ctx context.Context, in *PoolRemovePolicyReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// List the pool creation policies.
+	PoolListPolicies(ctx context.Context, in *PoolListPoliciesReq, opts ...grpc.CallOption) (*PoolListPoliciesResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error)
 	// FaultInjectPoolFault creates a pool fault for testing the checker.
 	FaultInjectPoolFault(ctx context.Context, in *chk.Fault, opts ...grpc.CallOption) (*DaosResp, error)
 	FaultInjectMgmtPoolFault(ctx context.Context, in *chk.Fault, opts ...grpc.CallOption) (*DaosResp, error)
+	// FaultInjectEngine sets a fault injection point on DAOS I/O Engines.
+	FaultInjectEngine(ctx context.Context, in *FaultInjectEngineReq, opts ...grpc.CallOption) (*DaosResp, error)
 }
 
 type mgmtSvcClient struct {
@@ -207,6 +279,16 @@ func (c *mgmtSvcClient) ClusterEvent(ctx context.Context, in *shared.ClusterEven
 	return out, nil
 }
 
+func (c *mgmtSvcClient) AgentEvent(ctx context.Context, in *shared.ClusterEventReq, opts ...grpc.CallOption) (*shared.ClusterEventResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(shared.ClusterEventResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_AgentEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) LeaderQuery(ctx context.Context, in *LeaderQueryReq, opts ...grpc.CallOption) (*LeaderQueryResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LeaderQueryResp)
@@ -227,6 +309,25 @@ func (c *mgmtSvcClient) PoolCreate(ctx context.Context, in *PoolCreateReq, opts
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolCreateStream(ctx context.Context, in *PoolCreateReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PoolCreateStreamResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[0], MgmtSvc_PoolCreateStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PoolCreateReq, PoolCreateStreamResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MgmtSvc_PoolCreateStreamClient = grpc.ServerStreamingClient[PoolCreateStreamResp]
+
 func (c *mgmtSvcClient) PoolDestroy(ctx context.Context, in *PoolDestroyReq, opts ...grpc.CallOption) (*PoolDestroyResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PoolDestroyResp)
@@ -377,6 +478,25 @@ func (c *mgmtSvcClient) GetAttachInfo(ctx context.Context, in *GetAttachInfoReq,
 	return out, nil
 }
 
+func (c *mgmtSvcClient) GetAttachInfoStream(ctx context.Context, in *GetAttachInfoReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetAttachInfoResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[1], MgmtSvc_GetAttachInfoStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetAttachInfoReq, GetAttachInfoResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MgmtSvc_GetAttachInfoStreamClient = grpc.ServerStreamingClient[GetAttachInfoResp]
+
 func (c *mgmtSvcClient) ListPools(ctx context.Context, in *ListPoolsReq, opts ...grpc.CallOption) (*ListPoolsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPoolsResp)
@@ -407,6 +527,36 @@ func (c *mgmtSvcClient) ContSetOwner(ctx context.Context, in *ContSetOwnerReq, o
 	return out, nil
 }
 
+func (c *mgmtSvcClient) ContCreate(ctx context.Context, in *ContCreateReq, opts ...grpc.CallOption) (*ContCreateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContCreateResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_ContCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) ContDestroy(ctx context.Context, in *ContDestroyReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_ContDestroy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) ContQuery(ctx context.Context, in *ContQueryReq, opts ...grpc.CallOption) (*ContQueryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContQueryResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_ContQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) SystemQuery(ctx context.Context, in *SystemQueryReq, opts ...grpc.CallOption) (*SystemQueryResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SystemQueryResp)
@@ -427,6 +577,25 @@ func (c *mgmtSvcClient) SystemStop(ctx context.Context, in *SystemStopReq, opts
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemStopStream(ctx context.Context, in *SystemStopReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SystemStopStreamResp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[2], MgmtSvc_SystemStopStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SystemStopReq, SystemStopStreamResp]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MgmtSvc_SystemStopStreamClient = grpc.ServerStreamingClient[SystemStopStreamResp]
+
 func (c *mgmtSvcClient) SystemStart(ctx context.Context, in *SystemStartReq, opts ...grpc.CallOption) (*SystemStartResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SystemStartResp)
@@ -447,6 +616,16 @@ func (c *mgmtSvcClient) SystemExclude(ctx context.Context, in *SystemExcludeReq,
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemListScheduled(ctx context.Context, in *SystemListScheduledReq, opts ...grpc.CallOption) (*SystemListScheduledResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemListScheduledResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemListScheduled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) SystemDrain(ctx context.Context, in *SystemDrainReq, opts ...grpc.CallOption) (*SystemDrainResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SystemDrainResp)
@@ -567,6 +746,36 @@ func (c *mgmtSvcClient) PoolUpgrade(ctx context.Context, in *PoolUpgradeReq, opt
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolRebalance(ctx context.Context, in *PoolRebalanceReq, opts ...grpc.CallOption) (*PoolRebalanceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoolRebalanceResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolRebalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolRenameLabel(ctx context.Context, in *PoolRenameLabelReq, opts ...grpc.CallOption) (*PoolRenameLabelResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoolRenameLabelResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolRenameLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolUpdateAliases(ctx context.Context, in *PoolUpdateAliasesReq, opts ...grpc.CallOption) (*PoolUpdateAliasesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoolUpdateAliasesResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolUpdateAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) SystemSetAttr(ctx context.Context, in *SystemSetAttrReq, opts ...grpc.CallOption) (*DaosResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DaosResp)
@@ -607,6 +816,126 @@ func (c *mgmtSvcClient) SystemGetProp(ctx context.Context, in *SystemGetPropReq,
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemSetFaultDomains(ctx context.Context, in *SystemSetFaultDomainsReq, opts ...grpc.CallOption) (*SystemSetFaultDomainsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemSetFaultDomainsResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemSetFaultDomains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemEvents(ctx context.Context, in *SystemEventsReq, opts ...grpc.CallOption) (*SystemEventsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemEventsResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemReplaceHost(ctx context.Context, in *SystemReplaceHostReq, opts ...grpc.CallOption) (*SystemReplaceHostResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemReplaceHostResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemReplaceHost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemUsage(ctx context.Context, in *SystemUsageReq, opts ...grpc.CallOption) (*SystemUsageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemUsageResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolMembershipChanges(ctx context.Context, in *PoolMembershipChangesReq, opts ...grpc.CallOption) (*PoolMembershipChangesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoolMembershipChangesResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolMembershipChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemOpLocks(ctx context.Context, in *SystemOpLocksReq, opts ...grpc.CallOption) (*SystemOpLocksResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemOpLocksResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemOpLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) AgentHeartbeat(ctx context.Context, in *AgentHeartbeatReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_AgentHeartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemListClients(ctx context.Context, in *SystemListClientsReq, opts ...grpc.CallOption) (*SystemListClientsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemListClientsResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemListClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemChanges(ctx context.Context, in *SystemChangesReq, opts ...grpc.CallOption) (*SystemChangesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemChangesResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolSetPolicy(ctx context.Context, in *PoolSetPolicyReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolSetPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolRemovePolicy(ctx context.Context, in *PoolRemovePolicyReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolRemovePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolListPolicies(ctx context.Context, in *PoolListPoliciesReq, opts ...grpc.CallOption) (*PoolListPoliciesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoolListPoliciesResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolListPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DaosResp)
@@ -637,6 +966,16 @@ func (c *mgmtSvcClient) FaultInjectMgmtPoolFault(ctx context.Context, in *chk.Fa
 	return out, nil
 }
 
+func (c *mgmtSvcClient) FaultInjectEngine(ctx context.Context, in *FaultInjectEngineReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_FaultInjectEngine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MgmtSvcServer is the server API for MgmtSvc service.
 // All implementations must embed UnimplementedMgmtSvcServer
 // for forward compatibility.
@@ -652,11 +991,15 @@ type MgmtSvcServer interface {
 	Join(context.Context, *JoinReq) (*JoinResp, error)
 	// ClusterEvent notify MS of a RAS event in the cluster.
 	ClusterEvent(context.Context, *shared.ClusterEventReq) (*shared.ClusterEventResp, error)
+	// AgentEvent notify MS of a RAS event raised by a client agent.
+	AgentEvent(context.Context, *shared.ClusterEventReq) (*shared.ClusterEventResp, error)
 	// LeaderQuery provides a mechanism for clients to discover
 	// the system's current Management Service leader
 	LeaderQuery(context.Context, *LeaderQueryReq) (*LeaderQueryResp, error)
 	// Create a DAOS pool allocated across a number of ranks
 	PoolCreate(context.Context, *PoolCreateReq) (*PoolCreateResp, error)
+	// Create a DAOS pool, streaming progress updates.
+	PoolCreateStream(*PoolCreateReq, grpc.ServerStreamingServer[PoolCreateStreamResp]) error
 	// Destroy a DAOS pool allocated across a number of ranks.
 	PoolDestroy(context.Context, *PoolDestroyReq) (*PoolDestroyResp, error)
 	// Evict a DAOS pool's connections.
@@ -687,20 +1030,32 @@ type MgmtSvcServer interface {
 	PoolDeleteACL(context.Context, *DeleteACLReq) (*ACLResp, error)
 	// Get the information required by libdaos to attach to the system.
 	GetAttachInfo(context.Context, *GetAttachInfoReq) (*GetAttachInfoResp, error)
+	// Get the GetAttachInfo details as a stream of partial responses.
+	GetAttachInfoStream(*GetAttachInfoReq, grpc.ServerStreamingServer[GetAttachInfoResp]) error
 	// List all pools in a DAOS system: basic info: UUIDs, service ranks.
 	ListPools(context.Context, *ListPoolsReq) (*ListPoolsResp, error)
 	// List all containers in a pool
 	ListContainers(context.Context, *ListContReq) (*ListContResp, error)
 	// Change the owner of a DAOS container
 	ContSetOwner(context.Context, *ContSetOwnerReq) (*DaosResp, error)
+	// Create a DAOS container
+	ContCreate(context.Context, *ContCreateReq) (*ContCreateResp, error)
+	// Destroy a DAOS container
+	ContDestroy(context.Context, *ContDestroyReq) (*DaosResp, error)
+	// Query a DAOS container
+	ContQuery(context.Context, *ContQueryReq) (*ContQueryResp, error)
 	// Query DAOS system status
 	SystemQuery(context.Context, *SystemQueryReq) (*SystemQueryResp, error)
 	// Stop DAOS system (shutdown data-plane instances)
 	SystemStop(context.Context, *SystemStopReq) (*SystemStopResp, error)
+	// Stop DAOS system, streaming progress updates.
+	SystemStopStream(*SystemStopReq, grpc.ServerStreamingServer[SystemStopStreamResp]) error
 	// Start DAOS system (restart data-plane instances)
 	SystemStart(context.Context, *SystemStartReq) (*SystemStartResp, error)
 	// Exclude DAOS ranks
 	SystemExclude(context.Context, *SystemExcludeReq) (*SystemExcludeResp, error)
+	// List pending scheduled rank exclusions
+	SystemListScheduled(context.Context, *SystemListScheduledReq) (*SystemListScheduledResp, error)
 	// Drain or reintegrate DAOS ranks from all pools
 	SystemDrain(context.Context, *SystemDrainReq) (*SystemDrainResp, error)
 	// Erase DAOS system database prior to reformat
@@ -725,6 +1080,12 @@ type MgmtSvcServer interface {
 	SystemCheckRepair(context.Context, *CheckActReq) (*CheckActResp, error)
 	// PoolUpgrade queries a DAOS pool.
 	PoolUpgrade(context.Context, *PoolUpgradeReq) (*PoolUpgradeResp, error)
+	// Redistribute existing data across all targets of a DAOS pool.
+	PoolRebalance(context.Context, *PoolRebalanceReq) (*PoolRebalanceResp, error)
+	// Change the label of a DAOS pool.
+	PoolRenameLabel(context.Context, *PoolRenameLabelReq) (*PoolRenameLabelResp, error)
+	// Add or remove alias labels of a DAOS pool.
+	PoolUpdateAliases(context.Context, *PoolUpdateAliasesReq) (*PoolUpdateAliasesResp, error)
 	// Set a system attribute or attributes.
 	SystemSetAttr(context.Context, *SystemSetAttrReq) (*DaosResp, error)
 	// Get a system attribute or attributes.
@@ -733,12 +1094,38 @@ type MgmtSvcServer interface {
 	SystemSetProp(context.Context, *SystemSetPropReq) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error)
+	// Set the fault domains of system members by host.
+	SystemSetFaultDomains(context.Context, *SystemSetFaultDomainsReq) (*SystemSetFaultDomainsResp, error)
+	// List RAS events recorded in the system database.
+	SystemEvents(context.Context, *SystemEventsReq) (*SystemEventsResp, error)
+	// Reassign the ranks of a failed host to a replacement host.
+	SystemReplaceHost(context.Context, *SystemReplaceHostReq) (*SystemReplaceHostResp, error)
+	// Get per-rank pool storage allocations and reservations.
+	SystemUsage(context.Context, *SystemUsageReq) (*SystemUsageResp, error)
+	// List pool target membership changes recorded since a given point.
+	PoolMembershipChanges(context.Context, *PoolMembershipChangesReq) (*PoolMembershipChangesResp, error)
+	// List the operation locks held by the MS leader.
+	SystemOpLocks(context.Context, *SystemOpLocksReq) (*SystemOpLocksResp, error)
+	// Record a heartbeat from the agent on a client machine.
+	AgentHeartbeat(context.Context, *AgentHeartbeatReq) (*DaosResp, error)
+	// List the client machines known to the MS.
+	SystemListClients(context.Context, *SystemListClientsReq) (*SystemListClientsResp, error)
+	// List changes to the system information cached by agents recorded since a given point.
+	SystemChanges(context.Context, *SystemChangesReq) (*SystemChangesResp, error)
+	// Add or replace the pool creation policy for a user or group.
+	PoolSetPolicy(context.Context, *PoolSetPolicyReq) (*DaosResp, error)
+	// Remove the pool creation policy for a user or group.
+	PoolRemovePolicy(context.Context, *PoolRemovePolicyReq) (*DaosResp, error)
+	// List the pool creation policies.
+	PoolListPolicies(context.Context, *PoolListPoliciesReq) (*PoolListPoliciesResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error)
 	// FaultInjectPoolFault creates a pool fault for testing the checker.
 	FaultInjectPoolFault(context.Context, *chk.Fault) (*DaosResp, error)
 	FaultInjectMgmtPoolFault(context.Context, *chk.Fault) (*DaosResp, error)
+	// FaultInjectEngine sets a fault injection point on DAOS I/O Engines.
+	FaultInjectEngine(context.Context, *FaultInjectEngineReq) (*DaosResp, error)
 	mustEmbedUnimplementedMgmtSvcServer()
 }
 
@@ -755,12 +1142,18 @@ func (UnimplementedMgmtSvcServer) Join(context.Context, *JoinReq) (*JoinResp, er
 func (UnimplementedMgmtSvcServer) ClusterEvent(context.Context, *shared.ClusterEventReq) (*shared.ClusterEventResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClusterEvent not implemented")
 }
+func (UnimplementedMgmtSvcServer) AgentEvent(context.Context, *shared.ClusterEventReq) (*shared.ClusterEventResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentEvent not implemented")
+}
 func (UnimplementedMgmtSvcServer) LeaderQuery(context.Context, *LeaderQueryReq) (*LeaderQueryResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaderQuery not implemented")
 }
 func (UnimplementedMgmtSvcServer) PoolCreate(context.Context, *PoolCreateReq) (*PoolCreateResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolCreate not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolCreateStream(*PoolCreateReq, grpc.ServerStreamingServer[PoolCreateStreamResp]) error {
+	return status.Errorf(codes.Unimplemented, "method PoolCreateStream not implemented")
+}
 func (UnimplementedMgmtSvcServer) PoolDestroy(context.Context, *PoolDestroyReq) (*PoolDestroyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolDestroy not implemented")
 }
@@ -806,6 +1199,9 @@ func (UnimplementedMgmtSvcServer) PoolDeleteACL(context.Context, *DeleteACLReq)
 func (UnimplementedMgmtSvcServer) GetAttachInfo(context.Context, *GetAttachInfoReq) (*GetAttachInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAttachInfo not implemented")
 }
+func (UnimplementedMgmtSvcServer) GetAttachInfoStream(*GetAttachInfoReq, grpc.ServerStreamingServer[GetAttachInfoResp]) error {
+	return status.Errorf(codes.Unimplemented, "method GetAttachInfoStream not implemented")
+}
 func (UnimplementedMgmtSvcServer) ListPools(context.Context, *ListPoolsReq) (*ListPoolsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPools not implemented")
 }
@@ -815,18 +1211,33 @@ func (UnimplementedMgmtSvcServer) ListContainers(context.Context, *ListContReq)
 func (UnimplementedMgmtSvcServer) ContSetOwner(context.Context, *ContSetOwnerReq) (*DaosResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContSetOwner not implemented")
 }
+func (UnimplementedMgmtSvcServer) ContCreate(context.Context, *ContCreateReq) (*ContCreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContCreate not implemented")
+}
+func (UnimplementedMgmtSvcServer) ContDestroy(context.Context, *ContDestroyReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContDestroy not implemented")
+}
+func (UnimplementedMgmtSvcServer) ContQuery(context.Context, *ContQueryReq) (*ContQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContQuery not implemented")
+}
 func (UnimplementedMgmtSvcServer) SystemQuery(context.Context, *SystemQueryReq) (*SystemQueryResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemQuery not implemented")
 }
 func (UnimplementedMgmtSvcServer) SystemStop(context.Context, *SystemStopReq) (*SystemStopResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemStop not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemStopStream(*SystemStopReq, grpc.ServerStreamingServer[SystemStopStreamResp]) error {
+	return status.Errorf(codes.Unimplemented, "method SystemStopStream not implemented")
+}
 func (UnimplementedMgmtSvcServer) SystemStart(context.Context, *SystemStartReq) (*SystemStartResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemStart not implemented")
 }
 func (UnimplementedMgmtSvcServer) SystemExclude(context.Context, *SystemExcludeReq) (*SystemExcludeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemExclude not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemListScheduled(context.Context, *SystemListScheduledReq) (*SystemListScheduledResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemListScheduled not implemented")
+}
 func (UnimplementedMgmtSvcServer) SystemDrain(context.Context, *SystemDrainReq) (*SystemDrainResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemDrain not implemented")
 }
@@ -863,6 +1274,15 @@ func (UnimplementedMgmtSvcServer) SystemCheckRepair(context.Context, *CheckActRe
 func (UnimplementedMgmtSvcServer) PoolUpgrade(context.Context, *PoolUpgradeReq) (*PoolUpgradeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolUpgrade not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolRebalance(context.Context, *PoolRebalanceReq) (*PoolRebalanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolRebalance not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolRenameLabel(context.Context, *PoolRenameLabelReq) (*PoolRenameLabelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolRenameLabel not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolUpdateAliases(context.Context, *PoolUpdateAliasesReq) (*PoolUpdateAliasesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolUpdateAliases not implemented")
+}
 func (UnimplementedMgmtSvcServer) SystemSetAttr(context.Context, *SystemSetAttrReq) (*DaosResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemSetAttr not implemented")
 }
@@ -875,6 +1295,42 @@ func (UnimplementedMgmtSvcServer) SystemSetProp(context.Context, *SystemSetPropR
 func (UnimplementedMgmtSvcServer) SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemGetProp not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemSetFaultDomains(context.Context, *SystemSetFaultDomainsReq) (*SystemSetFaultDomainsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemSetFaultDomains not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemEvents(context.Context, *SystemEventsReq) (*SystemEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemEvents not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemReplaceHost(context.Context, *SystemReplaceHostReq) (*SystemReplaceHostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemReplaceHost not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemUsage(context.Context, *SystemUsageReq) (*SystemUsageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemUsage not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolMembershipChanges(context.Context, *PoolMembershipChangesReq) (*PoolMembershipChangesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolMembershipChanges not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemOpLocks(context.Context, *SystemOpLocksReq) (*SystemOpLocksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemOpLocks not implemented")
+}
+func (UnimplementedMgmtSvcServer) AgentHeartbeat(context.Context, *AgentHeartbeatReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentHeartbeat not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemListClients(context.Context, *SystemListClientsReq) (*SystemListClientsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemListClients not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemChanges(context.Context, *SystemChangesReq) (*SystemChangesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemChanges not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolSetPolicy(context.Context, *PoolSetPolicyReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolSetPolicy not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolRemovePolicy(context.Context, *PoolRemovePolicyReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolRemovePolicy not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolListPolicies(context.Context, *PoolListPoliciesReq) (*PoolListPoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolListPolicies not implemented")
+}
 func (UnimplementedMgmtSvcServer) FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FaultInjectReport not implemented")
 }
@@ -884,6 +1340,9 @@ func (UnimplementedMgmtSvcServer) FaultInjectPoolFault(context.Context, *chk.Fau
 func (UnimplementedMgmtSvcServer) FaultInjectMgmtPoolFault(context.Context, *chk.Fault) (*DaosResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FaultInjectMgmtPoolFault not implemented")
 }
+func (UnimplementedMgmtSvcServer) FaultInjectEngine(context.Context, *FaultInjectEngineReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FaultInjectEngine not implemented")
+}
 func (UnimplementedMgmtSvcServer) mustEmbedUnimplementedMgmtSvcServer() {}
 func (UnimplementedMgmtSvcServer) testEmbeddedByValue()                 {}
 
@@ -941,6 +1400,24 @@ func _MgmtSvc_ClusterEvent_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_AgentEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(shared.ClusterEventReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).AgentEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_AgentEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).AgentEvent(ctx, req.(*shared.ClusterEventReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_LeaderQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LeaderQueryReq)
 	if err := dec(in); err != nil {
@@ -977,6 +1454,17 @@ func _MgmtSvc_PoolCreate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolCreateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PoolCreateReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).PoolCreateStream(m, &grpc.GenericServerStream[PoolCreateReq, PoolCreateStreamResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MgmtSvc_PoolCreateStreamServer = grpc.ServerStreamingServer[PoolCreateStreamResp]
+
 func _MgmtSvc_PoolDestroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PoolDestroyReq)
 	if err := dec(in); err != nil {
@@ -1247,6 +1735,17 @@ func _MgmtSvc_GetAttachInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_GetAttachInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAttachInfoReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).GetAttachInfoStream(m, &grpc.GenericServerStream[GetAttachInfoReq, GetAttachInfoResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MgmtSvc_GetAttachInfoStreamServer = grpc.ServerStreamingServer[GetAttachInfoResp]
+
 func _MgmtSvc_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPoolsReq)
 	if err := dec(in); err != nil {
@@ -1301,74 +1800,157 @@ func _MgmtSvc_ContSetOwner_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MgmtSvc_SystemQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemQueryReq)
+func _MgmtSvc_ContCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContCreateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MgmtSvcServer).SystemQuery(ctx, in)
+		return srv.(MgmtSvcServer).ContCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MgmtSvc_SystemQuery_FullMethodName,
+		FullMethod: MgmtSvc_ContCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MgmtSvcServer).SystemQuery(ctx, req.(*SystemQueryReq))
+		return srv.(MgmtSvcServer).ContCreate(ctx, req.(*ContCreateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MgmtSvc_SystemStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemStopReq)
+func _MgmtSvc_ContDestroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContDestroyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MgmtSvcServer).SystemStop(ctx, in)
+		return srv.(MgmtSvcServer).ContDestroy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MgmtSvc_SystemStop_FullMethodName,
+		FullMethod: MgmtSvc_ContDestroy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MgmtSvcServer).SystemStop(ctx, req.(*SystemStopReq))
+		return srv.(MgmtSvcServer).ContDestroy(ctx, req.(*ContDestroyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MgmtSvc_SystemStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemStartReq)
+func _MgmtSvc_ContQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContQueryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MgmtSvcServer).SystemStart(ctx, in)
+		return srv.(MgmtSvcServer).ContQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MgmtSvc_SystemStart_FullMethodName,
+		FullMethod: MgmtSvc_ContQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MgmtSvcServer).SystemStart(ctx, req.(*SystemStartReq))
+		return srv.(MgmtSvcServer).ContQuery(ctx, req.(*ContQueryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MgmtSvc_SystemExclude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SystemExcludeReq)
+func _MgmtSvc_SystemQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemQueryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MgmtSvcServer).SystemExclude(ctx, in)
+		return srv.(MgmtSvcServer).SystemQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MgmtSvc_SystemExclude_FullMethodName,
+		FullMethod: MgmtSvc_SystemQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MgmtSvcServer).SystemExclude(ctx, req.(*SystemExcludeReq))
+		return srv.(MgmtSvcServer).SystemQuery(ctx, req.(*SystemQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemStopReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemStop(ctx, req.(*SystemStopReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemStopStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemStopReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemStopStream(m, &grpc.GenericServerStream[SystemStopReq, SystemStopStreamResp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MgmtSvc_SystemStopStreamServer = grpc.ServerStreamingServer[SystemStopStreamResp]
+
+func _MgmtSvc_SystemStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemStartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemStart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemStart(ctx, req.(*SystemStartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemExclude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemExcludeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemExclude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemExclude_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemExclude(ctx, req.(*SystemExcludeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemListScheduled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemListScheduledReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemListScheduled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemListScheduled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemListScheduled(ctx, req.(*SystemListScheduledReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1589,6 +2171,60 @@ func _MgmtSvc_PoolUpgrade_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolRebalanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolRebalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolRebalance(ctx, req.(*PoolRebalanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolRenameLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolRenameLabelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolRenameLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolRenameLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolRenameLabel(ctx, req.(*PoolRenameLabelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolUpdateAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolUpdateAliasesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolUpdateAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolUpdateAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolUpdateAliases(ctx, req.(*PoolUpdateAliasesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_SystemSetAttr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SystemSetAttrReq)
 	if err := dec(in); err != nil {
@@ -1661,6 +2297,222 @@ func _MgmtSvc_SystemGetProp_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_SystemSetFaultDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemSetFaultDomainsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemSetFaultDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemSetFaultDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemSetFaultDomains(ctx, req.(*SystemSetFaultDomainsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemEvents(ctx, req.(*SystemEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemReplaceHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplaceHostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemReplaceHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemReplaceHost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemReplaceHost(ctx, req.(*SystemReplaceHostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemUsage(ctx, req.(*SystemUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolMembershipChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolMembershipChangesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolMembershipChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolMembershipChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolMembershipChanges(ctx, req.(*PoolMembershipChangesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemOpLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemOpLocksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemOpLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemOpLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemOpLocks(ctx, req.(*SystemOpLocksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_AgentHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentHeartbeatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).AgentHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_AgentHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).AgentHeartbeat(ctx, req.(*AgentHeartbeatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemListClientsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemListClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemListClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemListClients(ctx, req.(*SystemListClientsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemChangesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemChanges(ctx, req.(*SystemChangesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolSetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolSetPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolSetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolSetPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolSetPolicy(ctx, req.(*PoolSetPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolRemovePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolRemovePolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolRemovePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolRemovePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolRemovePolicy(ctx, req.(*PoolRemovePolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolListPoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolListPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolListPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolListPolicies(ctx, req.(*PoolListPoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_FaultInjectReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(chk.CheckReport)
 	if err := dec(in); err != nil {
@@ -1715,6 +2567,24 @@ func _MgmtSvc_FaultInjectMgmtPoolFault_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_FaultInjectEngine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FaultInjectEngineReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).FaultInjectEngine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_FaultInjectEngine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).FaultInjectEngine(ctx, req.(*FaultInjectEngineReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MgmtSvc_ServiceDesc is the grpc.ServiceDesc for MgmtSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1730,6 +2600,10 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClusterEvent",
 			Handler:    _MgmtSvc_ClusterEvent_Handler,
 		},
+		{
+			MethodName: "AgentEvent",
+			Handler:    _MgmtSvc_AgentEvent_Handler,
+		},
 		{
 			MethodName: "LeaderQuery",
 			Handler:    _MgmtSvc_LeaderQuery_Handler,
@@ -1810,6 +2684,18 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ContSetOwner",
 			Handler:    _MgmtSvc_ContSetOwner_Handler,
 		},
+		{
+			MethodName: "ContCreate",
+			Handler:    _MgmtSvc_ContCreate_Handler,
+		},
+		{
+			MethodName: "ContDestroy",
+			Handler:    _MgmtSvc_ContDestroy_Handler,
+		},
+		{
+			MethodName: "ContQuery",
+			Handler:    _MgmtSvc_ContQuery_Handler,
+		},
 		{
 			MethodName: "SystemQuery",
 			Handler:    _MgmtSvc_SystemQuery_Handler,
@@ -1826,6 +2712,10 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemExclude",
 			Handler:    _MgmtSvc_SystemExclude_Handler,
 		},
+		{
+			MethodName: "SystemListScheduled",
+			Handler:    _MgmtSvc_SystemListScheduled_Handler,
+		},
 		{
 			MethodName: "SystemDrain",
 			Handler:    _MgmtSvc_SystemDrain_Handler,
@@ -1874,6 +2764,18 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolUpgrade",
 			Handler:    _MgmtSvc_PoolUpgrade_Handler,
 		},
+		{
+			MethodName: "PoolRebalance",
+			Handler:    _MgmtSvc_PoolRebalance_Handler,
+		},
+		{
+			MethodName: "PoolRenameLabel",
+			Handler:    _MgmtSvc_PoolRenameLabel_Handler,
+		},
+		{
+			MethodName: "PoolUpdateAliases",
+			Handler:    _MgmtSvc_PoolUpdateAliases_Handler,
+		},
 		{
 			MethodName: "SystemSetAttr",
 			Handler:    _MgmtSvc_SystemSetAttr_Handler,
@@ -1890,6 +2792,54 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemGetProp",
 			Handler:    _MgmtSvc_SystemGetProp_Handler,
 		},
+		{
+			MethodName: "SystemSetFaultDomains",
+			Handler:    _MgmtSvc_SystemSetFaultDomains_Handler,
+		},
+		{
+			MethodName: "SystemEvents",
+			Handler:    _MgmtSvc_SystemEvents_Handler,
+		},
+		{
+			MethodName: "SystemReplaceHost",
+			Handler:    _MgmtSvc_SystemReplaceHost_Handler,
+		},
+		{
+			MethodName: "SystemUsage",
+			Handler:    _MgmtSvc_SystemUsage_Handler,
+		},
+		{
+			MethodName: "PoolMembershipChanges",
+			Handler:    _MgmtSvc_PoolMembershipChanges_Handler,
+		},
+		{
+			MethodName: "SystemOpLocks",
+			Handler:    _MgmtSvc_SystemOpLocks_Handler,
+		},
+		{
+			MethodName: "AgentHeartbeat",
+			Handler:    _MgmtSvc_AgentHeartbeat_Handler,
+		},
+		{
+			MethodName: "SystemListClients",
+			Handler:    _MgmtSvc_SystemListClients_Handler,
+		},
+		{
+			MethodName: "SystemChanges",
+			Handler:    _MgmtSvc_SystemChanges_Handler,
+		},
+		{
+			MethodName: "PoolSetPolicy",
+			Handler:    _MgmtSvc_PoolSetPolicy_Handler,
+		},
+		{
+			MethodName: "PoolRemovePolicy",
+			Handler:    _MgmtSvc_PoolRemovePolicy_Handler,
+		},
+		{
+			MethodName: "PoolListPolicies",
+			Handler:    _MgmtSvc_PoolListPolicies_Handler,
+		},
 		{
 			MethodName: "FaultInjectReport",
 			Handler:    _MgmtSvc_FaultInjectReport_Handler,
@@ -1902,7 +2852,27 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FaultInjectMgmtPoolFault",
 			Handler:    _MgmtSvc_FaultInjectMgmtPoolFault_Handler,
 		},
+		{
+			MethodName: "FaultInjectEngine",
+			Handler:    _MgmtSvc_FaultInjectEngine_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PoolCreateStream",
+			Handler:       _MgmtSvc_PoolCreateStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAttachInfoStream",
+			Handler:       _MgmtSvc_GetAttachInfoStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SystemStopStream",
+			Handler:       _MgmtSvc_SystemStopStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mgmt/mgmt.proto",
 }
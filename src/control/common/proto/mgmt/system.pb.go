@@ -319,6 +319,172 @@ func (x *SystemStopResp) GetAbsenthosts() string {
 	return ""
 }
 
+// SystemStopStreamResp is sent in the stream of a SystemStopStream request, as
+// either a progress update or the final response.
+type SystemStopStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Update:
+	//
+	//	*SystemStopStreamResp_Progress
+	//	*SystemStopStreamResp_Resp
+	Update isSystemStopStreamResp_Update `protobuf_oneof:"update"`
+}
+
+func (x *SystemStopStreamResp) Reset() {
+	*x = SystemStopStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemStopStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStopStreamResp) ProtoMessage() {}
+
+func (x *SystemStopStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStopStreamResp.ProtoReflect.Descriptor instead.
+func (*SystemStopStreamResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *SystemStopStreamResp) GetUpdate() isSystemStopStreamResp_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (x *SystemStopStreamResp) GetProgress() *shared.Progress {
+	if x, ok := x.GetUpdate().(*SystemStopStreamResp_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *SystemStopStreamResp) GetResp() *SystemStopResp {
+	if x, ok := x.GetUpdate().(*SystemStopStreamResp_Resp); ok {
+		return x.Resp
+	}
+	return nil
+}
+
+type isSystemStopStreamResp_Update interface {
+	isSystemStopStreamResp_Update()
+}
+
+type SystemStopStreamResp_Progress struct {
+	Progress *shared.Progress `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
+}
+
+type SystemStopStreamResp_Resp struct {
+	Resp *SystemStopResp `protobuf:"bytes,2,opt,name=resp,proto3,oneof"`
+}
+
+func (*SystemStopStreamResp_Progress) isSystemStopStreamResp_Update() {}
+
+func (*SystemStopStreamResp_Resp) isSystemStopStreamResp_Update() {}
+
+// PoolCreateStreamResp is sent in the stream of a PoolCreateStream request, as
+// either a progress update or the final response.
+type PoolCreateStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Update:
+	//
+	//	*PoolCreateStreamResp_Progress
+	//	*PoolCreateStreamResp_Resp
+	Update isPoolCreateStreamResp_Update `protobuf_oneof:"update"`
+}
+
+func (x *PoolCreateStreamResp) Reset() {
+	*x = PoolCreateStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolCreateStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolCreateStreamResp) ProtoMessage() {}
+
+func (x *PoolCreateStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolCreateStreamResp.ProtoReflect.Descriptor instead.
+func (*PoolCreateStreamResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *PoolCreateStreamResp) GetUpdate() isPoolCreateStreamResp_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (x *PoolCreateStreamResp) GetProgress() *shared.Progress {
+	if x, ok := x.GetUpdate().(*PoolCreateStreamResp_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *PoolCreateStreamResp) GetResp() *PoolCreateResp {
+	if x, ok := x.GetUpdate().(*PoolCreateStreamResp_Resp); ok {
+		return x.Resp
+	}
+	return nil
+}
+
+type isPoolCreateStreamResp_Update interface {
+	isPoolCreateStreamResp_Update()
+}
+
+type PoolCreateStreamResp_Progress struct {
+	Progress *shared.Progress `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
+}
+
+type PoolCreateStreamResp_Resp struct {
+	Resp *PoolCreateResp `protobuf:"bytes,2,opt,name=resp,proto3,oneof"`
+}
+
+func (*PoolCreateStreamResp_Progress) isPoolCreateStreamResp_Update() {}
+
+func (*PoolCreateStreamResp_Resp) isPoolCreateStreamResp_Update() {}
+
 // SystemStartReq supplies system restart parameters.
 type SystemStartReq struct {
 	state         protoimpl.MessageState
@@ -335,7 +501,7 @@ type SystemStartReq struct {
 func (x *SystemStartReq) Reset() {
 	*x = SystemStartReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[3]
+		mi := &file_mgmt_system_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -348,7 +514,7 @@ func (x *SystemStartReq) String() string {
 func (*SystemStartReq) ProtoMessage() {}
 
 func (x *SystemStartReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[3]
+	mi := &file_mgmt_system_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +527,7 @@ func (x *SystemStartReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemStartReq.ProtoReflect.Descriptor instead.
 func (*SystemStartReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{3}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SystemStartReq) GetSys() string {
@@ -414,7 +580,7 @@ type SystemStartResp struct {
 func (x *SystemStartResp) Reset() {
 	*x = SystemStartResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[4]
+		mi := &file_mgmt_system_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -427,7 +593,7 @@ func (x *SystemStartResp) String() string {
 func (*SystemStartResp) ProtoMessage() {}
 
 func (x *SystemStartResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[4]
+	mi := &file_mgmt_system_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +606,7 @@ func (x *SystemStartResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemStartResp.ProtoReflect.Descriptor instead.
 func (*SystemStartResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{4}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SystemStartResp) GetResults() []*shared.RankResult {
@@ -470,16 +636,18 @@ type SystemExcludeReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys   string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`      // DAOS system name
-	Ranks string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`  // rankset to exclude
-	Hosts string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`  // hostset to exclude
-	Clear bool   `protobuf:"varint,4,opt,name=clear,proto3" json:"clear,omitempty"` // Clear excluded state
+	Sys      string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`            // DAOS system name
+	Ranks    string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`        // rankset to exclude
+	Hosts    string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`        // hostset to exclude
+	Clear    bool   `protobuf:"varint,4,opt,name=clear,proto3" json:"clear,omitempty"`       // Clear excluded state
+	Start    uint64 `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`       // Unix time at which to apply request (0 = now)
+	Duration uint64 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"` // Seconds after start at which to clear excluded state (0 = never)
 }
 
 func (x *SystemExcludeReq) Reset() {
 	*x = SystemExcludeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[5]
+		mi := &file_mgmt_system_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -492,7 +660,7 @@ func (x *SystemExcludeReq) String() string {
 func (*SystemExcludeReq) ProtoMessage() {}
 
 func (x *SystemExcludeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[5]
+	mi := &file_mgmt_system_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +673,7 @@ func (x *SystemExcludeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemExcludeReq.ProtoReflect.Descriptor instead.
 func (*SystemExcludeReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{5}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SystemExcludeReq) GetSys() string {
@@ -536,19 +704,34 @@ func (x *SystemExcludeReq) GetClear() bool {
 	return false
 }
 
+func (x *SystemExcludeReq) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SystemExcludeReq) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
 // SystemExcludeResp returns status of exclude request.
 type SystemExcludeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results []*shared.RankResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Results   []*shared.RankResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Scheduled *ScheduledRankAction `protobuf:"bytes,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"` // Set if any part of the request was deferred
 }
 
 func (x *SystemExcludeResp) Reset() {
 	*x = SystemExcludeResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[6]
+		mi := &file_mgmt_system_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -561,7 +744,7 @@ func (x *SystemExcludeResp) String() string {
 func (*SystemExcludeResp) ProtoMessage() {}
 
 func (x *SystemExcludeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[6]
+	mi := &file_mgmt_system_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +757,7 @@ func (x *SystemExcludeResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemExcludeResp.ProtoReflect.Descriptor instead.
 func (*SystemExcludeResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{6}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SystemExcludeResp) GetResults() []*shared.RankResult {
@@ -584,35 +767,43 @@ func (x *SystemExcludeResp) GetResults() []*shared.RankResult {
 	return nil
 }
 
-// SystemDrainReq supplies system-drain parameters.
-type SystemDrainReq struct {
+func (x *SystemExcludeResp) GetScheduled() *ScheduledRankAction {
+	if x != nil {
+		return x.Scheduled
+	}
+	return nil
+}
+
+// ScheduledRankAction describes a pending MS-managed update of the excluded state of ranks.
+type ScheduledRankAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys   string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`      // DAOS system name
-	Ranks string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`  // rankset to drain on all pools
-	Hosts string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`  // hostset to drain on all pools
-	Reint bool   `protobuf:"varint,4,opt,name=reint,proto3" json:"reint,omitempty"` // Flag to indicate if request is for drain or reint.
+	Ranks  string `protobuf:"bytes,1,opt,name=ranks,proto3" json:"ranks,omitempty"`    // rankset to update
+	Clear  bool   `protobuf:"varint,2,opt,name=clear,proto3" json:"clear,omitempty"`   // Clear rather than set excluded state at start
+	Start  uint64 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`   // Unix time at which to update excluded state
+	End    uint64 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`       // Unix time at which to clear excluded state (0 = never)
+	Active bool   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"` // Excluded state has been set and will be cleared at end
 }
 
-func (x *SystemDrainReq) Reset() {
-	*x = SystemDrainReq{}
+func (x *ScheduledRankAction) Reset() {
+	*x = ScheduledRankAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[7]
+		mi := &file_mgmt_system_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemDrainReq) String() string {
+func (x *ScheduledRankAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemDrainReq) ProtoMessage() {}
+func (*ScheduledRankAction) ProtoMessage() {}
 
-func (x *SystemDrainReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[7]
+func (x *ScheduledRankAction) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,66 +814,72 @@ func (x *SystemDrainReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemDrainReq.ProtoReflect.Descriptor instead.
-func (*SystemDrainReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ScheduledRankAction.ProtoReflect.Descriptor instead.
+func (*ScheduledRankAction) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SystemDrainReq) GetSys() string {
+func (x *ScheduledRankAction) GetRanks() string {
 	if x != nil {
-		return x.Sys
+		return x.Ranks
 	}
 	return ""
 }
 
-func (x *SystemDrainReq) GetRanks() string {
+func (x *ScheduledRankAction) GetClear() bool {
 	if x != nil {
-		return x.Ranks
+		return x.Clear
 	}
-	return ""
+	return false
 }
 
-func (x *SystemDrainReq) GetHosts() string {
+func (x *ScheduledRankAction) GetStart() uint64 {
 	if x != nil {
-		return x.Hosts
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *SystemDrainReq) GetReint() bool {
+func (x *ScheduledRankAction) GetEnd() uint64 {
 	if x != nil {
-		return x.Reint
+		return x.End
+	}
+	return 0
+}
+
+func (x *ScheduledRankAction) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
 	return false
 }
 
-// Response from pool-ranks operation.
-type PoolRanksResp struct {
+// SystemListScheduledReq supplies system list-scheduled parameters.
+type SystemListScheduledReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`           // Pool ID
-	Results []*shared.RankResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"` // Pool-rank operation results.
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system name
 }
 
-func (x *PoolRanksResp) Reset() {
-	*x = PoolRanksResp{}
+func (x *SystemListScheduledReq) Reset() {
+	*x = SystemListScheduledReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[8]
+		mi := &file_mgmt_system_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolRanksResp) String() string {
+func (x *SystemListScheduledReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolRanksResp) ProtoMessage() {}
+func (*SystemListScheduledReq) ProtoMessage() {}
 
-func (x *PoolRanksResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[8]
+func (x *SystemListScheduledReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,52 +890,44 @@ func (x *PoolRanksResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoolRanksResp.ProtoReflect.Descriptor instead.
-func (*PoolRanksResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SystemListScheduledReq.ProtoReflect.Descriptor instead.
+func (*SystemListScheduledReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PoolRanksResp) GetId() string {
+func (x *SystemListScheduledReq) GetSys() string {
 	if x != nil {
-		return x.Id
+		return x.Sys
 	}
 	return ""
 }
 
-func (x *PoolRanksResp) GetResults() []*shared.RankResult {
-	if x != nil {
-		return x.Results
-	}
-	return nil
-}
-
-// SystemDrainResp returns status of system-drain request.
-type SystemDrainResp struct {
+// SystemListScheduledResp returns the pending scheduled rank actions.
+type SystemListScheduledResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reint     bool             `protobuf:"varint,1,opt,name=reint,proto3" json:"reint,omitempty"`        // Flag to indicate if results are for drain or reint.
-	Responses []*PoolRanksResp `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"` // Response from drain or reint calls on pool-ranks.
+	Actions []*ScheduledRankAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
 }
 
-func (x *SystemDrainResp) Reset() {
-	*x = SystemDrainResp{}
+func (x *SystemListScheduledResp) Reset() {
+	*x = SystemListScheduledResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[9]
+		mi := &file_mgmt_system_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemDrainResp) String() string {
+func (x *SystemListScheduledResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemDrainResp) ProtoMessage() {}
+func (*SystemListScheduledResp) ProtoMessage() {}
 
-func (x *SystemDrainResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[9]
+func (x *SystemListScheduledResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,54 +938,232 @@ func (x *SystemDrainResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemDrainResp.ProtoReflect.Descriptor instead.
-func (*SystemDrainResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *SystemDrainResp) GetReint() bool {
-	if x != nil {
-		return x.Reint
-	}
-	return false
+// Deprecated: Use SystemListScheduledResp.ProtoReflect.Descriptor instead.
+func (*SystemListScheduledResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SystemDrainResp) GetResponses() []*PoolRanksResp {
+func (x *SystemListScheduledResp) GetActions() []*ScheduledRankAction {
 	if x != nil {
-		return x.Responses
+		return x.Actions
 	}
 	return nil
 }
 
-// SystemQueryReq supplies system query parameters.
-type SystemQueryReq struct {
+// SystemDrainReq supplies system-drain parameters.
+type SystemDrainReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys       string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                               // DAOS system name
-	Ranks     string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`                           // rankset to query
-	Hosts     string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`                           // hostset to query
-	StateMask uint32 `protobuf:"varint,4,opt,name=state_mask,json=stateMask,proto3" json:"state_mask,omitempty"` // bitmask defining desired member states
+	Sys   string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`      // DAOS system name
+	Ranks string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`  // rankset to drain on all pools
+	Hosts string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`  // hostset to drain on all pools
+	Reint bool   `protobuf:"varint,4,opt,name=reint,proto3" json:"reint,omitempty"` // Flag to indicate if request is for drain or reint.
 }
 
-func (x *SystemQueryReq) Reset() {
-	*x = SystemQueryReq{}
+func (x *SystemDrainReq) Reset() {
+	*x = SystemDrainReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[10]
+		mi := &file_mgmt_system_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemQueryReq) String() string {
+func (x *SystemDrainReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemQueryReq) ProtoMessage() {}
+func (*SystemDrainReq) ProtoMessage() {}
 
-func (x *SystemQueryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[10]
+func (x *SystemDrainReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDrainReq.ProtoReflect.Descriptor instead.
+func (*SystemDrainReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SystemDrainReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemDrainReq) GetRanks() string {
+	if x != nil {
+		return x.Ranks
+	}
+	return ""
+}
+
+func (x *SystemDrainReq) GetHosts() string {
+	if x != nil {
+		return x.Hosts
+	}
+	return ""
+}
+
+func (x *SystemDrainReq) GetReint() bool {
+	if x != nil {
+		return x.Reint
+	}
+	return false
+}
+
+// Response from pool-ranks operation.
+type PoolRanksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`           // Pool ID
+	Results []*shared.RankResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"` // Pool-rank operation results.
+}
+
+func (x *PoolRanksResp) Reset() {
+	*x = PoolRanksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolRanksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolRanksResp) ProtoMessage() {}
+
+func (x *PoolRanksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolRanksResp.ProtoReflect.Descriptor instead.
+func (*PoolRanksResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PoolRanksResp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PoolRanksResp) GetResults() []*shared.RankResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SystemDrainResp returns status of system-drain request.
+type SystemDrainResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reint     bool             `protobuf:"varint,1,opt,name=reint,proto3" json:"reint,omitempty"`        // Flag to indicate if results are for drain or reint.
+	Responses []*PoolRanksResp `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"` // Response from drain or reint calls on pool-ranks.
+}
+
+func (x *SystemDrainResp) Reset() {
+	*x = SystemDrainResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemDrainResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemDrainResp) ProtoMessage() {}
+
+func (x *SystemDrainResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemDrainResp.ProtoReflect.Descriptor instead.
+func (*SystemDrainResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SystemDrainResp) GetReint() bool {
+	if x != nil {
+		return x.Reint
+	}
+	return false
+}
+
+func (x *SystemDrainResp) GetResponses() []*PoolRanksResp {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+// SystemQueryReq supplies system query parameters.
+type SystemQueryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys         string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                     // DAOS system name
+	Ranks       string `protobuf:"bytes,2,opt,name=ranks,proto3" json:"ranks,omitempty"`                                 // rankset to query
+	Hosts       string `protobuf:"bytes,3,opt,name=hosts,proto3" json:"hosts,omitempty"`                                 // hostset to query
+	StateMask   uint32 `protobuf:"varint,4,opt,name=state_mask,json=stateMask,proto3" json:"state_mask,omitempty"`       // bitmask defining desired member states
+	ChangeToken uint64 `protobuf:"varint,5,opt,name=change_token,json=changeToken,proto3" json:"change_token,omitempty"` // data_version of a cached response, if any
+}
+
+func (x *SystemQueryReq) Reset() {
+	*x = SystemQueryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemQueryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemQueryReq) ProtoMessage() {}
+
+func (x *SystemQueryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +1176,7 @@ func (x *SystemQueryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemQueryReq.ProtoReflect.Descriptor instead.
 func (*SystemQueryReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{10}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SystemQueryReq) GetSys() string {
@@ -840,6 +1207,13 @@ func (x *SystemQueryReq) GetStateMask() uint32 {
 	return 0
 }
 
+func (x *SystemQueryReq) GetChangeToken() uint64 {
+	if x != nil {
+		return x.ChangeToken
+	}
+	return 0
+}
+
 // SystemQueryResp returns active system members.
 type SystemQueryResp struct {
 	state         protoimpl.MessageState
@@ -851,12 +1225,13 @@ type SystemQueryResp struct {
 	Absenthosts string          `protobuf:"bytes,3,opt,name=absenthosts,proto3" json:"absenthosts,omitempty"`                     // hostset missing from membership
 	DataVersion uint64          `protobuf:"varint,4,opt,name=data_version,json=dataVersion,proto3" json:"data_version,omitempty"` // Version of the system database.
 	Providers   []string        `protobuf:"bytes,5,rep,name=providers,proto3" json:"providers,omitempty"`                         // Providers supported by system in configured order
+	NotModified bool            `protobuf:"varint,6,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"` // system database unchanged since change_token
 }
 
 func (x *SystemQueryResp) Reset() {
 	*x = SystemQueryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[11]
+		mi := &file_mgmt_system_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -869,7 +1244,7 @@ func (x *SystemQueryResp) String() string {
 func (*SystemQueryResp) ProtoMessage() {}
 
 func (x *SystemQueryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[11]
+	mi := &file_mgmt_system_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +1257,7 @@ func (x *SystemQueryResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemQueryResp.ProtoReflect.Descriptor instead.
 func (*SystemQueryResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{11}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SystemQueryResp) GetMembers() []*SystemMember {
@@ -920,6 +1295,13 @@ func (x *SystemQueryResp) GetProviders() []string {
 	return nil
 }
 
+func (x *SystemQueryResp) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
 // SystemEraseReq supplies system erase parameters.
 type SystemEraseReq struct {
 	state         protoimpl.MessageState
@@ -932,7 +1314,7 @@ type SystemEraseReq struct {
 func (x *SystemEraseReq) Reset() {
 	*x = SystemEraseReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[12]
+		mi := &file_mgmt_system_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -945,7 +1327,7 @@ func (x *SystemEraseReq) String() string {
 func (*SystemEraseReq) ProtoMessage() {}
 
 func (x *SystemEraseReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[12]
+	mi := &file_mgmt_system_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1340,7 @@ func (x *SystemEraseReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemEraseReq.ProtoReflect.Descriptor instead.
 func (*SystemEraseReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{12}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SystemEraseReq) GetSys() string {
@@ -979,7 +1361,7 @@ type SystemEraseResp struct {
 func (x *SystemEraseResp) Reset() {
 	*x = SystemEraseResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[13]
+		mi := &file_mgmt_system_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -992,7 +1374,7 @@ func (x *SystemEraseResp) String() string {
 func (*SystemEraseResp) ProtoMessage() {}
 
 func (x *SystemEraseResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[13]
+	mi := &file_mgmt_system_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,7 +1387,7 @@ func (x *SystemEraseResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemEraseResp.ProtoReflect.Descriptor instead.
 func (*SystemEraseResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{13}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SystemEraseResp) GetResults() []*shared.RankResult {
@@ -1021,14 +1403,16 @@ type SystemCleanupReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys     string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`         // DAOS system identifier
-	Machine string `protobuf:"bytes,2,opt,name=machine,proto3" json:"machine,omitempty"` // Name of the machine to cleanup resources for.
+	Sys      string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                            // DAOS system identifier
+	Machine  string `protobuf:"bytes,2,opt,name=machine,proto3" json:"machine,omitempty"`                    // Name of the machine to cleanup resources for.
+	AllStale bool   `protobuf:"varint,3,opt,name=all_stale,json=allStale,proto3" json:"all_stale,omitempty"` // Cleanup resources for all machines with stale agent heartbeats
+	DryRun   bool   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`       // List the pool handles to be cleaned up without evicting them
 }
 
 func (x *SystemCleanupReq) Reset() {
 	*x = SystemCleanupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[14]
+		mi := &file_mgmt_system_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1041,7 +1425,7 @@ func (x *SystemCleanupReq) String() string {
 func (*SystemCleanupReq) ProtoMessage() {}
 
 func (x *SystemCleanupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[14]
+	mi := &file_mgmt_system_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +1438,7 @@ func (x *SystemCleanupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemCleanupReq.ProtoReflect.Descriptor instead.
 func (*SystemCleanupReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{14}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SystemCleanupReq) GetSys() string {
@@ -1071,19 +1455,34 @@ func (x *SystemCleanupReq) GetMachine() string {
 	return ""
 }
 
+func (x *SystemCleanupReq) GetAllStale() bool {
+	if x != nil {
+		return x.AllStale
+	}
+	return false
+}
+
+func (x *SystemCleanupReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // SystemCleanupResp returns resultant state of cleanup operation.
 type SystemCleanupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results []*SystemCleanupResp_CleanupResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Results and Status for individual pools that are cleanedup.
+	Results         []*SystemCleanupResp_CleanupResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`                                        // Results and Status for individual pools that are cleanedup.
+	UnknownMachines []string                           `protobuf:"bytes,2,rep,name=unknown_machines,json=unknownMachines,proto3" json:"unknown_machines,omitempty"` // Machines with pool handles but no agent heartbeats
 }
 
 func (x *SystemCleanupResp) Reset() {
 	*x = SystemCleanupResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[15]
+		mi := &file_mgmt_system_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1096,7 +1495,7 @@ func (x *SystemCleanupResp) String() string {
 func (*SystemCleanupResp) ProtoMessage() {}
 
 func (x *SystemCleanupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[15]
+	mi := &file_mgmt_system_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,7 +1508,7 @@ func (x *SystemCleanupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemCleanupResp.ProtoReflect.Descriptor instead.
 func (*SystemCleanupResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{15}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SystemCleanupResp) GetResults() []*SystemCleanupResp_CleanupResult {
@@ -1119,33 +1518,44 @@ func (x *SystemCleanupResp) GetResults() []*SystemCleanupResp_CleanupResult {
 	return nil
 }
 
-// SystemSetAttrReq contains a request to set one or more system properties.
-type SystemSetAttrReq struct {
+func (x *SystemCleanupResp) GetUnknownMachines() []string {
+	if x != nil {
+		return x.UnknownMachines
+	}
+	return nil
+}
+
+// AgentHeartbeatReq notifies the MS that the agent on a client machine is running.
+type AgentHeartbeatReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sys        string            `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
-	Attributes map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Sys              string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                                                   // DAOS system identifier
+	Machine          string   `protobuf:"bytes,2,opt,name=machine,proto3" json:"machine,omitempty"`                                           // Name of the client machine
+	Interval         uint32   `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`                                        // Seconds until the next heartbeat is sent
+	Version          string   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`                                           // Version of the agent
+	FabricInterfaces []string `protobuf:"bytes,5,rep,name=fabric_interfaces,json=fabricInterfaces,proto3" json:"fabric_interfaces,omitempty"` // Fabric interfaces available to clients
+	ClientCount      uint32   `protobuf:"varint,6,opt,name=client_count,json=clientCount,proto3" json:"client_count,omitempty"`               // Number of local client processes with open pool handles
 }
 
-func (x *SystemSetAttrReq) Reset() {
-	*x = SystemSetAttrReq{}
+func (x *AgentHeartbeatReq) Reset() {
+	*x = AgentHeartbeatReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[16]
+		mi := &file_mgmt_system_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemSetAttrReq) String() string {
+func (x *AgentHeartbeatReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemSetAttrReq) ProtoMessage() {}
+func (*AgentHeartbeatReq) ProtoMessage() {}
 
-func (x *SystemSetAttrReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[16]
+func (x *AgentHeartbeatReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1156,19 +1566,199 @@ func (x *SystemSetAttrReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemSetAttrReq.ProtoReflect.Descriptor instead.
-func (*SystemSetAttrReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AgentHeartbeatReq.ProtoReflect.Descriptor instead.
+func (*AgentHeartbeatReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SystemSetAttrReq) GetSys() string {
+func (x *AgentHeartbeatReq) GetSys() string {
 	if x != nil {
 		return x.Sys
 	}
 	return ""
 }
 
-func (x *SystemSetAttrReq) GetAttributes() map[string]string {
+func (x *AgentHeartbeatReq) GetMachine() string {
+	if x != nil {
+		return x.Machine
+	}
+	return ""
+}
+
+func (x *AgentHeartbeatReq) GetInterval() uint32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *AgentHeartbeatReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AgentHeartbeatReq) GetFabricInterfaces() []string {
+	if x != nil {
+		return x.FabricInterfaces
+	}
+	return nil
+}
+
+func (x *AgentHeartbeatReq) GetClientCount() uint32 {
+	if x != nil {
+		return x.ClientCount
+	}
+	return 0
+}
+
+// SystemListClientsReq requests the list of client machines known to the MS.
+type SystemListClientsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+}
+
+func (x *SystemListClientsReq) Reset() {
+	*x = SystemListClientsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemListClientsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemListClientsReq) ProtoMessage() {}
+
+func (x *SystemListClientsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemListClientsReq.ProtoReflect.Descriptor instead.
+func (*SystemListClientsReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SystemListClientsReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// SystemListClientsResp contains the client machines known to the MS.
+type SystemListClientsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients []*SystemListClientsResp_Client `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+}
+
+func (x *SystemListClientsResp) Reset() {
+	*x = SystemListClientsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemListClientsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemListClientsResp) ProtoMessage() {}
+
+func (x *SystemListClientsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemListClientsResp.ProtoReflect.Descriptor instead.
+func (*SystemListClientsResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SystemListClientsResp) GetClients() []*SystemListClientsResp_Client {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+// SystemSetAttrReq contains a request to set one or more system properties.
+type SystemSetAttrReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys        string            `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SystemSetAttrReq) Reset() {
+	*x = SystemSetAttrReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemSetAttrReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSetAttrReq) ProtoMessage() {}
+
+func (x *SystemSetAttrReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSetAttrReq.ProtoReflect.Descriptor instead.
+func (*SystemSetAttrReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SystemSetAttrReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemSetAttrReq) GetAttributes() map[string]string {
 	if x != nil {
 		return x.Attributes
 	}
@@ -1189,7 +1779,7 @@ type SystemGetAttrReq struct {
 func (x *SystemGetAttrReq) Reset() {
 	*x = SystemGetAttrReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[17]
+		mi := &file_mgmt_system_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1202,7 +1792,7 @@ func (x *SystemGetAttrReq) String() string {
 func (*SystemGetAttrReq) ProtoMessage() {}
 
 func (x *SystemGetAttrReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[17]
+	mi := &file_mgmt_system_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,7 +1805,7 @@ func (x *SystemGetAttrReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetAttrReq.ProtoReflect.Descriptor instead.
 func (*SystemGetAttrReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{17}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SystemGetAttrReq) GetSys() string {
@@ -1244,7 +1834,7 @@ type SystemGetAttrResp struct {
 func (x *SystemGetAttrResp) Reset() {
 	*x = SystemGetAttrResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[18]
+		mi := &file_mgmt_system_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1257,7 +1847,7 @@ func (x *SystemGetAttrResp) String() string {
 func (*SystemGetAttrResp) ProtoMessage() {}
 
 func (x *SystemGetAttrResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[18]
+	mi := &file_mgmt_system_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1270,7 +1860,7 @@ func (x *SystemGetAttrResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetAttrResp.ProtoReflect.Descriptor instead.
 func (*SystemGetAttrResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{18}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SystemGetAttrResp) GetAttributes() map[string]string {
@@ -1293,7 +1883,7 @@ type SystemSetPropReq struct {
 func (x *SystemSetPropReq) Reset() {
 	*x = SystemSetPropReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[19]
+		mi := &file_mgmt_system_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1306,7 +1896,7 @@ func (x *SystemSetPropReq) String() string {
 func (*SystemSetPropReq) ProtoMessage() {}
 
 func (x *SystemSetPropReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[19]
+	mi := &file_mgmt_system_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,7 +1909,7 @@ func (x *SystemSetPropReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemSetPropReq.ProtoReflect.Descriptor instead.
 func (*SystemSetPropReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{19}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SystemSetPropReq) GetSys() string {
@@ -1350,7 +1940,7 @@ type SystemGetPropReq struct {
 func (x *SystemGetPropReq) Reset() {
 	*x = SystemGetPropReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[20]
+		mi := &file_mgmt_system_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1363,7 +1953,7 @@ func (x *SystemGetPropReq) String() string {
 func (*SystemGetPropReq) ProtoMessage() {}
 
 func (x *SystemGetPropReq) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[20]
+	mi := &file_mgmt_system_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1376,7 +1966,7 @@ func (x *SystemGetPropReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetPropReq.ProtoReflect.Descriptor instead.
 func (*SystemGetPropReq) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{20}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SystemGetPropReq) GetSys() string {
@@ -1405,7 +1995,7 @@ type SystemGetPropResp struct {
 func (x *SystemGetPropResp) Reset() {
 	*x = SystemGetPropResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[21]
+		mi := &file_mgmt_system_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1418,7 +2008,7 @@ func (x *SystemGetPropResp) String() string {
 func (*SystemGetPropResp) ProtoMessage() {}
 
 func (x *SystemGetPropResp) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[21]
+	mi := &file_mgmt_system_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +2021,7 @@ func (x *SystemGetPropResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemGetPropResp.ProtoReflect.Descriptor instead.
 func (*SystemGetPropResp) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{21}
+	return file_mgmt_system_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SystemGetPropResp) GetProperties() map[string]string {
@@ -1441,34 +2031,37 @@ func (x *SystemGetPropResp) GetProperties() map[string]string {
 	return nil
 }
 
-type SystemCleanupResp_CleanupResult struct {
+// SystemSetFaultDomainsReq contains a request to set the fault domains of the
+// system members resident on the specified hosts, or to remove all imported
+// fault domains.
+type SystemSetFaultDomainsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`              // Status of the evict on the specific pool
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                     // Error message if status indicates an error
-	PoolId string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // uuid of pool
-	Count  uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`                // number of pool handles cleaned up
+	Sys          string            `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	FaultDomains map[string]string `protobuf:"bytes,2,rep,name=fault_domains,json=faultDomains,proto3" json:"fault_domains,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // fault domain strings keyed by host
+	DryRun       bool              `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                                                                                          // report changes without applying them
+	Clear        bool              `protobuf:"varint,4,opt,name=clear,proto3" json:"clear,omitempty"`                                                                                                                          // remove all imported fault domains instead of setting any
 }
 
-func (x *SystemCleanupResp_CleanupResult) Reset() {
-	*x = SystemCleanupResp_CleanupResult{}
+func (x *SystemSetFaultDomainsReq) Reset() {
+	*x = SystemSetFaultDomainsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mgmt_system_proto_msgTypes[22]
+		mi := &file_mgmt_system_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SystemCleanupResp_CleanupResult) String() string {
+func (x *SystemSetFaultDomainsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemCleanupResp_CleanupResult) ProtoMessage() {}
+func (*SystemSetFaultDomainsReq) ProtoMessage() {}
 
-func (x *SystemCleanupResp_CleanupResult) ProtoReflect() protoreflect.Message {
-	mi := &file_mgmt_system_proto_msgTypes[22]
+func (x *SystemSetFaultDomainsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,325 +2072,1845 @@ func (x *SystemCleanupResp_CleanupResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemCleanupResp_CleanupResult.ProtoReflect.Descriptor instead.
-func (*SystemCleanupResp_CleanupResult) Descriptor() ([]byte, []int) {
-	return file_mgmt_system_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use SystemSetFaultDomainsReq.ProtoReflect.Descriptor instead.
+func (*SystemSetFaultDomainsReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetStatus() int32 {
+func (x *SystemSetFaultDomainsReq) GetSys() string {
 	if x != nil {
-		return x.Status
+		return x.Sys
 	}
-	return 0
+	return ""
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetMsg() string {
+func (x *SystemSetFaultDomainsReq) GetFaultDomains() map[string]string {
 	if x != nil {
-		return x.Msg
+		return x.FaultDomains
 	}
-	return ""
+	return nil
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetPoolId() string {
+func (x *SystemSetFaultDomainsReq) GetDryRun() bool {
 	if x != nil {
-		return x.PoolId
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *SystemCleanupResp_CleanupResult) GetCount() uint32 {
+func (x *SystemSetFaultDomainsReq) GetClear() bool {
 	if x != nil {
-		return x.Count
+		return x.Clear
 	}
-	return 0
+	return false
 }
 
-var File_mgmt_system_proto protoreflect.FileDescriptor
+// SystemSetFaultDomainsResp contains the fault domain changes made (or that
+// would be made in the case of a dry run) by the request.
+type SystemSetFaultDomainsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_mgmt_system_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6d, 0x67, 0x6d, 0x74, 0x1a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x02,
-	0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e,
-	0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x72, 0x69,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x55, 0x72,
-	0x69, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x61, 0x62, 0x72,
-	0x69, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x21,
-	0x0a, 0x0c, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f,
-	0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x13, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x46, 0x61, 0x62, 0x72,
-	0x69, 0x63, 0x55, 0x72, 0x69, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72,
-	0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x72, 0x65, 0x70, 0x12, 0x12,
-	0x0a, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6b, 0x69,
-	0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68,
-	0x6f, 0x73, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73,
-	0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61,
-	0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xa1, 0x01,
-	0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
-	0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a,
-	0x15, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x67,
-	0x6e, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x64, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
-	0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e,
-	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74,
-	0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68,
-	0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65,
-	0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x66, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61,
-	0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65,
-	0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22,
-	0x41, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52,
-	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x72, 0x61, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73,
-	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x0d, 0x50, 0x6f, 0x6f, 0x6c,
-	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
-	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74,
-	0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
-	0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x73, 0x22, 0x6d, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f,
-	0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
-	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x73, 0x6b, 0x22, 0xc4, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61,
-	0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e,
-	0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74,
-	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73,
-	0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x64, 0x61, 0x74, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x22, 0x0a, 0x0e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x3f, 0x0a,
-	0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3e,
-	0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x73, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xbe,
-	0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x68, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74,
-	0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d,
-	0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52,
-	0x65, 0x71, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d,
-	0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a,
-	0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65,
-	0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47,
-	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01,
-	0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f,
-	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73,
-	0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Changes      []*SystemSetFaultDomainsResp_FaultDomainChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	ClearedRanks string                                         `protobuf:"bytes,2,opt,name=cleared_ranks,json=clearedRanks,proto3" json:"cleared_ranks,omitempty"` // ranks whose imported fault domains were removed
 }
 
-var (
-	file_mgmt_system_proto_rawDescOnce sync.Once
-	file_mgmt_system_proto_rawDescData = file_mgmt_system_proto_rawDesc
-)
+func (x *SystemSetFaultDomainsResp) Reset() {
+	*x = SystemSetFaultDomainsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_mgmt_system_proto_rawDescGZIP() []byte {
-	file_mgmt_system_proto_rawDescOnce.Do(func() {
-		file_mgmt_system_proto_rawDescData = protoimpl.X.CompressGZIP(file_mgmt_system_proto_rawDescData)
-	})
-	return file_mgmt_system_proto_rawDescData
+func (x *SystemSetFaultDomainsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_mgmt_system_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_mgmt_system_proto_goTypes = []interface{}{
-	(*SystemMember)(nil),                    // 0: mgmt.SystemMember
-	(*SystemStopReq)(nil),                   // 1: mgmt.SystemStopReq
-	(*SystemStopResp)(nil),                  // 2: mgmt.SystemStopResp
-	(*SystemStartReq)(nil),                  // 3: mgmt.SystemStartReq
-	(*SystemStartResp)(nil),                 // 4: mgmt.SystemStartResp
-	(*SystemExcludeReq)(nil),                // 5: mgmt.SystemExcludeReq
-	(*SystemExcludeResp)(nil),               // 6: mgmt.SystemExcludeResp
-	(*SystemDrainReq)(nil),                  // 7: mgmt.SystemDrainReq
-	(*PoolRanksResp)(nil),                   // 8: mgmt.PoolRanksResp
-	(*SystemDrainResp)(nil),                 // 9: mgmt.SystemDrainResp
-	(*SystemQueryReq)(nil),                  // 10: mgmt.SystemQueryReq
-	(*SystemQueryResp)(nil),                 // 11: mgmt.SystemQueryResp
-	(*SystemEraseReq)(nil),                  // 12: mgmt.SystemEraseReq
-	(*SystemEraseResp)(nil),                 // 13: mgmt.SystemEraseResp
-	(*SystemCleanupReq)(nil),                // 14: mgmt.SystemCleanupReq
-	(*SystemCleanupResp)(nil),               // 15: mgmt.SystemCleanupResp
-	(*SystemSetAttrReq)(nil),                // 16: mgmt.SystemSetAttrReq
-	(*SystemGetAttrReq)(nil),                // 17: mgmt.SystemGetAttrReq
-	(*SystemGetAttrResp)(nil),               // 18: mgmt.SystemGetAttrResp
-	(*SystemSetPropReq)(nil),                // 19: mgmt.SystemSetPropReq
-	(*SystemGetPropReq)(nil),                // 20: mgmt.SystemGetPropReq
-	(*SystemGetPropResp)(nil),               // 21: mgmt.SystemGetPropResp
-	(*SystemCleanupResp_CleanupResult)(nil), // 22: mgmt.SystemCleanupResp.CleanupResult
-	nil,                                     // 23: mgmt.SystemSetAttrReq.AttributesEntry
-	nil,                                     // 24: mgmt.SystemGetAttrResp.AttributesEntry
-	nil,                                     // 25: mgmt.SystemSetPropReq.PropertiesEntry
-	nil,                                     // 26: mgmt.SystemGetPropResp.PropertiesEntry
-	(*shared.RankResult)(nil),               // 27: shared.RankResult
+func (*SystemSetFaultDomainsResp) ProtoMessage() {}
+
+func (x *SystemSetFaultDomainsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_mgmt_system_proto_depIdxs = []int32{
-	27, // 0: mgmt.SystemStopResp.results:type_name -> shared.RankResult
-	27, // 1: mgmt.SystemStartResp.results:type_name -> shared.RankResult
-	27, // 2: mgmt.SystemExcludeResp.results:type_name -> shared.RankResult
-	27, // 3: mgmt.PoolRanksResp.results:type_name -> shared.RankResult
-	8,  // 4: mgmt.SystemDrainResp.responses:type_name -> mgmt.PoolRanksResp
-	0,  // 5: mgmt.SystemQueryResp.members:type_name -> mgmt.SystemMember
-	27, // 6: mgmt.SystemEraseResp.results:type_name -> shared.RankResult
-	22, // 7: mgmt.SystemCleanupResp.results:type_name -> mgmt.SystemCleanupResp.CleanupResult
-	23, // 8: mgmt.SystemSetAttrReq.attributes:type_name -> mgmt.SystemSetAttrReq.AttributesEntry
-	24, // 9: mgmt.SystemGetAttrResp.attributes:type_name -> mgmt.SystemGetAttrResp.AttributesEntry
-	25, // 10: mgmt.SystemSetPropReq.properties:type_name -> mgmt.SystemSetPropReq.PropertiesEntry
-	26, // 11: mgmt.SystemGetPropResp.properties:type_name -> mgmt.SystemGetPropResp.PropertiesEntry
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+
+// Deprecated: Use SystemSetFaultDomainsResp.ProtoReflect.Descriptor instead.
+func (*SystemSetFaultDomainsResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{31}
 }
 
-func init() { file_mgmt_system_proto_init() }
-func file_mgmt_system_proto_init() {
-	if File_mgmt_system_proto != nil {
-		return
+func (x *SystemSetFaultDomainsResp) GetChanges() []*SystemSetFaultDomainsResp_FaultDomainChange {
+	if x != nil {
+		return x.Changes
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_mgmt_system_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemMember); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_mgmt_system_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStopReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
+	return nil
+}
+
+func (x *SystemSetFaultDomainsResp) GetClearedRanks() string {
+	if x != nil {
+		return x.ClearedRanks
+	}
+	return ""
+}
+
+// SystemEventsReq contains a request to list the RAS events recorded in the
+// system database. Events are filtered and paginated by the MS leader.
+type SystemEventsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys      string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	Severity uint32 `protobuf:"varint,2,opt,name=severity,proto3" json:"severity,omitempty"` // Return events at least this severe (0 for any)
+	Ranks    string `protobuf:"bytes,3,opt,name=ranks,proto3" json:"ranks,omitempty"`        // rankset to return events for (empty for any)
+	Since    string `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`        // Return events at or after this RFC3339 time
+	Until    string `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`        // Return events at or before this RFC3339 time
+	Offset   uint64 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`     // Number of matching events to skip
+	Limit    uint64 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`       // Maximum number of events to return (0 for no limit)
+}
+
+func (x *SystemEventsReq) Reset() {
+	*x = SystemEventsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEventsReq) ProtoMessage() {}
+
+func (x *SystemEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEventsReq.ProtoReflect.Descriptor instead.
+func (*SystemEventsReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SystemEventsReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemEventsReq) GetSeverity() uint32 {
+	if x != nil {
+		return x.Severity
+	}
+	return 0
+}
+
+func (x *SystemEventsReq) GetRanks() string {
+	if x != nil {
+		return x.Ranks
+	}
+	return ""
+}
+
+func (x *SystemEventsReq) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *SystemEventsReq) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *SystemEventsReq) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SystemEventsReq) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SystemEventsResp contains the matching RAS events, newest first.
+type SystemEventsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*shared.RASEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total  uint64             `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Number of matching events before pagination
+}
+
+func (x *SystemEventsResp) Reset() {
+	*x = SystemEventsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemEventsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEventsResp) ProtoMessage() {}
+
+func (x *SystemEventsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEventsResp.ProtoReflect.Descriptor instead.
+func (*SystemEventsResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SystemEventsResp) GetEvents() []*shared.RASEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *SystemEventsResp) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// SystemReplaceHostReq contains a request to reassign the ranks of a failed host
+// to a replacement host.
+type SystemReplaceHostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys     string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	OldHost string `protobuf:"bytes,2,opt,name=old_host,json=oldHost,proto3" json:"old_host,omitempty"` // Host whose ranks are to be reassigned
+	NewHost string `protobuf:"bytes,3,opt,name=new_host,json=newHost,proto3" json:"new_host,omitempty"` // Replacement host to take over the ranks
+}
+
+func (x *SystemReplaceHostReq) Reset() {
+	*x = SystemReplaceHostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemReplaceHostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReplaceHostReq) ProtoMessage() {}
+
+func (x *SystemReplaceHostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReplaceHostReq.ProtoReflect.Descriptor instead.
+func (*SystemReplaceHostReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SystemReplaceHostReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemReplaceHostReq) GetOldHost() string {
+	if x != nil {
+		return x.OldHost
+	}
+	return ""
+}
+
+func (x *SystemReplaceHostReq) GetNewHost() string {
+	if x != nil {
+		return x.NewHost
+	}
+	return ""
+}
+
+// SystemReplaceHostResp contains the ranks reassigned to the replacement host.
+type SystemReplaceHostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ranks string `protobuf:"bytes,1,opt,name=ranks,proto3" json:"ranks,omitempty"` // rankset of the reassigned ranks
+}
+
+func (x *SystemReplaceHostResp) Reset() {
+	*x = SystemReplaceHostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemReplaceHostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemReplaceHostResp) ProtoMessage() {}
+
+func (x *SystemReplaceHostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemReplaceHostResp.ProtoReflect.Descriptor instead.
+func (*SystemReplaceHostResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SystemReplaceHostResp) GetRanks() string {
+	if x != nil {
+		return x.Ranks
+	}
+	return ""
+}
+
+// SystemUsageReq contains a request to get the pool storage allocated and
+// reserved on each rank.
+type SystemUsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+}
+
+func (x *SystemUsageReq) Reset() {
+	*x = SystemUsageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemUsageReq) ProtoMessage() {}
+
+func (x *SystemUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemUsageReq.ProtoReflect.Descriptor instead.
+func (*SystemUsageReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SystemUsageReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// RankUsage describes the pool storage on a rank, as per-tier byte counts.
+type RankUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank           uint32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	AllocatedBytes []uint64 `protobuf:"varint,2,rep,packed,name=allocated_bytes,json=allocatedBytes,proto3" json:"allocated_bytes,omitempty"` // Storage allocated to created pools
+	ReservedBytes  []uint64 `protobuf:"varint,3,rep,packed,name=reserved_bytes,json=reservedBytes,proto3" json:"reserved_bytes,omitempty"`    // Storage reserved by pools being created
+	NumPools       uint32   `protobuf:"varint,4,opt,name=num_pools,json=numPools,proto3" json:"num_pools,omitempty"`                          // Number of pools with storage on the rank
+}
+
+func (x *RankUsage) Reset() {
+	*x = RankUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RankUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankUsage) ProtoMessage() {}
+
+func (x *RankUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankUsage.ProtoReflect.Descriptor instead.
+func (*RankUsage) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RankUsage) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RankUsage) GetAllocatedBytes() []uint64 {
+	if x != nil {
+		return x.AllocatedBytes
+	}
+	return nil
+}
+
+func (x *RankUsage) GetReservedBytes() []uint64 {
+	if x != nil {
+		return x.ReservedBytes
+	}
+	return nil
+}
+
+func (x *RankUsage) GetNumPools() uint32 {
+	if x != nil {
+		return x.NumPools
+	}
+	return 0
+}
+
+// PoolReservation describes the per-rank storage reserved by a pool that is
+// being created.
+type PoolReservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid      string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Label     string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Ranks     string   `protobuf:"bytes,3,opt,name=ranks,proto3" json:"ranks,omitempty"`                                  // rankset of the ranks the pool is being created on
+	TierBytes []uint64 `protobuf:"varint,4,rep,packed,name=tier_bytes,json=tierBytes,proto3" json:"tier_bytes,omitempty"` // Per-rank storage reserved for each tier
+	Since     string   `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`                                  // RFC3339 time the reservation was made
+}
+
+func (x *PoolReservation) Reset() {
+	*x = PoolReservation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolReservation) ProtoMessage() {}
+
+func (x *PoolReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolReservation.ProtoReflect.Descriptor instead.
+func (*PoolReservation) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PoolReservation) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PoolReservation) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PoolReservation) GetRanks() string {
+	if x != nil {
+		return x.Ranks
+	}
+	return ""
+}
+
+func (x *PoolReservation) GetTierBytes() []uint64 {
+	if x != nil {
+		return x.TierBytes
+	}
+	return nil
+}
+
+func (x *PoolReservation) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+// SystemUsageResp contains the pool storage allocated and reserved on each
+// rank, and the outstanding pool reservations.
+type SystemUsageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ranks        []*RankUsage       `protobuf:"bytes,1,rep,name=ranks,proto3" json:"ranks,omitempty"`
+	Reservations []*PoolReservation `protobuf:"bytes,2,rep,name=reservations,proto3" json:"reservations,omitempty"`
+}
+
+func (x *SystemUsageResp) Reset() {
+	*x = SystemUsageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemUsageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemUsageResp) ProtoMessage() {}
+
+func (x *SystemUsageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemUsageResp.ProtoReflect.Descriptor instead.
+func (*SystemUsageResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SystemUsageResp) GetRanks() []*RankUsage {
+	if x != nil {
+		return x.Ranks
+	}
+	return nil
+}
+
+func (x *SystemUsageResp) GetReservations() []*PoolReservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+// SystemOpLocksReq contains a request to list the operation locks held by the
+// MS leader.
+type SystemOpLocksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+}
+
+func (x *SystemOpLocksReq) Reset() {
+	*x = SystemOpLocksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemOpLocksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemOpLocksReq) ProtoMessage() {}
+
+func (x *SystemOpLocksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemOpLocksReq.ProtoReflect.Descriptor instead.
+func (*SystemOpLocksReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SystemOpLocksReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// OpLock describes an operation lock held by the MS leader.
+type OpLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PoolUuid  string `protobuf:"bytes,1,opt,name=pool_uuid,json=poolUuid,proto3" json:"pool_uuid,omitempty"`    // UUID of the locked pool (empty for the system lock)
+	PoolLabel string `protobuf:"bytes,2,opt,name=pool_label,json=poolLabel,proto3" json:"pool_label,omitempty"` // Label of the locked pool
+	Operation string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`                  // Operation holding the lock
+	User      string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`                            // User who requested the operation
+	Since     string `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`                          // RFC3339 time the lock was taken
+}
+
+func (x *OpLock) Reset() {
+	*x = OpLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpLock) ProtoMessage() {}
+
+func (x *OpLock) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpLock.ProtoReflect.Descriptor instead.
+func (*OpLock) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *OpLock) GetPoolUuid() string {
+	if x != nil {
+		return x.PoolUuid
+	}
+	return ""
+}
+
+func (x *OpLock) GetPoolLabel() string {
+	if x != nil {
+		return x.PoolLabel
+	}
+	return ""
+}
+
+func (x *OpLock) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *OpLock) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *OpLock) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+// SystemOpLocksResp contains the operation locks held by the MS leader,
+// oldest first.
+type SystemOpLocksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locks []*OpLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (x *SystemOpLocksResp) Reset() {
+	*x = SystemOpLocksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemOpLocksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemOpLocksResp) ProtoMessage() {}
+
+func (x *SystemOpLocksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemOpLocksResp.ProtoReflect.Descriptor instead.
+func (*SystemOpLocksResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SystemOpLocksResp) GetLocks() []*OpLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+// SystemChangesReq requests the changes to the system information cached by
+// agents, e.g. rank membership and pool service replicas, that have been
+// recorded by the MS since a given sequence number.
+type SystemChangesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys      string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                            // DAOS system identifier
+	AfterSeq uint64 `protobuf:"varint,2,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"` // Return changes recorded after this sequence number
+}
+
+func (x *SystemChangesReq) Reset() {
+	*x = SystemChangesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemChangesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemChangesReq) ProtoMessage() {}
+
+func (x *SystemChangesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemChangesReq.ProtoReflect.Descriptor instead.
+func (*SystemChangesReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SystemChangesReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SystemChangesReq) GetAfterSeq() uint64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+// SystemChange describes a change to the system recorded by the MS.
+type SystemChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq       uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`                          // Sequence number of the change
+	Event     string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`                       // Name of the RAS event that recorded the change
+	Rank      uint32 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                        // Rank affected by the change, if any
+	PoolUuid  string `protobuf:"bytes,4,opt,name=pool_uuid,json=poolUuid,proto3" json:"pool_uuid,omitempty"` // UUID of the pool affected by the change, if any
+	Op        string `protobuf:"bytes,5,opt,name=op,proto3" json:"op,omitempty"`                             // Operation that made the change, if known
+	Timestamp string `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`               // Time at which the change was recorded
+}
+
+func (x *SystemChange) Reset() {
+	*x = SystemChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemChange) ProtoMessage() {}
+
+func (x *SystemChange) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemChange.ProtoReflect.Descriptor instead.
+func (*SystemChange) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SystemChange) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SystemChange) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *SystemChange) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SystemChange) GetPoolUuid() string {
+	if x != nil {
+		return x.PoolUuid
+	}
+	return ""
+}
+
+func (x *SystemChange) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *SystemChange) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// SystemChangesResp contains the matching changes, oldest first.
+type SystemChangesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*SystemChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	LastSeq uint64          `protobuf:"varint,2,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"` // Sequence number of the most recently recorded event
+}
+
+func (x *SystemChangesResp) Reset() {
+	*x = SystemChangesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemChangesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemChangesResp) ProtoMessage() {}
+
+func (x *SystemChangesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemChangesResp.ProtoReflect.Descriptor instead.
+func (*SystemChangesResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SystemChangesResp) GetChanges() []*SystemChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SystemChangesResp) GetLastSeq() uint64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+type SystemCleanupResp_CleanupResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`              // Status of the evict on the specific pool
+	Msg     string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                     // Error message if status indicates an error
+	PoolId  string `protobuf:"bytes,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"` // uuid of pool
+	Count   uint32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`                // number of pool handles cleaned up
+	Machine string `protobuf:"bytes,5,opt,name=machine,proto3" json:"machine,omitempty"`             // Name of the machine the pool handles belong to
+}
+
+func (x *SystemCleanupResp_CleanupResult) Reset() {
+	*x = SystemCleanupResp_CleanupResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemCleanupResp_CleanupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemCleanupResp_CleanupResult) ProtoMessage() {}
+
+func (x *SystemCleanupResp_CleanupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemCleanupResp_CleanupResult.ProtoReflect.Descriptor instead.
+func (*SystemCleanupResp_CleanupResult) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetPoolId() string {
+	if x != nil {
+		return x.PoolId
+	}
+	return ""
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SystemCleanupResp_CleanupResult) GetMachine() string {
+	if x != nil {
+		return x.Machine
+	}
+	return ""
+}
+
+type SystemListClientsResp_Client struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Machine          string   `protobuf:"bytes,1,opt,name=machine,proto3" json:"machine,omitempty"`                                           // Name of the client machine
+	Version          string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                                           // Version of the agent
+	FabricInterfaces []string `protobuf:"bytes,3,rep,name=fabric_interfaces,json=fabricInterfaces,proto3" json:"fabric_interfaces,omitempty"` // Fabric interfaces available to clients
+	ClientCount      uint32   `protobuf:"varint,4,opt,name=client_count,json=clientCount,proto3" json:"client_count,omitempty"`               // Number of local client processes with open pool handles
+	LastSeen         int64    `protobuf:"varint,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`                        // Unix time of the last heartbeat
+	Interval         uint32   `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`                                        // Seconds between heartbeats
+	Stale            bool     `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"`                                              // Whether the agent has missed too many heartbeats
+}
+
+func (x *SystemListClientsResp_Client) Reset() {
+	*x = SystemListClientsResp_Client{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemListClientsResp_Client) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemListClientsResp_Client) ProtoMessage() {}
+
+func (x *SystemListClientsResp_Client) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemListClientsResp_Client.ProtoReflect.Descriptor instead.
+func (*SystemListClientsResp_Client) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *SystemListClientsResp_Client) GetMachine() string {
+	if x != nil {
+		return x.Machine
+	}
+	return ""
+}
+
+func (x *SystemListClientsResp_Client) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SystemListClientsResp_Client) GetFabricInterfaces() []string {
+	if x != nil {
+		return x.FabricInterfaces
+	}
+	return nil
+}
+
+func (x *SystemListClientsResp_Client) GetClientCount() uint32 {
+	if x != nil {
+		return x.ClientCount
+	}
+	return 0
+}
+
+func (x *SystemListClientsResp_Client) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *SystemListClientsResp_Client) GetInterval() uint32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *SystemListClientsResp_Client) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type SystemSetFaultDomainsResp_FaultDomainChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank      uint32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Addr      string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	OldDomain string `protobuf:"bytes,3,opt,name=old_domain,json=oldDomain,proto3" json:"old_domain,omitempty"`
+	NewDomain string `protobuf:"bytes,4,opt,name=new_domain,json=newDomain,proto3" json:"new_domain,omitempty"`
+}
+
+func (x *SystemSetFaultDomainsResp_FaultDomainChange) Reset() {
+	*x = SystemSetFaultDomainsResp_FaultDomainChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_system_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemSetFaultDomainsResp_FaultDomainChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSetFaultDomainsResp_FaultDomainChange) ProtoMessage() {}
+
+func (x *SystemSetFaultDomainsResp_FaultDomainChange) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_system_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSetFaultDomainsResp_FaultDomainChange.ProtoReflect.Descriptor instead.
+func (*SystemSetFaultDomainsResp_FaultDomainChange) Descriptor() ([]byte, []int) {
+	return file_mgmt_system_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *SystemSetFaultDomainsResp_FaultDomainChange) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SystemSetFaultDomainsResp_FaultDomainChange) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *SystemSetFaultDomainsResp_FaultDomainChange) GetOldDomain() string {
+	if x != nil {
+		return x.OldDomain
+	}
+	return ""
+}
+
+func (x *SystemSetFaultDomainsResp_FaultDomainChange) GetNewDomain() string {
+	if x != nil {
+		return x.NewDomain
+	}
+	return ""
+}
+
+var File_mgmt_system_proto protoreflect.FileDescriptor
+
+var file_mgmt_system_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6d, 0x67, 0x6d, 0x74, 0x1a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x15, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x02, 0x0a, 0x0c, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x63,
+	0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x55, 0x72, 0x69, 0x12, 0x27, 0x0a,
+	0x0f, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x32,
+	0x0a, 0x15, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x62, 0x72,
+	0x69, 0x63, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x46, 0x61, 0x62, 0x72, 0x69, 0x63, 0x55, 0x72,
+	0x69, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x72, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x13, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72,
+	0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65,
+	0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e,
+	0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62,
+	0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x14, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2a, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x42, 0x08, 0x0a,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x7c, 0x0a, 0x14, 0x50, 0x6f, 0x6f, 0x6c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x2e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2a, 0x0a, 0x04, 0x72, 0x65, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x04, 0x72, 0x65, 0x73, 0x70, 0x42, 0x08, 0x0a, 0x06, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61,
+	0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22,
+	0x98, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x11, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x37, 0x0a,
+	0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x52, 0x61, 0x6e, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x61, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x2a, 0x0a, 0x16, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x4e, 0x0a, 0x17, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61,
+	0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x0d,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0f, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72,
+	0x65, 0x69, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e,
+	0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe7, 0x01, 0x0a, 0x0f, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x22, 0x22, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x72,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x45, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x74, 0x0a, 0x10, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x6c,
+	0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x6c,
+	0x6c, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0x84, 0x02, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x73, 0x1a, 0x82, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x11, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x11, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x61, 0x62, 0x72, 0x69,
+	0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x28,
+	0x0a, 0x14, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0xb3, 0x02, 0x0a, 0x15, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x1a, 0xdb, 0x01, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x0a, 0x11, 0x66, 0x61, 0x62, 0x72, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x61, 0x62, 0x72,
+	0x69, 0x63, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x22, 0xab,
+	0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74,
+	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65,
+	0x71, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x10,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x9b, 0x01, 0x0a,
+	0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf3, 0x01, 0x0a, 0x18, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x55, 0x0a, 0x0d, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65,
+	0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0c, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x1a,
+	0x3f, 0x0a, 0x11, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x88, 0x02, 0x0a, 0x19, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x74,
+	0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x73,
+	0x1a, 0x79, 0x0a, 0x11, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6e, 0x65, 0x77, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x65, 0x77, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x0f,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61,
+	0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x0a,
+	0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x52, 0x41, 0x53, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x5e, 0x0a, 0x14, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x6c, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x6c, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x48, 0x6f, 0x73,
+	0x74, 0x22, 0x2d, 0x0a, 0x15, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61,
+	0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73,
+	0x22, 0x22, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x79, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x65,
+	0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x73, 0x0a, 0x0f,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x25, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x05, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x24, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4f, 0x70, 0x4c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x06, 0x4f, 0x70, 0x4c, 0x6f,
+	0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x37, 0x0a, 0x11, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x4f, 0x70, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x05, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x4f, 0x70, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22,
+	0x41, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53,
+	0x65, 0x71, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x75, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5c, 0x0a, 0x11, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x2c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mgmt_system_proto_rawDescOnce sync.Once
+	file_mgmt_system_proto_rawDescData = file_mgmt_system_proto_rawDesc
+)
+
+func file_mgmt_system_proto_rawDescGZIP() []byte {
+	file_mgmt_system_proto_rawDescOnce.Do(func() {
+		file_mgmt_system_proto_rawDescData = protoimpl.X.CompressGZIP(file_mgmt_system_proto_rawDescData)
+	})
+	return file_mgmt_system_proto_rawDescData
+}
+
+var file_mgmt_system_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_mgmt_system_proto_goTypes = []interface{}{
+	(*SystemMember)(nil),                    // 0: mgmt.SystemMember
+	(*SystemStopReq)(nil),                   // 1: mgmt.SystemStopReq
+	(*SystemStopResp)(nil),                  // 2: mgmt.SystemStopResp
+	(*SystemStopStreamResp)(nil),            // 3: mgmt.SystemStopStreamResp
+	(*PoolCreateStreamResp)(nil),            // 4: mgmt.PoolCreateStreamResp
+	(*SystemStartReq)(nil),                  // 5: mgmt.SystemStartReq
+	(*SystemStartResp)(nil),                 // 6: mgmt.SystemStartResp
+	(*SystemExcludeReq)(nil),                // 7: mgmt.SystemExcludeReq
+	(*SystemExcludeResp)(nil),               // 8: mgmt.SystemExcludeResp
+	(*ScheduledRankAction)(nil),             // 9: mgmt.ScheduledRankAction
+	(*SystemListScheduledReq)(nil),          // 10: mgmt.SystemListScheduledReq
+	(*SystemListScheduledResp)(nil),         // 11: mgmt.SystemListScheduledResp
+	(*SystemDrainReq)(nil),                  // 12: mgmt.SystemDrainReq
+	(*PoolRanksResp)(nil),                   // 13: mgmt.PoolRanksResp
+	(*SystemDrainResp)(nil),                 // 14: mgmt.SystemDrainResp
+	(*SystemQueryReq)(nil),                  // 15: mgmt.SystemQueryReq
+	(*SystemQueryResp)(nil),                 // 16: mgmt.SystemQueryResp
+	(*SystemEraseReq)(nil),                  // 17: mgmt.SystemEraseReq
+	(*SystemEraseResp)(nil),                 // 18: mgmt.SystemEraseResp
+	(*SystemCleanupReq)(nil),                // 19: mgmt.SystemCleanupReq
+	(*SystemCleanupResp)(nil),               // 20: mgmt.SystemCleanupResp
+	(*AgentHeartbeatReq)(nil),               // 21: mgmt.AgentHeartbeatReq
+	(*SystemListClientsReq)(nil),            // 22: mgmt.SystemListClientsReq
+	(*SystemListClientsResp)(nil),           // 23: mgmt.SystemListClientsResp
+	(*SystemSetAttrReq)(nil),                // 24: mgmt.SystemSetAttrReq
+	(*SystemGetAttrReq)(nil),                // 25: mgmt.SystemGetAttrReq
+	(*SystemGetAttrResp)(nil),               // 26: mgmt.SystemGetAttrResp
+	(*SystemSetPropReq)(nil),                // 27: mgmt.SystemSetPropReq
+	(*SystemGetPropReq)(nil),                // 28: mgmt.SystemGetPropReq
+	(*SystemGetPropResp)(nil),               // 29: mgmt.SystemGetPropResp
+	(*SystemSetFaultDomainsReq)(nil),        // 30: mgmt.SystemSetFaultDomainsReq
+	(*SystemSetFaultDomainsResp)(nil),       // 31: mgmt.SystemSetFaultDomainsResp
+	(*SystemEventsReq)(nil),                 // 32: mgmt.SystemEventsReq
+	(*SystemEventsResp)(nil),                // 33: mgmt.SystemEventsResp
+	(*SystemReplaceHostReq)(nil),            // 34: mgmt.SystemReplaceHostReq
+	(*SystemReplaceHostResp)(nil),           // 35: mgmt.SystemReplaceHostResp
+	(*SystemUsageReq)(nil),                  // 36: mgmt.SystemUsageReq
+	(*RankUsage)(nil),                       // 37: mgmt.RankUsage
+	(*PoolReservation)(nil),                 // 38: mgmt.PoolReservation
+	(*SystemUsageResp)(nil),                 // 39: mgmt.SystemUsageResp
+	(*SystemOpLocksReq)(nil),                // 40: mgmt.SystemOpLocksReq
+	(*OpLock)(nil),                          // 41: mgmt.OpLock
+	(*SystemOpLocksResp)(nil),               // 42: mgmt.SystemOpLocksResp
+	(*SystemChangesReq)(nil),                // 43: mgmt.SystemChangesReq
+	(*SystemChange)(nil),                    // 44: mgmt.SystemChange
+	(*SystemChangesResp)(nil),               // 45: mgmt.SystemChangesResp
+	(*SystemCleanupResp_CleanupResult)(nil), // 46: mgmt.SystemCleanupResp.CleanupResult
+	(*SystemListClientsResp_Client)(nil),    // 47: mgmt.SystemListClientsResp.Client
+	nil,                                     // 48: mgmt.SystemSetAttrReq.AttributesEntry
+	nil,                                     // 49: mgmt.SystemGetAttrResp.AttributesEntry
+	nil,                                     // 50: mgmt.SystemSetPropReq.PropertiesEntry
+	nil,                                     // 51: mgmt.SystemGetPropResp.PropertiesEntry
+	nil,                                     // 52: mgmt.SystemSetFaultDomainsReq.FaultDomainsEntry
+	(*SystemSetFaultDomainsResp_FaultDomainChange)(nil), // 53: mgmt.SystemSetFaultDomainsResp.FaultDomainChange
+	(*shared.RankResult)(nil),                           // 54: shared.RankResult
+	(*shared.Progress)(nil),                             // 55: shared.Progress
+	(*PoolCreateResp)(nil),                              // 56: mgmt.PoolCreateResp
+	(*shared.RASEvent)(nil),                             // 57: shared.RASEvent
+}
+var file_mgmt_system_proto_depIdxs = []int32{
+	54, // 0: mgmt.SystemStopResp.results:type_name -> shared.RankResult
+	55, // 1: mgmt.SystemStopStreamResp.progress:type_name -> shared.Progress
+	2,  // 2: mgmt.SystemStopStreamResp.resp:type_name -> mgmt.SystemStopResp
+	55, // 3: mgmt.PoolCreateStreamResp.progress:type_name -> shared.Progress
+	56, // 4: mgmt.PoolCreateStreamResp.resp:type_name -> mgmt.PoolCreateResp
+	54, // 5: mgmt.SystemStartResp.results:type_name -> shared.RankResult
+	54, // 6: mgmt.SystemExcludeResp.results:type_name -> shared.RankResult
+	9,  // 7: mgmt.SystemExcludeResp.scheduled:type_name -> mgmt.ScheduledRankAction
+	9,  // 8: mgmt.SystemListScheduledResp.actions:type_name -> mgmt.ScheduledRankAction
+	54, // 9: mgmt.PoolRanksResp.results:type_name -> shared.RankResult
+	13, // 10: mgmt.SystemDrainResp.responses:type_name -> mgmt.PoolRanksResp
+	0,  // 11: mgmt.SystemQueryResp.members:type_name -> mgmt.SystemMember
+	54, // 12: mgmt.SystemEraseResp.results:type_name -> shared.RankResult
+	46, // 13: mgmt.SystemCleanupResp.results:type_name -> mgmt.SystemCleanupResp.CleanupResult
+	47, // 14: mgmt.SystemListClientsResp.clients:type_name -> mgmt.SystemListClientsResp.Client
+	48, // 15: mgmt.SystemSetAttrReq.attributes:type_name -> mgmt.SystemSetAttrReq.AttributesEntry
+	49, // 16: mgmt.SystemGetAttrResp.attributes:type_name -> mgmt.SystemGetAttrResp.AttributesEntry
+	50, // 17: mgmt.SystemSetPropReq.properties:type_name -> mgmt.SystemSetPropReq.PropertiesEntry
+	51, // 18: mgmt.SystemGetPropResp.properties:type_name -> mgmt.SystemGetPropResp.PropertiesEntry
+	52, // 19: mgmt.SystemSetFaultDomainsReq.fault_domains:type_name -> mgmt.SystemSetFaultDomainsReq.FaultDomainsEntry
+	53, // 20: mgmt.SystemSetFaultDomainsResp.changes:type_name -> mgmt.SystemSetFaultDomainsResp.FaultDomainChange
+	57, // 21: mgmt.SystemEventsResp.events:type_name -> shared.RASEvent
+	37, // 22: mgmt.SystemUsageResp.ranks:type_name -> mgmt.RankUsage
+	38, // 23: mgmt.SystemUsageResp.reservations:type_name -> mgmt.PoolReservation
+	41, // 24: mgmt.SystemOpLocksResp.locks:type_name -> mgmt.OpLock
+	44, // 25: mgmt.SystemChangesResp.changes:type_name -> mgmt.SystemChange
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_mgmt_system_proto_init() }
+func file_mgmt_system_proto_init() {
+	if File_mgmt_system_proto != nil {
+		return
+	}
+	file_mgmt_pool_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mgmt_system_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStopReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStopResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStopStreamResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolCreateStreamResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStartReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStartResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStopResp); i {
+		file_mgmt_system_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemExcludeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1808,8 +3921,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStartReq); i {
+		file_mgmt_system_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemExcludeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1820,8 +3933,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStartResp); i {
+		file_mgmt_system_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledRankAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1832,8 +3945,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemExcludeReq); i {
+		file_mgmt_system_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemListScheduledReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1844,8 +3957,8 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemExcludeResp); i {
+		file_mgmt_system_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemListScheduledResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1856,7 +3969,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemDrainReq); i {
 			case 0:
 				return &v.state
@@ -1868,7 +3981,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PoolRanksResp); i {
 			case 0:
 				return &v.state
@@ -1880,7 +3993,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemDrainResp); i {
 			case 0:
 				return &v.state
@@ -1892,7 +4005,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemQueryReq); i {
 			case 0:
 				return &v.state
@@ -1904,7 +4017,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemQueryResp); i {
 			case 0:
 				return &v.state
@@ -1916,7 +4029,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemEraseReq); i {
 			case 0:
 				return &v.state
@@ -1928,7 +4041,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemEraseResp); i {
 			case 0:
 				return &v.state
@@ -1940,7 +4053,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemCleanupReq); i {
 			case 0:
 				return &v.state
@@ -1952,7 +4065,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemCleanupResp); i {
 			case 0:
 				return &v.state
@@ -1964,7 +4077,43 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentHeartbeatReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemListClientsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemListClientsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemSetAttrReq); i {
 			case 0:
 				return &v.state
@@ -1976,7 +4125,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemGetAttrReq); i {
 			case 0:
 				return &v.state
@@ -1988,7 +4137,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemGetAttrResp); i {
 			case 0:
 				return &v.state
@@ -2000,7 +4149,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemSetPropReq); i {
 			case 0:
 				return &v.state
@@ -2012,7 +4161,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemGetPropReq); i {
 			case 0:
 				return &v.state
@@ -2024,7 +4173,7 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemGetPropResp); i {
 			case 0:
 				return &v.state
@@ -2036,7 +4185,199 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
-		file_mgmt_system_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_mgmt_system_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSetFaultDomainsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSetFaultDomainsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemEventsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemEventsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemReplaceHostReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemReplaceHostResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemUsageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolReservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemUsageResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemOpLocksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemOpLocksResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemChangesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemChangesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SystemCleanupResp_CleanupResult); i {
 			case 0:
 				return &v.state
@@ -2048,6 +4389,38 @@ func file_mgmt_system_proto_init() {
 				return nil
 			}
 		}
+		file_mgmt_system_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemListClientsResp_Client); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_system_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemSetFaultDomainsResp_FaultDomainChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mgmt_system_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*SystemStopStreamResp_Progress)(nil),
+		(*SystemStopStreamResp_Resp)(nil),
+	}
+	file_mgmt_system_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*PoolCreateStreamResp_Progress)(nil),
+		(*PoolCreateStreamResp_Resp)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2055,7 +4428,7 @@ func file_mgmt_system_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mgmt_system_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,7 +24,6 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
-	"golang.org/x/sys/unix"
 	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/daos-stack/daos/src/control/logging"
@@ -296,7 +295,7 @@ func CreateTestSocket(t *testing.T, sockPath string) (*net.UnixListener, func())
 	cleanup := func() {
 		t.Helper()
 		sock.Close()
-		if err := unix.Unlink(sockPath); err != nil && !os.IsNotExist(err) {
+		if err := os.Remove(sockPath); err != nil && !os.IsNotExist(err) {
 			t.Fatalf("Unlink(%s): %s", sockPath, err)
 		}
 	}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,7 @@
 package common
 
 import (
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -30,6 +32,50 @@ func FormatTime(t time.Time) string {
 	return t.Format(iso8601)
 }
 
+// FormatDuration returns a compact representation of the duration using its
+// two most significant units, e.g. "214d3h", "2h13m" or "45s". Durations of
+// less than a second are represented as "0s".
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		return "-" + FormatDuration(-d)
+	}
+
+	units := []struct {
+		suffix string
+		size   time.Duration
+	}{
+		{"d", 24 * time.Hour},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+	}
+
+	for i, unit := range units {
+		if d < unit.size {
+			continue
+		}
+		out := fmt.Sprintf("%d%s", d/unit.size, unit.suffix)
+		if i+1 < len(units) {
+			next := units[i+1]
+			if rem := (d % unit.size) / next.size; rem > 0 {
+				out += fmt.Sprintf("%d%s", rem, next.suffix)
+			}
+		}
+		return out
+	}
+
+	return "0s"
+}
+
+// FormatAge returns a representation of the time elapsed between t and now,
+// e.g. "2h13m ago".
+func FormatAge(t, now time.Time) string {
+	if t.After(now) {
+		return "in " + FormatDuration(t.Sub(now))
+	}
+	return FormatDuration(now.Sub(t)) + " ago"
+}
+
 // ParseTime returns a time.Time object from ISO8601 or RFC3339
 // timestamp strings.
 func ParseTime(ts string) (time.Time, error) {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -120,3 +121,72 @@ func Test_Common_ParseFormattedTime(t *testing.T) {
 		t.Fatalf("after parsing, %q != %q", common.FormatTime(parsed), formatted)
 	}
 }
+
+func Test_Common_FormatDuration(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     time.Duration
+		expStr string
+	}{
+		"zero": {
+			expStr: "0s",
+		},
+		"sub-second": {
+			in:     999 * time.Millisecond,
+			expStr: "0s",
+		},
+		"seconds": {
+			in:     45*time.Second + 300*time.Millisecond,
+			expStr: "45s",
+		},
+		"minutes and seconds": {
+			in:     3*time.Minute + 5*time.Second,
+			expStr: "3m5s",
+		},
+		"hours and minutes": {
+			in:     2*time.Hour + 13*time.Minute + 59*time.Second,
+			expStr: "2h13m",
+		},
+		"whole hours": {
+			in:     2*time.Hour + 59*time.Second,
+			expStr: "2h",
+		},
+		"days and hours": {
+			in:     214*24*time.Hour + 3*time.Hour + 20*time.Minute,
+			expStr: "214d3h",
+		},
+		"negative": {
+			in:     -90 * time.Second,
+			expStr: "-1m30s",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expStr, common.FormatDuration(tc.in), "unexpected duration")
+		})
+	}
+}
+
+func Test_Common_FormatAge(t *testing.T) {
+	now := time.Date(2025, 6, 3, 14, 29, 19, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		in     time.Time
+		expStr string
+	}{
+		"now": {
+			in:     now,
+			expStr: "0s ago",
+		},
+		"past": {
+			in:     now.Add(-(2*time.Hour + 13*time.Minute)),
+			expStr: "2h13m ago",
+		},
+		"future": {
+			in:     now.Add(5 * time.Minute),
+			expStr: "in 5m",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expStr, common.FormatAge(tc.in, now), "unexpected age")
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -36,8 +37,14 @@ type DomainSocketServer struct {
 	service       *ModuleService
 	sessions      map[net.Conn]*Session
 	sessionsMutex sync.Mutex
+	connFilter    ConnFilter
 }
 
+// ConnFilter is a function that is called on each accepted connection before
+// a session is created for it. If it returns an error, the connection is
+// rejected and closed.
+type ConnFilter func(conn net.Conn) error
+
 // closeSession cleans up the session and removes it from the list of active
 // sessions.
 func (d *DomainSocketServer) closeSession(s *Session) {
@@ -77,20 +84,47 @@ func (d *DomainSocketServer) Listen(ctx context.Context) {
 			return
 		}
 
-		c := NewSession(conn, d.service)
-		d.sessionsMutex.Lock()
-		d.sessions[conn] = c
-		d.sessionsMutex.Unlock()
-		go d.listenSession(ctx, c)
+		if d.connFilter == nil {
+			d.startSession(ctx, conn)
+			continue
+		}
+
+		// The filter may need to look up the credentials of the peer, so
+		// it is run outside of the accept loop to avoid holding up other
+		// connections.
+		go func() {
+			if err := d.connFilter(conn); err != nil {
+				d.log.Noticef("%s: rejected connection: %s", d.sockFile, err)
+				_ = conn.Close()
+				return
+			}
+			d.startSession(ctx, conn)
+		}()
 	}
 }
 
+// startSession creates a Session for the connection and starts its listening
+// loop.
+func (d *DomainSocketServer) startSession(ctx context.Context, conn net.Conn) {
+	c := NewSession(conn, d.service)
+	d.sessionsMutex.Lock()
+	d.sessions[conn] = c
+	d.sessionsMutex.Unlock()
+	go d.listenSession(ctx, c)
+}
+
 // Start sets up the dRPC server socket and kicks off the listener goroutine.
 func (d *DomainSocketServer) Start(ctx context.Context) error {
 	if d == nil {
 		return errors.New("DomainSocketServer is nil")
 	}
 
+	if d.listener != nil {
+		// The socket was created by the caller, so it is used as-is.
+		go d.Listen(ctx)
+		return nil
+	}
+
 	addr := &net.UnixAddr{Name: d.sockFile, Net: "unixpacket"}
 	if err := d.checkExistingSocket(ctx, addr); err != nil {
 		return err
@@ -132,6 +166,19 @@ func (d *DomainSocketServer) checkExistingSocket(ctx context.Context, addr *net.
 	return err
 }
 
+// UseListener sets an existing listener (e.g. one passed by systemd socket
+// activation) to be used in place of creating the socket when the server is
+// started. The listener must be for a unixpacket socket.
+func (d *DomainSocketServer) UseListener(lis net.Listener) {
+	d.listener = lis
+}
+
+// SetConnFilter sets a filter to be applied to each incoming connection, e.g.
+// to restrict access to the socket based on the credentials of the peer.
+func (d *DomainSocketServer) SetConnFilter(filter ConnFilter) {
+	d.connFilter = filter
+}
+
 // RegisterRPCModule takes a Module and associates it with the given
 // DomainSocketServer so it can be used to process incoming dRPC calls.
 func (d *DomainSocketServer) RegisterRPCModule(mod Module) {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,9 +9,13 @@ package drpc
 
 import (
 	"context"
+	"net"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -261,6 +266,37 @@ func TestDrpc_DomainSocketServer_Start(t *testing.T) {
 	}
 }
 
+func TestDrpc_DomainSocketServer_UseListener(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	tmpDir, tmpCleanup := test.CreateTestDir(t)
+	defer tmpCleanup()
+
+	lisPath := filepath.Join(tmpDir, "activated.sock")
+	lis, err := net.ListenUnix("unixpacket", &net.UnixAddr{Name: lisPath, Net: "unixpacket"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The configured socket path is not used when a listener is supplied.
+	dss, err := NewDomainSocketServer(log, filepath.Join(tmpDir, "missing", "test.sock"), testFileMode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dss.UseListener(lis)
+
+	if err := dss.Start(test.Context(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.DialUnix("unixpacket", nil, &net.UnixAddr{Name: lisPath, Net: "unixpacket"})
+	if err != nil {
+		t.Fatalf("unable to connect to supplied listener: %s", err)
+	}
+	conn.Close()
+}
+
 func TestServer_RegisterModule(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	defer test.ShowBufferOnFailure(t, buf)
@@ -312,6 +348,61 @@ func TestServer_Listen_AcceptConnection(t *testing.T) {
 		"server should have made connections into sessions")
 }
 
+func TestServer_Listen_ConnFilter(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	lis := newMockListener()
+	lis.setNumConnsToAccept(3)
+	dss, _ := NewDomainSocketServer(log, "dontcare.sock", testFileMode)
+	dss.listener = lis
+
+	// The first connection is held in the filter until the others have
+	// been accepted, as for a slow peer.
+	release := make(chan struct{})
+	var filterCalls int32
+	dss.SetConnFilter(func(net.Conn) error {
+		switch atomic.AddInt32(&filterCalls, 1) {
+		case 1:
+			<-release
+		case 2:
+			return errors.New("mock filter")
+		}
+		return nil
+	})
+
+	numSessions := func() int {
+		dss.sessionsMutex.Lock()
+		defer dss.sessionsMutex.Unlock()
+		return len(dss.sessions)
+	}
+	waitFor := func(desc string, cond func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for !cond() {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for %s", desc)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	dss.Listen(test.Context(t)) // will return when error is sent
+
+	test.AssertEqual(t, lis.acceptCallCount, lis.acceptNumConns+1,
+		"slow filter should not hold up the accept loop")
+	waitFor("rejection to be logged", func() bool {
+		return strings.Contains(buf.String(), "mock filter")
+	})
+	waitFor("session after slow filter", func() bool { return numSessions() == 1 })
+
+	close(release)
+	waitFor("all sessions", func() bool { return numSessions() == lis.acceptNumConns-1 })
+
+	test.AssertEqual(t, int32(lis.acceptNumConns), atomic.LoadInt32(&filterCalls),
+		"filter should be called for each connection")
+}
+
 func TestServer_ListenSession_Error(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	defer test.ShowBufferOnFailure(t, buf)
@@ -397,8 +488,9 @@ func TestServer_IntegrationNoMethod(t *testing.T) {
 	}
 
 	expectedResp := &Response{
-		Sequence: call.Sequence,
-		Status:   Status_UNKNOWN_METHOD,
+		Sequence:   call.Sequence,
+		Status:     Status_UNKNOWN_METHOD,
+		ErrorClass: ErrorClass_FATAL,
 	}
 	cmpOpts := test.DefaultCmpOpts()
 	if diff := cmp.Diff(expectedResp, resp, cmpOpts...); diff != "" {
@@ -1,15 +1,20 @@
 //
 // (C) Copyright 2018-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
 
 package drpc
 
+import "time"
+
 // Failure represents a dRPC protocol failure.
 type Failure struct {
 	statusCode Status
 	message    string
+	errClass   ErrorClass
+	retryAfter time.Duration
 }
 
 // Error provides a descriptive string associated with the failure.
@@ -22,15 +27,37 @@ func (e Failure) GetStatus() Status {
 	return e.statusCode
 }
 
+// GetErrorClass provides the classification of the failure.
+func (e Failure) GetErrorClass() ErrorClass {
+	return e.errClass
+}
+
+// GetRetryAfter provides the suggested delay before retrying the call, if known.
+func (e Failure) GetRetryAfter() time.Duration {
+	return e.retryAfter
+}
+
 // NewFailure returns a Failure with the given status and a corresponding message.
 func NewFailure(status Status) Failure {
 	message := statusToString(status)
 	return Failure{
 		message:    message,
 		statusCode: status,
+		errClass:   statusToErrorClass(status),
 	}
 }
 
+// statusToErrorClass classifies the protocol failures, which are not resolved by
+// retrying the call.
+func statusToErrorClass(status Status) ErrorClass {
+	switch status {
+	case Status_SUCCESS, Status_SUBMITTED, Status_FAILURE:
+		return ErrorClass_NONE
+	}
+
+	return ErrorClass_FATAL
+}
+
 func statusToString(status Status) string {
 	switch status {
 	case Status_UNKNOWN_MODULE:
@@ -85,6 +112,17 @@ func NewFailureWithMessage(message string) Failure {
 	}
 }
 
+// NewClassifiedFailure returns a generic failure with a custom message, classified
+// with the given error class and suggested delay before retrying the call.
+func NewClassifiedFailure(message string, class ErrorClass, retryAfter time.Duration) Failure {
+	return Failure{
+		message:    message,
+		statusCode: Status_FAILURE,
+		errClass:   class,
+		retryAfter: retryAfter,
+	}
+}
+
 // ErrorToStatus translates an error to a dRPC Status.
 // In practice it checks to see if it was a dRPC Failure error, and uses the Status if so.
 // Otherwise it is assumed to be a generic failure.
@@ -97,3 +135,12 @@ func ErrorToStatus(err error) Status {
 	}
 	return Status_FAILURE
 }
+
+// ErrorToClass translates an error to a dRPC ErrorClass and suggested delay before
+// retrying the call. Errors other than a dRPC Failure are not classified.
+func ErrorToClass(err error) (ErrorClass, time.Duration) {
+	if failure, ok := err.(Failure); ok {
+		return failure.GetErrorClass(), failure.GetRetryAfter()
+	}
+	return ErrorClass_NONE, 0
+}
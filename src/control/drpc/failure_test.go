@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package drpc
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -127,6 +129,51 @@ func TestErrorToStatus(t *testing.T) {
 	}
 }
 
+func TestNewClassifiedFailure(t *testing.T) {
+	f := NewClassifiedFailure("try later", ErrorClass_TRANSIENT, time.Second)
+
+	test.AssertEqual(t, f.Error(), "try later", "didn't get the custom message")
+	test.AssertEqual(t, f.GetStatus(), Status_FAILURE, "expected a generic failure")
+	test.AssertEqual(t, f.GetErrorClass(), ErrorClass_TRANSIENT, "unexpected error class")
+	test.AssertEqual(t, f.GetRetryAfter(), time.Second, "unexpected retry delay")
+}
+
+func TestErrorToClass(t *testing.T) {
+	for name, tt := range map[string]struct {
+		err           error
+		expClass      ErrorClass
+		expRetryAfter time.Duration
+	}{
+		"nil": {
+			expClass: ErrorClass_NONE,
+		},
+		"generic error": {
+			err:      errors.New("a surprising error"),
+			expClass: ErrorClass_NONE,
+		},
+		"generic failure": {
+			err:      NewFailureWithMessage("a failure"),
+			expClass: ErrorClass_NONE,
+		},
+		"protocol failure": {
+			err:      NewFailure(Status_UNKNOWN_METHOD),
+			expClass: ErrorClass_FATAL,
+		},
+		"classified failure": {
+			err:           NewClassifiedFailure("busy", ErrorClass_TRANSIENT, 2*time.Second),
+			expClass:      ErrorClass_TRANSIENT,
+			expRetryAfter: 2 * time.Second,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			class, retryAfter := ErrorToClass(tt.err)
+
+			test.AssertEqual(t, tt.expClass, class, "unexpected error class")
+			test.AssertEqual(t, tt.expRetryAfter, retryAfter, "unexpected retry delay")
+		})
+	}
+}
+
 func TestFailureCreationMethods(t *testing.T) {
 	for name, tt := range map[string]struct {
 		function       func() Failure
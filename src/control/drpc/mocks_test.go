@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -18,12 +19,17 @@ import (
 
 // mockModule is a mock of the Module interface
 type mockModule struct {
-	HandleCallResponse []byte
-	HandleCallErr      error
-	IDValue            ModuleID
+	HandleCallResponse   []byte
+	HandleCallErr        error
+	HandleCallErrClass   ErrorClass
+	HandleCallRetryAfter time.Duration
+	IDValue              ModuleID
 }
 
-func (m *mockModule) HandleCall(_ context.Context, session *Session, method Method, input []byte) ([]byte, error) {
+func (m *mockModule) HandleCall(ctx context.Context, session *Session, method Method, input []byte) ([]byte, error) {
+	if m.HandleCallErrClass != ErrorClass_NONE {
+		SetResponseHint(ctx, m.HandleCallErrClass, m.HandleCallRetryAfter)
+	}
 	return m.HandleCallResponse, m.HandleCallErr
 }
 
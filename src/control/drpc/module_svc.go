@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package drpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -58,6 +60,27 @@ func (r *ModuleService) GetModule(id ModuleID) (Module, bool) {
 	return mod, found
 }
 
+type ctxKey string
+
+const responseHintKey ctxKey = "drpc_response_hint"
+
+// responseHint is the classification of a failure reported by the response to
+// a dRPC call, which helps the caller to decide whether to retry the call.
+type responseHint struct {
+	errClass   ErrorClass
+	retryAfter time.Duration
+}
+
+// SetResponseHint classifies a failure reported in the body of the response to
+// the dRPC call being handled with the given context, and suggests a delay
+// before retrying the call. It has no effect outside of a dRPC call handler.
+func SetResponseHint(ctx context.Context, class ErrorClass, retryAfter time.Duration) {
+	if hint, ok := ctx.Value(responseHintKey).(*responseHint); ok {
+		hint.errClass = class
+		hint.retryAfter = retryAfter
+	}
+}
+
 // marshalResponse is an internal function that will take the necessary
 // and create a dRPC Response protobuf bytes to send back
 //
@@ -65,10 +88,11 @@ func (r *ModuleService) GetModule(id ModuleID) (Module, bool) {
 //	sequence: the sequence number associated with the call processed
 //	status: the drpc.Status of the response
 //	body: the bytes associated with the response data.
+//	hint: the classification of the failure reported by the response, if any.
 //
 //	Returns:
 //	(bytes representing response protobuf, marshalling error if one exits)
-func marshalResponse(sequence int64, status Status, body []byte) ([]byte, error) {
+func marshalResponse(sequence int64, status Status, body []byte, hint responseHint) ([]byte, error) {
 	var response Response
 
 	if status == Status_SUCCESS {
@@ -83,6 +107,11 @@ func marshalResponse(sequence int64, status Status, body []byte) ([]byte, error)
 			Status:   status,
 		}
 	}
+	if hint.errClass == ErrorClass_NONE {
+		hint.errClass = statusToErrorClass(status)
+	}
+	response.ErrorClass = hint.errClass
+	response.RetryAfterMs = uint32(hint.retryAfter.Milliseconds())
 
 	responseBytes, mErr := proto.Marshal(&response)
 	if mErr != nil {
@@ -99,23 +128,29 @@ func (r *ModuleService) ProcessMessage(ctx context.Context, session *Session, ms
 
 	err := proto.Unmarshal(msgBytes, msg)
 	if err != nil {
-		return marshalResponse(-1, Status_FAILED_UNMARSHAL_CALL, nil)
+		return marshalResponse(-1, Status_FAILED_UNMARSHAL_CALL, nil, responseHint{})
 	}
 	module, ok := r.GetModule(ModuleID(msg.GetModule()))
 	if !ok {
 		r.log.Errorf("Attempted to call unregistered module %d", msg.GetModule())
-		return marshalResponse(msg.GetSequence(), Status_UNKNOWN_MODULE, nil)
+		return marshalResponse(msg.GetSequence(), Status_UNKNOWN_MODULE, nil, responseHint{})
 	}
 	var method Method
 	method, err = module.ID().GetMethod(msg.GetMethod())
 	if err != nil {
-		return marshalResponse(msg.GetSequence(), Status_UNKNOWN_METHOD, nil)
+		return marshalResponse(msg.GetSequence(), Status_UNKNOWN_METHOD, nil, responseHint{})
 	}
-	respBody, err := module.HandleCall(ctx, session, method, msg.GetBody())
+
+	// The handler may classify a failure reported in the response body.
+	hint := new(responseHint)
+	respBody, err := module.HandleCall(context.WithValue(ctx, responseHintKey, hint), session, method, msg.GetBody())
 	if err != nil {
 		r.log.Errorf("HandleCall for %s:%s failed: %s\n", module.ID().String(), method.String(), err)
-		return marshalResponse(msg.GetSequence(), ErrorToStatus(err), nil)
+		if hint.errClass == ErrorClass_NONE {
+			hint.errClass, hint.retryAfter = ErrorToClass(err)
+		}
+		return marshalResponse(msg.GetSequence(), ErrorToStatus(err), nil, *hint)
 	}
 
-	return marshalResponse(msg.GetSequence(), Status_SUCCESS, respBody)
+	return marshalResponse(msg.GetSequence(), Status_SUCCESS, respBody, *hint)
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package drpc
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -146,22 +148,33 @@ func getResponse(sequence int64, status Status, body []byte) *Response {
 	}
 }
 
+func getClassifiedResponse(sequence int64, status Status, body []byte, class ErrorClass, retryAfterMs uint32) *Response {
+	resp := getResponse(sequence, status, body)
+	resp.ErrorClass = class
+	resp.RetryAfterMs = retryAfterMs
+	return resp
+}
+
 func TestService_ProcessMessage(t *testing.T) {
 	const testSequenceNum int64 = 13
 
 	for name, tc := range map[string]struct {
-		callBytes      []byte
-		handleCallErr  error
-		handleCallResp []byte
-		expectedResp   *Response
+		callBytes       []byte
+		handleCallErr   error
+		handleCallResp  []byte
+		handleCallClass ErrorClass
+		handleCallRetry time.Duration
+		expectedResp    *Response
 	}{
 		"garbage input bytes": {
-			callBytes:    getGarbageBytes(),
-			expectedResp: getResponse(-1, Status_FAILED_UNMARSHAL_CALL, nil),
+			callBytes: getGarbageBytes(),
+			expectedResp: getClassifiedResponse(-1, Status_FAILED_UNMARSHAL_CALL, nil,
+				ErrorClass_FATAL, 0),
 		},
 		"module doesn't exist": {
-			callBytes:    getCallBytes(t, testSequenceNum, 256, MethodPoolCreate),
-			expectedResp: getResponse(testSequenceNum, Status_UNKNOWN_MODULE, nil),
+			callBytes: getCallBytes(t, testSequenceNum, 256, MethodPoolCreate),
+			expectedResp: getClassifiedResponse(testSequenceNum, Status_UNKNOWN_MODULE, nil,
+				ErrorClass_FATAL, 0),
 		},
 		"HandleCall fails with regular error": {
 			callBytes: getCallBytes(t, testSequenceNum, int32(defaultTestModID),
@@ -173,7 +186,32 @@ func TestService_ProcessMessage(t *testing.T) {
 			callBytes: getCallBytes(t, testSequenceNum, int32(defaultTestModID),
 				MethodPoolCreate),
 			handleCallErr: NewFailure(Status_FAILED_UNMARSHAL_PAYLOAD),
-			expectedResp:  getResponse(testSequenceNum, Status_FAILED_UNMARSHAL_PAYLOAD, nil),
+			expectedResp: getClassifiedResponse(testSequenceNum, Status_FAILED_UNMARSHAL_PAYLOAD, nil,
+				ErrorClass_FATAL, 0),
+		},
+		"HandleCall fails with classified drpc.Failure": {
+			callBytes: getCallBytes(t, testSequenceNum, int32(defaultTestModID),
+				MethodPoolCreate),
+			handleCallErr: NewClassifiedFailure("shutting down", ErrorClass_TRANSIENT, 5*time.Second),
+			expectedResp: getClassifiedResponse(testSequenceNum, Status_FAILURE, nil,
+				ErrorClass_TRANSIENT, 5000),
+		},
+		"HandleCall fails with hint": {
+			callBytes: getCallBytes(t, testSequenceNum, int32(defaultTestModID),
+				MethodPoolCreate),
+			handleCallErr:   errors.New("HandleCall error"),
+			handleCallClass: ErrorClass_CONFIG,
+			expectedResp: getClassifiedResponse(testSequenceNum, Status_FAILURE, nil,
+				ErrorClass_CONFIG, 0),
+		},
+		"HandleCall reports failure in body with hint": {
+			callBytes: getCallBytes(t, testSequenceNum, int32(defaultTestModID),
+				MethodPoolCreate),
+			handleCallResp:  []byte("unreachable"),
+			handleCallClass: ErrorClass_TRANSIENT,
+			handleCallRetry: 1500 * time.Millisecond,
+			expectedResp: getClassifiedResponse(testSequenceNum, Status_SUCCESS, []byte("unreachable"),
+				ErrorClass_TRANSIENT, 1500),
 		},
 		"HandleCall succeeds": {
 			callBytes: getCallBytes(t, testSequenceNum, int32(defaultTestModID),
@@ -189,6 +227,8 @@ func TestService_ProcessMessage(t *testing.T) {
 			mockMod := newTestModule(defaultTestModID)
 			mockMod.HandleCallErr = tc.handleCallErr
 			mockMod.HandleCallResponse = tc.handleCallResp
+			mockMod.HandleCallErrClass = tc.handleCallClass
+			mockMod.HandleCallRetryAfter = tc.handleCallRetry
 
 			service := NewModuleService(log)
 			service.RegisterModule(mockMod)
@@ -0,0 +1,166 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux
+// +build linux
+
+package drpc
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// parseConstDecls returns the constants declared in the given Go source file,
+// mapped to their literal values. Values that are not integer literals (e.g.
+// cgo references) are mapped to nil.
+func parseConstDecls(t *testing.T, path string) map[string]*int64 {
+	t.Helper()
+
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	consts := make(map[string]*int64)
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				consts[name.Name] = nil
+				if i >= len(vs.Values) {
+					continue
+				}
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.INT {
+					continue
+				}
+				val, err := strconv.ParseInt(lit.Value, 0, 64)
+				if err != nil {
+					t.Fatalf("%s: bad value for %s: %s", path, name.Name, err)
+				}
+				consts[name.Name] = &val
+			}
+		}
+	}
+
+	return consts
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// TestDrpc_StubConstants verifies that the hardcoded module and method IDs
+// used on platforms without the C headers match the values imported from
+// src/include/daos/drpc_modules.h.
+func TestDrpc_StubConstants(t *testing.T) {
+	cgoValues := map[string]int64{
+		"ModuleSecurityAgent":         int64(ModuleSecurityAgent),
+		"ModuleMgmt":                  int64(ModuleMgmt),
+		"ModuleSrv":                   int64(ModuleSrv),
+		"ModuleSecurity":              int64(ModuleSecurity),
+		"MethodRequestCredentials":    int64(MethodRequestCredentials),
+		"MethodPrepShutdown":          int64(MethodPrepShutdown),
+		"MethodPingRank":              int64(MethodPingRank),
+		"MethodSetRank":               int64(MethodSetRank),
+		"MethodSetLogMasks":           int64(MethodSetLogMasks),
+		"MethodGetAttachInfo":         int64(MethodGetAttachInfo),
+		"MethodPoolCreate":            int64(MethodPoolCreate),
+		"MethodPoolDestroy":           int64(MethodPoolDestroy),
+		"MethodPoolEvict":             int64(MethodPoolEvict),
+		"MethodPoolExclude":           int64(MethodPoolExclude),
+		"MethodPoolDrain":             int64(MethodPoolDrain),
+		"MethodPoolReintegrate":       int64(MethodPoolReintegrate),
+		"MethodPoolExtend":            int64(MethodPoolExtend),
+		"MethodBioHealth":             int64(MethodBioHealth),
+		"MethodSetUp":                 int64(MethodSetUp),
+		"MethodSmdDevs":               int64(MethodSmdDevs),
+		"MethodSmdPools":              int64(MethodSmdPools),
+		"MethodPoolGetACL":            int64(MethodPoolGetACL),
+		"MethodPoolOverwriteACL":      int64(MethodPoolOverwriteACL),
+		"MethodPoolUpdateACL":         int64(MethodPoolUpdateACL),
+		"MethodPoolDeleteACL":         int64(MethodPoolDeleteACL),
+		"MethodSetFaultyState":        int64(MethodSetFaultyState),
+		"MethodReplaceStorage":        int64(MethodReplaceStorage),
+		"MethodListContainers":        int64(MethodListContainers),
+		"MethodPoolQuery":             int64(MethodPoolQuery),
+		"MethodPoolQueryTarget":       int64(MethodPoolQueryTarget),
+		"MethodPoolSetProp":           int64(MethodPoolSetProp),
+		"MethodContSetOwner":          int64(MethodContSetOwner),
+		"MethodContCreate":            int64(MethodContCreate),
+		"MethodContDestroy":           int64(MethodContDestroy),
+		"MethodContQuery":             int64(MethodContQuery),
+		"MethodGroupUpdate":           int64(MethodGroupUpdate),
+		"MethodNotifyPoolConnect":     int64(MethodNotifyPoolConnect),
+		"MethodNotifyPoolDisconnect":  int64(MethodNotifyPoolDisconnect),
+		"MethodNotifyExit":            int64(MethodNotifyExit),
+		"MethodPoolGetProp":           int64(MethodPoolGetProp),
+		"MethodCheckerStart":          int64(MethodCheckerStart),
+		"MethodCheckerStop":           int64(MethodCheckerStop),
+		"MethodCheckerQuery":          int64(MethodCheckerQuery),
+		"MethodCheckerProp":           int64(MethodCheckerProp),
+		"MethodCheckerAction":         int64(MethodCheckerAction),
+		"MethodPoolUpgrade":           int64(MethodPoolUpgrade),
+		"MethodPoolRebalance":         int64(MethodPoolRebalance),
+		"MethodPoolListHandles":       int64(MethodPoolListHandles),
+		"MethodLedManage":             int64(MethodLedManage),
+		"MethodSetupClientTelemetry":  int64(MethodSetupClientTelemetry),
+		"MethodListClients":           int64(MethodListClients),
+		"MethodQueryClientTelemetry":  int64(MethodQueryClientTelemetry),
+		"MethodFaultInject":           int64(MethodFaultInject),
+		"MethodNotifyReady":           int64(MethodNotifyReady),
+		"MethodGetPoolServiceRanks":   int64(MethodGetPoolServiceRanks),
+		"MethodPoolFindByLabel":       int64(MethodPoolFindByLabel),
+		"MethodClusterEvent":          int64(MethodClusterEvent),
+		"MethodCheckerListPools":      int64(MethodCheckerListPools),
+		"MethodCheckerRegisterPool":   int64(MethodCheckerRegisterPool),
+		"MethodCheckerDeregisterPool": int64(MethodCheckerDeregisterPool),
+		"MethodCheckerReport":         int64(MethodCheckerReport),
+		"MethodListPools":             int64(MethodListPools),
+		"MethodValidateCredentials":   int64(MethodValidateCredentials),
+		"numSecAgentMethods":          int64(numSecAgentMethods),
+		"numMgmtMethods":              int64(numMgmtMethods),
+		"numSrvMethods":               int64(numSrvMethods),
+		"numSecMethods":               int64(numSecMethods),
+	}
+
+	linuxConsts := parseConstDecls(t, "modules_linux.go")
+	if diff := cmp.Diff(sortedKeys(linuxConsts), sortedKeys(cgoValues)); diff != "" {
+		t.Fatalf("constants in modules_linux.go not covered by this test (-linux, +test):\n%s", diff)
+	}
+
+	stubConsts := parseConstDecls(t, "modules_stubs.go")
+	if diff := cmp.Diff(sortedKeys(linuxConsts), sortedKeys(stubConsts)); diff != "" {
+		t.Fatalf("modules_stubs.go does not declare the same constants (-linux, +stubs):\n%s", diff)
+	}
+
+	for _, name := range sortedKeys(cgoValues) {
+		stubVal := stubConsts[name]
+		if stubVal == nil {
+			t.Errorf("%s: no integer value in modules_stubs.go", name)
+			continue
+		}
+		if *stubVal != cgoValues[name] {
+			t.Errorf("%s: modules_stubs.go has %d, C header has %d", name, *stubVal,
+				cgoValues[name])
+		}
+	}
+}
@@ -0,0 +1,161 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+// This file defines the DAOS dRPC module/method IDs for platforms where the
+// C headers cannot be imported. The values must be kept in sync with
+// src/include/daos/drpc_modules.h.
+
+package drpc
+
+const (
+	// ModuleSecurityAgent is the dRPC module for security tasks in DAOS agent
+	ModuleSecurityAgent ModuleID = 1
+	// ModuleMgmt is the dRPC module for management service tasks
+	ModuleMgmt ModuleID = 2
+	// ModuleSrv is the dRPC module for tasks relating to server setup
+	ModuleSrv ModuleID = 3
+	// ModuleSecurity is the dRPC module for security tasks in DAOS server
+	ModuleSecurity ModuleID = 4
+)
+
+const (
+	// MethodRequestCredentials is a ModuleSecurityAgent method
+	MethodRequestCredentials securityAgentMethod = 101
+)
+
+const (
+	// MethodPrepShutdown is a ModuleMgmt method
+	MethodPrepShutdown MgmtMethod = 218
+	// MethodPingRank is a ModuleMgmt method
+	MethodPingRank MgmtMethod = 225
+	// MethodSetRank is a ModuleMgmt method
+	MethodSetRank MgmtMethod = 202
+	// MethodSetLogMasks is a ModuleMgmt method
+	MethodSetLogMasks MgmtMethod = 238
+	// MethodGetAttachInfo is a ModuleMgmt method
+	MethodGetAttachInfo MgmtMethod = 206
+	// MethodPoolCreate is a ModuleMgmt method
+	MethodPoolCreate MgmtMethod = 207
+	// MethodPoolDestroy is a ModuleMgmt method
+	MethodPoolDestroy MgmtMethod = 208
+	// MethodPoolEvict is a ModuleMgmt method to evict pool connections
+	MethodPoolEvict MgmtMethod = 230
+	// MethodPoolExclude is a ModuleMgmt method for excluding pool ranks
+	MethodPoolExclude MgmtMethod = 228
+	// MethodPoolDrain is a ModuleMgmt method for draining pool ranks
+	MethodPoolDrain MgmtMethod = 231
+	// MethodPoolReintegrate is a ModuleMgmt method for reintegrating pool ranks
+	MethodPoolReintegrate MgmtMethod = 226
+	// MethodPoolExtend is a ModuleMgmt method for extending pool
+	MethodPoolExtend MgmtMethod = 229
+	// MethodBioHealth is a ModuleMgmt method
+	MethodBioHealth MgmtMethod = 210
+	// MethodSetUp is a ModuleMgmt method
+	MethodSetUp MgmtMethod = 209
+	// MethodSmdDevs is a ModuleMgmt method
+	MethodSmdDevs MgmtMethod = 211
+	// MethodSmdPools is a ModuleMgmt method
+	MethodSmdPools MgmtMethod = 212
+	// MethodPoolGetACL is a ModuleMgmt method
+	MethodPoolGetACL MgmtMethod = 213
+	// MethodPoolOverwriteACL is a ModuleMgmt method
+	MethodPoolOverwriteACL MgmtMethod = 215
+	// MethodPoolUpdateACL is a ModuleMgmt method
+	MethodPoolUpdateACL MgmtMethod = 216
+	// MethodPoolDeleteACL is a ModuleMgmt method
+	MethodPoolDeleteACL MgmtMethod = 217
+	// MethodSetFaultyState is a ModuleMgmt method
+	MethodSetFaultyState MgmtMethod = 220
+	// MethodReplaceStorage is a ModuleMgmt method
+	MethodReplaceStorage MgmtMethod = 221
+	// MethodListContainers is a ModuleMgmt method
+	MethodListContainers MgmtMethod = 222
+	// MethodPoolQuery defines a method for querying a pool
+	MethodPoolQuery MgmtMethod = 223
+	// MethodPoolQueryTarget defines a method for querying a pool engine's targets
+	MethodPoolQueryTarget MgmtMethod = 240
+	// MethodPoolSetProp defines a method for setting a pool property
+	MethodPoolSetProp MgmtMethod = 224
+	// MethodContSetOwner defines a method for setting the container's owner
+	MethodContSetOwner MgmtMethod = 227
+	// MethodContCreate defines a method for creating a container
+	MethodContCreate MgmtMethod = 248
+	// MethodContDestroy defines a method for destroying a container
+	MethodContDestroy MgmtMethod = 249
+	// MethodContQuery defines a method for querying a container
+	MethodContQuery MgmtMethod = 250
+	// MethodGroupUpdate defines a method for updating the group map
+	MethodGroupUpdate MgmtMethod = 232
+	// MethodNotifyPoolConnect defines a method to indicate a successful pool connect call
+	MethodNotifyPoolConnect MgmtMethod = 235
+	// MethodNotifyPoolDisconnect defines a method to indicate a successful pool disconnect call
+	MethodNotifyPoolDisconnect MgmtMethod = 236
+	// MethodNotifyExit defines a method for signaling a clean client shutdown
+	MethodNotifyExit MgmtMethod = 233
+	// MethodPoolGetProp defines a method for getting pool properties
+	MethodPoolGetProp MgmtMethod = 237
+	// MethodCheckerStart defines a method for starting the checker
+	MethodCheckerStart MgmtMethod = 242
+	// MethodCheckerStop defines a method for stopping the checker
+	MethodCheckerStop MgmtMethod = 243
+	// MethodCheckerQuery defines a method for getting the checker status
+	MethodCheckerQuery MgmtMethod = 244
+	// MethodCheckerProp defines a method for getting the checker properties
+	MethodCheckerProp MgmtMethod = 245
+	// MethodCheckerAction defines a method for specifying a checker action
+	MethodCheckerAction MgmtMethod = 246
+	// MethodPoolUpgrade defines a method for upgrade pool
+	MethodPoolUpgrade MgmtMethod = 239
+	// MethodPoolRebalance defines a method for redistributing pool data across all targets
+	MethodPoolRebalance MgmtMethod = 251
+	// MethodPoolListHandles defines a method for listing the open handles of a pool
+	MethodPoolListHandles MgmtMethod = 252
+	// MethodLedManage defines a method to manage a VMD device LED state
+	MethodLedManage MgmtMethod = 241
+	// MethodSetupClientTelemetry defines a method to setup client telemetry
+	MethodSetupClientTelemetry MgmtMethod = 247
+	// MethodListClients defines a method to list the client processes known to the agent
+	MethodListClients MgmtMethod = 253
+	// MethodQueryClientTelemetry defines a method to query the client telemetry retained by the agent
+	MethodQueryClientTelemetry MgmtMethod = 254
+	// MethodFaultInject defines a method to set a fault injection point on an engine
+	MethodFaultInject MgmtMethod = 255
+)
+
+const (
+	// MethodNotifyReady is a ModuleSrv method
+	MethodNotifyReady srvMethod = 301
+	// MethodGetPoolServiceRanks requests the service ranks for a pool
+	MethodGetPoolServiceRanks srvMethod = 303
+	// MethodPoolFindByLabel requests the service ranks and UUID for a pool
+	MethodPoolFindByLabel srvMethod = 305
+	// MethodClusterEvent notifies of a cluster event in the I/O Engine.
+	MethodClusterEvent srvMethod = 304
+	// MethodCheckerListPools requests the list of pools from the MS
+	MethodCheckerListPools srvMethod = 306 // TODO (DAOS-16126): Merge with MethodListPools
+	// MethodCheckerRegisterPool registers a pool with the MS
+	MethodCheckerRegisterPool srvMethod = 307
+	// MethodCheckerDeregisterPool deregisters a pool with the MS
+	MethodCheckerDeregisterPool srvMethod = 308
+	// MethodCheckerReport reports a checker finding to the MS
+	MethodCheckerReport srvMethod = 309
+	// MethodListPools requests the list of pools in the system
+	MethodListPools srvMethod = 310
+)
+
+const (
+	// MethodValidateCredentials is a ModuleSecurity method
+	MethodValidateCredentials securityMethod = 401
+)
+
+const (
+	numSecAgentMethods = 102
+	numMgmtMethods     = 256
+	numSrvMethods      = 311
+	numSecMethods      = 402
+)
@@ -0,0 +1,26 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewFabricIfaceDownEvent creates a FabricIfaceDown event for a fabric
+// interface that has been detected as down by the agent. The interface name is
+// set as the hardware ID of the event.
+func NewFabricIfaceDownEvent(iface string, numaNode int) *RASEvent {
+	return fill(&RASEvent{
+		Msg:      fmt.Sprintf("Fabric interface %s on NUMA node %d is down", iface, numaNode),
+		ID:       RASFabricIfaceDown,
+		Rank:     math.MaxUint32,
+		HWID:     iface,
+		Type:     RASTypeInfoOnly,
+		Severity: RASSeverityWarning,
+	})
+}
@@ -0,0 +1,38 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvents_ConvertFabricIfaceDown(t *testing.T) {
+	event := NewFabricIfaceDownEvent("ib0", 1)
+
+	if event.HWID != "ib0" {
+		t.Fatalf("unexpected hardware ID %q", event.HWID)
+	}
+	if event.Msg != "Fabric interface ib0 on NUMA node 1 is down" {
+		t.Fatalf("unexpected message %q", event.Msg)
+	}
+
+	pbEvent, err := event.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	returnedEvent := new(RASEvent)
+	if err := returnedEvent.FromProto(pbEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+	}
+}
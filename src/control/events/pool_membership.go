@@ -0,0 +1,28 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewPoolMembershipChangedEvent creates a PoolMembershipChanged event for a
+// pool whose target membership has been changed by the given operation, e.g.
+// an extend or a completed rebuild. The operation is set as the control
+// operation of the event.
+func NewPoolMembershipChangedEvent(poolUUID, op string) *RASEvent {
+	return fill(&RASEvent{
+		Msg:      fmt.Sprintf("DAOS pool target membership changed by %s", op),
+		ID:       RASPoolMembershipChanged,
+		Rank:     math.MaxUint32,
+		PoolUUID: poolUUID,
+		CtlOp:    op,
+		Type:     RASTypeInfoOnly,
+		Severity: RASSeverityNotice,
+	})
+}
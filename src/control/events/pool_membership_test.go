@@ -0,0 +1,40 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestEvents_ConvertPoolMembershipChanged(t *testing.T) {
+	event := NewPoolMembershipChangedEvent(test.MockUUID(1), "extend")
+
+	if event.PoolUUID != test.MockUUID(1) {
+		t.Fatalf("unexpected pool UUID %q", event.PoolUUID)
+	}
+	if event.CtlOp != "extend" {
+		t.Fatalf("unexpected control operation %q", event.CtlOp)
+	}
+
+	pbEvent, err := event.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	returnedEvent := new(RASEvent)
+	if err := returnedEvent.FromProto(pbEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+	}
+}
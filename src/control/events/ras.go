@@ -1,20 +1,15 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
 
 package events
 
-/*
-#include "daos_srv/ras.h"
-*/
-import "C"
-
 import (
 	"encoding/json"
 	"fmt"
-	"log/syslog"
 	"os"
 	"strings"
 	"time"
@@ -42,27 +37,6 @@ func NewFromProto(pbEvt *sharedpb.RASEvent) (*RASEvent, error) {
 // RASID identifies a given RAS event.
 type RASID uint32
 
-// RASID constant definitions matching those used when creating events either in
-// the control or data (engine) planes.
-const (
-	RASUnknownEvent            RASID = C.RAS_UNKNOWN_EVENT
-	RASEngineFormatRequired    RASID = C.RAS_ENGINE_FORMAT_REQUIRED     // notice
-	RASEngineDied              RASID = C.RAS_ENGINE_DIED                // error
-	RASPoolRepsUpdate          RASID = C.RAS_POOL_REPS_UPDATE           // info
-	RASSwimRankAlive           RASID = C.RAS_SWIM_RANK_ALIVE            // info
-	RASSwimRankDead            RASID = C.RAS_SWIM_RANK_DEAD             // info
-	RASSystemStartFailed       RASID = C.RAS_SYSTEM_START_FAILED        // error
-	RASSystemStopFailed        RASID = C.RAS_SYSTEM_STOP_FAILED         // error
-	RASEngineJoinFailed        RASID = C.RAS_ENGINE_JOIN_FAILED         // error
-	RASSystemFabricProvChanged RASID = C.RAS_SYSTEM_FABRIC_PROV_CHANGED // info
-	RASNVMeLinkSpeedChanged    RASID = C.RAS_DEVICE_LINK_SPEED_CHANGED  // warning|notice
-	RASNVMeLinkWidthChanged    RASID = C.RAS_DEVICE_LINK_WIDTH_CHANGED  // warning|notice
-)
-
-func (id RASID) String() string {
-	return C.GoString(C.ras_event2str(C.ras_event_t(id)))
-}
-
 // Uint32 returns uint32 representation of event ID.
 func (id RASID) Uint32() uint32 {
 	return uint32(id)
@@ -71,17 +45,6 @@ func (id RASID) Uint32() uint32 {
 // RASTypeID identifies the type of a given RAS event.
 type RASTypeID uint32
 
-// RASTypeID constant definitions.
-const (
-	RASTypeAny         RASTypeID = C.RAS_TYPE_ANY
-	RASTypeStateChange RASTypeID = C.RAS_TYPE_STATE_CHANGE
-	RASTypeInfoOnly    RASTypeID = C.RAS_TYPE_INFO
-)
-
-func (typ RASTypeID) String() string {
-	return C.GoString(C.ras_type2str(C.ras_type_t(typ)))
-}
-
 // Uint32 returns uint32 representation of event type.
 func (typ RASTypeID) Uint32() uint32 {
 	return uint32(typ)
@@ -90,34 +53,11 @@ func (typ RASTypeID) Uint32() uint32 {
 // RASSeverityID identifies the severity of a given RAS event.
 type RASSeverityID uint32
 
-// RASSeverityID constant definitions.
-const (
-	RASSeverityUnknown RASSeverityID = C.RAS_SEV_UNKNOWN
-	RASSeverityError   RASSeverityID = C.RAS_SEV_ERROR
-	RASSeverityWarning RASSeverityID = C.RAS_SEV_WARNING
-	RASSeverityNotice  RASSeverityID = C.RAS_SEV_NOTICE
-)
-
-func (sev RASSeverityID) String() string {
-	return C.GoString(C.ras_sev2str(C.ras_sev_t(sev)))
-}
-
 // Uint32 returns uint32 representation of event severity.
 func (sev RASSeverityID) Uint32() uint32 {
 	return uint32(sev)
 }
 
-// SyslogPriority maps RAS severity to syslog package priority.
-func (sev RASSeverityID) SyslogPriority() syslog.Priority {
-	slSev := map[RASSeverityID]syslog.Priority{
-		RASSeverityError:   syslog.LOG_ERR,
-		RASSeverityWarning: syslog.LOG_WARNING,
-		RASSeverityNotice:  syslog.LOG_NOTICE,
-	}[sev]
-
-	return slSev | syslog.LOG_DAEMON
-}
-
 // RASEvent describes details of a specific RAS event.
 type RASEvent struct {
 	ID           RASID           `json:"id"`
@@ -341,7 +281,7 @@ func (evt *RASEvent) PrintRAS() string {
 	if evt.HWID != "" {
 		fmt.Fprintf(&b, " hwid: [%s]", evt.HWID)
 	}
-	if evt.Rank != C.CRT_NO_RANK {
+	if evt.Rank != rasNoRank {
 		fmt.Fprintf(&b, " rank: [%d]", evt.Rank)
 	}
 	if evt.JobID != "" {
@@ -0,0 +1,64 @@
+//
+// (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+/*
+#include "daos_srv/ras.h"
+*/
+import "C"
+
+const rasNoRank = C.CRT_NO_RANK
+
+// RASID constant definitions matching those used when creating events either in
+// the control or data (engine) planes.
+const (
+	RASUnknownEvent            RASID = C.RAS_UNKNOWN_EVENT
+	RASEngineFormatRequired    RASID = C.RAS_ENGINE_FORMAT_REQUIRED     // notice
+	RASEngineDied              RASID = C.RAS_ENGINE_DIED                // error
+	RASPoolRebuildEnd          RASID = C.RAS_POOL_REBUILD_END           // notice
+	RASPoolRepsUpdate          RASID = C.RAS_POOL_REPS_UPDATE           // info
+	RASSwimRankAlive           RASID = C.RAS_SWIM_RANK_ALIVE            // info
+	RASSwimRankDead            RASID = C.RAS_SWIM_RANK_DEAD             // info
+	RASSystemStartFailed       RASID = C.RAS_SYSTEM_START_FAILED        // error
+	RASSystemStopFailed        RASID = C.RAS_SYSTEM_STOP_FAILED         // error
+	RASEngineJoinFailed        RASID = C.RAS_ENGINE_JOIN_FAILED         // error
+	RASSystemFabricProvChanged RASID = C.RAS_SYSTEM_FABRIC_PROV_CHANGED // info
+	RASNVMeLinkSpeedChanged    RASID = C.RAS_DEVICE_LINK_SPEED_CHANGED  // warning|notice
+	RASNVMeLinkWidthChanged    RASID = C.RAS_DEVICE_LINK_WIDTH_CHANGED  // warning|notice
+	RASDeviceSetFaulty         RASID = C.RAS_DEVICE_SET_FAULTY          // notice
+	RASFabricIfaceDown         RASID = C.RAS_FABRIC_IFACE_DOWN          // warning
+	RASPoolMembershipChanged   RASID = C.RAS_POOL_MEMBERSHIP_CHANGED    // notice
+	RASSystemMembershipChanged RASID = C.RAS_SYSTEM_MEMBERSHIP_CHANGED  // notice
+)
+
+func (id RASID) String() string {
+	return C.GoString(C.ras_event2str(C.ras_event_t(id)))
+}
+
+// RASTypeID constant definitions.
+const (
+	RASTypeAny         RASTypeID = C.RAS_TYPE_ANY
+	RASTypeStateChange RASTypeID = C.RAS_TYPE_STATE_CHANGE
+	RASTypeInfoOnly    RASTypeID = C.RAS_TYPE_INFO
+)
+
+func (typ RASTypeID) String() string {
+	return C.GoString(C.ras_type2str(C.ras_type_t(typ)))
+}
+
+// RASSeverityID constant definitions.
+const (
+	RASSeverityUnknown RASSeverityID = C.RAS_SEV_UNKNOWN
+	RASSeverityError   RASSeverityID = C.RAS_SEV_ERROR
+	RASSeverityWarning RASSeverityID = C.RAS_SEV_WARNING
+	RASSeverityNotice  RASSeverityID = C.RAS_SEV_NOTICE
+)
+
+func (sev RASSeverityID) String() string {
+	return C.GoString(C.ras_sev2str(C.ras_sev_t(sev)))
+}
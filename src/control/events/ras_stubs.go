@@ -0,0 +1,109 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package events
+
+// The values below mirror those in src/include/daos_srv/ras.h, which can only
+// be included on Linux.
+
+const rasNoRank = 0xFFFFFFFF // CRT_NO_RANK
+
+// RASID constant definitions matching those used when creating events either in
+// the control or data (engine) planes.
+const (
+	RASUnknownEvent            RASID = 0  // RAS_UNKNOWN_EVENT
+	RASEngineFormatRequired    RASID = 1  // notice
+	RASEngineDied              RASID = 2  // error
+	RASPoolRebuildEnd          RASID = 7  // notice
+	RASPoolRepsUpdate          RASID = 9  // info
+	RASSwimRankAlive           RASID = 14 // info
+	RASSwimRankDead            RASID = 15 // info
+	RASSystemStartFailed       RASID = 16 // error
+	RASSystemStopFailed        RASID = 17 // error
+	RASDeviceSetFaulty         RASID = 18 // notice
+	RASSystemFabricProvChanged RASID = 23 // info
+	RASEngineJoinFailed        RASID = 24 // error
+	RASNVMeLinkSpeedChanged    RASID = 25 // warning|notice
+	RASNVMeLinkWidthChanged    RASID = 26 // warning|notice
+	RASFabricIfaceDown         RASID = 27 // warning
+	RASPoolMembershipChanged   RASID = 28 // notice
+	RASSystemMembershipChanged RASID = 29 // notice
+)
+
+var rasEventNames = []string{
+	"unknown_ras_event",
+	"engine_format_required",
+	"engine_died",
+	"engine_asserted",
+	"engine_clock_drift",
+	"pool_corruption_detected",
+	"pool_rebuild_started",
+	"pool_rebuild_finished",
+	"pool_rebuild_failed",
+	"pool_replicas_updated",
+	"pool_durable_format_incompatible",
+	"pool_destroy_deferred",
+	"container_durable_format_incompatible",
+	"rdb_durable_format_incompatible",
+	"swim_rank_alive",
+	"swim_rank_dead",
+	"system_start_failed",
+	"system_stop_failed",
+	"device_set_faulty",
+	"device_media_error",
+	"device_unplugged",
+	"device_plugged",
+	"device_replace",
+	"system_fabric_provider_changed",
+	"engine_join_failed",
+	"device_link_speed_changed",
+	"device_link_width_changed",
+	"fabric_interface_down",
+	"pool_membership_changed",
+	"system_membership_changed",
+}
+
+func (id RASID) String() string {
+	if int(id) >= len(rasEventNames) {
+		return "unknown_unknown"
+	}
+	return rasEventNames[id]
+}
+
+// RASTypeID constant definitions.
+const (
+	RASTypeAny         RASTypeID = 0
+	RASTypeStateChange RASTypeID = 1
+	RASTypeInfoOnly    RASTypeID = 2
+)
+
+func (typ RASTypeID) String() string {
+	if typ == RASTypeStateChange {
+		return "STATE_CHANGE"
+	}
+	return "INFO"
+}
+
+// RASSeverityID constant definitions.
+const (
+	RASSeverityUnknown RASSeverityID = 0
+	RASSeverityError   RASSeverityID = 1
+	RASSeverityWarning RASSeverityID = 2
+	RASSeverityNotice  RASSeverityID = 3
+)
+
+func (sev RASSeverityID) String() string {
+	switch sev {
+	case RASSeverityError:
+		return "ERROR"
+	case RASSeverityWarning:
+		return "WARNING"
+	default:
+		return "NOTICE"
+	}
+}
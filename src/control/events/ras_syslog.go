@@ -0,0 +1,23 @@
+//
+// (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !windows
+// +build !windows
+
+package events
+
+import "log/syslog"
+
+// SyslogPriority maps RAS severity to syslog package priority.
+func (sev RASSeverityID) SyslogPriority() syslog.Priority {
+	slSev := map[RASSeverityID]syslog.Priority{
+		RASSeverityError:   syslog.LOG_ERR,
+		RASSeverityWarning: syslog.LOG_WARNING,
+		RASSeverityNotice:  syslog.LOG_NOTICE,
+	}[sev]
+
+	return slSev | syslog.LOG_DAEMON
+}
@@ -0,0 +1,24 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import "fmt"
+
+// NewSystemMembershipChangedEvent creates a SystemMembershipChanged event for
+// a rank whose system membership has been changed by the given operation, e.g.
+// a join or an exclude. The operation is set as the control operation of the
+// event.
+func NewSystemMembershipChangedEvent(rank uint32, op string) *RASEvent {
+	return fill(&RASEvent{
+		Msg:      fmt.Sprintf("DAOS rank %d system membership changed by %s", rank, op),
+		ID:       RASSystemMembershipChanged,
+		Rank:     rank,
+		CtlOp:    op,
+		Type:     RASTypeInfoOnly,
+		Severity: RASSeverityNotice,
+	})
+}
@@ -0,0 +1,38 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvents_ConvertSystemMembershipChanged(t *testing.T) {
+	event := NewSystemMembershipChangedEvent(3, "exclude")
+
+	if event.Rank != 3 {
+		t.Fatalf("unexpected rank %d", event.Rank)
+	}
+	if event.CtlOp != "exclude" {
+		t.Fatalf("unexpected control operation %q", event.CtlOp)
+	}
+
+	pbEvent, err := event.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	returnedEvent := new(RASEvent)
+	if err := returnedEvent.FromProto(pbEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+	}
+}
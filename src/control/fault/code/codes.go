@@ -117,6 +117,7 @@ const (
 	SystemBadFaultDomainDepth
 	SystemPoolLocked
 	SystemJoinReplaceRankNotFound
+	SystemLocked
 )
 
 // client fault codes
@@ -133,6 +134,8 @@ const (
 	ClientFormatRunningSystem
 	ClientRpcTimeout
 	ClientConfigVMDImbalance
+	ClientAPIIncompatible
+	ClientRpcMsgTooLarge
 )
 
 // server fault codes
@@ -161,6 +164,9 @@ const (
 	ServerBadFaultDomainLabels
 	ServerJoinReplaceEnabledPoolRank
 	ServerRankAdminExcluded
+	ServerPoolInsufficientCapacity
+	ServerPoolDestroyProtected
+	ServerPoolPolicyDenied
 )
 
 // server config fault codes
@@ -200,6 +206,10 @@ const (
 	ServerConfigScmDiffClass
 	ServerConfigEngineBdevRolesMismatch
 	ServerConfigSysRsvdZero
+	ServerConfigFaultProviderUnknown
+	ServerConfigFaultProviderFailed
+	ServerConfigFaultDomainSourceConflict
+	ServerConfigBadTelemetryOTLPEndpoint
 )
 
 // SPDK library bindings codes
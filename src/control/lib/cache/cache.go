@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -38,6 +39,14 @@ type (
 		RefreshIfNeeded(ctx context.Context) (bool, error)
 	}
 
+	// DependentItem is an Item whose contents are derived from those of other
+	// cached items, identified by their keys. When an item is deleted, replaced,
+	// expires or is refreshed, the items that depend on it are invalidated.
+	DependentItem interface {
+		Item
+		DependsOn() []string
+	}
+
 	// ItemCache is a mechanism for caching Items to keys.
 	ItemCache struct {
 		log   logging.Logger
@@ -68,15 +77,98 @@ func (ic *ItemCache) Set(item Item) error {
 	ic.mutex.Lock()
 	defer ic.mutex.Unlock()
 
+	if err := ic.checkDependencies(item); err != nil {
+		return err
+	}
+
 	ic.set(item)
 	return nil
 }
 
+// set caches the item, invalidating any items that depend on an item
+// previously cached under the same key.
 func (ic *ItemCache) set(item Item) {
+	ic.invalidateDependents(item.Key())
 	ic.items[item.Key()] = item
 }
 
-// Delete fully deletes an Item from the cache.
+// checkDependencies returns an error if caching the item would introduce a
+// dependency cycle.
+func (ic *ItemCache) checkDependencies(item Item) error {
+	di, ok := item.(DependentItem)
+	if !ok {
+		return nil
+	}
+
+	key := item.Key()
+	seen := make(map[string]struct{})
+	deps := di.DependsOn()
+	for len(deps) > 0 {
+		dep := deps[0]
+		deps = deps[1:]
+
+		if dep == key {
+			return errors.Errorf("item %q has a circular dependency", key)
+		}
+		if _, found := seen[dep]; found {
+			continue
+		}
+		seen[dep] = struct{}{}
+
+		if parent, ok := ic.items[dep].(DependentItem); ok {
+			deps = append(deps, parent.DependsOn()...)
+		}
+	}
+
+	return nil
+}
+
+// dependents returns the sorted keys of the cached items that depend directly
+// on the given key.
+func (ic *ItemCache) dependents(key string) []string {
+	var keys []string
+	for k, item := range ic.items {
+		di, ok := item.(DependentItem)
+		if !ok {
+			continue
+		}
+		for _, dep := range di.DependsOn() {
+			if dep == key {
+				keys = append(keys, k)
+				break
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// invalidateDependents deletes all items that depend directly or indirectly
+// on the given key, except for those with the keys given in keep. The keys of
+// the deleted items are returned.
+func (ic *ItemCache) invalidateDependents(key string, keep ...string) []string {
+	var deleted []string
+	for _, dep := range ic.dependents(key) {
+		if _, found := ic.items[dep]; !found || common.Includes(keep, dep) {
+			continue
+		}
+		if ic.log != nil {
+			ic.log.Debugf("invalidating item %q, which depends on %q", dep, key)
+		}
+		deleted = append(deleted, ic.delete(dep)...)
+	}
+	return deleted
+}
+
+// delete deletes the item and all items that depend on it, returning the keys
+// of the deleted items.
+func (ic *ItemCache) delete(key string) []string {
+	delete(ic.items, key)
+	return append([]string{key}, ic.invalidateDependents(key)...)
+}
+
+// Delete fully deletes an Item from the cache, along with any items that
+// depend on it.
 func (ic *ItemCache) Delete(key string) {
 	if ic == nil {
 		return
@@ -85,7 +177,7 @@ func (ic *ItemCache) Delete(key string) {
 	ic.mutex.Lock()
 	defer ic.mutex.Unlock()
 
-	delete(ic.items, key)
+	ic.delete(key)
 }
 
 // Has checks whether any item is cached under the given key.
@@ -162,23 +254,14 @@ func (ic *ItemCache) GetOrCreate(ctx context.Context, key string, missFn ItemCre
 		if err != nil {
 			return nil, noopRelease, errors.Wrapf(err, "create item for %q", key)
 		}
+		if err := ic.checkDependencies(item); err != nil {
+			return nil, noopRelease, err
+		}
 		ic.log.Debugf("created item for key %q", key)
 		ic.set(item)
 	}
 
-	item.Lock()
-	if ri, ok := item.(RefreshableItem); ok {
-		refreshed, err := ri.RefreshIfNeeded(ctx)
-		if err != nil {
-			item.Unlock()
-			return nil, noopRelease, errors.Wrapf(err, "fetch data for %q", key)
-		}
-		if refreshed {
-			ic.log.Debugf("refreshed item %q", key)
-		}
-	}
-
-	return item, item.Unlock, nil
+	return ic.lockAndRefreshIfNeeded(ctx, item)
 }
 
 // Get returns an item from the cache if it exists, otherwise it returns an
@@ -200,15 +283,23 @@ func (ic *ItemCache) Get(ctx context.Context, key string) (Item, func(), error)
 		return nil, noopRelease, err
 	}
 
+	return ic.lockAndRefreshIfNeeded(ctx, item)
+}
+
+// lockAndRefreshIfNeeded locks the item and refreshes it if it is refreshable
+// and needs to be refreshed, in which case the items that depend on it are
+// invalidated. The item is returned along with the function to release it.
+func (ic *ItemCache) lockAndRefreshIfNeeded(ctx context.Context, item Item) (Item, func(), error) {
 	item.Lock()
 	if ri, ok := item.(RefreshableItem); ok {
 		refreshed, err := ri.RefreshIfNeeded(ctx)
 		if err != nil {
 			item.Unlock()
-			return nil, noopRelease, errors.Wrapf(err, "fetch data for %q", key)
+			return nil, noopRelease, errors.Wrapf(err, "fetch data for %q", item.Key())
 		}
 		if refreshed {
-			ic.log.Debugf("refreshed item %q", key)
+			ic.log.Debugf("refreshed item %q", item.Key())
+			ic.invalidateDependents(item.Key())
 		}
 	}
 
@@ -219,7 +310,7 @@ func (ic *ItemCache) get(key string) (Item, error) {
 	item, ok := ic.items[key]
 	if ok {
 		if ei, ok := item.(ExpirableItem); ok && ei.IsExpired() {
-			delete(ic.items, key)
+			ic.delete(key)
 		} else {
 			return item, nil
 		}
@@ -227,7 +318,10 @@ func (ic *ItemCache) get(key string) (Item, error) {
 	return nil, &errKeyNotFound{key: key}
 }
 
-// Refresh forces a re-fetch of all items in the cache.
+// Refresh forces a re-fetch of the items with the given keys, or of all items
+// in the cache if no keys are given. Items are refreshed after the items that
+// they depend on, and items that depend on a refreshed item but are not being
+// refreshed themselves are invalidated.
 func (ic *ItemCache) Refresh(ctx context.Context, keys ...string) error {
 	if ic == nil {
 		return errors.New("nil ItemCache")
@@ -240,14 +334,51 @@ func (ic *ItemCache) Refresh(ctx context.Context, keys ...string) error {
 		keys = ic.keys()
 	}
 
-	for _, key := range keys {
+	var invalidated []string
+	for _, key := range ic.refreshOrder(keys) {
+		// An item may have been invalidated along with one that it
+		// depends on but that was not being refreshed.
+		if common.Includes(invalidated, key) {
+			continue
+		}
+
 		if err := ic.refreshItem(ctx, key); err != nil {
 			return err
 		}
+		invalidated = append(invalidated, ic.invalidateDependents(key, keys...)...)
 	}
 	return nil
 }
 
+// refreshOrder sorts the keys so that each comes after the keys of the items
+// that it depends on, directly or indirectly. Keys are otherwise kept in the
+// given order.
+func (ic *ItemCache) refreshOrder(keys []string) []string {
+	visited := make(map[string]struct{})
+	ordered := make([]string, 0, len(keys))
+	var visit func(key string)
+	visit = func(key string) {
+		if _, found := visited[key]; found {
+			return
+		}
+		visited[key] = struct{}{}
+
+		if di, ok := ic.items[key].(DependentItem); ok {
+			for _, dep := range di.DependsOn() {
+				visit(dep)
+			}
+		}
+		if common.Includes(keys, key) {
+			ordered = append(ordered, key)
+		}
+	}
+	for _, key := range keys {
+		visit(key)
+	}
+
+	return ordered
+}
+
 func (ic *ItemCache) refreshItem(ctx context.Context, key string) error {
 	item, err := ic.get(key)
 	if err != nil {
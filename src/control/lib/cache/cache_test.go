@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -571,3 +572,198 @@ func TestCache_ItemCache_Delete(t *testing.T) {
 		})
 	}
 }
+
+var _ DependentItem = (*mockDependentItem)(nil)
+
+type mockDependentItem struct {
+	mockItem
+	Deps      []string
+	refreshed *[]string
+}
+
+func (m *mockDependentItem) DependsOn() []string {
+	return m.Deps
+}
+
+func (m *mockDependentItem) Refresh(ctx context.Context) error {
+	if m.refreshed != nil {
+		*m.refreshed = append(*m.refreshed, m.ItemKey)
+	}
+	return m.RefreshErr
+}
+
+func testDependentItem(key string, deps ...string) *mockDependentItem {
+	return &mockDependentItem{
+		mockItem: mockItem{ItemKey: key},
+		Deps:     deps,
+	}
+}
+
+func TestCache_ItemCache_Dependencies(t *testing.T) {
+	// Attach info depends on the MS identity, and fabric info on the hardware
+	// topology, with a provider-specific item depending on the fabric info.
+	testItems := func() []*mockDependentItem {
+		return []*mockDependentItem{
+			testDependentItem("ms"),
+			testDependentItem("attach", "ms"),
+			testDependentItem("topology"),
+			testDependentItem("fabric", "topology"),
+			testDependentItem("fabric-tcp", "fabric"),
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		setup        func(items []*mockDependentItem)
+		op           func(ctx context.Context, ic *ItemCache) error
+		expErr       error
+		expKeys      []string
+		expRefreshed []string
+	}{
+		"set with circular dependency": {
+			op: func(_ context.Context, ic *ItemCache) error {
+				return ic.Set(testDependentItem("topology", "fabric-tcp"))
+			},
+			expErr:  errors.New("circular dependency"),
+			expKeys: []string{"attach", "fabric", "fabric-tcp", "ms", "topology"},
+		},
+		"set depending on itself": {
+			op: func(_ context.Context, ic *ItemCache) error {
+				return ic.Set(testDependentItem("self", "self"))
+			},
+			expErr:  errors.New("circular dependency"),
+			expKeys: []string{"attach", "fabric", "fabric-tcp", "ms", "topology"},
+		},
+		"set with missing dependency": {
+			op: func(_ context.Context, ic *ItemCache) error {
+				return ic.Set(testDependentItem("pool", "container"))
+			},
+			expKeys: []string{"attach", "fabric", "fabric-tcp", "ms", "pool", "topology"},
+		},
+		"replace invalidates dependents": {
+			op: func(_ context.Context, ic *ItemCache) error {
+				return ic.Set(testDependentItem("topology"))
+			},
+			expKeys: []string{"attach", "ms", "topology"},
+		},
+		"delete invalidates dependents": {
+			op: func(_ context.Context, ic *ItemCache) error {
+				ic.Delete("ms")
+				return nil
+			},
+			expKeys: []string{"fabric", "fabric-tcp", "topology"},
+		},
+		"delete dependent": {
+			op: func(_ context.Context, ic *ItemCache) error {
+				ic.Delete("fabric-tcp")
+				return nil
+			},
+			expKeys: []string{"attach", "fabric", "ms", "topology"},
+		},
+		"get refresh invalidates dependents": {
+			setup: func(items []*mockDependentItem) {
+				items[2].NeedsRefreshResult = true
+			},
+			op: func(ctx context.Context, ic *ItemCache) error {
+				_, release, err := ic.Get(ctx, "topology")
+				release()
+				return err
+			},
+			expKeys: []string{"attach", "ms", "topology"},
+		},
+		"get without refresh": {
+			op: func(ctx context.Context, ic *ItemCache) error {
+				_, release, err := ic.Get(ctx, "topology")
+				release()
+				return err
+			},
+			expKeys: []string{"attach", "fabric", "fabric-tcp", "ms", "topology"},
+		},
+		"create invalidates dependents": {
+			setup: func(items []*mockDependentItem) {
+				items[0].ItemKey = "ms-old"
+			},
+			op: func(ctx context.Context, ic *ItemCache) error {
+				ic.Delete("ms-old")
+				if err := ic.Set(testDependentItem("attach", "ms")); err != nil {
+					return err
+				}
+				_, release, err := ic.GetOrCreate(ctx, "ms", func() (Item, error) {
+					return testDependentItem("ms"), nil
+				})
+				release()
+				return err
+			},
+			expKeys: []string{"fabric", "fabric-tcp", "ms", "topology"},
+		},
+		"refresh parent invalidates dependents": {
+			op: func(ctx context.Context, ic *ItemCache) error {
+				return ic.Refresh(ctx, "topology")
+			},
+			expKeys:      []string{"attach", "ms", "topology"},
+			expRefreshed: []string{"topology"},
+		},
+		"refresh parent and dependent in order": {
+			op: func(ctx context.Context, ic *ItemCache) error {
+				return ic.Refresh(ctx, "fabric-tcp", "fabric", "topology")
+			},
+			expKeys:      []string{"attach", "fabric", "fabric-tcp", "ms", "topology"},
+			expRefreshed: []string{"topology", "fabric", "fabric-tcp"},
+		},
+		"refresh skips invalidated dependent": {
+			op: func(ctx context.Context, ic *ItemCache) error {
+				return ic.Refresh(ctx, "fabric-tcp", "topology")
+			},
+			expKeys:      []string{"attach", "ms", "topology"},
+			expRefreshed: []string{"topology"},
+		},
+		"refresh all": {
+			op: func(ctx context.Context, ic *ItemCache) error {
+				return ic.Refresh(ctx)
+			},
+			expKeys:      []string{"attach", "fabric", "fabric-tcp", "ms", "topology"},
+			expRefreshed: []string{"ms", "attach", "topology", "fabric", "fabric-tcp"},
+		},
+		"refresh fails": {
+			setup: func(items []*mockDependentItem) {
+				items[2].RefreshErr = errors.New("mock refresh")
+			},
+			op: func(ctx context.Context, ic *ItemCache) error {
+				return ic.Refresh(ctx, "fabric", "topology")
+			},
+			expErr:       errors.New("mock refresh"),
+			expKeys:      []string{"attach", "fabric", "fabric-tcp", "ms", "topology"},
+			expRefreshed: []string{"topology"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var refreshed []string
+			items := testItems()
+			for _, item := range items {
+				item.refreshed = &refreshed
+			}
+			if tc.setup != nil {
+				tc.setup(items)
+			}
+
+			ic := NewItemCache(log)
+			for _, item := range items {
+				if err := ic.Set(item); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := tc.op(test.Context(t), ic)
+			test.CmpErr(t, tc.expErr, err)
+
+			if diff := cmp.Diff(tc.expKeys, ic.Keys()); diff != "" {
+				t.Fatalf("unexpected keys (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expRefreshed, refreshed); diff != "" {
+				t.Fatalf("unexpected refreshes (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -109,6 +111,259 @@ func ParseACL(reader io.Reader) (*AccessControlList, error) {
 	return &AccessControlList{Entries: aceList}, nil
 }
 
+const (
+	// aclMaxPrincipalLen is the maximum length of an ACE principal string,
+	// matching DAOS_ACL_MAX_PRINCIPAL_LEN.
+	aclMaxPrincipalLen = 255
+	// aclMaxACELen is the maximum length of an ACE string, matching
+	// DAOS_ACL_MAX_ACE_STR_LEN.
+	aclMaxACELen = aclMaxPrincipalLen + 64
+
+	// Valid characters for each ACE field, in canonical order.
+	aceAccessTypes = "AUL"
+	aceFlags       = "GSFP"
+	acePerms       = "rwcdtTaAo"
+)
+
+// acePrincipalType is the type of principal that an ACE applies to. The
+// values are in the order that entries appear in a DAOS ACL.
+type acePrincipalType int
+
+const (
+	acePrincipalOwner acePrincipalType = iota
+	acePrincipalUser
+	acePrincipalOwnerGroup
+	acePrincipalGroup
+	acePrincipalEveryone
+)
+
+type (
+	// ACLEntryError describes a problem with an entry in an ACL file.
+	ACLEntryError struct {
+		Line  int    `json:"line"`
+		Entry string `json:"entry"`
+		Error string `json:"error"`
+	}
+
+	// ACLValidation contains the results of validating an ACL file,
+	// including the normalized ACL that would be applied from its valid
+	// entries.
+	ACLValidation struct {
+		Errors []*ACLEntryError   `json:"errors"`
+		ACL    *AccessControlList `json:"acl"`
+	}
+
+	normalizedACE struct {
+		str           string
+		principal     string
+		principalType acePrincipalType
+	}
+)
+
+// Valid returns true if no errors were found in the ACL file.
+func (v *ACLValidation) Valid() bool {
+	return v != nil && len(v.Errors) == 0
+}
+
+// checkACEField verifies that each character in an ACE field is in the set
+// of valid characters, and returns the field's characters in canonical order.
+func checkACEField(name, field, valid, explain string) (string, error) {
+	for _, c := range field {
+		if !strings.ContainsRune(valid, c) {
+			return "", errors.Errorf("invalid %s %q (valid: %s)", name, c, explain)
+		}
+	}
+
+	var b strings.Builder
+	for _, c := range valid {
+		if strings.ContainsRune(field, c) {
+			b.WriteRune(c)
+		}
+	}
+	return b.String(), nil
+}
+
+// checkACEPrincipal verifies that the principal is of the form name@[domain].
+func checkACEPrincipal(principal string) error {
+	if principal == "" {
+		return errors.New("missing principal")
+	}
+	if len(principal) > aclMaxPrincipalLen {
+		return errors.Errorf("principal is longer than %d characters", aclMaxPrincipalLen)
+	}
+
+	name, domain, found := strings.Cut(principal, "@")
+	if !found || name == "" || strings.Contains(domain, "@") {
+		return errors.Errorf("invalid principal %q (must be OWNER@, GROUP@, EVERYONE@ "+
+			"or of the form name@[domain])", principal)
+	}
+
+	return nil
+}
+
+// normalizeACE validates an ACE string in the format access-types:flags:principal:perms
+// using the same rules as the DAOS security library, and returns it in canonical form.
+func normalizeACE(ace string) (*normalizedACE, error) {
+	if len(ace) > aclMaxACELen {
+		return nil, errors.Errorf("entry is longer than %d characters", aclMaxACELen)
+	}
+
+	fields := strings.Split(ace, ":")
+	if len(fields) != 4 {
+		return nil, errors.Errorf("entry has %d fields, expected 4 "+
+			"(access-types:flags:principal:permissions)", len(fields))
+	}
+
+	types, err := checkACEField("access type", fields[0], aceAccessTypes,
+		"A=Allow, U=Audit, L=Alarm")
+	if err != nil {
+		return nil, err
+	}
+	if types == "" {
+		return nil, errors.New("no access type (at least one of A, U or L is required)")
+	}
+
+	flags, err := checkACEField("flag", fields[1], aceFlags,
+		"G=Group, S=Access-Success, F=Access-Failure, P=Pool-Inherit")
+	if err != nil {
+		return nil, err
+	}
+
+	principal := fields[2]
+	if err := checkACEPrincipal(principal); err != nil {
+		return nil, err
+	}
+
+	perms, err := checkACEField("permission", fields[3], acePerms,
+		"r=Read, w=Write, c=Create-Cont, d=Destroy-Cont, t=Get-Prop, T=Set-Prop, "+
+			"a=Get-ACL, A=Set-ACL, o=Set-Owner")
+	if err != nil {
+		return nil, err
+	}
+
+	isGroupFlag := strings.Contains(flags, "G")
+	var pType acePrincipalType
+	switch principal {
+	case "OWNER@":
+		pType = acePrincipalOwner
+	case "GROUP@":
+		pType = acePrincipalOwnerGroup
+	case "EVERYONE@":
+		pType = acePrincipalEveryone
+	default:
+		pType = acePrincipalUser
+		if isGroupFlag {
+			pType = acePrincipalGroup
+		}
+	}
+
+	switch {
+	case pType == acePrincipalOwnerGroup && !isGroupFlag:
+		return nil, errors.New("GROUP@ entries require the G (Group) flag")
+	case (pType == acePrincipalOwner || pType == acePrincipalEveryone) && isGroupFlag:
+		return nil, errors.Errorf("%s entries may not have the G (Group) flag", principal)
+	}
+
+	isAlertType := strings.ContainsAny(types, "UL")
+	hasAlertFlag := strings.ContainsAny(flags, "SF")
+	switch {
+	case isAlertType && !hasAlertFlag:
+		return nil, errors.New("Audit (U) and Alarm (L) entries require the " +
+			"S (Access-Success) or F (Access-Failure) flag")
+	case !isAlertType && hasAlertFlag:
+		return nil, errors.New("S (Access-Success) and F (Access-Failure) flags " +
+			"are only valid for Audit (U) and Alarm (L) entries")
+	}
+
+	return &normalizedACE{
+		str:           strings.Join([]string{types, flags, principal, perms}, ":"),
+		principal:     principal,
+		principalType: pType,
+	}, nil
+}
+
+// ValidateACL reads ACL file content from the io.Reader and checks each
+// entry without applying it. All invalid entries are reported, and the
+// valid entries are normalized into the form that DAOS would store them in.
+func ValidateACL(reader io.Reader) (*ACLValidation, error) {
+	result := &ACLValidation{
+		ACL: &AccessControlList{Entries: []string{}},
+	}
+
+	type seenEntry struct {
+		principalType acePrincipalType
+		principal     string
+	}
+	seen := make(map[seenEntry]int)
+	var aces []*normalizedACE
+
+	scanner := bufio.NewScanner(reader)
+	lineNum := 0
+	entries := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || isACLFileComment(line) {
+			continue
+		}
+		entries++
+
+		addErr := func(err error) {
+			result.Errors = append(result.Errors, &ACLEntryError{
+				Line:  lineNum,
+				Entry: line,
+				Error: err.Error(),
+			})
+		}
+
+		ace, err := normalizeACE(line)
+		if err != nil {
+			addErr(err)
+			continue
+		}
+
+		key := seenEntry{ace.principalType, ace.principal}
+		if prevLine, found := seen[key]; found {
+			addErr(errors.Errorf("duplicate entry for principal %s (first defined on line %d)",
+				ace.principal, prevLine))
+			continue
+		}
+		seen[key] = lineNum
+		aces = append(aces, ace)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.WithMessage(err, "reading ACL file")
+	}
+	if entries == 0 {
+		return nil, errors.New("no entries found")
+	}
+
+	sort.SliceStable(aces, func(i, j int) bool {
+		return aces[i].principalType < aces[j].principalType
+	})
+	for _, ace := range aces {
+		result.ACL.Entries = append(result.ACL.Entries, ace.str)
+	}
+
+	return result, nil
+}
+
+// ValidateACLFile validates the ACL file at the given path without applying it.
+func ValidateACLFile(aclFile string) (*ACLValidation, error) {
+	file, err := os.Open(aclFile)
+	if err != nil {
+		return nil, errors.WithMessage(err, "opening ACL file")
+	}
+	defer file.Close()
+
+	result, err := ValidateACL(file)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "ACL file '%s'", aclFile)
+	}
+
+	return result, nil
+}
+
 // FormatACL converts the AccessControlList to a human-readable string.
 func FormatACL(acl *AccessControlList, verbose bool) string {
 	var builder strings.Builder
@@ -0,0 +1,359 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Formats in which an ACL may be exported.
+const (
+	ACLFormatText = "text"
+	ACLFormatJSON = "json"
+	ACLFormatYAML = "yaml"
+)
+
+// Names of the characters of each ACE field in the structured ACL format, in
+// canonical order.
+var (
+	aceAccessTypeNames = []aceFieldName{
+		{'A', "Allow"}, {'U', "Audit"}, {'L', "Alarm"},
+	}
+	aceFlagNames = []aceFieldName{
+		{'G', "Group"}, {'S', "Access-Success"}, {'F', "Access-Failure"}, {'P', "Pool-Inherit"},
+	}
+	acePermNames = []aceFieldName{
+		{'r', "Read"}, {'w', "Write"}, {'c', "Create-Cont"}, {'d', "Destroy-Cont"},
+		{'t', "Get-Prop"}, {'T', "Set-Prop"}, {'a', "Get-ACL"}, {'A', "Set-ACL"},
+		{'o', "Set-Owner"},
+	}
+)
+
+type (
+	aceFieldName struct {
+		char rune
+		name string
+	}
+
+	// ACLEntry is an Access Control Entry in the structured ACL format. The
+	// access types default to Allow if none are given.
+	ACLEntry struct {
+		Principal   string   `json:"principal" yaml:"principal"`
+		AccessTypes []string `json:"access_types,omitempty" yaml:"access_types,omitempty"`
+		Flags       []string `json:"flags,omitempty" yaml:"flags,omitempty"`
+		Permissions []string `json:"permissions" yaml:"permissions"`
+	}
+
+	// StructuredACL is an Access Control List in the structured format used
+	// to import and export ACLs as JSON or YAML documents.
+	StructuredACL struct {
+		Owner      string      `json:"owner_user,omitempty" yaml:"owner_user,omitempty"`
+		OwnerGroup string      `json:"owner_group,omitempty" yaml:"owner_group,omitempty"`
+		Entries    []*ACLEntry `json:"entries" yaml:"entries"`
+	}
+
+	// ACLEntryChange describes an entry which is replaced by an ACL update.
+	ACLEntryChange struct {
+		Old string `json:"old"`
+		New string `json:"new"`
+	}
+
+	// ACLDiff describes the changes that an ACL update would make to an
+	// existing ACL.
+	ACLDiff struct {
+		Added     []string          `json:"added"`
+		Changed   []*ACLEntryChange `json:"changed"`
+		Unchanged []string          `json:"unchanged"`
+	}
+)
+
+// HasChanges returns true if the ACL update would modify the ACL.
+func (d *ACLDiff) HasChanges() bool {
+	return d != nil && (len(d.Added) > 0 || len(d.Changed) > 0)
+}
+
+// fieldNames converts the characters of an ACE field to their names.
+func fieldNames(field string, names []aceFieldName) []string {
+	var out []string
+	for _, fn := range names {
+		if strings.ContainsRune(field, fn.char) {
+			out = append(out, fn.name)
+		}
+	}
+	return out
+}
+
+// fieldChars converts the names of the characters of an ACE field, which are
+// case-insensitive, to the field string.
+func fieldChars(kind string, values []string, names []aceFieldName) (string, error) {
+	var b strings.Builder
+	for _, val := range values {
+		found := false
+		for _, fn := range names {
+			if strings.EqualFold(val, fn.name) {
+				b.WriteRune(fn.char)
+				found = true
+				break
+			}
+		}
+		if !found {
+			valid := make([]string, 0, len(names))
+			for _, fn := range names {
+				valid = append(valid, fn.name)
+			}
+			return "", errors.Errorf("invalid %s %q (valid: %s)", kind, val,
+				strings.Join(valid, ", "))
+		}
+	}
+	return b.String(), nil
+}
+
+// ace returns the entry in the short ACE string format, without checking the
+// validity of the resulting ACE.
+func (e *ACLEntry) ace() (string, error) {
+	if strings.ContainsAny(e.Principal, "\r\n") {
+		return "", errors.Errorf("invalid principal %q", e.Principal)
+	}
+
+	types := "A"
+	if len(e.AccessTypes) > 0 {
+		var err error
+		if types, err = fieldChars("access type", e.AccessTypes, aceAccessTypeNames); err != nil {
+			return "", err
+		}
+	}
+	flags, err := fieldChars("flag", e.Flags, aceFlagNames)
+	if err != nil {
+		return "", err
+	}
+	perms, err := fieldChars("permission", e.Permissions, acePermNames)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{types, flags, e.Principal, perms}, ":"), nil
+}
+
+// NewStructuredACL converts the AccessControlList to the structured format.
+func NewStructuredACL(acl *AccessControlList) (*StructuredACL, error) {
+	sa := &StructuredACL{Entries: []*ACLEntry{}}
+	if acl == nil {
+		return sa, nil
+	}
+	sa.Owner = acl.Owner
+	sa.OwnerGroup = acl.OwnerGroup
+
+	for _, str := range acl.Entries {
+		ace, err := normalizeACE(str)
+		if err != nil {
+			return nil, errors.Wrapf(err, "entry %q", str)
+		}
+		fields := strings.Split(ace.str, ":")
+		sa.Entries = append(sa.Entries, &ACLEntry{
+			Principal:   ace.principal,
+			AccessTypes: fieldNames(fields[0], aceAccessTypeNames),
+			Flags:       fieldNames(fields[1], aceFlagNames),
+			Permissions: fieldNames(fields[3], acePermNames),
+		})
+	}
+
+	return sa, nil
+}
+
+// Validate checks the entries of the structured ACL using the same rules as
+// ValidateACL. The line numbers in the result refer to the entry numbers,
+// starting from 1.
+func (sa *StructuredACL) Validate() (*ACLValidation, error) {
+	if sa == nil || len(sa.Entries) == 0 {
+		return nil, errors.New("no entries found")
+	}
+
+	result := &ACLValidation{
+		ACL: &AccessControlList{Entries: []string{}},
+	}
+
+	// Entries whose names are invalid are reported directly, and the rest
+	// are converted to ACE strings to be validated as an ACL file would be.
+	var lines []string
+	var entryNums []int
+	for i, entry := range sa.Entries {
+		ace, err := entry.ace()
+		if err != nil {
+			result.Errors = append(result.Errors, &ACLEntryError{
+				Line:  i + 1,
+				Entry: fmt.Sprintf("principal %q", entry.Principal),
+				Error: err.Error(),
+			})
+			continue
+		}
+		lines = append(lines, ace)
+		entryNums = append(entryNums, i+1)
+	}
+	if len(lines) == 0 {
+		return result, nil
+	}
+
+	aceResult, err := ValidateACL(strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range aceResult.Errors {
+		e.Line = entryNums[e.Line-1]
+		result.Errors = append(result.Errors, e)
+	}
+	sort.SliceStable(result.Errors, func(i, j int) bool {
+		return result.Errors[i].Line < result.Errors[j].Line
+	})
+	result.ACL = aceResult.ACL
+	result.ACL.Owner = sa.Owner
+	result.ACL.OwnerGroup = sa.OwnerGroup
+
+	return result, nil
+}
+
+// ParseStructuredACL reads an ACL in the structured format from the
+// io.Reader. The content may be either a YAML or a JSON document.
+func ParseStructuredACL(reader io.Reader) (*StructuredACL, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.WithMessage(err, "reading ACL file")
+	}
+
+	sa := new(StructuredACL)
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(sa); err != nil {
+			return nil, errors.Wrap(err, "parsing structured ACL as JSON")
+		}
+		return sa, nil
+	}
+
+	if err := yaml.UnmarshalStrict(data, sa); err != nil {
+		return nil, errors.Wrap(err, "parsing structured ACL as YAML")
+	}
+
+	return sa, nil
+}
+
+// ReadStructuredACLFile reads the ACL file in the structured format at the
+// given path and returns the validated ACL in the short ACE string format.
+func ReadStructuredACLFile(aclFile string) (*AccessControlList, error) {
+	file, err := os.Open(aclFile)
+	if err != nil {
+		return nil, errors.WithMessage(err, "opening ACL file")
+	}
+	defer file.Close()
+
+	sa, err := ParseStructuredACL(file)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "ACL file '%s'", aclFile)
+	}
+
+	result, err := sa.Validate()
+	if err != nil {
+		return nil, errors.WithMessagef(err, "ACL file '%s'", aclFile)
+	}
+	if !result.Valid() {
+		msgs := make([]string, 0, len(result.Errors))
+		for _, e := range result.Errors {
+			msgs = append(msgs, fmt.Sprintf("entry %d (%s): %s", e.Line, e.Entry, e.Error))
+		}
+		return nil, errors.Errorf("ACL file '%s' has invalid entries: %s", aclFile,
+			strings.Join(msgs, "; "))
+	}
+
+	return result.ACL, nil
+}
+
+// ExportACL formats the AccessControlList in the requested format. The
+// verbose option only applies to the text format.
+func ExportACL(acl *AccessControlList, format string, verbose bool) (string, error) {
+	switch format {
+	case "", ACLFormatText:
+		return FormatACL(acl, verbose), nil
+	case ACLFormatJSON, ACLFormatYAML:
+	default:
+		return "", errors.Errorf("unsupported ACL format %q", format)
+	}
+
+	sa, err := NewStructuredACL(acl)
+	if err != nil {
+		return "", err
+	}
+
+	if format == ACLFormatJSON {
+		data, err := json.MarshalIndent(sa, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	}
+
+	data, err := yaml.Marshal(sa)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// DiffACLUpdate returns the changes that applying the update entries to the
+// current ACL would make. An update replaces the entry for the same principal
+// or adds a new one, so no entries are removed.
+func DiffACLUpdate(current, update *AccessControlList) (*ACLDiff, error) {
+	type entryKey struct {
+		principalType acePrincipalType
+		principal     string
+	}
+
+	existing := make(map[entryKey]string)
+	if current != nil {
+		for _, str := range current.Entries {
+			ace, err := normalizeACE(str)
+			if err != nil {
+				return nil, errors.Wrapf(err, "current entry %q", str)
+			}
+			existing[entryKey{ace.principalType, ace.principal}] = ace.str
+		}
+	}
+
+	diff := &ACLDiff{
+		Added:     []string{},
+		Changed:   []*ACLEntryChange{},
+		Unchanged: []string{},
+	}
+	if update == nil {
+		return diff, nil
+	}
+	for _, str := range update.Entries {
+		ace, err := normalizeACE(str)
+		if err != nil {
+			return nil, errors.Wrapf(err, "entry %q", str)
+		}
+
+		old, found := existing[entryKey{ace.principalType, ace.principal}]
+		switch {
+		case !found:
+			diff.Added = append(diff.Added, ace.str)
+		case old != ace.str:
+			diff.Changed = append(diff.Changed, &ACLEntryChange{Old: old, New: ace.str})
+		default:
+			diff.Unchanged = append(diff.Unchanged, ace.str)
+		}
+	}
+
+	return diff, nil
+}
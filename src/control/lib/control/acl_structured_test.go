@@ -0,0 +1,360 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestControl_NewStructuredACL(t *testing.T) {
+	for name, tc := range map[string]struct {
+		acl    *AccessControlList
+		expSA  *StructuredACL
+		expErr error
+	}{
+		"nil": {
+			expSA: &StructuredACL{Entries: []*ACLEntry{}},
+		},
+		"invalid entry": {
+			acl:    &AccessControlList{Entries: []string{"A::bob:r"}},
+			expErr: errors.New("invalid principal"),
+		},
+		"success": {
+			acl: &AccessControlList{
+				Owner:      "alice@",
+				OwnerGroup: "admins@",
+				Entries:    []string{"A::OWNER@:rwdtTaAo", "AU:GS:GROUP@:rw", "A::bob@:r"},
+			},
+			expSA: &StructuredACL{
+				Owner:      "alice@",
+				OwnerGroup: "admins@",
+				Entries: []*ACLEntry{
+					{
+						Principal:   "OWNER@",
+						AccessTypes: []string{"Allow"},
+						Permissions: []string{"Read", "Write", "Destroy-Cont", "Get-Prop",
+							"Set-Prop", "Get-ACL", "Set-ACL", "Set-Owner"},
+					},
+					{
+						Principal:   "GROUP@",
+						AccessTypes: []string{"Allow", "Audit"},
+						Flags:       []string{"Group", "Access-Success"},
+						Permissions: []string{"Read", "Write"},
+					},
+					{
+						Principal:   "bob@",
+						AccessTypes: []string{"Allow"},
+						Permissions: []string{"Read"},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sa, err := NewStructuredACL(tc.acl)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expSA, sa); diff != "" {
+				t.Fatalf("unexpected structured ACL (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_StructuredACL_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		sa         *StructuredACL
+		expErrors  []*ACLEntryError
+		expEntries []string
+		expErr     error
+	}{
+		"no entries": {
+			sa:     &StructuredACL{},
+			expErr: errors.New("no entries found"),
+		},
+		"valid with default access type": {
+			sa: &StructuredACL{
+				Entries: []*ACLEntry{
+					{Principal: "EVERYONE@", Permissions: []string{"read"}},
+					{Principal: "OWNER@", Permissions: []string{"Write", "Read"}},
+				},
+			},
+			expEntries: []string{"A::OWNER@:rw", "A::EVERYONE@:r"},
+		},
+		"invalid entries": {
+			sa: &StructuredACL{
+				Entries: []*ACLEntry{
+					{Principal: "OWNER@", Permissions: []string{"Read"}},
+					{Principal: "bob@", Permissions: []string{"Fly"}},
+					{Principal: "GROUP@", Permissions: []string{"Read"}},
+					{Principal: "OWNER@", Permissions: []string{"Write"}},
+				},
+			},
+			expErrors: []*ACLEntryError{
+				{
+					Line:  2,
+					Entry: `principal "bob@"`,
+					Error: `invalid permission "Fly" (valid: Read, Write, Create-Cont, ` +
+						`Destroy-Cont, Get-Prop, Set-Prop, Get-ACL, Set-ACL, Set-Owner)`,
+				},
+				{
+					Line:  3,
+					Entry: "A::GROUP@:r",
+					Error: "GROUP@ entries require the G (Group) flag",
+				},
+				{
+					Line:  4,
+					Entry: "A::OWNER@:w",
+					Error: "duplicate entry for principal OWNER@ (first defined on line 1)",
+				},
+			},
+			expEntries: []string{"A::OWNER@:r"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			result, err := tc.sa.Validate()
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expErrors, result.Errors); diff != "" {
+				t.Fatalf("unexpected errors (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expEntries, result.ACL.Entries); diff != "" {
+				t.Fatalf("unexpected entries (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ParseStructuredACL(t *testing.T) {
+	expSA := &StructuredACL{
+		Owner: "alice@",
+		Entries: []*ACLEntry{
+			{Principal: "OWNER@", Permissions: []string{"Read", "Write"}},
+			{Principal: "GROUP@", Flags: []string{"Group"}, Permissions: []string{"Read"}},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		content string
+		expSA   *StructuredACL
+		expErr  error
+	}{
+		"yaml": {
+			content: `
+owner_user: alice@
+entries:
+- principal: OWNER@
+  permissions: [Read, Write]
+- principal: GROUP@
+  flags: [Group]
+  permissions: [Read]
+`,
+			expSA: expSA,
+		},
+		"json": {
+			content: `{
+	"owner_user": "alice@",
+	"entries": [
+		{"principal": "OWNER@", "permissions": ["Read", "Write"]},
+		{"principal": "GROUP@", "flags": ["Group"], "permissions": ["Read"]}
+	]
+}`,
+			expSA: expSA,
+		},
+		"unknown yaml field": {
+			content: "entries:\n- principal: OWNER@\n  perms: [Read]\n",
+			expErr:  errors.New("field perms not found"),
+		},
+		"unknown json field": {
+			content: `{"entries": [{"principal": "OWNER@", "perms": ["Read"]}]}`,
+			expErr:  errors.New(`unknown field "perms"`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sa, err := ParseStructuredACL(strings.NewReader(tc.content))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expSA, sa); diff != "" {
+				t.Fatalf("unexpected structured ACL (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ReadStructuredACLFile(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	_, err := ReadStructuredACLFile(dir + "/notafile")
+	test.CmpErr(t, errors.New("opening ACL file"), err)
+
+	path := test.CreateTestFile(t, dir, "entries: []\n")
+	_, err = ReadStructuredACLFile(path)
+	test.CmpErr(t, fmt.Errorf("ACL file '%s': no entries found", path), err)
+
+	path = test.CreateTestFile(t, dir, "entries:\n- principal: bob\n  permissions: [Read]\n")
+	_, err = ReadStructuredACLFile(path)
+	test.CmpErr(t, fmt.Errorf("ACL file '%s' has invalid entries: entry 1 (A::bob:r)", path), err)
+
+	path = test.CreateTestFile(t, dir,
+		"entries:\n- principal: EVERYONE@\n  permissions: [Read]\n- principal: OWNER@\n  permissions: [Read, Write]\n")
+	acl, err := ReadStructuredACLFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"A::OWNER@:rw", "A::EVERYONE@:r"}, acl.Entries); diff != "" {
+		t.Fatalf("unexpected entries (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestControl_ExportACL(t *testing.T) {
+	acl := &AccessControlList{
+		Owner:   "alice@",
+		Entries: []string{"A::OWNER@:rw"},
+	}
+
+	for name, tc := range map[string]struct {
+		format string
+		expOut string
+		expErr error
+	}{
+		"text": {
+			format: ACLFormatText,
+			expOut: "# Owner: alice@\n# Entries:\nA::OWNER@:rw\n",
+		},
+		"json": {
+			format: ACLFormatJSON,
+			expOut: `{
+  "owner_user": "alice@",
+  "entries": [
+    {
+      "principal": "OWNER@",
+      "access_types": [
+        "Allow"
+      ],
+      "permissions": [
+        "Read",
+        "Write"
+      ]
+    }
+  ]
+}
+`,
+		},
+		"yaml": {
+			format: ACLFormatYAML,
+			expOut: `owner_user: alice@
+entries:
+- principal: OWNER@
+  access_types:
+  - Allow
+  permissions:
+  - Read
+  - Write
+`,
+		},
+		"unknown": {
+			format: "xml",
+			expErr: errors.New(`unsupported ACL format "xml"`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := ExportACL(acl, tc.format, false)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expOut, out); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.format == ACLFormatText {
+				return
+			}
+			sa, err := ParseStructuredACL(strings.NewReader(out))
+			if err != nil {
+				t.Fatal(err)
+			}
+			result, err := sa.Validate()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(acl, result.ACL); diff != "" {
+				t.Fatalf("unexpected round-trip ACL (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_DiffACLUpdate(t *testing.T) {
+	current := &AccessControlList{
+		Entries: []string{"A::OWNER@:rw", "A::bob@:r", "A:G:GROUP@:rw", "A:G:bob@:r"},
+	}
+
+	for name, tc := range map[string]struct {
+		update     *AccessControlList
+		expDiff    *ACLDiff
+		expChanges bool
+		expErr     error
+	}{
+		"invalid entry": {
+			update: &AccessControlList{Entries: []string{"A::bob:r"}},
+			expErr: errors.New("invalid principal"),
+		},
+		"no changes": {
+			update: &AccessControlList{Entries: []string{"A::bob@:r"}},
+			expDiff: &ACLDiff{
+				Added:     []string{},
+				Changed:   []*ACLEntryChange{},
+				Unchanged: []string{"A::bob@:r"},
+			},
+		},
+		"added and changed": {
+			update: &AccessControlList{
+				Entries: []string{"A::kelsey@:r", "A::bob@:wr", "A:G:bob@:r"},
+			},
+			expDiff: &ACLDiff{
+				Added: []string{"A::kelsey@:r"},
+				Changed: []*ACLEntryChange{
+					{Old: "A::bob@:r", New: "A::bob@:rw"},
+				},
+				Unchanged: []string{"A:G:bob@:r"},
+			},
+			expChanges: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			diff, err := DiffACLUpdate(current, tc.update)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if d := cmp.Diff(tc.expDiff, diff); d != "" {
+				t.Fatalf("unexpected diff (-want, +got):\n%s\n", d)
+			}
+			test.AssertEqual(t, tc.expChanges, diff.HasChanges(), "unexpected HasChanges")
+		})
+	}
+}
@@ -437,3 +437,169 @@ func TestControl_GetVerboseACE(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_ValidateACL(t *testing.T) {
+	for name, tc := range map[string]struct {
+		content   string
+		reader    io.Reader
+		expResult *ACLValidation
+		expErr    error
+	}{
+		"empty": {
+			content: "# only a comment\n\n",
+			expErr:  errors.New("no entries found"),
+		},
+		"read error": {
+			reader: &mockErrorReader{errorMsg: "mock error"},
+			expErr: errors.New("mock error"),
+		},
+		"valid entries normalized": {
+			content: strings.Join([]string{
+				"# comment",
+				"A::EVERYONE@:r",
+				"A:G:writers@:wr",
+				"A::OWNER@:rwTtdc",
+				"  A:G:GROUP@:rw  ",
+				"UA:FS:bob@:r",
+			}, "\n"),
+			expResult: &ACLValidation{
+				ACL: &AccessControlList{
+					Entries: []string{
+						"A::OWNER@:rwcdtT",
+						"AU:SF:bob@:r",
+						"A:G:GROUP@:rw",
+						"A:G:writers@:rw",
+						"A::EVERYONE@:r",
+					},
+				},
+			},
+		},
+		"invalid entries": {
+			content: strings.Join([]string{
+				"A::OWNER@:rw",
+				"A::bob@",
+				"X::bob@:r",
+				":G:writers@:r",
+				"A:Z:bob@:r",
+				"A::bob:r",
+				"A::b@o@b:r",
+				"A::bob@:rx",
+				"A::GROUP@:r",
+				"A:G:OWNER@:r",
+				"U::bob@:r",
+				"A:S:bob@:r",
+				"A::OWNER@:r",
+				"A:G:bob@:r",
+			}, "\n"),
+			expResult: &ACLValidation{
+				Errors: []*ACLEntryError{
+					{
+						Line:  2,
+						Entry: "A::bob@",
+						Error: "entry has 3 fields, expected 4 (access-types:flags:principal:permissions)",
+					},
+					{
+						Line:  3,
+						Entry: "X::bob@:r",
+						Error: "invalid access type 'X' (valid: A=Allow, U=Audit, L=Alarm)",
+					},
+					{
+						Line:  4,
+						Entry: ":G:writers@:r",
+						Error: "no access type (at least one of A, U or L is required)",
+					},
+					{
+						Line:  5,
+						Entry: "A:Z:bob@:r",
+						Error: "invalid flag 'Z' (valid: G=Group, S=Access-Success, F=Access-Failure, P=Pool-Inherit)",
+					},
+					{
+						Line:  6,
+						Entry: "A::bob:r",
+						Error: "invalid principal \"bob\" (must be OWNER@, GROUP@, EVERYONE@ or of the form name@[domain])",
+					},
+					{
+						Line:  7,
+						Entry: "A::b@o@b:r",
+						Error: "invalid principal \"b@o@b\" (must be OWNER@, GROUP@, EVERYONE@ or of the form name@[domain])",
+					},
+					{
+						Line:  8,
+						Entry: "A::bob@:rx",
+						Error: "invalid permission 'x' (valid: r=Read, w=Write, c=Create-Cont, d=Destroy-Cont, " +
+							"t=Get-Prop, T=Set-Prop, a=Get-ACL, A=Set-ACL, o=Set-Owner)",
+					},
+					{
+						Line:  9,
+						Entry: "A::GROUP@:r",
+						Error: "GROUP@ entries require the G (Group) flag",
+					},
+					{
+						Line:  10,
+						Entry: "A:G:OWNER@:r",
+						Error: "OWNER@ entries may not have the G (Group) flag",
+					},
+					{
+						Line:  11,
+						Entry: "U::bob@:r",
+						Error: "Audit (U) and Alarm (L) entries require the S (Access-Success) or F (Access-Failure) flag",
+					},
+					{
+						Line:  12,
+						Entry: "A:S:bob@:r",
+						Error: "S (Access-Success) and F (Access-Failure) flags are only valid for Audit (U) and Alarm (L) entries",
+					},
+					{
+						Line:  13,
+						Entry: "A::OWNER@:r",
+						Error: "duplicate entry for principal OWNER@ (first defined on line 1)",
+					},
+				},
+				ACL: &AccessControlList{
+					Entries: []string{
+						"A::OWNER@:rw",
+						"A:G:bob@:r",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			reader := tc.reader
+			if reader == nil {
+				reader = &mockReader{text: tc.content}
+			}
+
+			result, err := ValidateACL(reader)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResult, result); diff != "" {
+				t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, len(tc.expResult.Errors) == 0, result.Valid(), "unexpected validity")
+		})
+	}
+}
+
+func TestControl_ValidateACLFile(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	_, err := ValidateACLFile(dir + "/notafile")
+	test.CmpErr(t, errors.New("opening ACL file"), err)
+
+	path := test.CreateTestFile(t, dir, "")
+	_, err = ValidateACLFile(path)
+	test.CmpErr(t, fmt.Errorf("ACL file '%s': no entries found", path), err)
+
+	path = test.CreateTestFile(t, dir, "A::OWNER@:rw\nA::bob:r\n")
+	result, err := ValidateACLFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, false, result.Valid(), "expected invalid ACL")
+	test.AssertEqual(t, 1, len(result.Errors), "unexpected number of errors")
+}
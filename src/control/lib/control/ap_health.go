@@ -0,0 +1,300 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	// apLatencyWeight is the weight given to the most recent sample in the
+	// moving average of an access point's response latency.
+	apLatencyWeight = 0.25
+	// apFailureBackoff is the time for which an access point is avoided after
+	// a failure. It is doubled for each consecutive failure, up to a maximum
+	// of maxAPFailureBackoff.
+	apFailureBackoff    = 10 * time.Second
+	maxAPFailureBackoff = 5 * time.Minute
+)
+
+type (
+	// apStats contains the response history of an access point.
+	apStats struct {
+		latency  time.Duration // moving average of successful response latency
+		healthy  bool          // last request was serviced successfully
+		failures uint          // consecutive connection failures
+		lastFail time.Time
+		replica  bool // known to be a MS replica
+	}
+
+	// apHealthTracker records the response latency and failure history of
+	// the access points used for Management Service requests, in order to
+	// select the best candidates for subsequent requests.
+	//
+	// The tracker also learns the MS leader and replicas from the responses
+	// to MS requests, so that requests which must be handled by the leader can
+	// be sent there directly, and read-only requests can be spread across the
+	// replicas in order to reduce the load on the leader.
+	apHealthTracker struct {
+		sync.RWMutex
+		stats  map[string]*apStats
+		leader string
+		now    func() time.Time
+	}
+)
+
+func newAPHealthTracker() *apHealthTracker {
+	return &apHealthTracker{
+		stats: make(map[string]*apStats),
+		now:   time.Now,
+	}
+}
+
+// isMSUnavailable returns true if the error indicates that the access point is
+// reachable but unable to service MS requests itself.
+func isMSUnavailable(err error) bool {
+	switch errors.Cause(err).(type) {
+	case *system.ErrNotLeader, *system.ErrNotReplica:
+		return true
+	}
+	return system.IsUnavailable(err)
+}
+
+// record updates the access point's history with the result of a request.
+func (t *apHealthTracker) record(addr string, latency time.Duration, err error) {
+	if t == nil {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	s := t.getStats(addr)
+
+	switch {
+	case err == nil:
+		if s.latency == 0 {
+			s.latency = latency
+		} else {
+			s.latency = time.Duration(apLatencyWeight*float64(latency) +
+				(1-apLatencyWeight)*float64(s.latency))
+		}
+		s.healthy = true
+		s.failures = 0
+	case errors.Cause(err) == context.Canceled || status.Code(errors.Cause(err)) == codes.Canceled:
+		// The request was abandoned, so the result says nothing about
+		// the access point.
+	case IsConnErr(err) || isTimeout(err):
+		s.healthy = false
+		s.failures++
+		s.lastFail = t.now()
+	case isMSUnavailable(err):
+		s.healthy = false
+		s.failures = 0
+	}
+}
+
+// getStats returns the history for the access point, creating it if necessary.
+// The caller must hold the write lock.
+func (t *apHealthTracker) getStats(addr string) *apStats {
+	s, found := t.stats[addr]
+	if !found {
+		s = new(apStats)
+		t.stats[addr] = s
+	}
+	return s
+}
+
+// setReplicas marks the supplied access points as MS replicas.
+// The caller must hold the write lock.
+func (t *apHealthTracker) setReplicas(addrs []string) {
+	for _, addr := range addrs {
+		t.getStats(addr).replica = true
+	}
+}
+
+// recordMSResponses updates the known MS leader and replicas based on the
+// responses to a MS request. If exactly one access point serviced a request
+// that is not read-only, it is assumed to be the leader. Any wrong guess is
+// corrected by the hint returned by that access point on the next request.
+func (t *apHealthTracker) recordMSResponses(resps []*HostResponse, readOnly bool) {
+	if t == nil {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	var serviced []string
+	for _, hr := range resps {
+		if hr == nil {
+			continue
+		}
+
+		switch e := errors.Cause(hr.Error).(type) {
+		case nil:
+			if hr.Message != nil {
+				serviced = append(serviced, hr.Addr)
+			}
+		case *system.ErrNotLeader:
+			t.getStats(hr.Addr).replica = true
+			t.setReplicas(e.Replicas)
+			if t.leader == hr.Addr {
+				t.leader = ""
+			}
+			if e.LeaderHint != "" {
+				t.setReplicas([]string{e.LeaderHint})
+				t.leader = e.LeaderHint
+			}
+		case *system.ErrNotReplica:
+			t.getStats(hr.Addr).replica = false
+			t.setReplicas(e.Replicas)
+			if t.leader == hr.Addr {
+				t.leader = ""
+			}
+		}
+	}
+
+	t.setReplicas(serviced)
+	if !readOnly && len(serviced) == 1 {
+		t.leader = serviced[0]
+	}
+}
+
+// healthyLeader returns the known MS leader, or an empty string if the leader
+// is unknown or has failed recently.
+func (t *apHealthTracker) healthyLeader() string {
+	if t == nil {
+		return ""
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	if t.leader == "" || t.isFailing(t.stats[t.leader]) {
+		return ""
+	}
+	return t.leader
+}
+
+// replicaForRead returns a MS replica to service a read-only request, or an
+// empty string if no replicas are known to be available. The leader is only
+// selected if it is the only available replica. Otherwise, the less loaded of
+// two randomly chosen replicas (as indicated by response latency) is selected,
+// which spreads requests across the replicas while favoring the responsive ones.
+func (t *apHealthTracker) replicaForRead(rnd *rand.Rand) string {
+	if t == nil {
+		return ""
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	var replicas []string
+	for addr, s := range t.stats {
+		if !s.replica || addr == t.leader || t.isFailing(s) {
+			continue
+		}
+		replicas = append(replicas, addr)
+	}
+
+	switch len(replicas) {
+	case 0:
+		if t.leader != "" && !t.isFailing(t.stats[t.leader]) {
+			return t.leader
+		}
+		return ""
+	case 1:
+		return replicas[0]
+	}
+
+	// Map iteration order is random, so sort the replicas for a stable
+	// selection from the supplied random source.
+	sort.Strings(replicas)
+	i := rnd.Intn(len(replicas))
+	j := (i + 1 + rnd.Intn(len(replicas)-1)) % len(replicas)
+	if t.stats[replicas[j]].latency < t.stats[replicas[i]].latency {
+		return replicas[j]
+	}
+	return replicas[i]
+}
+
+// failureBackoff returns the time for which the access point should be avoided
+// after its last failure.
+func (s *apStats) failureBackoff() time.Duration {
+	backoff := apFailureBackoff
+	for i := uint(1); i < s.failures && backoff < maxAPFailureBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxAPFailureBackoff {
+		backoff = maxAPFailureBackoff
+	}
+	return backoff
+}
+
+func (t *apHealthTracker) isFailing(s *apStats) bool {
+	return s != nil && s.failures > 0 && t.now().Before(s.lastFail.Add(s.failureBackoff()))
+}
+
+// bestHealthy returns the healthy access point with the lowest response latency,
+// or an empty string if none of the supplied access points are known to be healthy.
+func (t *apHealthTracker) bestHealthy(addrs []string) string {
+	if t == nil {
+		return ""
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	var best string
+	var bestLatency time.Duration
+	for _, addr := range addrs {
+		s, found := t.stats[addr]
+		if !found || !s.healthy {
+			continue
+		}
+		if best == "" || s.latency < bestLatency {
+			best = addr
+			bestLatency = s.latency
+		}
+	}
+
+	return best
+}
+
+// withoutFailing returns the supplied access points, excluding any that have
+// failed recently. If all of them have failed recently, all are returned.
+func (t *apHealthTracker) withoutFailing(addrs []string) []string {
+	if t == nil {
+		return addrs
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	var ok []string
+	for _, addr := range addrs {
+		if !t.isFailing(t.stats[addr]) {
+			ok = append(ok, addr)
+		}
+	}
+	if len(ok) == 0 {
+		return addrs
+	}
+
+	return ok
+}
@@ -0,0 +1,180 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_apStats_failureBackoff(t *testing.T) {
+	for failures, expBackoff := range map[uint]time.Duration{
+		1:  apFailureBackoff,
+		2:  2 * apFailureBackoff,
+		3:  4 * apFailureBackoff,
+		5:  16 * apFailureBackoff,
+		6:  maxAPFailureBackoff,
+		50: maxAPFailureBackoff,
+	} {
+		s := &apStats{failures: failures}
+		test.AssertEqual(t, expBackoff, s.failureBackoff(), "unexpected backoff")
+	}
+}
+
+func TestControl_apHealthTracker(t *testing.T) {
+	now := time.Now()
+	tracker := newAPHealthTracker()
+	tracker.now = func() time.Time { return now }
+
+	hosts := []string{"host1:10001", "host2:10001", "host3:10001", "host4:10001"}
+
+	// No history, so nothing is preferred or excluded.
+	test.AssertEqual(t, "", tracker.bestHealthy(hosts), "unexpected best AP")
+	if diff := cmp.Diff(hosts, tracker.withoutFailing(hosts)); diff != "" {
+		t.Fatalf("unexpected APs (-want, +got):\n%s\n", diff)
+	}
+
+	tracker.record("host1:10001", 40*time.Millisecond, nil)
+	tracker.record("host2:10001", 20*time.Millisecond, nil)
+	tracker.record("host3:10001", time.Millisecond, FaultConnectionRefused("host3:10001"))
+	tracker.record("host4:10001", time.Millisecond, &system.ErrNotReplica{})
+	test.AssertEqual(t, "host2:10001", tracker.bestHealthy(hosts), "unexpected best AP")
+
+	// The moving average moves toward the latest samples.
+	for i := 0; i < 5; i++ {
+		tracker.record("host2:10001", 100*time.Millisecond, nil)
+	}
+	test.AssertEqual(t, "host1:10001", tracker.bestHealthy(hosts), "unexpected best AP")
+
+	// An abandoned request doesn't change the history.
+	tracker.record("host1:10001", time.Second, context.Canceled)
+	test.AssertEqual(t, "host1:10001", tracker.bestHealthy(hosts), "unexpected best AP")
+
+	// An AP that is no longer a replica is not preferred, but is still a candidate.
+	tracker.record("host1:10001", time.Millisecond, &system.ErrNotLeader{})
+	test.AssertEqual(t, "host2:10001", tracker.bestHealthy(hosts), "unexpected best AP")
+
+	tracker.record("host2:10001", time.Second, errors.Wrap(context.DeadlineExceeded, "timed out"))
+	test.AssertEqual(t, "", tracker.bestHealthy(hosts), "unexpected best AP")
+
+	expHosts := []string{"host1:10001", "host4:10001"}
+	if diff := cmp.Diff(expHosts, tracker.withoutFailing(hosts)); diff != "" {
+		t.Fatalf("unexpected APs (-want, +got):\n%s\n", diff)
+	}
+
+	// If all APs have failed recently, none are excluded.
+	failing := []string{"host2:10001", "host3:10001"}
+	if diff := cmp.Diff(failing, tracker.withoutFailing(failing)); diff != "" {
+		t.Fatalf("unexpected APs (-want, +got):\n%s\n", diff)
+	}
+
+	// Failed APs become candidates again after the backoff has expired.
+	now = now.Add(apFailureBackoff)
+	if diff := cmp.Diff(hosts, tracker.withoutFailing(hosts)); diff != "" {
+		t.Fatalf("unexpected APs (-want, +got):\n%s\n", diff)
+	}
+
+	var nilTracker *apHealthTracker
+	nilTracker.record("host1:10001", time.Millisecond, nil)
+	test.AssertEqual(t, "", nilTracker.bestHealthy(hosts), "unexpected best AP")
+	if diff := cmp.Diff(hosts, nilTracker.withoutFailing(hosts)); diff != "" {
+		t.Fatalf("unexpected APs (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestControl_apHealthTracker_MSResponses(t *testing.T) {
+	now := time.Now()
+	tracker := newAPHealthTracker()
+	tracker.now = func() time.Time { return now }
+	rnd := rand.New(rand.NewSource(1))
+
+	// Nothing is known about the MS yet.
+	test.AssertEqual(t, "", tracker.healthyLeader(), "unexpected leader")
+	test.AssertEqual(t, "", tracker.replicaForRead(rnd), "unexpected replica")
+
+	// Only the leader is learned from a hint.
+	tracker.recordMSResponses([]*HostResponse{
+		{Addr: "host1:10001", Error: &system.ErrNotReplica{Replicas: []string{"host2:10001"}}},
+		{Addr: "host2:10001", Error: &system.ErrNotLeader{LeaderHint: "host3:10001"}},
+	}, false)
+	test.AssertEqual(t, "host3:10001", tracker.healthyLeader(), "unexpected leader")
+
+	// The leader is not selected for reads if other replicas are available.
+	replicas := make(map[string]int)
+	for i := 0; i < 100; i++ {
+		replicas[tracker.replicaForRead(rnd)]++
+	}
+	test.AssertEqual(t, 1, len(replicas), "unexpected replicas selected")
+	test.AssertEqual(t, 100, replicas["host2:10001"], "unexpected replica selected")
+
+	// Reads are spread across the replicas.
+	tracker.recordMSResponses([]*HostResponse{
+		{Addr: "host4:10001", Message: defaultMessage},
+	}, true)
+	test.AssertEqual(t, "host3:10001", tracker.healthyLeader(), "unexpected leader")
+	replicas = make(map[string]int)
+	for i := 0; i < 100; i++ {
+		replicas[tracker.replicaForRead(rnd)]++
+	}
+	test.AssertEqual(t, 2, len(replicas), "unexpected replicas selected")
+	test.AssertTrue(t, replicas["host2:10001"] > 0, "expected reads from host2")
+	test.AssertTrue(t, replicas["host4:10001"] > 0, "expected reads from host4")
+
+	// Reads favor the more responsive replicas.
+	tracker.record("host2:10001", 100*time.Millisecond, nil)
+	tracker.record("host4:10001", time.Millisecond, nil)
+	test.AssertEqual(t, "host4:10001", tracker.replicaForRead(rnd), "unexpected replica")
+
+	// A failing replica is avoided.
+	tracker.record("host4:10001", time.Millisecond, FaultConnectionRefused("host4:10001"))
+	test.AssertEqual(t, "host2:10001", tracker.replicaForRead(rnd), "unexpected replica")
+
+	// A failing leader is not selected.
+	tracker.record("host3:10001", time.Millisecond, FaultConnectionRefused("host3:10001"))
+	test.AssertEqual(t, "", tracker.healthyLeader(), "unexpected leader")
+
+	// A single AP servicing a request that is not read-only is the leader.
+	tracker.recordMSResponses([]*HostResponse{
+		{Addr: "host2:10001", Message: defaultMessage},
+		{Addr: "host4:10001", Error: FaultConnectionRefused("host4:10001")},
+	}, false)
+	test.AssertEqual(t, "host2:10001", tracker.healthyLeader(), "unexpected leader")
+
+	// ... unless more than one AP serviced it.
+	tracker.recordMSResponses([]*HostResponse{
+		{Addr: "host1:10001", Message: defaultMessage},
+		{Addr: "host5:10001", Message: defaultMessage},
+	}, false)
+	test.AssertEqual(t, "host2:10001", tracker.healthyLeader(), "unexpected leader")
+
+	// If the only remaining replica is the leader, it services reads.
+	tracker.recordMSResponses([]*HostResponse{
+		{Addr: "host1:10001", Error: &system.ErrNotReplica{}},
+		{Addr: "host5:10001", Error: &system.ErrNotReplica{}},
+	}, true)
+	test.AssertEqual(t, "host2:10001", tracker.replicaForRead(rnd), "unexpected replica")
+
+	// A leader that steps down is forgotten.
+	tracker.recordMSResponses([]*HostResponse{
+		{Addr: "host2:10001", Error: &system.ErrNotLeader{}},
+	}, false)
+	test.AssertEqual(t, "", tracker.healthyLeader(), "unexpected leader")
+	test.AssertEqual(t, "host2:10001", tracker.replicaForRead(rnd), "unexpected replica")
+
+	var nilTracker *apHealthTracker
+	nilTracker.recordMSResponses([]*HostResponse{{Addr: "host1:10001", Message: defaultMessage}}, false)
+	test.AssertEqual(t, "", nilTracker.healthyLeader(), "unexpected leader")
+	test.AssertEqual(t, "", nilTracker.replicaForRead(rnd), "unexpected replica")
+}
@@ -236,10 +236,9 @@ func ConfGenerateRemote(ctx context.Context, req ConfGenerateRemoteReq) (*ConfGe
 	return &remResp, nil
 }
 
-// getNetworkSet retrieves the result of network scan over host list and verifies that there is
-// only a single network set in response which indicates that network hardware setup is homogeneous
-// across all hosts.  Return host errors, network scan results for the host set or error.
-func getNetworkSet(ctx context.Context, req ConfGenerateRemoteReq) (*HostFabricSet, error) {
+// scanNetwork retrieves the result of network scan over host list. Return host errors, the sets of
+// hosts with matching network hardware or error.
+func scanNetwork(ctx context.Context, req ConfGenerateRemoteReq) (HostFabricMap, error) {
 	req.Log.Debugf("fetching host fabric info on hosts %v", req.HostList)
 
 	scanReq := &NetworkScanReq{
@@ -255,11 +254,24 @@ func getNetworkSet(ctx context.Context, req ConfGenerateRemoteReq) (*HostFabricS
 	if len(scanResp.GetHostErrors()) > 0 {
 		return nil, &ConfGenerateError{HostErrorsResp: scanResp.HostErrorsResp}
 	}
+	if len(scanResp.HostFabrics) == 0 {
+		return nil, errors.New("no host responses")
+	}
+
+	return scanResp.HostFabrics, nil
+}
+
+// getNetworkSet retrieves the result of network scan over host list and verifies that there is
+// only a single network set in response which indicates that network hardware setup is homogeneous
+// across all hosts.  Return host errors, network scan results for the host set or error.
+func getNetworkSet(ctx context.Context, req ConfGenerateRemoteReq) (*HostFabricSet, error) {
+	hostFabrics, err := scanNetwork(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
 	// verify homogeneous network
-	switch len(scanResp.HostFabrics) {
-	case 0:
-		return nil, errors.New("no host responses")
+	switch len(hostFabrics) {
 	case 1:
 		// success
 	default:
@@ -267,14 +279,14 @@ func getNetworkSet(ctx context.Context, req ConfGenerateRemoteReq) (*HostFabricS
 		req.Log.Info("Heterogeneous network hardware configurations detected, " +
 			"cannot proceed. The following sets of hosts have different " +
 			"network hardware:")
-		for _, hns := range scanResp.HostFabrics {
+		for _, hns := range hostFabrics {
 			req.Log.Info(hns.HostSet.String())
 		}
 
 		return nil, errors.New("network hardware not consistent across hosts")
 	}
 
-	networkSet := scanResp.HostFabrics[scanResp.HostFabrics.Keys()[0]]
+	networkSet := hostFabrics[hostFabrics.Keys()[0]]
 
 	req.Log.Debugf("Network hardware is consistent for hosts %s:\n\t%v",
 		networkSet.HostSet, networkSet.HostFabric.Interfaces)
@@ -383,13 +395,10 @@ func getNetworkDetails(req ConfGenerateReq, hf *HostFabric) (*networkDetails, er
 	}, nil
 }
 
-// getStorageSet retrieves the result of storage scan over host list and verifies that there is
-// only a single storage set in response which indicates that storage hardware setup is homogeneous
-// across all hosts.  Filter NVMe storage scan so only NUMA affinity and PCI address is taking into
-// account by supplying NvmeBasic flag in scan request. This enables configuration to work with
-// different combinations of SSD models.  Return host errors, storage scan results for the host set
-// or error.
-func getStorageSet(ctx context.Context, req ConfGenerateRemoteReq) (*HostStorageSet, error) {
+// scanStorage retrieves the result of storage scan over host list. NVMe storage scan is filtered so
+// only NUMA affinity and PCI address is taken into account. Return host errors, the sets of hosts
+// with matching storage hardware or error.
+func scanStorage(ctx context.Context, req ConfGenerateRemoteReq) (HostStorageMap, error) {
 	req.Log.Debugf("fetching host storage info on hosts %v", req.HostList)
 
 	scanReq := &StorageScanReq{NvmeBasic: true}
@@ -402,11 +411,27 @@ func getStorageSet(ctx context.Context, req ConfGenerateRemoteReq) (*HostStorage
 	if len(scanResp.GetHostErrors()) > 0 {
 		return nil, &ConfGenerateError{HostErrorsResp: scanResp.HostErrorsResp}
 	}
+	if len(scanResp.HostStorage) == 0 {
+		return nil, errors.New("no host responses")
+	}
+
+	return scanResp.HostStorage, nil
+}
+
+// getStorageSet retrieves the result of storage scan over host list and verifies that there is
+// only a single storage set in response which indicates that storage hardware setup is homogeneous
+// across all hosts.  Filter NVMe storage scan so only NUMA affinity and PCI address is taking into
+// account by supplying NvmeBasic flag in scan request. This enables configuration to work with
+// different combinations of SSD models.  Return host errors, storage scan results for the host set
+// or error.
+func getStorageSet(ctx context.Context, req ConfGenerateRemoteReq) (*HostStorageSet, error) {
+	storageSets, err := scanStorage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
 	// verify homogeneous storage
-	switch len(scanResp.HostStorage) {
-	case 0:
-		return nil, errors.New("no host responses")
+	switch len(storageSets) {
 	case 1:
 		// success
 	default:
@@ -414,14 +439,14 @@ func getStorageSet(ctx context.Context, req ConfGenerateRemoteReq) (*HostStorage
 		req.Log.Info("Heterogeneous storage hardware configurations detected, " +
 			"cannot proceed. The following sets of hosts have different " +
 			"storage hardware:")
-		for _, hss := range scanResp.HostStorage {
+		for _, hss := range storageSets {
 			req.Log.Info(hss.HostSet.String())
 		}
 
 		return nil, errors.New("storage hardware not consistent across hosts")
 	}
 
-	storageSet := scanResp.HostStorage[scanResp.HostStorage.Keys()[0]]
+	storageSet := storageSets[storageSets.Keys()[0]]
 	hostStorage := storageSet.HostStorage
 
 	req.Log.Debugf("Storage hardware is consistent for hosts %s:\n\t%s\n\t%s\n\t%s",
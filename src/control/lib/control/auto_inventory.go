@@ -0,0 +1,251 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+// Kinds of hardware compared across hosts when generating configs from the inventory.
+const (
+	HardwareFabricInterface = "fabric interface"
+	HardwareNvmeController  = "nvme controller"
+	HardwareScmNamespace    = "scm namespace"
+)
+
+type (
+	// ConfGenerateHostsConfig contains a server config generated for a set of hosts.
+	ConfGenerateHostsConfig struct {
+		Hosts  string        `json:"hosts"`
+		Server config.Server `json:"config"`
+	}
+
+	// HardwareDifference describes a device which is present on only some of the hosts.
+	HardwareDifference struct {
+		Kind   string `json:"kind"`
+		Device string `json:"device"`
+		Hosts  string `json:"hosts"` // Hosts on which the device is present
+	}
+
+	// ConfGenerateInventoryResp contains the server configs generated from the hardware inventory
+	// of multiple hosts. The first config is for the largest set of hosts and any following
+	// configs override it for the remaining sets of hosts.
+	ConfGenerateInventoryResp struct {
+		Configs     []*ConfGenerateHostsConfig `json:"configs"`
+		Differences []*HardwareDifference      `json:"differences"`
+	}
+)
+
+// Homogeneous returns true if a single config is suitable for all of the hosts.
+func (resp *ConfGenerateInventoryResp) Homogeneous() bool {
+	return resp != nil && len(resp.Configs) == 1
+}
+
+// hardwareGroup is a set of hosts with matching network and storage hardware.
+type hardwareGroup struct {
+	hosts *hostlist.HostSet
+	hf    *HostFabric
+	hs    *HostStorage
+}
+
+// groupHardware returns the sets of hosts with matching network and storage hardware, ordered by
+// descending number of hosts.
+func groupHardware(fabricSets HostFabricMap, storageSets HostStorageMap) ([]*hardwareGroup, error) {
+	hostStorage := make(map[string]uint64)
+	for key, hss := range storageSets {
+		for _, host := range hss.HostSet.Slice() {
+			hostStorage[host] = key
+		}
+	}
+
+	type groupKey struct{ fabric, storage uint64 }
+	groupMap := make(map[groupKey]*hardwareGroup)
+	for fabricKey, hfs := range fabricSets {
+		for _, host := range hfs.HostSet.Slice() {
+			storageKey, found := hostStorage[host]
+			if !found {
+				return nil, errors.Errorf("no storage scan result for host %s", host)
+			}
+
+			gk := groupKey{fabric: fabricKey, storage: storageKey}
+			if _, exists := groupMap[gk]; !exists {
+				groupMap[gk] = &hardwareGroup{
+					hosts: new(hostlist.HostSet),
+					hf:    hfs.HostFabric,
+					hs:    storageSets[storageKey].HostStorage,
+				}
+			}
+			if _, err := groupMap[gk].hosts.Insert(host); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	groups := make([]*hardwareGroup, 0, len(groupMap))
+	for _, group := range groupMap {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].hosts.Count() != groups[j].hosts.Count() {
+			return groups[i].hosts.Count() > groups[j].hosts.Count()
+		}
+		return groups[i].hosts.String() < groups[j].hosts.String()
+	})
+
+	return groups, nil
+}
+
+// hardwareDifferences returns the devices which are present on only some of the hosts.
+func hardwareDifferences(groups []*hardwareGroup) ([]*HardwareDifference, error) {
+	type device struct{ kind, name string }
+	devHosts := make(map[device]*hostlist.HostSet)
+	allHosts := new(hostlist.HostSet)
+
+	addDevice := func(dev device, hosts *hostlist.HostSet) error {
+		if _, exists := devHosts[dev]; !exists {
+			devHosts[dev] = new(hostlist.HostSet)
+		}
+		return devHosts[dev].Merge(hosts)
+	}
+
+	for _, group := range groups {
+		if err := allHosts.Merge(group.hosts); err != nil {
+			return nil, err
+		}
+
+		devices := make(map[device]struct{})
+		for _, iface := range group.hf.Interfaces {
+			devices[device{HardwareFabricInterface, iface.Device}] = struct{}{}
+		}
+		for _, ctrlr := range group.hs.NvmeDevices {
+			devices[device{HardwareNvmeController, ctrlr.PciAddr}] = struct{}{}
+		}
+		for _, ns := range group.hs.ScmNamespaces {
+			devices[device{HardwareScmNamespace, ns.BlockDevice}] = struct{}{}
+		}
+		for dev := range devices {
+			if err := addDevice(dev, group.hosts); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	diffs := []*HardwareDifference{}
+	for dev, hosts := range devHosts {
+		if hosts.Count() == allHosts.Count() {
+			continue
+		}
+		diffs = append(diffs, &HardwareDifference{
+			Kind:   dev.kind,
+			Device: dev.name,
+			Hosts:  hosts.String(),
+		})
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].Kind != diffs[j].Kind {
+			return diffs[i].Kind < diffs[j].Kind
+		}
+		return diffs[i].Device < diffs[j].Device
+	})
+
+	return diffs, nil
+}
+
+// ConfGenerateInventory scans the hardware of all hosts in the request's host list and generates
+// a server config for each set of hosts with matching hardware. Sets of hosts for which identical
+// configs are generated are merged, so that a single config is returned if the differences in
+// hardware do not affect the config. The devices which are present on only some of the hosts are
+// also returned.
+func ConfGenerateInventory(ctx context.Context, req ConfGenerateRemoteReq) (*ConfGenerateInventoryResp, error) {
+	req.Log.Debugf("ConfGenerateInventory called with request %+v", req)
+
+	if len(req.HostList) == 0 {
+		return nil, errors.New("no hosts specified")
+	}
+
+	if len(req.MgmtSvcReplicas) == 0 {
+		return nil, errors.New("no MS replicas specified")
+	}
+
+	fabricSets, err := scanNetwork(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	storageSets, err := scanStorage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := groupHardware(fabricSets, storageSets)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) > 1 {
+		req.Log.Noticef("Heterogeneous hardware detected across %d sets of hosts", len(groups))
+	}
+
+	resp := new(ConfGenerateInventoryResp)
+	if resp.Differences, err = hardwareDifferences(groups); err != nil {
+		return nil, err
+	}
+
+	// Configs are merged for sets of hosts whose generated configs are identical.
+	var cfgBytes [][]byte
+	var cfgHosts []*hostlist.HostSet
+	for _, group := range groups {
+		genResp, err := ConfGenerate(req.ConfGenerateReq, DefaultEngineCfg, group.hf, group.hs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "hosts %s", group.hosts)
+		}
+
+		b, err := yaml.Marshal(&genResp.Server)
+		if err != nil {
+			return nil, err
+		}
+
+		idx := -1
+		for i := range cfgBytes {
+			if bytes.Equal(b, cfgBytes[i]) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			resp.Configs = append(resp.Configs, &ConfGenerateHostsConfig{
+				Server: genResp.Server,
+			})
+			cfgBytes = append(cfgBytes, b)
+			cfgHosts = append(cfgHosts, new(hostlist.HostSet))
+			idx = len(resp.Configs) - 1
+		}
+		if err := cfgHosts[idx].Merge(group.hosts); err != nil {
+			return nil, err
+		}
+		resp.Configs[idx].Hosts = cfgHosts[idx].String()
+	}
+
+	// Merging may have changed the order of the configs by number of hosts.
+	counts := make(map[*ConfGenerateHostsConfig]int)
+	for i, cfg := range resp.Configs {
+		counts[cfg] = cfgHosts[i].Count()
+	}
+	sort.SliceStable(resp.Configs, func(i, j int) bool {
+		return counts[resp.Configs[i]] > counts[resp.Configs[j]]
+	})
+	req.Log.Debugf("generated %d configs for hosts %v", len(resp.Configs), req.HostList)
+
+	return resp, nil
+}
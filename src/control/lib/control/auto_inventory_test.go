@@ -0,0 +1,243 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func mockHardwareSets(t *testing.T, fabrics map[string]*HostFabric, storages map[string]*HostStorage) (HostFabricMap, HostStorageMap) {
+	t.Helper()
+
+	hfm := make(HostFabricMap)
+	for host, hf := range fabrics {
+		if err := hfm.Add(host, hf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hsm := make(HostStorageMap)
+	for host, hs := range storages {
+		if err := hsm.Add(host, hs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return hfm, hsm
+}
+
+func TestControl_AutoConfig_groupHardware(t *testing.T) {
+	ibFabric := &HostFabric{Interfaces: []*HostFabricInterface{ib0, ib1}, NumaCount: 2}
+	ethFabric := &HostFabric{Interfaces: []*HostFabricInterface{eth0}, NumaCount: 2}
+	twoSSDs := &HostStorage{
+		NvmeDevices: storage.NvmeControllers{
+			storage.MockNvmeController(1), storage.MockNvmeController(2),
+		},
+	}
+	oneSSD := &HostStorage{
+		NvmeDevices: storage.NvmeControllers{storage.MockNvmeController(1)},
+	}
+
+	type expGroup struct {
+		Hosts string
+		HF    *HostFabric
+		HS    *HostStorage
+	}
+
+	for name, tc := range map[string]struct {
+		fabrics   map[string]*HostFabric
+		storages  map[string]*HostStorage
+		expGroups []expGroup
+		expDiffs  []*HardwareDifference
+		expErr    error
+	}{
+		"missing storage result": {
+			fabrics: map[string]*HostFabric{
+				"host1": ibFabric,
+				"host2": ibFabric,
+			},
+			storages: map[string]*HostStorage{
+				"host1": twoSSDs,
+			},
+			expErr: errors.New("no storage scan result for host host2"),
+		},
+		"homogeneous": {
+			fabrics: map[string]*HostFabric{
+				"host1": ibFabric,
+				"host2": ibFabric,
+			},
+			storages: map[string]*HostStorage{
+				"host1": twoSSDs,
+				"host2": twoSSDs,
+			},
+			expGroups: []expGroup{
+				{Hosts: "host[1-2]", HF: ibFabric, HS: twoSSDs},
+			},
+			expDiffs: []*HardwareDifference{},
+		},
+		"heterogeneous": {
+			fabrics: map[string]*HostFabric{
+				"host1": ibFabric,
+				"host2": ibFabric,
+				"host3": ibFabric,
+				"host4": ethFabric,
+			},
+			storages: map[string]*HostStorage{
+				"host1": twoSSDs,
+				"host2": twoSSDs,
+				"host3": oneSSD,
+				"host4": twoSSDs,
+			},
+			expGroups: []expGroup{
+				{Hosts: "host[1-2]", HF: ibFabric, HS: twoSSDs},
+				{Hosts: "host3", HF: ibFabric, HS: oneSSD},
+				{Hosts: "host4", HF: ethFabric, HS: twoSSDs},
+			},
+			expDiffs: []*HardwareDifference{
+				{Kind: HardwareFabricInterface, Device: "eth0", Hosts: "host4"},
+				{Kind: HardwareFabricInterface, Device: "ib0", Hosts: "host[1-3]"},
+				{Kind: HardwareFabricInterface, Device: "ib1", Hosts: "host[1-3]"},
+				{
+					Kind:   HardwareNvmeController,
+					Device: storage.MockNvmeController(2).PciAddr,
+					Hosts:  "host[1-2,4]",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			hfm, hsm := mockHardwareSets(t, tc.fabrics, tc.storages)
+
+			groups, err := groupHardware(hfm, hsm)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, len(tc.expGroups), len(groups), "unexpected group count")
+			for i, g := range groups {
+				test.AssertEqual(t, tc.expGroups[i].Hosts, g.hosts.String(), "unexpected hosts")
+				test.AssertTrue(t, tc.expGroups[i].HF == g.hf, "unexpected fabric of "+g.hosts.String())
+				test.AssertTrue(t, tc.expGroups[i].HS == g.hs, "unexpected storage of "+g.hosts.String())
+			}
+
+			diffs, err := hardwareDifferences(groups)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expDiffs, diffs); diff != "" {
+				t.Fatalf("unexpected differences (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_AutoConfig_ConfGenerateInventory(t *testing.T) {
+	netResp := &ctlpb.NetworkScanResp{
+		Numacount:    2,
+		Corespernuma: 24,
+		Interfaces: []*ctlpb.FabricInterface{
+			{Provider: "ofi+psm2", Device: "ib0", Numanode: 0, Netdevclass: 32, Priority: 0},
+			{Provider: "ofi+psm2", Device: "ib1", Numanode: 1, Netdevclass: 32, Priority: 1},
+		},
+	}
+	storResp := MockServerScanResp(t, "withSpaceUsage")
+
+	for name, tc := range map[string]struct {
+		hostList         []string
+		msReplicas       []string
+		uErr             error
+		hostResponsesSet [][]*HostResponse
+		expHosts         []string
+		expErr           error
+	}{
+		"no hosts": {
+			msReplicas: []string{"host1"},
+			expErr:     errors.New("no hosts specified"),
+		},
+		"no ms replicas": {
+			hostList: []string{"host1"},
+			expErr:   errors.New("no MS replicas specified"),
+		},
+		"scan fails": {
+			hostList:   []string{"host1"},
+			msReplicas: []string{"host1"},
+			uErr:       errors.New("scan failed"),
+			expErr:     errors.New("scan failed"),
+		},
+		"homogeneous": {
+			hostList:   []string{"host1", "host2"},
+			msReplicas: []string{"host1"},
+			hostResponsesSet: [][]*HostResponse{
+				{
+					{Addr: "host1", Message: netResp},
+					{Addr: "host2", Message: netResp},
+				},
+				{
+					{Addr: "host1", Message: storResp},
+					{Addr: "host2", Message: storResp},
+				},
+			},
+			expHosts: []string{"host[1-2]"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := &MockInvokerConfig{UnaryError: tc.uErr}
+			for _, r := range tc.hostResponsesSet {
+				mic.UnaryResponseSet = append(mic.UnaryResponseSet,
+					&UnaryResponse{Responses: r})
+			}
+
+			req := ConfGenerateRemoteReq{
+				HostList: tc.hostList,
+				Client:   NewMockInvoker(log, mic),
+			}
+			req.Log = log
+			req.MgmtSvcReplicas = tc.msReplicas
+			req.NetClass = hardware.Infiniband
+
+			resp, err := ConfGenerateInventory(test.Context(t), req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotHosts []string
+			for _, cfg := range resp.Configs {
+				gotHosts = append(gotHosts, cfg.Hosts)
+			}
+			if diff := cmp.Diff(tc.expHosts, gotHosts); diff != "" {
+				t.Fatalf("unexpected config hosts (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, 0, len(resp.Differences), "unexpected differences")
+		})
+	}
+}
+
+func TestControl_ConfGenerateInventoryResp_Homogeneous(t *testing.T) {
+	var nilResp *ConfGenerateInventoryResp
+	test.AssertFalse(t, nilResp.Homogeneous(), "nil response")
+
+	resp := &ConfGenerateInventoryResp{
+		Configs: []*ConfGenerateHostsConfig{{Hosts: "host[1-2]"}},
+	}
+	test.AssertTrue(t, resp.Homogeneous(), "single config")
+
+	resp.Configs = append(resp.Configs, &ConfGenerateHostsConfig{Hosts: "host3"})
+	test.AssertFalse(t, resp.Homogeneous(), "multiple configs")
+}
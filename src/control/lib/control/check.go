@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -151,7 +152,10 @@ func (a SystemCheckRepairAction) String() string {
 	return strings.TrimPrefix(chkpb.CheckInconsistAction(a).String(), incActionPrefix)
 }
 
+// FromString sets the repair action from its name, which is case-insensitive
+// and may omit the CIA_ prefix.
 func (a *SystemCheckRepairAction) FromString(in string) error {
+	in = strings.ToUpper(in)
 	if !strings.HasPrefix(in, incActionPrefix) {
 		in = incActionPrefix + in
 	}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,10 +11,48 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
 	chkpb "github.com/daos-stack/daos/src/control/common/proto/chk"
+	"github.com/daos-stack/daos/src/control/common/test"
 )
 
+func TestControl_SystemCheckRepairAction_FromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in        string
+		expAction SystemCheckRepairAction
+		expErr    error
+	}{
+		"full name": {
+			in:        "CIA_TRUST_MS",
+			expAction: SystemCheckRepairAction(chkpb.CheckInconsistAction_CIA_TRUST_MS),
+		},
+		"without prefix": {
+			in:        "IGNORE",
+			expAction: SystemCheckRepairAction(chkpb.CheckInconsistAction_CIA_IGNORE),
+		},
+		"lower case": {
+			in:        "interact",
+			expAction: SystemCheckRepairAction(chkpb.CheckInconsistAction_CIA_INTERACT),
+		},
+		"invalid": {
+			in:     "garbage",
+			expErr: errors.New("invalid inconsistency action"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var act SystemCheckRepairAction
+			err := act.FromString(tc.in)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expAction, act, "unexpected action")
+		})
+	}
+}
+
 func TestControl_SystemCheckReport_RepairChoices(t *testing.T) {
 	for name, tc := range map[string]struct {
 		report     *SystemCheckReport
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,10 +11,14 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"time"
 
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/security"
 )
@@ -24,11 +29,16 @@ const (
 
 // Config defines the parameters used to connect to a control API server.
 type Config struct {
-	SystemName      string                    `yaml:"name"`
-	ControlPort     int                       `yaml:"port"`
-	HostList        []string                  `yaml:"hostlist"`
-	TransportConfig *security.TransportConfig `yaml:"transport_config"`
-	Path            string                    `yaml:"-"`
+	SystemName                  string                    `yaml:"name"`
+	ControlPort                 int                       `yaml:"port"`
+	HostList                    []string                  `yaml:"hostlist"`
+	HostListRefreshInterval     time.Duration             `yaml:"hostlist_refresh_interval,omitempty"`
+	TransportConfig             *security.TransportConfig `yaml:"transport_config"`
+	RequestTimeout              time.Duration             `yaml:"request_timeout,omitempty"`
+	TelemetryOTLPTraces         common.OTLPConfig         `yaml:"telemetry_otlp_traces,omitempty"`
+	PoolDestroyConfirmThreshold string                    `yaml:"pool_destroy_confirm_threshold,omitempty"`
+	MaxMsgSize                  string                    `yaml:"max_msg_size,omitempty"`
+	Path                        string                    `yaml:"-"`
 }
 
 // DefaultConfig returns a Config populated with default values. Only
@@ -43,6 +53,32 @@ func DefaultConfig() *Config {
 	}
 }
 
+// PoolDestroyConfirmBytes returns the pool size at or above which a typed
+// confirmation is required before a pool is destroyed. A return value of
+// zero indicates that no confirmation is required.
+func (cfg *Config) PoolDestroyConfirmBytes() (uint64, error) {
+	if cfg == nil || cfg.PoolDestroyConfirmThreshold == "" {
+		return 0, nil
+	}
+
+	threshold, err := humanize.ParseBytes(cfg.PoolDestroyConfirmThreshold)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid pool destroy confirm threshold %q",
+			cfg.PoolDestroyConfirmThreshold)
+	}
+
+	return threshold, nil
+}
+
+// MaxMsgBytes returns the maximum size in bytes of a gRPC message sent or
+// received by the client.
+func (cfg *Config) MaxMsgBytes() (int, error) {
+	if cfg == nil {
+		return build.DefaultControlMaxMsgSize, nil
+	}
+	return common.ParseControlMaxMsgSize(cfg.MaxMsgSize)
+}
+
 // UserConfigPath returns the computed path to a per-user
 // control configuration file, if it exists.
 func UserConfigPath() string {
@@ -96,6 +132,24 @@ func LoadConfig(cfgPath string) (*Config, error) {
 	if !daos.SystemNameIsValid(cfg.SystemName) {
 		return nil, fmt.Errorf("invalid system name: %q", cfg.SystemName)
 	}
+	if cfg.RequestTimeout < 0 {
+		return nil, fmt.Errorf("invalid request timeout: %s", cfg.RequestTimeout)
+	}
+	if cfg.HostListRefreshInterval < 0 {
+		return nil, fmt.Errorf("invalid hostlist refresh interval: %s", cfg.HostListRefreshInterval)
+	}
+	if _, err := ParseHostList(cfg.HostList, cfg.ControlPort); err != nil {
+		return nil, errors.Wrap(err, "invalid hostlist")
+	}
+	if err := cfg.TelemetryOTLPTraces.Validate(); err != nil {
+		return nil, errors.Wrap(err, "telemetry_otlp_traces")
+	}
+	if _, err := cfg.PoolDestroyConfirmBytes(); err != nil {
+		return nil, err
+	}
+	if _, err := cfg.MaxMsgBytes(); err != nil {
+		return nil, errors.Wrap(err, "max_msg_size")
+	}
 
 	return cfg, nil
 }
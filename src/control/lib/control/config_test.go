@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -121,6 +123,22 @@ func TestControl_LoadSpecifiedConfig(t *testing.T) {
 	}
 }
 
+func TestControl_LoadConfig_RequestTimeout(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	testPath := test.CreateTestFile(t, tmpDir, "request_timeout: 90s\n")
+
+	gotCfg, err := LoadConfig(testPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gotCfg.RequestTimeout != 90*time.Second {
+		t.Fatalf("expected request timeout %s, got %s", 90*time.Second, gotCfg.RequestTimeout)
+	}
+}
+
 func TestControl_LoadConfig_NoneFound(t *testing.T) {
 	restore := setDirs(t, "NONE", "NONE")
 	defer restore(t)
@@ -145,6 +163,34 @@ func TestControl_LoadConfig_BadInputs(t *testing.T) {
 			input:  `hostlist: ['nvm0612-ib0:10001','nvm0611-ib0:10001,'nvm0610-ib0:10001']`,
 			expErr: errors.New("did not find expected"),
 		},
+		"bad hostlist range": {
+			input:  `hostlist: ['10.0.0.0/8']`,
+			expErr: errors.New("larger than /22"),
+		},
+		"negative hostlist refresh interval": {
+			input:  `hostlist_refresh_interval: -1m`,
+			expErr: errors.New("invalid hostlist refresh interval"),
+		},
+		"bad request timeout": {
+			input:  `request_timeout: forever`,
+			expErr: errors.New("cannot unmarshal"),
+		},
+		"negative request timeout": {
+			input:  `request_timeout: -1m`,
+			expErr: errors.New("invalid request timeout"),
+		},
+		"bad OTLP traces endpoint": {
+			input:  "telemetry_otlp_traces:\n  endpoint: collector:4318\n",
+			expErr: errors.New("invalid OTLP endpoint"),
+		},
+		"bad pool destroy confirm threshold": {
+			input:  `pool_destroy_confirm_threshold: lots`,
+			expErr: errors.New("invalid pool destroy confirm threshold"),
+		},
+		"max message size too small": {
+			input:  `max_msg_size: 4KiB`,
+			expErr: errors.New("max_msg_size: message size limit"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			tmpDir, cleanup := test.CreateTestDir(t)
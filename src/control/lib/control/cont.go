@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -62,3 +63,220 @@ func ContSetOwner(ctx context.Context, rpcClient UnaryInvoker, req *ContSetOwner
 
 	return errors.Wrap(ur.getMSError(), "container set-owner failed")
 }
+
+type (
+	// ListContainersReq contains the parameters for a container list request.
+	ListContainersReq struct {
+		msRequest
+		msReadRequest
+		unaryRequest
+		PoolID string // UUID or label of the pool
+	}
+
+	// ContainerInfo describes a container in a pool.
+	ContainerInfo struct {
+		UUID  string `json:"uuid"`
+		Label string `json:"label"`
+	}
+
+	// ListContainersResp contains the containers in a pool.
+	ListContainersResp struct {
+		Containers []*ContainerInfo `json:"containers"`
+	}
+)
+
+// ListContainers fetches the list of containers in a DAOS pool.
+func ListContainers(ctx context.Context, rpcClient UnaryInvoker, req *ListContainersReq) (*ListContainersResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if req.PoolID == "" {
+		return nil, errors.New("no pool label or UUID specified")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ListContainers(ctx, &mgmtpb.ListContReq{
+			Sys: req.getSystem(rpcClient),
+			Id:  req.PoolID,
+		})
+	})
+
+	rpcClient.Debugf("List DAOS containers request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ListContainersResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "container list failed")
+	}
+
+	return resp, nil
+}
+
+type (
+	// ContCreateReq contains the parameters for a container create request.
+	ContCreateReq struct {
+		msRequest
+		unaryRequest
+		PoolID string // UUID or label of the pool to create the container in
+		Label  string // Optional label for the container
+		User   string // User to own the container, or empty for the current user
+		Group  string // Group to own the container, or empty for the current group
+	}
+
+	// ContCreateResp contains the response from a container create request.
+	ContCreateResp struct {
+		UUID  string `json:"uuid"`
+		Label string `json:"label"`
+	}
+)
+
+// ContCreate creates a DAOS container in a pool. If no owner user or group is
+// specified, the container is owned by the effective user and group.
+func ContCreate(ctx context.Context, rpcClient UnaryInvoker, req *ContCreateReq) (*ContCreateResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if req.PoolID == "" {
+		return nil, errors.New("no pool label or UUID specified")
+	}
+
+	user, group, err := formatNameGroup(req.User, req.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ContCreate(ctx, &mgmtpb.ContCreateReq{
+			Sys:        req.getSystem(rpcClient),
+			PoolId:     req.PoolID,
+			Label:      req.Label,
+			OwnerUser:  user,
+			OwnerGroup: group,
+		})
+	})
+
+	rpcClient.Debugf("Create DAOS container request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "container create failed")
+	}
+
+	pbResp, ok := msResp.(*mgmtpb.ContCreateResp)
+	if !ok {
+		return nil, errors.New("unable to extract ContCreateResp from MS response")
+	}
+
+	return &ContCreateResp{
+		UUID:  pbResp.ContUuid,
+		Label: req.Label,
+	}, nil
+}
+
+// ContDestroyReq contains the parameters for a container destroy request.
+type ContDestroyReq struct {
+	msRequest
+	unaryRequest
+	ContID string // Container UUID or label
+	PoolID string // UUID or label of the pool for the container
+	Force  bool   // Destroy the container even if it has open handles
+}
+
+// ContDestroy destroys a DAOS container.
+func ContDestroy(ctx context.Context, rpcClient UnaryInvoker, req *ContDestroyReq) error {
+	if req == nil {
+		return errors.New("nil request")
+	}
+
+	if req.PoolID == "" {
+		return errors.New("no pool label or UUID specified")
+	}
+
+	if req.ContID == "" {
+		return errors.New("no container label or UUID specified")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ContDestroy(ctx, &mgmtpb.ContDestroyReq{
+			Sys:    req.getSystem(rpcClient),
+			ContId: req.ContID,
+			PoolId: req.PoolID,
+			Force:  req.Force,
+		})
+	})
+
+	rpcClient.Debugf("Destroy DAOS container request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(ur.getMSError(), "container destroy failed")
+}
+
+type (
+	// ContQueryReq contains the parameters for a container query request.
+	ContQueryReq struct {
+		msRequest
+		msReadRequest
+		unaryRequest
+		ContID string // Container UUID or label
+		PoolID string // UUID or label of the pool for the container
+	}
+
+	// ContQueryResp contains the details of a container.
+	ContQueryResp struct {
+		UUID         string `json:"uuid"`
+		Label        string `json:"label"`
+		OwnerUser    string `json:"owner_user"`
+		OwnerGroup   string `json:"owner_group"`
+		LayoutType   string `json:"layout_type"`
+		NumHandles   uint32 `json:"num_handles"`
+		NumSnapshots uint32 `json:"num_snapshots"`
+	}
+)
+
+// ContQuery fetches the details of a DAOS container.
+func ContQuery(ctx context.Context, rpcClient UnaryInvoker, req *ContQueryReq) (*ContQueryResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if req.PoolID == "" {
+		return nil, errors.New("no pool label or UUID specified")
+	}
+
+	if req.ContID == "" {
+		return nil, errors.New("no container label or UUID specified")
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ContQuery(ctx, &mgmtpb.ContQueryReq{
+			Sys:    req.getSystem(rpcClient),
+			ContId: req.ContID,
+			PoolId: req.PoolID,
+		})
+	})
+
+	rpcClient.Debugf("Query DAOS container request: %+v\n", req)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ContQueryResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "container query failed")
+	}
+
+	return resp, nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,7 @@ package control
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
@@ -132,3 +134,336 @@ func TestControl_ContSetOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_ListContainers(t *testing.T) {
+	testPoolUUID := uuid.New().String()
+	testContUUID := uuid.New().String()
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ListContainersReq
+		expResp *ListContainersResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no pool ID": {
+			req:    &ListContainersReq{},
+			expErr: errors.New("pool label or UUID"),
+		},
+		"local failure": {
+			req: &ListContainersReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &ListContainersReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"no containers": {
+			req: &ListContainersReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.ListContResp{}),
+			},
+			expResp: &ListContainersResp{},
+		},
+		"success": {
+			req: &ListContainersReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.ListContResp{
+					Containers: []*mgmtpb.ListContResp_Cont{
+						{Uuid: testContUUID, Label: "cont1"},
+					},
+				}),
+			},
+			expResp: &ListContainersResp{
+				Containers: []*ContainerInfo{
+					{UUID: testContUUID, Label: "cont1"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ListContainers(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ContCreate(t *testing.T) {
+	testPoolUUID := uuid.New().String()
+	testContUUID := uuid.New().String()
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ContCreateReq
+		expResp *ContCreateResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no pool ID": {
+			req: &ContCreateReq{
+				Label: "cont1",
+			},
+			expErr: errors.New("pool label or UUID"),
+		},
+		"local failure": {
+			req: &ContCreateReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &ContCreateReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"unexpected response": {
+			req: &ContCreateReq{PoolID: testPoolUUID},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.DaosResp{}),
+			},
+			expErr: errors.New("unable to extract"),
+		},
+		"success": {
+			req: &ContCreateReq{
+				PoolID: testPoolUUID,
+				Label:  "cont1",
+				User:   "someuser@",
+				Group:  "somegroup@",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.ContCreateResp{ContUuid: testContUUID},
+				),
+			},
+			expResp: &ContCreateResp{
+				UUID:  testContUUID,
+				Label: "cont1",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ContCreate(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ContDestroy(t *testing.T) {
+	testPoolUUID := uuid.New().String()
+	testContUUID := uuid.New().String()
+
+	validReq := &ContDestroyReq{
+		PoolID: testPoolUUID,
+		ContID: testContUUID,
+	}
+
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		req    *ContDestroyReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no container ID": {
+			req: &ContDestroyReq{
+				PoolID: testPoolUUID,
+			},
+			expErr: errors.New("container label or UUID"),
+		},
+		"no pool ID": {
+			req: &ContDestroyReq{
+				ContID: testContUUID,
+			},
+			expErr: errors.New("pool label or UUID"),
+		},
+		"local failure": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.DaosResp{}),
+			},
+		},
+		"force with labels": {
+			req: &ContDestroyReq{
+				PoolID: "pool1",
+				ContID: "cont1",
+				Force:  true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.DaosResp{}),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotErr := ContDestroy(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_ContQuery(t *testing.T) {
+	testPoolUUID := uuid.New().String()
+	testContUUID := uuid.New().String()
+
+	validReq := &ContQueryReq{
+		PoolID: testPoolUUID,
+		ContID: "cont1",
+	}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ContQueryReq
+		expResp *ContQueryResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no container ID": {
+			req: &ContQueryReq{
+				PoolID: testPoolUUID,
+			},
+			expErr: errors.New("container label or UUID"),
+		},
+		"no pool ID": {
+			req: &ContQueryReq{
+				ContID: testContUUID,
+			},
+			expErr: errors.New("pool label or UUID"),
+		},
+		"local failure": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: validReq,
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.ContQueryResp{
+					Uuid:         testContUUID,
+					Label:        "cont1",
+					OwnerUser:    "someuser@",
+					OwnerGroup:   "somegroup@",
+					LayoutType:   "POSIX",
+					NumHandles:   2,
+					NumSnapshots: 1,
+				}),
+			},
+			expResp: &ContQueryResp{
+				UUID:         testContUUID,
+				Label:        "cont1",
+				OwnerUser:    "someuser@",
+				OwnerGroup:   "somegroup@",
+				LayoutType:   "POSIX",
+				NumHandles:   2,
+				NumSnapshots: 1,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := ContQuery(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,12 +10,12 @@ package control
 import (
 	"context"
 	"log"
-	"log/syslog"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/build"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/events"
@@ -50,8 +51,15 @@ func eventNotify(ctx context.Context, rpcClient UnaryInvoker, seq uint64, evt *e
 	req.SetHostList(aps)
 	rpcClient.Debugf("forwarding %s event (seq: %d)", evt.ID, seq)
 
+	// Events raised by agents are sent via a separate RPC, which only accepts
+	// the events that an agent may raise.
+	notify := mgmtpb.MgmtSvcClient.ClusterEvent
+	if rpcClient.GetComponent() == build.ComponentAgent {
+		notify = mgmtpb.MgmtSvcClient.AgentEvent
+	}
+
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		msg, err := mgmtpb.NewMgmtSvcClient(conn).ClusterEvent(ctx,
+		msg, err := notify(mgmtpb.NewMgmtSvcClient(conn), ctx,
 			&sharedpb.ClusterEventReq{Sequence: seq, Event: pbRASEvent})
 		if err == nil && msg != nil {
 			rpcClient.Debugf("%s event forwarded to MS @ %s", evt.ID, conn.Target())
@@ -136,41 +144,3 @@ func (el *EventLogger) OnEvent(_ context.Context, evt *events.RASEvent) {
 	// no syslogger, write to default logger instead
 	el.log.Info("&&& RAS " + out)
 }
-
-type newSysloggerFn func(syslog.Priority, int) (*log.Logger, error)
-
-// newEventLogger returns an initialized EventLogger using the provided function
-// to populate syslog endpoints which map to event severity identifiers.
-func newEventLogger(logBasic logging.Logger, newSyslogger newSysloggerFn) *EventLogger {
-	el := &EventLogger{
-		log:        logBasic,
-		sysloggers: make(map[events.RASSeverityID]*log.Logger),
-	}
-
-	// syslog writer will prepend timestamp in message header so don't add
-	// duplicate timestamp in log entries
-	flags := log.LstdFlags &^ (log.Ldate | log.Ltime)
-
-	for _, sev := range []events.RASSeverityID{
-		events.RASSeverityUnknown,
-		events.RASSeverityError,
-		events.RASSeverityWarning,
-		events.RASSeverityNotice,
-	} {
-		sl, err := newSyslogger(sev.SyslogPriority(), flags)
-		if err != nil {
-			logBasic.Errorf("failed to create syslogger with priority %d (severity=%s,"+
-				" facility=DAEMON): %s", sev.SyslogPriority(), sev, err)
-			continue
-		}
-		el.sysloggers[sev] = sl
-	}
-
-	return el
-}
-
-// NewEventLogger returns an initialized EventLogger capable of writing to the
-// supplied logger in addition to syslog.
-func NewEventLogger(log logging.Logger) *EventLogger {
-	return newEventLogger(log, syslog.NewLogger)
-}
@@ -0,0 +1,186 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	otlpScopeName = "github.com/daos-stack/daos/src/control/events"
+
+	// OpenTelemetry log data model severity numbers.
+	otlpSeverityUnspecified = 0
+	otlpSeverityInfo2       = 10
+	otlpSeverityWarn        = 13
+	otlpSeverityError       = 17
+)
+
+type (
+	otlpLogRecord struct {
+		TimeUnixNano         string         `json:"timeUnixNano,omitempty"`
+		ObservedTimeUnixNano string         `json:"observedTimeUnixNano"`
+		SeverityNumber       int            `json:"severityNumber"`
+		SeverityText         string         `json:"severityText"`
+		Body                 otlpAnyValue   `json:"body"`
+		Attributes           []otlpKeyValue `json:"attributes"`
+	}
+
+	otlpScopeLogs struct {
+		Scope      otlpScope        `json:"scope"`
+		LogRecords []*otlpLogRecord `json:"logRecords"`
+	}
+
+	otlpResourceLogs struct {
+		Resource  otlpResource    `json:"resource"`
+		ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+	}
+
+	otlpLogsRequest struct {
+		ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+	}
+
+	// OTLPEventExporter implements the events.Handler interface and exports
+	// RAS events as OpenTelemetry log records to a collector, using the
+	// OTLP/HTTP protocol with JSON encoding. Events are queued and sent in
+	// batches by a background goroutine; if the queue is full, events are
+	// dropped rather than blocking the publisher.
+	OTLPEventExporter struct {
+		log     logging.Logger
+		client  *otlpClient
+		batcher *otlpBatcher[*events.RASEvent]
+	}
+)
+
+// otlpSeverityNumber maps a RAS event severity to an OpenTelemetry severity number.
+func otlpSeverityNumber(sev events.RASSeverityID) int {
+	switch sev {
+	case events.RASSeverityError:
+		return otlpSeverityError
+	case events.RASSeverityWarning:
+		return otlpSeverityWarn
+	case events.RASSeverityNotice:
+		return otlpSeverityInfo2
+	default:
+		return otlpSeverityUnspecified
+	}
+}
+
+// newOTLPLogRecord converts a RAS event into an OpenTelemetry log record.
+func newOTLPLogRecord(evt *events.RASEvent, observed time.Time) *otlpLogRecord {
+	rec := &otlpLogRecord{
+		ObservedTimeUnixNano: strconv.FormatInt(observed.UnixNano(), 10),
+		SeverityNumber:       otlpSeverityNumber(evt.Severity),
+		SeverityText:         evt.Severity.String(),
+		Attributes: []otlpKeyValue{
+			otlpString("daos.event.id", evt.ID.String()),
+			otlpString("daos.event.type", evt.Type.String()),
+		},
+	}
+	msg := evt.Msg
+	rec.Body.StringValue = &msg
+
+	if ts, err := evt.GetTimestamp(); err == nil {
+		rec.TimeUnixNano = strconv.FormatInt(ts.UnixNano(), 10)
+	}
+
+	if rank := ranklist.Rank(evt.Rank); !rank.Equals(ranklist.NilRank) {
+		rec.Attributes = append(rec.Attributes,
+			otlpInt("daos.rank", int64(rank)),
+			otlpInt("daos.incarnation", int64(evt.Incarnation)))
+	}
+	for _, attr := range []struct{ key, val string }{
+		{"host.name", evt.Hostname},
+		{"daos.pool.uuid", evt.PoolUUID},
+		{"daos.cont.uuid", evt.ContUUID},
+		{"daos.obj.id", evt.ObjID},
+		{"daos.hw.id", evt.HWID},
+		{"daos.job.id", evt.JobID},
+		{"daos.ctl_op", evt.CtlOp},
+	} {
+		if attr.val != "" {
+			rec.Attributes = append(rec.Attributes, otlpString(attr.key, attr.val))
+		}
+	}
+	if evt.ProcID != 0 {
+		rec.Attributes = append(rec.Attributes, otlpInt("process.pid", int64(evt.ProcID)))
+	}
+	if evt.ThreadID != 0 {
+		rec.Attributes = append(rec.Attributes, otlpInt("thread.id", int64(evt.ThreadID)))
+	}
+
+	return rec
+}
+
+// NewOTLPEventExporter returns an initialized OTLPEventExporter. The exporter
+// does not send any events until Start is called.
+func NewOTLPEventExporter(log logging.Logger, cfg OTLPExporterConfig) *OTLPEventExporter {
+	ee := &OTLPEventExporter{
+		log:    log,
+		client: newOTLPClient(cfg),
+	}
+	ee.batcher = newOTLPBatcher(log, "RAS events", cfg.Endpoint, ee.export)
+
+	return ee
+}
+
+// OnEvent implements the events.Handler interface.
+func (ee *OTLPEventExporter) OnEvent(_ context.Context, evt *events.RASEvent) {
+	switch {
+	case evt == nil:
+		ee.log.Debug("skip event export, nil event")
+		return
+	case evt.IsForwarded():
+		return // event has already been exported at source
+	}
+
+	if !ee.batcher.add(evt) {
+		ee.log.Debugf("OTLP export queue full, dropped %s event", evt.ID)
+	}
+}
+
+// export sends a batch of events to the collector.
+func (ee *OTLPEventExporter) export(ctx context.Context, batch []*events.RASEvent) error {
+	now := time.Now()
+	records := make([]*otlpLogRecord, 0, len(batch))
+	for _, evt := range batch {
+		records = append(records, newOTLPLogRecord(evt, now))
+	}
+
+	return ee.client.post(ctx, &otlpLogsRequest{
+		ResourceLogs: []otlpResourceLogs{
+			{
+				Resource: ee.client.resource,
+				ScopeLogs: []otlpScopeLogs{
+					{
+						Scope:      otlpScope{Name: otlpScopeName, Version: build.DaosVersion},
+						LogRecords: records,
+					},
+				},
+			},
+		},
+	})
+}
+
+// Start starts the background export of queued events, which continues
+// until the supplied context is canceled.
+func (ee *OTLPEventExporter) Start(ctx context.Context) {
+	ee.batcher.start(ctx)
+}
+
+// Wait blocks until the exporter has stopped after the context passed to
+// Start has been canceled.
+func (ee *OTLPEventExporter) Wait() {
+	ee.batcher.wait()
+}
@@ -0,0 +1,214 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_newOTLPLogRecord(t *testing.T) {
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	observed := ts.Add(time.Second)
+
+	for name, tc := range map[string]struct {
+		evt         *events.RASEvent
+		expSevNum   int
+		expTime     string
+		expAttrKeys []string
+	}{
+		"engine event": {
+			evt: &events.RASEvent{
+				ID:          events.RASEngineDied,
+				Type:        events.RASTypeStateChange,
+				Severity:    events.RASSeverityError,
+				Timestamp:   common.FormatTime(ts),
+				Msg:         "engine died",
+				Hostname:    "host1",
+				Rank:        3,
+				Incarnation: 7,
+				ProcID:      1234,
+			},
+			expSevNum: otlpSeverityError,
+			expTime:   strconv.FormatInt(ts.UnixNano(), 10),
+			expAttrKeys: []string{
+				"daos.event.id", "daos.event.type", "daos.rank", "daos.incarnation",
+				"host.name", "process.pid",
+			},
+		},
+		"pool event": {
+			evt: &events.RASEvent{
+				ID:        events.RASPoolRepsUpdate,
+				Type:      events.RASTypeStateChange,
+				Severity:  events.RASSeverityNotice,
+				Timestamp: common.FormatTime(ts),
+				Msg:       "pool service replicas updated",
+				Rank:      1,
+				PoolUUID:  test.MockUUID(1),
+			},
+			expSevNum: otlpSeverityInfo2,
+			expTime:   strconv.FormatInt(ts.UnixNano(), 10),
+			expAttrKeys: []string{
+				"daos.event.id", "daos.event.type", "daos.rank", "daos.incarnation",
+				"daos.pool.uuid",
+			},
+		},
+		"control plane event without rank or valid timestamp": {
+			evt: &events.RASEvent{
+				ID:        events.RASSystemStopFailed,
+				Type:      events.RASTypeInfoOnly,
+				Severity:  events.RASSeverityWarning,
+				Timestamp: "bad",
+				Msg:       "system stop failed",
+				Rank:      uint32(ranklist.NilRank),
+				CtlOp:     "stop",
+			},
+			expSevNum: otlpSeverityWarn,
+			expAttrKeys: []string{
+				"daos.event.id", "daos.event.type", "daos.ctl_op",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rec := newOTLPLogRecord(tc.evt, observed)
+
+			test.AssertEqual(t, tc.expSevNum, rec.SeverityNumber, "unexpected severity number")
+			test.AssertEqual(t, tc.evt.Severity.String(), rec.SeverityText, "unexpected severity text")
+			test.AssertEqual(t, tc.expTime, rec.TimeUnixNano, "unexpected time")
+			test.AssertEqual(t, strconv.FormatInt(observed.UnixNano(), 10), rec.ObservedTimeUnixNano,
+				"unexpected observed time")
+			test.AssertEqual(t, tc.evt.Msg, *rec.Body.StringValue, "unexpected body")
+
+			var keys []string
+			for _, attr := range rec.Attributes {
+				keys = append(keys, attr.Key)
+			}
+			if diff := cmp.Diff(tc.expAttrKeys, keys); diff != "" {
+				t.Fatalf("unexpected attributes (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.evt.ID.String(), *rec.Attributes[0].Value.StringValue, "unexpected event ID")
+		})
+	}
+}
+
+func TestControl_OTLPEventExporter(t *testing.T) {
+	evt := func(msg string) *events.RASEvent {
+		return &events.RASEvent{
+			ID:        events.RASEngineDied,
+			Type:      events.RASTypeStateChange,
+			Severity:  events.RASSeverityError,
+			Timestamp: common.FormatTime(time.Now()),
+			Msg:       msg,
+			Hostname:  "host1",
+			Rank:      1,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		status     int
+		events     []*events.RASEvent
+		expBodies  []string
+		expLogText string
+	}{
+		"events exported": {
+			status: http.StatusOK,
+			events: []*events.RASEvent{
+				evt("one"),
+				evt("forwarded").WithForwarded(true),
+				evt("two"),
+				nil,
+			},
+			expBodies: []string{"one", "two"},
+		},
+		"collector error": {
+			status:     http.StatusServiceUnavailable,
+			events:     []*events.RASEvent{evt("one")},
+			expBodies:  []string{"one"},
+			expLogText: "failed to export 1 RAS events",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var mu sync.Mutex
+			var gotBodies []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				test.AssertEqual(t, "application/json", r.Header.Get("Content-Type"), "unexpected content type")
+				test.AssertEqual(t, "secret", r.Header.Get("Authorization"), "unexpected auth header")
+
+				raw, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				req := new(otlpLogsRequest)
+				if err := json.Unmarshal(raw, req); err != nil {
+					t.Errorf("invalid request body %q: %s", raw, err)
+					return
+				}
+
+				mu.Lock()
+				for _, rl := range req.ResourceLogs {
+					for _, sl := range rl.ScopeLogs {
+						for _, rec := range sl.LogRecords {
+							gotBodies = append(gotBodies, *rec.Body.StringValue)
+						}
+					}
+				}
+				mu.Unlock()
+
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			ee := NewOTLPEventExporter(log, OTLPExporterConfig{
+				Endpoint:   srv.URL + "/v1/logs",
+				Headers:    map[string]string{"Authorization": "secret"},
+				Component:  build.ComponentServer,
+				SystemName: "daos_server",
+				Hostname:   "host1",
+			})
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			ee.Start(ctx)
+			for _, e := range tc.events {
+				ee.OnEvent(ctx, e)
+			}
+			// Remaining events are flushed on shutdown.
+			cancel()
+			ee.Wait()
+
+			mu.Lock()
+			defer mu.Unlock()
+			if diff := cmp.Diff(tc.expBodies, gotBodies); diff != "" {
+				t.Fatalf("unexpected exported events (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expLogText != "" && !strings.Contains(buf.String(), tc.expLogText) {
+				t.Fatalf("expected %q in log output", tc.expLogText)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+//
+// (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !windows
+// +build !windows
+
+package control
+
+import (
+	"log"
+	"log/syslog"
+
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type newSysloggerFn func(syslog.Priority, int) (*log.Logger, error)
+
+// newEventLogger returns an initialized EventLogger using the provided function
+// to populate syslog endpoints which map to event severity identifiers.
+func newEventLogger(logBasic logging.Logger, newSyslogger newSysloggerFn) *EventLogger {
+	el := &EventLogger{
+		log:        logBasic,
+		sysloggers: make(map[events.RASSeverityID]*log.Logger),
+	}
+
+	// syslog writer will prepend timestamp in message header so don't add
+	// duplicate timestamp in log entries
+	flags := log.LstdFlags &^ (log.Ldate | log.Ltime)
+
+	for _, sev := range []events.RASSeverityID{
+		events.RASSeverityUnknown,
+		events.RASSeverityError,
+		events.RASSeverityWarning,
+		events.RASSeverityNotice,
+	} {
+		sl, err := newSyslogger(sev.SyslogPriority(), flags)
+		if err != nil {
+			logBasic.Errorf("failed to create syslogger with priority %d (severity=%s,"+
+				" facility=DAEMON): %s", sev.SyslogPriority(), sev, err)
+			continue
+		}
+		el.sysloggers[sev] = sl
+	}
+
+	return el
+}
+
+// NewEventLogger returns an initialized EventLogger capable of writing to the
+// supplied logger in addition to syslog.
+func NewEventLogger(log logging.Logger) *EventLogger {
+	return newEventLogger(log, syslog.NewLogger)
+}
@@ -0,0 +1,15 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import "github.com/daos-stack/daos/src/control/logging"
+
+// NewEventLogger returns an initialized EventLogger. Syslog is not available
+// on this platform so events are only written to the supplied logger.
+func NewEventLogger(log logging.Logger) *EventLogger {
+	return &EventLogger{log: log}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,14 +8,8 @@
 package control
 
 import (
-	"fmt"
-	"log"
-	"log/syslog"
-	"math"
-	"strings"
 	"testing"
 
-	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common"
@@ -138,110 +133,3 @@ func TestControl_EventForwarder_OnEvent(t *testing.T) {
 		})
 	}
 }
-
-// In real syslog implementation we would see entries logged to logger specific
-// to a given priority, here we just check the correct prefix (maps to severity)
-// is printed which verifies the event was written to the correct logger.
-func TestControl_EventLogger_OnEvent(t *testing.T) {
-	var (
-		evtIDMarker   = " id: "
-		mockSyslogBuf *strings.Builder
-	)
-
-	mockNewSyslogger := func(prio syslog.Priority, flags int) (*log.Logger, error) {
-		return log.New(mockSyslogBuf, fmt.Sprintf("prio%d ", prio), flags), nil
-	}
-	mockNewSysloggerFail := func(prio syslog.Priority, _ int) (*log.Logger, error) {
-		return nil, errors.Errorf("failed to create new syslogger (prio %d)", prio)
-	}
-
-	rasEventEngineDied := mockEvtEngineDied(t).WithForwarded(false)
-	rasEventEngineDiedFwded := mockEvtEngineDied(t).WithForwarded(true)
-
-	for name, tc := range map[string]struct {
-		event              *events.RASEvent
-		newSyslogger       newSysloggerFn
-		expShouldLog       bool
-		expShouldLogSyslog bool
-		expSyslogOut       string
-	}{
-		"nil event": {
-			event: nil,
-		},
-		"forwarded event is not logged": {
-			event: rasEventEngineDiedFwded,
-		},
-		"not forwarded error event gets logged": {
-			event:              rasEventEngineDied,
-			expShouldLog:       false,
-			expShouldLogSyslog: true,
-		},
-		"not forwarded info event gets logged": {
-			event: events.NewGenericEvent(events.RASID(math.MaxInt32-1),
-				events.RASSeverityNotice, "DAOS generic test event",
-				`{"people":["bill","steve","bob"]}`),
-			expShouldLog:       false,
-			expShouldLogSyslog: true,
-		},
-		"sysloggers not created": {
-			event:              rasEventEngineDied,
-			newSyslogger:       mockNewSysloggerFail,
-			expShouldLog:       true,
-			expShouldLogSyslog: false,
-		},
-		"exp syslog output": {
-			event:              rasEventEngineDied,
-			expShouldLog:       false,
-			expShouldLogSyslog: true,
-			expSyslogOut: `
-prio27 id: [engine_died] ts: [%s] host: [foo] type: [STATE_CHANGE] sev: [ERROR] msg: [DAOS engine 0 exited unexpectedly: process exited with 0] pid: [1234] rank: [0]
-`,
-		},
-	} {
-		t.Run(name, func(t *testing.T) {
-			logBasic, bufBasic := logging.NewTestLogger(t.Name())
-			defer test.ShowBufferOnFailure(t, bufBasic)
-
-			mockSyslogBuf = &strings.Builder{}
-
-			if tc.newSyslogger == nil {
-				tc.newSyslogger = mockNewSyslogger
-			}
-
-			el := newEventLogger(logBasic, tc.newSyslogger)
-			el.OnEvent(test.Context(t), tc.event)
-
-			// check event logged to control plane
-			test.AssertEqual(t, tc.expShouldLog,
-				strings.Contains(bufBasic.String(), evtIDMarker),
-				"unexpected log output")
-
-			slStr := mockSyslogBuf.String()
-			t.Logf("syslog out: %s", slStr)
-			if !tc.expShouldLogSyslog {
-				test.AssertTrue(t, slStr == "", "expected syslog to be empty")
-				return
-			}
-			prioStr := fmt.Sprintf("prio%d ", tc.event.Severity.SyslogPriority())
-			sevOut := "sev: [" + tc.event.Severity.String() + "]"
-
-			// check event logged to correct mock syslogger
-			test.AssertEqual(t, 1, strings.Count(slStr, evtIDMarker),
-				"unexpected number of events in syslog")
-			test.AssertTrue(t, strings.Contains(slStr, sevOut),
-				"syslog output missing severity")
-			test.AssertTrue(t, strings.HasPrefix(slStr, prioStr),
-				"syslog output missing syslog priority")
-
-			if tc.expSyslogOut == "" {
-				return
-			}
-			// hack to avoid mismatch on timestamp
-			tc.expSyslogOut = fmt.Sprintf(tc.expSyslogOut, tc.event.Timestamp)
-
-			if diff := cmp.Diff(strings.TrimLeft(tc.expSyslogOut, "\n"), slStr); diff != "" {
-				t.Fatalf("Unexpected syslog output (-want, +got):\n%s\n", diff)
-			}
-		})
-	}
-}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -80,6 +81,12 @@ func IsConnErr(err error) bool {
 	return false
 }
 
+// IsMsgTooLarge indicates whether the error resulted from a request or response
+// exceeding a gRPC message size limit.
+func IsMsgTooLarge(err error) bool {
+	return fault.IsFaultCode(errors.Cause(err), code.ClientRpcMsgTooLarge)
+}
+
 func FaultConnectionBadHost(srvAddr string) *fault.Fault {
 	return clientFault(
 		code.ClientConnectionBadHost,
@@ -120,6 +127,16 @@ func FaultConnectionTimedOut(srvAddr string) *fault.Fault {
 	)
 }
 
+// FaultAPIIncompatible indicates that the server does not implement a method
+// which is required by the client.
+func FaultAPIIncompatible(srvAddr, method string) *fault.Fault {
+	return clientFault(
+		code.ClientAPIIncompatible,
+		fmt.Sprintf("the server at %s does not implement %s, which is required by this client version", srvAddr, method),
+		"ensure that the same DAOS version is installed on all servers and clients",
+	)
+}
+
 func FaultRpcTimeout(req deadliner) *fault.Fault {
 	timeout := req.getTimeout()
 	if timeout == 0 {
@@ -132,6 +149,17 @@ func FaultRpcTimeout(req deadliner) *fault.Fault {
 	)
 }
 
+// FaultRpcMsgTooLarge indicates that a request or response exchanged with
+// the server exceeded a gRPC message size limit.
+func FaultRpcMsgTooLarge(srvAddr, msg string) *fault.Fault {
+	return clientFault(
+		code.ClientRpcMsgTooLarge,
+		fmt.Sprintf("message size limit exceeded in exchange with %s: %s", srvAddr, msg),
+		"narrow the scope of the request, or raise the limit with 'max_msg_size' in the client "+
+			"configuration and 'control_max_msg_size' in the server configuration",
+	)
+}
+
 func clientFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "client",
@@ -0,0 +1,170 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+)
+
+// HostErrorClass describes the general cause of a host error.
+type HostErrorClass string
+
+const (
+	// HostErrorClassConnRefused indicates that the host refused the connection.
+	HostErrorClassConnRefused HostErrorClass = "connection refused"
+	// HostErrorClassConnection indicates that the host could not be reached.
+	HostErrorClassConnection HostErrorClass = "connection failure"
+	// HostErrorClassCertificate indicates a certificate or other transport
+	// security problem.
+	HostErrorClassCertificate HostErrorClass = "certificate"
+	// HostErrorClassTimeout indicates that the connection or request timed out.
+	HostErrorClassTimeout HostErrorClass = "timeout"
+	// HostErrorClassApplication indicates that the host received the request
+	// but was unable to complete it.
+	HostErrorClassApplication HostErrorClass = "application error"
+)
+
+// hostErrorClassOrder is the order in which classes of host errors are
+// reported, with failures to reach the hosts first.
+var hostErrorClassOrder = []HostErrorClass{
+	HostErrorClassConnRefused,
+	HostErrorClassConnection,
+	HostErrorClassCertificate,
+	HostErrorClassTimeout,
+	HostErrorClassApplication,
+}
+
+// ClassifyHostError returns the class of the supplied host error.
+func ClassifyHostError(err error) HostErrorClass {
+	cause := errors.Cause(err)
+
+	if f, ok := cause.(*fault.Fault); ok {
+		switch {
+		case f.Code == code.ClientConnectionRefused:
+			return HostErrorClassConnRefused
+		case f.Code == code.ClientConnectionTimedOut, f.Code == code.ClientRpcTimeout:
+			return HostErrorClassTimeout
+		case IsConnErr(f):
+			return HostErrorClassConnection
+		case f.Domain == "security":
+			return HostErrorClassCertificate
+		}
+		return HostErrorClassApplication
+	}
+
+	msg := cause.Error()
+	switch {
+	case errors.Is(cause, context.DeadlineExceeded), status.Code(cause) == codes.DeadlineExceeded:
+		return HostErrorClassTimeout
+	case strings.Contains(msg, "x509:"), strings.Contains(msg, "certificate"):
+		return HostErrorClassCertificate
+	case strings.Contains(msg, "connection refused"):
+		return HostErrorClassConnRefused
+	case status.Code(cause) == codes.Unavailable:
+		return HostErrorClassConnection
+	}
+
+	return HostErrorClassApplication
+}
+
+// hostErrorCode returns the numeric fault or DAOS status code of the host
+// error, or zero if it has none.
+func hostErrorCode(err error) int {
+	switch cause := errors.Cause(err).(type) {
+	case *fault.Fault:
+		return int(cause.Code)
+	case daos.Status:
+		return int(cause)
+	}
+	return 0
+}
+
+type (
+	// HostClassError describes one of the errors in a class of host errors.
+	HostClassError struct {
+		Error   string            `json:"error"`
+		Code    int               `json:"code,omitempty"`
+		HostSet *hostlist.HostSet `json:"hosts"`
+	}
+
+	// HostErrorClassSet contains the set of hosts which experienced errors
+	// of the same class, and the errors they experienced.
+	HostErrorClassSet struct {
+		Class     HostErrorClass    `json:"class"`
+		HostSet   *hostlist.HostSet `json:"hosts"`
+		HostCount int               `json:"host_count"`
+		Errors    []*HostClassError `json:"errors"`
+	}
+)
+
+// Classify groups the host errors in the map by class. The returned sets are
+// ordered by class, and the errors within each set by the number of hosts that
+// experienced them, most frequent first.
+func (hem HostErrorsMap) Classify() []*HostErrorClassSet {
+	classSets := make(map[HostErrorClass]*HostErrorClassSet)
+	for _, errStr := range hem.Keys() {
+		hes := hem[errStr]
+		if hes == nil || hes.HostSet == nil {
+			continue
+		}
+
+		class := ClassifyHostError(hes.HostError)
+		hecs, found := classSets[class]
+		if !found {
+			hecs = &HostErrorClassSet{
+				Class:   class,
+				HostSet: new(hostlist.HostSet),
+			}
+			classSets[class] = hecs
+		}
+		if err := hecs.HostSet.Merge(hes.HostSet); err != nil {
+			continue
+		}
+
+		hce := &HostClassError{
+			Error:   hes.HostError.Error(),
+			Code:    hostErrorCode(hes.HostError),
+			HostSet: hes.HostSet,
+		}
+		if f, ok := errors.Cause(hes.HostError).(*fault.Fault); ok {
+			hce.Error = f.Description
+		}
+		hecs.Errors = append(hecs.Errors, hce)
+	}
+
+	var out []*HostErrorClassSet
+	for _, class := range hostErrorClassOrder {
+		hecs, found := classSets[class]
+		if !found {
+			continue
+		}
+		hecs.HostCount = hecs.HostSet.Count()
+		sort.SliceStable(hecs.Errors, func(i, j int) bool {
+			return hecs.Errors[i].HostSet.Count() > hecs.Errors[j].HostSet.Count()
+		})
+		out = append(out, hecs)
+	}
+
+	return out
+}
+
+// GetHostErrorClasses returns the host errors in the response grouped by
+// class, or nil if there are none.
+func (her *HostErrorsResp) GetHostErrorClasses() []*HostErrorClassSet {
+	return her.HostErrors.Classify()
+}
@@ -0,0 +1,196 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestControl_ClassifyHostError(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err      error
+		expClass HostErrorClass
+	}{
+		"connection refused fault": {
+			err:      FaultConnectionRefused("host1:10001"),
+			expClass: HostErrorClassConnRefused,
+		},
+		"wrapped connection refused fault": {
+			err:      errors.Wrap(FaultConnectionRefused("host1:10001"), "wrapped"),
+			expClass: HostErrorClassConnRefused,
+		},
+		"no route fault": {
+			err:      FaultConnectionNoRoute("host1:10001"),
+			expClass: HostErrorClassConnection,
+		},
+		"connection timed out fault": {
+			err:      FaultConnectionTimedOut("host1:10001"),
+			expClass: HostErrorClassTimeout,
+		},
+		"rpc timeout fault": {
+			err:      FaultRpcTimeout(&SystemQueryReq{}),
+			expClass: HostErrorClassTimeout,
+		},
+		"invalid certificate fault": {
+			err:      security.FaultInvalidCert(errors.New("expired")),
+			expClass: HostErrorClassCertificate,
+		},
+		"other fault": {
+			err:      FaultFormatRunningSystem,
+			expClass: HostErrorClassApplication,
+		},
+		"context deadline": {
+			err:      context.DeadlineExceeded,
+			expClass: HostErrorClassTimeout,
+		},
+		"grpc deadline": {
+			err:      status.Error(codes.DeadlineExceeded, "too slow"),
+			expClass: HostErrorClassTimeout,
+		},
+		"x509 error": {
+			err:      errors.New("x509: certificate signed by unknown authority"),
+			expClass: HostErrorClassCertificate,
+		},
+		"connection refused error": {
+			err:      errors.New("dial tcp: connection refused"),
+			expClass: HostErrorClassConnRefused,
+		},
+		"grpc unavailable": {
+			err:      status.Error(codes.Unavailable, "unavailable"),
+			expClass: HostErrorClassConnection,
+		},
+		"daos status": {
+			err:      daos.Nonexistent,
+			expClass: HostErrorClassApplication,
+		},
+		"other error": {
+			err:      errors.New("something went wrong"),
+			expClass: HostErrorClassApplication,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expClass, ClassifyHostError(tc.err), "unexpected class")
+		})
+	}
+}
+
+func TestControl_HostErrorsMap_Classify(t *testing.T) {
+	for name, tc := range map[string]struct {
+		hostErrs map[string]error
+		expJSON  string
+	}{
+		"no errors": {
+			expJSON: `null`,
+		},
+		"mixed classes": {
+			hostErrs: func() map[string]error {
+				hostErrs := map[string]error{
+					"host9:10001": daos.Nonexistent,
+					"host8:10001": errors.New("storage busy"),
+					"host7:10001": daos.Nonexistent,
+					"host6:10001": FaultConnectionTimedOut("host6:10001"),
+				}
+				for i := 1; i <= 5; i++ {
+					addr := fmt.Sprintf("host%d:10001", i)
+					hostErrs[addr] = FaultConnectionRefused(addr)
+				}
+				return hostErrs
+			}(),
+			expJSON: `[
+  {
+    "class": "connection refused",
+    "hosts": "host[1-5]:10001",
+    "host_count": 5,
+    "errors": [
+      {
+        "error": "the server at host1:10001 refused the connection",
+        "code": 506,
+        "hosts": "host1:10001"
+      },
+      {
+        "error": "the server at host2:10001 refused the connection",
+        "code": 506,
+        "hosts": "host2:10001"
+      },
+      {
+        "error": "the server at host3:10001 refused the connection",
+        "code": 506,
+        "hosts": "host3:10001"
+      },
+      {
+        "error": "the server at host4:10001 refused the connection",
+        "code": 506,
+        "hosts": "host4:10001"
+      },
+      {
+        "error": "the server at host5:10001 refused the connection",
+        "code": 506,
+        "hosts": "host5:10001"
+      }
+    ]
+  },
+  {
+    "class": "timeout",
+    "hosts": "host6:10001",
+    "host_count": 1,
+    "errors": [
+      {
+        "error": "the connection to the server at host6:10001 timed out",
+        "code": 508,
+        "hosts": "host6:10001"
+      }
+    ]
+  },
+  {
+    "class": "application error",
+    "hosts": "host[7-9]:10001",
+    "host_count": 3,
+    "errors": [
+      {
+        "error": "DER_NONEXIST(-1005): The specified entity does not exist",
+        "code": -1005,
+        "hosts": "host[7,9]:10001"
+      },
+      {
+        "error": "storage busy",
+        "hosts": "host8:10001"
+      }
+    ]
+  }
+]`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			hem := make(HostErrorsMap)
+			for addr, err := range tc.hostErrs {
+				if err := hem.Add(addr, err); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotJSON, err := json.MarshalIndent(hem.Classify(), "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expJSON, string(gotJSON)); diff != "" {
+				t.Fatalf("unexpected classes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,17 +1,230 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
 
 package control
 
-import "github.com/daos-stack/daos/src/control/common"
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+)
+
+const (
+	// SRVHostPrefix identifies a hostlist entry that is expanded from the
+	// targets of a DNS SRV record, e.g. "srv:_daos._tcp.example.com".
+	SRVHostPrefix = "srv:"
+	// maxCIDRHostBits limits the size of a CIDR hostlist entry (/22 for
+	// IPv4), so that a typo can't expand into an enormous hostlist.
+	maxCIDRHostBits = 10
+	// defaultHostListRefresh is the interval after which SRV hostlist
+	// entries are looked up again, if not set in the configuration.
+	defaultHostListRefresh = 5 * time.Minute
+)
+
+type (
+	lookupSRVFn func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+
+	resolvedHosts struct {
+		hosts   []string
+		expires time.Time
+	}
+
+	// hostResolver expands the dynamic entries in a hostlist, i.e. CIDR
+	// ranges and DNS SRV records, into control plane addresses. SRV lookup
+	// results are cached until the refresh interval has elapsed, so that
+	// long-running clients pick up changes to the server addresses.
+	hostResolver struct {
+		sync.Mutex
+		log       debugLogger
+		cache     map[string]*resolvedHosts
+		lookupSRV lookupSRVFn
+		now       func() time.Time
+	}
+)
+
+func newHostResolver(log debugLogger) *hostResolver {
+	return &hostResolver{
+		log:       log,
+		cache:     make(map[string]*resolvedHosts),
+		lookupSRV: net.DefaultResolver.LookupSRV,
+		now:       time.Now,
+	}
+}
+
+func isSRVHost(entry string) bool {
+	return strings.HasPrefix(entry, SRVHostPrefix)
+}
+
+func isCIDRHost(entry string) bool {
+	return strings.Contains(entry, "/")
+}
+
+// expandCIDR expands an IPv4 CIDR range with an optional port suffix, e.g.
+// "10.0.0.0/28:10001", into a list of addresses. The network and broadcast
+// addresses are excluded from ranges that have them.
+func expandCIDR(entry string, defaultPort int) ([]string, error) {
+	cidr, port := entry, strconv.Itoa(defaultPort)
+	if i := strings.LastIndex(entry, ":"); i > strings.Index(entry, "/") {
+		cidr, port = entry[:i], entry[i+1:]
+		if _, err := strconv.Atoi(port); err != nil {
+			return nil, errors.Errorf("invalid port in host range %q", entry)
+		}
+	}
+
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid host range %q", entry)
+	}
+	start := ipNet.IP.To4()
+	if start == nil {
+		return nil, errors.Errorf("invalid host range %q: only IPv4 ranges are supported", entry)
+	}
+	ones, bits := ipNet.Mask.Size()
+	if bits-ones > maxCIDRHostBits {
+		return nil, errors.Errorf("host range %q is larger than /%d", entry, bits-maxCIDRHostBits)
+	}
+
+	first := binary.BigEndian.Uint32(start)
+	count := uint32(1) << (bits - ones)
+	if count > 2 {
+		first++
+		count -= 2
+	}
+
+	hosts := make([]string, 0, count)
+	for i := uint32(0); i < count; i++ {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, first+i)
+		hosts = append(hosts, net.JoinHostPort(ip.String(), port))
+	}
+
+	return hosts, nil
+}
+
+// resolveSRV returns the targets of the SRV record named by the hostlist
+// entry. A cached result is returned until it expires, and is also returned
+// if a refresh fails so that a transient DNS outage doesn't take out the
+// hostlist.
+func (hr *hostResolver) resolveSRV(ctx context.Context, entry string, refresh time.Duration) ([]string, error) {
+	now := hr.now()
+	cached, found := hr.cache[entry]
+	if found && now.Before(cached.expires) {
+		return cached.hosts, nil
+	}
+
+	name := strings.TrimPrefix(entry, SRVHostPrefix)
+	if name == "" {
+		return nil, errors.Errorf("invalid SRV host %q: missing record name", entry)
+	}
+
+	_, records, err := hr.lookupSRV(ctx, "", "", name)
+	if err == nil && len(records) == 0 {
+		err = errors.New("no targets found")
+	}
+	if err != nil {
+		if found {
+			hr.log.Debugf("failed to refresh SRV host %q, using previous targets: %s", entry, err)
+			return cached.hosts, nil
+		}
+		return nil, errors.Wrapf(err, "failed to look up SRV host %q", entry)
+	}
+
+	hosts := make([]string, 0, len(records))
+	for _, rec := range records {
+		target := strings.TrimSuffix(rec.Target, ".")
+		hosts = append(hosts, net.JoinHostPort(target, strconv.Itoa(int(rec.Port))))
+	}
+	hr.log.Debugf("SRV host %q resolved to %v", entry, hosts)
+
+	hr.cache[entry] = &resolvedHosts{
+		hosts:   hosts,
+		expires: now.Add(refresh),
+	}
+	return hosts, nil
+}
+
+// expand returns the supplied hostlist with any dynamic entries replaced by
+// the hosts they expand to. Static entries are returned unchanged. A nil
+// resolver does not expand anything.
+func (hr *hostResolver) expand(ctx context.Context, in []string, defaultPort int, refresh time.Duration) ([]string, error) {
+	if hr == nil || len(in) == 0 {
+		return in, nil
+	}
+	if refresh <= 0 {
+		refresh = defaultHostListRefresh
+	}
+
+	hr.Lock()
+	defer hr.Unlock()
+
+	var out []string
+	for _, entry := range in {
+		var hosts []string
+		var err error
+		switch {
+		case isSRVHost(entry):
+			hosts, err = hr.resolveSRV(ctx, entry, refresh)
+		case isCIDRHost(entry):
+			hosts, err = expandCIDR(entry, defaultPort)
+		default:
+			hosts = []string{entry}
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, hosts...)
+	}
+
+	return out, nil
+}
+
+// ParseHostList validates and deduplicates the given list of host strings in
+// the same way as common.ParseHostList, with the exception that CIDR ranges
+// and DNS SRV records are validated and retained for later expansion.
+func ParseHostList(in []string, defaultPort int) ([]string, error) {
+	var static, dynamic []string
+	for _, entry := range in {
+		switch {
+		case isSRVHost(entry):
+			if entry == SRVHostPrefix {
+				return nil, errors.Errorf("invalid SRV host %q: missing record name", entry)
+			}
+		case isCIDRHost(entry):
+			if _, err := expandCIDR(entry, defaultPort); err != nil {
+				return nil, err
+			}
+		default:
+			static = append(static, entry)
+			continue
+		}
+		dynamic = append(dynamic, entry)
+	}
+
+	out, err := common.ParseHostList(static, defaultPort)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(out, dynamic...), nil
+}
 
 // getRequestHosts returns a list of control plane addresses for
 // the request. If the request does not supply its own hostlist,
-// create one from the configuration's hostlist.
-func getRequestHosts(cfg *Config, req targetChooser) (hosts []string, err error) {
+// create one from the configuration's hostlist. Any dynamic entries
+// in the hostlist are expanded using the supplied resolver.
+func getRequestHosts(ctx context.Context, cfg *Config, hr *hostResolver, req targetChooser) (hosts []string, err error) {
 	if len(req.getHostList()) == 0 && len(cfg.HostList) == 0 {
 		return nil, FaultConfigEmptyHostList
 	}
@@ -19,16 +232,19 @@ func getRequestHosts(cfg *Config, req targetChooser) (hosts []string, err error)
 		return nil, FaultConfigBadControlPort
 	}
 
-	hosts, err = common.ParseHostList(req.getHostList(), cfg.ControlPort)
-	if err != nil {
-		return nil, err
-	}
+	for _, hl := range [][]string{req.getHostList(), cfg.HostList} {
+		hl, err = hr.expand(ctx, hl, cfg.ControlPort, cfg.HostListRefreshInterval)
+		if err != nil {
+			return nil, err
+		}
 
-	if len(hosts) == 0 {
-		hosts, err = common.ParseHostList(cfg.HostList, cfg.ControlPort)
+		hosts, err = common.ParseHostList(hl, cfg.ControlPort)
 		if err != nil {
 			return nil, err
 		}
+		if len(hosts) > 0 {
+			break
+		}
 	}
 
 	return hosts, nil
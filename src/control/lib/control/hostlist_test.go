@@ -7,12 +7,16 @@
 package control
 
 import (
+	"context"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
 )
 
 type testTgtChooser struct {
@@ -36,6 +40,183 @@ func mockHostList(hosts ...string) []string {
 	return hosts
 }
 
+func mockLookupSRV(records map[string][]*net.SRV, err error) lookupSRVFn {
+	return func(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+		if err != nil {
+			return "", nil, err
+		}
+		return name, records[name], nil
+	}
+}
+
+func TestControl_expandCIDR(t *testing.T) {
+	for name, tc := range map[string]struct {
+		entry  string
+		expOut []string
+		expErr error
+	}{
+		"not a range": {
+			entry:  "10.0.0.1/foo",
+			expErr: errors.New("invalid host range"),
+		},
+		"IPv6 range": {
+			entry:  "fd00::/126",
+			expErr: errors.New("only IPv4"),
+		},
+		"range too large": {
+			entry:  "10.0.0.0/21",
+			expErr: errors.New("larger than /22"),
+		},
+		"bad port": {
+			entry:  "10.0.0.0/30:foo",
+			expErr: errors.New("invalid port"),
+		},
+		"single address": {
+			entry:  "10.0.0.1/32",
+			expOut: []string{"10.0.0.1:42"},
+		},
+		"point-to-point range": {
+			entry:  "10.0.0.0/31",
+			expOut: []string{"10.0.0.0:42", "10.0.0.1:42"},
+		},
+		"network and broadcast excluded": {
+			entry: "10.0.0.5/29",
+			expOut: []string{
+				"10.0.0.1:42", "10.0.0.2:42", "10.0.0.3:42",
+				"10.0.0.4:42", "10.0.0.5:42", "10.0.0.6:42",
+			},
+		},
+		"port suffix": {
+			entry:  "10.0.0.0/30:10001",
+			expOut: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+		},
+		"top of address space": {
+			entry:  "255.255.255.255/32",
+			expOut: []string{"255.255.255.255:42"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotOut, gotErr := expandCIDR(tc.entry, 42)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expOut, gotOut); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_hostResolver_expand(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	srvName := "_daos._tcp.example.com"
+	srvHost := SRVHostPrefix + srvName
+	records := map[string][]*net.SRV{
+		srvName: {{Target: "host1.", Port: 10001}},
+	}
+	var lookupErr error
+	lookups := 0
+
+	now := time.Now()
+	hr := newHostResolver(log)
+	hr.now = func() time.Time { return now }
+	hr.lookupSRV = func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		lookups++
+		return mockLookupSRV(records, lookupErr)(ctx, service, proto, name)
+	}
+
+	expand := func(t *testing.T, exp []string) {
+		t.Helper()
+		got, err := hr.expand(test.Context(t), []string{"static", srvHost}, 42, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(exp, got); diff != "" {
+			t.Fatalf("unexpected hosts (-want, +got):\n%s\n", diff)
+		}
+	}
+
+	// Initial lookup.
+	expand(t, []string{"static", "host1:10001"})
+	test.AssertEqual(t, 1, lookups, "unexpected lookup count")
+
+	// Cached result is used until the refresh interval elapses.
+	records[srvName] = []*net.SRV{{Target: "host2.", Port: 10001}}
+	expand(t, []string{"static", "host1:10001"})
+	test.AssertEqual(t, 1, lookups, "unexpected lookup count")
+
+	now = now.Add(time.Minute)
+	expand(t, []string{"static", "host2:10001"})
+	test.AssertEqual(t, 2, lookups, "unexpected lookup count")
+
+	// Previous result is used if a refresh fails.
+	lookupErr = errors.New("lookup failed")
+	now = now.Add(time.Minute)
+	expand(t, []string{"static", "host2:10001"})
+	test.AssertEqual(t, 3, lookups, "unexpected lookup count")
+
+	// Lookup failure is returned if there is no previous result.
+	_, err := hr.expand(test.Context(t), []string{SRVHostPrefix + "other"}, 42, time.Minute)
+	test.CmpErr(t, lookupErr, err)
+
+	// Empty lookup results are treated as a failure.
+	lookupErr = nil
+	_, err = hr.expand(test.Context(t), []string{SRVHostPrefix + "other"}, 42, time.Minute)
+	test.CmpErr(t, errors.New("no targets found"), err)
+
+	// A nil resolver doesn't expand anything.
+	var nilHR *hostResolver
+	got, err := nilHR.expand(test.Context(t), []string{srvHost}, 42, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, []string{srvHost}, got, "unexpected hosts")
+}
+
+func TestControl_ParseHostList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     []string
+		expOut []string
+		expErr error
+	}{
+		"empty": {},
+		"static hosts": {
+			in:     []string{"foo", "bar:42"},
+			expOut: []string{"bar:42", "foo:10001"},
+		},
+		"bad static host": {
+			in:     []string{"::"},
+			expErr: errors.New("invalid host"),
+		},
+		"dynamic hosts retained": {
+			in:     []string{"srv:_daos._tcp.example.com", "foo", "10.0.0.0/24"},
+			expOut: []string{"foo:10001", "srv:_daos._tcp.example.com", "10.0.0.0/24"},
+		},
+		"bad range": {
+			in:     []string{"10.0.0.0/33"},
+			expErr: errors.New("invalid host range"),
+		},
+		"missing SRV name": {
+			in:     []string{"srv:"},
+			expErr: errors.New("missing record name"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotOut, gotErr := ParseHostList(tc.in, 10001)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expOut, gotOut); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_getRequestHosts(t *testing.T) {
 	defaultCfg := DefaultConfig()
 
@@ -77,9 +258,35 @@ func TestControl_getRequestHosts(t *testing.T) {
 			req:    &testTgtChooser{},
 			expOut: mockHostList(defaultCfg.HostList...),
 		},
+		"CIDR range in config hostlist": {
+			cfg: &Config{
+				ControlPort: 42,
+				HostList:    mockHostList("10.0.0.8/30"),
+			},
+			req:    &testTgtChooser{},
+			expOut: mockHostList("10.0.0.9:42", "10.0.0.10:42"),
+		},
+		"SRV record in request hostlist": {
+			cfg: defaultCfg,
+			req: &testTgtChooser{
+				hostList: mockHostList("srv:_daos._tcp.example.com"),
+			},
+			expOut: mockHostList("host1.example.com:10001", "host2.example.com:10002"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
-			gotOut, gotErr := getRequestHosts(tc.cfg, tc.req)
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			hr := newHostResolver(log)
+			hr.lookupSRV = mockLookupSRV(map[string][]*net.SRV{
+				"_daos._tcp.example.com": {
+					{Target: "host1.example.com.", Port: 10001},
+					{Target: "host2.example.com.", Port: 10002},
+				},
+			}, nil)
+
+			gotOut, gotErr := getRequestHosts(test.Context(t), tc.cfg, hr, tc.req)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
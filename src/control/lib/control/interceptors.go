@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,10 +9,16 @@ package control
 
 import (
 	"context"
+	"io"
+	"os"
+	"os/user"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/daos-stack/daos/src/control/build"
@@ -19,11 +26,40 @@ import (
 	"github.com/daos-stack/daos/src/control/security"
 )
 
+// ClientUserMetadataKey is the gRPC metadata key used to identify the user
+// on whose behalf a request is made, e.g. when reporting the owner of an
+// operation lock on the server.
+const ClientUserMetadataKey = "daos-client-user"
+
+var (
+	clientUserOnce sync.Once
+	clientUser     string
+)
+
+// getClientUser returns the name of the user running the client process, in
+// user@host format.
+func getClientUser() string {
+	clientUserOnce.Do(func() {
+		name := "unknown"
+		if u, err := user.Current(); err == nil {
+			name = u.Username
+		}
+		host, err := os.Hostname()
+		if err != nil {
+			host = "unknown"
+		}
+		clientUser = name + "@" + host
+	})
+	return clientUser
+}
+
 // connErrToFault attempts to resolve a network connection
 // error to a more informative Fault with resolution.
 func connErrToFault(st *status.Status, target string) error {
 	// Bleh. Can't find a better way to make these work.
 	switch {
+	case st.Code() == codes.ResourceExhausted && strings.Contains(st.Message(), "larger than max"):
+		return FaultRpcMsgTooLarge(target, st.Message())
 	case strings.Contains(st.Message(), "connection refused"):
 		return FaultConnectionRefused(target)
 	case strings.Contains(st.Message(), "connection closed"),
@@ -43,19 +79,40 @@ func connErrToFault(st *status.Status, target string) error {
 	}
 }
 
+// unwrapRPCError returns the error annotated by the server, if any, or
+// otherwise attempts to resolve the error to a more informative Fault.
+func unwrapRPCError(err error, target string) error {
+	st := status.Convert(err)
+	uErr := proto.UnwrapError(st)
+	if uErr.Error() != st.Err().Error() {
+		return uErr
+	}
+	return connErrToFault(st, target)
+}
+
+// errorUnwrappingStream unwraps any errors returned while receiving the
+// messages of a streaming RPC.
+type errorUnwrappingStream struct {
+	grpc.ClientStream
+	target string
+}
+
+func (s *errorUnwrappingStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return unwrapRPCError(err, s.target)
+}
+
 // streamErrorInterceptor calls the specified streaming RPC and returns any unwrapped errors.
 func streamErrorInterceptor() grpc.DialOption {
 	return grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
 		cs, err := streamer(ctx, desc, cc, method, opts...)
 		if err != nil {
-			st := status.Convert(err)
-			err = proto.UnwrapError(st)
-			if err.Error() != st.Err().Error() {
-				return cs, err
-			}
-			return cs, connErrToFault(st, cc.Target())
+			return cs, unwrapRPCError(err, cc.Target())
 		}
-		return cs, nil
+		return &errorUnwrappingStream{ClientStream: cs, target: cc.Target()}, nil
 	})
 }
 
@@ -64,12 +121,7 @@ func unaryErrorInterceptor() grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		err := invoker(ctx, method, req, reply, cc, opts...)
 		if err != nil {
-			st := status.Convert(err)
-			err = proto.UnwrapError(st)
-			if err.Error() != st.Err().Error() {
-				return err
-			}
-			return connErrToFault(st, cc.Target())
+			return unwrapRPCError(err, cc.Target())
 		}
 		return nil
 	}
@@ -99,3 +151,44 @@ func unaryVersionedComponentInterceptor(comp build.Component) grpc.UnaryClientIn
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// streamVersionedComponentInterceptor appends the component name and version to
+// the outgoing request headers of a streaming RPC.
+func streamVersionedComponentInterceptor(comp build.Component) grpc.StreamClientInterceptor {
+	return func(parent context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if comp == build.ComponentAny {
+			var err error
+			if comp, err = security.MethodToComponent(method); err != nil {
+				return nil, errors.Wrap(err, "unable to determine component from method")
+			}
+		}
+		ctx, err := build.ToContext(parent, comp, build.DaosVersion)
+		if err != nil {
+			if err != build.ErrCtxMetadataExists {
+				return nil, err
+			}
+			ctx = parent
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// unaryClientUserInterceptor appends the name of the user running the client
+// to the outgoing request headers.
+func unaryClientUserInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, ClientUserMetadataKey, getClientUser())
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// unaryProtoCompatInterceptor checks that the server implements the method
+// before calling it.
+func unaryProtoCompatInterceptor(pcc *protoCompatChecker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := pcc.checkMethod(ctx, cc, method); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,53 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestControl_connErrToFault(t *testing.T) {
+	target := "host1:10001"
+
+	for name, tc := range map[string]struct {
+		st           *status.Status
+		expErr       error
+		expMsgTooBig bool
+	}{
+		"connection refused": {
+			st:     status.New(codes.Unavailable, "dial tcp: connect: connection refused"),
+			expErr: FaultConnectionRefused(target),
+		},
+		"response too large": {
+			st: status.New(codes.ResourceExhausted,
+				"grpc: received message larger than max (20971520 vs. 16777216)"),
+			expErr:       FaultRpcMsgTooLarge(target, "grpc: received message larger than max (20971520 vs. 16777216)"),
+			expMsgTooBig: true,
+		},
+		"server send limit": {
+			st: status.New(codes.ResourceExhausted,
+				"grpc: trying to send message larger than max (20971520 vs. 16777216)"),
+			expErr:       FaultRpcMsgTooLarge(target, "grpc: trying to send message larger than max (20971520 vs. 16777216)"),
+			expMsgTooBig: true,
+		},
+		"other resource exhausted": {
+			st:     status.New(codes.ResourceExhausted, "quota exceeded"),
+			expErr: status.New(codes.ResourceExhausted, "quota exceeded").Err(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := connErrToFault(tc.st, target)
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expMsgTooBig, IsMsgTooLarge(gotErr), "unexpected IsMsgTooLarge result")
+		})
+	}
+}
@@ -0,0 +1,226 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// TailLogFollowTimeout is the default timeout of a TailLog request which
+// follows the logs, after which the logs are no longer followed.
+const TailLogFollowTimeout = 24 * time.Hour
+
+type (
+	// LogLine is a line read from a log on a host.
+	LogLine struct {
+		Host    string         `json:"host"`
+		Source  string         `json:"source"`         // "control" or "engine"
+		Rank    *ranklist.Rank `json:"rank,omitempty"` // Set for the log of an engine with a rank
+		Text    string         `json:"text"`
+		Dropped uint64         `json:"dropped,omitempty"` // Lines skipped by the server's rate limit before this one
+	}
+
+	// LogLineFunc is called with each line read from a followed log.
+	LogLineFunc func(*LogLine)
+
+	// TailLogReq contains the inputs for the tail log request.
+	TailLogReq struct {
+		unaryRequest
+		Ranks   ranklist.RankSet // Engines whose logs are read; all if empty
+		Control bool             // Read the control plane log instead of engine logs
+		Lines   int              // Number of lines to read from the end of each log
+		Follow  bool             // Keep reading lines as they are appended to the logs
+		lineFn  LogLineFunc
+	}
+
+	// TailLogResp contains the results of a tail log request.
+	TailLogResp struct {
+		HostErrorsResp
+		Lines []*LogLine `json:"lines"`
+	}
+)
+
+// SetLineFunc sets a function to be called with each line read from the logs
+// as it is received, rather than collecting the lines in the response. Lines
+// from multiple hosts are delivered one at a time.
+func (req *TailLogReq) SetLineFunc(fn LogLineFunc) {
+	if fn == nil {
+		req.lineFn = nil
+		return
+	}
+
+	var mu sync.Mutex
+	req.lineFn = func(line *LogLine) {
+		mu.Lock()
+		defer mu.Unlock()
+		fn(line)
+	}
+}
+
+func logLineFromPB(host string, pbLine *ctlpb.LogLine) *LogLine {
+	line := &LogLine{
+		Host:    host,
+		Source:  pbLine.Source,
+		Text:    pbLine.Text,
+		Dropped: pbLine.Dropped,
+	}
+	if rank := ranklist.Rank(pbLine.Rank); rank != ranklist.NilRank {
+		line.Rank = &rank
+	}
+	return line
+}
+
+func (resp *TailLogResp) addHostResponse(hr *HostResponse) error {
+	pbResp, ok := hr.Message.(*ctlpb.TailLogResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	for _, pbLine := range pbResp.GetLines() {
+		resp.Lines = append(resp.Lines, logLineFromPB(hr.Addr, pbLine))
+	}
+
+	return nil
+}
+
+// recvTailLog receives the lines streamed by a host. If a line function is
+// set, each line is passed to it and no lines are returned.
+func recvTailLog(stream grpc.ServerStreamingClient[ctlpb.TailLogResp], host string, lineFn LogLineFunc) (*ctlpb.TailLogResp, error) {
+	resp := new(ctlpb.TailLogResp)
+	if err := recvStream(stream, func(part *ctlpb.TailLogResp) error {
+		if lineFn == nil {
+			resp.Lines = append(resp.Lines, part.Lines...)
+			return nil
+		}
+		for _, pbLine := range part.Lines {
+			lineFn(logLineFromPB(host, pbLine))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// setRankHosts sets the request's host list to the hosts of the requested
+// ranks, as reported by the Management Service.
+func (req *TailLogReq) setRankHosts(ctx context.Context, rpcClient UnaryInvoker) error {
+	sqReq := new(SystemQueryReq)
+	sqReq.SetRanks(&req.Ranks)
+	sqResp, err := SystemQuery(ctx, rpcClient, sqReq)
+	if err != nil {
+		return errors.Wrap(err, "resolving hosts of ranks")
+	}
+	if err := sqResp.getAbsentHostsRanksErrors(); err != nil {
+		return err
+	}
+
+	hostSet := make(map[string]struct{})
+	var hosts []string
+	for _, m := range sqResp.Members {
+		if m.Addr == nil {
+			continue
+		}
+		if _, found := hostSet[m.Addr.String()]; found {
+			continue
+		}
+		hostSet[m.Addr.String()] = struct{}{}
+		hosts = append(hosts, m.Addr.String())
+	}
+	if len(hosts) == 0 {
+		return errors.Errorf("no hosts found for ranks %s", req.Ranks.String())
+	}
+	req.SetHostList(hosts)
+
+	return nil
+}
+
+// TailLog reads the lines at the end of the engine logs, or of the control plane
+// log, on each host in the request's host list. If ranks are requested and no
+// host list is set, the hosts of the ranks are read from the Management Service.
+//
+// If the request follows the logs, lines appended to them are passed to the
+// request's line function until the context is canceled or the request times
+// out, and the response only holds host errors.
+func TailLog(ctx context.Context, rpcClient UnaryInvoker, req *TailLogReq) (*TailLogResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if req.Lines < 0 {
+		return nil, errors.New("number of lines may not be negative")
+	}
+	if req.Follow && req.lineFn == nil {
+		return nil, errors.New("a line function is required to follow logs")
+	}
+	if req.Control && req.Ranks.Count() > 0 {
+		return nil, errors.New("ranks may not be selected with the control plane log")
+	}
+
+	if req.Ranks.Count() > 0 && len(req.getHostList()) == 0 {
+		if err := req.setRankHosts(ctx, rpcClient); err != nil {
+			return nil, err
+		}
+	}
+
+	pbReq := &ctlpb.TailLogReq{
+		Sys:     req.getSystem(rpcClient),
+		Ranks:   req.Ranks.String(),
+		Control: req.Control,
+		Lines:   uint32(req.Lines),
+		Follow:  req.Follow,
+	}
+	if req.Follow {
+		setDefaultTimeout(ctx, req, TailLogFollowTimeout)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		stream, err := ctlpb.NewCtlSvcClient(conn).TailLog(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := recvTailLog(stream, conn.Target(), req.lineFn)
+		if err != nil && req.Follow && ctx.Err() != nil {
+			// Following the logs ends when the request times out or
+			// is canceled.
+			return new(ctlpb.TailLogResp), nil
+		}
+		return resp, err
+	})
+	rpcClient.Debugf("DAOS tail log request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke tail log RPC: %s", err)
+		return nil, err
+	}
+
+	resp := new(TailLogResp)
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := resp.addHostResponse(hr); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
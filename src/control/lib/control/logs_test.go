@@ -0,0 +1,275 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockLogLine(host, source string, rank *ranklist.Rank, text string) *LogLine {
+	return &LogLine{
+		Host:   host,
+		Source: source,
+		Rank:   rank,
+		Text:   text,
+	}
+}
+
+func TestControl_TailLog(t *testing.T) {
+	tailLogResp := &ctlpb.TailLogResp{
+		Lines: []*ctlpb.LogLine{
+			{Source: "engine", Rank: 1, Text: "line 1"},
+			{Source: "engine", Rank: uint32(ranklist.NilRank), Text: "line 2", Dropped: 3},
+		},
+	}
+	rankMembers := &mgmtpb.SystemQueryResp{
+		Members: []*mgmtpb.SystemMember{
+			{Rank: 1, Uuid: test.MockUUID(1), State: system.MemberStateJoined.String(), Addr: "10.0.0.1:10001"},
+			{Rank: 2, Uuid: test.MockUUID(2), State: system.MemberStateJoined.String(), Addr: "10.0.0.1:10001"},
+			{Rank: 3, Uuid: test.MockUUID(3), State: system.MemberStateJoined.String(), Addr: "10.0.0.2:10001"},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		req         *TailLogReq
+		follow      bool
+		mic         *MockInvokerConfig
+		expHosts    []string
+		expResponse *TailLogResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"negative lines": {
+			req:    &TailLogReq{Lines: -1},
+			expErr: errors.New("may not be negative"),
+		},
+		"follow without line function": {
+			req:    &TailLogReq{Follow: true},
+			expErr: errors.New("line function is required"),
+		},
+		"ranks with control plane log": {
+			req: func() *TailLogReq {
+				req := &TailLogReq{Control: true}
+				req.Ranks.Add(1)
+				return req
+			}(),
+			expErr: errors.New("ranks may not be selected"),
+		},
+		"invoke fails": {
+			req: &TailLogReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("failed"),
+			},
+			expErr: errors.New("failed"),
+		},
+		"nil message": {
+			req: &TailLogReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1"},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"multiple hosts": {
+			req: &TailLogReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1", Message: tailLogResp},
+						{Addr: "host2", Message: &ctlpb.TailLogResp{}},
+						{Addr: "host3", Error: errors.New("failed")},
+					},
+				},
+			},
+			expResponse: &TailLogResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host3",
+					Error: "failed",
+				}),
+				Lines: []*LogLine{
+					mockLogLine("host1", "engine", ranklist.NewRankPtr(1), "line 1"),
+					{Host: "host1", Source: "engine", Text: "line 2", Dropped: 3},
+				},
+			},
+		},
+		"hosts of ranks": {
+			req: func() *TailLogReq {
+				req := &TailLogReq{}
+				req.Ranks.Add(1)
+				req.Ranks.Add(3)
+				return req
+			}(),
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("10.0.0.1:10001", nil, rankMembers),
+					{
+						Responses: []*HostResponse{
+							{Addr: "10.0.0.1:10001", Message: &ctlpb.TailLogResp{}},
+							{Addr: "10.0.0.2:10001", Message: &ctlpb.TailLogResp{}},
+						},
+					},
+				},
+			},
+			expHosts:    []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+			expResponse: &TailLogResp{},
+		},
+		"absent ranks": {
+			req: func() *TailLogReq {
+				req := &TailLogReq{}
+				req.Ranks.Add(4)
+				return req
+			}(),
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemQueryResp{
+					Absentranks: "4",
+				}),
+			},
+			expErr: errors.New("non-existent ranks 4"),
+		},
+		"ranks with host list": {
+			req: func() *TailLogReq {
+				req := &TailLogReq{}
+				req.Ranks.Add(1)
+				req.SetHostList([]string{"host1"})
+				return req
+			}(),
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1", Message: &ctlpb.TailLogResp{}},
+					},
+				},
+			},
+			expHosts:    []string{"host1"},
+			expResponse: &TailLogResp{},
+		},
+		"follow": {
+			req:    &TailLogReq{Follow: true},
+			follow: true,
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1", Message: &ctlpb.TailLogResp{}},
+					},
+				},
+			},
+			expResponse: &TailLogResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.follow {
+				tc.req.SetLineFunc(func(*LogLine) {})
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := TailLog(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			if tc.expHosts != nil {
+				test.AssertEqual(t, tc.expHosts, tc.req.getHostList(), "unexpected host list")
+			}
+			if tc.follow && tc.req.getDeadline().IsZero() {
+				t.Fatal("expected the follow timeout to be set")
+			}
+		})
+	}
+}
+
+func TestControl_recvTailLog(t *testing.T) {
+	rank1 := ranklist.NewRankPtr(1)
+	parts := func() []*ctlpb.TailLogResp {
+		return []*ctlpb.TailLogResp{
+			{Lines: []*ctlpb.LogLine{{Source: "control", Rank: uint32(ranklist.NilRank), Text: "a"}}},
+			{Lines: []*ctlpb.LogLine{{Source: "engine", Rank: 1, Text: "b"}}},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		stream    *mockStreamClient[ctlpb.TailLogResp]
+		setFn     bool
+		expPBResp *ctlpb.TailLogResp
+		expLines  []*LogLine
+		expErr    error
+	}{
+		"lines collected": {
+			stream: &mockStreamClient[ctlpb.TailLogResp]{parts: parts()},
+			expPBResp: &ctlpb.TailLogResp{
+				Lines: []*ctlpb.LogLine{
+					{Source: "control", Rank: uint32(ranklist.NilRank), Text: "a"},
+					{Source: "engine", Rank: 1, Text: "b"},
+				},
+			},
+		},
+		"lines passed to function": {
+			stream:    &mockStreamClient[ctlpb.TailLogResp]{parts: parts()},
+			setFn:     true,
+			expPBResp: &ctlpb.TailLogResp{},
+			expLines: []*LogLine{
+				mockLogLine("host1", "control", nil, "a"),
+				mockLogLine("host1", "engine", rank1, "b"),
+			},
+		},
+		"stream error": {
+			stream: &mockStreamClient[ctlpb.TailLogResp]{
+				parts: parts(),
+				err:   errors.New("stream failed"),
+			},
+			setFn:  true,
+			expErr: errors.New("stream failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotLines []*LogLine
+			var lineFn LogLineFunc
+			if tc.setFn {
+				lineFn = func(line *LogLine) {
+					gotLines = append(gotLines, line)
+				}
+			}
+
+			gotPBResp, gotErr := recvTailLog(tc.stream, "host1", lineFn)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expPBResp, gotPBResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expLines, gotLines); diff != "" {
+				t.Fatalf("unexpected lines (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
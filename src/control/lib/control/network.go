@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -199,6 +200,7 @@ type (
 	GetAttachInfoReq struct {
 		unaryRequest
 		msRequest
+		msReadRequest
 		retryableRequest
 		System   string
 		AllRanks bool
@@ -282,14 +284,57 @@ func (gair *GetAttachInfoResp) String() string {
 	)
 }
 
+// getAttachInfoStream retrieves the GetAttachInfo response as a stream of partial
+// responses and reassembles it.
+func getAttachInfoStream(ctx context.Context, conn *grpc.ClientConn, req *mgmtpb.GetAttachInfoReq) (*mgmtpb.GetAttachInfoResp, error) {
+	stream, err := mgmtpb.NewMgmtSvcClient(conn).GetAttachInfoStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *mgmtpb.GetAttachInfoResp
+	if err := recvStream(stream, func(part *mgmtpb.GetAttachInfoResp) error {
+		if err := part.DecompressRankURIs(); err != nil {
+			return errors.Wrap(err, "invalid GetAttachInfo response")
+		}
+		if resp == nil {
+			resp = part
+			return nil
+		}
+		resp.MergeRankURIs(part)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.New("empty GetAttachInfo response stream")
+	}
+
+	return resp, nil
+}
+
 // GetAttachInfo makes a request to the current MS leader in order to learn
 // the PSRs (rank/uri mapping) for the DAOS cluster. This information is used
 // by DAOS clients in order to make connections to DAOS servers over the storage fabric.
 func GetAttachInfo(ctx context.Context, rpcClient UnaryInvoker, req *GetAttachInfoReq) (*GetAttachInfoResp, error) {
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return mgmtpb.NewMgmtSvcClient(conn).GetAttachInfo(ctx, &mgmtpb.GetAttachInfoReq{
-			Sys:      req.getSystem(rpcClient),
-			AllRanks: req.AllRanks,
+		pbReq := &mgmtpb.GetAttachInfoReq{
+			Sys:            req.getSystem(rpcClient),
+			AllRanks:       req.AllRanks,
+			AcceptEncoding: mgmtpb.RankURIEncodingGzip,
+		}
+		resp, err := mgmtpb.NewMgmtSvcClient(conn).GetAttachInfo(ctx, pbReq)
+		if err == nil {
+			// Large rank URI tables may be sent compressed; restore them transparently.
+			if err := resp.DecompressRankURIs(); err != nil {
+				return nil, errors.Wrap(err, "invalid GetAttachInfo response")
+			}
+			return resp, nil
+		}
+
+		// Rank URI tables too large for a single message are retrieved in parts.
+		return invokeStreamFallback(err, func() (proto.Message, error) {
+			return getAttachInfoStream(ctx, conn, pbReq)
 		})
 	})
 	req.retryTestFn = func(err error, _ uint) bool {
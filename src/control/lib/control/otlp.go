@@ -0,0 +1,232 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	otlpQueueSize      = 1024
+	otlpMaxBatchSize   = 128
+	otlpFlushInterval  = 5 * time.Second
+	otlpRequestTimeout = 10 * time.Second
+)
+
+type (
+	// OTLPExporterConfig defines the parameters for exporting telemetry
+	// to an OpenTelemetry collector.
+	OTLPExporterConfig struct {
+		Endpoint   string
+		Headers    map[string]string
+		Component  build.Component
+		SystemName string
+		Hostname   string
+	}
+
+	otlpAnyValue struct {
+		StringValue *string `json:"stringValue,omitempty"`
+		IntValue    *string `json:"intValue,omitempty"`
+	}
+
+	otlpKeyValue struct {
+		Key   string       `json:"key"`
+		Value otlpAnyValue `json:"value"`
+	}
+
+	otlpScope struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	}
+
+	// otlpClient sends OTLP/HTTP requests with JSON encoding to a collector.
+	otlpClient struct {
+		cfg      OTLPExporterConfig
+		client   *http.Client
+		resource otlpResource
+	}
+
+	// otlpBatcher queues items to be exported and passes them to the export
+	// function in batches from a background goroutine. If the queue is full,
+	// items are dropped rather than blocking the caller.
+	otlpBatcher[T any] struct {
+		log      logging.Logger
+		what     string
+		endpoint string
+		export   func(context.Context, []T) error
+		queue    chan T
+		done     chan struct{}
+		once     sync.Once
+		failing  bool
+	}
+)
+
+func otlpString(key, val string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: &val}}
+}
+
+func otlpInt(key string, val int64) otlpKeyValue {
+	str := strconv.FormatInt(val, 10)
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{IntValue: &str}}
+}
+
+func newOTLPClient(cfg OTLPExporterConfig) *otlpClient {
+	resAttrs := []otlpKeyValue{
+		otlpString("service.name", "daos_"+cfg.Component.String()),
+		otlpString("service.version", build.DaosVersion),
+	}
+	if cfg.Hostname != "" {
+		resAttrs = append(resAttrs, otlpString("host.name", cfg.Hostname))
+	}
+	if cfg.SystemName != "" {
+		resAttrs = append(resAttrs, otlpString("daos.system", cfg.SystemName))
+	}
+
+	return &otlpClient{
+		cfg:      cfg,
+		client:   &http.Client{Timeout: otlpRequestTimeout},
+		resource: otlpResource{Attributes: resAttrs},
+	}
+}
+
+// post sends the JSON-encoded payload to the collector.
+func (oc *otlpClient) post(ctx context.Context, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "encoding OTLP request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, oc.cfg.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "creating OTLP request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, val := range oc.cfg.Headers {
+		req.Header.Set(key, val)
+	}
+
+	resp, err := oc.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("collector returned %s", resp.Status)
+	}
+
+	return nil
+}
+
+func newOTLPBatcher[T any](log logging.Logger, what, endpoint string, export func(context.Context, []T) error) *otlpBatcher[T] {
+	return &otlpBatcher[T]{
+		log:      log,
+		what:     what,
+		endpoint: endpoint,
+		export:   export,
+		queue:    make(chan T, otlpQueueSize),
+		done:     make(chan struct{}),
+	}
+}
+
+// add queues the item for export, returning false if the queue is full.
+func (b *otlpBatcher[T]) add(item T) bool {
+	select {
+	case b.queue <- item:
+		return true
+	default:
+		return false
+	}
+}
+
+func (b *otlpBatcher[T]) flush(ctx context.Context, batch []T) {
+	if len(batch) == 0 {
+		return
+	}
+
+	if err := b.export(ctx, batch); err != nil {
+		// Only log the first of a series of failures at notice level to
+		// avoid flooding the log while the collector is unavailable.
+		msg := "failed to export %d %s to %s: %s"
+		if !b.failing {
+			b.log.Noticef(msg, len(batch), b.what, b.endpoint, err)
+		} else {
+			b.log.Debugf(msg, len(batch), b.what, b.endpoint, err)
+		}
+		b.failing = true
+		return
+	}
+
+	if b.failing {
+		b.log.Noticef("resumed export of %s to %s", b.what, b.endpoint)
+		b.failing = false
+	}
+}
+
+func (b *otlpBatcher[T]) run(ctx context.Context) {
+	defer close(b.done)
+
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+
+	var batch []T
+	for {
+		select {
+		case <-ctx.Done():
+			// Make a best effort to send any remaining items.
+		drain:
+			for {
+				select {
+				case item := <-b.queue:
+					batch = append(batch, item)
+				default:
+					break drain
+				}
+			}
+			flushCtx, cancel := context.WithTimeout(context.Background(), otlpRequestTimeout)
+			b.flush(flushCtx, batch)
+			cancel()
+			return
+		case item := <-b.queue:
+			batch = append(batch, item)
+			if len(batch) < otlpMaxBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+
+		b.flush(ctx, batch)
+		batch = nil
+	}
+}
+
+func (b *otlpBatcher[T]) start(ctx context.Context) {
+	b.once.Do(func() {
+		go b.run(ctx)
+	})
+}
+
+func (b *otlpBatcher[T]) wait() {
+	<-b.done
+}
@@ -27,6 +27,7 @@ import (
 	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
 	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
 	"github.com/daos-stack/daos/src/control/lib/daos"
@@ -39,8 +40,6 @@ const (
 	// PoolCreateTimeout defines the amount of time a pool create
 	// request can take before being timed out.
 	PoolCreateTimeout = 10 * time.Minute // be generous for large pools
-	// DefaultPoolTimeout is the default timeout for a pool request.
-	DefaultPoolTimeout = 5 * time.Minute
 )
 
 // Pool create error conditions.
@@ -171,15 +170,6 @@ type (
 	}
 )
 
-func (r *poolRequest) getDeadline() time.Time {
-	// If the request has a custom deadline, use that.
-	if !r.deadline.IsZero() {
-		return r.deadline
-	}
-	r.SetTimeout(DefaultPoolTimeout)
-	return r.deadline
-}
-
 func (r *poolRequest) canRetry(reqErr error, try uint) bool {
 	// If the request has set a custom retry test function, use it.
 	if r.retryTestFn != nil {
@@ -214,6 +204,7 @@ type (
 	// PoolCreateReq contains the parameters for a pool create request.
 	PoolCreateReq struct {
 		poolRequest
+		progressRequest
 		UUID       uuid.UUID            `json:"uuid,omitempty"` // Optional UUID; auto-generate if not supplied
 		User       string               `json:"user"`
 		UserGroup  string               `json:"user_group"`
@@ -223,9 +214,16 @@ type (
 		TotalBytes uint64               `json:"total_bytes"` // Auto-sizing param
 		TierRatio  []float64            `json:"tier_ratio"`  // Auto-sizing param
 		NumRanks   uint32               `json:"num_ranks"`   // Auto-sizing param
-		Ranks      []ranklist.Rank      `json:"ranks"`       // Manual-sizing param
-		TierBytes  []uint64             `json:"tier_bytes"`  // Per-rank values
-		MemRatio   float32              `json:"mem_ratio"`   // mem_file_size:meta_blob_size
+		// Optional minimum total data capacity of a pool sized as a percentage
+		// of the available capacity.
+		MinTotalBytes uint64          `json:"min_total_bytes"`
+		Ranks         []ranklist.Rank `json:"ranks"`      // Manual-sizing param
+		TierBytes     []uint64        `json:"tier_bytes"` // Per-rank values
+		MemRatio      float32         `json:"mem_ratio"`  // mem_file_size:meta_blob_size
+		// Optional rank selection policy, evaluated by the MS.
+		PlacementPolicy string `json:"placement_policy"`
+		// Optional regex; ranks with matching fault domains are not selected.
+		ExcludeDomains string `json:"exclude_domains"`
 	}
 
 	// PoolCreateResp contains the response from a pool create request.
@@ -237,10 +235,104 @@ type (
 		TierBytes     []uint64 `json:"tier_bytes"`       // Per-rank storage tier sizes.
 		MemFileBytes  uint64   `json:"mem_file_bytes"`   // Per-rank. MD-on-SSD mode only.
 		MdOnSsdActive bool     `json:"md_on_ssd_active"` // MD-on-SSD mode.
+		// Placement policy applied and fault domains spanned by the target
+		// ranks, if placement options were requested.
+		PlacementPolicy string   `json:"placement_policy,omitempty"`
+		TgtFaultDomains []string `json:"tgt_fault_domains,omitempty"`
 	}
 )
 
-type maxPoolSizeGetter func(*PoolCreateReq) (uint64, uint64, error)
+// poolRankSizes holds the maximal tier sizes of a pool on each candidate rank.
+type poolRankSizes struct {
+	mdOnSSD   bool
+	tierBytes map[ranklist.Rank][]uint64
+}
+
+// ranks returns the candidate ranks in ascending order.
+func (prs *poolRankSizes) ranks() []ranklist.Rank {
+	ranks := make([]ranklist.Rank, 0, len(prs.tierBytes))
+	for rank := range prs.tierBytes {
+		ranks = append(ranks, rank)
+	}
+	sort.Slice(ranks, func(i, j int) bool { return ranks[i] < ranks[j] })
+
+	return ranks
+}
+
+// min returns the maximal tier sizes of a pool spanning all of the given ranks,
+// as the same tier sizes are allocated on every rank of a pool.
+func (prs *poolRankSizes) min(ranks ...ranklist.Rank) []uint64 {
+	minBytes := []uint64{math.MaxUint64, math.MaxUint64}
+	for _, rank := range ranks {
+		for i, b := range prs.tierBytes[rank] {
+			if b < minBytes[i] {
+				minBytes[i] = b
+			}
+		}
+	}
+	for i := range minBytes {
+		if minBytes[i] == math.MaxUint64 {
+			minBytes[i] = 0
+		}
+	}
+
+	return minBytes
+}
+
+// dataBytes returns the data capacity provided by the given per-rank tier sizes.
+// In MD-on-SSD mode the first tier holds metadata only.
+func (prs *poolRankSizes) dataBytes(tierBytes []uint64) uint64 {
+	if prs.mdOnSSD {
+		return tierBytes[1]
+	}
+
+	return tierBytes[0] + tierBytes[1]
+}
+
+// scale returns the candidate rank sizes multiplied by the given ratio.
+func (prs *poolRankSizes) scale(ratio float64) *poolRankSizes {
+	scaled := &poolRankSizes{
+		mdOnSSD:   prs.mdOnSSD,
+		tierBytes: make(map[ranklist.Rank][]uint64, len(prs.tierBytes)),
+	}
+	for rank, tierBytes := range prs.tierBytes {
+		scaled.tierBytes[rank] = []uint64{
+			uint64(float64(tierBytes[0]) * ratio),
+			uint64(float64(tierBytes[1]) * ratio),
+		}
+	}
+
+	return scaled
+}
+
+// largestDataRanks returns the subset of candidate ranks that provides a pool
+// with the most data capacity. Ranks with little available space are left out
+// when the capacity that they add is less than what they remove from the other
+// ranks.
+func (prs *poolRankSizes) largestDataRanks() []ranklist.Rank {
+	ranks := prs.ranks()
+	sort.SliceStable(ranks, func(i, j int) bool {
+		return prs.dataBytes(prs.tierBytes[ranks[i]]) > prs.dataBytes(prs.tierBytes[ranks[j]])
+	})
+
+	var best []ranklist.Rank
+	var bestBytes uint64
+	for i := range ranks {
+		tierBytes := prs.min(ranks[:i+1]...)
+		if tierBytes[0] == 0 {
+			break
+		}
+		if total := prs.dataBytes(tierBytes) * uint64(i+1); total > bestBytes {
+			best, bestBytes = ranks[:i+1], total
+		}
+	}
+	sort.Slice(best, func(i, j int) bool { return best[i] < best[j] })
+
+	return best
+}
+
+// maxPoolSizeGetter returns the maximal tier sizes of a pool on each candidate rank.
+type maxPoolSizeGetter func(*PoolCreateReq) (*poolRankSizes, error)
 
 func poolCreateReqChkSizes(log debugLogger, getMaxPoolSz maxPoolSizeGetter, req *PoolCreateReq) error {
 	hasTotBytes := req.TotalBytes > 0
@@ -249,6 +341,10 @@ func poolCreateReqChkSizes(log debugLogger, getMaxPoolSz maxPoolSizeGetter, req
 	hasTierRatio := len(req.TierRatio) == 2
 	hasNoTierRatio := len(req.TierRatio) == 0
 
+	if req.MinTotalBytes > 0 && !(hasNoTierBytes && hasTierRatio && !hasTotBytes) {
+		return errors.New("minimum pool size is only supported with a size percentage")
+	}
+
 	switch {
 	case hasTierBytes && hasNoTierRatio && !hasTotBytes:
 		if req.TierBytes[0] == 0 {
@@ -275,19 +371,47 @@ func poolCreateReqChkSizes(log debugLogger, getMaxPoolSz maxPoolSizeGetter, req
 		}
 		req.TierRatio = nil
 		// Storage tier ratios specified without a total size, use specified fraction of
-		// available space (auto-percentage-size).
-		scmBytes, nvmeBytes, err := getMaxPoolSz(req)
+		// available space on each rank (auto-percentage-size).
+		maxSizes, err := getMaxPoolSz(req)
 		if err != nil {
 			return err
 		}
-		req.TierBytes = []uint64{
-			uint64(float64(scmBytes) * availRatio),
-			uint64(float64(nvmeBytes) * availRatio),
+		rankSizes := maxSizes.scale(availRatio)
+		ranks := rankSizes.ranks()
+		for _, rank := range ranks {
+			log.Debugf("auto-percentage-size of rank %d: %s (%d B), %s (%d B)", rank,
+				humanize.Bytes(rankSizes.tierBytes[rank][0]), rankSizes.tierBytes[rank][0],
+				humanize.Bytes(rankSizes.tierBytes[rank][1]), rankSizes.tierBytes[rank][1])
 		}
+		req.TierBytes = rankSizes.min(ranks...)
+		pct := humanize.FtoaWithDigits(availRatio*100, 2)
 		if req.TierBytes[0] == 0 {
-			return errors.Errorf("Not enough SCM storage available with ratio %d%%: "+
-				"SCM storage capacity or ratio should be increased",
-				int(availRatio*100))
+			return errors.Errorf("Not enough SCM storage available with ratio %s%%: "+
+				"SCM storage capacity or ratio should be increased", pct)
+		}
+
+		totalBytes := rankSizes.dataBytes(req.TierBytes) * uint64(len(ranks))
+		canSelectRanks := len(req.Ranks) == 0 && req.PlacementPolicy == "" &&
+			req.ExcludeDomains == ""
+		if totalBytes < req.MinTotalBytes && canSelectRanks {
+			// Leave out ranks with little available space if that results in a
+			// pool with more data capacity.
+			if selected := rankSizes.largestDataRanks(); len(selected) < len(ranks) {
+				tierBytes := rankSizes.min(selected...)
+				if selTotal := rankSizes.dataBytes(tierBytes) * uint64(len(selected)); selTotal > totalBytes {
+					log.Debugf("auto-percentage-size selected ranks %v of %v", selected, ranks)
+					req.Ranks = selected
+					req.TierBytes = tierBytes
+					ranks = selected
+					totalBytes = selTotal
+				}
+			}
+		}
+		if totalBytes < req.MinTotalBytes {
+			return errors.Errorf("Not enough storage available with ratio %s%%: "+
+				"pool data capacity of %s on %d ranks is less than the minimum of %s",
+				pct, humanize.Bytes(totalBytes), len(ranks),
+				humanize.Bytes(req.MinTotalBytes))
 		}
 		log.Debugf("auto-percentage-size pool create mode: %+v", req)
 
@@ -305,7 +429,7 @@ func poolCreateGenPBReq(ctx context.Context, rpcClient UnaryInvoker, in *PoolCre
 		return
 	}
 
-	getMaxPoolSz := func(createReq *PoolCreateReq) (uint64, uint64, error) {
+	getMaxPoolSz := func(createReq *PoolCreateReq) (*poolRankSizes, error) {
 		return getMaxPoolSize(ctx, rpcClient, createReq)
 	}
 
@@ -336,9 +460,13 @@ func PoolCreate(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq)
 
 	// TODO: Set this timeout based on the SCM size, when we have a
 	// better understanding of the relationship.
-	req.SetTimeout(PoolCreateTimeout)
+	setDefaultTimeout(ctx, req, PoolCreateTimeout)
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return mgmtpb.NewMgmtSvcClient(conn).PoolCreate(ctx, pbReq)
+		return req.invokeWithProgress(func() (proto.Message, error) {
+			return poolCreateStream(ctx, conn, req, pbReq)
+		}, func() (proto.Message, error) {
+			return mgmtpb.NewMgmtSvcClient(conn).PoolCreate(ctx, pbReq)
+		})
 	})
 
 	rpcClient.Debugf("Create DAOS pool request: %+v\n", pbUtil.Debug(pbReq))
@@ -358,6 +486,21 @@ func PoolCreate(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq)
 	return pcr, nil
 }
 
+// poolCreateStream creates the pool, reporting the progress of the create pushed
+// by the management service.
+func poolCreateStream(ctx context.Context, conn *grpc.ClientConn, req *PoolCreateReq, pbReq *mgmtpb.PoolCreateReq) (*mgmtpb.PoolCreateResp, error) {
+	stream, err := mgmtpb.NewMgmtSvcClient(conn).PoolCreateStream(ctx, pbReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return recvProgressStream(stream, func(p *sharedpb.Progress) {
+		req.reportProgress(conn.Target(), p)
+	}, func(part *mgmtpb.PoolCreateStreamResp) (*sharedpb.Progress, *mgmtpb.PoolCreateResp) {
+		return part.GetProgress(), part.GetResp()
+	})
+}
+
 // PoolDestroyReq contains the parameters for a pool destroy request.
 type PoolDestroyReq struct {
 	poolRequest
@@ -422,19 +565,31 @@ func PoolUpgrade(ctx context.Context, rpcClient UnaryInvoker, req *PoolUpgradeRe
 	return errors.Wrap(ur.getMSError(), "pool upgrade failed")
 }
 
-// PoolEvictReq contains the parameters for a pool evict request.
+// PoolEvictReq contains the parameters for a pool evict request. The User,
+// Group and OlderThan filters restrict eviction to the matching pool handles
+// and may not be combined with Handles.
 type PoolEvictReq struct {
 	poolRequest
-	ID      string
-	Handles []string
+	ID        string
+	Handles   []string
+	User      string
+	Group     string
+	OlderThan time.Duration
 }
 
 // PoolEvict performs a pool connection evict operation on a DAOS Management Server instance.
 func PoolEvict(ctx context.Context, rpcClient UnaryInvoker, req *PoolEvictReq) error {
+	if len(req.Handles) > 0 && (req.User != "" || req.Group != "" || req.OlderThan > 0) {
+		return errors.New("pool evict filters may not be combined with a list of handles")
+	}
+
 	pbReq := &mgmtpb.PoolEvictReq{
-		Sys:     req.getSystem(rpcClient),
-		Id:      req.ID,
-		Handles: req.Handles,
+		Sys:       req.getSystem(rpcClient),
+		Id:        req.ID,
+		Handles:   req.Handles,
+		User:      req.User,
+		Group:     req.Group,
+		OlderThan: uint64(req.OlderThan / time.Second),
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolEvict(ctx, pbReq)
@@ -453,6 +608,7 @@ type (
 	// PoolQueryReq contains the parameters for a pool query request.
 	PoolQueryReq struct {
 		poolRequest
+		msReadRequest
 		ID        string
 		QueryMask daos.PoolQueryMask
 	}
@@ -466,15 +622,26 @@ type (
 	// PoolQueryTargetReq contains parameters for a pool query target request
 	PoolQueryTargetReq struct {
 		poolRequest
-		ID      string
-		Rank    ranklist.Rank
-		Targets []uint32
+		msReadRequest
+		ID            string
+		Rank          ranklist.Rank
+		Targets       []uint32
+		AllRanks      bool // Query the target states of all ranks in the pool
+		UnhealthyOnly bool // With AllRanks, only return ranks with targets not up_in
+	}
+
+	// PoolRankTargets contains the state of each target on a pool rank.
+	PoolRankTargets struct {
+		Rank   ranklist.Rank               `json:"rank"`
+		Status int32                       `json:"status"`
+		States []daos.PoolQueryTargetState `json:"states"`
 	}
 
 	// PoolQueryTargetResp contains a pool query target response
 	PoolQueryTargetResp struct {
 		Status int32 `json:"status"`
 		Infos  []*daos.PoolQueryTargetInfo
+		Ranks  []*PoolRankTargets `json:"ranks,omitempty"`
 	}
 )
 
@@ -489,10 +656,12 @@ func (pqr *PoolQueryResp) MarshalJSON() ([]byte, error) {
 	type Alias daos.PoolInfo
 	return json.Marshal(&struct {
 		*Alias
-		Status int32 `json:"status"`
+		Status           int32 `json:"status"`
+		CreateTimeAgeSec int64 `json:"create_time_age_sec,omitempty"`
 	}{
-		Alias:  (*Alias)(&pqr.PoolInfo),
-		Status: pqr.Status,
+		Alias:            (*Alias)(&pqr.PoolInfo),
+		Status:           pqr.Status,
+		CreateTimeAgeSec: int64(pqr.CreationAge(time.Now()).Seconds()),
 	})
 }
 
@@ -560,10 +729,12 @@ func (pqr *PoolQueryResp) UpdateState() error {
 // for the specified pool ID, pool engine rank, and target indices.
 func PoolQueryTargets(ctx context.Context, rpcClient UnaryInvoker, req *PoolQueryTargetReq) (*PoolQueryTargetResp, error) {
 	pbReq := &mgmtpb.PoolQueryTargetReq{
-		Sys:     req.getSystem(rpcClient),
-		Id:      req.ID,
-		Rank:    uint32(req.Rank),
-		Targets: req.Targets,
+		Sys:           req.getSystem(rpcClient),
+		Id:            req.ID,
+		Rank:          uint32(req.Rank),
+		Targets:       req.Targets,
+		AllRanks:      req.AllRanks,
+		UnhealthyOnly: req.UnhealthyOnly,
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolQueryTarget(ctx, pbReq)
@@ -595,6 +766,16 @@ func PoolQueryTargets(ctx context.Context, rpcClient UnaryInvoker, req *PoolQuer
 		}
 		pqtr.Infos = append(pqtr.Infos, tgtInfo)
 	}
+	for _, pbRank := range pbResp.Ranks {
+		rt := &PoolRankTargets{
+			Rank:   ranklist.Rank(pbRank.Rank),
+			Status: pbRank.Status,
+		}
+		for _, state := range pbRank.States {
+			rt.States = append(rt.States, daos.PoolQueryTargetState(state))
+		}
+		pqtr.Ranks = append(pqtr.Ranks, rt)
+	}
 	return pqtr, nil
 }
 
@@ -663,6 +844,7 @@ func PoolSetProp(ctx context.Context, rpcClient UnaryInvoker, req *PoolSetPropRe
 // PoolGetPropReq contains pool get-prop parameters.
 type PoolGetPropReq struct {
 	poolRequest
+	msReadRequest
 	// ID identifies the pool for which this property should be set.
 	ID string
 	// Name is always a string representation of the pool property.
@@ -941,6 +1123,325 @@ func PoolExtend(ctx context.Context, rpcClient UnaryInvoker, req *PoolExtendReq)
 	return errors.Wrap(ur.getMSError(), "pool extend failed")
 }
 
+// PoolRebalanceReq contains the parameters for a pool rebalance request.
+type PoolRebalanceReq struct {
+	poolRequest
+	ID string
+}
+
+// PoolRebalance redistributes the existing data of a DAOS pool across all of the pool's
+// targets, e.g. onto ranks added by a pool extend when the automatic rebuild was deferred.
+// Progress may be monitored via the rebuild status returned by pool query.
+func PoolRebalance(ctx context.Context, rpcClient UnaryInvoker, req *PoolRebalanceReq) error {
+	pbReq := &mgmtpb.PoolRebalanceReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.ID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolRebalance(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Rebalance DAOS pool request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(ur.getMSError(), "pool rebalance failed")
+}
+
+type (
+	// PoolRenameLabelReq contains the parameters for a pool label rename request.
+	PoolRenameLabelReq struct {
+		poolRequest
+		ID       string
+		NewLabel string
+	}
+
+	// PoolRenameLabelResp contains the result of a pool label rename. ClientMachines
+	// lists the machines with open handles on the pool, whose clients may still
+	// refer to the pool by its old label.
+	PoolRenameLabelResp struct {
+		UUID           string   `json:"uuid"`
+		OldLabel       string   `json:"old_label"`
+		NewLabel       string   `json:"new_label"`
+		ClientMachines []string `json:"client_machines"`
+		HandlesUnknown bool     `json:"handles_unknown"`
+	}
+)
+
+// PoolRenameLabel changes the label of a DAOS pool. The Management Service rejects
+// the request if the new label is already in use by another pool.
+func PoolRenameLabel(ctx context.Context, rpcClient UnaryInvoker, req *PoolRenameLabelReq) (*PoolRenameLabelResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if req.NewLabel == "" {
+		return nil, errors.New("new pool label must not be empty")
+	}
+	if req.NewLabel == req.ID {
+		return nil, errors.New("new pool label must differ from the current label")
+	}
+
+	pbReq := &mgmtpb.PoolRenameLabelReq{
+		Sys:      req.getSystem(rpcClient),
+		Id:       req.ID,
+		NewLabel: req.NewLabel,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolRenameLabel(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Rename DAOS pool label request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolRenameLabelResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "pool label rename failed")
+	}
+	resp.NewLabel = req.NewLabel
+
+	return resp, nil
+}
+
+type (
+	// PoolUpdateAliasesReq contains the parameters for a request to add or
+	// remove alias labels of a pool. If no aliases are added or removed, the
+	// current aliases of the pool are returned.
+	PoolUpdateAliasesReq struct {
+		poolRequest
+		ID     string
+		Add    []string
+		Remove []string
+	}
+
+	// PoolUpdateAliasesResp contains the aliases of a pool after an update.
+	PoolUpdateAliasesResp struct {
+		UUID    string   `json:"uuid"`
+		Label   string   `json:"label"`
+		Aliases []string `json:"aliases"`
+	}
+)
+
+// PoolUpdateAliases adds or removes alias labels of a DAOS pool. An alias may be
+// used in place of the pool's label anywhere a pool ID is accepted. The Management
+// Service rejects aliases which are already in use as the label or alias of
+// another pool.
+func PoolUpdateAliases(ctx context.Context, rpcClient UnaryInvoker, req *PoolUpdateAliasesReq) (*PoolUpdateAliasesResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	for _, aliases := range [][]string{req.Add, req.Remove} {
+		for _, alias := range aliases {
+			if alias == "" {
+				return nil, errors.New("pool alias must not be empty")
+			}
+		}
+	}
+
+	pbReq := &mgmtpb.PoolUpdateAliasesReq{
+		Sys:    req.getSystem(rpcClient),
+		Id:     req.ID,
+		Add:    req.Add,
+		Remove: req.Remove,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolUpdateAliases(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Update DAOS pool aliases request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolUpdateAliasesResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "pool alias update failed")
+	}
+
+	return resp, nil
+}
+
+type (
+	// PoolRebalanceTierEstimate contains the estimated data movement within a storage
+	// tier for a pool rebalance.
+	PoolRebalanceTierEstimate struct {
+		MediaType  daos.StorageMediaType `json:"media_type"`
+		Targets    uint32                `json:"targets"`
+		UsedBytes  uint64                `json:"used_bytes"`
+		MinUsed    uint64                `json:"min_used"`
+		MaxUsed    uint64                `json:"max_used"`
+		MovedBytes uint64                `json:"moved_bytes"`
+	}
+
+	// PoolRebalanceEstimate contains the estimated data movement for a pool rebalance.
+	PoolRebalanceEstimate struct {
+		Tiers []*PoolRebalanceTierEstimate `json:"tiers"`
+	}
+)
+
+// estimatePoolRebalance estimates the amount of data that would need to move in each
+// storage tier in order for all of the supplied targets to hold an equal share of the
+// used space. Targets that are not available to hold data are ignored.
+func estimatePoolRebalance(infos []*daos.PoolQueryTargetInfo) *PoolRebalanceEstimate {
+	var tierUsed [][]uint64
+	var mediaTypes []daos.StorageMediaType
+	for _, info := range infos {
+		switch info.State {
+		case daos.PoolTargetStateUp, daos.PoolTargetStateUpIn, daos.PoolTargetStateNew:
+		default:
+			continue
+		}
+
+		for tier, space := range info.Space {
+			if tier >= len(tierUsed) {
+				tierUsed = append(tierUsed, nil)
+				mediaTypes = append(mediaTypes, space.MediaType)
+			}
+			var used uint64
+			if space.Total > space.Free {
+				used = space.Total - space.Free
+			}
+			tierUsed[tier] = append(tierUsed[tier], used)
+		}
+	}
+
+	est := &PoolRebalanceEstimate{
+		Tiers: []*PoolRebalanceTierEstimate{},
+	}
+	for tier, used := range tierUsed {
+		tierEst := &PoolRebalanceTierEstimate{
+			MediaType: mediaTypes[tier],
+			Targets:   uint32(len(used)),
+			MinUsed:   math.MaxUint64,
+		}
+		for _, u := range used {
+			tierEst.UsedBytes += u
+			tierEst.MinUsed = min(tierEst.MinUsed, u)
+			tierEst.MaxUsed = max(tierEst.MaxUsed, u)
+		}
+
+		// Data only needs to move off of targets holding more than the mean.
+		mean := tierEst.UsedBytes / uint64(len(used))
+		for _, u := range used {
+			if u > mean {
+				tierEst.MovedBytes += u - mean
+			}
+		}
+		est.Tiers = append(est.Tiers, tierEst)
+	}
+
+	return est
+}
+
+// EstimatePoolRebalance queries the space usage of all targets on the enabled ranks of a
+// pool and estimates the amount of data that a rebalance would move.
+func EstimatePoolRebalance(ctx context.Context, rpcClient UnaryInvoker, req *PoolRebalanceReq) (*PoolRebalanceEstimate, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	queryReq := &PoolQueryReq{
+		ID:        req.ID,
+		QueryMask: daos.DefaultPoolQueryMask,
+	}
+	queryReq.SetSystem(req.Sys)
+	if err := queryReq.QueryMask.SetOptions(daos.PoolQueryOptionEnabledEngines); err != nil {
+		return nil, err
+	}
+
+	queryResp, err := PoolQuery(ctx, rpcClient, queryReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "pool query failed")
+	}
+	if queryResp.TotalEngines == 0 {
+		return estimatePoolRebalance(nil), nil
+	}
+
+	tgtCount := queryResp.TotalTargets / queryResp.TotalEngines
+	tgtIdxs := make([]uint32, tgtCount)
+	for i := range tgtIdxs {
+		tgtIdxs[i] = uint32(i)
+	}
+
+	var infos []*daos.PoolQueryTargetInfo
+	for _, rank := range queryResp.EnabledRanks.Ranks() {
+		tgtReq := &PoolQueryTargetReq{
+			ID:      req.ID,
+			Rank:    rank,
+			Targets: tgtIdxs,
+		}
+		tgtReq.SetSystem(req.Sys)
+
+		tgtResp, err := PoolQueryTargets(ctx, rpcClient, tgtReq)
+		if err != nil {
+			return nil, errors.Wrapf(err, "pool query targets on rank %d failed", rank)
+		}
+		infos = append(infos, tgtResp.Infos...)
+	}
+
+	return estimatePoolRebalance(infos), nil
+}
+
+type (
+	// PoolMembershipChangesReq contains the parameters for a request to list
+	// the pool target membership changes recorded after a sequence number.
+	PoolMembershipChangesReq struct {
+		unaryRequest
+		msRequest
+		msReadRequest
+		AfterSeq uint64
+	}
+
+	// PoolMembershipChange describes a change to the target membership of a
+	// pool, e.g. due to an extend or a completed rebuild.
+	PoolMembershipChange struct {
+		Seq       uint64 `json:"seq"`
+		PoolUUID  string `json:"pool_uuid"`
+		Op        string `json:"op"`
+		Timestamp string `json:"timestamp"`
+	}
+
+	// PoolMembershipChangesResp contains the matching changes, oldest first,
+	// and the sequence number of the most recently recorded event, which is
+	// used as the starting point of the next request. Resync is set if changes
+	// recorded after the requested sequence number may have been discarded.
+	PoolMembershipChangesResp struct {
+		Changes []*PoolMembershipChange `json:"changes"`
+		LastSeq uint64                  `json:"last_seq"`
+		Resync  bool                    `json:"resync"`
+	}
+)
+
+// PoolMembershipChanges lists the pool target membership changes recorded by
+// the Management Service after the requested sequence number.
+func PoolMembershipChanges(ctx context.Context, rpcClient UnaryInvoker, req *PoolMembershipChangesReq) (*PoolMembershipChangesResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+
+	pbReq := &mgmtpb.PoolMembershipChangesReq{
+		Sys:      req.getSystem(rpcClient),
+		AfterSeq: req.AfterSeq,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolMembershipChanges(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS PoolMembershipChanges request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolMembershipChangesResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
 // Implements poolRankOpSig.
 func poolReintegrateRank(ctx context.Context, rpcClient UnaryInvoker, req *PoolRanksReq, rank ranklist.Rank) (*PoolRankResult, error) {
 	pbReq := new(mgmtpb.PoolReintReq)
@@ -979,7 +1480,9 @@ func PoolReintegrate(ctx context.Context, rpcClient UnaryInvoker, req *PoolRanks
 type ListPoolsReq struct {
 	unaryRequest
 	msRequest
-	NoQuery bool
+	msReadRequest
+	NoQuery     bool
+	ChangeToken uint64 // Return NotModified if the system is unchanged since this token
 }
 
 // PoolQueryErr contains the error if a pool query failed.
@@ -994,6 +1497,8 @@ type ListPoolsResp struct {
 	Status      int32                       `json:"status"`
 	Pools       []*daos.PoolInfo            `json:"pools"`
 	QueryErrors map[uuid.UUID]*PoolQueryErr `json:"-"` // NB: Exported because of tests in other packages.
+	ChangeToken uint64                      `json:"-"` // Identifies the state of the system at the time of the response
+	NotModified bool                        `json:"-"` // Pool list omitted as the system is unchanged since the request token
 }
 
 // PoolQueryError returns the error if PoolQuery failed for the given pool.
@@ -1067,8 +1572,28 @@ func newListPoolsResp() *ListPoolsResp {
 
 // ListPools fetches the list of all pools and their service replicas from the
 // system.
+//
+// If the request carries the ChangeToken from an earlier response and the system
+// is unchanged since then, the response is flagged as NotModified and carries
+// no pools.
 func ListPools(ctx context.Context, rpcClient UnaryInvoker, req *ListPoolsReq) (*ListPoolsResp, error) {
-	pbReq := &mgmtpb.ListPoolsReq{Sys: req.getSystem(rpcClient)}
+	resp, err := listPools(ctx, rpcClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.NoQuery || resp.NotModified {
+		return resp, nil
+	}
+
+	return queryPools(ctx, rpcClient, resp)
+}
+
+func listPools(ctx context.Context, rpcClient UnaryInvoker, req *ListPoolsReq) (*ListPoolsResp, error) {
+	pbReq := &mgmtpb.ListPoolsReq{
+		Sys:         req.getSystem(rpcClient),
+		ChangeToken: req.ChangeToken,
+	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).ListPools(ctx, pbReq)
 	})
@@ -1084,11 +1609,19 @@ func ListPools(ctx context.Context, rpcClient UnaryInvoker, req *ListPoolsReq) (
 		return nil, err
 	}
 
-	if req.NoQuery {
-		return resp, nil
+	ci := new(changeInfo)
+	if err := convertMSResponse(ur, ci); err != nil {
+		return nil, err
 	}
+	resp.ChangeToken = ci.DataVersion
+	resp.NotModified = ci.NotModified
 
-	// issue query request and populate usage statistics for each pool
+	return resp, nil
+}
+
+// queryPools issues a query request for each pool in the list and populates its
+// usage statistics.
+func queryPools(ctx context.Context, rpcClient UnaryInvoker, resp *ListPoolsResp) (*ListPoolsResp, error) {
 	for i, p := range resp.Pools {
 		if p.State != daos.PoolServiceStateReady {
 			rpcClient.Debugf("Skipping query of pool in state: %s", p.State)
@@ -1138,14 +1671,13 @@ func newFilterRankFunc(ranks ranklist.RankList) filterRankFn {
 	}
 }
 
-// Add namespace ranks to rankNVMeFreeSpace map and return minimum free available SCM namespace bytes.
-func processSCMSpaceStats(log debugLogger, filterRank filterRankFn, scmNamespaces storage.ScmNamespaces, rankNVMeFreeSpace rankFreeSpaceMap) (uint64, error) {
-	scmBytes := uint64(math.MaxUint64)
-
+// Add free available SCM namespace bytes to rankSCMFreeSpace map and namespace ranks to
+// rankNVMeFreeSpace map.
+func processSCMSpaceStats(log debugLogger, filterRank filterRankFn, scmNamespaces storage.ScmNamespaces, rankSCMFreeSpace, rankNVMeFreeSpace rankFreeSpaceMap) error {
 	// Realistically there should only be one-per-rank but handle the case for multiple anyway.
 	for _, scmNamespace := range scmNamespaces {
 		if scmNamespace.Mount == nil {
-			return 0, errors.Errorf("SCM device %s (bdev %s, name %s) is not mounted",
+			return errors.Errorf("SCM device %s (bdev %s, name %s) is not mounted",
 				scmNamespace.UUID, scmNamespace.BlockDevice, scmNamespace.Name)
 		}
 
@@ -1156,22 +1688,17 @@ func processSCMSpaceStats(log debugLogger, filterRank filterRankFn, scmNamespace
 			continue
 		}
 
-		usableBytes := scmNamespace.Mount.UsableBytes
-
-		if scmBytes > usableBytes {
-			scmBytes = usableBytes
-		}
-
 		if _, exists := rankNVMeFreeSpace[scmNamespace.Mount.Rank]; exists {
-			return 0, errors.Errorf("Multiple SCM devices found for rank %d",
+			return errors.Errorf("Multiple SCM devices found for rank %d",
 				scmNamespace.Mount.Rank)
 		}
 
+		rankSCMFreeSpace[scmNamespace.Mount.Rank] = scmNamespace.Mount.UsableBytes
 		// Initialize entry for rank in NVMe free space map.
 		rankNVMeFreeSpace[scmNamespace.Mount.Rank] = 0
 	}
 
-	return scmBytes, nil
+	return nil
 }
 
 // Add NVMe free bytes to rankNVMeFreeSpace map.
@@ -1221,18 +1748,19 @@ func processNVMeSpaceStats(log debugLogger, filterRank filterRankFn, nvmeControl
 	return nil
 }
 
-// Return the maximal SCM and NVMe size of a pool which could be created with all the storage nodes.
-func getMaxPoolSize(ctx context.Context, rpcClient UnaryInvoker, createReq *PoolCreateReq) (uint64, uint64, error) {
+// Return the maximal SCM and NVMe size of a pool on each of the storage ranks which could be
+// used to create it.
+func getMaxPoolSize(ctx context.Context, rpcClient UnaryInvoker, createReq *PoolCreateReq) (*poolRankSizes, error) {
 	if createReq.MemRatio < 0 {
-		return 0, 0, errors.New("invalid mem-ratio, should be greater than zero")
+		return nil, errors.New("invalid mem-ratio, should be greater than zero")
 	}
 	if createReq.MemRatio > 1 {
-		return 0, 0, errors.New("invalid mem-ratio, should not be greater than one")
+		return nil, errors.New("invalid mem-ratio, should not be greater than one")
 	}
 
 	// Verify that the DAOS system is ready before attempting to query storage.
 	if _, err := SystemQuery(ctx, rpcClient, &SystemQueryReq{}); err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 
 	scanReq := &StorageScanReq{
@@ -1242,73 +1770,146 @@ func getMaxPoolSize(ctx context.Context, rpcClient UnaryInvoker, createReq *Pool
 
 	scanResp, err := StorageScan(ctx, rpcClient, scanReq)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 
 	if len(scanResp.HostStorage) == 0 {
-		return 0, 0, errors.New("Empty host storage response from StorageScan")
+		return nil, errors.New("Empty host storage response from StorageScan")
 	}
 
 	// Generate function to verify a rank is in the provided rank slice.
 	filterRank := newFilterRankFunc(ranklist.RankList(createReq.Ranks))
+	rankSCMFreeSpace := make(rankFreeSpaceMap)
 	rankNVMeFreeSpace := make(rankFreeSpaceMap)
-	scmBytes := uint64(math.MaxUint64)
 	for _, key := range scanResp.HostStorage.Keys() {
 		hostStorage := scanResp.HostStorage[key].HostStorage
 
 		if hostStorage.ScmNamespaces.Usable() == 0 {
-			return 0, 0, errors.Errorf("Host without SCM storage: hostname=%s",
+			return nil, errors.Errorf("Host without SCM storage: hostname=%s",
 				scanResp.HostStorage[key].HostSet.String())
 		}
 
-		sb, err := processSCMSpaceStats(rpcClient, filterRank, hostStorage.ScmNamespaces, rankNVMeFreeSpace)
-		if err != nil {
-			return 0, 0, err
-		}
-
-		if scmBytes > sb {
-			scmBytes = sb
+		if err := processSCMSpaceStats(rpcClient, filterRank, hostStorage.ScmNamespaces, rankSCMFreeSpace, rankNVMeFreeSpace); err != nil {
+			return nil, err
 		}
 
 		if err := processNVMeSpaceStats(rpcClient, filterRank, hostStorage.NvmeDevices, rankNVMeFreeSpace); err != nil {
-			return 0, 0, err
+			return nil, err
 		}
 	}
 
-	if scmBytes == math.MaxUint64 {
-		return 0, 0, errors.Errorf("No SCM storage space available with rank list %q",
+	if len(rankSCMFreeSpace) == 0 {
+		return nil, errors.Errorf("No SCM storage space available with rank list %q",
 			createReq.Ranks)
 	}
 
-	nvmeBytes := uint64(math.MaxUint64)
-	for _, nvmeRankBytes := range rankNVMeFreeSpace {
-		if nvmeBytes > nvmeRankBytes {
-			nvmeBytes = nvmeRankBytes
-		}
+	sizes := &poolRankSizes{
+		mdOnSSD:   scanResp.HostStorage.IsMdOnSsdEnabled(),
+		tierBytes: make(map[ranklist.Rank][]uint64, len(rankSCMFreeSpace)),
 	}
-
-	if !scanResp.HostStorage.IsMdOnSsdEnabled() {
+	if !sizes.mdOnSSD {
+		for rank, scmBytes := range rankSCMFreeSpace {
+			sizes.tierBytes[rank] = []uint64{scmBytes, rankNVMeFreeSpace[rank]}
+		}
+		minBytes := sizes.min(sizes.ranks()...)
 		rpcClient.Debugf("Maximal size of a pool: scmBytes=%s (%d B) nvmeBytes=%s (%d B)",
-			humanize.Bytes(scmBytes), scmBytes, humanize.Bytes(nvmeBytes), nvmeBytes)
+			humanize.Bytes(minBytes[0]), minBytes[0], humanize.Bytes(minBytes[1]), minBytes[1])
 
-		return scmBytes, nvmeBytes, nil
+		return sizes, nil
 	}
 	rpcClient.Debugf("md-on-ssd mode detected")
 
-	// In MD-on-SSD mode calculate metaBytes based on the minimum ramdisk (called scm here)
-	// availability across ranks. NVMe sizes returned in StorageScan response at the beginning
-	// of this function have been adjusted based on SSD bdev roles and MemRatio passed in the
-	// scan request. The rationale behind deriving pool sizes from ramdisk availability is that
-	// this is more likely to be the limiting factor than SSD usage.
+	// In MD-on-SSD mode calculate metaBytes of each rank based on its ramdisk (called scm here)
+	// availability. NVMe sizes returned in StorageScan response at the beginning of this
+	// function have been adjusted based on SSD bdev roles and MemRatio passed in the scan
+	// request. The rationale behind deriving pool sizes from ramdisk availability is that this
+	// is more likely to be the limiting factor than SSD usage.
 	if createReq.MemRatio == 0 {
 		createReq.MemRatio = 1
 	}
-	metaBytes := uint64(float64(scmBytes) / float64(createReq.MemRatio))
+	scmBytes := uint64(math.MaxUint64)
+	for rank, rankSCMBytes := range rankSCMFreeSpace {
+		metaBytes := uint64(float64(rankSCMBytes) / float64(createReq.MemRatio))
+		sizes.tierBytes[rank] = []uint64{metaBytes, rankNVMeFreeSpace[rank]}
+		if scmBytes > rankSCMBytes {
+			scmBytes = rankSCMBytes
+		}
+	}
+	minBytes := sizes.min(sizes.ranks()...)
 
 	rpcClient.Debugf("With minimum available ramdisk capacity of %s and mem-ratio %.2f,"+
 		" the maximum per-rank sizes for a pool are META=%s (%d B) and DATA=%s (%d B)",
-		humanize.Bytes(scmBytes), createReq.MemRatio, humanize.Bytes(metaBytes),
-		metaBytes, humanize.Bytes(nvmeBytes), nvmeBytes)
+		humanize.Bytes(scmBytes), createReq.MemRatio, humanize.Bytes(minBytes[0]),
+		minBytes[0], humanize.Bytes(minBytes[1]), minBytes[1])
+
+	return sizes, nil
+}
+
+// PoolRankFreeSpaceReq contains the parameters for a request to get the storage
+// available for pool creation on a set of ranks. The host list of the request
+// should contain the hosts of the ranks.
+type PoolRankFreeSpaceReq struct {
+	unaryRequest
+	Ranks    []ranklist.Rank
+	MemRatio float32
+}
+
+// GetPoolRankFreeSpace returns the storage available for pool creation on each of
+// the requested ranks. The values are per-tier byte counts that can be compared
+// with the per-rank tier sizes of a pool create request, so in MD-on-SSD mode the
+// first tier is the maximum metadata size that the available ramdisk capacity
+// allows with the given mem-ratio.
+func GetPoolRankFreeSpace(ctx context.Context, rpcClient UnaryInvoker, req *PoolRankFreeSpaceReq) (map[ranklist.Rank][]uint64, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	scanReq := &StorageScanReq{
+		Usage:    true,
+		MemRatio: req.MemRatio,
+	}
+	scanReq.SetHostList(req.HostList)
+
+	scanResp, err := StorageScan(ctx, rpcClient, scanReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := scanResp.Errors(); err != nil {
+		return nil, err
+	}
+
+	filterRank := newFilterRankFunc(ranklist.RankList(req.Ranks))
+	rankSCMFreeSpace := make(rankFreeSpaceMap)
+	rankNVMeFreeSpace := make(rankFreeSpaceMap)
+	for _, key := range scanResp.HostStorage.Keys() {
+		hostStorage := scanResp.HostStorage[key].HostStorage
+
+		for _, scmNamespace := range hostStorage.ScmNamespaces {
+			if scmNamespace.Mount == nil || !filterRank(scmNamespace.Mount.Rank) {
+				continue
+			}
+			rankSCMFreeSpace[scmNamespace.Mount.Rank] += scmNamespace.Mount.UsableBytes
+			rankNVMeFreeSpace[scmNamespace.Mount.Rank] = 0
+		}
+
+		if err := processNVMeSpaceStats(rpcClient, filterRank, hostStorage.NvmeDevices, rankNVMeFreeSpace); err != nil {
+			return nil, err
+		}
+	}
+
+	memRatio := req.MemRatio
+	if memRatio == 0 {
+		memRatio = 1
+	}
+	mdOnSSD := scanResp.HostStorage.IsMdOnSsdEnabled()
+
+	freeSpace := make(map[ranklist.Rank][]uint64, len(rankSCMFreeSpace))
+	for rank, scmBytes := range rankSCMFreeSpace {
+		if mdOnSSD {
+			scmBytes = uint64(float64(scmBytes) / float64(memRatio))
+		}
+		freeSpace[rank] = []uint64{scmBytes, rankNVMeFreeSpace[rank]}
+	}
 
-	return metaBytes, nvmeBytes, nil
+	return freeSpace, nil
 }
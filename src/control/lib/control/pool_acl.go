@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -21,6 +22,7 @@ import (
 type PoolGetACLReq struct {
 	unaryRequest
 	msRequest
+	msReadRequest
 	ID string // pool ID
 }
 
@@ -0,0 +1,204 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	pclient "github.com/prometheus/client_model/go"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// Names of the per-target engine metrics used to report pool aggregation and
+// garbage collection progress.
+const (
+	aggDeletedSizeMetric = "engine_pool_vos_aggregation_deleted_size"
+	aggMergedSizeMetric  = "engine_pool_vos_aggregation_merged_size"
+	aggFailCountMetric   = "engine_pool_vos_aggregation_fail_count"
+	aggLagMetric         = "engine_pool_vos_aggregation_lag"
+	aggLastRunMetric     = "engine_pool_vos_aggregation_last_run"
+	gcObjDelMetric       = "engine_pool_vos_gc_obj_del"
+	gcEvDelMetric        = "engine_pool_vos_gc_ev_del"
+	gcSvDelMetric        = "engine_pool_vos_gc_sv_del"
+	gcLastRunMetric      = "engine_pool_vos_gc_last_run"
+)
+
+type (
+	// PoolAggregationQueryReq contains the parameters for a query of the
+	// aggregation and garbage collection statistics of a pool.
+	PoolAggregationQueryReq struct {
+		httpReq
+		Hosts    []string // hosts to query for telemetry data
+		Port     uint32   // port to use for collecting telemetry data
+		PoolUUID string   // UUID of the pool to report on
+	}
+
+	// PoolRankAggregationStats contains the aggregation and garbage
+	// collection statistics of a pool on a single engine rank, summed
+	// across all of the engine's targets.
+	PoolRankAggregationStats struct {
+		Rank ranklist.Rank `json:"rank"`
+		// ReclaimedBytes is the size of the records deleted by aggregation.
+		ReclaimedBytes uint64 `json:"reclaimed_bytes"`
+		// MergedBytes is the size of the extents merged by aggregation.
+		MergedBytes uint64 `json:"merged_bytes"`
+		// Failures is the number of failed aggregation passes.
+		Failures uint64 `json:"failures"`
+		// Lag is the largest age of the last aggregated epoch on any
+		// target, i.e. the backlog of data waiting to be aggregated.
+		Lag time.Duration `json:"lag"`
+		// LastRun is the time of the most recent aggregation pass, or
+		// the zero time if aggregation has not run.
+		LastRun time.Time `json:"last_run"`
+		// GCReclaimedRecords is the number of objects and records
+		// reclaimed by garbage collection.
+		GCReclaimedRecords uint64 `json:"gc_reclaimed_records"`
+		// GCLastRun is the time of the most recent garbage collection
+		// pass, or the zero time if garbage collection has not run.
+		GCLastRun time.Time `json:"gc_last_run"`
+	}
+
+	// PoolAggregationQueryResp contains the per-rank aggregation and
+	// garbage collection statistics of a pool.
+	PoolAggregationQueryResp struct {
+		PoolUUID string                      `json:"pool_uuid"`
+		Ranks    []*PoolRankAggregationStats `json:"ranks"`
+	}
+)
+
+func pbMetricValue(m *pclient.Metric, mType pclient.MetricType) float64 {
+	switch mType {
+	case pclient.MetricType_COUNTER:
+		return m.GetCounter().GetValue()
+	case pclient.MetricType_GAUGE:
+		return m.GetGauge().GetValue()
+	default:
+		return m.GetUntyped().GetValue()
+	}
+}
+
+func unixSecondsToTime(secs float64) time.Time {
+	if secs <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(secs), 0)
+}
+
+func laterTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// addPoolAggregationStats adds the pool's values from the scraped metrics to
+// the per-rank statistics. Metrics that are not published by the engine are
+// ignored, so that older engines report zero values rather than an error.
+func addPoolAggregationStats(stats map[ranklist.Rank]*PoolRankAggregationStats, scraped pbMetricMap, poolUUID string) error {
+	for _, name := range []string{
+		aggDeletedSizeMetric, aggMergedSizeMetric, aggFailCountMetric, aggLagMetric,
+		aggLastRunMetric, gcObjDelMetric, gcEvDelMetric, gcSvDelMetric, gcLastRunMetric,
+	} {
+		mf, found := scraped[name]
+		if !found {
+			continue
+		}
+
+		for _, m := range mf.Metric {
+			labels := metricsLabelsToMap(m)
+			if labels["pool"] != poolUUID {
+				continue
+			}
+			r, err := strconv.ParseUint(labels["rank"], 10, 32)
+			if err != nil {
+				return errors.Wrapf(err, "invalid rank label on metric %q", name)
+			}
+			rank := ranklist.Rank(r)
+
+			rs, found := stats[rank]
+			if !found {
+				rs = &PoolRankAggregationStats{Rank: rank}
+				stats[rank] = rs
+			}
+
+			val := pbMetricValue(m, mf.GetType())
+			switch name {
+			case aggDeletedSizeMetric:
+				rs.ReclaimedBytes += uint64(val)
+			case aggMergedSizeMetric:
+				rs.MergedBytes += uint64(val)
+			case aggFailCountMetric:
+				rs.Failures += uint64(val)
+			case aggLagMetric:
+				if lag := time.Duration(val) * time.Second; lag > rs.Lag {
+					rs.Lag = lag
+				}
+			case aggLastRunMetric:
+				rs.LastRun = laterTime(rs.LastRun, unixSecondsToTime(val))
+			case gcObjDelMetric, gcEvDelMetric, gcSvDelMetric:
+				rs.GCReclaimedRecords += uint64(val)
+			case gcLastRunMetric:
+				rs.GCLastRun = laterTime(rs.GCLastRun, unixSecondsToTime(val))
+			}
+		}
+	}
+
+	return nil
+}
+
+// PoolAggregationQuery collects the aggregation and garbage collection
+// statistics of a pool from the telemetry published by the engines on the
+// supplied hosts.
+func PoolAggregationQuery(ctx context.Context, req *PoolAggregationQueryReq) (*PoolAggregationQueryResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if len(req.Hosts) == 0 {
+		return nil, errors.New("host must be specified")
+	}
+
+	if req.Port == 0 {
+		return nil, errors.New("port must be specified")
+	}
+
+	if req.PoolUUID == "" {
+		return nil, errors.New("pool UUID must be specified")
+	}
+
+	stats := make(map[ranklist.Rank]*PoolRankAggregationStats)
+	for _, host := range req.Hosts {
+		req.url = getMetricsURL(host, req.Port)
+
+		scraped, err := scrapeMetrics(ctx, req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to query metrics on %s", host)
+		}
+
+		if err := addPoolAggregationStats(stats, scraped, req.PoolUUID); err != nil {
+			return nil, errors.Wrapf(err, "host %s", host)
+		}
+	}
+
+	resp := &PoolAggregationQueryResp{
+		PoolUUID: req.PoolUUID,
+		Ranks:    make([]*PoolRankAggregationStats, 0, len(stats)),
+	}
+	for _, rs := range stats {
+		resp.Ranks = append(resp.Ranks, rs)
+	}
+	sort.Slice(resp.Ranks, func(i, j int) bool {
+		return resp.Ranks[i].Rank < resp.Ranks[j].Rank
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,161 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	pclient "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func newTestPoolTgtMetricFamily(name string, mType pclient.MetricType, values map[string][]float64) *pclient.MetricFamily {
+	fam := newTestMetricFamily(name, "help", mType)
+
+	// values are keyed by pool UUID, with one value per rank and target
+	for pool, vals := range values {
+		for i, val := range vals {
+			var m *pclient.Metric
+			if mType == pclient.MetricType_COUNTER {
+				m = newTestPBCounter(val)
+			} else {
+				m = newTestPBGauge(val)
+			}
+			for _, lbl := range []struct{ name, value string }{
+				{"pool", pool},
+				{"rank", []string{"0", "0", "1", "1"}[i]},
+				{"target", []string{"0", "1", "0", "1"}[i]},
+			} {
+				m.Label = append(m.Label, &pclient.LabelPair{
+					Name:  proto.String(lbl.name),
+					Value: proto.String(lbl.value),
+				})
+			}
+			fam.Metric = append(fam.Metric, m)
+		}
+	}
+
+	return fam
+}
+
+func TestControl_PoolAggregationQuery(t *testing.T) {
+	pool := test.MockUUID(1)
+	otherPool := test.MockUUID(2)
+	t1 := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	t2 := t1.Add(time.Minute)
+
+	testMetricFam := []*pclient.MetricFamily{
+		newTestPoolTgtMetricFamily(aggDeletedSizeMetric, pclient.MetricType_COUNTER,
+			map[string][]float64{pool: {100, 200, 0, 50}, otherPool: {1, 1, 1, 1}}),
+		newTestPoolTgtMetricFamily(aggMergedSizeMetric, pclient.MetricType_COUNTER,
+			map[string][]float64{pool: {10, 20, 30, 40}}),
+		newTestPoolTgtMetricFamily(aggFailCountMetric, pclient.MetricType_COUNTER,
+			map[string][]float64{pool: {0, 1, 0, 0}}),
+		newTestPoolTgtMetricFamily(aggLagMetric, pclient.MetricType_GAUGE,
+			map[string][]float64{pool: {5, 30, 0, 2}}),
+		newTestPoolTgtMetricFamily(aggLastRunMetric, pclient.MetricType_GAUGE,
+			map[string][]float64{pool: {float64(t1.Unix()), float64(t2.Unix()), 0, 0}}),
+		newTestPoolTgtMetricFamily(gcObjDelMetric, pclient.MetricType_COUNTER,
+			map[string][]float64{pool: {1, 1, 1, 1}}),
+		newTestPoolTgtMetricFamily(gcEvDelMetric, pclient.MetricType_COUNTER,
+			map[string][]float64{pool: {2, 0, 0, 0}}),
+		newTestPoolTgtMetricFamily(gcLastRunMetric, pclient.MetricType_GAUGE,
+			map[string][]float64{pool: {0, 0, float64(t1.Unix()), 0}}),
+	}
+
+	for name, tc := range map[string]struct {
+		scrapeFn func(context.Context, *url.URL, httpGetFn, time.Duration) ([]byte, error)
+		req      *PoolAggregationQueryReq
+		expResp  *PoolAggregationQueryResp
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"no host": {
+			req:    &PoolAggregationQueryReq{Port: 9191, PoolUUID: pool},
+			expErr: errors.New("host must be specified"),
+		},
+		"no port": {
+			req:    &PoolAggregationQueryReq{Hosts: []string{"host1"}, PoolUUID: pool},
+			expErr: errors.New("port must be specified"),
+		},
+		"no pool": {
+			req:    &PoolAggregationQueryReq{Hosts: []string{"host1"}, Port: 9191},
+			expErr: errors.New("pool UUID must be specified"),
+		},
+		"scrape failed": {
+			req: &PoolAggregationQueryReq{Hosts: []string{"host1"}, Port: 9191, PoolUUID: pool},
+			scrapeFn: func(context.Context, *url.URL, httpGetFn, time.Duration) ([]byte, error) {
+				return nil, errors.New("mock scrape")
+			},
+			expErr: errors.New("mock scrape"),
+		},
+		"no metrics": {
+			req: &PoolAggregationQueryReq{Hosts: []string{"host1"}, Port: 9191, PoolUUID: pool},
+			scrapeFn: func(context.Context, *url.URL, httpGetFn, time.Duration) ([]byte, error) {
+				return []byte{}, nil
+			},
+			expResp: &PoolAggregationQueryResp{
+				PoolUUID: pool,
+				Ranks:    []*PoolRankAggregationStats{},
+			},
+		},
+		"stats summed per rank": {
+			req:      &PoolAggregationQueryReq{Hosts: []string{"host1"}, Port: 9191, PoolUUID: pool},
+			scrapeFn: mockScrapeFnSuccess(t, testMetricFam...),
+			expResp: &PoolAggregationQueryResp{
+				PoolUUID: pool,
+				Ranks: []*PoolRankAggregationStats{
+					{
+						Rank:               0,
+						ReclaimedBytes:     300,
+						MergedBytes:        30,
+						Failures:           1,
+						Lag:                30 * time.Second,
+						LastRun:            t2,
+						GCReclaimedRecords: 4,
+					},
+					{
+						Rank:               1,
+						ReclaimedBytes:     50,
+						MergedBytes:        70,
+						Lag:                2 * time.Second,
+						GCReclaimedRecords: 2,
+						GCLastRun:          t1,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if tc.req != nil {
+				tc.req.getBodyFn = tc.scrapeFn
+			}
+
+			resp, err := PoolAggregationQuery(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) }),
+			}
+			if diff := cmp.Diff(tc.expResp, resp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+type (
+	// PoolPolicy defines the limits enforced by the MS when a pool is
+	// created for a user or group. Zero or empty limits are not enforced.
+	PoolPolicy struct {
+		Type              string   `json:"type"`
+		Principal         string   `json:"principal"`
+		MaxPools          uint32   `json:"max_pools"`
+		MaxTotalBytes     uint64   `json:"max_total_bytes"`
+		AllowedProperties []string `json:"allowed_props"`
+	}
+
+	// PoolSetPolicyReq contains the inputs for the pool set-policy request.
+	PoolSetPolicyReq struct {
+		unaryRequest
+		msRequest
+
+		Policy PoolPolicy
+	}
+
+	// PoolRemovePolicyReq contains the inputs for the pool remove-policy
+	// request.
+	PoolRemovePolicyReq struct {
+		unaryRequest
+		msRequest
+
+		Type      string
+		Principal string
+	}
+
+	// PoolListPoliciesReq contains the inputs for the pool list-policies
+	// request.
+	PoolListPoliciesReq struct {
+		unaryRequest
+		msRequest
+		msReadRequest
+	}
+
+	// PoolListPoliciesResp contains the pool policies stored by the MS.
+	PoolListPoliciesResp struct {
+		Policies []*PoolPolicy `json:"policies"`
+	}
+)
+
+// formatPrincipal adds the domain separator to a user or group name, if
+// it is missing.
+func formatPrincipal(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("user or group name cannot be empty")
+	}
+	if !strings.Contains(name, "@") {
+		name += "@"
+	}
+	return name, nil
+}
+
+// PoolSetPolicy adds or replaces the pool creation policy for a user or group.
+func PoolSetPolicy(ctx context.Context, rpcClient UnaryInvoker, req *PoolSetPolicyReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+
+	principal, err := formatPrincipal(req.Policy.Principal)
+	if err != nil {
+		return err
+	}
+
+	pbReq := &mgmtpb.PoolSetPolicyReq{
+		Sys: req.getSystem(rpcClient),
+		Policy: &mgmtpb.PoolPolicy{
+			Type:          req.Policy.Type,
+			Principal:     principal,
+			MaxPools:      req.Policy.MaxPools,
+			MaxTotalBytes: req.Policy.MaxTotalBytes,
+			AllowedProps:  req.Policy.AllowedProperties,
+		},
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolSetPolicy(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS PoolSetPolicy request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
+// PoolRemovePolicy removes the pool creation policy for a user or group.
+func PoolRemovePolicy(ctx context.Context, rpcClient UnaryInvoker, req *PoolRemovePolicyReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+
+	principal, err := formatPrincipal(req.Principal)
+	if err != nil {
+		return err
+	}
+
+	pbReq := &mgmtpb.PoolRemovePolicyReq{
+		Sys:       req.getSystem(rpcClient),
+		Type:      req.Type,
+		Principal: principal,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolRemovePolicy(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS PoolRemovePolicy request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
+// PoolListPolicies returns the pool creation policies stored by the MS.
+func PoolListPolicies(ctx context.Context, rpcClient UnaryInvoker, req *PoolListPoliciesReq) (*PoolListPoliciesResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.PoolListPoliciesReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolListPolicies(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS PoolListPolicies request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolListPoliciesResp)
+	return resp, convertMSResponse(ur, resp)
+}
@@ -0,0 +1,223 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_formatPrincipal(t *testing.T) {
+	for name, tc := range map[string]struct {
+		name   string
+		expOut string
+		expErr error
+	}{
+		"empty": {
+			expErr: errors.New("cannot be empty"),
+		},
+		"no domain": {
+			name:   "alice",
+			expOut: "alice@",
+		},
+		"with domain": {
+			name:   "alice@example",
+			expOut: "alice@example",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := formatPrincipal(tc.name)
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expOut, out, "unexpected principal")
+		})
+	}
+}
+
+func TestControl_PoolSetPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *PoolSetPolicyReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"empty principal": {
+			req: &PoolSetPolicyReq{
+				Policy: PoolPolicy{Type: "user"},
+			},
+			expErr: errors.New("name cannot be empty"),
+		},
+		"req fails": {
+			req: &PoolSetPolicyReq{
+				Policy: PoolPolicy{Type: "user", Principal: "alice"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &PoolSetPolicyReq{
+				Policy: PoolPolicy{
+					Type:              "group",
+					Principal:         "hpc",
+					MaxPools:          4,
+					AllowedProperties: []string{"reclaim"},
+				},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+			gotErr := PoolSetPolicy(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_PoolRemovePolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *PoolRemovePolicyReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"empty principal": {
+			req:    &PoolRemovePolicyReq{Type: "group"},
+			expErr: errors.New("name cannot be empty"),
+		},
+		"req fails": {
+			req: &PoolRemovePolicyReq{Type: "user", Principal: "alice"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("unable to find pool policy"), nil),
+				},
+			},
+			expErr: errors.New("unable to find pool policy"),
+		},
+		"success": {
+			req: &PoolRemovePolicyReq{Type: "user", Principal: "alice"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+			gotErr := PoolRemovePolicy(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_PoolListPolicies(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *PoolListPoliciesReq
+		mic     *MockInvokerConfig
+		expResp *PoolListPoliciesResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &PoolListPoliciesReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"no policies": {
+			req: &PoolListPoliciesReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.PoolListPoliciesResp{}),
+				},
+			},
+			expResp: &PoolListPoliciesResp{},
+		},
+		"success": {
+			req: &PoolListPoliciesReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.PoolListPoliciesResp{
+						Policies: []*mgmtpb.PoolPolicy{
+							{
+								Type:          "group",
+								Principal:     "hpc@",
+								MaxTotalBytes: 1 << 40,
+								AllowedProps:  []string{"reclaim"},
+							},
+							{
+								Type:      "user",
+								Principal: "alice@",
+								MaxPools:  2,
+							},
+						},
+					}),
+				},
+			},
+			expResp: &PoolListPoliciesResp{
+				Policies: []*PoolPolicy{
+					{
+						Type:              "group",
+						Principal:         "hpc@",
+						MaxTotalBytes:     1 << 40,
+						AllowedProperties: []string{"reclaim"},
+					},
+					{
+						Type:      "user",
+						Principal: "alice@",
+						MaxPools:  2,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := PoolListPolicies(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
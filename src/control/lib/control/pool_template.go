@@ -0,0 +1,181 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// PoolTemplateAttrPrefix is the prefix of the system attribute keys used to
+	// store pool templates on the Management Service.
+	PoolTemplateAttrPrefix = "pool_template."
+	// maxPoolTemplateNameLen is the maximum length of a pool template name.
+	maxPoolTemplateNameLen = 127
+)
+
+var poolTemplateNameRe = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+type (
+	// PoolTemplate defines a named set of pool create options. Sizes, ratios,
+	// rank lists and properties are specified in the same format as the
+	// corresponding dmg pool create options.
+	PoolTemplate struct {
+		Size       string   `yaml:"size,omitempty" json:"size,omitempty"`
+		TierRatio  string   `yaml:"tier_ratio,omitempty" json:"tier_ratio,omitempty"`
+		MemRatio   string   `yaml:"mem_ratio,omitempty" json:"mem_ratio,omitempty"`
+		ScmSize    string   `yaml:"scm_size,omitempty" json:"scm_size,omitempty"`
+		NVMeSize   string   `yaml:"nvme_size,omitempty" json:"nvme_size,omitempty"`
+		MetaSize   string   `yaml:"meta_size,omitempty" json:"meta_size,omitempty"`
+		DataSize   string   `yaml:"data_size,omitempty" json:"data_size,omitempty"`
+		NumRanks   uint32   `yaml:"nranks,omitempty" json:"nranks,omitempty"`
+		Ranks      string   `yaml:"ranks,omitempty" json:"ranks,omitempty"`
+		NumSvcReps uint32   `yaml:"nsvc,omitempty" json:"nsvc,omitempty"`
+		Properties string   `yaml:"properties,omitempty" json:"properties,omitempty"`
+		ACL        []string `yaml:"acl,omitempty" json:"acl,omitempty"`
+		User       string   `yaml:"user,omitempty" json:"user,omitempty"`
+		Group      string   `yaml:"group,omitempty" json:"group,omitempty"`
+	}
+
+	// PoolTemplateFile defines the layout of a pool template file.
+	PoolTemplateFile struct {
+		Templates map[string]*PoolTemplate `yaml:"templates"`
+	}
+)
+
+// GetACL returns the template's ACL entries as an AccessControlList, or nil if the
+// template does not define any entries.
+func (pt *PoolTemplate) GetACL() *AccessControlList {
+	if pt == nil || len(pt.ACL) == 0 {
+		return nil
+	}
+
+	return &AccessControlList{Entries: pt.ACL}
+}
+
+// PoolTemplateNameIsValid checks that a pool template name meets the length and
+// content requirements.
+func PoolTemplateNameIsValid(name string) bool {
+	return len(name) <= maxPoolTemplateNameLen && poolTemplateNameRe.MatchString(name)
+}
+
+// PoolTemplateNames returns the sorted names of the supplied templates.
+func PoolTemplateNames(templates map[string]*PoolTemplate) []string {
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// LoadPoolTemplates reads the pool templates defined in the YAML file at the
+// supplied path.
+func LoadPoolTemplates(path string) (map[string]*PoolTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading pool template file")
+	}
+
+	var tf PoolTemplateFile
+	if err := yaml.UnmarshalStrict(data, &tf); err != nil {
+		return nil, errors.Wrapf(err, "parsing pool template file %s", path)
+	}
+	if len(tf.Templates) == 0 {
+		return nil, errors.Errorf("pool template file %s contains no templates", path)
+	}
+
+	for name, tmpl := range tf.Templates {
+		if !PoolTemplateNameIsValid(name) {
+			return nil, errors.Errorf("invalid pool template name %q", name)
+		}
+		if tmpl == nil {
+			return nil, errors.Errorf("pool template %q is empty", name)
+		}
+	}
+
+	return tf.Templates, nil
+}
+
+// SetPoolTemplates stores the supplied pool templates on the Management Service,
+// replacing any existing templates with the same names. A nil template deletes
+// the stored template of that name.
+func SetPoolTemplates(ctx context.Context, rpcClient UnaryInvoker, templates map[string]*PoolTemplate) error {
+	if len(templates) == 0 {
+		return errors.New("no pool templates specified")
+	}
+
+	req := &SystemSetAttrReq{
+		Attributes: make(map[string]string),
+	}
+	for name, tmpl := range templates {
+		if !PoolTemplateNameIsValid(name) {
+			return errors.Errorf("invalid pool template name %q", name)
+		}
+
+		var val string
+		if tmpl != nil {
+			buf, err := json.Marshal(tmpl)
+			if err != nil {
+				return errors.Wrapf(err, "encoding pool template %q", name)
+			}
+			val = string(buf)
+		}
+		req.Attributes[PoolTemplateAttrPrefix+name] = val
+	}
+
+	return SystemSetAttr(ctx, rpcClient, req)
+}
+
+// GetPoolTemplates retrieves all of the pool templates stored on the Management
+// Service.
+func GetPoolTemplates(ctx context.Context, rpcClient UnaryInvoker) (map[string]*PoolTemplate, error) {
+	resp, err := SystemGetAttr(ctx, rpcClient, &SystemGetAttrReq{})
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[string]*PoolTemplate)
+	for key, val := range resp.Attributes {
+		if !strings.HasPrefix(key, PoolTemplateAttrPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(key, PoolTemplateAttrPrefix)
+
+		tmpl := new(PoolTemplate)
+		if err := json.Unmarshal([]byte(val), tmpl); err != nil {
+			return nil, errors.Wrapf(err, "decoding pool template %q", name)
+		}
+		templates[name] = tmpl
+	}
+
+	return templates, nil
+}
+
+// GetPoolTemplate retrieves the named pool template from the Management Service.
+func GetPoolTemplate(ctx context.Context, rpcClient UnaryInvoker, name string) (*PoolTemplate, error) {
+	templates, err := GetPoolTemplates(ctx, rpcClient)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, found := templates[name]
+	if !found {
+		return nil, errors.Errorf("pool template %q not found", name)
+	}
+
+	return tmpl, nil
+}
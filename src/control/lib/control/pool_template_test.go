@@ -0,0 +1,273 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_PoolTemplateNameIsValid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		name     string
+		expValid bool
+	}{
+		"empty": {},
+		"simple": {
+			name:     "prod-small",
+			expValid: true,
+		},
+		"dots and underscores": {
+			name:     "team_a.v2",
+			expValid: true,
+		},
+		"whitespace": {
+			name: "prod small",
+		},
+		"separator": {
+			name: "prod:small",
+		},
+		"too long": {
+			name: strings.Repeat("x", maxPoolTemplateNameLen+1),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := PoolTemplateNameIsValid(tc.name); got != tc.expValid {
+				t.Fatalf("expected valid=%t, got %t", tc.expValid, got)
+			}
+		})
+	}
+}
+
+func TestControl_LoadPoolTemplates(t *testing.T) {
+	for name, tc := range map[string]struct {
+		content      string
+		noFile       bool
+		expTemplates map[string]*PoolTemplate
+		expErr       error
+	}{
+		"missing file": {
+			noFile: true,
+			expErr: errors.New("reading pool template file"),
+		},
+		"no templates": {
+			content: "templates:\n",
+			expErr:  errors.New("contains no templates"),
+		},
+		"unknown key": {
+			content: "templates:\n  small:\n    sise: 1TB\n",
+			expErr:  errors.New("not found in type"),
+		},
+		"invalid name": {
+			content: "templates:\n  'bad name':\n    size: 1TB\n",
+			expErr:  errors.New("invalid pool template name"),
+		},
+		"empty template": {
+			content: "templates:\n  small:\n",
+			expErr:  errors.New("is empty"),
+		},
+		"success": {
+			content: `
+templates:
+  prod-small:
+    size: 10TB
+    tier_ratio: 6,94
+    nranks: 4
+    nsvc: 3
+    properties: rd_fac:1,space_rb:5
+    acl:
+      - A::OWNER@:rw
+      - A:G:GROUP@:r
+  prod-md:
+    meta_size: 1TB
+    data_size: 10TB
+    mem_ratio: "50"
+    ranks: 0-7
+    user: alice@
+    group: admins@
+`,
+			expTemplates: map[string]*PoolTemplate{
+				"prod-small": {
+					Size:       "10TB",
+					TierRatio:  "6,94",
+					NumRanks:   4,
+					NumSvcReps: 3,
+					Properties: "rd_fac:1,space_rb:5",
+					ACL:        []string{"A::OWNER@:rw", "A:G:GROUP@:r"},
+				},
+				"prod-md": {
+					MetaSize: "1TB",
+					DataSize: "10TB",
+					MemRatio: "50",
+					Ranks:    "0-7",
+					User:     "alice@",
+					Group:    "admins@",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			path := filepath.Join(testDir, "missing.yml")
+			if !tc.noFile {
+				path = test.CreateTestFile(t, testDir, tc.content)
+			}
+
+			gotTemplates, gotErr := LoadPoolTemplates(path)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expTemplates, gotTemplates); diff != "" {
+				t.Fatalf("unexpected templates (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SetPoolTemplates(t *testing.T) {
+	for name, tc := range map[string]struct {
+		templates map[string]*PoolTemplate
+		mic       *MockInvokerConfig
+		expAttrs  map[string]string
+		expErr    error
+	}{
+		"no templates": {
+			expErr: errors.New("no pool templates"),
+		},
+		"invalid name": {
+			templates: map[string]*PoolTemplate{
+				"bad name": {Size: "1TB"},
+			},
+			expErr: errors.New("invalid pool template name"),
+		},
+		"set fails": {
+			templates: map[string]*PoolTemplate{
+				"small": {Size: "1TB"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"set and delete": {
+			templates: map[string]*PoolTemplate{
+				"small": {Size: "1TB", NumSvcReps: 3},
+				"old":   nil,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+			},
+			expAttrs: map[string]string{
+				PoolTemplateAttrPrefix + "small": `{"size":"1TB","nsvc":3}`,
+				PoolTemplateAttrPrefix + "old":   "",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotErr := SetPoolTemplates(test.Context(t), mi, tc.templates)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if len(mi.SentReqs) != 1 {
+				t.Fatalf("expected 1 request, got %d", len(mi.SentReqs))
+			}
+			gotReq, ok := mi.SentReqs[0].(*SystemSetAttrReq)
+			if !ok {
+				t.Fatalf("unexpected request type %T", mi.SentReqs[0])
+			}
+			if diff := cmp.Diff(tc.expAttrs, gotReq.Attributes); diff != "" {
+				t.Fatalf("unexpected attributes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_GetPoolTemplate(t *testing.T) {
+	mockAttrs := map[string]string{
+		"foo":                            "bar",
+		PoolTemplateAttrPrefix + "small": `{"size":"1TB","nsvc":3}`,
+		PoolTemplateAttrPrefix + "large": `{"size":"100TB","ranks":"0-31"}`,
+	}
+
+	for name, tc := range map[string]struct {
+		attrs       map[string]string
+		remoteErr   error
+		tmplName    string
+		expTemplate *PoolTemplate
+		expErr      error
+	}{
+		"get fails": {
+			remoteErr: errors.New("remote failed"),
+			tmplName:  "small",
+			expErr:    errors.New("remote failed"),
+		},
+		"not found": {
+			attrs:    mockAttrs,
+			tmplName: "medium",
+			expErr:   errors.New(`"medium" not found`),
+		},
+		"bad encoding": {
+			attrs: map[string]string{
+				PoolTemplateAttrPrefix + "small": "size: 1TB",
+			},
+			tmplName: "small",
+			expErr:   errors.New("decoding pool template"),
+		},
+		"success": {
+			attrs:    mockAttrs,
+			tmplName: "large",
+			expTemplate: &PoolTemplate{
+				Size:  "100TB",
+				Ranks: "0-31",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", tc.remoteErr, &mgmtpb.SystemGetAttrResp{
+					Attributes: tc.attrs,
+				}),
+			})
+
+			gotTemplate, gotErr := GetPoolTemplate(test.Context(t), mi, tc.tmplName)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expTemplate, gotTemplate); diff != "" {
+				t.Fatalf("unexpected template (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -235,6 +236,553 @@ func TestControl_PoolUpgrade(t *testing.T) {
 	}
 }
 
+func TestControl_PoolRebalance(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		req    *PoolRebalanceReq
+		expErr error
+	}{
+		"local failure": {
+			req: &PoolRebalanceReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolRebalanceReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"-DER_GRPVER is retried": {
+			req: &PoolRebalanceReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", daos.GroupVersionMismatch, nil),
+					MockMSResponse("host1", nil, &mgmtpb.PoolRebalanceResp{}),
+				},
+			},
+		},
+		"success": {
+			req: &PoolRebalanceReq{
+				ID: test.MockUUID(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolRebalanceResp{},
+				),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotErr := PoolRebalance(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_PoolRenameLabel(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolRenameLabelReq
+		expResp *PoolRenameLabelResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolRenameLabelReq"),
+		},
+		"empty new label": {
+			req: &PoolRenameLabelReq{
+				ID: "old",
+			},
+			expErr: errors.New("must not be empty"),
+		},
+		"unchanged label": {
+			req: &PoolRenameLabelReq{
+				ID:       "old",
+				NewLabel: "old",
+			},
+			expErr: errors.New("must differ"),
+		},
+		"local failure": {
+			req: &PoolRenameLabelReq{
+				ID:       "old",
+				NewLabel: "new",
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolRenameLabelReq{
+				ID:       "old",
+				NewLabel: "new",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &PoolRenameLabelReq{
+				ID:       "old",
+				NewLabel: "new",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolRenameLabelResp{
+						Uuid:           test.MockUUID(),
+						OldLabel:       "old",
+						ClientMachines: []string{"client1", "client2"},
+					},
+				),
+			},
+			expResp: &PoolRenameLabelResp{
+				UUID:           test.MockUUID(),
+				OldLabel:       "old",
+				NewLabel:       "new",
+				ClientMachines: []string{"client1", "client2"},
+			},
+		},
+		"handles unknown": {
+			req: &PoolRenameLabelReq{
+				ID:       test.MockUUID(),
+				NewLabel: "new",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolRenameLabelResp{
+						Uuid:           test.MockUUID(),
+						OldLabel:       "old",
+						HandlesUnknown: true,
+					},
+				),
+			},
+			expResp: &PoolRenameLabelResp{
+				UUID:           test.MockUUID(),
+				OldLabel:       "old",
+				NewLabel:       "new",
+				HandlesUnknown: true,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolRenameLabel(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolUpdateAliases(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolUpdateAliasesReq
+		expResp *PoolUpdateAliasesResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolUpdateAliasesReq"),
+		},
+		"empty alias": {
+			req: &PoolUpdateAliasesReq{
+				ID:     "label",
+				Remove: []string{""},
+			},
+			expErr: errors.New("must not be empty"),
+		},
+		"local failure": {
+			req: &PoolUpdateAliasesReq{
+				ID:  "label",
+				Add: []string{"alias"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolUpdateAliasesReq{
+				ID:  "label",
+				Add: []string{"alias"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &PoolUpdateAliasesReq{
+				ID:  "label",
+				Add: []string{"alias"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolUpdateAliasesResp{
+						Uuid:    test.MockUUID(),
+						Label:   "label",
+						Aliases: []string{"old", "alias"},
+					},
+				),
+			},
+			expResp: &PoolUpdateAliasesResp{
+				UUID:    test.MockUUID(),
+				Label:   "label",
+				Aliases: []string{"old", "alias"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolUpdateAliases(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolMembershipChanges(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolMembershipChangesReq
+		expResp *PoolMembershipChangesResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolMembershipChangesReq"),
+		},
+		"local failure": {
+			req: &PoolMembershipChangesReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolMembershipChangesReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &PoolMembershipChangesReq{AfterSeq: 2},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolMembershipChangesResp{
+						Changes: []*mgmtpb.PoolMembershipChange{
+							{
+								Seq:       3,
+								PoolUuid:  test.MockUUID(),
+								Op:        "extend",
+								Timestamp: "2025-01-02T03:04:05.000+00:00",
+							},
+						},
+						LastSeq: 4,
+					},
+				),
+			},
+			expResp: &PoolMembershipChangesResp{
+				Changes: []*PoolMembershipChange{
+					{
+						Seq:       3,
+						PoolUUID:  test.MockUUID(),
+						Op:        "extend",
+						Timestamp: "2025-01-02T03:04:05.000+00:00",
+					},
+				},
+				LastSeq: 4,
+			},
+		},
+		"changes discarded": {
+			req: &PoolMembershipChangesReq{AfterSeq: 2},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolMembershipChangesResp{
+						LastSeq: 2000,
+						Resync:  true,
+					},
+				),
+			},
+			expResp: &PoolMembershipChangesResp{
+				LastSeq: 2000,
+				Resync:  true,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolMembershipChanges(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_estimatePoolRebalance(t *testing.T) {
+	mockInfo := func(state daos.PoolQueryTargetState, scmUsed, nvmeUsed uint64) *daos.PoolQueryTargetInfo {
+		return &daos.PoolQueryTargetInfo{
+			State: state,
+			Space: []*daos.StorageUsageStats{
+				{
+					Total:     1000,
+					Free:      1000 - scmUsed,
+					MediaType: daos.StorageMediaTypeScm,
+				},
+				{
+					Total:     10000,
+					Free:      10000 - nvmeUsed,
+					MediaType: daos.StorageMediaTypeNvme,
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		infos  []*daos.PoolQueryTargetInfo
+		expEst *PoolRebalanceEstimate
+	}{
+		"no targets": {
+			expEst: &PoolRebalanceEstimate{
+				Tiers: []*PoolRebalanceTierEstimate{},
+			},
+		},
+		"balanced": {
+			infos: []*daos.PoolQueryTargetInfo{
+				mockInfo(daos.PoolTargetStateUpIn, 100, 1000),
+				mockInfo(daos.PoolTargetStateUpIn, 100, 1000),
+			},
+			expEst: &PoolRebalanceEstimate{
+				Tiers: []*PoolRebalanceTierEstimate{
+					{
+						MediaType: daos.StorageMediaTypeScm,
+						Targets:   2,
+						UsedBytes: 200,
+						MinUsed:   100,
+						MaxUsed:   100,
+					},
+					{
+						MediaType: daos.StorageMediaTypeNvme,
+						Targets:   2,
+						UsedBytes: 2000,
+						MinUsed:   1000,
+						MaxUsed:   1000,
+					},
+				},
+			},
+		},
+		"new targets empty; down targets ignored": {
+			infos: []*daos.PoolQueryTargetInfo{
+				mockInfo(daos.PoolTargetStateUpIn, 300, 3000),
+				mockInfo(daos.PoolTargetStateUpIn, 300, 3000),
+				mockInfo(daos.PoolTargetStateNew, 0, 0),
+				mockInfo(daos.PoolTargetStateUp, 0, 0),
+				mockInfo(daos.PoolTargetStateDown, 900, 9000),
+				mockInfo(daos.PoolTargetStateDownOut, 900, 9000),
+			},
+			expEst: &PoolRebalanceEstimate{
+				Tiers: []*PoolRebalanceTierEstimate{
+					{
+						MediaType:  daos.StorageMediaTypeScm,
+						Targets:    4,
+						UsedBytes:  600,
+						MaxUsed:    300,
+						MovedBytes: 300,
+					},
+					{
+						MediaType:  daos.StorageMediaTypeNvme,
+						Targets:    4,
+						UsedBytes:  6000,
+						MaxUsed:    3000,
+						MovedBytes: 3000,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotEst := estimatePoolRebalance(tc.infos)
+
+			if diff := cmp.Diff(tc.expEst, gotEst); diff != "" {
+				t.Fatalf("unexpected estimate (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_EstimatePoolRebalance(t *testing.T) {
+	mockTgtResp := func(used ...uint64) *mgmtpb.PoolQueryTargetResp {
+		resp := &mgmtpb.PoolQueryTargetResp{}
+		for _, u := range used {
+			resp.Infos = append(resp.Infos, &mgmtpb.PoolQueryTargetInfo{
+				State: mgmtpb.PoolQueryTargetInfo_UP_IN,
+				Space: []*mgmtpb.StorageTargetUsage{
+					{
+						Total:     1000,
+						Free:      1000 - u,
+						MediaType: mgmtpb.StorageMediaType(daos.StorageMediaTypeScm),
+					},
+					{
+						MediaType: mgmtpb.StorageMediaType(daos.StorageMediaTypeNvme),
+					},
+				},
+			})
+		}
+		return resp
+	}
+	mockQueryResp := &mgmtpb.PoolQueryResp{
+		Uuid:         test.MockUUID(),
+		TotalTargets: 4,
+		TotalEngines: 2,
+		EnabledRanks: "0-1",
+	}
+
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		expEst *PoolRebalanceEstimate
+		expErr error
+	}{
+		"query failure": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"no engines": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+					Uuid: test.MockUUID(),
+				}),
+			},
+			expEst: &PoolRebalanceEstimate{
+				Tiers: []*PoolRebalanceTierEstimate{},
+			},
+		},
+		"target query failure": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, mockQueryResp),
+					MockMSResponse("host1", errors.New("remote failed"), nil),
+				},
+			},
+			expErr: errors.New("rank 0"),
+		},
+		"success": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, mockQueryResp),
+					MockMSResponse("host1", nil, mockTgtResp(400, 400)),
+					MockMSResponse("host1", nil, mockTgtResp(0, 0)),
+				},
+			},
+			expEst: &PoolRebalanceEstimate{
+				Tiers: []*PoolRebalanceTierEstimate{
+					{
+						MediaType:  daos.StorageMediaTypeScm,
+						Targets:    4,
+						UsedBytes:  800,
+						MaxUsed:    400,
+						MovedBytes: 400,
+					},
+					{
+						MediaType: daos.StorageMediaTypeNvme,
+						Targets:   4,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotEst, gotErr := EstimatePoolRebalance(ctx, mi, &PoolRebalanceReq{
+				ID: test.MockUUID(),
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expEst, gotEst); diff != "" {
+				t.Fatalf("unexpected estimate (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_PoolRanksReq_Convert(t *testing.T) {
 	req := &PoolRanksReq{
 		ID:        "foo",
@@ -802,6 +1350,14 @@ func TestControl_PoolEvict(t *testing.T) {
 			},
 			expErr: errors.New("remote failed"),
 		},
+		"filters with handles": {
+			req: &PoolEvictReq{
+				ID:      test.MockUUID(),
+				Handles: []string{test.MockUUID(1)},
+				User:    "alice",
+			},
+			expErr: errors.New("may not be combined"),
+		},
 		"success": {
 			req: &PoolEvictReq{
 				ID: test.MockUUID(),
@@ -812,6 +1368,19 @@ func TestControl_PoolEvict(t *testing.T) {
 				),
 			},
 		},
+		"success with filters": {
+			req: &PoolEvictReq{
+				ID:        test.MockUUID(),
+				User:      "alice",
+				Group:     "users",
+				OlderThan: time.Hour,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolEvictResp{},
+				),
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -851,6 +1420,10 @@ func TestControl_PoolCreateReq_Convert(t *testing.T) {
 		Ranks:      []ranklist.Rank{1, 2, 3},
 		TierBytes:  []uint64{humanize.GiByte, 10 * humanize.GiByte},
 		MemRatio:   0.55,
+		// Placement options are not normally combined with a rank
+		// list but the conversion doesn't care.
+		PlacementPolicy: "spread",
+		ExcludeDomains:  "^/rack0/",
 		Properties: []*daos.PoolProperty{
 			{
 				Name:   "label",
@@ -864,15 +1437,17 @@ func TestControl_PoolCreateReq_Convert(t *testing.T) {
 		t.Fatal(err)
 	}
 	expReqPB := &mgmtpb.PoolCreateReq{
-		User:       "bob",
-		UserGroup:  "work",
-		NumSvcReps: 2,
-		TotalBytes: 1,
-		TierRatio:  []float64{0.06, 0.94},
-		NumRanks:   3,
-		Ranks:      []uint32{1, 2, 3},
-		TierBytes:  []uint64{humanize.GiByte, 10 * humanize.GiByte},
-		MemRatio:   0.55,
+		User:            "bob",
+		UserGroup:       "work",
+		NumSvcReps:      2,
+		TotalBytes:      1,
+		TierRatio:       []float64{0.06, 0.94},
+		NumRanks:        3,
+		Ranks:           []uint32{1, 2, 3},
+		TierBytes:       []uint64{humanize.GiByte, 10 * humanize.GiByte},
+		MemRatio:        0.55,
+		PlacementPolicy: "spread",
+		ExcludeDomains:  "^/rack0/",
 		Properties: []*mgmtpb.PoolProperty{
 			{Number: 1, Value: &mgmtpb.PoolProperty_Strval{"foo"}},
 		},
@@ -888,11 +1463,28 @@ func TestControl_poolCreateReqChkSizes(t *testing.T) {
 	tierRatios := []float64{0.06, 0.94}
 	sameTierRatios := []float64{0.80, 0.80}
 	tierBytes := []uint64{humanize.GiByte * 6, humanize.GiByte * 94}
+	rankSizes := func(mdOnSSD bool, tierBytes ...[]uint64) *poolRankSizes {
+		prs := &poolRankSizes{
+			mdOnSSD:   mdOnSSD,
+			tierBytes: make(map[ranklist.Rank][]uint64),
+		}
+		for i, tb := range tierBytes {
+			prs.tierBytes[ranklist.Rank(i)] = tb
+		}
+		return prs
+	}
+	// Three ranks with plenty of free NVMe space and one that is nearly full.
+	unevenSizes := func() *poolRankSizes {
+		return rankSizes(true,
+			[]uint64{100 * humanize.GiByte, 1000 * humanize.GiByte},
+			[]uint64{100 * humanize.GiByte, 1000 * humanize.GiByte},
+			[]uint64{100 * humanize.GiByte, 1000 * humanize.GiByte},
+			[]uint64{100 * humanize.GiByte, 100 * humanize.GiByte})
+	}
 
 	for name, tc := range map[string]struct {
 		req              PoolCreateReq
-		getMaxScm        uint64
-		getMaxNvme       uint64
+		getMaxSizes      *poolRankSizes
 		getMaxErr        error
 		expNrGetMaxCalls int
 		expReq           *PoolCreateReq
@@ -921,13 +1513,14 @@ func TestControl_poolCreateReqChkSizes(t *testing.T) {
 			req: PoolCreateReq{
 				TierRatio: sameTierRatios,
 			},
-			expErr: errors.New("Not enough SCM"),
+			getMaxSizes: rankSizes(false, []uint64{0, 0}),
+			expErr:      errors.New("Not enough SCM"),
 		},
 		"auto-percentage-size; no nvme": {
 			req: PoolCreateReq{
 				TierRatio: sameTierRatios,
 			},
-			getMaxScm:        100 * humanize.GiByte,
+			getMaxSizes:      rankSizes(false, []uint64{100 * humanize.GiByte, 0}),
 			expNrGetMaxCalls: 1,
 			expReq: &PoolCreateReq{
 				TierBytes: []uint64{80 * humanize.GiByte, 0},
@@ -937,13 +1530,122 @@ func TestControl_poolCreateReqChkSizes(t *testing.T) {
 			req: PoolCreateReq{
 				TierRatio: sameTierRatios,
 			},
-			getMaxScm:        100 * humanize.GiByte,
-			getMaxNvme:       200 * humanize.GiByte,
+			getMaxSizes:      rankSizes(false, []uint64{100 * humanize.GiByte, 200 * humanize.GiByte}),
 			expNrGetMaxCalls: 1,
 			expReq: &PoolCreateReq{
 				TierBytes: []uint64{80 * humanize.GiByte, 160 * humanize.GiByte},
 			},
 		},
+		"auto-percentage-size; fractional ratio": {
+			req: PoolCreateReq{
+				TierRatio: []float64{0.125, 0.125},
+			},
+			getMaxSizes:      rankSizes(false, []uint64{100 * humanize.GiByte, 200 * humanize.GiByte}),
+			expNrGetMaxCalls: 1,
+			expReq: &PoolCreateReq{
+				TierBytes: []uint64{12.5 * humanize.GiByte, 25 * humanize.GiByte},
+			},
+		},
+		"auto-percentage-size; uneven ranks": {
+			req: PoolCreateReq{
+				TierRatio: sameTierRatios,
+			},
+			getMaxSizes: rankSizes(false,
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{50 * humanize.GiByte, 400 * humanize.GiByte}),
+			expNrGetMaxCalls: 1,
+			expReq: &PoolCreateReq{
+				TierBytes: []uint64{40 * humanize.GiByte, 160 * humanize.GiByte},
+			},
+		},
+		"auto-percentage-size; minimum size met": {
+			req: PoolCreateReq{
+				TierRatio:     sameTierRatios,
+				MinTotalBytes: 960 * humanize.GiByte,
+			},
+			getMaxSizes: rankSizes(false,
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte}),
+			expNrGetMaxCalls: 1,
+			expReq: &PoolCreateReq{
+				TierBytes:     []uint64{80 * humanize.GiByte, 160 * humanize.GiByte},
+				MinTotalBytes: 960 * humanize.GiByte,
+			},
+		},
+		"auto-percentage-size; minimum size not met": {
+			req: PoolCreateReq{
+				TierRatio:     sameTierRatios,
+				MinTotalBytes: 1200 * humanize.GiByte,
+			},
+			getMaxSizes: rankSizes(false,
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte}),
+			expErr: errors.New("pool data capacity of 1.0 TB on 4 ranks is less than the minimum of 1.3 TB"),
+		},
+		"auto-percentage-size; md-on-ssd minimum counts data tier only": {
+			req: PoolCreateReq{
+				TierRatio:     sameTierRatios,
+				MinTotalBytes: 700 * humanize.GiByte,
+			},
+			getMaxSizes: rankSizes(true,
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				[]uint64{100 * humanize.GiByte, 200 * humanize.GiByte}),
+			expErr: errors.New("pool data capacity of 687 GB on 4 ranks"),
+		},
+		"auto-percentage-size; uneven ranks; no minimum": {
+			req: PoolCreateReq{
+				TierRatio: sameTierRatios,
+			},
+			getMaxSizes:      unevenSizes(),
+			expNrGetMaxCalls: 1,
+			expReq: &PoolCreateReq{
+				TierBytes: []uint64{80 * humanize.GiByte, 80 * humanize.GiByte},
+			},
+		},
+		"auto-percentage-size; uneven ranks; minimum met by selecting ranks": {
+			req: PoolCreateReq{
+				TierRatio:     sameTierRatios,
+				MinTotalBytes: 2000 * humanize.GiByte,
+			},
+			getMaxSizes:      unevenSizes(),
+			expNrGetMaxCalls: 1,
+			expReq: &PoolCreateReq{
+				Ranks:         []ranklist.Rank{0, 1, 2},
+				TierBytes:     []uint64{80 * humanize.GiByte, 800 * humanize.GiByte},
+				MinTotalBytes: 2000 * humanize.GiByte,
+			},
+		},
+		"auto-percentage-size; uneven ranks; minimum not met with requested ranks": {
+			req: PoolCreateReq{
+				TierRatio:     sameTierRatios,
+				Ranks:         []ranklist.Rank{0, 1, 2, 3},
+				MinTotalBytes: 2000 * humanize.GiByte,
+			},
+			getMaxSizes: unevenSizes(),
+			expErr:      errors.New("pool data capacity of 344 GB on 4 ranks"),
+		},
+		"auto-percentage-size; uneven ranks; minimum not met with any ranks": {
+			req: PoolCreateReq{
+				TierRatio:     sameTierRatios,
+				MinTotalBytes: 3000 * humanize.GiByte,
+			},
+			getMaxSizes: unevenSizes(),
+			expErr:      errors.New("pool data capacity of 2.6 TB on 3 ranks"),
+		},
+		"minimum size without percentage": {
+			req: PoolCreateReq{
+				TierRatio:     tierRatios,
+				TotalBytes:    humanize.GiByte * 20,
+				MinTotalBytes: humanize.GiByte,
+			},
+			expErr: errors.New("only supported with a size percentage"),
+		},
 		"manual-size": {
 			req: PoolCreateReq{
 				TierBytes: tierBytes,
@@ -965,9 +1667,9 @@ func TestControl_poolCreateReqChkSizes(t *testing.T) {
 			defer test.ShowBufferOnFailure(t, buf)
 
 			nrGetMaxCalls := 0
-			getMaxPoolSz := func(createReq *PoolCreateReq) (uint64, uint64, error) {
+			getMaxPoolSz := func(createReq *PoolCreateReq) (*poolRankSizes, error) {
 				nrGetMaxCalls++
-				return tc.getMaxScm, tc.getMaxNvme, tc.getMaxErr
+				return tc.getMaxSizes, tc.getMaxErr
 			}
 
 			gotErr := poolCreateReqChkSizes(log, getMaxPoolSz, &tc.req)
@@ -2389,6 +3091,8 @@ func TestControl_ListPools(t *testing.T) {
 						Pools: []*mgmtpb.ListPoolsResp_Pool{
 							{
 								Uuid:    test.MockUUID(1),
+								Label:   "pool1",
+								Aliases: []string{"alias1"},
 								SvcReps: []uint32{1, 3, 5, 8},
 								State:   daos.PoolServiceStateReady.String(),
 							},
@@ -2402,6 +3106,8 @@ func TestControl_ListPools(t *testing.T) {
 					{
 						State:           daos.PoolServiceStateReady,
 						UUID:            test.MockPoolUUID(1),
+						Label:           "pool1",
+						Aliases:         []string{"alias1"},
 						TotalTargets:    42,
 						ActiveTargets:   42,
 						ServiceReplicas: []ranklist.Rank{1, 3, 5, 8},
@@ -2692,6 +3398,8 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 		queryError       error
 		expScmBytes      uint64
 		expNvmeBytes     uint64
+		expNumRanks      int
+		expRankBytes     map[ranklist.Rank][]uint64
 		expError         error
 		expDebug         string
 	}{
@@ -2705,6 +3413,7 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			},
 			expScmBytes:  100 * humanize.GByte,
 			expNvmeBytes: humanize.TByte,
+			expNumRanks:  1,
 		},
 		"single MD-on-SSD server; no mem-ratio specified; defaults to 1.0": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -2721,6 +3430,7 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			},
 			expScmBytes:  100 * humanize.GByte,
 			expNvmeBytes: humanize.TByte,
+			expNumRanks:  1,
 		},
 		"single MD-on-SSD server; invalid mem-ratio; high": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -2770,6 +3480,7 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			memRatio:     1,
 			expScmBytes:  100 * humanize.GByte,
 			expNvmeBytes: humanize.TByte,
+			expNumRanks:  1,
 		},
 		"single MD-on-SSD server; phase-2 mode (mem-file-sz < meta-blob-sz)": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -2787,6 +3498,7 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			memRatio:     0.5,
 			expScmBytes:  200 * humanize.GByte, // Double meta-blob-sz due to mem-ratio.
 			expNvmeBytes: humanize.TByte,
+			expNumRanks:  1,
 		},
 		"single ephemeral server": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -2798,6 +3510,7 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			},
 			expScmBytes:  100 * humanize.GByte,
 			expNvmeBytes: humanize.TByte,
+			expNumRanks:  1,
 		},
 		"double server": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -2855,6 +3568,13 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			},
 			expScmBytes:  50 * humanize.GByte,
 			expNvmeBytes: 700 * humanize.GByte,
+			expNumRanks:  4,
+			expRankBytes: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, humanize.TByte},
+				1: {humanize.TByte, humanize.TByte},
+				2: {100 * humanize.GByte, 700 * humanize.GByte},
+				3: {50 * humanize.GByte, 2 * humanize.TByte},
+			},
 		},
 		"double server; rank filter": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -2925,6 +3645,13 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			tgtRanks:     []ranklist.Rank{0, 1, 2, 4},
 			expScmBytes:  50 * humanize.GByte,
 			expNvmeBytes: 700 * humanize.GByte,
+			expNumRanks:  4,
+			expRankBytes: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, humanize.TByte},
+				1: {humanize.TByte, humanize.TByte},
+				2: {humanize.TByte, 700 * humanize.GByte},
+				4: {50 * humanize.GByte, 2 * humanize.TByte},
+			},
 		},
 		"No NVMe; single server": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -2936,6 +3663,7 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			},
 			expScmBytes:  100 * humanize.GByte,
 			expNvmeBytes: uint64(0),
+			expNumRanks:  1,
 		},
 		"No NVMe; double server": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -3005,6 +3733,7 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			tgtRanks:     []ranklist.Rank{0, 1, 2, 4},
 			expScmBytes:  50 * humanize.GByte,
 			expNvmeBytes: uint64(0),
+			expNumRanks:  4,
 		},
 		"SCM:NVMe ratio": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -3018,6 +3747,7 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			},
 			expScmBytes:  100 * humanize.GByte,
 			expNvmeBytes: 100 * humanize.TByte,
+			expNumRanks:  1,
 		},
 		"invalid response message": {
 			hostsConfigArray: []MockHostStorageConfig{{}},
@@ -3097,6 +3827,7 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			},
 			expScmBytes:  100 * humanize.GByte,
 			expNvmeBytes: uint64(0),
+			expNumRanks:  1,
 		},
 		"unmounted SCM device": {
 			hostsConfigArray: []MockHostStorageConfig{
@@ -3220,14 +3951,21 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 			mockInvoker := NewMockInvoker(log, mockInvokerConfig)
 
 			createReq := &PoolCreateReq{Ranks: tc.tgtRanks, MemRatio: tc.memRatio}
-			scmBytes, nvmeBytes, gotErr := getMaxPoolSize(test.Context(t), mockInvoker,
-				createReq)
+			sizes, gotErr := getMaxPoolSize(test.Context(t), mockInvoker, createReq)
 
 			test.CmpErr(t, tc.expError, gotErr)
 			if gotErr != nil {
 				return
 			}
 
+			minBytes := sizes.min(sizes.ranks()...)
+			scmBytes, nvmeBytes, numRanks := minBytes[0], minBytes[1], len(sizes.tierBytes)
+			if tc.expRankBytes != nil {
+				if diff := cmp.Diff(tc.expRankBytes, sizes.tierBytes); diff != "" {
+					t.Fatalf("unexpected per-rank sizes (-want, +got):\n%s\n", diff)
+				}
+			}
+
 			test.AssertEqual(t, tc.expScmBytes, scmBytes,
 				fmt.Sprintf("Invalid SCM pool size, want %s got %s",
 					humanize.Bytes(tc.expScmBytes), humanize.Bytes(scmBytes)))
@@ -3236,6 +3974,8 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 				fmt.Sprintf("Invalid NVMe pool size, want %s got %s",
 					humanize.Bytes(tc.expNvmeBytes), humanize.Bytes(nvmeBytes)))
 
+			test.AssertEqual(t, tc.expNumRanks, numRanks, "unexpected number of ranks")
+
 			if tc.expDebug != "" {
 				test.AssertTrue(t, strings.Contains(buf.String(), tc.expDebug),
 					"Missing log message: "+tc.expDebug)
@@ -3244,6 +3984,113 @@ func TestControl_getMaxPoolSize(t *testing.T) {
 	}
 }
 
+func TestControl_GetPoolRankFreeSpace(t *testing.T) {
+	for name, tc := range map[string]struct {
+		hostsConfigArray []MockHostStorageConfig
+		hostErr          error
+		tgtRanks         []ranklist.Rank
+		memRatio         float32
+		expFreeSpace     map[ranklist.Rank][]uint64
+		expErr           error
+	}{
+		"single server": {
+			hostsConfigArray: []MockHostStorageConfig{
+				{
+					HostName:   "foo",
+					ScmConfig:  []MockScmConfig{newScmCfg(0)},
+					NvmeConfig: []MockNvmeConfig{newNvmeCfg(0, 0)},
+				},
+			},
+			expFreeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, humanize.TByte},
+			},
+		},
+		"multiple ranks; filtered": {
+			hostsConfigArray: []MockHostStorageConfig{
+				{
+					HostName:   "foo",
+					ScmConfig:  []MockScmConfig{newScmCfg(0), newScmCfg(1, 50*humanize.GByte)},
+					NvmeConfig: []MockNvmeConfig{newNvmeCfg(0, 0), newNvmeCfg(1, 0, 2*humanize.TByte)},
+				},
+				{
+					HostName:   "bar",
+					ScmConfig:  []MockScmConfig{newScmCfg(2)},
+					NvmeConfig: []MockNvmeConfig{newNvmeCfg(2, 0)},
+				},
+			},
+			tgtRanks: []ranklist.Rank{1, 2},
+			expFreeSpace: map[ranklist.Rank][]uint64{
+				1: {50 * humanize.GByte, 2 * humanize.TByte},
+				2: {100 * humanize.GByte, humanize.TByte},
+			},
+		},
+		"no NVMe": {
+			hostsConfigArray: []MockHostStorageConfig{
+				{
+					HostName:  "foo",
+					ScmConfig: []MockScmConfig{newScmCfg(0)},
+				},
+			},
+			expFreeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, 0},
+			},
+		},
+		"MD-on-SSD; mem-ratio applied to metadata tier": {
+			hostsConfigArray: []MockHostStorageConfig{
+				{
+					HostName:  "foo",
+					ScmConfig: []MockScmConfig{newScmCfg(0)},
+					NvmeConfig: []MockNvmeConfig{
+						newNvmeCfg(0, storage.BdevRoleData),
+						newNvmeCfg(0, storage.BdevRoleWAL|storage.BdevRoleMeta, 2*humanize.TByte),
+					},
+				},
+			},
+			memRatio: 0.5,
+			expFreeSpace: map[ranklist.Rank][]uint64{
+				0: {200 * humanize.GByte, humanize.TByte},
+			},
+		},
+		"host error": {
+			hostsConfigArray: []MockHostStorageConfig{
+				{HostName: "foo"},
+			},
+			hostErr: errors.New("scan failed"),
+			expErr:  errors.New("1 host had errors"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			scanResp := &UnaryResponse{}
+			for _, hsc := range tc.hostsConfigArray {
+				hr := &HostResponse{Addr: hsc.HostName, Error: tc.hostErr}
+				if tc.hostErr == nil {
+					hr.Message = MockStorageScanResp(t, hsc.ScmConfig, hsc.NvmeConfig)
+				}
+				scanResp.Responses = append(scanResp.Responses, hr)
+			}
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponse: scanResp,
+			})
+
+			gotFreeSpace, gotErr := GetPoolRankFreeSpace(test.Context(t), mi, &PoolRankFreeSpaceReq{
+				Ranks:    tc.tgtRanks,
+				MemRatio: tc.memRatio,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expFreeSpace, gotFreeSpace); diff != "" {
+				t.Fatalf("unexpected free space (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 type MockRequestsRecorderInvoker struct {
 	MockInvoker
 	Requests []UnaryRequest
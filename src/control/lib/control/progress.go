@@ -0,0 +1,107 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+)
+
+type (
+	// ProgressUpdate describes the progress of a long-running request on a
+	// host, as pushed by the server.
+	ProgressUpdate struct {
+		Host      string `json:"host"`
+		Stage     string `json:"stage"`
+		Completed int    `json:"completed"`
+		Total     int    `json:"total"` // Zero if the progress of the stage can not be measured
+	}
+
+	// ProgressFunc is called with each update of the progress of a request.
+	ProgressFunc func(*ProgressUpdate)
+
+	// progressRequest can be embedded in requests that support updates of
+	// their progress.
+	progressRequest struct {
+		progressFn ProgressFunc
+	}
+)
+
+// SetProgressFunc sets a function to be called with the updates of the progress
+// of the request. Updates from multiple hosts are delivered one at a time.
+func (r *progressRequest) SetProgressFunc(fn ProgressFunc) {
+	if fn == nil {
+		r.progressFn = nil
+		return
+	}
+
+	var mu sync.Mutex
+	r.progressFn = func(update *ProgressUpdate) {
+		mu.Lock()
+		defer mu.Unlock()
+		fn(update)
+	}
+}
+
+func (r *progressRequest) reportProgress(host string, pbProgress *sharedpb.Progress) {
+	if r.progressFn == nil || pbProgress == nil {
+		return
+	}
+
+	r.progressFn(&ProgressUpdate{
+		Host:      host,
+		Stage:     pbProgress.Stage,
+		Completed: int(pbProgress.Completed),
+		Total:     int(pbProgress.Total),
+	})
+}
+
+// invokeWithProgress invokes the streaming variant of an RPC if updates of the
+// request's progress were requested, or the unary variant otherwise. The unary
+// variant is also invoked if the server does not implement the streaming one.
+func (r *progressRequest) invokeWithProgress(invokeStream, invokeUnary func() (proto.Message, error)) (proto.Message, error) {
+	if r.progressFn == nil {
+		return invokeUnary()
+	}
+
+	msg, err := invokeStream()
+	if status.Code(err) == codes.Unimplemented {
+		return invokeUnary()
+	}
+	return msg, err
+}
+
+// recvProgressStream receives the parts of a server-streaming RPC which sends
+// progress updates followed by the final response. The split function returns
+// the progress update or the response carried by a part.
+func recvProgressStream[T, R any](stream grpc.ServerStreamingClient[T], report func(*sharedpb.Progress), split func(*T) (*sharedpb.Progress, *R)) (*R, error) {
+	var resp *R
+	if err := recvStream(stream, func(part *T) error {
+		progress, partResp := split(part)
+		if progress != nil {
+			report(progress)
+		}
+		if partResp != nil {
+			resp = partResp
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.New("no response in progress stream")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,193 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+type mockStreamClient[T any] struct {
+	grpc.ClientStream
+	parts []*T
+	err   error
+}
+
+func (ms *mockStreamClient[T]) Recv() (*T, error) {
+	if len(ms.parts) == 0 {
+		if ms.err != nil {
+			return nil, ms.err
+		}
+		return nil, io.EOF
+	}
+
+	part := ms.parts[0]
+	ms.parts = ms.parts[1:]
+	return part, nil
+}
+
+func mockFormatProgress(stage string, completed, total uint32) *ctlpb.StorageFormatStreamResp {
+	return &ctlpb.StorageFormatStreamResp{
+		Update: &ctlpb.StorageFormatStreamResp_Progress{
+			Progress: &sharedpb.Progress{Stage: stage, Completed: completed, Total: total},
+		},
+	}
+}
+
+func TestControl_recvProgressStream(t *testing.T) {
+	formatResp := &ctlpb.StorageFormatResp{
+		Crets: []*ctlpb.NvmeControllerResult{{PciAddr: "0000:80:00.0"}},
+	}
+
+	for name, tc := range map[string]struct {
+		stream     *mockStreamClient[ctlpb.StorageFormatStreamResp]
+		setFn      bool
+		expUpdates []*ProgressUpdate
+		expResp    *ctlpb.StorageFormatResp
+		expErr     error
+	}{
+		"progress and response": {
+			stream: &mockStreamClient[ctlpb.StorageFormatStreamResp]{
+				parts: []*ctlpb.StorageFormatStreamResp{
+					mockFormatProgress("formatting SCM", 0, 2),
+					mockFormatProgress("formatting SCM", 2, 2),
+					{
+						Update: &ctlpb.StorageFormatStreamResp_Resp{Resp: formatResp},
+					},
+				},
+			},
+			setFn: true,
+			expUpdates: []*ProgressUpdate{
+				{Host: "host1", Stage: "formatting SCM", Completed: 0, Total: 2},
+				{Host: "host1", Stage: "formatting SCM", Completed: 2, Total: 2},
+			},
+			expResp: formatResp,
+		},
+		"no progress function": {
+			stream: &mockStreamClient[ctlpb.StorageFormatStreamResp]{
+				parts: []*ctlpb.StorageFormatStreamResp{
+					mockFormatProgress("formatting SCM", 0, 2),
+					{
+						Update: &ctlpb.StorageFormatStreamResp_Resp{Resp: formatResp},
+					},
+				},
+			},
+			expResp: formatResp,
+		},
+		"no response": {
+			stream: &mockStreamClient[ctlpb.StorageFormatStreamResp]{
+				parts: []*ctlpb.StorageFormatStreamResp{
+					mockFormatProgress("formatting SCM", 0, 0),
+				},
+			},
+			setFn: true,
+			expUpdates: []*ProgressUpdate{
+				{Host: "host1", Stage: "formatting SCM"},
+			},
+			expErr: errors.New("no response in progress stream"),
+		},
+		"stream error": {
+			stream: &mockStreamClient[ctlpb.StorageFormatStreamResp]{
+				err: errors.New("broken"),
+			},
+			setFn:  true,
+			expErr: errors.New("broken"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var updates []*ProgressUpdate
+			req := new(StorageFormatReq)
+			if tc.setFn {
+				req.SetProgressFunc(func(update *ProgressUpdate) {
+					updates = append(updates, update)
+				})
+			}
+
+			resp, err := recvProgressStream(tc.stream, func(p *sharedpb.Progress) {
+				req.reportProgress("host1", p)
+			}, func(part *ctlpb.StorageFormatStreamResp) (*sharedpb.Progress, *ctlpb.StorageFormatResp) {
+				return part.GetProgress(), part.GetResp()
+			})
+			test.CmpErr(t, tc.expErr, err)
+
+			if diff := cmp.Diff(tc.expUpdates, updates); diff != "" {
+				t.Fatalf("unexpected updates (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expResp, resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_invokeWithProgress(t *testing.T) {
+	unaryResp := &ctlpb.StorageFormatResp{}
+	streamResp := &ctlpb.StorageFormatResp{
+		Crets: []*ctlpb.NvmeControllerResult{{PciAddr: "0000:80:00.0"}},
+	}
+
+	for name, tc := range map[string]struct {
+		setFn     bool
+		streamErr error
+		expResp   proto.Message
+		expErr    error
+	}{
+		"no progress function": {
+			expResp: unaryResp,
+		},
+		"stream": {
+			setFn:   true,
+			expResp: streamResp,
+		},
+		"stream unimplemented": {
+			setFn:     true,
+			streamErr: status.Error(codes.Unimplemented, "unknown method"),
+			expResp:   unaryResp,
+		},
+		"stream fails": {
+			setFn:     true,
+			streamErr: errors.New("failed"),
+			expErr:    errors.New("failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := new(StorageFormatReq)
+			if tc.setFn {
+				req.SetProgressFunc(func(*ProgressUpdate) {})
+			}
+
+			resp, err := req.invokeWithProgress(func() (proto.Message, error) {
+				if tc.streamErr != nil {
+					return nil, tc.streamErr
+				}
+				return streamResp, nil
+			}, func() (proto.Message, error) {
+				return unaryResp, nil
+			})
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
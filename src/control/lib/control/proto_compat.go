@@ -0,0 +1,323 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type (
+	// remoteAPI is an index of the services and messages described by the
+	// protobuf file descriptors obtained from a server.
+	remoteAPI struct {
+		services map[string]map[string]struct{}
+		messages map[string]*descriptorpb.DescriptorProto
+	}
+
+	// protoCompatResult is the result of comparing the client's definition
+	// of a service with the server's.
+	protoCompatResult struct {
+		missing  map[string]struct{} // methods not implemented by the server
+		warnings []string
+	}
+
+	// protoCompatChecker validates the protobuf API of each server against
+	// the client's own the first time that a service is used on the server,
+	// so that incompatibilities between mixed versions of the control plane
+	// are reported clearly rather than as decode errors.
+	protoCompatChecker struct {
+		sync.Mutex
+		log     debugLogger
+		results map[string]*protoCompatResult
+		warned  map[string]struct{}
+	}
+
+	noticeLogger interface {
+		Noticef(string, ...interface{})
+	}
+)
+
+func newProtoCompatChecker(log debugLogger) *protoCompatChecker {
+	return &protoCompatChecker{
+		log:     log,
+		results: make(map[string]*protoCompatResult),
+		warned:  make(map[string]struct{}),
+	}
+}
+
+// splitMethod splits a full gRPC method name (e.g. /mgmt.MgmtSvc/PoolQuery)
+// into its service and method names.
+func splitMethod(fullMethod string) (string, string, error) {
+	comps := strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	if len(comps) != 2 || comps[0] == "" || comps[1] == "" {
+		return "", "", errors.Errorf("invalid gRPC method %q", fullMethod)
+	}
+	return comps[0], comps[1], nil
+}
+
+func newRemoteAPI(rawFiles [][]byte) (*remoteAPI, error) {
+	api := &remoteAPI{
+		services: make(map[string]map[string]struct{}),
+		messages: make(map[string]*descriptorpb.DescriptorProto),
+	}
+
+	var addMessages func(prefix string, msgs []*descriptorpb.DescriptorProto)
+	addMessages = func(prefix string, msgs []*descriptorpb.DescriptorProto) {
+		for _, msg := range msgs {
+			name := prefix + msg.GetName()
+			api.messages[name] = msg
+			addMessages(name+".", msg.GetNestedType())
+		}
+	}
+
+	for _, raw := range rawFiles {
+		fd := new(descriptorpb.FileDescriptorProto)
+		if err := proto.Unmarshal(raw, fd); err != nil {
+			return nil, errors.Wrap(err, "failed to decode server file descriptor")
+		}
+
+		prefix := ""
+		if fd.GetPackage() != "" {
+			prefix = fd.GetPackage() + "."
+		}
+		for _, svc := range fd.GetService() {
+			methods := make(map[string]struct{})
+			for _, m := range svc.GetMethod() {
+				methods[m.GetName()] = struct{}{}
+			}
+			api.services[prefix+svc.GetName()] = methods
+		}
+		addMessages(prefix, fd.GetMessageType())
+	}
+
+	return api, nil
+}
+
+// compareMessage compares the client's definition of a message (and any
+// messages that it contains) with the server's, returning a warning for each
+// field that is only known to one side.
+func (api *remoteAPI) compareMessage(local protoreflect.MessageDescriptor, seen map[protoreflect.FullName]struct{}) []string {
+	if _, found := seen[local.FullName()]; found {
+		return nil
+	}
+	seen[local.FullName()] = struct{}{}
+
+	remote, found := api.messages[string(local.FullName())]
+	if !found {
+		return []string{fmt.Sprintf("message %s is unknown to the server", local.FullName())}
+	}
+
+	remoteFields := make(map[int32]*descriptorpb.FieldDescriptorProto)
+	for _, f := range remote.GetField() {
+		remoteFields[f.GetNumber()] = f
+	}
+
+	var warnings []string
+	fields := local.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.Get(i)
+		if _, found := remoteFields[int32(f.Number())]; !found {
+			warnings = append(warnings, fmt.Sprintf("field %s (%d) is unknown to the server",
+				f.FullName(), f.Number()))
+		}
+		if f.Message() != nil {
+			warnings = append(warnings, api.compareMessage(f.Message(), seen)...)
+		}
+	}
+	for _, f := range remote.GetField() {
+		if fields.ByNumber(protoreflect.FieldNumber(f.GetNumber())) == nil {
+			warnings = append(warnings, fmt.Sprintf("field %s.%s (%d) is unknown to the client",
+				local.FullName(), f.GetName(), f.GetNumber()))
+		}
+	}
+
+	return warnings
+}
+
+// compareService compares the client's definition of a service with the
+// server's.
+func (api *remoteAPI) compareService(local protoreflect.ServiceDescriptor) *protoCompatResult {
+	result := &protoCompatResult{
+		missing: make(map[string]struct{}),
+	}
+
+	remoteMethods := api.services[string(local.FullName())]
+	seen := make(map[protoreflect.FullName]struct{})
+	methods := local.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		m := methods.Get(i)
+		if _, found := remoteMethods[string(m.Name())]; !found {
+			result.missing[string(m.Name())] = struct{}{}
+			continue
+		}
+		result.warnings = append(result.warnings, api.compareMessage(m.Input(), seen)...)
+		result.warnings = append(result.warnings, api.compareMessage(m.Output(), seen)...)
+	}
+
+	for name := range result.missing {
+		result.warnings = append(result.warnings, fmt.Sprintf("method %s.%s is not implemented by the server",
+			local.FullName(), name))
+	}
+	sort.Strings(result.warnings)
+
+	return result
+}
+
+// fetchServiceFiles uses the server's reflection service to obtain the file
+// descriptors which define the service. If the service is not registered on
+// the server, no descriptors are returned.
+func fetchServiceFiles(parent context.Context, cc *grpc.ClientConn, svc string) ([][]byte, error) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	stream, err := rpb.NewServerReflectionClient(cc).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.CloseSend()
+
+	query := func(req *rpb.ServerReflectionRequest) (*rpb.ServerReflectionResponse, error) {
+		if err := stream.Send(req); err != nil {
+			return nil, err
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if errResp := resp.GetErrorResponse(); errResp != nil {
+			return nil, status.Error(codes.Code(errResp.GetErrorCode()), errResp.GetErrorMessage())
+		}
+		return resp, nil
+	}
+
+	// The server can describe any message type that it was built with, so
+	// check that the service is actually registered first.
+	resp, err := query(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	registered := false
+	for _, s := range resp.GetListServicesResponse().GetService() {
+		if s.GetName() == svc {
+			registered = true
+			break
+		}
+	}
+	if !registered {
+		return nil, nil
+	}
+
+	resp, err = query(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: svc,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetFileDescriptorResponse().GetFileDescriptorProto(), nil
+}
+
+func (pcc *protoCompatChecker) warn(target, msg string) {
+	pcc.Lock()
+	_, warned := pcc.warned[msg]
+	pcc.warned[msg] = struct{}{}
+	pcc.Unlock()
+	if warned {
+		return
+	}
+
+	if nl, ok := pcc.log.(noticeLogger); ok {
+		nl.Noticef("API mismatch with server at %s: %s", target, msg)
+		return
+	}
+	pcc.log.Debugf("API mismatch with server at %s: %s", target, msg)
+}
+
+func (pcc *protoCompatChecker) getResult(ctx context.Context, cc *grpc.ClientConn, svc string) (*protoCompatResult, error) {
+	key := cc.Target() + "/" + svc
+
+	pcc.Lock()
+	result, found := pcc.results[key]
+	pcc.Unlock()
+	if found {
+		return result, nil
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(svc))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to find client descriptor for %s", svc)
+	}
+	localSvc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, errors.Errorf("%s is not a service", svc)
+	}
+
+	rawFiles, err := fetchServiceFiles(ctx, cc, svc)
+	switch {
+	case status.Code(errors.Cause(err)) == codes.Unimplemented:
+		pcc.log.Debugf("server at %s does not support reflection; skipping API check", cc.Target())
+		result = new(protoCompatResult)
+	case err != nil:
+		return nil, err
+	default:
+		api, err := newRemoteAPI(rawFiles)
+		if err != nil {
+			return nil, err
+		}
+		result = api.compareService(localSvc)
+	}
+
+	pcc.Lock()
+	pcc.results[key] = result
+	pcc.Unlock()
+
+	for _, msg := range result.warnings {
+		pcc.warn(cc.Target(), msg)
+	}
+
+	return result, nil
+}
+
+// checkMethod returns an error if the server does not implement the method.
+func (pcc *protoCompatChecker) checkMethod(ctx context.Context, cc *grpc.ClientConn, fullMethod string) error {
+	svc, method, err := splitMethod(fullMethod)
+	if err != nil {
+		return err
+	}
+
+	result, err := pcc.getResult(ctx, cc, svc)
+	if err != nil {
+		// Leave it to the request itself to report any problem with the
+		// connection; the check will be retried on the next request.
+		pcc.log.Debugf("unable to check API of server at %s: %s", cc.Target(), err)
+		return nil
+	}
+
+	if _, missing := result.missing[method]; missing {
+		return FaultAPIIncompatible(cc.Target(), svc+"."+method)
+	}
+
+	return nil
+}
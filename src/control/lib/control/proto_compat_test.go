@@ -0,0 +1,228 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_splitMethod(t *testing.T) {
+	for name, tc := range map[string]struct {
+		method    string
+		expSvc    string
+		expMethod string
+		expErr    error
+	}{
+		"valid": {
+			method:    "/mgmt.MgmtSvc/PoolQuery",
+			expSvc:    "mgmt.MgmtSvc",
+			expMethod: "PoolQuery",
+		},
+		"no method": {
+			method: "/mgmt.MgmtSvc/",
+			expErr: errors.New("invalid gRPC method"),
+		},
+		"too many components": {
+			method: "/mgmt.MgmtSvc/PoolQuery/extra",
+			expErr: errors.New("invalid gRPC method"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc, method, err := splitMethod(tc.method)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expSvc, svc, "unexpected service")
+			test.AssertEqual(t, tc.expMethod, method, "unexpected method")
+		})
+	}
+}
+
+// serviceFiles returns the file descriptors for the service and all of its
+// dependencies, after applying the supplied function to each of them.
+func serviceFiles(t *testing.T, svc protoreflect.ServiceDescriptor, modify func(*descriptorpb.FileDescriptorProto)) [][]byte {
+	t.Helper()
+
+	var rawFiles [][]byte
+	seen := make(map[string]struct{})
+	var addFile func(fd protoreflect.FileDescriptor)
+	addFile = func(fd protoreflect.FileDescriptor) {
+		if _, found := seen[fd.Path()]; found {
+			return
+		}
+		seen[fd.Path()] = struct{}{}
+
+		fdp := protodesc.ToFileDescriptorProto(fd)
+		if modify != nil {
+			modify(fdp)
+		}
+		raw, err := proto.Marshal(fdp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rawFiles = append(rawFiles, raw)
+
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			addFile(imports.Get(i).FileDescriptor)
+		}
+	}
+	addFile(svc.ParentFile())
+
+	return rawFiles
+}
+
+func TestControl_remoteAPI_compareService(t *testing.T) {
+	localSvc := mgmtpb.File_mgmt_mgmt_proto.Services().ByName("MgmtSvc")
+	if localSvc == nil {
+		t.Fatal("MgmtSvc descriptor not found")
+	}
+
+	for name, tc := range map[string]struct {
+		modify      func(*descriptorpb.FileDescriptorProto)
+		expMissing  []string
+		expWarnings []string
+	}{
+		"identical": {},
+		"mismatched": {
+			modify: func(fdp *descriptorpb.FileDescriptorProto) {
+				for _, svc := range fdp.GetService() {
+					var methods []*descriptorpb.MethodDescriptorProto
+					for _, m := range svc.GetMethod() {
+						if m.GetName() != "SystemQuery" {
+							methods = append(methods, m)
+						}
+					}
+					svc.Method = methods
+				}
+				for _, msg := range fdp.GetMessageType() {
+					switch msg.GetName() {
+					case "PoolQueryReq":
+						var fields []*descriptorpb.FieldDescriptorProto
+						for _, f := range msg.GetField() {
+							if f.GetName() != "query_mask" {
+								fields = append(fields, f)
+							}
+						}
+						msg.Field = fields
+					case "PoolQueryResp":
+						msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+							Name:   proto.String("future_field"),
+							Number: proto.Int32(999),
+							Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+						})
+					}
+				}
+			},
+			expMissing: []string{"SystemQuery"},
+			expWarnings: []string{
+				"field mgmt.PoolQueryReq.query_mask (4) is unknown to the server",
+				"field mgmt.PoolQueryResp.future_field (999) is unknown to the client",
+				"method mgmt.MgmtSvc.SystemQuery is not implemented by the server",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			api, err := newRemoteAPI(serviceFiles(t, localSvc, tc.modify))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			result := api.compareService(localSvc)
+
+			var missing []string
+			for method := range result.missing {
+				missing = append(missing, method)
+			}
+			test.AssertEqual(t, len(tc.expMissing), len(missing), "unexpected missing methods")
+			for _, method := range tc.expMissing {
+				if _, found := result.missing[method]; !found {
+					t.Fatalf("expected %s to be missing, got %v", method, missing)
+				}
+			}
+			test.AssertEqual(t, strings.Join(tc.expWarnings, "\n"), strings.Join(result.warnings, "\n"), "unexpected warnings")
+		})
+	}
+}
+
+type testMgmtSvc struct {
+	mgmtpb.UnimplementedMgmtSvcServer
+}
+
+func TestControl_protoCompatChecker_checkMethod(t *testing.T) {
+	for name, tc := range map[string]struct {
+		reflection bool
+		method     string
+		expErr     error
+	}{
+		"implemented": {
+			reflection: true,
+			method:     "/mgmt.MgmtSvc/PoolQuery",
+		},
+		"service not registered": {
+			reflection: true,
+			method:     "/ctl.CtlSvc/StorageScan",
+			expErr:     errors.New("does not implement ctl.CtlSvc.StorageScan"),
+		},
+		"server without reflection": {
+			method: "/ctl.CtlSvc/StorageScan",
+		},
+		"invalid method": {
+			method: "bad",
+			expErr: errors.New("invalid gRPC method"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			srv := grpc.NewServer()
+			mgmtpb.RegisterMgmtSvcServer(srv, &testMgmtSvc{})
+			if tc.reflection {
+				reflection.Register(srv)
+			}
+			go srv.Serve(lis)
+			defer srv.Stop()
+
+			cc, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer cc.Close()
+
+			pcc := newProtoCompatChecker(log)
+			for i := 0; i < 2; i++ {
+				err = pcc.checkMethod(test.Context(t), cc, tc.method)
+				test.CmpErr(t, tc.expErr, err)
+			}
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, 1, len(pcc.results), "expected result to be cached")
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -199,6 +200,23 @@ func (r *msRequest) isMSRequest() bool {
 	return true
 }
 
+// msReadRequest is an embeddable struct to be used alongside msRequest by
+// MS requests which do not modify the system state and may therefore be
+// serviced by any MS replica rather than only by the leader.
+type msReadRequest struct{}
+
+// isReadOnly indicates that the request may be serviced by any MS replica.
+func (r *msReadRequest) isReadOnly() bool {
+	return true
+}
+
+// isReadOnlyRequest returns true if the request may be serviced by any MS
+// replica.
+func isReadOnlyRequest(req interface{}) bool {
+	ror, ok := req.(interface{ isReadOnly() bool })
+	return ok && ror.isReadOnly()
+}
+
 // retryableRequest is the default implementation of the retryer interface.
 type retryableRequest struct {
 	// retryTimeout sets an optional timeout for each retry.
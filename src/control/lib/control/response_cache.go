@@ -0,0 +1,155 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// changeInfo holds the change detection fields common to the MS responses that
+// support conditional requests.
+type changeInfo struct {
+	DataVersion uint64 `json:"data_version"`
+	NotModified bool   `json:"not_modified"`
+}
+
+type (
+	responseCacheEntry struct {
+		token uint64
+		resp  interface{}
+	}
+
+	// ResponseCache retains the most recent ListPools and SystemQuery
+	// responses received from the MS along with their change tokens. When a
+	// request is repeated, the token is sent with it and the MS only returns
+	// the full response if the system has changed in the meantime, which
+	// reduces the cost of polling the system state.
+	ResponseCache struct {
+		sync.Mutex
+		entries map[string]*responseCacheEntry
+	}
+)
+
+// NewResponseCache returns an initialized ResponseCache.
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{
+		entries: make(map[string]*responseCacheEntry),
+	}
+}
+
+func (rc *ResponseCache) get(key string) *responseCacheEntry {
+	rc.Lock()
+	defer rc.Unlock()
+
+	return rc.entries[key]
+}
+
+func (rc *ResponseCache) set(key string, token uint64, resp interface{}) {
+	rc.Lock()
+	defer rc.Unlock()
+
+	rc.entries[key] = &responseCacheEntry{token: token, resp: resp}
+}
+
+// ListPools fetches the list of pools in the system as ListPools does, but only
+// transfers the pool list if it has changed since the previous call. Unless
+// NoQuery is set, the pools are still queried for their current usage.
+func (rc *ResponseCache) ListPools(ctx context.Context, rpcClient UnaryInvoker, req *ListPoolsReq) (*ListPoolsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	key := "ListPools:" + req.getSystem(rpcClient)
+	entry := rc.get(key)
+	if entry != nil {
+		req.ChangeToken = entry.token
+	}
+
+	resp, err := listPools(ctx, rpcClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.NotModified && entry != nil {
+		rpcClient.Debugf("pool list unchanged since %d, using cached response", entry.token)
+		cached := entry.resp.(*ListPoolsResp)
+		resp.Status = cached.Status
+		resp.Pools = slices.Clone(cached.Pools)
+	} else {
+		cached := newListPoolsResp()
+		cached.Status = resp.Status
+		cached.Pools = slices.Clone(resp.Pools)
+		rc.set(key, resp.ChangeToken, cached)
+	}
+	resp.NotModified = false
+
+	if req.NoQuery {
+		return resp, nil
+	}
+
+	return queryPools(ctx, rpcClient, resp)
+}
+
+// SystemQuery requests DAOS system status as SystemQuery does, but only
+// transfers the member details if they have changed since the previous call
+// with the same rank, host and state selection.
+func (rc *ResponseCache) SystemQuery(ctx context.Context, rpcClient UnaryInvoker, req *SystemQueryReq) (*SystemQueryResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	mask, err := req.getStateMask()
+	if err != nil {
+		return nil, errors.Wrap(err, "calculating member state bitmask")
+	}
+	key := fmt.Sprintf("SystemQuery:%s:%s:%s:%d", req.getSystem(rpcClient),
+		req.Ranks.String(), req.Hosts.String(), mask)
+	entry := rc.get(key)
+	if entry != nil {
+		req.ChangeToken = entry.token
+	}
+
+	resp, err := SystemQuery(ctx, rpcClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.NotModified || entry == nil {
+		cached, err := copySystemQueryResp(resp)
+		if err != nil {
+			return nil, err
+		}
+		rc.set(key, resp.ChangeToken, cached)
+		return resp, nil
+	}
+
+	rpcClient.Debugf("system membership unchanged since %d, using cached response", entry.token)
+	out, err := copySystemQueryResp(entry.resp.(*SystemQueryResp))
+	if err != nil {
+		return nil, err
+	}
+	out.ChangeToken = resp.ChangeToken
+	return out, nil
+}
+
+func copySystemQueryResp(in *SystemQueryResp) (*SystemQueryResp, error) {
+	out := &SystemQueryResp{
+		Members:     slices.Clone(in.Members),
+		Providers:   slices.Clone(in.Providers),
+		ChangeToken: in.ChangeToken,
+	}
+	if err := out.setAbsentHostsRanks(in.AbsentHosts.String(), in.AbsentRanks.String()); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
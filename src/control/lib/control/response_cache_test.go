@@ -0,0 +1,140 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_ResponseCache_ListPools(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mockPool := func(i int32) *mgmtpb.ListPoolsResp_Pool {
+		return &mgmtpb.ListPoolsResp_Pool{
+			Uuid:    test.MockUUID(i),
+			Label:   test.MockUUID(i)[:8],
+			SvcReps: []uint32{0},
+			State:   daos.PoolServiceStateReady.String(),
+		}
+	}
+	expPool := func(i int32) *daos.PoolInfo {
+		return &daos.PoolInfo{
+			UUID:            test.MockPoolUUID(i),
+			Label:           test.MockUUID(i)[:8],
+			ServiceReplicas: []ranklist.Rank{0},
+			State:           daos.PoolServiceStateReady,
+		}
+	}
+
+	mi := NewMockInvoker(log, &MockInvokerConfig{
+		UnaryResponseSet: []*UnaryResponse{
+			MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+				Pools:       []*mgmtpb.ListPoolsResp_Pool{mockPool(1)},
+				DataVersion: 5,
+			}),
+			MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+				DataVersion: 5,
+				NotModified: true,
+			}),
+			MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+				Pools:       []*mgmtpb.ListPoolsResp_Pool{mockPool(1), mockPool(2)},
+				DataVersion: 7,
+			}),
+		},
+	})
+	rc := NewResponseCache()
+
+	for i, exp := range []struct {
+		token uint64
+		pools []*daos.PoolInfo
+	}{
+		{token: 0, pools: []*daos.PoolInfo{expPool(1)}},
+		{token: 5, pools: []*daos.PoolInfo{expPool(1)}},
+		{token: 5, pools: []*daos.PoolInfo{expPool(1), expPool(2)}},
+	} {
+		req := &ListPoolsReq{NoQuery: true}
+		resp, err := rc.ListPools(test.Context(t), mi, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		test.AssertEqual(t, exp.token, req.ChangeToken, "unexpected change token sent")
+		test.AssertFalse(t, resp.NotModified, "unexpected not modified response")
+		if diff := cmp.Diff(exp.pools, resp.Pools); diff != "" {
+			t.Fatalf("call %d: unexpected pools (-want, +got):\n%s\n", i, diff)
+		}
+	}
+}
+
+func TestControl_ResponseCache_SystemQuery(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mockMember := func(rank uint32) *mgmtpb.SystemMember {
+		return &mgmtpb.SystemMember{
+			Rank:  rank,
+			Uuid:  test.MockUUID(int32(rank)),
+			State: system.MemberStateJoined.String(),
+			Addr:  test.MockHostAddr(1).String(),
+		}
+	}
+
+	mi := NewMockInvoker(log, &MockInvokerConfig{
+		UnaryResponseSet: []*UnaryResponse{
+			MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+				Members:     []*mgmtpb.SystemMember{mockMember(0)},
+				Absentranks: "3",
+				DataVersion: 2,
+			}),
+			MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+				Members:     []*mgmtpb.SystemMember{mockMember(1)},
+				DataVersion: 3,
+			}),
+			MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+				DataVersion: 2,
+				NotModified: true,
+			}),
+		},
+	})
+	rc := NewResponseCache()
+
+	for i, exp := range []struct {
+		ranks       string
+		token       uint64
+		rank        ranklist.Rank
+		absentRanks string
+	}{
+		{ranks: "0,3", token: 0, rank: 0, absentRanks: "3"},
+		{ranks: "1", token: 0, rank: 1},
+		{ranks: "0,3", token: 2, rank: 0, absentRanks: "3"},
+	} {
+		req := &SystemQueryReq{}
+		req.Ranks = *ranklist.MustCreateRankSet(exp.ranks)
+		resp, err := rc.SystemQuery(test.Context(t), mi, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		test.AssertEqual(t, exp.token, req.ChangeToken, "unexpected change token sent")
+		test.AssertFalse(t, resp.NotModified, "unexpected not modified response")
+		if len(resp.Members) != 1 {
+			t.Fatalf("call %d: expected 1 member, got %d", i, len(resp.Members))
+		}
+		test.AssertEqual(t, exp.rank, resp.Members[0].Rank, "unexpected member")
+		test.AssertEqual(t, exp.absentRanks, resp.AbsentRanks.String(), "unexpected absent ranks")
+	}
+}
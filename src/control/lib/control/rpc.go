@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package control
 
 import (
 	"context"
+	"io"
 	"math/rand"
 	"os"
 	"sync"
@@ -36,6 +38,10 @@ const (
 	baseMSBackoff      = 250 * time.Millisecond
 	maxMSBackoffFactor = 7 // 8s
 	maxMSCandidates    = 5
+
+	// msRaceGracePeriod is the time to wait for responses from the remaining
+	// MS candidates after the first successful response has been received.
+	msRaceGracePeriod = 250 * time.Millisecond
 )
 
 type (
@@ -124,9 +130,15 @@ type (
 	// Client implements the Invoker interface and should be provided to
 	// API methods to invoke RPCs.
 	Client struct {
-		config    *Config
-		log       debugLogger
-		component build.Component
+		config      *Config
+		log         debugLogger
+		component   build.Component
+		apHealth    *apHealthTracker
+		hosts       *hostResolver
+		protoCompat *protoCompatChecker
+		tracer      *RequestTracer
+		spanExp     *OTLPSpanExporter
+		rootSpan    *Span
 	}
 
 	// ClientOption defines the signature for functional Client options.
@@ -147,6 +159,14 @@ func WithClientLogger(log debugLogger) ClientOption {
 	}
 }
 
+// WithClientSpanExporter sets the exporter used to record trace spans for
+// the RPCs invoked by the client.
+func WithClientSpanExporter(se *OTLPSpanExporter) ClientOption {
+	return func(c *Client) {
+		c.spanExp = se
+	}
+}
+
 // WithConfig sets the client's configuration.
 func WithConfig(cfg *Config) ClientOption {
 	return func(c *Client) {
@@ -158,7 +178,8 @@ func WithConfig(cfg *Config) ClientOption {
 // parameters set by the provided ClientOption list.
 func NewClient(opts ...ClientOption) *Client {
 	c := &Client{
-		config: DefaultConfig(),
+		config:   DefaultConfig(),
+		apHealth: newAPHealthTracker(),
 	}
 
 	for _, opt := range opts {
@@ -168,6 +189,8 @@ func NewClient(opts ...ClientOption) *Client {
 	if c.log == nil {
 		WithClientLogger(defaultLogger)(c)
 	}
+	c.protoCompat = newProtoCompatChecker(c.log)
+	c.hosts = newHostResolver(c.log)
 
 	return c
 }
@@ -206,17 +229,50 @@ func (c *Client) Debugf(fmtStr string, args ...interface{}) {
 	c.log.Debugf(fmtStr, args...)
 }
 
+// EnableTracing starts recording the timing of all RPCs subsequently invoked
+// by the client, and returns the tracer used to retrieve the results.
+func (c *Client) EnableTracing() *RequestTracer {
+	c.tracer = NewRequestTracer()
+	return c.tracer
+}
+
+// SetSpanExporter sets the exporter used to record trace spans for the RPCs
+// subsequently invoked by the client.
+func (c *Client) SetSpanExporter(se *OTLPSpanExporter) {
+	c.spanExp = se
+}
+
+// EnableSpanExport starts exporting trace spans for all RPCs subsequently
+// invoked by the client, and returns a root span to which the RPCs are
+// attached unless they are invoked as part of another trace. The caller must
+// end the root span when done.
+func (c *Client) EnableSpanExport(se *OTLPSpanExporter, rootName string) *Span {
+	c.SetSpanExporter(se)
+	c.rootSpan = se.StartRootSpan(rootName)
+	return c.rootSpan
+}
+
 // dialOptions is a helper method to return a set of gRPC
 // client dialer options.
 func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 	opts := []grpc.DialOption{
 		streamErrorInterceptor(),
+		grpc.WithChainStreamInterceptor(
+			streamVersionedComponentInterceptor(c.GetComponent()),
+		),
 		grpc.WithChainUnaryInterceptor(
 			unaryErrorInterceptor(),
 			unaryVersionedComponentInterceptor(c.GetComponent()),
+			unaryClientUserInterceptor(),
+			unaryProtoCompatInterceptor(c.protoCompat),
 		),
 		grpc.FailOnNonTempDialError(true),
 	}
+	if c.spanExp != nil {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(
+			unaryTraceInterceptor(c.spanExp, c.rootSpan),
+		))
+	}
 
 	creds, err := security.DialOptionForTransportConfig(c.config.TransportConfig)
 	if err != nil {
@@ -224,20 +280,56 @@ func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 	}
 	opts = append(opts, creds)
 
+	maxMsgSize, err := c.config.MaxMsgBytes()
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, grpc.WithDefaultCallOptions(
+		grpc.MaxCallRecvMsgSize(maxMsgSize),
+		grpc.MaxCallSendMsgSize(maxMsgSize),
+	))
+
 	return opts, nil
 }
 
-// setDeadlineIfUnset sets a deadline on the context unless there is already
-// one set. If the request does not define a specific deadline, then the
-// default timeout is used.
-func setDeadlineIfUnset(parent context.Context, req UnaryRequest) (context.Context, context.CancelFunc) {
-	if _, hasDeadline := parent.Deadline(); hasDeadline {
-		return parent, func() {}
+// getRequestTimeout returns the default timeout for requests that do not set
+// their own, as specified in the client configuration.
+func (c *Client) getRequestTimeout() time.Duration {
+	if c.config == nil || c.config.RequestTimeout <= 0 {
+		return defaultRequestTimeout
+	}
+	return c.config.RequestTimeout
+}
+
+// setDefaultTimeout sets the supplied request-specific default timeout on the
+// request, unless the caller has already set a timeout on the request or a
+// deadline on the context.
+func setDefaultTimeout(ctx context.Context, req deadliner, timeout time.Duration) {
+	if !req.getDeadline().IsZero() {
+		return
+	}
+	if _, hasDeadline := ctx.Deadline(); hasDeadline {
+		return
 	}
+	req.SetTimeout(timeout)
+}
 
+// setRequestDeadline returns a context derived from the parent that expires at
+// the request's deadline. If the request does not define a specific deadline,
+// then the parent's deadline is used, if any, and otherwise the supplied default
+// timeout. As the derived context never outlives its parent, the earlier of the
+// request and parent deadlines is propagated to the RPC on every host.
+func setRequestDeadline(parent context.Context, req UnaryRequest, defTimeout time.Duration) (context.Context, context.CancelFunc) {
 	rd := req.getDeadline()
 	if rd.IsZero() {
-		req.SetTimeout(defaultRequestTimeout)
+		if _, hasDeadline := parent.Deadline(); hasDeadline {
+			return parent, func() {}
+		}
+
+		if defTimeout <= 0 {
+			defTimeout = defaultRequestTimeout
+		}
+		req.SetTimeout(defTimeout)
 		rd = req.getDeadline()
 	}
 	return context.WithDeadline(parent, rd)
@@ -265,12 +357,45 @@ func wrapReqTimeout(req UnaryRequest, err error) error {
 	return err
 }
 
+// recvStream receives the partial responses of a server-streaming RPC until the
+// server closes the stream, passing each to the supplied function.
+func recvStream[T any](stream grpc.ServerStreamingClient[T], addPart func(*T) error) error {
+	for {
+		part, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := addPart(part); err != nil {
+			return err
+		}
+	}
+}
+
+// invokeStreamFallback handles the error of a failed unary RPC invocation by
+// invoking the streaming variant of the RPC if the request or response exceeded
+// a message size limit. The original error is returned if the server does not
+// implement the streaming variant.
+func invokeStreamFallback(err error, invokeStream func() (proto.Message, error)) (proto.Message, error) {
+	if !IsMsgTooLarge(err) {
+		return nil, err
+	}
+
+	sMsg, sErr := invokeStream()
+	if status.Code(sErr) == codes.Unimplemented {
+		return nil, err
+	}
+	return sMsg, sErr
+}
+
 // InvokeUnaryRPCAsync performs an asynchronous invocation of the given RPC
 // across all hosts in the request's host list. The returned HostResponseChan
 // provides access to a stream of HostResponse items as they are received, and
 // is closed when no more responses are expected.
 func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (HostResponseChan, error) {
-	hosts, err := getRequestHosts(c.config, req)
+	hosts, err := getRequestHosts(parent, c.config, c.hosts, req)
 	if err != nil {
 		return nil, err
 	}
@@ -282,7 +407,7 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 	respChan := make(HostResponseChan, len(hosts))
 	go func() {
 		// Set a deadline for all requests to fan out/in.
-		ctx, cancel := setDeadlineIfUnset(parent, req)
+		ctx, cancel := setRequestDeadline(parent, req, c.getRequestTimeout())
 		defer cancel()
 
 		var wg sync.WaitGroup
@@ -290,7 +415,11 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 			wg.Add(1)
 			go func(hostAddr string) {
 				var msg proto.Message
+				start := time.Now()
 				opts, err := c.dialOptions()
+				if err == nil && c.tracer != nil {
+					opts = append(opts, c.tracer.dialOptions(hostAddr)...)
+				}
 				if err == nil {
 					var conn *grpc.ClientConn
 					conn, err = grpc.DialContext(ctx, hostAddr, opts...)
@@ -299,6 +428,9 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 						conn.Close()
 					}
 				}
+				if req.isMSRequest() {
+					c.apHealth.record(hostAddr, time.Since(start), err)
+				}
 
 				select {
 				case <-parent.Done():
@@ -315,26 +447,82 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 	return respChan, nil
 }
 
+// selectMSCandidates selects the access points to which a MS request should be
+// sent from the default hostlist. If the MS leader is known and healthy, it is
+// selected for requests that must be handled by the leader. Read-only requests
+// are instead spread across the known MS replicas. Failing that, if an access
+// point is known to be healthy, only the one with the lowest response latency
+// is selected. In each of these cases, a single access point is selected and
+// true is returned. Otherwise, a random subset of the access points that have not
+// recently failed is selected, with the idea that at least one of them will be
+// up and running enough to return ErrNotReplica in order to learn the actual
+// list of MS replicas. We may also get lucky and send the request to a server
+// that can handle the request directly.
+func selectMSCandidates(defaultHosts []string, health *apHealthTracker, readOnly bool) ([]string, bool, error) {
+	rnd := rand.New(msCandidateRandSource)
+
+	if readOnly {
+		if replica := health.replicaForRead(rnd); replica != "" {
+			return []string{replica}, true, nil
+		}
+	} else if leader := health.healthyLeader(); leader != "" {
+		return []string{leader}, true, nil
+	}
+
+	if best := health.bestHealthy(defaultHosts); best != "" {
+		return []string{best}, true, nil
+	}
+
+	hosts := health.withoutFailing(defaultHosts)
+	msCandidates := hostlist.MustCreateSet("")
+
+	numCandidates := maxMSCandidates
+	if len(hosts) < numCandidates {
+		numCandidates = len(hosts)
+	}
+
+	for msCandidates.Count() < numCandidates {
+		if _, err := msCandidates.Insert(hosts[rnd.Intn(len(hosts))]); err != nil {
+			return nil, false, errors.Wrap(err, "failed to build MS candidates set")
+		}
+	}
+	candidates := msCandidates.Slice()
+	if len(candidates) == 0 {
+		return nil, false, errors.New("unable to select MS candidates")
+	}
+
+	return candidates, false, nil
+}
+
 // invokeUnaryRPC is the actual implementation which is called by the
 // real Client as well as the MockInvoker. This allows us to ensure that
 // the retry logic here gets adequate test coverage.
-func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker, req UnaryRequest, defaultHosts []string) (*UnaryResponse, error) {
-	gatherResponses := func(ctx context.Context, respChan chan *HostResponse, ur *UnaryResponse) error {
+func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker, req UnaryRequest, defaultHosts []string, defaultTimeout time.Duration, health *apHealthTracker) (*UnaryResponse, error) {
+	// If raceGrace is nonzero, stop waiting for the remaining responses once
+	// that much time has passed since the first successful response.
+	gatherResponses := func(ctx context.Context, respChan chan *HostResponse, ur *UnaryResponse, raceGrace time.Duration) error {
+		var raceDone <-chan time.Time
 		for {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
+			case <-raceDone:
+				log.Debugf("not waiting for remaining MS candidate responses")
+				return nil
 			case hr := <-respChan:
 				if hr == nil {
 					return nil
 				}
 				ur.Responses = append(ur.Responses, hr)
+				if raceGrace > 0 && raceDone == nil && hr.Error == nil && hr.Message != nil {
+					raceDone = time.After(raceGrace)
+				}
 			}
 		}
 	}
 
 	// Set a deadline for the request across all retries.
-	reqCtx, cancel := setDeadlineIfUnset(parentCtx, req)
+	reqCtx, cancel := setRequestDeadline(parentCtx, req, defaultTimeout)
 	defer cancel()
 
 	// For non-MS requests, just keep things simple. Fan-out, fan-in,
@@ -346,36 +534,26 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 		}
 
 		ur := &UnaryResponse{log: log}
-		if err := gatherResponses(reqCtx, respChan, ur); err != nil {
+		if err := gatherResponses(reqCtx, respChan, ur, 0); err != nil {
 			return nil, wrapReqTimeout(req, err)
 		}
 		return ur, nil
 	}
 
+	// If no specific hostlist was supplied, select the MS candidates from the
+	// default hostlist. Unless one of them is already known to be healthy,
+	// the request is raced across the candidates.
+	readOnly := isReadOnlyRequest(req)
+	var autoSelected, preferred bool
 	if len(req.getHostList()) == 0 {
-		// For a MS request with no specific hostlist, choose a random subset
-		// of the default hostlist, with the idea that at least one of them
-		// will be up and running enough to return ErrNotReplica in order to
-		// learn the actual list of MS replicas. We may also get lucky and
-		// send the request to a server that can handle the request directly.
-		rnd := rand.New(msCandidateRandSource)
-		msCandidates := hostlist.MustCreateSet("")
-
-		numCandidates := maxMSCandidates
-		if len(defaultHosts) < numCandidates {
-			numCandidates = len(defaultHosts)
-		}
-
-		for msCandidates.Count() < numCandidates {
-			if _, err := msCandidates.Insert(defaultHosts[rnd.Intn(len(defaultHosts))]); err != nil {
-				return nil, errors.Wrap(err, "failed to build MS candidates set")
-			}
-		}
-		req.SetHostList(msCandidates.Slice())
-		if len(req.getHostList()) == 0 {
-			return nil, errors.New("unable to select MS candidates")
+		candidates, isPreferred, err := selectMSCandidates(defaultHosts, health, readOnly)
+		if err != nil {
+			return nil, err
 		}
+		req.SetHostList(candidates)
+		autoSelected, preferred = true, isPreferred
 	}
+	racing := autoSelected && !preferred
 
 	// Copy the starting hostlist to use for reset on retry later.
 	startHostList := make([]string, len(req.getHostList()))
@@ -428,18 +606,41 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 			tryCtx, tryCancel = context.WithTimeout(reqCtx, tryTimeout)
 			defer tryCancel()
 		}
-		respChan, err := c.InvokeUnaryRPCAsync(tryCtx, req)
+		var raceGrace time.Duration
+		raceCtx, raceCancel := context.WithCancel(tryCtx)
+		if racing {
+			raceGrace = msRaceGracePeriod
+		}
+		respChan, err := c.InvokeUnaryRPCAsync(raceCtx, req)
 		if isHardFailure(err, reqCtx) {
+			raceCancel()
 			return nil, wrapReqTimeout(req, err)
 		}
 
 		ur := &UnaryResponse{log: log, fromMS: true, retryCount: try}
-		err = gatherResponses(tryCtx, respChan, ur)
+		err = gatherResponses(tryCtx, respChan, ur, raceGrace)
+		// Abandon any candidates that have not yet responded.
+		raceCancel()
 		if isHardFailure(err, reqCtx) {
 			return nil, wrapReqTimeout(req, err)
 		}
+		health.recordMSResponses(ur.Responses, readOnly)
 
 		err = ur.getMSError()
+		if preferred && reqCtx.Err() == nil && (IsConnErr(err) || isTimeout(err)) {
+			// The preferred access point has become unreachable, so
+			// fall back to racing the request across other candidates
+			// without waiting for a backoff.
+			log.Debugf("preferred MS access point %v failed: %s", req.getHostList(), err)
+			candidates, _, err := selectMSCandidates(defaultHosts, health, readOnly)
+			if err != nil {
+				return nil, err
+			}
+			req.SetHostList(candidates)
+			startHostList = candidates
+			preferred, racing = false, true
+			continue
+		}
 		// If the request specifies that the error is retryable,
 		// check to see if it also defines its own retry logic
 		// and run that if so. Otherwise, let the usual retry
@@ -468,6 +669,7 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 				break
 			}
 			req.SetHostList([]string{e.LeaderHint})
+			racing = false
 		case *system.ErrNotReplica:
 			// If we went the request to a non-replica host, then
 			// the error should give us the list of replicas to try.
@@ -475,6 +677,7 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 			// service the request.
 			if len(e.Replicas) > 0 {
 				req.SetHostList(e.Replicas)
+				racing = false
 			}
 		default:
 			// As long as the outer context hasn't timed out, we
@@ -495,6 +698,7 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 				// Reset the hostlist to the starting hostlist, in order
 				// to restart the search for the current MS leader.
 				req.SetHostList(startHostList)
+				racing = autoSelected && !preferred
 				break
 			}
 
@@ -528,5 +732,11 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 // items which represent the success or failure of the RPC invocation for each host
 // in the request.
 func (c *Client) InvokeUnaryRPC(ctx context.Context, req UnaryRequest) (*UnaryResponse, error) {
-	return invokeUnaryRPC(ctx, c.log, c, req, c.config.HostList)
+	defaultHosts, err := c.hosts.expand(ctx, c.config.HostList, c.config.ControlPort,
+		c.config.HostListRefreshInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return invokeUnaryRPC(ctx, c.log, c, req, defaultHosts, c.getRequestTimeout(), c.apHealth)
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,7 +10,6 @@ package control
 import (
 	"context"
 	"fmt"
-	"os"
 	"runtime"
 	"sort"
 	"testing"
@@ -18,12 +18,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
-	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/system"
@@ -35,6 +35,7 @@ type testRequest struct {
 	retryableRequest
 	rpcFn    unaryRPC
 	toMS     bool
+	readOnly bool
 	HostList []string
 	Deadline time.Time
 	Timeout  time.Duration
@@ -45,6 +46,10 @@ func (tr *testRequest) isMSRequest() bool {
 	return tr.toMS
 }
 
+func (tr *testRequest) isReadOnly() bool {
+	return tr.readOnly
+}
+
 func (tr *testRequest) SetHostList(hl []string) {
 	tr.HostList = hl
 }
@@ -85,6 +90,147 @@ type ctxCancel struct {
 	cancel context.CancelFunc
 }
 
+func TestControl_Client_getRequestTimeout(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg        *Config
+		expTimeout time.Duration
+	}{
+		"nil config": {
+			expTimeout: defaultRequestTimeout,
+		},
+		"unset in config": {
+			cfg:        DefaultConfig(),
+			expTimeout: defaultRequestTimeout,
+		},
+		"set in config": {
+			cfg: &Config{
+				RequestTimeout: 42 * time.Second,
+			},
+			expTimeout: 42 * time.Second,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := NewClient()
+			client.SetConfig(tc.cfg)
+
+			if diff := cmp.Diff(tc.expTimeout, client.getRequestTimeout()); diff != "" {
+				t.Fatalf("unexpected timeout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_setRequestDeadline(t *testing.T) {
+	// Allow for the time taken between computing the expected deadline
+	// and the deadline set by the function under test.
+	const slop = 5 * time.Second
+
+	for name, tc := range map[string]struct {
+		parentTimeout time.Duration
+		reqTimeout    time.Duration
+		defTimeout    time.Duration
+		expTimeout    time.Duration
+		expReqTimeout time.Duration
+	}{
+		"no deadlines; default timeout used": {
+			defTimeout:    time.Minute,
+			expTimeout:    time.Minute,
+			expReqTimeout: time.Minute,
+		},
+		"no deadlines; no default timeout": {
+			expTimeout:    defaultRequestTimeout,
+			expReqTimeout: defaultRequestTimeout,
+		},
+		"parent deadline used if request has none": {
+			parentTimeout: time.Hour,
+			defTimeout:    time.Minute,
+			expTimeout:    time.Hour,
+		},
+		"request deadline earlier than parent deadline": {
+			parentTimeout: time.Hour,
+			reqTimeout:    time.Minute,
+			defTimeout:    30 * time.Minute,
+			expTimeout:    time.Minute,
+			expReqTimeout: time.Minute,
+		},
+		"parent deadline earlier than request deadline": {
+			parentTimeout: time.Minute,
+			reqTimeout:    time.Hour,
+			expTimeout:    time.Minute,
+			expReqTimeout: time.Hour,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			parent := test.Context(t)
+			if tc.parentTimeout > 0 {
+				var cancel context.CancelFunc
+				parent, cancel = context.WithTimeout(parent, tc.parentTimeout)
+				defer cancel()
+			}
+
+			req := &testRequest{}
+			if tc.reqTimeout > 0 {
+				req.SetTimeout(tc.reqTimeout)
+			}
+
+			expDeadline := time.Now().Add(tc.expTimeout)
+			ctx, cancel := setRequestDeadline(parent, req, tc.defTimeout)
+			defer cancel()
+
+			gotDeadline, hasDeadline := ctx.Deadline()
+			if !hasDeadline {
+				t.Fatal("expected context to have a deadline")
+			}
+			if gotDeadline.Before(expDeadline.Add(-slop)) || gotDeadline.After(expDeadline.Add(slop)) {
+				t.Fatalf("expected deadline near %s, got %s", expDeadline, gotDeadline)
+			}
+
+			if diff := cmp.Diff(tc.expReqTimeout, req.getTimeout()); diff != "" {
+				t.Fatalf("unexpected request timeout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_setDefaultTimeout(t *testing.T) {
+	for name, tc := range map[string]struct {
+		parentTimeout time.Duration
+		reqTimeout    time.Duration
+		expTimeout    time.Duration
+	}{
+		"default applied": {
+			expTimeout: time.Hour,
+		},
+		"request timeout retained": {
+			reqTimeout: time.Minute,
+			expTimeout: time.Minute,
+		},
+		"parent deadline retained": {
+			parentTimeout: time.Minute,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := test.Context(t)
+			if tc.parentTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.parentTimeout)
+				defer cancel()
+			}
+
+			req := &testRequest{}
+			if tc.reqTimeout > 0 {
+				req.SetTimeout(tc.reqTimeout)
+			}
+
+			setDefaultTimeout(ctx, req, time.Hour)
+
+			if diff := cmp.Diff(tc.expTimeout, req.getTimeout()); diff != "" {
+				t.Fatalf("unexpected request timeout (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_InvokeUnaryRPCAsync(t *testing.T) {
 	clientCfg := DefaultConfig()
 	clientCfg.TransportConfig.AllowInsecure = true
@@ -228,9 +374,8 @@ func TestControl_InvokeUnaryRPCAsync(t *testing.T) {
 
 				if time.Now().After(checkDeadline) {
 					// Dump the stack to see which goroutines are lingering
-					if err := unix.Kill(os.Getpid(), unix.SIGABRT); err != nil {
-						t.Fatal(err)
-					}
+					buf := make([]byte, 1<<20)
+					t.Fatalf("lingering goroutines:\n%s", buf[:runtime.Stack(buf, true)])
 				}
 			}
 		})
@@ -556,3 +701,139 @@ func TestControl_InvokeUnaryRPC(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_InvokeUnaryRPC_APHealth(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	clientCfg := DefaultConfig()
+	clientCfg.TransportConfig.AllowInsecure = true
+	clientCfg.HostList = nil
+	for i := 0; i < maxMSCandidates*2; i++ {
+		clientCfg.HostList = append(clientCfg.HostList, fmt.Sprintf("host%02d:%d", i, clientCfg.ControlPort))
+	}
+	downHost := clientCfg.HostList[3]
+
+	client := NewClient(
+		WithConfig(clientCfg),
+		WithClientLogger(log),
+	)
+	// Start with the AP that is now down being the preferred one.
+	client.apHealth.record(downHost, time.Millisecond, nil)
+	candidates, preferred, err := selectMSCandidates(clientCfg.HostList, client.apHealth, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, preferred, "expected preferred candidate")
+	test.AssertEqual(t, []string{downHost}, candidates, "unexpected candidates")
+
+	newReq := func() *testRequest {
+		return &testRequest{
+			toMS: true,
+			rpcFn: func(_ context.Context, cc *grpc.ClientConn) (proto.Message, error) {
+				if cc.Target() == downHost {
+					return nil, FaultConnectionRefused(downHost)
+				}
+				return defaultMessage, nil
+			},
+		}
+	}
+
+	// The failure of the preferred AP results in a fallback to other candidates.
+	resp, err := client.InvokeUnaryRPC(test.Context(t), newReq())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := resp.getMSError(); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, int(resp.retryCount), "unexpected retry count")
+	for _, hr := range resp.Responses {
+		if hr.Addr == downHost {
+			t.Fatalf("unexpected response from %s", downHost)
+		}
+	}
+
+	// Subsequent requests are sent to the fastest healthy AP only.
+	best := client.apHealth.bestHealthy(clientCfg.HostList)
+	if best == "" || best == downHost {
+		t.Fatalf("unexpected best AP %q", best)
+	}
+	resp, err = client.InvokeUnaryRPC(test.Context(t), newReq())
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(resp.Responses), "unexpected number of responses")
+	test.AssertEqual(t, best, resp.Responses[0].Addr, "unexpected responding AP")
+}
+
+func TestControl_InvokeUnaryRPC_MSRouting(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	clientCfg := DefaultConfig()
+	clientCfg.TransportConfig.AllowInsecure = true
+	clientCfg.HostList = nil
+	for i := 0; i < maxMSCandidates*2; i++ {
+		clientCfg.HostList = append(clientCfg.HostList, fmt.Sprintf("host%02d:%d", i, clientCfg.ControlPort))
+	}
+	replicas := []string{clientCfg.HostList[1], clientCfg.HostList[2], clientCfg.HostList[3]}
+	leader := replicas[1]
+
+	client := NewClient(
+		WithConfig(clientCfg),
+		WithClientLogger(log),
+	)
+
+	newReq := func(readOnly bool) *testRequest {
+		return &testRequest{
+			toMS:     true,
+			readOnly: readOnly,
+			rpcFn: func(_ context.Context, cc *grpc.ClientConn) (proto.Message, error) {
+				switch {
+				case !common.Includes(replicas, cc.Target()):
+					return nil, &system.ErrNotReplica{Replicas: replicas}
+				case !readOnly && cc.Target() != leader:
+					return nil, &system.ErrNotLeader{LeaderHint: leader, Replicas: replicas}
+				}
+				return defaultMessage, nil
+			},
+		}
+	}
+
+	invoke := func(readOnly bool) string {
+		t.Helper()
+
+		resp, err := client.InvokeUnaryRPC(test.Context(t), newReq(readOnly))
+		if err != nil {
+			t.Fatal(err)
+		}
+		msResp, err := resp.findMSResponse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msResp.Addr
+	}
+
+	// The leader is discovered by the first request.
+	test.AssertEqual(t, leader, invoke(false), "unexpected responding AP")
+	test.AssertEqual(t, leader, client.apHealth.healthyLeader(), "unexpected leader")
+
+	// Subsequent requests that must be handled by the leader are sent
+	// directly to it.
+	resp, err := client.InvokeUnaryRPC(test.Context(t), newReq(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(resp.Responses), "unexpected number of responses")
+	test.AssertEqual(t, leader, resp.Responses[0].Addr, "unexpected responding AP")
+
+	// Read-only requests are spread across the other replicas.
+	readers := make(map[string]int)
+	for i := 0; i < 20; i++ {
+		readers[invoke(true)]++
+	}
+	test.AssertEqual(t, 0, readers[leader], "unexpected reads from leader")
+	test.AssertTrue(t, readers[replicas[0]] > 0, "expected reads from first replica")
+	test.AssertTrue(t, readers[replicas[2]] > 0, "expected reads from last replica")
+}
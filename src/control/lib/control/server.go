@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/server/engine"
 )
@@ -155,3 +157,86 @@ func SetEngineLogMasks(ctx context.Context, rpcClient UnaryInvoker, req *SetEngi
 	rpcClient.Debugf("DAOS set engine log masks response: %+v", resp)
 	return resp, nil
 }
+
+// ListEnginesReq contains the inputs for the list engines request.
+type ListEnginesReq struct {
+	unaryRequest
+}
+
+// EngineInfo contains details of an engine instance on a host.
+type EngineInfo struct {
+	Host        string `json:"host"`
+	Index       uint32 `json:"index"`
+	Rank        uint32 `json:"rank"`
+	State       string `json:"state"`
+	NumaNode    uint32 `json:"numa_node"`
+	FabricIface string `json:"fabric_iface"`
+	Provider    string `json:"provider"`
+	TargetCount uint32 `json:"target_count"`
+	ScmClass    string `json:"scm_class"`
+	ScmMount    string `json:"scm_mount"`
+	BdevCount   uint32 `json:"bdev_count"`
+	Uptime      uint64 `json:"uptime"`
+	Version     string `json:"version"`
+}
+
+// ListEnginesResp contains the results of a list engines request.
+type ListEnginesResp struct {
+	HostErrorsResp
+	Engines []*EngineInfo `json:"engines"`
+}
+
+func (resp *ListEnginesResp) addHostResponse(hr *HostResponse) error {
+	pbResp, ok := hr.Message.(*ctlpb.ListEnginesResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	for _, pbEngine := range pbResp.GetEngines() {
+		ei := new(EngineInfo)
+		if err := convert.Types(pbEngine, ei); err != nil {
+			return errors.Wrap(err, "converting engine info")
+		}
+		ei.Host = hr.Addr
+		resp.Engines = append(resp.Engines, ei)
+	}
+
+	return nil
+}
+
+// ListEngines will send RPC to hostlist to request details of the DAOS engines on each host
+// in the list.
+func ListEngines(ctx context.Context, rpcClient UnaryInvoker, req *ListEnginesReq) (*ListEnginesResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &ctlpb.ListEnginesReq{Sys: req.getSystem(rpcClient)}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).ListEngines(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS list engines request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke list engines RPC: %s", err)
+		return nil, err
+	}
+
+	resp := new(ListEnginesResp)
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := resp.addHostResponse(hr); err != nil {
+			return nil, err
+		}
+	}
+
+	rpcClient.Debugf("DAOS list engines response: %+v", resp)
+	return resp, nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,8 +10,11 @@ package control
 import (
 	"context"
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
@@ -77,10 +81,11 @@ type (
 	// SmdManageReq contains the request parameters for a SMD query operation.
 	SmdManageReq struct {
 		unaryRequest
-		IDs             string // comma separated list of IDs
+		IDs             string // comma separated list of IDs, may contain globs for LED ops
 		Rank            ranklist.Rank
 		ReplaceUUID     string // For device replacement, UUID of new device
 		IdentifyTimeout uint32 // For LED identify, blink duration in minutes
+		Pool            string // For LED ops, only select devices backing this pool
 		Operation       SmdManageOpcode
 	}
 
@@ -339,6 +344,210 @@ func packPBSmdManageReq(req *SmdManageReq, pbReq *ctlpb.SmdManageReq) error {
 	return nil
 }
 
+func isLedOp(op SmdManageOpcode) bool {
+	return op == LedCheckOp || op == LedBlinkOp || op == LedResetOp
+}
+
+// hasLedSelectors returns true if the devices for an LED operation are
+// selected by glob patterns or by pool, in which case the selection has to be
+// resolved against the devices present on each host.
+func (req *SmdManageReq) hasLedSelectors() bool {
+	return isLedOp(req.Operation) && (req.Pool != "" || strings.ContainsAny(req.IDs, "*?["))
+}
+
+// resolvePoolUUID returns the UUID of the pool with the given label, alias or UUID.
+func resolvePoolUUID(ctx context.Context, rpcClient UnaryInvoker, id string) (string, error) {
+	if poolUUID, err := uuid.Parse(id); err == nil {
+		return poolUUID.String(), nil
+	}
+
+	resp, err := ListPools(ctx, rpcClient, &ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return "", errors.Wrap(err, "listing pools")
+	}
+	for _, pool := range resp.Pools {
+		if pool.HasLabel(id) {
+			return pool.UUID.String(), nil
+		}
+	}
+
+	return "", errors.Errorf("pool %q not found", id)
+}
+
+// ledSelection matches the devices on a host against the glob patterns and
+// pool of an LED request.
+type ledSelection struct {
+	patterns []string
+	matched  map[string]bool
+	poolUUID string
+}
+
+func newLedSelection(ids, poolUUID string) (*ledSelection, error) {
+	sel := &ledSelection{
+		matched:  make(map[string]bool),
+		poolUUID: poolUUID,
+	}
+	for _, id := range strings.Split(ids, ",") {
+		id = strings.ToLower(strings.TrimSpace(id))
+		if id == "" {
+			continue
+		}
+		if _, err := path.Match(id, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid device pattern %q", id)
+		}
+		sel.patterns = append(sel.patterns, id)
+	}
+
+	return sel, nil
+}
+
+func (sel *ledSelection) matchPatterns(dev *storage.SmdDevice) bool {
+	if len(sel.patterns) == 0 {
+		return true
+	}
+
+	var found bool
+	for _, pattern := range sel.patterns {
+		for _, id := range []string{strings.ToLower(dev.Ctrlr.PciAddr), strings.ToLower(dev.UUID)} {
+			if ok, _ := path.Match(pattern, id); ok && id != "" {
+				sel.matched[pattern] = true
+				found = true
+			}
+		}
+	}
+
+	return found
+}
+
+// backsPool returns true if the device provides storage for any of the pool
+// targets on its rank.
+func backsPool(dev *storage.SmdDevice, pools []*SmdPool) bool {
+	for _, pool := range pools {
+		if pool.Rank != dev.Rank {
+			continue
+		}
+		if len(dev.TargetIDs) == 0 || len(pool.TargetIDs) == 0 {
+			return true
+		}
+		for _, tgt := range dev.TargetIDs {
+			if slices.Contains(pool.TargetIDs, tgt) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// deviceIDs returns the identifiers of the selected devices in the supplied
+// SMD info, using the PCI address where available.
+func (sel *ledSelection) deviceIDs(si *SmdInfo) []string {
+	if si == nil {
+		return nil
+	}
+
+	var ids []string
+	for _, dev := range si.Devices {
+		if sel.poolUUID != "" && !backsPool(dev, si.Pools[sel.poolUUID]) {
+			continue
+		}
+		if !sel.matchPatterns(dev) {
+			continue
+		}
+
+		id := dev.Ctrlr.PciAddr
+		if id == "" {
+			id = dev.UUID
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func (sel *ledSelection) unmatched() []string {
+	var unmatched []string
+	for _, pattern := range sel.patterns {
+		if !sel.matched[pattern] {
+			unmatched = append(unmatched, pattern)
+		}
+	}
+
+	return unmatched
+}
+
+// resolveLedRequests resolves the glob patterns and pool selection of an LED
+// request into requests which list the matching devices explicitly for each
+// set of hosts. Hosts that can't be queried are recorded in the response.
+func resolveLedRequests(ctx context.Context, rpcClient UnaryInvoker, req *SmdManageReq, sr *SmdResp) ([]*SmdManageReq, error) {
+	var poolUUID string
+	if req.Pool != "" {
+		var err error
+		if poolUUID, err = resolvePoolUUID(ctx, rpcClient, req.Pool); err != nil {
+			return nil, err
+		}
+	}
+
+	sel, err := newLedSelection(req.IDs, poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	queryReq := &SmdQueryReq{
+		OmitPools: poolUUID == "",
+		Rank:      ranklist.NilRank,
+	}
+	queryReq.SetHostList(req.HostList)
+	queryResp, err := SmdQuery(ctx, rpcClient, queryReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying devices")
+	}
+	for _, hes := range queryResp.HostErrors {
+		for _, addr := range hes.HostSet.Slice() {
+			if err := sr.addHostError(addr, hes.HostError); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if queryResp.HostStorage.HostCount() == 0 {
+		return nil, nil
+	}
+
+	var reqs []*SmdManageReq
+	for _, key := range queryResp.HostStorage.Keys() {
+		hss := queryResp.HostStorage[key]
+		ids := sel.deviceIDs(hss.HostStorage.SmdInfo)
+		if len(ids) == 0 {
+			continue
+		}
+
+		hostReq := &SmdManageReq{
+			IDs:             strings.Join(ids, ","),
+			Rank:            req.Rank,
+			IdentifyTimeout: req.IdentifyTimeout,
+			Operation:       req.Operation,
+		}
+		hostReq.SetSystem(req.Sys)
+		hostReq.SetHostList(hss.HostSet.Slice())
+		reqs = append(reqs, hostReq)
+	}
+
+	if unmatched := sel.unmatched(); len(unmatched) > 0 {
+		return nil, errors.Errorf("no devices match %s", strings.Join(unmatched, ","))
+	}
+	if len(reqs) == 0 {
+		if poolUUID != "" {
+			return nil, errors.Errorf("no devices found backing pool %s", req.Pool)
+		}
+		return nil, errors.New("no devices match the selection")
+	}
+
+	return reqs, nil
+}
+
 // SmdManage concurrently performs per-server metadata operations across all
 // hosts supplied in the request's hostlist, or all configured hosts if not
 // explicitly specified. The function blocks until all results (successful
@@ -351,49 +560,70 @@ func SmdManage(ctx context.Context, rpcClient UnaryInvoker, req *SmdManageReq) (
 		return nil, errors.New("nil request")
 	}
 
+	sr := new(SmdResp)
+	if !req.hasLedSelectors() {
+		if err := smdManage(ctx, rpcClient, req, sr); err != nil {
+			return nil, err
+		}
+		return sr, nil
+	}
+
+	hostReqs, err := resolveLedRequests(ctx, rpcClient, req, sr)
+	if err != nil {
+		return nil, err
+	}
+	for _, hostReq := range hostReqs {
+		if err := smdManage(ctx, rpcClient, hostReq, sr); err != nil {
+			return nil, err
+		}
+	}
+
+	return sr, nil
+}
+
+func smdManage(ctx context.Context, rpcClient UnaryInvoker, req *SmdManageReq, sr *SmdResp) error {
 	pbReq := new(ctlpb.SmdManageReq)
 	if err := packPBSmdManageReq(req, pbReq); err != nil {
-		return nil, errors.Wrap(err, "packing proto manage request")
+		return errors.Wrap(err, "packing proto manage request")
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return ctlpb.NewCtlSvcClient(conn).SmdManage(ctx, pbReq)
 	})
 
 	if req.Operation == SetFaultyOp || req.Operation == DevReplaceOp {
-		reqHosts, err := getRequestHosts(DefaultConfig(), req)
+		reqHosts, err := getRequestHosts(ctx, DefaultConfig(), nil, req)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if len(reqHosts) > 1 {
-			return nil, errors.Errorf("cannot perform %s operation on > 1 host",
+			return errors.Errorf("cannot perform %s operation on > 1 host",
 				req.Operation)
 		}
 	}
 
 	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	sr := new(SmdResp)
 	for _, hostResp := range ur.Responses {
 		if hostResp.Error != nil {
 			if err := sr.addHostError(hostResp.Addr, hostResp.Error); err != nil {
-				return nil, err
+				return err
 			}
 			continue
 		}
 
 		if respError := sr.getHostManageRespErr(hostResp); respError != nil {
 			if err := sr.addHostError(hostResp.Addr, respError); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		if err := sr.addHostManageResponse(hostResp); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return sr, nil
+	return nil
 }
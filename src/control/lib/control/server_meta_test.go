@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package control
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -15,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -852,3 +855,209 @@ func TestControl_SmdManage(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_SmdManage_LedSelectors(t *testing.T) {
+	mockDev := func(uuidIdx int32, pciAddr string, tgts ...int32) *ctlpb.SmdDevice {
+		return &ctlpb.SmdDevice{
+			Uuid:   test.MockUUID(uuidIdx),
+			TgtIds: tgts,
+			Ctrlr: &ctlpb.NvmeController{
+				PciAddr:  pciAddr,
+				DevState: ctlpb.NvmeDevState_NORMAL,
+			},
+		}
+	}
+	mockPool := func(uuidIdx int32, tgts ...int32) *ctlpb.SmdQueryResp_Pool {
+		return &ctlpb.SmdQueryResp_Pool{
+			Uuid:   test.MockUUID(uuidIdx),
+			TgtIds: tgts,
+		}
+	}
+	smdQueryResp := &UnaryResponse{
+		Responses: []*HostResponse{
+			{
+				Addr: "host1",
+				Message: &ctlpb.SmdQueryResp{
+					Ranks: []*ctlpb.SmdQueryResp_RankResp{
+						{
+							Rank: 0,
+							Devices: []*ctlpb.SmdDevice{
+								mockDev(1, "5d0505:01:00.0", 0, 1),
+								mockDev(2, "5d0505:03:00.0", 2, 3),
+							},
+							Pools: []*ctlpb.SmdQueryResp_Pool{
+								mockPool(10, 0, 1),
+								mockPool(11, 2, 3),
+							},
+						},
+					},
+				},
+			},
+			{
+				Addr: "host2",
+				Message: &ctlpb.SmdQueryResp{
+					Ranks: []*ctlpb.SmdQueryResp_RankResp{
+						{
+							Rank: 1,
+							Devices: []*ctlpb.SmdDevice{
+								mockDev(3, "d70505:01:00.0", 0, 1),
+								mockDev(4, "", 2, 3),
+							},
+							Pools: []*ctlpb.SmdQueryResp_Pool{
+								mockPool(11, 0, 1, 2, 3),
+							},
+						},
+					},
+				},
+			},
+			{
+				Addr:  "host3",
+				Error: errors.New("host3 down"),
+			},
+		},
+	}
+	ledResp := func(hosts ...string) *UnaryResponse {
+		ur := new(UnaryResponse)
+		for _, host := range hosts {
+			ur.Responses = append(ur.Responses, &HostResponse{
+				Addr:    host,
+				Message: &ctlpb.SmdManageResp{},
+			})
+		}
+		return ur
+	}
+
+	type sentReq struct {
+		hosts string
+		ids   string
+	}
+
+	for name, tc := range map[string]struct {
+		ids       string
+		pool      string
+		responses []*UnaryResponse
+		expSent   []sentReq
+		expErr    error
+	}{
+		"glob on pci address": {
+			ids:       "*:01:00.0",
+			responses: []*UnaryResponse{smdQueryResp, ledResp("host1"), ledResp("host2")},
+			expSent: []sentReq{
+				{hosts: "host1", ids: "5d0505:01:00.0"},
+				{hosts: "host2", ids: "d70505:01:00.0"},
+			},
+		},
+		"glob and uuid": {
+			ids:       "5d0505:*," + test.MockUUID(4),
+			responses: []*UnaryResponse{smdQueryResp, ledResp("host1"), ledResp("host2")},
+			expSent: []sentReq{
+				{hosts: "host1", ids: "5d0505:01:00.0,5d0505:03:00.0"},
+				{hosts: "host2", ids: test.MockUUID(4)},
+			},
+		},
+		"pool uuid": {
+			pool:      test.MockUUID(10),
+			responses: []*UnaryResponse{smdQueryResp, ledResp("host1")},
+			expSent: []sentReq{
+				{hosts: "host1", ids: "5d0505:01:00.0"},
+			},
+		},
+		"pool label and glob": {
+			ids:  "d70505:*",
+			pool: "pool11",
+			responses: []*UnaryResponse{
+				MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+					Pools: []*mgmtpb.ListPoolsResp_Pool{
+						{
+							Uuid:  test.MockUUID(11),
+							Label: "pool11",
+							State: daos.PoolServiceStateReady.String(),
+						},
+					},
+				}),
+				smdQueryResp, ledResp("host2"),
+			},
+			expSent: []sentReq{
+				{hosts: "host2", ids: "d70505:01:00.0"},
+			},
+		},
+		"pool alias and glob": {
+			ids:  "d70505:*",
+			pool: "project",
+			responses: []*UnaryResponse{
+				MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+					Pools: []*mgmtpb.ListPoolsResp_Pool{
+						{
+							Uuid:    test.MockUUID(11),
+							Label:   "pool11",
+							Aliases: []string{"project"},
+							State:   daos.PoolServiceStateReady.String(),
+						},
+					},
+				}),
+				smdQueryResp, ledResp("host2"),
+			},
+			expSent: []sentReq{
+				{hosts: "host2", ids: "d70505:01:00.0"},
+			},
+		},
+		"unknown pool label": {
+			pool: "missing",
+			responses: []*UnaryResponse{
+				MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{}),
+			},
+			expErr: errors.New("pool \"missing\" not found"),
+		},
+		"pool without devices": {
+			pool:      test.MockUUID(12),
+			responses: []*UnaryResponse{smdQueryResp},
+			expErr:    errors.New("no devices found backing pool"),
+		},
+		"unmatched pattern": {
+			ids:       "5d0505:*,ffffff:*",
+			responses: []*UnaryResponse{smdQueryResp},
+			expErr:    errors.New("no devices match ffffff:*"),
+		},
+		"bad pattern": {
+			ids:    "5d0505:[",
+			expErr: errors.New("invalid device pattern"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+			})
+
+			req := &SmdManageReq{
+				Operation: LedBlinkOp,
+				IDs:       tc.ids,
+				Pool:      tc.pool,
+			}
+			resp, err := SmdManage(test.Context(t), mi, req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotSent []sentReq
+			for _, uReq := range mi.SentReqs {
+				mReq, ok := uReq.(*SmdManageReq)
+				if !ok {
+					continue
+				}
+				gotSent = append(gotSent, sentReq{
+					hosts: strings.Join(mReq.HostList, ","),
+					ids:   mReq.IDs,
+				})
+			}
+			if diff := cmp.Diff(tc.expSent, gotSent, cmp.AllowUnexported(sentReq{})); diff != "" {
+				t.Fatalf("unexpected requests sent (-want, +got):\n%s\n", diff)
+			}
+
+			test.AssertEqual(t, 1, resp.GetHostErrors().ErrorCount(), "expected host3 error")
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -436,3 +437,142 @@ func Test_SetEngineLogMasks(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListEngines(t *testing.T) {
+	pbEngine := func(idx uint32) *ctlpb.EngineInfo {
+		return &ctlpb.EngineInfo{
+			Index:       idx,
+			Rank:        idx + 1,
+			State:       "Joined",
+			NumaNode:    idx,
+			FabricIface: "ib0",
+			Provider:    "ofi+tcp",
+			TargetCount: 8,
+			ScmClass:    "dcpm",
+			ScmMount:    "/mnt/daos",
+			BdevCount:   2,
+			Uptime:      3600,
+			Version:     "2.6.0",
+		}
+	}
+	engineInfo := func(host string, idx uint32) *EngineInfo {
+		return &EngineInfo{
+			Host:        host,
+			Index:       idx,
+			Rank:        idx + 1,
+			State:       "Joined",
+			NumaNode:    idx,
+			FabricIface: "ib0",
+			Provider:    "ofi+tcp",
+			TargetCount: 8,
+			ScmClass:    "dcpm",
+			ScmMount:    "/mnt/daos",
+			BdevCount:   2,
+			Uptime:      3600,
+			Version:     "2.6.0",
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req         *ListEnginesReq
+		mic         *MockInvokerConfig
+		expResponse *ListEnginesResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"invoke fails": {
+			req: &ListEnginesReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("failed"),
+			},
+			expErr: errors.New("failed"),
+		},
+		"nil message": {
+			req: &ListEnginesReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+						},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"server error": {
+			req: &ListEnginesReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:  "host1",
+							Error: errors.New("failed"),
+						},
+					},
+				},
+			},
+			expResponse: &ListEnginesResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host1",
+					Error: "failed",
+				}),
+			},
+		},
+		"multiple hosts": {
+			req: &ListEnginesReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.ListEnginesResp{
+								Engines: []*ctlpb.EngineInfo{
+									pbEngine(0), pbEngine(1),
+								},
+							},
+						},
+						{
+							Addr:    "host2",
+							Message: &ctlpb.ListEnginesResp{},
+						},
+						{
+							Addr:  "host3",
+							Error: errors.New("failed"),
+						},
+					},
+				},
+			},
+			expResponse: &ListEnginesResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host3",
+					Error: "failed",
+				}),
+				Engines: []*EngineInfo{
+					engineInfo("host1", 0),
+					engineInfo("host1", 1),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := ListEngines(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/server/storage"
@@ -171,6 +173,113 @@ func (hsm HostStorageMap) IsMdOnSsdEnabled() bool {
 	return false
 }
 
+type (
+	// StorageTierUsage describes the capacity and free space of a storage tier.
+	StorageTierUsage struct {
+		TotalBytes uint64 `json:"total_bytes"`
+		FreeBytes  uint64 `json:"free_bytes"`
+	}
+
+	// StorageUsageEntry describes the SCM and NVMe usage of a host or a pool.
+	StorageUsageEntry struct {
+		Name string           `json:"name"`
+		UUID string           `json:"uuid,omitempty"`
+		Scm  StorageTierUsage `json:"scm"`
+		Nvme StorageTierUsage `json:"nvme"`
+	}
+
+	// StorageUsageSummary contains storage usage aggregated by host or by pool,
+	// along with the sum over all entries.
+	StorageUsageSummary struct {
+		Entries []*StorageUsageEntry `json:"entries"`
+		Total   *StorageUsageEntry   `json:"total"`
+	}
+)
+
+// UsedBytes returns the number of bytes used in the tier.
+func (stu StorageTierUsage) UsedBytes() uint64 {
+	if stu.FreeBytes > stu.TotalBytes {
+		return 0
+	}
+	return stu.TotalBytes - stu.FreeBytes
+}
+
+func (stu *StorageTierUsage) add(other StorageTierUsage) {
+	stu.TotalBytes += other.TotalBytes
+	stu.FreeBytes += other.FreeBytes
+}
+
+func newStorageUsageSummary(entries []*StorageUsageEntry) *StorageUsageSummary {
+	sus := &StorageUsageSummary{
+		Entries: entries,
+		Total:   &StorageUsageEntry{Name: "Total"},
+	}
+	for _, e := range entries {
+		sus.Total.Scm.add(e.Scm)
+		sus.Total.Nvme.add(e.Nvme)
+	}
+
+	return sus
+}
+
+// UsageByHost returns the SCM and NVMe usage of each host in the map, sorted
+// by host address.
+func (hsm HostStorageMap) UsageByHost() *StorageUsageSummary {
+	var entries []*StorageUsageEntry
+	for _, hss := range hsm {
+		hs := hss.HostStorage
+		if hs == nil {
+			continue
+		}
+		for _, host := range hss.HostSet.Slice() {
+			entries = append(entries, &StorageUsageEntry{
+				Name: host,
+				Scm: StorageTierUsage{
+					TotalBytes: hs.ScmNamespaces.Total(),
+					FreeBytes:  hs.ScmNamespaces.Free(),
+				},
+				Nvme: StorageTierUsage{
+					TotalBytes: hs.NvmeDevices.Total(),
+					FreeBytes:  hs.NvmeDevices.Free(),
+				},
+			})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+
+	return newStorageUsageSummary(entries)
+}
+
+// UsageByPool returns the SCM and NVMe usage of each of the supplied pools,
+// sorted by pool name. Pools without tier statistics are reported with zero
+// usage.
+func UsageByPool(pools []*daos.PoolInfo) *StorageUsageSummary {
+	entries := make([]*StorageUsageEntry, 0, len(pools))
+	for _, pi := range pools {
+		entry := &StorageUsageEntry{
+			Name: pi.Name(),
+			UUID: pi.UUID.String(),
+		}
+		for _, tier := range pi.TierStats {
+			usage := StorageTierUsage{TotalBytes: tier.Total, FreeBytes: tier.Free}
+			switch tier.MediaType {
+			case daos.StorageMediaTypeScm:
+				entry.Scm.add(usage)
+			case daos.StorageMediaTypeNvme:
+				entry.Nvme.add(usage)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+
+	return newStorageUsageSummary(entries)
+}
+
 type (
 	// StorageScanReq contains the parameters for a storage scan request.
 	StorageScanReq struct {
@@ -257,6 +366,32 @@ func (ssp *StorageScanResp) addHostResponse(hr *HostResponse) error {
 	return nil
 }
 
+// storageScanStream retrieves the StorageScan response as a stream of partial
+// responses and reassembles it.
+func storageScanStream(ctx context.Context, conn *grpc.ClientConn, req *ctlpb.StorageScanReq) (*ctlpb.StorageScanResp, error) {
+	stream, err := ctlpb.NewCtlSvcClient(conn).StorageScanStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *ctlpb.StorageScanResp
+	if err := recvStream(stream, func(part *ctlpb.StorageScanResp) error {
+		if resp == nil {
+			resp = part
+			return nil
+		}
+		resp.MergeNvmeCtrlrs(part)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.New("empty StorageScan response stream")
+	}
+
+	return resp, nil
+}
+
 // StorageScan concurrently performs storage scans across all hosts
 // supplied in the request's hostlist, or all configured hosts if not
 // explicitly specified. The function blocks until all results (successful
@@ -268,7 +403,7 @@ func (ssp *StorageScanResp) addHostResponse(hr *HostResponse) error {
 // NumaBasic option strips SSD details down to only the most basic.
 func StorageScan(ctx context.Context, rpcClient UnaryInvoker, req *StorageScanReq) (*StorageScanResp, error) {
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return ctlpb.NewCtlSvcClient(conn).StorageScan(ctx, &ctlpb.StorageScanReq{
+		pbReq := &ctlpb.StorageScanReq{
 			Scm: &ctlpb.ScanScmReq{
 				Usage: req.Usage,
 			},
@@ -281,6 +416,15 @@ func StorageScan(ctx context.Context, rpcClient UnaryInvoker, req *StorageScanRe
 				// Only request link stats if health explicitly requested.
 				LinkStats: req.NvmeHealth,
 			},
+		}
+		resp, err := ctlpb.NewCtlSvcClient(conn).StorageScan(ctx, pbReq)
+		if err == nil {
+			return resp, nil
+		}
+
+		// Scan results too large for a single message are retrieved in parts.
+		return invokeStreamFallback(err, func() (proto.Message, error) {
+			return storageScanStream(ctx, conn, pbReq)
 		})
 	})
 
@@ -310,6 +454,7 @@ type (
 	// StorageFormatReq contains the parameters for a storage format request.
 	StorageFormatReq struct {
 		unaryRequest
+		progressRequest
 		Reformat bool `json:"reformat"`
 		Replace  bool `json:"replace"`
 	}
@@ -440,6 +585,21 @@ func checkFormatReq(ctx context.Context, rpcClient UnaryInvoker, req *StorageFor
 	return nil
 }
 
+// storageFormatStream formats the host's storage, reporting the progress of the
+// format pushed by the server.
+func storageFormatStream(ctx context.Context, conn *grpc.ClientConn, req *StorageFormatReq, pbReq *ctlpb.StorageFormatReq) (*ctlpb.StorageFormatResp, error) {
+	stream, err := ctlpb.NewCtlSvcClient(conn).StorageFormatStream(ctx, pbReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return recvProgressStream(stream, func(p *sharedpb.Progress) {
+		req.reportProgress(conn.Target(), p)
+	}, func(part *ctlpb.StorageFormatStreamResp) (*sharedpb.Progress, *ctlpb.StorageFormatResp) {
+		return part.GetProgress(), part.GetResp()
+	})
+}
+
 // StorageFormat concurrently performs storage preparation steps across
 // all hosts supplied in the request's hostlist, or all configured hosts
 // if not explicitly specified. The function blocks until all results
@@ -455,7 +615,11 @@ func StorageFormat(ctx context.Context, rpcClient UnaryInvoker, req *StorageForm
 		return nil, err
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return ctlpb.NewCtlSvcClient(conn).StorageFormat(ctx, pbReq)
+		return req.invokeWithProgress(func() (proto.Message, error) {
+			return storageFormatStream(ctx, conn, req, pbReq)
+		}, func() (proto.Message, error) {
+			return ctlpb.NewCtlSvcClient(conn).StorageFormat(ctx, pbReq)
+		})
 	})
 
 	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
@@ -0,0 +1,261 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+type (
+	// StorageMapReq contains the parameters for a pool storage map request.
+	StorageMapReq struct {
+		ID       string
+		HostList []string
+	}
+
+	// StorageMapTarget describes the state and space usage of a pool target.
+	StorageMapTarget struct {
+		Index uint32                    `json:"index"`
+		State daos.PoolQueryTargetState `json:"state"`
+		Usage StorageTierUsage          `json:"usage"`
+	}
+
+	// StorageMapDevice describes the pool targets on a storage device. Targets
+	// on engines without NVMe devices are reported on a device with no UUID.
+	StorageMapDevice struct {
+		UUID    string               `json:"uuid,omitempty"`
+		State   storage.NvmeDevState `json:"dev_state"`
+		Targets []*StorageMapTarget  `json:"targets"`
+		Usage   StorageTierUsage     `json:"usage"`
+	}
+
+	// StorageMapRank describes the storage devices of a pool engine.
+	StorageMapRank struct {
+		Rank    ranklist.Rank       `json:"rank"`
+		Host    string              `json:"host"`
+		Devices []*StorageMapDevice `json:"devices"`
+	}
+
+	// StorageMap describes the placement of a pool's targets on the storage
+	// devices of the engines in the system.
+	StorageMap struct {
+		Pool  string            `json:"pool"`
+		Ranks []*StorageMapRank `json:"ranks"`
+	}
+)
+
+// UsedPercent returns the percentage of the pool space on the device that is
+// in use.
+func (smd *StorageMapDevice) UsedPercent() int {
+	if smd.Usage.TotalBytes == 0 {
+		return 0
+	}
+	return int(smd.Usage.UsedBytes() * 100 / smd.Usage.TotalBytes)
+}
+
+// Faulty returns true if the device has been marked as faulty.
+func (smd *StorageMapDevice) Faulty() bool {
+	return smd.State == storage.NvmeStateFaulty
+}
+
+// Rebuilding returns true if data is being moved to or from any of the pool
+// targets on the device.
+func (smd *StorageMapDevice) Rebuilding() bool {
+	for _, tgt := range smd.Targets {
+		switch tgt.State {
+		case daos.PoolTargetStateDown, daos.PoolTargetStateUp, daos.PoolTargetStateDrain:
+			return true
+		}
+	}
+	return false
+}
+
+// Excluded returns true if all of the pool targets on the device have been
+// excluded from the pool.
+func (smd *StorageMapDevice) Excluded() bool {
+	if len(smd.Targets) == 0 {
+		return false
+	}
+	for _, tgt := range smd.Targets {
+		if tgt.State != daos.PoolTargetStateDownOut {
+			return false
+		}
+	}
+	return true
+}
+
+// targetUsage returns the space usage of the target in the tier backed by the
+// given media type.
+func targetUsage(info *daos.PoolQueryTargetInfo, media daos.StorageMediaType) StorageTierUsage {
+	for _, space := range info.Space {
+		if space != nil && space.MediaType == media {
+			return StorageTierUsage{
+				TotalBytes: space.Total,
+				FreeBytes:  space.Free,
+			}
+		}
+	}
+	return StorageTierUsage{}
+}
+
+// storageMapHost returns the names of the hosts in the set, without the
+// control port.
+func storageMapHost(hs *hostlist.HostSet) string {
+	var hosts []string
+	for _, addr := range hs.Slice() {
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		hosts = append(hosts, addr)
+	}
+	return strings.Join(hosts, ",")
+}
+
+// NewStorageMap builds a pool storage map from the SMD devices of the engines
+// and the pool target information of each pool rank.
+func NewStorageMap(pool string, hsm HostStorageMap, rankTargets map[ranklist.Rank][]*daos.PoolQueryTargetInfo) *StorageMap {
+	ranks := make(map[ranklist.Rank]*StorageMapRank)
+	for _, hss := range hsm {
+		hs := hss.HostStorage
+		if hs == nil || hs.SmdInfo == nil {
+			continue
+		}
+		for _, dev := range hs.SmdInfo.Devices {
+			if _, found := rankTargets[dev.Rank]; !found {
+				continue
+			}
+			smr, found := ranks[dev.Rank]
+			if !found {
+				smr = &StorageMapRank{
+					Rank: dev.Rank,
+					Host: storageMapHost(hss.HostSet),
+				}
+				ranks[dev.Rank] = smr
+			}
+			smd := &StorageMapDevice{
+				UUID:  dev.UUID,
+				State: dev.Ctrlr.NvmeState,
+			}
+			smr.Devices = append(smr.Devices, smd)
+
+			tgtIDs := append([]int32{}, dev.TargetIDs...)
+			sort.Slice(tgtIDs, func(i, j int) bool { return tgtIDs[i] < tgtIDs[j] })
+			for _, id := range tgtIDs {
+				if id < 0 || int(id) >= len(rankTargets[dev.Rank]) || rankTargets[dev.Rank][id] == nil {
+					continue
+				}
+				info := rankTargets[dev.Rank][id]
+				tgt := &StorageMapTarget{
+					Index: uint32(id),
+					State: info.State,
+					Usage: targetUsage(info, daos.StorageMediaTypeNvme),
+				}
+				smd.Targets = append(smd.Targets, tgt)
+				smd.Usage.add(tgt.Usage)
+			}
+		}
+	}
+
+	sm := &StorageMap{Pool: pool}
+	for rank, infos := range rankTargets {
+		smr, found := ranks[rank]
+		if !found {
+			// Engines without NVMe devices store all of their targets
+			// in SCM.
+			smr = &StorageMapRank{Rank: rank}
+			smd := new(StorageMapDevice)
+			for i, info := range infos {
+				if info == nil {
+					continue
+				}
+				tgt := &StorageMapTarget{
+					Index: uint32(i),
+					State: info.State,
+					Usage: targetUsage(info, daos.StorageMediaTypeScm),
+				}
+				smd.Targets = append(smd.Targets, tgt)
+				smd.Usage.add(tgt.Usage)
+			}
+			smr.Devices = []*StorageMapDevice{smd}
+		}
+		sort.Slice(smr.Devices, func(i, j int) bool { return smr.Devices[i].UUID < smr.Devices[j].UUID })
+		sm.Ranks = append(sm.Ranks, smr)
+	}
+	sort.Slice(sm.Ranks, func(i, j int) bool { return sm.Ranks[i].Rank < sm.Ranks[j].Rank })
+
+	return sm
+}
+
+// GetStorageMap queries the pool and the storage devices of its engines, and
+// returns a map of the placement of the pool's targets on those devices.
+func GetStorageMap(ctx context.Context, rpcClient UnaryInvoker, req *StorageMapReq) (*StorageMap, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pqr, err := PoolQuery(ctx, rpcClient, &PoolQueryReq{
+		ID: req.ID,
+		QueryMask: daos.MustNewPoolQueryMask(daos.PoolQueryOptionEnabledEngines,
+			daos.PoolQueryOptionDisabledEngines),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pool query failed")
+	}
+	if pqr.TotalTargets == 0 || pqr.TotalEngines == 0 {
+		// Nothing to map, e.g. if the pool is still being created.
+		return NewStorageMap(pqr.Name(), nil, nil), nil
+	}
+	tgtIdxs := make([]uint32, pqr.TotalTargets/pqr.TotalEngines)
+	for i := range tgtIdxs {
+		tgtIdxs[i] = uint32(i)
+	}
+
+	poolRanks := ranklist.MustCreateRankSet("")
+	for _, rs := range []*ranklist.RankSet{pqr.EnabledRanks, pqr.DisabledRanks} {
+		if rs != nil {
+			poolRanks.Merge(rs)
+		}
+	}
+
+	rankTargets := make(map[ranklist.Rank][]*daos.PoolQueryTargetInfo)
+	for _, rank := range poolRanks.Ranks() {
+		resp, err := PoolQueryTargets(ctx, rpcClient, &PoolQueryTargetReq{
+			ID:      req.ID,
+			Rank:    rank,
+			Targets: tgtIdxs,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "pool query targets on rank %d failed", rank)
+		}
+		rankTargets[rank] = resp.Infos
+	}
+
+	smdReq := &SmdQueryReq{
+		OmitPools: true,
+		Rank:      ranklist.NilRank,
+	}
+	smdReq.SetHostList(req.HostList)
+	smdResp, err := SmdQuery(ctx, rpcClient, smdReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "device query failed")
+	}
+	if err := smdResp.Errors(); err != nil {
+		return nil, err
+	}
+
+	return NewStorageMap(pqr.Name(), smdResp.HostStorage, rankTargets), nil
+}
@@ -0,0 +1,282 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestControl_NewStorageMap(t *testing.T) {
+	tgtInfo := func(state daos.PoolQueryTargetState, media daos.StorageMediaType, total, free uint64) *daos.PoolQueryTargetInfo {
+		return &daos.PoolQueryTargetInfo{
+			State: state,
+			Space: []*daos.StorageUsageStats{
+				{Total: total, Free: free, MediaType: media},
+			},
+		}
+	}
+	nvmeTgt := func(state daos.PoolQueryTargetState, total, free uint64) *daos.PoolQueryTargetInfo {
+		return tgtInfo(state, daos.StorageMediaTypeNvme, total, free)
+	}
+	smdDev := func(uuid string, rank ranklist.Rank, state storage.NvmeDevState, tgts ...int32) *storage.SmdDevice {
+		return &storage.SmdDevice{
+			UUID:      uuid,
+			Rank:      rank,
+			TargetIDs: tgts,
+			Ctrlr:     storage.NvmeController{NvmeState: state},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		hosts       map[string][]*storage.SmdDevice
+		rankTargets map[ranklist.Rank][]*daos.PoolQueryTargetInfo
+		expMap      *StorageMap
+	}{
+		"no ranks": {
+			expMap: &StorageMap{Pool: "pool"},
+		},
+		"devices on pool ranks": {
+			hosts: map[string][]*storage.SmdDevice{
+				"host1:10001": {
+					smdDev("dev-b", 0, storage.NvmeStateNormal, 1, 3),
+					smdDev("dev-a", 0, storage.NvmeStateFaulty, 2, 0),
+				},
+				"host2:10001": {
+					smdDev("dev-c", 1, storage.NvmeStateNormal, 0, 1),
+					// Not a pool rank.
+					smdDev("dev-d", 2, storage.NvmeStateNormal, 0, 1),
+				},
+			},
+			rankTargets: map[ranklist.Rank][]*daos.PoolQueryTargetInfo{
+				0: {
+					nvmeTgt(daos.PoolTargetStateDownOut, 100, 100),
+					nvmeTgt(daos.PoolTargetStateUpIn, 100, 20),
+					nvmeTgt(daos.PoolTargetStateDownOut, 100, 100),
+					nvmeTgt(daos.PoolTargetStateUpIn, 100, 60),
+				},
+				1: {
+					nvmeTgt(daos.PoolTargetStateUpIn, 100, 50),
+					nvmeTgt(daos.PoolTargetStateDown, 100, 50),
+				},
+			},
+			expMap: &StorageMap{
+				Pool: "pool",
+				Ranks: []*StorageMapRank{
+					{
+						Rank: 0,
+						Host: "host1",
+						Devices: []*StorageMapDevice{
+							{
+								UUID:  "dev-a",
+								State: storage.NvmeStateFaulty,
+								Targets: []*StorageMapTarget{
+									{Index: 0, State: daos.PoolTargetStateDownOut, Usage: StorageTierUsage{100, 100}},
+									{Index: 2, State: daos.PoolTargetStateDownOut, Usage: StorageTierUsage{100, 100}},
+								},
+								Usage: StorageTierUsage{200, 200},
+							},
+							{
+								UUID:  "dev-b",
+								State: storage.NvmeStateNormal,
+								Targets: []*StorageMapTarget{
+									{Index: 1, State: daos.PoolTargetStateUpIn, Usage: StorageTierUsage{100, 20}},
+									{Index: 3, State: daos.PoolTargetStateUpIn, Usage: StorageTierUsage{100, 60}},
+								},
+								Usage: StorageTierUsage{200, 80},
+							},
+						},
+					},
+					{
+						Rank: 1,
+						Host: "host2",
+						Devices: []*StorageMapDevice{
+							{
+								UUID:  "dev-c",
+								State: storage.NvmeStateNormal,
+								Targets: []*StorageMapTarget{
+									{Index: 0, State: daos.PoolTargetStateUpIn, Usage: StorageTierUsage{100, 50}},
+									{Index: 1, State: daos.PoolTargetStateDown, Usage: StorageTierUsage{100, 50}},
+								},
+								Usage: StorageTierUsage{200, 100},
+							},
+						},
+					},
+				},
+			},
+		},
+		"rank without devices": {
+			rankTargets: map[ranklist.Rank][]*daos.PoolQueryTargetInfo{
+				3: {
+					tgtInfo(daos.PoolTargetStateUpIn, daos.StorageMediaTypeScm, 100, 75),
+					nil,
+				},
+			},
+			expMap: &StorageMap{
+				Pool: "pool",
+				Ranks: []*StorageMapRank{
+					{
+						Rank: 3,
+						Devices: []*StorageMapDevice{
+							{
+								Targets: []*StorageMapTarget{
+									{Index: 0, State: daos.PoolTargetStateUpIn, Usage: StorageTierUsage{100, 75}},
+								},
+								Usage: StorageTierUsage{100, 75},
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			hsm := make(HostStorageMap)
+			for host, devs := range tc.hosts {
+				hs := &HostStorage{SmdInfo: &SmdInfo{Devices: devs}}
+				if err := hsm.Add(host, hs); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotMap := NewStorageMap("pool", hsm, tc.rankTargets)
+			if diff := cmp.Diff(tc.expMap, gotMap); diff != "" {
+				t.Fatalf("unexpected storage map (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_StorageMapDevice_Markers(t *testing.T) {
+	tgts := func(states ...daos.PoolQueryTargetState) []*StorageMapTarget {
+		var out []*StorageMapTarget
+		for i, state := range states {
+			out = append(out, &StorageMapTarget{Index: uint32(i), State: state})
+		}
+		return out
+	}
+
+	for name, tc := range map[string]struct {
+		dev           *StorageMapDevice
+		expUsed       int
+		expFaulty     bool
+		expRebuilding bool
+		expExcluded   bool
+	}{
+		"no targets": {
+			dev: &StorageMapDevice{},
+		},
+		"healthy": {
+			dev: &StorageMapDevice{
+				State:   storage.NvmeStateNormal,
+				Targets: tgts(daos.PoolTargetStateUpIn, daos.PoolTargetStateUpIn),
+				Usage:   StorageTierUsage{TotalBytes: 400, FreeBytes: 100},
+			},
+			expUsed: 75,
+		},
+		"faulty and excluded": {
+			dev: &StorageMapDevice{
+				State:   storage.NvmeStateFaulty,
+				Targets: tgts(daos.PoolTargetStateDownOut, daos.PoolTargetStateDownOut),
+			},
+			expFaulty:   true,
+			expExcluded: true,
+		},
+		"rebuilding": {
+			dev: &StorageMapDevice{
+				Targets: tgts(daos.PoolTargetStateDownOut, daos.PoolTargetStateDown),
+			},
+			expRebuilding: true,
+		},
+		"draining": {
+			dev: &StorageMapDevice{
+				Targets: tgts(daos.PoolTargetStateUpIn, daos.PoolTargetStateDrain),
+			},
+			expRebuilding: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if tc.dev.UsedPercent() != tc.expUsed {
+				t.Fatalf("expected %d%% used, got %d%%", tc.expUsed, tc.dev.UsedPercent())
+			}
+			if tc.dev.Faulty() != tc.expFaulty {
+				t.Fatalf("expected faulty %t", tc.expFaulty)
+			}
+			if tc.dev.Rebuilding() != tc.expRebuilding {
+				t.Fatalf("expected rebuilding %t", tc.expRebuilding)
+			}
+			if tc.dev.Excluded() != tc.expExcluded {
+				t.Fatalf("expected excluded %t", tc.expExcluded)
+			}
+		})
+	}
+}
+
+func TestControl_GetStorageMap(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *StorageMapReq
+		expMap  *StorageMap
+		expReqs int
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.StorageMapReq"),
+		},
+		"pool query fails": {
+			req: &StorageMapReq{ID: "tank"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"pool without targets": {
+			req: &StorageMapReq{ID: "tank"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+					Uuid:  test.MockUUID(),
+					Label: "tank",
+				}),
+			},
+			expMap:  &StorageMap{Pool: "tank"},
+			expReqs: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotMap, gotErr := GetStorageMap(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			// Only the pool is queried if it has no targets to map.
+			test.AssertEqual(t, tc.expReqs, len(mi.SentReqs), "unexpected number of requests")
+
+			if diff := cmp.Diff(tc.expMap, gotMap); diff != "" {
+				t.Fatalf("unexpected storage map (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,11 +13,13 @@ import (
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/storage"
@@ -167,6 +170,146 @@ func TestControl_StorageMap(t *testing.T) {
 	}
 }
 
+func TestControl_HostStorageMap_UsageByHost(t *testing.T) {
+	mockStorage := func(scmTotal, scmFree, nvmeTotal, nvmeFree uint64) *HostStorage {
+		return &HostStorage{
+			ScmNamespaces: storage.ScmNamespaces{
+				{
+					Mount: &storage.ScmMountPoint{
+						TotalBytes: scmTotal,
+						AvailBytes: scmFree,
+					},
+				},
+			},
+			NvmeDevices: storage.NvmeControllers{
+				{
+					SmdDevices: []*storage.SmdDevice{
+						{TotalBytes: nvmeTotal, AvailBytes: nvmeFree},
+					},
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		hosts     map[string]*HostStorage
+		expResult *StorageUsageSummary
+	}{
+		"empty map": {
+			expResult: &StorageUsageSummary{
+				Total: &StorageUsageEntry{Name: "Total"},
+			},
+		},
+		"hosts with identical and differing storage": {
+			hosts: map[string]*HostStorage{
+				"host3:10001": mockStorage(200, 50, 4000, 1000),
+				"host1:10001": mockStorage(100, 50, 2000, 500),
+				"host2:10001": mockStorage(100, 50, 2000, 500),
+			},
+			expResult: &StorageUsageSummary{
+				Entries: []*StorageUsageEntry{
+					{
+						Name: "host1:10001",
+						Scm:  StorageTierUsage{TotalBytes: 100, FreeBytes: 50},
+						Nvme: StorageTierUsage{TotalBytes: 2000, FreeBytes: 500},
+					},
+					{
+						Name: "host2:10001",
+						Scm:  StorageTierUsage{TotalBytes: 100, FreeBytes: 50},
+						Nvme: StorageTierUsage{TotalBytes: 2000, FreeBytes: 500},
+					},
+					{
+						Name: "host3:10001",
+						Scm:  StorageTierUsage{TotalBytes: 200, FreeBytes: 50},
+						Nvme: StorageTierUsage{TotalBytes: 4000, FreeBytes: 1000},
+					},
+				},
+				Total: &StorageUsageEntry{
+					Name: "Total",
+					Scm:  StorageTierUsage{TotalBytes: 400, FreeBytes: 150},
+					Nvme: StorageTierUsage{TotalBytes: 8000, FreeBytes: 2000},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			hsm := make(HostStorageMap)
+			for host, hs := range tc.hosts {
+				if err := hsm.Add(host, hs); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if diff := cmp.Diff(tc.expResult, hsm.UsageByHost()); diff != "" {
+				t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_UsageByPool(t *testing.T) {
+	mockPool := func(idx int32, label string, tiers ...*daos.StorageUsageStats) *daos.PoolInfo {
+		return &daos.PoolInfo{
+			UUID:      uuid.MustParse(test.MockUUID(idx)),
+			Label:     label,
+			TierStats: tiers,
+		}
+	}
+	scmStats := func(total, free uint64) *daos.StorageUsageStats {
+		return &daos.StorageUsageStats{Total: total, Free: free, MediaType: daos.StorageMediaTypeScm}
+	}
+	nvmeStats := func(total, free uint64) *daos.StorageUsageStats {
+		return &daos.StorageUsageStats{Total: total, Free: free, MediaType: daos.StorageMediaTypeNvme}
+	}
+
+	for name, tc := range map[string]struct {
+		pools     []*daos.PoolInfo
+		expResult *StorageUsageSummary
+	}{
+		"no pools": {
+			expResult: &StorageUsageSummary{
+				Entries: []*StorageUsageEntry{},
+				Total:   &StorageUsageEntry{Name: "Total"},
+			},
+		},
+		"multiple pools": {
+			pools: []*daos.PoolInfo{
+				mockPool(2, "pool2", scmStats(10, 5), nvmeStats(100, 20)),
+				mockPool(1, "pool1", scmStats(20, 10), nvmeStats(200, 40)),
+				mockPool(3, "", nil...),
+			},
+			expResult: &StorageUsageSummary{
+				Entries: []*StorageUsageEntry{
+					{Name: "00000003", UUID: test.MockUUID(3)},
+					{
+						Name: "pool1",
+						UUID: test.MockUUID(1),
+						Scm:  StorageTierUsage{TotalBytes: 20, FreeBytes: 10},
+						Nvme: StorageTierUsage{TotalBytes: 200, FreeBytes: 40},
+					},
+					{
+						Name: "pool2",
+						UUID: test.MockUUID(2),
+						Scm:  StorageTierUsage{TotalBytes: 10, FreeBytes: 5},
+						Nvme: StorageTierUsage{TotalBytes: 100, FreeBytes: 20},
+					},
+				},
+				Total: &StorageUsageEntry{
+					Name: "Total",
+					Scm:  StorageTierUsage{TotalBytes: 30, FreeBytes: 15},
+					Nvme: StorageTierUsage{TotalBytes: 300, FreeBytes: 60},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expResult, UsageByPool(tc.pools)); diff != "" {
+				t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_StorageScan(t *testing.T) {
 	var (
 		standard       = MockServerScanResp(t, "standard")
@@ -11,6 +11,7 @@ import (
 	"context"
 	"encoding/json"
 	"net"
+	"sort"
 	"strings"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -195,7 +197,7 @@ func SystemJoin(ctx context.Context, rpcClient UnaryInvoker, req *SystemJoinReq)
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).Join(ctx, pbReq)
 	})
-	req.SetTimeout(SystemJoinTimeout)
+	setDefaultTimeout(ctx, req, SystemJoinTimeout)
 	req.retryTimeout = SystemJoinRetryTimeout
 	req.retryTestFn = func(err error, _ uint) bool {
 		switch {
@@ -225,11 +227,13 @@ func SystemJoin(ctx context.Context, rpcClient UnaryInvoker, req *SystemJoinReq)
 type SystemQueryReq struct {
 	unaryRequest
 	msRequest
+	msReadRequest
 	sysRequest
 	retryableRequest
 	FailOnUnavailable bool               // Fail without retrying if the MS is unavailable
 	NotOK             bool               // Only show engines not in a joined state
 	WantedStates      system.MemberState // Bitmask of desired states
+	ChangeToken       uint64             // Return NotModified if the system is unchanged since this token
 }
 
 func (req *SystemQueryReq) getStateMask() (system.MemberState, error) {
@@ -251,6 +255,8 @@ type SystemQueryResp struct {
 	sysResponse `json:"-"`
 	Members     system.Members `json:"members"`
 	Providers   []string       `json:"providers"`
+	ChangeToken uint64         `json:"-"` // Identifies the state of the system at the time of the response
+	NotModified bool           `json:"-"` // Members omitted as the system is unchanged since the request token
 }
 
 // Wrap sysResponse handling of absent hosts and ranks in a helper to be called from response
@@ -305,15 +311,20 @@ func (resp *SystemQueryResp) Errors() error {
 // mgmt_system.go method of the same name. The triggered method uses the control
 // API to fanout to (selection or all) gRPC servers listening as part of the
 // DAOS system and retrieve results from the selected ranks hosted there.
+//
+// If the request carries the ChangeToken from an earlier response and the system
+// is unchanged since then, the response is flagged as NotModified and carries
+// no members.
 func SystemQuery(ctx context.Context, rpcClient UnaryInvoker, req *SystemQueryReq) (*SystemQueryResp, error) {
 	if req == nil {
 		return nil, errors.Errorf("nil %T request", req)
 	}
 
 	pbReq := &mgmtpb.SystemQueryReq{
-		Hosts: req.Hosts.String(),
-		Ranks: req.Ranks.String(),
-		Sys:   req.getSystem(rpcClient),
+		Hosts:       req.Hosts.String(),
+		Ranks:       req.Ranks.String(),
+		Sys:         req.getSystem(rpcClient),
+		ChangeToken: req.ChangeToken,
 	}
 
 	mask, err := req.getStateMask()
@@ -347,7 +358,18 @@ func SystemQuery(ctx context.Context, rpcClient UnaryInvoker, req *SystemQueryRe
 	}
 
 	resp := new(SystemQueryResp)
-	return resp, convertMSResponse(ur, resp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return resp, err
+	}
+
+	ci := new(changeInfo)
+	if err := convertMSResponse(ur, ci); err != nil {
+		return nil, err
+	}
+	resp.ChangeToken = ci.DataVersion
+	resp.NotModified = ci.NotModified
+
+	return resp, nil
 }
 
 func concatErrs(errOne, errTwo error) error {
@@ -445,6 +467,7 @@ type SystemStopReq struct {
 	unaryRequest
 	msRequest
 	sysRequest
+	progressRequest
 	Force               bool
 	Full                bool
 	IgnoreAdminExcluded bool // Ignore any ranks in the rank/host list in the AdminExcluded state
@@ -513,7 +536,11 @@ func SystemStop(ctx context.Context, rpcClient UnaryInvoker, req *SystemStopReq)
 		IgnoreAdminExcluded: req.IgnoreAdminExcluded,
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return mgmtpb.NewMgmtSvcClient(conn).SystemStop(ctx, pbReq)
+		return req.invokeWithProgress(func() (proto.Message, error) {
+			return systemStopStream(ctx, conn, req, pbReq)
+		}, func() (proto.Message, error) {
+			return mgmtpb.NewMgmtSvcClient(conn).SystemStop(ctx, pbReq)
+		})
 	})
 
 	rpcClient.Debugf("DAOS system stop request: %s", pbUtil.Debug(pbReq))
@@ -526,6 +553,21 @@ func SystemStop(ctx context.Context, rpcClient UnaryInvoker, req *SystemStopReq)
 	return resp, convertMSResponse(ur, resp)
 }
 
+// systemStopStream stops the system, reporting the progress of the stop pushed by
+// the management service.
+func systemStopStream(ctx context.Context, conn *grpc.ClientConn, req *SystemStopReq, pbReq *mgmtpb.SystemStopReq) (*mgmtpb.SystemStopResp, error) {
+	stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemStopStream(ctx, pbReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return recvProgressStream(stream, func(p *sharedpb.Progress) {
+		req.reportProgress(conn.Target(), p)
+	}, func(part *mgmtpb.SystemStopStreamResp) (*sharedpb.Progress, *mgmtpb.SystemStopResp) {
+		return part.GetProgress(), part.GetResp()
+	})
+}
+
 // getResetHostErrors maps rank error messages to hosts that experience them.
 func getResetRankErrors(results system.MemberResults) (map[string][]string, []string, error) {
 	rankErrors := make(map[string][]string) // hosts that experience a specific rank err
@@ -559,7 +601,19 @@ type SystemExcludeReq struct {
 	unaryRequest
 	msRequest
 	sysRequest
-	Clear bool
+	Clear    bool
+	Start    time.Time     // Time at which to apply the request (zero = now)
+	Duration time.Duration // Time after start at which to clear excluded state (zero = never)
+}
+
+// ScheduledRankAction describes a pending MS-managed update of the excluded state of system
+// ranks. Start and End times are in seconds since the Unix epoch.
+type ScheduledRankAction struct {
+	Ranks  *ranklist.RankSet `json:"ranks"`
+	Clear  bool              `json:"clear"`
+	Start  uint64            `json:"start"`
+	End    uint64            `json:"end"`
+	Active bool              `json:"active"`
 }
 
 // SystemExcludeResp contains the request response. UnmarshalJSON is not implemented on this type
@@ -568,6 +622,7 @@ type SystemExcludeReq struct {
 type SystemExcludeResp struct {
 	sysResponse `json:"-"`
 	Results     system.MemberResults
+	Scheduled   *ScheduledRankAction `json:"scheduled,omitempty"`
 }
 
 // Errors returns a single error combining all error messages associated with a system exclude
@@ -585,12 +640,22 @@ func SystemExclude(ctx context.Context, rpcClient UnaryInvoker, req *SystemExclu
 	if req == nil {
 		return nil, errors.Errorf("nil %T request", req)
 	}
+	if req.Duration < 0 {
+		return nil, errors.New("duration cannot be negative")
+	}
+	if req.Clear && req.Duration != 0 {
+		return nil, errors.New("duration cannot be specified when clearing excluded state")
+	}
 
 	pbReq := &mgmtpb.SystemExcludeReq{
-		Hosts: req.Hosts.String(),
-		Ranks: req.Ranks.String(),
-		Sys:   req.getSystem(rpcClient),
-		Clear: req.Clear,
+		Hosts:    req.Hosts.String(),
+		Ranks:    req.Ranks.String(),
+		Sys:      req.getSystem(rpcClient),
+		Clear:    req.Clear,
+		Duration: uint64(req.Duration.Seconds()),
+	}
+	if !req.Start.IsZero() {
+		pbReq.Start = uint64(req.Start.Unix())
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemExclude(ctx, pbReq)
@@ -609,6 +674,42 @@ func SystemExclude(ctx context.Context, rpcClient UnaryInvoker, req *SystemExclu
 	//                  gets called for each of the rank's pools.
 }
 
+// SystemListScheduledReq contains the inputs for the system list-scheduled request.
+type SystemListScheduledReq struct {
+	unaryRequest
+	msRequest
+}
+
+// SystemListScheduledResp contains the pending scheduled updates of the excluded state of
+// system ranks.
+type SystemListScheduledResp struct {
+	Actions []*ScheduledRankAction `json:"actions"`
+}
+
+// SystemListScheduled returns the pending scheduled updates of the excluded state of system
+// ranks.
+func SystemListScheduled(ctx context.Context, rpcClient UnaryInvoker, req *SystemListScheduledReq) (*SystemListScheduledResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemListScheduledReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemListScheduled(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system list-scheduled request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemListScheduledResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
 // SystemDrainReq contains the inputs for the system drain request.
 type SystemDrainReq struct {
 	unaryRequest
@@ -1000,24 +1101,32 @@ func StartRanks(ctx context.Context, rpcClient UnaryInvoker, req *RanksReq) (*Ra
 	return invokeRPCFanout(ctx, rpcClient, req)
 }
 
-// SystemCleanupReq contains the inputs for the system cleanup request.
+// SystemCleanupReq contains the inputs for the system cleanup request. Either
+// a machine name or AllStale must be set. AllStale selects the machines whose
+// agents have stopped sending heartbeats to the MS.
 type SystemCleanupReq struct {
 	unaryRequest
 	msRequest
 	sysRequest
-	Machine string `json:"machine"`
+	Machine  string `json:"machine"`
+	AllStale bool   `json:"all_stale"`
+	DryRun   bool   `json:"dry_run"`
 }
 
 type CleanupResult struct {
-	Status int32  `json:"status"`  // Status returned from this specific evict call
-	Msg    string `json:"msg"`     // Error message if Status is not Success
-	PoolID string `json:"pool_id"` // Unique identifier
-	Count  uint32 `json:"count"`   // Number of pool handles evicted
+	Status  int32  `json:"status"`  // Status returned from this specific evict call
+	Msg     string `json:"msg"`     // Error message if Status is not Success
+	PoolID  string `json:"pool_id"` // Unique identifier
+	Count   uint32 `json:"count"`   // Number of pool handles evicted, or to be evicted in a dry run
+	Machine string `json:"machine"` // Machine that the pool handles belong to
 }
 
-// SystemCleanupResp contains the request response.
+// SystemCleanupResp contains the request response. UnknownMachines lists the
+// machines holding pool handles whose agents have not sent heartbeats to the
+// current MS leader, and which are therefore not cleaned up as stale.
 type SystemCleanupResp struct {
-	Results []*CleanupResult `json:"results"`
+	Results         []*CleanupResult `json:"results"`
+	UnknownMachines []string         `json:"unknown_machines"`
 }
 
 // Errors returns a single error combining all error messages associated with a
@@ -1041,13 +1150,18 @@ func SystemCleanup(ctx context.Context, rpcClient UnaryInvoker, req *SystemClean
 		return nil, errors.Errorf("nil %T request", req)
 	}
 
-	if req.Machine == "" {
+	switch {
+	case req.Machine == "" && !req.AllStale:
 		return nil, errors.New("SystemCleanup requires a machine name.")
+	case req.Machine != "" && req.AllStale:
+		return nil, errors.New("SystemCleanup machine name and all stale machines are mutually exclusive")
 	}
 
 	pbReq := &mgmtpb.SystemCleanupReq{
-		Sys:     req.getSystem(rpcClient),
-		Machine: req.Machine,
+		Sys:      req.getSystem(rpcClient),
+		Machine:  req.Machine,
+		AllStale: req.AllStale,
+		DryRun:   req.DryRun,
 	}
 
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
@@ -1064,6 +1178,104 @@ func SystemCleanup(ctx context.Context, rpcClient UnaryInvoker, req *SystemClean
 	return resp, convertMSResponse(ur, resp)
 }
 
+// AgentHeartbeatReq contains the inputs for the agent heartbeat request,
+// including an inventory of the client machine.
+type AgentHeartbeatReq struct {
+	unaryRequest
+	msRequest
+	Machine          string
+	Interval         time.Duration
+	Version          string
+	FabricInterfaces []string
+	ClientCount      uint32
+}
+
+// AgentHeartbeat notifies the MS that the agent on the machine is running and
+// will send its next heartbeat within the interval. The MS uses the heartbeats
+// to find machines with stale pool handles.
+func AgentHeartbeat(ctx context.Context, rpcClient UnaryInvoker, req *AgentHeartbeatReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if req.Machine == "" {
+		return errors.New("AgentHeartbeat requires a machine name")
+	}
+	if req.Interval < time.Second {
+		return errors.New("AgentHeartbeat interval must be at least one second")
+	}
+
+	pbReq := &mgmtpb.AgentHeartbeatReq{
+		Sys:              req.getSystem(rpcClient),
+		Machine:          req.Machine,
+		Interval:         uint32(req.Interval / time.Second),
+		Version:          req.Version,
+		FabricInterfaces: req.FabricInterfaces,
+		ClientCount:      req.ClientCount,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).AgentHeartbeat(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS agent heartbeat request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
+type (
+	// SystemListClientsReq contains the inputs for the system list-clients
+	// request.
+	SystemListClientsReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// ClientNode describes a client machine whose agent has sent heartbeats
+	// to the MS leader.
+	ClientNode struct {
+		Machine          string   `json:"machine"`
+		Version          string   `json:"version"`
+		FabricInterfaces []string `json:"fabric_interfaces"`
+		ClientCount      uint32   `json:"client_count"`
+		LastSeen         int64    `json:"last_seen"` // Unix time of the last heartbeat
+		Interval         uint32   `json:"interval"`  // Seconds between heartbeats
+		Stale            bool     `json:"stale"`
+	}
+
+	// SystemListClientsResp contains the client machines known to the MS
+	// leader, sorted by machine name.
+	SystemListClientsResp struct {
+		Clients []*ClientNode `json:"clients"`
+	}
+)
+
+// SystemListClients returns the client machines whose agents have sent
+// heartbeats to the MS leader, along with the inventory reported by each.
+func SystemListClients(ctx context.Context, rpcClient UnaryInvoker, req *SystemListClientsReq) (*SystemListClientsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemListClientsReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemListClients(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemListClients request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemListClientsResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
 // SystemSetAttrReq contains the inputs for the system set-attr request.
 type SystemSetAttrReq struct {
 	unaryRequest
@@ -1103,8 +1315,12 @@ type (
 	SystemGetAttrReq struct {
 		unaryRequest
 		msRequest
+		msReadRequest
 
 		Keys []string
+		// Prefix restricts the attributes returned to those with names
+		// starting with it.
+		Prefix string
 	}
 
 	// SystemGetAttrResp contains the request response.
@@ -1134,7 +1350,34 @@ func SystemGetAttr(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetAt
 	}
 
 	resp := new(SystemGetAttrResp)
-	return resp, convertMSResponse(ur, resp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, err
+	}
+
+	if req.Prefix != "" {
+		for key := range resp.Attributes {
+			if !strings.HasPrefix(key, req.Prefix) {
+				delete(resp.Attributes, key)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// Names returns the sorted names of the attributes in the response.
+func (resp *SystemGetAttrResp) Names() []string {
+	if resp == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(resp.Attributes))
+	for name := range resp.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
 }
 
 // SystemSetPropReq contains the inputs for the system set-prop request.
@@ -1179,6 +1422,7 @@ type (
 	SystemGetPropReq struct {
 		unaryRequest
 		msRequest
+		msReadRequest
 
 		Keys []daos.SystemPropertyKey
 	}
@@ -1246,3 +1490,350 @@ func SystemGetProp(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetPr
 
 	return resp, nil
 }
+
+type (
+	// SystemSetFaultDomainsReq contains the inputs for the system set fault domains
+	// request.
+	SystemSetFaultDomainsReq struct {
+		unaryRequest
+		msRequest
+
+		FaultDomains map[string]string // Fault domain strings keyed by host
+		DryRun       bool              // Report changes without applying them
+		Clear        bool              // Remove all imported fault domains
+	}
+
+	// FaultDomainChange describes a change to the fault domain of a system member.
+	FaultDomainChange struct {
+		Rank      ranklist.Rank `json:"rank"`
+		Addr      string        `json:"addr"`
+		OldDomain string        `json:"old_domain"`
+		NewDomain string        `json:"new_domain"`
+	}
+
+	// SystemSetFaultDomainsResp contains the fault domain changes made, or
+	// that would be made in the case of a dry run.
+	SystemSetFaultDomainsResp struct {
+		Changes      []*FaultDomainChange `json:"changes"`
+		ClearedRanks *ranklist.RankSet    `json:"cleared_ranks"`
+	}
+)
+
+// SystemSetFaultDomains sets the fault domains of the system members resident on the
+// given hosts, or removes all imported fault domains if Clear is set.
+func SystemSetFaultDomains(ctx context.Context, rpcClient UnaryInvoker, req *SystemSetFaultDomainsReq) (*SystemSetFaultDomainsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Clear {
+		if len(req.FaultDomains) != 0 {
+			return nil, errors.New("fault domains cannot be specified with clear")
+		}
+	} else if len(req.FaultDomains) == 0 {
+		return nil, errors.New("fault domains cannot be empty")
+	}
+
+	pbReq := &mgmtpb.SystemSetFaultDomainsReq{
+		Sys:          req.getSystem(rpcClient),
+		FaultDomains: req.FaultDomains,
+		DryRun:       req.DryRun,
+		Clear:        req.Clear,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemSetFaultDomains(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemSetFaultDomains request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SystemSetFaultDomainsResp{ClearedRanks: &ranklist.RankSet{}}
+	return resp, convertMSResponse(ur, resp)
+}
+
+type (
+	// SystemReplaceHostReq contains the inputs for the system replace host request.
+	SystemReplaceHostReq struct {
+		unaryRequest
+		msRequest
+
+		OldHost string // Host whose ranks are to be reassigned
+		NewHost string // Replacement host to take over the ranks
+	}
+
+	// SystemReplaceHostResp contains the ranks reassigned to the replacement host.
+	SystemReplaceHostResp struct {
+		Ranks *ranklist.RankSet `json:"ranks"`
+	}
+)
+
+// SystemReplaceHost reassigns the ranks resident on a failed host to a replacement
+// host. The replacement host's engines must then be formatted in replace mode in
+// order to join the system with the reassigned ranks.
+func SystemReplaceHost(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplaceHostReq) (*SystemReplaceHostResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.OldHost == "" || req.NewHost == "" {
+		return nil, errors.New("old and new hosts cannot be empty")
+	}
+
+	pbReq := &mgmtpb.SystemReplaceHostReq{
+		Sys:     req.getSystem(rpcClient),
+		OldHost: req.OldHost,
+		NewHost: req.NewHost,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemReplaceHost(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemReplaceHost request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SystemReplaceHostResp{Ranks: &ranklist.RankSet{}}
+	return resp, convertMSResponse(ur, resp)
+}
+
+type (
+	// SystemEventsReq contains the inputs for the system events request.
+	SystemEventsReq struct {
+		unaryRequest
+		msRequest
+
+		Severity events.RASSeverityID // Return events at least this severe
+		Ranks    *ranklist.RankSet    // Return events for these ranks
+		Since    time.Time            // Return events at or after this time
+		Until    time.Time            // Return events at or before this time
+		Offset   uint64               // Number of matching events to skip
+		Limit    uint64               // Maximum number of events to return
+	}
+
+	// SystemEventsResp contains the RAS events recorded in the system
+	// database that matched the request, newest first.
+	SystemEventsResp struct {
+		Events []*events.RASEvent `json:"events"`
+		Total  uint64             `json:"total"`
+	}
+)
+
+// SystemEvents lists the RAS events recorded in the system database.
+func SystemEvents(ctx context.Context, rpcClient UnaryInvoker, req *SystemEventsReq) (*SystemEventsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemEventsReq{
+		Sys:      req.getSystem(rpcClient),
+		Severity: req.Severity.Uint32(),
+		Ranks:    req.Ranks.String(),
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = req.Since.Format(time.RFC3339)
+	}
+	if !req.Until.IsZero() {
+		pbReq.Until = req.Until.Format(time.RFC3339)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemEvents(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemEvents request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	pbResp, ok := msg.(*mgmtpb.SystemEventsResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type: %T", msg)
+	}
+
+	resp := &SystemEventsResp{
+		Events: make([]*events.RASEvent, 0, len(pbResp.GetEvents())),
+		Total:  pbResp.GetTotal(),
+	}
+	for _, pbEvt := range pbResp.GetEvents() {
+		evt, err := events.NewFromProto(pbEvt)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert event")
+		}
+		resp.Events = append(resp.Events, evt)
+	}
+
+	return resp, nil
+}
+
+type (
+	// SystemUsageReq contains the inputs for the system usage request.
+	SystemUsageReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// RankUsage describes the pool storage on a rank, as per-tier byte counts.
+	RankUsage struct {
+		Rank           ranklist.Rank `json:"rank"`
+		AllocatedBytes []uint64      `json:"allocated_bytes"`
+		ReservedBytes  []uint64      `json:"reserved_bytes"`
+		NumPools       uint32        `json:"num_pools"`
+	}
+
+	// PoolReservation describes the per-rank storage reserved by a pool that
+	// is being created.
+	PoolReservation struct {
+		UUID      string            `json:"uuid"`
+		Label     string            `json:"label"`
+		Ranks     *ranklist.RankSet `json:"ranks"`
+		TierBytes []uint64          `json:"tier_bytes"`
+		Since     string            `json:"since"`
+	}
+
+	// SystemUsageResp contains the pool storage allocated and reserved on
+	// each rank, and the outstanding pool reservations.
+	SystemUsageResp struct {
+		Ranks        []*RankUsage       `json:"ranks"`
+		Reservations []*PoolReservation `json:"reservations"`
+	}
+)
+
+// SystemUsage returns the pool storage allocated to created pools and reserved
+// by pools that are being created, on each rank in the system.
+func SystemUsage(ctx context.Context, rpcClient UnaryInvoker, req *SystemUsageReq) (*SystemUsageResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemUsageReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemUsage(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemUsage request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemUsageResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+type (
+	// SystemOpLocksReq contains the inputs for the system operation locks
+	// request.
+	SystemOpLocksReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// OpLock describes an operation lock held by the MS leader. The pool
+	// fields are empty for the system-wide lock.
+	OpLock struct {
+		PoolUUID  string `json:"pool_uuid"`
+		PoolLabel string `json:"pool_label"`
+		Operation string `json:"operation"`
+		User      string `json:"user"`
+		Since     string `json:"since"`
+	}
+
+	// SystemOpLocksResp contains the operation locks held by the MS
+	// leader, oldest first.
+	SystemOpLocksResp struct {
+		Locks []*OpLock `json:"locks"`
+	}
+)
+
+// SystemOpLocks returns the operation locks held by the MS leader, which
+// prevent conflicting operations from running concurrently.
+func SystemOpLocks(ctx context.Context, rpcClient UnaryInvoker, req *SystemOpLocksReq) (*SystemOpLocksResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemOpLocksReq{
+		Sys: req.getSystem(rpcClient),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemOpLocks(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemOpLocks request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemOpLocksResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+type (
+	// SystemChangesReq contains the parameters for a request to list the
+	// changes to the system information cached by agents that have been
+	// recorded after a sequence number.
+	SystemChangesReq struct {
+		unaryRequest
+		msRequest
+		AfterSeq uint64
+	}
+
+	// SystemChange describes a change to the system recorded by the MS,
+	// e.g. a rank joining or being excluded, or a pool service replica
+	// update.
+	SystemChange struct {
+		Seq       uint64 `json:"seq"`
+		Event     string `json:"event"`
+		Rank      uint32 `json:"rank"`
+		PoolUUID  string `json:"pool_uuid"`
+		Op        string `json:"op"`
+		Timestamp string `json:"timestamp"`
+	}
+
+	// SystemChangesResp contains the matching changes, oldest first, and
+	// the sequence number of the most recently recorded event, which is
+	// used as the starting point of the next request.
+	SystemChangesResp struct {
+		Changes []*SystemChange `json:"changes"`
+		LastSeq uint64          `json:"last_seq"`
+	}
+)
+
+// SystemChanges lists the changes to the system information cached by agents
+// that have been recorded by the Management Service after the requested
+// sequence number.
+func SystemChanges(ctx context.Context, rpcClient UnaryInvoker, req *SystemChangesReq) (*SystemChangesResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+
+	pbReq := &mgmtpb.SystemChangesReq{
+		Sys:      req.getSystem(rpcClient),
+		AfterSeq: req.AfterSeq,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemChanges(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemChanges request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemChangesResp)
+	return resp, convertMSResponse(ur, resp)
+}
@@ -19,6 +19,7 @@ import (
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -1013,6 +1014,34 @@ func TestControl_SystemExclude(t *testing.T) {
 			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
 			expErr: errors.New("remote failed"),
 		},
+		"negative duration": {
+			req:    &SystemExcludeReq{Duration: -time.Hour},
+			expErr: errors.New("cannot be negative"),
+		},
+		"clear with duration": {
+			req:    &SystemExcludeReq{Clear: true, Duration: time.Hour},
+			expErr: errors.New("cannot be specified when clearing"),
+		},
+		"scheduled": {
+			req: &SystemExcludeReq{
+				Start:    time.Unix(1000000, 0),
+				Duration: time.Hour,
+			},
+			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemExcludeResp{
+				Scheduled: &mgmtpb.ScheduledRankAction{
+					Ranks: "0-1",
+					Start: 1000000,
+					End:   1003600,
+				},
+			}),
+			expResp: &SystemExcludeResp{
+				Scheduled: &ScheduledRankAction{
+					Ranks: ranklist.MustCreateRankSet("0-1"),
+					Start: 1000000,
+					End:   1003600,
+				},
+			},
+		},
 		"dual rank": {
 			req: new(SystemExcludeReq),
 			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemExcludeResp{
@@ -1074,8 +1103,12 @@ func TestControl_SystemExclude(t *testing.T) {
 				return
 			}
 
-			cmpOpts := []cmp.Option{cmpopts.IgnoreUnexported(SystemExcludeResp{},
-				system.MemberResult{})}
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(SystemExcludeResp{}, system.MemberResult{}),
+				cmp.Comparer(func(x, y *ranklist.RankSet) bool {
+					return x.String() == y.String()
+				}),
+			}
 			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
 			}
@@ -1085,6 +1118,85 @@ func TestControl_SystemExclude(t *testing.T) {
 	}
 }
 
+func TestControl_SystemListScheduled(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemListScheduledReq
+		uErr    error
+		uResp   *UnaryResponse
+		expErr  error
+		expResp *SystemListScheduledResp
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.SystemListScheduledReq request"),
+		},
+		"local failure": {
+			req:    new(SystemListScheduledReq),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    new(SystemListScheduledReq),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"none scheduled": {
+			req:     new(SystemListScheduledReq),
+			uResp:   MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemListScheduledResp{}),
+			expResp: &SystemListScheduledResp{},
+		},
+		"scheduled": {
+			req: new(SystemListScheduledReq),
+			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemListScheduledResp{
+				Actions: []*mgmtpb.ScheduledRankAction{
+					{Ranks: "0-1", Start: 1000000, End: 1003600, Active: true},
+					{Ranks: "3", Clear: true, Start: 2000000},
+				},
+			}),
+			expResp: &SystemListScheduledResp{
+				Actions: []*ScheduledRankAction{
+					{
+						Ranks:  ranklist.MustCreateRankSet("0-1"),
+						Start:  1000000,
+						End:    1003600,
+						Active: true,
+					},
+					{
+						Ranks: ranklist.MustCreateRankSet("3"),
+						Clear: true,
+						Start: 2000000,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemListScheduled(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y *ranklist.RankSet) bool {
+					return x.String() == y.String()
+				}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_SystemDrain(t *testing.T) {
 	for name, tc := range map[string]struct {
 		req        *SystemDrainReq
@@ -1220,6 +1332,10 @@ func TestControl_SystemCleanup(t *testing.T) {
 			req:    new(SystemCleanupReq),
 			expErr: errors.New("requires a machine name"),
 		},
+		"machine name and all stale": {
+			req:    &SystemCleanupReq{Machine: "foo", AllStale: true},
+			expErr: errors.New("mutually exclusive"),
+		},
 		"local failure": {
 			req:    &SystemCleanupReq{Machine: "foo"},
 			uErr:   errors.New("local failed"),
@@ -1282,6 +1398,23 @@ func TestControl_SystemCleanup(t *testing.T) {
 			},
 			expRespErr: errors.New("fail1, fail3"),
 		},
+		"all stale; dry run": {
+			req: &SystemCleanupReq{AllStale: true, DryRun: true},
+			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemCleanupResp{
+				Results: []*mgmtpb.SystemCleanupResp_CleanupResult{
+					{PoolId: test.MockUUID(1), Count: 2, Machine: "client1"},
+					{PoolId: test.MockUUID(2), Count: 1, Machine: "client1"},
+				},
+				UnknownMachines: []string{"client2"},
+			}),
+			expResp: &SystemCleanupResp{
+				Results: []*CleanupResult{
+					{PoolID: test.MockUUID(1), Count: 2, Machine: "client1"},
+					{PoolID: test.MockUUID(2), Count: 1, Machine: "client1"},
+				},
+				UnknownMachines: []string{"client2"},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1771,6 +1904,153 @@ func TestControl_SystemJoin_Timeouts(t *testing.T) {
 	}
 }
 
+func TestControl_AgentHeartbeat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *AgentHeartbeatReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"missing machine name": {
+			req:    &AgentHeartbeatReq{Interval: time.Minute},
+			expErr: errors.New("requires a machine name"),
+		},
+		"interval too short": {
+			req: &AgentHeartbeatReq{
+				Machine:  "client1",
+				Interval: time.Millisecond,
+			},
+			expErr: errors.New("at least one second"),
+		},
+		"req fails": {
+			req: &AgentHeartbeatReq{
+				Machine:  "client1",
+				Interval: time.Minute,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &AgentHeartbeatReq{
+				Machine:  "client1",
+				Interval: time.Minute,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := AgentHeartbeat(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_SystemListClients(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemListClientsReq
+		mic     *MockInvokerConfig
+		expResp *SystemListClientsResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemListClientsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"no clients": {
+			req: &SystemListClientsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemListClientsResp{}),
+				},
+			},
+			expResp: &SystemListClientsResp{},
+		},
+		"success": {
+			req: &SystemListClientsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemListClientsResp{
+						Clients: []*mgmtpb.SystemListClientsResp_Client{
+							{
+								Machine:          "client1",
+								Version:          "2.6.0",
+								FabricInterfaces: []string{"ib0", "ib1"},
+								ClientCount:      3,
+								LastSeen:         1735787045,
+								Interval:         60,
+							},
+							{
+								Machine:  "client2",
+								Version:  "2.6.0",
+								LastSeen: 1735780000,
+								Interval: 60,
+								Stale:    true,
+							},
+						},
+					}),
+				},
+			},
+			expResp: &SystemListClientsResp{
+				Clients: []*ClientNode{
+					{
+						Machine:          "client1",
+						Version:          "2.6.0",
+						FabricInterfaces: []string{"ib0", "ib1"},
+						ClientCount:      3,
+						LastSeen:         1735787045,
+						Interval:         60,
+					},
+					{
+						Machine:  "client2",
+						Version:  "2.6.0",
+						LastSeen: 1735780000,
+						Interval: 60,
+						Stale:    true,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemListClients(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_SystemSetAttr(t *testing.T) {
 	for name, tc := range map[string]struct {
 		req    *SystemSetAttrReq
@@ -1869,6 +2149,29 @@ func TestControl_SystemGetAttr(t *testing.T) {
 				},
 			},
 		},
+		"prefix": {
+			req: &SystemGetAttrReq{
+				Prefix: "site.",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemGetAttrResp{
+						Attributes: map[string]string{
+							"site.owner":       "storage-team",
+							"site.maintenance": "2025-06-01",
+							"sitemap":          "x",
+							"foo":              "bar",
+						},
+					}),
+				},
+			},
+			expResp: &SystemGetAttrResp{
+				Attributes: map[string]string{
+					"site.owner":       "storage-team",
+					"site.maintenance": "2025-06-01",
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(name)
@@ -1887,3 +2190,424 @@ func TestControl_SystemGetAttr(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_SystemSetFaultDomains(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemSetFaultDomainsReq
+		mic     *MockInvokerConfig
+		expResp *SystemSetFaultDomainsResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"empty fault domains": {
+			req:    &SystemSetFaultDomainsReq{},
+			expErr: errors.New("cannot be empty"),
+		},
+		"clear with fault domains": {
+			req: &SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{"host1": "/rack=r0/node=host1"},
+				Clear:        true,
+			},
+			expErr: errors.New("cannot be specified with clear"),
+		},
+		"req fails": {
+			req: &SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{"host1": "/rack=r0/node=host1"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{"host1": "/rack=r0/node=host1"},
+				DryRun:       true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemSetFaultDomainsResp{
+						Changes: []*mgmtpb.SystemSetFaultDomainsResp_FaultDomainChange{
+							{
+								Rank:      1,
+								Addr:      "10.0.0.1:10001",
+								OldDomain: "/host1",
+								NewDomain: "/rack=r0/node=host1",
+							},
+						},
+					}),
+				},
+			},
+			expResp: &SystemSetFaultDomainsResp{
+				Changes: []*FaultDomainChange{
+					{
+						Rank:      1,
+						Addr:      "10.0.0.1:10001",
+						OldDomain: "/host1",
+						NewDomain: "/rack=r0/node=host1",
+					},
+				},
+				ClearedRanks: ranklist.MustCreateRankSet(""),
+			},
+		},
+		"clear": {
+			req: &SystemSetFaultDomainsReq{
+				Clear: true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemSetFaultDomainsResp{
+						ClearedRanks: "0-3",
+					}),
+				},
+			},
+			expResp: &SystemSetFaultDomainsResp{
+				ClearedRanks: ranklist.MustCreateRankSet("0-3"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemSetFaultDomains(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y *ranklist.RankSet) bool {
+					return x.String() == y.String()
+				}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemReplaceHost(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemReplaceHostReq
+		mic     *MockInvokerConfig
+		expResp *SystemReplaceHostResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"missing new host": {
+			req:    &SystemReplaceHostReq{OldHost: "host1"},
+			expErr: errors.New("cannot be empty"),
+		},
+		"req fails": {
+			req: &SystemReplaceHostReq{OldHost: "host1", NewHost: "host5"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemReplaceHostReq{OldHost: "host1", NewHost: "host5"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemReplaceHostResp{
+						Ranks: "0-1",
+					}),
+				},
+			},
+			expResp: &SystemReplaceHostResp{
+				Ranks: ranklist.MustCreateRankSet("0-1"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemReplaceHost(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y *ranklist.RankSet) bool {
+					return x.String() == y.String()
+				}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemEvents(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemEventsReq
+		mic     *MockInvokerConfig
+		expResp *SystemEventsResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemEventsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"no events": {
+			req: &SystemEventsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemEventsResp{}),
+				},
+			},
+			expResp: &SystemEventsResp{
+				Events: []*events.RASEvent{},
+			},
+		},
+		"success": {
+			req: &SystemEventsReq{
+				Severity: events.RASSeverityError,
+				Ranks:    ranklist.MustCreateRankSet("[0-3]"),
+				Since:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+				Limit:    1,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemEventsResp{
+						Events: []*sharedpb.RASEvent{
+							{
+								Id:        uint32(events.RASEngineDied),
+								Msg:       "engine died",
+								Timestamp: "2025-01-02T04:00:00.000000+00:00",
+								Type:      uint32(events.RASTypeStateChange),
+								Severity:  uint32(events.RASSeverityError),
+								Hostname:  "foo",
+								Rank:      1,
+							},
+						},
+						Total: 3,
+					}),
+				},
+			},
+			expResp: &SystemEventsResp{
+				Events: []*events.RASEvent{
+					{
+						ID:        events.RASEngineDied,
+						Msg:       "engine died",
+						Timestamp: "2025-01-02T04:00:00.000000+00:00",
+						Type:      events.RASTypeStateChange,
+						Severity:  events.RASSeverityError,
+						Hostname:  "foo",
+						Rank:      1,
+					},
+				},
+				Total: 3,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemEvents(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(events.RASEvent{}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemOpLocks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemOpLocksReq
+		mic     *MockInvokerConfig
+		expResp *SystemOpLocksResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemOpLocksReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"no locks": {
+			req: &SystemOpLocksReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemOpLocksResp{}),
+				},
+			},
+			expResp: &SystemOpLocksResp{},
+		},
+		"success": {
+			req: &SystemOpLocksReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemOpLocksResp{
+						Locks: []*mgmtpb.OpLock{
+							{
+								PoolUuid:  test.MockUUID(1),
+								PoolLabel: "pool1",
+								Operation: "PoolExtend",
+								User:      "admin@host1",
+								Since:     "2025-01-02T03:00:00.000000+00:00",
+							},
+							{
+								Operation: "SystemStop",
+								User:      "admin@host2",
+								Since:     "2025-01-02T04:00:00.000000+00:00",
+							},
+						},
+					}),
+				},
+			},
+			expResp: &SystemOpLocksResp{
+				Locks: []*OpLock{
+					{
+						PoolUUID:  test.MockUUID(1),
+						PoolLabel: "pool1",
+						Operation: "PoolExtend",
+						User:      "admin@host1",
+						Since:     "2025-01-02T03:00:00.000000+00:00",
+					},
+					{
+						Operation: "SystemStop",
+						User:      "admin@host2",
+						Since:     "2025-01-02T04:00:00.000000+00:00",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemOpLocks(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemChanges(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *SystemChangesReq
+		expResp *SystemChangesResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.SystemChangesReq"),
+		},
+		"local failure": {
+			req: &SystemChangesReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &SystemChangesReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &SystemChangesReq{AfterSeq: 2},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.SystemChangesResp{
+						Changes: []*mgmtpb.SystemChange{
+							{
+								Seq:       3,
+								Event:     "system_membership_changed",
+								Rank:      1,
+								Op:        "exclude",
+								Timestamp: "2025-01-02T03:04:05.000+00:00",
+							},
+						},
+						LastSeq: 4,
+					},
+				),
+			},
+			expResp: &SystemChangesResp{
+				Changes: []*SystemChange{
+					{
+						Seq:       3,
+						Event:     "system_membership_changed",
+						Rank:      1,
+						Op:        "exclude",
+						Timestamp: "2025-01-02T03:04:05.000+00:00",
+					},
+				},
+				LastSeq: 4,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := SystemChanges(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+// ServerElapsedTrailer is the name of the gRPC response trailer in which the
+// server reports the time spent handling a request, in nanoseconds.
+const ServerElapsedTrailer = "daos-server-elapsed"
+
+type (
+	// RPCTrace contains the timing breakdown of a single RPC sent to a host.
+	RPCTrace struct {
+		Host   string    `json:"host"`
+		Method string    `json:"method"`
+		Start  time.Time `json:"start"`
+		// Connect is the time taken to establish the TCP connection.
+		Connect time.Duration `json:"connect"`
+		// Handshake is the time taken for the TLS and HTTP/2 handshakes.
+		Handshake time.Duration `json:"handshake"`
+		// Send is the time taken to send the request once connected.
+		Send time.Duration `json:"send"`
+		// Server is the time spent handling the request, as reported by
+		// the server. Zero if the server does not report it.
+		Server time.Duration `json:"server"`
+		// Total is the time from the start of the RPC until the response
+		// was received.
+		Total time.Duration `json:"total"`
+		Error string        `json:"error,omitempty"`
+	}
+
+	// RequestTrace contains the timing breakdown of all RPCs invoked by a
+	// client while tracing was enabled.
+	RequestTrace struct {
+		RPCs []*RPCTrace `json:"rpcs"`
+		// Elapsed is the time since tracing was enabled.
+		Elapsed time.Duration `json:"elapsed"`
+		// AfterLastRPC is the time since the last RPC completed, e.g. the
+		// time spent processing and rendering the responses.
+		AfterLastRPC time.Duration `json:"after_last_rpc"`
+	}
+
+	// RequestTracer records the timing of RPCs invoked by a client.
+	RequestTracer struct {
+		sync.Mutex
+		start   time.Time
+		lastEnd time.Time
+		rpcs    []*RPCTrace
+	}
+
+	// hostTracer records the timing of the connection to a single host,
+	// and implements the stats.Handler interface in order to record the
+	// RPCs sent over the connection.
+	hostTracer struct {
+		sync.Mutex
+		tracer    *RequestTracer
+		host      string
+		connect   time.Duration
+		dialed    time.Time
+		handshake time.Duration
+		connected time.Time
+		reported  bool
+	}
+
+	rpcTraceKey struct{}
+
+	rpcTraceState struct {
+		trace *RPCTrace
+		sent  time.Time
+	}
+)
+
+// NewRequestTracer returns an initialized RequestTracer.
+func NewRequestTracer() *RequestTracer {
+	return &RequestTracer{start: time.Now()}
+}
+
+func (rt *RequestTracer) addRPC(trace *RPCTrace, end time.Time) {
+	rt.Lock()
+	defer rt.Unlock()
+
+	rt.rpcs = append(rt.rpcs, trace)
+	if end.After(rt.lastEnd) {
+		rt.lastEnd = end
+	}
+}
+
+// Finish returns the timing breakdown of the RPCs recorded so far.
+func (rt *RequestTracer) Finish() *RequestTrace {
+	rt.Lock()
+	defer rt.Unlock()
+
+	now := time.Now()
+	trace := &RequestTrace{
+		RPCs:    make([]*RPCTrace, len(rt.rpcs)),
+		Elapsed: now.Sub(rt.start),
+	}
+	copy(trace.RPCs, rt.rpcs)
+	sort.SliceStable(trace.RPCs, func(i, j int) bool {
+		return trace.RPCs[i].Start.Before(trace.RPCs[j].Start)
+	})
+	if !rt.lastEnd.IsZero() {
+		trace.AfterLastRPC = now.Sub(rt.lastEnd)
+	}
+
+	return trace
+}
+
+// dialOptions returns the gRPC dial options used to trace the connection
+// to the given host.
+func (rt *RequestTracer) dialOptions(host string) []grpc.DialOption {
+	ht := &hostTracer{
+		tracer: rt,
+		host:   host,
+	}
+
+	return []grpc.DialOption{
+		grpc.WithContextDialer(ht.dial),
+		grpc.WithStatsHandler(ht),
+	}
+}
+
+func (ht *hostTracer) dial(ctx context.Context, addr string) (net.Conn, error) {
+	start := time.Now()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+
+	ht.Lock()
+	ht.dialed = time.Now()
+	ht.connect = ht.dialed.Sub(start)
+	ht.Unlock()
+
+	return conn, err
+}
+
+// TagConn implements the stats.Handler interface.
+func (ht *hostTracer) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn implements the stats.Handler interface.
+func (ht *hostTracer) HandleConn(_ context.Context, cs stats.ConnStats) {
+	if _, ok := cs.(*stats.ConnBegin); !ok {
+		return
+	}
+
+	ht.Lock()
+	defer ht.Unlock()
+
+	ht.connected = time.Now()
+	if !ht.dialed.IsZero() {
+		ht.handshake = ht.connected.Sub(ht.dialed)
+	}
+}
+
+// TagRPC implements the stats.Handler interface.
+func (ht *hostTracer) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, rpcTraceKey{}, &rpcTraceState{
+		trace: &RPCTrace{
+			Host:   ht.host,
+			Method: info.FullMethodName,
+		},
+	})
+}
+
+// HandleRPC implements the stats.Handler interface.
+func (ht *hostTracer) HandleRPC(ctx context.Context, rs stats.RPCStats) {
+	state, ok := ctx.Value(rpcTraceKey{}).(*rpcTraceState)
+	if !ok {
+		return
+	}
+
+	switch s := rs.(type) {
+	case *stats.Begin:
+		state.trace.Start = s.BeginTime
+	case *stats.OutPayload:
+		state.sent = s.SentTime
+	case *stats.InTrailer:
+		if vals := s.Trailer.Get(ServerElapsedTrailer); len(vals) > 0 {
+			if ns, err := strconv.ParseInt(vals[0], 10, 64); err == nil {
+				state.trace.Server = time.Duration(ns)
+			}
+		}
+	case *stats.End:
+		ht.finishRPC(state, s)
+	}
+}
+
+func (ht *hostTracer) finishRPC(state *rpcTraceState, end *stats.End) {
+	trace := state.trace
+	trace.Total = end.EndTime.Sub(trace.Start)
+	if end.Error != nil {
+		trace.Error = end.Error.Error()
+	}
+
+	ht.Lock()
+	// The connection timings are only attributed to the first RPC sent
+	// over the connection.
+	if !ht.reported {
+		trace.Connect = ht.connect
+		trace.Handshake = ht.handshake
+		ht.reported = true
+	}
+	sendStart := trace.Start
+	if ht.connected.After(sendStart) {
+		sendStart = ht.connected
+	}
+	ht.Unlock()
+
+	if !state.sent.IsZero() && state.sent.After(sendStart) {
+		trace.Send = state.sent.Sub(sendStart)
+	}
+
+	ht.tracer.addRPC(trace, end.EndTime)
+}
@@ -0,0 +1,420 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	otlpTraceScopeName = "github.com/daos-stack/daos/src/control/lib/control"
+
+	// traceParentKey is the gRPC metadata key used to propagate the trace
+	// context between components, in the W3C Trace Context format.
+	traceParentKey     = "traceparent"
+	traceParentVersion = "00"
+	traceFlagSampled   = 0x01
+
+	// OpenTelemetry span kinds.
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+
+	// OpenTelemetry span status codes.
+	otlpStatusUnset = 0
+	otlpStatusError = 2
+)
+
+type (
+	traceID [16]byte
+	spanID  [8]byte
+
+	// spanContext identifies a span within a trace.
+	spanContext struct {
+		traceID traceID
+		spanID  spanID
+		sampled bool
+	}
+
+	// Span records the timing and outcome of an operation within a trace.
+	// The methods of a nil Span are no-ops, so that callers need not check
+	// whether tracing is enabled.
+	Span struct {
+		mu       sync.Mutex
+		exporter *OTLPSpanExporter
+		sc       spanContext
+		parentID spanID
+		name     string
+		kind     int
+		start    time.Time
+		attrs    []otlpKeyValue
+		ended    bool
+	}
+
+	spanCtxKey struct{}
+
+	otlpStatus struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+
+	otlpSpan struct {
+		TraceID           string         `json:"traceId"`
+		SpanID            string         `json:"spanId"`
+		ParentSpanID      string         `json:"parentSpanId,omitempty"`
+		Name              string         `json:"name"`
+		Kind              int            `json:"kind"`
+		StartTimeUnixNano string         `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+		Attributes        []otlpKeyValue `json:"attributes"`
+		Status            otlpStatus     `json:"status"`
+	}
+
+	otlpScopeSpans struct {
+		Scope otlpScope   `json:"scope"`
+		Spans []*otlpSpan `json:"spans"`
+	}
+
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+
+	otlpTracesRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+
+	// OTLPSpanExporter exports the spans of traced requests to an
+	// OpenTelemetry collector, using the OTLP/HTTP protocol with JSON
+	// encoding. Ended spans are queued and sent in batches by a background
+	// goroutine; if the queue is full, spans are dropped rather than
+	// delaying the traced request.
+	OTLPSpanExporter struct {
+		log     logging.Logger
+		client  *otlpClient
+		batcher *otlpBatcher[*otlpSpan]
+	}
+)
+
+func (id traceID) isValid() bool {
+	return id != traceID{}
+}
+
+func (id spanID) isValid() bool {
+	return id != spanID{}
+}
+
+// String returns the span context in the W3C traceparent header format.
+func (sc spanContext) String() string {
+	var flags byte
+	if sc.sampled {
+		flags |= traceFlagSampled
+	}
+	return fmt.Sprintf("%s-%s-%s-%02x", traceParentVersion,
+		hex.EncodeToString(sc.traceID[:]), hex.EncodeToString(sc.spanID[:]), flags)
+}
+
+// parseTraceParent parses a span context from a W3C traceparent header.
+func parseTraceParent(val string) (*spanContext, error) {
+	parts := strings.Split(strings.TrimSpace(val), "-")
+	if len(parts) != 4 || parts[0] != traceParentVersion {
+		return nil, errors.Errorf("invalid traceparent %q", val)
+	}
+
+	sc := new(spanContext)
+	for _, field := range []struct {
+		dst []byte
+		src string
+	}{
+		{sc.traceID[:], parts[1]},
+		{sc.spanID[:], parts[2]},
+	} {
+		if len(field.src) != hex.EncodedLen(len(field.dst)) {
+			return nil, errors.Errorf("invalid traceparent %q", val)
+		}
+		if _, err := hex.Decode(field.dst, []byte(field.src)); err != nil {
+			return nil, errors.Wrapf(err, "invalid traceparent %q", val)
+		}
+	}
+	if !sc.traceID.isValid() || !sc.spanID.isValid() {
+		return nil, errors.Errorf("invalid traceparent %q", val)
+	}
+
+	flags, err := strconv.ParseUint(parts[3], 16, 8)
+	if err != nil || len(parts[3]) != 2 {
+		return nil, errors.Errorf("invalid traceparent %q", val)
+	}
+	sc.sampled = flags&traceFlagSampled != 0
+
+	return sc, nil
+}
+
+// randomID fills the supplied buffer with a random, non-zero ID.
+func randomID(buf []byte) {
+	for {
+		_, _ = rand.Read(buf)
+		for _, b := range buf {
+			if b != 0 {
+				return
+			}
+		}
+	}
+}
+
+// newSpan creates a span as a child of the parent, or as the root of a new
+// trace if the parent is nil.
+func newSpan(se *OTLPSpanExporter, parent *spanContext, name string, kind int) *Span {
+	s := &Span{
+		exporter: se,
+		name:     name,
+		kind:     kind,
+		start:    time.Now(),
+		sc:       spanContext{sampled: true},
+	}
+	if parent != nil {
+		s.sc.traceID = parent.traceID
+		s.sc.sampled = parent.sampled
+		s.parentID = parent.spanID
+	} else {
+		randomID(s.sc.traceID[:])
+	}
+	randomID(s.sc.spanID[:])
+
+	return s
+}
+
+// spanFromContext returns the span carried by the context, if any.
+func spanFromContext(ctx context.Context) *Span {
+	s, _ := ctx.Value(spanCtxKey{}).(*Span)
+	return s
+}
+
+// contextWithSpan returns a copy of the context that carries the span.
+func contextWithSpan(ctx context.Context, s *Span) context.Context {
+	return context.WithValue(ctx, spanCtxKey{}, s)
+}
+
+// StartSpan starts a span as a child of the span carried by the context, and
+// returns a copy of the context that carries the new span. If the context
+// does not carry a span, i.e. the request is not being traced, the context is
+// returned unchanged along with a nil Span.
+func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	parent := spanFromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+
+	s := newSpan(parent.exporter, &parent.sc, name, otlpSpanKindInternal)
+	return contextWithSpan(ctx, s), s
+}
+
+// TraceID returns the hex-encoded ID of the trace to which the span belongs.
+func (s *Span) TraceID() string {
+	if s == nil {
+		return ""
+	}
+	return hex.EncodeToString(s.sc.traceID[:])
+}
+
+// SetAttribute sets a string attribute on the span.
+func (s *Span) SetAttribute(key, val string) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attrs = append(s.attrs, otlpString(key, val))
+}
+
+// End records the end of the span with the error, if any, returned by the
+// operation, and queues the span for export. Subsequent calls are ignored.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ended {
+		return
+	}
+	s.ended = true
+
+	if !s.sc.sampled || s.exporter == nil {
+		return
+	}
+	s.exporter.add(s.toOTLP(time.Now(), err))
+}
+
+func (s *Span) toOTLP(end time.Time, err error) *otlpSpan {
+	out := &otlpSpan{
+		TraceID:           hex.EncodeToString(s.sc.traceID[:]),
+		SpanID:            hex.EncodeToString(s.sc.spanID[:]),
+		Name:              s.name,
+		Kind:              s.kind,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        s.attrs,
+		Status:            otlpStatus{Code: otlpStatusUnset},
+	}
+	if s.parentID.isValid() {
+		out.ParentSpanID = hex.EncodeToString(s.parentID[:])
+	}
+	if err != nil {
+		out.Status = otlpStatus{Code: otlpStatusError, Message: err.Error()}
+	}
+
+	return out
+}
+
+// NewOTLPSpanExporter returns an initialized OTLPSpanExporter. The exporter
+// does not send any spans until Start is called.
+func NewOTLPSpanExporter(log logging.Logger, cfg OTLPExporterConfig) *OTLPSpanExporter {
+	se := &OTLPSpanExporter{
+		log:    log,
+		client: newOTLPClient(cfg),
+	}
+	se.batcher = newOTLPBatcher(log, "trace spans", cfg.Endpoint, se.export)
+
+	return se
+}
+
+func (se *OTLPSpanExporter) add(span *otlpSpan) {
+	if !se.batcher.add(span) {
+		se.log.Debugf("OTLP export queue full, dropped %q span", span.Name)
+	}
+}
+
+// export sends a batch of spans to the collector.
+func (se *OTLPSpanExporter) export(ctx context.Context, batch []*otlpSpan) error {
+	return se.client.post(ctx, &otlpTracesRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: se.client.resource,
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: otlpTraceScopeName, Version: build.DaosVersion},
+						Spans: batch,
+					},
+				},
+			},
+		},
+	})
+}
+
+// Start starts the background export of ended spans, which continues until
+// the supplied context is canceled.
+func (se *OTLPSpanExporter) Start(ctx context.Context) {
+	se.batcher.start(ctx)
+}
+
+// Wait blocks until the exporter has stopped after the context passed to
+// Start has been canceled.
+func (se *OTLPSpanExporter) Wait() {
+	se.batcher.wait()
+}
+
+// StartRootSpan starts a span at the root of a new trace, e.g. to group the
+// RPCs invoked by a single command.
+func (se *OTLPSpanExporter) StartRootSpan(name string) *Span {
+	return newSpan(se, nil, name, otlpSpanKindInternal)
+}
+
+// setRPCAttributes sets the attributes defined by the OpenTelemetry semantic
+// conventions for RPC spans.
+func (s *Span) setRPCAttributes(fullMethod string, err error) {
+	s.SetAttribute("rpc.system", "grpc")
+	if svc, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/"); ok {
+		s.SetAttribute("rpc.service", svc)
+		s.SetAttribute("rpc.method", method)
+	}
+
+	s.mu.Lock()
+	s.attrs = append(s.attrs, otlpInt("rpc.grpc.status_code", int64(status.Code(err))))
+	s.mu.Unlock()
+}
+
+// spanName returns the span name for a gRPC method, i.e. the full method
+// name without the leading slash.
+func spanName(fullMethod string) string {
+	return strings.TrimPrefix(fullMethod, "/")
+}
+
+// unaryTraceInterceptor records a client span for each RPC, as a child of the
+// span carried by the context or the root span, if any, and propagates the
+// span context to the server.
+func unaryTraceInterceptor(se *OTLPSpanExporter, root *Span) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var parent *spanContext
+		if ps := spanFromContext(ctx); ps != nil {
+			parent = &ps.sc
+		} else if root != nil {
+			parent = &root.sc
+		}
+
+		span := newSpan(se, parent, spanName(method), otlpSpanKindClient)
+		if cc != nil {
+			span.SetAttribute("server.address", cc.Target())
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, traceParentKey, span.sc.String())
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		span.setRPCAttributes(method, err)
+		span.End(err)
+
+		return err
+	}
+}
+
+// UnaryServerInterceptor returns a gRPC server interceptor that records a
+// server span for each request, continuing the trace propagated by the
+// client if present. The span is carried by the context passed to the
+// handler, so that any RPCs invoked by the handler are recorded in the same
+// trace.
+func (se *OTLPSpanExporter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var parent *spanContext
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if vals := md.Get(traceParentKey); len(vals) > 0 {
+				sc, err := parseTraceParent(vals[0])
+				if err != nil {
+					se.log.Debugf("ignoring trace context from client: %s", err)
+				}
+				parent = sc
+			}
+		}
+
+		span := newSpan(se, parent, spanName(info.FullMethod), otlpSpanKindServer)
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			span.SetAttribute("client.address", p.Addr.String())
+		}
+
+		resp, err := handler(contextWithSpan(ctx, span), req)
+		span.setRPCAttributes(info.FullMethod, err)
+		span.End(err)
+
+		return resp, err
+	}
+}
@@ -0,0 +1,245 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_parseTraceParent(t *testing.T) {
+	for name, tc := range map[string]struct {
+		val        string
+		expSampled bool
+		expErr     error
+	}{
+		"sampled": {
+			val:        "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			expSampled: true,
+		},
+		"not sampled": {
+			val: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+		},
+		"empty": {
+			expErr: errors.New("invalid traceparent"),
+		},
+		"unknown version": {
+			val:    "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			expErr: errors.New("invalid traceparent"),
+		},
+		"short trace ID": {
+			val:    "00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+			expErr: errors.New("invalid traceparent"),
+		},
+		"bad span ID": {
+			val:    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902zz-01",
+			expErr: errors.New("invalid traceparent"),
+		},
+		"zero trace ID": {
+			val:    "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			expErr: errors.New("invalid traceparent"),
+		},
+		"bad flags": {
+			val:    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-1",
+			expErr: errors.New("invalid traceparent"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sc, err := parseTraceParent(tc.val)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expSampled, sc.sampled, "unexpected sampled flag")
+			test.AssertEqual(t, tc.val, sc.String(), "unexpected traceparent")
+		})
+	}
+}
+
+func TestControl_StartSpan(t *testing.T) {
+	ctx, span := StartSpan(test.Context(t), "untraced")
+	if span != nil {
+		t.Fatal("expected nil span without a parent")
+	}
+	// The methods of a nil span are no-ops.
+	span.SetAttribute("key", "val")
+	span.End(nil)
+	test.AssertEqual(t, "", span.TraceID(), "unexpected trace ID")
+
+	root := newSpan(nil, nil, "root", otlpSpanKindInternal)
+	ctx, child := StartSpan(contextWithSpan(ctx, root), "child")
+	test.AssertEqual(t, root.TraceID(), child.TraceID(), "unexpected trace ID")
+	test.AssertEqual(t, root.sc.spanID, child.parentID, "unexpected parent ID")
+	if child.sc.spanID == root.sc.spanID {
+		t.Fatal("expected child to have a new span ID")
+	}
+	test.AssertEqual(t, child, spanFromContext(ctx), "context does not carry child span")
+}
+
+func TestControl_OTLPSpanExporter(t *testing.T) {
+	const method = "/mgmt.MgmtSvc/PoolCreate"
+
+	for name, tc := range map[string]struct {
+		status        int
+		sampled       bool
+		handlerErr    error
+		expNames      []string
+		expErrorSpans []string
+		expLogText    string
+	}{
+		"request traced": {
+			status:  http.StatusOK,
+			sampled: true,
+			expNames: []string{
+				"drpc/PoolCreate",
+				"mgmt.MgmtSvc/PoolCreate",
+				"mgmt.MgmtSvc/PoolCreate",
+				"dmg pool create",
+			},
+		},
+		"request failed": {
+			status:     http.StatusOK,
+			sampled:    true,
+			handlerErr: errors.New("mock failure"),
+			expNames: []string{
+				"drpc/PoolCreate",
+				"mgmt.MgmtSvc/PoolCreate",
+				"mgmt.MgmtSvc/PoolCreate",
+				"dmg pool create",
+			},
+			expErrorSpans: []string{
+				"drpc/PoolCreate",
+				"mgmt.MgmtSvc/PoolCreate",
+				"mgmt.MgmtSvc/PoolCreate",
+			},
+		},
+		"not sampled": {
+			status: http.StatusOK,
+		},
+		"collector error": {
+			status:  http.StatusServiceUnavailable,
+			sampled: true,
+			expNames: []string{
+				"drpc/PoolCreate",
+				"mgmt.MgmtSvc/PoolCreate",
+				"mgmt.MgmtSvc/PoolCreate",
+				"dmg pool create",
+			},
+			expLogText: "failed to export 4 trace spans",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var mu sync.Mutex
+			var gotSpans []*otlpSpan
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				test.AssertEqual(t, "application/json", r.Header.Get("Content-Type"), "unexpected content type")
+
+				raw, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				req := new(otlpTracesRequest)
+				if err := json.Unmarshal(raw, req); err != nil {
+					t.Errorf("invalid request body %q: %s", raw, err)
+					return
+				}
+
+				mu.Lock()
+				for _, rs := range req.ResourceSpans {
+					for _, ss := range rs.ScopeSpans {
+						gotSpans = append(gotSpans, ss.Spans...)
+					}
+				}
+				mu.Unlock()
+
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			se := NewOTLPSpanExporter(log, OTLPExporterConfig{
+				Endpoint:  srv.URL + "/v1/traces",
+				Component: build.ComponentAdmin,
+			})
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			se.Start(ctx)
+
+			root := se.StartRootSpan("dmg pool create")
+			root.sc.sampled = tc.sampled
+
+			// Pass the outgoing metadata from the client interceptor
+			// to the server interceptor, as gRPC would.
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				_, span := StartSpan(ctx, "drpc/PoolCreate")
+				span.End(tc.handlerErr)
+				return nil, tc.handlerErr
+			}
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				srvCtx := metadata.NewIncomingContext(context.Background(), md)
+				_, err := se.UnaryServerInterceptor()(srvCtx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+				return err
+			}
+			err := unaryTraceInterceptor(se, root)(ctx, method, nil, nil, nil, invoker)
+			test.CmpErr(t, tc.handlerErr, err)
+			root.End(nil)
+
+			cancel()
+			se.Wait()
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			var names, errorSpans []string
+			parents := make(map[string]string)
+			for _, span := range gotSpans {
+				names = append(names, span.Name)
+				if span.Status.Code == otlpStatusError {
+					errorSpans = append(errorSpans, span.Name)
+				}
+				test.AssertEqual(t, root.TraceID(), span.TraceID, "unexpected trace ID")
+				parents[span.SpanID] = span.ParentSpanID
+			}
+			if diff := cmp.Diff(tc.expNames, names); diff != "" {
+				t.Fatalf("unexpected exported spans (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expErrorSpans, errorSpans); diff != "" {
+				t.Fatalf("unexpected error spans (-want, +got):\n%s\n", diff)
+			}
+
+			// Each span is a child of the one that ended after it.
+			for i := 0; i < len(gotSpans)-1; i++ {
+				test.AssertEqual(t, gotSpans[i+1].SpanID, parents[gotSpans[i].SpanID],
+					"unexpected parent of "+gotSpans[i].Name)
+			}
+
+			if tc.expLogText != "" && !strings.Contains(buf.String(), tc.expLogText) {
+				t.Fatalf("expected %q in log output", tc.expLogText)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestControl_RequestTracer(t *testing.T) {
+	begin := time.Now().Add(-time.Second)
+	at := func(ms int) time.Time {
+		return begin.Add(time.Duration(ms) * time.Millisecond)
+	}
+
+	type rpcEvents struct {
+		method  string
+		trailer metadata.MD
+		sent    time.Time
+		end     time.Time
+		err     error
+	}
+
+	for name, tc := range map[string]struct {
+		dialed    time.Time
+		connected time.Time
+		rpcs      []rpcEvents
+		expRPCs   []*RPCTrace
+	}{
+		"no RPCs": {
+			expRPCs: []*RPCTrace{},
+		},
+		"single RPC": {
+			dialed:    at(2),
+			connected: at(5),
+			rpcs: []rpcEvents{
+				{
+					method:  "/ctl.MgmtSvc/PoolQuery",
+					trailer: metadata.Pairs(ServerElapsedTrailer, "10000000"),
+					sent:    at(6),
+					end:     at(20),
+				},
+			},
+			expRPCs: []*RPCTrace{
+				{
+					Host:      "host1:10001",
+					Method:    "/ctl.MgmtSvc/PoolQuery",
+					Start:     begin,
+					Connect:   2 * time.Millisecond,
+					Handshake: 3 * time.Millisecond,
+					Send:      time.Millisecond,
+					Server:    10 * time.Millisecond,
+					Total:     20 * time.Millisecond,
+				},
+			},
+		},
+		"connection timings only on first RPC": {
+			dialed:    at(2),
+			connected: at(5),
+			rpcs: []rpcEvents{
+				{
+					method: "/ctl.MgmtSvc/PoolQuery",
+					sent:   at(6),
+					end:    at(20),
+				},
+				{
+					method:  "/ctl.MgmtSvc/SystemQuery",
+					trailer: metadata.Pairs(ServerElapsedTrailer, "bad"),
+					end:     at(30),
+					err:     errors.New("failed"),
+				},
+			},
+			expRPCs: []*RPCTrace{
+				{
+					Host:      "host1:10001",
+					Method:    "/ctl.MgmtSvc/PoolQuery",
+					Start:     begin,
+					Connect:   2 * time.Millisecond,
+					Handshake: 3 * time.Millisecond,
+					Send:      time.Millisecond,
+					Total:     20 * time.Millisecond,
+				},
+				{
+					Host:   "host1:10001",
+					Method: "/ctl.MgmtSvc/SystemQuery",
+					Start:  begin,
+					Total:  30 * time.Millisecond,
+					Error:  "failed",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rt := NewRequestTracer()
+			ht := &hostTracer{
+				tracer:  rt,
+				host:    "host1:10001",
+				connect: 2 * time.Millisecond,
+				dialed:  tc.dialed,
+			}
+			if !tc.connected.IsZero() {
+				ht.HandleConn(test.Context(t), &stats.ConnBegin{Client: true})
+				// Replace the recorded connection time for stable results.
+				ht.connected = tc.connected
+				ht.handshake = tc.connected.Sub(tc.dialed)
+			}
+
+			for _, evts := range tc.rpcs {
+				ctx := ht.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: evts.method})
+				ht.HandleRPC(ctx, &stats.Begin{Client: true, BeginTime: begin})
+				if !evts.sent.IsZero() {
+					ht.HandleRPC(ctx, &stats.OutPayload{Client: true, SentTime: evts.sent})
+				}
+				if evts.trailer != nil {
+					ht.HandleRPC(ctx, &stats.InTrailer{Client: true, Trailer: evts.trailer})
+				}
+				ht.HandleRPC(ctx, &stats.End{Client: true, BeginTime: begin, EndTime: evts.end, Error: evts.err})
+			}
+
+			trace := rt.Finish()
+			if diff := cmp.Diff(tc.expRPCs, trace.RPCs); diff != "" {
+				t.Fatalf("unexpected RPC traces (-want, +got):\n%s\n", diff)
+			}
+			if trace.Elapsed <= 0 {
+				t.Fatal("expected non-zero elapsed time")
+			}
+			if len(tc.rpcs) == 0 {
+				test.AssertEqual(t, time.Duration(0), trace.AfterLastRPC, "unexpected post-RPC time")
+			}
+		})
+	}
+}
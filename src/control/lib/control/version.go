@@ -0,0 +1,239 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+)
+
+type (
+	// GetComponentVersionsReq contains the inputs for the get component
+	// versions request.
+	GetComponentVersionsReq struct {
+		unaryRequest
+	}
+
+	// ComponentVersion describes the version of a DAOS component running
+	// on a host.
+	ComponentVersion struct {
+		Component string    `json:"component"`
+		Version   string    `json:"version"`
+		BuildInfo string    `json:"build_info,omitempty"`
+		Host      string    `json:"host"`
+		LastSeen  time.Time `json:"last_seen,omitempty"`
+	}
+
+	// GetComponentVersionsResp contains the versions of the servers that
+	// responded to the request, and of the agents that have recently
+	// contacted those servers.
+	GetComponentVersionsResp struct {
+		HostErrorsResp
+		Servers []*ComponentVersion `json:"servers"`
+		Agents  []*ComponentVersion `json:"agents"`
+	}
+
+	// ComponentVersionGroup identifies the set of hosts running a given
+	// version of a component.
+	ComponentVersionGroup struct {
+		Component string `json:"component"`
+		Version   string `json:"version"`
+		Hosts     string `json:"hosts"`
+	}
+
+	// CompatVerdict is the result of checking whether a version of a
+	// component is able to interoperate with a version of the server.
+	CompatVerdict struct {
+		Component     string `json:"component"`
+		Version       string `json:"version"`
+		ServerVersion string `json:"server_version"`
+		Compatible    bool   `json:"compatible"`
+		Reason        string `json:"reason,omitempty"`
+	}
+)
+
+func (resp *GetComponentVersionsResp) addHostResponse(hr *HostResponse) error {
+	pbResp, ok := hr.Message.(*ctlpb.GetComponentVersionsResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	if pbSrv := pbResp.GetServer(); pbSrv != nil {
+		resp.Servers = append(resp.Servers, &ComponentVersion{
+			Component: pbSrv.GetComponent(),
+			Version:   pbSrv.GetVersion(),
+			BuildInfo: pbSrv.GetBuildInfo(),
+			Host:      hr.Addr,
+		})
+	}
+
+	// The same agent may have contacted more than one server, so only
+	// keep the most recently reported version for each agent.
+	for _, pbAgent := range pbResp.GetAgents() {
+		agent := &ComponentVersion{
+			Component: pbAgent.GetComponent(),
+			Version:   pbAgent.GetVersion(),
+			Host:      pbAgent.GetAddr(),
+			LastSeen:  time.Unix(pbAgent.GetLastSeen(), 0),
+		}
+
+		replaced := false
+		for i, existing := range resp.Agents {
+			if existing.Host != agent.Host {
+				continue
+			}
+			if agent.LastSeen.After(existing.LastSeen) {
+				resp.Agents[i] = agent
+			}
+			replaced = true
+			break
+		}
+		if !replaced {
+			resp.Agents = append(resp.Agents, agent)
+		}
+	}
+
+	return nil
+}
+
+// Groups returns the hosts running each version of each component, including
+// the supplied admin component, sorted by component and version.
+func (resp *GetComponentVersionsResp) Groups(admin *ComponentVersion) ([]*ComponentVersionGroup, error) {
+	all := append(append([]*ComponentVersion{}, resp.Servers...), resp.Agents...)
+	if admin != nil {
+		all = append(all, admin)
+	}
+
+	sets := make(map[ComponentVersionGroup]*hostlist.HostSet)
+	for _, cv := range all {
+		key := ComponentVersionGroup{Component: cv.Component, Version: cv.Version}
+		if _, found := sets[key]; !found {
+			sets[key] = hostlist.MustCreateSet("")
+		}
+		if _, err := sets[key].Insert(cv.Host); err != nil {
+			return nil, err
+		}
+	}
+
+	groups := make([]*ComponentVersionGroup, 0, len(sets))
+	for key, set := range sets {
+		group := key
+		group.Hosts = set.RangedString()
+		groups = append(groups, &group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Component != groups[j].Component {
+			return groups[i].Component < groups[j].Component
+		}
+		return groups[i].Version < groups[j].Version
+	})
+
+	return groups, nil
+}
+
+func checkCompat(srvVersion string, other *ComponentVersionGroup) *CompatVerdict {
+	verdict := &CompatVerdict{
+		Component:     other.Component,
+		Version:       other.Version,
+		ServerVersion: srvVersion,
+	}
+
+	srv, err := build.NewVersionedComponent(build.ComponentServer, srvVersion)
+	if err != nil {
+		verdict.Reason = err.Error()
+		return verdict
+	}
+	oc, err := build.NewVersionedComponent(build.Component(other.Component), other.Version)
+	if err != nil {
+		verdict.Reason = err.Error()
+		return verdict
+	}
+
+	// Server to server compatibility needs to hold in both directions.
+	if err = build.CheckCompatibility(srv, oc); err == nil && oc.Component == build.ComponentServer {
+		err = build.CheckCompatibility(oc, srv)
+	}
+	if err != nil {
+		verdict.Reason = err.Error()
+		return verdict
+	}
+
+	verdict.Compatible = true
+	return verdict
+}
+
+// CheckCompatibility applies the interoperability rules enforced by the server
+// to each pair of server version and component version in the groups, and
+// returns a verdict for each pair.
+func CheckCompatibility(groups []*ComponentVersionGroup) []*CompatVerdict {
+	var srvVersions []string
+	for _, group := range groups {
+		if group.Component == build.ComponentServer.String() {
+			srvVersions = append(srvVersions, group.Version)
+		}
+	}
+
+	var verdicts []*CompatVerdict
+	for _, srvVersion := range srvVersions {
+		for _, group := range groups {
+			if group.Component == build.ComponentServer.String() && group.Version <= srvVersion {
+				// Each pair of server versions only needs to be checked once.
+				continue
+			}
+			verdicts = append(verdicts, checkCompat(srvVersion, group))
+		}
+	}
+
+	return verdicts
+}
+
+// GetComponentVersions requests the version of each server in the hostlist,
+// along with the versions of the agents that have recently contacted them.
+func GetComponentVersions(ctx context.Context, rpcClient UnaryInvoker, req *GetComponentVersionsReq) (*GetComponentVersionsResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &ctlpb.GetComponentVersionsReq{Sys: req.getSystem(rpcClient)}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).GetComponentVersions(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS get component versions request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke get component versions RPC: %s", err)
+		return nil, err
+	}
+
+	resp := new(GetComponentVersionsResp)
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := resp.addHostResponse(hr); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(resp.Agents, func(i, j int) bool { return resp.Agents[i].Host < resp.Agents[j].Host })
+
+	rpcClient.Debugf("DAOS get component versions response: %+v", resp)
+	return resp, nil
+}
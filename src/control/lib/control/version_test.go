@@ -0,0 +1,225 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_GetComponentVersions(t *testing.T) {
+	pbServer := func(version string) *ctlpb.ComponentVersion {
+		return &ctlpb.ComponentVersion{
+			Component: "server",
+			Version:   version,
+			BuildInfo: "build",
+		}
+	}
+	pbAgent := func(addr, version string, lastSeen int64) *ctlpb.ComponentVersion {
+		return &ctlpb.ComponentVersion{
+			Component: "agent",
+			Version:   version,
+			Addr:      addr,
+			LastSeen:  lastSeen,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req         *GetComponentVersionsReq
+		mic         *MockInvokerConfig
+		expResponse *GetComponentVersionsResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"invoke fails": {
+			req: &GetComponentVersionsReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("failed"),
+			},
+			expErr: errors.New("failed"),
+		},
+		"nil message": {
+			req: &GetComponentVersionsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+						},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"multiple hosts": {
+			req: &GetComponentVersionsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.GetComponentVersionsResp{
+								Server: pbServer("2.6.0"),
+								Agents: []*ctlpb.ComponentVersion{
+									pbAgent("10.0.0.2", "2.4.0", 100),
+									pbAgent("10.0.0.1", "2.6.0", 100),
+								},
+							},
+						},
+						{
+							Addr: "host2",
+							Message: &ctlpb.GetComponentVersionsResp{
+								Server: pbServer("2.6.1"),
+								Agents: []*ctlpb.ComponentVersion{
+									pbAgent("10.0.0.2", "2.6.0", 200),
+									pbAgent("10.0.0.1", "2.4.0", 50),
+								},
+							},
+						},
+						{
+							Addr:  "host3",
+							Error: errors.New("failed"),
+						},
+					},
+				},
+			},
+			expResponse: &GetComponentVersionsResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host3",
+					Error: "failed",
+				}),
+				Servers: []*ComponentVersion{
+					{Component: "server", Version: "2.6.0", BuildInfo: "build", Host: "host1"},
+					{Component: "server", Version: "2.6.1", BuildInfo: "build", Host: "host2"},
+				},
+				Agents: []*ComponentVersion{
+					{Component: "agent", Version: "2.6.0", Host: "10.0.0.1", LastSeen: time.Unix(100, 0)},
+					{Component: "agent", Version: "2.6.0", Host: "10.0.0.2", LastSeen: time.Unix(200, 0)},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := GetComponentVersions(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_GetComponentVersionsResp_Groups(t *testing.T) {
+	resp := &GetComponentVersionsResp{
+		Servers: []*ComponentVersion{
+			{Component: "server", Version: "2.6.0", Host: "host1"},
+			{Component: "server", Version: "2.6.0", Host: "host2"},
+			{Component: "server", Version: "2.4.0", Host: "host3"},
+		},
+		Agents: []*ComponentVersion{
+			{Component: "agent", Version: "2.6.0", Host: "client1"},
+		},
+	}
+
+	groups, err := resp.Groups(&ComponentVersion{Component: "admin", Version: "2.6.0", Host: "localhost"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expGroups := []*ComponentVersionGroup{
+		{Component: "admin", Version: "2.6.0", Hosts: "localhost"},
+		{Component: "agent", Version: "2.6.0", Hosts: "client1"},
+		{Component: "server", Version: "2.4.0", Hosts: "host3"},
+		{Component: "server", Version: "2.6.0", Hosts: "host[1-2]"},
+	}
+	if diff := cmp.Diff(expGroups, groups); diff != "" {
+		t.Fatalf("unexpected groups (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestControl_CheckCompatibility(t *testing.T) {
+	for name, tc := range map[string]struct {
+		groups      []*ComponentVersionGroup
+		expVerdicts []*CompatVerdict
+	}{
+		"no servers": {
+			groups: []*ComponentVersionGroup{
+				{Component: "admin", Version: "2.6.0"},
+			},
+		},
+		"all same version": {
+			groups: []*ComponentVersionGroup{
+				{Component: "admin", Version: "2.6.0"},
+				{Component: "agent", Version: "2.6.0"},
+				{Component: "server", Version: "2.6.0"},
+			},
+			expVerdicts: []*CompatVerdict{
+				{Component: "admin", Version: "2.6.0", ServerVersion: "2.6.0", Compatible: true},
+				{Component: "agent", Version: "2.6.0", ServerVersion: "2.6.0", Compatible: true},
+			},
+		},
+		"mixed versions": {
+			groups: []*ComponentVersionGroup{
+				{Component: "admin", Version: "2.6.0"},
+				{Component: "agent", Version: "1.2.0"},
+				{Component: "server", Version: "2.6.0"},
+				{Component: "server", Version: "2.6.1"},
+			},
+			expVerdicts: []*CompatVerdict{
+				{Component: "admin", Version: "2.6.0", ServerVersion: "2.6.0", Compatible: true},
+				{Component: "agent", Version: "1.2.0", ServerVersion: "2.6.0"},
+				{Component: "server", Version: "2.6.1", ServerVersion: "2.6.0", Compatible: true},
+				{Component: "admin", Version: "2.6.0", ServerVersion: "2.6.1", Compatible: true},
+				{Component: "agent", Version: "1.2.0", ServerVersion: "2.6.1"},
+			},
+		},
+		"invalid version": {
+			groups: []*ComponentVersionGroup{
+				{Component: "agent", Version: "bad"},
+				{Component: "server", Version: "2.6.0"},
+			},
+			expVerdicts: []*CompatVerdict{
+				{Component: "agent", Version: "bad", ServerVersion: "2.6.0"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			verdicts := CheckCompatibility(tc.groups)
+
+			test.AssertEqual(t, len(tc.expVerdicts), len(verdicts), "unexpected number of verdicts")
+			for i, exp := range tc.expVerdicts {
+				got := verdicts[i]
+				if !exp.Compatible {
+					test.AssertTrue(t, got.Reason != "", "expected reason for incompatibility")
+					exp.Reason = got.Reason
+				}
+				if diff := cmp.Diff(exp, got); diff != "" {
+					t.Fatalf("unexpected verdict %d (-want, +got):\n%s\n", i, diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package daos
+
+import "strings"
+
+const (
+	// ACLPrincipalMaxLen is the maximum length of a principal string.
+	ACLPrincipalMaxLen = 255 // DAOS_ACL_MAX_PRINCIPAL_LEN
+)
+
+// ACLPrincipalIsValid applies the same rules as daos_acl_principal_is_valid(),
+// i.e. a non-empty name followed by a single '@' and an optional domain.
+func ACLPrincipalIsValid(principal string) bool {
+	if len(principal) == 0 || len(principal) > ACLPrincipalMaxLen {
+		return false
+	}
+
+	at := strings.IndexByte(principal, '@')
+	return at > 0 && strings.IndexByte(principal[at+1:], '@') < 0
+}
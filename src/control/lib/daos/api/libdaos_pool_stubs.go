@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -56,9 +57,12 @@ func daos_gpi2cpi(gpi *daos.PoolInfo) *C.daos_pool_info_t {
 		pi_leader:    C.uint32_t(gpi.ServiceLeader),
 		pi_bits:      C.uint64_t(gpi.QueryMask),
 		pi_rebuild_st: C.struct_daos_rebuild_status{
-			rs_errno:  C.int32_t(gpi.Rebuild.Status),
-			rs_obj_nr: C.uint64_t(gpi.Rebuild.Objects),
-			rs_rec_nr: C.uint64_t(gpi.Rebuild.Records),
+			rs_errno:         C.int32_t(gpi.Rebuild.Status),
+			rs_obj_nr:        C.uint64_t(gpi.Rebuild.Objects),
+			rs_rec_nr:        C.uint64_t(gpi.Rebuild.Records),
+			rs_toberb_obj_nr: C.uint64_t(gpi.Rebuild.TotalObjects),
+			rs_seconds:       C.uint32_t(gpi.Rebuild.DurationSec),
+			rs_version:       C.uint32_t(gpi.Rebuild.Version),
 		},
 		pi_space: C.struct_daos_pool_space{
 			ps_ntargets: C.uint32_t(gpi.ActiveTargets),
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -123,10 +124,13 @@ func newPoolRebuildStatus(drs *C.struct_daos_rebuild_status) *daos.PoolRebuildSt
 	}
 
 	return &daos.PoolRebuildStatus{
-		Status:  int32(drs.rs_errno),
-		Objects: uint64(drs.rs_obj_nr),
-		Records: uint64(drs.rs_rec_nr),
-		State:   compatRebuildState(),
+		Status:       int32(drs.rs_errno),
+		Objects:      uint64(drs.rs_obj_nr),
+		Records:      uint64(drs.rs_rec_nr),
+		TotalObjects: uint64(drs.rs_toberb_obj_nr),
+		State:        compatRebuildState(),
+		DurationSec:  uint32(drs.rs_seconds),
+		Version:      uint32(drs.rs_version),
 	}
 }
 
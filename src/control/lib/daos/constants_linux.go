@@ -0,0 +1,24 @@
+//
+// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daos
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../../cart/utils -I${SRCDIR}/../../../utils/self_test
+
+#include <daos_types.h>
+#include "self_test_lib.h"
+*/
+import "C"
+
+const (
+	// MaxAttributeNameLength defines the maximum length of an attribute name.
+	MaxAttributeNameLength = C.DAOS_ATTR_NAME_MAX
+
+	sysNameMax          = C.DAOS_SYS_NAME_MAX
+	defaultBufAlignment = C.CRT_ST_BUF_ALIGN_DEFAULT
+)
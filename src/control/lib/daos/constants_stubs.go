@@ -0,0 +1,19 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package daos
+
+// The values below mirror those in the DAOS headers, which can only be
+// included on Linux.
+const (
+	// MaxAttributeNameLength defines the maximum length of an attribute name.
+	MaxAttributeNameLength = 511 // DAOS_ATTR_NAME_MAX
+
+	sysNameMax          = 15 // DAOS_SYS_NAME_MAX
+	defaultBufAlignment = -1 // CRT_ST_BUF_ALIGN_DEFAULT
+)
@@ -0,0 +1,75 @@
+//
+// (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daos
+
+import "github.com/pkg/errors"
+
+/*
+#include <stdint.h>
+
+#include <daos_cont.h>
+#include <daos/common.h>
+
+#cgo LDFLAGS: -ldaos_common
+*/
+import "C"
+
+const (
+	// ContainerOpenFlagReadOnly opens the container in read-only mode.
+	ContainerOpenFlagReadOnly ContainerOpenFlag = C.DAOS_COO_RO
+	// ContainerOpenFlagReadWrite opens the container in read-write mode.
+	ContainerOpenFlagReadWrite ContainerOpenFlag = C.DAOS_COO_RW
+	// ContainerOpenFlagExclusive opens the container in exclusive read-write mode.
+	ContainerOpenFlagExclusive ContainerOpenFlag = C.DAOS_COO_EX
+	// ContainerOpenFlagForce skips container health checks.
+	ContainerOpenFlagForce ContainerOpenFlag = C.DAOS_COO_FORCE
+	// ContainerOpenFlagReadOnlyMetadata skips container metadata updates.
+	ContainerOpenFlagReadOnlyMetadata ContainerOpenFlag = C.DAOS_COO_RO_MDSTATS
+	// ContainerOpenFlagEvict evicts the current user's open handles.
+	ContainerOpenFlagEvict ContainerOpenFlag = C.DAOS_COO_EVICT
+	// ContainerOpenFlagEvictAll evicts all open handles.
+	ContainerOpenFlagEvictAll ContainerOpenFlag = C.DAOS_COO_EVICT_ALL
+
+	// ContainerLayoutUnknown represents an unknown container layout.
+	ContainerLayoutUnknown ContainerLayout = C.DAOS_PROP_CO_LAYOUT_UNKNOWN
+	// ContainerLayoutPOSIX represents a POSIX container layout.
+	ContainerLayoutPOSIX ContainerLayout = C.DAOS_PROP_CO_LAYOUT_POSIX
+	// ContainerLayoutHDF5 represents an HDF5 container layout.
+	ContainerLayoutHDF5 ContainerLayout = C.DAOS_PROP_CO_LAYOUT_HDF5
+	// ContainerLayoutPython represents a Python container layout.
+	ContainerLayoutPython ContainerLayout = C.DAOS_PROP_CO_LAYOUT_PYTHON
+	// ContainerLayoutSpark represents a Spark container layout.
+	ContainerLayoutSpark ContainerLayout = C.DAOS_PROP_CO_LAYOUT_SPARK
+	// ContainerLayoutDatabase represents a database container layout.
+	ContainerLayoutDatabase ContainerLayout = C.DAOS_PROP_CO_LAYOUT_DATABASE
+	// ContainerLayoutRoot represents a root container layout.
+	ContainerLayoutRoot ContainerLayout = C.DAOS_PROP_CO_LAYOUT_ROOT
+	// ContainerLayoutSeismic represents a seismic container layout.
+	ContainerLayoutSeismic ContainerLayout = C.DAOS_PROP_CO_LAYOUT_SEISMIC
+	// ContainerLayoutMeteo represents a meteo container layout.
+	ContainerLayoutMeteo ContainerLayout = C.DAOS_PROP_CO_LAYOUT_METEO
+)
+
+// FromString converts a string to a ContainerLayout.
+func (l *ContainerLayout) FromString(in string) error {
+	cStr, free := toCString(in)
+	defer free()
+	C.daos_parse_ctype(cStr, (*C.uint16_t)(l))
+
+	if *l == ContainerLayoutUnknown {
+		return errors.Errorf("unknown container layout %q", in)
+	}
+
+	return nil
+}
+
+func (l ContainerLayout) String() string {
+	var cType [10]C.char
+	C.daos_unparse_ctype(C.ushort(l), &cType[0])
+	return C.GoString(&cType[0])
+}
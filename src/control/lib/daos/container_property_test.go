@@ -1,9 +1,12 @@
 //
 // (C) Copyright 2021-2022 Intel Corporation.
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
+//go:build linux
+// +build linux
 
 package daos
 
@@ -0,0 +1,106 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package daos
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The values below mirror those in src/include/daos_cont.h and
+// src/include/daos_prop.h, which can only be included on Linux.
+const (
+	// ContainerOpenFlagReadOnly opens the container in read-only mode.
+	ContainerOpenFlagReadOnly ContainerOpenFlag = 1 << 0
+	// ContainerOpenFlagReadWrite opens the container in read-write mode.
+	ContainerOpenFlagReadWrite ContainerOpenFlag = 1 << 1
+	// ContainerOpenFlagExclusive opens the container in exclusive read-write mode.
+	ContainerOpenFlagExclusive ContainerOpenFlag = 1 << 2
+	// ContainerOpenFlagForce skips container health checks.
+	ContainerOpenFlagForce ContainerOpenFlag = 1 << 3
+	// ContainerOpenFlagReadOnlyMetadata skips container metadata updates.
+	ContainerOpenFlagReadOnlyMetadata ContainerOpenFlag = 1 << 4
+	// ContainerOpenFlagEvict evicts the current user's open handles.
+	ContainerOpenFlagEvict ContainerOpenFlag = 1 << 5
+	// ContainerOpenFlagEvictAll evicts all open handles.
+	ContainerOpenFlagEvictAll ContainerOpenFlag = 1 << 6
+
+	// ContainerLayoutUnknown represents an unknown container layout.
+	ContainerLayoutUnknown ContainerLayout = 0
+	// ContainerLayoutPOSIX represents a POSIX container layout.
+	ContainerLayoutPOSIX ContainerLayout = 1
+	// ContainerLayoutHDF5 represents an HDF5 container layout.
+	ContainerLayoutHDF5 ContainerLayout = 2
+	// ContainerLayoutPython represents a Python container layout.
+	ContainerLayoutPython ContainerLayout = 3
+	// ContainerLayoutSpark represents a Spark container layout.
+	ContainerLayoutSpark ContainerLayout = 4
+	// ContainerLayoutDatabase represents a database container layout.
+	ContainerLayoutDatabase ContainerLayout = 5
+	// ContainerLayoutRoot represents a root container layout.
+	ContainerLayoutRoot ContainerLayout = 6
+	// ContainerLayoutSeismic represents a seismic container layout.
+	ContainerLayoutSeismic ContainerLayout = 7
+	// ContainerLayoutMeteo represents a meteo container layout.
+	ContainerLayoutMeteo ContainerLayout = 8
+)
+
+// FromString converts a string to a ContainerLayout.
+func (l *ContainerLayout) FromString(in string) error {
+	switch strings.ToUpper(in) {
+	case "HDF5":
+		*l = ContainerLayoutHDF5
+	case "POSIX":
+		*l = ContainerLayoutPOSIX
+	case "PYTHON":
+		*l = ContainerLayoutPython
+	case "SPARK":
+		*l = ContainerLayoutSpark
+	case "DATABASE", "DB":
+		*l = ContainerLayoutDatabase
+	case "ROOT", "RNTUPLE":
+		*l = ContainerLayoutRoot
+	case "SEISMIC", "DSG":
+		*l = ContainerLayoutSeismic
+	case "METEO", "FDB":
+		*l = ContainerLayoutMeteo
+	default:
+		*l = ContainerLayoutUnknown
+	}
+
+	if *l == ContainerLayoutUnknown {
+		return errors.Errorf("unknown container layout %q", in)
+	}
+
+	return nil
+}
+
+func (l ContainerLayout) String() string {
+	switch l {
+	case ContainerLayoutPOSIX:
+		return "POSIX"
+	case ContainerLayoutHDF5:
+		return "HDF5"
+	case ContainerLayoutPython:
+		return "PYTHON"
+	case ContainerLayoutSpark:
+		return "SPARK"
+	case ContainerLayoutDatabase:
+		return "DATABASE"
+	case ContainerLayoutRoot:
+		return "ROOT"
+	case ContainerLayoutSeismic:
+		return "SEISMIC"
+	case ContainerLayoutMeteo:
+		return "METEO"
+	default:
+		return "unknown"
+	}
+}
@@ -1,19 +1,13 @@
 //
 // (C) Copyright 2022 Intel Corporation.
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
 
 package daos
 
-/*
-#cgo LDFLAGS: -lgurt
-
-#include <gurt/common.h>
-*/
-import "C"
-
 import (
 	"time"
 
@@ -31,11 +25,6 @@ type (
 	HLC uint64
 )
 
-// Nanoseconds returns the HLC represented as the number of nanoseconds since the Unix epoch.
-func (hlc HLC) Nanoseconds() int64 {
-	return int64(C.d_hlc2unixnsec(C.uint64_t(hlc)))
-}
-
 func (hlc HLC) String() string {
 	return hlc.ToTime().String()
 }
@@ -48,6 +37,15 @@ func (hlc HLC) IsZero() bool {
 	return hlc == 0 || hlc.String() == ZeroHLCDate
 }
 
+// Age returns the time elapsed between the HLC and the supplied time, or zero
+// if the HLC is zero.
+func (hlc HLC) Age(now time.Time) time.Duration {
+	if hlc.IsZero() {
+		return 0
+	}
+	return now.Sub(hlc.ToTime())
+}
+
 func (hlc HLC) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + common.FormatTime(hlc.ToTime()) + `"`), nil
 }
@@ -60,8 +58,3 @@ func (hlc *HLC) UnmarshalJSON(b []byte) error {
 	*hlc = NewHLC(t.UnixNano())
 	return nil
 }
-
-// NewHLC creates a new HLC from the given number of nanoseconds since the Unix epoch.
-func NewHLC(nsec int64) HLC {
-	return HLC(C.d_unixnsec2hlc(C.uint64_t(nsec)))
-}
@@ -0,0 +1,25 @@
+//
+// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daos
+
+/*
+#cgo LDFLAGS: -lgurt
+
+#include <gurt/common.h>
+*/
+import "C"
+
+// Nanoseconds returns the HLC represented as the number of nanoseconds since the Unix epoch.
+func (hlc HLC) Nanoseconds() int64 {
+	return int64(C.d_hlc2unixnsec(C.uint64_t(hlc)))
+}
+
+// NewHLC creates a new HLC from the given number of nanoseconds since the Unix epoch.
+func NewHLC(nsec int64) HLC {
+	return HLC(C.d_unixnsec2hlc(C.uint64_t(nsec)))
+}
@@ -0,0 +1,34 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package daos
+
+import "math"
+
+// The HLC conversions below are equivalent to those in src/gurt/hlc.c, which
+// is not available on this platform.
+const (
+	hlcNsec     = 16         // D_HLC_NSEC
+	hlcStartSec = 1609459200 // D_HLC_START_SEC
+	nsecPerSec  = 1000000000
+)
+
+// Nanoseconds returns the HLC represented as the number of nanoseconds since the Unix epoch.
+func (hlc HLC) Nanoseconds() int64 {
+	return int64(uint64(hlc)/hlcNsec + hlcStartSec*nsecPerSec)
+}
+
+// NewHLC creates a new HLC from the given number of nanoseconds since the Unix epoch.
+func NewHLC(nsec int64) HLC {
+	start := uint64(hlcStartSec * nsecPerSec)
+	unixNsec := uint64(nsec)
+	if unixNsec < start || unixNsec-start > math.MaxUint64/hlcNsec {
+		return 0
+	}
+	return HLC((unixNsec - start) * hlcNsec)
+}
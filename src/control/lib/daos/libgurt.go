@@ -1,10 +1,11 @@
 //
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
-//go:build !test_stubs
-// +build !test_stubs
+//go:build linux && !test_stubs
+// +build linux,!test_stubs
 
 package daos
 
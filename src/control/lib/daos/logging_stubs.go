@@ -0,0 +1,18 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package daos
+
+// The DAOS debug log is provided by libgurt, which is not available on this
+// platform, so its setup and teardown are no-ops.
+
+func daos_debug_init(_ *byte) int {
+	return 0
+}
+
+func daos_debug_fini() {}
@@ -1,6 +1,7 @@
 //
 // (C) Copyright 2024-2025 Intel Corporation.
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -13,11 +14,6 @@ import (
 	"github.com/pkg/errors"
 )
 
-/*
-#include <daos.h>
-*/
-import "C"
-
 // NB: We can't use the oclass_name2id() and oclass_id2name() helpers
 // in this package because they are client-only symbols and we don't
 // want this package to depend directly on libdaos. :/
@@ -44,9 +40,6 @@ func SetObjectClassHelpers(stringer objClassIDStringer, resolver objClassNameRes
 type (
 	objClassNameResolver func(string) (ObjectClass, error)
 	objClassIDStringer   func(ObjectClass) string
-
-	// ObjectClass represents an object class.
-	ObjectClass C.daos_oclass_id_t
 )
 
 // ObjectClassFromString parses a string to an ObjectClass.
@@ -76,16 +69,6 @@ func (oc ObjectClass) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + oc.String() + `"`), nil
 }
 
-type (
-	// ObjectID represents a DAOS object ID.
-	ObjectID C.daos_obj_id_t
-)
-
-// Class returns the derived object class.
-func (oid ObjectID) Class() ObjectClass {
-	return ObjectClass(C.daos_obj_id2class(C.daos_obj_id_t(oid)))
-}
-
 // IsZero returns true if the ObjectID is the zero value.
 func (oid ObjectID) IsZero() bool {
 	return oid.hi == 0 && oid.lo == 0
@@ -99,8 +82,7 @@ func (oid *ObjectID) FromString(s string) error {
 		return errors.Wrapf(InvalidInput, "invalid object ID %q: %v", s, err)
 	}
 
-	oid.hi = C.uint64_t(hi)
-	oid.lo = C.uint64_t(lo)
+	*oid = newObjectID(hi, lo)
 	return nil
 }
 
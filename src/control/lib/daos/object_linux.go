@@ -0,0 +1,33 @@
+//
+// (C) Copyright 2024-2025 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daos
+
+/*
+#include <daos.h>
+*/
+import "C"
+
+type (
+	// ObjectClass represents an object class.
+	ObjectClass C.daos_oclass_id_t
+
+	// ObjectID represents a DAOS object ID.
+	ObjectID C.daos_obj_id_t
+)
+
+func newObjectID(hi, lo uint64) ObjectID {
+	return ObjectID{
+		hi: C.uint64_t(hi),
+		lo: C.uint64_t(lo),
+	}
+}
+
+// Class returns the derived object class.
+func (oid ObjectID) Class() ObjectClass {
+	return ObjectClass(C.daos_obj_id2class(C.daos_obj_id_t(oid)))
+}
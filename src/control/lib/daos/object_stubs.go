@@ -0,0 +1,40 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package daos
+
+// The object ID layout below mirrors the one in src/include/daos_obj.h, which
+// can only be included on Linux.
+const (
+	oidFmtClassShift = 48 // OID_FMT_CLASS_SHIFT
+	oidFmtMetaShift  = 32 // OID_FMT_META_SHIFT
+	ocRedunShift     = 24 // OC_REDUN_SHIFT
+)
+
+type (
+	// ObjectClass represents an object class.
+	ObjectClass uint32
+
+	// ObjectID represents a DAOS object ID.
+	ObjectID struct {
+		lo uint64
+		hi uint64
+	}
+)
+
+func newObjectID(hi, lo uint64) ObjectID {
+	return ObjectID{hi: hi, lo: lo}
+}
+
+// Class returns the derived object class.
+func (oid ObjectID) Class() ObjectClass {
+	ord := (oid.hi >> oidFmtClassShift) & 0xFF
+	nrGrps := (oid.hi >> oidFmtMetaShift) & 0xFFFF
+
+	return ObjectClass(ord<<ocRedunShift | nrGrps)
+}
@@ -10,9 +10,11 @@ package daos
 
 import (
 	"encoding/json"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -21,13 +23,6 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 )
 
-/*
-#include <stdint.h>
-
-#include <daos_pool.h>
-*/
-import "C"
-
 type (
 	// PoolTierUsage describes usage of a single pool storage tier in
 	// a simpler format.
@@ -60,6 +55,8 @@ type (
 		Objects      uint64           `json:"objects"`
 		Records      uint64           `json:"records"`
 		TotalObjects uint64           `json:"total_objects"`
+		DurationSec  uint32           `json:"duration_sec,omitempty"` // Time spent rebuilding, if known
+		Version      uint32           `json:"version,omitempty"`      // Pool map version being rebuilt
 	}
 
 	// PoolInfo contains information about the pool.
@@ -68,6 +65,7 @@ type (
 		State            PoolServiceState     `json:"state"`
 		UUID             uuid.UUID            `json:"uuid"`
 		Label            string               `json:"label,omitempty"`
+		Aliases          []string             `json:"aliases,omitempty"`
 		TotalTargets     uint32               `json:"total_targets"`
 		ActiveTargets    uint32               `json:"active_targets"`
 		TotalEngines     uint32               `json:"total_engines"`
@@ -84,6 +82,7 @@ type (
 		UpgradeLayoutVer uint32               `json:"upgrade_layout_ver"`
 		MemFileBytes     uint64               `json:"mem_file_bytes"`
 		MdOnSsdActive    bool                 `json:"md_on_ssd_active"`
+		CreateTime       uint64               `json:"create_time,omitempty"` // Seconds since the Unix epoch
 	}
 
 	PoolQueryTargetType  int32
@@ -109,7 +108,7 @@ type (
 	PoolQueryOption string
 
 	// PoolQueryMask implements a bitmask for pool query options.
-	PoolQueryMask C.uint64_t
+	PoolQueryMask uint64
 
 	// PoolConnectFlag represents DAOS pool connect options.
 	PoolConnectFlag uint
@@ -117,9 +116,9 @@ type (
 
 const (
 	// DefaultPoolQueryMask defines the default pool query mask.
-	DefaultPoolQueryMask = PoolQueryMask(^uint64(0) &^ (C.DPI_ENGINES_ENABLED | C.DPI_ENGINES_DEAD))
+	DefaultPoolQueryMask = PoolQueryMask(^uint64(0) &^ (dpiEnginesEnabled | dpiEnginesDead))
 	// HealthOnlyPoolQueryMask defines the mask for health-only queries.
-	HealthOnlyPoolQueryMask = PoolQueryMask(^uint64(0) &^ (C.DPI_ENGINES_ENABLED | C.DPI_SPACE))
+	HealthOnlyPoolQueryMask = PoolQueryMask(^uint64(0) &^ (dpiEnginesEnabled | dpiSpace))
 
 	// PoolQueryOptionSpace retrieves storage space usage as part of the pool query.
 	PoolQueryOptionSpace PoolQueryOption = "space"
@@ -131,13 +130,6 @@ const (
 	PoolQueryOptionDisabledEngines PoolQueryOption = "disabled_engines"
 	// PoolQueryOptionDeadEngines retrieves dead engines as part of the pool query.
 	PoolQueryOptionDeadEngines PoolQueryOption = "dead_engines"
-
-	// PoolConnectFlagReadOnly indicates that the connection is read-only.
-	PoolConnectFlagReadOnly PoolConnectFlag = C.DAOS_PC_RO
-	// PoolConnectFlagReadWrite indicates that the connection is read-write.
-	PoolConnectFlagReadWrite PoolConnectFlag = C.DAOS_PC_RW
-	// PoolConnectFlagExclusive indicates that the connection is exclusive.
-	PoolConnectFlagExclusive PoolConnectFlag = C.DAOS_PC_EX
 )
 
 func (pcf PoolConnectFlag) String() string {
@@ -159,15 +151,15 @@ func (pqo PoolQueryOption) String() string {
 	return string(pqo)
 }
 
-var poolQueryOptMap = map[C.int]PoolQueryOption{
-	C.DPI_SPACE:            PoolQueryOptionSpace,
-	C.DPI_REBUILD_STATUS:   PoolQueryOptionRebuild,
-	C.DPI_ENGINES_ENABLED:  PoolQueryOptionEnabledEngines,
-	C.DPI_ENGINES_DISABLED: PoolQueryOptionDisabledEngines,
-	C.DPI_ENGINES_DEAD:     PoolQueryOptionDeadEngines,
+var poolQueryOptMap = map[PoolQueryMask]PoolQueryOption{
+	dpiSpace:           PoolQueryOptionSpace,
+	dpiRebuildStatus:   PoolQueryOptionRebuild,
+	dpiEnginesEnabled:  PoolQueryOptionEnabledEngines,
+	dpiEnginesDisabled: PoolQueryOptionDisabledEngines,
+	dpiEnginesDead:     PoolQueryOptionDeadEngines,
 }
 
-func resolvePoolQueryOpt(name PoolQueryOption) (C.int, error) {
+func resolvePoolQueryOpt(name PoolQueryOption) (PoolQueryMask, error) {
 	for opt, optName := range poolQueryOptMap {
 		if name == optName {
 			return opt, nil
@@ -191,7 +183,7 @@ func (pqm *PoolQueryMask) SetOptions(options ...PoolQueryOption) error {
 		if opt, err := resolvePoolQueryOpt(optName); err != nil {
 			return err
 		} else {
-			*pqm |= PoolQueryMask(opt)
+			*pqm |= opt
 		}
 	}
 	return nil
@@ -203,7 +195,7 @@ func (pqm *PoolQueryMask) ClearOptions(options ...PoolQueryOption) error {
 		if opt, err := resolvePoolQueryOpt(optName); err != nil {
 			return err
 		} else {
-			*pqm &^= PoolQueryMask(opt)
+			*pqm &^= opt
 		}
 	}
 	return nil
@@ -226,12 +218,9 @@ func (pqm PoolQueryMask) HasOption(option PoolQueryOption) bool {
 
 func (pqm PoolQueryMask) String() string {
 	var flags []string
-	for i := 0; i < (C.sizeof_int*8)-1; i++ {
-		opt := C.int(1 << i)
-		if flag, ok := poolQueryOptMap[opt]; ok {
-			if pqm&PoolQueryMask(opt) != 0 {
-				flags = append(flags, flag.String())
-			}
+	for opt, flag := range poolQueryOptMap {
+		if pqm&opt != 0 {
+			flags = append(flags, flag.String())
 		}
 	}
 	sort.Strings(flags)
@@ -280,13 +269,33 @@ func (pi *PoolInfo) MarshalJSON() ([]byte, error) {
 
 	return json.Marshal(&struct {
 		*Alias
-		Usage []*PoolTierUsage `json:"usage,omitempty"`
+		Usage            []*PoolTierUsage `json:"usage,omitempty"`
+		CreateTimeAgeSec int64            `json:"create_time_age_sec,omitempty"`
 	}{
-		Alias: (*Alias)(pi),
-		Usage: pi.Usage(),
+		Alias:            (*Alias)(pi),
+		Usage:            pi.Usage(),
+		CreateTimeAgeSec: int64(pi.CreationAge(time.Now()).Seconds()),
 	})
 }
 
+// CreationTime returns the time at which the pool was created, or the zero
+// time if it is not known.
+func (pi *PoolInfo) CreationTime() time.Time {
+	if pi.CreateTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(pi.CreateTime), 0)
+}
+
+// CreationAge returns the time elapsed between the pool's creation and the
+// supplied time, or zero if the creation time is not known.
+func (pi *PoolInfo) CreationAge(now time.Time) time.Duration {
+	if pi.CreateTime == 0 {
+		return 0
+	}
+	return now.Sub(pi.CreationTime())
+}
+
 // Usage returns a slice of PoolTierUsage objects describing the pool's storage
 // usage in a simpler format.
 func (pi *PoolInfo) Usage() []*PoolTierUsage {
@@ -320,6 +329,12 @@ func (pi *PoolInfo) Name() string {
 	return name
 }
 
+// HasLabel returns true if the pool has the given label, either as its label
+// or as one of its aliases.
+func (pi *PoolInfo) HasLabel(label string) bool {
+	return label != "" && (pi.Label == label || slices.Contains(pi.Aliases, label))
+}
+
 // PoolServiceState is used to represent the state of the pool service
 type PoolServiceState uint
 
@@ -414,6 +429,68 @@ func (smt *StorageMediaType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+const (
+	// PoolRebuildPhaseScanning indicates that the rebuild is scanning for
+	// objects to be rebuilt.
+	PoolRebuildPhaseScanning = "scanning"
+	// PoolRebuildPhasePulling indicates that the rebuild is pulling the data
+	// of the objects found to be rebuilt.
+	PoolRebuildPhasePulling = "pulling"
+)
+
+// Phase returns the phase of a rebuild in progress, or an empty string if the
+// rebuild is not in progress.
+func (rs *PoolRebuildStatus) Phase() string {
+	if rs == nil || rs.State != PoolRebuildStateBusy {
+		return ""
+	}
+	if rs.TotalObjects == 0 {
+		return PoolRebuildPhaseScanning
+	}
+	return PoolRebuildPhasePulling
+}
+
+// Progress returns the fraction of the objects found to be rebuilt that have
+// been rebuilt so far. The returned bool is false if the progress is unknown.
+func (rs *PoolRebuildStatus) Progress() (float64, bool) {
+	if rs == nil || rs.TotalObjects == 0 {
+		return 0, false
+	}
+	if rs.Objects >= rs.TotalObjects {
+		return 1, true
+	}
+	return float64(rs.Objects) / float64(rs.TotalObjects), true
+}
+
+// Throughput returns the average number of objects and records rebuilt per
+// second. The returned bool is false if the rebuild duration is unknown.
+func (rs *PoolRebuildStatus) Throughput() (objsPerSec, recsPerSec float64, ok bool) {
+	if rs == nil || rs.DurationSec == 0 {
+		return 0, 0, false
+	}
+	secs := float64(rs.DurationSec)
+	return float64(rs.Objects) / secs, float64(rs.Records) / secs, true
+}
+
+// ETA returns an estimate of the time remaining until a rebuild in progress
+// completes, based on its average throughput so far. As more objects may be
+// found to be rebuilt while the rebuild progresses, the estimate is a lower
+// bound. The returned bool is false if no estimate can be made.
+func (rs *PoolRebuildStatus) ETA() (time.Duration, bool) {
+	if rs.Phase() != PoolRebuildPhasePulling || rs.Objects == 0 {
+		return 0, false
+	}
+	objsPerSec, _, ok := rs.Throughput()
+	if !ok {
+		return 0, false
+	}
+	if rs.Objects >= rs.TotalObjects {
+		return 0, true
+	}
+	remaining := float64(rs.TotalObjects-rs.Objects) / objsPerSec
+	return (time.Duration(remaining * float64(time.Second))).Round(time.Second), true
+}
+
 // PoolRebuildState indicates the current state of the pool rebuild process.
 type PoolRebuildState int32
 
@@ -0,0 +1,30 @@
+//
+// (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daos
+
+/*
+#include <stdint.h>
+
+#include <daos_pool.h>
+*/
+import "C"
+
+const (
+	dpiSpace           = C.DPI_SPACE
+	dpiRebuildStatus   = C.DPI_REBUILD_STATUS
+	dpiEnginesEnabled  = C.DPI_ENGINES_ENABLED
+	dpiEnginesDisabled = C.DPI_ENGINES_DISABLED
+	dpiEnginesDead     = C.DPI_ENGINES_DEAD
+
+	// PoolConnectFlagReadOnly indicates that the connection is read-only.
+	PoolConnectFlagReadOnly PoolConnectFlag = C.DAOS_PC_RO
+	// PoolConnectFlagReadWrite indicates that the connection is read-write.
+	PoolConnectFlagReadWrite PoolConnectFlag = C.DAOS_PC_RW
+	// PoolConnectFlagExclusive indicates that the connection is exclusive.
+	PoolConnectFlagExclusive PoolConnectFlag = C.DAOS_PC_EX
+)
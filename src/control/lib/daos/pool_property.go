@@ -11,11 +11,9 @@ package daos
 import (
 	"encoding/json"
 	"fmt"
-	"math"
 	"sort"
 	"strconv"
 	"strings"
-	"unsafe"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -23,214 +21,25 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 )
 
-/*
-#include <daos_prop.h>
-#include <daos_pool.h>
-#include <daos/object.h>
-#include <daos/pool_map.h>
-#include <daos_srv/control.h>
-
-#cgo LDFLAGS: -ldaos_common -lgurt -lcart
-*/
-import "C"
-
-const (
-	// MaxLabelLength is the maximum length of a label.
-	MaxLabelLength = C.DAOS_PROP_LABEL_MAX_LEN
-)
-
-const (
-	// PoolPropertyMin before any pool property
-	PoolPropertyMin = C.DAOS_PROP_PO_MIN
-	// PoolPropertyLabel is a string that a user can associate with a pool.
-	PoolPropertyLabel = C.DAOS_PROP_PO_LABEL
-	// PoolPropertyACL is the Access Control List for a pool.
-	PoolPropertyACL = C.DAOS_PROP_PO_ACL
-	// PoolPropertyReservedSpace is the ratio of space that can be reserved
-	// on each target for rebuild purposes.
-	PoolPropertyReservedSpace = C.DAOS_PROP_PO_SPACE_RB
-	// PoolPropertySelfHealing defines the self-healing behavior of the pool.
-	PoolPropertySelfHealing = C.DAOS_PROP_PO_SELF_HEAL
-	// PoolPropertySpaceReclaim defines the free space reclamation behavior of the pool.
-	PoolPropertySpaceReclaim = C.DAOS_PROP_PO_RECLAIM
-	// PoolPropertyOwner is the user who acts as the owner of the pool.
-	PoolPropertyOwner = C.DAOS_PROP_PO_OWNER
-	// PoolPropertyOwnerGroup is the group that acts as the owner of the pool.
-	PoolPropertyOwnerGroup = C.DAOS_PROP_PO_OWNER_GROUP
-	// PoolPropertyECCellSize is the EC Cell size.
-	PoolPropertyECCellSize = C.DAOS_PROP_PO_EC_CELL_SZ
-	// PoolPropertyRedunFac defines redundancy factor of the pool.
-	PoolPropertyRedunFac = C.DAOS_PROP_PO_REDUN_FAC
-	// PoolPropertyECPda is performance domain affinity level of EC object.
-	PoolPropertyECPda = C.DAOS_PROP_PO_EC_PDA
-	// PoolPropertyRPPda is performance domain affinity level of replicated object.
-	PoolPropertyRPPda = C.DAOS_PROP_PO_RP_PDA
-	// PoolDataThreshold is the data threshold size for a pool
-	PoolDataThresh = C.DAOS_PROP_PO_DATA_THRESH
-	//PoolPropertyGlobalVersion is aggregation of pool/container/object/keys version.
-	PoolPropertyGlobalVersion = C.DAOS_PROP_PO_GLOBAL_VERSION
-	//PoolPropertyUpgradeStatus is pool upgrade status
-	PoolPropertyUpgradeStatus = C.DAOS_PROP_PO_UPGRADE_STATUS
-	// PoolPropertyScrubMode Checksum scrubbing schedule
-	PoolPropertyScrubMode = C.DAOS_PROP_PO_SCRUB_MODE
-	// PoolPropertyScrubFreq Checksum scrubbing frequency
-	PoolPropertyScrubFreq = C.DAOS_PROP_PO_SCRUB_FREQ
-	// PoolPropertyScrubThresh Checksum scrubbing threshold
-	PoolPropertyScrubThresh = C.DAOS_PROP_PO_SCRUB_THRESH
-	// PoolPropertySvcRedunFac defines redundancy factor of the pool service.
-	PoolPropertySvcRedunFac = C.DAOS_PROP_PO_SVC_REDUN_FAC
-	// PoolPropertySvcList is the list of pool service replicas.
-	PoolPropertySvcList = C.DAOS_PROP_PO_SVC_LIST
-	// PoolPropertyCheckpointMode defines the behavior of WAL checkpoints
-	PoolPropertyCheckpointMode = C.DAOS_PROP_PO_CHECKPOINT_MODE
-	// PoolPropertyCheckpointFreq defines the frequency of timed WAL checkpoints
-	PoolPropertyCheckpointFreq = C.DAOS_PROP_PO_CHECKPOINT_FREQ
-	// PoolPropertyCheckpointThresh defines the size threshold to trigger WAL checkpoints
-	PoolPropertyCheckpointThresh = C.DAOS_PROP_PO_CHECKPOINT_THRESH
-	//PoolPropertyPerfDomain is pool performance domain
-	PoolPropertyPerfDomain = C.DAOS_PROP_PO_PERF_DOMAIN
-	//PoolPropertyReintMode is pool reintegration mode
-	PoolPropertyReintMode      = C.DAOS_PROP_PO_REINT_MODE
-	PoolPropertySvcOpsEnabled  = C.DAOS_PROP_PO_SVC_OPS_ENABLED
-	PoolPropertySvcOpsEntryAge = C.DAOS_PROP_PO_SVC_OPS_ENTRY_AGE
-)
-
-const (
-	// PoolSpaceReclaimDisabled sets the PoolPropertySpaceReclaim property to disabled.
-	PoolSpaceReclaimDisabled = C.DAOS_RECLAIM_DISABLED
-	// PoolSpaceReclaimLazy sets the PoolPropertySpaceReclaim property to lazy.
-	PoolSpaceReclaimLazy = C.DAOS_RECLAIM_LAZY
-	// PoolSpaceReclaimSnapshot sets the PoolPropertySpaceReclaim property to snapshot.
-	PoolSpaceReclaimSnapshot = C.DAOS_RECLAIM_SNAPSHOT
-	// PoolSpaceReclaimBatch sets the PoolPropertySpaceReclaim property to batch.
-	PoolSpaceReclaimBatch = C.DAOS_RECLAIM_BATCH
-	// PoolSpaceReclaimTime sets the PoolPropertySpaceReclaim property to time.
-	PoolSpaceReclaimTime = C.DAOS_RECLAIM_TIME
-)
-
-const (
-	// PoolSelfHealingAutoExclude sets the self-healing strategy to auto-exclude.
-	PoolSelfHealingAutoExclude = C.DAOS_SELF_HEAL_AUTO_EXCLUDE
-	// PoolSelfHealingAutoRebuild sets the self-healing strategy to auto-rebuild.
-	PoolSelfHealingAutoRebuild = C.DAOS_SELF_HEAL_AUTO_REBUILD
-	// PoolSelfHealingDelayRebuild sets the self-healing strategy to delay-rebuild.
-	PoolSelfHealingDelayRebuild = C.DAOS_SELF_HEAL_DELAY_REBUILD
-)
-
-const (
-	// ECCellMin defines the minimum-allowaable EC cell size.
-	ECCellMin = C.DAOS_EC_CELL_MIN
-	// ECCellMax defines the maximum-allowaable EC cell size.
-	ECCellMax = C.DAOS_EC_CELL_MAX
-	// ECCellDefault defines the default EC cell size.
-	ECCellDefault = C.DAOS_EC_CELL_DEF
-)
-
-const (
-	// MediaTypeScm is the media type for SCM.
-	MediaTypeScm = C.DAOS_MEDIA_SCM
-	// MediaTypeNvme is the media type for NVMe.
-	MediaTypeNvme = C.DAOS_MEDIA_NVME
-)
-
-const (
-	// PoolUpgradeStatusNotStarted indicates pool upgrading not started yet.
-	PoolUpgradeStatusNotStarted = C.DAOS_UPGRADE_STATUS_NOT_STARTED
-	// PoolUpgradeStatusInProgress defines pool upgrading is in progress.
-	PoolUpgradeStatusInProgress = C.DAOS_UPGRADE_STATUS_IN_PROGRESS
-	//PoolUpgradeStatusCompleted defines pool upgrading completed last time.
-	PoolUpgradeStatusCompleted = C.DAOS_UPGRADE_STATUS_COMPLETED
-	//PoolUpgradeStatusFailed defines pool upgrading operation failed.
-	PoolUpgradeStatusFailed = C.DAOS_UPGRADE_STATUS_FAILED
-)
-
-const (
-	// PoolRedunFacMax defines the maximum value of PoolPropertyRedunFac.
-	PoolRedunFacMax = C.DAOS_PROP_PO_REDUN_FAC_MAX
-	// PoolRedunFacDefault defines the default value of PoolPropertyRedunFac.
-	PoolRedunFacDefault = C.DAOS_PROP_PO_REDUN_FAC_DEFAULT
-	// PoolSvcRedunFacMax defines the maximum value of PoolPropertySvcRedunFac.
-	PoolSvcRedunFacMax = C.DAOS_PROP_PO_SVC_REDUN_FAC_MAX
-	// PoolSvcRedunFacDefault defines the default value of PoolPropertySvcRedunFac.
-	PoolSvcRedunFacDefault = C.DAOS_PROP_PO_SVC_REDUN_FAC_DEFAULT
-	PoolSvcOpsEntryAgeMin  = C.DAOS_PROP_PO_SVC_OPS_ENTRY_AGE_MIN
-	PoolSvcOpsEntryAgeMax  = C.DAOS_PROP_PO_SVC_OPS_ENTRY_AGE_MAX
-)
-
-const (
-	// DaosMdCapEnv is the name of the environment variable defining the size of a metadata pmem
-	// pool/file in MiBs.
-	DaosMdCapEnv = C.DAOS_MD_CAP_ENV
-	// DefaultDaosMdCapSize is the default size of a metadata pmem pool/file in MiBs.
-	DefaultDaosMdCapSize = C.DEFAULT_DAOS_MD_CAP_SIZE
-)
-
-// LabelIsValid checks a label to verify that it meets length/content
-// requirements.
-func LabelIsValid(label string) bool {
-	cLabel := C.CString(label)
-	defer C.free(unsafe.Pointer(cLabel))
-
-	return bool(C.daos_label_is_valid(cLabel))
-}
-
-// EcCellSizeIsValid checks EC cell Size to verify that it meets size
-// requirements.
-func EcCellSizeIsValid(sz uint64) bool {
-	if sz > math.MaxUint32 {
-		return false
-	}
-	return bool(C.daos_ec_cs_valid(C.uint32_t(sz)))
-}
-
-// EcPdaIsValid checks EC performance domain affinity level that it
-// doesn't exceed max unsigned int 32 bits.
-func EcPdaIsValid(pda uint64) bool {
-	if pda > math.MaxUint32 {
-		return false
-	}
-	return bool(C.daos_ec_pda_valid(C.uint32_t(pda)))
-}
-
-// RpPdaIsValid checks RP performance domain affinity level that it
-// doesn't exceed max unsigned int 32 bits.
-func RpPdaIsValid(pda uint64) bool {
-	if pda > math.MaxUint32 {
-		return false
-	}
-	return bool(C.daos_rp_pda_valid(C.uint32_t(pda)))
-}
-
-// DataThreshIsValid verifies that the input value meets the required criteria.
-func DataThreshIsValid(size uint64) bool {
-	if size > math.MaxUint32 {
-		return false
-	}
-	return bool(C.daos_data_thresh_valid(C.uint32_t(size)))
-}
-
-const (
-	PoolScrubModeOff   = C.DAOS_SCRUB_MODE_OFF
-	PoolScrubModeLazy  = C.DAOS_SCRUB_MODE_LAZY
-	PoolScrubModeTimed = C.DAOS_SCRUB_MODE_TIMED
-)
-
 const (
-	PoolCheckpointDisabled = C.DAOS_CHECKPOINT_DISABLED
-	PoolCheckpointTimed    = C.DAOS_CHECKPOINT_TIMED
-	PoolCheckpointLazy     = C.DAOS_CHECKPOINT_LAZY
+	// PoolPropertyMgmtMin is the lowest number of the pool properties that
+	// are stored by the Management Service rather than the pool service.
+	// These properties are never passed to the engine.
+	PoolPropertyMgmtMin = 0x10000
+	// PoolPropertyDestroyProtect prevents the pool from being destroyed
+	// until the property is cleared.
+	PoolPropertyDestroyProtect = PoolPropertyMgmtMin + 1
 )
 
 const (
-	PoolPerfDomainRoot        = C.PO_COMP_TP_ROOT
-	PoolPerfDomainUserDefined = C.PO_COMP_TP_PERF
+	// PoolDestroyProtectDisabled allows the pool to be destroyed.
+	PoolDestroyProtectDisabled = 0
+	// PoolDestroyProtectEnabled prevents the pool from being destroyed.
+	PoolDestroyProtectEnabled = 1
 )
 
-const (
-	PoolReintModeDataSync    = C.DAOS_REINT_MODE_DATA_SYNC
-	PoolReintModeNoDataSync  = C.DAOS_REINT_MODE_NO_DATA_SYNC
-	PoolReintModeIncremental = C.DAOS_REINT_MODE_INCREMENTAL
-)
+// poolLimitNone is the string value of a QoS limit property that is not set.
+const poolLimitNone = "none"
 
 func numericMarshaler(v *PoolPropertyValue) ([]byte, error) {
 	n, err := v.GetNumber()
@@ -365,6 +174,70 @@ func PoolProperties() PoolPropertyMap {
 				valueMarshaler: numericMarshaler,
 			},
 		},
+		"bw_limit": {
+			Property: PoolProperty{
+				Number:      PoolPropertyBwLimit,
+				Description: "Maximum aggregate bandwidth, per second (advisory)",
+				valueHandler: func(s string) (*PoolPropertyValue, error) {
+					if s == poolLimitNone {
+						return &PoolPropertyValue{uint64(0)}, nil
+					}
+					bwErr := errors.Errorf("invalid bw_limit %q (valid values: %s or at least %s)",
+						s, poolLimitNone, humanize.IBytes(PoolBwLimitMin))
+					b, err := humanize.ParseBytes(strings.TrimSuffix(s, "/s"))
+					if err != nil {
+						return nil, bwErr
+					}
+					if b != 0 && b < PoolBwLimitMin {
+						return nil, errors.Wrap(bwErr, "value supplied is too low")
+					}
+					return &PoolPropertyValue{b}, nil
+				},
+				valueStringer: func(v *PoolPropertyValue) string {
+					n, err := v.GetNumber()
+					if err != nil {
+						return "not set"
+					}
+					if n == 0 {
+						return poolLimitNone
+					}
+					return humanize.IBytes(n) + "/s"
+				},
+				valueMarshaler: numericMarshaler,
+			},
+		},
+		"iops_limit": {
+			Property: PoolProperty{
+				Number:      PoolPropertyIopsLimit,
+				Description: "Maximum aggregate I/O operations, per second (advisory)",
+				valueHandler: func(s string) (*PoolPropertyValue, error) {
+					if s == poolLimitNone {
+						return &PoolPropertyValue{uint64(0)}, nil
+					}
+					iopsErr := errors.Errorf("invalid iops_limit %q (valid values: %s or at least %d)",
+						s, poolLimitNone, PoolIopsLimitMin)
+					n, err := strconv.ParseUint(s, 10, 64)
+					if err != nil {
+						return nil, iopsErr
+					}
+					if n != 0 && n < PoolIopsLimitMin {
+						return nil, errors.Wrap(iopsErr, "value supplied is too low")
+					}
+					return &PoolPropertyValue{n}, nil
+				},
+				valueStringer: func(v *PoolPropertyValue) string {
+					n, err := v.GetNumber()
+					if err != nil {
+						return "not set"
+					}
+					if n == 0 {
+						return poolLimitNone
+					}
+					return fmt.Sprintf("%d", n)
+				},
+				valueMarshaler: numericMarshaler,
+			},
+		},
 		"label": {
 			Property: PoolProperty{
 				Number:      PoolPropertyLabel,
@@ -699,9 +572,25 @@ func PoolProperties() PoolPropertyMap {
 				"incremental":  PoolReintModeIncremental,
 			},
 		},
+		"destroy_protect": {
+			Property: PoolProperty{
+				Number:      PoolPropertyDestroyProtect,
+				Description: "Destruction protection",
+			},
+			values: map[string]uint64{
+				"disabled": PoolDestroyProtectDisabled,
+				"enabled":  PoolDestroyProtectEnabled,
+			},
+		},
 	}
 }
 
+// IsMgmtPoolProperty returns true if the pool property is stored by the
+// Management Service rather than the pool service.
+func IsMgmtPoolProperty(number uint32) bool {
+	return number >= PoolPropertyMgmtMin
+}
+
 func PoolDeprecatedProperties() map[string]string {
 	return map[string]string{
 		"rf": "rd_fac",
@@ -0,0 +1,90 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daos_test
+
+import (
+	"testing"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// TestDaos_PoolPropertyStubs verifies that the pool property values used on
+// platforms without the C headers match those in src/include/daos_prop.h and
+// related headers.
+func TestDaos_PoolPropertyStubs(t *testing.T) {
+	checkStubConsts(t, parseStubFile(t, "pool_property_stubs.go"), "pool_property_linux.go",
+		map[string]any{
+			"MaxLabelLength":               daos.MaxLabelLength,
+			"PoolPropertyMin":              daos.PoolPropertyMin,
+			"PoolPropertyLabel":            daos.PoolPropertyLabel,
+			"PoolPropertyACL":              daos.PoolPropertyACL,
+			"PoolPropertyReservedSpace":    daos.PoolPropertyReservedSpace,
+			"PoolPropertySelfHealing":      daos.PoolPropertySelfHealing,
+			"PoolPropertySpaceReclaim":     daos.PoolPropertySpaceReclaim,
+			"PoolPropertyOwner":            daos.PoolPropertyOwner,
+			"PoolPropertyOwnerGroup":       daos.PoolPropertyOwnerGroup,
+			"PoolPropertyECCellSize":       daos.PoolPropertyECCellSize,
+			"PoolPropertyRedunFac":         daos.PoolPropertyRedunFac,
+			"PoolPropertyECPda":            daos.PoolPropertyECPda,
+			"PoolPropertyRPPda":            daos.PoolPropertyRPPda,
+			"PoolDataThresh":               daos.PoolDataThresh,
+			"PoolPropertyGlobalVersion":    daos.PoolPropertyGlobalVersion,
+			"PoolPropertyUpgradeStatus":    daos.PoolPropertyUpgradeStatus,
+			"PoolPropertyScrubMode":        daos.PoolPropertyScrubMode,
+			"PoolPropertyScrubFreq":        daos.PoolPropertyScrubFreq,
+			"PoolPropertyScrubThresh":      daos.PoolPropertyScrubThresh,
+			"PoolPropertySvcRedunFac":      daos.PoolPropertySvcRedunFac,
+			"PoolPropertySvcList":          daos.PoolPropertySvcList,
+			"PoolPropertyCheckpointMode":   daos.PoolPropertyCheckpointMode,
+			"PoolPropertyCheckpointFreq":   daos.PoolPropertyCheckpointFreq,
+			"PoolPropertyCheckpointThresh": daos.PoolPropertyCheckpointThresh,
+			"PoolPropertyPerfDomain":       daos.PoolPropertyPerfDomain,
+			"PoolPropertyReintMode":        daos.PoolPropertyReintMode,
+			"PoolPropertySvcOpsEnabled":    daos.PoolPropertySvcOpsEnabled,
+			"PoolPropertySvcOpsEntryAge":   daos.PoolPropertySvcOpsEntryAge,
+			"PoolPropertyBwLimit":          daos.PoolPropertyBwLimit,
+			"PoolPropertyIopsLimit":        daos.PoolPropertyIopsLimit,
+			"PoolSpaceReclaimDisabled":     daos.PoolSpaceReclaimDisabled,
+			"PoolSpaceReclaimLazy":         daos.PoolSpaceReclaimLazy,
+			"PoolSpaceReclaimSnapshot":     daos.PoolSpaceReclaimSnapshot,
+			"PoolSpaceReclaimBatch":        daos.PoolSpaceReclaimBatch,
+			"PoolSpaceReclaimTime":         daos.PoolSpaceReclaimTime,
+			"PoolSelfHealingAutoExclude":   daos.PoolSelfHealingAutoExclude,
+			"PoolSelfHealingAutoRebuild":   daos.PoolSelfHealingAutoRebuild,
+			"PoolSelfHealingDelayRebuild":  daos.PoolSelfHealingDelayRebuild,
+			"ECCellMin":                    daos.ECCellMin,
+			"ECCellMax":                    daos.ECCellMax,
+			"ECCellDefault":                daos.ECCellDefault,
+			"MediaTypeScm":                 daos.MediaTypeScm,
+			"MediaTypeNvme":                daos.MediaTypeNvme,
+			"PoolUpgradeStatusNotStarted":  daos.PoolUpgradeStatusNotStarted,
+			"PoolUpgradeStatusInProgress":  daos.PoolUpgradeStatusInProgress,
+			"PoolUpgradeStatusCompleted":   daos.PoolUpgradeStatusCompleted,
+			"PoolUpgradeStatusFailed":      daos.PoolUpgradeStatusFailed,
+			"PoolRedunFacMax":              daos.PoolRedunFacMax,
+			"PoolRedunFacDefault":          daos.PoolRedunFacDefault,
+			"PoolSvcRedunFacMax":           daos.PoolSvcRedunFacMax,
+			"PoolSvcRedunFacDefault":       daos.PoolSvcRedunFacDefault,
+			"PoolSvcOpsEntryAgeMin":        daos.PoolSvcOpsEntryAgeMin,
+			"PoolSvcOpsEntryAgeMax":        daos.PoolSvcOpsEntryAgeMax,
+			"PoolBwLimitMin":               daos.PoolBwLimitMin,
+			"PoolIopsLimitMin":             daos.PoolIopsLimitMin,
+			"DaosMdCapEnv":                 daos.DaosMdCapEnv,
+			"DefaultDaosMdCapSize":         daos.DefaultDaosMdCapSize,
+			"PoolScrubModeOff":             daos.PoolScrubModeOff,
+			"PoolScrubModeLazy":            daos.PoolScrubModeLazy,
+			"PoolScrubModeTimed":           daos.PoolScrubModeTimed,
+			"PoolCheckpointDisabled":       daos.PoolCheckpointDisabled,
+			"PoolCheckpointTimed":          daos.PoolCheckpointTimed,
+			"PoolCheckpointLazy":           daos.PoolCheckpointLazy,
+			"PoolPerfDomainRoot":           daos.PoolPerfDomainRoot,
+			"PoolPerfDomainUserDefined":    daos.PoolPerfDomainUserDefined,
+			"PoolReintModeDataSync":        daos.PoolReintModeDataSync,
+			"PoolReintModeNoDataSync":      daos.PoolReintModeNoDataSync,
+			"PoolReintModeIncremental":     daos.PoolReintModeIncremental,
+		})
+}
@@ -0,0 +1,220 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package daos
+
+import (
+	"math"
+	"regexp"
+)
+
+// The values below mirror those in src/include/daos_prop.h and related
+// headers, which can only be included on Linux.
+
+const (
+	// MaxLabelLength is the maximum length of a label.
+	MaxLabelLength = 127
+)
+
+const (
+	// PoolPropertyMin before any pool property
+	PoolPropertyMin = 0
+	// PoolPropertyLabel is a string that a user can associate with a pool.
+	PoolPropertyLabel = 1
+	// PoolPropertyACL is the Access Control List for a pool.
+	PoolPropertyACL = 2
+	// PoolPropertyReservedSpace is the ratio of space that can be reserved
+	// on each target for rebuild purposes.
+	PoolPropertyReservedSpace = 3
+	// PoolPropertySelfHealing defines the self-healing behavior of the pool.
+	PoolPropertySelfHealing = 4
+	// PoolPropertySpaceReclaim defines the free space reclamation behavior of the pool.
+	PoolPropertySpaceReclaim = 5
+	// PoolPropertyOwner is the user who acts as the owner of the pool.
+	PoolPropertyOwner = 6
+	// PoolPropertyOwnerGroup is the group that acts as the owner of the pool.
+	PoolPropertyOwnerGroup = 7
+	// PoolPropertyECCellSize is the EC Cell size.
+	PoolPropertyECCellSize = 9
+	// PoolPropertyRedunFac defines redundancy factor of the pool.
+	PoolPropertyRedunFac = 11
+	// PoolPropertyECPda is performance domain affinity level of EC object.
+	PoolPropertyECPda = 12
+	// PoolPropertyRPPda is performance domain affinity level of replicated object.
+	PoolPropertyRPPda = 13
+	// PoolDataThreshold is the data threshold size for a pool
+	PoolDataThresh = 10
+	//PoolPropertyGlobalVersion is aggregation of pool/container/object/keys version.
+	PoolPropertyGlobalVersion = 14
+	//PoolPropertyUpgradeStatus is pool upgrade status
+	PoolPropertyUpgradeStatus = 15
+	// PoolPropertyScrubMode Checksum scrubbing schedule
+	PoolPropertyScrubMode = 16
+	// PoolPropertyScrubFreq Checksum scrubbing frequency
+	PoolPropertyScrubFreq = 17
+	// PoolPropertyScrubThresh Checksum scrubbing threshold
+	PoolPropertyScrubThresh = 18
+	// PoolPropertySvcRedunFac defines redundancy factor of the pool service.
+	PoolPropertySvcRedunFac = 19
+	// PoolPropertySvcList is the list of pool service replicas.
+	PoolPropertySvcList = 8
+	// PoolPropertyCheckpointMode defines the behavior of WAL checkpoints
+	PoolPropertyCheckpointMode = 22
+	// PoolPropertyCheckpointFreq defines the frequency of timed WAL checkpoints
+	PoolPropertyCheckpointFreq = 23
+	// PoolPropertyCheckpointThresh defines the size threshold to trigger WAL checkpoints
+	PoolPropertyCheckpointThresh = 24
+	//PoolPropertyPerfDomain is pool performance domain
+	PoolPropertyPerfDomain = 21
+	//PoolPropertyReintMode is pool reintegration mode
+	PoolPropertyReintMode      = 25
+	PoolPropertySvcOpsEnabled  = 26
+	PoolPropertySvcOpsEntryAge = 27
+	// PoolPropertyBwLimit is the maximum aggregate bandwidth of the pool, in bytes per second.
+	PoolPropertyBwLimit = 28
+	// PoolPropertyIopsLimit is the maximum aggregate IOPS of the pool.
+	PoolPropertyIopsLimit = 29
+)
+
+const (
+	// PoolSpaceReclaimDisabled sets the PoolPropertySpaceReclaim property to disabled.
+	PoolSpaceReclaimDisabled = 0
+	// PoolSpaceReclaimLazy sets the PoolPropertySpaceReclaim property to lazy.
+	PoolSpaceReclaimLazy = 1
+	// PoolSpaceReclaimSnapshot sets the PoolPropertySpaceReclaim property to snapshot.
+	PoolSpaceReclaimSnapshot = 2
+	// PoolSpaceReclaimBatch sets the PoolPropertySpaceReclaim property to batch.
+	PoolSpaceReclaimBatch = 3
+	// PoolSpaceReclaimTime sets the PoolPropertySpaceReclaim property to time.
+	PoolSpaceReclaimTime = 4
+)
+
+const (
+	// PoolSelfHealingAutoExclude sets the self-healing strategy to auto-exclude.
+	PoolSelfHealingAutoExclude = 1
+	// PoolSelfHealingAutoRebuild sets the self-healing strategy to auto-rebuild.
+	PoolSelfHealingAutoRebuild = 2
+	// PoolSelfHealingDelayRebuild sets the self-healing strategy to delay-rebuild.
+	PoolSelfHealingDelayRebuild = 4
+)
+
+const (
+	// ECCellMin defines the minimum-allowaable EC cell size.
+	ECCellMin = 4096
+	// ECCellMax defines the maximum-allowaable EC cell size.
+	ECCellMax = 1048576
+	// ECCellDefault defines the default EC cell size.
+	ECCellDefault = 65536
+)
+
+const (
+	// MediaTypeScm is the media type for SCM.
+	MediaTypeScm = 0
+	// MediaTypeNvme is the media type for NVMe.
+	MediaTypeNvme = 1
+)
+
+const (
+	// PoolUpgradeStatusNotStarted indicates pool upgrading not started yet.
+	PoolUpgradeStatusNotStarted = 0
+	// PoolUpgradeStatusInProgress defines pool upgrading is in progress.
+	PoolUpgradeStatusInProgress = 1
+	//PoolUpgradeStatusCompleted defines pool upgrading completed last time.
+	PoolUpgradeStatusCompleted = 2
+	//PoolUpgradeStatusFailed defines pool upgrading operation failed.
+	PoolUpgradeStatusFailed = 3
+)
+
+const (
+	// PoolRedunFacMax defines the maximum value of PoolPropertyRedunFac.
+	PoolRedunFacMax = 4
+	// PoolRedunFacDefault defines the default value of PoolPropertyRedunFac.
+	PoolRedunFacDefault = 0
+	// PoolSvcRedunFacMax defines the maximum value of PoolPropertySvcRedunFac.
+	PoolSvcRedunFacMax = 4
+	// PoolSvcRedunFacDefault defines the default value of PoolPropertySvcRedunFac.
+	PoolSvcRedunFacDefault = 2
+	PoolSvcOpsEntryAgeMin  = 60
+	PoolSvcOpsEntryAgeMax  = 600
+	// PoolBwLimitMin defines the minimum non-zero value of PoolPropertyBwLimit.
+	PoolBwLimitMin = 1048576
+	// PoolIopsLimitMin defines the minimum non-zero value of PoolPropertyIopsLimit.
+	PoolIopsLimitMin = 100
+)
+
+const (
+	// DaosMdCapEnv is the name of the environment variable defining the size of a metadata pmem
+	// pool/file in MiBs.
+	DaosMdCapEnv = "DAOS_MD_CAP"
+	// DefaultDaosMdCapSize is the default size of a metadata pmem pool/file in MiBs.
+	DefaultDaosMdCapSize = 1 << 30
+)
+
+const (
+	PoolScrubModeOff   = 0
+	PoolScrubModeLazy  = 1
+	PoolScrubModeTimed = 2
+)
+
+const (
+	PoolCheckpointDisabled = 0
+	PoolCheckpointTimed    = 1
+	PoolCheckpointLazy     = 2
+)
+
+const (
+	PoolPerfDomainRoot        = 255
+	PoolPerfDomainUserDefined = 200
+)
+
+const (
+	PoolReintModeDataSync    = 0
+	PoolReintModeNoDataSync  = 1
+	PoolReintModeIncremental = 2
+)
+
+var (
+	labelRe    = regexp.MustCompile(`^[[:alnum:].:_-]+$`)
+	uuidPrefix = regexp.MustCompile(`^[[:xdigit:]]{8}-[[:xdigit:]]{4}-[[:xdigit:]]{4}-[[:xdigit:]]{4}-[[:xdigit:]]{12}`)
+)
+
+// LabelIsValid checks a label to verify that it meets length/content
+// requirements.
+func LabelIsValid(label string) bool {
+	if len(label) == 0 || len(label) > MaxLabelLength {
+		return false
+	}
+
+	return labelRe.MatchString(label) && !uuidPrefix.MatchString(label)
+}
+
+// EcCellSizeIsValid checks EC cell Size to verify that it meets size
+// requirements.
+func EcCellSizeIsValid(sz uint64) bool {
+	if sz > math.MaxUint32 {
+		return false
+	}
+	return sz >= ECCellMin && sz <= ECCellMax && sz%32 == 0
+}
+
+// EcPdaIsValid checks EC performance domain affinity level that it
+// doesn't exceed max unsigned int 32 bits.
+func EcPdaIsValid(pda uint64) bool {
+	return pda > 0 && pda <= math.MaxUint32
+}
+
+// RpPdaIsValid checks RP performance domain affinity level that it
+// doesn't exceed max unsigned int 32 bits.
+func RpPdaIsValid(pda uint64) bool {
+	return pda > 0 && pda <= math.MaxUint32
+}
+
+// DataThreshIsValid verifies that the input value meets the required criteria.
+func DataThreshIsValid(size uint64) bool {
+	return size <= math.MaxUint32
+}
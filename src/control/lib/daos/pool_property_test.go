@@ -337,6 +337,79 @@ func TestDaos_PoolProperties(t *testing.T) {
 			value:  "601",
 			expErr: errors.New("invalid"),
 		},
+		"bw_limit-valid": {
+			name:    "bw_limit",
+			value:   "1GiB/s",
+			expStr:  "bw_limit:1.0 GiB/s",
+			expJson: []byte(`{"name":"bw_limit","description":"Maximum aggregate bandwidth, per second (advisory)","value":1073741824}`),
+		},
+		"bw_limit-valid-without-suffix": {
+			name:    "bw_limit",
+			value:   "500MiB",
+			expStr:  "bw_limit:500 MiB/s",
+			expJson: []byte(`{"name":"bw_limit","description":"Maximum aggregate bandwidth, per second (advisory)","value":524288000}`),
+		},
+		"bw_limit-none": {
+			name:    "bw_limit",
+			value:   "none",
+			expStr:  "bw_limit:none",
+			expJson: []byte(`{"name":"bw_limit","description":"Maximum aggregate bandwidth, per second (advisory)","value":0}`),
+		},
+		"bw_limit-zero": {
+			name:    "bw_limit",
+			value:   "0",
+			expStr:  "bw_limit:none",
+			expJson: []byte(`{"name":"bw_limit","description":"Maximum aggregate bandwidth, per second (advisory)","value":0}`),
+		},
+		"bw_limit-invalid-toolow": {
+			name:   "bw_limit",
+			value:  "512KiB",
+			expErr: errors.New("too low"),
+		},
+		"bw_limit-invalid": {
+			name:   "bw_limit",
+			value:  "fast",
+			expErr: errors.New("invalid bw_limit"),
+		},
+		"iops_limit-valid": {
+			name:    "iops_limit",
+			value:   "50000",
+			expStr:  "iops_limit:50000",
+			expJson: []byte(`{"name":"iops_limit","description":"Maximum aggregate I/O operations, per second (advisory)","value":50000}`),
+		},
+		"iops_limit-none": {
+			name:    "iops_limit",
+			value:   "none",
+			expStr:  "iops_limit:none",
+			expJson: []byte(`{"name":"iops_limit","description":"Maximum aggregate I/O operations, per second (advisory)","value":0}`),
+		},
+		"iops_limit-invalid-toolow": {
+			name:   "iops_limit",
+			value:  "99",
+			expErr: errors.New("too low"),
+		},
+		"iops_limit-invalid": {
+			name:   "iops_limit",
+			value:  "-1",
+			expErr: errors.New("invalid iops_limit"),
+		},
+		"destroy_protect-enabled": {
+			name:    "destroy_protect",
+			value:   "enabled",
+			expStr:  "destroy_protect:enabled",
+			expJson: []byte(`{"name":"destroy_protect","description":"Destruction protection","value":"enabled"}`),
+		},
+		"destroy_protect-disabled": {
+			name:    "destroy_protect",
+			value:   "disabled",
+			expStr:  "destroy_protect:disabled",
+			expJson: []byte(`{"name":"destroy_protect","description":"Destruction protection","value":"disabled"}`),
+		},
+		"destroy_protect-invalid": {
+			name:   "destroy_protect",
+			value:  "yes",
+			expErr: errors.New("invalid value"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			prop, err := daos.PoolProperties().GetProperty(tc.name)
@@ -0,0 +1,26 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package daos
+
+// The values below mirror those in src/include/daos_pool.h and
+// src/include/daos_types.h, which can only be included on Linux.
+const (
+	dpiSpace           = 1 << 0
+	dpiRebuildStatus   = 1 << 1
+	dpiEnginesEnabled  = 1 << 2
+	dpiEnginesDisabled = 1 << 3
+	dpiEnginesDead     = 1 << 4
+
+	// PoolConnectFlagReadOnly indicates that the connection is read-only.
+	PoolConnectFlagReadOnly PoolConnectFlag = 1 << 0
+	// PoolConnectFlagReadWrite indicates that the connection is read-write.
+	PoolConnectFlagReadWrite PoolConnectFlag = 1 << 1
+	// PoolConnectFlagExclusive indicates that the connection is exclusive.
+	PoolConnectFlagExclusive PoolConnectFlag = 1 << 2
+)
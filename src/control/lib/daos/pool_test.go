@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +11,7 @@ import (
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -108,6 +110,146 @@ func TestDaos_PoolInfo_Usage(t *testing.T) {
 	}
 }
 
+func TestDaos_PoolInfo_HasLabel(t *testing.T) {
+	pi := &PoolInfo{
+		Label:   "label",
+		Aliases: []string{"alias1", "alias2"},
+	}
+
+	for name, tc := range map[string]struct {
+		label  string
+		expHas bool
+	}{
+		"empty": {},
+		"label": {
+			label:  "label",
+			expHas: true,
+		},
+		"alias": {
+			label:  "alias2",
+			expHas: true,
+		},
+		"other": {
+			label: "other",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expHas, pi.HasLabel(tc.label), "")
+		})
+	}
+}
+
+func TestDaos_PoolRebuildStatus_Detail(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rs            *PoolRebuildStatus
+		expPhase      string
+		expProgress   float64
+		expProgressOK bool
+		expObjsPerSec float64
+		expRecsPerSec float64
+		expRateOK     bool
+		expETA        time.Duration
+		expETAOK      bool
+	}{
+		"nil": {},
+		"idle": {
+			rs: &PoolRebuildStatus{
+				State: PoolRebuildStateIdle,
+			},
+		},
+		"done": {
+			rs: &PoolRebuildStatus{
+				State:        PoolRebuildStateDone,
+				Objects:      100,
+				Records:      400,
+				TotalObjects: 100,
+				DurationSec:  50,
+			},
+			expProgress:   1,
+			expProgressOK: true,
+			expObjsPerSec: 2,
+			expRecsPerSec: 8,
+			expRateOK:     true,
+		},
+		"scanning": {
+			rs: &PoolRebuildStatus{
+				State:       PoolRebuildStateBusy,
+				DurationSec: 10,
+			},
+			expPhase:  PoolRebuildPhaseScanning,
+			expRateOK: true,
+		},
+		"pulling; no objects rebuilt": {
+			rs: &PoolRebuildStatus{
+				State:        PoolRebuildStateBusy,
+				TotalObjects: 100,
+				DurationSec:  10,
+			},
+			expPhase:      PoolRebuildPhasePulling,
+			expProgressOK: true,
+			expRateOK:     true,
+		},
+		"pulling; duration unknown": {
+			rs: &PoolRebuildStatus{
+				State:        PoolRebuildStateBusy,
+				Objects:      25,
+				TotalObjects: 100,
+			},
+			expPhase:      PoolRebuildPhasePulling,
+			expProgress:   0.25,
+			expProgressOK: true,
+		},
+		"pulling": {
+			rs: &PoolRebuildStatus{
+				State:        PoolRebuildStateBusy,
+				Objects:      25,
+				Records:      100,
+				TotalObjects: 100,
+				DurationSec:  10,
+			},
+			expPhase:      PoolRebuildPhasePulling,
+			expProgress:   0.25,
+			expProgressOK: true,
+			expObjsPerSec: 2.5,
+			expRecsPerSec: 10,
+			expRateOK:     true,
+			expETA:        30 * time.Second,
+			expETAOK:      true,
+		},
+		"pulling; more objects rebuilt than found": {
+			rs: &PoolRebuildStatus{
+				State:        PoolRebuildStateBusy,
+				Objects:      120,
+				TotalObjects: 100,
+				DurationSec:  10,
+			},
+			expPhase:      PoolRebuildPhasePulling,
+			expProgress:   1,
+			expProgressOK: true,
+			expObjsPerSec: 12,
+			expRateOK:     true,
+			expETAOK:      true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expPhase, tc.rs.Phase(), "unexpected phase")
+
+			progress, ok := tc.rs.Progress()
+			test.AssertEqual(t, tc.expProgressOK, ok, "unexpected progress ok")
+			test.AssertEqual(t, tc.expProgress, progress, "unexpected progress")
+
+			objsPerSec, recsPerSec, ok := tc.rs.Throughput()
+			test.AssertEqual(t, tc.expRateOK, ok, "unexpected throughput ok")
+			test.AssertEqual(t, tc.expObjsPerSec, objsPerSec, "unexpected objs/s")
+			test.AssertEqual(t, tc.expRecsPerSec, recsPerSec, "unexpected recs/s")
+
+			eta, ok := tc.rs.ETA()
+			test.AssertEqual(t, tc.expETAOK, ok, "unexpected ETA ok")
+			test.AssertEqual(t, tc.expETA, eta, "unexpected ETA")
+		})
+	}
+}
+
 func genTestMask(modifyFn func(pqm *PoolQueryMask)) PoolQueryMask {
 	testMask := PoolQueryMask(0)
 	modifyFn(&testMask)
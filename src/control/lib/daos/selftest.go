@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -19,13 +20,6 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 )
 
-/*
-#cgo CFLAGS: -I${SRCDIR}/../../../cart/utils -I${SRCDIR}/../../../utils/self_test
-
-#include "self_test_lib.h"
-*/
-import "C"
-
 type (
 	// EndpointLatency represents the results of running latency tests against
 	// a single rank:target endpoint.
@@ -76,11 +70,10 @@ type (
 var defaultLatencyPercentiles []uint64 = []uint64{50, 75, 90, 95, 99}
 
 const (
-	defaultSendSize     = 1 << 10 // 1KiB
-	defaultReplySize    = defaultSendSize
-	defaultRepCount     = 10000
-	defaultMaxInflight  = 16
-	defaultBufAlignment = C.CRT_ST_BUF_ALIGN_DEFAULT
+	defaultSendSize    = 1 << 10 // 1KiB
+	defaultReplySize   = defaultSendSize
+	defaultRepCount    = 10000
+	defaultMaxInflight = 16
 )
 
 // SetDefaults replaces unset parameters with default values.
@@ -0,0 +1,296 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daos_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// stubFile holds the constants and error tables parsed from a Go source file.
+type stubFile struct {
+	consts map[string]constant.Value
+	tables map[string][][2]string
+}
+
+func evalConstExpr(expr ast.Expr, known map[string]constant.Value) constant.Value {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return constant.MakeFromLiteral(e.Value, e.Kind, 0)
+	case *ast.ParenExpr:
+		return evalConstExpr(e.X, known)
+	case *ast.Ident:
+		if val, found := known[e.Name]; found {
+			return val
+		}
+	case *ast.UnaryExpr:
+		return constant.UnaryOp(e.Op, evalConstExpr(e.X, known), 0)
+	case *ast.BinaryExpr:
+		x, y := evalConstExpr(e.X, known), evalConstExpr(e.Y, known)
+		if e.Op == token.SHL || e.Op == token.SHR {
+			if s, ok := constant.Uint64Val(y); ok {
+				return constant.Shift(x, e.Op, uint(s))
+			}
+			break
+		}
+		return constant.BinaryOp(x, e.Op, y)
+	}
+
+	// Anything else (e.g. a cgo reference) can't be evaluated here.
+	return constant.MakeUnknown()
+}
+
+// parseStubFile extracts the constants, and any package-level tables of
+// string pairs, declared in the given Go source file.
+func parseStubFile(t *testing.T, path string) *stubFile {
+	t.Helper()
+
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sf := &stubFile{
+		consts: make(map[string]constant.Value),
+		tables: make(map[string][][2]string),
+	}
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, name := range vs.Names {
+				if gd.Tok == token.CONST {
+					sf.consts[name.Name] = constant.MakeUnknown()
+					if i < len(vs.Values) {
+						sf.consts[name.Name] = evalConstExpr(vs.Values[i], sf.consts)
+					}
+					continue
+				}
+				if i >= len(vs.Values) {
+					continue
+				}
+				if table := parseStringPairs(t, vs.Values[i]); table != nil {
+					sf.tables[name.Name] = table
+				}
+			}
+		}
+	}
+
+	return sf
+}
+
+func parseStringPairs(t *testing.T, expr ast.Expr) [][2]string {
+	t.Helper()
+
+	cl, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+
+	var table [][2]string
+	for _, elt := range cl.Elts {
+		pair, ok := elt.(*ast.CompositeLit)
+		if !ok || len(pair.Elts) != 2 {
+			return nil
+		}
+		var entry [2]string
+		for i, pe := range pair.Elts {
+			lit, ok := pe.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return nil
+			}
+			str, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			entry[i] = str
+		}
+		table = append(table, entry)
+	}
+
+	return table
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func constString(val constant.Value) string {
+	if val.Kind() == constant.String {
+		return constant.StringVal(val)
+	}
+	return val.ExactString()
+}
+
+// checkStubConsts verifies that the exported constants declared in the stubs
+// file match those declared in the Linux file and have the same values as
+// the ones imported from the C headers.
+func checkStubConsts(t *testing.T, stubs *stubFile, linuxPath string, cgoValues map[string]any) {
+	t.Helper()
+
+	linux := parseStubFile(t, linuxPath)
+	if diff := cmp.Diff(sortedKeys(linux.consts), sortedKeys(cgoValues)); diff != "" {
+		t.Fatalf("constants in %s not covered by this test (-linux, +test):\n%s", linuxPath, diff)
+	}
+
+	exported := make(map[string]constant.Value)
+	for name, val := range stubs.consts {
+		if ast.IsExported(name) {
+			exported[name] = val
+		}
+	}
+	if diff := cmp.Diff(sortedKeys(linux.consts), sortedKeys(exported)); diff != "" {
+		t.Fatalf("stubs do not declare the same constants as %s (-linux, +stubs):\n%s", linuxPath, diff)
+	}
+
+	for _, name := range sortedKeys(cgoValues) {
+		stubVal := exported[name]
+		if stubVal.Kind() == constant.Unknown {
+			t.Errorf("%s: no constant value in stubs", name)
+			continue
+		}
+		if got, want := constString(stubVal), fmt.Sprint(cgoValues[name]); got != want {
+			t.Errorf("%s: stubs have %s, C headers have %s", name, got, want)
+		}
+	}
+}
+
+// TestDaos_StatusStubs verifies that the status codes and strings used on
+// platforms without the C headers match those in src/include/daos_errno.h.
+func TestDaos_StatusStubs(t *testing.T) {
+	stubs := parseStubFile(t, "status_stubs.go")
+
+	checkStubConsts(t, stubs, "status_linux.go", map[string]any{
+		"Success":                  int32(daos.Success),
+		"NoPermission":             int32(daos.NoPermission),
+		"NoHandle":                 int32(daos.NoHandle),
+		"InvalidInput":             int32(daos.InvalidInput),
+		"Exists":                   int32(daos.Exists),
+		"Nonexistent":              int32(daos.Nonexistent),
+		"Unreachable":              int32(daos.Unreachable),
+		"NoSpace":                  int32(daos.NoSpace),
+		"Already":                  int32(daos.Already),
+		"NoMemory":                 int32(daos.NoMemory),
+		"NotImpl":                  int32(daos.NotImpl),
+		"TimedOut":                 int32(daos.TimedOut),
+		"Busy":                     int32(daos.Busy),
+		"TryAgain":                 int32(daos.TryAgain),
+		"ProtocolError":            int32(daos.ProtocolError),
+		"NotInit":                  int32(daos.NotInit),
+		"BufTooSmall":              int32(daos.BufTooSmall),
+		"StructTooSmall":           int32(daos.StructTooSmall),
+		"Canceled":                 int32(daos.Canceled),
+		"OutOfGroup":               int32(daos.OutOfGroup),
+		"MercuryError":             int32(daos.MercuryError),
+		"Unregistered":             int32(daos.Unregistered),
+		"AddrStringFailed":         int32(daos.AddrStringFailed),
+		"PMIXError":                int32(daos.PMIXError),
+		"IVCallback":               int32(daos.IVCallback),
+		"MiscError":                int32(daos.MiscError),
+		"BadPath":                  int32(daos.BadPath),
+		"NotDir":                   int32(daos.NotDir),
+		"CorpcIncomplete":          int32(daos.CorpcIncomplete),
+		"NoRASRank":                int32(daos.NoRASRank),
+		"NotAttached":              int32(daos.NotAttached),
+		"Mismatch":                 int32(daos.Mismatch),
+		"Excluded":                 int32(daos.Excluded),
+		"NoReply":                  int32(daos.NoReply),
+		"DenialOfService":          int32(daos.DenialOfService),
+		"BadTarget":                int32(daos.BadTarget),
+		"GroupVersionMismatch":     int32(daos.GroupVersionMismatch),
+		"MercuryFatalError":        int32(daos.MercuryFatalError),
+		"NoService":                int32(daos.NoService),
+		"IOError":                  int32(daos.IOError),
+		"FreeMemError":             int32(daos.FreeMemError),
+		"NoEntry":                  int32(daos.NoEntry),
+		"UnknownType":              int32(daos.UnknownType),
+		"UnknownSchema":            int32(daos.UnknownSchema),
+		"NotLocal":                 int32(daos.NotLocal),
+		"Stale":                    int32(daos.Stale),
+		"NotLeader":                int32(daos.NotLeader),
+		"TargetCreateError":        int32(daos.TargetCreateError),
+		"EpochReadOnly":            int32(daos.EpochReadOnly),
+		"EpochRecycled":            int32(daos.EpochRecycled),
+		"KeyTooBig":                int32(daos.KeyTooBig),
+		"RecordTooBig":             int32(daos.RecordTooBig),
+		"IOInvalid":                int32(daos.IOInvalid),
+		"EventQueueBusy":           int32(daos.EventQueueBusy),
+		"DomainMismatch":           int32(daos.DomainMismatch),
+		"Shutdown":                 int32(daos.Shutdown),
+		"InProgress":               int32(daos.InProgress),
+		"NotApplicable":            int32(daos.NotApplicable),
+		"NotReplica":               int32(daos.NotReplica),
+		"ChecksumError":            int32(daos.ChecksumError),
+		"ControlIncompatible":      int32(daos.ControlIncompatible),
+		"NoCert":                   int32(daos.NoCert),
+		"BadCert":                  int32(daos.BadCert),
+		"RedundancyFactorExceeded": int32(daos.RedundancyFactorExceeded),
+		"AgentCommFailed":          int32(daos.AgentCommFailed),
+	})
+
+	for tableName, baseName := range map[string]string{
+		"gurtErrors": "derErrGurtBase",
+		"daosErrors": "derErrDAOSBase",
+	} {
+		t.Run(tableName, func(t *testing.T) {
+			table, found := stubs.tables[tableName]
+			if !found {
+				t.Fatalf("%s not found in stubs", tableName)
+			}
+			base, ok := constant.Int64Val(stubs.consts[baseName])
+			if !ok {
+				t.Fatalf("%s not found in stubs", baseName)
+			}
+
+			for i, entry := range table {
+				ds := daos.Status(-(base + int64(i) + 1))
+				expStr := fmt.Sprintf("%s(%d): %s", entry[0], ds, entry[1])
+				if ds.Error() != expStr {
+					t.Errorf("stubs have %q, C library has %q", expStr, ds.Error())
+				}
+			}
+
+			// Any code beyond the end of the table is expected to be unknown.
+			ds := daos.Status(-(base + int64(len(table)) + 1))
+			expStr := fmt.Sprintf("DER_UNKNOWN(%d): Unknown error code %d", ds, ds)
+			if ds.Error() != expStr {
+				t.Errorf("%s is missing %q", tableName, ds.Error())
+			}
+		})
+	}
+
+	unknown, ok := constant.Int64Val(stubs.consts["derUnknown"])
+	if !ok {
+		t.Fatal("derUnknown not found in stubs")
+	}
+	ds := daos.Status(-unknown)
+	expStr := fmt.Sprintf("DER_UNKNOWN(%d): Unknown error", ds)
+	if ds.Error() != expStr {
+		t.Errorf("stubs have %q, C library has %q", expStr, ds.Error())
+	}
+}
@@ -0,0 +1,293 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package daos
+
+import "fmt"
+
+// The status codes and strings below mirror those in src/include/daos_errno.h,
+// which can only be included on Linux.
+
+const (
+	derErrGurtBase = 1000
+	derErrDAOSBase = 2000
+	derUnknown     = derErrGurtBase + 500000
+)
+
+type derDesc struct {
+	name string
+	desc string
+}
+
+var gurtErrors = []derDesc{
+	{"DER_NO_PERM", "Operation not permitted"},
+	{"DER_NO_HDL", "Invalid handle"},
+	{"DER_INVAL", "Invalid parameters"},
+	{"DER_EXIST", "Entity already exists"},
+	{"DER_NONEXIST", "The specified entity does not exist"},
+	{"DER_UNREACH", "Unreachable node"},
+	{"DER_NOSPACE", "No space on storage target"},
+	{"DER_ALREADY", "Operation already performed"},
+	{"DER_NOMEM", "Out of memory"},
+	{"DER_NOSYS", "Function not implemented"},
+	{"DER_TIMEDOUT", "Time out"},
+	{"DER_BUSY", "Device or resource busy"},
+	{"DER_AGAIN", "Try again"},
+	{"DER_PROTO", "Incompatible protocol"},
+	{"DER_UNINIT", "Device or resource not initialized"},
+	{"DER_TRUNC", "Buffer too short"},
+	{"DER_OVERFLOW", "Data too long for defined data type or buffer size"},
+	{"DER_CANCELED", "Operation canceled"},
+	{"DER_OOG", "Out of group or member list"},
+	{"DER_HG", "Transport layer mercury error"},
+	{"DER_UNREG", "RPC or protocol version not registered"},
+	{"DER_ADDRSTR_GEN", "Failed to generate an address string"},
+	{"DER_PMIX", "PMIx layer error"},
+	{"DER_IVCB_FORWARD", "Incast variable unavailable locally. Must forward"},
+	{"DER_MISC", "Miscellaneous error"},
+	{"DER_BADPATH", "Bad path name"},
+	{"DER_NOTDIR", "Not a directory"},
+	{"DER_CORPC_INCOMPLETE", "Collective RPC failed"},
+	{"DER_NO_RAS_RANK", "No rank is subscribed to RAS"},
+	{"DER_NOTATTACH", "Service group not attached"},
+	{"DER_MISMATCH", "Version mismatch"},
+	{"DER_EXCLUDED", "Rank has been excluded"},
+	{"DER_NOREPLY", "User provided RPC handler did not send reply back"},
+	{"DER_DOS", "Denial of service"},
+	{"DER_BAD_TARGET", "Incorrect target for the RPC"},
+	{"DER_GRPVER", "Group versioning mismatch"},
+	{"DER_HLC_SYNC", "HLC synchronization error"},
+	{"DER_NO_SHMEM", "Not enough shared memory free"},
+	{"DER_ADD_METRIC_FAILED", "Failed to add the specified metric"},
+	{"DER_DURATION_MISMATCH", "Duration end not paired with duration start"},
+	{"DER_OP_NOT_PERMITTED", "Operation not permitted for metric type provided"},
+	{"DER_EXCEEDS_PATH_LEN", "Path name exceeds permitted length"},
+	{"DER_METRIC_NOT_FOUND", "Read failed because metric not found"},
+	{"DER_SHMEM_PERMS", "Unable to access shared memory segment due to incompatible user or group permissions"},
+	{"DER_HG_FATAL", "Fatal transport layer mercury error"},
+	{"DER_QUOTA_LIMIT", "Quota limit reached"},
+}
+
+var daosErrors = []derDesc{
+	{"DER_IO", "I / O error"},
+	{"DER_FREE_MEM", "Memory free error"},
+	{"DER_ENOENT", "Entity not found"},
+	{"DER_NOTYPE", "Unknown object type"},
+	{"DER_NOSCHEMA", "Unknown object schema"},
+	{"DER_NOLOCAL", "Object is not local"},
+	{"DER_STALE", "Stale pool map version"},
+	{"DER_NOTLEADER", "Not service leader"},
+	{"DER_TGT_CREATE", "Target create error"},
+	{"DER_EP_RO", "Epoch is read only"},
+	{"DER_EP_OLD", "Epoch is too old. All data have been recycled"},
+	{"DER_KEY2BIG", "Key is too large"},
+	{"DER_REC2BIG", "Record is too large"},
+	{"DER_IO_INVAL", "I / O buffers do not match object extents"},
+	{"DER_EQ_BUSY", "Event queue is busy"},
+	{"DER_DOMAIN", "Domain of cluster component do not match"},
+	{"DER_SHUTDOWN", "Service should shut down"},
+	{"DER_INPROGRESS", "Operation now in progress"},
+	{"DER_NOTAPPLICABLE", "Not applicable"},
+	{"DER_NOTREPLICA", "Not a service replica"},
+	{"DER_CSUM", "Checksum error"},
+	{"DER_DF_INVAL", "Unsupported durable format"},
+	{"DER_DF_INCOMPT", "Incompatible durable format version"},
+	{"DER_REC_SIZE", "Record size error"},
+	{"DER_TX_RESTART", "Transaction should restart"},
+	{"DER_DATA_LOSS", "Data lost or not recoverable"},
+	{"DER_OP_CANCELED", "Operation canceled"},
+	{"DER_TX_BUSY", "TX is not committed"},
+	{"DER_AGENT_INCOMPAT", "Agent is incompatible with libdaos"},
+	{"DER_NEED_TX", "To be handled via distributed transaction"},
+	{"DER_RF", "Failures exceed RF"},
+	{"DER_FETCH_AGAIN", "Fetch again"},
+	{"DER_TX_UNCERTAIN", "TX status is uncertain"},
+	{"DER_AGENT_COMM", "Agent communication error"},
+	{"DER_ID_MISMATCH", "ID mismatch"},
+	{"DER_TGT_RETRY", "Retry with other target"},
+	{"DER_NOTSUPPORTED", "Operation not supported"},
+	{"DER_CONTROL_INCOMPAT", "One or more control plane components are incompatible"},
+	{"DER_NO_SERVICE", "No service available"},
+	{"DER_TX_ID_REUSED", "TX ID may be reused"},
+	{"DER_UPDATE_AGAIN", "update again"},
+	{"DER_NVME_IO", "NVMe I / O error"},
+	{"DER_NO_CERT", "Unable to access one or more certificates"},
+	{"DER_BAD_CERT", "Invalid x509 certificate"},
+	{"DER_VOS_PARTIAL_UPDATE", "Same epoch partial overwrite of VOS array value disallowed"},
+	{"DER_CHKPT_BUSY", "Page is temporarily read only due to checkpointing"},
+	{"DER_DIV_BY_ZERO", "Division by zero"},
+	{"DER_OVERLOAD_RETRY", "retry later because of overloaded service"},
+	{"DER_NOT_RESUME", "Cannot resume former DAOS check instance"},
+}
+
+func lookupStatus(ds Status) (derDesc, bool) {
+	switch {
+	case ds == Success:
+		return derDesc{"DER_SUCCESS", "Success"}, true
+	case ds == -derUnknown:
+		return derDesc{"DER_UNKNOWN", "Unknown error"}, true
+	case ds > 0:
+		return derDesc{}, false
+	}
+
+	errnum := int(-ds)
+	for base, errs := range map[int][]derDesc{
+		derErrGurtBase: gurtErrors,
+		derErrDAOSBase: daosErrors,
+	} {
+		if errnum > base && errnum <= base+len(errs) {
+			return errs[errnum-base-1], true
+		}
+	}
+
+	return derDesc{}, false
+}
+
+func (ds Status) Error() string {
+	d, found := lookupStatus(ds)
+	if !found {
+		d = derDesc{"DER_UNKNOWN", fmt.Sprintf("Unknown error code %d", ds)}
+	}
+	return fmt.Sprintf("%s(%d): %s", d.name, ds, d.desc)
+}
+
+const (
+	// Success indicates no error
+	Success Status = 0
+	// NoPermission indicates that access to a resource was denied
+	NoPermission Status = -1001 // DER_NO_PERM
+	// NoHandle indicates the handle was invalid
+	NoHandle Status = -1002 // DER_NO_HDL
+	// InvalidInput indicates an input was invalid
+	InvalidInput Status = -1003 // DER_INVAL
+	// Exists indicates the entity already exists
+	Exists Status = -1004 // DER_EXIST
+	// Nonexistent indicates the entity does not exist
+	Nonexistent Status = -1005 // DER_NONEXIST
+	// Unreachable indicates a node was unreachable
+	Unreachable Status = -1006 // DER_UNREACH
+	// NoSpace indicates there was not enough storage space
+	NoSpace Status = -1007 // DER_NOSPACE
+	// Already indicates the operation was already done
+	Already Status = -1008 // DER_ALREADY
+	// NoMemory indicates the system ran out of memory
+	NoMemory Status = -1009 // DER_NOMEM
+	// NotImpl indicates the requested functionality is not implemented
+	NotImpl Status = -1010 // DER_NOSYS
+	// TimedOut indicates the operation timed out
+	TimedOut Status = -1011 // DER_TIMEDOUT
+	// Busy indicates the system was busy and didn't process the request
+	Busy Status = -1012 // DER_BUSY
+	// TryAgain indicates the operation failed, but should be tried again
+	TryAgain Status = -1013 // DER_AGAIN
+	// ProtocolError indicates incompatibility in communications protocols
+	ProtocolError Status = -1014 // DER_PROTO
+	// NotInit indicates something in the system wasn't initialized
+	NotInit Status = -1015 // DER_UNINIT
+	// BufTooSmall indicates a provided buffer was too small
+	BufTooSmall Status = -1016 // DER_TRUNC
+	// StructTooSmall indicates data could not fit in the provided structure
+	StructTooSmall Status = -1017 // DER_OVERFLOW
+	// Canceled indicates the operation was canceled
+	Canceled Status = -1018 // DER_CANCELED
+	// OutOfGroup indicates that a rank wasn't found in the group
+	OutOfGroup Status = -1019 // DER_OOG
+	// MercuryError indicates that there was an error in the Mercury transport layer
+	MercuryError Status = -1020 // DER_HG
+	// Unregistered indicates that a requested RPC was not registered
+	Unregistered Status = -1021 // DER_UNREG
+	// AddrStringFailed indicates that an address string couldn't be generated
+	AddrStringFailed Status = -1022 // DER_ADDRSTR_GEN
+	// PMIXError indicates an error in the PMIX layer
+	PMIXError Status = -1023 // DER_PMIX
+	// IVCallback indicates that the IV callback cannot be handled locally
+	IVCallback Status = -1024 // DER_IVCB_FORWARD
+	// MiscError indicates an unspecified error
+	MiscError Status = -1025 // DER_MISC
+	// BadPath indicates that a bad file or directory path was provided
+	BadPath Status = -1026 // DER_BADPATH
+	// NotDir indicates that the path is not to a directory
+	NotDir Status = -1027 // DER_NOTDIR
+	// CorpcIncomplete indicates that corpc failed
+	CorpcIncomplete Status = -1028 // DER_CORPC_INCOMPLETE
+	// NoRASRank indicates that no rank is subscribed to RAS
+	NoRASRank Status = -1029 // DER_NO_RAS_RANK
+	// NotAttached indicates that a service group is not attached
+	NotAttached Status = -1030 // DER_NOTATTACH
+	// Mismatch indicates a version mismatch
+	Mismatch Status = -1031 // DER_MISMATCH
+	// Excluded indicates that the rank was excluded
+	Excluded Status = -1032 // DER_EXCLUDED
+	// NoReply indicates that there was no reply to an RPC
+	NoReply Status = -1033 // DER_NOREPLY
+	// DenialOfService indicates that there was a denial of service
+	DenialOfService Status = -1034 // DER_DOS
+	// BadTarget indicates that the target was wrong for the RPC
+	BadTarget Status = -1035 // DER_BAD_TARGET
+	// GroupVersionMismatch indicates that group versions didn't match
+	GroupVersionMismatch Status = -1036 // DER_GRPVER
+	// MercuryFatalError indicates a fatal (non-retryable) Mercury error
+	MercuryFatalError Status = -1045 // DER_HG_FATAL
+	// NoService indicates the pool service is not up and didn't process the pool request
+	NoService Status = -2039 // DER_NO_SERVICE
+)
+
+const (
+	// IOError indicates a generic IO error
+	IOError Status = -2001 // DER_IO
+	// FreeMemError indicates an error freeing memory
+	FreeMemError Status = -2002 // DER_FREE_MEM
+	// NoEntry indicates that the entry was not found
+	NoEntry Status = -2003 // DER_ENOENT
+	// UnknownType indicates that the entity type was unknown
+	UnknownType Status = -2004 // DER_NOTYPE
+	// UnknownSchema indicates that the entity schema was unknown
+	UnknownSchema Status = -2005 // DER_NOSCHEMA
+	// NotLocal indicates that the entity was not local
+	NotLocal Status = -2006 // DER_NOLOCAL
+	// Stale indicates that a resource was stale
+	Stale Status = -2007 // DER_STALE
+	// NotLeader indicates that the replica is not the service leader
+	NotLeader Status = -2008 // DER_NOTLEADER
+	// TargetCreateError indicates that target creation failed
+	TargetCreateError Status = -2009 // DER_TGT_CREATE
+	// EpochReadOnly indicates that the epoch couldn't be modified
+	EpochReadOnly Status = -2010 // DER_EP_RO
+	// EpochRecycled indicates that the epoch was recycled due to age
+	EpochRecycled Status = -2011 // DER_EP_OLD
+	// KeyTooBig indicates that the key is too big
+	KeyTooBig Status = -2012 // DER_KEY2BIG
+	// RecordTooBig indicates that the record is too big
+	RecordTooBig Status = -2013 // DER_REC2BIG
+	// IOInvalid indicates a mismatch between IO buffers and object extents
+	IOInvalid Status = -2014 // DER_IO_INVAL
+	// EventQueueBusy indicates that the event queue is busy
+	EventQueueBusy Status = -2015 // DER_EQ_BUSY
+	// DomainMismatch indicates that there was a mismatch of domains in cluster components
+	DomainMismatch Status = -2016 // DER_DOMAIN
+	// Shutdown indicates that the service should shut down
+	Shutdown Status = -2017 // DER_SHUTDOWN
+	// InProgress indicates that the operation is in progress
+	InProgress Status = -2018 // DER_INPROGRESS
+	// NotApplicable indicates that the operation is not applicable
+	NotApplicable Status = -2019 // DER_NOTAPPLICABLE
+	// NotReplica indicates that the requested component is not a service replica
+	NotReplica Status = -2020 // DER_NOTREPLICA
+	// ChecksumError indicates a checksum error
+	ChecksumError Status = -2021 // DER_CSUM
+	// ControlIncompatible indicates that one or more control plane components are incompatible
+	ControlIncompatible Status = -2038 // DER_CONTROL_INCOMPAT
+	// NoCert indicates that one or more configured certificates could not be accessed.
+	NoCert Status = -2043 // DER_NO_CERT
+	// BadCert indicates that an invalid certificate was detected.
+	BadCert Status = -2044 // DER_BAD_CERT
+	// RedundancyFactorExceeded indicates that the maximum number of failed components was exceeded.
+	RedundancyFactorExceeded Status = -2031 // DER_RF
+	// AgentCommFailed indicates that client/agent communication failed.
+	AgentCommFailed Status = -2034 // DER_AGENT_COMM
+)
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -18,15 +19,10 @@ import (
 	"github.com/daos-stack/daos/src/control/common"
 )
 
-/*
-#include <daos_types.h>
-*/
-import "C"
-
 // SystemNameIsValid returns true if the given name is valid for a DAOS system.
 func SystemNameIsValid(name string) bool {
 	// NB: So far, this seems to be the only constraint on system names.
-	if name == "" || len(name) > C.DAOS_SYS_NAME_MAX {
+	if name == "" || len(name) > sysNameMax {
 		return false
 	}
 
@@ -1,8 +1,11 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
+//go:build linux
+// +build linux
 
 // Package dlopen provides some convenience functions to dlopen a library and
 // get its symbols.
@@ -0,0 +1,41 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package dlopen
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+var ErrSoNotFound = errors.New("unable to open a handle to the library")
+
+// LibHandle represents an open handle to a library (.so)
+type LibHandle struct {
+	Handle  unsafe.Pointer
+	Libname string
+}
+
+// GetHandle returns ErrSoNotFound, as libraries can not be opened on this
+// platform.
+func GetHandle(libs ...string) (*LibHandle, error) {
+	return nil, ErrSoNotFound
+}
+
+// GetSymbolPointer returns an error, as symbols can not be resolved on this
+// platform.
+func (l *LibHandle) GetSymbolPointer(symbol string) (unsafe.Pointer, error) {
+	return nil, fmt.Errorf("error resolving symbol %q: dlopen not supported on %s", symbol, runtime.GOOS)
+}
+
+// Close is a no-op on this platform.
+func (l *LibHandle) Close() error {
+	return nil
+}
@@ -0,0 +1,202 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package estimate provides estimates of the storage required to hold a
+// given workload in a DAOS pool, from which pool sizes and tier ratios can
+// be recommended.
+//
+// Estimates are calculated by a pluggable Backend. The built-in "vos"
+// backend models the layout of objects in the DAOS versioned object store,
+// and additional backends may be registered or provided by an external
+// program via an "exec:<path>" specification.
+package estimate
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultBackend is the name of the backend used if none is specified.
+	DefaultBackend = "vos"
+	// DefaultHeadroom is the percentage of free space added to an estimate
+	// when calculating a recommended pool size.
+	DefaultHeadroom = 20
+
+	execPrefix = "exec:"
+)
+
+type (
+	// Workload describes the expected contents of a pool.
+	Workload struct {
+		Objects        uint64 `json:"objects"`
+		ObjectSize     uint64 `json:"object_size"`
+		ValueSize      uint64 `json:"value_size,omitempty"`
+		DkeysPerObject uint64 `json:"dkeys_per_object,omitempty"`
+		Redundancy     string `json:"redundancy,omitempty"`
+	}
+
+	// Estimate describes the storage required to hold a workload. Metadata
+	// and small values are stored in the SCM (or MD-on-SSD metadata) tier
+	// and bulk data is stored in the NVMe tier.
+	Estimate struct {
+		Backend         string `json:"backend"`
+		MetadataBytes   uint64 `json:"metadata_bytes"`
+		SmallValueBytes uint64 `json:"small_value_bytes"`
+		DataBytes       uint64 `json:"data_bytes"`
+	}
+
+	// Backend calculates storage estimates for workloads.
+	Backend interface {
+		Name() string
+		Estimate(context.Context, *Workload) (*Estimate, error)
+	}
+)
+
+var (
+	backendsMutex sync.RWMutex
+	backends      = make(map[string]Backend)
+)
+
+// Validate checks that the workload describes a non-empty set of objects.
+func (w *Workload) Validate() error {
+	if w == nil {
+		return errors.New("nil workload")
+	}
+	if w.Objects == 0 {
+		return errors.New("number of objects must be greater than zero")
+	}
+	if w.ObjectSize == 0 {
+		return errors.New("object size must be greater than zero")
+	}
+	if w.ValueSize > w.ObjectSize {
+		return errors.Errorf("value size (%d) must not be greater than object size (%d)",
+			w.ValueSize, w.ObjectSize)
+	}
+
+	return nil
+}
+
+func divCeil(num, den uint64) uint64 {
+	if den == 0 {
+		return 0
+	}
+	return (num + den - 1) / den
+}
+
+// MetaTierBytes returns the number of bytes required in the metadata tier.
+func (e *Estimate) MetaTierBytes() uint64 {
+	if e == nil {
+		return 0
+	}
+	return e.MetadataBytes + e.SmallValueBytes
+}
+
+// TotalBytes returns the total number of bytes required across all tiers.
+func (e *Estimate) TotalBytes() uint64 {
+	if e == nil {
+		return 0
+	}
+	return e.MetaTierBytes() + e.DataBytes
+}
+
+// TierRatio returns the recommended percentages of pool storage to allocate
+// to the metadata and data tiers. The metadata tier is always allocated at
+// least 1%.
+func (e *Estimate) TierRatio() (meta, data uint64) {
+	total := e.TotalBytes()
+	if total == 0 {
+		return 0, 0
+	}
+
+	meta = divCeil(e.MetaTierBytes()*100, total)
+	if meta < 1 {
+		meta = 1
+	}
+	return meta, 100 - meta
+}
+
+// MemRatio returns the recommended percentage of the metadata tier to be held
+// in memory when running in MD-on-SSD mode. The percentage is enough to keep
+// all metadata resident, allowing small values to be evicted to SSD.
+func (e *Estimate) MemRatio() uint64 {
+	metaTier := e.MetaTierBytes()
+	if metaTier == 0 {
+		return 0
+	}
+
+	ratio := divCeil(e.MetadataBytes*100, metaTier)
+	if ratio < 1 {
+		ratio = 1
+	}
+	return ratio
+}
+
+// PoolSize returns the recommended total pool size, including the given
+// percentage of headroom for free space.
+func (e *Estimate) PoolSize(headroom uint64) uint64 {
+	return divCeil(e.TotalBytes()*(100+headroom), 100)
+}
+
+// Register makes a backend available by name. It panics if a backend of the
+// same name has already been registered.
+func Register(b Backend) {
+	backendsMutex.Lock()
+	defer backendsMutex.Unlock()
+
+	if b == nil {
+		panic("estimate: Register backend is nil")
+	}
+	if _, dup := backends[b.Name()]; dup {
+		panic("estimate: Register called twice for backend " + b.Name())
+	}
+	backends[b.Name()] = b
+}
+
+// Backends returns a sorted list of the names of the registered backends.
+func Backends() []string {
+	backendsMutex.RLock()
+	defer backendsMutex.RUnlock()
+
+	return namesLocked()
+}
+
+// Get returns the backend for the given specification, which is either the
+// name of a registered backend or "exec:<path>" to use an external program.
+// The default backend is returned if the specification is empty.
+func Get(spec string) (Backend, error) {
+	if spec == "" {
+		spec = DefaultBackend
+	}
+
+	if strings.HasPrefix(spec, execPrefix) {
+		return newExecBackend(strings.TrimPrefix(spec, execPrefix))
+	}
+
+	backendsMutex.RLock()
+	defer backendsMutex.RUnlock()
+
+	b, found := backends[spec]
+	if !found {
+		return nil, errors.Errorf("unknown estimate backend %q (available: %s, or %s<path>)",
+			spec, strings.Join(namesLocked(), ", "), execPrefix)
+	}
+
+	return b, nil
+}
+
+func namesLocked() []string {
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
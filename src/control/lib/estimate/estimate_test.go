@@ -0,0 +1,182 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package estimate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestEstimate_Workload_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		w      *Workload
+		expErr error
+	}{
+		"nil": {
+			expErr: errors.New("nil workload"),
+		},
+		"no objects": {
+			w:      &Workload{ObjectSize: 1},
+			expErr: errors.New("number of objects"),
+		},
+		"no object size": {
+			w:      &Workload{Objects: 1},
+			expErr: errors.New("object size"),
+		},
+		"value larger than object": {
+			w:      &Workload{Objects: 1, ObjectSize: 1024, ValueSize: 2048},
+			expErr: errors.New("value size"),
+		},
+		"valid": {
+			w: &Workload{Objects: 1, ObjectSize: 1024, ValueSize: 1024},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.w.Validate())
+		})
+	}
+}
+
+func TestEstimate_Estimate_Ratios(t *testing.T) {
+	for name, tc := range map[string]struct {
+		est         *Estimate
+		headroom    uint64
+		expMeta     uint64
+		expData     uint64
+		expMemRatio uint64
+		expTotal    uint64
+		expPoolSize uint64
+	}{
+		"nil": {},
+		"metadata only": {
+			est:         &Estimate{MetadataBytes: 100},
+			headroom:    DefaultHeadroom,
+			expMeta:     100,
+			expMemRatio: 100,
+			expTotal:    100,
+			expPoolSize: 120,
+		},
+		"mixed": {
+			est: &Estimate{
+				MetadataBytes:   250,
+				SmallValueBytes: 750,
+				DataBytes:       9000,
+			},
+			headroom:    DefaultHeadroom,
+			expMeta:     10,
+			expData:     90,
+			expMemRatio: 25,
+			expTotal:    10000,
+			expPoolSize: 12000,
+		},
+		"tiny metadata tier": {
+			est: &Estimate{
+				MetadataBytes: 1,
+				DataBytes:     1000000,
+			},
+			expMeta:     1,
+			expData:     99,
+			expMemRatio: 100,
+			expTotal:    1000001,
+			expPoolSize: 1000001,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			meta, data := tc.est.TierRatio()
+			test.AssertEqual(t, tc.expMeta, meta, "unexpected metadata tier ratio")
+			test.AssertEqual(t, tc.expData, data, "unexpected data tier ratio")
+			test.AssertEqual(t, tc.expMemRatio, tc.est.MemRatio(), "unexpected mem ratio")
+			test.AssertEqual(t, tc.expTotal, tc.est.TotalBytes(), "unexpected total")
+			test.AssertEqual(t, tc.expPoolSize, tc.est.PoolSize(tc.headroom), "unexpected pool size")
+		})
+	}
+}
+
+func TestEstimate_Get(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	script := filepath.Join(tmpDir, "estimator")
+	if err := os.WriteFile(script, []byte(`#!/bin/sh
+cat > /dev/null
+echo '{"metadata_bytes": 1, "small_value_bytes": 2, "data_bytes": 3}'
+`), 0755); err != nil {
+		t.Fatal(err)
+	}
+	failScript := filepath.Join(tmpDir, "failing")
+	if err := os.WriteFile(failScript, []byte(`#!/bin/sh
+echo "model unavailable" >&2
+exit 1
+`), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	workload := &Workload{Objects: 1, ObjectSize: 1}
+
+	for name, tc := range map[string]struct {
+		spec       string
+		expName    string
+		expGetErr  error
+		expEst     *Estimate
+		expEstErr  error
+		skipResult bool
+	}{
+		"default": {
+			expName:    DefaultBackend,
+			skipResult: true,
+		},
+		"unknown": {
+			spec:      "foo",
+			expGetErr: errors.New("unknown estimate backend \"foo\" (available: vos"),
+		},
+		"exec without path": {
+			spec:      "exec:",
+			expGetErr: errors.New("no program path"),
+		},
+		"exec": {
+			spec:    "exec:" + script,
+			expName: "exec:" + script,
+			expEst: &Estimate{
+				Backend:         "exec:" + script,
+				MetadataBytes:   1,
+				SmallValueBytes: 2,
+				DataBytes:       3,
+			},
+		},
+		"exec fails": {
+			spec:      "exec:" + failScript,
+			expName:   "exec:" + failScript,
+			expEstErr: errors.New("model unavailable"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b, err := Get(tc.spec)
+			test.CmpErr(t, tc.expGetErr, err)
+			if tc.expGetErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expName, b.Name(), "unexpected backend name")
+
+			est, err := b.Estimate(context.Background(), workload)
+			test.CmpErr(t, tc.expEstErr, err)
+			if tc.expEstErr != nil || tc.skipResult {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expEst, est); diff != "" {
+				t.Fatalf("unexpected estimate (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
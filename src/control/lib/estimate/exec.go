@@ -0,0 +1,69 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package estimate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// execBackend delegates estimation to an external program, which is passed
+// the JSON-encoded Workload on stdin and must write a JSON-encoded Estimate
+// to stdout.
+type execBackend struct {
+	path string
+}
+
+func newExecBackend(path string) (*execBackend, error) {
+	if path == "" {
+		return nil, errors.Errorf("no program path in %q backend", execPrefix+path)
+	}
+
+	return &execBackend{path: path}, nil
+}
+
+func (b *execBackend) Name() string {
+	return execPrefix + b.path
+}
+
+func (b *execBackend) Estimate(ctx context.Context, w *Workload) (*Estimate, error) {
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+
+	input, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, b.path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, errors.Wrapf(err, "%s: %s", b.path, msg)
+		}
+		return nil, errors.Wrap(err, b.path)
+	}
+
+	est := new(Estimate)
+	if err := json.Unmarshal(output, est); err != nil {
+		return nil, errors.Wrapf(err, "invalid estimate from %s", b.path)
+	}
+	if est.Backend == "" {
+		est.Backend = b.Name()
+	}
+
+	return est, nil
+}
@@ -0,0 +1,166 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package estimate
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Values smaller than the inline threshold are stored alongside the
+	// metadata; larger values are stored in NVMe blocks.
+	vosInlineThreshold = 4096
+	vosBlockSize       = 4096
+
+	// Approximate per-record overheads of the VOS trees.
+	vosObjectOverhead = 512
+	vosDkeyOverhead   = 256
+	vosAkeyOverhead   = 256
+	vosValueOverhead  = 64
+
+	vosMaxDefaultValueSize = 1 << 20
+)
+
+func init() {
+	Register(vosBackend{})
+}
+
+// redundancy describes the storage amplification of an object class.
+type redundancy struct {
+	replicas uint64
+	data     uint64
+	parity   uint64
+}
+
+// parseRedundancy parses the redundancy of a DAOS object class name, e.g.
+// "SX", "RP_3G1" or "EC_8P2GX". Group counts are ignored as they do not
+// affect the amount of storage used.
+func parseRedundancy(oclass string) (redundancy, error) {
+	name := strings.ToUpper(strings.TrimSpace(oclass))
+	noRed := redundancy{replicas: 1}
+
+	stripGroups := func(s string) string {
+		if i := strings.IndexByte(s, 'G'); i >= 0 {
+			return s[:i]
+		}
+		return s
+	}
+	badClass := errors.Errorf("unsupported object class %q", oclass)
+
+	switch {
+	case name == "", name == "NONE", name == "SX":
+		return noRed, nil
+	case strings.HasPrefix(name, "S"):
+		if _, err := strconv.ParseUint(name[1:], 10, 32); err != nil {
+			return redundancy{}, badClass
+		}
+		return noRed, nil
+	case strings.HasPrefix(name, "RP_"):
+		n, err := strconv.ParseUint(stripGroups(strings.TrimPrefix(name, "RP_")), 10, 32)
+		if err != nil || n == 0 {
+			return redundancy{}, badClass
+		}
+		return redundancy{replicas: n}, nil
+	case strings.HasPrefix(name, "EC_"):
+		parts := strings.SplitN(stripGroups(strings.TrimPrefix(name, "EC_")), "P", 2)
+		if len(parts) != 2 {
+			return redundancy{}, badClass
+		}
+		k, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil || k == 0 {
+			return redundancy{}, badClass
+		}
+		p, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil || p == 0 {
+			return redundancy{}, badClass
+		}
+		return redundancy{replicas: 1, data: k, parity: p}, nil
+	}
+
+	return redundancy{}, badClass
+}
+
+// apply scales a single-copy estimate by the redundancy. With erasure coding,
+// every shard holds a copy of the object metadata, small values are
+// replicated to the parity shards and bulk data is amplified by the ratio of
+// total to data cells.
+func (r redundancy) apply(est *Estimate) {
+	if r.data == 0 {
+		est.MetadataBytes *= r.replicas
+		est.SmallValueBytes *= r.replicas
+		est.DataBytes *= r.replicas
+		return
+	}
+
+	est.MetadataBytes *= r.data + r.parity
+	est.SmallValueBytes *= 1 + r.parity
+	est.DataBytes = divCeil(est.DataBytes*(r.data+r.parity), r.data)
+}
+
+// vosBackend estimates storage using a model of the VOS object layout, in
+// which each object is stored as a set of dkeys holding one akey per value.
+type vosBackend struct{}
+
+func (vosBackend) Name() string {
+	return DefaultBackend
+}
+
+func vosValueBytes(size uint64) (small, data uint64) {
+	if size < vosInlineThreshold {
+		return size, 0
+	}
+	return 0, divCeil(size, vosBlockSize) * vosBlockSize
+}
+
+func (b vosBackend) Estimate(_ context.Context, w *Workload) (*Estimate, error) {
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+
+	red, err := parseRedundancy(w.Redundancy)
+	if err != nil {
+		return nil, err
+	}
+
+	valSize := w.ValueSize
+	if valSize == 0 {
+		valSize = min(w.ObjectSize, vosMaxDefaultValueSize)
+	}
+	fullValues := w.ObjectSize / valSize
+	tailSize := w.ObjectSize % valSize
+	nrValues := fullValues
+	if tailSize > 0 {
+		nrValues++
+	}
+
+	dkeys := w.DkeysPerObject
+	if dkeys == 0 || dkeys > nrValues {
+		dkeys = nrValues
+	}
+
+	fullSmall, fullData := vosValueBytes(valSize)
+	tailSmall, tailData := vosValueBytes(tailSize)
+
+	objMeta := vosObjectOverhead + dkeys*vosDkeyOverhead +
+		nrValues*(vosAkeyOverhead+vosValueOverhead)
+	objSmall := fullValues*fullSmall + tailSmall
+	objData := fullValues*fullData + tailData
+
+	est := &Estimate{
+		Backend:         b.Name(),
+		MetadataBytes:   w.Objects * objMeta,
+		SmallValueBytes: w.Objects * objSmall,
+		DataBytes:       w.Objects * objData,
+	}
+	red.apply(est)
+
+	return est, nil
+}
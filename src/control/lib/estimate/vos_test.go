@@ -0,0 +1,159 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package estimate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestEstimate_parseRedundancy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		oclass string
+		expRed redundancy
+		expErr error
+	}{
+		"empty": {
+			expRed: redundancy{replicas: 1},
+		},
+		"none": {
+			oclass: "none",
+			expRed: redundancy{replicas: 1},
+		},
+		"SX": {
+			oclass: "SX",
+			expRed: redundancy{replicas: 1},
+		},
+		"S1": {
+			oclass: "s1",
+			expRed: redundancy{replicas: 1},
+		},
+		"replicated": {
+			oclass: "RP_3G1",
+			expRed: redundancy{replicas: 3},
+		},
+		"replicated no groups": {
+			oclass: "rp_2",
+			expRed: redundancy{replicas: 2},
+		},
+		"erasure coded": {
+			oclass: "EC_8P2GX",
+			expRed: redundancy{replicas: 1, data: 8, parity: 2},
+		},
+		"bad shard count": {
+			oclass: "SY",
+			expErr: errors.New("unsupported object class"),
+		},
+		"zero replicas": {
+			oclass: "RP_0",
+			expErr: errors.New("unsupported object class"),
+		},
+		"missing parity": {
+			oclass: "EC_8G1",
+			expErr: errors.New("unsupported object class"),
+		},
+		"unknown": {
+			oclass: "foo",
+			expErr: errors.New("unsupported object class"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			red, err := parseRedundancy(tc.oclass)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expRed, red, "unexpected redundancy")
+		})
+	}
+}
+
+func TestEstimate_vosBackend(t *testing.T) {
+	const mib = 1 << 20
+
+	for name, tc := range map[string]struct {
+		w      *Workload
+		expEst *Estimate
+		expErr error
+	}{
+		"invalid workload": {
+			w:      &Workload{},
+			expErr: errors.New("number of objects"),
+		},
+		"bad redundancy": {
+			w:      &Workload{Objects: 1, ObjectSize: 1, Redundancy: "foo"},
+			expErr: errors.New("unsupported object class"),
+		},
+		"small objects": {
+			w: &Workload{Objects: 1000, ObjectSize: 1024},
+			expEst: &Estimate{
+				Backend:         DefaultBackend,
+				MetadataBytes:   1000 * (512 + 256 + 256 + 64),
+				SmallValueBytes: 1000 * 1024,
+			},
+		},
+		"large objects with default value size": {
+			w: &Workload{Objects: 10, ObjectSize: 4*mib + 100},
+			expEst: &Estimate{
+				Backend:         DefaultBackend,
+				MetadataBytes:   10 * (512 + 5*256 + 5*(256+64)),
+				SmallValueBytes: 10 * 100,
+				DataBytes:       10 * 4 * mib,
+			},
+		},
+		"dkeys per object": {
+			w: &Workload{Objects: 10, ObjectSize: mib, ValueSize: 64 * 1024, DkeysPerObject: 2},
+			expEst: &Estimate{
+				Backend:       DefaultBackend,
+				MetadataBytes: 10 * (512 + 2*256 + 16*(256+64)),
+				DataBytes:     10 * mib,
+			},
+		},
+		"unaligned data values": {
+			w: &Workload{Objects: 1, ObjectSize: 5000},
+			expEst: &Estimate{
+				Backend:       DefaultBackend,
+				MetadataBytes: 512 + 256 + 256 + 64,
+				DataBytes:     8192,
+			},
+		},
+		"replicated": {
+			w: &Workload{Objects: 1, ObjectSize: 8192, ValueSize: 4096, Redundancy: "RP_3"},
+			expEst: &Estimate{
+				Backend:       DefaultBackend,
+				MetadataBytes: 3 * (512 + 2*256 + 2*(256+64)),
+				DataBytes:     3 * 8192,
+			},
+		},
+		"erasure coded": {
+			w: &Workload{Objects: 1, ObjectSize: 16384 + 100, ValueSize: 16384, Redundancy: "EC_4P2G1"},
+			expEst: &Estimate{
+				Backend:         DefaultBackend,
+				MetadataBytes:   6 * (512 + 2*256 + 2*(256+64)),
+				SmallValueBytes: 3 * 100,
+				DataBytes:       6 * 16384 / 4,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			est, err := vosBackend{}.Estimate(context.Background(), tc.w)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expEst, est); diff != "" {
+				t.Fatalf("unexpected estimate (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package hardware
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+)
+
+type (
+	// AcceleratorTopologyProvider is an interface for acquiring the locations of the
+	// accelerators in the system relative to its network interfaces.
+	AcceleratorTopologyProvider interface {
+		GetAcceleratorTopology(context.Context) (*AcceleratorTopology, error)
+	}
+
+	// AcceleratorVendor identifies the vendor of an accelerator.
+	AcceleratorVendor string
+
+	// PCIPath is the chain of elements in the PCI hierarchy from the host bridge to a
+	// device, e.g. ["pci0000:00", "0000:00:01.0", "0000:01:00.0"].
+	PCIPath []string
+
+	// AffinityDevice is a device whose location in the PCI hierarchy is known.
+	AffinityDevice struct {
+		Name     string     `json:"name"`
+		PCIAddr  PCIAddress `json:"pci_address"`
+		NUMANode uint       `json:"numa_node"`
+		PCIPath  PCIPath    `json:"pci_path"`
+	}
+
+	// Accelerator represents a GPU or other accelerator device.
+	Accelerator struct {
+		AffinityDevice
+		Vendor AcceleratorVendor `json:"vendor"`
+	}
+
+	// AcceleratorTopology describes the locations of the accelerators in the system
+	// relative to its network interfaces.
+	AcceleratorTopology struct {
+		Accelerators  []*Accelerator    `json:"accelerators"`
+		NetInterfaces []*AffinityDevice `json:"net_interfaces"`
+	}
+)
+
+const (
+	// AcceleratorVendorNVIDIA indicates an NVIDIA accelerator.
+	AcceleratorVendorNVIDIA AcceleratorVendor = "NVIDIA"
+	// AcceleratorVendorAMD indicates an AMD accelerator.
+	AcceleratorVendorAMD AcceleratorVendor = "AMD"
+)
+
+// PCIAffinity indicates how closely two devices are connected. Greater values
+// indicate a closer connection.
+type PCIAffinity uint
+
+const (
+	// PCIAffinityUnknown indicates that the connection between the devices is unknown.
+	PCIAffinityUnknown PCIAffinity = iota
+	// PCIAffinitySystem indicates that the devices are on different NUMA nodes.
+	PCIAffinitySystem
+	// PCIAffinityNUMA indicates that the devices are on the same NUMA node, but are
+	// connected to different host bridges.
+	PCIAffinityNUMA
+	// PCIAffinityHostBridge indicates that the devices are connected through a host
+	// bridge.
+	PCIAffinityHostBridge
+	// PCIAffinityBridge indicates that the devices are connected through PCI bridges
+	// or switches, without traversing a host bridge.
+	PCIAffinityBridge
+)
+
+func (a PCIAffinity) String() string {
+	switch a {
+	case PCIAffinitySystem:
+		return "system"
+	case PCIAffinityNUMA:
+		return "numa"
+	case PCIAffinityHostBridge:
+		return "host-bridge"
+	case PCIAffinityBridge:
+		return "pci-bridge"
+	}
+
+	return "unknown"
+}
+
+// MarshalJSON outputs the affinity as a string.
+func (a PCIAffinity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// commonLen returns the number of leading elements shared by the two paths.
+func (p PCIPath) commonLen(other PCIPath) int {
+	i := 0
+	for i < len(p) && i < len(other) && p[i] == other[i] {
+		i++
+	}
+	return i
+}
+
+// Affinity returns the affinity between two devices, based on their locations in
+// the PCI hierarchy. If the location of either device is unknown, only their NUMA
+// nodes are compared.
+func (d *AffinityDevice) Affinity(other *AffinityDevice) PCIAffinity {
+	if d == nil || other == nil {
+		return PCIAffinityUnknown
+	}
+
+	if len(d.PCIPath) > 0 && len(other.PCIPath) > 0 {
+		switch d.PCIPath.commonLen(other.PCIPath) {
+		case 0:
+		case 1:
+			return PCIAffinityHostBridge
+		default:
+			return PCIAffinityBridge
+		}
+	}
+
+	if d.NUMANode == other.NUMANode {
+		return PCIAffinityNUMA
+	}
+	return PCIAffinitySystem
+}
+
+// NetInterfaceAffinity returns the closest affinity of each network interface to any
+// accelerator, keyed by interface name.
+func (t *AcceleratorTopology) NetInterfaceAffinity() map[string]PCIAffinity {
+	if t == nil {
+		return nil
+	}
+
+	affinity := make(map[string]PCIAffinity)
+	for _, iface := range t.NetInterfaces {
+		affinity[iface.Name] = PCIAffinityUnknown
+		for _, accel := range t.Accelerators {
+			if a := iface.Affinity(&accel.AffinityDevice); a > affinity[iface.Name] {
+				affinity[iface.Name] = a
+			}
+		}
+	}
+	return affinity
+}
+
+// AffineNetInterfaces returns the names of the network interfaces on each NUMA node
+// with the closest affinity to an accelerator. Interfaces are only included if they
+// share at least a host bridge with an accelerator.
+func (t *AcceleratorTopology) AffineNetInterfaces() []string {
+	if t == nil {
+		return nil
+	}
+
+	ifaceAffinity := t.NetInterfaceAffinity()
+	numaAffinity := make(map[uint]PCIAffinity)
+	for _, iface := range t.NetInterfaces {
+		if a := ifaceAffinity[iface.Name]; a > numaAffinity[iface.NUMANode] {
+			numaAffinity[iface.NUMANode] = a
+		}
+	}
+
+	var names []string
+	for _, iface := range t.NetInterfaces {
+		a := ifaceAffinity[iface.Name]
+		if a >= PCIAffinityHostBridge && a == numaAffinity[iface.NUMANode] {
+			names = append(names, iface.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,126 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package hardware
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func mockAffinityDevice(name string, numa uint, path ...string) *AffinityDevice {
+	return &AffinityDevice{
+		Name:     name,
+		NUMANode: numa,
+		PCIPath:  path,
+	}
+}
+
+func mockAccelerator(name string, numa uint, path ...string) *Accelerator {
+	return &Accelerator{
+		AffinityDevice: *mockAffinityDevice(name, numa, path...),
+		Vendor:         AcceleratorVendorNVIDIA,
+	}
+}
+
+func TestHardware_AffinityDevice_Affinity(t *testing.T) {
+	for name, tc := range map[string]struct {
+		dev         *AffinityDevice
+		other       *AffinityDevice
+		expAffinity PCIAffinity
+	}{
+		"nil": {
+			other:       mockAffinityDevice("ib0", 0),
+			expAffinity: PCIAffinityUnknown,
+		},
+		"same switch": {
+			dev:         mockAffinityDevice("gpu0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0", "0000:02:00.0"),
+			other:       mockAffinityDevice("ib0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0", "0000:02:01.0"),
+			expAffinity: PCIAffinityBridge,
+		},
+		"same root port": {
+			dev:         mockAffinityDevice("gpu0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0"),
+			other:       mockAffinityDevice("ib0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.1"),
+			expAffinity: PCIAffinityBridge,
+		},
+		"same host bridge": {
+			dev:         mockAffinityDevice("gpu0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0"),
+			other:       mockAffinityDevice("ib0", 0, "pci0000:00", "0000:00:02.0", "0000:02:00.0"),
+			expAffinity: PCIAffinityHostBridge,
+		},
+		"same NUMA node": {
+			dev:         mockAffinityDevice("gpu0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0"),
+			other:       mockAffinityDevice("ib0", 0, "pci0000:20", "0000:20:01.0", "0000:21:00.0"),
+			expAffinity: PCIAffinityNUMA,
+		},
+		"different NUMA nodes": {
+			dev:         mockAffinityDevice("gpu0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0"),
+			other:       mockAffinityDevice("ib0", 1, "pci0000:80", "0000:80:01.0", "0000:81:00.0"),
+			expAffinity: PCIAffinitySystem,
+		},
+		"unknown path; same NUMA node": {
+			dev:         mockAffinityDevice("gpu0", 1, "pci0000:80", "0000:80:01.0", "0000:81:00.0"),
+			other:       mockAffinityDevice("ib0", 1),
+			expAffinity: PCIAffinityNUMA,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expAffinity, tc.dev.Affinity(tc.other), "unexpected affinity")
+		})
+	}
+}
+
+func TestHardware_AcceleratorTopology_AffineNetInterfaces(t *testing.T) {
+	for name, tc := range map[string]struct {
+		topo      *AcceleratorTopology
+		expIfaces []string
+	}{
+		"nil": {},
+		"no accelerators": {
+			topo: &AcceleratorTopology{
+				NetInterfaces: []*AffinityDevice{
+					mockAffinityDevice("ib0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0"),
+				},
+			},
+		},
+		"closest interface on each NUMA node": {
+			topo: &AcceleratorTopology{
+				Accelerators: []*Accelerator{
+					mockAccelerator("gpu0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0", "0000:02:00.0"),
+					mockAccelerator("gpu1", 1, "pci0000:80", "0000:80:01.0", "0000:81:00.0"),
+				},
+				NetInterfaces: []*AffinityDevice{
+					mockAffinityDevice("ib0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0", "0000:02:01.0"),
+					mockAffinityDevice("ib1", 0, "pci0000:00", "0000:00:02.0", "0000:03:00.0"),
+					mockAffinityDevice("ib2", 1, "pci0000:80", "0000:80:02.0", "0000:82:00.0"),
+					mockAffinityDevice("ib3", 1, "pci0000:80", "0000:80:03.0", "0000:83:00.0"),
+				},
+			},
+			expIfaces: []string{"ib0", "ib2", "ib3"},
+		},
+		"no interface shares a host bridge": {
+			topo: &AcceleratorTopology{
+				Accelerators: []*Accelerator{
+					mockAccelerator("gpu0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0"),
+				},
+				NetInterfaces: []*AffinityDevice{
+					mockAffinityDevice("ib0", 0, "pci0000:20", "0000:20:01.0", "0000:21:00.0"),
+					mockAffinityDevice("ib1", 1, "pci0000:80", "0000:80:01.0", "0000:81:00.0"),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotIfaces := tc.topo.AffineNetInterfaces()
+			if diff := cmp.Diff(tc.expIfaces, gotIfaces); diff != "" {
+				t.Fatalf("unexpected interfaces (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
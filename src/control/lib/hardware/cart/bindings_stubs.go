@@ -0,0 +1,18 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package cart
+
+import (
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func getProtocolInfo(log logging.Logger, provider string) ([]*crtFabricDevice, error) {
+	return nil, hardware.ErrUnsupportedPlatform
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -38,6 +39,16 @@ func DefaultProcessNUMAProvider(log logging.Logger) hardware.ProcessNUMAProvider
 	return hwloc.NewProvider(log)
 }
 
+// DefaultNUMADistanceProvider gets the default provider for NUMA node distances.
+func DefaultNUMADistanceProvider(log logging.Logger) hardware.NUMADistanceProvider {
+	return hwloc.NewProvider(log)
+}
+
+// DefaultAcceleratorTopologyProvider gets the default provider for the accelerator topology.
+func DefaultAcceleratorTopologyProvider(log logging.Logger) hardware.AcceleratorTopologyProvider {
+	return sysfs.NewProvider(log)
+}
+
 // DefaultIOMMUDetector gets the default provider for the IOMMU detector.
 func DefaultIOMMUDetector(log logging.Logger) hardware.IOMMUDetector {
 	return sysfs.NewProvider(log)
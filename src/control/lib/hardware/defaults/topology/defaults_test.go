@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -60,3 +61,33 @@ func TestTopology_DefaultProcessNUMAProvider(t *testing.T) {
 		t.Fatalf("(-want, +got)\n%s\n", diff)
 	}
 }
+
+func TestTopology_DefaultNUMADistanceProvider(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	expResult := hwloc.NewProvider(log)
+
+	result := topology.DefaultNUMADistanceProvider(log)
+
+	if diff := cmp.Diff(expResult, result,
+		cmpopts.IgnoreUnexported(hwloc.Provider{}),
+	); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
+
+func TestTopology_DefaultAcceleratorTopologyProvider(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	expResult := sysfs.NewProvider(log)
+
+	result := topology.DefaultAcceleratorTopologyProvider(log)
+
+	if diff := cmp.Diff(expResult, result,
+		cmpopts.IgnoreUnexported(sysfs.Provider{}),
+	); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package hardware
 
 import (
 	"fmt"
+	"runtime"
 
 	"github.com/pkg/errors"
 )
@@ -15,6 +17,16 @@ import (
 // ErrNoNUMANodes indicates that the system can't detect any NUMA nodes.
 var ErrNoNUMANodes = errors.New("no NUMA nodes detected")
 
+// ErrUnsupportedPlatform indicates that local hardware discovery is not
+// available on the platform this binary was built for.
+var ErrUnsupportedPlatform = errors.Errorf("hardware discovery not supported on %s", runtime.GOOS)
+
+// IsUnsupportedPlatform returns true if the supplied error indicates that
+// local hardware discovery is not supported on this platform.
+func IsUnsupportedPlatform(err error) bool {
+	return errors.Cause(err) == ErrUnsupportedPlatform
+}
+
 // IsUnsupportedFabric returns true if the supplied error is
 // an instance of errUnsupportedFabric.
 func IsUnsupportedFabric(err error) bool {
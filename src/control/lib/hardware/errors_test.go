@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -53,3 +54,27 @@ func TestHardware_IsProviderNotOnDevice(t *testing.T) {
 		})
 	}
 }
+
+func TestHardware_IsUnsupportedPlatform(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err       error
+		expResult bool
+	}{
+		"nil": {},
+		"true": {
+			err:       ErrUnsupportedPlatform,
+			expResult: true,
+		},
+		"wrapped": {
+			err:       errors.Wrap(ErrUnsupportedPlatform, "scan failed"),
+			expResult: true,
+		},
+		"false": {
+			err: errors.New("something else"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expResult, IsUnsupportedPlatform(tc.err), "")
+		})
+	}
+}
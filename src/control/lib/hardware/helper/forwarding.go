@@ -0,0 +1,72 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package helper
+
+import (
+	"context"
+
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/pbin"
+)
+
+// Forwarder forwards hardware discovery requests to daos_agent_helper.
+type Forwarder struct {
+	pbin.Forwarder
+}
+
+// NewForwarder creates a new Forwarder.
+func NewForwarder(log logging.Logger) *Forwarder {
+	pf := pbin.NewForwarder(log, pbin.DaosAgentHelperName)
+
+	return &Forwarder{
+		Forwarder: *pf,
+	}
+}
+
+// Scan forwards a fabric scan request.
+func (f *Forwarder) Scan(_ context.Context, providers ...string) (*hardware.FabricInterfaceSet, error) {
+	req := FabricScanRequest{
+		Providers: providers,
+	}
+	req.Forwarded = true
+
+	res := new(FabricScanResponse)
+	if err := f.SendReq(FabricScanMethod, req, res); err != nil {
+		return nil, err
+	}
+
+	return FabricInterfaceSetFrom(res.Interfaces), nil
+}
+
+// GetNUMADistances forwards a NUMA distances request.
+func (f *Forwarder) GetNUMADistances(_ context.Context) (hardware.NUMADistances, error) {
+	req := NUMADistancesRequest{}
+	req.Forwarded = true
+
+	res := new(NUMADistancesResponse)
+	if err := f.SendReq(NUMADistancesMethod, req, res); err != nil {
+		return nil, err
+	}
+
+	return res.Distances, nil
+}
+
+// GetNUMANodeIDForPID forwards a request for the NUMA node of a process.
+func (f *Forwarder) GetNUMANodeIDForPID(_ context.Context, pid int32) (uint, error) {
+	req := ProcessNUMANodeRequest{
+		PID: pid,
+	}
+	req.Forwarded = true
+
+	res := new(ProcessNUMANodeResponse)
+	if err := f.SendReq(ProcessNUMANodeMethod, req, res); err != nil {
+		return 0, err
+	}
+
+	return res.NUMANode, nil
+}
@@ -0,0 +1,109 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package helper provides the requests that daos_agent forwards to the
+// daos_agent_helper privileged helper, so that hardware discovery that needs
+// elevated capabilities does not need to run in the agent process.
+package helper
+
+import (
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/pbin"
+)
+
+// Methods supported by daos_agent_helper.
+const (
+	FabricScanMethod      = "FabricScan"
+	NUMADistancesMethod   = "NUMADistances"
+	ProcessNUMANodeMethod = "ProcessNUMANode"
+)
+
+type (
+	// FabricInterface is the serializable form of a hardware.FabricInterface.
+	FabricInterface struct {
+		Name          string                     `json:"name"`
+		OSName        string                     `json:"os_name"`
+		NetInterfaces []string                   `json:"net_interfaces"`
+		Providers     []*hardware.FabricProvider `json:"providers"`
+		DeviceClass   hardware.NetDevClass       `json:"device_class"`
+		NUMANode      uint                       `json:"numa_node"`
+	}
+
+	// FabricScanRequest is a request to scan the fabric interfaces
+	// supporting the given providers.
+	FabricScanRequest struct {
+		pbin.ForwardableRequest
+		Providers []string
+	}
+
+	// FabricScanResponse contains the results of a fabric scan.
+	FabricScanResponse struct {
+		Interfaces []*FabricInterface
+	}
+
+	// NUMADistancesRequest is a request for the relative distances between
+	// the NUMA nodes.
+	NUMADistancesRequest struct {
+		pbin.ForwardableRequest
+	}
+
+	// NUMADistancesResponse contains the relative NUMA node distances.
+	NUMADistancesResponse struct {
+		Distances hardware.NUMADistances
+	}
+
+	// ProcessNUMANodeRequest is a request for the NUMA node that a process
+	// is bound to.
+	ProcessNUMANodeRequest struct {
+		pbin.ForwardableRequest
+		PID int32
+	}
+
+	// ProcessNUMANodeResponse contains the NUMA node of a process.
+	ProcessNUMANodeResponse struct {
+		NUMANode uint
+	}
+)
+
+// FabricInterfacesFromSet converts a set of fabric interfaces to their
+// serializable form, ordered by name.
+func FabricInterfacesFromSet(fis *hardware.FabricInterfaceSet) ([]*FabricInterface, error) {
+	var ifaces []*FabricInterface
+	for _, name := range fis.Names() {
+		fi, err := fis.GetInterface(name)
+		if err != nil {
+			return nil, err
+		}
+		ifaces = append(ifaces, &FabricInterface{
+			Name:          fi.Name,
+			OSName:        fi.OSName,
+			NetInterfaces: fi.NetInterfaces.ToSlice(),
+			Providers:     fi.Providers.ToSlice(),
+			DeviceClass:   fi.DeviceClass,
+			NUMANode:      fi.NUMANode,
+		})
+	}
+
+	return ifaces, nil
+}
+
+// FabricInterfaceSetFrom converts serialized fabric interfaces back into a set.
+func FabricInterfaceSetFrom(ifaces []*FabricInterface) *hardware.FabricInterfaceSet {
+	fis := hardware.NewFabricInterfaceSet()
+	for _, iface := range ifaces {
+		fis.Update(&hardware.FabricInterface{
+			Name:          iface.Name,
+			OSName:        iface.OSName,
+			NetInterfaces: common.NewStringSet(iface.NetInterfaces...),
+			Providers:     hardware.NewFabricProviderSet(iface.Providers...),
+			DeviceClass:   iface.DeviceClass,
+			NUMANode:      iface.NUMANode,
+		})
+	}
+
+	return fis
+}
@@ -0,0 +1,85 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package helper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+)
+
+func TestHelper_FabricInterfaces(t *testing.T) {
+	fis := hardware.NewFabricInterfaceSet(
+		&hardware.FabricInterface{
+			Name:          "mlx5_0",
+			OSName:        "ib0",
+			NetInterfaces: common.NewStringSet("ib0"),
+			Providers: hardware.NewFabricProviderSet(
+				&hardware.FabricProvider{Name: "ofi+verbs", Priority: 0},
+				&hardware.FabricProvider{Name: "ucx+rc_v", Priority: 1},
+			),
+			DeviceClass: hardware.Infiniband,
+			NUMANode:    1,
+		},
+		&hardware.FabricInterface{
+			Name:          "eth0",
+			OSName:        "eth0",
+			NetInterfaces: common.NewStringSet("eth0"),
+			Providers: hardware.NewFabricProviderSet(
+				&hardware.FabricProvider{Name: "ofi+tcp"},
+			),
+			DeviceClass: hardware.Ether,
+		},
+	)
+
+	ifaces, err := FabricInterfacesFromSet(fis)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expIfaces := []*FabricInterface{
+		{
+			Name:          "eth0",
+			OSName:        "eth0",
+			NetInterfaces: []string{"eth0"},
+			Providers:     []*hardware.FabricProvider{{Name: "ofi+tcp"}},
+			DeviceClass:   hardware.Ether,
+		},
+		{
+			Name:          "mlx5_0",
+			OSName:        "ib0",
+			NetInterfaces: []string{"ib0"},
+			Providers: []*hardware.FabricProvider{
+				{Name: "ofi+verbs", Priority: 0},
+				{Name: "ucx+rc_v", Priority: 1},
+			},
+			DeviceClass: hardware.Infiniband,
+			NUMANode:    1,
+		},
+	}
+	if diff := cmp.Diff(expIfaces, ifaces); diff != "" {
+		t.Fatalf("unexpected interfaces (-want, +got)\n%s\n", diff)
+	}
+
+	// The set must survive the trip through the helper's JSON payload.
+	buf, err := json.Marshal(&FabricScanResponse{Interfaces: ifaces})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp FabricScanResponse
+	if err := json.Unmarshal(buf, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(fis.String(), FabricInterfaceSetFrom(resp.Interfaces).String()); diff != "" {
+		t.Fatalf("unexpected set (-want, +got)\n%s\n", diff)
+	}
+}
@@ -1,8 +1,11 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
+//go:build linux
+// +build linux
 
 package hwloc
 
@@ -96,6 +99,40 @@ const char * node_get_subtype(hwloc_obj_t node)
 {
 	return node->subtype;
 }
+
+// Copies the NUMA node latency matrix into the supplied arrays, which must have room for
+// max_nodes entries and max_nodes^2 values respectively. Returns the number of NUMA nodes in
+// the matrix, or -1 if it is unavailable.
+int topo_get_numa_distances(hwloc_topology_t topology, unsigned *os_indexes,
+			    hwloc_uint64_t *values, unsigned max_nodes)
+{
+	struct hwloc_distances_s *dist;
+	unsigned nr = 1;
+	unsigned i;
+	int rc;
+
+	rc = hwloc_distances_get_by_type(topology, HWLOC_OBJ_NUMANODE, &nr, &dist,
+					 HWLOC_DISTANCES_KIND_MEANS_LATENCY, 0);
+	if (rc != 0 || nr == 0) {
+		return -1;
+	}
+
+	if (dist->nbobjs > max_nodes) {
+		hwloc_distances_release(topology, dist);
+		return -1;
+	}
+
+	for (i = 0; i < dist->nbobjs; i++) {
+		os_indexes[i] = dist->objs[i]->os_index;
+	}
+	for (i = 0; i < dist->nbobjs * dist->nbobjs; i++) {
+		values[i] = dist->values[i];
+	}
+
+	rc = dist->nbobjs;
+	hwloc_distances_release(topology, dist);
+	return rc;
+}
 #else
 int topo_setFlags(hwloc_topology_t topology)
 {
@@ -126,6 +163,12 @@ const char * node_get_subtype(hwloc_obj_t node)
 {
 	return "";
 }
+
+int topo_get_numa_distances(hwloc_topology_t topology, unsigned *os_indexes,
+			    hwloc_uint64_t *values, unsigned max_nodes)
+{
+	return -1;
+}
 #endif
 */
 import "C"
@@ -238,6 +281,37 @@ func (t *topology) getNumObjByType(objType int) uint {
 	return uint(C.hwloc_get_nbobjs_by_type(t.cTopology, C.hwloc_obj_type_t(objType)))
 }
 
+// getNUMADistances fetches the NUMA node latency matrix, keyed by NUMA node OS index.
+func (t *topology) getNUMADistances() (hardware.NUMADistances, error) {
+	maxNodes := t.getNumObjByType(objTypeNUMANode)
+	if maxNodes == 0 {
+		return nil, hardware.ErrNoNUMANodes
+	}
+
+	osIndexes := make([]C.uint, maxNodes)
+	values := make([]C.hwloc_uint64_t, maxNodes*maxNodes)
+
+	t.RLock()
+	defer t.RUnlock()
+
+	numNodes := int(C.topo_get_numa_distances(t.cTopology, &osIndexes[0], &values[0],
+		C.uint(maxNodes)))
+	if numNodes < 0 {
+		return nil, errors.New("hwloc NUMA distances not available")
+	}
+
+	dists := make(hardware.NUMADistances)
+	for i := 0; i < numNodes; i++ {
+		from := uint(osIndexes[i])
+		dists[from] = make(map[uint]uint64)
+		for j := 0; j < numNodes; j++ {
+			dists[from][uint(osIndexes[j])] = uint64(values[i*numNodes+j])
+		}
+	}
+
+	return dists, nil
+}
+
 const (
 	objTypeOSDevice  = C.HWLOC_OBJ_OS_DEVICE
 	objTypeBridge    = C.HWLOC_OBJ_BRIDGE
@@ -1,8 +1,11 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
+//go:build linux
+// +build linux
 
 package hwloc
 
@@ -455,6 +458,37 @@ func (p *Provider) getNUMANodeIDForPIDAsync(ctx context.Context, pid int32, ch c
 	ch <- numaResult{numaNode: node}
 }
 
+type numaDistancesResult struct {
+	distances hardware.NUMADistances
+	err       error
+}
+
+// GetNUMADistances fetches the relative distances between the NUMA nodes in the system, as
+// reported by the hwloc NUMA latency matrix.
+func (p *Provider) GetNUMADistances(ctx context.Context) (hardware.NUMADistances, error) {
+	ch := make(chan numaDistancesResult)
+	go p.getNUMADistancesAsync(ctx, ch)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-ch:
+		return result.distances, result.err
+	}
+}
+
+func (p *Provider) getNUMADistancesAsync(ctx context.Context, ch chan numaDistancesResult) {
+	topo, cleanupTopo, err := p.getRawTopology(ctx)
+	if err != nil {
+		ch <- numaDistancesResult{err: errors.Wrap(err, "initializing topology")}
+		return
+	}
+	defer cleanupTopo()
+
+	dists, err := topo.getNUMADistances()
+	ch <- numaDistancesResult{distances: dists, err: err}
+}
+
 func (p *Provider) findNUMANodeWithCPUSet(topo *topology, cpuSet *cpuSet) (uint, error) {
 	nodeSet, cleanupNodeSet, err := cpuSet.toNodeSet()
 	if err != nil {
@@ -0,0 +1,53 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package hwloc
+
+import (
+	"context"
+
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// CacheContext returns the parent context unchanged, as there is no hwloc
+// topology to cache on this platform.
+func CacheContext(parent context.Context, log logging.Logger) (context.Context, error) {
+	return parent, nil
+}
+
+// Cleanup is a no-op on this platform.
+func Cleanup(ctx context.Context) {}
+
+// NewProvider returns a new hwloc Provider.
+func NewProvider(log logging.Logger) *Provider {
+	return &Provider{
+		log: log,
+	}
+}
+
+// Provider is a stub hwloc provider for platforms without hwloc support.
+type Provider struct {
+	log logging.Logger
+}
+
+// GetTopology returns an error, as hwloc is not supported on this platform.
+func (p *Provider) GetTopology(ctx context.Context) (*hardware.Topology, error) {
+	return nil, hardware.ErrUnsupportedPlatform
+}
+
+// GetNUMANodeIDForPID returns an error, as hwloc is not supported on this
+// platform.
+func (p *Provider) GetNUMANodeIDForPID(ctx context.Context, pid int32) (uint, error) {
+	return 0, hardware.ErrUnsupportedPlatform
+}
+
+// GetNUMADistances returns an error, as hwloc is not supported on this platform.
+func (p *Provider) GetNUMADistances(ctx context.Context) (hardware.NUMADistances, error) {
+	return nil, hardware.ErrUnsupportedPlatform
+}
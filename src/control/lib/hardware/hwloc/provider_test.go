@@ -1,8 +1,11 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
+//go:build linux
+// +build linux
 
 package hwloc
 
@@ -937,3 +940,48 @@ func TestHwloc_Provider_findNUMANodeWithCPUSet(t *testing.T) {
 
 	}
 }
+
+func TestHwloc_Provider_GetNUMADistances(t *testing.T) {
+	_, filename, _, _ := runtime.Caller(0)
+	testdataDir := filepath.Join(filepath.Dir(filename), "testdata")
+
+	for name, tc := range map[string]struct {
+		hwlocXMLFile string
+		expResult    hardware.NUMADistances
+		expErr       error
+	}{
+		"no distances": {
+			hwlocXMLFile: filepath.Join(testdataDir, "no-numa-nodes.xml"),
+			expErr:       errors.New("NUMA"),
+		},
+		"boro-84": {
+			hwlocXMLFile: filepath.Join(testdataDir, "boro-84.xml"),
+			expResult: hardware.NUMADistances{
+				0: {0: 10, 1: 21},
+				1: {0: 21, 1: 10},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			_, err := os.Stat(tc.hwlocXMLFile)
+			test.AssertEqual(t, err, nil, "unable to read hwloc XML file")
+			os.Setenv("HWLOC_XMLFILE", tc.hwlocXMLFile)
+			defer os.Unsetenv("HWLOC_XMLFILE")
+
+			provider := NewProvider(log)
+
+			result, err := provider.GetNUMADistances(test.Context(t))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResult, result); diff != "" {
+				t.Errorf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
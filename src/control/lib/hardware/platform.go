@@ -0,0 +1,17 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package hardware
+
+import "runtime"
+
+// DiscoverySupported indicates whether local hardware discovery is available
+// on this platform. On other platforms the topology and fabric providers are
+// stubs that return ErrUnsupportedPlatform, and clients of the control plane
+// must rely on remote or statically-configured hardware details instead.
+func DiscoverySupported() bool {
+	return runtime.GOOS == "linux"
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,8 @@ package hardware
 import (
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
@@ -75,3 +78,89 @@ func PrintTopology(t *Topology, output io.Writer) error {
 
 	return ew.Err
 }
+
+// pciAffinityDescriptions describes the affinities shown by PrintAcceleratorTopology.
+var pciAffinityDescriptions = []struct {
+	affinity    PCIAffinity
+	description string
+}{
+	{PCIAffinityBridge, "connected through PCI bridges or switches only"},
+	{PCIAffinityHostBridge, "connected through a host bridge"},
+	{PCIAffinityNUMA, "same NUMA node, connected through different host bridges"},
+	{PCIAffinitySystem, "different NUMA nodes"},
+}
+
+// PrintAcceleratorTopology prints the accelerators and network interfaces on each
+// NUMA node, and the affinity between them, to the given writer.
+func PrintAcceleratorTopology(t *AcceleratorTopology, output io.Writer) error {
+	ew := txtfmt.NewErrWriter(output)
+
+	if t == nil || (len(t.Accelerators) == 0 && len(t.NetInterfaces) == 0) {
+		fmt.Fprintf(ew, "No accelerator topology information available\n")
+		return nil
+	}
+
+	numaNodes := make(map[uint]bool)
+	for _, accel := range t.Accelerators {
+		numaNodes[accel.NUMANode] = true
+	}
+	for _, iface := range t.NetInterfaces {
+		numaNodes[iface.NUMANode] = true
+	}
+	numaIDs := make([]uint, 0, len(numaNodes))
+	for id := range numaNodes {
+		numaIDs = append(numaIDs, id)
+	}
+	sort.Slice(numaIDs, func(i, j int) bool { return numaIDs[i] < numaIDs[j] })
+
+	for _, id := range numaIDs {
+		fmt.Fprintf(ew, "NUMA Node %d\n", id)
+		fmt.Fprintf(ew, "  Accelerators:\n")
+		for _, accel := range t.Accelerators {
+			if accel.NUMANode == id {
+				fmt.Fprintf(ew, "    %s %s (%s)\n", &accel.PCIAddr, accel.Name, accel.Vendor)
+			}
+		}
+		fmt.Fprintf(ew, "  Network interfaces:\n")
+		for _, iface := range t.NetInterfaces {
+			if iface.NUMANode == id {
+				fmt.Fprintf(ew, "    %s %s\n", &iface.PCIAddr, iface.Name)
+			}
+		}
+	}
+
+	if len(t.Accelerators) == 0 || len(t.NetInterfaces) == 0 {
+		return ew.Err
+	}
+
+	accelTitle := "Accelerator"
+	titles := []string{accelTitle}
+	for _, iface := range t.NetInterfaces {
+		titles = append(titles, iface.Name)
+	}
+
+	table := []txtfmt.TableRow{}
+	for _, accel := range t.Accelerators {
+		row := txtfmt.TableRow{accelTitle: accel.Name}
+		for _, iface := range t.NetInterfaces {
+			row[iface.Name] = accel.Affinity(iface).String()
+		}
+		table = append(table, row)
+	}
+
+	fmt.Fprintf(ew, "\nAccelerator Affinity\n\n")
+	tablePrint := txtfmt.NewTableFormatter(titles...)
+	tablePrint.InitWriter(ew)
+	tablePrint.Format(table)
+
+	fmt.Fprintln(ew)
+	for _, desc := range pciAffinityDescriptions {
+		fmt.Fprintf(ew, "  %-12s %s\n", desc.affinity.String()+":", desc.description)
+	}
+
+	if affine := t.AffineNetInterfaces(); len(affine) > 0 {
+		fmt.Fprintf(ew, "\nInterfaces preferred for accelerator affinity: %s\n", strings.Join(affine, ", "))
+	}
+
+	return ew.Err
+}
@@ -220,3 +220,91 @@ NUMA Node 2
 		})
 	}
 }
+
+func TestHardware_PrintAcceleratorTopology(t *testing.T) {
+	mockDev := func(name, addr string, numa uint, path ...string) *AffinityDevice {
+		return &AffinityDevice{
+			Name:     name,
+			PCIAddr:  *MustNewPCIAddress(addr),
+			NUMANode: numa,
+			PCIPath:  path,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		topo   *AcceleratorTopology
+		expOut string
+	}{
+		"nil": {
+			expOut: "No accelerator topology information available\n",
+		},
+		"no accelerators": {
+			topo: &AcceleratorTopology{
+				NetInterfaces: []*AffinityDevice{
+					{Name: "eth0", PCIAddr: *MustNewPCIAddress("0000:01:00.0")},
+				},
+			},
+			expOut: `
+NUMA Node 0
+  Accelerators:
+  Network interfaces:
+    0000:01:00.0 eth0
+`,
+		},
+		"accelerators and interfaces": {
+			topo: &AcceleratorTopology{
+				Accelerators: []*Accelerator{
+					{
+						AffinityDevice: *mockDev("gpu0", "0000:02:00.0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0", "0000:02:00.0"),
+						Vendor:         AcceleratorVendorNVIDIA,
+					},
+					{
+						AffinityDevice: *mockDev("gpu1", "0000:82:00.0", 1, "pci0000:80", "0000:80:01.0", "0000:82:00.0"),
+						Vendor:         AcceleratorVendorNVIDIA,
+					},
+				},
+				NetInterfaces: []*AffinityDevice{
+					mockDev("ib0", "0000:03:00.0", 0, "pci0000:00", "0000:00:01.0", "0000:01:00.0", "0000:03:00.0"),
+					mockDev("ib1", "0000:84:00.0", 1, "pci0000:80", "0000:80:02.0", "0000:84:00.0"),
+				},
+			},
+			expOut: `
+NUMA Node 0
+  Accelerators:
+    0000:02:00.0 gpu0 (NVIDIA)
+  Network interfaces:
+    0000:03:00.0 ib0
+NUMA Node 1
+  Accelerators:
+    0000:82:00.0 gpu1 (NVIDIA)
+  Network interfaces:
+    0000:84:00.0 ib1
+
+Accelerator Affinity
+
+Accelerator ib0        ib1         
+----------- ---        ---         
+gpu0        pci-bridge system      
+gpu1        system     host-bridge 
+
+  pci-bridge:  connected through PCI bridges or switches only
+  host-bridge: connected through a host bridge
+  numa:        same NUMA node, connected through different host bridges
+  system:      different NUMA nodes
+
+Interfaces preferred for accelerator affinity: ib0, ib1
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := PrintAcceleratorTopology(tc.topo, &buf); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), buf.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,9 +9,11 @@ package sysfs
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -213,7 +216,10 @@ func (s *Provider) getNetworkDevice(path, subsystem string) (*hardware.PCIDevice
 }
 
 func (s *Provider) getNUMANode(path string) (uint, error) {
-	numaPath := filepath.Join(path, "device", "numa_node")
+	return s.readNUMANode(filepath.Join(path, "device", "numa_node"))
+}
+
+func (s *Provider) readNUMANode(numaPath string) (uint, error) {
 	numaBytes, err := os.ReadFile(numaPath)
 	if err != nil {
 		return 0, err
@@ -361,6 +367,169 @@ func (s *Provider) getParentDevName(iface string) (string, error) {
 	return strings.TrimSpace(string(parentBytes)), nil
 }
 
+// acceleratorVendors maps the PCI vendor IDs of supported accelerators to their vendors.
+var acceleratorVendors = map[string]hardware.AcceleratorVendor{
+	"0x10de": hardware.AcceleratorVendorNVIDIA,
+	"0x1002": hardware.AcceleratorVendorAMD,
+}
+
+// isAcceleratorClass checks whether the PCI device class is a display controller or a
+// processing accelerator.
+func isAcceleratorClass(class string) bool {
+	return strings.HasPrefix(class, "0x03") || strings.HasPrefix(class, "0x12")
+}
+
+// GetAcceleratorTopology builds the topology of the accelerators and network devices
+// from the contents of sysfs.
+func (s *Provider) GetAcceleratorTopology(ctx context.Context) (*hardware.AcceleratorTopology, error) {
+	if s == nil {
+		return nil, errors.New("sysfs provider is nil")
+	}
+
+	accels, err := s.getAccelerators()
+	if err != nil {
+		return nil, err
+	}
+
+	ifaces, err := s.getAffinityNetDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	return &hardware.AcceleratorTopology{
+		Accelerators:  accels,
+		NetInterfaces: ifaces,
+	}, nil
+}
+
+func (s *Provider) getAccelerators() ([]*hardware.Accelerator, error) {
+	pciPath := s.sysPath("bus", "pci", "devices")
+	pciDevs, err := os.ReadDir(pciPath)
+	if os.IsNotExist(err) {
+		s.log.Tracef("no PCI devices in sysfs")
+		return []*hardware.Accelerator{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	accels := []*hardware.Accelerator{}
+	for _, dev := range pciDevs {
+		devPath := filepath.Join(pciPath, dev.Name())
+
+		classBytes, err := os.ReadFile(filepath.Join(devPath, "class"))
+		if err != nil || !isAcceleratorClass(strings.TrimSpace(string(classBytes))) {
+			continue
+		}
+
+		vendorBytes, err := os.ReadFile(filepath.Join(devPath, "vendor"))
+		if err != nil {
+			continue
+		}
+		vendor, found := acceleratorVendors[strings.TrimSpace(string(vendorBytes))]
+		if !found {
+			continue
+		}
+
+		pciAddr, err := hardware.NewPCIAddress(dev.Name())
+		if err != nil {
+			s.log.Tracef("skipping accelerator %q: %s", dev.Name(), err)
+			continue
+		}
+
+		numaID, err := s.readNUMANode(filepath.Join(devPath, "numa_node"))
+		if err != nil {
+			s.log.Tracef("using default NUMA node for accelerator %q, unable to get: %s", dev.Name(), err)
+		}
+
+		s.log.Tracef("adding %s accelerator found at %q (NUMA node %d)", vendor, devPath, numaID)
+		accels = append(accels, &hardware.Accelerator{
+			AffinityDevice: hardware.AffinityDevice{
+				PCIAddr:  *pciAddr,
+				NUMANode: numaID,
+				PCIPath:  s.getPCIPath(devPath),
+			},
+			Vendor: vendor,
+		})
+	}
+
+	// Accelerators are named in PCI address order, which matches the order in which
+	// they are enumerated when CUDA_DEVICE_ORDER=PCI_BUS_ID.
+	sort.Slice(accels, func(i, j int) bool {
+		return accels[i].PCIAddr.LessThan(&accels[j].PCIAddr)
+	})
+	for i, accel := range accels {
+		accel.Name = fmt.Sprintf("gpu%d", i)
+	}
+
+	return accels, nil
+}
+
+func (s *Provider) getAffinityNetDevices() ([]*hardware.AffinityDevice, error) {
+	devs := []*hardware.AffinityDevice{}
+	for _, subsystem := range netSubsystems {
+		subsysPath := s.sysPath("class", subsystem)
+		entries, err := os.ReadDir(subsysPath)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			path := filepath.Join(subsysPath, entry.Name())
+
+			// Virtual devices have no PCI address.
+			pciAddr, err := s.getPCIAddress(path)
+			if err != nil {
+				continue
+			}
+
+			numaID, err := s.getNUMANode(path)
+			if err != nil {
+				s.log.Tracef("using default NUMA node for %q, unable to get: %s", entry.Name(), err)
+			}
+
+			devs = append(devs, &hardware.AffinityDevice{
+				Name:     entry.Name(),
+				PCIAddr:  *pciAddr,
+				NUMANode: numaID,
+				PCIPath:  s.getPCIPath(filepath.Join(path, "device")),
+			})
+		}
+	}
+
+	sort.Slice(devs, func(i, j int) bool {
+		return devs[i].Name < devs[j].Name
+	})
+
+	return devs, nil
+}
+
+// getPCIPath returns the location of the device in the PCI hierarchy, or nil if it
+// can't be determined.
+func (s *Provider) getPCIPath(devPath string) hardware.PCIPath {
+	realPath, err := filepath.EvalSymlinks(devPath)
+	if err != nil {
+		return nil
+	}
+
+	devicesPath, err := filepath.EvalSymlinks(s.sysPath("devices"))
+	if err != nil {
+		return nil
+	}
+
+	relPath, err := filepath.Rel(devicesPath, realPath)
+	if err != nil {
+		return nil
+	}
+
+	elems := strings.Split(relPath, string(filepath.Separator))
+	if !strings.HasPrefix(elems[0], "pci") {
+		return nil
+	}
+	return elems
+}
+
 // GetFabricInterfaces harvests fabric interfaces from sysfs.
 func (s *Provider) GetFabricInterfaces(ctx context.Context, provider string) (*hardware.FabricInterfaceSet, error) {
 	if s == nil {
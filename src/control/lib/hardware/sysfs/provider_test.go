@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -1296,6 +1297,124 @@ func TestSysfs_condenseNetDevState(t *testing.T) {
 	}
 }
 
+func setupPCIBusDev(t *testing.T, root, class, vendor, numaStr string, pciPath ...string) {
+	t.Helper()
+
+	devPath := filepath.Join(append([]string{root, "devices"}, pciPath...)...)
+	if err := os.MkdirAll(devPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(devPath, "class"), class+"\n")
+	writeTestFile(t, filepath.Join(devPath, "vendor"), vendor+"\n")
+	writeTestFile(t, filepath.Join(devPath, "numa_node"), numaStr+"\n")
+
+	busPath := filepath.Join(root, "bus", "pci", "devices")
+	if err := os.MkdirAll(busPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(devPath, filepath.Join(busPath, filepath.Base(devPath))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSysfs_Provider_GetAcceleratorTopology(t *testing.T) {
+	for name, tc := range map[string]struct {
+		setup     func(*testing.T, string)
+		p         *Provider
+		expResult *hardware.AcceleratorTopology
+		expErr    error
+	}{
+		"nil": {
+			expErr: errors.New("nil"),
+		},
+		"empty": {
+			p: &Provider{},
+			expResult: &hardware.AcceleratorTopology{
+				Accelerators:  []*hardware.Accelerator{},
+				NetInterfaces: []*hardware.AffinityDevice{},
+			},
+		},
+		"accelerators and net devices": {
+			setup: func(t *testing.T, root string) {
+				path := setupPCIDev(t, root, "0000:02:00.0", "net", "net0")
+				setupClassLink(t, root, "net", path)
+				setupNUMANode(t, path, "0\n")
+
+				virtPath := filepath.Join(root, "devices", "virtual", "net", "virt0")
+				if err := os.MkdirAll(virtPath, 0755); err != nil {
+					t.Fatal(err)
+				}
+				setupClassLink(t, root, "net", virtPath)
+
+				setupPCIBusDev(t, root, "0x120000", "0x1002", "1",
+					"pci0000:80", "0000:80:01.0", "0000:81:00.0")
+				setupPCIBusDev(t, root, "0x030200", "0x10de", "0",
+					"pci0000:00", "0000:00:01.0", "0000:03:00.0")
+				setupPCIBusDev(t, root, "0x030000", "0x8086", "0",
+					"pci0000:00", "0000:00:02.0")
+				setupPCIBusDev(t, root, "0x040300", "0x10de", "0",
+					"pci0000:00", "0000:00:01.0", "0000:03:00.1")
+			},
+			p: &Provider{},
+			expResult: &hardware.AcceleratorTopology{
+				Accelerators: []*hardware.Accelerator{
+					{
+						AffinityDevice: hardware.AffinityDevice{
+							Name:     "gpu0",
+							PCIAddr:  *hardware.MustNewPCIAddress("0000:03:00.0"),
+							PCIPath:  hardware.PCIPath{"pci0000:00", "0000:00:01.0", "0000:03:00.0"},
+							NUMANode: 0,
+						},
+						Vendor: hardware.AcceleratorVendorNVIDIA,
+					},
+					{
+						AffinityDevice: hardware.AffinityDevice{
+							Name:     "gpu1",
+							PCIAddr:  *hardware.MustNewPCIAddress("0000:81:00.0"),
+							PCIPath:  hardware.PCIPath{"pci0000:80", "0000:80:01.0", "0000:81:00.0"},
+							NUMANode: 1,
+						},
+						Vendor: hardware.AcceleratorVendorAMD,
+					},
+				},
+				NetInterfaces: []*hardware.AffinityDevice{
+					{
+						Name:     "net0",
+						PCIAddr:  *hardware.MustNewPCIAddress("0000:02:00.0"),
+						PCIPath:  hardware.PCIPath{"pci0000:00", "0000:00:01.0", "0000:02:00.0"},
+						NUMANode: 0,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanupTestDir := test.CreateTestDir(t)
+			defer cleanupTestDir()
+
+			if tc.setup != nil {
+				tc.setup(t, testDir)
+			}
+
+			if tc.p != nil {
+				tc.p.log = log
+				tc.p.root = testDir
+			}
+
+			result, err := tc.p.GetAcceleratorTopology(test.Context(t))
+
+			test.CmpErr(t, tc.expErr, err)
+
+			if diff := cmp.Diff(tc.expResult, result); diff != "" {
+				t.Errorf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func setupTestIsIOMMUEnabled(t *testing.T, root string, extraDirs ...string) {
 	t.Helper()
 
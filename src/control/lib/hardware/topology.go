@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -27,6 +28,17 @@ type (
 		GetNUMANodeIDForPID(context.Context, int32) (uint, error)
 	}
 
+	// NUMADistanceProvider is an interface for getting the relative distances between
+	// NUMA nodes.
+	NUMADistanceProvider interface {
+		GetNUMADistances(context.Context) (NUMADistances, error)
+	}
+
+	// NUMADistances maps a NUMA node ID to the relative distances from that node to
+	// each NUMA node, keyed by NUMA node ID. Only the relative order of distances is
+	// meaningful.
+	NUMADistances map[uint]map[uint]uint64
+
 	// NodeMap maps a node ID to a node.
 	NodeMap map[uint]*NUMANode
 
@@ -215,6 +227,16 @@ func (t *Topology) NumCoresPerNUMA() int {
 	return 0
 }
 
+// Distance returns the relative distance between two NUMA nodes, if known.
+func (nd NUMADistances) Distance(from, to uint) (uint64, bool) {
+	dists, found := nd[from]
+	if !found {
+		return 0, false
+	}
+	dist, found := dists[to]
+	return dist, found
+}
+
 // AddDevice adds a device to the topology.
 func (t *Topology) AddDevice(numaID uint, device *PCIDevice) error {
 	if t == nil {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -238,6 +239,57 @@ func TestTopology_NumCoresPerNUMA(t *testing.T) {
 	}
 }
 
+func TestHardware_NUMADistances_Distance(t *testing.T) {
+	dists := NUMADistances{
+		0: {0: 10, 1: 21},
+		1: {0: 21, 1: 10},
+	}
+
+	for name, tc := range map[string]struct {
+		dists    NUMADistances
+		from     uint
+		to       uint
+		expDist  uint64
+		expFound bool
+	}{
+		"nil": {
+			from: 0,
+			to:   1,
+		},
+		"unknown source": {
+			dists: dists,
+			from:  2,
+			to:    0,
+		},
+		"unknown destination": {
+			dists: dists,
+			from:  0,
+			to:    2,
+		},
+		"local": {
+			dists:    dists,
+			from:     1,
+			to:       1,
+			expDist:  10,
+			expFound: true,
+		},
+		"remote": {
+			dists:    dists,
+			from:     0,
+			to:       1,
+			expDist:  21,
+			expFound: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotDist, gotFound := tc.dists.Distance(tc.from, tc.to)
+
+			test.AssertEqual(t, tc.expDist, gotDist, "")
+			test.AssertEqual(t, tc.expFound, gotFound, "")
+		})
+	}
+}
+
 func TestHardware_Topology_AddDevice(t *testing.T) {
 	for name, tc := range map[string]struct {
 		topo      *Topology
@@ -0,0 +1,305 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package support
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// collectorOutputFile is the file in a manifest collector's folder that
+	// receives the output of its command.
+	collectorOutputFile = "output.txt"
+
+	// defaultCollectorTimeout is the time a manifest collector's command may
+	// run for if the manifest does not specify a timeout.
+	defaultCollectorTimeout = 10 * time.Minute
+
+	// CollectorDirEnv is the environment variable giving a manifest
+	// collector's command the folder to write any additional files to.
+	CollectorDirEnv = "DAOS_SUPPORT_COLLECTOR_DIR"
+)
+
+// Collector is a step of a support log collection.
+type Collector interface {
+	// Name returns a description of the step for the progress and report.
+	Name() string
+	// Estimate returns the number of progress steps taken by the collector.
+	Estimate() int
+	// Collect runs the step, copying its output below the target folder.
+	Collect(log logging.Logger, params CollectLogsParams) error
+}
+
+type collectFn func(logging.Logger, ...CollectLogsParams) error
+
+// builtinCollectors is the table of built-in collection steps, keyed by the log
+// function which selects them in a CollectLogReq.
+var builtinCollectors = map[int32]collectFn{
+	CopyServerConfigEnum:     copyServerConfig,
+	CollectSystemCmdEnum:     collectSystemCmd,
+	CollectServerLogEnum:     collectServerLog,
+	CollectExtraLogsDirEnum:  collectExtraLogsDir,
+	CollectDaosServerCmdEnum: collectDaosServerCmd,
+	CollectDmgCmdEnum:        collectDmgCmd,
+	CollectDmgDiskInfoEnum:   collectDmgDiskInfo,
+	CollectAgentCmdEnum: func(log logging.Logger, opts ...CollectLogsParams) error {
+		return collectCmdOutput(daosAgentCmdInfo, log, opts...)
+	},
+	CollectClientLogEnum: collectClientLog,
+	CollectAgentLogEnum:  collectAgentLog,
+	CopyAgentConfigEnum:  copyAgentConfig,
+	RsyncLogEnum:         rsyncLog,
+	ArchiveLogsEnum:      ArchiveLogs,
+	CollectManifestEnum:  collectManifest,
+}
+
+// builtinCollector runs a built-in collection step for a single command.
+type builtinCollector struct {
+	logFunc int32
+	logCmd  string
+}
+
+func (c *builtinCollector) Name() string {
+	return StepName(c.logFunc, c.logCmd)
+}
+
+func (c *builtinCollector) Estimate() int {
+	return 1
+}
+
+func (c *builtinCollector) Collect(log logging.Logger, params CollectLogsParams) error {
+	params.LogFunction = c.logFunc
+	params.LogCmd = c.logCmd
+
+	return CollectSupportLog(log, params)
+}
+
+// NewCollectors returns a built-in collector for the log function per supplied
+// command, or a single collector if no commands are supplied.
+func NewCollectors(logFunc int32, logCmds ...string) []Collector {
+	if len(logCmds) == 0 {
+		logCmds = []string{""}
+	}
+
+	collectors := make([]Collector, 0, len(logCmds))
+	for _, logCmd := range logCmds {
+		collectors = append(collectors, &builtinCollector{
+			logFunc: logFunc,
+			logCmd:  logCmd,
+		})
+	}
+
+	return collectors
+}
+
+// EstimateSteps returns the total number of progress steps taken by the
+// collectors.
+func EstimateSteps(collectors ...Collector) int {
+	var total int
+	for _, c := range collectors {
+		total += c.Estimate()
+	}
+
+	return total
+}
+
+// CollectorRunner runs collectors in turn, recording the outcome of each in the
+// report and advancing the progress bar.
+type CollectorRunner struct {
+	Log         logging.Logger
+	Report      *Report
+	Progress    *ProgressBar
+	Output      io.Writer // Destination for the progress bar
+	StopOnError bool
+}
+
+func (r *CollectorRunner) advance(steps int) {
+	if r.Progress == nil || r.Output == nil {
+		return
+	}
+	for i := 0; i < steps; i++ {
+		fmt.Fprint(r.Output, r.Progress.Display())
+	}
+}
+
+// Run runs the collectors with the supplied parameters. A failed collector is
+// logged and the remaining collectors are run, unless StopOnError is set in
+// which case the error is returned immediately.
+func (r *CollectorRunner) Run(params CollectLogsParams, collectors ...Collector) error {
+	for _, c := range collectors {
+		r.Log.Debugf("Running collector %q", c.Name())
+
+		err := c.Collect(r.Log, params)
+		if r.Report != nil {
+			r.Report.AddStepErr(c.Name(), err)
+		}
+		if err != nil {
+			r.Log.Errorf("%s: %s", c.Name(), err)
+			if r.StopOnError {
+				return err
+			}
+		}
+		r.advance(c.Estimate())
+	}
+
+	return nil
+}
+
+// ManifestCollector runs a site-specific command declared in a collectors
+// manifest.
+type ManifestCollector struct {
+	CollectorName string        `yaml:"name"`
+	Command       string        `yaml:"command"`
+	Args          []string      `yaml:"args,omitempty"`
+	Timeout       time.Duration `yaml:"timeout,omitempty"`
+}
+
+// CollectorManifest declares out-of-tree collectors to be run as part of a
+// support log collection.
+type CollectorManifest struct {
+	Collectors []*ManifestCollector `yaml:"collectors"`
+}
+
+// Name returns the name of the collector given in the manifest.
+func (c *ManifestCollector) Name() string {
+	return c.CollectorName
+}
+
+// Estimate returns the number of progress steps taken by the collector.
+func (c *ManifestCollector) Estimate() int {
+	return 1
+}
+
+// Collect runs the collector's command, writing its output into a folder named
+// after the collector. The folder is passed to the command in the environment
+// so that the command may store additional files there.
+func (c *ManifestCollector) Collect(log logging.Logger, params CollectLogsParams) error {
+	folder, err := createHostLogFolder(filepath.Join(siteCollectors, c.CollectorName), log, params)
+	if err != nil {
+		return err
+	}
+
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = defaultCollectorTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, c.Command, c.Args...)
+	cmd.Env = append(os.Environ(), CollectorDirEnv+"="+folder)
+	log.Debugf("Running collector command %s > %s", cmd, filepath.Join(folder, collectorOutputFile))
+
+	out, cmdErr := cmd.CombinedOutput()
+	if err := os.WriteFile(filepath.Join(folder, collectorOutputFile), out, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write %s", filepath.Join(folder, collectorOutputFile))
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		return errors.Errorf("collector %q timed out after %s", c.CollectorName, timeout)
+	}
+
+	return errors.Wrapf(cmdErr, "collector %q", c.CollectorName)
+}
+
+func (m *CollectorManifest) validate() error {
+	names := make(map[string]struct{})
+	for i, c := range m.Collectors {
+		if c == nil {
+			return errors.Errorf("collector %d: empty definition", i)
+		}
+		if c.CollectorName == "" {
+			return errors.Errorf("collector %d: no name specified", i)
+		}
+		if c.CollectorName != filepath.Base(c.CollectorName) || strings.HasPrefix(c.CollectorName, ".") {
+			return errors.Errorf("collector %q: name may not be a path", c.CollectorName)
+		}
+		if _, exists := names[c.CollectorName]; exists {
+			return errors.Errorf("collector %q: duplicate name", c.CollectorName)
+		}
+		names[c.CollectorName] = struct{}{}
+
+		if c.Command == "" {
+			return errors.Errorf("collector %q: no command specified", c.CollectorName)
+		}
+		if !filepath.IsAbs(c.Command) {
+			return errors.Errorf("collector %q: command %q is not an absolute path",
+				c.CollectorName, c.Command)
+		}
+		if c.Timeout < 0 {
+			return errors.Errorf("collector %q: timeout may not be negative", c.CollectorName)
+		}
+	}
+
+	return nil
+}
+
+// LoadCollectorManifest reads the YAML collectors manifest at the supplied path
+// and returns its collectors in the declared order.
+func LoadCollectorManifest(path string) ([]Collector, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading collectors manifest")
+	}
+
+	manifest := new(CollectorManifest)
+	if err := yaml.UnmarshalStrict(data, manifest); err != nil {
+		return nil, errors.Wrapf(err, "parsing collectors manifest %s", path)
+	}
+	if err := manifest.validate(); err != nil {
+		return nil, errors.Wrapf(err, "collectors manifest %s", path)
+	}
+
+	collectors := make([]Collector, 0, len(manifest.Collectors))
+	for _, c := range manifest.Collectors {
+		collectors = append(collectors, c)
+	}
+
+	return collectors, nil
+}
+
+// Run the collectors declared in the manifest given as the log command. All of
+// the collectors are run unless stop on error is set, and the names of any that
+// failed are returned in the error.
+func collectManifest(log logging.Logger, opts ...CollectLogsParams) error {
+	collectors, err := LoadCollectorManifest(opts[0].LogCmd)
+	if err != nil {
+		return err
+	}
+
+	runner := &CollectorRunner{
+		Log:         log,
+		Report:      NewReport(""),
+		StopOnError: opts[0].StopOnError,
+	}
+	if err := runner.Run(opts[0], collectors...); err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, step := range runner.Report.Steps {
+		if len(step.Errors) > 0 {
+			failed = append(failed, step.Name)
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("collectors failed: %s", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
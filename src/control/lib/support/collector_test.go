@@ -0,0 +1,359 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package support
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockCollector struct {
+	name     string
+	estimate int
+	err      error
+	called   bool
+}
+
+func (c *mockCollector) Name() string {
+	return c.name
+}
+
+func (c *mockCollector) Estimate() int {
+	return c.estimate
+}
+
+func (c *mockCollector) Collect(_ logging.Logger, _ CollectLogsParams) error {
+	c.called = true
+	return c.err
+}
+
+func TestSupport_NewCollectors(t *testing.T) {
+	collectors := NewCollectors(CollectSystemCmdEnum, "dmesg", "df -h")
+	test.AssertEqual(t, 2, len(collectors), "unexpected collector count")
+	test.AssertEqual(t, "df -h", collectors[1].Name(), "unexpected collector name")
+
+	collectors = NewCollectors(CopyServerConfigEnum)
+	test.AssertEqual(t, 1, len(collectors), "unexpected collector count")
+	test.AssertEqual(t, "copy server config", collectors[0].Name(), "unexpected collector name")
+	test.AssertEqual(t, 1, EstimateSteps(collectors...), "unexpected estimate")
+}
+
+func TestSupport_CollectSupportLog_Unknown(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	err := CollectSupportLog(log, CollectLogsParams{LogFunction: 42})
+	test.CmpErr(t, errors.New("unknown log function 42"), err)
+}
+
+func TestSupport_CollectorRunner_Run(t *testing.T) {
+	for name, tc := range map[string]struct {
+		stopOnError bool
+		collectors  []*mockCollector
+		expCalled   []bool
+		expSteps    []*ReportStep
+		expProgress int
+		expErr      error
+	}{
+		"success": {
+			collectors: []*mockCollector{
+				{name: "one", estimate: 1},
+				{name: "two", estimate: 2},
+			},
+			expCalled: []bool{true, true},
+			expSteps: []*ReportStep{
+				{Name: "one"},
+				{Name: "two"},
+			},
+			expProgress: 3,
+		},
+		"failure continues": {
+			collectors: []*mockCollector{
+				{name: "one", estimate: 1, err: errors.New("failed")},
+				{name: "two", estimate: 1},
+			},
+			expCalled: []bool{true, true},
+			expSteps: []*ReportStep{
+				{Name: "one", Errors: []string{"failed"}},
+				{Name: "two"},
+			},
+			expProgress: 2,
+		},
+		"stop on error": {
+			stopOnError: true,
+			collectors: []*mockCollector{
+				{name: "one", estimate: 1, err: errors.New("failed")},
+				{name: "two", estimate: 1},
+			},
+			expCalled: []bool{true, false},
+			expSteps: []*ReportStep{
+				{Name: "one", Errors: []string{"failed"}},
+			},
+			expErr: errors.New("failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var out strings.Builder
+			runner := &CollectorRunner{
+				Log:         log,
+				Report:      NewReport("test"),
+				Progress:    &ProgressBar{Total: 10, Steps: 10},
+				Output:      &out,
+				StopOnError: tc.stopOnError,
+			}
+
+			var collectors []Collector
+			for _, c := range tc.collectors {
+				collectors = append(collectors, c)
+			}
+			err := runner.Run(CollectLogsParams{}, collectors...)
+			test.CmpErr(t, tc.expErr, err)
+
+			for i, c := range tc.collectors {
+				test.AssertEqual(t, tc.expCalled[i], c.called, "unexpected call of "+c.name)
+			}
+			if diff := cmp.Diff(tc.expSteps, runner.Report.Steps); diff != "" {
+				t.Fatalf("unexpected report steps (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expProgress, runner.Progress.Start, "unexpected progress")
+		})
+	}
+}
+
+func TestSupport_LoadCollectorManifest(t *testing.T) {
+	for name, tc := range map[string]struct {
+		manifest      string
+		noFile        bool
+		expCollectors []*ManifestCollector
+		expErr        error
+	}{
+		"missing file": {
+			noFile: true,
+			expErr: errors.New("reading collectors manifest"),
+		},
+		"bad yaml": {
+			manifest: "collectors: [",
+			expErr:   errors.New("parsing collectors manifest"),
+		},
+		"unknown field": {
+			manifest: `
+collectors:
+- name: site
+  command: /bin/true
+  script: /bin/false
+`,
+			expErr: errors.New("field script not found"),
+		},
+		"no name": {
+			manifest: `
+collectors:
+- command: /bin/true
+`,
+			expErr: errors.New("collector 0: no name specified"),
+		},
+		"path name": {
+			manifest: `
+collectors:
+- name: ../site
+  command: /bin/true
+`,
+			expErr: errors.New("name may not be a path"),
+		},
+		"duplicate name": {
+			manifest: `
+collectors:
+- name: site
+  command: /bin/true
+- name: site
+  command: /bin/false
+`,
+			expErr: errors.New(`collector "site": duplicate name`),
+		},
+		"no command": {
+			manifest: `
+collectors:
+- name: site
+`,
+			expErr: errors.New("no command specified"),
+		},
+		"relative command": {
+			manifest: `
+collectors:
+- name: site
+  command: collect.sh
+`,
+			expErr: errors.New("not an absolute path"),
+		},
+		"negative timeout": {
+			manifest: `
+collectors:
+- name: site
+  command: /bin/true
+  timeout: -1m
+`,
+			expErr: errors.New("timeout may not be negative"),
+		},
+		"empty": {
+			manifest:      "collectors: []",
+			expCollectors: []*ManifestCollector{},
+		},
+		"collectors": {
+			manifest: `
+collectors:
+- name: fabric
+  command: /opt/site/collect_fabric.sh
+  args: [--all]
+  timeout: 2m
+- name: lustre
+  command: /opt/site/collect_lustre.sh
+`,
+			expCollectors: []*ManifestCollector{
+				{
+					CollectorName: "fabric",
+					Command:       "/opt/site/collect_fabric.sh",
+					Args:          []string{"--all"},
+					Timeout:       2 * time.Minute,
+				},
+				{
+					CollectorName: "lustre",
+					Command:       "/opt/site/collect_lustre.sh",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "collectors.yml")
+			if !tc.noFile {
+				if err := os.WriteFile(path, []byte(tc.manifest), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			collectors, err := LoadCollectorManifest(path)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotCollectors := []*ManifestCollector{}
+			for _, c := range collectors {
+				gotCollectors = append(gotCollectors, c.(*ManifestCollector))
+			}
+			if diff := cmp.Diff(tc.expCollectors, gotCollectors); diff != "" {
+				t.Fatalf("unexpected collectors (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSupport_ManifestCollector_Collect(t *testing.T) {
+	hostName, err := GetHostName()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		collector *ManifestCollector
+		expOutput string
+		expFile   string
+		expErr    error
+	}{
+		"success": {
+			collector: &ManifestCollector{
+				CollectorName: "site",
+				Command:       "/bin/sh",
+				Args:          []string{"-c", "echo collected; echo extra > $" + CollectorDirEnv + "/extra.txt"},
+			},
+			expOutput: "collected\n",
+			expFile:   "extra.txt",
+		},
+		"command fails": {
+			collector: &ManifestCollector{
+				CollectorName: "site",
+				Command:       "/bin/sh",
+				Args:          []string{"-c", "echo failed; exit 1"},
+			},
+			expOutput: "failed\n",
+			expErr:    errors.New(`collector "site": exit status 1`),
+		},
+		"timeout": {
+			collector: &ManifestCollector{
+				CollectorName: "site",
+				Command:       "/bin/sleep",
+				Args:          []string{"10"},
+				Timeout:       10 * time.Millisecond,
+			},
+			expErr: errors.New(`collector "site" timed out`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			targetDir := t.TempDir()
+			err := tc.collector.Collect(log, CollectLogsParams{TargetFolder: targetDir})
+			test.CmpErr(t, tc.expErr, err)
+
+			folder := filepath.Join(targetDir, hostName, siteCollectors, tc.collector.CollectorName)
+			out, err := os.ReadFile(filepath.Join(folder, collectorOutputFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expOutput, string(out), "unexpected output")
+
+			if tc.expFile != "" {
+				if _, err := os.Stat(filepath.Join(folder, tc.expFile)); err != nil {
+					t.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestSupport_collectManifest(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	tmpDir := t.TempDir()
+	manifest := filepath.Join(tmpDir, "collectors.yml")
+	if err := os.WriteFile(manifest, []byte(`
+collectors:
+- name: fails
+  command: /bin/false
+- name: works
+  command: /bin/true
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := CollectSupportLog(log, CollectLogsParams{
+		LogFunction:  CollectManifestEnum,
+		LogCmd:       manifest,
+		TargetFolder: filepath.Join(tmpDir, "logs"),
+	})
+	test.CmpErr(t, errors.New("collectors failed: fails"), err)
+
+	err = CollectSupportLog(log, CollectLogsParams{
+		LogFunction:  CollectManifestEnum,
+		LogCmd:       manifest,
+		TargetFolder: filepath.Join(tmpDir, "logs"),
+		StopOnError:  true,
+	})
+	test.CmpErr(t, errors.New(`collector "fails": exit status 1`), err)
+}
@@ -0,0 +1,524 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package support
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// diffMaxLCSCells is the maximum size of the table used to compute an
+	// ordered line diff. Larger files are compared as sets of lines.
+	diffMaxLCSCells = 4 << 20
+)
+
+// BundleCategory identifies the category of the collected files compared
+// between bundles.
+type BundleCategory string
+
+// BundleCategory constant definitions.
+const (
+	BundleConfigs  BundleCategory = "configs"
+	BundleVersions BundleCategory = "versions"
+	BundleDevices  BundleCategory = "devices"
+)
+
+// bundleFolders are the names of the folders created by the log collection.
+// The folders are created in a per-host folder, other than dmgSystemLogs
+// which is created at the top of the bundle.
+var bundleFolders = map[string]struct{}{
+	dmgSystemLogs:    {},
+	dmgNodeLogs:      {},
+	daosAgentCmdInfo: {},
+	genSystemInfo:    {},
+	engineLogs:       {},
+	controlLogs:      {},
+	adminLogs:        {},
+	clientLogs:       {},
+	DaosServerConfig: {},
+	agentConfig:      {},
+	agentLogs:        {},
+	extraLogs:        {},
+}
+
+var (
+	// engineLogErrRe matches the facility, priority and message of an
+	// engine log line logged at ERR priority or above.
+	engineLogErrRe = regexp.MustCompile(`\]\s+(\S+)\s+(ERR|CRIT|ALRT|EMRG)\s+(.*)$`)
+
+	sigUUIDRe   = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	sigHexRe    = regexp.MustCompile(`0x[0-9a-fA-F]+`)
+	sigNumberRe = regexp.MustCompile(`[0-9]+`)
+
+	// rpmInstallTimeRe matches the install time prefix of the collected
+	// rpm package list, which is not relevant when comparing versions.
+	rpmInstallTimeRe = regexp.MustCompile(`^\(.*\):\s*`)
+)
+
+// Bundle contains the parts of a collected support bundle that are compared
+// with another bundle.
+type Bundle struct {
+	Path       string
+	Files      map[string][]string // Compared files, keyed by path relative to the bundle
+	Signatures map[string]int      // Engine log error signatures and their counts
+}
+
+// bundleKey returns the path of a collected file relative to the top of the
+// bundle, or an empty string if the file was not created by the log
+// collection.
+func bundleKey(name string) string {
+	parts := strings.Split(strings.Trim(filepath.ToSlash(name), "/"), "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if _, found := bundleFolders[parts[i]]; !found {
+			continue
+		}
+		if parts[i] == dmgSystemLogs {
+			return path.Join(parts[i:]...)
+		}
+		if i == 0 {
+			return ""
+		}
+		return path.Join(parts[i-1:]...)
+	}
+
+	return ""
+}
+
+// bundleCategory returns the category of a collected file, or an empty string
+// if the file is not compared.
+func bundleCategory(key string) BundleCategory {
+	parts := strings.Split(key, "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	folder := parts[0]
+	if folder != dmgSystemLogs {
+		folder = parts[1]
+	}
+	base := parts[len(parts)-1]
+
+	switch {
+	case folder == DaosServerConfig || folder == agentConfig:
+		return BundleConfigs
+	case base == "daos_server_version" || base == "daos_agent_version":
+		return BundleVersions
+	case folder == genSystemInfo && strings.HasPrefix(base, "rpm"):
+		return BundleVersions
+	case strings.HasPrefix(base, "dmg_storage_scan"),
+		strings.HasPrefix(base, "dmg_storage_query_list-devices"):
+		return BundleDevices
+	default:
+		return ""
+	}
+}
+
+func isEngineLog(key string) bool {
+	parts := strings.Split(key, "/")
+	return len(parts) > 2 && parts[1] == engineLogs
+}
+
+// logSignature returns the signature of an engine log line logged at ERR
+// priority or above, with the identifiers and numbers that vary between
+// occurrences of the same error replaced, or false if the line is not an
+// error.
+func logSignature(line string) (string, bool) {
+	matches := engineLogErrRe.FindStringSubmatch(line)
+	if matches == nil {
+		return "", false
+	}
+
+	msg := sigUUIDRe.ReplaceAllString(matches[3], "<uuid>")
+	msg = sigHexRe.ReplaceAllString(msg, "<hex>")
+	msg = sigNumberRe.ReplaceAllString(msg, "N")
+
+	return strings.Join([]string{matches[1], matches[2], strings.TrimSpace(msg)}, " "), true
+}
+
+func (b *Bundle) addFile(log logging.Logger, name string, r io.Reader) error {
+	key := bundleKey(name)
+	if key == "" {
+		return nil
+	}
+
+	if isEngineLog(key) {
+		log.Debugf("scanning engine log %s for error signatures", key)
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			if sig, ok := logSignature(scanner.Text()); ok {
+				b.Signatures[sig]++
+			}
+		}
+		return errors.Wrapf(scanner.Err(), "reading %s", name)
+	}
+
+	if bundleCategory(key) == "" {
+		return nil
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return errors.Wrapf(err, "reading %s", name)
+	}
+	b.Files[key] = strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+
+	return nil
+}
+
+func (b *Bundle) loadDir(log logging.Logger, dir string) error {
+	return filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		return b.addFile(log, rel, f)
+	})
+}
+
+func (b *Bundle) loadArchive(log logging.Logger, r io.Reader) error {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		r = gr
+	} else {
+		r = br
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if err := b.addFile(log, hdr.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// LoadBundle loads the parts of a support bundle that are compared with another
+// bundle. The bundle may be a folder created by a log collection or an archive
+// of one, optionally compressed with gzip.
+func LoadBundle(log logging.Logger, bundlePath string) (*Bundle, error) {
+	b := &Bundle{
+		Path:       bundlePath,
+		Files:      make(map[string][]string),
+		Signatures: make(map[string]int),
+	}
+
+	fi, err := os.Stat(bundlePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if fi.IsDir() {
+		err = b.loadDir(log, bundlePath)
+	} else {
+		var f *os.File
+		if f, err = os.Open(bundlePath); err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		err = b.loadArchive(log, f)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load support bundle %s", bundlePath)
+	}
+
+	if len(b.Files) == 0 && len(b.Signatures) == 0 {
+		return nil, errors.Errorf("no collected support logs found in %s", bundlePath)
+	}
+
+	return b, nil
+}
+
+type (
+	// FileDiff describes the differences between a collected file in two
+	// bundles. Each changed line is prefixed with "-" if it was only found
+	// in the first bundle, or "+" if it was only found in the second.
+	FileDiff struct {
+		Path     string   `json:"path"`
+		OnlyInA  bool     `json:"only_in_a,omitempty"`
+		OnlyInB  bool     `json:"only_in_b,omitempty"`
+		Category string   `json:"category"`
+		Lines    []string `json:"lines,omitempty"`
+	}
+
+	// SignatureDiff describes an engine log error signature whose count
+	// differs between two bundles.
+	SignatureDiff struct {
+		Signature string `json:"signature"`
+		CountA    int    `json:"count_a"`
+		CountB    int    `json:"count_b"`
+	}
+
+	// BundleDiff describes the differences between two bundles.
+	BundleDiff struct {
+		A          string           `json:"a"`
+		B          string           `json:"b"`
+		Files      []*FileDiff      `json:"files"`
+		Signatures []*SignatureDiff `json:"signatures"`
+	}
+)
+
+// diffLines returns the lines that differ between a and b, in order, with
+// "-" prepended to the lines only in a and "+" to the lines only in b.
+func diffLines(a, b []string) []string {
+	if len(a)*len(b) > diffMaxLCSCells {
+		return diffLineSets(a, b)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, "-"+a[i])
+			i++
+		default:
+			out = append(out, "+"+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, "-"+a[i])
+	}
+	for ; j < len(b); j++ {
+		out = append(out, "+"+b[j])
+	}
+
+	return out
+}
+
+// diffLineSets returns the sorted lines found in only one of a and b, with "-"
+// prepended to the lines only in a and "+" to the lines only in b.
+func diffLineSets(a, b []string) []string {
+	counts := make(map[string]int)
+	for _, line := range a {
+		counts[line]--
+	}
+	for _, line := range b {
+		counts[line]++
+	}
+
+	lines := make([]string, 0, len(counts))
+	for line := range counts {
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+
+	var out []string
+	for _, line := range lines {
+		for n := counts[line]; n < 0; n++ {
+			out = append(out, "-"+line)
+		}
+		for n := counts[line]; n > 0; n-- {
+			out = append(out, "+"+line)
+		}
+	}
+
+	return out
+}
+
+// versionLines returns the lines of a collected version file with the parts
+// that are not relevant to the versions removed.
+func versionLines(lines []string) []string {
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		out = append(out, rpmInstallTimeRe.ReplaceAllString(line, ""))
+	}
+
+	return out
+}
+
+// DiffBundles compares the configs, software versions, device inventory and
+// engine log error signatures of two bundles.
+func DiffBundles(a, b *Bundle) *BundleDiff {
+	diff := &BundleDiff{
+		A:          a.Path,
+		B:          b.Path,
+		Files:      []*FileDiff{},
+		Signatures: []*SignatureDiff{},
+	}
+
+	keys := make(map[string]struct{})
+	for key := range a.Files {
+		keys[key] = struct{}{}
+	}
+	for key := range b.Files {
+		keys[key] = struct{}{}
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	for _, key := range sortedKeys {
+		category := bundleCategory(key)
+		aLines, inA := a.Files[key]
+		bLines, inB := b.Files[key]
+
+		fd := &FileDiff{
+			Path:     key,
+			OnlyInA:  !inB,
+			OnlyInB:  !inA,
+			Category: string(category),
+		}
+		if inA && inB {
+			if category == BundleVersions {
+				fd.Lines = diffLineSets(versionLines(aLines), versionLines(bLines))
+			} else {
+				fd.Lines = diffLines(aLines, bLines)
+			}
+			if len(fd.Lines) == 0 {
+				continue
+			}
+		}
+		diff.Files = append(diff.Files, fd)
+	}
+
+	sigs := make(map[string]struct{})
+	for sig := range a.Signatures {
+		sigs[sig] = struct{}{}
+	}
+	for sig := range b.Signatures {
+		sigs[sig] = struct{}{}
+	}
+	for sig := range sigs {
+		if a.Signatures[sig] == b.Signatures[sig] {
+			continue
+		}
+		diff.Signatures = append(diff.Signatures, &SignatureDiff{
+			Signature: sig,
+			CountA:    a.Signatures[sig],
+			CountB:    b.Signatures[sig],
+		})
+	}
+	sort.Slice(diff.Signatures, func(i, j int) bool {
+		si, sj := diff.Signatures[i], diff.Signatures[j]
+		if (si.CountA == 0) != (sj.CountA == 0) {
+			// Signatures new in B are the most interesting.
+			return si.CountA == 0
+		}
+		if si.CountB != sj.CountB {
+			return si.CountB > sj.CountB
+		}
+		return si.Signature < sj.Signature
+	})
+
+	return diff
+}
+
+func printFileDiffs(out io.Writer, title string, category BundleCategory, files []*FileDiff) {
+	fmt.Fprintf(out, "%s\n%s\n", title, strings.Repeat("-", len(title)))
+
+	var found bool
+	for _, fd := range files {
+		if fd.Category != string(category) {
+			continue
+		}
+		found = true
+
+		switch {
+		case fd.OnlyInA:
+			fmt.Fprintf(out, "%s: only in A\n", fd.Path)
+		case fd.OnlyInB:
+			fmt.Fprintf(out, "%s: only in B\n", fd.Path)
+		default:
+			fmt.Fprintf(out, "%s:\n", fd.Path)
+			for _, line := range fd.Lines {
+				fmt.Fprintf(out, "  %s\n", line)
+			}
+		}
+	}
+	if !found {
+		fmt.Fprintln(out, "No differences.")
+	}
+	fmt.Fprintln(out)
+}
+
+// PrintBundleDiff writes a description of the differences between two bundles
+// to the supplied writer.
+func PrintBundleDiff(out io.Writer, diff *BundleDiff) {
+	fmt.Fprintf(out, "A: %s\nB: %s\n\n", diff.A, diff.B)
+
+	printFileDiffs(out, "Configs", BundleConfigs, diff.Files)
+	printFileDiffs(out, "Versions", BundleVersions, diff.Files)
+	printFileDiffs(out, "Device Inventory", BundleDevices, diff.Files)
+
+	title := "Engine Log Error Signatures"
+	fmt.Fprintf(out, "%s\n%s\n", title, strings.Repeat("-", len(title)))
+	if len(diff.Signatures) == 0 {
+		fmt.Fprintln(out, "No differences.")
+		return
+	}
+	fmt.Fprintf(out, "%8s %8s  %s\n", "A", "B", "Signature")
+	for _, sd := range diff.Signatures {
+		fmt.Fprintf(out, "%8d %8d  %s\n", sd.CountA, sd.CountB, sd.Signature)
+	}
+}
@@ -0,0 +1,290 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package support
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestSupport_bundleKey(t *testing.T) {
+	for name, tc := range map[string]struct {
+		name   string
+		expKey string
+	}{
+		"host file": {
+			name:   "/tmp/daos_support_server_logs/host1/DaosServerConfig/daos_server.yml",
+			expKey: "host1/DaosServerConfig/daos_server.yml",
+		},
+		"system file": {
+			name:   "/tmp/daos_support_server_logs/DmgSystemLogs/dmg_system_query",
+			expKey: "DmgSystemLogs/dmg_system_query",
+		},
+		"relative host file": {
+			name:   "host1/EngineLogs/daos_engine_0.log",
+			expKey: "host1/EngineLogs/daos_engine_0.log",
+		},
+		"report": {
+			name: "/tmp/daos_support_server_logs/report.md",
+		},
+		"host folder missing": {
+			name: "EngineLogs/daos_engine_0.log",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expKey, bundleKey(tc.name), "unexpected key")
+		})
+	}
+}
+
+func TestSupport_logSignature(t *testing.T) {
+	for name, tc := range map[string]struct {
+		line   string
+		expSig string
+		expOK  bool
+	}{
+		"info line": {
+			line: "04/17-13:05:12.34 host1 DAOS[1234/1240/0] rdb  INFO src/rdb/rdb.c:100 rdb_start() started",
+		},
+		"error line": {
+			line:   "04/17-13:05:12.34 host1 DAOS[1234/1240/0] rdb  ERR  src/rdb/rdb_raft.c:1234 rdb_raft_step() 12345678-1234-1234-1234-123456789abc: failed at 0x7f00: DER_TIMEDOUT(-1011)",
+			expSig: "rdb ERR src/rdb/rdb_raft.c:N rdb_raft_step() <uuid>: failed at <hex>: DER_TIMEDOUT(-N)",
+			expOK:  true,
+		},
+		"critical line": {
+			line:   "04/17-13:05:12.34 host1 DAOS[1234/1240/0] bio  CRIT src/bio/bio_xstream.c:42 bio_fn() device gone",
+			expSig: "bio CRIT src/bio/bio_xstream.c:N bio_fn() device gone",
+			expOK:  true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sig, ok := logSignature(tc.line)
+			test.AssertEqual(t, tc.expOK, ok, "unexpected match")
+			test.AssertEqual(t, tc.expSig, sig, "unexpected signature")
+		})
+	}
+}
+
+func TestSupport_diffLines(t *testing.T) {
+	for name, tc := range map[string]struct {
+		a   []string
+		b   []string
+		exp []string
+	}{
+		"identical": {
+			a: []string{"one", "two"},
+			b: []string{"one", "two"},
+		},
+		"changed line": {
+			a:   []string{"name: daos_server", "port: 10001", "provider: ofi+tcp"},
+			b:   []string{"name: daos_server", "port: 10001", "provider: ofi+verbs"},
+			exp: []string{"-provider: ofi+tcp", "+provider: ofi+verbs"},
+		},
+		"added and removed": {
+			a:   []string{"one", "two", "three"},
+			b:   []string{"zero", "one", "three"},
+			exp: []string{"+zero", "-two"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.exp, diffLines(tc.a, tc.b)); diff != "" {
+				t.Fatalf("unexpected diff (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSupport_DiffBundles(t *testing.T) {
+	engineLog := func(lines ...string) string {
+		return strings.Join(lines, "\n") + "\n"
+	}
+	errLine := func(msg string) string {
+		return "04/17-13:05:12.34 host1 DAOS[1234/1240/0] rdb  ERR  src/rdb/rdb_raft.c:1234 " + msg
+	}
+
+	bundleA := map[string]string{
+		"host1/DaosServerConfig/daos_server.yml":         "name: daos_server\nprovider: ofi+tcp\n",
+		"host1/DmgNodeLogs/daos_server_version":          "daos_server version 2.6.0\n",
+		"host1/GenSystemInfo/rpm_qa":                     "(Mon Jan 1): daos-2.6.0\n(Mon Jan 1): libfabric-1.20\n",
+		"DmgSystemLogs/dmg_storage_scan":                 "nvme: 2\n",
+		"host1/EngineLogs/daos_engine_0.log":             engineLog(errLine("rdb_raft_step() timed out after 5s")),
+		"host1/GenSystemInfo/df_-h":                      "ignored\n",
+		"host1/AgentConfig/daos_agent.yml":               "name: daos_server\n",
+		"host1/DmgNodeLogs/dmg_storage_query_list-pools": "ignored\n",
+	}
+	bundleB := map[string]string{
+		"host1/DaosServerConfig/daos_server.yml": "name: daos_server\nprovider: ofi+verbs\n",
+		"host1/DmgNodeLogs/daos_server_version":  "daos_server version 2.6.0\n",
+		"host1/GenSystemInfo/rpm_qa":             "(Tue Jan 2): libfabric-1.22\n(Tue Jan 2): daos-2.6.0\n",
+		"DmgSystemLogs/dmg_storage_scan":         "nvme: 2\n",
+		"host1/EngineLogs/daos_engine_0.log": engineLog(
+			errLine("rdb_raft_step() timed out after 7s"),
+			errLine("rdb_raft_step() timed out after 9s"),
+			errLine("rdb_raft_elect() no quorum"),
+		),
+		"host1/GenSystemInfo/df_-h": "still ignored\n",
+	}
+
+	writeBundle := func(t *testing.T, dir string, files map[string]string) {
+		t.Helper()
+
+		for name, content := range files {
+			path := filepath.Join(dir, name)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	expDiff := func(a, b string) *BundleDiff {
+		return &BundleDiff{
+			A: a,
+			B: b,
+			Files: []*FileDiff{
+				{
+					Path:     "host1/AgentConfig/daos_agent.yml",
+					OnlyInA:  true,
+					Category: string(BundleConfigs),
+				},
+				{
+					Path:     "host1/DaosServerConfig/daos_server.yml",
+					Category: string(BundleConfigs),
+					Lines:    []string{"-provider: ofi+tcp", "+provider: ofi+verbs"},
+				},
+				{
+					Path:     "host1/GenSystemInfo/rpm_qa",
+					Category: string(BundleVersions),
+					Lines:    []string{"-libfabric-1.20", "+libfabric-1.22"},
+				},
+			},
+			Signatures: []*SignatureDiff{
+				{
+					Signature: "rdb ERR src/rdb/rdb_raft.c:N rdb_raft_elect() no quorum",
+					CountB:    1,
+				},
+				{
+					Signature: "rdb ERR src/rdb/rdb_raft.c:N rdb_raft_step() timed out after Ns",
+					CountA:    1,
+					CountB:    2,
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		archive bool
+	}{
+		"folders":  {},
+		"archives": {archive: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			paths := make([]string, 2)
+			for i, files := range []map[string]string{bundleA, bundleB} {
+				dir := filepath.Join(tmpDir, string(rune('a'+i)))
+				writeBundle(t, dir, files)
+				paths[i] = dir
+
+				if tc.archive {
+					var archive bytes.Buffer
+					if err := common.FolderCompress(dir, &archive); err != nil {
+						t.Fatal(err)
+					}
+					paths[i] = dir + ".tar.gz"
+					if err := os.WriteFile(paths[i], archive.Bytes(), 0644); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			a, err := LoadBundle(log, paths[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := LoadBundle(log, paths[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotDiff := DiffBundles(a, b)
+			if diff := cmp.Diff(expDiff(paths[0], paths[1]), gotDiff); diff != "" {
+				t.Fatalf("unexpected diff (-want, +got):\n%s\n", diff)
+			}
+
+			var out strings.Builder
+			PrintBundleDiff(&out, gotDiff)
+			for _, exp := range []string{
+				"host1/AgentConfig/daos_agent.yml: only in A",
+				"  +provider: ofi+verbs",
+				"Device Inventory\n----------------\nNo differences.",
+				"       0        1  rdb ERR src/rdb/rdb_raft.c:N rdb_raft_elect() no quorum",
+			} {
+				if !strings.Contains(out.String(), exp) {
+					t.Fatalf("expected output to contain %q, got:\n%s", exp, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestSupport_LoadBundle_Errors(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	notArchive := filepath.Join(tmpDir, "not_archive.tar")
+	if err := os.WriteFile(notArchive, []byte("not an archive"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	emptyDir := filepath.Join(tmpDir, "empty")
+	if err := os.Mkdir(emptyDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		path   string
+		expErr error
+	}{
+		"missing": {
+			path:   filepath.Join(tmpDir, "missing"),
+			expErr: errors.New("no such file"),
+		},
+		"not an archive": {
+			path:   notArchive,
+			expErr: errors.New("unable to load support bundle"),
+		},
+		"empty": {
+			path:   emptyDir,
+			expErr: errors.New("no collected support logs"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadBundle(log, tc.path)
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+}
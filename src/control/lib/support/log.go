@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +11,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -43,6 +45,7 @@ const (
 	CopyAgentConfigEnum
 	RsyncLogEnum
 	ArchiveLogsEnum
+	CollectManifestEnum
 )
 
 type CollectLogSubCmd struct {
@@ -55,6 +58,7 @@ type CollectLogSubCmd struct {
 	LogStartTime         string `short:"S" long:"log-start-time" description:"Specify the log collection start time, Format: HH:MM:SS"`
 	LogEndTime           string `short:"E" long:"log-end-time" description:"Specify the log collection end time, Format: HH:MM:SS"`
 	FileTransferExecArgs string `short:"T" long:"transfer-args" description:"Extra arguments for alternate file transfer tool"`
+	CollectorsManifest   string `short:"m" long:"collectors-manifest" description:"YAML manifest of additional site-specific collectors to run"`
 }
 
 type LogTypeSubCmd struct {
@@ -67,6 +71,8 @@ const (
 	MMDDHHMMSS      = "1/2-15:4:5"
 	MMDDYYYY_HHMMSS = "1-2-2006 15:4:5"
 	YYYYMMDD_HHMMSS = "2006/1/2 15:4:5"
+	journalTime     = "2006-01-02 15:04:05"
+	dmesgISOTime    = "2006-01-02T15:04:05,000000-07:00"
 )
 
 // Folder names to copy logs and configs
@@ -83,6 +89,7 @@ const (
 	agentConfig      = "AgentConfig"      // Copy the Agent config
 	agentLogs        = "AgentLogs"        // Copy the Agent log
 	extraLogs        = "ExtraLogs"        // Copy the Custom logs
+	siteCollectors   = "SiteCollectors"   // Copy the output of the manifest collectors
 )
 
 const DmgListDeviceCmd = "dmg storage query list-devices"
@@ -108,7 +115,14 @@ var AgentCmd = []string{
 	"daos_agent dump-topology",
 }
 
+// JournalUnits are the systemd units whose journal entries are collected.
+var JournalUnits = []string{
+	"daos_server",
+	"daos_agent",
+}
+
 var SystemCmd = []string{
+	"journalctl",
 	"dmesg",
 	"df -h",
 	"mount",
@@ -581,6 +595,147 @@ func collectCmdOutput(folderName string, log logging.Logger, opts ...CollectLogs
 	return nil
 }
 
+// Get the local start/end time of the log collection window, or zero times if no dates were
+// provided by user.
+func getLogWindow(log logging.Logger, opts ...CollectLogsParams) (time.Time, time.Time, error) {
+	if opts[0].LogStartDate == "" && opts[0].LogEndDate == "" {
+		return time.Time{}, time.Time{}, nil
+	}
+
+	startTime, endTime, err := getDateTime(log, opts...)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	// The user provided date and time are in the local time zone of the node.
+	toLocal := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.Local)
+	}
+
+	return toLocal(startTime), toLocal(endTime), nil
+}
+
+// Build the journalctl arguments to export the journal entries of a unit within the window.
+func journalctlArgs(unit string, startTime, endTime time.Time) []string {
+	args := []string{"--no-pager", "--output=json", "--unit=" + unit}
+	if !startTime.IsZero() {
+		args = append(args, "--since="+startTime.Format(journalTime))
+	}
+	if !endTime.IsZero() {
+		args = append(args, "--until="+endTime.Format(journalTime))
+	}
+
+	return args
+}
+
+// Collect the systemd journal entries of the DAOS units. Entries are exported as JSON, one
+// entry per line, into a file per unit.
+func collectJournal(log logging.Logger, opts ...CollectLogsParams) error {
+	startTime, endTime, err := getLogWindow(log, opts...)
+	if err != nil {
+		return err
+	}
+
+	targetLocation, err := createHostLogFolder(genSystemInfo, log, opts...)
+	if err != nil {
+		return err
+	}
+
+	for _, unit := range JournalUnits {
+		args := journalctlArgs(unit, startTime, endTime)
+		log.Debugf("Collecting journal = journalctl %s", strings.Join(args, " "))
+
+		out, err := exec.Command("journalctl", args...).Output()
+		if err != nil {
+			return errors.Wrapf(err, "failed to collect journal for unit %s", unit)
+		}
+
+		fileName := filepath.Join(targetLocation, fmt.Sprintf("journalctl_%s.json", unit))
+		if err := os.WriteFile(fileName, out, 0644); err != nil {
+			return errors.Wrapf(err, "failed to write %s", fileName)
+		}
+	}
+
+	return nil
+}
+
+// dmesgRecord is a kernel ring buffer message as stored in the collected dmesg file.
+type dmesgRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+}
+
+// Copy the kernel messages read from dmesg output in ISO time format that fall within the
+// start/end time to the writer, as JSON records one per line. A zero start or end time leaves
+// the window open on that side.
+func filterDmesg(r io.Reader, w io.Writer, startTime, endTime time.Time) error {
+	enc := json.NewEncoder(w)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineData := scanner.Text()
+
+		tsStr, msg, _ := strings.Cut(lineData, " ")
+		ts, err := time.Parse(dmesgISOTime, tsStr)
+		if err != nil {
+			// Skip lines without a valid time stamp.
+			continue
+		}
+		if !startTime.IsZero() && ts.Before(startTime) {
+			continue
+		}
+		if !endTime.IsZero() && ts.After(endTime) {
+			continue
+		}
+
+		if err := enc.Encode(&dmesgRecord{Timestamp: ts, Message: msg}); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// Collect the kernel ring buffer messages logged within the log collection window.
+func collectDmesg(log logging.Logger, opts ...CollectLogsParams) error {
+	startTime, endTime, err := getLogWindow(log, opts...)
+	if err != nil {
+		return err
+	}
+
+	targetLocation, err := createHostLogFolder(genSystemInfo, log, opts...)
+	if err != nil {
+		return err
+	}
+
+	out, err := exec.Command("dmesg", "--time-format=iso").Output()
+	if err != nil {
+		return errors.Wrap(err, "failed to collect dmesg")
+	}
+
+	fileName := filepath.Join(targetLocation, "dmesg.json")
+	log.Debugf("Collecting kernel messages = dmesg > %s", fileName)
+	writeFile, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer writeFile.Close()
+
+	return filterDmesg(bytes.NewReader(out), writeFile, startTime, endTime)
+}
+
+// Collect system information, restricting the journal and kernel messages to the log
+// collection window.
+func collectSystemCmd(log logging.Logger, opts ...CollectLogsParams) error {
+	switch opts[0].LogCmd {
+	case "journalctl":
+		return collectJournal(log, opts...)
+	case "dmesg":
+		return collectDmesg(log, opts...)
+	default:
+		return collectCmdOutput(genSystemInfo, log, opts...)
+	}
+}
+
 // Collect client side log
 func collectClientLog(log logging.Logger, opts ...CollectLogsParams) error {
 	clientLogFile := os.Getenv("D_LOG_FILE")
@@ -985,34 +1140,10 @@ func collectDaosServerCmd(log logging.Logger, opts ...CollectLogsParams) error {
 
 // Common Entry/Exit point function.
 func CollectSupportLog(log logging.Logger, opts ...CollectLogsParams) error {
-	switch opts[0].LogFunction {
-	case CopyServerConfigEnum:
-		return copyServerConfig(log, opts...)
-	case CollectSystemCmdEnum:
-		return collectCmdOutput(genSystemInfo, log, opts...)
-	case CollectServerLogEnum:
-		return collectServerLog(log, opts...)
-	case CollectExtraLogsDirEnum:
-		return collectExtraLogsDir(log, opts...)
-	case CollectDaosServerCmdEnum:
-		return collectDaosServerCmd(log, opts...)
-	case CollectDmgCmdEnum:
-		return collectDmgCmd(log, opts...)
-	case CollectDmgDiskInfoEnum:
-		return collectDmgDiskInfo(log, opts...)
-	case CollectAgentCmdEnum:
-		return collectCmdOutput(daosAgentCmdInfo, log, opts...)
-	case CollectClientLogEnum:
-		return collectClientLog(log, opts...)
-	case CollectAgentLogEnum:
-		return collectAgentLog(log, opts...)
-	case CopyAgentConfigEnum:
-		return copyAgentConfig(log, opts...)
-	case RsyncLogEnum:
-		return rsyncLog(log, opts...)
-	case ArchiveLogsEnum:
-		return ArchiveLogs(log, opts...)
+	collect, found := builtinCollectors[opts[0].LogFunction]
+	if !found {
+		return errors.Errorf("unknown log function %d", opts[0].LogFunction)
 	}
 
-	return nil
+	return collect(log, opts...)
 }
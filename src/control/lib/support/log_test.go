@@ -15,6 +15,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common/test"
@@ -1281,3 +1282,135 @@ func TestSupport_getDateTime(t *testing.T) {
 		})
 	}
 }
+
+func TestSupport_getLogWindow(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	for name, tc := range map[string]struct {
+		params       CollectLogsParams
+		expStartTime time.Time
+		expEndTime   time.Time
+		expErr       error
+	}{
+		"No Dates": {},
+		"Invalid Date": {
+			params: CollectLogsParams{
+				LogStartDate: "1-2-2023",
+				LogEndDate:   "13-3-2023",
+			},
+			expErr: errors.New("month out of range"),
+		},
+		"Valid Date and Time": {
+			params: CollectLogsParams{
+				LogStartDate: "1-2-2023",
+				LogEndDate:   "1-3-2023",
+				LogStartTime: "10:10:10",
+			},
+			expStartTime: time.Date(2023, 1, 2, 10, 10, 10, 0, time.Local),
+			expEndTime:   time.Date(2023, 1, 3, 23, 59, 59, 0, time.Local),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			startTime, endTime, gotErr := getLogWindow(log, tc.params)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			if !tc.expStartTime.Equal(startTime) {
+				t.Fatalf("Expected StartTime:=%s But Got :=%s", tc.expStartTime, startTime)
+			}
+			if !tc.expEndTime.Equal(endTime) {
+				t.Fatalf("Expected EndTime:=%s But Got :=%s", tc.expEndTime, endTime)
+			}
+		})
+	}
+}
+
+func TestSupport_journalctlArgs(t *testing.T) {
+	startTime := time.Date(2023, 1, 2, 10, 10, 10, 0, time.Local)
+	endTime := time.Date(2023, 1, 3, 12, 12, 12, 0, time.Local)
+
+	for name, tc := range map[string]struct {
+		startTime time.Time
+		endTime   time.Time
+		expArgs   []string
+	}{
+		"No Window": {
+			expArgs: []string{"--no-pager", "--output=json", "--unit=daos_server"},
+		},
+		"Start Time Only": {
+			startTime: startTime,
+			expArgs: []string{"--no-pager", "--output=json", "--unit=daos_server",
+				"--since=2023-01-02 10:10:10"},
+		},
+		"Start and End Time": {
+			startTime: startTime,
+			endTime:   endTime,
+			expArgs: []string{"--no-pager", "--output=json", "--unit=daos_server",
+				"--since=2023-01-02 10:10:10", "--until=2023-01-03 12:12:12"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotArgs := journalctlArgs("daos_server", tc.startTime, tc.endTime)
+			test.CmpAny(t, "journalctl args", tc.expArgs, gotArgs)
+		})
+	}
+}
+
+func TestSupport_filterDmesg(t *testing.T) {
+	dmesgOut := strings.Join([]string{
+		"2023-01-02T09:00:00,000000+00:00 early message",
+		"2023-01-02T10:30:00,123456+00:00 in window",
+		"no time stamp",
+		"2023-01-02T11:00:00,000000+00:00 also in window",
+		"2023-01-02T13:00:00,000000+00:00 late message",
+	}, "\n")
+	mustParse := func(ts string) time.Time {
+		t.Helper()
+		tm, err := time.Parse(dmesgISOTime, ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+	record := func(ts, msg string) string {
+		return fmt.Sprintf(`{"timestamp":%q,"message":%q}`+"\n",
+			mustParse(ts).Format(time.RFC3339Nano), msg)
+	}
+
+	for name, tc := range map[string]struct {
+		startTime time.Time
+		endTime   time.Time
+		expOut    string
+	}{
+		"No Window": {
+			expOut: record("2023-01-02T09:00:00,000000+00:00", "early message") +
+				record("2023-01-02T10:30:00,123456+00:00", "in window") +
+				record("2023-01-02T11:00:00,000000+00:00", "also in window") +
+				record("2023-01-02T13:00:00,000000+00:00", "late message"),
+		},
+		"Window": {
+			startTime: time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC),
+			endTime:   time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
+			expOut: record("2023-01-02T10:30:00,123456+00:00", "in window") +
+				record("2023-01-02T11:00:00,000000+00:00", "also in window"),
+		},
+		"Window In Other Time Zone": {
+			startTime: time.Date(2023, 1, 2, 12, 0, 0, 0, time.FixedZone("", 2*3600)),
+			expOut: record("2023-01-02T10:30:00,123456+00:00", "in window") +
+				record("2023-01-02T11:00:00,000000+00:00", "also in window") +
+				record("2023-01-02T13:00:00,000000+00:00", "late message"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			if err := filterDmesg(strings.NewReader(dmesgOut), &out, tc.startTime, tc.endTime); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expOut, out.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package support
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// ReportFile is the name of the summary report written to the top of
+	// the log folder.
+	ReportFile = "report.md"
+
+	// reportMaxFactLines is the maximum number of lines of a collected file
+	// to be included in the report.
+	reportMaxFactLines = 100
+)
+
+// reportFact defines a key fact about the system to be included in the report,
+// taken from the collected files matching the patterns relative to the log
+// folder.
+type reportFact struct {
+	title    string
+	patterns []string
+}
+
+var reportFacts = []reportFact{
+	{
+		title: "Versions",
+		patterns: []string{
+			filepath.Join("*", dmgNodeLogs, "daos_server_version"),
+			filepath.Join("*", daosAgentCmdInfo, "daos_agent_version"),
+		},
+	},
+	{
+		title:    "Engine Status",
+		patterns: []string{filepath.Join(dmgSystemLogs, "dmg_system_query")},
+	},
+	{
+		title: "Device Inventory",
+		patterns: []string{
+			filepath.Join(dmgSystemLogs, "dmg_storage_scan"),
+			filepath.Join("*", dmgNodeLogs, "dmg_storage_query_list-devices"),
+		},
+	},
+}
+
+// ReportStep records the outcome of a log collection step.
+type ReportStep struct {
+	Name   string
+	Errors []string
+}
+
+// Report records the outcome of the steps of a log collection, in order to
+// write a summary of the collection into the log folder.
+type Report struct {
+	Command string
+	Started time.Time
+	Steps   []*ReportStep
+}
+
+// NewReport returns a Report for a log collection run by the given command.
+func NewReport(command string) *Report {
+	return &Report{
+		Command: command,
+		Started: time.Now(),
+	}
+}
+
+// StepName returns a description of the log collection step for the given
+// log function and command.
+func StepName(logFunc int32, logCmd string) string {
+	if logFunc == CollectManifestEnum {
+		return fmt.Sprintf("collectors manifest %s", logCmd)
+	}
+	if logCmd != "" {
+		return logCmd
+	}
+
+	switch logFunc {
+	case CopyServerConfigEnum:
+		return "copy server config"
+	case CollectExtraLogsDirEnum:
+		return "collect extra logs dir"
+	case CollectDmgDiskInfoEnum:
+		return "collect device info"
+	case CollectClientLogEnum:
+		return "collect client logs"
+	case CollectAgentLogEnum:
+		return "collect agent logs"
+	case CopyAgentConfigEnum:
+		return "copy agent config"
+	case RsyncLogEnum:
+		return "rsync logs to admin node"
+	case ArchiveLogsEnum:
+		return "archive logs"
+	default:
+		return fmt.Sprintf("log function %d", logFunc)
+	}
+}
+
+// AddStep records the outcome of a log collection step. The step is reported
+// as failed if any of the supplied error messages is non-empty.
+func (r *Report) AddStep(name string, errs ...string) {
+	step := &ReportStep{Name: name}
+	for _, err := range errs {
+		if err != "" {
+			step.Errors = append(step.Errors, err)
+		}
+	}
+	r.Steps = append(r.Steps, step)
+}
+
+// AddStepErr records the outcome of a log collection step that returned the
+// supplied error.
+func (r *Report) AddStepErr(name string, err error) {
+	if err != nil {
+		r.AddStep(name, err.Error())
+		return
+	}
+	r.AddStep(name)
+}
+
+// escapeCell escapes a string for inclusion in a Markdown table cell.
+func escapeCell(in string) string {
+	in = strings.ReplaceAll(in, "|", "\\|")
+	return strings.Join(strings.Fields(in), " ")
+}
+
+func writeFact(out io.Writer, folder, path string) error {
+	f, err := os.Open(filepath.Join(folder, path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fmt.Fprintf(out, "### %s\n\n```\n", filepath.ToSlash(path))
+	scanner := bufio.NewScanner(f)
+	lines := 0
+	for scanner.Scan() {
+		if lines == reportMaxFactLines {
+			fmt.Fprintf(out, "... (truncated, see %s)\n", filepath.ToSlash(path))
+			break
+		}
+		fmt.Fprintln(out, scanner.Text())
+		lines++
+	}
+	fmt.Fprint(out, "```\n\n")
+
+	return scanner.Err()
+}
+
+func writeFacts(log logging.Logger, out io.Writer, folder string) {
+	for _, fact := range reportFacts {
+		var paths []string
+		for _, pattern := range fact.patterns {
+			matches, err := filepath.Glob(filepath.Join(folder, pattern))
+			if err != nil {
+				continue
+			}
+			for _, match := range matches {
+				if rel, err := filepath.Rel(folder, match); err == nil {
+					paths = append(paths, rel)
+				}
+			}
+		}
+		sort.Strings(paths)
+
+		fmt.Fprintf(out, "## %s\n\n", fact.title)
+		if len(paths) == 0 {
+			fmt.Fprint(out, "Not collected.\n\n")
+			continue
+		}
+		for _, path := range paths {
+			if err := writeFact(out, folder, path); err != nil {
+				log.Debugf("unable to add %s to report: %s", path, err)
+			}
+		}
+	}
+}
+
+func writeItems(out io.Writer, folder string) error {
+	fmt.Fprint(out, "## Collected Items\n\n| File | Size |\n|-|-|\n")
+
+	return filepath.WalkDir(folder, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(folder, path)
+		if err != nil {
+			return err
+		}
+		if rel == ReportFile {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "| %s | %s |\n", escapeCell(filepath.ToSlash(rel)), humanize.IBytes(uint64(info.Size())))
+		return nil
+	})
+}
+
+// WriteTo writes the report in Markdown format, including the outcome of
+// each step, key facts about the system taken from the collected files, and
+// a list of the collected files in the folder.
+func (r *Report) WriteTo(log logging.Logger, out io.Writer, folder string) error {
+	hostName, err := GetHostName()
+	if err != nil {
+		hostName = "unknown host"
+	}
+
+	fmt.Fprint(out, "# DAOS Support Log Summary\n\n")
+	fmt.Fprintf(out, "Collected by `%s` on %s at %s (took %s).\n\n", r.Command, hostName,
+		r.Started.Format(time.RFC3339), time.Since(r.Started).Round(time.Second))
+
+	var failed int
+	for _, step := range r.Steps {
+		if len(step.Errors) > 0 {
+			failed++
+		}
+	}
+	fmt.Fprintf(out, "## Collection Steps\n\n%d steps, %d failed.\n\n| Step | Result |\n|-|-|\n",
+		len(r.Steps), failed)
+	for _, step := range r.Steps {
+		result := "OK"
+		if len(step.Errors) > 0 {
+			result = "FAILED: " + strings.Join(step.Errors, "; ")
+		}
+		fmt.Fprintf(out, "| %s | %s |\n", escapeCell(step.Name), escapeCell(result))
+	}
+	fmt.Fprintln(out)
+
+	writeFacts(log, out, folder)
+
+	return writeItems(out, folder)
+}
+
+// Write writes the report to the top of the log folder.
+func (r *Report) Write(log logging.Logger, folder string) error {
+	path := filepath.Join(folder, ReportFile)
+	log.Debugf("Writing support log summary report to %s", path)
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrap(err, "unable to create summary report")
+	}
+	defer f.Close()
+
+	if err := r.WriteTo(log, f, folder); err != nil {
+		return errors.Wrap(err, "unable to write summary report")
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package support
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestSupport_StepName(t *testing.T) {
+	for name, tc := range map[string]struct {
+		logFunc int32
+		logCmd  string
+		expName string
+	}{
+		"command": {
+			logFunc: CollectSystemCmdEnum,
+			logCmd:  "dmesg",
+			expName: "dmesg",
+		},
+		"no command": {
+			logFunc: CopyServerConfigEnum,
+			expName: "copy server config",
+		},
+		"collectors manifest": {
+			logFunc: CollectManifestEnum,
+			logCmd:  "/etc/daos/collectors.yml",
+			expName: "collectors manifest /etc/daos/collectors.yml",
+		},
+		"unknown function": {
+			logFunc: 42,
+			expName: "log function 42",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expName, StepName(tc.logFunc, tc.logCmd), "unexpected step name")
+		})
+	}
+}
+
+func TestSupport_Report_Write(t *testing.T) {
+	var longFile strings.Builder
+	for i := 0; i < reportMaxFactLines+10; i++ {
+		longFile.WriteString("line\n")
+	}
+
+	for name, tc := range map[string]struct {
+		files      map[string]string
+		steps      func(*Report)
+		expStrs    []string
+		notExpStrs []string
+	}{
+		"nothing collected": {
+			steps: func(r *Report) {
+				r.AddStepErr("dmg system query", errors.New("connection refused"))
+			},
+			expStrs: []string{
+				"Collected by `dmg support collect-log`",
+				"1 steps, 1 failed.",
+				"| dmg system query | FAILED: connection refused |",
+				"## Versions\n\nNot collected.",
+				"## Engine Status\n\nNot collected.",
+				"## Device Inventory\n\nNot collected.",
+				"| File | Size |\n|-|-|\n",
+			},
+		},
+		"collected files": {
+			files: map[string]string{
+				"DmgSystemLogs/dmg_system_query":        "Rank State\n---- -----\n0    Joined\n",
+				"DmgSystemLogs/dmg_storage_scan":        longFile.String(),
+				"host1/DmgNodeLogs/daos_server_version": "daos_server version 2.7.100\n",
+				"host2/DmgNodeLogs/daos_server_version": "daos_server version 2.7.101\n",
+				"host1/EngineLogs/daos_engine_0.log":    "log",
+			},
+			steps: func(r *Report) {
+				r.AddStepErr("dmg system query", nil)
+				r.AddStep("journalctl", "", "host[1-2]: no | pipe")
+				r.AddStep("copy server config")
+			},
+			expStrs: []string{
+				"3 steps, 1 failed.",
+				"| dmg system query | OK |",
+				"| journalctl | FAILED: host[1-2]: no \\| pipe |",
+				"| copy server config | OK |",
+				"### host1/DmgNodeLogs/daos_server_version\n\n```\ndaos_server version 2.7.100\n```",
+				"### host2/DmgNodeLogs/daos_server_version\n\n```\ndaos_server version 2.7.101\n```",
+				"## Engine Status\n\n### DmgSystemLogs/dmg_system_query\n\n```\nRank State\n---- -----\n0    Joined\n```",
+				"line\n... (truncated, see DmgSystemLogs/dmg_storage_scan)\n```",
+				"| host1/EngineLogs/daos_engine_0.log | 3 B |",
+				"| DmgSystemLogs/dmg_system_query | 34 B |",
+			},
+			notExpStrs: []string{
+				"Not collected.",
+				"| " + ReportFile,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			folder, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			for path, content := range tc.files {
+				path = filepath.Join(folder, path)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			report := NewReport("dmg support collect-log")
+			tc.steps(report)
+
+			// Write twice to verify that the report does not list itself.
+			for i := 0; i < 2; i++ {
+				if err := report.Write(log, folder); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			out, err := os.ReadFile(filepath.Join(folder, ReportFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, exp := range tc.expStrs {
+				if !strings.Contains(string(out), exp) {
+					t.Errorf("expected %q in report:\n%s", exp, out)
+				}
+			}
+			for _, notExp := range tc.notExpStrs {
+				if strings.Contains(string(out), notExp) {
+					t.Errorf("unexpected %q in report:\n%s", notExp, out)
+				}
+			}
+		})
+	}
+}
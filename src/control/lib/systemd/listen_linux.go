@@ -0,0 +1,76 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package systemd
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// listenFDsStart is the first file descriptor passed by systemd socket activation.
+const listenFDsStart = 3
+
+// ListenFDs returns the files passed to the process by systemd socket
+// activation (see sd_listen_fds(3)), named with the FileDescriptorName= of the
+// socket unit, if set. If the process was not socket-activated, no files are
+// returned. If unsetEnv is true, the environment variables used to pass the
+// files are removed so that they are not inherited by child processes.
+func ListenFDs(unsetEnv bool) ([]*os.File, error) {
+	return listenFDs(listenFDsStart, unsetEnv)
+}
+
+func listenFDs(start int, unsetEnv bool) ([]*os.File, error) {
+	if unsetEnv {
+		defer func() {
+			os.Unsetenv("LISTEN_PID")
+			os.Unsetenv("LISTEN_FDS")
+			os.Unsetenv("LISTEN_FDNAMES")
+		}()
+	}
+
+	pidStr := os.Getenv("LISTEN_PID")
+	if pidStr == "" {
+		return nil, nil
+	}
+	pid, err := strconv.Atoi(pidStr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid LISTEN_PID %q", pidStr)
+	}
+	if pid != os.Getpid() {
+		// The files were passed to a different process.
+		return nil, nil
+	}
+
+	nfdsStr := os.Getenv("LISTEN_FDS")
+	nfds, err := strconv.Atoi(nfdsStr)
+	if err != nil || nfds < 0 {
+		return nil, errors.Errorf("invalid LISTEN_FDS %q", nfdsStr)
+	}
+
+	var names []string
+	if namesStr := os.Getenv("LISTEN_FDNAMES"); namesStr != "" {
+		names = strings.Split(namesStr, ":")
+	}
+
+	files := make([]*os.File, 0, nfds)
+	for i := 0; i < nfds; i++ {
+		fd := start + i
+		syscall.CloseOnExec(fd)
+
+		name := "LISTEN_FD_" + strconv.Itoa(fd)
+		if i < len(names) && names[i] != "" {
+			name = names[i]
+		}
+		files = append(files, os.NewFile(uintptr(fd), name))
+	}
+
+	return files, nil
+}
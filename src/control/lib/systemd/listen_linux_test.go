@@ -0,0 +1,142 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package systemd
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSystemd_listenFDs(t *testing.T) {
+	// Create a pair of consecutive file descriptors to be passed as if by
+	// systemd. They are closed by the test if they are not returned.
+	setupFDs := func(t *testing.T) int {
+		t.Helper()
+
+		l, err := net.Listen("unix", filepath.Join(t.TempDir(), "test.sock"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+		f, err := l.(*net.UnixListener).File()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		var fds []int
+		for i := 0; i < 2; i++ {
+			fd, err := syscall.Dup(int(f.Fd()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			fds = append(fds, fd)
+		}
+		if fds[1] != fds[0]+1 {
+			for _, fd := range fds {
+				syscall.Close(fd)
+			}
+			t.Skipf("file descriptors %v are not consecutive", fds)
+		}
+		return fds[0]
+	}
+
+	pid := strconv.Itoa(os.Getpid())
+	for name, tc := range map[string]struct {
+		env      map[string]string
+		expNames []string
+		expErr   error
+	}{
+		"not socket-activated": {},
+		"different process": {
+			env: map[string]string{
+				"LISTEN_PID": strconv.Itoa(os.Getpid() + 1),
+				"LISTEN_FDS": "2",
+			},
+		},
+		"bad pid": {
+			env: map[string]string{
+				"LISTEN_PID": "foo",
+				"LISTEN_FDS": "2",
+			},
+			expErr: errors.New("invalid LISTEN_PID"),
+		},
+		"bad fd count": {
+			env: map[string]string{
+				"LISTEN_PID": pid,
+				"LISTEN_FDS": "-1",
+			},
+			expErr: errors.New("invalid LISTEN_FDS"),
+		},
+		"unnamed": {
+			env: map[string]string{
+				"LISTEN_PID": pid,
+				"LISTEN_FDS": "2",
+			},
+			expNames: []string{"LISTEN_FD_%d", "LISTEN_FD_%d"},
+		},
+		"named": {
+			env: map[string]string{
+				"LISTEN_PID":     pid,
+				"LISTEN_FDS":     "2",
+				"LISTEN_FDNAMES": "daos_agent:other",
+			},
+			expNames: []string{"daos_agent", "other"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			start := setupFDs(t)
+			for key, val := range tc.env {
+				t.Setenv(key, val)
+			}
+
+			files, err := listenFDs(start, true)
+			if len(files) == 0 {
+				syscall.Close(start)
+				syscall.Close(start + 1)
+			}
+			for _, f := range files {
+				defer f.Close()
+			}
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotNames []string
+			for i, f := range files {
+				test.AssertEqual(t, uintptr(start+i), f.Fd(), "unexpected file descriptor")
+				gotNames = append(gotNames, f.Name())
+			}
+			var expNames []string
+			for i, n := range tc.expNames {
+				if n == "LISTEN_FD_%d" {
+					n = "LISTEN_FD_" + strconv.Itoa(start+i)
+				}
+				expNames = append(expNames, n)
+			}
+			if diff := cmp.Diff(expNames, gotNames); diff != "" {
+				t.Fatalf("unexpected files (-want, +got):\n%s\n", diff)
+			}
+
+			for _, key := range []string{"LISTEN_PID", "LISTEN_FDS", "LISTEN_FDNAMES"} {
+				if _, set := os.LookupEnv(key); set {
+					t.Fatalf("expected %s to be unset", key)
+				}
+			}
+		})
+	}
+}
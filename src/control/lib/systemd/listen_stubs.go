@@ -0,0 +1,17 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package systemd
+
+import "os"
+
+// ListenFDs returns no files, as there is no systemd socket activation on
+// this platform.
+func ListenFDs(unsetEnv bool) ([]*os.File, error) {
+	return nil, nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,7 +8,12 @@
 package systemd
 
 import (
-	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
 )
 
 // ErrSdNotifyNoSocket is the error returned when the NOTIFY_SOCKET does not exist.
@@ -22,3 +28,63 @@ func Ready() error {
 func Stopping() error {
 	return SdNotify("STOPPING=1")
 }
+
+// Reloading sends RELOADING=1 to the systemd notify socket, along with the
+// time at which the reload started, as required by Type=notify-reload services.
+func Reloading() error {
+	return SdNotify(fmt.Sprintf("RELOADING=1\nMONOTONIC_USEC=%d", monotonicUsec()))
+}
+
+// Watchdog sends WATCHDOG=1 to the systemd notify socket, to keep the service
+// from being considered as hung by the systemd watchdog.
+func Watchdog() error {
+	return SdNotify("WATCHDOG=1")
+}
+
+// Status sends a free-form status message to the systemd notify socket, to be
+// displayed by `systemctl status`.
+func Status(msg string) error {
+	return SdNotify("STATUS=" + msg)
+}
+
+// Reload notifies systemd that the service is reloading while the supplied
+// function runs, and that it is ready again once the function has returned.
+// If there is no systemd notify socket, the function is run without notifying.
+func Reload(reload func()) error {
+	if err := Reloading(); err != nil && err != ErrSdNotifyNoSocket {
+		return err
+	}
+	reload()
+	if err := Ready(); err != nil && err != ErrSdNotifyNoSocket {
+		return err
+	}
+	return nil
+}
+
+// WatchdogInterval returns the interval within which systemd expects to
+// receive watchdog keep-alive pings from the process, as set by the
+// WatchdogSec= setting of the service (see sd_watchdog_enabled(3)). Zero is
+// returned if the watchdog is not enabled for the process.
+func WatchdogInterval() (time.Duration, error) {
+	usecStr := os.Getenv("WATCHDOG_USEC")
+	if usecStr == "" {
+		return 0, nil
+	}
+	usec, err := strconv.ParseUint(usecStr, 10, 64)
+	if err != nil || usec == 0 {
+		return 0, errors.Errorf("invalid WATCHDOG_USEC %q", usecStr)
+	}
+
+	if pidStr := os.Getenv("WATCHDOG_PID"); pidStr != "" {
+		pid, err := strconv.Atoi(pidStr)
+		if err != nil {
+			return 0, errors.Errorf("invalid WATCHDOG_PID %q", pidStr)
+		}
+		if pid != os.Getpid() {
+			// The watchdog is enabled for a different process.
+			return 0, nil
+		}
+	}
+
+	return time.Duration(usec) * time.Microsecond, nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,8 @@ package systemd
 import (
 	"net"
 	"os"
+
+	"golang.org/x/sys/unix"
 )
 
 // SdNotify sends a specified string to the systemd notification socket.
@@ -27,3 +30,13 @@ func SdNotify(state string) error {
 	_, err = conn.Write([]byte(state))
 	return err
 }
+
+// monotonicUsec returns the current time of the monotonic clock used by
+// systemd, in microseconds.
+func monotonicUsec() int64 {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return 0
+	}
+	return ts.Nano() / 1000
+}
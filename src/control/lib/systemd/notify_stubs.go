@@ -0,0 +1,19 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package systemd
+
+// SdNotify returns ErrSdNotifyNoSocket, as there is no systemd on this
+// platform.
+func SdNotify(state string) error {
+	return ErrSdNotifyNoSocket
+}
+
+func monotonicUsec() int64 {
+	return 0
+}
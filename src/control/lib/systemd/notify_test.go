@@ -1,8 +1,11 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
+//go:build linux
+// +build linux
 
 package systemd_test
 
@@ -11,7 +14,10 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/systemd"
@@ -58,3 +64,84 @@ func Test_Systemd_SdNotify(t *testing.T) {
 		})
 	}
 }
+
+func Test_Systemd_WatchdogInterval(t *testing.T) {
+	for name, tc := range map[string]struct {
+		usec        string
+		pid         string
+		expInterval time.Duration
+		expErr      error
+	}{
+		"not enabled": {},
+		"enabled": {
+			usec:        "30000000",
+			expInterval: 30 * time.Second,
+		},
+		"enabled for process": {
+			usec:        "500000",
+			pid:         strconv.Itoa(os.Getpid()),
+			expInterval: 500 * time.Millisecond,
+		},
+		"enabled for different process": {
+			usec: "500000",
+			pid:  strconv.Itoa(os.Getpid() + 1),
+		},
+		"bad interval": {
+			usec:   "foo",
+			expErr: errors.New("invalid WATCHDOG_USEC"),
+		},
+		"zero interval": {
+			usec:   "0",
+			expErr: errors.New("invalid WATCHDOG_USEC"),
+		},
+		"bad pid": {
+			usec:   "500000",
+			pid:    "foo",
+			expErr: errors.New("invalid WATCHDOG_PID"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("WATCHDOG_USEC", tc.usec)
+			t.Setenv("WATCHDOG_PID", tc.pid)
+
+			interval, err := systemd.WatchdogInterval()
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expInterval, interval, "unexpected interval")
+		})
+	}
+}
+
+func Test_Systemd_Reload(t *testing.T) {
+	sockFile := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: sockFile, Net: "unixgram"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	t.Setenv("NOTIFY_SOCKET", sockFile)
+
+	readMsg := func(t *testing.T) string {
+		t.Helper()
+		buf := make([]byte, 256)
+		if err := conn.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, err := conn.Read(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(buf[:n])
+	}
+
+	var reloaded bool
+	if err := systemd.Reload(func() { reloaded = true }); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, reloaded, "reload function not called")
+
+	msg := readMsg(t)
+	if !strings.HasPrefix(msg, "RELOADING=1\nMONOTONIC_USEC=") {
+		t.Fatalf("unexpected reloading message %q", msg)
+	}
+	test.AssertEqual(t, "READY=1", readMsg(t), "unexpected ready message")
+}
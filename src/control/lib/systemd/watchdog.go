@@ -0,0 +1,93 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package systemd
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// HealthCheck is a named check of the health of the process, which returns an
+// error if the process is not healthy. The check must return when the supplied
+// context is done.
+type HealthCheck struct {
+	Name  string
+	Check func(context.Context) error
+}
+
+// StartWatchdog starts sending watchdog keep-alive pings to systemd at half of
+// the interval set by the WatchdogSec= setting of the service, for as long as
+// the context is not done. A ping is only sent if all of the health checks
+// pass, so that a process that is running but not healthy is restarted by
+// systemd. The returned bool is false if the watchdog is not enabled.
+func StartWatchdog(ctx context.Context, log logging.Logger, checks ...HealthCheck) (bool, error) {
+	interval, err := WatchdogInterval()
+	if err != nil {
+		return false, err
+	}
+	if interval == 0 {
+		return false, nil
+	}
+
+	log.Debugf("systemd watchdog enabled with interval %s", interval)
+	go runWatchdog(ctx, log, interval/2, Watchdog, checks...)
+
+	return true, nil
+}
+
+func runWatchdog(ctx context.Context, log logging.Logger, period time.Duration, ping func() error, checks ...HealthCheck) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	healthy := true
+	for {
+		if err := checkHealth(ctx, period, checks...); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Errorf("health check failed; withholding systemd watchdog ping: %s", err)
+			if healthy {
+				_ = Status("unhealthy: " + err.Error())
+			}
+			healthy = false
+		} else {
+			if !healthy {
+				log.Notice("health checks passed; resuming systemd watchdog pings")
+				_ = Status("")
+			}
+			healthy = true
+			if err := ping(); err != nil {
+				log.Errorf("failed to send systemd watchdog ping: %s", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealth runs the health checks in turn, failing any that do not complete
+// within the timeout.
+func checkHealth(parent context.Context, timeout time.Duration, checks ...HealthCheck) error {
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+
+	for _, hc := range checks {
+		if err := hc.Check(ctx); err != nil {
+			return errors.Wrapf(err, "%s", hc.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package systemd
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestSystemd_checkHealth(t *testing.T) {
+	pass := HealthCheck{
+		Name:  "pass",
+		Check: func(context.Context) error { return nil },
+	}
+	fail := HealthCheck{
+		Name:  "fail",
+		Check: func(context.Context) error { return errors.New("mock failure") },
+	}
+	hang := HealthCheck{
+		Name: "hang",
+		Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		checks []HealthCheck
+		expErr error
+	}{
+		"no checks": {},
+		"all pass": {
+			checks: []HealthCheck{pass, pass},
+		},
+		"one fails": {
+			checks: []HealthCheck{pass, fail},
+			expErr: errors.New("fail: mock failure"),
+		},
+		"one hangs": {
+			checks: []HealthCheck{hang, pass},
+			expErr: errors.New("hang: context deadline exceeded"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := checkHealth(test.Context(t), 10*time.Millisecond, tc.checks...)
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestSystemd_runWatchdog(t *testing.T) {
+	for name, tc := range map[string]struct {
+		healthy  bool
+		expPings bool
+	}{
+		"healthy": {
+			healthy:  true,
+			expPings: true,
+		},
+		"unhealthy": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var checks, pings atomic.Int32
+			check := HealthCheck{
+				Name: "mock",
+				Check: func(context.Context) error {
+					checks.Add(1)
+					if !tc.healthy {
+						return errors.New("mock failure")
+					}
+					return nil
+				},
+			}
+			ping := func() error {
+				pings.Add(1)
+				return nil
+			}
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				runWatchdog(ctx, log, time.Millisecond, ping, check)
+			}()
+
+			for checks.Load() < 3 {
+				time.Sleep(time.Millisecond)
+			}
+			cancel()
+			<-done
+
+			test.AssertEqual(t, tc.expPings, pings.Load() > 0, "unexpected pings")
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -34,6 +35,7 @@ type (
 	// ClientCollector is a metrics collector for DAOS client metrics.
 	ClientCollector struct {
 		metricsCollector
+		agg *clientAggregator
 	}
 
 	// ClientSource is a metrics source for DAOS client metrics.
@@ -98,6 +100,21 @@ func newClientMetric(log logging.Logger, m telemetry.Metric) *sourceMetric {
 	return newSourceMetric(log, m, baseName, labels)
 }
 
+// newClientSample records the current value of a client metric.
+func newClientSample(now time.Time, sm *sourceMetric) ClientSample {
+	labels := make(map[string]string, len(sm.labels))
+	for k, v := range sm.labels {
+		labels[k] = v
+	}
+
+	return ClientSample{
+		Time:   now,
+		Name:   sm.baseName,
+		Labels: labels,
+		Value:  sm.metric.FloatValue(),
+	}
+}
+
 // NewClientSource creates a new ClientSource for client metrics.
 func NewClientSource(parent context.Context) (context.Context, *ClientSource, error) {
 	ctx, err := telemetry.InitClientRoot(parent)
@@ -146,7 +163,9 @@ func NewClientCollector(ctx context.Context, log logging.Logger, source *ClientS
 		}
 	}()
 
+	agg := newClientAggregator()
 	c := &ClientCollector{
+		agg: agg,
 		metricsCollector: metricsCollector{
 			log: log,
 			summary: prometheus.NewSummaryVec(
@@ -159,7 +178,22 @@ func NewClientCollector(ctx context.Context, log logging.Logger, source *ClientS
 				[]string{"source", "result"},
 			),
 			collectFn: func(ch chan *sourceMetric) {
-				source.Collect(log, ch)
+				sourceMetrics := make(chan *sourceMetric)
+				go func() {
+					source.Collect(log, sourceMetrics)
+					close(sourceMetrics)
+				}()
+
+				now := time.Now()
+				var samples []ClientSample
+				for sm := range sourceMetrics {
+					agg.add(sm)
+					if opts.History != nil && sm != nil && sm.metric != nil {
+						samples = append(samples, newClientSample(now, sm))
+					}
+					ch <- sm
+				}
+				opts.History.Add(samples...)
 			},
 		},
 	}
@@ -174,3 +208,24 @@ func NewClientCollector(ctx context.Context, log logging.Logger, source *ClientS
 
 	return c, nil
 }
+
+// Collect collects the per-process client metrics, followed by the per-pool
+// and per-container I/O totals aggregated across all client processes.
+func (c *ClientCollector) Collect(ch chan<- prometheus.Metric) {
+	if c == nil {
+		return
+	}
+	if c.agg == nil {
+		c.metricsCollector.Collect(ch)
+		return
+	}
+
+	c.agg.mu.Lock()
+	defer c.agg.mu.Unlock()
+
+	c.metricsCollector.Collect(ch)
+	if ch == nil {
+		return
+	}
+	collectClientAggregates(ch, c.agg.finish())
+}
@@ -0,0 +1,211 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
+
+package promexp
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/lib/telemetry"
+)
+
+type (
+	// clientAggKey identifies the pool, or pool and container, that a set of
+	// aggregated client I/O metrics applies to.
+	clientAggKey struct {
+		pool      string
+		container string
+	}
+
+	// clientAggValues holds the cumulative I/O totals for a clientAggKey.
+	clientAggValues struct {
+		readBytes  float64
+		writeBytes float64
+		readOps    float64
+		writeOps   float64
+	}
+
+	// clientAggSample is the contribution of a single per-process client
+	// metric to the aggregated totals.
+	clientAggSample struct {
+		key    clientAggKey
+		values clientAggValues
+	}
+
+	// clientAggregator sums per-pool and per-container I/O metrics across
+	// all client processes on the node. Contributions from processes whose
+	// metrics have been pruned are retained, so that the aggregated values
+	// remain monotonic for the lifetime of the agent.
+	clientAggregator struct {
+		mu      sync.Mutex
+		last    map[string]clientAggSample
+		current map[string]clientAggSample
+		retired map[clientAggKey]*clientAggValues
+	}
+)
+
+var (
+	clientPoolAggLabels = []string{"pool"}
+	clientContAggLabels = []string{"pool", "container"}
+
+	clientPoolAggDescs = newClientAggDescs("pool", "pool", clientPoolAggLabels)
+	clientContAggDescs = newClientAggDescs("container", "container", clientContAggLabels)
+)
+
+// clientAggDescs holds the descriptors for one set of aggregated metrics.
+type clientAggDescs struct {
+	readBytes  *prometheus.Desc
+	writeBytes *prometheus.Desc
+	readOps    *prometheus.Desc
+	writeOps   *prometheus.Desc
+}
+
+func newClientAggDescs(prefix, entity string, labels []string) *clientAggDescs {
+	newDesc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc("client_"+prefix+"_"+name,
+			help+" by all client processes on the node, per "+entity, labels, nil)
+	}
+
+	return &clientAggDescs{
+		readBytes:  newDesc("read_bytes", "Total bytes read"),
+		writeBytes: newDesc("write_bytes", "Total bytes written"),
+		readOps:    newDesc("read_ops", "Total read operations"),
+		writeOps:   newDesc("write_ops", "Total write operations"),
+	}
+}
+
+func (v *clientAggValues) add(other clientAggValues) {
+	v.readBytes += other.readBytes
+	v.writeBytes += other.writeBytes
+	v.readOps += other.readOps
+	v.writeOps += other.writeOps
+}
+
+func newClientAggregator() *clientAggregator {
+	return &clientAggregator{
+		last:    make(map[string]clientAggSample),
+		current: make(map[string]clientAggSample),
+		retired: make(map[clientAggKey]*clientAggValues),
+	}
+}
+
+// getClientAggSample maps a per-process client metric onto the aggregated
+// values it contributes to. Pool-level totals are taken from the object
+// layer metrics, and container-level totals from the DFS read/write sizes.
+func getClientAggSample(sm *sourceMetric) (clientAggSample, bool) {
+	pool, found := sm.labels["pool"]
+	if !found {
+		return clientAggSample{}, false
+	}
+	sample := clientAggSample{key: clientAggKey{pool: pool}}
+
+	switch sm.baseName {
+	case "client_pool_xferred_fetch":
+		sample.values.readBytes = sm.metric.FloatValue()
+	case "client_pool_xferred_update":
+		sample.values.writeBytes = sm.metric.FloatValue()
+	case "client_pool_ops_fetch":
+		sample.values.readOps = sm.metric.FloatValue()
+	case "client_pool_ops_update":
+		sample.values.writeOps = sm.metric.FloatValue()
+	case "client_dfs_read_bytes", "client_dfs_write_bytes":
+		stats, ok := sm.metric.(telemetry.StatsMetric)
+		if !ok {
+			return clientAggSample{}, false
+		}
+		sample.key.container = sm.labels["container"]
+		if sm.baseName == "client_dfs_read_bytes" {
+			sample.values.readBytes = float64(stats.Sum())
+			sample.values.readOps = float64(stats.SampleSize())
+		} else {
+			sample.values.writeBytes = float64(stats.Sum())
+			sample.values.writeOps = float64(stats.SampleSize())
+		}
+	default:
+		return clientAggSample{}, false
+	}
+
+	return sample, true
+}
+
+// add records the contribution of a client metric for the current collection.
+func (a *clientAggregator) add(sm *sourceMetric) {
+	if sm == nil || sm.metric == nil {
+		return
+	}
+
+	sample, ok := getClientAggSample(sm)
+	if !ok {
+		return
+	}
+	a.current[sm.metric.FullPath()] = sample
+}
+
+// finish completes the current collection. Any metric that was seen in the
+// previous collection but not in this one belongs to a process whose metrics
+// have been pruned, so its last values are retained. The aggregated totals
+// are returned.
+//
+// If nothing was collected at all, the previous collection is kept as is, so
+// that a failed collection does not cause values to be counted twice once the
+// metrics are seen again.
+func (a *clientAggregator) finish() map[clientAggKey]*clientAggValues {
+	if len(a.current) == 0 {
+		a.current = a.last
+	}
+
+	for path, sample := range a.last {
+		if _, found := a.current[path]; found {
+			continue
+		}
+		if _, found := a.retired[sample.key]; !found {
+			a.retired[sample.key] = &clientAggValues{}
+		}
+		a.retired[sample.key].add(sample.values)
+	}
+	a.last = a.current
+	a.current = make(map[string]clientAggSample)
+
+	totals := make(map[clientAggKey]*clientAggValues)
+	for key, values := range a.retired {
+		v := *values
+		totals[key] = &v
+	}
+	for _, sample := range a.last {
+		if _, found := totals[sample.key]; !found {
+			totals[sample.key] = &clientAggValues{}
+		}
+		totals[sample.key].add(sample.values)
+	}
+
+	return totals
+}
+
+// collectClientAggregates sends the aggregated totals to the provided channel.
+func collectClientAggregates(ch chan<- prometheus.Metric, totals map[clientAggKey]*clientAggValues) {
+	for key, values := range totals {
+		descs := clientPoolAggDescs
+		labels := []string{key.pool}
+		if key.container != "" {
+			descs = clientContAggDescs
+			labels = append(labels, key.container)
+		}
+
+		for desc, value := range map[*prometheus.Desc]float64{
+			descs.readBytes:  values.readBytes,
+			descs.writeBytes: values.writeBytes,
+			descs.readOps:    values.readOps,
+			descs.writeOps:   values.writeOps,
+		} {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value, labels...)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
+
+package promexp
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/telemetry"
+)
+
+type mockAggMetric struct {
+	path    string
+	mType   telemetry.MetricType
+	value   float64
+	sum     uint64
+	samples uint64
+}
+
+func (m *mockAggMetric) Path() string               { return m.path }
+func (m *mockAggMetric) Name() string               { return m.path }
+func (m *mockAggMetric) FullPath() string           { return m.path }
+func (m *mockAggMetric) Type() telemetry.MetricType { return m.mType }
+func (m *mockAggMetric) Desc() string               { return "" }
+func (m *mockAggMetric) Units() string              { return "" }
+func (m *mockAggMetric) FloatValue() float64        { return m.value }
+func (m *mockAggMetric) String() string             { return m.path }
+func (m *mockAggMetric) Min() uint64                { return 0 }
+func (m *mockAggMetric) Max() uint64                { return 0 }
+func (m *mockAggMetric) Sum() uint64                { return m.sum }
+func (m *mockAggMetric) Mean() float64              { return 0 }
+func (m *mockAggMetric) StdDev() float64            { return 0 }
+func (m *mockAggMetric) SumSquares() float64        { return 0 }
+func (m *mockAggMetric) SampleSize() uint64         { return m.samples }
+
+func TestPromExp_clientAggregator(t *testing.T) {
+	poolUUID := test.MockPoolUUID(1).String()
+	contUUID := test.MockPoolUUID(2).String()
+
+	poolCounter := func(pid int, name string, value float64) *sourceMetric {
+		return &sourceMetric{
+			metric: &mockAggMetric{
+				path:  fmt.Sprintf("job/%d/pool/%s/%s", pid, poolUUID, name),
+				mType: telemetry.MetricTypeCounter,
+				value: value,
+			},
+			baseName: "client_pool_" + name,
+			labels:   labelMap{"jobid": "job", "pid": fmt.Sprint(pid), "pool": poolUUID},
+		}
+	}
+	dfsSizes := func(pid int, name string, sum, samples uint64) *sourceMetric {
+		return &sourceMetric{
+			metric: &mockAggMetric{
+				path:    fmt.Sprintf("job/%d/pool/%s/container/%s/dfs/%s", pid, poolUUID, contUUID, name),
+				mType:   telemetry.MetricTypeStatsGauge,
+				sum:     sum,
+				samples: samples,
+			},
+			baseName: "client_dfs_" + name,
+			labels: labelMap{
+				"jobid":     "job",
+				"pid":       fmt.Sprint(pid),
+				"pool":      poolUUID,
+				"container": contUUID,
+			},
+		}
+	}
+	poolKey := clientAggKey{pool: poolUUID}
+	contKey := clientAggKey{pool: poolUUID, container: contUUID}
+
+	for name, tc := range map[string]struct {
+		collections [][]*sourceMetric
+		expTotals   map[clientAggKey]*clientAggValues
+	}{
+		"nothing collected": {
+			collections: [][]*sourceMetric{nil},
+			expTotals:   map[clientAggKey]*clientAggValues{},
+		},
+		"unrelated metrics ignored": {
+			collections: [][]*sourceMetric{
+				{
+					poolCounter(1, "ops_punch", 5),
+					{
+						metric:   &mockAggMetric{path: "job/1/started_at"},
+						baseName: "client_started_at",
+						labels:   labelMap{"jobid": "job", "pid": "1"},
+					},
+				},
+			},
+			expTotals: map[clientAggKey]*clientAggValues{},
+		},
+		"summed across processes": {
+			collections: [][]*sourceMetric{
+				{
+					poolCounter(1, "xferred_fetch", 1024),
+					poolCounter(1, "xferred_update", 2048),
+					poolCounter(1, "ops_fetch", 2),
+					poolCounter(1, "ops_update", 4),
+					poolCounter(2, "xferred_fetch", 512),
+					poolCounter(2, "ops_fetch", 1),
+					dfsSizes(1, "read_bytes", 4096, 8),
+					dfsSizes(2, "read_bytes", 1024, 2),
+					dfsSizes(2, "write_bytes", 100, 1),
+				},
+			},
+			expTotals: map[clientAggKey]*clientAggValues{
+				poolKey: {readBytes: 1536, writeBytes: 2048, readOps: 3, writeOps: 4},
+				contKey: {readBytes: 5120, writeBytes: 100, readOps: 10, writeOps: 1},
+			},
+		},
+		"exited process retained": {
+			collections: [][]*sourceMetric{
+				{
+					poolCounter(1, "xferred_fetch", 1024),
+					poolCounter(2, "xferred_fetch", 512),
+				},
+				{
+					poolCounter(2, "xferred_fetch", 768),
+				},
+			},
+			expTotals: map[clientAggKey]*clientAggValues{
+				poolKey: {readBytes: 1792},
+			},
+		},
+		"empty collection not counted twice": {
+			collections: [][]*sourceMetric{
+				{
+					poolCounter(1, "xferred_fetch", 1024),
+				},
+				nil,
+				{
+					poolCounter(1, "xferred_fetch", 2048),
+				},
+			},
+			expTotals: map[clientAggKey]*clientAggValues{
+				poolKey: {readBytes: 2048},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			agg := newClientAggregator()
+
+			var gotTotals map[clientAggKey]*clientAggValues
+			for _, collection := range tc.collections {
+				for _, sm := range collection {
+					agg.add(sm)
+				}
+				gotTotals = agg.finish()
+			}
+
+			cmpOpts := []cmp.Option{
+				cmp.AllowUnexported(clientAggKey{}, clientAggValues{}),
+			}
+			if diff := cmp.Diff(tc.expTotals, gotTotals, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected totals (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPromExp_collectClientAggregates(t *testing.T) {
+	poolUUID := test.MockPoolUUID(1).String()
+	contUUID := test.MockPoolUUID(2).String()
+
+	totals := map[clientAggKey]*clientAggValues{
+		{pool: poolUUID}:                      {readBytes: 1, writeBytes: 2, readOps: 3, writeOps: 4},
+		{pool: poolUUID, container: contUUID}: {readBytes: 5, writeBytes: 6, readOps: 7, writeOps: 8},
+	}
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		collectClientAggregates(ch, totals)
+		close(ch)
+	}()
+
+	gotValues := make(map[string]float64)
+	for m := range ch {
+		var pb dto.Metric
+		if err := m.Write(&pb); err != nil {
+			t.Fatal(err)
+		}
+		key := m.Desc().String()
+		for _, lp := range pb.GetLabel() {
+			key += fmt.Sprintf(" %s=%s", lp.GetName(), lp.GetValue())
+		}
+		gotValues[key] = pb.GetCounter().GetValue()
+	}
+
+	expValues := make(map[string]float64)
+	for descs, labels := range map[*clientAggDescs]string{
+		clientPoolAggDescs: fmt.Sprintf(" pool=%s", poolUUID),
+		clientContAggDescs: fmt.Sprintf(" container=%s pool=%s", contUUID, poolUUID),
+	} {
+		base := 0.0
+		if descs == clientContAggDescs {
+			base = 4
+		}
+		expValues[descs.readBytes.String()+labels] = base + 1
+		expValues[descs.writeBytes.String()+labels] = base + 2
+		expValues[descs.readOps.String()+labels] = base + 3
+		expValues[descs.writeOps.String()+labels] = base + 4
+	}
+
+	if diff := cmp.Diff(expValues, gotValues); diff != "" {
+		t.Fatalf("unexpected metrics (-want, +got):\n%s", diff)
+	}
+}
+
+func TestPromExp_newClientSample(t *testing.T) {
+	now := time.Now()
+	labels := labelMap{"jobid": "job", "pid": "100", "pool": test.MockPoolUUID(1).String()}
+	sm := &sourceMetric{
+		metric: &mockAggMetric{
+			path:  "job/100/pool/ops/fetch",
+			mType: telemetry.MetricTypeCounter,
+			value: 42,
+		},
+		baseName: "client_pool_ops_fetch",
+		labels:   labels,
+	}
+
+	sample := newClientSample(now, sm)
+	labels["pid"] = "200"
+
+	expSample := ClientSample{
+		Time:   now,
+		Name:   "client_pool_ops_fetch",
+		Labels: map[string]string{"jobid": "job", "pid": "100", "pool": test.MockPoolUUID(1).String()},
+		Value:  42,
+	}
+	if diff := cmp.Diff(expSample, sample); diff != "" {
+		t.Fatalf("unexpected sample (-want, +got):\n%s", diff)
+	}
+}
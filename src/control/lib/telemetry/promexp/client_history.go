@@ -0,0 +1,145 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package promexp
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultClientHistorySize is the default maximum number of samples retained
+// in a ClientHistory.
+const DefaultClientHistorySize = 65536
+
+type (
+	// ClientSample is the value of a client metric at the time it was
+	// collected.
+	ClientSample struct {
+		Time   time.Time
+		Name   string
+		Labels map[string]string
+		Value  float64
+	}
+
+	// ClientHistoryFilter selects the samples returned by a ClientHistory
+	// query. Unset fields match all samples.
+	ClientHistoryFilter struct {
+		JobID      string
+		PID        string
+		NamePrefix string
+		Since      time.Time
+	}
+
+	// ClientHistory is a bounded ring buffer of client metric samples. Samples
+	// are retained for the configured duration, so that the metrics of client
+	// processes remain available after the processes have exited. If the
+	// buffer is full, the oldest samples are discarded first.
+	ClientHistory struct {
+		mu      sync.Mutex
+		retain  time.Duration
+		samples []ClientSample
+		start   int
+		count   int
+		now     func() time.Time
+	}
+)
+
+// NewClientHistory creates a ClientHistory that retains up to size samples
+// for the given duration.
+func NewClientHistory(retain time.Duration, size int) *ClientHistory {
+	if size <= 0 {
+		size = DefaultClientHistorySize
+	}
+
+	return &ClientHistory{
+		retain:  retain,
+		samples: make([]ClientSample, size),
+		now:     time.Now,
+	}
+}
+
+// Retain returns the duration for which samples are retained.
+func (h *ClientHistory) Retain() time.Duration {
+	if h == nil {
+		return 0
+	}
+	return h.retain
+}
+
+func (h *ClientHistory) at(i int) *ClientSample {
+	return &h.samples[(h.start+i)%len(h.samples)]
+}
+
+// expire discards the samples that are older than the retention duration.
+func (h *ClientHistory) expire() {
+	cutoff := h.now().Add(-h.retain)
+	for h.count > 0 && h.at(0).Time.Before(cutoff) {
+		*h.at(0) = ClientSample{}
+		h.start = (h.start + 1) % len(h.samples)
+		h.count--
+	}
+}
+
+// Add records the given samples, discarding the oldest samples if the buffer
+// is full.
+func (h *ClientHistory) Add(samples ...ClientSample) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, sample := range samples {
+		if h.count == len(h.samples) {
+			h.start = (h.start + 1) % len(h.samples)
+			h.count--
+		}
+		*h.at(h.count) = sample
+		h.count++
+	}
+	h.expire()
+}
+
+func (f *ClientHistoryFilter) matches(sample *ClientSample) bool {
+	if f == nil {
+		return true
+	}
+
+	switch {
+	case f.JobID != "" && sample.Labels["jobid"] != f.JobID:
+		return false
+	case f.PID != "" && sample.Labels["pid"] != f.PID:
+		return false
+	case !strings.HasPrefix(sample.Name, f.NamePrefix):
+		return false
+	case sample.Time.Before(f.Since):
+		return false
+	}
+	return true
+}
+
+// Query returns the retained samples that match the filter, oldest first.
+func (h *ClientHistory) Query(filter *ClientHistoryFilter) []ClientSample {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.expire()
+
+	samples := []ClientSample{}
+	for i := 0; i < h.count; i++ {
+		if sample := h.at(i); filter.matches(sample) {
+			samples = append(samples, *sample)
+		}
+	}
+	return samples
+}
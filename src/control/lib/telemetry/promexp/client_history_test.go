@@ -0,0 +1,114 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package promexp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPromExp_ClientHistory(t *testing.T) {
+	start := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	sample := func(offset time.Duration, name, jobid, pid string, value float64) ClientSample {
+		return ClientSample{
+			Time:   start.Add(offset),
+			Name:   name,
+			Labels: map[string]string{"jobid": jobid, "pid": pid},
+			Value:  value,
+		}
+	}
+	s1 := sample(0, "client_pool_ops_fetch", "job1", "100", 1)
+	s2 := sample(time.Minute, "client_pool_ops_update", "job1", "100", 2)
+	s3 := sample(2*time.Minute, "client_pool_ops_fetch", "job2", "200", 3)
+	s4 := sample(3*time.Minute, "client_dfs_read_bytes", "job2", "201", 4)
+
+	for name, tc := range map[string]struct {
+		size       int
+		retain     time.Duration
+		now        time.Duration
+		samples    []ClientSample
+		filter     *ClientHistoryFilter
+		expSamples []ClientSample
+	}{
+		"empty": {
+			retain:     time.Hour,
+			expSamples: []ClientSample{},
+		},
+		"all retained": {
+			retain:     time.Hour,
+			now:        3 * time.Minute,
+			samples:    []ClientSample{s1, s2, s3, s4},
+			expSamples: []ClientSample{s1, s2, s3, s4},
+		},
+		"expired samples discarded": {
+			retain:     90 * time.Second,
+			now:        3 * time.Minute,
+			samples:    []ClientSample{s1, s2, s3, s4},
+			expSamples: []ClientSample{s3, s4},
+		},
+		"oldest samples overwritten when full": {
+			size:       3,
+			retain:     time.Hour,
+			now:        3 * time.Minute,
+			samples:    []ClientSample{s1, s2, s3, s4},
+			expSamples: []ClientSample{s2, s3, s4},
+		},
+		"filter by job": {
+			retain:     time.Hour,
+			now:        3 * time.Minute,
+			samples:    []ClientSample{s1, s2, s3, s4},
+			filter:     &ClientHistoryFilter{JobID: "job2"},
+			expSamples: []ClientSample{s3, s4},
+		},
+		"filter by pid": {
+			retain:     time.Hour,
+			now:        3 * time.Minute,
+			samples:    []ClientSample{s1, s2, s3, s4},
+			filter:     &ClientHistoryFilter{PID: "200"},
+			expSamples: []ClientSample{s3},
+		},
+		"filter by name prefix and time": {
+			retain:  time.Hour,
+			now:     3 * time.Minute,
+			samples: []ClientSample{s1, s2, s3, s4},
+			filter: &ClientHistoryFilter{
+				NamePrefix: "client_pool_",
+				Since:      start.Add(time.Minute),
+			},
+			expSamples: []ClientSample{s2, s3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			size := tc.size
+			if size == 0 {
+				size = 16
+			}
+			h := NewClientHistory(tc.retain, size)
+			h.now = func() time.Time { return start.Add(tc.now) }
+
+			h.Add(tc.samples...)
+
+			if diff := cmp.Diff(tc.expSamples, h.Query(tc.filter)); diff != "" {
+				t.Fatalf("unexpected samples (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPromExp_ClientHistory_Nil(t *testing.T) {
+	var h *ClientHistory
+
+	h.Add(ClientSample{Name: "foo"})
+	if samples := h.Query(nil); samples != nil {
+		t.Fatalf("expected nil samples, got %+v", samples)
+	}
+	if h.Retain() != 0 {
+		t.Fatalf("expected zero retention, got %s", h.Retain())
+	}
+}
@@ -0,0 +1,80 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux || !(amd64 || arm64)
+// +build !linux !amd64,!arm64
+
+package promexp
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/lib/telemetry"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type (
+	// RegMonFn defines a function signature for registering a Prometheus
+	// monitor.
+	RegMonFn func(context.Context, logging.Logger) error
+
+	// ExporterConfig defines the configuration for the Prometheus exporter.
+	ExporterConfig struct {
+		Port     int
+		Title    string
+		Register RegMonFn
+		// Handlers are additional HTTP handlers to be served, keyed by path.
+		Handlers map[string]http.Handler
+	}
+
+	// CollectorOpts contains options for the metrics collector.
+	CollectorOpts struct {
+		Ignores        []string
+		RetainDuration time.Duration
+		// History, if set, records the collected client metrics.
+		History *ClientHistory
+	}
+
+	// ClientCollector is a stub metrics collector for DAOS client metrics.
+	ClientCollector struct{}
+
+	// ClientSource is a stub metrics source for DAOS client metrics.
+	ClientSource struct{}
+)
+
+const (
+	// EngineTelemetryPort specifies the default port for engine telemetry.
+	EngineTelemetryPort = 9191
+	// ClientTelemetryPort specifies the default port for client telemetry.
+	ClientTelemetryPort = 9192
+)
+
+// NewClientSource returns an error, as client telemetry is not supported on
+// this platform.
+func NewClientSource(parent context.Context) (context.Context, *ClientSource, error) {
+	return nil, nil, telemetry.ErrUnsupported
+}
+
+// NewClientCollector returns an error, as client telemetry is not supported
+// on this platform.
+func NewClientCollector(ctx context.Context, log logging.Logger, source *ClientSource, opts *CollectorOpts) (*ClientCollector, error) {
+	return nil, telemetry.ErrUnsupported
+}
+
+// Describe implements prometheus.Collector.
+func (c *ClientCollector) Describe(ch chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (c *ClientCollector) Collect(ch chan<- prometheus.Metric) {}
+
+// StartExporter returns an error, as telemetry export is not supported on
+// this platform.
+func StartExporter(ctx context.Context, log logging.Logger, cfg *ExporterConfig) (func(), error) {
+	return nil, telemetry.ErrUnsupported
+}
@@ -1,9 +1,13 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 // (C) Copyright 2025 Google LLC
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
 
 package promexp
 
@@ -174,6 +178,7 @@ func TestPromExp_NewClientCollector(t *testing.T) {
 				cmp.FilterPath(func(p cmp.Path) bool {
 					// Ignore a few specific fields
 					return (strings.HasSuffix(p.String(), "log") ||
+						strings.HasSuffix(p.String(), "agg") ||
 						strings.HasSuffix(p.String(), "sourceMutex") ||
 						strings.HasSuffix(p.String(), "cleanupSource") ||
 						strings.HasSuffix(p.String(), "collectFn"))
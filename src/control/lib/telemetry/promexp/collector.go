@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -24,6 +25,8 @@ type (
 	CollectorOpts struct {
 		Ignores        []string
 		RetainDuration time.Duration
+		// History, if set, records the collected client metrics.
+		History *ClientHistory
 	}
 
 	metricsCollector struct {
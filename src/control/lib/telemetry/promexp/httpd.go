@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -32,6 +33,8 @@ type (
 		Port     int
 		Title    string
 		Register RegMonFn
+		// Handlers are additional HTTP handlers to be served, keyed by path.
+		Handlers map[string]http.Handler
 	}
 )
 
@@ -66,6 +69,9 @@ func StartExporter(ctx context.Context, log logging.Logger, cfg *ExporterConfig)
 	http.Handle("/metrics", promhttp.HandlerFor(
 		prometheus.DefaultGatherer, promhttp.HandlerOpts{},
 	))
+	for path, handler := range cfg.Handlers {
+		http.Handle(path, handler)
+	}
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		num, err := w.Write([]byte(fmt.Sprintf(`<html>
 				<head><title>%s</title></head>
@@ -1,8 +1,12 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
 
 package promexp_test
 
@@ -61,6 +65,11 @@ func TestPromExp_StartExporter(t *testing.T) {
 				Register: func(ctx context.Context, log logging.Logger) error {
 					return nil
 				},
+				Handlers: map[string]http.Handler{
+					"/teapot": http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusTeapot)
+					}),
+				},
 			},
 		},
 	} {
@@ -105,6 +114,15 @@ func TestPromExp_StartExporter(t *testing.T) {
 			}
 			resp.Body.Close()
 
+			for path := range tc.cfg.Handlers {
+				resp, err = http.Get(fmt.Sprintf("http://localhost:%d%s", tc.cfg.Port, path))
+				if err != nil {
+					t.Fatal(err)
+				}
+				resp.Body.Close()
+				test.AssertEqual(t, http.StatusTeapot, resp.StatusCode, "unexpected status from "+path)
+			}
+
 			cleanup()
 			time.Sleep(1 * time.Second)
 
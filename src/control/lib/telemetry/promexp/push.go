@@ -0,0 +1,191 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package promexp
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// maxPushTimeout is the maximum time allowed for a single push of metrics.
+const maxPushTimeout = 10 * time.Second
+
+// PushConfig defines the configuration for pushing metrics to a Prometheus
+// Pushgateway.
+type PushConfig struct {
+	// URL is the base URL of the Pushgateway.
+	URL string
+	// Job is the value of the job label of the pushed metrics.
+	Job string
+	// Grouping contains additional labels identifying the pushed metrics,
+	// e.g. the instance.
+	Grouping map[string]string
+	// Interval is the time between pushes.
+	Interval time.Duration
+}
+
+// groupURL returns the URL of the Pushgateway metrics group identified by the
+// job and grouping labels. Label values that cannot be used as a path segment
+// are base64-encoded, as required by the Pushgateway.
+func (cfg *PushConfig) groupURL() (string, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", errors.Errorf("invalid push URL %q: must be an http:// or https:// URL", cfg.URL)
+	}
+	if cfg.Job == "" {
+		return "", errors.New("push job name is required")
+	}
+
+	segments := []string{strings.TrimSuffix(u.String(), "/"), "metrics"}
+	segments = append(segments, pushLabelSegments("job", cfg.Job)...)
+
+	names := make([]string, 0, len(cfg.Grouping))
+	for name := range cfg.Grouping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		segments = append(segments, pushLabelSegments(name, cfg.Grouping[name])...)
+	}
+
+	return strings.Join(segments, "/"), nil
+}
+
+func pushLabelSegments(name, value string) []string {
+	switch {
+	case value == "":
+		return []string{name + "@base64", "="}
+	case strings.Contains(value, "/"):
+		return []string{name + "@base64", base64.URLEncoding.EncodeToString([]byte(value))}
+	default:
+		return []string{name, url.PathEscape(value)}
+	}
+}
+
+// pushMetrics replaces the metrics in the Pushgateway group with the metrics
+// gathered from the gatherer.
+func pushMetrics(ctx context.Context, client *http.Client, groupURL string, gatherer prometheus.Gatherer) error {
+	mfs, err := gatherer.Gather()
+	if err != nil {
+		return errors.Wrap(err, "gathering metrics")
+	}
+
+	var buf bytes.Buffer
+	enc := expfmt.NewEncoder(&buf, expfmt.FmtText)
+	for _, mf := range mfs {
+		if err := enc.Encode(mf); err != nil {
+			return errors.Wrapf(err, "encoding metric %s", mf.GetName())
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, groupURL, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", string(expfmt.FmtText))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return errors.Errorf("unexpected response %q: %s", resp.Status, bytes.TrimSpace(body))
+	}
+
+	return nil
+}
+
+// StartPusher starts pushing the metrics gathered from the gatherer to a
+// Prometheus Pushgateway at the configured interval, for nodes that cannot be
+// scraped. The returned function stops the pusher after a final push.
+func StartPusher(ctx context.Context, log logging.Logger, cfg *PushConfig, gatherer prometheus.Gatherer) (func(), error) {
+	if cfg == nil {
+		return nil, errors.New("invalid push config: nil config")
+	}
+
+	if cfg.Interval <= 0 {
+		return nil, errors.New("invalid push config: bad interval")
+	}
+
+	if gatherer == nil {
+		return nil, errors.New("invalid push config: nil gatherer")
+	}
+
+	groupURL, err := cfg.groupURL()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid push config")
+	}
+
+	timeout := cfg.Interval
+	if timeout > maxPushTimeout {
+		timeout = maxPushTimeout
+	}
+	client := &http.Client{Timeout: timeout}
+
+	var failing bool
+	push := func(ctx context.Context) {
+		err := pushMetrics(ctx, client, groupURL, gatherer)
+		switch {
+		case err != nil && !failing:
+			log.Noticef("failed to push metrics to %s: %s", groupURL, err)
+		case err != nil:
+			log.Debugf("failed to push metrics to %s: %s", groupURL, err)
+		case failing:
+			log.Noticef("resumed pushing metrics to %s", groupURL)
+		}
+		failing = err != nil
+	}
+
+	pushCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+
+		log.Infof("Pushing metrics to %s every %s", groupURL, cfg.Interval)
+		for {
+			select {
+			case <-pushCtx.Done():
+				return
+			case <-ticker.C:
+				push(pushCtx)
+			}
+		}
+	}()
+
+	return func() {
+		log.Debug("Shutting down Prometheus metrics pusher")
+		cancel()
+		wg.Wait()
+
+		// When this cleanup function is called, the original context
+		// will probably have already been canceled.
+		timedCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		push(timedCtx)
+	}, nil
+}
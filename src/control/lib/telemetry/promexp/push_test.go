@@ -0,0 +1,148 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package promexp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestPromExp_PushConfig_groupURL(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *PushConfig
+		expURL string
+		expErr error
+	}{
+		"bad URL": {
+			cfg:    &PushConfig{URL: "pushgateway:9091", Job: "daos"},
+			expErr: errors.New("invalid push URL"),
+		},
+		"no job": {
+			cfg:    &PushConfig{URL: "http://pushgateway:9091"},
+			expErr: errors.New("job name is required"),
+		},
+		"job only": {
+			cfg:    &PushConfig{URL: "http://pushgateway:9091/", Job: "daos"},
+			expURL: "http://pushgateway:9091/metrics/job/daos",
+		},
+		"grouping labels": {
+			cfg: &PushConfig{
+				URL: "https://pushgateway:9091/prefix",
+				Job: "daos",
+				Grouping: map[string]string{
+					"instance": "node1",
+					"empty":    "",
+					"path":     "/a/b",
+				},
+			},
+			expURL: "https://pushgateway:9091/prefix/metrics/job/daos/empty@base64/=/instance/node1/path@base64/L2EvYg==",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotURL, gotErr := tc.cfg.groupURL()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expURL, gotURL, "unexpected group URL")
+		})
+	}
+}
+
+func TestPromExp_StartPusher(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "test_pushed_total",
+		Help: "A test counter.",
+	})
+	reg.MustRegister(counter)
+	counter.Inc()
+
+	for name, tc := range map[string]struct {
+		cfg      *PushConfig
+		gatherer prometheus.Gatherer
+		status   int
+		expErr   error
+	}{
+		"nil cfg": {
+			gatherer: reg,
+			expErr:   errors.New("nil config"),
+		},
+		"bad interval": {
+			cfg:      &PushConfig{Job: "daos"},
+			gatherer: reg,
+			expErr:   errors.New("bad interval"),
+		},
+		"nil gatherer": {
+			cfg:    &PushConfig{Job: "daos", Interval: time.Second},
+			expErr: errors.New("nil gatherer"),
+		},
+		"push fails": {
+			cfg:      &PushConfig{Job: "daos", Interval: 10 * time.Millisecond},
+			gatherer: reg,
+			status:   http.StatusBadRequest,
+		},
+		"success": {
+			cfg: &PushConfig{
+				Job:      "daos",
+				Grouping: map[string]string{"instance": "node1"},
+				Interval: 10 * time.Millisecond,
+			},
+			gatherer: reg,
+			status:   http.StatusOK,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			pushes := make(chan string, 100)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/metrics/job/daos") {
+					pushes <- string(body)
+				}
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			if tc.cfg != nil {
+				tc.cfg.URL = srv.URL
+			}
+			stop, err := StartPusher(test.Context(t), log, tc.cfg, tc.gatherer)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			select {
+			case body := <-pushes:
+				if !strings.Contains(body, "test_pushed_total 1") {
+					t.Fatalf("pushed metrics missing test counter:\n%s", body)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for metrics to be pushed")
+			}
+
+			stop()
+			// The final push is made when the pusher is stopped.
+			if len(pushes) == 0 {
+				t.Fatal("expected a final push when the pusher was stopped")
+			}
+		})
+	}
+}
@@ -1,8 +1,12 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
 
 package promexp
 
@@ -0,0 +1,32 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux || !(amd64 || arm64)
+// +build !linux !amd64,!arm64
+
+package telemetry
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// NB: These must match the definitions in daos/metrics.h.
+	ClientMetricsEnabledEnv = "D_CLIENT_METRICS_ENABLE"
+	ClientMetricsRetainEnv  = "D_CLIENT_METRICS_RETAIN"
+)
+
+// ErrUnsupported is returned by telemetry operations on platforms without
+// support for the DAOS telemetry library.
+var ErrUnsupported = errors.Errorf("telemetry not supported on %s/%s", runtime.GOOS, runtime.GOARCH)
+
+// SetupClientRoot returns an error, as client telemetry is not supported on
+// this platform.
+func SetupClientRoot(ctx context.Context, jobid string, pid, shm_key int) error {
+	return ErrUnsupported
+}
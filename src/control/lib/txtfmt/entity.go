@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,13 +10,60 @@ package txtfmt
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
 	"text/tabwriter"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 )
 
 const (
 	defEntityRowIndent = 2
 )
 
+const (
+	// EntityFormatText renders an entity as aligned, human-readable text.
+	EntityFormatText EntityFormat = iota
+	// EntityFormatKeyValue renders an entity as one key=value pair per
+	// line, with keys in snake_case and values quoted where necessary.
+	EntityFormatKeyValue
+	// EntityFormatYAML renders an entity as a YAML mapping with keys in
+	// snake_case.
+	EntityFormatYAML
+)
+
+// EntityFormat determines how the attributes of an entity are rendered.
+type EntityFormat int
+
+func (ef EntityFormat) String() string {
+	switch ef {
+	case EntityFormatText:
+		return "text"
+	case EntityFormatKeyValue:
+		return "kv"
+	case EntityFormatYAML:
+		return "yaml"
+	default:
+		return fmt.Sprintf("unknown entity format %d", int(ef))
+	}
+}
+
+// ParseEntityFormat returns the EntityFormat matching the supplied name.
+func ParseEntityFormat(name string) (EntityFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "text":
+		return EntityFormatText, nil
+	case "kv", "key-value", "keyvalue":
+		return EntityFormatKeyValue, nil
+	case "yaml", "yml":
+		return EntityFormatYAML, nil
+	default:
+		return EntityFormatText, errors.Errorf("unknown entity format %q (valid: text, kv, yaml)", name)
+	}
+}
+
 // EntityFormatter can be used for neatly displaying attributes
 // of a single entity.
 type EntityFormatter struct {
@@ -90,3 +138,97 @@ func FormatEntity(title string, attrs []TableRow) string {
 	f := NewEntityFormatter(title, GetEntityPadding(attrs)+defEntityRowIndent)
 	return f.Format(attrs)
 }
+
+// EntityKey converts a human-readable attribute name into the snake_case key
+// used by the machine-parsable entity formats, e.g. "Mem Ratio (MD-on-SSD)"
+// becomes "mem_ratio_md_on_ssd".
+func EntityKey(name string) string {
+	var sb strings.Builder
+	sep := false
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			sep = sb.Len() > 0
+			continue
+		}
+		if sep {
+			sb.WriteByte('_')
+			sep = false
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+// entityAttrs flattens the table into ordered key/value pairs.
+func entityAttrs(attrs []TableRow) yaml.MapSlice {
+	items := make(yaml.MapSlice, 0, len(attrs))
+	for _, row := range attrs {
+		for key, val := range row {
+			items = append(items, yaml.MapItem{Key: EntityKey(key), Value: val})
+		}
+	}
+	return items
+}
+
+// formatEntityTitle renders the title as a comment line, which is ignored
+// by parsers of both the key=value and YAML formats.
+func formatEntityTitle(out *strings.Builder, title string) {
+	if title == "" {
+		return
+	}
+	fmt.Fprintf(out, "# %s\n", title)
+}
+
+func quoteEntityValue(val string) string {
+	if val == "" || strings.ContainsFunc(val, func(r rune) bool {
+		return unicode.IsSpace(r) || !unicode.IsPrint(r) || strings.ContainsRune(`"'=#\$`+"`", r)
+	}) {
+		return strconv.Quote(val)
+	}
+	return val
+}
+
+// FormatEntityKeyValue returns the supplied entity title and table of
+// attributes rendered as one key=value pair per line.
+func FormatEntityKeyValue(title string, attrs []TableRow) string {
+	var out strings.Builder
+	formatEntityTitle(&out, title)
+	for _, item := range entityAttrs(attrs) {
+		fmt.Fprintf(&out, "%s=%s\n", item.Key, quoteEntityValue(item.Value.(string)))
+	}
+	return out.String()
+}
+
+// FormatEntityYAML returns the supplied entity title and table of attributes
+// rendered as a YAML mapping.
+func FormatEntityYAML(title string, attrs []TableRow) (string, error) {
+	var out strings.Builder
+	formatEntityTitle(&out, title)
+
+	items := entityAttrs(attrs)
+	if len(items) == 0 {
+		out.WriteString("{}\n")
+		return out.String(), nil
+	}
+	data, err := yaml.Marshal(items)
+	if err != nil {
+		return "", errors.Wrap(err, "marshaling entity to YAML")
+	}
+	out.Write(data)
+	return out.String(), nil
+}
+
+// FormatEntityAs returns a string from the supplied entity title and table of
+// attributes rendered in the requested format.
+func FormatEntityAs(format EntityFormat, title string, attrs []TableRow) (string, error) {
+	switch format {
+	case EntityFormatText:
+		return FormatEntity(title, attrs), nil
+	case EntityFormatKeyValue:
+		return FormatEntityKeyValue(title, attrs), nil
+	case EntityFormatYAML:
+		return FormatEntityYAML(title, attrs)
+	default:
+		return "", errors.Errorf("unsupported entity format %d", int(format))
+	}
+}
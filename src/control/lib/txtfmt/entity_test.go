@@ -70,3 +70,135 @@ long title is long
 		})
 	}
 }
+
+func TestParseEntityFormat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in        string
+		expFormat EntityFormat
+		expErr    bool
+	}{
+		"empty":     {expFormat: EntityFormatText},
+		"text":      {in: "text", expFormat: EntityFormatText},
+		"kv":        {in: "kv", expFormat: EntityFormatKeyValue},
+		"key-value": {in: "Key-Value", expFormat: EntityFormatKeyValue},
+		"yaml":      {in: "yaml", expFormat: EntityFormatYAML},
+		"yml":       {in: "yml", expFormat: EntityFormatYAML},
+		"unknown":   {in: "xml", expErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			format, err := ParseEntityFormat(tc.in)
+			if tc.expErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if format != tc.expFormat {
+				t.Fatalf("expected %s, got %s", tc.expFormat, format)
+			}
+			if format.String() != tc.expFormat.String() {
+				t.Fatalf("unexpected string %q", format)
+			}
+		})
+	}
+}
+
+func TestEntityKey(t *testing.T) {
+	for in, exp := range map[string]string{
+		"UUID":                    "uuid",
+		"Pool UUID":               "pool_uuid",
+		"Mem Ratio (MD-on-SSD)":   "mem_ratio_md_on_ssd",
+		"  Leading and trailing ": "leading_and_trailing",
+		"already_snake":           "already_snake",
+	} {
+		if got := EntityKey(in); got != exp {
+			t.Errorf("EntityKey(%q): expected %q, got %q", in, exp, got)
+		}
+	}
+}
+
+func TestFormatEntityAs(t *testing.T) {
+	attrs := []TableRow{
+		{"UUID": "12345678-1234-1234-1234-123456789abc"},
+		{"Service Ranks": "[0-2]"},
+		{"Total Size": "6.0 GB"},
+		{"Label": ""},
+		{"Open Handles": "3"},
+	}
+
+	for name, tc := range map[string]struct {
+		format    EntityFormat
+		title     string
+		attrs     []TableRow
+		expResult string
+		expErr    bool
+	}{
+		"text": {
+			format: EntityFormatText,
+			title:  "pool",
+			attrs:  []TableRow{{"a": "b"}},
+			expResult: `
+pool
+----
+  a : b 
+`,
+		},
+		"key-value": {
+			format: EntityFormatKeyValue,
+			title:  "Pool created",
+			attrs:  attrs,
+			expResult: `
+# Pool created
+uuid=12345678-1234-1234-1234-123456789abc
+service_ranks=[0-2]
+total_size="6.0 GB"
+label=""
+open_handles=3
+`,
+		},
+		"key-value no attrs": {
+			format: EntityFormatKeyValue,
+		},
+		"yaml": {
+			format: EntityFormatYAML,
+			title:  "Pool created",
+			attrs:  attrs,
+			expResult: `
+# Pool created
+uuid: 12345678-1234-1234-1234-123456789abc
+service_ranks: '[0-2]'
+total_size: 6.0 GB
+label: ""
+open_handles: "3"
+`,
+		},
+		"yaml no attrs": {
+			format:    EntityFormatYAML,
+			expResult: "{}\n",
+		},
+		"unknown format": {
+			format: EntityFormat(42),
+			expErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			result, err := FormatEntityAs(tc.format, tc.title, tc.attrs)
+			if tc.expErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expResult, "\n"), result); diff != "" {
+				t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,11 +11,60 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"text/tabwriter"
 	"unicode"
+	"unicode/utf8"
 )
 
+const (
+	// ColumnWrap wraps cell values that exceed the column width onto
+	// additional lines, breaking at word boundaries where possible.
+	ColumnWrap ColumnOverflow = iota
+	// ColumnEllipsis truncates cell values that exceed the column width
+	// and marks the truncation with an ellipsis.
+	ColumnEllipsis
+
+	ellipsis = "..."
+)
+
+// ColumnOverflow determines how cell values wider than their column are
+// rendered.
+type ColumnOverflow int
+
+var (
+	defaultMaxWidth     atomic.Int32
+	defaultAlignNumeric atomic.Bool
+)
+
+// SetDefaultMaxWidth sets the maximum line width for tables created by
+// NewTableFormatter. A width of 0 disables the limit.
+func SetDefaultMaxWidth(width int) {
+	defaultMaxWidth.Store(int32(max(width, 0)))
+}
+
+// SetDefaultRightAlignNumeric sets whether tables created by
+// NewTableFormatter right-align numeric columns.
+func SetDefaultRightAlignNumeric(enabled bool) {
+	defaultAlignNumeric.Store(enabled)
+}
+
+// ConfigureForTerminal adjusts the table defaults for output to the given
+// file. If it is a terminal, tables are sized to fit its width and numeric
+// columns are right-aligned; otherwise the defaults are left unchanged so
+// that redirected output is not altered.
+func ConfigureForTerminal(f *os.File) {
+	width := TerminalWidth(f)
+	if width == 0 {
+		return
+	}
+	SetDefaultMaxWidth(width)
+	SetDefaultRightAlignNumeric(true)
+}
+
 // Title returns the string in Title Format.
 //
 // NB: This is basically a copy of strings.Title(), which is deprecated.
@@ -36,9 +86,13 @@ type TableRow map[string]string
 // TableFormatter is a structure that formats string output for a table with
 // labeled columns.
 type TableFormatter struct {
-	titles []string
-	writer *tabwriter.Writer
-	out    bytes.Buffer
+	titles       []string
+	writer       *tabwriter.Writer
+	out          bytes.Buffer
+	maxWidth     int
+	colMaxWidths map[string]int
+	colOverflow  map[string]ColumnOverflow
+	alignNumeric bool
 }
 
 // Init instantiates internal variables.
@@ -62,21 +116,219 @@ func (t *TableFormatter) SetColumnTitles(c ...string) {
 	t.titles = c
 }
 
-// formatHeader formats a table header based on the column titles.
-func (t *TableFormatter) formatHeader() {
-	for _, title := range t.titles {
-		fmt.Fprintf(t.writer, "%s\t", title)
+// SetMaxWidth sets the maximum width of each output line. If the table would
+// be wider, the widest columns are narrowed (but never below the width of
+// their titles) until it fits. A width of 0 disables the limit.
+func (t *TableFormatter) SetMaxWidth(width int) {
+	t.maxWidth = max(width, 0)
+}
+
+// SetColumnMaxWidth sets the maximum width of the column with the given
+// title. A width of 0 removes the limit.
+func (t *TableFormatter) SetColumnMaxWidth(title string, width int) {
+	if t.colMaxWidths == nil {
+		t.colMaxWidths = make(map[string]int)
 	}
-	fmt.Fprint(t.writer, "\n")
-	for _, title := range t.titles {
-		for i := 0; i < len(title); i++ {
-			fmt.Fprint(t.writer, "-")
+	if width <= 0 {
+		delete(t.colMaxWidths, title)
+		return
+	}
+	t.colMaxWidths[title] = width
+}
+
+// SetColumnOverflow sets how values wider than the column with the given
+// title are rendered. Columns wrap by default.
+func (t *TableFormatter) SetColumnOverflow(title string, mode ColumnOverflow) {
+	if t.colOverflow == nil {
+		t.colOverflow = make(map[string]ColumnOverflow)
+	}
+	t.colOverflow[title] = mode
+}
+
+// SetRightAlignNumeric enables right-alignment of columns in which every
+// value is a number.
+func (t *TableFormatter) SetRightAlignNumeric(enabled bool) {
+	t.alignNumeric = enabled
+}
+
+func textWidth(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+func padLeft(s string, width int) string {
+	if pad := width - textWidth(s); pad > 0 {
+		return strings.Repeat(" ", pad) + s
+	}
+	return s
+}
+
+// truncate shortens a value to the given width, replacing the tail with an
+// ellipsis if there is room for one.
+func truncate(s string, width int) string {
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	if width <= len(ellipsis) {
+		return string(r[:width])
+	}
+	return string(r[:width-len(ellipsis)]) + ellipsis
+}
+
+// wrap splits a value into lines no wider than the given width, breaking at
+// whitespace where possible and splitting words that are too long to fit on
+// a line of their own.
+func wrap(s string, width int) []string {
+	if textWidth(s) <= width {
+		return []string{s}
+	}
+
+	var lines []string
+	var cur []rune
+	for _, word := range strings.Fields(s) {
+		w := []rune(word)
+		if len(cur) > 0 && len(cur)+1+len(w) <= width {
+			cur = append(append(cur, ' '), w...)
+			continue
+		}
+		if len(cur) > 0 {
+			lines = append(lines, string(cur))
+		}
+		for len(w) > width {
+			lines = append(lines, string(w[:width]))
+			w = w[width:]
+		}
+		cur = w
+	}
+	if len(cur) > 0 || len(lines) == 0 {
+		lines = append(lines, string(cur))
+	}
+
+	return lines
+}
+
+// cellValues returns the values to be displayed for each row, ordered by
+// column title.
+func (t *TableFormatter) cellValues(table []TableRow) [][]string {
+	rows := make([][]string, 0, len(table))
+	for _, row := range table {
+		values := make([]string, len(t.titles))
+		for i, title := range t.titles {
+			value, ok := row[title]
+			if !ok {
+				value = "None"
+			}
+			values[i] = value
+		}
+		rows = append(rows, values)
+	}
+	return rows
+}
+
+// columnWidths calculates the display width of each column, taking the
+// per-column and table width limits into account.
+func (t *TableFormatter) columnWidths(rows [][]string) []int {
+	widths := make([]int, len(t.titles))
+	minWidths := make([]int, len(t.titles))
+	for i, title := range t.titles {
+		minWidths[i] = textWidth(title)
+		widths[i] = minWidths[i]
+		for _, row := range rows {
+			widths[i] = max(widths[i], textWidth(row[i]))
+		}
+		if limit, ok := t.colMaxWidths[title]; ok && widths[i] > limit {
+			widths[i] = max(limit, minWidths[i])
+		}
+	}
+
+	if t.maxWidth == 0 {
+		return widths
+	}
+
+	for {
+		// Each column is followed by a single space of padding.
+		total := 0
+		widest := -1
+		for i, w := range widths {
+			total += w + 1
+			if w > minWidths[i] && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if total <= t.maxWidth || widest < 0 {
+			return widths
+		}
+		widths[widest]--
+	}
+}
+
+// numericColumns identifies the columns to be right-aligned.
+func (t *TableFormatter) numericColumns(rows [][]string) []bool {
+	numeric := make([]bool, len(t.titles))
+	if !t.alignNumeric || len(rows) == 0 {
+		return numeric
+	}
+
+	for i := range t.titles {
+		numeric[i] = true
+		for _, row := range rows {
+			if _, err := strconv.ParseFloat(strings.TrimSpace(row[i]), 64); err != nil {
+				numeric[i] = false
+				break
+			}
+		}
+	}
+	return numeric
+}
+
+// layoutRow fits the row values into the column widths, returning one or
+// more lines of cells.
+func (t *TableFormatter) layoutRow(row []string, widths []int) [][]string {
+	cells := make([][]string, len(row))
+	numLines := 1
+	for i, value := range row {
+		if t.colOverflow[t.titles[i]] == ColumnEllipsis {
+			cells[i] = []string{truncate(value, widths[i])}
+		} else {
+			cells[i] = wrap(value, widths[i])
+		}
+		numLines = max(numLines, len(cells[i]))
+	}
+
+	lines := make([][]string, numLines)
+	for l := range lines {
+		lines[l] = make([]string, len(row))
+		for i := range row {
+			if l < len(cells[i]) {
+				lines[l][i] = cells[i][l]
+			}
 		}
-		fmt.Fprint(t.writer, "\t")
+	}
+	return lines
+}
+
+// formatLine writes a single line of cells, right-aligning any numeric
+// columns.
+func (t *TableFormatter) formatLine(cells []string, widths []int, numeric []bool) {
+	for i, cell := range cells {
+		if numeric[i] {
+			cell = padLeft(cell, widths[i])
+		}
+		fmt.Fprintf(t.writer, "%s\t", cell)
 	}
 	fmt.Fprint(t.writer, "\n")
 }
 
+// formatHeader formats a table header based on the column titles.
+func (t *TableFormatter) formatHeader(widths []int, numeric []bool) {
+	dashes := make([]string, len(t.titles))
+	for i, title := range t.titles {
+		dashes[i] = strings.Repeat("-", textWidth(title))
+	}
+	t.formatLine(t.titles, widths, numeric)
+	t.formatLine(dashes, widths, numeric)
+}
+
 // Format generates an output string for the set of table rows provided. It
 // includes a header with column titles, and fills only the requested columns
 // in order.
@@ -85,17 +337,15 @@ func (t *TableFormatter) Format(table []TableRow) string {
 		return "" // nothing to format
 	}
 
-	t.formatHeader()
+	rows := t.cellValues(table)
+	widths := t.columnWidths(rows)
+	numeric := t.numericColumns(rows)
 
-	for _, row := range table {
-		for _, title := range t.titles {
-			value, ok := row[title]
-			if !ok {
-				value = "None"
-			}
-			fmt.Fprintf(t.writer, "%s\t", value)
+	t.formatHeader(widths, numeric)
+	for _, row := range rows {
+		for _, line := range t.layoutRow(row, widths) {
+			t.formatLine(line, widths, numeric)
 		}
-		fmt.Fprint(t.writer, "\n")
 	}
 
 	t.writer.Flush()
@@ -104,7 +354,10 @@ func (t *TableFormatter) Format(table []TableRow) string {
 
 // NewTableFormatter creates and instantiates a new TableFormatter.
 func NewTableFormatter(columnTitles ...string) *TableFormatter {
-	f := &TableFormatter{}
+	f := &TableFormatter{
+		maxWidth:     int(defaultMaxWidth.Load()),
+		alignNumeric: defaultAlignNumeric.Load(),
+	}
 	f.Init()
 	f.SetColumnTitles(columnTitles...)
 	return f
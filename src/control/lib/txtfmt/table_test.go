@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -210,3 +211,189 @@ wolf-118 5.79TB (2 namespaces) 1.46TB (2 controllers)
 		})
 	}
 }
+
+func TestTableFormatter_Format_Layout(t *testing.T) {
+	for name, tt := range map[string]struct {
+		titles         []string
+		table          []TableRow
+		setup          func(*TableFormatter)
+		expectedResult string
+	}{
+		"column max width wraps at word boundary": {
+			titles: []string{"Name", "Description"},
+			table: []TableRow{
+				{"Name": "one", "Description": "the quick brown fox"},
+				{"Name": "two", "Description": "short"},
+			},
+			setup: func(f *TableFormatter) {
+				f.SetColumnMaxWidth("Description", 11)
+			},
+			expectedResult: `
+Name Description 
+---- ----------- 
+one  the quick   
+     brown fox   
+two  short       
+`,
+		},
+		"long word split across lines": {
+			titles: []string{"Name", "Path"},
+			table: []TableRow{
+				{"Name": "one", "Path": "/a/very/long/path"},
+			},
+			setup: func(f *TableFormatter) {
+				f.SetColumnMaxWidth("Path", 6)
+			},
+			expectedResult: `
+Name Path   
+---- ----   
+one  /a/ver 
+     y/long 
+     /path  
+`,
+		},
+		"column ellipsis": {
+			titles: []string{"Name", "Description"},
+			table: []TableRow{
+				{"Name": "one", "Description": "the quick brown fox"},
+			},
+			setup: func(f *TableFormatter) {
+				f.SetColumnMaxWidth("Description", 12)
+				f.SetColumnOverflow("Description", ColumnEllipsis)
+			},
+			expectedResult: `
+Name Description  
+---- -----------  
+one  the quick... 
+`,
+		},
+		"column max width never below title": {
+			titles: []string{"Description"},
+			table: []TableRow{
+				{"Description": "the quick brown fox"},
+			},
+			setup: func(f *TableFormatter) {
+				f.SetColumnMaxWidth("Description", 3)
+			},
+			expectedResult: `
+Description 
+----------- 
+the quick   
+brown fox   
+`,
+		},
+		"table max width shrinks widest column": {
+			titles: []string{"Host", "Addresses", "Info"},
+			table: []TableRow{
+				{"Host": "host1", "Addresses": "10.0.0.1 10.0.0.2 10.0.0.3", "Info": "some info"},
+			},
+			setup: func(f *TableFormatter) {
+				f.SetMaxWidth(30)
+			},
+			expectedResult: `
+Host  Addresses Info      
+----  --------- ----      
+host1 10.0.0.1  some info 
+      10.0.0.2            
+      10.0.0.3            
+`,
+		},
+		"table max width fits": {
+			titles: []string{"One", "Two"},
+			table:  []TableRow{{"One": "1", "Two": "2"}},
+			setup: func(f *TableFormatter) {
+				f.SetMaxWidth(80)
+			},
+			expectedResult: `
+One Two 
+--- --- 
+1   2   
+`,
+		},
+		"right-align numeric columns": {
+			titles: []string{"Rank", "Name", "Size"},
+			table: []TableRow{
+				{"Rank": "1", "Name": "a", "Size": "1.5"},
+				{"Rank": "10", "Name": "bb", "Size": "200"},
+				{"Rank": "100", "Name": "ccc", "Size": "3000.25"},
+			},
+			setup: func(f *TableFormatter) {
+				f.SetRightAlignNumeric(true)
+			},
+			expectedResult: `
+Rank Name    Size 
+---- ----    ---- 
+   1 a        1.5 
+  10 bb       200 
+ 100 ccc  3000.25 
+`,
+		},
+		"non-numeric value disables alignment": {
+			titles: []string{"Rank", "Size"},
+			table: []TableRow{
+				{"Rank": "1", "Size": "1.5"},
+				{"Rank": "10"},
+			},
+			setup: func(f *TableFormatter) {
+				f.SetRightAlignNumeric(true)
+			},
+			expectedResult: `
+Rank Size 
+---- ---- 
+   1 1.5  
+  10 None 
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := NewTableFormatter(tt.titles...)
+			tt.setup(f)
+
+			result := f.Format(tt.table)
+
+			if diff := cmp.Diff(strings.TrimLeft(tt.expectedResult, "\n"), result); diff != "" {
+				t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestTableFormatter_wrap(t *testing.T) {
+	for name, tt := range map[string]struct {
+		value    string
+		width    int
+		expLines []string
+	}{
+		"fits": {
+			value:    "short",
+			width:    10,
+			expLines: []string{"short"},
+		},
+		"empty": {
+			value:    "",
+			width:    5,
+			expLines: []string{""},
+		},
+		"word boundaries": {
+			value:    "one two three four",
+			width:    9,
+			expLines: []string{"one two", "three", "four"},
+		},
+		"long word": {
+			value:    "abcdefghij kl",
+			width:    4,
+			expLines: []string{"abcd", "efgh", "ij", "kl"},
+		},
+		"multibyte runes": {
+			value:    "ééé ééé",
+			width:    4,
+			expLines: []string{"ééé", "ééé"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expLines, wrap(tt.value, tt.width)); diff != "" {
+				t.Fatalf("unexpected lines (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build unix
+
+package txtfmt
+
+import (
+	"os"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+// TerminalWidth returns the width in columns of the terminal attached to the
+// given file, or 0 if the file is not a terminal. A positive value in the
+// COLUMNS environment variable overrides the detected width.
+func TerminalWidth(f *os.File) int {
+	if f == nil {
+		return 0
+	}
+
+	ws, err := unix.IoctlGetWinsize(int(f.Fd()), unix.TIOCGWINSZ)
+	if err != nil {
+		return 0
+	}
+
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 0 {
+		return cols
+	}
+	return int(ws.Col)
+}
@@ -0,0 +1,16 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !unix
+
+package txtfmt
+
+import "os"
+
+// TerminalWidth always returns 0 on platforms without terminal size
+// detection, leaving table widths unlimited.
+func TerminalWidth(f *os.File) int {
+	return 0
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,7 @@ package ui
 import (
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -45,6 +47,21 @@ func FmtHumanSize(size float64, suffix string, binary bool) string {
 	return fmt.Sprintf("%E %s", size, suffix)
 }
 
+// ParseByteSize parses a human-readable byte size, in either decimal or binary
+// units (e.g. "512MB", "1.5TiB"). A number without a unit is a size in bytes.
+func ParseByteSize(in string) (uint64, error) {
+	if strings.TrimSpace(in) == "" {
+		return 0, errors.New("no size specified")
+	}
+
+	size, err := humanize.ParseBytes(in)
+	if err != nil {
+		return 0, errors.Errorf("invalid size %q (e.g. 512MiB, 1.5TiB)", in)
+	}
+
+	return size, nil
+}
+
 // ByteSizeFlag is a go-flags compatible flag type for converting
 // string input into a byte size.
 type ByteSizeFlag struct {
@@ -61,13 +78,9 @@ func (sf ByteSizeFlag) String() string {
 }
 
 func (sf *ByteSizeFlag) UnmarshalFlag(fv string) (err error) {
-	if fv == "" {
-		return errors.New("no size specified")
-	}
-
-	sf.Bytes, err = humanize.ParseBytes(fv)
+	sf.Bytes, err = ParseByteSize(fv)
 	if err != nil {
-		return errors.Errorf("invalid size %q", fv)
+		return err
 	}
 	sf.set.SetTrue()
 
@@ -117,6 +117,11 @@ func TestUI_ByteSizeFlag(t *testing.T) {
 			expSize: 10 * 1000 * 1000,
 			expStr:  "10 MB",
 		},
+		"valid fractional TiB": {
+			input:   "1.5TiB",
+			expSize: 3 << 39,
+			expStr:  "1.6 TB",
+		},
 		"valid raw number": {
 			input:   "1058577",
 			expSize: 1058577,
@@ -0,0 +1,87 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package ui
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/atm"
+)
+
+// parseDuration parses a duration in the time.ParseDuration format (e.g.
+// "90s", "2h30m"). A number without a unit is interpreted in bareUnit.
+func parseDuration(in string, bareUnit time.Duration) (time.Duration, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return 0, errors.New("no duration specified")
+	}
+
+	d, err := time.ParseDuration(in)
+	if err != nil {
+		num, numErr := strconv.ParseFloat(in, 64)
+		if numErr != nil {
+			return 0, errors.Errorf("invalid duration %q (e.g. 90s, 30m, 2h30m)", in)
+		}
+		d = time.Duration(num * float64(bareUnit))
+	}
+	if d < 0 {
+		return 0, errors.Errorf("invalid duration %q: may not be negative", in)
+	}
+
+	return d, nil
+}
+
+// ParseDuration parses a human-readable duration (e.g. "90s", "30m", "2h30m").
+// A number without a unit is a duration in seconds.
+func ParseDuration(in string) (time.Duration, error) {
+	return parseDuration(in, time.Second)
+}
+
+// DurationFlag is a go-flags compatible flag type for converting string
+// input into a duration. A number without a unit is a duration in seconds.
+type DurationFlag struct {
+	set      atm.Bool
+	Duration time.Duration
+}
+
+func (df DurationFlag) IsSet() bool {
+	return df.set.IsTrue()
+}
+
+func (df DurationFlag) String() string {
+	return df.Duration.String()
+}
+
+func (df *DurationFlag) UnmarshalFlag(fv string) (err error) {
+	df.Duration, err = ParseDuration(fv)
+	if err != nil {
+		return err
+	}
+	df.set.SetTrue()
+
+	return nil
+}
+
+// MinutesFlag is a DurationFlag for options that historically accepted a
+// number of minutes. A number without a unit is a duration in minutes.
+type MinutesFlag struct {
+	DurationFlag
+}
+
+func (mf *MinutesFlag) UnmarshalFlag(fv string) (err error) {
+	mf.Duration, err = parseDuration(fv, time.Minute)
+	if err != nil {
+		return err
+	}
+	mf.set.SetTrue()
+
+	return nil
+}
@@ -0,0 +1,115 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package ui_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+func TestUI_DurationFlag(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input  string
+		expDur time.Duration
+		expStr string
+		expErr error
+	}{
+		"empty": {
+			expErr: errors.New("no duration specified"),
+		},
+		"invalid duration": {
+			input:  "horse",
+			expErr: errors.New("invalid duration"),
+		},
+		"invalid unit": {
+			input:  "5 fortnights",
+			expErr: errors.New("invalid duration"),
+		},
+		"negative duration invalid": {
+			input:  "-5m",
+			expErr: errors.New("may not be negative"),
+		},
+		"0": {
+			input:  "0",
+			expStr: "0s",
+		},
+		"raw number is seconds": {
+			input:  "90",
+			expDur: 90 * time.Second,
+			expStr: "1m30s",
+		},
+		"minutes": {
+			input:  "90m",
+			expDur: 90 * time.Minute,
+			expStr: "1h30m0s",
+		},
+		"compound": {
+			input:  "2h30m",
+			expDur: 150 * time.Minute,
+			expStr: "2h30m0s",
+		},
+		"fractional": {
+			input:  "1.5h",
+			expDur: 90 * time.Minute,
+			expStr: "1h30m0s",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := ui.DurationFlag{}
+			gotErr := f.UnmarshalFlag(tc.input)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				test.AssertFalse(t, f.IsSet(), "shouldn't be set on error")
+				return
+			}
+			test.AssertTrue(t, f.IsSet(), "should be set on success")
+			test.AssertEqual(t, tc.expDur, f.Duration, "unexpected duration")
+			test.AssertEqual(t, tc.expStr, f.String(), "unexpected string")
+		})
+	}
+}
+
+func TestUI_MinutesFlag(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input  string
+		expDur time.Duration
+		expErr error
+	}{
+		"empty": {
+			expErr: errors.New("no duration specified"),
+		},
+		"invalid duration": {
+			input:  "soon",
+			expErr: errors.New("invalid duration"),
+		},
+		"raw number is minutes": {
+			input:  "5",
+			expDur: 5 * time.Minute,
+		},
+		"with unit": {
+			input:  "90s",
+			expDur: 90 * time.Second,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := ui.MinutesFlag{}
+			gotErr := f.UnmarshalFlag(tc.input)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				test.AssertFalse(t, f.IsSet(), "shouldn't be set on error")
+				return
+			}
+			test.AssertTrue(t, f.IsSet(), "should be set on success")
+			test.AssertEqual(t, tc.expDur, f.Duration, "unexpected duration")
+		})
+	}
+}
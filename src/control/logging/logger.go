@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -71,6 +72,7 @@ type (
 		sync.RWMutex
 
 		level         LogLevel
+		moduleLevels  map[string]LogLevel
 		traceLoggers  []TraceLogger
 		debugLoggers  []DebugLogger
 		infoLoggers   []InfoLogger
@@ -0,0 +1,308 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package logging
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// ModuleSeparator separates the components of a hierarchical module
+	// name, e.g. agent.cache.
+	ModuleSeparator = "."
+
+	moduleLevelSeparator = ","
+	moduleLevelAssign    = "="
+)
+
+var _ Logger = (*ModuleLogger)(nil)
+
+// ModuleLogger provides a Logger for a named control plane module (e.g.
+// agent.cache) which emits messages via its parent LeveledLogger. The level
+// at which messages are emitted may be set per module on the parent logger,
+// so that verbose output can be enabled for a single subsystem.
+type ModuleLogger struct {
+	parent *LeveledLogger
+	name   string
+}
+
+// ParseModuleLevels parses a comma-separated list of module=level pairs,
+// e.g. "agent.cache=debug,server.mgmt=trace".
+func ParseModuleLevels(in string) (map[string]LogLevel, error) {
+	levels := make(map[string]LogLevel)
+	for _, pair := range strings.Split(in, moduleLevelSeparator) {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		comps := strings.Split(pair, moduleLevelAssign)
+		if len(comps) != 2 {
+			return nil, fmt.Errorf("invalid module log level %q (expected module=level)", pair)
+		}
+		name := strings.TrimSpace(comps[0])
+		if err := checkModuleName(name); err != nil {
+			return nil, err
+		}
+
+		var level LogLevel
+		if err := level.SetString(strings.TrimSpace(comps[1])); err != nil {
+			return nil, err
+		}
+		levels[name] = level
+	}
+
+	return levels, nil
+}
+
+func checkModuleName(name string) error {
+	if name == "" || strings.ContainsAny(name, moduleLevelSeparator+moduleLevelAssign+" \t") {
+		return fmt.Errorf("invalid log module name %q", name)
+	}
+	for _, comp := range strings.Split(name, ModuleSeparator) {
+		if comp == "" {
+			return fmt.Errorf("invalid log module name %q", name)
+		}
+	}
+
+	return nil
+}
+
+// ForModule returns a Logger for the named module. If the supplied logger does
+// not support per-module levels, it is returned unchanged.
+func ForModule(log Logger, name string) Logger {
+	switch l := log.(type) {
+	case *LeveledLogger:
+		return l.Module(name)
+	case *ModuleLogger:
+		return l.parent.Module(name)
+	default:
+		return log
+	}
+}
+
+// Module returns a ModuleLogger for the named module.
+func (ll *LeveledLogger) Module(name string) *ModuleLogger {
+	return &ModuleLogger{
+		parent: ll,
+		name:   name,
+	}
+}
+
+// SetModuleLevel sets the LogLevel for the named module, overriding the
+// logger's level for messages emitted by the module and its submodules.
+func (ll *LeveledLogger) SetModuleLevel(name string, level LogLevel) error {
+	if err := checkModuleName(name); err != nil {
+		return err
+	}
+
+	ll.Lock()
+	defer ll.Unlock()
+
+	if ll.moduleLevels == nil {
+		ll.moduleLevels = make(map[string]LogLevel)
+	}
+	ll.moduleLevels[name] = level
+
+	return nil
+}
+
+// SetModuleLevels replaces all of the logger's module levels with the
+// supplied levels. A nil or empty map clears the module levels.
+func (ll *LeveledLogger) SetModuleLevels(levels map[string]LogLevel) error {
+	newLevels := make(map[string]LogLevel, len(levels))
+	for name, level := range levels {
+		if err := checkModuleName(name); err != nil {
+			return err
+		}
+		newLevels[name] = level
+	}
+
+	ll.Lock()
+	defer ll.Unlock()
+	ll.moduleLevels = newLevels
+
+	return nil
+}
+
+// ModuleLevels returns a copy of the logger's module levels.
+func (ll *LeveledLogger) ModuleLevels() map[string]LogLevel {
+	ll.RLock()
+	defer ll.RUnlock()
+
+	levels := make(map[string]LogLevel, len(ll.moduleLevels))
+	for name, level := range ll.moduleLevels {
+		levels[name] = level
+	}
+
+	return levels
+}
+
+// ModuleLevelsString returns the logger's module levels formatted as a
+// sorted, comma-separated list of module=level pairs.
+func (ll *LeveledLogger) ModuleLevelsString() string {
+	levels := ll.ModuleLevels()
+
+	pairs := make([]string, 0, len(levels))
+	for name, level := range levels {
+		pairs = append(pairs, name+moduleLevelAssign+level.String())
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, moduleLevelSeparator)
+}
+
+// ModuleLevel returns the effective LogLevel for the named module. The level
+// set for the most specific enclosing module is used, e.g. a level set for
+// agent applies to agent.cache unless one is set for agent.cache. If no level
+// is set for the module, the logger's level is returned.
+func (ll *LeveledLogger) ModuleLevel(name string) LogLevel {
+	ll.RLock()
+	defer ll.RUnlock()
+
+	for len(ll.moduleLevels) > 0 && name != "" {
+		if level, found := ll.moduleLevels[name]; found {
+			return level
+		}
+
+		idx := strings.LastIndex(name, ModuleSeparator)
+		if idx < 0 {
+			break
+		}
+		name = name[:idx]
+	}
+
+	return ll.level.Get()
+}
+
+// Name returns the name of the module.
+func (ml *ModuleLogger) Name() string {
+	return ml.name
+}
+
+// Level returns the module's current LogLevel.
+func (ml *ModuleLogger) Level() LogLevel {
+	return ml.parent.ModuleLevel(ml.name)
+}
+
+// EnabledFor returns true if the module is enabled for the
+// specified LogLevel.
+func (ml *ModuleLogger) EnabledFor(level LogLevel) bool {
+	return ml.Level() >= level
+}
+
+// Trace emits an unformatted message at Trace level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Trace(msg string) {
+	ml.Tracef("%s", msg)
+}
+
+// Tracef emits a formatted message at Trace level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Tracef(format string, args ...interface{}) {
+	if !ml.EnabledFor(LogLevelTrace) {
+		return
+	}
+
+	ml.parent.RLock()
+	loggers := ml.parent.traceLoggers
+	ml.parent.RUnlock()
+
+	for _, l := range loggers {
+		l.Tracef(format, args...)
+	}
+}
+
+// Debug emits an unformatted message at Debug level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Debug(msg string) {
+	ml.Debugf("%s", msg)
+}
+
+// Debugf emits a formatted message at Debug level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Debugf(format string, args ...interface{}) {
+	if !ml.EnabledFor(LogLevelDebug) {
+		return
+	}
+
+	ml.parent.RLock()
+	loggers := ml.parent.debugLoggers
+	ml.parent.RUnlock()
+
+	for _, l := range loggers {
+		l.Debugf(format, args...)
+	}
+}
+
+// Info emits an unformatted message at Info level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Info(msg string) {
+	ml.Infof("%s", msg)
+}
+
+// Infof emits a formatted message at Info level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Infof(format string, args ...interface{}) {
+	if !ml.EnabledFor(LogLevelInfo) {
+		return
+	}
+
+	ml.parent.RLock()
+	loggers := ml.parent.infoLoggers
+	ml.parent.RUnlock()
+
+	for _, l := range loggers {
+		l.Infof(format, args...)
+	}
+}
+
+// Notice emits an unformatted message at Notice level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Notice(msg string) {
+	ml.Noticef("%s", msg)
+}
+
+// Noticef emits a formatted message at Notice level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Noticef(format string, args ...interface{}) {
+	if !ml.EnabledFor(LogLevelNotice) {
+		return
+	}
+
+	ml.parent.RLock()
+	loggers := ml.parent.noticeLoggers
+	ml.parent.RUnlock()
+
+	for _, l := range loggers {
+		l.Noticef(format, args...)
+	}
+}
+
+// Error emits an unformatted message at Error level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Error(msg string) {
+	ml.Errorf("%s", msg)
+}
+
+// Errorf emits a formatted message at Error level, if
+// the module is configured to do so.
+func (ml *ModuleLogger) Errorf(format string, args ...interface{}) {
+	if !ml.EnabledFor(LogLevelError) {
+		return
+	}
+
+	ml.parent.RLock()
+	loggers := ml.parent.errorLoggers
+	ml.parent.RUnlock()
+
+	for _, l := range loggers {
+		l.Errorf(format, args...)
+	}
+}
@@ -0,0 +1,160 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package logging_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestLogging_ParseModuleLevels(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in        string
+		expLevels map[string]logging.LogLevel
+		expErr    bool
+	}{
+		"empty": {
+			expLevels: map[string]logging.LogLevel{},
+		},
+		"single": {
+			in: "agent.cache=debug",
+			expLevels: map[string]logging.LogLevel{
+				"agent.cache": logging.LogLevelDebug,
+			},
+		},
+		"multiple with whitespace": {
+			in: " agent.cache = DEBUG, server.mgmt=trace,",
+			expLevels: map[string]logging.LogLevel{
+				"agent.cache": logging.LogLevelDebug,
+				"server.mgmt": logging.LogLevelTrace,
+			},
+		},
+		"missing level": {
+			in:     "agent.cache",
+			expErr: true,
+		},
+		"bad level": {
+			in:     "agent.cache=loud",
+			expErr: true,
+		},
+		"empty module": {
+			in:     "=debug",
+			expErr: true,
+		},
+		"empty module component": {
+			in:     "agent..cache=debug",
+			expErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			levels, err := logging.ParseModuleLevels(tc.in)
+			if tc.expErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expLevels, levels); diff != "" {
+				t.Fatalf("unexpected levels (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestLogging_ModuleLevel(t *testing.T) {
+	log, _ := logging.NewTestLogger(t.Name())
+	log.SetLevel(logging.LogLevelInfo)
+
+	if err := log.SetModuleLevels(map[string]logging.LogLevel{
+		"agent":       logging.LogLevelDebug,
+		"agent.cache": logging.LogLevelTrace,
+		"server.mgmt": logging.LogLevelError,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for module, expLevel := range map[string]logging.LogLevel{
+		"agent":            logging.LogLevelDebug,
+		"agent.cache":      logging.LogLevelTrace,
+		"agent.cache.item": logging.LogLevelTrace,
+		"agent.fabric":     logging.LogLevelDebug,
+		"agents":           logging.LogLevelInfo,
+		"server":           logging.LogLevelInfo,
+		"server.mgmt":      logging.LogLevelError,
+		"dmg.pool":         logging.LogLevelInfo,
+	} {
+		t.Run(module, func(t *testing.T) {
+			if got := log.ModuleLevel(module); got != expLevel {
+				t.Fatalf("expected level %s, got %s", expLevel, got)
+			}
+		})
+	}
+
+	expStr := "agent.cache=TRACE,agent=DEBUG,server.mgmt=ERROR"
+	if got := log.ModuleLevelsString(); got != expStr {
+		t.Fatalf("expected %q, got %q", expStr, got)
+	}
+
+	if err := log.SetModuleLevels(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := log.ModuleLevel("agent.cache"); got != logging.LogLevelInfo {
+		t.Fatalf("expected level %s after clear, got %s", logging.LogLevelInfo, got)
+	}
+}
+
+func TestLogging_ModuleLogger(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	log.SetLevel(logging.LogLevelInfo)
+
+	if err := log.SetModuleLevel("agent.cache", logging.LogLevelDebug); err != nil {
+		t.Fatal(err)
+	}
+	if err := log.SetModuleLevel("server.mgmt", logging.LogLevelError); err != nil {
+		t.Fatal(err)
+	}
+
+	cacheLog := logging.ForModule(log, "agent.cache")
+	fabricLog := logging.ForModule(cacheLog, "agent.fabric")
+	mgmtLog := logging.ForModule(log, "server.mgmt")
+
+	cacheLog.Debug("cache debug")
+	cacheLog.Trace("cache trace")
+	fabricLog.Debug("fabric debug")
+	fabricLog.Info("fabric info")
+	mgmtLog.Notice("mgmt notice")
+	mgmtLog.Error("mgmt error")
+	log.Debug("base debug")
+
+	out := buf.String()
+	for msg, expFound := range map[string]bool{
+		"cache debug":  true,
+		"cache trace":  false,
+		"fabric debug": false,
+		"fabric info":  true,
+		"mgmt notice":  false,
+		"mgmt error":   true,
+		"base debug":   false,
+	} {
+		if strings.Contains(out, msg) != expFound {
+			t.Errorf("expected %q found=%t in output:\n%s", msg, expFound, out)
+		}
+	}
+
+	// The caller location should be reported for module debug messages.
+	if !strings.Contains(out, "module_test.go") {
+		t.Errorf("expected caller location in output:\n%s", out)
+	}
+}
@@ -24,6 +24,14 @@ const (
 	// can be set to enable non-ERROR logging in the privileged helper.
 	DaosPrivHelperLogFileEnvVar = "DAOS_HELPER_LOG_FILE"
 
+	// DaosAgentHelperName is the name of the agent's privileged helper.
+	DaosAgentHelperName = "daos_agent_helper"
+
+	// DaosAgentHelperLogFileEnvVar is the name of the environment variable
+	// which can be set to enable non-ERROR logging in the agent's privileged
+	// helper.
+	DaosAgentHelperLogFileEnvVar = "DAOS_AGENT_HELPER_LOG_FILE"
+
 	// DaosFWName is the name of the firmware helper.
 	DaosFWName = "daos_firmware_helper"
 
@@ -0,0 +1,19 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package pbin
+
+import (
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+func setuid(uid int) error {
+	return errors.Errorf("setuid not supported on %s", runtime.GOOS)
+}
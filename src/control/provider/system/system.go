@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,27 @@ import (
 	"os/exec"
 )
 
+const (
+	FsTypeNone    = "none"
+	FsTypeExt4    = "ext4"
+	FsTypeBtrfs   = "btrfs"
+	FsTypeXfs     = "xfs"
+	FsTypeZfs     = "zfs"
+	FsTypeNtfs    = "ntfs"
+	FsTypeTmpfs   = "tmpfs"
+	FsTypeNfs     = "nfs"
+	FsTypeUnknown = "unknown"
+
+	// magic numbers harvested from statfs man page
+	MagicTmpfs = 0x01021994
+	MagicExt4  = 0xEF53
+	MagicBtrfs = 0x9123683E
+	MagicXfs   = 0x58465342
+	MagicZfs   = 0x2FC12FC1
+	MagicNtfs  = 0x5346544e
+	MagicNfs   = 0x6969
+)
+
 type (
 	// IsMountedProvider is the interface that wraps the IsMounted method,
 	// which can be provided by a system-specific implementation or a mock.
@@ -28,6 +50,20 @@ type (
 		Unmount(target string, flags int) error
 	}
 
+	// FsType describes the filesystem mounted at a path.
+	FsType struct {
+		Name   string
+		NoSUID bool
+	}
+
+	// MkfsReq defines the input parameters for a Mkfs call.
+	MkfsReq struct {
+		Device     string
+		Filesystem string
+		Options    []string
+		Force      bool
+	}
+
 	// RunCmdError documents the output of a command that has been run.
 	RunCmdError struct {
 		Wrapped error  // Error from the command run
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -20,28 +21,7 @@ import (
 	"golang.org/x/sys/unix"
 )
 
-const (
-	FsTypeNone    = "none"
-	FsTypeExt4    = "ext4"
-	FsTypeBtrfs   = "btrfs"
-	FsTypeXfs     = "xfs"
-	FsTypeZfs     = "zfs"
-	FsTypeNtfs    = "ntfs"
-	FsTypeTmpfs   = "tmpfs"
-	FsTypeNfs     = "nfs"
-	FsTypeUnknown = "unknown"
-
-	parseFsUnformatted = "data"
-
-	// magic numbers harvested from statfs man page
-	MagicTmpfs = 0x01021994
-	MagicExt4  = 0xEF53
-	MagicBtrfs = 0x9123683E
-	MagicXfs   = 0x58465342
-	MagicZfs   = 0x2FC12FC1
-	MagicNtfs  = 0x5346544e
-	MagicNfs   = 0x6969
-)
+const parseFsUnformatted = "data"
 
 var magicToStr = map[int64]string{
 	MagicBtrfs: FsTypeBtrfs,
@@ -200,11 +180,6 @@ func (s LinuxProvider) GetfsUsage(target string) (uint64, uint64, error) {
 	return frSize * stBuf.Blocks, frSize * stBuf.Bavail, nil
 }
 
-type FsType struct {
-	Name   string
-	NoSUID bool
-}
-
 // GetfsType retrieves the filesystem type for a path.
 func (s LinuxProvider) GetfsType(path string) (*FsType, error) {
 	stBuf := new(unix.Statfs_t)
@@ -242,14 +217,6 @@ func (s LinuxProvider) checkDevice(device string) error {
 	return nil
 }
 
-// MkfsReq defines the input parameters for a Mkfs call.
-type MkfsReq struct {
-	Device     string
-	Filesystem string
-	Options    []string
-	Force      bool
-}
-
 // Mkfs attempts to create a filesystem of the supplied type, on the
 // supplied device.
 func (s LinuxProvider) Mkfs(req MkfsReq) error {
@@ -0,0 +1,104 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package system
+
+import (
+	"os"
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+var errUnsupported = errors.Errorf("operation not supported on %s", runtime.GOOS)
+
+// DefaultProvider returns the package-default provider implementation.
+func DefaultProvider() *StubProvider {
+	return &StubProvider{}
+}
+
+// StubProvider implements the system interfaces on platforms where the
+// server-side storage operations are unavailable. File operations are passed
+// through to the os package; mount and filesystem operations return errors.
+type StubProvider struct{}
+
+// IsMounted returns an error, as mount detection is not supported.
+func (s StubProvider) IsMounted(target string) (bool, error) {
+	return false, errUnsupported
+}
+
+// Mount returns an error, as mounting is not supported.
+func (s StubProvider) Mount(source, target, fstype string, flags uintptr, data string) error {
+	return errUnsupported
+}
+
+// Unmount returns an error, as unmounting is not supported.
+func (s StubProvider) Unmount(target string, flags int) error {
+	return errUnsupported
+}
+
+// GetfsUsage returns an error, as filesystem usage is not supported.
+func (s StubProvider) GetfsUsage(target string) (uint64, uint64, error) {
+	return 0, 0, errUnsupported
+}
+
+// GetfsType returns an error, as filesystem type detection is not supported.
+func (s StubProvider) GetfsType(path string) (*FsType, error) {
+	return nil, errUnsupported
+}
+
+// Mkfs returns an error, as filesystem creation is not supported.
+func (s StubProvider) Mkfs(req MkfsReq) error {
+	return errUnsupported
+}
+
+// Getfs returns an error, as filesystem probing is not supported.
+func (s StubProvider) Getfs(device string) (string, error) {
+	return FsTypeNone, errUnsupported
+}
+
+// Stat probes the specified path and returns os level file info.
+func (s StubProvider) Stat(path string) (os.FileInfo, error) {
+	return os.Stat(path)
+}
+
+// ReadFile reads the named file and returns the contents.
+func (s StubProvider) ReadFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+// Chmod changes the mode of the specified path.
+func (s StubProvider) Chmod(path string, mode os.FileMode) error {
+	return os.Chmod(path, mode)
+}
+
+// Chown changes the ownership of the specified path.
+func (s StubProvider) Chown(path string, uid, gid int) error {
+	return os.Chown(path, uid, gid)
+}
+
+// Geteuid returns the numeric effective user id of the caller.
+func (s StubProvider) Geteuid() int {
+	return os.Geteuid()
+}
+
+// Getegid returns the numeric effective group id of the caller.
+func (s StubProvider) Getegid() int {
+	return os.Getegid()
+}
+
+// Mkdir creates a new directory with the specified name and permission
+// bits (before umask).
+func (s StubProvider) Mkdir(name string, perm os.FileMode) error {
+	return os.Mkdir(name, perm)
+}
+
+// RemoveAll removes path and any children it contains.
+func (s StubProvider) RemoveAll(path string) error {
+	return os.RemoveAll(path)
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,7 +10,6 @@ package auth
 import (
 	"errors"
 	"os/user"
-	"syscall"
 	"testing"
 
 	"google.golang.org/protobuf/proto"
@@ -148,7 +148,7 @@ func testGroupNamesFn(expErr error, groupNames ...string) getGroupNamesFn {
 }
 
 func getTestCreds(uid uint32, gid uint32) *security.DomainInfo {
-	creds := &syscall.Ucred{
+	creds := &security.Ucred{
 		Uid: uid,
 		Gid: gid,
 	}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,20 +9,16 @@ package security
 
 import (
 	"fmt"
-	"net"
 	"os/user"
-	"syscall"
 
 	"github.com/pkg/errors"
-	"golang.org/x/sys/unix"
 
 	"github.com/daos-stack/daos/src/control/common"
-	"github.com/daos-stack/daos/src/control/logging"
 )
 
 // DomainInfo holds our socket credentials to be used by the DomainSocketServer
 type DomainInfo struct {
-	creds *syscall.Ucred
+	creds *Ucred
 	ctx   string
 }
 
@@ -84,27 +81,6 @@ func (d *DomainInfo) Ctx() string {
 }
 
 // InitDomainInfo returns an initialized DomainInfo structure
-func InitDomainInfo(creds *syscall.Ucred, ctx string) *DomainInfo {
+func InitDomainInfo(creds *Ucred, ctx string) *DomainInfo {
 	return &DomainInfo{creds, ctx}
 }
-
-// DomainInfoFromUnixConn determines credentials from a unix socket.
-func DomainInfoFromUnixConn(log logging.Logger, sock *net.UnixConn) (*DomainInfo, error) {
-	f, err := sock.File()
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to get socket file")
-	}
-	defer f.Close()
-
-	fd := int(f.Fd())
-	creds, err := syscall.GetsockoptUcred(fd, syscall.SOL_SOCKET, syscall.SO_PEERCRED)
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to get sockopt creds")
-	}
-
-	ctx, err := unix.GetsockoptString(fd, syscall.SOL_SOCKET, syscall.SO_PEERSEC)
-	if err != nil {
-		ctx = ""
-	}
-	return InitDomainInfo(creds, ctx), nil
-}
@@ -0,0 +1,53 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// Ucred holds the credentials of a domain socket peer.
+type Ucred struct {
+	Pid int32
+	Uid uint32
+	Gid uint32
+}
+
+// DomainInfoFromUnixConn determines credentials from a unix socket.
+func DomainInfoFromUnixConn(log logging.Logger, sock *net.UnixConn) (*DomainInfo, error) {
+	f, err := sock.File()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get socket file")
+	}
+	defer f.Close()
+
+	fd := int(f.Fd())
+	xucred, err := unix.GetsockoptXucred(fd, unix.SOL_LOCAL, unix.LOCAL_PEERCRED)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get sockopt creds")
+	}
+
+	pid, err := unix.GetsockoptInt(fd, unix.SOL_LOCAL, unix.LOCAL_PEERPID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get sockopt pid")
+	}
+
+	creds := &Ucred{
+		Pid: int32(pid),
+		Uid: xucred.Uid,
+	}
+	if xucred.Ngroups > 0 {
+		creds.Gid = xucred.Groups[0]
+	}
+
+	return InitDomainInfo(creds, ""), nil
+}
@@ -0,0 +1,42 @@
+//
+// (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"net"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// Ucred holds the credentials of a domain socket peer.
+type Ucred = syscall.Ucred
+
+// DomainInfoFromUnixConn determines credentials from a unix socket.
+func DomainInfoFromUnixConn(log logging.Logger, sock *net.UnixConn) (*DomainInfo, error) {
+	f, err := sock.File()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get socket file")
+	}
+	defer f.Close()
+
+	fd := int(f.Fd())
+	creds, err := syscall.GetsockoptUcred(fd, syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get sockopt creds")
+	}
+
+	ctx, err := unix.GetsockoptString(fd, syscall.SOL_SOCKET, syscall.SO_PEERSEC)
+	if err != nil {
+		ctx = ""
+	}
+	return InitDomainInfo(creds, ctx), nil
+}
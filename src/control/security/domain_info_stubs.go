@@ -0,0 +1,31 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package security
+
+import (
+	"net"
+	"runtime"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// Ucred holds the credentials of a domain socket peer.
+type Ucred struct {
+	Pid int32
+	Uid uint32
+	Gid uint32
+}
+
+// DomainInfoFromUnixConn returns an error, as retrieving the credentials of
+// a domain socket peer is not supported on this platform.
+func DomainInfoFromUnixConn(log logging.Logger, sock *net.UnixConn) (*DomainInfo, error) {
+	return nil, errors.Errorf("domain socket peer credentials not supported on %s", runtime.GOOS)
+}
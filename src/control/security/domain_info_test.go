@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,7 +9,6 @@ package security_test
 
 import (
 	"fmt"
-	"syscall"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -23,12 +23,12 @@ func TestSecurity_DomainInfo_String(t *testing.T) {
 		t.Fatalf("failed to get process name for pid 1: %s", err)
 	}
 
-	ucred_noPid := &syscall.Ucred{
+	ucred_noPid := &security.Ucred{
 		Pid: 0,
 		Uid: 123456,
 		Gid: 789012,
 	}
-	ucred_Pid := &syscall.Ucred{
+	ucred_Pid := &security.Ucred{
 		Pid: 1,
 		Uid: 0,
 		Gid: 0,
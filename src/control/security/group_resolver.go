@@ -0,0 +1,162 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultGroupResolverTimeout  = 5 * time.Second
+	defaultGroupResolverCacheTTL = 5 * time.Minute
+)
+
+// GroupResolverConfig configures an external command used to look up the
+// groups to which a user belongs, e.g. from an LDAP directory. The command is
+// run with the user name as its only argument and must print the names of the
+// user's groups, one per line.
+type GroupResolverConfig struct {
+	Command  string        `yaml:"command"`
+	Timeout  time.Duration `yaml:"timeout,omitempty"`
+	CacheTTL time.Duration `yaml:"cache_ttl,omitempty"`
+}
+
+// Validate checks the group resolver configuration.
+func (cfg *GroupResolverConfig) Validate() error {
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.Command == "" {
+		return errors.New("command must be set")
+	}
+	if !filepath.IsAbs(cfg.Command) {
+		return errors.Errorf("command %q must be an absolute path", cfg.Command)
+	}
+	if cfg.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+	if cfg.CacheTTL < 0 {
+		return errors.New("cache_ttl must not be negative")
+	}
+
+	return nil
+}
+
+// GroupResolver is an interface for looking up the groups to which a user
+// belongs.
+type GroupResolver interface {
+	ResolveGroups(ctx context.Context, user string) ([]string, error)
+}
+
+type cachedGroups struct {
+	groups  []string
+	expires time.Time
+}
+
+// CommandGroupResolver resolves groups by running an external command.
+// Successful results are cached for the configured TTL.
+type CommandGroupResolver struct {
+	sync.Mutex
+	command  string
+	timeout  time.Duration
+	cacheTTL time.Duration
+	cache    map[string]*cachedGroups
+	now      func() time.Time
+}
+
+// NewCommandGroupResolver creates a new group resolver from the configuration.
+func NewCommandGroupResolver(cfg *GroupResolverConfig) *CommandGroupResolver {
+	r := &CommandGroupResolver{
+		command:  cfg.Command,
+		timeout:  cfg.Timeout,
+		cacheTTL: cfg.CacheTTL,
+		cache:    make(map[string]*cachedGroups),
+		now:      time.Now,
+	}
+	if r.timeout == 0 {
+		r.timeout = defaultGroupResolverTimeout
+	}
+	if r.cacheTTL == 0 {
+		r.cacheTTL = defaultGroupResolverCacheTTL
+	}
+	return r
+}
+
+// ResolveGroups returns the sorted names of the groups to which the user
+// belongs.
+func (r *CommandGroupResolver) ResolveGroups(ctx context.Context, user string) ([]string, error) {
+	if r == nil {
+		return nil, errors.Errorf("%T is nil", r)
+	}
+	if user == "" {
+		return nil, errors.New("empty user name")
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if cached, found := r.cache[user]; found && r.now().Before(cached.expires) {
+		return cached.groups, nil
+	}
+
+	groups, err := r.runCommand(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	r.cache[user] = &cachedGroups{
+		groups:  groups,
+		expires: r.now().Add(r.cacheTTL),
+	}
+
+	return groups, nil
+}
+
+func (r *CommandGroupResolver) runCommand(parent context.Context, user string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(parent, r.timeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, r.command, user)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, errors.Wrapf(ctx.Err(), "resolving groups for %q", user)
+		}
+		return nil, errors.Wrapf(err, "resolving groups for %q: %s", user,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	seen := make(map[string]struct{})
+	var groups []string
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		group := strings.TrimSpace(scanner.Text())
+		if group == "" {
+			continue
+		}
+		if _, found := seen[group]; found {
+			continue
+		}
+		seen[group] = struct{}{}
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	return groups, nil
+}
@@ -0,0 +1,160 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSecurity_GroupResolverConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *GroupResolverConfig
+		expErr error
+	}{
+		"nil": {},
+		"no command": {
+			cfg:    &GroupResolverConfig{},
+			expErr: errors.New("command must be set"),
+		},
+		"relative command": {
+			cfg:    &GroupResolverConfig{Command: "resolve_groups"},
+			expErr: errors.New("absolute path"),
+		},
+		"negative timeout": {
+			cfg: &GroupResolverConfig{
+				Command: "/usr/bin/resolve_groups",
+				Timeout: -time.Second,
+			},
+			expErr: errors.New("timeout"),
+		},
+		"negative cache TTL": {
+			cfg: &GroupResolverConfig{
+				Command:  "/usr/bin/resolve_groups",
+				CacheTTL: -time.Second,
+			},
+			expErr: errors.New("cache_ttl"),
+		},
+		"valid": {
+			cfg: &GroupResolverConfig{
+				Command:  "/usr/bin/resolve_groups",
+				Timeout:  time.Second,
+				CacheTTL: time.Minute,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+func writeResolverScript(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+content), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSecurity_CommandGroupResolver_ResolveGroups(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	okCmd := writeResolverScript(t, tmpDir, "ok.sh",
+		"[ \"$1\" = \"alice\" ] || exit 1\necho hpc\necho \"  admins \"\necho\necho hpc\n")
+	failCmd := writeResolverScript(t, tmpDir, "fail.sh", "echo 'ldap unavailable' >&2\nexit 1\n")
+	slowCmd := writeResolverScript(t, tmpDir, "slow.sh", "exec sleep 5\n")
+
+	for name, tc := range map[string]struct {
+		cfg       *GroupResolverConfig
+		user      string
+		expGroups []string
+		expErr    error
+	}{
+		"empty user": {
+			cfg:    &GroupResolverConfig{Command: okCmd},
+			expErr: errors.New("empty user"),
+		},
+		"success": {
+			cfg:       &GroupResolverConfig{Command: okCmd},
+			user:      "alice",
+			expGroups: []string{"admins", "hpc"},
+		},
+		"command fails": {
+			cfg:    &GroupResolverConfig{Command: failCmd},
+			user:   "alice",
+			expErr: errors.New("ldap unavailable"),
+		},
+		"command times out": {
+			cfg: &GroupResolverConfig{
+				Command: slowCmd,
+				Timeout: 10 * time.Millisecond,
+			},
+			user:   "alice",
+			expErr: errors.New("deadline exceeded"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := NewCommandGroupResolver(tc.cfg)
+
+			gotGroups, gotErr := r.ResolveGroups(test.Context(t), tc.user)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expGroups, gotGroups); diff != "" {
+				t.Fatalf("unexpected groups (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSecurity_CommandGroupResolver_Cache(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	// The script reports a different group on each invocation.
+	countFile := filepath.Join(tmpDir, "count")
+	cmd := writeResolverScript(t, tmpDir, "count.sh",
+		"echo x >> "+countFile+"\necho group$(wc -l < "+countFile+" | tr -d ' ')\n")
+
+	r := NewCommandGroupResolver(&GroupResolverConfig{
+		Command:  cmd,
+		CacheTTL: time.Minute,
+	})
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	resolve := func(expGroup string) {
+		t.Helper()
+
+		groups, err := r.ResolveGroups(test.Context(t), "alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]string{expGroup}, groups); diff != "" {
+			t.Fatalf("unexpected groups (-want, +got):\n%s\n", diff)
+		}
+	}
+
+	resolve("group1")
+	resolve("group1")
+
+	now = now.Add(2 * time.Minute)
+	resolve("group2")
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -53,31 +54,40 @@ func inList(c Component, compList []Component) bool {
 func TestSecurity_ComponentHasAccess(t *testing.T) {
 	allComponents := []Component{ComponentUndefined, ComponentAdmin, ComponentAgent, ComponentServer}
 	testCases := map[string][]Component{
-		"/ctl.CtlSvc/StorageScan":                {ComponentAdmin},
+		"/ctl.CtlSvc/StorageScan":                {ComponentAdmin, ComponentServer},
+		"/ctl.CtlSvc/StorageScanStream":          {ComponentAdmin, ComponentServer},
 		"/ctl.CtlSvc/StorageFormat":              {ComponentAdmin},
+		"/ctl.CtlSvc/StorageFormatStream":        {ComponentAdmin},
 		"/ctl.CtlSvc/StorageNvmeRebind":          {ComponentAdmin},
 		"/ctl.CtlSvc/StorageNvmeAddDevice":       {ComponentAdmin},
 		"/ctl.CtlSvc/NetworkScan":                {ComponentAdmin},
 		"/ctl.CtlSvc/CollectLog":                 {ComponentAdmin},
+		"/ctl.CtlSvc/TailLog":                    {ComponentAdmin},
 		"/ctl.CtlSvc/FirmwareQuery":              {ComponentAdmin},
 		"/ctl.CtlSvc/FirmwareUpdate":             {ComponentAdmin},
 		"/ctl.CtlSvc/SmdQuery":                   {ComponentAdmin},
 		"/ctl.CtlSvc/SmdManage":                  {ComponentAdmin},
 		"/ctl.CtlSvc/SetEngineLogMasks":          {ComponentAdmin},
+		"/ctl.CtlSvc/ListEngines":                {ComponentAdmin},
+		"/ctl.CtlSvc/GetComponentVersions":       {ComponentAdmin},
 		"/ctl.CtlSvc/PrepShutdownRanks":          {ComponentServer},
 		"/ctl.CtlSvc/StopRanks":                  {ComponentServer},
 		"/ctl.CtlSvc/ResetFormatRanks":           {ComponentServer},
 		"/ctl.CtlSvc/StartRanks":                 {ComponentServer},
 		"/mgmt.MgmtSvc/Join":                     {ComponentServer},
 		"/mgmt.MgmtSvc/ClusterEvent":             {ComponentServer},
+		"/mgmt.MgmtSvc/AgentEvent":               {ComponentAgent},
 		"/mgmt.MgmtSvc/LeaderQuery":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemQuery":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemStop":               {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemStopStream":         {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemErase":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemStart":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemExclude":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemListScheduled":      {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemDrain":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolCreate":               {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolCreateStream":         {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolDestroy":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolQuery":                {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolQueryTarget":          {ComponentAdmin},
@@ -93,9 +103,13 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/mgmt.MgmtSvc/PoolEvict":                {ComponentAdmin, ComponentAgent},
 		"/mgmt.MgmtSvc/PoolExtend":               {ComponentAdmin},
 		"/mgmt.MgmtSvc/GetAttachInfo":            {ComponentAgent},
+		"/mgmt.MgmtSvc/GetAttachInfoStream":      {ComponentAgent},
 		"/mgmt.MgmtSvc/ListPools":                {ComponentAdmin},
 		"/mgmt.MgmtSvc/ListContainers":           {ComponentAdmin},
 		"/mgmt.MgmtSvc/ContSetOwner":             {ComponentAdmin},
+		"/mgmt.MgmtSvc/ContCreate":               {ComponentAdmin},
+		"/mgmt.MgmtSvc/ContDestroy":              {ComponentAdmin},
+		"/mgmt.MgmtSvc/ContQuery":                {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemCleanup":            {ComponentAdmin, ComponentAgent},
 		"/mgmt.MgmtSvc/SystemCheckEnable":        {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemCheckDisable":       {ComponentAdmin},
@@ -108,16 +122,35 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/mgmt.MgmtSvc/FaultInjectReport":        {ComponentAdmin},
 		"/mgmt.MgmtSvc/FaultInjectPoolFault":     {ComponentAdmin},
 		"/mgmt.MgmtSvc/FaultInjectMgmtPoolFault": {ComponentAdmin},
+		"/mgmt.MgmtSvc/FaultInjectEngine":        {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolUpgrade":              {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolRebalance":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolRenameLabel":          {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolUpdateAliases":        {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemSetAttr":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemGetAttr":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemSetProp":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemGetProp":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemSetFaultDomains":    {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemEvents":             {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemReplaceHost":        {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemUsage":              {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolMembershipChanges":    {ComponentAgent},
+		"/mgmt.MgmtSvc/SystemOpLocks":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/AgentHeartbeat":           {ComponentAgent},
+		"/mgmt.MgmtSvc/SystemListClients":        {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemChanges":            {ComponentAgent},
+		"/mgmt.MgmtSvc/PoolSetPolicy":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolRemovePolicy":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolListPolicies":         {ComponentAdmin},
 		"/RaftTransport/AppendEntries":           {ComponentServer},
 		"/RaftTransport/AppendEntriesPipeline":   {ComponentServer},
 		"/RaftTransport/RequestVote":             {ComponentServer},
 		"/RaftTransport/TimeoutNow":              {ComponentServer},
 		"/RaftTransport/InstallSnapshot":         {ComponentServer},
+
+		"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      {ComponentAdmin, ComponentAgent, ComponentServer},
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": {ComponentAdmin, ComponentAgent, ComponentServer},
 	}
 
 	var missing []string
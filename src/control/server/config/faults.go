@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
@@ -76,6 +78,11 @@ var (
 		"both fault domain and fault path are defined in the configuration",
 		"remove either the fault domain ('fault_path' parameter) or callback script ('fault_cb' parameter) and restart the control server",
 	)
+	FaultConfigFaultDomainSourceConflict = serverConfigFault(
+		code.ServerConfigFaultDomainSourceConflict,
+		"a fault domain provider is defined in the configuration along with a fault domain or fault path",
+		"remove either the fault domain provider ('fault_provider' parameter) or the fault domain ('fault_path' parameter) and callback script ('fault_cb' parameter) and restart the control server",
+	)
 	FaultConfigFaultCallbackEmpty = serverConfigFault(
 		code.ServerConfigFaultCallbackEmpty,
 		"fault domain callback executed but did not generate output",
@@ -224,6 +231,37 @@ func FaultConfigFaultCallbackInsecure(requiredDir string) *fault.Fault {
 	)
 }
 
+// FaultConfigFaultProviderUnknown creates a fault for the scenario where the
+// configured fault domain provider is not supported.
+func FaultConfigFaultProviderUnknown(provider string, supported []string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigFaultProviderUnknown,
+		fmt.Sprintf("unknown fault domain provider %q", provider),
+		fmt.Sprintf("specify one of the supported fault domain providers (%s) in the 'fault_provider' parameter and restart the control server",
+			strings.Join(supported, ", ")),
+	)
+}
+
+// FaultConfigFaultProviderFailed creates a fault for the scenario where the
+// fault domain could not be retrieved from the configured provider.
+func FaultConfigFaultProviderFailed(provider string, err error) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigFaultProviderFailed,
+		fmt.Sprintf("fault domain provider %q failed: %s", provider, err.Error()),
+		"ensure that the instance metadata service of the cloud provider is reachable from the host, or specify the fault domain ('fault_path' parameter) and restart the control server",
+	)
+}
+
+// FaultConfigBadTelemetryOTLPEndpoint creates a fault for the scenario where the configured
+// OTLP collector endpoint is not a valid HTTP(S) URL.
+func FaultConfigBadTelemetryOTLPEndpoint(param, endpoint string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadTelemetryOTLPEndpoint,
+		fmt.Sprintf("invalid OTLP endpoint %q in configuration ('%s' parameter)", endpoint, param),
+		fmt.Sprintf("specify the full http:// or https:// URL of an OpenTelemetry collector's OTLP/HTTP endpoint (e.g. http://collector:4318/v1/logs for logs or http://collector:4318/v1/traces for traces) in configuration ('%s' parameter) and restart the control server", param),
+	)
+}
+
 // FaultConfigNrHugepagesOutOfRange creates a fault for the scenario where the number of configured
 // huge pages is smaller than zero or larger than the maximum value allowed.
 func FaultConfigNrHugepagesOutOfRange(req, max int) *fault.Fault {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -13,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -40,6 +42,37 @@ type SupportConfig struct {
 	FileTransferExec string `yaml:"file_transfer_exec,omitempty"`
 }
 
+// PoolGroupTemplate describes the default properties and ACL entries that are
+// applied to pools created by members of a group.
+type PoolGroupTemplate struct {
+	Group      string   `yaml:"group"`
+	Properties []string `yaml:"properties,omitempty"`
+	ACL        []string `yaml:"acl,omitempty"`
+}
+
+// PoolProperties returns the parsed template properties.
+func (pgt *PoolGroupTemplate) PoolProperties() ([]*daos.PoolProperty, error) {
+	var props []*daos.PoolProperty
+	for _, str := range pgt.Properties {
+		name, value, found := strings.Cut(str, ":")
+		if !found {
+			return nil, errors.Errorf("invalid property %q (must be name:value)", str)
+		}
+		prop, err := daos.PoolProperties().GetProperty(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		if prop.Number == daos.PoolPropertyLabel {
+			return nil, errors.New("pool label may not be set in a template")
+		}
+		if err := prop.SetValue(strings.TrimSpace(value)); err != nil {
+			return nil, err
+		}
+		props = append(props, prop)
+	}
+	return props, nil
+}
+
 type deprecatedParams struct {
 	AccessPoints []string `yaml:"access_points,omitempty"` // deprecated in 2.8
 }
@@ -48,26 +81,35 @@ type deprecatedParams struct {
 // See utils/config/daos_server.yml for parameter descriptions.
 type Server struct {
 	// control-specific
-	ControlPort       int                       `yaml:"port"`
-	TransportConfig   *security.TransportConfig `yaml:"transport_config"`
-	Engines           []*engine.Config          `yaml:"engines"`
-	BdevExclude       []string                  `yaml:"bdev_exclude,omitempty"`
-	DisableVFIO       bool                      `yaml:"disable_vfio"`
-	DisableVMD        *bool                     `yaml:"disable_vmd"`
-	EnableHotplug     bool                      `yaml:"enable_hotplug"`
-	NrHugepages       int                       `yaml:"nr_hugepages"`        // total for all engines
-	SystemRamReserved int                       `yaml:"system_ram_reserved"` // total for all engines
-	DisableHugepages  bool                      `yaml:"disable_hugepages"`
-	ControlLogMask    common.ControlLogLevel    `yaml:"control_log_mask"`
-	ControlLogFile    string                    `yaml:"control_log_file,omitempty"`
-	ControlLogJSON    bool                      `yaml:"control_log_json,omitempty"`
-	HelperLogFile     string                    `yaml:"helper_log_file,omitempty"`
-	FWHelperLogFile   string                    `yaml:"firmware_helper_log_file,omitempty"`
-	FaultPath         string                    `yaml:"fault_path,omitempty"`
-	TelemetryPort     int                       `yaml:"telemetry_port,omitempty"`
-	CoreDumpFilter    uint8                     `yaml:"core_dump_filter,omitempty"`
-	ClientEnvVars     []string                  `yaml:"client_env_vars,omitempty"`
-	SupportConfig     SupportConfig             `yaml:"support_config,omitempty"`
+	ControlPort         int                               `yaml:"port"`
+	TransportConfig     *security.TransportConfig         `yaml:"transport_config"`
+	Engines             []*engine.Config                  `yaml:"engines"`
+	BdevExclude         []string                          `yaml:"bdev_exclude,omitempty"`
+	DisableVFIO         bool                              `yaml:"disable_vfio"`
+	DisableVMD          *bool                             `yaml:"disable_vmd"`
+	EnableHotplug       bool                              `yaml:"enable_hotplug"`
+	LedIdentifyTimeout  uint32                            `yaml:"led_identify_timeout,omitempty"`
+	LedIdentifyMaxTime  uint32                            `yaml:"led_identify_max_timeout,omitempty"`
+	NrHugepages         int                               `yaml:"nr_hugepages"`        // total for all engines
+	SystemRamReserved   int                               `yaml:"system_ram_reserved"` // total for all engines
+	DisableHugepages    bool                              `yaml:"disable_hugepages"`
+	ControlLogMask      common.ControlLogLevel            `yaml:"control_log_mask"`
+	ControlLogModules   map[string]common.ControlLogLevel `yaml:"control_log_modules,omitempty"`
+	ControlLogFile      string                            `yaml:"control_log_file,omitempty"`
+	ControlLogJSON      bool                              `yaml:"control_log_json,omitempty"`
+	ControlMaxMsgSize   string                            `yaml:"control_max_msg_size,omitempty"`
+	HelperLogFile       string                            `yaml:"helper_log_file,omitempty"`
+	FWHelperLogFile     string                            `yaml:"firmware_helper_log_file,omitempty"`
+	FaultPath           string                            `yaml:"fault_path,omitempty"`
+	FaultProvider       string                            `yaml:"fault_provider,omitempty"`
+	TelemetryPort       int                               `yaml:"telemetry_port,omitempty"`
+	TelemetryOTLPLogs   common.OTLPConfig                 `yaml:"telemetry_otlp_logs,omitempty"`
+	TelemetryOTLPTraces common.OTLPConfig                 `yaml:"telemetry_otlp_traces,omitempty"`
+	CoreDumpFilter      uint8                             `yaml:"core_dump_filter,omitempty"`
+	ClientEnvVars       []string                          `yaml:"client_env_vars,omitempty"`
+	SupportConfig       SupportConfig                     `yaml:"support_config,omitempty"`
+	GroupResolver       *security.GroupResolverConfig     `yaml:"group_resolver,omitempty"`
+	PoolGroupTemplates  []*PoolGroupTemplate              `yaml:"pool_group_templates,omitempty"`
 
 	// duplicated in engine.Config
 	SystemName string              `yaml:"name"`
@@ -149,6 +191,18 @@ func (cfg *Server) WithClientEnvVars(envVars []string) *Server {
 	return cfg
 }
 
+// WithGroupResolver sets the configuration of the external group resolver.
+func (cfg *Server) WithGroupResolver(grc *security.GroupResolverConfig) *Server {
+	cfg.GroupResolver = grc
+	return cfg
+}
+
+// WithPoolGroupTemplates sets the group-based pool templates.
+func (cfg *Server) WithPoolGroupTemplates(templates ...*PoolGroupTemplate) *Server {
+	cfg.PoolGroupTemplates = templates
+	return cfg
+}
+
 // WithCrtTimeout sets the top-level CrtTimeout.
 func (cfg *Server) WithCrtTimeout(timeout uint32) *Server {
 	cfg.Fabric.CrtTimeout = timeout
@@ -229,6 +283,12 @@ func (cfg *Server) WithFaultPath(fp string) *Server {
 	return cfg
 }
 
+// WithFaultProvider sets the cloud provider used to determine the fault domain.
+func (cfg *Server) WithFaultProvider(provider string) *Server {
+	cfg.FaultProvider = provider
+	return cfg
+}
+
 // WithFaultCb sets the path to the fault callback script.
 func (cfg *Server) WithFaultCb(cb string) *Server {
 	cfg.FaultCb = cb
@@ -262,6 +322,14 @@ func (cfg *Server) WithEnableHotplug(enabled bool) *Server {
 	return cfg
 }
 
+// WithLedIdentifyTimeout sets the default and maximum number of minutes that
+// an SSD LED identify operation may last.
+func (cfg *Server) WithLedIdentifyTimeout(defMins, maxMins uint32) *Server {
+	cfg.LedIdentifyTimeout = defMins
+	cfg.LedIdentifyMaxTime = maxMins
+	return cfg
+}
+
 // WithHyperthreads enables or disables hyperthread support.
 func (cfg *Server) WithHyperthreads(enabled bool) *Server {
 	cfg.Hyperthreads = enabled
@@ -293,6 +361,12 @@ func (cfg *Server) WithControlLogMask(lvl common.ControlLogLevel) *Server {
 	return cfg
 }
 
+// WithControlLogModules sets the daos_server per-module log levels.
+func (cfg *Server) WithControlLogModules(levels map[string]common.ControlLogLevel) *Server {
+	cfg.ControlLogModules = levels
+	return cfg
+}
+
 // WithControlLogFile sets the path to the daos_server logfile.
 func (cfg *Server) WithControlLogFile(filePath string) *Server {
 	cfg.ControlLogFile = filePath
@@ -305,6 +379,18 @@ func (cfg *Server) WithControlLogJSON(enabled bool) *Server {
 	return cfg
 }
 
+// WithControlMaxMsgSize sets the maximum size of a control plane gRPC message.
+func (cfg *Server) WithControlMaxMsgSize(size string) *Server {
+	cfg.ControlMaxMsgSize = size
+	return cfg
+}
+
+// ControlMaxMsgBytes returns the maximum size in bytes of a gRPC message sent
+// or received by the control plane server.
+func (cfg *Server) ControlMaxMsgBytes() (int, error) {
+	return common.ParseControlMaxMsgSize(cfg.ControlMaxMsgSize)
+}
+
 // WithHelperLogFile sets the path to the daos_server_helper logfile.
 func (cfg *Server) WithHelperLogFile(filePath string) *Server {
 	cfg.HelperLogFile = filePath
@@ -323,6 +409,18 @@ func (cfg *Server) WithTelemetryPort(port int) *Server {
 	return cfg
 }
 
+// WithTelemetryOTLPLogs sets the OTLP collector configuration for RAS event export.
+func (cfg *Server) WithTelemetryOTLPLogs(otlpCfg common.OTLPConfig) *Server {
+	cfg.TelemetryOTLPLogs = otlpCfg
+	return cfg
+}
+
+// WithTelemetryOTLPTraces sets the OTLP collector configuration for request trace export.
+func (cfg *Server) WithTelemetryOTLPTraces(otlpCfg common.OTLPConfig) *Server {
+	cfg.TelemetryOTLPTraces = otlpCfg
+	return cfg
+}
+
 // DefaultServer creates a new instance of configuration struct
 // populated with defaults.
 func DefaultServer() *Server {
@@ -343,12 +441,14 @@ func DefaultServer() *Server {
 }
 
 // Load reads the serialized configuration from disk and validates file syntax.
+// Environment variable references are expanded and included files merged, see
+// common.ReadConfigFile.
 func (cfg *Server) Load(log logging.Logger) error {
 	if cfg.Path == "" {
 		return FaultConfigNoPath
 	}
 
-	bytes, err := os.ReadFile(cfg.Path)
+	bytes, _, err := common.ReadConfigFile(cfg.Path)
 	if err != nil {
 		return errors.WithMessage(err, "reading file")
 	}
@@ -687,6 +787,23 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 		return FaultConfigSysRsvdZero
 	}
 
+	if _, err := cfg.ControlMaxMsgBytes(); err != nil {
+		return errors.Wrap(err, "control_max_msg_size")
+	}
+
+	if err := cfg.GroupResolver.Validate(); err != nil {
+		return errors.Wrap(err, "group_resolver")
+	}
+
+	if err := cfg.validatePoolGroupTemplates(); err != nil {
+		return errors.Wrap(err, "pool_group_templates")
+	}
+
+	if cfg.LedIdentifyMaxTime != 0 && cfg.LedIdentifyTimeout > cfg.LedIdentifyMaxTime {
+		return errors.Errorf("led_identify_timeout (%d) may not exceed led_identify_max_timeout (%d)",
+			cfg.LedIdentifyTimeout, cfg.LedIdentifyMaxTime)
+	}
+
 	// A config without engines is valid when initially discovering hardware prior to adding
 	// per-engine sections with device allocations.
 	if len(cfg.Engines) == 0 {
@@ -715,6 +832,13 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 		return FaultConfigBadTelemetryPort
 	}
 
+	if err := cfg.TelemetryOTLPLogs.Validate(); err != nil {
+		return FaultConfigBadTelemetryOTLPEndpoint("telemetry_otlp_logs", cfg.TelemetryOTLPLogs.Endpoint)
+	}
+	if err := cfg.TelemetryOTLPTraces.Validate(); err != nil {
+		return FaultConfigBadTelemetryOTLPEndpoint("telemetry_otlp_traces", cfg.TelemetryOTLPTraces.Endpoint)
+	}
+
 	for idx, ec := range cfg.Engines {
 		ec.Storage.ControlMetadata = cfg.Metadata
 		ec.Storage.EngineIdx = uint(idx)
@@ -738,6 +862,28 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 	return nil
 }
 
+func (cfg *Server) validatePoolGroupTemplates() error {
+	seen := make(map[string]struct{})
+	for _, pgt := range cfg.PoolGroupTemplates {
+		if pgt == nil || pgt.Group == "" {
+			return errors.New("group must be set")
+		}
+		if _, found := seen[pgt.Group]; found {
+			return errors.Errorf("duplicate template for group %q", pgt.Group)
+		}
+		seen[pgt.Group] = struct{}{}
+
+		if len(pgt.Properties) == 0 && len(pgt.ACL) == 0 {
+			return errors.Errorf("template for group %q has no properties or acl entries", pgt.Group)
+		}
+		if _, err := pgt.PoolProperties(); err != nil {
+			return errors.Wrapf(err, "template for group %q", pgt.Group)
+		}
+	}
+
+	return nil
+}
+
 // validateMultiEngineConfig performs an extra level of validation for multi-server configs. The
 // goal is to ensure that each instance has unique values for resources which cannot be shared
 // (e.g. log files, fabric configurations, PCI devices, etc.)
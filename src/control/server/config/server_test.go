@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -16,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -245,11 +247,20 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithDisableVFIO(true).   // vfio enabled by default
 		WithDisableVMD(true).    // vmd enabled by default
 		WithEnableHotplug(true). // hotplug disabled by default
+		WithLedIdentifyTimeout(5, 30).
 		WithControlLogMask(common.ControlLogLevelError).
+		WithControlLogModules(map[string]common.ControlLogLevel{
+			"server.mgmt": common.ControlLogLevelDebug,
+		}).
 		WithControlLogFile("/tmp/daos_server.log").
 		WithHelperLogFile("/tmp/daos_server_helper.log").
 		WithFirmwareHelperLogFile("/tmp/daos_firmware_helper.log").
+		WithControlMaxMsgSize("64MiB").
 		WithTelemetryPort(9191).
+		WithTelemetryOTLPLogs(common.OTLPConfig{
+			Endpoint: "http://otel-collector:4318/v1/logs",
+			Headers:  map[string]string{"Authorization": "Bearer <token>"},
+		}).
 		WithSystemName("daos_server").
 		WithSocketDir("./.daos/daos_server").
 		WithFabricProvider("ofi+verbs;ofi_rxm").
@@ -257,8 +268,19 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithMgmtSvcReplicas("hostname1", "hostname2", "hostname3").
 		WithFaultCb("./.daos/fd_callback").
 		WithFaultPath("/vcdu0/rack1/hostname").
+		WithFaultProvider("aws").
 		WithClientEnvVars([]string{"foo=bar"}).
 		WithFabricAuthKey("foo:bar").
+		WithGroupResolver(&security.GroupResolverConfig{
+			Command:  "/usr/local/bin/daos_resolve_groups",
+			Timeout:  5 * time.Second,
+			CacheTTL: 5 * time.Minute,
+		}).
+		WithPoolGroupTemplates(&PoolGroupTemplate{
+			Group:      "hpc",
+			Properties: []string{"reclaim:lazy", "space_rb:5"},
+			ACL:        []string{"A:G:hpc@:rw"},
+		}).
 		WithHyperthreads(true). // hyper-threads disabled by default
 		WithSystemRamReserved(5)
 
@@ -617,12 +639,116 @@ func TestServerConfig_Validation(t *testing.T) {
 				return c.WithTelemetryPort(0)
 			},
 		},
+		"good control max message size": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithControlMaxMsgSize("64MiB")
+			},
+		},
+		"bad control max message size": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithControlMaxMsgSize("lots")
+			},
+			expErr: errors.New("control_max_msg_size"),
+		},
+		"bad group resolver": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithGroupResolver(&security.GroupResolverConfig{
+					Command: "resolve_groups",
+				})
+			},
+			expErr: errors.New("group_resolver"),
+		},
+		"pool group template without group": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithPoolGroupTemplates(&PoolGroupTemplate{
+					Properties: []string{"reclaim:lazy"},
+				})
+			},
+			expErr: errors.New("group must be set"),
+		},
+		"duplicate pool group templates": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithPoolGroupTemplates(
+					&PoolGroupTemplate{Group: "hpc", ACL: []string{"A:G:hpc@:r"}},
+					&PoolGroupTemplate{Group: "hpc", ACL: []string{"A:G:hpc@:rw"}},
+				)
+			},
+			expErr: errors.New("duplicate template"),
+		},
+		"empty pool group template": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithPoolGroupTemplates(&PoolGroupTemplate{Group: "hpc"})
+			},
+			expErr: errors.New("no properties or acl"),
+		},
+		"bad pool group template property": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithPoolGroupTemplates(&PoolGroupTemplate{
+					Group:      "hpc",
+					Properties: []string{"reclaim:sometimes"},
+				})
+			},
+			expErr: errors.New("template for group \"hpc\""),
+		},
+		"pool group template sets label": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithPoolGroupTemplates(&PoolGroupTemplate{
+					Group:      "hpc",
+					Properties: []string{"label:hpc_pool"},
+				})
+			},
+			expErr: errors.New("pool label"),
+		},
+		"led identify timeout within max": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithLedIdentifyTimeout(5, 30)
+			},
+		},
+		"led identify timeout exceeds max": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithLedIdentifyTimeout(60, 30)
+			},
+			expErr: errors.New("led_identify_timeout (60) may not exceed"),
+		},
 		"bad telemetry port (negative)": {
 			extraConfig: func(c *Server) *Server {
 				return c.WithTelemetryPort(-123)
 			},
 			expErr: FaultConfigBadTelemetryPort,
 		},
+		"OTLP logs endpoint": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryOTLPLogs(common.OTLPConfig{
+					Endpoint: "https://collector:4318/v1/logs",
+					Headers:  map[string]string{"Authorization": "Bearer token"},
+				})
+			},
+		},
+		"bad OTLP logs endpoint scheme": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryOTLPLogs(common.OTLPConfig{Endpoint: "grpc://collector:4317"})
+			},
+			expErr: FaultConfigBadTelemetryOTLPEndpoint("telemetry_otlp_logs", "grpc://collector:4317"),
+		},
+		"bad OTLP logs endpoint no host": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryOTLPLogs(common.OTLPConfig{Endpoint: "http:///v1/logs"})
+			},
+			expErr: FaultConfigBadTelemetryOTLPEndpoint("telemetry_otlp_logs", "http:///v1/logs"),
+		},
+		"OTLP traces endpoint": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryOTLPTraces(common.OTLPConfig{
+					Endpoint: "http://collector:4318/v1/traces",
+				})
+			},
+		},
+		"bad OTLP traces endpoint": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryOTLPTraces(common.OTLPConfig{Endpoint: "collector:4318"})
+			},
+			expErr: FaultConfigBadTelemetryOTLPEndpoint("telemetry_otlp_traces", "collector:4318"),
+		},
 		"different number of bdevs": {
 			extraConfig: func(c *Server) *Server {
 				// add multiple bdevs for engine 0 to create mismatch
@@ -0,0 +1,250 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+const (
+	// defaultTailLogLines is the number of lines read from the end of each
+	// log if the request doesn't specify it.
+	defaultTailLogLines = 10
+	// maxTailLogLines is the maximum number of lines that may be read from
+	// the end of each log.
+	maxTailLogLines = 10000
+	// maxTailLogFollowers is the maximum number of requests that may follow
+	// the logs at the same time.
+	maxTailLogFollowers = 8
+	// tailLogRate is the maximum rate, in lines per second, at which the
+	// lines appended to the logs are sent to a request following them. The
+	// rate is shared evenly between the logs, so that a busy log cannot
+	// starve the others. Any excess lines are skipped, and the number
+	// skipped reported.
+	tailLogRate = 1000
+	// tailLogPollInterval is the interval at which followed logs are read.
+	tailLogPollInterval = 500 * time.Millisecond
+	// tailLogBatchSize is the maximum number of lines sent in a single
+	// response.
+	tailLogBatchSize = 1000
+
+	logSourceControl = "control"
+	logSourceEngine  = "engine"
+)
+
+// tailLogSource is a log read by a TailLog request.
+type tailLogSource struct {
+	source  string
+	rank    ranklist.Rank
+	path    string
+	tail    *logTail
+	limiter *lineLimiter
+	dropped uint64 // lines skipped by the rate limit and not yet reported
+}
+
+// logLines converts lines read from the source's log, reporting any lines
+// skipped before them.
+func (src *tailLogSource) logLines(lines []string) []*ctlpb.LogLine {
+	out := make([]*ctlpb.LogLine, 0, len(lines))
+	for _, text := range lines {
+		out = append(out, &ctlpb.LogLine{
+			Source:  src.source,
+			Rank:    src.rank.Uint32(),
+			Text:    text,
+			Dropped: src.dropped,
+		})
+		src.dropped = 0
+	}
+	return out
+}
+
+// readLines reads the lines appended to the source's log, skipping the oldest
+// lines in excess of the source's rate limit.
+func (src *tailLogSource) readLines(now time.Time) ([]*ctlpb.LogLine, error) {
+	newLines, err := src.tail.readLines()
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s log %q", src.source, src.path)
+	}
+
+	allowed := src.limiter.limit(len(newLines), now)
+	src.dropped += uint64(len(newLines) - allowed)
+	return src.logLines(newLines[len(newLines)-allowed:]), nil
+}
+
+// lineLimiter limits the rate at which lines are sent, using a token bucket
+// which holds up to one second's worth of lines.
+type lineLimiter struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newLineLimiter(rate float64, now time.Time) *lineLimiter {
+	return &lineLimiter{
+		rate:   rate,
+		tokens: rate,
+		last:   now,
+	}
+}
+
+// limit returns the number of the given number of lines that may be sent.
+func (ll *lineLimiter) limit(numLines int, now time.Time) int {
+	ll.tokens = math.Min(ll.rate, ll.tokens+now.Sub(ll.last).Seconds()*ll.rate)
+	ll.last = now
+
+	allowed := int(math.Min(float64(numLines), math.Floor(ll.tokens)))
+	ll.tokens -= float64(allowed)
+	return allowed
+}
+
+// tailLogSources returns the logs to be read by the request: either that of
+// the control plane, or those of the engines with the requested ranks.
+func (svc *ControlService) tailLogSources(req *ctlpb.TailLogReq) ([]*tailLogSource, error) {
+	if req.Control {
+		if svc.srvCfg.ControlLogFile == "" {
+			return nil, errors.New("control plane log file not configured")
+		}
+		return []*tailLogSource{{
+			source: logSourceControl,
+			rank:   ranklist.NilRank,
+			path:   svc.srvCfg.ControlLogFile,
+		}}, nil
+	}
+
+	ranks, err := ranklist.CreateRankSet(req.Ranks)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []*tailLogSource
+	for idx, ei := range svc.harness.Instances() {
+		rank, err := ei.GetRank()
+		if err != nil {
+			rank = ranklist.NilRank
+		}
+		if ranks.Count() > 0 && !ranks.Contains(rank) {
+			continue
+		}
+
+		if idx >= len(svc.srvCfg.Engines) || svc.srvCfg.Engines[idx].LogFile == "" {
+			return nil, errors.Errorf("engine %d log file not configured", idx)
+		}
+		sources = append(sources, &tailLogSource{
+			source: logSourceEngine,
+			rank:   rank,
+			path:   svc.srvCfg.Engines[idx].LogFile,
+		})
+	}
+
+	if len(sources) == 0 {
+		if ranks.Count() > 0 {
+			return nil, errors.Errorf("no engines with ranks %s on this host", ranks)
+		}
+		return nil, errors.New("no engines on this host")
+	}
+
+	return sources, nil
+}
+
+// sendLogLines sends the lines in batches of up to tailLogBatchSize lines.
+func sendLogLines(send func(*ctlpb.TailLogResp) error, lines []*ctlpb.LogLine) error {
+	for len(lines) > 0 {
+		n := len(lines)
+		if n > tailLogBatchSize {
+			n = tailLogBatchSize
+		}
+		if err := send(&ctlpb.TailLogResp{Lines: lines[:n]}); err != nil {
+			return err
+		}
+		lines = lines[n:]
+	}
+	return nil
+}
+
+// TailLog sends the lines at the end of the control plane log or of the logs of
+// the selected engines on the host. If requested, the lines appended to the logs
+// are then sent as they are read, until the request is canceled.
+func (svc *ControlService) TailLog(req *ctlpb.TailLogReq, stream ctlpb.CtlSvc_TailLogServer) error {
+	return svc.tailLog(stream.Context(), req, stream.Send)
+}
+
+func (svc *ControlService) tailLog(ctx context.Context, req *ctlpb.TailLogReq, send func(*ctlpb.TailLogResp) error) error {
+	if req == nil {
+		return errNilReq
+	}
+	if req.Lines > maxTailLogLines {
+		return errors.Errorf("number of lines may not exceed %d", maxTailLogLines)
+	}
+	numLines := int(req.Lines)
+	if numLines == 0 {
+		numLines = defaultTailLogLines
+	}
+
+	if req.Follow {
+		if svc.tailLogFollowers.Add(1) > maxTailLogFollowers {
+			svc.tailLogFollowers.Add(-1)
+			return errors.Errorf("too many requests following logs (max %d)", maxTailLogFollowers)
+		}
+		defer svc.tailLogFollowers.Add(-1)
+	}
+
+	sources, err := svc.tailLogSources(req)
+	if err != nil {
+		return err
+	}
+
+	var lines []*ctlpb.LogLine
+	for _, src := range sources {
+		tail, tailLines, err := openLogTail(src.path, numLines)
+		if err != nil {
+			return errors.Wrapf(err, "%s log %q", src.source, src.path)
+		}
+		defer tail.Close()
+		src.tail = tail
+		lines = append(lines, src.logLines(tailLines)...)
+	}
+	if err := sendLogLines(send, lines); err != nil {
+		return err
+	}
+
+	if !req.Follow {
+		return nil
+	}
+	svc.log.Debugf("following %d log(s)", len(sources))
+
+	ticker := time.NewTicker(tailLogPollInterval)
+	defer ticker.Stop()
+	start := time.Now()
+	for _, src := range sources {
+		src.limiter = newLineLimiter(tailLogRate/float64(len(sources)), start)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			var lines []*ctlpb.LogLine
+			for _, src := range sources {
+				newLines, err := src.readLines(now)
+				if err != nil {
+					return err
+				}
+				lines = append(lines, newLines...)
+			}
+			if err := sendLogLines(send, lines); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,311 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+func mockTailLogSvc(t *testing.T, log logging.Logger, dir string, ranks ...ranklist.Rank) *ControlService {
+	t.Helper()
+
+	h := NewEngineHarness(log)
+	var engineCfgs []*engine.Config
+	for i, rank := range ranks {
+		logFile := filepath.Join(dir, fmt.Sprintf("engine%d.log", i))
+		engineCfgs = append(engineCfgs, engine.MockConfig().WithLogFile(logFile))
+		mic := &MockInstanceConfig{Index: uint32(i), GetRankResp: rank}
+		if rank == ranklist.NilRank {
+			mic.GetRankErr = errors.New("no rank")
+		}
+		if err := h.AddInstance(NewMockInstance(mic)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &ControlService{
+		StorageControlService: StorageControlService{log: log},
+		harness:               h,
+		srvCfg: config.DefaultServer().
+			WithEngines(engineCfgs...).
+			WithControlLogFile(filepath.Join(dir, "control.log")),
+	}
+}
+
+func writeMockLog(t *testing.T, path string, lines ...string) {
+	t.Helper()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(mockLogContent(lines)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mockEngineLines(rank ranklist.Rank, lines ...string) []*ctlpb.LogLine {
+	var out []*ctlpb.LogLine
+	for _, l := range lines {
+		out = append(out, &ctlpb.LogLine{Source: logSourceEngine, Rank: rank.Uint32(), Text: l})
+	}
+	return out
+}
+
+func TestServer_CtlSvc_TailLog(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req          *ctlpb.TailLogReq
+		ranks        []ranklist.Rank
+		noControlLog bool
+		missingLog   bool
+		expLines     []*ctlpb.LogLine
+		expErr       error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"too many lines": {
+			req:    &ctlpb.TailLogReq{Lines: maxTailLogLines + 1},
+			expErr: errors.New("may not exceed"),
+		},
+		"no engines": {
+			req:    &ctlpb.TailLogReq{},
+			expErr: errors.New("no engines on this host"),
+		},
+		"default number of lines": {
+			req:      &ctlpb.TailLogReq{},
+			ranks:    []ranklist.Rank{1},
+			expLines: mockEngineLines(1, mockLogLines(15, 25)...),
+		},
+		"all engines": {
+			req:   &ctlpb.TailLogReq{Lines: 2},
+			ranks: []ranklist.Rank{1, ranklist.NilRank},
+			expLines: append(mockEngineLines(1, mockLogLines(23, 25)...),
+				mockEngineLines(ranklist.NilRank, mockLogLines(23, 25)...)...),
+		},
+		"selected ranks": {
+			req:      &ctlpb.TailLogReq{Ranks: "2", Lines: 1},
+			ranks:    []ranklist.Rank{1, 2},
+			expLines: mockEngineLines(2, "line 24"),
+		},
+		"ranks not on host": {
+			req:    &ctlpb.TailLogReq{Ranks: "3-4"},
+			ranks:  []ranklist.Rank{1, 2},
+			expErr: errors.New("no engines with ranks 3-4 on this host"),
+		},
+		"engine log missing": {
+			req:        &ctlpb.TailLogReq{},
+			ranks:      []ranklist.Rank{1},
+			missingLog: true,
+			expErr:     errors.New("engine log"),
+		},
+		"control log": {
+			req:   &ctlpb.TailLogReq{Control: true, Lines: 1},
+			ranks: []ranklist.Rank{1},
+			expLines: []*ctlpb.LogLine{
+				{Source: logSourceControl, Rank: uint32(ranklist.NilRank), Text: "line 24"},
+			},
+		},
+		"control log not configured": {
+			req:          &ctlpb.TailLogReq{Control: true},
+			noControlLog: true,
+			expErr:       errors.New("control plane log file not configured"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dir := t.TempDir()
+			svc := mockTailLogSvc(t, log, dir, tc.ranks...)
+			if tc.noControlLog {
+				svc.srvCfg.ControlLogFile = ""
+			}
+			if !tc.missingLog {
+				if !tc.noControlLog {
+					writeMockLog(t, svc.srvCfg.ControlLogFile, mockLogLines(0, 25)...)
+				}
+				for _, ec := range svc.srvCfg.Engines {
+					writeMockLog(t, ec.LogFile, mockLogLines(0, 25)...)
+				}
+			}
+
+			var gotLines []*ctlpb.LogLine
+			gotErr := svc.tailLog(test.Context(t), tc.req, func(resp *ctlpb.TailLogResp) error {
+				gotLines = append(gotLines, resp.Lines...)
+				return nil
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expLines, gotLines, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected lines (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_TailLog_Follow(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := mockTailLogSvc(t, log, t.TempDir(), 1)
+	logFile := svc.srvCfg.Engines[0].LogFile
+	writeMockLog(t, logFile, mockLogLines(0, 5)...)
+
+	var mu sync.Mutex
+	var gotLines []*ctlpb.LogLine
+	numLines := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(gotLines)
+	}
+	waitLines := func(t *testing.T, n int) {
+		t.Helper()
+		for deadline := time.Now().Add(10 * time.Second); numLines() < n; {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for %d lines", n)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(test.Context(t))
+	done := make(chan error)
+	go func() {
+		done <- svc.tailLog(ctx, &ctlpb.TailLogReq{Lines: 2, Follow: true}, func(resp *ctlpb.TailLogResp) error {
+			mu.Lock()
+			defer mu.Unlock()
+			gotLines = append(gotLines, resp.Lines...)
+			return nil
+		})
+	}()
+
+	waitLines(t, 2)
+	writeMockLog(t, logFile, mockLogLines(5, 8)...)
+	waitLines(t, 5)
+
+	// The request is counted while it follows the logs.
+	test.AssertEqual(t, int32(1), svc.tailLogFollowers.Load(), "unexpected number of followers")
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, int32(0), svc.tailLogFollowers.Load(), "unexpected number of followers")
+
+	expLines := mockEngineLines(1, mockLogLines(3, 8)...)
+	if diff := cmp.Diff(expLines, gotLines, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected lines (-want, +got):\n%s", diff)
+	}
+}
+
+func TestServer_CtlSvc_TailLog_TooManyFollowers(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := mockTailLogSvc(t, log, t.TempDir(), 1)
+	svc.tailLogFollowers.Store(maxTailLogFollowers)
+
+	err := svc.tailLog(test.Context(t), &ctlpb.TailLogReq{Follow: true}, func(*ctlpb.TailLogResp) error {
+		return nil
+	})
+	test.CmpErr(t, errors.New("too many requests following logs"), err)
+	test.AssertEqual(t, int32(maxTailLogFollowers), svc.tailLogFollowers.Load(), "unexpected number of followers")
+}
+
+func TestServer_lineLimiter(t *testing.T) {
+	start := time.Now()
+	ll := newLineLimiter(100, start)
+
+	// The bucket starts full.
+	test.AssertEqual(t, 60, ll.limit(60, start), "")
+	test.AssertEqual(t, 40, ll.limit(60, start), "")
+	test.AssertEqual(t, 0, ll.limit(1, start), "")
+
+	// The bucket is refilled at the rate.
+	test.AssertEqual(t, 50, ll.limit(60, start.Add(500*time.Millisecond)), "")
+
+	// The bucket holds up to one second's worth of lines.
+	test.AssertEqual(t, 100, ll.limit(1000, start.Add(time.Hour)), "")
+}
+
+func TestServer_tailLogSource_logLines(t *testing.T) {
+	src := &tailLogSource{source: logSourceEngine, rank: 2, dropped: 5}
+
+	expLines := mockEngineLines(2, "a", "b")
+	expLines[0].Dropped = 5
+	if diff := cmp.Diff(expLines, src.logLines([]string{"a", "b"}), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected lines (-want, +got):\n%s", diff)
+	}
+	test.AssertEqual(t, uint64(0), src.dropped, "dropped lines not reset")
+}
+
+func TestServer_tailLogSource_readLines_MultiSource(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Now()
+
+	// Each source is limited to its share of the rate.
+	rate := 100.0
+	var sources []*tailLogSource
+	for rank := ranklist.Rank(0); rank < 2; rank++ {
+		path := filepath.Join(dir, fmt.Sprintf("engine%d.log", rank))
+		writeMockLog(t, path)
+		tail, _, err := openLogTail(path, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tail.Close()
+		sources = append(sources, &tailLogSource{
+			source:  logSourceEngine,
+			rank:    rank,
+			path:    path,
+			tail:    tail,
+			limiter: newLineLimiter(rate/2, start),
+		})
+	}
+
+	// A busy log doesn't use the budget of a quiet one.
+	writeMockLog(t, sources[0].path, mockLogLines(0, 200)...)
+	writeMockLog(t, sources[1].path, mockLogLines(0, 10)...)
+
+	var gotLines []*ctlpb.LogLine
+	for _, src := range sources {
+		lines, err := src.readLines(start)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotLines = append(gotLines, lines...)
+	}
+
+	expLines := mockEngineLines(0, mockLogLines(150, 200)...)
+	expLines[0].Dropped = 150
+	expLines = append(expLines, mockEngineLines(1, mockLogLines(0, 10)...)...)
+	if diff := cmp.Diff(expLines, gotLines, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected lines (-want, +got):\n%s", diff)
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -14,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/drpc"
@@ -403,3 +405,62 @@ func (svc *ControlService) SetEngineLogMasks(ctx context.Context, req *ctlpb.Set
 
 	return resp, nil
 }
+
+// getEngineInfo returns details of an engine instance, taken from its runtime state and
+// configuration.
+func getEngineInfo(ei Engine, cfg *engine.Config) *ctlpb.EngineInfo {
+	info := &ctlpb.EngineInfo{
+		Index:       ei.Index(),
+		Rank:        uint32(ranklist.NilRank),
+		State:       ei.LocalState().String(),
+		TargetCount: uint32(ei.GetTargetCount()),
+		Version:     build.DaosVersion,
+	}
+
+	if rank, err := ei.GetRank(); err == nil {
+		info.Rank = rank.Uint32()
+	}
+
+	if startedAt := ei.StartedAt(); ei.IsStarted() && !startedAt.IsZero() {
+		info.Uptime = uint64(time.Since(startedAt).Seconds())
+	}
+
+	if cfg == nil {
+		return info
+	}
+
+	info.NumaNode = uint32(cfg.Fabric.NumaNodeIndex)
+	if cfg.PinnedNumaNode != nil {
+		info.NumaNode = uint32(*cfg.PinnedNumaNode)
+	}
+	info.FabricIface = cfg.Fabric.Interface
+	info.Provider = cfg.Fabric.Provider
+
+	if scmCfgs := cfg.Storage.Tiers.ScmConfigs(); len(scmCfgs) > 0 {
+		info.ScmClass = scmCfgs[0].Class.String()
+		info.ScmMount = scmCfgs[0].Scm.MountPoint
+	}
+	if bdevs := cfg.Storage.Tiers.Bdevs(); bdevs != nil {
+		info.BdevCount = uint32(bdevs.Len())
+	}
+
+	return info
+}
+
+// ListEngines returns details of each of the engine instances managed by this server.
+func (svc *ControlService) ListEngines(ctx context.Context, req *ctlpb.ListEnginesReq) (*ctlpb.ListEnginesResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	resp := new(ctlpb.ListEnginesResp)
+	for idx, ei := range svc.harness.Instances() {
+		var cfg *engine.Config
+		if svc.srvCfg != nil && idx < len(svc.srvCfg.Engines) {
+			cfg = svc.srvCfg.Engines[idx]
+		}
+		resp.Engines = append(resp.Engines, getEngineInfo(ei, cfg))
+	}
+
+	return resp, nil
+}
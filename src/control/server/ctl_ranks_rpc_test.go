@@ -8,6 +8,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"sync"
 	"syscall"
@@ -19,6 +20,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 
+	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
@@ -26,6 +28,7 @@ import (
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/atm"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -930,3 +933,116 @@ func TestServer_CtlSvc_SetEngineLogMasks(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_CtlSvc_ListEngines(t *testing.T) {
+	engineCfg := func(idx uint32) *engine.Config {
+		return engine.MockConfig().
+			WithStorageIndex(idx).
+			WithFabricInterface(fmt.Sprintf("ib%d", idx)).
+			WithFabricProvider("ofi+tcp").
+			WithPinnedNumaNode(uint(idx)).
+			WithTargetCount(8).
+			WithStorage(
+				storage.NewTierConfig().
+					WithStorageClass("ram").
+					WithScmMountPoint(fmt.Sprintf("/mnt/daos%d", idx)),
+				storage.NewTierConfig().
+					WithStorageClass("nvme").
+					WithBdevDeviceList(test.MockPCIAddr(1), test.MockPCIAddr(2)),
+			)
+	}
+
+	for name, tc := range map[string]struct {
+		req     *ctlpb.ListEnginesReq
+		mics    []*MockInstanceConfig
+		expResp *ctlpb.ListEnginesResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no engines": {
+			req:     &ctlpb.ListEnginesReq{},
+			expResp: &ctlpb.ListEnginesResp{},
+		},
+		"started and stopped engines": {
+			req: &ctlpb.ListEnginesReq{},
+			mics: []*MockInstanceConfig{
+				{
+					Index:       0,
+					GetRankResp: ranklist.Rank(3),
+					TargetCount: 8,
+					Started:     atm.NewBool(true),
+					StartedAt:   time.Now().Add(-time.Hour),
+					LocalState:  system.MemberStateJoined,
+				},
+				{
+					Index:       1,
+					GetRankErr:  errors.New("no rank"),
+					TargetCount: 8,
+					LocalState:  system.MemberStateStopped,
+				},
+			},
+			expResp: &ctlpb.ListEnginesResp{
+				Engines: []*ctlpb.EngineInfo{
+					{
+						Index:       0,
+						Rank:        3,
+						State:       "Joined",
+						NumaNode:    0,
+						FabricIface: "ib0",
+						Provider:    "ofi+tcp",
+						TargetCount: 8,
+						ScmClass:    "ram",
+						ScmMount:    "/mnt/daos0",
+						BdevCount:   2,
+						Uptime:      3600,
+						Version:     build.DaosVersion,
+					},
+					{
+						Index:       1,
+						Rank:        uint32(ranklist.NilRank),
+						State:       "Stopped",
+						NumaNode:    1,
+						FabricIface: "ib1",
+						Provider:    "ofi+tcp",
+						TargetCount: 8,
+						ScmClass:    "ram",
+						ScmMount:    "/mnt/daos1",
+						BdevCount:   2,
+						Version:     build.DaosVersion,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var engineCfgs []*engine.Config
+			h := NewEngineHarness(log)
+			for i, mic := range tc.mics {
+				engineCfgs = append(engineCfgs, engineCfg(uint32(i)))
+				if err := h.AddInstance(NewMockInstance(mic)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			svc := &ControlService{
+				StorageControlService: StorageControlService{log: log},
+				harness:               h,
+				srvCfg:                config.DefaultServer().WithEngines(engineCfgs...),
+			}
+
+			gotResp, gotErr := svc.ListEngines(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
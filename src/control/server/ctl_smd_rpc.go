@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,11 +8,16 @@
 package server
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
+	"os/exec"
 	"sort"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	uuid "github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -20,11 +26,14 @@ import (
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
 )
 
 // Set as variables so can be overwritten during unit testing.
@@ -32,8 +41,37 @@ var (
 	baseDevReplaceBackoff      = 250 * time.Millisecond
 	maxDevReplaceBackoffFactor = 7 // 8s
 	maxDevReplaceRetries       = 20
+	readKernelLog              = readDmesg
+	kernelLogTimeout           = 5 * time.Second
+	maxKernelLogLines          = 20
+	maxKernelLogLineLen        = 256
+	devFaultEvidenceTTL        = time.Minute
 )
 
+// defaultLedIdentifyMins is the number of minutes an LED identify operation
+// lasts if neither the request nor the server config specify a timeout.
+const defaultLedIdentifyMins = 2
+
+// ledIdentifyMins returns the number of minutes an LED identify operation may
+// last. A timeout is always applied so that a blinking LED is eventually
+// turned off, and any maximum set in the server config is enforced.
+func ledIdentifyMins(log logging.Logger, cfg *config.Server, reqMins uint32) uint32 {
+	mins := reqMins
+	if mins == 0 {
+		mins = cfg.LedIdentifyTimeout
+	}
+	if mins == 0 {
+		mins = defaultLedIdentifyMins
+	}
+	if cfg.LedIdentifyMaxTime != 0 && mins > cfg.LedIdentifyMaxTime {
+		log.Noticef("LED identify timeout of %d minutes reduced to configured maximum of %d",
+			mins, cfg.LedIdentifyMaxTime)
+		mins = cfg.LedIdentifyMaxTime
+	}
+
+	return mins
+}
+
 func queryRank(reqRank uint32, engineRank ranklist.Rank) bool {
 	rr := ranklist.Rank(reqRank)
 	if rr.Equals(ranklist.NilRank) {
@@ -192,8 +230,9 @@ func extractReqIDs(log logging.Logger, ids string, addrs idMap, uuids idMap) err
 
 // Union type containing either traddr or uuid.
 type devID struct {
-	trAddr string
-	uuid   string
+	trAddr    string
+	uuid      string
+	ctrlrAddr string // PCI address of the controller of a device identified by UUID
 }
 
 func (id *devID) String() string {
@@ -295,7 +334,7 @@ func (svc *ControlService) mapIDsToEngine(ctx context.Context, ids string, useTr
 
 			if matchUUID {
 				// Only add UUID entry if TrAddr is not available for a device.
-				edm.add(engine, devID{uuid: dev.Uuid})
+				edm.add(engine, devID{uuid: dev.Uuid, ctrlrAddr: dev.Ctrlr.PciAddr})
 				delete(devUUIDs, dev.Uuid)
 			}
 		}
@@ -376,6 +415,136 @@ func replaceDevRetryBusy(ctx context.Context, log logging.Logger, e Engine, req
 	return
 }
 
+func readDmesg(ctx context.Context) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, kernelLogTimeout)
+	defer cancel()
+
+	return exec.CommandContext(ctx, "dmesg").Output()
+}
+
+// truncateLine shortens a line to at most maxLen bytes without splitting a
+// multi-byte character.
+func truncateLine(line string, maxLen int) string {
+	if len(line) <= maxLen {
+		return line
+	}
+	for maxLen > 0 && !utf8.RuneStart(line[maxLen]) {
+		maxLen--
+	}
+	return line[:maxLen]
+}
+
+// filterKernelLog returns the most recent kernel log lines that mention the given PCI
+// address. Both the number of lines returned and the length of each line are bounded.
+func filterKernelLog(kmsg []byte, pciAddr string) []string {
+	if pciAddr == "" {
+		return nil
+	}
+	pciAddr = strings.ToLower(pciAddr)
+
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(kmsg))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.Contains(strings.ToLower(line), pciAddr) {
+			continue
+		}
+		lines = append(lines, truncateLine(line, maxKernelLogLineLen))
+		if len(lines) > maxKernelLogLines {
+			lines = lines[1:]
+		}
+	}
+
+	return lines
+}
+
+type devFaultEvidence struct {
+	pciAddr   string
+	kernelLog []string
+	expires   time.Time
+}
+
+// devFaultTracker holds the kernel log evidence gathered for devices that are being set
+// faulty through the control plane. The engine raises the RAS event for the state change,
+// and the evidence is attached to that event when it is received from the engine.
+type devFaultTracker struct {
+	sync.Mutex
+	pending map[string]*devFaultEvidence
+}
+
+func newDevFaultTracker() *devFaultTracker {
+	return &devFaultTracker{
+		pending: make(map[string]*devFaultEvidence),
+	}
+}
+
+// add records the evidence for a device, replacing any previously recorded for it.
+func (dft *devFaultTracker) add(devUUID, pciAddr string, kernelLog []string) {
+	dft.Lock()
+	defer dft.Unlock()
+
+	now := time.Now()
+	for id, ev := range dft.pending {
+		if now.After(ev.expires) {
+			delete(dft.pending, id)
+		}
+	}
+
+	dft.pending[devUUID] = &devFaultEvidence{
+		pciAddr:   pciAddr,
+		kernelLog: kernelLog,
+		expires:   now.Add(devFaultEvidenceTTL),
+	}
+}
+
+// annotate attaches the recorded evidence to a device set-faulty event raised by the
+// engine for a device that was set faulty through the control plane. The evidence is
+// consumed by the first matching event. Any other events are left unchanged.
+func (dft *devFaultTracker) annotate(evt *sharedpb.RASEvent) {
+	if dft == nil || evt == nil || events.RASID(evt.Id) != events.RASDeviceSetFaulty {
+		return
+	}
+
+	dft.Lock()
+	defer dft.Unlock()
+
+	for devUUID, ev := range dft.pending {
+		// The engine identifies the device by UUID in the event message.
+		if !strings.Contains(evt.Msg, devUUID) {
+			continue
+		}
+		delete(dft.pending, devUUID)
+		if time.Now().After(ev.expires) {
+			return
+		}
+
+		if evt.HwId == "" {
+			evt.HwId = ev.pciAddr
+		}
+		if evt.ExtendedInfo == nil && len(ev.kernelLog) > 0 {
+			evt.ExtendedInfo = &sharedpb.RASEvent_StrInfo{
+				StrInfo: strings.Join(ev.kernelLog, "\n"),
+			}
+		}
+		return
+	}
+}
+
+// recordDevFaultEvidence gathers the recent kernel log lines that mention the PCI address
+// of a device which is about to be set faulty, so that the kernel-side evidence of the
+// fault is captured in the RAS event raised by the engine.
+func (svc *ControlService) recordDevFaultEvidence(ctx context.Context, dev *devID) {
+	if svc.devFaults == nil || dev == nil || dev.uuid == "" || dev.ctrlrAddr == "" {
+		return
+	}
+
+	kmsg, err := readKernelLog(ctx)
+	if err != nil {
+		svc.log.Errorf("unable to read kernel log for device %s: %s", dev.ctrlrAddr, err)
+	}
+	svc.devFaults.add(dev.uuid, dev.ctrlrAddr, filterKernelLog(kmsg, dev.ctrlrAddr))
+}
+
 func (svc *ControlService) singleDevSmdManage(ctx context.Context, req *ctlpb.SmdManageReq, id string) ([]*ctlpb.SmdManageResp_RankResp, error) {
 	// Evaluate which engine(s) to send requests to.
 	engineDevMap, err := svc.mapIDsToEngine(ctx, id, false)
@@ -414,6 +583,9 @@ func (svc *ControlService) singleDevSmdManage(ctx context.Context, req *ctlpb.Sm
 	case *ctlpb.SmdManageReq_Faulty:
 		dReq := req.GetFaulty()
 		msg := fmt.Sprintf("%s set-faulty", msg)
+		// Record the evidence before the request is sent, as the engine may raise the
+		// event for the state change before the response is received.
+		svc.recordDevFaultEvidence(ctx, devs.getFirst())
 		devRes, err = sendManageReq(ctx, engine, drpc.MethodSetFaultyState, dReq)
 		svc.log.Tracef("%s: req %+v, resp %+v", msg, dReq, devRes)
 	default:
@@ -453,6 +625,11 @@ func (svc *ControlService) multiDevSmdManage(ctx context.Context, req *ctlpb.Smd
 			req.Op)
 	}
 
+	if led := req.GetLed(); led.LedAction == ctlpb.LedAction_SET &&
+		led.LedState == ctlpb.LedState_QUICK_BLINK {
+		led.LedDurationMins = ledIdentifyMins(svc.log, svc.srvCfg, led.LedDurationMins)
+	}
+
 	var rankResps []*ctlpb.SmdManageResp_RankResp
 	for engine, devs := range engineDevMap {
 		devResults := []*ctlpb.SmdManageResp_Result{}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,9 @@
 package server
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,8 +18,10 @@ import (
 	"github.com/pkg/errors"
 
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hardware/pciutils"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -1598,10 +1604,13 @@ func TestServer_CtlSvc_SmdManage(t *testing.T) {
 			origInterval := baseDevReplaceBackoff
 			origRetries := maxDevReplaceRetries
 			origFactor := maxDevReplaceBackoffFactor
+			origReadKernelLog := readKernelLog
 			baseDevReplaceBackoff = 50 * time.Millisecond
 			maxDevReplaceRetries = 5
 			maxDevReplaceBackoffFactor = 1
+			readKernelLog = func(context.Context) ([]byte, error) { return nil, nil }
 			defer func() {
+				readKernelLog = origReadKernelLog
 				maxDevReplaceBackoffFactor = origFactor
 				maxDevReplaceRetries = origRetries
 				baseDevReplaceBackoff = origInterval
@@ -1657,3 +1666,189 @@ func TestServer_CtlSvc_SmdManage(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_ledIdentifyMins(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg     *config.Server
+		reqMins uint32
+		expMins uint32
+	}{
+		"no timeout requested or configured": {
+			cfg:     config.DefaultServer(),
+			expMins: defaultLedIdentifyMins,
+		},
+		"configured default": {
+			cfg:     config.DefaultServer().WithLedIdentifyTimeout(5, 0),
+			expMins: 5,
+		},
+		"requested timeout": {
+			cfg:     config.DefaultServer().WithLedIdentifyTimeout(5, 0),
+			reqMins: 120,
+			expMins: 120,
+		},
+		"requested timeout within max": {
+			cfg:     config.DefaultServer().WithLedIdentifyTimeout(5, 30),
+			reqMins: 20,
+			expMins: 20,
+		},
+		"requested timeout exceeds max": {
+			cfg:     config.DefaultServer().WithLedIdentifyTimeout(5, 30),
+			reqMins: 120,
+			expMins: 30,
+		},
+		"builtin default exceeds max": {
+			cfg:     config.DefaultServer().WithLedIdentifyTimeout(0, 1),
+			expMins: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			test.AssertEqual(t, tc.expMins, ledIdentifyMins(log, tc.cfg, tc.reqMins),
+				"unexpected identify timeout")
+		})
+	}
+}
+
+func TestServer_filterKernelLog(t *testing.T) {
+	kmsg := strings.Join([]string{
+		"[    1.000000] pci 0000:81:00.0: [8086:0a54] type 00 class 0x010802",
+		"[    2.000000] pci 0000:82:00.0: [8086:0a54] type 00 class 0x010802",
+		"[    3.000000] nvme nvme0: pci function 0000:81:00.0",
+		"[    4.000000] NVME 0000:81:00.0: CONTROLLER IS DOWN; WILL RESET",
+		"[    5.000000] nvme 0000:81:00.0: " + strings.Repeat("x", 300),
+		"[    6.000000] nvme 0000:81:00.0: x" + strings.Repeat("\u00e9", 150),
+	}, "\n")
+
+	for name, tc := range map[string]struct {
+		pciAddr  string
+		maxLines int
+		expLines []string
+	}{
+		"no address": {
+			maxLines: 10,
+		},
+		"no matches": {
+			pciAddr:  "0000:83:00.0",
+			maxLines: 10,
+		},
+		"matches": {
+			pciAddr:  "0000:81:00.0",
+			maxLines: 10,
+			expLines: []string{
+				"[    1.000000] pci 0000:81:00.0: [8086:0a54] type 00 class 0x010802",
+				"[    3.000000] nvme nvme0: pci function 0000:81:00.0",
+				"[    4.000000] NVME 0000:81:00.0: CONTROLLER IS DOWN; WILL RESET",
+				("[    5.000000] nvme 0000:81:00.0: " + strings.Repeat("x", 300))[:maxKernelLogLineLen],
+				// Truncated on a character boundary.
+				"[    6.000000] nvme 0000:81:00.0: x" + strings.Repeat("\u00e9", 110),
+			},
+		},
+		"most recent matches": {
+			pciAddr:  "0000:81:00.0",
+			maxLines: 2,
+			expLines: []string{
+				("[    5.000000] nvme 0000:81:00.0: " + strings.Repeat("x", 300))[:maxKernelLogLineLen],
+				"[    6.000000] nvme 0000:81:00.0: x" + strings.Repeat("\u00e9", 110),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			origMaxLines := maxKernelLogLines
+			maxKernelLogLines = tc.maxLines
+			defer func() {
+				maxKernelLogLines = origMaxLines
+			}()
+
+			gotLines := filterKernelLog([]byte(kmsg), tc.pciAddr)
+			if diff := cmp.Diff(tc.expLines, gotLines); diff != "" {
+				t.Fatalf("unexpected kernel log lines (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_recordDevFaultEvidence(t *testing.T) {
+	kmsg := "[    1.000000] nvme 0000:81:00.0: controller is down\n"
+
+	for name, tc := range map[string]struct {
+		dev        *devID
+		evt        *sharedpb.RASEvent
+		readErr    error
+		expHwID    string
+		expStrInfo string
+	}{
+		"evidence attached": {
+			dev: &devID{uuid: test.MockUUID(1), ctrlrAddr: "0000:81:00.0"},
+			evt: &sharedpb.RASEvent{
+				Id:  uint32(events.RASDeviceSetFaulty),
+				Msg: fmt.Sprintf("Device: %s set faulty", test.MockUUID(1)),
+			},
+			expHwID:    "0000:81:00.0",
+			expStrInfo: "[    1.000000] nvme 0000:81:00.0: controller is down",
+		},
+		"kernel log read fails": {
+			dev: &devID{uuid: test.MockUUID(1), ctrlrAddr: "0000:81:00.0"},
+			evt: &sharedpb.RASEvent{
+				Id:  uint32(events.RASDeviceSetFaulty),
+				Msg: fmt.Sprintf("Device: %s set faulty", test.MockUUID(1)),
+			},
+			readErr: errors.New("permission denied"),
+			expHwID: "0000:81:00.0",
+		},
+		"no controller address": {
+			dev: &devID{uuid: test.MockUUID(1)},
+			evt: &sharedpb.RASEvent{
+				Id:  uint32(events.RASDeviceSetFaulty),
+				Msg: fmt.Sprintf("Device: %s set faulty", test.MockUUID(1)),
+			},
+		},
+		"different device": {
+			dev: &devID{uuid: test.MockUUID(1), ctrlrAddr: "0000:81:00.0"},
+			evt: &sharedpb.RASEvent{
+				Id:  uint32(events.RASDeviceSetFaulty),
+				Msg: fmt.Sprintf("Device: %s set faulty", test.MockUUID(2)),
+			},
+		},
+		"different event": {
+			dev: &devID{uuid: test.MockUUID(1), ctrlrAddr: "0000:81:00.0"},
+			evt: &sharedpb.RASEvent{
+				Id:  uint32(events.RASNVMeLinkSpeedChanged),
+				Msg: fmt.Sprintf("Device: %s set faulty", test.MockUUID(1)),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			origReadKernelLog := readKernelLog
+			readKernelLog = func(context.Context) ([]byte, error) {
+				if tc.readErr != nil {
+					return nil, tc.readErr
+				}
+				return []byte(kmsg), nil
+			}
+			defer func() {
+				readKernelLog = origReadKernelLog
+			}()
+
+			svc := mockControlService(t, log, config.DefaultServer(), nil, nil, nil)
+			svc.recordDevFaultEvidence(test.Context(t), tc.dev)
+			svc.devFaults.annotate(tc.evt)
+
+			test.AssertEqual(t, tc.expHwID, tc.evt.HwId, "unexpected hardware ID")
+			test.AssertEqual(t, tc.expStrInfo, tc.evt.GetStrInfo(), "unexpected extended info")
+
+			if tc.expHwID == "" {
+				return
+			}
+
+			// Evidence is only attached to the first matching event.
+			evt := &sharedpb.RASEvent{Id: tc.evt.Id, Msg: tc.evt.Msg}
+			svc.devFaults.annotate(evt)
+			test.AssertEqual(t, "", evt.HwId, "unexpected hardware ID on repeat event")
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/daos-stack/daos/src/control/common/proto"
 	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -770,6 +771,32 @@ func (cs *ControlService) StorageScan(ctx context.Context, req *ctlpb.StorageSca
 	return resp, nil
 }
 
+// storageScanStreamMaxCtrlrs is the maximum number of NVMe controllers sent in each
+// partial response of a StorageScanStream request.
+const storageScanStreamMaxCtrlrs = 32
+
+// StorageScanStream discovers non-volatile storage hardware on node and sends the
+// results as a stream of partial responses, for hosts whose scan results do not fit
+// in a single message.
+func (cs *ControlService) StorageScanStream(req *ctlpb.StorageScanReq, stream ctlpb.CtlSvc_StorageScanStreamServer) error {
+	resp, err := cs.StorageScan(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	parts, err := resp.SplitNvmeCtrlrs(storageScanStreamMaxCtrlrs)
+	if err != nil {
+		return err
+	}
+	for _, part := range parts {
+		if err := stream.Send(part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (cs *ControlService) formatMetadata(instances []Engine, reformat bool) (bool, error) {
 	// Format control metadata first, if needed
 	if needs, err := cs.storage.ControlMetadataNeedsFormat(); err != nil {
@@ -873,6 +900,7 @@ func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatR
 	errored := make(map[int]string)
 	skipped := make(map[int]bool)
 	formatting := 0
+	formatted := 0
 
 	for idx, ei := range req.instances {
 		if needFormat[idx] || req.reformat {
@@ -903,12 +931,15 @@ func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatR
 		}
 	}
 
+	total := formatting
 	for formatting > 0 {
+		reportProgress(ctx, "formatting SCM", formatted, total)
 		select {
 		case <-ctx.Done():
 			return nil, nil, ctx.Err()
 		case scmResult := <-scmChan:
 			formatting--
+			formatted++
 			state := scmResult.GetState()
 			if state.GetStatus() != ctlpb.ResponseStatus_CTL_SUCCESS {
 				errored[int(scmResult.GetInstanceidx())] = state.GetError()
@@ -916,6 +947,9 @@ func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatR
 			resp.Mrets = append(resp.Mrets, scmResult)
 		}
 	}
+	if total > 0 {
+		reportProgress(ctx, "formatting SCM", formatted, total)
+	}
 
 	return errored, skipped, nil
 }
@@ -947,6 +981,8 @@ func getEngineBdevCtrlrs(ctx context.Context, engine Engine) (storage.NvmeContro
 func formatNvme(ctx context.Context, req formatNvmeReq, resp *ctlpb.StorageFormatResp) error {
 	// Allow format to complete on one instance even if another fails
 	for idx, engine := range req.instances {
+		reportProgress(ctx, "formatting NVMe", idx, len(req.instances))
+
 		_, hasError := req.errored[idx]
 		_, skipped := req.skipped[idx]
 
@@ -996,6 +1032,7 @@ func formatNvme(ctx context.Context, req formatNvmeReq, resp *ctlpb.StorageForma
 
 		resp.Crets = append(resp.Crets, cResults...)
 	}
+	reportProgress(ctx, "formatting NVMe", len(req.instances), len(req.instances))
 
 	return nil
 }
@@ -1026,6 +1063,8 @@ func (cs *ControlService) StorageFormat(ctx context.Context, req *ctlpb.StorageF
 		return resp, nil
 	}
 
+	reportProgress(ctx, "formatting control metadata", 0, 0)
+
 	// DAOS-15947: control_metadata format is valid in --replace case where multiple engines
 	// require replacement or format on the same host. No need to handle independently for
 	// individual engine as if control_metadata is missing then it needs to be created.
@@ -1086,6 +1125,25 @@ func (cs *ControlService) StorageFormat(ctx context.Context, req *ctlpb.StorageF
 	return resp, nil
 }
 
+// StorageFormatStream formats storage as StorageFormat does, sending updates of
+// the progress of the format in the stream before the final response.
+func (cs *ControlService) StorageFormatStream(req *ctlpb.StorageFormatReq, stream ctlpb.CtlSvc_StorageFormatStreamServer) error {
+	ctx := streamProgress(stream.Context(), cs.log, func(p *sharedpb.Progress) error {
+		return stream.Send(&ctlpb.StorageFormatStreamResp{
+			Update: &ctlpb.StorageFormatStreamResp_Progress{Progress: p},
+		})
+	})
+
+	resp, err := cs.StorageFormat(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&ctlpb.StorageFormatStreamResp{
+		Update: &ctlpb.StorageFormatStreamResp_Resp{Resp: resp},
+	})
+}
+
 // StorageNvmeRebind rebinds SSD from kernel and binds to user-space to allow DAOS to use it.
 func (cs *ControlService) StorageNvmeRebind(ctx context.Context, req *ctlpb.NvmeRebindReq) (*ctlpb.NvmeRebindResp, error) {
 	if req == nil {
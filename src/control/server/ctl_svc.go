@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,8 @@
 package server
 
 import (
+	"sync/atomic"
+
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
@@ -19,10 +22,14 @@ import (
 type ControlService struct {
 	ctlpb.UnimplementedCtlSvcServer
 	StorageControlService
-	harness *EngineHarness
-	srvCfg  *config.Server
-	events  *events.PubSub
-	fabric  *hardware.FabricScanner
+	harness      *EngineHarness
+	srvCfg       *config.Server
+	events       *events.PubSub
+	fabric       *hardware.FabricScanner
+	peerVersions *peerVersionTracker
+	devFaults    *devFaultTracker
+
+	tailLogFollowers atomic.Int32 // number of TailLog requests following logs
 }
 
 // NewControlService returns ControlService to be used as gRPC control service
@@ -42,5 +49,7 @@ func NewControlService(log logging.Logger, h *EngineHarness,
 		srvCfg:                cfg,
 		events:                e,
 		fabric:                f,
+		peerVersions:          newPeerVersionTracker(),
+		devFaults:             newDevFaultTracker(),
 	}
 }
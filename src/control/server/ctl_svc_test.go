@@ -42,6 +42,7 @@ func newMockControlServiceFromBackends(t *testing.T, log logging.Logger, cfg *co
 		harness:               &EngineHarness{log: log},
 		events:                events.NewPubSub(test.Context(t), log),
 		srvCfg:                cfg,
+		devFaults:             newDevFaultTracker(),
 	}
 
 	started := make([]bool, len(cfg.Engines))
@@ -0,0 +1,158 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+const (
+	// peerVersionExpiry is the time after which a peer that has not sent
+	// any requests is no longer reported.
+	peerVersionExpiry = 24 * time.Hour
+	// maxPeerVersions is the maximum number of peers to track.
+	maxPeerVersions = 8192
+)
+
+type (
+	peerVersion struct {
+		component build.Component
+		version   build.Version
+		lastSeen  time.Time
+	}
+
+	// peerVersionTracker records the component versions presented by the
+	// peers that send requests to the server.
+	peerVersionTracker struct {
+		sync.Mutex
+		peers map[string]*peerVersion
+		now   func() time.Time
+	}
+)
+
+func newPeerVersionTracker() *peerVersionTracker {
+	return &peerVersionTracker{
+		peers: make(map[string]*peerVersion),
+		now:   time.Now,
+	}
+}
+
+// prune removes expired peers and, if necessary, the least recently seen
+// peers in order to make room for a new one. Must be called with the lock held.
+func (pvt *peerVersionTracker) prune() {
+	cutoff := pvt.now().Add(-peerVersionExpiry)
+	for addr, pv := range pvt.peers {
+		if pv.lastSeen.Before(cutoff) {
+			delete(pvt.peers, addr)
+		}
+	}
+
+	for len(pvt.peers) >= maxPeerVersions {
+		var oldest string
+		for addr, pv := range pvt.peers {
+			if oldest == "" || pv.lastSeen.Before(pvt.peers[oldest].lastSeen) {
+				oldest = addr
+			}
+		}
+		delete(pvt.peers, oldest)
+	}
+}
+
+// record updates the version of the peer at the given address.
+func (pvt *peerVersionTracker) record(addr string, vc *build.VersionedComponent) {
+	if pvt == nil || vc == nil {
+		return
+	}
+
+	pvt.Lock()
+	defer pvt.Unlock()
+
+	pv, found := pvt.peers[addr]
+	if !found {
+		pvt.prune()
+		pv = new(peerVersion)
+		pvt.peers[addr] = pv
+	}
+	pv.component = vc.Component
+	pv.version = vc.Version
+	pv.lastSeen = pvt.now()
+}
+
+// list returns the versions of the recently seen peers of the given component
+// type, sorted by address.
+func (pvt *peerVersionTracker) list(comp build.Component) []*ctlpb.ComponentVersion {
+	if pvt == nil {
+		return nil
+	}
+
+	pvt.Lock()
+	defer pvt.Unlock()
+
+	cutoff := pvt.now().Add(-peerVersionExpiry)
+	var out []*ctlpb.ComponentVersion
+	for addr, pv := range pvt.peers {
+		if pv.component != comp || pv.lastSeen.Before(cutoff) {
+			continue
+		}
+		out = append(out, &ctlpb.ComponentVersion{
+			Component: pv.component.String(),
+			Version:   pv.version.String(),
+			Addr:      addr,
+			LastSeen:  pv.lastSeen.Unix(),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Addr < out[j].Addr })
+
+	return out
+}
+
+// unaryPeerVersionInterceptor records the component and version presented in
+// the headers of each agent request, regardless of whether the agent is
+// compatible with the server.
+func unaryPeerVersionInterceptor(pvt *peerVersionTracker) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if vc, err := build.FromContext(ctx); err == nil && vc.Component == build.ComponentAgent {
+			if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+				addr := p.Addr.String()
+				if host, _, err := net.SplitHostPort(addr); err == nil {
+					addr = host
+				}
+				pvt.record(addr, vc)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// GetComponentVersions returns the version of this server, and of the agents
+// that have recently sent requests to it.
+func (svc *ControlService) GetComponentVersions(ctx context.Context, req *ctlpb.GetComponentVersionsReq) (*ctlpb.GetComponentVersionsResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	return &ctlpb.GetComponentVersionsResp{
+		Server: &ctlpb.ComponentVersion{
+			Component: build.ComponentServer.String(),
+			Version:   build.DaosVersion,
+			BuildInfo: build.BuildInfo,
+		},
+		Agents: svc.peerVersions.list(build.ComponentAgent),
+	}, nil
+}
@@ -0,0 +1,197 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func testVersionedComponent(comp build.Component, version string) *build.VersionedComponent {
+	vc, err := build.NewVersionedComponent(comp, version)
+	if err != nil {
+		panic(err)
+	}
+	return vc
+}
+
+func TestServer_peerVersionTracker(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+
+	for name, tc := range map[string]struct {
+		records  func(pvt *peerVersionTracker, now *time.Time)
+		comp     build.Component
+		expPeers []*ctlpb.ComponentVersion
+	}{
+		"none": {
+			comp: build.ComponentAgent,
+		},
+		"latest version reported": {
+			records: func(pvt *peerVersionTracker, now *time.Time) {
+				pvt.record("10.0.0.2", testVersionedComponent(build.ComponentAgent, "2.4.0"))
+				pvt.record("10.0.0.1", testVersionedComponent(build.ComponentAgent, "2.4.0"))
+				*now = now.Add(time.Minute)
+				pvt.record("10.0.0.2", testVersionedComponent(build.ComponentAgent, "2.6.0"))
+				pvt.record("10.0.0.3", testVersionedComponent(build.ComponentAdmin, "2.6.0"))
+			},
+			comp: build.ComponentAgent,
+			expPeers: []*ctlpb.ComponentVersion{
+				{
+					Component: "agent",
+					Version:   "2.4.0",
+					Addr:      "10.0.0.1",
+					LastSeen:  start.Unix(),
+				},
+				{
+					Component: "agent",
+					Version:   "2.6.0",
+					Addr:      "10.0.0.2",
+					LastSeen:  start.Add(time.Minute).Unix(),
+				},
+			},
+		},
+		"expired peers omitted": {
+			records: func(pvt *peerVersionTracker, now *time.Time) {
+				pvt.record("10.0.0.1", testVersionedComponent(build.ComponentAgent, "2.4.0"))
+				*now = now.Add(peerVersionExpiry)
+				pvt.record("10.0.0.2", testVersionedComponent(build.ComponentAgent, "2.6.0"))
+				*now = now.Add(time.Second)
+			},
+			comp: build.ComponentAgent,
+			expPeers: []*ctlpb.ComponentVersion{
+				{
+					Component: "agent",
+					Version:   "2.6.0",
+					Addr:      "10.0.0.2",
+					LastSeen:  start.Add(peerVersionExpiry).Unix(),
+				},
+			},
+		},
+		"oldest peer evicted": {
+			records: func(pvt *peerVersionTracker, now *time.Time) {
+				for i := 0; i < maxPeerVersions; i++ {
+					pvt.record(fmt.Sprintf("peer-%d", i), testVersionedComponent(build.ComponentAgent, "2.4.0"))
+					*now = now.Add(time.Second)
+				}
+				pvt.record("newest", testVersionedComponent(build.ComponentAdmin, "2.6.0"))
+				if _, found := pvt.peers["peer-0"]; found {
+					t.Fatal("expected oldest peer to be evicted")
+				}
+				test.AssertEqual(t, maxPeerVersions, len(pvt.peers), "unexpected number of peers")
+			},
+			comp: build.ComponentAdmin,
+			expPeers: []*ctlpb.ComponentVersion{
+				{
+					Component: "admin",
+					Version:   "2.6.0",
+					Addr:      "newest",
+					LastSeen:  start.Add(maxPeerVersions * time.Second).Unix(),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			now := start
+			pvt := newPeerVersionTracker()
+			pvt.now = func() time.Time { return now }
+
+			if tc.records != nil {
+				tc.records(pvt, &now)
+			}
+
+			if diff := cmp.Diff(tc.expPeers, pvt.list(tc.comp), protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected peers (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_unaryPeerVersionInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		component string
+		version   string
+		expPeers  int
+	}{
+		"no headers": {},
+		"agent": {
+			component: "agent",
+			version:   "2.6.0",
+			expPeers:  1,
+		},
+		"incompatible agent": {
+			component: "agent",
+			version:   "1.0.0",
+			expPeers:  1,
+		},
+		"admin ignored": {
+			component: "admin",
+			version:   "2.6.0",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := peer.NewContext(test.Context(t), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 10001},
+			})
+			if tc.component != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+					build.DaosComponentHeader, tc.component,
+					build.DaosVersionHeader, tc.version,
+				))
+			}
+
+			pvt := newPeerVersionTracker()
+			handlerCalled := false
+			_, err := unaryPeerVersionInterceptor(pvt)(ctx, nil, &grpc.UnaryServerInfo{},
+				func(context.Context, interface{}) (interface{}, error) {
+					handlerCalled = true
+					return nil, nil
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertTrue(t, handlerCalled, "handler not called")
+
+			peers := pvt.list(build.ComponentAgent)
+			test.AssertEqual(t, tc.expPeers, len(peers), "unexpected number of peers")
+			if tc.expPeers > 0 {
+				test.AssertEqual(t, "10.0.0.1", peers[0].Addr, "unexpected peer address")
+				test.AssertEqual(t, tc.version, peers[0].Version, "unexpected peer version")
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_GetComponentVersions(t *testing.T) {
+	svc := &ControlService{peerVersions: newPeerVersionTracker()}
+	svc.peerVersions.record("10.0.0.1", testVersionedComponent(build.ComponentAgent, "2.6.0"))
+
+	resp, err := svc.GetComponentVersions(test.Context(t), &ctlpb.GetComponentVersionsReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test.AssertEqual(t, build.DaosVersion, resp.Server.Version, "unexpected server version")
+	test.AssertEqual(t, "server", resp.Server.Component, "unexpected server component")
+	test.AssertEqual(t, 1, len(resp.Agents), "unexpected number of agents")
+
+	if _, err := svc.GetComponentVersions(test.Context(t), nil); err == nil {
+		t.Fatal("expected error for nil request")
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -65,12 +66,14 @@ func checkSocketDir(sockDir string) error {
 }
 
 type drpcServerSetupReq struct {
-	log     logging.Logger
-	sockDir string
-	engines []Engine
-	tc      *security.TransportConfig
-	sysdb   *raft.Database
-	events  *events.PubSub
+	log       logging.Logger
+	sockDir   string
+	engines   []Engine
+	tc        *security.TransportConfig
+	sysdb     *raft.Database
+	events    *events.PubSub
+	groups    security.GroupResolver
+	devFaults *devFaultTracker
 }
 
 // drpcServerSetup specifies socket path and starts drpc server.
@@ -90,9 +93,10 @@ func drpcServerSetup(ctx context.Context, req *drpcServerSetupReq) error {
 	}
 
 	// Create and add our modules
-	drpcServer.RegisterRPCModule(NewSecurityModule(req.log, req.tc))
+	drpcServer.RegisterRPCModule(NewSecurityModule(req.log, req.tc).WithGroupResolver(req.groups))
 	drpcServer.RegisterRPCModule(newMgmtModule())
-	drpcServer.RegisterRPCModule(newSrvModule(req.log, req.sysdb, req.sysdb, req.engines, req.events))
+	drpcServer.RegisterRPCModule(newSrvModule(req.log, req.sysdb, req.sysdb, req.engines, req.events).
+		WithDevFaultTracker(req.devFaults))
 
 	if err := drpcServer.Start(ctx); err != nil {
 		return errors.Wrapf(err, "unable to start socket server on %s", sockPath)
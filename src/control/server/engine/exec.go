@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -65,16 +66,11 @@ func (r *Runner) run(parent context.Context, args, env []string, exitCh RunnerEx
 	}
 	cmd.Env = env
 
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		// I/O Engine should get a SIGKILL if this process dies.
-		Pdeathsig: syscall.SIGKILL,
-		// I/O Engine should run with real uid/gid (drop egid).
-		Credential: &syscall.Credential{
-			Uid:         uint32(os.Getuid()),
-			Gid:         uint32(os.Getgid()),
-			NoSetGroups: true,
-		},
+	sysProcAttr, err := engineSysProcAttr()
+	if err != nil {
+		return errors.Wrapf(err, "%s (instance %d) failed to start", binPath, r.Config.Index)
 	}
+	cmd.SysProcAttr = sysProcAttr
 
 	r.log.Debugf("%s:%d args: %s", engineBin, r.Config.Index, args)
 	r.log.Debugf("%s:%d env: %s", engineBin, r.Config.Index, cmd.Env)
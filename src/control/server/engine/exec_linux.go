@@ -0,0 +1,26 @@
+//
+// (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"os"
+	"syscall"
+)
+
+func engineSysProcAttr() (*syscall.SysProcAttr, error) {
+	return &syscall.SysProcAttr{
+		// I/O Engine should get a SIGKILL if this process dies.
+		Pdeathsig: syscall.SIGKILL,
+		// I/O Engine should run with real uid/gid (drop egid).
+		Credential: &syscall.Credential{
+			Uid:         uint32(os.Getuid()),
+			Gid:         uint32(os.Getgid()),
+			NoSetGroups: true,
+		},
+	}, nil
+}
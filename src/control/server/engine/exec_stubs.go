@@ -0,0 +1,20 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package engine
+
+import (
+	"runtime"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+func engineSysProcAttr() (*syscall.SysProcAttr, error) {
+	return nil, errors.Errorf("%s not supported on %s", engineBin, runtime.GOOS)
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,7 @@
 package server
 
 import (
+	"context"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -41,6 +43,13 @@ func getFaultDomain(cfg *config.Server) (*system.FaultDomain, error) {
 		return nil, config.FaultConfigBothFaultPathAndCb
 	}
 
+	if cfg.FaultProvider != "" {
+		if cfg.FaultPath != "" || cfg.FaultCb != "" {
+			return nil, config.FaultConfigFaultDomainSourceConflict
+		}
+		return getFaultDomainFromProvider(context.Background(), cfg.FaultProvider, os.Hostname)
+	}
+
 	if cfg.FaultPath != "" {
 		return newFaultDomainFromConfig(cfg.FaultPath)
 	}
@@ -0,0 +1,193 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	// cloudMetadataTimeout is the maximum time allowed to retrieve the fault domain
+	// from a cloud provider's instance metadata service.
+	cloudMetadataTimeout = 10 * time.Second
+	// cloudNodeLabel is the label of the node level of fault domains determined
+	// by a cloud provider.
+	cloudNodeLabel = "node"
+	// maxMetadataRespLen is the maximum length of a metadata service response.
+	maxMetadataRespLen = 4096
+)
+
+type (
+	// metadataGetterFn retrieves the name of the instance's fault domain from the
+	// instance metadata service at the given endpoint.
+	metadataGetterFn func(ctx context.Context, client *http.Client, endpoint string) (string, error)
+
+	// cloudFaultDomainProvider determines the fault domain of the instance from
+	// a cloud provider's instance metadata service.
+	cloudFaultDomainProvider struct {
+		label     string // label of the fault domain level, e.g. zone
+		endpoint  string // base URL of the instance metadata service
+		getDomain metadataGetterFn
+	}
+)
+
+// cloudFaultDomainProviders are the supported values of the fault_provider server
+// configuration parameter.
+var cloudFaultDomainProviders = map[string]*cloudFaultDomainProvider{
+	"aws": {
+		label:     "partition",
+		endpoint:  "http://169.254.169.254",
+		getDomain: getAWSPartition,
+	},
+	"gcp": {
+		label:     "zone",
+		endpoint:  "http://metadata.google.internal",
+		getDomain: getGCPZone,
+	},
+	"azure": {
+		label:     "fault_domain",
+		endpoint:  "http://169.254.169.254",
+		getDomain: getAzureFaultDomain,
+	},
+}
+
+func cloudFaultDomainProviderNames() []string {
+	names := make([]string, 0, len(cloudFaultDomainProviders))
+	for name := range cloudFaultDomainProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// newMetadataClient returns an HTTP client for instance metadata service requests.
+// Proxy settings are ignored, as the metadata service is only reachable directly
+// from the instance.
+func newMetadataClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{Proxy: nil},
+	}
+}
+
+// metadataRequest performs a request against the instance metadata service and
+// returns the trimmed response body.
+func metadataRequest(ctx context.Context, client *http.Client, method, url string, headers map[string]string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return "", err
+	}
+	for key, val := range headers {
+		req.Header.Set(key, val)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMetadataRespLen))
+	if err != nil {
+		return "", errors.Wrapf(err, "reading response from %s", url)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("%s %s: %s", method, url, resp.Status)
+	}
+
+	val := strings.TrimSpace(string(body))
+	if val == "" {
+		return "", errors.Errorf("%s %s: empty response", method, url)
+	}
+
+	return val, nil
+}
+
+// getAWSPartition returns the partition of the instance's partition placement group,
+// using the IMDSv2 session-oriented API.
+func getAWSPartition(ctx context.Context, client *http.Client, endpoint string) (string, error) {
+	token, err := metadataRequest(ctx, client, http.MethodPut, endpoint+"/latest/api/token",
+		map[string]string{"X-aws-ec2-metadata-token-ttl-seconds": "60"})
+	if err != nil {
+		return "", errors.Wrap(err, "retrieving metadata session token")
+	}
+
+	partition, err := metadataRequest(ctx, client, http.MethodGet,
+		endpoint+"/latest/meta-data/placement/partition-number",
+		map[string]string{"X-aws-ec2-metadata-token": token})
+	if err != nil {
+		return "", errors.Wrap(err, "retrieving placement group partition (is the instance in a partition placement group?)")
+	}
+
+	return partition, nil
+}
+
+// getGCPZone returns the zone of the instance.
+func getGCPZone(ctx context.Context, client *http.Client, endpoint string) (string, error) {
+	zone, err := metadataRequest(ctx, client, http.MethodGet,
+		endpoint+"/computeMetadata/v1/instance/zone",
+		map[string]string{"Metadata-Flavor": "Google"})
+	if err != nil {
+		return "", errors.Wrap(err, "retrieving instance zone")
+	}
+
+	// The zone is returned as projects/<project number>/zones/<zone>.
+	return path.Base(zone), nil
+}
+
+// getAzureFaultDomain returns the platform fault domain of the instance.
+func getAzureFaultDomain(ctx context.Context, client *http.Client, endpoint string) (string, error) {
+	fd, err := metadataRequest(ctx, client, http.MethodGet,
+		endpoint+"/metadata/instance/compute/platformFaultDomain?api-version=2021-02-01&format=text",
+		map[string]string{"Metadata": "true"})
+	if err != nil {
+		return "", errors.Wrap(err, "retrieving platform fault domain")
+	}
+
+	return fd, nil
+}
+
+// getFaultDomainFromProvider determines the fault domain of the server from the
+// named cloud provider's instance metadata service. The fault domain has two
+// levels, the provider's fault domain and the host, e.g. /zone=us-east1-b/node=host1.
+func getFaultDomainFromProvider(ctx context.Context, name string, getHostname hostnameGetterFn) (*system.FaultDomain, error) {
+	provider, found := cloudFaultDomainProviders[name]
+	if !found {
+		return nil, config.FaultConfigFaultProviderUnknown(name, cloudFaultDomainProviderNames())
+	}
+
+	hostname, err := getHostname()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cloudMetadataTimeout)
+	defer cancel()
+
+	domain, err := provider.getDomain(ctx, newMetadataClient(), provider.endpoint)
+	if err != nil {
+		return nil, config.FaultConfigFaultProviderFailed(name, err)
+	}
+
+	fdStr := system.FaultDomainSeparator + strings.Join([]string{
+		provider.label + system.FaultDomainLabelAssign + domain,
+		cloudNodeLabel + system.FaultDomainLabelAssign + hostname,
+	}, system.FaultDomainSeparator)
+
+	return newFaultDomainFromConfig(fdStr)
+}
@@ -0,0 +1,132 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+// mockMetadataService returns a handler that serves the given responses keyed by
+// request path, provided that the required header is set.
+func mockMetadataService(header string, responses map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(header) == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		resp, found := responses[r.URL.Path]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(resp))
+	}
+}
+
+func TestServer_getFaultDomainFromProvider(t *testing.T) {
+	awsService := func(responses map[string]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/latest/api/token" {
+				mockMetadataService("X-aws-ec2-metadata-token-ttl-seconds",
+					map[string]string{r.URL.Path: "token"})(w, r)
+				return
+			}
+			mockMetadataService("X-aws-ec2-metadata-token", responses)(w, r)
+		}
+	}
+	getHostname := func() (string, error) {
+		return "host1", nil
+	}
+
+	for name, tc := range map[string]struct {
+		provider    string
+		service     http.HandlerFunc
+		getHostname hostnameGetterFn
+		expResult   string
+		expErr      error
+	}{
+		"unknown provider": {
+			provider: "ibm",
+			expErr:   config.FaultConfigFaultProviderUnknown("ibm", []string{"aws", "azure", "gcp"}),
+		},
+		"hostname fails": {
+			provider: "gcp",
+			getHostname: func() (string, error) {
+				return "", errors.New("mock hostname")
+			},
+			expErr: errors.New("mock hostname"),
+		},
+		"aws": {
+			provider: "aws",
+			service: awsService(map[string]string{
+				"/latest/meta-data/placement/partition-number": "3\n",
+			}),
+			expResult: "/partition=3/node=host1",
+		},
+		"aws not in partition placement group": {
+			provider: "aws",
+			service:  awsService(map[string]string{}),
+			expErr:   errors.New("404 Not Found"),
+		},
+		"gcp": {
+			provider: "gcp",
+			service: mockMetadataService("Metadata-Flavor", map[string]string{
+				"/computeMetadata/v1/instance/zone": "projects/123456/zones/us-east1-b",
+			}),
+			expResult: "/zone=us-east1-b/node=host1",
+		},
+		"azure": {
+			provider: "azure",
+			service: mockMetadataService("Metadata", map[string]string{
+				"/metadata/instance/compute/platformFaultDomain": "1",
+			}),
+			expResult: "/fault_domain=1/node=host1",
+		},
+		"azure empty response": {
+			provider: "azure",
+			service: mockMetadataService("Metadata", map[string]string{
+				"/metadata/instance/compute/platformFaultDomain": "",
+			}),
+			expErr: errors.New("empty response"),
+		},
+		"invalid domain": {
+			provider: "gcp",
+			service: mockMetadataService("Metadata-Flavor", map[string]string{
+				"/computeMetadata/v1/instance/zone": "zone=a",
+			}),
+			expErr: errors.New("invalid fault domain"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if provider, found := cloudFaultDomainProviders[tc.provider]; found && tc.service != nil {
+				srv := httptest.NewServer(tc.service)
+				defer srv.Close()
+
+				mockProvider := *provider
+				mockProvider.endpoint = srv.URL
+				cloudFaultDomainProviders[tc.provider] = &mockProvider
+				defer func() {
+					cloudFaultDomainProviders[tc.provider] = provider
+				}()
+			}
+			if tc.getHostname == nil {
+				tc.getHostname = getHostname
+			}
+
+			result, err := getFaultDomainFromProvider(test.Context(t), tc.provider, tc.getHostname)
+			test.CmpErr(t, tc.expErr, err)
+			assertFaultDomainEqualStr(t, tc.expResult, result)
+		})
+	}
+}
@@ -140,6 +140,19 @@ func TestServer_getFaultDomain(t *testing.T) {
 			},
 			expErr: config.FaultConfigBothFaultPathAndCb,
 		},
+		"cfg both fault provider and fault path": {
+			cfg: &config.Server{
+				FaultPath:     validFaultDomain,
+				FaultProvider: "aws",
+			},
+			expErr: config.FaultConfigFaultDomainSourceConflict,
+		},
+		"cfg unknown fault provider": {
+			cfg: &config.Server{
+				FaultProvider: "ibm",
+			},
+			expErr: config.FaultConfigFaultProviderUnknown("ibm", []string{"aws", "azure", "gcp"}),
+		},
 		"default gets hostname": {
 			cfg:       &config.Server{},
 			expResult: system.FaultDomainSeparator + realHostname,
@@ -103,6 +103,19 @@ func FaultPoolScmTooSmall(minTotal, minSCM uint64) *fault.Fault {
 	)
 }
 
+// FaultPoolInsufficientCapacity indicates that the storage requested for a pool on a rank
+// exceeds what is available, after accounting for the storage reserved by pools that are
+// still being created.
+func FaultPoolInsufficientCapacity(rank ranklist.Rank, tier string, req, avail, reserved uint64) *fault.Fault {
+	return serverFault(
+		code.ServerPoolInsufficientCapacity,
+		fmt.Sprintf("requested %s capacity %s exceeds the %s available on rank %d (%s reserved by pools being created)",
+			tier, humanize.IBytes(req), humanize.IBytes(avail), rank, humanize.IBytes(reserved)),
+		"retry the request with a smaller pool size, or wait for pending pool creates to complete and retry; "+
+			"check 'dmg system usage' for pool storage reservations",
+	)
+}
+
 func FaultPoolInvalidRanks(invalid []ranklist.Rank) *fault.Fault {
 	rs := make([]string, len(invalid))
 	for i, r := range invalid {
@@ -126,6 +139,24 @@ func FaultPoolInvalidNumRanks(req, avail int) *fault.Fault {
 	)
 }
 
+func FaultPoolDestroyProtected(id string) *fault.Fault {
+	return serverFault(
+		code.ServerPoolDestroyProtected,
+		fmt.Sprintf("pool %s has destruction protection enabled", id),
+		"clear the protection with 'dmg pool set-prop <pool> destroy_protect:disabled' and retry the operation",
+	)
+}
+
+// FaultPoolPolicyDenied indicates that a pool create request was denied
+// because it would exceed the limits of a pool policy.
+func FaultPoolPolicyDenied(reason string) *fault.Fault {
+	return serverFault(
+		code.ServerPoolPolicyDenied,
+		fmt.Sprintf("pool create denied by policy: %s", reason),
+		"retry the request within the limits of the policy, or ask the administrator to review the policies listed by 'dmg pool policy list'",
+	)
+}
+
 func FaultPoolDuplicateLabel(dupe string) *fault.Fault {
 	return serverFault(
 		code.ServerPoolDuplicateLabel,
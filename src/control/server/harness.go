@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -57,6 +58,7 @@ type Engine interface {
 	Index() uint32
 	IsStarted() bool
 	IsReady() bool
+	StartedAt() time.Time
 	LocalState() system.MemberState
 	RemoveSuperblock() error
 	Run(context.Context)
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -73,6 +74,7 @@ type EngineInstance struct {
 	_cancelCtx       context.CancelFunc
 	_superblock      *Superblock
 	_lastErr         error // populated when harness receives signal
+	_startedAt       time.Time
 	_lastHealthStats map[string]*ctlpb.BioHealthResp
 }
 
@@ -117,6 +119,22 @@ func (ei *EngineInstance) IsReady() bool {
 	return ei.ready.Load() && ei.IsStarted()
 }
 
+// StartedAt returns the time at which the I/O Engine process was last started, or the
+// zero time if the process is not running.
+func (ei *EngineInstance) StartedAt() time.Time {
+	ei.RLock()
+	defer ei.RUnlock()
+
+	return ei._startedAt
+}
+
+func (ei *EngineInstance) setStartedAt(t time.Time) {
+	ei.Lock()
+	defer ei.Unlock()
+
+	ei._startedAt = t
+}
+
 // OnAwaitFormat adds a list of callbacks to invoke when the instance
 // requires formatting.
 func (ei *EngineInstance) OnAwaitFormat(fns ...onAwaitFormatFn) {
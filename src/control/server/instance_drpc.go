@@ -19,6 +19,7 @@ import (
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	srvpb "github.com/daos-stack/daos/src/control/common/proto/srv"
 	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/system"
@@ -76,9 +77,14 @@ func (ei *EngineInstance) isDrpcSocketReady() bool {
 func (ei *EngineInstance) callDrpc(ctx context.Context, method drpc.Method, body proto.Message) (*drpc.Response, error) {
 	dc := ei.getDrpcClient()
 
+	// Record the call in the trace of the request being handled, if any.
+	ctx, span := control.StartSpan(ctx, "drpc/"+method.String())
+	span.SetAttribute("daos.engine.index", fmt.Sprintf("%d", ei.Index()))
+
 	rankMsg := ""
 	if sb := ei.getSuperblock(); sb != nil && sb.Rank != nil {
 		rankMsg = fmt.Sprintf(" (rank %s)", sb.Rank)
+		span.SetAttribute("daos.rank", sb.Rank.String())
 	}
 
 	startedAt := time.Now()
@@ -86,7 +92,10 @@ func (ei *EngineInstance) callDrpc(ctx context.Context, method drpc.Method, body
 		ei.log.Debugf("dRPC to index %d%s: %s/%dB/%s", ei.Index(), rankMsg, method, proto.Size(body), time.Since(startedAt))
 	}()
 
-	return makeDrpcCall(ctx, ei.log, dc, method, body)
+	resp, err := makeDrpcCall(ctx, ei.log, dc, method, body)
+	span.End(err)
+
+	return resp, err
 }
 
 // CallDrpc makes the supplied dRPC call via this instance's dRPC client.
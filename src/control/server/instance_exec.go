@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -64,7 +66,13 @@ func (ei *EngineInstance) start(ctx context.Context) (chan *engine.RunnerExitInf
 		ei.log.Errorf("instance %d: unable to log SCM storage stats: %s", ei.Index(), err)
 	}
 
-	return ei.runner.Start(ctx)
+	exitCh, err := ei.runner.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ei.setStartedAt(time.Now())
+
+	return exitCh, nil
 }
 
 // waitReady awaits ready signal from I/O Engine before starting
@@ -128,6 +136,7 @@ func (ei *EngineInstance) handleExit(ctx context.Context, exitPid int, exitErr e
 	}
 
 	ei._lastErr = exitErr
+	ei.setStartedAt(time.Time{})
 
 	details := []string{fmt.Sprintf("instance %d", engineIdx)}
 	if exitPid != 0 {
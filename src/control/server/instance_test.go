@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -179,6 +180,7 @@ type (
 		Index               uint32
 		Started             atm.Bool
 		Ready               atm.Bool
+		StartedAt           time.Time
 		CheckerMode         atm.Bool
 		LocalState          system.MemberState
 		RemoveSuperblockErr error
@@ -236,6 +238,10 @@ func (mi *MockInstance) IsReady() bool {
 	return mi.cfg.Ready.Load()
 }
 
+func (mi *MockInstance) StartedAt() time.Time {
+	return mi.cfg.StartedAt
+}
+
 func (mi *MockInstance) LocalState() system.MemberState {
 	return mi.cfg.LocalState
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,8 @@ package server
 
 import (
 	"fmt"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,12 +19,14 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common/proto"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
@@ -206,6 +211,40 @@ func unaryVersionInterceptor(log logging.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
+// versionServerStream checks the version of the peer when the request message
+// of a server-streaming RPC is received.
+type versionServerStream struct {
+	grpc.ServerStream
+	log     logging.Logger
+	self    *build.VersionedComponent
+	checked bool
+}
+
+func (ss *versionServerStream) RecvMsg(m interface{}) error {
+	if err := ss.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if ss.checked {
+		return nil
+	}
+	ss.checked = true
+
+	if err := checkVersion(ss.Context(), ss.log, ss.self, m); err != nil {
+		return errors.Wrapf(err, "version check failed for %T", m)
+	}
+	return nil
+}
+
+func streamVersionInterceptor(log logging.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &versionServerStream{
+			ServerStream: ss,
+			log:          log,
+			self:         selfServerComponent,
+		})
+	}
+}
+
 func unaryErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	res, err := handler(ctx, req)
 	return res, proto.AnnotateError(err)
@@ -216,6 +255,61 @@ func streamErrorInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.St
 	return proto.AnnotateError(err)
 }
 
+// unaryElapsedInterceptor reports the time spent handling the request to the
+// client in a response trailer, so that the client can distinguish the server
+// processing time from network latency.
+func unaryElapsedInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	startTime := time.Now()
+	res, err := handler(ctx, req)
+
+	elapsed := strconv.FormatInt(int64(time.Since(startTime)), 10)
+	_ = grpc.SetTrailer(ctx, metadata.Pairs(control.ServerElapsedTrailer, elapsed))
+
+	return res, err
+}
+
+// requestLockOwner identifies the operation and the user requesting it, so that
+// any operation locks taken while handling the request can be attributed to it.
+func requestLockOwner(ctx context.Context, fullMethod string) system.LockOwner {
+	owner := system.LockOwner{Operation: path.Base(fullMethod)}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(control.ClientUserMetadataKey); len(vals) > 0 {
+			owner.User = vals[0]
+		}
+	}
+	if owner.User == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			owner.User = p.Addr.String()
+		}
+	}
+
+	return owner
+}
+
+// unaryLockOwnerInterceptor identifies the operation and the user requesting
+// it in the context passed to the handler, so that any operation locks taken
+// while handling the request can be attributed to it.
+func unaryLockOwnerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(system.WithLockOwner(ctx, requestLockOwner(ctx, info.FullMethod)), req)
+}
+
+// ctxServerStream overrides the context of a server stream.
+type ctxServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *ctxServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+// streamLockOwnerInterceptor identifies the operation and the user requesting
+// it in the context of the stream passed to the handler.
+func streamLockOwnerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := system.WithLockOwner(ss.Context(), requestLockOwner(ss.Context(), info.FullMethod))
+	return handler(srv, &ctxServerStream{ServerStream: ss, ctx: ctx})
+}
+
 type statusGetter interface {
 	GetStatus() int32
 }
@@ -244,6 +338,33 @@ func unaryStatusInterceptor(ctx context.Context, req interface{}, info *grpc.Una
 	return res, err
 }
 
+// statusServerStream converts a non-zero DAOS status in a response message of
+// a server-streaming RPC into an error, in place of sending the message.
+type statusServerStream struct {
+	grpc.ServerStream
+	err error
+}
+
+func (ss *statusServerStream) SendMsg(m interface{}) error {
+	if sg, ok := m.(statusGetter); ok {
+		if err := dErrFromStatus(sg); err != nil {
+			ss.err = err
+			return err
+		}
+	}
+
+	return ss.ServerStream.SendMsg(m)
+}
+
+func streamStatusInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	statusSS := &statusServerStream{ServerStream: ss}
+	if err := handler(srv, statusSS); err != nil {
+		return err
+	}
+
+	return statusSS.err
+}
+
 // isSentinelErr indicates whether or not the error is a sentinel
 // error used to convey a specific state to the client.
 func isSentinelErr(err error) bool {
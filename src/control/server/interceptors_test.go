@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,19 +12,26 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 type testStatus struct {
@@ -74,6 +82,243 @@ func TestServer_unaryStatusInterceptor(t *testing.T) {
 	}
 }
 
+// testServerStream implements the grpc.ServerStream message methods in order to
+// capture the messages sent by the handler of a server-streaming RPC.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []interface{}
+}
+
+func (ts *testServerStream) Context() context.Context { return ts.ctx }
+
+func (ts *testServerStream) SendMsg(m interface{}) error {
+	ts.sent = append(ts.sent, m)
+	return nil
+}
+
+func (ts *testServerStream) RecvMsg(m interface{}) error { return nil }
+
+func TestServer_streamStatusInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		handlerResps []interface{}
+		handlerErr   error
+		expSent      []interface{}
+		expErr       error
+	}{
+		"handler error": {
+			handlerErr: errors.New("whoops"),
+			expErr:     errors.New("whoops"),
+		},
+		"DAOS status 0": {
+			handlerResps: []interface{}{&testStatus{}},
+			expSent:      []interface{}{&testStatus{}},
+		},
+		"DAOS status -1005": {
+			handlerResps: []interface{}{&testStatus{Status: -1005}},
+			expErr:       daos.Nonexistent,
+		},
+		"non-status resp": {
+			handlerResps: []interface{}{42},
+			expSent:      []interface{}{42},
+		},
+		"pool create progress then failed create": {
+			handlerResps: []interface{}{
+				&mgmtpb.PoolCreateStreamResp{
+					Update: &mgmtpb.PoolCreateStreamResp_Progress{
+						Progress: &sharedpb.Progress{Completed: 1, Total: 2},
+					},
+				},
+				&mgmtpb.PoolCreateStreamResp{
+					Update: &mgmtpb.PoolCreateStreamResp_Resp{
+						Resp: &mgmtpb.PoolCreateResp{Status: int32(daos.NoSpace)},
+					},
+				},
+			},
+			expSent: []interface{}{
+				&mgmtpb.PoolCreateStreamResp{
+					Update: &mgmtpb.PoolCreateStreamResp_Progress{
+						Progress: &sharedpb.Progress{Completed: 1, Total: 2},
+					},
+				},
+			},
+			expErr: daos.NoSpace,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ss := &testServerStream{ctx: test.Context(t)}
+			handler := func(srv interface{}, stream grpc.ServerStream) error {
+				for _, resp := range tc.handlerResps {
+					if err := stream.SendMsg(resp); err != nil {
+						// Ignore the send error, as a handler that
+						// logs rather than returns it would.
+						break
+					}
+				}
+				return tc.handlerErr
+			}
+			gotErr := streamStatusInterceptor(nil, ss, nil, handler)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(tc.expSent, ss.sent, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected sent messages (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_versionServerStream(t *testing.T) {
+	newHdrCtx := func(comp build.Component, ver string) context.Context {
+		return metadata.NewIncomingContext(test.Context(t), metadata.Pairs(
+			build.DaosComponentHeader, comp.String(),
+			build.DaosVersionHeader, ver,
+		))
+	}
+
+	for name, tc := range map[string]struct {
+		ctx    context.Context
+		msgs   []interface{}
+		expErr error
+	}{
+		"compatible agent": {
+			ctx:  newHdrCtx(build.ComponentAgent, "2.4.1"),
+			msgs: []interface{}{&checkVerReq{Sys: build.DefaultSystemName}},
+		},
+		"incompatible dmg": {
+			ctx:    newHdrCtx(build.ComponentAdmin, "2.6.0"),
+			msgs:   []interface{}{&checkVerReq{Sys: build.DefaultSystemName}},
+			expErr: errors.New("version check failed for *server.checkVerReq"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			test.ShowBufferOnFailure(t, buf)
+
+			ss := &versionServerStream{
+				ServerStream: &testServerStream{ctx: tc.ctx},
+				log:          log,
+				self: &build.VersionedComponent{
+					Component: "server",
+					Version:   build.MustNewVersion("2.4.0"),
+				},
+			}
+
+			var gotErr error
+			for _, msg := range tc.msgs {
+				if gotErr = ss.RecvMsg(msg); gotErr != nil {
+					break
+				}
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+// mockTransportStream implements the grpc.ServerTransportStream interface in
+// order to capture the trailer set by an interceptor.
+type mockTransportStream struct {
+	trailer metadata.MD
+}
+
+func (m *mockTransportStream) Method() string { return "/test/Method" }
+
+func (m *mockTransportStream) SetHeader(metadata.MD) error { return nil }
+
+func (m *mockTransportStream) SendHeader(metadata.MD) error { return nil }
+
+func (m *mockTransportStream) SetTrailer(md metadata.MD) error {
+	m.trailer = metadata.Join(m.trailer, md)
+	return nil
+}
+
+func TestServer_unaryElapsedInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		handlerErr error
+	}{
+		"success": {},
+		"handler error": {
+			handlerErr: errors.New("whoops"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			stream := &mockTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(test.Context(t), stream)
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return 42, tc.handlerErr
+			}
+			gotResp, gotErr := unaryElapsedInterceptor(ctx, nil, nil, handler)
+			test.CmpErr(t, tc.handlerErr, gotErr)
+			test.AssertEqual(t, 42, gotResp, "unexpected response")
+
+			vals := stream.trailer.Get(control.ServerElapsedTrailer)
+			if len(vals) != 1 {
+				t.Fatalf("expected one elapsed time in trailer, got %v", vals)
+			}
+			if _, err := strconv.ParseInt(vals[0], 10, 64); err != nil {
+				t.Fatalf("invalid elapsed time %q in trailer", vals[0])
+			}
+		})
+	}
+}
+
+func TestServer_unaryLockOwnerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/mgmt.MgmtSvc/PoolExtend"}
+
+	for name, tc := range map[string]struct {
+		ctx      context.Context
+		expOwner system.LockOwner
+	}{
+		"no client user or peer": {
+			ctx:      test.Context(t),
+			expOwner: system.LockOwner{Operation: "PoolExtend"},
+		},
+		"peer address": {
+			ctx: newTestAuthCtx(test.Context(t), "admin"),
+			expOwner: system.LockOwner{
+				Operation: "PoolExtend",
+				User:      common.LocalhostCtrlAddr().String(),
+			},
+		},
+		"client user": {
+			ctx: metadata.NewIncomingContext(newTestAuthCtx(test.Context(t), "admin"),
+				metadata.Pairs(control.ClientUserMetadataKey, "bob@host1")),
+			expOwner: system.LockOwner{Operation: "PoolExtend", User: "bob@host1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotOwner system.LockOwner
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotOwner = system.LockOwnerFromContext(ctx)
+				return nil, nil
+			}
+			if _, err := unaryLockOwnerInterceptor(tc.ctx, nil, info, handler); err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, tc.expOwner, gotOwner, "unexpected lock owner")
+		})
+	}
+}
+
+func TestServer_streamLockOwnerInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/mgmt.MgmtSvc/SystemStopStream"}
+	ctx := metadata.NewIncomingContext(newTestAuthCtx(test.Context(t), "admin"),
+		metadata.Pairs(control.ClientUserMetadataKey, "bob@host1"))
+
+	var gotOwner system.LockOwner
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		gotOwner = system.LockOwnerFromContext(ss.Context())
+		return nil
+	}
+	if err := streamLockOwnerInterceptor(nil, newMockServerStream[any](ctx), info, handler); err != nil {
+		t.Fatal(err)
+	}
+
+	expOwner := system.LockOwner{Operation: "SystemStopStream", User: "bob@host1"}
+	test.AssertEqual(t, expOwner, gotOwner, "unexpected lock owner")
+}
+
 // newTestAuthCtx returns a context with a fake peer.PeerInfo
 // set up to validate component access/versioning.
 func newTestAuthCtx(parent context.Context, commonName string) context.Context {
@@ -0,0 +1,174 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// logTailChunkSize is the size of the chunks in which a log is read
+	// backwards from its end to find the last lines.
+	logTailChunkSize = 64 * 1024
+	// logTailMaxRead is the maximum amount of a log read at once when
+	// following it, so that a burst of messages doesn't exhaust memory.
+	logTailMaxRead = 1024 * 1024
+)
+
+// logTail reads the lines at the end of a log file, and then the lines that
+// are appended to it. A log that is rotated or truncated is read again from
+// its start.
+type logTail struct {
+	path   string
+	file   *os.File
+	offset int64 // offset of the first byte not yet returned in a complete line
+}
+
+// openLogTail opens the log file at the given path and returns up to the given
+// number of complete lines at its end. A partial line at the end of the log is
+// returned by the next call to readLines, once it has been completed.
+func openLogTail(path string, numLines int) (*logTail, []string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "opening log")
+	}
+
+	lt := &logTail{
+		path: path,
+		file: file,
+	}
+	lines, err := lt.readLast(numLines)
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+
+	return lt, lines, nil
+}
+
+// readLast returns up to numLines complete lines at the end of the log, and
+// sets the offset to the end of the last complete line.
+func (lt *logTail) readLast(numLines int) ([]string, error) {
+	fi, err := lt.file.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "reading log")
+	}
+
+	// Read chunks backwards from the end of the log until it has been read
+	// to its start or enough newlines have been found.
+	var buf []byte
+	pos := fi.Size()
+	for pos > 0 && bytes.Count(buf, []byte{'\n'}) <= numLines {
+		size := int64(logTailChunkSize)
+		if size > pos {
+			size = pos
+		}
+		pos -= size
+
+		chunk := make([]byte, size)
+		if _, err := lt.file.ReadAt(chunk, pos); err != nil && err != io.EOF {
+			return nil, errors.Wrap(err, "reading log")
+		}
+		buf = append(chunk, buf...)
+	}
+
+	end := bytes.LastIndexByte(buf, '\n') + 1
+	lt.offset = pos + int64(end)
+	if numLines <= 0 || end == 0 {
+		return nil, nil
+	}
+
+	// If the start of the log was not reached, the first line may be
+	// incomplete, but more than numLines lines have been read.
+	lines := splitLogLines(buf[:end])
+	if len(lines) > numLines {
+		lines = lines[len(lines)-numLines:]
+	}
+
+	return lines, nil
+}
+
+// readLines returns the complete lines appended to the log since the last call.
+// If the log has been replaced or truncated, e.g. by log rotation, the lines
+// are read from the start of the new log.
+func (lt *logTail) readLines() ([]string, error) {
+	fi, err := os.Stat(lt.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// The log is being rotated.
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "reading log")
+	}
+
+	openFi, err := lt.file.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "reading log")
+	}
+	if !os.SameFile(fi, openFi) {
+		file, err := os.Open(lt.path)
+		if err != nil {
+			return nil, errors.Wrap(err, "reopening log")
+		}
+		lt.file.Close()
+		lt.file = file
+		lt.offset = 0
+	} else if fi.Size() < lt.offset {
+		lt.offset = 0
+	}
+
+	size := fi.Size() - lt.offset
+	if size <= 0 {
+		return nil, nil
+	}
+	if size > logTailMaxRead {
+		size = logTailMaxRead
+	}
+
+	buf := make([]byte, size)
+	n, err := lt.file.ReadAt(buf, lt.offset)
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "reading log")
+	}
+	buf = buf[:n]
+
+	end := bytes.LastIndexByte(buf, '\n') + 1
+	if end == 0 {
+		if n < logTailMaxRead {
+			// Wait for the line to be completed.
+			return nil, nil
+		}
+		// Don't wait for an overlong line to be completed.
+		end = n
+	}
+	lt.offset += int64(end)
+
+	return splitLogLines(buf[:end]), nil
+}
+
+// Close closes the log file.
+func (lt *logTail) Close() error {
+	return lt.file.Close()
+}
+
+// splitLogLines splits the buffer into lines, without their trailing newline.
+func splitLogLines(buf []byte) []string {
+	buf = bytes.TrimSuffix(buf, []byte{'\n'})
+	if len(buf) == 0 {
+		return nil
+	}
+
+	var lines []string
+	for _, line := range bytes.Split(buf, []byte{'\n'}) {
+		lines = append(lines, string(line))
+	}
+	return lines
+}
@@ -0,0 +1,178 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func mockLogLines(start, end int) []string {
+	var lines []string
+	for i := start; i < end; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	return lines
+}
+
+func mockLogContent(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestServer_openLogTail(t *testing.T) {
+	// Enough lines for the log to be read in several chunks.
+	manyLines := mockLogLines(0, 2*logTailChunkSize/8)
+
+	for name, tc := range map[string]struct {
+		content   string
+		noFile    bool
+		numLines  int
+		expLines  []string
+		expOffset int64
+		expErr    error
+	}{
+		"missing log": {
+			noFile: true,
+			expErr: errors.New("no such file"),
+		},
+		"empty log": {
+			numLines: 10,
+		},
+		"fewer lines than requested": {
+			content:   mockLogContent(mockLogLines(0, 3)),
+			numLines:  10,
+			expLines:  mockLogLines(0, 3),
+			expOffset: int64(len(mockLogContent(mockLogLines(0, 3)))),
+		},
+		"more lines than requested": {
+			content:   mockLogContent(mockLogLines(0, 20)),
+			numLines:  5,
+			expLines:  mockLogLines(15, 20),
+			expOffset: int64(len(mockLogContent(mockLogLines(0, 20)))),
+		},
+		"no lines requested": {
+			content:   mockLogContent(mockLogLines(0, 20)),
+			expOffset: int64(len(mockLogContent(mockLogLines(0, 20)))),
+		},
+		"partial last line": {
+			content:   mockLogContent(mockLogLines(0, 3)) + "partial",
+			numLines:  2,
+			expLines:  mockLogLines(1, 3),
+			expOffset: int64(len(mockLogContent(mockLogLines(0, 3)))),
+		},
+		"several chunks": {
+			content:   mockLogContent(manyLines),
+			numLines:  len(manyLines) - 1,
+			expLines:  manyLines[1:],
+			expOffset: int64(len(mockLogContent(manyLines))),
+		},
+		"whole log in several chunks": {
+			content:   mockLogContent(manyLines),
+			numLines:  len(manyLines) + 1,
+			expLines:  manyLines,
+			expOffset: int64(len(mockLogContent(manyLines))),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "test.log")
+			if !tc.noFile {
+				if err := os.WriteFile(path, []byte(tc.content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			lt, lines, err := openLogTail(path, tc.numLines)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			defer lt.Close()
+
+			if diff := cmp.Diff(tc.expLines, lines, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("unexpected lines (-want, +got):\n%s", diff)
+			}
+			test.AssertEqual(t, tc.expOffset, lt.offset, "unexpected offset")
+		})
+	}
+}
+
+func TestServer_logTail_readLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.log")
+	if err := os.WriteFile(path, []byte(mockLogContent(mockLogLines(0, 3))), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	lt, _, err := openLogTail(path, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lt.Close()
+
+	appendLog := func(t *testing.T, content string) {
+		t.Helper()
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if _, err := f.WriteString(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	checkLines := func(t *testing.T, expLines []string) {
+		t.Helper()
+		lines, err := lt.readLines()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(expLines, lines, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("unexpected lines (-want, +got):\n%s", diff)
+		}
+	}
+
+	// Nothing appended.
+	checkLines(t, nil)
+
+	// Complete lines appended.
+	appendLog(t, mockLogContent(mockLogLines(3, 5)))
+	checkLines(t, mockLogLines(3, 5))
+
+	// A partial line is not returned until it has been completed.
+	appendLog(t, "line")
+	checkLines(t, nil)
+	appendLog(t, " 5\nline 6\n")
+	checkLines(t, mockLogLines(5, 7))
+
+	// A truncated log is read from its start.
+	if err := os.WriteFile(path, []byte(mockLogContent(mockLogLines(7, 8))), 0644); err != nil {
+		t.Fatal(err)
+	}
+	checkLines(t, mockLogLines(7, 8))
+
+	// A rotated log is read from the start of the new log.
+	if err := os.Rename(path, path+".1"); err != nil {
+		t.Fatal(err)
+	}
+	checkLines(t, nil)
+	if err := os.WriteFile(path, []byte(mockLogContent(mockLogLines(8, 20))), 0644); err != nil {
+		t.Fatal(err)
+	}
+	checkLines(t, mockLogLines(8, 20))
+}
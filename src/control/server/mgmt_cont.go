@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -53,3 +54,60 @@ func (svc *mgmtSvc) ContSetOwner(ctx context.Context, req *mgmtpb.ContSetOwnerRe
 
 	return resp, nil
 }
+
+// ContCreate forwards a gRPC request to the DAOS I/O Engine to create a container.
+func (svc *mgmtSvc) ContCreate(ctx context.Context, req *mgmtpb.ContCreateReq) (*mgmtpb.ContCreateResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodContCreate, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.ContCreateResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal ContCreate response")
+	}
+
+	return resp, nil
+}
+
+// ContDestroy forwards a gRPC request to the DAOS I/O Engine to destroy a container.
+func (svc *mgmtSvc) ContDestroy(ctx context.Context, req *mgmtpb.ContDestroyReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodContDestroy, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.DaosResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal ContDestroy response")
+	}
+
+	return resp, nil
+}
+
+// ContQuery forwards a gRPC request to the DAOS I/O Engine to query a container.
+func (svc *mgmtSvc) ContQuery(ctx context.Context, req *mgmtpb.ContQueryReq) (*mgmtpb.ContQueryResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodContQuery, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.ContQueryResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal ContQuery response")
+	}
+
+	return resp, nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -238,3 +239,260 @@ func TestMgmt_ContSetOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestMgmt_ContCreate(t *testing.T) {
+	validContCreateReq := func() *mgmtpb.ContCreateReq {
+		return &mgmtpb.ContCreateReq{
+			Sys:    build.DefaultSystemName,
+			PoolId: mockUUID,
+			Label:  "cont1",
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		createMS  func(*testing.T, logging.Logger) *mgmtSvc
+		setupDrpc func(*testing.T, *mgmtSvc)
+		req       *mgmtpb.ContCreateReq
+		expResp   *mgmtpb.ContCreateResp
+		expErr    error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"pool svc not found": {
+			req: &mgmtpb.ContCreateReq{
+				Sys:    build.DefaultSystemName,
+				PoolId: "fake",
+				Label:  "cont1",
+			},
+			expErr: errors.New("unable to find pool"),
+		},
+		"harness not started": {
+			createMS: func(t *testing.T, log logging.Logger) *mgmtSvc {
+				db := raft.MockDatabase(t, log)
+				ms := system.MockMembership(t, log, db, mockTCPResolver)
+				return newMgmtSvc(NewEngineHarness(log), ms, db, nil,
+					events.NewPubSub(test.Context(t), log))
+			},
+			req:    validContCreateReq(),
+			expErr: FaultHarnessNotStarted,
+		},
+		"drpc error": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupSvcDrpcClient(svc, 0, getMockDrpcClient(nil, errors.New("mock drpc")))
+			},
+			req:    validContCreateReq(),
+			expErr: errors.New("mock drpc"),
+		},
+		"bad drpc resp": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				badBytes := makeBadBytes(16)
+				setupSvcDrpcClient(svc, 0, getMockDrpcClientBytes(badBytes, nil))
+			},
+			req:    validContCreateReq(),
+			expErr: errors.New("unmarshal"),
+		},
+		"success": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupSvcDrpcClient(svc, 0, getMockDrpcClient(&mgmtpb.ContCreateResp{ContUuid: mockUUID}, nil))
+			},
+			req: validContCreateReq(),
+			expResp: &mgmtpb.ContCreateResp{
+				ContUuid: mockUUID,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.createMS == nil {
+				tc.createMS = newTestMgmtSvc
+			}
+			svc := tc.createMS(t, log)
+			addTestPoolService(t, svc.sysdb, testPoolService())
+
+			if tc.setupDrpc != nil {
+				tc.setupDrpc(t, svc)
+			}
+
+			resp, err := svc.ContCreate(test.Context(t), tc.req)
+
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("(-want, +got): \n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestMgmt_ContDestroy(t *testing.T) {
+	validContDestroyReq := func() *mgmtpb.ContDestroyReq {
+		return &mgmtpb.ContDestroyReq{
+			Sys:    build.DefaultSystemName,
+			ContId: "cont1",
+			PoolId: mockUUID,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		createMS  func(*testing.T, logging.Logger) *mgmtSvc
+		setupDrpc func(*testing.T, *mgmtSvc)
+		req       *mgmtpb.ContDestroyReq
+		expResp   *mgmtpb.DaosResp
+		expErr    error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"pool svc not found": {
+			req: &mgmtpb.ContDestroyReq{
+				Sys:    build.DefaultSystemName,
+				ContId: "cont1",
+				PoolId: "fake",
+			},
+			expErr: errors.New("unable to find pool"),
+		},
+		"harness not started": {
+			createMS: func(t *testing.T, log logging.Logger) *mgmtSvc {
+				db := raft.MockDatabase(t, log)
+				ms := system.MockMembership(t, log, db, mockTCPResolver)
+				return newMgmtSvc(NewEngineHarness(log), ms, db, nil,
+					events.NewPubSub(test.Context(t), log))
+			},
+			req:    validContDestroyReq(),
+			expErr: FaultHarnessNotStarted,
+		},
+		"drpc error": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupSvcDrpcClient(svc, 0, getMockDrpcClient(nil, errors.New("mock drpc")))
+			},
+			req:    validContDestroyReq(),
+			expErr: errors.New("mock drpc"),
+		},
+		"bad drpc resp": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				badBytes := makeBadBytes(16)
+				setupSvcDrpcClient(svc, 0, getMockDrpcClientBytes(badBytes, nil))
+			},
+			req:    validContDestroyReq(),
+			expErr: errors.New("unmarshal"),
+		},
+		"success": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupSvcDrpcClient(svc, 0, getMockDrpcClient(&mgmtpb.DaosResp{}, nil))
+			},
+			req:     validContDestroyReq(),
+			expResp: &mgmtpb.DaosResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.createMS == nil {
+				tc.createMS = newTestMgmtSvc
+			}
+			svc := tc.createMS(t, log)
+			addTestPoolService(t, svc.sysdb, testPoolService())
+
+			if tc.setupDrpc != nil {
+				tc.setupDrpc(t, svc)
+			}
+
+			resp, err := svc.ContDestroy(test.Context(t), tc.req)
+
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("(-want, +got): \n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestMgmt_ContQuery(t *testing.T) {
+	validContQueryReq := func() *mgmtpb.ContQueryReq {
+		return &mgmtpb.ContQueryReq{
+			Sys:    build.DefaultSystemName,
+			ContId: "cont1",
+			PoolId: mockUUID,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		createMS  func(*testing.T, logging.Logger) *mgmtSvc
+		setupDrpc func(*testing.T, *mgmtSvc)
+		req       *mgmtpb.ContQueryReq
+		expResp   *mgmtpb.ContQueryResp
+		expErr    error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"pool svc not found": {
+			req: &mgmtpb.ContQueryReq{
+				Sys:    build.DefaultSystemName,
+				ContId: "cont1",
+				PoolId: "fake",
+			},
+			expErr: errors.New("unable to find pool"),
+		},
+		"harness not started": {
+			createMS: func(t *testing.T, log logging.Logger) *mgmtSvc {
+				db := raft.MockDatabase(t, log)
+				ms := system.MockMembership(t, log, db, mockTCPResolver)
+				return newMgmtSvc(NewEngineHarness(log), ms, db, nil,
+					events.NewPubSub(test.Context(t), log))
+			},
+			req:    validContQueryReq(),
+			expErr: FaultHarnessNotStarted,
+		},
+		"drpc error": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupSvcDrpcClient(svc, 0, getMockDrpcClient(nil, errors.New("mock drpc")))
+			},
+			req:    validContQueryReq(),
+			expErr: errors.New("mock drpc"),
+		},
+		"bad drpc resp": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				badBytes := makeBadBytes(16)
+				setupSvcDrpcClient(svc, 0, getMockDrpcClientBytes(badBytes, nil))
+			},
+			req:    validContQueryReq(),
+			expErr: errors.New("unmarshal"),
+		},
+		"success": {
+			setupDrpc: func(t *testing.T, svc *mgmtSvc) {
+				setupSvcDrpcClient(svc, 0, getMockDrpcClient(&mgmtpb.ContQueryResp{Uuid: mockUUID, Label: "cont1"}, nil))
+			},
+			req: validContQueryReq(),
+			expResp: &mgmtpb.ContQueryResp{
+				Uuid:  mockUUID,
+				Label: "cont1",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.createMS == nil {
+				tc.createMS = newTestMgmtSvc
+			}
+			svc := tc.createMS(t, log)
+			addTestPoolService(t, svc.sysdb, testPoolService())
+
+			if tc.setupDrpc != nil {
+				tc.setupDrpc(t, svc)
+			}
+
+			resp, err := svc.ContQuery(test.Context(t), tc.req)
+
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("(-want, +got): \n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -48,6 +49,7 @@ func (mod *mgmtModule) ID() drpc.ModuleID {
 // a system pool database.
 type poolDatabase interface {
 	FindPoolServiceByLabel(string) (*system.PoolService, error)
+	FindPoolServiceByAlias(string) (*system.PoolService, error)
 	FindPoolServiceByUUID(uuid.UUID) (*system.PoolService, error)
 	PoolServiceList(bool) ([]*system.PoolService, error)
 	AddPoolService(context.Context, *system.PoolService) error
@@ -64,6 +66,7 @@ type srvModule struct {
 	checkerDB checker.FindingStore
 	engines   []Engine
 	events    *events.PubSub
+	devFaults *devFaultTracker
 }
 
 // newSrvModule creates a new srv module references to the system database,
@@ -78,6 +81,13 @@ func newSrvModule(log logging.Logger, pdb poolDatabase, cdb checker.FindingStore
 	}
 }
 
+// WithDevFaultTracker sets the tracker used to annotate device set-faulty events
+// raised by the engine.
+func (mod *srvModule) WithDevFaultTracker(dft *devFaultTracker) *srvModule {
+	mod.devFaults = dft
+	return mod
+}
+
 // HandleCall is the handler for calls to the srvModule.
 func (mod *srvModule) HandleCall(ctx context.Context, session *drpc.Session, method drpc.Method, req []byte) (_ []byte, err error) {
 	defer func() {
@@ -157,6 +167,10 @@ func (mod *srvModule) handlePoolFindByLabel(reqb []byte) ([]byte, error) {
 	resp := new(srvpb.PoolFindByLabelResp)
 
 	ps, err := mod.poolDB.FindPoolServiceByLabel(req.GetLabel())
+	if system.IsPoolNotFound(err) {
+		// Clients may connect to a pool by one of its aliases.
+		ps, err = mod.poolDB.FindPoolServiceByAlias(req.GetLabel())
+	}
 	if err != nil || ps.State != system.PoolServiceStateReady {
 		resp.Status = int32(daos.Nonexistent)
 		mod.log.Debugf("PoolFindByLabelResp: %+v", resp)
@@ -196,6 +210,8 @@ func (mod *srvModule) handleClusterEvent(reqb []byte) ([]byte, error) {
 		return nil, drpc.UnmarshalingPayloadFailure()
 	}
 
+	mod.devFaults.annotate(req.GetEvent())
+
 	resp, err := mod.events.HandleClusterEvent(req, false)
 	if err != nil {
 		return nil, errors.Wrapf(err, "handle cluster event %+v", req)
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -369,6 +370,22 @@ func TestSrvModule_handlePoolFindByLabel(t *testing.T) {
 				Svcreps: []uint32{0, 1, 2},
 			}),
 		},
+		"found by alias": {
+			reqBytes: getTestBytes(t, &srvpb.PoolFindByLabelReq{
+				Label: "testalias",
+			}),
+			testPool: &system.PoolService{
+				PoolUUID:  test.MockPoolUUID(),
+				PoolLabel: "testlabel",
+				Aliases:   []string{"testalias"},
+				State:     system.PoolServiceStateReady,
+				Replicas:  []ranklist.Rank{0, 1, 2},
+			},
+			expResp: getTestBytes(t, &srvpb.PoolFindByLabelResp{
+				Uuid:    test.MockPoolUUID().String(),
+				Svcreps: []uint32{0, 1, 2},
+			}),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			ctx := test.Context(t)
@@ -0,0 +1,83 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+// eventRecordInterval is the interval at which events received by the MS
+// leader are recorded in the system database.
+const eventRecordInterval = time.Second
+
+type (
+	// eventAdder is implemented by the system database.
+	eventAdder interface {
+		AddEvents([]*events.RASEvent) error
+	}
+
+	// eventRecorder accumulates the events received by the MS leader so that
+	// they can be recorded in the system database as a single batch rather
+	// than as one raft log entry per event.
+	eventRecorder struct {
+		sync.Mutex
+		pending []*events.RASEvent
+		dropped int
+	}
+)
+
+func newEventRecorder() *eventRecorder {
+	return &eventRecorder{}
+}
+
+// OnEvent implements events.Handler. Only the most recent events are kept
+// while waiting to be recorded, as the system database retains no more than
+// raft.MaxEventRecords anyway. Pool membership changes are recorded when they
+// are published, so they are skipped here.
+func (er *eventRecorder) OnEvent(_ context.Context, evt *events.RASEvent) {
+	if evt.ID == events.RASPoolMembershipChanged {
+		return
+	}
+
+	er.Lock()
+	defer er.Unlock()
+
+	er.pending = append(er.pending, evt)
+	if excess := len(er.pending) - raft.MaxEventRecords; excess > 0 {
+		er.pending = er.pending[excess:]
+		er.dropped += excess
+	}
+}
+
+// reset discards any events waiting to be recorded.
+func (er *eventRecorder) reset() {
+	er.Lock()
+	defer er.Unlock()
+
+	er.pending = nil
+	er.dropped = 0
+}
+
+// flush records the pending events in the database. The number of events
+// discarded since the last flush is returned along with any error.
+func (er *eventRecorder) flush(db eventAdder) (int, error) {
+	er.Lock()
+	pending, dropped := er.pending, er.dropped
+	er.pending, er.dropped = nil, 0
+	er.Unlock()
+
+	if len(pending) == 0 {
+		return dropped, nil
+	}
+
+	return dropped, db.AddEvents(pending)
+}
@@ -0,0 +1,109 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+type mockEventAdder struct {
+	batches [][]*events.RASEvent
+	err     error
+}
+
+func (m *mockEventAdder) AddEvents(evts []*events.RASEvent) error {
+	m.batches = append(m.batches, evts)
+	return m.err
+}
+
+func TestServer_eventRecorder(t *testing.T) {
+	newEvent := func(i int) *events.RASEvent {
+		return events.NewGenericEvent(events.RASUnknownEvent, events.RASSeverityNotice,
+			fmt.Sprintf("event %d", i), "")
+	}
+
+	for name, tc := range map[string]struct {
+		numEvents   int
+		membership  bool
+		reset       bool
+		adderErr    error
+		expBatches  int
+		expBatchLen int
+		expFirstMsg string
+		expDropped  int
+		expErr      error
+	}{
+		"no events": {},
+		"single batch": {
+			numEvents:   10,
+			expBatches:  1,
+			expBatchLen: 10,
+			expFirstMsg: "event 0",
+		},
+		"oldest events discarded": {
+			numEvents:   raft.MaxEventRecords + 5,
+			expBatches:  1,
+			expBatchLen: raft.MaxEventRecords,
+			expFirstMsg: "event 5",
+			expDropped:  5,
+		},
+		"reset discards pending events": {
+			numEvents: 10,
+			reset:     true,
+		},
+		"pool membership changes skipped": {
+			numEvents:  10,
+			membership: true,
+		},
+		"add fails": {
+			numEvents:   1,
+			adderErr:    errors.New("not leader"),
+			expBatches:  1,
+			expBatchLen: 1,
+			expFirstMsg: "event 0",
+			expErr:      errors.New("not leader"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			er := newEventRecorder()
+			for i := 0; i < tc.numEvents; i++ {
+				evt := newEvent(i)
+				if tc.membership {
+					evt = events.NewPoolMembershipChangedEvent(test.MockUUID(1), "extend")
+				}
+				er.OnEvent(context.Background(), evt)
+			}
+			if tc.reset {
+				er.reset()
+			}
+
+			db := &mockEventAdder{err: tc.adderErr}
+			dropped, err := er.flush(db)
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expDropped, dropped, "unexpected dropped count")
+			test.AssertEqual(t, tc.expBatches, len(db.batches), "unexpected number of batches")
+			if tc.expBatches > 0 {
+				test.AssertEqual(t, tc.expBatchLen, len(db.batches[0]), "unexpected batch length")
+				test.AssertEqual(t, tc.expFirstMsg, db.batches[0][0].Msg, "unexpected first event")
+			}
+
+			// Events are only recorded once.
+			if _, err := er.flush(db); err != nil && tc.adderErr == nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expBatches, len(db.batches), "pending events not cleared")
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -187,3 +188,36 @@ func (svc *mgmtSvc) FaultInjectPoolFault(parent context.Context, fault *chkpb.Fa
 
 	return resp, nil
 }
+
+// FaultInjectEngine sets a fault injection point on the requested engine rank,
+// or on all ranks if no rank is specified. The request is forwarded to a local
+// engine, which propagates it to the other ranks.
+func (svc *mgmtSvc) FaultInjectEngine(ctx context.Context, req *mgmtpb.FaultInjectEngineReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if rank := ranklist.Rank(req.Rank); !rank.Equals(ranklist.NilRank) {
+		if _, err := svc.membership.Get(rank); err != nil {
+			return nil, err
+		}
+	}
+
+	svc.log.Debugf("injecting engine fault: fail_loc %#x, value %d, num %d, rank %d",
+		req.FailLoc, req.FailValue, req.FailNum, req.Rank)
+	dresp, err := svc.harness.CallDrpc(ctx, drpc.MethodFaultInject, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.DaosResp)
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal FaultInject response")
+	}
+
+	if resp.GetStatus() != 0 {
+		return nil, errors.Wrap(daos.Status(resp.Status), "engine fault injection failed")
+	}
+
+	return resp, nil
+}
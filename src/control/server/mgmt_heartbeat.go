@@ -0,0 +1,215 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// agentHeartbeatStaleFactor is the number of consecutive heartbeats that an
+// agent must miss before its machine is considered stale.
+const agentHeartbeatStaleFactor = 3
+
+type (
+	agentHeartbeat struct {
+		lastSeen     time.Time
+		interval     time.Duration
+		version      string
+		fabricIfaces []string
+		clientCount  uint32
+	}
+
+	// agentHeartbeatTracker records the heartbeats received from the agents
+	// on client machines. Heartbeats are only sent to the MS leader, so the
+	// records are reset when leadership is gained.
+	agentHeartbeatTracker struct {
+		sync.Mutex
+		agents map[string]*agentHeartbeat
+		now    func() time.Time
+	}
+)
+
+func newAgentHeartbeatTracker() *agentHeartbeatTracker {
+	return &agentHeartbeatTracker{
+		agents: make(map[string]*agentHeartbeat),
+		now:    time.Now,
+	}
+}
+
+func (hb *agentHeartbeat) isStale(now time.Time) bool {
+	return now.Sub(hb.lastSeen) > agentHeartbeatStaleFactor*hb.interval
+}
+
+// record records a heartbeat from the agent on the machine.
+func (t *agentHeartbeatTracker) record(machine string, hb *agentHeartbeat) {
+	t.Lock()
+	defer t.Unlock()
+
+	hb.lastSeen = t.now()
+	t.agents[machine] = hb
+}
+
+// reset discards all recorded heartbeats.
+func (t *agentHeartbeatTracker) reset() {
+	t.Lock()
+	defer t.Unlock()
+
+	t.agents = make(map[string]*agentHeartbeat)
+}
+
+// isStale returns true if the agent on the machine has missed enough heartbeats
+// to be considered stale. The second return value is false if no heartbeat has
+// been recorded for the machine, in which case its state is unknown.
+func (t *agentHeartbeatTracker) isStale(machine string) (stale bool, known bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	hb, found := t.agents[machine]
+	if !found {
+		return false, false
+	}
+
+	return hb.isStale(t.now()), true
+}
+
+// list returns the machines from which heartbeats have been recorded, sorted
+// by machine name.
+func (t *agentHeartbeatTracker) list() []*mgmtpb.SystemListClientsResp_Client {
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.now()
+	clients := make([]*mgmtpb.SystemListClientsResp_Client, 0, len(t.agents))
+	for machine, hb := range t.agents {
+		clients = append(clients, &mgmtpb.SystemListClientsResp_Client{
+			Machine:          machine,
+			Version:          hb.version,
+			FabricInterfaces: hb.fabricIfaces,
+			ClientCount:      hb.clientCount,
+			LastSeen:         hb.lastSeen.Unix(),
+			Interval:         uint32(hb.interval / time.Second),
+			Stale:            hb.isStale(now),
+		})
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].Machine < clients[j].Machine
+	})
+
+	return clients
+}
+
+// AgentHeartbeat implements the method defined for the Management Service.
+//
+// Record a heartbeat from the agent on a client machine.
+func (svc *mgmtSvc) AgentHeartbeat(ctx context.Context, req *mgmtpb.AgentHeartbeatReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.GetMachine() == "" {
+		return nil, errors.New("AgentHeartbeat requires a machine name")
+	}
+	if req.GetInterval() == 0 {
+		return nil, errors.New("AgentHeartbeat requires a heartbeat interval")
+	}
+
+	svc.agentHeartbeats.record(req.GetMachine(), &agentHeartbeat{
+		interval:     time.Duration(req.GetInterval()) * time.Second,
+		version:      req.GetVersion(),
+		fabricIfaces: req.GetFabricInterfaces(),
+		clientCount:  req.GetClientCount(),
+	})
+
+	return new(mgmtpb.DaosResp), nil
+}
+
+// SystemListClients implements the method defined for the Management Service.
+//
+// List the client machines whose agents have sent heartbeats to this MS leader.
+func (svc *mgmtSvc) SystemListClients(ctx context.Context, req *mgmtpb.SystemListClientsReq) (*mgmtpb.SystemListClientsResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.SystemListClientsResp{
+		Clients: svc.agentHeartbeats.list(),
+	}, nil
+}
+
+// cleanupStaleMachines evicts the pool handles held by machines whose agents
+// have stopped sending heartbeats, or only lists them in a dry run. Machines
+// with pool handles that have never sent a heartbeat to this MS leader are
+// reported as unknown and are left alone.
+func (svc *mgmtSvc) cleanupStaleMachines(ctx context.Context, req *mgmtpb.SystemCleanupReq) (*mgmtpb.SystemCleanupResp, error) {
+	psList, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.SystemCleanupResp)
+	unknown := make(map[string]struct{})
+
+	for _, ps := range psList {
+		counts, err := svc.countPoolHandles(ctx, req.GetSys(), ps.PoolUUID)
+		if err != nil {
+			svc.log.Errorf("pool %s: unable to list open handles: %s", ps.PoolUUID, err)
+			resp.Results = append(resp.Results, &mgmtpb.SystemCleanupResp_CleanupResult{
+				Status: int32(daos.MiscError),
+				Msg:    fmt.Sprintf("Unable to list open handles on pool %s: %s", ps.PoolUUID, err),
+				PoolId: ps.PoolUUID.String(),
+			})
+			continue
+		}
+
+		machines := make([]string, 0, len(counts))
+		for machine := range counts {
+			machines = append(machines, machine)
+		}
+		sort.Strings(machines)
+
+		for _, machine := range machines {
+			stale, known := svc.agentHeartbeats.isStale(machine)
+			if !known {
+				unknown[machine] = struct{}{}
+			}
+			if !stale {
+				continue
+			}
+
+			if req.GetDryRun() {
+				resp.Results = append(resp.Results, &mgmtpb.SystemCleanupResp_CleanupResult{
+					PoolId:  ps.PoolUUID.String(),
+					Count:   counts[machine],
+					Machine: machine,
+				})
+				continue
+			}
+
+			result, err := svc.evictMachineHandles(ctx, req.GetSys(), machine, ps.PoolUUID.String())
+			if err != nil {
+				return nil, err
+			}
+			resp.Results = append(resp.Results, result)
+		}
+	}
+
+	for machine := range unknown {
+		resp.UnknownMachines = append(resp.UnknownMachines, machine)
+	}
+	sort.Strings(resp.UnknownMachines)
+
+	return resp, nil
+}
@@ -0,0 +1,315 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestServer_agentHeartbeatTracker(t *testing.T) {
+	now := time.Now()
+	tracker := newAgentHeartbeatTracker()
+	tracker.now = func() time.Time { return now }
+
+	checkStale := func(machine string, expStale, expKnown bool) {
+		t.Helper()
+
+		stale, known := tracker.isStale(machine)
+		test.AssertEqual(t, expStale, stale, "unexpected stale state for "+machine)
+		test.AssertEqual(t, expKnown, known, "unexpected known state for "+machine)
+	}
+
+	checkStale("client1", false, false)
+
+	tracker.record("client1", &agentHeartbeat{interval: time.Minute})
+	checkStale("client1", false, true)
+
+	now = now.Add(agentHeartbeatStaleFactor * time.Minute)
+	checkStale("client1", false, true)
+
+	now = now.Add(time.Second)
+	checkStale("client1", true, true)
+
+	tracker.record("client1", &agentHeartbeat{interval: time.Minute})
+	checkStale("client1", false, true)
+
+	tracker.reset()
+	checkStale("client1", false, false)
+}
+
+func TestServer_MgmtSvc_AgentHeartbeat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *mgmtpb.AgentHeartbeatReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"missing machine": {
+			req:    &mgmtpb.AgentHeartbeatReq{Interval: 60},
+			expErr: errors.New("requires a machine name"),
+		},
+		"missing interval": {
+			req:    &mgmtpb.AgentHeartbeatReq{Machine: "client1"},
+			expErr: errors.New("requires a heartbeat interval"),
+		},
+		"success": {
+			req: &mgmtpb.AgentHeartbeatReq{
+				Machine:          "client1",
+				Interval:         60,
+				Version:          "2.6.0",
+				FabricInterfaces: []string{"ib0", "ib1"},
+				ClientCount:      3,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.req != nil {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			_, gotErr := svc.AgentHeartbeat(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			expClients := []*mgmtpb.SystemListClientsResp_Client{
+				{
+					Machine:          tc.req.Machine,
+					Version:          tc.req.Version,
+					FabricInterfaces: tc.req.FabricInterfaces,
+					ClientCount:      tc.req.ClientCount,
+					Interval:         tc.req.Interval,
+				},
+			}
+			cmpOpts := append(test.DefaultCmpOpts(),
+				protocmp.IgnoreFields(&mgmtpb.SystemListClientsResp_Client{}, "last_seen"))
+			if diff := cmp.Diff(expClients, svc.agentHeartbeats.list(), cmpOpts...); diff != "" {
+				t.Fatalf("unexpected clients (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemListClients(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+
+	now := time.Unix(1735787045, 0)
+	svc.agentHeartbeats.now = func() time.Time { return now.Add(-time.Hour) }
+	svc.agentHeartbeats.record("client2", &agentHeartbeat{
+		interval: time.Minute,
+		version:  "2.4.0",
+	})
+	svc.agentHeartbeats.now = func() time.Time { return now }
+	svc.agentHeartbeats.record("client1", &agentHeartbeat{
+		interval:     time.Minute,
+		version:      "2.6.0",
+		fabricIfaces: []string{"ib0"},
+		clientCount:  2,
+	})
+
+	gotResp, gotErr := svc.SystemListClients(test.Context(t), &mgmtpb.SystemListClientsReq{
+		Sys: build.DefaultSystemName,
+	})
+	if gotErr != nil {
+		t.Fatal(gotErr)
+	}
+
+	expResp := &mgmtpb.SystemListClientsResp{
+		Clients: []*mgmtpb.SystemListClientsResp_Client{
+			{
+				Machine:          "client1",
+				Version:          "2.6.0",
+				FabricInterfaces: []string{"ib0"},
+				ClientCount:      2,
+				LastSeen:         now.Unix(),
+				Interval:         60,
+			},
+			{
+				Machine:  "client2",
+				Version:  "2.4.0",
+				LastSeen: now.Add(-time.Hour).Unix(),
+				Interval: 60,
+				Stale:    true,
+			},
+		},
+	}
+	if diff := cmp.Diff(expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+	}
+}
+
+func TestServer_MgmtSvc_SystemCleanup(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req         *mgmtpb.SystemCleanupReq
+		drpcResps   []*mockDrpcResponse
+		expResp     *mgmtpb.SystemCleanupResp
+		expDrpcReqs []drpc.Method
+		expErr      error
+	}{
+		"no machine": {
+			req:    &mgmtpb.SystemCleanupReq{},
+			expErr: errors.New("requires a machine name"),
+		},
+		"machine and all stale": {
+			req:    &mgmtpb.SystemCleanupReq{Machine: "client1", AllStale: true},
+			expErr: errors.New("mutually exclusive"),
+		},
+		"machine": {
+			req: &mgmtpb.SystemCleanupReq{Machine: "client1"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolEvictResp{Count: 2}},
+			},
+			expResp: &mgmtpb.SystemCleanupResp{
+				Results: []*mgmtpb.SystemCleanupResp_CleanupResult{
+					{PoolId: mockUUID, Count: 2, Machine: "client1"},
+				},
+			},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolEvict},
+		},
+		"machine; dry run": {
+			req: &mgmtpb.SystemCleanupReq{Machine: "client1", DryRun: true},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolListHandlesResp{
+					Machines: []string{"client1", "client2", "client1"},
+				}},
+			},
+			expResp: &mgmtpb.SystemCleanupResp{
+				Results: []*mgmtpb.SystemCleanupResp_CleanupResult{
+					{PoolId: mockUUID, Count: 2, Machine: "client1"},
+				},
+			},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolListHandles},
+		},
+		"all stale": {
+			req: &mgmtpb.SystemCleanupReq{AllStale: true},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolListHandlesResp{
+					Machines: []string{"stale1", "live1", "unknown1", "stale1"},
+				}},
+				{Message: &mgmtpb.PoolEvictResp{Count: 2}},
+			},
+			expResp: &mgmtpb.SystemCleanupResp{
+				Results: []*mgmtpb.SystemCleanupResp_CleanupResult{
+					{PoolId: mockUUID, Count: 2, Machine: "stale1"},
+				},
+				UnknownMachines: []string{"unknown1"},
+			},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolListHandles, drpc.MethodPoolEvict},
+		},
+		"all stale; dry run": {
+			req: &mgmtpb.SystemCleanupReq{AllStale: true, DryRun: true},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolListHandlesResp{
+					Machines: []string{"stale1", "live1", "unknown1", "stale1"},
+				}},
+			},
+			expResp: &mgmtpb.SystemCleanupResp{
+				Results: []*mgmtpb.SystemCleanupResp_CleanupResult{
+					{PoolId: mockUUID, Count: 2, Machine: "stale1"},
+				},
+				UnknownMachines: []string{"unknown1"},
+			},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolListHandles},
+		},
+		"all stale; handles with unknown machine": {
+			req: &mgmtpb.SystemCleanupReq{AllStale: true, DryRun: true},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolListHandlesResp{
+					Machines: []string{"stale1", ""},
+					Handles: []*mgmtpb.PoolHandle{
+						{Uuid: test.MockUUID(11), Machine: "stale1"},
+						{Uuid: test.MockUUID(12)},
+						{Uuid: test.MockUUID(13)},
+					},
+				}},
+			},
+			expResp: &mgmtpb.SystemCleanupResp{
+				Results: []*mgmtpb.SystemCleanupResp_CleanupResult{
+					{PoolId: mockUUID, Count: 1, Machine: "stale1"},
+				},
+			},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolListHandles},
+		},
+		"all stale; list handles fails": {
+			req: &mgmtpb.SystemCleanupReq{AllStale: true},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolListHandlesResp{Status: int32(daos.Busy)}},
+			},
+			expResp: &mgmtpb.SystemCleanupResp{
+				Results: []*mgmtpb.SystemCleanupResp_CleanupResult{
+					{
+						PoolId: mockUUID,
+						Status: int32(daos.MiscError),
+						Msg:    "Unable to list open handles on pool " + mockUUID + ": " + daos.Busy.Error(),
+					},
+				},
+			},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolListHandles},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, mockUUID)
+
+			now := time.Now()
+			svc.agentHeartbeats.now = func() time.Time { return now.Add(-time.Hour) }
+			svc.agentHeartbeats.record("stale1", &agentHeartbeat{interval: time.Minute})
+			svc.agentHeartbeats.now = func() time.Time { return now }
+			svc.agentHeartbeats.record("live1", &agentHeartbeat{interval: time.Minute})
+
+			cfg := new(mockDrpcClientConfig)
+			for _, mock := range tc.drpcResps {
+				cfg.setSendMsgResponseList(t, mock)
+			}
+			mdc := newMockDrpcClient(cfg)
+			setupSvcDrpcClient(svc, 0, mdc)
+
+			tc.req.Sys = build.DefaultSystemName
+			gotResp, gotErr := svc.SystemCleanup(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			var gotDrpcReqs []drpc.Method
+			for _, call := range mdc.calls.get() {
+				gotDrpcReqs = append(gotDrpcReqs, call.Method)
+			}
+			if diff := cmp.Diff(tc.expDrpcReqs, gotDrpcReqs); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
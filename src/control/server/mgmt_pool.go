@@ -8,8 +8,11 @@
 package server
 
 import (
+	"fmt"
 	"math/rand"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -18,15 +21,21 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/server/engine"
 	"github.com/daos-stack/daos/src/control/server/storage"
 	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
 )
 
 const (
@@ -95,7 +104,7 @@ func (svc *mgmtSvc) resolvePoolID(id string) (uuid.UUID, error) {
 	type lookupFn func(string) (*system.PoolService, error)
 	// Cycle through a list of lookup functions, returning the first one
 	// that succeeds in finding the pool, or an error if no pool is found.
-	for _, lookup := range []lookupFn{svc.sysdb.FindPoolServiceByLabel} {
+	for _, lookup := range []lookupFn{svc.sysdb.FindPoolServiceByLabel, svc.sysdb.FindPoolServiceByAlias} {
 		ps, err := lookup(id)
 		if err == nil {
 			return ps.PoolUUID, nil
@@ -105,6 +114,53 @@ func (svc *mgmtSvc) resolvePoolID(id string) (uuid.UUID, error) {
 	return uuid.Nil, system.ErrPoolLabelNotFound(id)
 }
 
+// checkPoolLabelUnused returns an error if the given label is the label or one
+// of the aliases of a pool other than the pool with the given UUID.
+func (svc *mgmtSvc) checkPoolLabelUnused(label string, poolUUID uuid.UUID) error {
+	for _, lookup := range []func(string) (*system.PoolService, error){
+		svc.sysdb.FindPoolServiceByLabel,
+		svc.sysdb.FindPoolServiceByAlias,
+	} {
+		found, err := lookup(label)
+		if err != nil {
+			if system.IsPoolNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if found.PoolUUID != poolUUID {
+			return FaultPoolDuplicateLabel(label)
+		}
+	}
+
+	return nil
+}
+
+// poolLabelsUnusedCheck returns a check which fails if any of the given labels
+// is the label or one of the aliases of a pool other than the one identified
+// by poolUUID. The check is run by the system database as the pool service
+// entry is written, so that no other pool can claim one of the labels between
+// the check and the write.
+func poolLabelsUnusedCheck(poolUUID uuid.UUID, labels ...string) raft.PoolServiceCheck {
+	return func(pools []*system.PoolService) error {
+		for _, ps := range pools {
+			if ps.PoolUUID == poolUUID {
+				continue
+			}
+			for _, label := range labels {
+				if label == "" {
+					continue
+				}
+				if ps.PoolLabel == label || slices.Contains(ps.Aliases, label) {
+					return FaultPoolDuplicateLabel(label)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 // getPoolService returns the pool service entry for the given UUID.
 func (svc *mgmtSvc) getPoolService(id string) (*system.PoolService, error) {
 	poolUUID, err := svc.resolvePoolID(id)
@@ -266,6 +322,26 @@ func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *mgmtpb.PoolCreateReq) (
 	return msg.(*mgmtpb.PoolCreateResp), nil
 }
 
+// PoolCreateStream creates a pool as PoolCreate does, sending updates of the
+// progress of the create in the stream before the final response. As with
+// PoolCreate, the final response carries the DAOS status of the create.
+func (svc *mgmtSvc) PoolCreateStream(req *mgmtpb.PoolCreateReq, stream mgmtpb.MgmtSvc_PoolCreateStreamServer) error {
+	ctx := streamProgress(stream.Context(), svc.log, func(p *sharedpb.Progress) error {
+		return stream.Send(&mgmtpb.PoolCreateStreamResp{
+			Update: &mgmtpb.PoolCreateStreamResp_Progress{Progress: p},
+		})
+	})
+
+	resp, err := svc.PoolCreate(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&mgmtpb.PoolCreateStreamResp{
+		Update: &mgmtpb.PoolCreateStreamResp_Resp{Resp: resp},
+	})
+}
+
 // poolCreate handles the actual pool creation request. This is separated from
 // PoolCreate() so that it can be called from the batch request handler.
 //
@@ -273,6 +349,10 @@ func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *mgmtpb.PoolCreateReq) (
 // are per-engine so need to be larger than (minimum_target_allocation *
 // target_count).
 func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq) (resp *mgmtpb.PoolCreateResp, err error) {
+	// Pool policies only restrict the properties set by the caller, not
+	// those added from templates or system properties.
+	reqPropNames := poolPropNames(req.GetProperties())
+	svc.poolCreateAddGroupTemplates(parent, req)
 	if err := svc.poolCreateAddSystemProps(req); err != nil {
 		return nil, err
 	}
@@ -332,8 +412,8 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		}
 
 		labelExists = true
-		if _, err := svc.sysdb.FindPoolServiceByLabel(poolLabel); err == nil {
-			return nil, FaultPoolDuplicateLabel(poolLabel)
+		if err := svc.checkPoolLabelUnused(poolLabel, uuid.Nil); err != nil {
+			return nil, err
 		}
 	}
 
@@ -341,11 +421,34 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		return nil, FaultPoolNoLabel
 	}
 
+	// Properties stored by the MS are applied to the pool service entry once
+	// the pool has been created, and are not passed to the engine.
+	mgmtProps, engineProps := splitMgmtPoolProps(req.GetProperties())
+	if err := setMgmtPoolProps(new(system.PoolService), mgmtProps); err != nil {
+		return nil, err
+	}
+	req.Properties = engineProps
+
+	// Placement options are evaluated by the MS and are not passed to the
+	// engine.
+	policy, err := system.ParsePoolPlacementPolicy(req.GetPlacementPolicy())
+	if err != nil {
+		return nil, err
+	}
+	excludeDomains := req.GetExcludeDomains()
+	usePlacement := policy != system.PoolPlacementDefault || excludeDomains != ""
+	if usePlacement && len(req.GetRanks()) > 0 {
+		return nil, errors.New("pool placement options may not be combined with an explicit rank list")
+	}
+	req.PlacementPolicy = ""
+	req.ExcludeDomains = ""
+
 	allRanks, err := svc.sysdb.MemberRanks(system.AvailableMemberFilter)
 	if err != nil {
 		return nil, err
 	}
 
+	var placementMembers system.Members
 	if len(req.GetRanks()) > 0 {
 		// If the request supplies a specific rank list, use it. Note that
 		// the rank list may include downed ranks, in which case the create
@@ -359,6 +462,39 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		}
 
 		req.Ranks = ranklist.RanksToUint32(reqRanks)
+	} else if usePlacement {
+		// Select the requested number of available ranks (or all of them)
+		// according to the placement options.
+		placementMembers, err = svc.membership.Members(nil, system.AvailableMemberFilter)
+		if err != nil {
+			return nil, err
+		}
+		placementMembers, err = system.ExcludeMembersByFaultDomain(placementMembers, excludeDomains)
+		if err != nil {
+			return nil, err
+		}
+
+		nRanks := len(placementMembers)
+		if req.GetNumRanks() > 0 {
+			nRanks = int(req.GetNumRanks())
+
+			if nRanks > len(placementMembers) {
+				return nil, FaultPoolInvalidNumRanks(nRanks, len(placementMembers))
+			}
+		}
+
+		placementMembers = system.OrderMembersByPlacement(placementMembers, policy)
+		if policy == system.PoolPlacementDefault {
+			rand.Shuffle(len(placementMembers), func(i, j int) {
+				placementMembers[i], placementMembers[j] = placementMembers[j], placementMembers[i]
+			})
+		}
+
+		req.Ranks = make([]uint32, nRanks)
+		for i := 0; i < nRanks; i++ {
+			req.Ranks[i] = placementMembers[i].Rank.Uint32()
+		}
+		sort.Slice(req.Ranks, func(i, j int) bool { return req.Ranks[i] < req.Ranks[j] })
 	} else {
 		// Otherwise, create the pool across the requested number of
 		// available ranks in the system (if the request does not
@@ -417,10 +553,32 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		return nil, err
 	}
 
+	checkPolicies, err := svc.checkPoolPolicies(req, reqPropNames)
+	if err != nil {
+		return nil, err
+	}
+
+	reportProgress(ctx, "checking storage capacity", 0, 0)
+	checkCapacity, err := svc.checkPoolCreateCapacity(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	ps = system.NewPoolService(poolUUID, req.TierBytes, req.MemRatio,
 		ranklist.RanksFromUint32(req.GetRanks()))
 	ps.PoolLabel = poolLabel
-	if err := svc.sysdb.AddPoolService(ctx, ps); err != nil {
+	ps.Owner = req.GetUser()
+	ps.OwnerGroup = req.GetUserGroup()
+	checkLabel := poolLabelsUnusedCheck(poolUUID, poolLabel)
+	if err := svc.sysdb.AddPoolServiceChecked(ctx, ps, func(pools []*system.PoolService) error {
+		if err := checkLabel(pools); err != nil {
+			return err
+		}
+		if err := checkPolicies(pools); err != nil {
+			return err
+		}
+		return checkCapacity(pools)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -461,6 +619,7 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		}
 	}()
 
+	reportProgress(ctx, fmt.Sprintf("creating pool on %d ranks", len(req.GetRanks())), 0, 0)
 	dResp, err := svc.harness.CallDrpc(ctx, drpc.MethodPoolCreate, req)
 	if err != nil {
 		svc.log.Errorf("pool create dRPC call failed: %s", err)
@@ -493,10 +652,20 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 
 	ps.Replicas = ranklist.RanksFromUint32(resp.GetSvcReps())
 	ps.State = system.PoolServiceStateReady
+	ps.CreationTime = time.Now()
+	if err := setMgmtPoolProps(ps, mgmtProps); err != nil {
+		return nil, err
+	}
 	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
 		return nil, err
 	}
 
+	if usePlacement {
+		resp.PlacementPolicy = string(policy)
+		resp.TgtFaultDomains = system.MemberFaultDomains(placementMembers,
+			ranklist.RanksFromUint32(req.GetRanks()))
+	}
+
 	return resp, nil
 }
 
@@ -535,18 +704,149 @@ func (svc *mgmtSvc) poolCreateAddSystemProps(req *mgmtpb.PoolCreateReq) error {
 		if _, found := poolSetProps[k]; found {
 			continue
 		}
-		pbProp := &mgmtpb.PoolProperty{
-			Number: p.Number,
+		req.Properties = append(req.Properties, poolPropToPB(p))
+	}
+
+	return nil
+}
+
+func poolPropToPB(p *daos.PoolProperty) *mgmtpb.PoolProperty {
+	pbProp := &mgmtpb.PoolProperty{
+		Number: p.Number,
+	}
+	if nv, err := p.Value.GetNumber(); err == nil {
+		pbProp.SetValueNumber(nv)
+	} else {
+		pbProp.SetValueString(p.Value.String())
+	}
+	return pbProp
+}
+
+// poolGroupTemplate holds the parsed default properties and ACL entries for
+// pools created by members of a group.
+type poolGroupTemplate struct {
+	group string
+	props []*daos.PoolProperty
+	acl   []string
+}
+
+// newPoolGroupTemplates parses the group-based pool templates from the server
+// configuration.
+func newPoolGroupTemplates(cfgs []*config.PoolGroupTemplate) ([]*poolGroupTemplate, error) {
+	templates := make([]*poolGroupTemplate, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		props, err := cfg.PoolProperties()
+		if err != nil {
+			return nil, errors.Wrapf(err, "pool template for group %q", cfg.Group)
+		}
+
+		tmpl := &poolGroupTemplate{
+			group: cfg.Group,
+			props: props,
 		}
-		if nv, err := p.Value.GetNumber(); err == nil {
-			pbProp.SetValueNumber(nv)
-		} else {
-			pbProp.SetValueString(p.Value.String())
+		if len(cfg.ACL) > 0 {
+			result, err := control.ValidateACL(strings.NewReader(strings.Join(cfg.ACL, "\n")))
+			if err != nil {
+				return nil, errors.Wrapf(err, "pool template for group %q", cfg.Group)
+			}
+			if !result.Valid() {
+				return nil, errors.Errorf("pool template for group %q: invalid acl entry %q: %s",
+					cfg.Group, result.Errors[0].Entry, result.Errors[0].Error)
+			}
+			tmpl.acl = result.ACL.Entries
 		}
-		req.Properties = append(req.Properties, pbProp)
+		templates = append(templates, tmpl)
 	}
 
-	return nil
+	return templates, nil
+}
+
+// acePrincipalKey returns a key identifying the principal of an ACE in short
+// string format, e.g. "A:G:hpc@:rw". User and group principals with the same
+// name have different keys.
+func acePrincipalKey(ace string) string {
+	fields := strings.Split(ace, ":")
+	if len(fields) != 4 {
+		return ace
+	}
+	if strings.Contains(fields[1], "G") {
+		return "g:" + fields[2]
+	}
+	return "u:" + fields[2]
+}
+
+// poolCreateAddGroupTemplates applies the pool templates for the groups of the
+// pool owner to the request. The owner's groups are the owner group and, if a
+// group resolver is configured, the directory groups of the owner user.
+// Properties and ACL entries set in the request take precedence over those in
+// the templates, and templates are applied in the order in which they are
+// configured.
+func (svc *mgmtSvc) poolCreateAddGroupTemplates(ctx context.Context, req *mgmtpb.PoolCreateReq) {
+	if len(svc.poolTemplates) == 0 {
+		return
+	}
+
+	groups := common.NewStringSet()
+	if group := strings.TrimSuffix(req.GetUserGroup(), "@"); group != "" {
+		groups.Add(group)
+	}
+	if user := strings.TrimSuffix(req.GetUser(), "@"); user != "" && svc.groupResolver != nil {
+		resolved, err := svc.groupResolver.ResolveGroups(ctx, user)
+		if err != nil {
+			svc.log.Errorf("unable to resolve groups for pool owner %s: %s", req.GetUser(), err)
+		}
+		groups.Add(resolved...)
+	}
+
+	setProps := make(map[uint32]struct{})
+	for _, p := range req.GetProperties() {
+		setProps[p.GetNumber()] = struct{}{}
+	}
+	setPrincipals := make(map[string]struct{})
+	for _, ace := range req.GetAcl() {
+		setPrincipals[acePrincipalKey(ace)] = struct{}{}
+	}
+
+	var addedACL []string
+	for _, tmpl := range svc.poolTemplates {
+		if !groups.Has(tmpl.group) {
+			continue
+		}
+		svc.log.Debugf("applying pool template for group %q to pool %s", tmpl.group, req.GetUuid())
+
+		for _, p := range tmpl.props {
+			if _, found := setProps[p.Number]; found {
+				continue
+			}
+			setProps[p.Number] = struct{}{}
+			req.Properties = append(req.Properties, poolPropToPB(p))
+		}
+
+		for _, ace := range tmpl.acl {
+			key := acePrincipalKey(ace)
+			if _, found := setPrincipals[key]; found {
+				continue
+			}
+			setPrincipals[key] = struct{}{}
+			addedACL = append(addedACL, ace)
+		}
+	}
+
+	if len(addedACL) == 0 {
+		return
+	}
+
+	// A pool created without an ACL is given a default ACL granting access
+	// to the owner user and group. Keep these entries when adding template
+	// entries to an empty ACL, unless a template defines them.
+	if len(req.GetAcl()) == 0 {
+		for _, ace := range []string{"A::OWNER@:rw", "A:G:GROUP@:rw"} {
+			if _, found := setPrincipals[acePrincipalKey(ace)]; !found {
+				req.Acl = append(req.Acl, ace)
+			}
+		}
+	}
+	req.Acl = append(req.Acl, addedACL...)
 }
 
 // checkPools iterates over the list of pools in the system to check
@@ -686,6 +986,11 @@ func (svc *mgmtSvc) poolDestroyNoLeaderCheck(parent context.Context, req *mgmtpb
 	if err != nil {
 		return nil, err
 	}
+	// Protection is only applied once the pool is ready, so a pool that
+	// failed to be created can always be cleaned up.
+	if ps.DestroyProtect && ps.State == system.PoolServiceStateReady {
+		return nil, FaultPoolDestroyProtected(req.Id)
+	}
 	req.SetUUID(poolUUID)
 	req.SvcRanks = ranklist.RanksToUint32(ps.Replicas)
 
@@ -711,9 +1016,14 @@ func (svc *mgmtSvc) poolDestroyNoLeaderCheck(parent context.Context, req *mgmtpb
 		}
 
 		// Perform separate PoolEvict _before_ possible transition to destroying state.
-		evStatus, err := svc.poolEvictConnections(ctx, req)
-		if !req.Force && err != nil {
-			return nil, err
+		// A pool that is still being created has no connections to evict and its
+		// service is not ready to handle the request, so the evict is skipped.
+		evStatus := daos.Success
+		if ps.State != system.PoolServiceStateCreating {
+			evStatus, err = svc.poolEvictConnections(ctx, req)
+			if !req.Force && err != nil {
+				return nil, err
+			}
 		}
 
 		// If the request is being forced, or the evict request did not fail
@@ -810,6 +1120,10 @@ func (svc *mgmtSvc) PoolEvict(ctx context.Context, req *mgmtpb.PoolEvictReq) (*m
 		return nil, err
 	}
 
+	if req.User != "" || req.Group != "" || req.OlderThan > 0 {
+		return svc.evictFilteredPoolConnections(ctx, req)
+	}
+
 	if len(req.Handles) == 0 {
 		// If we're not evicting a set of handles, then we shouldn't bother with trying
 		// to batch up the requests from multiple agents.
@@ -823,6 +1137,88 @@ func (svc *mgmtSvc) PoolEvict(ctx context.Context, req *mgmtpb.PoolEvictReq) (*m
 	return msg.(*mgmtpb.PoolEvictResp), nil
 }
 
+// evictFilteredPoolConnections evicts the pool handles matching the user, group,
+// machine and age filters in the request. The filters are evaluated against the
+// open handles reported by the pool service, and only the matching handles are
+// passed on for eviction.
+func (svc *mgmtSvc) evictFilteredPoolConnections(ctx context.Context, req *mgmtpb.PoolEvictReq) (*mgmtpb.PoolEvictResp, error) {
+	if len(req.Handles) > 0 {
+		return nil, errors.New("pool evict filters may not be combined with a list of handles")
+	}
+	if req.Destroy {
+		return nil, errors.New("pool evict filters may not be combined with destroy")
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	handles, err := svc.listPoolHandles(ctx, req.GetSys(), poolUUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "pool %s: unable to list open handles", poolUUID)
+	}
+
+	now := time.Now()
+	matchPrincipal := func(filter, name string) bool {
+		return filter == "" || strings.TrimSuffix(filter, "@") == strings.TrimSuffix(name, "@")
+	}
+	var toEvict []string
+	for _, hdl := range handles {
+		if !matchPrincipal(req.User, hdl.GetUser()) || !matchPrincipal(req.Group, hdl.GetGroup()) {
+			continue
+		}
+		if req.Machine != "" && req.Machine != hdl.GetMachine() {
+			continue
+		}
+		if req.OlderThan > 0 {
+			// Evicting nothing would hide the fact that the age
+			// of the handle cannot be determined.
+			if hdl.GetConnectTime() == 0 {
+				return nil, errors.Errorf("pool %s: open time of handle %s is unknown, unable to apply age filter",
+					poolUUID, hdl.GetUuid())
+			}
+			connected := time.Unix(int64(hdl.GetConnectTime()), 0)
+			if now.Sub(connected) < time.Duration(req.OlderThan)*time.Second {
+				continue
+			}
+		}
+		toEvict = append(toEvict, hdl.GetUuid())
+	}
+
+	if len(toEvict) == 0 {
+		svc.log.Debugf("pool %s: no open handles matched the evict filters", poolUUID)
+		return new(mgmtpb.PoolEvictResp), nil
+	}
+
+	return svc.evictPoolConnections(ctx, &mgmtpb.PoolEvictReq{
+		Sys:      req.Sys,
+		Id:       req.Id,
+		SvcRanks: req.SvcRanks,
+		Handles:  toEvict,
+	})
+}
+
+// notifyPoolMembershipChanged publishes an event to notify interested parties,
+// e.g. agents caching attach info, that the target membership of the pool has
+// been changed by the given operation. The event is recorded in the system
+// database before it is published, rather than being batched by the event
+// recorder, so that it can't be dropped before agents have had a chance to
+// see it.
+func (svc *mgmtSvc) notifyPoolMembershipChanged(id, op string) {
+	poolUUID, err := svc.resolvePoolID(id)
+	if err != nil {
+		svc.log.Errorf("pool %s: failed to notify membership change: %s", id, err)
+		return
+	}
+
+	evt := events.NewPoolMembershipChangedEvent(poolUUID.String(), op)
+	if err := svc.sysdb.AddEvents([]*events.RASEvent{evt}); err != nil {
+		svc.log.Errorf("pool %s: failed to record membership change: %s", poolUUID, err)
+	}
+	svc.events.Publish(evt)
+}
+
 // PoolExclude implements the method defined for the Management Service.
 func (svc *mgmtSvc) PoolExclude(ctx context.Context, req *mgmtpb.PoolExcludeReq) (*mgmtpb.PoolExcludeResp, error) {
 	if err := svc.checkLeaderRequest(req); err != nil {
@@ -842,6 +1238,10 @@ func (svc *mgmtSvc) PoolExclude(ctx context.Context, req *mgmtpb.PoolExcludeReq)
 		return nil, err
 	}
 
+	if resp.Status == 0 {
+		svc.notifyPoolMembershipChanged(req.GetId(), "exclude")
+	}
+
 	return resp, nil
 }
 
@@ -864,6 +1264,10 @@ func (svc *mgmtSvc) PoolDrain(ctx context.Context, req *mgmtpb.PoolDrainReq) (*m
 		return nil, err
 	}
 
+	if resp.Status == 0 {
+		svc.notifyPoolMembershipChanged(req.GetId(), "drain")
+	}
+
 	return resp, nil
 }
 
@@ -901,6 +1305,10 @@ func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (
 		return nil, err
 	}
 
+	if resp.Status == 0 {
+		svc.notifyPoolMembershipChanged(req.GetId(), "extend")
+	}
+
 	return resp, nil
 }
 
@@ -946,6 +1354,60 @@ func (svc *mgmtSvc) PoolReintegrate(ctx context.Context, req *mgmtpb.PoolReintRe
 		return nil, err
 	}
 
+	if resp.Status == 0 {
+		svc.notifyPoolMembershipChanged(req.GetId(), "reintegrate")
+	}
+
+	return resp, nil
+}
+
+// PoolMembershipChanges returns the pool target membership changes recorded in
+// the system database after the requested sequence number, oldest first. As the
+// database only retains the most recent events, the response indicates whether
+// any events recorded after the requested sequence number have been discarded,
+// in which case the caller must assume that any pool may have changed.
+func (svc *mgmtSvc) PoolMembershipChanges(ctx context.Context, req *mgmtpb.PoolMembershipChangesReq) (*mgmtpb.PoolMembershipChangesResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Read the last sequence number first, so that a change recorded
+	// while the records are being filtered is reported again rather than
+	// being missed by the caller.
+	lastSeq, err := svc.sysdb.LastEventSeq()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for discarded events before filtering the records, so that a
+	// discard that races with the filtering is reported.
+	firstSeq, err := svc.sysdb.FirstEventSeq()
+	if err != nil {
+		return nil, err
+	}
+	afterSeq := req.GetAfterSeq()
+
+	recs, _, err := svc.sysdb.FilterEvents(&raft.EventFilter{
+		IDs:      []events.RASID{events.RASPoolMembershipChanged},
+		AfterSeq: afterSeq,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.PoolMembershipChangesResp{
+		LastSeq: lastSeq,
+		Resync:  afterSeq < lastSeq && afterSeq+1 < firstSeq,
+	}
+	for i := len(recs) - 1; i >= 0; i-- {
+		resp.Changes = append(resp.Changes, &mgmtpb.PoolMembershipChange{
+			Seq:       recs[i].Seq,
+			PoolUuid:  recs[i].PoolUUID,
+			Op:        recs[i].CtlOp,
+			Timestamp: common.FormatTime(recs[i].Timestamp),
+		})
+	}
+
 	return resp, nil
 }
 
@@ -972,15 +1434,31 @@ func (svc *mgmtSvc) PoolQuery(ctx context.Context, req *mgmtpb.PoolQueryReq) (*m
 	// Preserve compatibility with pre-2.6 callers.
 	resp.Leader = resp.SvcLdr
 
+	// The creation time is only known to the MS, and only for pools
+	// created since it was first recorded.
+	if ps, err := svc.getPoolService(req.GetId()); err == nil && !ps.CreationTime.IsZero() {
+		resp.CreateTime = uint64(ps.CreationTime.Unix())
+	}
+
 	return resp, nil
 }
 
 // PoolQueryTarget forwards a pool query targets request to the I/O Engine.
+//
+// If the targets of all ranks are requested, each rank in the pool is queried
+// in turn and only the target states are returned.
 func (svc *mgmtSvc) PoolQueryTarget(ctx context.Context, req *mgmtpb.PoolQueryTargetReq) (*mgmtpb.PoolQueryTargetResp, error) {
 	if err := svc.checkReplicaRequest(req); err != nil {
 		return nil, err
 	}
 
+	if req.GetAllRanks() {
+		return svc.poolQueryAllTargets(ctx, req)
+	}
+	if req.GetUnhealthyOnly() {
+		return nil, errors.New("unhealthy-only may only be requested for all ranks")
+	}
+
 	dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolQueryTarget, req)
 	if err != nil {
 		return nil, err
@@ -994,6 +1472,91 @@ func (svc *mgmtSvc) PoolQueryTarget(ctx context.Context, req *mgmtpb.PoolQueryTa
 	return resp, nil
 }
 
+// poolQueryAllTargets derives the ranks and the number of targets per rank from
+// a pool query, then queries the targets of each rank and collects their states.
+func (svc *mgmtSvc) poolQueryAllTargets(ctx context.Context, req *mgmtpb.PoolQueryTargetReq) (*mgmtpb.PoolQueryTargetResp, error) {
+	queryMask := daos.MustNewPoolQueryMask(daos.PoolQueryOptionEnabledEngines,
+		daos.PoolQueryOptionDisabledEngines)
+	pqReq := &mgmtpb.PoolQueryReq{
+		Sys:       req.GetSys(),
+		Id:        req.GetId(),
+		SvcRanks:  req.GetSvcRanks(),
+		QueryMask: uint64(queryMask),
+	}
+
+	dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolQuery, pqReq)
+	if err != nil {
+		return nil, err
+	}
+
+	pqResp := new(mgmtpb.PoolQueryResp)
+	if err := svc.unmarshalPB(dResp.Body, pqResp); err != nil {
+		return nil, err
+	}
+	if pqResp.GetStatus() != 0 {
+		return &mgmtpb.PoolQueryTargetResp{Status: pqResp.GetStatus()}, nil
+	}
+	if pqResp.GetTotalEngines() == 0 {
+		return nil, errors.New("pool query returned no engines")
+	}
+
+	ranks, err := ranklist.CreateRankSet(pqResp.GetEnabledRanks())
+	if err != nil {
+		return nil, errors.Wrap(err, "enabled ranks")
+	}
+	disabled, err := ranklist.CreateRankSet(pqResp.GetDisabledRanks())
+	if err != nil {
+		return nil, errors.Wrap(err, "disabled ranks")
+	}
+	ranks.Merge(disabled)
+
+	tgtCount := pqResp.GetTotalTargets() / pqResp.GetTotalEngines()
+	targets := make([]uint32, tgtCount)
+	for i := range targets {
+		targets[i] = uint32(i)
+	}
+
+	resp := new(mgmtpb.PoolQueryTargetResp)
+	for _, rank := range ranks.Ranks() {
+		tReq := &mgmtpb.PoolQueryTargetReq{
+			Sys:      req.GetSys(),
+			Id:       req.GetId(),
+			SvcRanks: pqReq.GetSvcRanks(),
+			Rank:     rank.Uint32(),
+			Targets:  targets,
+		}
+
+		dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolQueryTarget, tReq)
+		if err != nil {
+			return nil, err
+		}
+
+		tResp := new(mgmtpb.PoolQueryTargetResp)
+		if err := svc.unmarshalPB(dResp.Body, tResp); err != nil {
+			return nil, err
+		}
+
+		rt := &mgmtpb.PoolQueryTargetResp_RankTargets{
+			Rank:   rank.Uint32(),
+			Status: tResp.GetStatus(),
+		}
+		healthy := rt.Status == 0
+		for _, info := range tResp.GetInfos() {
+			rt.States = append(rt.States, info.GetState())
+			if info.GetState() != mgmtpb.PoolQueryTargetInfo_UP_IN {
+				healthy = false
+			}
+		}
+		if healthy && req.GetUnhealthyOnly() {
+			continue
+		}
+
+		resp.Ranks = append(resp.Ranks, rt)
+	}
+
+	return resp, nil
+}
+
 // PoolUpgrade forwards a pool upgrade request to the I/O Engine.
 func (svc *mgmtSvc) PoolUpgrade(ctx context.Context, req *mgmtpb.PoolUpgradeReq) (*mgmtpb.PoolUpgradeResp, error) {
 	if err := svc.checkLeaderRequest(req); err != nil {
@@ -1013,6 +1576,87 @@ func (svc *mgmtSvc) PoolUpgrade(ctx context.Context, req *mgmtpb.PoolUpgradeReq)
 	return resp, nil
 }
 
+// PoolRebalance implements the method defined for the Management Service.
+//
+// Redistribute existing pool data across all of the pool's targets. This is
+// used to explicitly rebalance data onto ranks added by a pool extend when the
+// automatic rebuild was deferred.
+func (svc *mgmtSvc) PoolRebalance(ctx context.Context, req *mgmtpb.PoolRebalanceReq) (*mgmtpb.PoolRebalanceResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	dResp, err := svc.makeLockedPoolServiceCall(ctx, drpc.MethodPoolRebalance, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.PoolRebalanceResp{}
+	if err := svc.unmarshalPB(dResp.Body, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// splitMgmtPoolProps separates the pool properties stored by the Management
+// Service from those that are passed to the engine.
+func splitMgmtPoolProps(props []*mgmtpb.PoolProperty) (mgmtProps, engineProps []*mgmtpb.PoolProperty) {
+	for _, prop := range props {
+		if daos.IsMgmtPoolProperty(prop.GetNumber()) {
+			mgmtProps = append(mgmtProps, prop)
+			continue
+		}
+		engineProps = append(engineProps, prop)
+	}
+
+	return
+}
+
+// setMgmtPoolProps applies pool properties stored by the Management Service
+// to the pool service entry. The caller is responsible for persisting it.
+func setMgmtPoolProps(ps *system.PoolService, props []*mgmtpb.PoolProperty) error {
+	for _, prop := range props {
+		switch prop.GetNumber() {
+		case daos.PoolPropertyDestroyProtect:
+			switch prop.GetNumval() {
+			case daos.PoolDestroyProtectDisabled:
+				ps.DestroyProtect = false
+			case daos.PoolDestroyProtectEnabled:
+				ps.DestroyProtect = true
+			default:
+				return errors.Errorf("invalid destroy_protect value %d", prop.GetNumval())
+			}
+		default:
+			return errors.Errorf("unknown pool property %d", prop.GetNumber())
+		}
+	}
+
+	return nil
+}
+
+// getMgmtPoolProps returns the values of the requested pool properties stored
+// by the Management Service.
+func getMgmtPoolProps(ps *system.PoolService, props []*mgmtpb.PoolProperty) ([]*mgmtpb.PoolProperty, error) {
+	out := make([]*mgmtpb.PoolProperty, 0, len(props))
+	for _, prop := range props {
+		outProp := &mgmtpb.PoolProperty{Number: prop.GetNumber()}
+		switch prop.GetNumber() {
+		case daos.PoolPropertyDestroyProtect:
+			val := uint64(daos.PoolDestroyProtectDisabled)
+			if ps.DestroyProtect {
+				val = daos.PoolDestroyProtectEnabled
+			}
+			outProp.SetValueNumber(val)
+		default:
+			return nil, errors.Errorf("unknown pool property %d", prop.GetNumber())
+		}
+		out = append(out, outProp)
+	}
+
+	return out, nil
+}
+
 func (svc *mgmtSvc) updatePoolLabel(ctx context.Context, sys string, uuid uuid.UUID, prop *mgmtpb.PoolProperty) error {
 	if prop.GetNumber() != daos.PoolPropertyLabel {
 		return errors.New("updatePoolLabel() called with non-label prop")
@@ -1025,21 +1669,12 @@ func (svc *mgmtSvc) updatePoolLabel(ctx context.Context, sys string, uuid uuid.U
 	}
 
 	if label != "" {
-		// If we're setting a label, first check to see
-		// if a pool has already had the label applied.
-		found, err := svc.sysdb.FindPoolServiceByLabel(label)
-		if found != nil && found.PoolUUID != ps.PoolUUID {
-			// If we find a pool with this label but the
-			// UUID differs, then we should fail the request.
-			return FaultPoolDuplicateLabel(label)
-		}
-		if err != nil && !system.IsPoolNotFound(err) {
-			// If the query failed, then we should fail
-			// the request.
+		// If we're setting a label, first check to see if another
+		// pool already uses it as a label or alias. The label may be
+		// set again on the same pool for idempotency.
+		if err := svc.checkPoolLabelUnused(label, ps.PoolUUID); err != nil {
 			return err
 		}
-		// Otherwise, allow the label to be set again on the same
-		// pool for idempotency.
 	}
 
 	req := &mgmtpb.PoolSetPropReq{
@@ -1064,9 +1699,13 @@ func (svc *mgmtSvc) updatePoolLabel(ctx context.Context, sys string, uuid uuid.U
 	}
 
 	// Persist the label update in the MS DB if the
-	// dRPC call succeeded.
+	// dRPC call succeeded. An alias which becomes the
+	// label is no longer needed.
 	ps.PoolLabel = label
-	return svc.sysdb.UpdatePoolService(ctx, ps)
+	ps.Aliases = slices.DeleteFunc(ps.Aliases, func(alias string) bool {
+		return alias == label
+	})
+	return svc.sysdb.UpdatePoolServiceChecked(ctx, ps, poolLabelsUnusedCheck(ps.PoolUUID, label))
 }
 
 // PoolSetProp forwards a request to the I/O Engine to set pool properties.
@@ -1091,8 +1730,24 @@ func (svc *mgmtSvc) PoolSetProp(parent context.Context, req *mgmtpb.PoolSetPropR
 		return nil, errors.New("PoolSetProp() request with 0 properties")
 	}
 
-	miscProps := make([]*mgmtpb.PoolProperty, 0, len(req.GetProperties()))
-	for _, prop := range req.GetProperties() {
+	// Properties stored by the MS are handled first, so that if they are
+	// invalid, none of the other props are changed.
+	mgmtProps, engineProps := splitMgmtPoolProps(req.GetProperties())
+	if len(mgmtProps) > 0 {
+		ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+		if err != nil {
+			return nil, err
+		}
+		if err := setMgmtPoolProps(ps, mgmtProps); err != nil {
+			return nil, err
+		}
+		if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+			return nil, errors.Wrapf(err, "failed to update pool %s", poolUUID)
+		}
+	}
+
+	miscProps := make([]*mgmtpb.PoolProperty, 0, len(engineProps))
+	for _, prop := range engineProps {
 		// Label is a special case, in that we need to ensure that it's unique
 		// and also to update the pool service entry. Handle it first and separately
 		// so that if it fails, none of the other props are changed.
@@ -1126,6 +1781,209 @@ func (svc *mgmtSvc) PoolSetProp(parent context.Context, req *mgmtpb.PoolSetPropR
 	return resp, nil
 }
 
+// PoolRenameLabel implements the method defined for the Management Service.
+//
+// Change the label of an existing pool. The new label is checked for uniqueness
+// and applied via the pool service while holding the pool lock, so that no other
+// label update can race with the rename. The response lists the machines with
+// open handles on the pool, as their clients may still refer to the old label.
+func (svc *mgmtSvc) PoolRenameLabel(parent context.Context, req *mgmtpb.PoolRenameLabelReq) (*mgmtpb.PoolRenameLabelResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	newLabel := req.GetNewLabel()
+	if !daos.LabelIsValid(newLabel) {
+		return nil, errors.Errorf("invalid pool label %q", newLabel)
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx := lock.InContext(parent)
+
+	ps, err := svc.getPoolService(poolUUID.String())
+	if err != nil {
+		return nil, err
+	}
+	if ps.PoolLabel == newLabel {
+		return nil, errors.Errorf("pool %s already has label %q", ps.PoolUUID, newLabel)
+	}
+
+	resp := &mgmtpb.PoolRenameLabelResp{
+		Uuid:     ps.PoolUUID.String(),
+		OldLabel: ps.PoolLabel,
+	}
+
+	prop := &mgmtpb.PoolProperty{
+		Number: daos.PoolPropertyLabel,
+		Value:  &mgmtpb.PoolProperty_Strval{Strval: newLabel},
+	}
+	if err := svc.updatePoolLabel(ctx, req.GetSys(), poolUUID, prop); err != nil {
+		return nil, err
+	}
+	svc.log.Noticef("pool %s label changed from %q to %q", ps.PoolUUID, resp.OldLabel,
+		newLabel)
+
+	// The rename has been applied at this point, so a failure to list the
+	// open handles is reported in the response rather than as an error.
+	machines, err := svc.listPoolHandleMachines(ctx, req.GetSys(), poolUUID)
+	if err != nil {
+		svc.log.Errorf("pool %s: unable to list open handles: %s", ps.PoolUUID, err)
+		resp.HandlesUnknown = true
+		return resp, nil
+	}
+	resp.ClientMachines = machines
+
+	return resp, nil
+}
+
+// PoolUpdateAliases adds or removes alias labels of a pool. An alias may be used
+// in place of the pool's label or UUID anywhere a pool is identified, and must
+// not be in use as the label or alias of another pool. The aliases of the pool
+// are returned after the update.
+func (svc *mgmtSvc) PoolUpdateAliases(parent context.Context, req *mgmtpb.PoolUpdateAliasesReq) (*mgmtpb.PoolUpdateAliasesResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx := lock.InContext(parent)
+
+	ps, err := svc.getPoolService(poolUUID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := slices.Clone(ps.Aliases)
+	for _, alias := range req.GetRemove() {
+		idx := slices.Index(aliases, alias)
+		if idx < 0 {
+			return nil, errors.Errorf("pool %s has no alias %q", ps.PoolUUID, alias)
+		}
+		aliases = slices.Delete(aliases, idx, idx+1)
+	}
+	for _, alias := range req.GetAdd() {
+		if !daos.LabelIsValid(alias) {
+			return nil, errors.Errorf("invalid pool alias %q", alias)
+		}
+		if alias == ps.PoolLabel || slices.Contains(aliases, alias) {
+			return nil, errors.Errorf("pool %s already has label or alias %q",
+				ps.PoolUUID, alias)
+		}
+		if err := svc.checkPoolLabelUnused(alias, ps.PoolUUID); err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, alias)
+	}
+
+	if !slices.Equal(aliases, ps.Aliases) {
+		ps.Aliases = aliases
+		check := poolLabelsUnusedCheck(ps.PoolUUID, req.GetAdd()...)
+		if err := svc.sysdb.UpdatePoolServiceChecked(ctx, ps, check); err != nil {
+			return nil, err
+		}
+		svc.log.Noticef("pool %s aliases changed to %q", ps.PoolUUID, aliases)
+	}
+
+	return &mgmtpb.PoolUpdateAliasesResp{
+		Uuid:    ps.PoolUUID.String(),
+		Label:   ps.PoolLabel,
+		Aliases: ps.Aliases,
+	}, nil
+}
+
+// listPoolHandleMachines returns the sorted, unique names of the machines holding
+// open handles on the pool.
+func (svc *mgmtSvc) listPoolHandleMachines(ctx context.Context, sys string, poolUUID uuid.UUID) ([]string, error) {
+	counts, err := svc.countPoolHandles(ctx, sys, poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	machines := make([]string, 0, len(counts))
+	for machine := range counts {
+		machines = append(machines, machine)
+	}
+	sort.Strings(machines)
+
+	return machines, nil
+}
+
+// callPoolListHandles retrieves the open handles on the pool from the pool service.
+func (svc *mgmtSvc) callPoolListHandles(ctx context.Context, sys string, poolUUID uuid.UUID) (*mgmtpb.PoolListHandlesResp, error) {
+	req := &mgmtpb.PoolListHandlesReq{
+		Sys: sys,
+		Id:  poolUUID.String(),
+	}
+
+	dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolListHandles, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.PoolListHandlesResp)
+	if err := svc.unmarshalPB(dResp.Body, resp); err != nil {
+		return nil, err
+	}
+	if resp.GetStatus() != 0 {
+		return nil, daos.Status(resp.GetStatus())
+	}
+
+	return resp, nil
+}
+
+// listPoolHandles returns the details of the open handles on the pool. An error
+// is returned if the pool service reports open handles without their details.
+func (svc *mgmtSvc) listPoolHandles(ctx context.Context, sys string, poolUUID uuid.UUID) ([]*mgmtpb.PoolHandle, error) {
+	resp, err := svc.callPoolListHandles(ctx, sys, poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.GetHandles()) == 0 && len(resp.GetMachines()) > 0 {
+		return nil, errors.New("pool service did not report open handle details")
+	}
+
+	return resp.GetHandles(), nil
+}
+
+// countPoolHandles returns the number of open handles on the pool held by each
+// machine. Handles whose machine was not recorded when they were opened are not
+// counted.
+func (svc *mgmtSvc) countPoolHandles(ctx context.Context, sys string, poolUUID uuid.UUID) (map[string]uint32, error) {
+	resp, err := svc.callPoolListHandles(ctx, sys, poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]uint32)
+	for _, machine := range resp.GetMachines() {
+		if machine == "" {
+			continue
+		}
+		counts[machine]++
+	}
+
+	return counts, nil
+}
+
 // PoolGetProp forwards a request to the I/O Engine to get pool properties.
 func (svc *mgmtSvc) PoolGetProp(ctx context.Context, req *mgmtpb.PoolGetPropReq) (*mgmtpb.PoolGetPropResp, error) {
 	if err := svc.checkReplicaRequest(req); err != nil {
@@ -1139,12 +1997,30 @@ func (svc *mgmtSvc) PoolGetProp(ctx context.Context, req *mgmtpb.PoolGetPropReq)
 		return nil, errors.Errorf("PoolGetProp() request with 0 properties")
 	}
 
+	var mgmtResp []*mgmtpb.PoolProperty
+	mgmtProps, engineProps := splitMgmtPoolProps(req.GetProperties())
+	if len(mgmtProps) > 0 {
+		ps, err := svc.getPoolService(req.GetId())
+		if err != nil {
+			return nil, err
+		}
+		if mgmtResp, err = getMgmtPoolProps(ps, mgmtProps); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := new(mgmtpb.PoolGetPropResp)
+	if len(engineProps) == 0 {
+		resp.Properties = mgmtResp
+		return resp, nil
+	}
+	req.Properties = engineProps
+
 	dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolGetProp, req)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := new(mgmtpb.PoolGetPropResp)
 	if err := svc.unmarshalPB(dResp.Body, resp); err != nil {
 		return nil, err
 	}
@@ -1152,6 +2028,7 @@ func (svc *mgmtSvc) PoolGetProp(ctx context.Context, req *mgmtpb.PoolGetPropReq)
 	if resp.GetStatus() != 0 {
 		return resp, nil
 	}
+	resp.Properties = append(resp.Properties, mgmtResp...)
 
 	return resp, nil
 }
@@ -1240,6 +2117,17 @@ func (svc *mgmtSvc) ListPools(ctx context.Context, req *mgmtpb.ListPoolsReq) (*m
 		return nil, err
 	}
 
+	unchanged, err := svc.sysdbUnchanged(req.ChangeToken)
+	if err != nil {
+		return nil, err
+	}
+	if unchanged {
+		return &mgmtpb.ListPoolsResp{
+			DataVersion: req.ChangeToken,
+			NotModified: true,
+		}, nil
+	}
+
 	psList, err := svc.sysdb.PoolServiceList(true)
 	if err != nil {
 		return nil, err
@@ -1252,6 +2140,7 @@ func (svc *mgmtSvc) ListPools(ctx context.Context, req *mgmtpb.ListPoolsReq) (*m
 			Label:   ps.PoolLabel,
 			SvcReps: ranklist.RanksToUint32(ps.Replicas),
 			State:   ps.State.String(),
+			Aliases: ps.Aliases,
 		})
 	}
 
@@ -0,0 +1,211 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+// rankFreeSpaceGetter returns the storage available for pool creation on each of the
+// supplied ranks, as per-rank tier sizes.
+type rankFreeSpaceGetter func(ctx context.Context, ranks []ranklist.Rank, memRatio float32) (map[ranklist.Rank][]uint64, error)
+
+// scanRankFreeSpace queries the hosts of the supplied ranks for the storage
+// available for pool creation.
+func (svc *mgmtSvc) scanRankFreeSpace(ctx context.Context, ranks []ranklist.Rank, memRatio float32) (map[ranklist.Rank][]uint64, error) {
+	if svc.rpcClient == nil {
+		return nil, errors.New("no control API client")
+	}
+
+	hostSet := make(map[string]struct{})
+	for _, rank := range ranks {
+		m, err := svc.membership.Get(rank)
+		if err != nil {
+			return nil, err
+		}
+		hostSet[m.Addr.String()] = struct{}{}
+	}
+	hosts := make([]string, 0, len(hostSet))
+	for host := range hostSet {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	req := &control.PoolRankFreeSpaceReq{
+		Ranks:    ranks,
+		MemRatio: memRatio,
+	}
+	req.SetHostList(hosts)
+
+	return control.GetPoolRankFreeSpace(ctx, svc.rpcClient, req)
+}
+
+// poolStorageUsage returns the per-rank storage allocated to created pools and
+// reserved by pools that are being created, along with the reservations. The
+// storage of a pool being created is reserved from the time that the request is
+// admitted until the pool is either created or removed after a failure.
+func (svc *mgmtSvc) poolStorageUsage() (map[ranklist.Rank]*mgmtpb.RankUsage, []*mgmtpb.PoolReservation, error) {
+	psList, err := svc.sysdb.PoolServiceList(true)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(psList, func(i, j int) bool {
+		return psList[i].LastUpdate.Before(psList[j].LastUpdate)
+	})
+
+	addBytes := func(sum []uint64, tierBytes []uint64) []uint64 {
+		for len(sum) < len(tierBytes) {
+			sum = append(sum, 0)
+		}
+		for i, b := range tierBytes {
+			sum[i] += b
+		}
+		return sum
+	}
+
+	usage := make(map[ranklist.Rank]*mgmtpb.RankUsage)
+	var reservations []*mgmtpb.PoolReservation
+	for _, ps := range psList {
+		if ps.Storage == nil {
+			continue
+		}
+		ranks := ps.Storage.CreationRanks()
+		tierBytes := ps.Storage.PerRankTierStorage
+
+		reserving := ps.State == system.PoolServiceStateCreating
+		if reserving {
+			reservations = append(reservations, &mgmtpb.PoolReservation{
+				Uuid:      ps.PoolUUID.String(),
+				Label:     ps.PoolLabel,
+				Ranks:     ranklist.RankSetFromRanks(ranks).String(),
+				TierBytes: tierBytes,
+				Since:     common.FormatTime(ps.LastUpdate),
+			})
+		}
+
+		for _, rank := range ranks {
+			ru, found := usage[rank]
+			if !found {
+				ru = &mgmtpb.RankUsage{Rank: rank.Uint32()}
+				usage[rank] = ru
+			}
+			ru.NumPools++
+			if reserving {
+				ru.ReservedBytes = addBytes(ru.ReservedBytes, tierBytes)
+			} else {
+				ru.AllocatedBytes = addBytes(ru.AllocatedBytes, tierBytes)
+			}
+		}
+	}
+
+	return usage, reservations, nil
+}
+
+// checkPoolCreateCapacity returns a check that verifies that the per-rank storage requested
+// for a new pool is available on each of the pool's ranks. The free space is scanned up
+// front, and the check subtracts the storage of any pool that may not be reflected in the
+// scan, i.e. of any pool that did not exist when the scan started, or that was still being
+// created. The check is run by the system database when the new pool service is added, so
+// that concurrent requests are evaluated one at a time against each other's reservations.
+// Rejecting the request there prevents a create that was sized from a stale view of the
+// free space (e.g. two concurrent requests for 100% of the available storage) from failing
+// part-way through on the engines. If the available storage can't be determined, the check
+// is skipped, as are the ranks for which no free space is reported.
+func (svc *mgmtSvc) checkPoolCreateCapacity(ctx context.Context, req *mgmtpb.PoolCreateReq) (raft.PoolServiceCheck, error) {
+	getFreeSpace := svc.getRankFreeSpace
+	if getFreeSpace == nil {
+		getFreeSpace = svc.scanRankFreeSpace
+	}
+
+	// Pools that exist before the scan starts have their storage accounted for in the
+	// reported free space, whatever their state (e.g. a pool being destroyed), except
+	// for pools being created, the storage of which may not be allocated yet.
+	psList, err := svc.sysdb.PoolServiceList(true)
+	if err != nil {
+		return nil, err
+	}
+	scanned := make(map[uuid.UUID]struct{}, len(psList))
+	for _, ps := range psList {
+		if ps.State == system.PoolServiceStateCreating {
+			continue
+		}
+		scanned[ps.PoolUUID] = struct{}{}
+	}
+
+	ranks := ranklist.RanksFromUint32(req.GetRanks())
+	freeSpace, err := getFreeSpace(ctx, ranks, req.GetMemRatio())
+	if err != nil {
+		svc.log.Noticef("unable to determine free space for pool %s; skipping capacity check: %s",
+			req.GetUuid(), err)
+		return func([]*system.PoolService) error { return nil }, nil
+	}
+	for _, rank := range ranks {
+		if _, found := freeSpace[rank]; !found {
+			svc.log.Debugf("no free space reported for rank %d; skipping capacity check", rank)
+		}
+	}
+
+	tierNames := []string{"SCM", "NVMe"}
+	if req.GetMemRatio() > 0 {
+		tierNames = []string{"metadata", "data"}
+	}
+
+	return func(pools []*system.PoolService) error {
+		reserved := make(map[ranklist.Rank][]uint64)
+		for _, ps := range pools {
+			if _, found := scanned[ps.PoolUUID]; found || ps.Storage == nil {
+				continue
+			}
+			for _, rank := range ps.Storage.CreationRanks() {
+				for len(reserved[rank]) < len(ps.Storage.PerRankTierStorage) {
+					reserved[rank] = append(reserved[rank], 0)
+				}
+				for tier, b := range ps.Storage.PerRankTierStorage {
+					reserved[rank][tier] += b
+				}
+			}
+		}
+
+		for _, rank := range ranks {
+			free, found := freeSpace[rank]
+			if !found {
+				continue
+			}
+			for tier, reqBytes := range req.GetTierBytes() {
+				if tier >= len(free) || tier >= len(tierNames) {
+					break
+				}
+
+				var rsvd uint64
+				if tier < len(reserved[rank]) {
+					rsvd = reserved[rank][tier]
+				}
+				var avail uint64
+				if free[tier] > rsvd {
+					avail = free[tier] - rsvd
+				}
+
+				if reqBytes > avail {
+					return FaultPoolInsufficientCapacity(rank, tierNames[tier], reqBytes, avail, rsvd)
+				}
+			}
+		}
+
+		return nil
+	}, nil
+}
@@ -0,0 +1,229 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockCapacityPool(t *testing.T, idx int32, state system.PoolServiceState, tierBytes []uint64, ranks ...ranklist.Rank) *system.PoolService {
+	t.Helper()
+
+	ps := system.NewPoolService(test.MockPoolUUID(idx), tierBytes, 0, ranks)
+	ps.PoolLabel = fmt.Sprintf("pool%d", idx)
+	ps.State = state
+	ps.Replicas = []ranklist.Rank{0}
+	return ps
+}
+
+func TestServer_MgmtSvc_checkPoolCreateCapacity(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pools     []*system.PoolService
+		freeSpace map[ranklist.Rank][]uint64
+		freeErr   error
+		afterScan []*system.PoolService
+		req       *mgmtpb.PoolCreateReq
+		expErr    error
+	}{
+		"free space unavailable": {
+			freeErr: errors.New("scan failed"),
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{humanize.TByte, 0},
+			},
+		},
+		"sufficient capacity": {
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, humanize.TByte},
+				1: {100 * humanize.GByte, humanize.TByte},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0, 1},
+				TierBytes: []uint64{100 * humanize.GByte, humanize.TByte},
+			},
+		},
+		"insufficient NVMe capacity": {
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, humanize.TByte},
+				1: {100 * humanize.GByte, 500 * humanize.GByte},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0, 1},
+				TierBytes: []uint64{10 * humanize.GByte, humanize.TByte},
+			},
+			expErr: FaultPoolInsufficientCapacity(1, "NVMe", humanize.TByte, 500*humanize.GByte, 0),
+		},
+		"capacity reserved by pool being created": {
+			pools: []*system.PoolService{
+				mockCapacityPool(t, 1, system.PoolServiceStateCreating,
+					[]uint64{60 * humanize.GByte, 0}, 0),
+			},
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, 0},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{50 * humanize.GByte, 0},
+			},
+			expErr: FaultPoolInsufficientCapacity(0, "SCM", 50*humanize.GByte, 40*humanize.GByte,
+				60*humanize.GByte),
+		},
+		"reservation exceeds free space": {
+			pools: []*system.PoolService{
+				mockCapacityPool(t, 1, system.PoolServiceStateCreating,
+					[]uint64{200 * humanize.GByte, 0}, 0),
+			},
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, 0},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{humanize.GByte, 0},
+			},
+			expErr: FaultPoolInsufficientCapacity(0, "SCM", humanize.GByte, 0, 200*humanize.GByte),
+		},
+		"created pools not subtracted": {
+			pools: []*system.PoolService{
+				mockCapacityPool(t, 1, system.PoolServiceStateReady,
+					[]uint64{60 * humanize.GByte, 0}, 0),
+			},
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, 0},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{100 * humanize.GByte, 0},
+			},
+		},
+		"reservation on other rank": {
+			pools: []*system.PoolService{
+				mockCapacityPool(t, 1, system.PoolServiceStateCreating,
+					[]uint64{100 * humanize.GByte, 0}, 1),
+			},
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, 0},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{100 * humanize.GByte, 0},
+			},
+		},
+		"MD-on-SSD tier names": {
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, humanize.TByte},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{200 * humanize.GByte, humanize.TByte},
+				MemRatio:  0.5,
+			},
+			expErr: FaultPoolInsufficientCapacity(0, "metadata", 200*humanize.GByte,
+				100*humanize.GByte, 0),
+		},
+		"rank without free space info": {
+			freeSpace: map[ranklist.Rank][]uint64{},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{humanize.TByte, 0},
+			},
+		},
+		"rank without free space info skipped": {
+			freeSpace: map[ranklist.Rank][]uint64{
+				1: {100 * humanize.GByte, 0},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0, 1},
+				TierBytes: []uint64{humanize.TByte, 0},
+			},
+			expErr: FaultPoolInsufficientCapacity(1, "SCM", humanize.TByte, 100*humanize.GByte, 0),
+		},
+		"pool being destroyed before scan not subtracted": {
+			pools: []*system.PoolService{
+				mockCapacityPool(t, 1, system.PoolServiceStateDestroying,
+					[]uint64{60 * humanize.GByte, 0}, 0),
+			},
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, 0},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{100 * humanize.GByte, 0},
+			},
+		},
+		"capacity reserved by concurrent create": {
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, 0},
+			},
+			afterScan: []*system.PoolService{
+				mockCapacityPool(t, 1, system.PoolServiceStateCreating,
+					[]uint64{100 * humanize.GByte, 0}, 0),
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{100 * humanize.GByte, 0},
+			},
+			expErr: FaultPoolInsufficientCapacity(0, "SCM", 100*humanize.GByte, 0,
+				100*humanize.GByte),
+		},
+		"pool created after scan subtracted": {
+			freeSpace: map[ranklist.Rank][]uint64{
+				0: {100 * humanize.GByte, 0},
+			},
+			afterScan: []*system.PoolService{
+				mockCapacityPool(t, 1, system.PoolServiceStateReady,
+					[]uint64{60 * humanize.GByte, 0}, 0),
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{50 * humanize.GByte, 0},
+			},
+			expErr: FaultPoolInsufficientCapacity(0, "SCM", 50*humanize.GByte, 40*humanize.GByte,
+				60*humanize.GByte),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, ps := range tc.pools {
+				addTestPoolService(t, svc.sysdb, ps)
+			}
+
+			var gotRanks []ranklist.Rank
+			svc.getRankFreeSpace = func(_ context.Context, ranks []ranklist.Rank, _ float32) (map[ranklist.Rank][]uint64, error) {
+				gotRanks = ranks
+				return tc.freeSpace, tc.freeErr
+			}
+
+			check, gotErr := svc.checkPoolCreateCapacity(test.Context(t), tc.req)
+			test.AssertEqual(t, len(tc.req.Ranks), len(gotRanks), "unexpected ranks queried")
+			if gotErr == nil {
+				for _, ps := range tc.afterScan {
+					addTestPoolService(t, svc.sysdb, ps)
+				}
+				pools, err := svc.sysdb.PoolServiceList(true)
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotErr = check(pools)
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
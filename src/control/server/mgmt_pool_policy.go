@@ -0,0 +1,167 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+func poolPolicyFromPB(pb *mgmtpb.PoolPolicy) (*system.PoolPolicy, error) {
+	if pb == nil {
+		return nil, errors.New("nil pool policy")
+	}
+
+	typ, err := system.ParsePoolPolicyType(pb.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	return &system.PoolPolicy{
+		Type:              typ,
+		Principal:         pb.GetPrincipal(),
+		MaxPools:          pb.GetMaxPools(),
+		MaxTotalBytes:     pb.GetMaxTotalBytes(),
+		AllowedProperties: pb.GetAllowedProps(),
+	}, nil
+}
+
+func poolPolicyToPB(p *system.PoolPolicy) *mgmtpb.PoolPolicy {
+	return &mgmtpb.PoolPolicy{
+		Type:          string(p.Type),
+		Principal:     p.Principal,
+		MaxPools:      p.MaxPools,
+		MaxTotalBytes: p.MaxTotalBytes,
+		AllowedProps:  p.AllowedProperties,
+	}
+}
+
+// PoolSetPolicy adds or replaces the pool creation policy for a user or group.
+func (svc *mgmtSvc) PoolSetPolicy(ctx context.Context, req *mgmtpb.PoolSetPolicyReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	policy, err := poolPolicyFromPB(req.GetPolicy())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.sysdb.SetPoolPolicy(policy); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("pool policy for %s set: %+v", policy, policy)
+
+	return new(mgmtpb.DaosResp), nil
+}
+
+// PoolRemovePolicy removes the pool creation policy for a user or group.
+func (svc *mgmtSvc) PoolRemovePolicy(ctx context.Context, req *mgmtpb.PoolRemovePolicyReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	typ, err := system.ParsePoolPolicyType(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.sysdb.RemovePoolPolicy(typ, req.GetPrincipal()); err != nil {
+		return nil, err
+	}
+
+	return new(mgmtpb.DaosResp), nil
+}
+
+// PoolListPolicies returns the pool creation policies.
+func (svc *mgmtSvc) PoolListPolicies(ctx context.Context, req *mgmtpb.PoolListPoliciesReq) (*mgmtpb.PoolListPoliciesResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	policies, err := svc.sysdb.PoolPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.PoolListPoliciesResp)
+	for _, p := range policies {
+		resp.Policies = append(resp.Policies, poolPolicyToPB(p))
+	}
+
+	return resp, nil
+}
+
+// poolPropNames returns the names of the supplied pool properties.
+func poolPropNames(props []*mgmtpb.PoolProperty) []string {
+	numToName := make(map[uint32]string)
+	for name, hdlr := range daos.PoolProperties() {
+		numToName[hdlr.Property.Number] = name
+	}
+
+	names := make([]string, 0, len(props))
+	for _, prop := range props {
+		if name, found := numToName[prop.GetNumber()]; found {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// checkPoolPolicies checks the pool create request against the pool policies
+// for the owner and owner group of the pool. The properties are the names of
+// those set in the original request, before any defaults were added. The pool
+// storage must already have been calculated. The returned check enforces the
+// limits on the pools owned when the new pool service is added, so that they
+// can't be exceeded by concurrent requests.
+func (svc *mgmtSvc) checkPoolPolicies(req *mgmtpb.PoolCreateReq, props []string) (raft.PoolServiceCheck, error) {
+	policies, err := svc.sysdb.PoolPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	var perRank uint64
+	for _, tierBytes := range req.GetTierBytes() {
+		perRank += tierBytes
+	}
+	ppr := &system.PoolPolicyRequest{
+		Owner:      req.GetUser(),
+		OwnerGroup: req.GetUserGroup(),
+		TotalBytes: perRank * uint64(len(req.GetRanks())),
+		Properties: props,
+	}
+
+	check := func(pools []*system.PoolService) error {
+		for _, policy := range policies {
+			if err := policy.CheckPoolCreate(pools, ppr); err != nil {
+				svc.log.Noticef("pool %s create denied by %s policy: %s", req.GetUuid(), policy, err)
+				return FaultPoolPolicyDenied(err.Error())
+			}
+		}
+		return nil
+	}
+	if len(policies) == 0 {
+		return check, nil
+	}
+
+	// Deny the request early if it already breaches a policy.
+	pools, err := svc.sysdb.PoolServiceList(true)
+	if err != nil {
+		return nil, err
+	}
+	if err := check(pools); err != nil {
+		return nil, err
+	}
+
+	return check, nil
+}
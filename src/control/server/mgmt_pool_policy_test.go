@@ -0,0 +1,283 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_PoolSetPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req         *mgmtpb.PoolSetPolicyReq
+		expPolicies []*system.PoolPolicy
+		expErr      error
+	}{
+		"nil request": {
+			req:    (*mgmtpb.PoolSetPolicyReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolSetPolicyReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"nil policy": {
+			req:    &mgmtpb.PoolSetPolicyReq{},
+			expErr: errors.New("nil pool policy"),
+		},
+		"bad type": {
+			req: &mgmtpb.PoolSetPolicyReq{
+				Policy: &mgmtpb.PoolPolicy{Type: "owner", Principal: "alice@"},
+			},
+			expErr: errors.New("invalid pool policy type"),
+		},
+		"bad allowed property": {
+			req: &mgmtpb.PoolSetPolicyReq{
+				Policy: &mgmtpb.PoolPolicy{
+					Type:         "user",
+					Principal:    "alice@",
+					AllowedProps: []string{"quack"},
+				},
+			},
+			expErr: errors.New("invalid allowed property"),
+		},
+		"success": {
+			req: &mgmtpb.PoolSetPolicyReq{
+				Policy: &mgmtpb.PoolPolicy{
+					Type:          "group",
+					Principal:     "hpc@",
+					MaxPools:      4,
+					MaxTotalBytes: 1 << 40,
+					AllowedProps:  []string{"reclaim"},
+				},
+			},
+			expPolicies: []*system.PoolPolicy{
+				{
+					Type:              system.PoolPolicyGroup,
+					Principal:         "hpc@",
+					MaxPools:          4,
+					MaxTotalBytes:     1 << 40,
+					AllowedProperties: []string{"reclaim"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			_, err := svc.PoolSetPolicy(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			policies, err := svc.sysdb.PoolPolicies()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expPolicies, policies); diff != "" {
+				t.Fatalf("unexpected policies (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolRemovePolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *mgmtpb.PoolRemovePolicyReq
+		expErr error
+	}{
+		"nil request": {
+			req:    (*mgmtpb.PoolRemovePolicyReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"bad type": {
+			req:    &mgmtpb.PoolRemovePolicyReq{Type: "owner", Principal: "alice@"},
+			expErr: errors.New("invalid pool policy type"),
+		},
+		"not found": {
+			req:    &mgmtpb.PoolRemovePolicyReq{Type: "group", Principal: "alice@"},
+			expErr: system.ErrPoolPolicyNotFound("group:alice@"),
+		},
+		"success": {
+			req: &mgmtpb.PoolRemovePolicyReq{Type: "user", Principal: "alice@"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if err := svc.sysdb.SetPoolPolicy(&system.PoolPolicy{
+				Type:      system.PoolPolicyUser,
+				Principal: "alice@",
+				MaxPools:  1,
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			_, err := svc.PoolRemovePolicy(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			policies, err := svc.sysdb.PoolPolicies()
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, 0, len(policies), "policy not removed")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolListPolicies(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	for _, p := range []*system.PoolPolicy{
+		{Type: system.PoolPolicyUser, Principal: "alice@", MaxPools: 2},
+		{Type: system.PoolPolicyGroup, Principal: "hpc@", AllowedProperties: []string{"reclaim"}},
+	} {
+		if err := svc.sysdb.SetPoolPolicy(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := svc.PoolListPolicies(test.Context(t), &mgmtpb.PoolListPoliciesReq{Sys: "quack"})
+	test.CmpErr(t, FaultWrongSystem("quack", build.DefaultSystemName), err)
+
+	resp, err := svc.PoolListPolicies(test.Context(t), &mgmtpb.PoolListPoliciesReq{
+		Sys: build.DefaultSystemName,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expResp := &mgmtpb.PoolListPoliciesResp{
+		Policies: []*mgmtpb.PoolPolicy{
+			{Type: "group", Principal: "hpc@", AllowedProps: []string{"reclaim"}},
+			{Type: "user", Principal: "alice@", MaxPools: 2},
+		},
+	}
+	if diff := cmp.Diff(expResp, resp, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+	}
+}
+
+func TestServer_MgmtSvc_checkPoolPolicies(t *testing.T) {
+	ownedPool := func(idx int32) *system.PoolService {
+		ps := system.NewPoolService(test.MockPoolUUID(idx), []uint64{humanize.GByte, 0}, 0,
+			[]ranklist.Rank{0})
+		ps.PoolLabel = fmt.Sprintf("pool%d", idx)
+		ps.Owner = "alice@"
+		ps.Replicas = []ranklist.Rank{0}
+		return ps
+	}
+
+	for name, tc := range map[string]struct {
+		policies  []*system.PoolPolicy
+		pools     []*system.PoolService
+		afterPrep []*system.PoolService
+		expErr    error
+	}{
+		"no policies": {
+			pools:     []*system.PoolService{ownedPool(1)},
+			afterPrep: []*system.PoolService{ownedPool(2)},
+		},
+		"under the limit": {
+			policies: []*system.PoolPolicy{
+				{Type: system.PoolPolicyUser, Principal: "alice@", MaxPools: 2},
+			},
+			pools: []*system.PoolService{ownedPool(1)},
+		},
+		"at the limit": {
+			policies: []*system.PoolPolicy{
+				{Type: system.PoolPolicyUser, Principal: "alice@", MaxPools: 1},
+			},
+			pools:  []*system.PoolService{ownedPool(1)},
+			expErr: errors.New("already owns 1 of a maximum of 1 pools"),
+		},
+		"limit reached by concurrent create": {
+			policies: []*system.PoolPolicy{
+				{Type: system.PoolPolicyUser, Principal: "alice@", MaxPools: 2},
+			},
+			pools:     []*system.PoolService{ownedPool(1)},
+			afterPrep: []*system.PoolService{ownedPool(2)},
+			expErr:    errors.New("already owns 2 of a maximum of 2 pools"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, p := range tc.policies {
+				if err := svc.sysdb.SetPoolPolicy(p); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, ps := range tc.pools {
+				addTestPoolService(t, svc.sysdb, ps)
+			}
+
+			req := &mgmtpb.PoolCreateReq{
+				Uuid:      test.MockUUID(9),
+				User:      "alice@",
+				Ranks:     []uint32{0},
+				TierBytes: []uint64{humanize.GByte, 0},
+			}
+			check, gotErr := svc.checkPoolPolicies(req, []string{"label"})
+			if gotErr == nil {
+				for _, ps := range tc.afterPrep {
+					addTestPoolService(t, svc.sysdb, ps)
+				}
+				pools, err := svc.sysdb.PoolServiceList(true)
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotErr = check(pools)
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestServer_poolPropNames(t *testing.T) {
+	props := []*mgmtpb.PoolProperty{
+		{Number: daos.PoolPropertyLabel},
+		{Number: daos.PoolPropertySpaceReclaim},
+		{Number: 9999},
+	}
+
+	if diff := cmp.Diff([]string{"label", "reclaim"}, poolPropNames(props)); diff != "" {
+		t.Fatalf("unexpected names (-want, +got)\n%s\n", diff)
+	}
+}
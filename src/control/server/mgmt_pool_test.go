@@ -10,6 +10,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -25,9 +26,13 @@ import (
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/server/engine"
 	"github.com/daos-stack/daos/src/control/server/storage"
 	"github.com/daos-stack/daos/src/control/system"
@@ -106,6 +111,22 @@ func addTestPools(t *testing.T, sysdb *raft.Database, poolUUIDs ...string) {
 	}
 }
 
+func setTestPoolAliases(t *testing.T, sysdb *raft.Database, uuidStr string, aliases ...string) {
+	t.Helper()
+
+	ps, err := sysdb.FindPoolServiceByUUID(uuid.MustParse(uuidStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps.Aliases = aliases
+
+	lock, ctx := getPoolLockCtx(t, nil, sysdb, ps.PoolUUID)
+	defer lock.Release()
+	if err := sysdb.UpdatePoolService(ctx, ps); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testPoolLabelProp() []*mgmtpb.PoolProperty {
 	return []*mgmtpb.PoolProperty{
 		{
@@ -418,7 +439,9 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 		setupMockDrpc  func(_ *mgmtSvc, _ error)
 		targetCount    int
 		memberCount    int
+		memberDomains  []string
 		mdonssdEnabled bool
+		policies       []*system.PoolPolicy
 		req            *mgmtpb.PoolCreateReq
 		drpcRet        *mgmtpb.PoolCreateResp
 		expResp        *mgmtpb.PoolCreateResp
@@ -602,6 +625,73 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 			},
 			expErr: FaultPoolInvalidNumRanks(3, 2),
 		},
+		"invalid placement policy": {
+			targetCount: 8,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:            test.MockUUID(1),
+				TierBytes:       []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				PlacementPolicy: "scatter",
+				Properties:      testPoolLabelProp(),
+			},
+			expErr: errors.New("invalid pool placement policy"),
+		},
+		"placement policy with explicit ranks": {
+			targetCount: 8,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:            test.MockUUID(1),
+				TierBytes:       []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Ranks:           []uint32{0},
+				PlacementPolicy: "spread",
+				Properties:      testPoolLabelProp(),
+			},
+			expErr: errors.New("may not be combined"),
+		},
+		"invalid exclude domains pattern": {
+			targetCount: 8,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:           test.MockUUID(1),
+				TierBytes:      []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				ExcludeDomains: "host(",
+				Properties:     testPoolLabelProp(),
+			},
+			expErr: errors.New("invalid fault domain exclusion pattern"),
+		},
+		"too many ranks excluded": {
+			targetCount:   8,
+			memberCount:   3,
+			memberDomains: []string{"/rack0/host0", "/rack1/host1", "/rack0/host2"},
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:           test.MockUUID(1),
+				TierBytes:      []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				NumRanks:       2,
+				ExcludeDomains: "^/rack0/",
+				Properties:     testPoolLabelProp(),
+			},
+			expErr: FaultPoolInvalidNumRanks(2, 1),
+		},
+		"successful creation with placement": {
+			targetCount:   8,
+			memberCount:   3,
+			memberDomains: []string{"/rack0/host0", "/rack1/host1", "/rack0/host2"},
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:            test.MockUUID(1),
+				TierBytes:       []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				NumRanks:        2,
+				PlacementPolicy: "spread",
+				ExcludeDomains:  "host0$",
+				Properties:      testPoolLabelProp(),
+			},
+			drpcRet: &mgmtpb.PoolCreateResp{
+				TierBytes: []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				TgtRanks:  []uint32{1, 2},
+			},
+			expResp: &mgmtpb.PoolCreateResp{
+				TierBytes:       []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				TgtRanks:        []uint32{1, 2},
+				PlacementPolicy: "spread",
+				TgtFaultDomains: []string{"/rack0/host2", "/rack1/host1"},
+			},
+		},
 		"svc replicas > max": {
 			targetCount: 1,
 			memberCount: MaxPoolServiceReps + 2,
@@ -634,6 +724,68 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 			},
 			expErr: FaultPoolNoLabel,
 		},
+		"allowed by pool policy": {
+			targetCount: 8,
+			policies: []*system.PoolPolicy{
+				{
+					Type:              system.PoolPolicyUser,
+					Principal:         "alice@",
+					MaxPools:          1,
+					AllowedProperties: []string{"reclaim"},
+				},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:       test.MockUUID(1),
+				User:       "alice@",
+				TierBytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Properties: testPoolLabelProp(),
+			},
+			drpcRet: &mgmtpb.PoolCreateResp{
+				TierBytes: []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				TgtRanks:  []uint32{0, 1},
+			},
+			expResp: &mgmtpb.PoolCreateResp{
+				TierBytes: []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				TgtRanks:  []uint32{0, 1},
+			},
+		},
+		"property denied by pool policy": {
+			targetCount: 8,
+			policies: []*system.PoolPolicy{
+				{
+					Type:              system.PoolPolicyUser,
+					Principal:         "alice@",
+					AllowedProperties: []string{"ec_cell_sz"},
+				},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:      test.MockUUID(1),
+				User:      "alice@",
+				TierBytes: []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Properties: append(testPoolLabelProp(), &mgmtpb.PoolProperty{
+					Number: daos.PoolPropertySpaceReclaim,
+					Value:  &mgmtpb.PoolProperty_Numval{Numval: daos.PoolSpaceReclaimLazy},
+				}),
+			},
+			expErr: FaultPoolPolicyDenied(`property "reclaim" may not be set on pools owned by user alice@ (allowed: ec_cell_sz)`),
+		},
+		"capacity denied by pool policy": {
+			targetCount: 8,
+			policies: []*system.PoolPolicy{
+				{
+					Type:          system.PoolPolicyGroup,
+					Principal:     "hpc@",
+					MaxTotalBytes: 10 * humanize.TByte,
+				},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:       test.MockUUID(1),
+				UserGroup:  "hpc@",
+				TierBytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Properties: testPoolLabelProp(),
+			},
+			expErr: errors.New("over the limit of 10 TB"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			buf.Reset()
@@ -660,6 +812,9 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 			}
 			for i := 0; i < numMembers; i++ {
 				mm := system.MockMember(t, uint32(i), system.MemberStateJoined)
+				if i < len(tc.memberDomains) {
+					mm.FaultDomain = system.MustCreateFaultDomainFromString(tc.memberDomains[i])
+				}
 				if _, err := tc.mgmtSvc.membership.Add(mm); err != nil {
 					t.Fatal(err)
 				}
@@ -672,6 +827,12 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 			}
 			tc.setupMockDrpc(tc.mgmtSvc, tc.expErr)
 
+			for _, p := range tc.policies {
+				if err := tc.mgmtSvc.sysdb.SetPoolPolicy(p); err != nil {
+					t.Fatal(err)
+				}
+			}
+
 			if tc.req != nil && tc.req.Sys == "" {
 				tc.req.Sys = build.DefaultSystemName
 			}
@@ -787,6 +948,143 @@ func TestServer_MgmtSvc_PoolCreateDownRanks(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_poolCreateAddGroupTemplates(t *testing.T) {
+	numProp := func(number uint32, val uint64) *mgmtpb.PoolProperty {
+		return &mgmtpb.PoolProperty{
+			Number: number,
+			Value:  &mgmtpb.PoolProperty_Numval{Numval: val},
+		}
+	}
+	templates := []*config.PoolGroupTemplate{
+		{
+			Group:      "hpc",
+			Properties: []string{"reclaim:lazy", "space_rb:5"},
+			ACL:        []string{"A:G:hpc@:rw"},
+		},
+		{
+			Group:      "ai",
+			Properties: []string{"space_rb:10"},
+			ACL:        []string{"A:G:ai@:r", "A:G:hpc@:r"},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		resolver security.GroupResolver
+		req      *mgmtpb.PoolCreateReq
+		expProps []*mgmtpb.PoolProperty
+		expACL   []string
+	}{
+		"no matching group": {
+			req: &mgmtpb.PoolCreateReq{
+				User:      "alice@",
+				UserGroup: "users@",
+			},
+		},
+		"owner group matches": {
+			req: &mgmtpb.PoolCreateReq{
+				User:      "alice@",
+				UserGroup: "hpc@",
+			},
+			expProps: []*mgmtpb.PoolProperty{
+				numProp(daos.PoolPropertySpaceReclaim, daos.PoolSpaceReclaimLazy),
+				numProp(daos.PoolPropertyReservedSpace, 5),
+			},
+			expACL: []string{"A::OWNER@:rw", "A:G:GROUP@:rw", "A:G:hpc@:rw"},
+		},
+		"request values take precedence": {
+			req: &mgmtpb.PoolCreateReq{
+				User:       "alice@",
+				UserGroup:  "hpc@",
+				Properties: []*mgmtpb.PoolProperty{numProp(daos.PoolPropertyReservedSpace, 0)},
+				Acl:        []string{"A::OWNER@:r", "A:G:hpc@:r"},
+			},
+			expProps: []*mgmtpb.PoolProperty{
+				numProp(daos.PoolPropertyReservedSpace, 0),
+				numProp(daos.PoolPropertySpaceReclaim, daos.PoolSpaceReclaimLazy),
+			},
+			expACL: []string{"A::OWNER@:r", "A:G:hpc@:r"},
+		},
+		"resolved groups match": {
+			resolver: &mockGroupResolver{groups: []string{"ai", "hpc"}},
+			req: &mgmtpb.PoolCreateReq{
+				User:      "alice@",
+				UserGroup: "users@",
+			},
+			expProps: []*mgmtpb.PoolProperty{
+				numProp(daos.PoolPropertySpaceReclaim, daos.PoolSpaceReclaimLazy),
+				numProp(daos.PoolPropertyReservedSpace, 5),
+			},
+			expACL: []string{"A::OWNER@:rw", "A:G:GROUP@:rw", "A:G:hpc@:rw", "A:G:ai@:r"},
+		},
+		"resolver fails": {
+			resolver: &mockGroupResolver{err: errors.New("ldap unavailable")},
+			req: &mgmtpb.PoolCreateReq{
+				User:      "alice@",
+				UserGroup: "ai@",
+			},
+			expProps: []*mgmtpb.PoolProperty{
+				numProp(daos.PoolPropertyReservedSpace, 10),
+			},
+			expACL: []string{"A::OWNER@:rw", "A:G:GROUP@:rw", "A:G:ai@:r", "A:G:hpc@:r"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			svc.groupResolver = tc.resolver
+			var err error
+			svc.poolTemplates, err = newPoolGroupTemplates(templates)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			svc.poolCreateAddGroupTemplates(test.Context(t), tc.req)
+
+			if diff := cmp.Diff(tc.expProps, tc.req.Properties, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected properties (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expACL, tc.req.Acl); diff != "" {
+				t.Fatalf("unexpected acl (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_newPoolGroupTemplates(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfgs   []*config.PoolGroupTemplate
+		expACL []string
+		expErr error
+	}{
+		"invalid acl entry": {
+			cfgs: []*config.PoolGroupTemplate{
+				{Group: "hpc", ACL: []string{"A:G:hpc@:rwz"}},
+			},
+			expErr: errors.New("invalid acl entry"),
+		},
+		"acl entries normalized": {
+			cfgs: []*config.PoolGroupTemplate{
+				{Group: "hpc", ACL: []string{"A:G:hpc@:wr"}},
+			},
+			expACL: []string{"A:G:hpc@:rw"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			templates, err := newPoolGroupTemplates(tc.cfgs)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expACL, templates[0].acl); diff != "" {
+				t.Fatalf("unexpected acl (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_PoolDestroy(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	creating := system.PoolServiceStateCreating
@@ -818,6 +1116,7 @@ func TestServer_MgmtSvc_PoolDestroy(t *testing.T) {
 	for name, tc := range map[string]struct {
 		mgmtSvc            *mgmtSvc
 		poolSvcState       *system.PoolServiceState // Initial state.
+		destroyProtect     bool
 		req                *mgmtpb.PoolDestroyReq
 		junkResp           bool
 		drpcResps          []*mockDrpcResponse // Sequential list of dRPC responses.
@@ -877,12 +1176,19 @@ func TestServer_MgmtSvc_PoolDestroy(t *testing.T) {
 			},
 			expSvcState: &creating,
 		},
-		// getPoolService() returns error before evict dRPC is issued.
-		"recursive=true, already creating, evict dRPC fails -DER_AGAIN, remains creating": {
+		// Evict is skipped while the pool is being created, so destroy is attempted.
+		"recursive=true, already creating, destroy dRPC fails -DER_AGAIN, now destroying": {
 			req:          &mgmtpb.PoolDestroyReq{Id: mockUUID, Recursive: true},
 			poolSvcState: &creating,
-			expErr:       daos.TryAgain,
-			expSvcState:  &creating,
+			drpcResps: []*mockDrpcResponse{
+				&mockDrpcResponse{
+					Message: &mgmtpb.PoolDestroyResp{Status: int32(daos.TryAgain)},
+				},
+			},
+			expResp: &mgmtpb.PoolDestroyResp{
+				Status: int32(daos.TryAgain),
+			},
+			expSvcState: &destroying,
 		},
 		// getPoolService() returns TryAgain during list-cont and evict but errors ignored.
 		"force=true, already creating, evict dRPC fails -DER_AGAIN, remains creating": {
@@ -1244,6 +1550,28 @@ func TestServer_MgmtSvc_PoolDestroy(t *testing.T) {
 			// check passes.
 			expResp: &mgmtpb.PoolDestroyResp{},
 		},
+		"destroy protection enabled; destroy refused": {
+			req:            &mgmtpb.PoolDestroyReq{Id: mockUUID, Recursive: true, Force: true},
+			destroyProtect: true,
+			expErr:         FaultPoolDestroyProtected(mockUUID),
+		},
+		"destroy protection enabled on pool being created; successful destroy": {
+			req:            &mgmtpb.PoolDestroyReq{Id: mockUUID, Recursive: true},
+			poolSvcState:   &creating,
+			destroyProtect: true,
+			drpcResps: []*mockDrpcResponse{
+				&mockDrpcResponse{
+					Message: &mgmtpb.PoolDestroyResp{},
+				},
+			},
+			expDrpcReq: &mgmtpb.PoolDestroyReq{
+				Sys:       build.DefaultSystemName,
+				Id:        mockUUID,
+				SvcRanks:  []uint32{0, 1, 2, 3, 4, 5, 6, 7},
+				Recursive: true,
+			},
+			expResp: &mgmtpb.PoolDestroyResp{},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			buf.Reset()
@@ -1263,6 +1591,7 @@ func TestServer_MgmtSvc_PoolDestroy(t *testing.T) {
 			if tc.poolSvcState != nil {
 				curTestPoolSvc.State = *tc.poolSvcState
 			}
+			curTestPoolSvc.DestroyProtect = tc.destroyProtect
 
 			lock, ctx := getPoolLockCtx(t, nil, mgmtSvc.sysdb, curTestPoolSvc.PoolUUID)
 			defer lock.Release()
@@ -1903,6 +2232,156 @@ func TestServer_MgmtSvc_PoolEvict(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_PoolEvict_Filters(t *testing.T) {
+	now := uint64(time.Now().Unix())
+	mockHandles := []*mgmtpb.PoolHandle{
+		{Uuid: test.MockUUID(11), Machine: "host1", User: "alice@", Group: "users@", ConnectTime: now - 7200},
+		{Uuid: test.MockUUID(12), Machine: "host2", User: "alice@", Group: "admins@", ConnectTime: now - 60},
+		{Uuid: test.MockUUID(13), Machine: "host1", User: "bob@", Group: "users@", ConnectTime: now - 7200},
+		{Uuid: test.MockUUID(14), Machine: "host2", User: "bob@", Group: "users@"},
+	}
+	listResp := &mgmtpb.PoolListHandlesResp{
+		Machines: []string{"host1", "host2", "host1", "host2"},
+		Handles:  mockHandles,
+	}
+
+	for name, tc := range map[string]struct {
+		req         *mgmtpb.PoolEvictReq
+		drpcResps   []*mockDrpcResponse
+		expResp     *mgmtpb.PoolEvictResp
+		expDrpcReqs []drpc.Method
+		expHandles  []string
+		expErr      error
+	}{
+		"filters with handles": {
+			req: &mgmtpb.PoolEvictReq{
+				Id:      mockUUID,
+				User:    "alice",
+				Handles: []string{test.MockUUID(11)},
+			},
+			expErr: errors.New("may not be combined with a list of handles"),
+		},
+		"filters with destroy": {
+			req:    &mgmtpb.PoolEvictReq{Id: mockUUID, Group: "users", Destroy: true},
+			expErr: errors.New("may not be combined with destroy"),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.PoolEvictReq{Id: "missing", User: "alice"},
+			expErr: system.ErrPoolLabelNotFound("missing"),
+		},
+		"list handles fails": {
+			req: &mgmtpb.PoolEvictReq{Id: mockUUID, User: "alice"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolListHandlesResp{Status: int32(daos.Busy)}},
+			},
+			expErr: daos.Busy,
+		},
+		"handle details not reported": {
+			req: &mgmtpb.PoolEvictReq{Id: mockUUID, User: "alice"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolListHandlesResp{Machines: []string{"host1"}}},
+			},
+			expErr: errors.New("did not report open handle details"),
+		},
+		"no matching handles": {
+			req: &mgmtpb.PoolEvictReq{Id: mockUUID, User: "carol"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: listResp},
+			},
+			expResp:     &mgmtpb.PoolEvictResp{},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolListHandles},
+		},
+		"by user": {
+			req: &mgmtpb.PoolEvictReq{Id: mockUUID, User: "alice"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: listResp},
+				{Message: &mgmtpb.PoolEvictResp{Count: 2}},
+			},
+			expResp:     &mgmtpb.PoolEvictResp{Count: 2},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolListHandles, drpc.MethodPoolEvict},
+			expHandles:  []string{test.MockUUID(11), test.MockUUID(12)},
+		},
+		"by group and machine": {
+			req: &mgmtpb.PoolEvictReq{Id: mockUUID, Group: "users@", Machine: "host2"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: listResp},
+				{Message: &mgmtpb.PoolEvictResp{Count: 1}},
+			},
+			expResp:     &mgmtpb.PoolEvictResp{Count: 1},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolListHandles, drpc.MethodPoolEvict},
+			expHandles:  []string{test.MockUUID(14)},
+		},
+		"by age with unknown connect time": {
+			req: &mgmtpb.PoolEvictReq{Id: mockUUID, OlderThan: 3600},
+			drpcResps: []*mockDrpcResponse{
+				{Message: listResp},
+			},
+			expErr: errors.New("open time of handle"),
+		},
+		"by user and age": {
+			req: &mgmtpb.PoolEvictReq{Id: mockUUID, User: "alice", OlderThan: 3600},
+			drpcResps: []*mockDrpcResponse{
+				{Message: listResp},
+				{Message: &mgmtpb.PoolEvictResp{Count: 1}},
+			},
+			expResp:     &mgmtpb.PoolEvictResp{Count: 1},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolListHandles, drpc.MethodPoolEvict},
+			expHandles:  []string{test.MockUUID(11)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ms := newTestMgmtSvc(t, log)
+			addTestPools(t, ms.sysdb, mockUUID)
+
+			cfg := new(mockDrpcClientConfig)
+			for _, mock := range tc.drpcResps {
+				cfg.setSendMsgResponseList(t, mock)
+			}
+			mdc := newMockDrpcClient(cfg)
+			setupSvcDrpcClient(ms, 0, mdc)
+
+			if tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := ms.PoolEvict(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			calls := mdc.calls.get()
+			var gotDrpcReqs []drpc.Method
+			for _, call := range calls {
+				gotDrpcReqs = append(gotDrpcReqs, call.Method)
+			}
+			if diff := cmp.Diff(tc.expDrpcReqs, gotDrpcReqs); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got)\n%s\n", diff)
+			}
+
+			if tc.expHandles == nil {
+				return
+			}
+			evictReq := new(mgmtpb.PoolEvictReq)
+			if err := proto.Unmarshal(calls[len(calls)-1].Body, evictReq); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, "", evictReq.User, "user filter passed to engine")
+			test.AssertEqual(t, uint64(0), evictReq.OlderThan, "age filter passed to engine")
+			if diff := cmp.Diff(tc.expHandles, evictReq.Handles); diff != "" {
+				t.Fatalf("unexpected evicted handles (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func newTestListPoolsReq() *mgmtpb.ListPoolsReq {
 	return &mgmtpb.ListPoolsReq{
 		Sys: build.DefaultSystemName,
@@ -1980,6 +2459,48 @@ func TestListPools_Success(t *testing.T) {
 	}
 }
 
+func TestListPools_ChangeToken(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, mockUUID)
+
+	req := newTestListPoolsReq()
+	resp, err := svc.ListPools(test.Context(t), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertFalse(t, resp.NotModified, "unexpected not modified response")
+	test.AssertEqual(t, 1, len(resp.Pools), "unexpected number of pools")
+
+	req.ChangeToken = resp.DataVersion
+	resp, err = svc.ListPools(test.Context(t), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expResp := &mgmtpb.ListPoolsResp{
+		DataVersion: req.ChangeToken,
+		NotModified: true,
+	}
+	if diff := cmp.Diff(expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("bad response (-want, +got): \n%s\n", diff)
+	}
+
+	addTestPoolService(t, svc.sysdb, &system.PoolService{
+		PoolUUID:  test.MockPoolUUID(2),
+		PoolLabel: "pool2",
+		State:     system.PoolServiceStateReady,
+		Replicas:  []ranklist.Rank{0},
+	})
+	resp, err = svc.ListPools(test.Context(t), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertFalse(t, resp.NotModified, "unexpected not modified response")
+	test.AssertEqual(t, 2, len(resp.Pools), "unexpected number of pools")
+}
+
 func newTestGetACLReq() *mgmtpb.GetACLReq {
 	return &mgmtpb.GetACLReq{
 		Sys: build.DefaultSystemName,
@@ -2345,6 +2866,7 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 	for name, tc := range map[string]struct {
 		mgmtSvc       *mgmtSvc
 		setupMockDrpc func(_ *mgmtSvc, _ error)
+		creationTime  time.Time
 		req           *mgmtpb.PoolQueryReq
 		expResp       *mgmtpb.PoolQueryResp
 		expErr        error
@@ -2434,14 +2956,32 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 				MemFileBytes: humanize.GiByte,
 			},
 		},
-	} {
-		t.Run(name, func(t *testing.T) {
-			buf.Reset()
-			defer test.ShowBufferOnFailure(t, buf)
-
-			if tc.mgmtSvc == nil {
-				tier := storage.NewTierConfig().
-					WithStorageClass("nvme").
+		"successful query; creation time recorded": {
+			creationTime: time.Unix(1700000000, 0),
+			req: &mgmtpb.PoolQueryReq{
+				Id: mockUUID,
+			},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				resp := &mgmtpb.PoolQueryResp{
+					State: mgmtpb.PoolServiceState_Ready,
+					Uuid:  mockUUID,
+				}
+				setupMockDrpcClient(svc, resp, nil)
+			},
+			expResp: &mgmtpb.PoolQueryResp{
+				State:      mgmtpb.PoolServiceState_Ready,
+				Uuid:       mockUUID,
+				CreateTime: 1700000000,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf.Reset()
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.mgmtSvc == nil {
+				tier := storage.NewTierConfig().
+					WithStorageClass("nvme").
 					WithBdevDeviceList("foo", "bar")
 				engineCfg := engine.MockConfig().
 					WithTargetCount(16).
@@ -2451,6 +2991,19 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 				tc.mgmtSvc = newTestMgmtSvcWithProvider(t, log, mp)
 			}
 			addTestPools(t, tc.mgmtSvc.sysdb, mockUUID)
+			if !tc.creationTime.IsZero() {
+				ps, err := tc.mgmtSvc.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+				if err != nil {
+					t.Fatal(err)
+				}
+				ps.CreationTime = tc.creationTime
+				lock, ctx := getPoolLockCtx(t, nil, tc.mgmtSvc.sysdb, ps.PoolUUID)
+				err = tc.mgmtSvc.sysdb.UpdatePoolService(ctx, ps)
+				lock.Release()
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
 
 			if tc.setupMockDrpc == nil {
 				tc.setupMockDrpc = func(svc *mgmtSvc, err error) {
@@ -2478,17 +3031,137 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_PoolQueryTarget_AllRanks(t *testing.T) {
+	upIn := mgmtpb.PoolQueryTargetInfo_UP_IN
+	downOut := mgmtpb.PoolQueryTargetInfo_DOWN_OUT
+	poolQueryResp := &mgmtpb.PoolQueryResp{
+		TotalTargets:  6,
+		TotalEngines:  3,
+		EnabledRanks:  "0,2",
+		DisabledRanks: "1",
+	}
+	tgtResp := func(states ...mgmtpb.PoolQueryTargetInfo_TargetState) *mgmtpb.PoolQueryTargetResp {
+		resp := new(mgmtpb.PoolQueryTargetResp)
+		for _, state := range states {
+			resp.Infos = append(resp.Infos, &mgmtpb.PoolQueryTargetInfo{State: state})
+		}
+		return resp
+	}
+
+	for name, tc := range map[string]struct {
+		req         *mgmtpb.PoolQueryTargetReq
+		drpcResps   []*mockDrpcResponse
+		expResp     *mgmtpb.PoolQueryTargetResp
+		expDrpcReqs []drpc.Method
+		expErr      error
+	}{
+		"unhealthy only without all ranks": {
+			req:    &mgmtpb.PoolQueryTargetReq{UnhealthyOnly: true},
+			expErr: errors.New("all ranks"),
+		},
+		"pool query fails": {
+			req: &mgmtpb.PoolQueryTargetReq{AllRanks: true},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolQueryResp{Status: int32(daos.Busy)}},
+			},
+			expResp:     &mgmtpb.PoolQueryTargetResp{Status: int32(daos.Busy)},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolQuery},
+		},
+		"no engines": {
+			req: &mgmtpb.PoolQueryTargetReq{AllRanks: true},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolQueryResp{}},
+			},
+			expErr: errors.New("no engines"),
+		},
+		"all ranks": {
+			req: &mgmtpb.PoolQueryTargetReq{AllRanks: true},
+			drpcResps: []*mockDrpcResponse{
+				{Message: poolQueryResp},
+				{Message: tgtResp(upIn, upIn)},
+				{Message: tgtResp(downOut, downOut)},
+				{Message: &mgmtpb.PoolQueryTargetResp{Status: int32(daos.Nonexistent)}},
+			},
+			expResp: &mgmtpb.PoolQueryTargetResp{
+				Ranks: []*mgmtpb.PoolQueryTargetResp_RankTargets{
+					{Rank: 0, States: []mgmtpb.PoolQueryTargetInfo_TargetState{upIn, upIn}},
+					{Rank: 1, States: []mgmtpb.PoolQueryTargetInfo_TargetState{downOut, downOut}},
+					{Rank: 2, Status: int32(daos.Nonexistent)},
+				},
+			},
+			expDrpcReqs: []drpc.Method{
+				drpc.MethodPoolQuery, drpc.MethodPoolQueryTarget,
+				drpc.MethodPoolQueryTarget, drpc.MethodPoolQueryTarget,
+			},
+		},
+		"unhealthy only": {
+			req: &mgmtpb.PoolQueryTargetReq{AllRanks: true, UnhealthyOnly: true},
+			drpcResps: []*mockDrpcResponse{
+				{Message: poolQueryResp},
+				{Message: tgtResp(upIn, upIn)},
+				{Message: tgtResp(upIn, downOut)},
+				{Message: tgtResp(upIn, upIn)},
+			},
+			expResp: &mgmtpb.PoolQueryTargetResp{
+				Ranks: []*mgmtpb.PoolQueryTargetResp_RankTargets{
+					{Rank: 1, States: []mgmtpb.PoolQueryTargetInfo_TargetState{upIn, downOut}},
+				},
+			},
+			expDrpcReqs: []drpc.Method{
+				drpc.MethodPoolQuery, drpc.MethodPoolQueryTarget,
+				drpc.MethodPoolQueryTarget, drpc.MethodPoolQueryTarget,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, mockUUID)
+
+			cfg := new(mockDrpcClientConfig)
+			for _, mock := range tc.drpcResps {
+				cfg.setSendMsgResponseList(t, mock)
+			}
+			mdc := newMockDrpcClient(cfg)
+			setupSvcDrpcClient(svc, 0, mdc)
+
+			tc.req.Sys = build.DefaultSystemName
+			tc.req.Id = mockUUID
+			gotResp, gotErr := svc.PoolQueryTarget(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			var gotDrpcReqs []drpc.Method
+			for _, call := range mdc.calls.get() {
+				gotDrpcReqs = append(gotDrpcReqs, call.Method)
+			}
+			if diff := cmp.Diff(tc.expDrpcReqs, gotDrpcReqs); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func getLastMockCall(mdc *mockDrpcClient) *drpc.Call {
 	return mdc.SendMsgInputCall
 }
 
 func TestServer_MgmtSvc_PoolSetProp(t *testing.T) {
 	for name, tc := range map[string]struct {
-		getMockDrpc func(error) *mockDrpcClient
-		drpcResp    *mgmtpb.PoolSetPropResp
-		req         *mgmtpb.PoolSetPropReq
-		expDrpcReq  *mgmtpb.PoolSetPropReq
-		expErr      error
+		getMockDrpc       func(error) *mockDrpcClient
+		drpcResp          *mgmtpb.PoolSetPropResp
+		req               *mgmtpb.PoolSetPropReq
+		expDrpcReq        *mgmtpb.PoolSetPropReq
+		expDestroyProtect bool
+		expErr            error
 	}{
 		"wrong system": {
 			req:    &mgmtpb.PoolSetPropReq{Id: mockUUID, Sys: "bad"},
@@ -2583,6 +3256,49 @@ func TestServer_MgmtSvc_PoolSetProp(t *testing.T) {
 				},
 			},
 		},
+		"invalid destroy protection value": {
+			req: &mgmtpb.PoolSetPropReq{
+				Id: mockUUID,
+				Properties: []*mgmtpb.PoolProperty{
+					{
+						Number: daos.PoolPropertyDestroyProtect,
+						Value:  &mgmtpb.PoolProperty_Numval{2},
+					},
+					{
+						Number: daos.PoolPropertySpaceReclaim,
+						Value:  &mgmtpb.PoolProperty_Numval{daos.PoolSpaceReclaimDisabled},
+					},
+				},
+			},
+			expErr: errors.New("invalid destroy_protect value"),
+		},
+		"destroy protection is not passed to engine": {
+			req: &mgmtpb.PoolSetPropReq{
+				Id: mockUUID,
+				Properties: []*mgmtpb.PoolProperty{
+					{
+						Number: daos.PoolPropertyDestroyProtect,
+						Value:  &mgmtpb.PoolProperty_Numval{daos.PoolDestroyProtectEnabled},
+					},
+					{
+						Number: daos.PoolPropertySpaceReclaim,
+						Value:  &mgmtpb.PoolProperty_Numval{daos.PoolSpaceReclaimDisabled},
+					},
+				},
+			},
+			expDrpcReq: &mgmtpb.PoolSetPropReq{
+				Sys:      build.DefaultSystemName,
+				SvcRanks: []uint32{0},
+				Id:       mockUUID,
+				Properties: []*mgmtpb.PoolProperty{
+					{
+						Number: daos.PoolPropertySpaceReclaim,
+						Value:  &mgmtpb.PoolProperty_Numval{daos.PoolSpaceReclaimDisabled},
+					},
+				},
+			},
+			expDestroyProtect: true,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -2618,6 +3334,13 @@ func TestServer_MgmtSvc_PoolSetProp(t *testing.T) {
 			if diff := cmp.Diff(tc.expDrpcReq, lastReq, test.DefaultCmpOpts()...); diff != "" {
 				t.Fatalf("unexpected final dRPC request (-want, +got):\n%s\n", diff)
 			}
+
+			ps, err := ms.sysdb.FindPoolServiceByUUID(uuid.MustParse(tc.req.Id))
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expDestroyProtect, ps.DestroyProtect,
+				"unexpected destroy protection")
 		})
 	}
 }
@@ -2628,6 +3351,7 @@ func TestServer_MgmtSvc_PoolGetProp(t *testing.T) {
 		drpcResp      *mgmtpb.PoolGetPropResp
 		req           *mgmtpb.PoolGetPropReq
 		expDrpcReq    *mgmtpb.PoolGetPropReq
+		expResp       *mgmtpb.PoolGetPropResp
 		expErr        error
 	}{
 		"wrong system": {
@@ -2668,6 +3392,60 @@ func TestServer_MgmtSvc_PoolGetProp(t *testing.T) {
 				},
 			},
 		},
+		"destroy protection only; no dRPC": {
+			req: &mgmtpb.PoolGetPropReq{
+				Id: mockUUID,
+				Properties: []*mgmtpb.PoolProperty{
+					{
+						Number: daos.PoolPropertyDestroyProtect,
+					},
+				},
+			},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				setupSvcDrpcClient(svc, 0, getMockDrpcClient(nil, errors.New("unexpected dRPC")))
+			},
+			expResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{
+						Number: daos.PoolPropertyDestroyProtect,
+						Value:  &mgmtpb.PoolProperty_Numval{daos.PoolDestroyProtectDisabled},
+					},
+				},
+			},
+		},
+		"destroy protection with engine props": {
+			req: &mgmtpb.PoolGetPropReq{
+				Id: mockUUID,
+				Properties: []*mgmtpb.PoolProperty{
+					{
+						Number: daos.PoolPropertyDestroyProtect,
+					},
+					{
+						Number: daos.PoolPropertySpaceReclaim,
+					},
+				},
+			},
+			drpcResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{
+						Number: daos.PoolPropertySpaceReclaim,
+						Value:  &mgmtpb.PoolProperty_Numval{daos.PoolSpaceReclaimLazy},
+					},
+				},
+			},
+			expResp: &mgmtpb.PoolGetPropResp{
+				Properties: []*mgmtpb.PoolProperty{
+					{
+						Number: daos.PoolPropertySpaceReclaim,
+						Value:  &mgmtpb.PoolProperty_Numval{daos.PoolSpaceReclaimLazy},
+					},
+					{
+						Number: daos.PoolPropertyDestroyProtect,
+						Value:  &mgmtpb.PoolProperty_Numval{daos.PoolDestroyProtectDisabled},
+					},
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -2688,11 +3466,18 @@ func TestServer_MgmtSvc_PoolGetProp(t *testing.T) {
 			if tc.req != nil && tc.req.Sys == "" {
 				tc.req.Sys = build.DefaultSystemName
 			}
-			_, gotErr := ms.PoolGetProp(test.Context(t), tc.req)
+			gotResp, gotErr := ms.PoolGetProp(test.Context(t), tc.req)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
 			}
+
+			if tc.expResp == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
 		})
 	}
 }
@@ -2788,3 +3573,540 @@ func TestServer_MgmtSvc_PoolUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_MgmtSvc_PoolRebalance(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	missingSB := newTestMgmtSvc(t, log)
+	missingSB.harness.instances[0].(*EngineInstance)._superblock = nil
+	notAP := newTestMgmtSvc(t, log)
+	testPoolService := &system.PoolService{
+		PoolUUID: uuid.MustParse(mockUUID),
+		State:    system.PoolServiceStateReady,
+		Replicas: []ranklist.Rank{0},
+	}
+
+	for name, tc := range map[string]struct {
+		mgmtSvc       *mgmtSvc
+		setupMockDrpc func(_ *mgmtSvc, _ error)
+		req           *mgmtpb.PoolRebalanceReq
+		expResp       *mgmtpb.PoolRebalanceResp
+		expErr        error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolRebalanceReq{Id: mockUUID, Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"missing superblock": {
+			mgmtSvc: missingSB,
+			req:     &mgmtpb.PoolRebalanceReq{Id: mockUUID},
+			expErr:  errNotReplica,
+		},
+		"not MS replica": {
+			mgmtSvc: notAP,
+			req:     &mgmtpb.PoolRebalanceReq{Id: mockUUID},
+			expErr:  errNotReplica,
+		},
+		"dRPC send fails": {
+			req:    &mgmtpb.PoolRebalanceReq{Id: mockUUID},
+			expErr: errors.New("send failure"),
+		},
+		"garbage resp": {
+			req: &mgmtpb.PoolRebalanceReq{Id: mockUUID},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				// dRPC call returns junk in the message body
+				badBytes := makeBadBytes(42)
+
+				setupSvcDrpcClient(svc, 0, getMockDrpcClientBytes(badBytes, err))
+			},
+			expErr: errors.New("unmarshal"),
+		},
+		"missing uuid": {
+			req:    &mgmtpb.PoolRebalanceReq{},
+			expErr: errors.New("empty pool id"),
+		},
+		"successful rebalance": {
+			req:     &mgmtpb.PoolRebalanceReq{Id: mockUUID},
+			expResp: &mgmtpb.PoolRebalanceResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf.Reset()
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.mgmtSvc == nil {
+				tc.mgmtSvc = newTestMgmtSvc(t, log)
+			}
+			addTestPoolService(t, tc.mgmtSvc.sysdb, testPoolService)
+
+			if tc.setupMockDrpc == nil {
+				tc.setupMockDrpc = func(svc *mgmtSvc, err error) {
+					setupSvcDrpcClient(svc, 0, getMockDrpcClient(tc.expResp, tc.expErr))
+				}
+			}
+			tc.setupMockDrpc(tc.mgmtSvc, tc.expErr)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := tc.mgmtSvc.PoolRebalance(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := test.DefaultCmpOpts()
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolRenameLabel(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req         *mgmtpb.PoolRenameLabelReq
+		drpcResps   []*mockDrpcResponse
+		expResp     *mgmtpb.PoolRenameLabelResp
+		expDrpcReqs []drpc.Method
+		expLabel    string
+		expAliases  []string
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolRenameLabelReq{Id: "0", NewLabel: "new", Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"invalid label": {
+			req:    &mgmtpb.PoolRenameLabelReq{Id: "0", NewLabel: "bad label"},
+			expErr: errors.New("invalid pool label"),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.PoolRenameLabelReq{Id: "missing", NewLabel: "new"},
+			expErr: system.ErrPoolLabelNotFound("missing"),
+		},
+		"same label": {
+			req:    &mgmtpb.PoolRenameLabelReq{Id: mockUUID, NewLabel: "0"},
+			expErr: errors.New("already has label"),
+		},
+		"label is not unique": {
+			req:    &mgmtpb.PoolRenameLabelReq{Id: "0", NewLabel: "1"},
+			expErr: FaultPoolDuplicateLabel("1"),
+		},
+		"label is alias of another pool": {
+			req:    &mgmtpb.PoolRenameLabelReq{Id: "0", NewLabel: "one"},
+			expErr: FaultPoolDuplicateLabel("one"),
+		},
+		"set prop fails": {
+			req: &mgmtpb.PoolRenameLabelReq{Id: "0", NewLabel: "new"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolSetPropResp{Status: int32(daos.NoPermission)}},
+			},
+			expErr:   errors.New("label update failed"),
+			expLabel: "0",
+		},
+		"list handles fails": {
+			req: &mgmtpb.PoolRenameLabelReq{Id: "0", NewLabel: "new"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolSetPropResp{}},
+				{Message: &mgmtpb.PoolListHandlesResp{Status: int32(daos.Busy)}},
+			},
+			expResp: &mgmtpb.PoolRenameLabelResp{
+				Uuid:           mockUUID,
+				OldLabel:       "0",
+				HandlesUnknown: true,
+			},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolSetProp, drpc.MethodPoolListHandles},
+			expLabel:    "new",
+		},
+		"success": {
+			req: &mgmtpb.PoolRenameLabelReq{Id: "0", NewLabel: "new"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolSetPropResp{}},
+				{Message: &mgmtpb.PoolListHandlesResp{
+					Machines: []string{"host2", "host1", "host2"},
+				}},
+			},
+			expResp: &mgmtpb.PoolRenameLabelResp{
+				Uuid:           mockUUID,
+				OldLabel:       "0",
+				ClientMachines: []string{"host1", "host2"},
+			},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolSetProp, drpc.MethodPoolListHandles},
+			expLabel:    "new",
+		},
+		"rename by alias to the alias": {
+			req: &mgmtpb.PoolRenameLabelReq{Id: "zero", NewLabel: "zero"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolSetPropResp{}},
+				{Message: &mgmtpb.PoolListHandlesResp{}},
+			},
+			expResp: &mgmtpb.PoolRenameLabelResp{
+				Uuid:     mockUUID,
+				OldLabel: "0",
+			},
+			expDrpcReqs: []drpc.Method{drpc.MethodPoolSetProp, drpc.MethodPoolListHandles},
+			expLabel:    "zero",
+			expAliases:  []string{"nil"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ms := newTestMgmtSvc(t, log)
+			addTestPools(t, ms.sysdb, mockUUID, test.MockUUID(3))
+			setTestPoolAliases(t, ms.sysdb, mockUUID, "zero", "nil")
+			setTestPoolAliases(t, ms.sysdb, test.MockUUID(3), "one")
+
+			cfg := new(mockDrpcClientConfig)
+			for _, mock := range tc.drpcResps {
+				cfg.setSendMsgResponseList(t, mock)
+			}
+			mdc := newMockDrpcClient(cfg)
+			setupSvcDrpcClient(ms, 0, mdc)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := ms.PoolRenameLabel(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if tc.expLabel != "" {
+				ps, err := ms.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if ps.PoolLabel != tc.expLabel {
+					t.Fatalf("expected pool label %q, got %q", tc.expLabel, ps.PoolLabel)
+				}
+				if tc.expAliases != nil {
+					test.AssertEqual(t, tc.expAliases, ps.Aliases, "unexpected pool aliases")
+				}
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			var gotDrpcReqs []drpc.Method
+			for _, call := range mdc.calls.get() {
+				gotDrpcReqs = append(gotDrpcReqs, call.Method)
+			}
+			if diff := cmp.Diff(tc.expDrpcReqs, gotDrpcReqs); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolUpdateAliases(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req        *mgmtpb.PoolUpdateAliasesReq
+		expResp    *mgmtpb.PoolUpdateAliasesResp
+		expAliases []string
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.PoolUpdateAliasesReq{Id: "0", Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"unknown pool": {
+			req:    &mgmtpb.PoolUpdateAliasesReq{Id: "missing"},
+			expErr: system.ErrPoolLabelNotFound("missing"),
+		},
+		"list": {
+			req: &mgmtpb.PoolUpdateAliasesReq{Id: mockUUID},
+			expResp: &mgmtpb.PoolUpdateAliasesResp{
+				Uuid:    mockUUID,
+				Label:   "0",
+				Aliases: []string{"zero"},
+			},
+			expAliases: []string{"zero"},
+		},
+		"invalid alias": {
+			req:        &mgmtpb.PoolUpdateAliasesReq{Id: "0", Add: []string{"bad alias"}},
+			expErr:     errors.New("invalid pool alias"),
+			expAliases: []string{"zero"},
+		},
+		"alias is own label": {
+			req:        &mgmtpb.PoolUpdateAliasesReq{Id: "0", Add: []string{"0"}},
+			expErr:     errors.New("already has label or alias"),
+			expAliases: []string{"zero"},
+		},
+		"alias already added": {
+			req:        &mgmtpb.PoolUpdateAliasesReq{Id: "0", Add: []string{"zero"}},
+			expErr:     errors.New("already has label or alias"),
+			expAliases: []string{"zero"},
+		},
+		"alias is label of another pool": {
+			req:        &mgmtpb.PoolUpdateAliasesReq{Id: "0", Add: []string{"1"}},
+			expErr:     FaultPoolDuplicateLabel("1"),
+			expAliases: []string{"zero"},
+		},
+		"alias is alias of another pool": {
+			req:        &mgmtpb.PoolUpdateAliasesReq{Id: "0", Add: []string{"new", "one"}},
+			expErr:     FaultPoolDuplicateLabel("one"),
+			expAliases: []string{"zero"},
+		},
+		"remove unknown alias": {
+			req:        &mgmtpb.PoolUpdateAliasesReq{Id: "0", Remove: []string{"one"}},
+			expErr:     errors.New("has no alias"),
+			expAliases: []string{"zero"},
+		},
+		"add by alias": {
+			req: &mgmtpb.PoolUpdateAliasesReq{Id: "zero", Add: []string{"new"}},
+			expResp: &mgmtpb.PoolUpdateAliasesResp{
+				Uuid:    mockUUID,
+				Label:   "0",
+				Aliases: []string{"zero", "new"},
+			},
+			expAliases: []string{"zero", "new"},
+		},
+		"add and remove": {
+			req: &mgmtpb.PoolUpdateAliasesReq{
+				Id:     "0",
+				Add:    []string{"new"},
+				Remove: []string{"zero"},
+			},
+			expResp: &mgmtpb.PoolUpdateAliasesResp{
+				Uuid:    mockUUID,
+				Label:   "0",
+				Aliases: []string{"new"},
+			},
+			expAliases: []string{"new"},
+		},
+		"replace alias": {
+			req: &mgmtpb.PoolUpdateAliasesReq{
+				Id:     "0",
+				Add:    []string{"zero"},
+				Remove: []string{"zero"},
+			},
+			expResp: &mgmtpb.PoolUpdateAliasesResp{
+				Uuid:    mockUUID,
+				Label:   "0",
+				Aliases: []string{"zero"},
+			},
+			expAliases: []string{"zero"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ms := newTestMgmtSvc(t, log)
+			addTestPools(t, ms.sysdb, mockUUID, test.MockUUID(3))
+			setTestPoolAliases(t, ms.sysdb, mockUUID, "zero")
+			setTestPoolAliases(t, ms.sysdb, test.MockUUID(3), "one")
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := ms.PoolUpdateAliases(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if tc.expAliases != nil {
+				ps, err := ms.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+				if err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, tc.expAliases, ps.Aliases, "unexpected pool aliases")
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_poolLabelsUnusedCheck(t *testing.T) {
+	pools := []*system.PoolService{
+		{PoolUUID: test.MockPoolUUID(1), PoolLabel: "one", Aliases: []string{"uno"}},
+		{PoolUUID: test.MockPoolUUID(2), PoolLabel: "two", Aliases: []string{"dos"}},
+	}
+
+	for name, tc := range map[string]struct {
+		poolUUID uuid.UUID
+		labels   []string
+		expErr   error
+	}{
+		"no labels": {
+			poolUUID: test.MockPoolUUID(1),
+		},
+		"unused labels": {
+			poolUUID: test.MockPoolUUID(1),
+			labels:   []string{"three", "tres"},
+		},
+		"empty label ignored": {
+			poolUUID: test.MockPoolUUID(1),
+			labels:   []string{""},
+		},
+		"own label and alias": {
+			poolUUID: test.MockPoolUUID(1),
+			labels:   []string{"one", "uno"},
+		},
+		"label of another pool": {
+			poolUUID: test.MockPoolUUID(1),
+			labels:   []string{"three", "two"},
+			expErr:   FaultPoolDuplicateLabel("two"),
+		},
+		"alias of another pool": {
+			poolUUID: test.MockPoolUUID(1),
+			labels:   []string{"dos"},
+			expErr:   FaultPoolDuplicateLabel("dos"),
+		},
+		"new pool": {
+			poolUUID: uuid.Nil,
+			labels:   []string{"uno"},
+			expErr:   FaultPoolDuplicateLabel("uno"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := poolLabelsUnusedCheck(tc.poolUUID, tc.labels...)(pools)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolMembershipChanges(t *testing.T) {
+	mockEvent := func(evt *events.RASEvent, ts string) *events.RASEvent {
+		evt.Timestamp = ts
+		return evt
+	}
+	mockEvents := []*events.RASEvent{
+		mockEvent(events.NewPoolMembershipChangedEvent(test.MockUUID(1), "extend"),
+			"2025-01-02T03:00:00.000000+00:00"),
+		mockEvent(events.NewGenericEvent(events.RASUnknownEvent, events.RASSeverityNotice, "foo", ""),
+			"2025-01-02T04:00:00.000000+00:00"),
+		mockEvent(events.NewPoolMembershipChangedEvent(test.MockUUID(2), "rebuild"),
+			"2025-01-02T05:00:00.000000+00:00"),
+	}
+
+	for name, tc := range map[string]struct {
+		numLater  int // generic events recorded after the mock events
+		req       *mgmtpb.PoolMembershipChangesReq
+		expResp   *mgmtpb.PoolMembershipChangesResp
+		expAPIErr error
+	}{
+		"nil req": {
+			req:       (*mgmtpb.PoolMembershipChangesReq)(nil),
+			expAPIErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:       &mgmtpb.PoolMembershipChangesReq{Sys: "quack"},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"all changes": {
+			req: &mgmtpb.PoolMembershipChangesReq{},
+			expResp: &mgmtpb.PoolMembershipChangesResp{
+				Changes: []*mgmtpb.PoolMembershipChange{
+					{
+						Seq:       1,
+						PoolUuid:  test.MockUUID(1),
+						Op:        "extend",
+						Timestamp: "2025-01-02T03:00:00.000+00:00",
+					},
+					{
+						Seq:       3,
+						PoolUuid:  test.MockUUID(2),
+						Op:        "rebuild",
+						Timestamp: "2025-01-02T05:00:00.000+00:00",
+					},
+				},
+				LastSeq: 3,
+			},
+		},
+		"changes after sequence": {
+			req: &mgmtpb.PoolMembershipChangesReq{AfterSeq: 1},
+			expResp: &mgmtpb.PoolMembershipChangesResp{
+				Changes: []*mgmtpb.PoolMembershipChange{
+					{
+						Seq:       3,
+						PoolUuid:  test.MockUUID(2),
+						Op:        "rebuild",
+						Timestamp: "2025-01-02T05:00:00.000+00:00",
+					},
+				},
+				LastSeq: 3,
+			},
+		},
+		"no new changes": {
+			req: &mgmtpb.PoolMembershipChangesReq{AfterSeq: 3},
+			expResp: &mgmtpb.PoolMembershipChangesResp{
+				LastSeq: 3,
+			},
+		},
+		"changes discarded": {
+			numLater: raft.MaxEventRecords,
+			req:      &mgmtpb.PoolMembershipChangesReq{AfterSeq: 1},
+			expResp: &mgmtpb.PoolMembershipChangesResp{
+				LastSeq: 3 + raft.MaxEventRecords,
+				Resync:  true,
+			},
+		},
+		"no changes discarded after sequence": {
+			numLater: raft.MaxEventRecords,
+			req:      &mgmtpb.PoolMembershipChangesReq{AfterSeq: 3},
+			expResp: &mgmtpb.PoolMembershipChangesResp{
+				LastSeq: 3 + raft.MaxEventRecords,
+			},
+		},
+		"latest sequence only": {
+			numLater: raft.MaxEventRecords,
+			req:      &mgmtpb.PoolMembershipChangesReq{AfterSeq: math.MaxUint64},
+			expResp: &mgmtpb.PoolMembershipChangesResp{
+				LastSeq: 3 + raft.MaxEventRecords,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{}, []*control.HostResponse{})
+			if err := svc.sysdb.AddEvents(mockEvents); err != nil {
+				t.Fatal(err)
+			}
+			var later []*events.RASEvent
+			for i := 0; i < tc.numLater; i++ {
+				later = append(later, events.NewGenericEvent(events.RASUnknownEvent,
+					events.RASSeverityNotice, "bar", ""))
+			}
+			if err := svc.sysdb.AddEvents(later); err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotAPIErr := svc.PoolMembershipChanges(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
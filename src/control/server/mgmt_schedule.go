@@ -0,0 +1,195 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	scheduledRankActionsProp    = "scheduled_rank_actions"
+	scheduledRankActionInterval = 10 * time.Second
+)
+
+// getScheduledRankActions returns the pending scheduled rank actions stored in the system
+// database.
+func (svc *mgmtSvc) getScheduledRankActions() ([]*mgmtpb.ScheduledRankAction, error) {
+	var actions []*mgmtpb.ScheduledRankAction
+
+	propStr, err := system.GetMgmtProperty(svc.sysdb, scheduledRankActionsProp)
+	if err != nil {
+		if system.IsErrSystemAttrNotFound(err) {
+			return actions, nil
+		}
+		return nil, err
+	}
+	if propStr == "" {
+		return actions, nil
+	}
+
+	if err := json.Unmarshal([]byte(propStr), &actions); err != nil {
+		return nil, errors.Wrapf(err, "invalid %q property", scheduledRankActionsProp)
+	}
+	return actions, nil
+}
+
+func (svc *mgmtSvc) setScheduledRankActions(actions []*mgmtpb.ScheduledRankAction) error {
+	propBytes, err := json.Marshal(actions)
+	if err != nil {
+		return err
+	}
+	return system.SetMgmtProperty(svc.sysdb, scheduledRankActionsProp, string(propBytes))
+}
+
+// addScheduledRankAction stores a new scheduled rank action in the system database.
+func (svc *mgmtSvc) addScheduledRankAction(action *mgmtpb.ScheduledRankAction) error {
+	svc.schedActionsLock.Lock()
+	defer svc.schedActionsLock.Unlock()
+
+	actions, err := svc.getScheduledRankActions()
+	if err != nil {
+		return err
+	}
+
+	svc.log.Noticef("scheduled admin-excluded state update: %s", scheduledRankActionString(action))
+	return svc.setScheduledRankActions(append(actions, action))
+}
+
+func scheduledRankActionString(action *mgmtpb.ScheduledRankAction) string {
+	op := "set"
+	if action.Clear {
+		op = "clear"
+	}
+	str := fmt.Sprintf("%s on ranks %s at %s", op, action.Ranks, unixTimeString(action.Start))
+	if action.End != 0 {
+		str += fmt.Sprintf(", clear at %s", unixTimeString(action.End))
+	}
+	return str
+}
+
+func unixTimeString(secs uint64) string {
+	return time.Unix(int64(secs), 0).Format(time.RFC3339)
+}
+
+// runScheduledRankAction applies any part of the scheduled rank action that is due at the
+// given time and indicates whether the action has been updated and whether it has completed.
+func (svc *mgmtSvc) runScheduledRankAction(action *mgmtpb.ScheduledRankAction, now uint64) (updated, done bool, err error) {
+	ranks, err := ranklist.CreateRankSet(action.Ranks)
+	if err != nil {
+		return false, true, err
+	}
+
+	if !action.Active {
+		if now < action.Start {
+			return false, false, nil
+		}
+		if action.End != 0 && now >= action.End {
+			return false, true, errors.New("maintenance window elapsed before it could be started")
+		}
+
+		if _, err := svc.setRanksAdminExcluded(ranks.Ranks(), action.Clear); err != nil {
+			return false, true, err
+		}
+		if action.End == 0 {
+			return true, true, nil
+		}
+		action.Active = true
+		return true, false, nil
+	}
+
+	if now < action.End {
+		return false, false, nil
+	}
+	if _, err := svc.setRanksAdminExcluded(ranks.Ranks(), true); err != nil {
+		return false, true, err
+	}
+	return true, true, nil
+}
+
+// runScheduledRankActions applies all of the scheduled rank actions that are due at the given
+// time, removing completed actions from the system database.
+func (svc *mgmtSvc) runScheduledRankActions(ctx context.Context, now time.Time) error {
+	svc.schedActionsLock.Lock()
+	defer svc.schedActionsLock.Unlock()
+
+	actions, err := svc.getScheduledRankActions()
+	if err != nil {
+		return err
+	}
+
+	var pending []*mgmtpb.ScheduledRankAction
+	var changed, membersUpdated bool
+	for _, action := range actions {
+		updated, done, err := svc.runScheduledRankAction(action, uint64(now.Unix()))
+		if err != nil {
+			svc.log.Errorf("scheduled admin-excluded state update (%s) failed: %s",
+				scheduledRankActionString(action), err)
+		} else if updated {
+			svc.log.Noticef("applied scheduled admin-excluded state update (%s)",
+				scheduledRankActionString(action))
+		}
+		membersUpdated = membersUpdated || updated
+		changed = changed || updated || done
+
+		if !done {
+			pending = append(pending, action)
+		}
+	}
+
+	if membersUpdated {
+		svc.reqGroupUpdate(ctx, false)
+	}
+	if !changed {
+		return nil
+	}
+	return svc.setScheduledRankActions(pending)
+}
+
+// scheduledRankActionLoop periodically applies scheduled rank actions that have become due.
+func (svc *mgmtSvc) scheduledRankActionLoop(parent context.Context) {
+	ticker := time.NewTicker(scheduledRankActionInterval)
+	defer ticker.Stop()
+
+	svc.log.Debug("starting scheduledRankActionLoop")
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped scheduledRankActionLoop")
+			return
+		case now := <-ticker.C:
+			if err := svc.runScheduledRankActions(parent, now); err != nil {
+				svc.log.Errorf("failed to run scheduled rank actions: %s", err)
+			}
+		}
+	}
+}
+
+// SystemListScheduled returns the pending scheduled updates of the excluded state of ranks.
+func (svc *mgmtSvc) SystemListScheduled(ctx context.Context, req *mgmtpb.SystemListScheduledReq) (*mgmtpb.SystemListScheduledResp, error) {
+	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	svc.schedActionsLock.Lock()
+	defer svc.schedActionsLock.Unlock()
+
+	actions, err := svc.getScheduledRankActions()
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.SystemListScheduledResp{Actions: actions}, nil
+}
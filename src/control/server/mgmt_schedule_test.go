@@ -0,0 +1,270 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_SystemExclude_Scheduled(t *testing.T) {
+	future := uint64(time.Now().Add(24 * time.Hour).Unix())
+	hour := uint64(time.Hour.Seconds())
+
+	for name, tc := range map[string]struct {
+		req          *mgmtpb.SystemExcludeReq
+		expResults   []*sharedpb.RankResult
+		expScheduled *mgmtpb.ScheduledRankAction
+		expMembers   system.Members
+		expAPIErr    error
+	}{
+		"clear with duration": {
+			req:       &mgmtpb.SystemExcludeReq{Ranks: "0-1", Clear: true, Duration: hour},
+			expAPIErr: errors.New("duration cannot be specified"),
+		},
+		"exclude in future": {
+			req: &mgmtpb.SystemExcludeReq{Ranks: "0-1", Start: future},
+			expScheduled: &mgmtpb.ScheduledRankAction{
+				Ranks: "0-1",
+				Start: future,
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "joined"),
+			},
+		},
+		"exclude hosts in future with duration": {
+			req: &mgmtpb.SystemExcludeReq{
+				Hosts:    test.MockHostAddr(1).String(),
+				Start:    future,
+				Duration: hour,
+			},
+			expScheduled: &mgmtpb.ScheduledRankAction{
+				Ranks: "0-1",
+				Start: future,
+				End:   future + hour,
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "joined"),
+			},
+		},
+		"clear exclude in future": {
+			req: &mgmtpb.SystemExcludeReq{Ranks: "2", Start: future, Clear: true},
+			expScheduled: &mgmtpb.ScheduledRankAction{
+				Ranks: "2",
+				Clear: true,
+				Start: future,
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "joined"),
+			},
+		},
+		"exclude now with duration": {
+			req: &mgmtpb.SystemExcludeReq{Ranks: "0", Duration: hour},
+			expResults: []*sharedpb.RankResult{
+				mockRankSuccess("set admin-excluded state", 0, 1),
+			},
+			expScheduled: &mgmtpb.ScheduledRankAction{
+				Ranks:  "0",
+				Active: true,
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "adminexcluded"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "joined"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "joined"),
+			}, nil)
+			tc.req.Sys = build.DefaultSystemName
+
+			gotResp, gotAPIErr := svc.SystemExclude(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			checkRankResults(t, tc.expResults, gotResp.Results)
+			checkMembers(t, tc.expMembers, svc.membership)
+
+			gotScheduled := gotResp.Scheduled
+			if gotScheduled.Active {
+				// Start time is determined by the MS for immediate requests.
+				if gotScheduled.End-gotScheduled.Start != tc.req.Duration {
+					t.Fatalf("unexpected window length %ds, want %ds",
+						gotScheduled.End-gotScheduled.Start, tc.req.Duration)
+				}
+				gotScheduled.Start, gotScheduled.End = 0, 0
+			}
+			if diff := cmp.Diff(tc.expScheduled, gotScheduled, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected scheduled action (-want, +got)\n%s\n", diff)
+			}
+
+			listResp, err := svc.SystemListScheduled(test.Context(t),
+				&mgmtpb.SystemListScheduledReq{Sys: build.DefaultSystemName})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(listResp.Actions) != 1 {
+				t.Fatalf("expected 1 scheduled action, got %d", len(listResp.Actions))
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_runScheduledRankActions(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	past := uint64(now.Unix() - 60)
+	future := uint64(now.Unix() + 60)
+
+	for name, tc := range map[string]struct {
+		actions    []*mgmtpb.ScheduledRankAction
+		expActions []*mgmtpb.ScheduledRankAction
+		expMembers system.Members
+	}{
+		"no actions": {
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "adminexcluded"),
+			},
+		},
+		"nothing due": {
+			actions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "0-1", Start: future},
+				{Ranks: "2", Start: past, End: future, Active: true},
+			},
+			expActions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "0-1", Start: future},
+				{Ranks: "2", Start: past, End: future, Active: true},
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "adminexcluded"),
+			},
+		},
+		"exclude due": {
+			actions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "0-1", Start: past},
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "adminexcluded"),
+				mockMember(t, 1, 1, "adminexcluded"),
+				mockMember(t, 2, 2, "adminexcluded"),
+			},
+		},
+		"clear exclude due": {
+			actions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "2", Start: past, Clear: true},
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "excluded"),
+			},
+		},
+		"window started": {
+			actions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "0", Start: past, End: future},
+			},
+			expActions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "0", Start: past, End: future, Active: true},
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "adminexcluded"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "adminexcluded"),
+			},
+		},
+		"window ended": {
+			actions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "2", Start: past - 60, End: past, Active: true},
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "excluded"),
+			},
+		},
+		"window missed": {
+			actions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "0", Start: past - 60, End: past},
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "adminexcluded"),
+			},
+		},
+		"invalid rank dropped": {
+			actions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "42", Start: past},
+				{Ranks: "1", Start: future},
+			},
+			expActions: []*mgmtpb.ScheduledRankAction{
+				{Ranks: "1", Start: future},
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "adminexcluded"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "adminexcluded"),
+			}, nil)
+			if err := svc.setScheduledRankActions(tc.actions); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := svc.runScheduledRankActions(test.Context(t), now); err != nil {
+				t.Fatal(err)
+			}
+
+			checkMembers(t, tc.expMembers, svc.membership)
+
+			gotActions, err := svc.getScheduledRankActions()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expActions, gotActions, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected scheduled actions (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
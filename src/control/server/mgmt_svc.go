@@ -11,6 +11,7 @@ import (
 	"context"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -24,6 +25,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/system"
 	"github.com/daos-stack/daos/src/control/system/raft"
 )
@@ -31,6 +33,10 @@ import (
 const (
 	groupUpdateInterval = 500 * time.Millisecond
 	batchLoopInterval   = 250 * time.Millisecond
+
+	// mgmtLogModule is the name of the log module used by the
+	// management service.
+	mgmtLogModule = "server.mgmt"
 )
 
 type (
@@ -78,16 +84,22 @@ type mgmtSvc struct {
 	events            *events.PubSub
 	systemProps       daos.SystemPropertyMap
 	clientNetworkHint []*mgmtpb.ClientNetHint
+	groupResolver     security.GroupResolver
+	poolTemplates     []*poolGroupTemplate
+	agentHeartbeats   *agentHeartbeatTracker
+	eventRecorder     *eventRecorder
 	batchInterval     time.Duration
 	batchReqs         batchReqChan
 	serialReqs        batchReqChan
 	groupUpdateReqs   chan bool
 	lastMapVer        uint32
+	schedActionsLock  sync.Mutex // serializes updates of scheduled rank actions
+	getRankFreeSpace  rankFreeSpaceGetter
 }
 
 func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c control.UnaryInvoker, p *events.PubSub) *mgmtSvc {
 	return &mgmtSvc{
-		log:               h.log,
+		log:               logging.ForModule(h.log, mgmtLogModule),
 		harness:           h,
 		membership:        m,
 		sysdb:             s,
@@ -95,6 +107,8 @@ func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c cont
 		events:            p,
 		systemProps:       daos.SystemProperties(),
 		clientNetworkHint: []*mgmtpb.ClientNetHint{new(mgmtpb.ClientNetHint)},
+		agentHeartbeats:   newAgentHeartbeatTracker(),
+		eventRecorder:     newEventRecorder(),
 		batchInterval:     batchLoopInterval,
 		batchReqs:         make(batchReqChan),
 		serialReqs:        make(batchReqChan),
@@ -175,7 +189,11 @@ func (svc *mgmtSvc) checkReplicaRequest(req proto.Message) error {
 // startLeaderLoops kicks off the leader-only processing loops
 // that will be canceled on leadership loss.
 func (svc *mgmtSvc) startLeaderLoops(ctx context.Context) {
+	// Heartbeats received during a previous leadership term are out of date.
+	svc.agentHeartbeats.reset()
+	svc.eventRecorder.reset()
 	go svc.leaderTaskLoop(ctx)
+	go svc.scheduledRankActionLoop(ctx)
 }
 
 // startAsyncLoops kicks off the asynchronous processing loops.
@@ -324,7 +342,8 @@ func (svc *mgmtSvc) processSerialRequest(ctx context.Context, req *batchRequest)
 	svc.log.Debugf("invoking serial handler for %T", req.msg)
 	switch msg := req.msg.(type) {
 	case *mgmtpb.PoolCreateReq:
-		resp, err := svc.poolCreate(ctx, msg)
+		// Report progress to the requester of the serialized request.
+		resp, err := svc.poolCreate(withProgress(ctx, progressFromContext(req.ctx)), msg)
 		req.sendResponse(ctx, resp, err)
 	default:
 		svc.log.Errorf("no serial handler for message type %T", req.msg)
@@ -395,6 +414,8 @@ func (svc *mgmtSvc) leaderTaskLoop(parent context.Context) {
 
 	groupUpdateTimer := time.NewTicker(groupUpdateInterval)
 	defer groupUpdateTimer.Stop()
+	eventRecordTimer := time.NewTicker(eventRecordInterval)
+	defer eventRecordTimer.Stop()
 
 	svc.log.Debug("starting leaderTaskLoop")
 	for {
@@ -420,6 +441,14 @@ func (svc *mgmtSvc) leaderTaskLoop(parent context.Context) {
 				continue
 			}
 			groupUpdateNeeded = false
+		case <-eventRecordTimer.C:
+			dropped, err := svc.eventRecorder.flush(svc.sysdb)
+			if dropped > 0 {
+				svc.log.Debugf("%d events discarded before being recorded in system database", dropped)
+			}
+			if err != nil {
+				svc.log.Debugf("failed to record events in system database: %s", err)
+			}
 		}
 	}
 }
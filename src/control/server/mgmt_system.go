@@ -9,12 +9,14 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -48,6 +50,14 @@ const (
 	groupUpdatePauseProp = "group_update_paused"
 	domainLabelsProp     = "domain_labels"
 	domainLabelsSep      = "=" // invalid in a label name
+	fdOverridesProp      = "fault_domain_overrides"
+
+	// attachInfoCompressMinURIs is the minimum number of rank URIs for which the rank
+	// URI table of a GetAttachInfo response is compressed, if the requester accepts it.
+	attachInfoCompressMinURIs = 1024
+	// attachInfoStreamMaxURIs is the maximum number of rank URIs sent in each partial
+	// response of a GetAttachInfoStream request.
+	attachInfoStreamMaxURIs = 4096
 )
 
 var errSysForceNotFull = errors.New("force must be used if not full system stop")
@@ -132,9 +142,55 @@ func (svc *mgmtSvc) GetAttachInfo(ctx context.Context, req *mgmtpb.GetAttachInfo
 		}
 	}
 
+	if err := compressAttachInfo(req, resp, attachInfoCompressMinURIs); err != nil {
+		svc.log.Errorf("sending uncompressed rank URIs: %s", err)
+	}
+
 	return resp, nil
 }
 
+// GetAttachInfoStream handles a GetAttachInfo request, sending the rank URIs as a
+// stream of partial responses for systems whose rank URI table does not fit in a
+// single message.
+func (svc *mgmtSvc) GetAttachInfoStream(req *mgmtpb.GetAttachInfoReq, stream mgmtpb.MgmtSvc_GetAttachInfoStreamServer) error {
+	// The rank URI table is split before each partial response is compressed.
+	acceptEncoding := req.GetAcceptEncoding()
+	req.AcceptEncoding = ""
+	resp, err := svc.GetAttachInfo(stream.Context(), req)
+	req.AcceptEncoding = acceptEncoding
+	if err != nil {
+		return err
+	}
+
+	parts, err := resp.SplitRankURIs(attachInfoStreamMaxURIs)
+	if err != nil {
+		return err
+	}
+	for _, part := range parts {
+		if err := compressAttachInfo(req, part, attachInfoCompressMinURIs); err != nil {
+			svc.log.Errorf("sending uncompressed rank URIs: %s", err)
+		}
+		if err := stream.Send(part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compressAttachInfo compresses the rank URI table of the response if the requester
+// accepts a supported encoding and the table contains at least minURIs entries.
+func compressAttachInfo(req *mgmtpb.GetAttachInfoReq, resp *mgmtpb.GetAttachInfoResp, minURIs int) error {
+	if req.GetAcceptEncoding() != mgmtpb.RankURIEncodingGzip {
+		return nil
+	}
+	if len(resp.RankUris)+len(resp.SecondaryRankUris) < minURIs {
+		return nil
+	}
+
+	return resp.CompressRankURIs()
+}
+
 // LeaderQuery returns the system leader and MS replica details.
 func (svc *mgmtSvc) LeaderQuery(ctx context.Context, req *mgmtpb.LeaderQueryReq) (*mgmtpb.LeaderQueryResp, error) {
 	if err := svc.checkSystemRequest(req); err != nil {
@@ -237,6 +293,7 @@ func (svc *mgmtSvc) join(ctx context.Context, req *mgmtpb.JoinReq, peerAddr *net
 		Replace:                 req.Replace,
 	}
 
+	var replacedMember *system.Member
 	if req.Replace {
 		rankToReplace, err := svc.membership.FindRankFromJoinRequest(joinReq)
 		if err != nil {
@@ -246,6 +303,10 @@ func (svc *mgmtSvc) join(ctx context.Context, req *mgmtpb.JoinReq, peerAddr *net
 			return nil, errors.Wrapf(err, "join: replace rank %d", rankToReplace)
 		}
 		joinReq.Rank = rankToReplace
+
+		if replacedMember, err = svc.membership.Get(rankToReplace); err != nil {
+			return nil, err
+		}
 	}
 
 	joinResponse, err := svc.membership.Join(joinReq)
@@ -265,6 +326,14 @@ func (svc *mgmtSvc) join(ctx context.Context, req *mgmtpb.JoinReq, peerAddr *net
 			member.Rank, member.PrimaryFabricURI, member.SecondaryFabricURIs, joinResponse.PrevState, member.State)
 	}
 
+	svc.events.Publish(events.NewSystemMembershipChangedEvent(member.Rank.Uint32(), "join"))
+
+	if replacedMember != nil {
+		if err := svc.migrateFaultDomainOverride(replacedMember, member); err != nil {
+			svc.log.Errorf("failed to migrate fault domain of rank %d: %s", member.Rank, err)
+		}
+	}
+
 	joinState := mgmtpb.JoinResp_IN
 	if svc.checkerIsEnabled() {
 		joinState = mgmtpb.JoinResp_CHECK
@@ -302,7 +371,17 @@ func (svc *mgmtSvc) join(ctx context.Context, req *mgmtpb.JoinReq, peerAddr *net
 }
 
 func (svc *mgmtSvc) verifyFaultDomain(req *mgmtpb.JoinReq) (*system.FaultDomain, error) {
-	fd, err := system.NewFaultDomainFromString(req.SrvFaultDomain)
+	fdStr := req.SrvFaultDomain
+	overrides, err := svc.getFaultDomainOverrides()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get fault domain overrides")
+	}
+	if override, found := overrides[req.Uuid]; found {
+		svc.log.Debugf("using imported fault domain %q for %s (configured: %q)", override, req.Uuid, fdStr)
+		fdStr = override
+	}
+
+	fd, err := system.NewFaultDomainFromString(fdStr)
 	if err != nil {
 		return nil, config.FaultConfigFaultDomainInvalid(err)
 	}
@@ -311,12 +390,7 @@ func (svc *mgmtSvc) verifyFaultDomain(req *mgmtpb.JoinReq) (*system.FaultDomain,
 		return nil, errors.New("no fault domain in join request")
 	}
 
-	labels := fd.Labels
-	if !fd.HasLabels() {
-		// While saving the labels, an unlabeled fault domain sets the labels to empty
-		// strings. This allows us to distinguish between unset and unlabeled.
-		labels = make([]string, fd.NumLevels())
-	}
+	labels := faultDomainLabels(fd)
 
 	sysLabels, err := svc.getDomainLabels()
 	if system.IsErrSystemAttrNotFound(err) {
@@ -339,16 +413,26 @@ func (svc *mgmtSvc) verifyFaultDomain(req *mgmtpb.JoinReq) (*system.FaultDomain,
 
 	svc.log.Tracef("system labels: [%s], request labels: [%s]", strings.Join(printSysLabels, ", "), strings.Join(labels, ", "))
 	if len(sysLabels) != len(labels) {
-		return nil, FaultBadFaultDomainLabels(req.SrvFaultDomain, req.Uri, fd.Labels, printSysLabels)
+		return nil, FaultBadFaultDomainLabels(fdStr, req.Uri, fd.Labels, printSysLabels)
 	}
 	for i := range sysLabels {
 		if labels[i] != sysLabels[i] {
-			return nil, FaultBadFaultDomainLabels(req.SrvFaultDomain, req.Uri, fd.Labels, printSysLabels)
+			return nil, FaultBadFaultDomainLabels(fdStr, req.Uri, fd.Labels, printSysLabels)
 		}
 	}
 	return fd, nil
 }
 
+// faultDomainLabels returns the labels for each level of the fault domain. While saving the
+// labels, an unlabeled fault domain sets the labels to empty strings. This allows us to
+// distinguish between unset and unlabeled.
+func faultDomainLabels(fd *system.FaultDomain) []string {
+	if !fd.HasLabels() {
+		return make([]string, fd.NumLevels())
+	}
+	return fd.Labels
+}
+
 func (svc *mgmtSvc) getDomainLabels() ([]string, error) {
 	propStr, err := system.GetMgmtProperty(svc.sysdb, domainLabelsProp)
 	if err != nil {
@@ -362,6 +446,33 @@ func (svc *mgmtSvc) setDomainLabels(labels []string) error {
 	return system.SetMgmtProperty(svc.sysdb, domainLabelsProp, propStr)
 }
 
+// getFaultDomainOverrides returns the imported fault domain strings that take precedence over
+// the configured fault domains of members, keyed by member UUID.
+func (svc *mgmtSvc) getFaultDomainOverrides() (map[string]string, error) {
+	overrides := make(map[string]string)
+
+	propStr, err := system.GetMgmtProperty(svc.sysdb, fdOverridesProp)
+	if err != nil {
+		if system.IsErrSystemAttrNotFound(err) {
+			return overrides, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(propStr), &overrides); err != nil {
+		return nil, errors.Wrapf(err, "invalid %q property", fdOverridesProp)
+	}
+	return overrides, nil
+}
+
+func (svc *mgmtSvc) setFaultDomainOverrides(overrides map[string]string) error {
+	propBytes, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+	return system.SetMgmtProperty(svc.sysdb, fdOverridesProp, string(propBytes))
+}
+
 // allRanksJoined checks whether all ranks that the system knows about, and that are not admin
 // excluded, are joined.
 //
@@ -742,6 +853,7 @@ func (svc *mgmtSvc) rpcFanout(ctx context.Context, req *fanoutRequest, resp *fan
 
 	waiting := ranklist.RankSetFromRanks(req.Ranks.Ranks())
 	finished := ranklist.MustCreateRankSet("")
+	reportProgress(ctx, funcName(req.Method), 0, req.Ranks.Count())
 	ranksReq.SetReportCb(func(hr *control.HostResponse) {
 		rs, ok := hr.Message.(interface{ GetResults() []*sharedpb.RankResult })
 		if !ok {
@@ -762,6 +874,7 @@ func (svc *mgmtSvc) rpcFanout(ctx context.Context, req *fanoutRequest, resp *fan
 			msg = fmt.Sprintf(" waiting: %q", waiting)
 		}
 		svc.log.Infof(msg)
+		reportProgress(ctx, funcName(req.Method), finished.Count(), req.Ranks.Count())
 	})
 
 	// Not strictly necessary but helps with debugging.
@@ -794,6 +907,102 @@ func (svc *mgmtSvc) rpcFanout(ctx context.Context, req *fanoutRequest, resp *fan
 	return resp, req.Ranks, nil
 }
 
+// rankGroups splits the ranks of a fan-out request into the groups in which
+// they should be stopped or started. Ranks hosting pool service replicas are
+// stopped after and started before the other ranks, so that pool services stay
+// available while the other ranks are stopped or started. Empty groups are
+// omitted.
+func (svc *mgmtSvc) rankGroups(ranks *ranklist.RankSet, replicasFirst bool) ([]*ranklist.RankSet, error) {
+	psList, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := ranklist.MustCreateRankSet("")
+	others := ranklist.MustCreateRankSet("")
+	for _, ps := range psList {
+		for _, r := range ps.Replicas {
+			if ranks.Contains(r) {
+				replicas.Add(r)
+			}
+		}
+	}
+	for _, r := range ranks.Ranks() {
+		if !replicas.Contains(r) {
+			others.Add(r)
+		}
+	}
+
+	groups := []*ranklist.RankSet{others, replicas}
+	if replicasFirst {
+		groups = []*ranklist.RankSet{replicas, others}
+	}
+
+	var out []*ranklist.RankSet
+	for _, group := range groups {
+		if group.Count() > 0 {
+			out = append(out, group)
+		}
+	}
+	return out, nil
+}
+
+// rpcFanoutOrdered performs an rpcFanout to each group of ranks in turn, waiting
+// for each group to complete before starting the next. The results of all of the
+// groups are returned in the fan-out response.
+func (svc *mgmtSvc) rpcFanoutOrdered(ctx context.Context, req *fanoutRequest, resp *fanoutResponse, groups []*ranklist.RankSet) (*fanoutResponse, error) {
+	if resp == nil {
+		resp = new(fanoutResponse)
+	}
+
+	total := 0
+	for _, group := range groups {
+		total += group.Count()
+	}
+
+	var results system.MemberResults
+	for i, group := range groups {
+		svc.log.Debugf("fanout group %d/%d: ranks %s", i+1, len(groups), group)
+
+		// Report the progress across all of the groups.
+		groupCtx := ctx
+		if progress := progressFromContext(ctx); progress != nil {
+			done := len(results)
+			groupCtx = withProgress(ctx, func(stage string, completed, _ int) {
+				progress(stage, done+completed, total)
+			})
+		}
+
+		groupReq := *req
+		groupReq.Ranks = group
+		groupResp, _, err := svc.rpcFanout(groupCtx, &groupReq, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, groupResp.Results...)
+	}
+	resp.Results = results
+
+	return resp, nil
+}
+
+// sysdbUnchanged returns true if the system database is still at the version
+// identified by a change token that a client obtained with an earlier response,
+// in which case the response cached by the client is still current. A zero
+// token indicates that the client has no cached response.
+func (svc *mgmtSvc) sysdbUnchanged(token uint64) (bool, error) {
+	if token == 0 {
+		return false, nil
+	}
+
+	v, err := svc.sysdb.DataVersion()
+	if err != nil {
+		return false, err
+	}
+
+	return v == token, nil
+}
+
 // SystemQuery implements the method defined for the Management Service.
 //
 // Retrieve the state of DAOS ranks in the system by returning details stored in
@@ -808,6 +1017,17 @@ func (svc *mgmtSvc) SystemQuery(ctx context.Context, req *mgmtpb.SystemQueryReq)
 		return nil, err
 	}
 
+	unchanged, err := svc.sysdbUnchanged(req.ChangeToken)
+	if err != nil {
+		return nil, err
+	}
+	if unchanged {
+		return &mgmtpb.SystemQueryResp{
+			DataVersion: req.ChangeToken,
+			NotModified: true,
+		}, nil
+	}
+
 	hitRanks, missRanks, missHosts, err := svc.resolveRanks(req.Hosts, req.Ranks)
 	if err != nil {
 		return nil, err
@@ -964,7 +1184,8 @@ func (svc *mgmtSvc) checkRanksAdminExcluded(ranks ranklist.RankList) error {
 // Initiate two-phase controlled shutdown of DAOS system, return results for
 // each selected rank. First phase results in "PrepShutdown" dRPC requests being
 // issued to each rank and the second phase stops the running executable
-// processes associated with each rank.
+// processes associated with each rank. Ranks hosting pool service replicas are
+// stopped after all other ranks.
 //
 // This control service method is triggered from the control API method of the
 // same name in lib/control/system.go and returns results from all selected ranks.
@@ -974,6 +1195,12 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 	}
 	svc.log.Debug("Received SystemStop RPC")
 
+	lock, err := svc.sysdb.TakeSystemLock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+
 	fReq, fResp, err := svc.getFanoutNoAdminExcluded(req, req.IgnoreAdminExcluded)
 	if err != nil {
 		return nil, err
@@ -987,7 +1214,8 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 		}
 
 		fReq.Method = control.PrepShutdownRanks
-		fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
+		prepCtx := withProgressStage(ctx, "preparing ranks for shutdown")
+		fResp, _, err = svc.rpcFanout(prepCtx, fReq, fResp, true)
 		if err != nil {
 			return nil, err
 		}
@@ -997,10 +1225,16 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 		}
 	}
 
-	// Second phase: Stop the ranks. If the request is forced, we will
-	// kill the ranks immediately without a graceful shutdown.
+	// Second phase: Stop the ranks, those hosting pool service replicas
+	// last. If the request is forced, we will kill the ranks immediately
+	// without a graceful shutdown.
+	groups, err := svc.rankGroups(fReq.Ranks, false)
+	if err != nil {
+		return nil, err
+	}
 	fReq.Method = control.StopRanks
-	fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
+	stopCtx := withProgressStage(ctx, "stopping ranks")
+	fResp, err = svc.rpcFanoutOrdered(stopCtx, fReq, fResp, groups)
 	if err != nil {
 		return nil, err
 	}
@@ -1013,6 +1247,25 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 	return resp, nil
 }
 
+// SystemStopStream stops the system as SystemStop does, sending updates of the
+// progress of the stop in the stream before the final response.
+func (svc *mgmtSvc) SystemStopStream(req *mgmtpb.SystemStopReq, stream mgmtpb.MgmtSvc_SystemStopStreamServer) error {
+	ctx := streamProgress(stream.Context(), svc.log, func(p *sharedpb.Progress) error {
+		return stream.Send(&mgmtpb.SystemStopStreamResp{
+			Update: &mgmtpb.SystemStopStreamResp_Progress{Progress: p},
+		})
+	})
+
+	resp, err := svc.SystemStop(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&mgmtpb.SystemStopStreamResp{
+		Update: &mgmtpb.SystemStopStreamResp_Resp{Resp: resp},
+	})
+}
+
 func newSystemStartFailedEvent(errs string) *events.RASEvent {
 	return events.NewGenericEvent(events.RASSystemStartFailed, events.RASSeverityError,
 		fmt.Sprintf("System startup failed, %s", errs), "")
@@ -1079,6 +1332,7 @@ func (svc *mgmtSvc) checkMemberStates(requiredStates ...system.MemberState) erro
 //
 // Initiate controlled start of DAOS system instances (system members)
 // after a controlled shutdown using information in the membership registry.
+// Ranks hosting pool service replicas are started before all other ranks.
 // Return system start results.
 //
 // This control service method is triggered from the control API method of the
@@ -1089,14 +1343,25 @@ func (svc *mgmtSvc) SystemStart(ctx context.Context, req *mgmtpb.SystemStartReq)
 	}
 	svc.log.Debug("Received SystemStart RPC")
 
+	lock, err := svc.sysdb.TakeSystemLock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+
 	fReq, fResp, err := svc.getFanoutNoAdminExcluded(req, req.IgnoreAdminExcluded)
 	if err != nil {
 		return nil, err
 	}
 
+	// Start the ranks hosting pool service replicas first.
+	groups, err := svc.rankGroups(fReq.Ranks, true)
+	if err != nil {
+		return nil, err
+	}
 	fReq.CheckMode = req.CheckMode
 	fReq.Method = control.StartRanks
-	fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
+	fResp, err = svc.rpcFanoutOrdered(ctx, fReq, fResp, groups)
 	if err != nil {
 		return nil, err
 	}
@@ -1110,6 +1375,9 @@ func (svc *mgmtSvc) SystemStart(ctx context.Context, req *mgmtpb.SystemStartReq)
 }
 
 // SystemExclude marks the specified ranks as administratively excluded from the system.
+//
+// If a future start time is supplied, the update is scheduled and applied by the MS leader
+// when due. If a duration is supplied, the excluded state is cleared when it has elapsed.
 func (svc *mgmtSvc) SystemExclude(ctx context.Context, req *mgmtpb.SystemExcludeReq) (*mgmtpb.SystemExcludeResp, error) {
 	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
 		return nil, err
@@ -1118,6 +1386,9 @@ func (svc *mgmtSvc) SystemExclude(ctx context.Context, req *mgmtpb.SystemExclude
 	if req.Hosts == "" && req.Ranks == "" {
 		return nil, errors.New("no hosts or ranks specified")
 	}
+	if req.Clear && req.Duration != 0 {
+		return nil, errors.New("duration cannot be specified when clearing excluded state")
+	}
 
 	fReq, fResp, err := svc.getFanout(req)
 	if err != nil {
@@ -1132,21 +1403,67 @@ func (svc *mgmtSvc) SystemExclude(ctx context.Context, req *mgmtpb.SystemExclude
 	}
 
 	resp := new(mgmtpb.SystemExcludeResp)
-	for _, r := range fReq.Ranks.Ranks() {
+
+	now := uint64(time.Now().Unix())
+	if req.Start > now {
+		resp.Scheduled = &mgmtpb.ScheduledRankAction{
+			Ranks: fReq.Ranks.String(),
+			Clear: req.Clear,
+			Start: req.Start,
+		}
+		if req.Duration != 0 {
+			resp.Scheduled.End = req.Start + req.Duration
+		}
+		if err := svc.addScheduledRankAction(resp.Scheduled); err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+
+	resp.Results, err = svc.setRanksAdminExcluded(fReq.Ranks.Ranks(), req.Clear)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Duration != 0 {
+		resp.Scheduled = &mgmtpb.ScheduledRankAction{
+			Ranks:  fReq.Ranks.String(),
+			Start:  now,
+			End:    now + req.Duration,
+			Active: true,
+		}
+		if err := svc.addScheduledRankAction(resp.Scheduled); err != nil {
+			return nil, err
+		}
+	}
+
+	svc.reqGroupUpdate(ctx, false)
+
+	return resp, nil
+}
+
+// setRanksAdminExcluded sets or clears the admin-excluded state of the given ranks.
+func (svc *mgmtSvc) setRanksAdminExcluded(ranks []ranklist.Rank, clear bool) ([]*sharedpb.RankResult, error) {
+	var results []*sharedpb.RankResult
+	for _, r := range ranks {
 		m, err := svc.sysdb.FindMemberByRank(r)
 		if err != nil {
 			return nil, err
 		}
 		action := "set admin-excluded state"
 		m.State = system.MemberStateAdminExcluded
-		if req.Clear {
+		if clear {
 			action = "clear admin-excluded state"
 			m.State = system.MemberStateExcluded // cleared on rejoin
 		}
 		if err := svc.sysdb.UpdateMember(m); err != nil {
 			return nil, err
 		}
-		resp.Results = append(resp.Results, &sharedpb.RankResult{
+		if !clear {
+			svc.events.Publish(events.NewSystemMembershipChangedEvent(r.Uint32(), "exclude"))
+		}
+		results = append(results, &sharedpb.RankResult{
 			Rank:   r.Uint32(),
 			Action: action,
 			State:  strings.ToLower(m.State.String()),
@@ -1154,9 +1471,7 @@ func (svc *mgmtSvc) SystemExclude(ctx context.Context, req *mgmtpb.SystemExclude
 		})
 	}
 
-	svc.reqGroupUpdate(ctx, false)
-
-	return resp, nil
+	return results, nil
 }
 
 func (svc *mgmtSvc) refuseUnavailableRanks(hosts, ranks string) (*ranklist.RankSet, error) {
@@ -1406,6 +1721,37 @@ func (svc *mgmtSvc) ClusterEvent(ctx context.Context, req *sharedpb.ClusterEvent
 	return resp, nil
 }
 
+// agentEventIDs are the IDs of the RAS events that client agents are allowed
+// to raise. Other events describe the state of the servers and are only
+// accepted from servers via ClusterEvent.
+var agentEventIDs = map[events.RASID]struct{}{
+	events.RASFabricIfaceDown: {},
+}
+
+// AgentEvent management service gRPC handler receives AgentEvent requests
+// from client agents attempting to notify the MS of a RAS event detected on
+// a client node (this handler should only get called on the MS leader).
+func (svc *mgmtSvc) AgentEvent(ctx context.Context, req *sharedpb.ClusterEventReq) (*sharedpb.ClusterEventResp, error) {
+	if err := svc.checkLeaderRequest(wrapCheckerReq(req)); err != nil {
+		return nil, err
+	}
+
+	if req.GetEvent() == nil {
+		return nil, errors.New("nil event in request")
+	}
+	id := events.RASID(req.GetEvent().GetId())
+	if _, ok := agentEventIDs[id]; !ok {
+		return nil, errors.Errorf("%s event may not be raised by an agent", id)
+	}
+
+	resp, err := svc.events.HandleClusterEvent(req, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "handle agent event %+v", req)
+	}
+
+	return resp, nil
+}
+
 // eraseAndRestart is called on MS replicas to shut down the raft DB and
 // remove its files before restarting the control plane server.
 func (svc *mgmtSvc) eraseAndRestart(pause bool) error {
@@ -1465,6 +1811,14 @@ func (svc *mgmtSvc) SystemErase(ctx context.Context, pbReq *mgmtpb.SystemEraseRe
 
 	// On the leader, we should first tell all servers to prepare for
 	// reformat by wiping out their engine superblocks, etc.
+	if svc.sysdb.IsLeader() {
+		lock, err := svc.sysdb.TakeSystemLock(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer lock.Release()
+	}
+
 	fanReq, fanResp, err := svc.getFanout(&mgmtpb.SystemQueryReq{})
 	if err != nil {
 		return nil, err
@@ -1522,8 +1876,13 @@ func (svc *mgmtSvc) SystemCleanup(ctx context.Context, req *mgmtpb.SystemCleanup
 		return nil, err
 	}
 
-	if req.Machine == "" {
+	switch {
+	case req.Machine == "" && !req.AllStale:
 		return nil, errors.New("SystemCleanup requires a machine name.")
+	case req.Machine != "" && req.AllStale:
+		return nil, errors.New("SystemCleanup machine name and all stale machines are mutually exclusive")
+	case req.AllStale:
+		return svc.cleanupStaleMachines(ctx, req)
 	}
 
 	psList, err := svc.sysdb.PoolServiceList(false)
@@ -1534,40 +1893,66 @@ func (svc *mgmtSvc) SystemCleanup(ctx context.Context, req *mgmtpb.SystemCleanup
 	resp := new(mgmtpb.SystemCleanupResp)
 
 	for _, ps := range psList {
-		var errMsg string
-
-		evictReq := &mgmtpb.PoolEvictReq{
-			Sys:     req.Sys,
-			Machine: req.Machine,
-			Id:      ps.PoolUUID.String(),
+		if req.DryRun {
+			counts, err := svc.countPoolHandles(ctx, req.Sys, ps.PoolUUID)
+			if err != nil {
+				return nil, err
+			}
+			if counts[req.Machine] == 0 {
+				continue
+			}
+			resp.Results = append(resp.Results, &mgmtpb.SystemCleanupResp_CleanupResult{
+				PoolId:  ps.PoolUUID.String(),
+				Count:   counts[req.Machine],
+				Machine: req.Machine,
+			})
+			continue
 		}
 
-		dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolEvict, evictReq)
+		result, err := svc.evictMachineHandles(ctx, req.Sys, req.Machine, ps.PoolUUID.String())
 		if err != nil {
 			return nil, err
 		}
+		resp.Results = append(resp.Results, result)
+	}
 
-		evictResp := &mgmtpb.PoolEvictResp{}
-		if err := svc.unmarshalPB(dResp.Body, evictResp); err != nil {
-			evictResp.Status = int32(daos.MiscError)
-			evictResp.Count = 0
-			errMsg = err.Error()
-		} else if evictResp.Status != int32(daos.Success) {
-			errMsg = fmt.Sprintf("Unable to clean up handles for machine %s on pool %s",
-				evictReq.Machine, evictReq.Id)
-		}
+	return resp, nil
+}
 
-		svc.log.Debugf("Response from pool evict in cleanup: '%+v' (req: '%+v')", evictResp,
-			evictReq)
-		resp.Results = append(resp.Results, &mgmtpb.SystemCleanupResp_CleanupResult{
-			Status: evictResp.Status,
-			Msg:    errMsg,
-			PoolId: evictReq.Id,
-			Count:  uint32(evictResp.Count),
-		})
+// evictMachineHandles evicts the handles held by the machine on the pool.
+func (svc *mgmtSvc) evictMachineHandles(ctx context.Context, sys, machine, poolID string) (*mgmtpb.SystemCleanupResp_CleanupResult, error) {
+	var errMsg string
+
+	evictReq := &mgmtpb.PoolEvictReq{
+		Sys:     sys,
+		Machine: machine,
+		Id:      poolID,
 	}
 
-	return resp, nil
+	dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolEvict, evictReq)
+	if err != nil {
+		return nil, err
+	}
+
+	evictResp := &mgmtpb.PoolEvictResp{}
+	if err := svc.unmarshalPB(dResp.Body, evictResp); err != nil {
+		evictResp.Status = int32(daos.MiscError)
+		evictResp.Count = 0
+		errMsg = err.Error()
+	} else if evictResp.Status != int32(daos.Success) {
+		errMsg = fmt.Sprintf("Unable to clean up handles for machine %s on pool %s",
+			evictReq.Machine, evictReq.Id)
+	}
+
+	svc.log.Debugf("Response from pool evict in cleanup: '%+v' (req: '%+v')", evictResp,
+		evictReq)
+	return &mgmtpb.SystemCleanupResp_CleanupResult{
+		Status:  evictResp.Status,
+		Msg:     errMsg,
+		PoolId:  evictReq.Id,
+		Count:   uint32(evictResp.Count),
+		Machine: machine,
+	}, nil
 }
 
 // SystemSetAttr sets system-level attributes.
@@ -1698,3 +2083,450 @@ func (svc *mgmtSvc) SystemGetProp(ctx context.Context, req *mgmtpb.SystemGetProp
 
 	return &mgmtpb.SystemGetPropResp{Properties: props}, nil
 }
+
+// SystemSetFaultDomains sets the fault domains of the system members resident on the requested
+// hosts. All of the requested changes are validated before any are applied and the member updates
+// are committed together. Imported fault domains persist across member rejoins, taking precedence
+// over the fault domains in the server configuration files, until they are cleared.
+func (svc *mgmtSvc) SystemSetFaultDomains(ctx context.Context, req *mgmtpb.SystemSetFaultDomainsReq) (*mgmtpb.SystemSetFaultDomainsResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.Clear {
+		if len(req.FaultDomains) != 0 {
+			return nil, errors.New("fault domains cannot be specified with clear")
+		}
+		return svc.clearFaultDomainOverrides(req.DryRun)
+	}
+
+	if len(req.FaultDomains) == 0 {
+		return nil, errors.New("no fault domains specified")
+	}
+
+	hosts := make([]string, 0, len(req.FaultDomains))
+	for host := range req.FaultDomains {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	var labels []string
+	domains := make(map[ranklist.Rank]*system.FaultDomain)
+	for _, host := range hosts {
+		fd, err := system.NewFaultDomainFromString(req.FaultDomains[host])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid fault domain for host %s", host)
+		}
+		if fd.Empty() {
+			return nil, errors.Errorf("empty fault domain for host %s", host)
+		}
+
+		fdLabels := faultDomainLabels(fd)
+		if labels == nil {
+			labels = fdLabels
+		} else if !slices.Equal(labels, fdLabels) {
+			return nil, errors.Errorf("fault domain %q for host %s has labels inconsistent with other hosts",
+				fd, host)
+		}
+
+		hitRS, missHS, err := svc.membership.CheckHosts(host, build.DefaultControlPort)
+		if err != nil {
+			return nil, err
+		}
+		if missHS.Count() > 0 {
+			return nil, errors.Errorf("invalid host(s): %s", missHS.String())
+		}
+		for _, rank := range hitRS.Ranks() {
+			if cur, found := domains[rank]; found && !cur.Equals(fd) {
+				return nil, errors.Errorf("conflicting fault domains %q and %q for rank %d", cur, fd, rank)
+			}
+			domains[rank] = fd
+		}
+	}
+
+	sysLabels, err := svc.getDomainLabels()
+	if err != nil && !system.IsErrSystemAttrNotFound(err) {
+		return nil, errors.Wrap(err, "failed to get current fault domain labels")
+	}
+	allRanks, err := svc.membership.RankList()
+	if err != nil {
+		return nil, err
+	}
+	allHosts := len(domains) == len(allRanks)
+
+	// The depth of the fault domains can only change along with the labels, so report a
+	// depth mismatch ahead of the more general relabel restriction.
+	if sysLabels != nil && len(labels) != len(sysLabels) && !allHosts {
+		return nil, errors.Errorf("fault domains have %d levels, need %d to be consistent with the system labels",
+			len(labels), len(sysLabels))
+	}
+	relabel := !slices.Equal(sysLabels, labels)
+	if relabel && !allHosts {
+		return nil, errors.Errorf("fault domain labels [%s] differ from system labels [%s], labels can only be changed if all hosts are included",
+			strings.Join(labels, ", "), strings.Join(sysLabels, ", "))
+	}
+
+	// The fault domain overrides and labels are committed in the same operation as
+	// the member updates, so that a failure can't leave the system partially updated.
+	var props map[string]string
+	if !req.DryRun {
+		if props, err = svc.faultDomainProps(domains, labels, relabel); err != nil {
+			return nil, err
+		}
+	}
+
+	changes, err := svc.membership.UpdateFaultDomains(domains, system.MgmtPropertyAttrs(props), req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.SystemSetFaultDomainsResp)
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, &mgmtpb.SystemSetFaultDomainsResp_FaultDomainChange{
+			Rank:      c.Rank.Uint32(),
+			Addr:      c.Addr.String(),
+			OldDomain: c.Old.String(),
+			NewDomain: c.New.String(),
+		})
+	}
+
+	if req.DryRun {
+		return resp, nil
+	}
+
+	if len(changes) > 0 {
+		svc.reqGroupUpdate(ctx, false)
+	}
+
+	return resp, nil
+}
+
+// faultDomainProps returns the MS properties to be updated when the given fault domains
+// are set: the fault domain overrides, and the fault domain labels if they have changed.
+func (svc *mgmtSvc) faultDomainProps(domains map[ranklist.Rank]*system.FaultDomain, labels []string, relabel bool) (map[string]string, error) {
+	overrides, err := svc.getFaultDomainOverrides()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get fault domain overrides")
+	}
+	if _, err := svc.pruneFaultDomainOverrides(overrides); err != nil {
+		return nil, err
+	}
+	for rank, fd := range domains {
+		m, err := svc.sysdb.FindMemberByRank(rank)
+		if err != nil {
+			return nil, err
+		}
+		overrides[m.UUID.String()] = fd.String()
+	}
+	overridesBytes, err := json.Marshal(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	props := map[string]string{
+		fdOverridesProp: string(overridesBytes),
+	}
+	if relabel {
+		svc.log.Debugf("updating fault domain labels: %+v", labels)
+		props[domainLabelsProp] = strings.Join(labels, domainLabelsSep)
+	}
+
+	return props, nil
+}
+
+// pruneFaultDomainOverrides removes the imported fault domains of members that are no longer in
+// the system from the given overrides, and returns the ranks of the members that remain.
+func (svc *mgmtSvc) pruneFaultDomainOverrides(overrides map[string]string) (*ranklist.RankSet, error) {
+	ranks := ranklist.NewRankSet()
+	for uuidStr := range overrides {
+		memberUUID, err := uuid.Parse(uuidStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %q property", fdOverridesProp)
+		}
+		m, err := svc.sysdb.FindMemberByUUID(memberUUID)
+		if err != nil {
+			if !system.IsMemberNotFound(err) {
+				return nil, err
+			}
+			svc.log.Debugf("removing imported fault domain %q of removed member %s",
+				overrides[uuidStr], uuidStr)
+			delete(overrides, uuidStr)
+			continue
+		}
+		ranks.Add(m.Rank)
+	}
+
+	return ranks, nil
+}
+
+// clearFaultDomainOverrides removes all imported fault domains, so that members take on the fault
+// domains in their server configuration files when they next join the system. The current fault
+// domains of members are unchanged until then.
+func (svc *mgmtSvc) clearFaultDomainOverrides(dryRun bool) (*mgmtpb.SystemSetFaultDomainsResp, error) {
+	overrides, err := svc.getFaultDomainOverrides()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get fault domain overrides")
+	}
+	ranks, err := svc.pruneFaultDomainOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun {
+		svc.log.Debugf("removing imported fault domains of ranks %s", ranks)
+		if err := svc.setFaultDomainOverrides(map[string]string{}); err != nil {
+			return nil, errors.Wrap(err, "failed to clear fault domain overrides")
+		}
+	}
+
+	return &mgmtpb.SystemSetFaultDomainsResp{
+		ClearedRanks: ranks.String(),
+	}, nil
+}
+
+// SystemReplaceHost reassigns the ranks resident on a failed host to a replacement
+// host. The replacement host's engines are then formatted in replace mode and join
+// the system with the reassigned ranks, retaining the original ranks' fault domains.
+func (svc *mgmtSvc) SystemReplaceHost(ctx context.Context, req *mgmtpb.SystemReplaceHostReq) (*mgmtpb.SystemReplaceHostResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.GetOldHost() == "" || req.GetNewHost() == "" {
+		return nil, errors.New("old and new hosts must both be specified")
+	}
+
+	hitRS, missHS, err := svc.membership.CheckHosts(req.GetOldHost(), build.DefaultControlPort)
+	if err != nil {
+		return nil, err
+	}
+	if missHS.Count() > 0 {
+		return nil, errors.Errorf("invalid host(s): %s", missHS.String())
+	}
+
+	// Fail early if any of the ranks are still enabled in a pool, as the
+	// replacement engines would otherwise be refused when they join.
+	for _, rank := range hitRS.Ranks() {
+		if err := svc.checkReplaceRank(ctx, rank); err != nil {
+			return nil, errors.Wrapf(err, "replace-host: rank %d", rank)
+		}
+	}
+
+	ranks, err := svc.membership.ReplaceHost(req.GetOldHost(), req.GetNewHost(), build.DefaultControlPort)
+	if err != nil {
+		return nil, err
+	}
+	svc.log.Noticef("ranks %v reassigned from host %s to %s", ranks, req.GetOldHost(), req.GetNewHost())
+
+	return &mgmtpb.SystemReplaceHostResp{
+		Ranks: ranklist.RankSetFromRanks(ranks).String(),
+	}, nil
+}
+
+// migrateFaultDomainOverride updates the imported fault domains when a member has been
+// replaced. Any imported fault domain of the replaced member is removed, and a member which
+// has joined on a replacement host retains the fault domain of the member it replaced, by
+// keying the fault domain by the new member UUID.
+func (svc *mgmtSvc) migrateFaultDomainOverride(old, cur *system.Member) error {
+	if old.UUID == cur.UUID && !old.AwaitingReplacement {
+		return nil
+	}
+
+	overrides, err := svc.getFaultDomainOverrides()
+	if err != nil {
+		return err
+	}
+
+	_, found := overrides[old.UUID.String()]
+	delete(overrides, old.UUID.String())
+	if old.AwaitingReplacement {
+		overrides[cur.UUID.String()] = cur.FaultDomain.String()
+	} else if !found {
+		return nil
+	}
+
+	return svc.setFaultDomainOverrides(overrides)
+}
+
+// getEventFilter creates a system database event filter from the request parameters.
+func getEventFilter(req *mgmtpb.SystemEventsReq) (*raft.EventFilter, error) {
+	filter := &raft.EventFilter{
+		MinSeverity: events.RASSeverityID(req.GetSeverity()),
+		Offset:      req.GetOffset(),
+		Limit:       req.GetLimit(),
+	}
+	if filter.MinSeverity > events.RASSeverityNotice {
+		return nil, errors.Errorf("invalid event severity %d", req.GetSeverity())
+	}
+
+	var err error
+	if filter.Ranks, err = ranklist.CreateRankSet(req.GetRanks()); err != nil {
+		return nil, errors.Wrap(err, "invalid ranks")
+	}
+	if req.GetSince() != "" {
+		if filter.Since, err = common.ParseTime(req.GetSince()); err != nil {
+			return nil, errors.Wrap(err, "invalid since time")
+		}
+	}
+	if req.GetUntil() != "" {
+		if filter.Until, err = common.ParseTime(req.GetUntil()); err != nil {
+			return nil, errors.Wrap(err, "invalid until time")
+		}
+	}
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && filter.Until.Before(filter.Since) {
+		return nil, errors.Errorf("until time %s is before since time %s", req.GetUntil(), req.GetSince())
+	}
+
+	return filter, nil
+}
+
+// SystemEvents returns the RAS events recorded in the system database that match the request
+// filters, newest first.
+func (svc *mgmtSvc) SystemEvents(ctx context.Context, req *mgmtpb.SystemEventsReq) (*mgmtpb.SystemEventsResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	filter, err := getEventFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recs, total, err := svc.sysdb.FilterEvents(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemEventsResp{Total: total}
+	for _, rec := range recs {
+		resp.Events = append(resp.Events, &sharedpb.RASEvent{
+			Id:          rec.ID.Uint32(),
+			Msg:         rec.Msg,
+			Timestamp:   common.FormatTime(rec.Timestamp),
+			Type:        rec.Type.Uint32(),
+			Severity:    rec.Severity.Uint32(),
+			Hostname:    rec.Hostname,
+			Rank:        rec.Rank,
+			Incarnation: rec.Incarnation,
+			HwId:        rec.HWID,
+			PoolUuid:    rec.PoolUUID,
+			ContUuid:    rec.ContUUID,
+		})
+	}
+
+	return resp, nil
+}
+
+// systemChangeEventIDs are the IDs of the recorded events that indicate a change
+// to the system information cached by agents.
+var systemChangeEventIDs = []events.RASID{
+	events.RASSystemMembershipChanged,
+	events.RASEngineDied,
+	events.RASSwimRankDead,
+	events.RASPoolRepsUpdate,
+	events.RASSystemFabricProvChanged,
+}
+
+// SystemChanges returns the changes to the system information cached by agents,
+// e.g. rank joins and excludes or pool service replica updates, that have been
+// recorded in the system database after the requested sequence number, oldest
+// first.
+func (svc *mgmtSvc) SystemChanges(ctx context.Context, req *mgmtpb.SystemChangesReq) (*mgmtpb.SystemChangesResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	// As with pool membership changes, read the last sequence number first
+	// so that nothing recorded while filtering is missed by the caller.
+	lastSeq, err := svc.sysdb.LastEventSeq()
+	if err != nil {
+		return nil, err
+	}
+
+	recs, _, err := svc.sysdb.FilterEvents(&raft.EventFilter{
+		IDs:      systemChangeEventIDs,
+		AfterSeq: req.GetAfterSeq(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemChangesResp{LastSeq: lastSeq}
+	for i := len(recs) - 1; i >= 0; i-- {
+		resp.Changes = append(resp.Changes, &mgmtpb.SystemChange{
+			Seq:       recs[i].Seq,
+			Event:     recs[i].ID.String(),
+			Rank:      recs[i].Rank,
+			PoolUuid:  recs[i].PoolUUID,
+			Op:        recs[i].CtlOp,
+			Timestamp: common.FormatTime(recs[i].Timestamp),
+		})
+	}
+
+	return resp, nil
+}
+
+// SystemUsage returns the pool storage allocated and reserved on each rank in the
+// system, along with the reservations held by pools that are being created.
+func (svc *mgmtSvc) SystemUsage(ctx context.Context, req *mgmtpb.SystemUsageReq) (*mgmtpb.SystemUsageResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	usage, reservations, err := svc.poolStorageUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	// Include ranks without any pool storage.
+	ranks, err := svc.sysdb.MemberRanks()
+	if err != nil {
+		return nil, err
+	}
+	for _, rank := range ranks {
+		if _, found := usage[rank]; !found {
+			usage[rank] = &mgmtpb.RankUsage{Rank: rank.Uint32()}
+		}
+	}
+
+	resp := &mgmtpb.SystemUsageResp{Reservations: reservations}
+	for _, ru := range usage {
+		resp.Ranks = append(resp.Ranks, ru)
+	}
+	sort.Slice(resp.Ranks, func(i, j int) bool {
+		return resp.Ranks[i].Rank < resp.Ranks[j].Rank
+	})
+
+	return resp, nil
+}
+
+// SystemOpLocks returns the operation locks held by the MS leader, oldest first.
+func (svc *mgmtSvc) SystemOpLocks(ctx context.Context, req *mgmtpb.SystemOpLocksReq) (*mgmtpb.SystemOpLocksResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	locks, err := svc.sysdb.OpLocks()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.SystemOpLocksResp)
+	for _, lock := range locks {
+		pbLock := &mgmtpb.OpLock{
+			Operation: lock.Owner.Operation,
+			User:      lock.Owner.User,
+			Since:     common.FormatTime(lock.TakenAt),
+		}
+		if lock.PoolUUID != uuid.Nil {
+			pbLock.PoolUuid = lock.PoolUUID.String()
+			// The pool may not have an entry yet if it is being created.
+			if ps, err := svc.sysdb.FindPoolServiceByUUID(lock.PoolUUID); err == nil {
+				pbLock.PoolLabel = ps.PoolLabel
+			}
+		}
+		resp.Locks = append(resp.Locks, pbLock)
+	}
+
+	return resp, nil
+}
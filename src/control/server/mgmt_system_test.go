@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	uuid "github.com/google/uuid"
@@ -203,6 +204,40 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 				Sys:         build.DefaultSystemName,
 			},
 		},
+		"compression accepted; small table sent uncompressed": {
+			clientNetworkHint: &mgmtpb.ClientNetHint{
+				Provider:    "ofi+tcp",
+				CrtTimeout:  5,
+				NetDevClass: uint32(hardware.Ether),
+			},
+			req: &mgmtpb.GetAttachInfoReq{
+				Sys:            build.DefaultSystemName,
+				AllRanks:       true,
+				AcceptEncoding: mgmtpb.RankURIEncodingGzip,
+			},
+			expResp: &mgmtpb.GetAttachInfoResp{
+				ClientNetHint: &mgmtpb.ClientNetHint{
+					Provider:    "ofi+tcp",
+					CrtTimeout:  5,
+					NetDevClass: uint32(hardware.Ether),
+				},
+				RankUris: []*mgmtpb.GetAttachInfoResp_RankUri{
+					{
+						Rank:    msReplica.Rank.Uint32(),
+						Uri:     msReplica.PrimaryFabricURI,
+						NumCtxs: 0,
+					},
+					{
+						Rank:    nonReplica.Rank.Uint32(),
+						Uri:     nonReplica.PrimaryFabricURI,
+						NumCtxs: 1,
+					},
+				},
+				MsRanks:     []uint32{0},
+				DataVersion: 2,
+				Sys:         build.DefaultSystemName,
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -235,8 +270,21 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 				t.Fatalf("unexpected error: %+v\n", gotErr)
 			}
 
+			// The streamed response should be reassembled into the same response.
+			stream := newMockServerStream[mgmtpb.GetAttachInfoResp](test.Context(t))
+			if err := tc.svc.GetAttachInfoStream(tc.req, stream); err != nil {
+				t.Fatalf("unexpected stream error: %+v\n", err)
+			}
+			if len(stream.sent) == 0 {
+				t.Fatal("no responses streamed")
+			}
+			streamResp := stream.sent[0]
+			for _, part := range stream.sent[1:] {
+				streamResp.MergeRankURIs(part)
+			}
+
 			// Sort the "want" and "got" RankUris slices by rank before comparing them.
-			for _, r := range [][]*mgmtpb.GetAttachInfoResp_RankUri{tc.expResp.RankUris, gotResp.RankUris} {
+			for _, r := range [][]*mgmtpb.GetAttachInfoResp_RankUri{tc.expResp.RankUris, gotResp.RankUris, streamResp.RankUris} {
 				sort.Slice(r, func(i, j int) bool { return r[i].Rank < r[j].Rank })
 			}
 
@@ -244,6 +292,68 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
 			}
+			if diff := cmp.Diff(tc.expResp, streamResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected streamed response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_compressAttachInfo(t *testing.T) {
+	testResp := func() *mgmtpb.GetAttachInfoResp {
+		return &mgmtpb.GetAttachInfoResp{
+			RankUris: []*mgmtpb.GetAttachInfoResp_RankUri{
+				{Rank: 0, Uri: "ofi+tcp://10.0.0.1:31416"},
+				{Rank: 1, Uri: "ofi+tcp://10.0.0.2:31416", NumCtxs: 1},
+			},
+			SecondaryRankUris: []*mgmtpb.GetAttachInfoResp_RankUri{
+				{Rank: 0, Uri: "ofi+verbs://10.0.1.1:31416", ProviderIdx: 1},
+			},
+			MsRanks: []uint32{0},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req           *mgmtpb.GetAttachInfoReq
+		minURIs       int
+		expCompressed bool
+	}{
+		"not accepted": {
+			req: &mgmtpb.GetAttachInfoReq{},
+		},
+		"unsupported encoding": {
+			req: &mgmtpb.GetAttachInfoReq{AcceptEncoding: "snappy"},
+		},
+		"below threshold": {
+			req:     &mgmtpb.GetAttachInfoReq{AcceptEncoding: mgmtpb.RankURIEncodingGzip},
+			minURIs: 4,
+		},
+		"compressed": {
+			req:           &mgmtpb.GetAttachInfoReq{AcceptEncoding: mgmtpb.RankURIEncodingGzip},
+			minURIs:       3,
+			expCompressed: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := testResp()
+			if err := compressAttachInfo(tc.req, resp, tc.minURIs); err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, tc.expCompressed, resp.RankUrisEncoding != "",
+				"unexpected compression state")
+			if tc.expCompressed {
+				if len(resp.RankUris) != 0 || len(resp.SecondaryRankUris) != 0 {
+					t.Fatal("rank URIs not removed from compressed response")
+				}
+				if err := resp.DecompressRankURIs(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if diff := cmp.Diff(testResp(), resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
 		})
 	}
 }
@@ -396,6 +506,88 @@ func TestServer_MgmtSvc_ClusterEvent(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_AgentEvent(t *testing.T) {
+	eventIfaceDown := events.NewFabricIfaceDownEvent("ib0", 1)
+
+	for name, tc := range map[string]struct {
+		nilReq        bool
+		event         *events.RASEvent
+		expResp       *sharedpb.ClusterEventResp
+		expDispatched []string
+		expErr        error
+	}{
+		"nil request": {
+			nilReq: true,
+			expErr: errors.New("nil request"),
+		},
+		"state change event refused": {
+			event:  mockEvtEngineDied(t),
+			expErr: errors.New("may not be raised by an agent"),
+		},
+		"fabric interface down": {
+			event: eventIfaceDown,
+			expResp: &sharedpb.ClusterEventResp{
+				Sequence: 1,
+			},
+			expDispatched: []string{
+				func() string {
+					e := eventIfaceDown.WithForwarded(true)
+					e.Timestamp = ""
+					return e.String()
+				}(),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+
+			ctx, cancel := context.WithTimeout(test.Context(t), 200*time.Millisecond)
+			defer cancel()
+
+			ps := events.NewPubSub(ctx, log)
+			defer ps.Close()
+
+			svc.events = ps
+
+			subscriber := newMockSubscriber(1)
+			svc.events.Subscribe(events.RASTypeAny, subscriber)
+
+			var pbReq *sharedpb.ClusterEventReq
+			if !tc.nilReq {
+				eventPB, err := tc.event.ToProto()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				pbReq = &sharedpb.ClusterEventReq{
+					Sequence: 1,
+					Event:    eventPB,
+				}
+			}
+
+			gotResp, gotErr := svc.AgentEvent(test.Context(t), pbReq)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			<-ctx.Done()
+
+			cmpOpts := test.DefaultCmpOpts()
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			if diff := cmp.Diff(tc.expDispatched, subscriber.getRx(), defEvtCmpOpts...); diff != "" {
+				t.Fatalf("unexpected events dispatched (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_getPeerListenAddr(t *testing.T) {
 	defaultAddr, err := net.ResolveTCPAddr("tcp", "127.0.0.1:10001")
 	if err != nil {
@@ -1200,11 +1392,24 @@ func TestServer_MgmtSvc_SystemQuery(t *testing.T) {
 		expAbsentRanks string
 		expErrMsg      string
 		expProviders   []string
+		changeToken    uint64
+		currentToken   bool
+		expNotModified bool
 	}{
 		"nil req": {
 			nilReq:    true,
 			expErrMsg: "nil request",
 		},
+		"stale change token": {
+			hosts:          "10.0.0.[4-5]",
+			changeToken:    1,
+			expRanks:       "",
+			expAbsentHosts: "10.0.0.[4-5]",
+		},
+		"current change token": {
+			currentToken:   true,
+			expNotModified: true,
+		},
 		"unfiltered rank results": {
 			expMembers: []*mgmtpb.SystemMember{
 				{
@@ -1406,6 +1611,14 @@ func TestServer_MgmtSvc_SystemQuery(t *testing.T) {
 			req := &mgmtpb.SystemQueryReq{
 				Sys:   build.DefaultSystemName,
 				Ranks: tc.ranks, Hosts: tc.hosts,
+				ChangeToken: tc.changeToken,
+			}
+			if tc.currentToken {
+				v, err := svc.sysdb.DataVersion()
+				if err != nil {
+					t.Fatal(err)
+				}
+				req.ChangeToken = v
 			}
 			if tc.nilReq {
 				req = nil
@@ -1429,6 +1642,7 @@ func TestServer_MgmtSvc_SystemQuery(t *testing.T) {
 			if diff := cmp.Diff(tc.expProviders, gotResp.Providers); diff != "" {
 				t.Errorf("unexpected results (-want, +got)\n%s\n", diff)
 			}
+			test.AssertEqual(t, tc.expNotModified, gotResp.NotModified, "not modified")
 		})
 	}
 }
@@ -1750,6 +1964,81 @@ func TestServer_MgmtSvc_SystemStart(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_rankGroups(t *testing.T) {
+	pools := []*system.PoolService{
+		{
+			PoolUUID: test.MockPoolUUID(1),
+			State:    system.PoolServiceStateReady,
+			Replicas: []ranklist.Rank{1, 2},
+		},
+		{
+			PoolUUID: test.MockPoolUUID(2),
+			State:    system.PoolServiceStateReady,
+			Replicas: []ranklist.Rank{2, 5},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		pools         []*system.PoolService
+		ranks         string
+		replicasFirst bool
+		expGroups     []string
+	}{
+		"no pools": {
+			ranks:     "0-3",
+			expGroups: []string{"0-3"},
+		},
+		"replicas last": {
+			pools:     pools,
+			ranks:     "0-3",
+			expGroups: []string{"0,3", "1-2"},
+		},
+		"replicas first": {
+			pools:         pools,
+			ranks:         "0-3",
+			replicasFirst: true,
+			expGroups:     []string{"1-2", "0,3"},
+		},
+		"only replicas": {
+			pools:     pools,
+			ranks:     "1-2",
+			expGroups: []string{"1-2"},
+		},
+		"no replicas": {
+			pools:     pools,
+			ranks:     "0,3",
+			expGroups: []string{"0,3"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "joined"),
+				mockMember(t, 3, 2, "joined"),
+				mockMember(t, 5, 3, "joined"),
+			}, []*control.HostResponse{})
+			for _, ps := range tc.pools {
+				addTestPoolService(t, svc.sysdb, ps)
+			}
+
+			groups, err := svc.rankGroups(ranklist.MustCreateRankSet(tc.ranks), tc.replicasFirst)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotGroups []string
+			for _, group := range groups {
+				gotGroups = append(gotGroups, group.String())
+			}
+			test.CmpAny(t, "rank groups", tc.expGroups, gotGroups)
+		})
+	}
+}
+
 func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 	emf := func(a string) func() system.Members {
 		return func() system.Members {
@@ -1808,6 +2097,7 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 	for name, tc := range map[string]struct {
 		req            *mgmtpb.SystemStopReq
 		members        system.Members
+		pools          []*system.PoolService
 		mResps         [][]*control.HostResponse
 		expMembers     func() system.Members
 		expResults     []*sharedpb.RankResult
@@ -1895,6 +2185,35 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 			expInvokeCount: 2, // prep should be called
 			expFanoutRanks: ranklist.MustCreateRankSet("0-1,3"),
 		},
+		"full system stop; pool service replicas stopped last": {
+			req: &mgmtpb.SystemStopReq{Force: true},
+			pools: []*system.PoolService{
+				{
+					PoolUUID: test.MockPoolUUID(1),
+					State:    system.PoolServiceStateReady,
+					Replicas: []ranklist.Rank{1},
+				},
+			},
+			mResps: [][]*control.HostResponse{
+				{
+					hr(1, mockRankSuccess("stop", 0)),
+					hr(2, mockRankSuccess("stop", 3)),
+				},
+				{
+					hr(1, mockRankSuccess("stop", 1)),
+				},
+			},
+			expResults: rankResStopSuccess,
+			expMembers: func() system.Members {
+				return system.Members{
+					mockMember(t, 0, 1, "stopped"),
+					mockMember(t, 1, 1, "stopped"),
+					mockMember(t, 3, 2, "stopped"),
+				}
+			},
+			expInvokeCount: 2,
+			expFanoutRanks: ranklist.MustCreateRankSet("0,3"),
+		},
 		"full system stop; partial ranks in req": {
 			req:       &mgmtpb.SystemStopReq{Ranks: "0,1"},
 			mResps:    hostRespStopSuccess,
@@ -2049,6 +2368,9 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 				}
 			}
 			svc := mgmtSystemTestSetup(t, log, tc.members, tc.mResps...)
+			for _, ps := range tc.pools {
+				addTestPoolService(t, svc.sysdb, ps)
+			}
 
 			ctx, cancel := context.WithTimeout(test.Context(t), 200*time.Millisecond)
 			defer cancel()
@@ -2256,68 +2578,962 @@ func TestServer_MgmtSvc_SystemExclude(t *testing.T) {
 	}
 }
 
-func TestServer_MgmtSvc_SystemDrain(t *testing.T) {
+func TestServer_MgmtSvc_SystemSetFaultDomains(t *testing.T) {
+	host1 := test.MockHostAddr(1).String()
+	host2 := test.MockHostAddr(2).String()
+	mockFDMember := func(t *testing.T, r, a int32, fdStr string) *system.Member {
+		return mockMember(t, r, a, "joined").WithFaultDomain(system.MustCreateFaultDomainFromString(fdStr))
+	}
+	defaultMembers := func(t *testing.T) system.Members {
+		return system.Members{
+			mockMember(t, 0, 1, "joined"),
+			mockMember(t, 1, 1, "joined"),
+			mockMember(t, 2, 2, "joined"),
+			mockMember(t, 3, 2, "joined"),
+		}
+	}
+
 	for name, tc := range map[string]struct {
-		req            *mgmtpb.SystemDrainReq
-		useLabels      bool
-		pools          []string
-		members        system.Members
-		drpcResps      []*mockDrpcResponse // For dRPC PoolQuery
-		expDrpcCount   int
-		mic            *control.MockInvokerConfig // For control-API PoolDrain/Reint
-		expCtlApiCount int
-		expErr         error
-		expResp        *mgmtpb.SystemDrainResp
+		req          *mgmtpb.SystemSetFaultDomainsReq
+		curLabels    []string
+		curOverrides map[string]string
+		expResp      *mgmtpb.SystemSetFaultDomainsResp
+		expMembers   func(*testing.T) system.Members
+		expLabels    []string
+		expOverrides map[string]string
+		expAPIErr    error
 	}{
 		"nil req": {
-			req:    (*mgmtpb.SystemDrainReq)(nil),
-			expErr: errors.New("nil *mgmt.SystemDrainReq"),
+			req:       (*mgmtpb.SystemSetFaultDomainsReq)(nil),
+			expAPIErr: errors.New("nil request"),
 		},
 		"not system leader": {
-			req:    &mgmtpb.SystemDrainReq{Sys: "quack"},
-			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				Sys: "quack",
+			},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
 		},
-		"no hosts or ranks": {
-			req:    &mgmtpb.SystemDrainReq{},
-			expErr: errors.New("no hosts or ranks"),
+		"no fault domains": {
+			req:       &mgmtpb.SystemSetFaultDomainsReq{},
+			expAPIErr: errors.New("no fault domains"),
 		},
-		"hosts and ranks": {
-			req: &mgmtpb.SystemDrainReq{
-				Hosts: "host1,host2",
-				Ranks: "0,1",
+		"invalid fault domain": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{host1: "junk"},
 			},
-			expErr: errors.New("ranklist and hostlist"),
+			expAPIErr: errors.New("invalid fault domain for host"),
 		},
-		"invalid ranks": {
-			req:    &mgmtpb.SystemDrainReq{Ranks: "41,42"},
-			expErr: errors.New("invalid rank(s)"),
+		"unknown host": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{"10.0.0.5": "/r0/n5"},
+			},
+			expAPIErr: errors.New("invalid host(s): 10.0.0.5"),
 		},
-		"invalid hosts": {
-			req:    &mgmtpb.SystemDrainReq{Hosts: "host-[1-2]"},
-			expErr: errors.New("invalid host(s)"),
+		"inconsistent labels": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{
+					host1: "/rack=r0/node=n1",
+					host2: "/r0/n2",
+				},
+			},
+			expAPIErr: errors.New("labels inconsistent"),
 		},
-		"requested admin excluded ranks": {
-			req: &mgmtpb.SystemDrainReq{Ranks: "0-2"},
-			members: system.Members{
-				system.MockMember(t, 0, system.MemberStateAdminExcluded),
-				system.MockMember(t, 1, system.MemberStateJoined),
-				system.MockMember(t, 2, system.MemberStateAdminExcluded),
+		"inconsistent depth": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{host1: "/r0"},
 			},
-			expErr: FaultRankAdminExcluded(ranklist.RankList{0, 2}),
+			curLabels: []string{"", ""},
+			expAPIErr: errors.New("need 2"),
 		},
-		"local failure on pool query": {
-			req:   &mgmtpb.SystemDrainReq{Ranks: "0"},
-			pools: []string{test.MockUUID(1)},
-			drpcResps: []*mockDrpcResponse{
-				&mockDrpcResponse{
-					Error: errors.New("local failed"),
-				},
+		"relabel subset of hosts": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{host1: "/rack=r0/node=n1"},
 			},
-			expErr:       errors.New("local failed"),
-			expDrpcCount: 1,
+			curLabels: []string{"", ""},
+			expAPIErr: errors.New("labels can only be changed if all hosts are included"),
 		},
-		"local failure on pool drain": {
-			req:   &mgmtpb.SystemDrainReq{Ranks: "0"},
+		"dry run": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{host1: "/r0/n1"},
+				DryRun:       true,
+			},
+			curLabels: []string{"", ""},
+			expResp: &mgmtpb.SystemSetFaultDomainsResp{
+				Changes: []*mgmtpb.SystemSetFaultDomainsResp_FaultDomainChange{
+					{Rank: 0, Addr: host1, OldDomain: "/" + host1 + "/0", NewDomain: "/r0/n1"},
+					{Rank: 1, Addr: host1, OldDomain: "/" + host1 + "/1", NewDomain: "/r0/n1"},
+				},
+			},
+			expMembers:   defaultMembers,
+			expLabels:    []string{"", ""},
+			expOverrides: map[string]string{},
+		},
+		"update subset of hosts": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{host1: "/r0/n1"},
+			},
+			curLabels: []string{"", ""},
+			expResp: &mgmtpb.SystemSetFaultDomainsResp{
+				Changes: []*mgmtpb.SystemSetFaultDomainsResp_FaultDomainChange{
+					{Rank: 0, Addr: host1, OldDomain: "/" + host1 + "/0", NewDomain: "/r0/n1"},
+					{Rank: 1, Addr: host1, OldDomain: "/" + host1 + "/1", NewDomain: "/r0/n1"},
+				},
+			},
+			expMembers: func(t *testing.T) system.Members {
+				return system.Members{
+					mockFDMember(t, 0, 1, "/r0/n1"),
+					mockFDMember(t, 1, 1, "/r0/n1"),
+					mockMember(t, 2, 2, "joined"),
+					mockMember(t, 3, 2, "joined"),
+				}
+			},
+			expLabels: []string{"", ""},
+			expOverrides: map[string]string{
+				test.MockUUID(0): "/r0/n1",
+				test.MockUUID(1): "/r0/n1",
+			},
+		},
+		"update prunes removed members": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{host1: "/r0/n1"},
+			},
+			curLabels: []string{"", ""},
+			curOverrides: map[string]string{
+				test.MockUUID(2): "/r1/n2",
+				test.MockUUID(9): "/r9/n9",
+			},
+			expResp: &mgmtpb.SystemSetFaultDomainsResp{
+				Changes: []*mgmtpb.SystemSetFaultDomainsResp_FaultDomainChange{
+					{Rank: 0, Addr: host1, OldDomain: "/" + host1 + "/0", NewDomain: "/r0/n1"},
+					{Rank: 1, Addr: host1, OldDomain: "/" + host1 + "/1", NewDomain: "/r0/n1"},
+				},
+			},
+			expMembers: func(t *testing.T) system.Members {
+				return system.Members{
+					mockFDMember(t, 0, 1, "/r0/n1"),
+					mockFDMember(t, 1, 1, "/r0/n1"),
+					mockMember(t, 2, 2, "joined"),
+					mockMember(t, 3, 2, "joined"),
+				}
+			},
+			expLabels: []string{"", ""},
+			expOverrides: map[string]string{
+				test.MockUUID(0): "/r0/n1",
+				test.MockUUID(1): "/r0/n1",
+				test.MockUUID(2): "/r1/n2",
+			},
+		},
+		"clear with fault domains": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{host1: "/r0/n1"},
+				Clear:        true,
+			},
+			expAPIErr: errors.New("cannot be specified with clear"),
+		},
+		"clear dry run": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				Clear:  true,
+				DryRun: true,
+			},
+			curLabels: []string{"", ""},
+			curOverrides: map[string]string{
+				test.MockUUID(0): "/r0/n1",
+				test.MockUUID(2): "/r1/n2",
+				test.MockUUID(9): "/r9/n9",
+			},
+			expResp: &mgmtpb.SystemSetFaultDomainsResp{
+				ClearedRanks: "0,2",
+			},
+			expMembers: defaultMembers,
+			expLabels:  []string{"", ""},
+			expOverrides: map[string]string{
+				test.MockUUID(0): "/r0/n1",
+				test.MockUUID(2): "/r1/n2",
+				test.MockUUID(9): "/r9/n9",
+			},
+		},
+		"clear": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				Clear: true,
+			},
+			curLabels: []string{"", ""},
+			curOverrides: map[string]string{
+				test.MockUUID(0): "/r0/n1",
+				test.MockUUID(2): "/r1/n2",
+				test.MockUUID(9): "/r9/n9",
+			},
+			expResp: &mgmtpb.SystemSetFaultDomainsResp{
+				ClearedRanks: "0,2",
+			},
+			expMembers:   defaultMembers,
+			expLabels:    []string{"", ""},
+			expOverrides: map[string]string{},
+		},
+		"relabel all hosts": {
+			req: &mgmtpb.SystemSetFaultDomainsReq{
+				FaultDomains: map[string]string{
+					host1: "/rack=r0/node=n1",
+					host2: "/rack=r1/node=n2",
+				},
+			},
+			curLabels: []string{"", ""},
+			expResp: &mgmtpb.SystemSetFaultDomainsResp{
+				Changes: []*mgmtpb.SystemSetFaultDomainsResp_FaultDomainChange{
+					{Rank: 0, Addr: host1, OldDomain: "/" + host1 + "/0", NewDomain: "/rack=r0/node=n1"},
+					{Rank: 1, Addr: host1, OldDomain: "/" + host1 + "/1", NewDomain: "/rack=r0/node=n1"},
+					{Rank: 2, Addr: host2, OldDomain: "/" + host2 + "/2", NewDomain: "/rack=r1/node=n2"},
+					{Rank: 3, Addr: host2, OldDomain: "/" + host2 + "/3", NewDomain: "/rack=r1/node=n2"},
+				},
+			},
+			expMembers: func(t *testing.T) system.Members {
+				return system.Members{
+					mockFDMember(t, 0, 1, "/rack=r0/node=n1"),
+					mockFDMember(t, 1, 1, "/rack=r0/node=n1"),
+					mockFDMember(t, 2, 2, "/rack=r1/node=n2"),
+					mockFDMember(t, 3, 2, "/rack=r1/node=n2"),
+				}
+			},
+			expLabels: []string{"rack", "node"},
+			expOverrides: map[string]string{
+				test.MockUUID(0): "/rack=r0/node=n1",
+				test.MockUUID(1): "/rack=r0/node=n1",
+				test.MockUUID(2): "/rack=r1/node=n2",
+				test.MockUUID(3): "/rack=r1/node=n2",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, defaultMembers(t), []*control.HostResponse{})
+			if tc.curLabels != nil {
+				if err := svc.setDomainLabels(tc.curLabels); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.curOverrides != nil {
+				if err := svc.setFaultDomainOverrides(tc.curOverrides); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotAPIErr := svc.SystemSetFaultDomains(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+			checkMembers(t, tc.expMembers(t), svc.membership)
+
+			gotLabels, err := svc.getDomainLabels()
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.CmpAny(t, "labels", tc.expLabels, gotLabels)
+
+			gotOverrides, err := svc.getFaultDomainOverrides()
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.CmpAny(t, "fault domain overrides", tc.expOverrides, gotOverrides)
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemReplaceHost(t *testing.T) {
+	oldHost := test.MockHostAddr(1).IP.String()
+	newHost := test.MockHostAddr(3).IP.String()
+	defaultMembers := func(t *testing.T) system.Members {
+		return system.Members{
+			mockMember(t, 0, 1, "excluded"),
+			mockMember(t, 1, 1, "stopped"),
+			mockMember(t, 2, 2, "joined"),
+			mockMember(t, 3, 2, "joined"),
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		members    system.Members
+		req        *mgmtpb.SystemReplaceHostReq
+		expResp    *mgmtpb.SystemReplaceHostResp
+		expMembers func(*testing.T) system.Members
+		expAPIErr  error
+	}{
+		"nil req": {
+			req:       (*mgmtpb.SystemReplaceHostReq)(nil),
+			expAPIErr: errors.New("nil request"),
+		},
+		"not system leader": {
+			req: &mgmtpb.SystemReplaceHostReq{
+				Sys: "quack",
+			},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"missing new host": {
+			req: &mgmtpb.SystemReplaceHostReq{
+				OldHost: oldHost,
+			},
+			expAPIErr: errors.New("must both be specified"),
+		},
+		"unknown old host": {
+			req: &mgmtpb.SystemReplaceHostReq{
+				OldHost: "10.0.0.4",
+				NewHost: newHost,
+			},
+			expAPIErr: errors.New("invalid host(s): 10.0.0.4"),
+		},
+		"new host resolution fails": {
+			req: &mgmtpb.SystemReplaceHostReq{
+				OldHost: oldHost,
+				NewHost: "10.0.0.5",
+			},
+			expAPIErr: errors.New("bad lookup"),
+		},
+		"new host has ranks": {
+			req: &mgmtpb.SystemReplaceHostReq{
+				OldHost: oldHost,
+				NewHost: test.MockHostAddr(2).IP.String(),
+			},
+			expAPIErr: errors.New("already has ranks"),
+		},
+		"old host has available ranks": {
+			req: &mgmtpb.SystemReplaceHostReq{
+				OldHost: test.MockHostAddr(2).IP.String(),
+				NewHost: newHost,
+			},
+			expAPIErr: errors.New("only unavailable ranks can be replaced"),
+		},
+		"success": {
+			req: &mgmtpb.SystemReplaceHostReq{
+				OldHost: oldHost,
+				NewHost: newHost,
+			},
+			expResp: &mgmtpb.SystemReplaceHostResp{
+				Ranks: "0-1",
+			},
+			expMembers: func(t *testing.T) system.Members {
+				members := defaultMembers(t)
+				for _, m := range members[:2] {
+					m.Addr = test.MockHostAddr(3)
+					m.AwaitingReplacement = true
+				}
+				return members
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, defaultMembers(t), []*control.HostResponse{})
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotAPIErr := svc.SystemReplaceHost(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+			checkMembers(t, tc.expMembers(t), svc.membership)
+		})
+	}
+}
+
+func TestServer_MgmtSvc_migrateFaultDomainOverride(t *testing.T) {
+	curOverrides := map[string]string{
+		test.MockUUID(0): "/r0/n0",
+		test.MockUUID(1): "/r0/n1",
+	}
+
+	for name, tc := range map[string]struct {
+		oldMember    func(*testing.T) *system.Member
+		newMember    func(*testing.T, *system.Member) *system.Member
+		expOverrides map[string]string
+	}{
+		"rejoin with same uuid": {
+			oldMember: func(t *testing.T) *system.Member {
+				return mockMember(t, 1, 1, "excluded")
+			},
+			newMember: func(t *testing.T, old *system.Member) *system.Member {
+				return mockMember(t, 1, 1, "joined")
+			},
+			expOverrides: curOverrides,
+		},
+		"replaced on same host": {
+			oldMember: func(t *testing.T) *system.Member {
+				return mockMember(t, 1, 1, "excluded")
+			},
+			newMember: func(t *testing.T, old *system.Member) *system.Member {
+				m := mockMember(t, 1, 1, "joined")
+				m.UUID = uuid.MustParse(test.MockUUID(5))
+				return m
+			},
+			expOverrides: map[string]string{
+				test.MockUUID(0): "/r0/n0",
+			},
+		},
+		"replaced on same host without imported fault domain": {
+			oldMember: func(t *testing.T) *system.Member {
+				return mockMember(t, 2, 2, "excluded")
+			},
+			newMember: func(t *testing.T, old *system.Member) *system.Member {
+				m := mockMember(t, 2, 2, "joined")
+				m.UUID = uuid.MustParse(test.MockUUID(5))
+				return m
+			},
+			expOverrides: curOverrides,
+		},
+		"moved to replacement host": {
+			oldMember: func(t *testing.T) *system.Member {
+				m := mockMember(t, 1, 1, "excluded")
+				m.AwaitingReplacement = true
+				return m
+			},
+			newMember: func(t *testing.T, old *system.Member) *system.Member {
+				m := mockMember(t, 1, 3, "joined")
+				m.UUID = uuid.MustParse(test.MockUUID(5))
+				m.FaultDomain = old.FaultDomain
+				return m
+			},
+			expOverrides: map[string]string{
+				test.MockUUID(0): "/r0/n0",
+				test.MockUUID(5): "/" + test.MockHostAddr(1).String() + "/1",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+
+			if err := svc.setFaultDomainOverrides(curOverrides); err != nil {
+				t.Fatal(err)
+			}
+
+			oldMember := tc.oldMember(t)
+			if err := svc.migrateFaultDomainOverride(oldMember, tc.newMember(t, oldMember)); err != nil {
+				t.Fatal(err)
+			}
+
+			gotOverrides, err := svc.getFaultDomainOverrides()
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.CmpAny(t, "fault domain overrides", tc.expOverrides, gotOverrides)
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemEvents(t *testing.T) {
+	mockEvent := func(rank uint32, sev events.RASSeverityID, ts string) *events.RASEvent {
+		evt := events.NewGenericEvent(events.RASUnknownEvent, sev, fmt.Sprintf("rank %d", rank), "")
+		evt.Hostname = "foo"
+		evt.Timestamp = ts
+		return evt.WithRank(rank)
+	}
+	mockEvents := []*events.RASEvent{
+		mockEvent(0, events.RASSeverityNotice, "2025-01-02T03:00:00.000000+00:00"),
+		mockEvent(1, events.RASSeverityError, "2025-01-02T04:00:00.000000+00:00"),
+		mockEvent(2, events.RASSeverityWarning, "2025-01-02T05:00:00.000000+00:00"),
+	}
+	expPBEvent := func(evt *events.RASEvent) *sharedpb.RASEvent {
+		ts, err := evt.GetTimestamp()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &sharedpb.RASEvent{
+			Id:        evt.ID.Uint32(),
+			Msg:       evt.Msg,
+			Timestamp: common.FormatTime(ts),
+			Type:      evt.Type.Uint32(),
+			Severity:  evt.Severity.Uint32(),
+			Hostname:  evt.Hostname,
+			Rank:      evt.Rank,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req       *mgmtpb.SystemEventsReq
+		expResp   *mgmtpb.SystemEventsResp
+		expAPIErr error
+	}{
+		"nil req": {
+			req:       (*mgmtpb.SystemEventsReq)(nil),
+			expAPIErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:       &mgmtpb.SystemEventsReq{Sys: "quack"},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"invalid severity": {
+			req:       &mgmtpb.SystemEventsReq{Severity: 42},
+			expAPIErr: errors.New("invalid event severity"),
+		},
+		"invalid ranks": {
+			req:       &mgmtpb.SystemEventsReq{Ranks: "a-b"},
+			expAPIErr: errors.New("invalid ranks"),
+		},
+		"invalid since": {
+			req:       &mgmtpb.SystemEventsReq{Since: "yesterday"},
+			expAPIErr: errors.New("invalid since"),
+		},
+		"until before since": {
+			req: &mgmtpb.SystemEventsReq{
+				Since: "2025-01-02T05:00:00Z",
+				Until: "2025-01-02T04:00:00Z",
+			},
+			expAPIErr: errors.New("is before since"),
+		},
+		"all events": {
+			req: &mgmtpb.SystemEventsReq{},
+			expResp: &mgmtpb.SystemEventsResp{
+				Events: []*sharedpb.RASEvent{
+					expPBEvent(mockEvents[2]),
+					expPBEvent(mockEvents[1]),
+					expPBEvent(mockEvents[0]),
+				},
+				Total: 3,
+			},
+		},
+		"filtered and paginated": {
+			req: &mgmtpb.SystemEventsReq{
+				Severity: events.RASSeverityWarning.Uint32(),
+				Since:    "2025-01-02T03:30:00Z",
+				Limit:    1,
+			},
+			expResp: &mgmtpb.SystemEventsResp{
+				Events: []*sharedpb.RASEvent{
+					expPBEvent(mockEvents[2]),
+				},
+				Total: 2,
+			},
+		},
+		"rank filter": {
+			req: &mgmtpb.SystemEventsReq{Ranks: "0"},
+			expResp: &mgmtpb.SystemEventsResp{
+				Events: []*sharedpb.RASEvent{
+					expPBEvent(mockEvents[0]),
+				},
+				Total: 1,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{}, []*control.HostResponse{})
+			if err := svc.sysdb.AddEvents(mockEvents); err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotAPIErr := svc.SystemEvents(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemChanges(t *testing.T) {
+	mockEvent := func(evt *events.RASEvent, ts string) *events.RASEvent {
+		evt.Timestamp = ts
+		return evt
+	}
+	mockEvents := []*events.RASEvent{
+		mockEvent(events.NewSystemMembershipChangedEvent(1, "join"),
+			"2025-01-02T03:00:00.000000+00:00"),
+		mockEvent(events.NewPoolMembershipChangedEvent(test.MockUUID(1), "extend"),
+			"2025-01-02T04:00:00.000000+00:00"),
+		mockEvent(events.NewPoolSvcReplicasUpdateEvent("foo", 2, test.MockUUID(2), []uint32{0, 2}, 1),
+			"2025-01-02T05:00:00.000000+00:00"),
+		mockEvent(events.NewGenericEvent(events.RASUnknownEvent, events.RASSeverityNotice, "foo", ""),
+			"2025-01-02T06:00:00.000000+00:00"),
+	}
+
+	for name, tc := range map[string]struct {
+		req       *mgmtpb.SystemChangesReq
+		expResp   *mgmtpb.SystemChangesResp
+		expAPIErr error
+	}{
+		"nil req": {
+			req:       (*mgmtpb.SystemChangesReq)(nil),
+			expAPIErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:       &mgmtpb.SystemChangesReq{Sys: "quack"},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"all changes": {
+			req: &mgmtpb.SystemChangesReq{},
+			expResp: &mgmtpb.SystemChangesResp{
+				Changes: []*mgmtpb.SystemChange{
+					{
+						Seq:       1,
+						Event:     events.RASSystemMembershipChanged.String(),
+						Rank:      1,
+						Op:        "join",
+						Timestamp: "2025-01-02T03:00:00.000+00:00",
+					},
+					{
+						Seq:       3,
+						Event:     events.RASPoolRepsUpdate.String(),
+						Rank:      2,
+						PoolUuid:  test.MockUUID(2),
+						Timestamp: "2025-01-02T05:00:00.000+00:00",
+					},
+				},
+				LastSeq: 4,
+			},
+		},
+		"changes after sequence": {
+			req: &mgmtpb.SystemChangesReq{AfterSeq: 1},
+			expResp: &mgmtpb.SystemChangesResp{
+				Changes: []*mgmtpb.SystemChange{
+					{
+						Seq:       3,
+						Event:     events.RASPoolRepsUpdate.String(),
+						Rank:      2,
+						PoolUuid:  test.MockUUID(2),
+						Timestamp: "2025-01-02T05:00:00.000+00:00",
+					},
+				},
+				LastSeq: 4,
+			},
+		},
+		"no new changes": {
+			req: &mgmtpb.SystemChangesReq{AfterSeq: 3},
+			expResp: &mgmtpb.SystemChangesResp{
+				LastSeq: 4,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{}, []*control.HostResponse{})
+			if err := svc.sysdb.AddEvents(mockEvents); err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotAPIErr := svc.SystemChanges(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemUsage(t *testing.T) {
+	creating := mockCapacityPool(t, 2, system.PoolServiceStateCreating,
+		[]uint64{20 * humanize.GByte, 200 * humanize.GByte}, 1, 2)
+
+	for name, tc := range map[string]struct {
+		req       *mgmtpb.SystemUsageReq
+		pools     []*system.PoolService
+		expResp   *mgmtpb.SystemUsageResp
+		expAPIErr error
+	}{
+		"nil req": {
+			req:       (*mgmtpb.SystemUsageReq)(nil),
+			expAPIErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:       &mgmtpb.SystemUsageReq{Sys: "quack"},
+			expAPIErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"no pools": {
+			req: &mgmtpb.SystemUsageReq{},
+			expResp: &mgmtpb.SystemUsageResp{
+				Ranks: []*mgmtpb.RankUsage{
+					{Rank: 0}, {Rank: 1}, {Rank: 2},
+				},
+			},
+		},
+		"allocated and reserved": {
+			req: &mgmtpb.SystemUsageReq{},
+			pools: []*system.PoolService{
+				mockCapacityPool(t, 1, system.PoolServiceStateReady,
+					[]uint64{10 * humanize.GByte, 100 * humanize.GByte}, 0, 1),
+				creating,
+			},
+			expResp: &mgmtpb.SystemUsageResp{
+				Ranks: []*mgmtpb.RankUsage{
+					{
+						Rank:           0,
+						AllocatedBytes: []uint64{10 * humanize.GByte, 100 * humanize.GByte},
+						NumPools:       1,
+					},
+					{
+						Rank:           1,
+						AllocatedBytes: []uint64{10 * humanize.GByte, 100 * humanize.GByte},
+						ReservedBytes:  []uint64{20 * humanize.GByte, 200 * humanize.GByte},
+						NumPools:       2,
+					},
+					{
+						Rank:          2,
+						ReservedBytes: []uint64{20 * humanize.GByte, 200 * humanize.GByte},
+						NumPools:      1,
+					},
+				},
+				Reservations: []*mgmtpb.PoolReservation{
+					{
+						Uuid:      creating.PoolUUID.String(),
+						Label:     creating.PoolLabel,
+						Ranks:     "1-2",
+						TierBytes: []uint64{20 * humanize.GByte, 200 * humanize.GByte},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "joined"),
+			}, []*control.HostResponse{})
+			for _, ps := range tc.pools {
+				addTestPoolService(t, svc.sysdb, ps)
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotAPIErr := svc.SystemUsage(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expAPIErr, gotAPIErr)
+			if tc.expAPIErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				protocmp.Transform(),
+				protocmp.IgnoreFields(&mgmtpb.PoolReservation{}, "since"),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemOpLocks(t *testing.T) {
+	poolOwner := system.LockOwner{Operation: "PoolExtend", User: "admin@host1"}
+	sysOwner := system.LockOwner{Operation: "SystemStop", User: "admin@host2"}
+
+	for name, tc := range map[string]struct {
+		req        *mgmtpb.SystemOpLocksReq
+		poolLock   bool
+		systemLock bool
+		expResp    *mgmtpb.SystemOpLocksResp
+		expErr     error
+	}{
+		"nil req": {
+			req:    (*mgmtpb.SystemOpLocksReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.SystemOpLocksReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"no locks": {
+			req:     &mgmtpb.SystemOpLocksReq{},
+			expResp: &mgmtpb.SystemOpLocksResp{},
+		},
+		"pool and system locks": {
+			req:        &mgmtpb.SystemOpLocksReq{},
+			poolLock:   true,
+			systemLock: true,
+			expResp: &mgmtpb.SystemOpLocksResp{
+				Locks: []*mgmtpb.OpLock{
+					{
+						PoolUuid:  test.MockUUID(1),
+						PoolLabel: "pool1",
+						Operation: poolOwner.Operation,
+						User:      poolOwner.User,
+					},
+					{
+						Operation: sysOwner.Operation,
+						User:      sysOwner.User,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mgmtSystemTestSetup(t, log, system.Members{
+				mockMember(t, 0, 1, "joined"),
+			}, []*control.HostResponse{})
+
+			if tc.poolLock {
+				ps := &system.PoolService{
+					PoolUUID:  test.MockPoolUUID(1),
+					PoolLabel: "pool1",
+					State:     system.PoolServiceStateReady,
+				}
+				addTestPoolService(t, svc.sysdb, ps)
+
+				lock, err := svc.sysdb.TakePoolLock(system.WithLockOwner(test.Context(t), poolOwner),
+					ps.PoolUUID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer lock.Release()
+			}
+			if tc.systemLock {
+				lock, err := svc.sysdb.TakeSystemLock(system.WithLockOwner(test.Context(t), sysOwner))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer lock.Release()
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.SystemOpLocks(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				protocmp.Transform(),
+				protocmp.IgnoreFields(&mgmtpb.OpLock{}, "since"),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemStop_Locked(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := mgmtSystemTestSetup(t, log, system.Members{
+		mockMember(t, 0, 1, "joined"),
+	}, []*control.HostResponse{})
+
+	owner := system.LockOwner{Operation: "SystemStart", User: "admin@host1"}
+	lock, err := svc.sysdb.TakeSystemLock(system.WithLockOwner(test.Context(t), owner))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+
+	_, err = svc.SystemStop(test.Context(t), &mgmtpb.SystemStopReq{
+		Sys:   build.DefaultSystemName,
+		Force: true,
+	})
+	test.CmpErr(t, errors.New("operation SystemStart in progress since"), err)
+}
+
+func TestServer_MgmtSvc_SystemDrain(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req            *mgmtpb.SystemDrainReq
+		useLabels      bool
+		pools          []string
+		members        system.Members
+		drpcResps      []*mockDrpcResponse // For dRPC PoolQuery
+		expDrpcCount   int
+		mic            *control.MockInvokerConfig // For control-API PoolDrain/Reint
+		expCtlApiCount int
+		expErr         error
+		expResp        *mgmtpb.SystemDrainResp
+	}{
+		"nil req": {
+			req:    (*mgmtpb.SystemDrainReq)(nil),
+			expErr: errors.New("nil *mgmt.SystemDrainReq"),
+		},
+		"not system leader": {
+			req:    &mgmtpb.SystemDrainReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"no hosts or ranks": {
+			req:    &mgmtpb.SystemDrainReq{},
+			expErr: errors.New("no hosts or ranks"),
+		},
+		"hosts and ranks": {
+			req: &mgmtpb.SystemDrainReq{
+				Hosts: "host1,host2",
+				Ranks: "0,1",
+			},
+			expErr: errors.New("ranklist and hostlist"),
+		},
+		"invalid ranks": {
+			req:    &mgmtpb.SystemDrainReq{Ranks: "41,42"},
+			expErr: errors.New("invalid rank(s)"),
+		},
+		"invalid hosts": {
+			req:    &mgmtpb.SystemDrainReq{Hosts: "host-[1-2]"},
+			expErr: errors.New("invalid host(s)"),
+		},
+		"requested admin excluded ranks": {
+			req: &mgmtpb.SystemDrainReq{Ranks: "0-2"},
+			members: system.Members{
+				system.MockMember(t, 0, system.MemberStateAdminExcluded),
+				system.MockMember(t, 1, system.MemberStateJoined),
+				system.MockMember(t, 2, system.MemberStateAdminExcluded),
+			},
+			expErr: FaultRankAdminExcluded(ranklist.RankList{0, 2}),
+		},
+		"local failure on pool query": {
+			req:   &mgmtpb.SystemDrainReq{Ranks: "0"},
+			pools: []string{test.MockUUID(1)},
+			drpcResps: []*mockDrpcResponse{
+				&mockDrpcResponse{
+					Error: errors.New("local failed"),
+				},
+			},
+			expErr:       errors.New("local failed"),
+			expDrpcCount: 1,
+		},
+		"local failure on pool drain": {
+			req:   &mgmtpb.SystemDrainReq{Ranks: "0"},
 			pools: []string{test.MockUUID(1)},
 			drpcResps: []*mockDrpcResponse{
 				&mockDrpcResponse{
@@ -3321,6 +4537,7 @@ func TestMgmtSvc_verifyFaultDomain(t *testing.T) {
 	for name, tc := range map[string]struct {
 		getSvc         func(*testing.T, logging.Logger) *mgmtSvc
 		curLabels      []string
+		overrides      map[string]string
 		req            *mgmtpb.JoinReq
 		expFaultDomain *system.FaultDomain
 		expErr         error
@@ -3385,6 +4602,30 @@ func TestMgmtSvc_verifyFaultDomain(t *testing.T) {
 			expFaultDomain: system.MustCreateFaultDomainFromString("/r1/n2"),
 			expLabels:      []string{"", ""},
 		},
+		"imported fault domain takes precedence": {
+			curLabels: []string{"rack", "node"},
+			overrides: map[string]string{
+				test.MockUUID(1): "/rack=r2/node=n2",
+			},
+			req: &mgmt.JoinReq{
+				Uuid:           test.MockUUID(1),
+				SrvFaultDomain: "/rack=r1/node=n2",
+			},
+			expFaultDomain: system.MustCreateFaultDomainFromString("/rack=r2/node=n2"),
+			expLabels:      []string{"rack", "node"},
+		},
+		"invalid imported fault domain": {
+			curLabels: []string{"rack", "node"},
+			overrides: map[string]string{
+				test.MockUUID(1): "junk",
+			},
+			req: &mgmt.JoinReq{
+				Uuid:           test.MockUUID(1),
+				SrvFaultDomain: "/rack=r1/node=n2",
+			},
+			expErr:    errors.New("invalid fault domain"),
+			expLabels: []string{"rack", "node"},
+		},
 		"labeled request with unlabeled system": {
 			curLabels: []string{"", ""},
 			req: &mgmt.JoinReq{
@@ -3443,6 +4684,11 @@ func TestMgmtSvc_verifyFaultDomain(t *testing.T) {
 					t.Fatal(err)
 				}
 			}
+			if tc.overrides != nil {
+				if err := svc.setFaultDomainOverrides(tc.overrides); err != nil {
+					t.Fatal(err)
+				}
+			}
 
 			fd, err := svc.verifyFaultDomain(tc.req)
 
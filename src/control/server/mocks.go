@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"sync"
 
 	"github.com/dustin/go-humanize"
+	"google.golang.org/grpc"
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/events"
@@ -100,3 +102,24 @@ func (ms *mockSubscriber) getRx() []string {
 
 	return ms.rx
 }
+
+// mockServerStream captures the responses sent by the handler of a
+// server-streaming RPC.
+type mockServerStream[T any] struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*T
+}
+
+func newMockServerStream[T any](ctx context.Context) *mockServerStream[T] {
+	return &mockServerStream[T]{ctx: ctx}
+}
+
+func (ms *mockServerStream[T]) Context() context.Context {
+	return ms.ctx
+}
+
+func (ms *mockServerStream[T]) Send(msg *T) error {
+	ms.sent = append(ms.sent, msg)
+	return nil
+}
@@ -0,0 +1,80 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// progressFunc is called to report the progress of a long-running request.
+type progressFunc func(stage string, completed, total int)
+
+type progressKey struct{}
+
+// withProgress returns a child context carrying a function to be called with
+// the progress of the request handled with the context.
+func withProgress(parent context.Context, fn progressFunc) context.Context {
+	if fn == nil {
+		return parent
+	}
+	return context.WithValue(parent, progressKey{}, fn)
+}
+
+// progressFromContext returns the progress function carried by the context, or
+// nil if there is none.
+func progressFromContext(ctx context.Context) progressFunc {
+	if ctx == nil {
+		return nil
+	}
+	fn, _ := ctx.Value(progressKey{}).(progressFunc)
+	return fn
+}
+
+// reportProgress reports the progress of the request handled with the context,
+// if the requester asked for progress updates. A total of zero indicates that
+// the progress of the stage can not be measured.
+func reportProgress(ctx context.Context, stage string, completed, total int) {
+	if fn := progressFromContext(ctx); fn != nil {
+		fn(stage, completed, total)
+	}
+}
+
+// withProgressStage returns a child context whose progress updates are reported
+// with the supplied description of the stage.
+func withProgressStage(parent context.Context, stage string) context.Context {
+	fn := progressFromContext(parent)
+	if fn == nil {
+		return parent
+	}
+	return withProgress(parent, func(_ string, completed, total int) {
+		fn(stage, completed, total)
+	})
+}
+
+// streamProgress returns a child context whose progress updates are sent to the
+// requester with the supplied function. A failure to send an update does not
+// fail the request, as the final response may still be delivered.
+func streamProgress(parent context.Context, log logging.Logger, send func(*sharedpb.Progress) error) context.Context {
+	var mu sync.Mutex
+	return withProgress(parent, func(stage string, completed, total int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		log.Debugf("progress: %s (%d/%d)", stage, completed, total)
+		if err := send(&sharedpb.Progress{
+			Stage:     stage,
+			Completed: uint32(completed),
+			Total:     uint32(total),
+		}); err != nil {
+			log.Debugf("failed to send progress update: %s", err)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestServer_reportProgress(t *testing.T) {
+	for name, tc := range map[string]struct {
+		stage   string
+		sendErr error
+		expSent []*sharedpb.Progress
+	}{
+		"no stage override": {
+			expSent: []*sharedpb.Progress{
+				{Stage: "formatting", Completed: 0, Total: 2},
+				{Stage: "formatting", Completed: 2, Total: 2},
+			},
+		},
+		"stage override": {
+			stage: "stopping ranks",
+			expSent: []*sharedpb.Progress{
+				{Stage: "stopping ranks", Completed: 0, Total: 2},
+				{Stage: "stopping ranks", Completed: 2, Total: 2},
+			},
+		},
+		"send fails": {
+			sendErr: errors.New("closed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			// Progress reported without a requester is ignored.
+			reportProgress(test.Context(t), "ignored", 1, 1)
+
+			var sent []*sharedpb.Progress
+			ctx := streamProgress(test.Context(t), log, func(p *sharedpb.Progress) error {
+				if tc.sendErr != nil {
+					return tc.sendErr
+				}
+				sent = append(sent, p)
+				return nil
+			})
+			if tc.stage != "" {
+				ctx = withProgressStage(ctx, tc.stage)
+			}
+
+			reportProgress(ctx, "formatting", 0, 2)
+			reportProgress(ctx, "formatting", 2, 2)
+
+			if diff := cmp.Diff(tc.expSent, sent, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected progress (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
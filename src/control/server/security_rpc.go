@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"crypto"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
 
@@ -23,8 +25,9 @@ import (
 
 // SecurityModule is the security drpc module struct
 type SecurityModule struct {
-	log    logging.Logger
-	config *security.TransportConfig
+	log           logging.Logger
+	config        *security.TransportConfig
+	groupResolver security.GroupResolver
 }
 
 // NewSecurityModule creates a new security module with a transport config
@@ -35,7 +38,68 @@ func NewSecurityModule(log logging.Logger, tc *security.TransportConfig) *Securi
 	}
 }
 
-func (m *SecurityModule) processValidateCredentials(body []byte) ([]byte, error) {
+// WithGroupResolver sets a resolver used to add the user's directory groups to
+// validated credentials.
+func (m *SecurityModule) WithGroupResolver(resolver security.GroupResolver) *SecurityModule {
+	m.groupResolver = resolver
+	return m
+}
+
+// addResolvedGroups returns a copy of the AUTH_SYS token with any groups found
+// by the resolver added to the token's groups. If the groups can't be resolved,
+// the token is returned unchanged.
+func (m *SecurityModule) addResolvedGroups(ctx context.Context, token *auth.Token) *auth.Token {
+	if m.groupResolver == nil || token.GetFlavor() != auth.Flavor_AUTH_SYS {
+		return token
+	}
+
+	sys, err := auth.AuthSysFromAuthToken(token)
+	if err != nil {
+		m.log.Errorf("unable to resolve groups: %s", err)
+		return token
+	}
+
+	user := strings.TrimSuffix(sys.GetUser(), "@")
+	groups, err := m.groupResolver.ResolveGroups(ctx, user)
+	if err != nil {
+		m.log.Errorf("unable to resolve groups for %s: %s", sys.GetUser(), err)
+		return token
+	}
+
+	known := make(map[string]struct{})
+	known[sys.GetGroup()] = struct{}{}
+	for _, group := range sys.GetGroups() {
+		known[group] = struct{}{}
+	}
+
+	added := false
+	for _, group := range groups {
+		principal := group + "@"
+		if _, found := known[principal]; found {
+			continue
+		}
+		known[principal] = struct{}{}
+		sys.Groups = append(sys.Groups, principal)
+		added = true
+	}
+	if !added {
+		return token
+	}
+
+	data, err := proto.Marshal(sys)
+	if err != nil {
+		m.log.Errorf("unable to marshal token with resolved groups: %s", err)
+		return token
+	}
+	m.log.Debugf("added resolved groups to credential for %s: %v", sys.GetUser(), sys.GetGroups())
+
+	return &auth.Token{
+		Flavor: token.GetFlavor(),
+		Data:   data,
+	}
+}
+
+func (m *SecurityModule) processValidateCredentials(ctx context.Context, body []byte) ([]byte, error) {
 	req := &auth.ValidateCredReq{}
 	err := proto.Unmarshal(body, req)
 	if err != nil {
@@ -69,7 +133,7 @@ func (m *SecurityModule) processValidateCredentials(body []byte) ([]byte, error)
 		return m.validateRespWithStatus(daos.NoPermission)
 	}
 
-	resp := &auth.ValidateCredResp{Token: cred.Token}
+	resp := &auth.ValidateCredResp{Token: m.addResolvedGroups(ctx, cred.Token)}
 	responseBytes, err := proto.Marshal(resp)
 	if err != nil {
 		return nil, drpc.MarshalingFailure()
@@ -82,12 +146,12 @@ func (m *SecurityModule) validateRespWithStatus(status daos.Status) ([]byte, err
 }
 
 // HandleCall is the handler for calls to the SecurityModule
-func (m *SecurityModule) HandleCall(_ context.Context, session *drpc.Session, method drpc.Method, body []byte) ([]byte, error) {
+func (m *SecurityModule) HandleCall(ctx context.Context, session *drpc.Session, method drpc.Method, body []byte) ([]byte, error) {
 	if method != drpc.MethodValidateCredentials {
 		return nil, drpc.UnknownMethodFailure()
 	}
 
-	return m.processValidateCredentials(body)
+	return m.processValidateCredentials(ctx, body)
 }
 
 // ID will return Security module ID
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,7 @@
 package server
 
 import (
+	"context"
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
@@ -346,3 +348,65 @@ func TestSrvSecurityModule_ValidateCred_Secure_BadVerifier(t *testing.T) {
 		Status: int32(daos.NoPermission),
 	})
 }
+
+type mockGroupResolver struct {
+	groups []string
+	err    error
+}
+
+func (r *mockGroupResolver) ResolveGroups(_ context.Context, _ string) ([]string, error) {
+	return r.groups, r.err
+}
+
+func TestSrvSecurityModule_ValidateCred_GroupResolver(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resolver  security.GroupResolver
+		expGroups []string
+	}{
+		"no resolver": {},
+		"resolver fails": {
+			resolver: &mockGroupResolver{err: errors.New("ldap unavailable")},
+		},
+		"no new groups": {
+			resolver: &mockGroupResolver{groups: []string{"goodgroup"}},
+		},
+		"new groups added": {
+			resolver: &mockGroupResolver{
+				groups: []string{"admins", "goodgroup", "hpc"},
+			},
+			expGroups: []string{"admins@", "hpc@"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mod := NewSecurityModule(log, insecureTransportConfig()).
+				WithGroupResolver(tc.resolver)
+
+			token := getValidToken(t)
+			reqBytes := getMarshaledValidateCredReq(t, token, getVerifierForToken(t, token, nil))
+
+			respBytes, err := callValidateCreds(t, mod, reqBytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp := &auth.ValidateCredResp{}
+			if err := proto.Unmarshal(respBytes, resp); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, int32(0), resp.Status, "unexpected status")
+
+			sys, err := auth.AuthSysFromAuthToken(resp.Token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, "gooduser@", sys.User, "unexpected user")
+			test.AssertEqual(t, "goodgroup@", sys.Group, "unexpected group")
+			if diff := cmp.Diff(tc.expGroups, sys.Groups); diff != "" {
+				t.Fatalf("unexpected groups (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
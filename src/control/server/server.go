@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -19,9 +20,11 @@ import (
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/events"
@@ -30,6 +33,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
+	"github.com/daos-stack/daos/src/control/lib/systemd"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -150,6 +154,8 @@ type server struct {
 	pubSub       *events.PubSub
 	evtForwarder *control.EventForwarder
 	evtLogger    *control.EventLogger
+	evtExporter  *control.OTLPEventExporter
+	spanExporter *control.OTLPSpanExporter
 	ctlSvc       *ControlService
 	mgmtSvc      *mgmtSvc
 	grpcServer   *grpc.Server
@@ -229,19 +235,50 @@ func (srv *server) createServices(ctx context.Context) (err error) {
 	}
 	srv.membership = system.NewMembership(srv.log, srv.sysdb)
 
+	if otlpCfg := srv.cfg.TelemetryOTLPTraces; otlpCfg.Enabled() {
+		srv.log.Debugf("exporting request traces to OTLP collector at %s", otlpCfg.Endpoint)
+		srv.spanExporter = control.NewOTLPSpanExporter(srv.log, control.OTLPExporterConfig{
+			Endpoint:   otlpCfg.Endpoint,
+			Headers:    otlpCfg.Headers,
+			Component:  build.ComponentServer,
+			SystemName: srv.cfg.SystemName,
+			Hostname:   srv.hostname,
+		})
+		srv.spanExporter.Start(ctx)
+	}
+
 	// Create rpcClient for inter-server communication.
 	cliCfg := control.DefaultConfig()
 	cliCfg.TransportConfig = srv.cfg.TransportConfig
-	rpcClient := control.NewClient(
+	cliCfg.MaxMsgSize = srv.cfg.ControlMaxMsgSize
+	cliOpts := []control.ClientOption{
 		control.WithClientComponent(build.ComponentServer),
 		control.WithConfig(cliCfg),
-		control.WithClientLogger(srv.log))
+		control.WithClientLogger(srv.log),
+	}
+	if srv.spanExporter != nil {
+		// Continue the traces of requests that are handled by invoking
+		// RPCs on other servers.
+		cliOpts = append(cliOpts, control.WithClientSpanExporter(srv.spanExporter))
+	}
+	rpcClient := control.NewClient(cliOpts...)
 
 	// Create event distribution primitives.
 	srv.pubSub = events.NewPubSub(ctx, srv.log)
 	srv.OnShutdown(srv.pubSub.Close)
 	srv.evtForwarder = control.NewEventForwarder(rpcClient, srv.cfg.MgmtSvcReplicas)
 	srv.evtLogger = control.NewEventLogger(srv.log)
+	if otlpCfg := srv.cfg.TelemetryOTLPLogs; otlpCfg.Enabled() {
+		srv.log.Debugf("exporting RAS events to OTLP collector at %s", otlpCfg.Endpoint)
+		srv.evtExporter = control.NewOTLPEventExporter(srv.log, control.OTLPExporterConfig{
+			Endpoint:   otlpCfg.Endpoint,
+			Headers:    otlpCfg.Headers,
+			Component:  build.ComponentServer,
+			SystemName: srv.cfg.SystemName,
+			Hostname:   srv.hostname,
+		})
+		srv.evtExporter.Start(ctx)
+	}
 
 	srv.ctlSvc = NewControlService(srv.log, srv.harness, srv.cfg, srv.pubSub,
 		network.DefaultFabricScanner(srv.log))
@@ -396,7 +433,13 @@ func (srv *server) addEngines(ctx context.Context) error {
 
 // setupGrpc creates a new grpc server and registers services.
 func (srv *server) setupGrpc() error {
-	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.sysdb.IsLeader)
+	maxMsgSize, err := srv.cfg.ControlMaxMsgBytes()
+	if err != nil {
+		return err
+	}
+
+	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, maxMsgSize, srv.sysdb.IsLeader,
+		srv.ctlSvc.peerVersions, srv.spanExporter)
 	if err != nil {
 		return err
 	}
@@ -427,7 +470,18 @@ func (srv *server) setupGrpc() error {
 	}
 	srv.mgmtSvc.clientNetworkHint = clientNetHints
 
+	if srv.cfg.GroupResolver != nil {
+		srv.mgmtSvc.groupResolver = security.NewCommandGroupResolver(srv.cfg.GroupResolver)
+	}
+	poolGroupTemplates, err := newPoolGroupTemplates(srv.cfg.PoolGroupTemplates)
+	if err != nil {
+		return errors.Wrap(err, "pool_group_templates")
+	}
+	srv.mgmtSvc.poolTemplates = poolGroupTemplates
+
 	mgmtpb.RegisterMgmtSvcServer(srv.grpcServer, srv.mgmtSvc)
+	// Allow clients to check that their API is compatible with the server's.
+	reflection.Register(srv.grpcServer)
 
 	tSec, err := security.DialOptionForTransportConfig(srv.cfg.TransportConfig)
 	if err != nil {
@@ -502,12 +556,14 @@ func (srv *server) start(ctx context.Context) error {
 		build.DaosVersion, os.Getpid(), srv.ctlAddr)
 
 	drpcSetupReq := &drpcServerSetupReq{
-		log:     srv.log,
-		sockDir: srv.cfg.SocketDir,
-		engines: srv.harness.Instances(),
-		tc:      srv.cfg.TransportConfig,
-		sysdb:   srv.sysdb,
-		events:  srv.pubSub,
+		log:       srv.log,
+		sockDir:   srv.cfg.SocketDir,
+		engines:   srv.harness.Instances(),
+		tc:        srv.cfg.TransportConfig,
+		sysdb:     srv.sysdb,
+		events:    srv.pubSub,
+		groups:    srv.mgmtSvc.groupResolver,
+		devFaults: srv.ctlSvc.devFaults,
 	}
 	// Single daos_server dRPC server to handle all engine requests
 	if err := drpcServerSetup(ctx, drpcSetupReq); err != nil {
@@ -521,6 +577,14 @@ func (srv *server) start(ctx context.Context) error {
 
 	srv.mgmtSvc.startAsyncLoops(ctx)
 
+	if err := systemd.Ready(); err != nil && err != systemd.ErrSdNotifyNoSocket {
+		return errors.Wrap(err, "unable to notify systemd")
+	}
+	defer systemd.Stopping()
+	if _, err := systemd.StartWatchdog(ctx, srv.log, listenerHealthCheck(srv.listener)); err != nil {
+		return errors.Wrap(err, "unable to start systemd watchdog")
+	}
+
 	if srv.cfg.AutoFormat {
 		srv.log.Notice("--auto flag set on server start so formatting storage now")
 		if _, err := srv.ctlSvc.StorageFormat(ctx, &ctlpb.StorageFormatReq{}); err != nil {
@@ -567,6 +631,24 @@ func lookupIF(name string) (netInterface, error) {
 	return iface, nil
 }
 
+// reloadLogModules re-reads the per-module control log levels from the server
+// config file and applies them, enabling verbose output for individual modules
+// without a restart. Other config changes are ignored.
+func (srv *server) reloadLogModules() {
+	newCfg := config.DefaultServer()
+	newCfg.Path = srv.cfg.Path
+	if err := newCfg.Load(srv.log); err != nil {
+		srv.log.Errorf("failed to reload config %q: %s", srv.cfg.Path, err)
+		return
+	}
+
+	if err := cmdutil.ConfigureModuleLevels(srv.log, newCfg.ControlLogModules); err != nil {
+		srv.log.Errorf("failed to set control log module levels: %s", err)
+		return
+	}
+	srv.cfg.ControlLogModules = newCfg.ControlLogModules
+}
+
 // Start is the entry point for a daos_server instance.
 func Start(log logging.Logger, cfg *config.Server) error {
 	if err := common.CheckDupeProcess(); err != nil {
@@ -642,11 +724,21 @@ func Start(log logging.Logger, cfg *config.Server) error {
 	srv.registerEvents()
 
 	sigChan := make(chan os.Signal)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGHUP)
 	go func() {
-		sig := <-sigChan
-		srv.log.Debugf("Caught signal: %s", sig)
-		shutdown()
+		for sig := range sigChan {
+			if sig == syscall.SIGHUP {
+				srv.log.Infof("Caught signal: %s; reloading control log module levels", sig)
+				if err := systemd.Reload(srv.reloadLogModules); err != nil {
+					srv.log.Errorf("unable to notify systemd of reload: %s", err)
+				}
+				continue
+			}
+
+			srv.log.Debugf("Caught signal: %s", sig)
+			shutdown()
+			return
+		}
 	}()
 
 	return srv.start(ctx)
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -27,6 +28,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/systemd"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/pbin"
 	"github.com/daos-stack/daos/src/control/security"
@@ -192,6 +194,22 @@ func createListener(ctlAddr *net.TCPAddr, listen netListenFn) (net.Listener, err
 	return lis, nil
 }
 
+// listenerHealthCheck returns a health check that verifies that the gRPC
+// listener is accepting connections.
+func listenerHealthCheck(lis net.Listener) systemd.HealthCheck {
+	return systemd.HealthCheck{
+		Name: "gRPC listener",
+		Check: func(ctx context.Context) error {
+			var dialer net.Dialer
+			conn, err := dialer.DialContext(ctx, lis.Addr().Network(), lis.Addr().String())
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
+
 // updateFabricEnvars adjusts the engine fabric configuration.
 func updateFabricEnvars(log logging.Logger, cfg *engine.Config, fis *hardware.FabricInterfaceSet) error {
 	// In the case of some providers, mercury uses the interface name
@@ -679,6 +697,24 @@ func registerFollowerSubscriptions(srv *server) {
 	srv.pubSub.Reset()
 	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.evtForwarder)
+	srv.pubSub.Subscribe(events.RASTypeInfoOnly,
+		events.HandlerFunc(func(ctx context.Context, evt *events.RASEvent) {
+			// A completed rebuild changes the target membership of the
+			// pool, which the MS needs to know about to notify agents.
+			if evt.ID == events.RASPoolRebuildEnd {
+				srv.evtForwarder.OnEvent(ctx, evt)
+			}
+		}))
+	registerExporterSubscription(srv)
+}
+
+// registerExporterSubscription subscribes the OTLP exporter, if enabled, to
+// all events. Events forwarded from other hosts are ignored by the exporter
+// as they have already been exported by the host that raised them.
+func registerExporterSubscription(srv *server) {
+	if srv.evtExporter != nil {
+		srv.pubSub.Subscribe(events.RASTypeAny, srv.evtExporter)
+	}
 }
 
 // registerLeaderSubscriptions stops forwarding events to MS and instead starts
@@ -686,8 +722,19 @@ func registerFollowerSubscriptions(srv *server) {
 func registerLeaderSubscriptions(srv *server) {
 	srv.pubSub.Reset()
 	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
+	registerExporterSubscription(srv)
+	// Keep a bounded history of events in the system database so that they
+	// can be queried without relying on external log forwarding. Events are
+	// recorded in batches by the leader task loop.
+	srv.pubSub.Subscribe(events.RASTypeAny, srv.mgmtSvc.eventRecorder)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.membership)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.sysdb)
+	srv.pubSub.Subscribe(events.RASTypeInfoOnly,
+		events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+			if evt.ID == events.RASPoolRebuildEnd {
+				srv.mgmtSvc.notifyPoolMembershipChanged(evt.PoolUUID, "rebuild")
+			}
+		}))
 	srv.pubSub.Subscribe(events.RASTypeStateChange,
 		events.HandlerFunc(func(ctx context.Context, evt *events.RASEvent) {
 			switch evt.ID {
@@ -722,21 +769,37 @@ func registerLeaderSubscriptions(srv *server) {
 }
 
 // getGrpcOpts generates a set of gRPC options for the server based on the supplied configuration.
-func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool) ([]grpc.ServerOption, error) {
+func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, maxMsgSize int, ldrChk func() bool, pvt *peerVersionTracker, spanExp *control.OTLPSpanExporter) ([]grpc.ServerOption, error) {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		unaryLoggingInterceptor(log, ldrChk), // must be first in order to properly log errors
+	}
+	if spanExp != nil {
+		// Record the request span before any other processing.
+		unaryInterceptors = append(unaryInterceptors, spanExp.UnaryServerInterceptor())
+	}
+	unaryInterceptors = append(unaryInterceptors,
+		unaryElapsedInterceptor,
+		unaryLockOwnerInterceptor,
 		unaryErrorInterceptor,
 		unaryStatusInterceptor,
+		unaryPeerVersionInterceptor(pvt), // must precede version check to record incompatible peers
 		unaryVersionInterceptor(log),
-	}
+	)
 	streamInterceptors := []grpc.StreamServerInterceptor{
+		streamLockOwnerInterceptor,
 		streamErrorInterceptor,
+		streamStatusInterceptor,
+		streamVersionInterceptor(log),
 	}
 	tcOpt, err := security.ServerOptionForTransportConfig(cfgTransport)
 	if err != nil {
 		return nil, err
 	}
-	srvOpts := []grpc.ServerOption{tcOpt}
+	srvOpts := []grpc.ServerOption{
+		tcOpt,
+		grpc.MaxRecvMsgSize(maxMsgSize),
+		grpc.MaxSendMsgSize(maxMsgSize),
+	}
 
 	uintOpt, err := unaryInterceptorForTransportConfig(cfgTransport)
 	if err != nil {
@@ -1126,6 +1126,33 @@ func TestServerUtils_getControlAddr(t *testing.T) {
 	}
 }
 
+func TestServerUtils_listenerHealthCheck(t *testing.T) {
+	for name, tc := range map[string]struct {
+		closed bool
+		expErr error
+	}{
+		"accepting connections": {},
+		"closed": {
+			closed: true,
+			expErr: errors.New("connection refused"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			lis, err := net.Listen("tcp4", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer lis.Close()
+			if tc.closed {
+				lis.Close()
+			}
+
+			hc := listenerHealthCheck(lis)
+			test.CmpErr(t, tc.expErr, hc.Check(test.Context(t)))
+		})
+	}
+}
+
 func TestServer_processFabricProvider(t *testing.T) {
 	for name, tc := range map[string]struct {
 		cfgFabric string
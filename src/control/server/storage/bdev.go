@@ -1,6 +1,7 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -27,12 +28,6 @@ import (
 	"github.com/daos-stack/daos/src/control/pbin"
 )
 
-/*
-#include "stdlib.h"
-#include "daos_srv/control.h"
-*/
-import "C"
-
 // BdevPciAddrSep defines the separator used between PCI addresses in string lists.
 const (
 	BdevPciAddrSep = " "
@@ -50,34 +45,14 @@ const (
 
 // JSON config file constants.
 const (
-	ConfBdevSetOptions           = "bdev_set_options"
-	ConfBdevNvmeSetOptions       = "bdev_nvme_set_options"
-	ConfBdevNvmeSetHotplug       = "bdev_nvme_set_hotplug"
-	ConfBdevAioCreate            = "bdev_aio_create"
-	ConfBdevNvmeAttachController = C.NVME_CONF_ATTACH_CONTROLLER
-	ConfVmdEnable                = C.NVME_CONF_ENABLE_VMD
-	ConfSetHotplugBusidRange     = C.NVME_CONF_SET_HOTPLUG_RANGE
-	ConfSetAccelProps            = C.NVME_CONF_SET_ACCEL_PROPS
-	ConfSetSpdkRpcServer         = C.NVME_CONF_SET_SPDK_RPC_SERVER
-	ConfSetAutoFaultyProps       = C.NVME_CONF_SET_AUTO_FAULTY
-)
-
-// Acceleration related constants for engine setting and optional capabilities.
-const (
-	AccelEngineNone  = C.NVME_ACCEL_NONE
-	AccelEngineSPDK  = C.NVME_ACCEL_SPDK
-	AccelEngineDML   = C.NVME_ACCEL_DML
-	AccelOptMoveFlag = C.NVME_ACCEL_FLAG_MOVE
-	AccelOptCRCFlag  = C.NVME_ACCEL_FLAG_CRC
+	ConfBdevSetOptions     = "bdev_set_options"
+	ConfBdevNvmeSetOptions = "bdev_nvme_set_options"
+	ConfBdevNvmeSetHotplug = "bdev_nvme_set_hotplug"
+	ConfBdevAioCreate      = "bdev_aio_create"
 )
 
-// Role assignments for NVMe SSDs related to type of storage (enables Metadata-on-SSD capability).
-const (
-	BdevRoleData = C.NVME_ROLE_DATA
-	BdevRoleMeta = C.NVME_ROLE_META
-	BdevRoleWAL  = C.NVME_ROLE_WAL
-	BdevRoleAll  = BdevRoleData | BdevRoleMeta | BdevRoleWAL
-)
+// BdevRoleAll is the combination of all NVMe SSD role assignments.
+const BdevRoleAll = BdevRoleData | BdevRoleMeta | BdevRoleWAL
 
 // NvmeDevState represents the operation state of an NVMe device.
 type NvmeDevState int32
@@ -0,0 +1,40 @@
+//
+// (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+/*
+#include "stdlib.h"
+#include "daos_srv/control.h"
+*/
+import "C"
+
+// JSON config file constants shared with the engine.
+const (
+	ConfBdevNvmeAttachController = C.NVME_CONF_ATTACH_CONTROLLER
+	ConfVmdEnable                = C.NVME_CONF_ENABLE_VMD
+	ConfSetHotplugBusidRange     = C.NVME_CONF_SET_HOTPLUG_RANGE
+	ConfSetAccelProps            = C.NVME_CONF_SET_ACCEL_PROPS
+	ConfSetSpdkRpcServer         = C.NVME_CONF_SET_SPDK_RPC_SERVER
+	ConfSetAutoFaultyProps       = C.NVME_CONF_SET_AUTO_FAULTY
+)
+
+// Acceleration related constants for engine setting and optional capabilities.
+const (
+	AccelEngineNone  = C.NVME_ACCEL_NONE
+	AccelEngineSPDK  = C.NVME_ACCEL_SPDK
+	AccelEngineDML   = C.NVME_ACCEL_DML
+	AccelOptMoveFlag = C.NVME_ACCEL_FLAG_MOVE
+	AccelOptCRCFlag  = C.NVME_ACCEL_FLAG_CRC
+)
+
+// Role assignments for NVMe SSDs related to type of storage (enables Metadata-on-SSD capability).
+const (
+	BdevRoleData = C.NVME_ROLE_DATA
+	BdevRoleMeta = C.NVME_ROLE_META
+	BdevRoleWAL  = C.NVME_ROLE_WAL
+)
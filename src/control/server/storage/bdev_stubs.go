@@ -0,0 +1,38 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build !linux
+// +build !linux
+
+package storage
+
+// The values below mirror those in src/include/daos_srv/control.h, which can
+// only be included on Linux.
+
+// JSON config file constants shared with the engine.
+const (
+	ConfBdevNvmeAttachController = "bdev_nvme_attach_controller"
+	ConfVmdEnable                = "enable_vmd"
+	ConfSetHotplugBusidRange     = "hotplug_busid_range"
+	ConfSetAccelProps            = "accel_props"
+	ConfSetSpdkRpcServer         = "spdk_rpc_srv"
+	ConfSetAutoFaultyProps       = "auto_faulty"
+)
+
+// Acceleration related constants for engine setting and optional capabilities.
+const (
+	AccelEngineNone  = "none"
+	AccelEngineSPDK  = "spdk"
+	AccelEngineDML   = "dml"
+	AccelOptMoveFlag = 1 << 0
+	AccelOptCRCFlag  = 1 << 1
+)
+
+// Role assignments for NVMe SSDs related to type of storage (enables Metadata-on-SSD capability).
+const (
+	BdevRoleData = 1 << 0
+	BdevRoleMeta = 1 << 1
+	BdevRoleWAL  = 1 << 2
+)
@@ -9,6 +9,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"math"
 	"net"
 	"sync"
 	"testing"
@@ -250,12 +251,23 @@ func newTestMgmtSvcWithProvider(t *testing.T, log logging.Logger, provider *stor
 	ctx := test.Context(t)
 	svc := newMgmtSvc(harness, ms, db, nil, events.NewPubSub(ctx, log))
 	svc.batchInterval = 100 * time.Microsecond // Speed up tests
+	svc.getRankFreeSpace = mockUnlimitedFreeSpace
 	svc.startAsyncLoops(ctx)
 	svc.startLeaderLoops(ctx)
 
 	return svc
 }
 
+// mockUnlimitedFreeSpace reports more free space than any pool could request on
+// each of the ranks.
+func mockUnlimitedFreeSpace(_ context.Context, ranks []ranklist.Rank, _ float32) (map[ranklist.Rank][]uint64, error) {
+	free := make(map[ranklist.Rank][]uint64, len(ranks))
+	for _, rank := range ranks {
+		free[rank] = []uint64{math.MaxUint64, math.MaxUint64}
+	}
+	return free, nil
+}
+
 // newTestMgmtSvc creates a mgmtSvc that contains an EngineInstance
 // properly set up as an MS.
 func newTestMgmtSvc(t *testing.T, log logging.Logger) *mgmtSvc {
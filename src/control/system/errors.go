@@ -331,3 +331,20 @@ func IsErrSystemAttrNotFound(err error) bool {
 	_, ok := errors.Cause(err).(*errSystemAttrNotFound)
 	return ok
 }
+
+type errPoolPolicyNotFound struct {
+	key string
+}
+
+func (err *errPoolPolicyNotFound) Error() string {
+	return fmt.Sprintf("unable to find pool policy for %s", err.key)
+}
+
+func ErrPoolPolicyNotFound(key string) *errPoolPolicyNotFound {
+	return &errPoolPolicyNotFound{key: key}
+}
+
+func IsErrPoolPolicyNotFound(err error) bool {
+	_, ok := errors.Cause(err).(*errPoolPolicyNotFound)
+	return ok
+}
@@ -13,7 +13,6 @@ import (
 
 	"github.com/google/uuid"
 
-	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
 )
@@ -28,12 +27,20 @@ func FaultBadFaultDomainDepth(domain *FaultDomain, expDepth int) *fault.Fault {
 }
 
 // FaultPoolLocked generates a fault indicating that the pool is locked.
-func FaultPoolLocked(poolUUID, lockID uuid.UUID, lockTime time.Time) *fault.Fault {
+func FaultPoolLocked(poolUUID, lockID uuid.UUID, lockTime time.Time, owner LockOwner) *fault.Fault {
 	return systemFault(code.SystemPoolLocked,
-		fmt.Sprintf("pool %s is locked (id: %s, time: %s)", poolUUID, lockID, common.FormatTime(lockTime)),
+		fmt.Sprintf("pool %s is locked: %s (id: %s)", poolUUID, owner.inProgress(lockTime), lockID),
 		"retry the pool operation")
 }
 
+// FaultSystemLocked generates a fault indicating that a system-wide operation
+// is already in progress.
+func FaultSystemLocked(lockTime time.Time, owner LockOwner) *fault.Fault {
+	return systemFault(code.SystemLocked,
+		fmt.Sprintf("system is locked: %s", owner.inProgress(lockTime)),
+		"wait for the operation to complete (see 'dmg ops locks') and retry")
+}
+
 func FaultJoinReplaceRankNotFound(nrFieldsNotMatching int) *fault.Fault {
 	suggestionMsg := "check that dmg format --replace is being run on a host with an engine " +
 		"that has previously had a rank excluded from the system"
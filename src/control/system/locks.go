@@ -0,0 +1,68 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/daos-stack/daos/src/control/common"
+)
+
+type lockOwnerKey struct{}
+
+type (
+	// LockOwner identifies the operation holding an MS operation lock, so
+	// that conflicting operations can report what they are waiting on.
+	LockOwner struct {
+		Operation string `json:"operation"`
+		User      string `json:"user"`
+	}
+
+	// OpLock describes an operation lock held on the MS leader. The pool
+	// UUID is nil for the system-wide lock.
+	OpLock struct {
+		PoolUUID uuid.UUID `json:"pool_uuid"`
+		Owner    LockOwner `json:"owner"`
+		TakenAt  time.Time `json:"taken_at"`
+	}
+)
+
+// inProgress describes the owner's operation as having been in progress
+// since the supplied time.
+func (lo LockOwner) inProgress(since time.Time) string {
+	op := lo.Operation
+	if op == "" {
+		op = "unknown"
+	}
+	user := lo.User
+	if user == "" {
+		user = "unknown"
+	}
+
+	return fmt.Sprintf("operation %s in progress since %s by user %s", op,
+		common.FormatTime(since), user)
+}
+
+// WithLockOwner returns a child context identifying the owner of any
+// operation locks taken with it.
+func WithLockOwner(parent context.Context, owner LockOwner) context.Context {
+	return context.WithValue(parent, lockOwnerKey{}, owner)
+}
+
+// LockOwnerFromContext returns the lock owner set in the supplied context,
+// or an empty owner if none was set.
+func LockOwnerFromContext(ctx context.Context) LockOwner {
+	if ctx == nil {
+		return LockOwner{}
+	}
+	owner, _ := ctx.Value(lockOwnerKey{}).(LockOwner)
+	return owner
+}
@@ -201,6 +201,9 @@ type Member struct {
 	Info                    string        `json:"info"`
 	FaultDomain             *FaultDomain  `json:"fault_domain"`
 	LastUpdate              time.Time     `json:"last_update"`
+	// AwaitingReplacement is set when the member has been reassigned to a
+	// replacement host that has not yet joined with the member's rank.
+	AwaitingReplacement bool `json:"awaiting_replacement,omitempty"`
 }
 
 // MarshalJSON marshals system.Member to JSON.
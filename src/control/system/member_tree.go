@@ -0,0 +1,111 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// MemberDomainTree is a node in a tree of system members grouped by their fault
+// domains, with a rollup of the states of all members in the subtree.
+type MemberDomainTree struct {
+	Name      string              `json:"name"`
+	Domain    string              `json:"domain"`
+	Ranks     []ranklist.Rank     `json:"ranks,omitempty"` // Members in this exact domain
+	States    map[string]int      `json:"states"`          // Member count per state in the subtree
+	Total     int                 `json:"total"`
+	Available int                 `json:"available"`
+	Children  []*MemberDomainTree `json:"children,omitempty"`
+
+	members Members
+}
+
+// Degraded indicates whether any member in the subtree is unavailable.
+func (t *MemberDomainTree) Degraded() bool {
+	return t.Available < t.Total
+}
+
+// Down indicates whether every member in the subtree is unavailable.
+func (t *MemberDomainTree) Down() bool {
+	return t.Total > 0 && t.Available == 0
+}
+
+// Members returns the members in this exact domain, ordered by rank.
+func (t *MemberDomainTree) Members() Members {
+	return t.members
+}
+
+func (t *MemberDomainTree) child(name string) *MemberDomainTree {
+	for _, c := range t.Children {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	domain := t.Domain
+	if domain != FaultDomainSeparator {
+		domain += FaultDomainSeparator
+	}
+	c := &MemberDomainTree{
+		Name:   name,
+		Domain: domain + name,
+		States: make(map[string]int),
+	}
+	t.Children = append(t.Children, c)
+
+	return c
+}
+
+func (t *MemberDomainTree) add(m *Member) {
+	state := strings.ToLower(m.State.String())
+	t.States[state]++
+	t.Total++
+	if m.State&AvailableMemberFilter != 0 {
+		t.Available++
+	}
+}
+
+func (t *MemberDomainTree) sort() {
+	sort.Slice(t.Children, func(i, j int) bool {
+		return t.Children[i].Name < t.Children[j].Name
+	})
+	sort.Slice(t.members, func(i, j int) bool {
+		return t.members[i].Rank < t.members[j].Rank
+	})
+	for _, m := range t.members {
+		t.Ranks = append(t.Ranks, m.Rank)
+	}
+	for _, c := range t.Children {
+		c.sort()
+	}
+}
+
+// NewMemberDomainTree arranges the supplied members into a tree according to
+// their fault domains. Members without a fault domain belong to the root.
+func NewMemberDomainTree(members Members) *MemberDomainTree {
+	root := &MemberDomainTree{
+		Name:   FaultDomainSeparator,
+		Domain: FaultDomainSeparator,
+		States: make(map[string]int),
+	}
+
+	for _, m := range members {
+		node := root
+		node.add(m)
+		for _, level := range m.FaultDomain.DomainStrings() {
+			node = node.child(level)
+			node.add(m)
+		}
+		node.members = append(node.members, m)
+	}
+	root.sort()
+
+	return root
+}
@@ -0,0 +1,170 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestSystem_NewMemberDomainTree(t *testing.T) {
+	mockMember := func(t *testing.T, idx uint32, state MemberState, fd string) *Member {
+		return MockMember(t, idx, state).WithFaultDomain(MustCreateFaultDomainFromString(fd))
+	}
+
+	for name, tc := range map[string]struct {
+		members Members
+		expTree *MemberDomainTree
+	}{
+		"no members": {
+			expTree: &MemberDomainTree{
+				Name:   "/",
+				Domain: "/",
+				States: map[string]int{},
+			},
+		},
+		"no fault domains": {
+			members: Members{
+				MockMember(t, 1, MemberStateJoined),
+				MockMember(t, 0, MemberStateStopped),
+			},
+			expTree: &MemberDomainTree{
+				Name:      "/",
+				Domain:    "/",
+				Ranks:     []ranklist.Rank{0, 1},
+				States:    map[string]int{"joined": 1, "stopped": 1},
+				Total:     2,
+				Available: 1,
+			},
+		},
+		"multi-level": {
+			members: Members{
+				mockMember(t, 3, MemberStateExcluded, "/rack1/node3"),
+				mockMember(t, 0, MemberStateJoined, "/rack0/node0"),
+				mockMember(t, 1, MemberStateJoined, "/rack0/node1"),
+				mockMember(t, 2, MemberStateJoined, "/rack1/node2"),
+				mockMember(t, 4, MemberStateJoined, "/rack0/node0"),
+			},
+			expTree: &MemberDomainTree{
+				Name:      "/",
+				Domain:    "/",
+				States:    map[string]int{"joined": 4, "excluded": 1},
+				Total:     5,
+				Available: 4,
+				Children: []*MemberDomainTree{
+					{
+						Name:      "rack0",
+						Domain:    "/rack0",
+						States:    map[string]int{"joined": 3},
+						Total:     3,
+						Available: 3,
+						Children: []*MemberDomainTree{
+							{
+								Name:      "node0",
+								Domain:    "/rack0/node0",
+								Ranks:     []ranklist.Rank{0, 4},
+								States:    map[string]int{"joined": 2},
+								Total:     2,
+								Available: 2,
+							},
+							{
+								Name:      "node1",
+								Domain:    "/rack0/node1",
+								Ranks:     []ranklist.Rank{1},
+								States:    map[string]int{"joined": 1},
+								Total:     1,
+								Available: 1,
+							},
+						},
+					},
+					{
+						Name:      "rack1",
+						Domain:    "/rack1",
+						States:    map[string]int{"joined": 1, "excluded": 1},
+						Total:     2,
+						Available: 1,
+						Children: []*MemberDomainTree{
+							{
+								Name:      "node2",
+								Domain:    "/rack1/node2",
+								Ranks:     []ranklist.Rank{2},
+								States:    map[string]int{"joined": 1},
+								Total:     1,
+								Available: 1,
+							},
+							{
+								Name:   "node3",
+								Domain: "/rack1/node3",
+								Ranks:  []ranklist.Rank{3},
+								States: map[string]int{"excluded": 1},
+								Total:  1,
+							},
+						},
+					},
+				},
+			},
+		},
+		"labelled domains": {
+			members: Members{
+				mockMember(t, 0, MemberStateJoined, "/rack=r0/node=n0"),
+			},
+			expTree: &MemberDomainTree{
+				Name:      "/",
+				Domain:    "/",
+				States:    map[string]int{"joined": 1},
+				Total:     1,
+				Available: 1,
+				Children: []*MemberDomainTree{
+					{
+						Name:      "rack=r0",
+						Domain:    "/rack=r0",
+						States:    map[string]int{"joined": 1},
+						Total:     1,
+						Available: 1,
+						Children: []*MemberDomainTree{
+							{
+								Name:      "node=n0",
+								Domain:    "/rack=r0/node=n0",
+								Ranks:     []ranklist.Rank{0},
+								States:    map[string]int{"joined": 1},
+								Total:     1,
+								Available: 1,
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tree := NewMemberDomainTree(tc.members)
+
+			if diff := cmp.Diff(tc.expTree, tree, cmpopts.IgnoreUnexported(MemberDomainTree{})); diff != "" {
+				t.Fatalf("unexpected tree (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expTree.Degraded(), tree.Degraded(), "unexpected degraded state")
+		})
+	}
+}
+
+func TestSystem_MemberDomainTree_Down(t *testing.T) {
+	tree := NewMemberDomainTree(Members{
+		MockMember(t, 0, MemberStateStopped).WithFaultDomain(MustCreateFaultDomain("rack0")),
+		MockMember(t, 1, MemberStateJoined).WithFaultDomain(MustCreateFaultDomain("rack1")),
+	})
+
+	test.AssertTrue(t, tree.Degraded(), "root should be degraded")
+	test.AssertFalse(t, tree.Down(), "root should not be down")
+	test.AssertTrue(t, tree.Children[0].Down(), "rack0 should be down")
+	test.AssertFalse(t, tree.Children[1].Degraded(), "rack1 should not be degraded")
+	test.AssertEqual(t, 1, len(tree.Children[0].Members()), "unexpected rack0 members")
+}
@@ -38,11 +38,20 @@ type MemberStore interface {
 	AllMembers() ([]*Member, error)
 	AddMember(member *Member) error
 	UpdateMember(member *Member) error
+	UpdateMembers(members []*Member, attrs map[string]string) error
 	RemoveMember(member *Member) error
 	CurMapVersion() (uint32, error)
 	FaultDomainTree() *FaultDomainTree
 }
 
+// FaultDomainChange describes an update to the fault domain of a system member.
+type FaultDomainChange struct {
+	Rank Rank
+	Addr *net.TCPAddr
+	Old  *FaultDomain
+	New  *FaultDomain
+}
+
 // Membership tracks details of system members.
 type Membership struct {
 	sync.RWMutex
@@ -110,8 +119,23 @@ func (m *Membership) FindRankFromJoinRequest(req *JoinRequest) (Rank, error) {
 		return NilRank, errors.New("empty system membership")
 	}
 
-	var minMissing []string
+	// Members that have been reassigned to a replacement host are matched on
+	// control address alone, as the fabric addresses of the replacement host
+	// will differ from those of the original.
 	rank := NilRank
+	for _, cm := range currentMembers {
+		if !cm.AwaitingReplacement || cm.Addr.String() != req.ControlAddr.String() {
+			continue
+		}
+		if rank == NilRank || cm.Rank < rank {
+			rank = cm.Rank
+		}
+	}
+	if rank != NilRank {
+		return rank, nil
+	}
+
+	var minMissing []string
 	for _, cm := range currentMembers {
 		// Only match identical member with different UUID.
 		var missing []string
@@ -217,6 +241,17 @@ func (m *Membership) joinReplace(req *JoinRequest) (*JoinResponse, error) {
 	memberToReplace.State = MemberStateJoined
 	memberToReplace.Info = ""
 	memberToReplace.UUID = req.UUID
+	if memberToReplace.AwaitingReplacement {
+		// The member has moved to a replacement host, so take on the new
+		// host's fabric details. The fault domain of the original member
+		// is retained.
+		memberToReplace.PrimaryFabricURI = req.PrimaryFabricURI
+		memberToReplace.SecondaryFabricURIs = req.SecondaryFabricURIs
+		memberToReplace.PrimaryFabricContexts = req.FabricContexts
+		memberToReplace.SecondaryFabricContexts = req.SecondaryFabricContexts
+		memberToReplace.Incarnation = req.Incarnation
+		memberToReplace.AwaitingReplacement = false
+	}
 
 	if err := m.db.AddMember(memberToReplace); err != nil {
 		return nil, errors.Wrap(err, "adding new member in replace-rank join request")
@@ -651,6 +686,142 @@ func (m *Membership) CheckHosts(hosts string, ctlPort int) (*RankSet, *hostlist.
 	return rs, missHS, nil
 }
 
+// UpdateFaultDomains sets new fault domains for the given ranks. The update is rejected if
+// any of the ranks are not members or if the resulting member fault domains would not all
+// have the same number of levels. Members whose fault domain is unchanged are skipped and
+// the remaining updates are committed together, along with the supplied system attributes.
+// If dryRun is set, the changes are returned but not applied.
+func (m *Membership) UpdateFaultDomains(domains map[Rank]*FaultDomain, attrs map[string]string, dryRun bool) ([]*FaultDomainChange, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	members, err := m.db.AllMembers()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Rank < members[j].Rank })
+
+	for rank := range domains {
+		if _, err := m.db.FindMemberByRank(rank); err != nil {
+			return nil, err
+		}
+	}
+
+	var changes []*FaultDomainChange
+	var toUpdate []*Member
+	expDepth := -1
+	for _, member := range members {
+		fd := member.FaultDomain
+		if newFD, found := domains[member.Rank]; found {
+			fd = newFD
+		}
+
+		if expDepth < 0 {
+			expDepth = fd.NumLevels()
+		}
+		if fd.NumLevels() != expDepth {
+			return nil, errors.Errorf("fault domain %q for rank %d has %d levels, need %d to be consistent with other members",
+				fd, member.Rank, fd.NumLevels(), expDepth)
+		}
+
+		if fd.Equals(member.FaultDomain) {
+			continue
+		}
+		changes = append(changes, &FaultDomainChange{
+			Rank: member.Rank,
+			Addr: member.Addr,
+			Old:  member.FaultDomain,
+			New:  fd,
+		})
+		member.FaultDomain = fd
+		toUpdate = append(toUpdate, member)
+	}
+
+	if dryRun || (len(toUpdate) == 0 && len(attrs) == 0) {
+		return changes, nil
+	}
+
+	if err := m.db.UpdateMembers(toUpdate, attrs); err != nil {
+		return nil, errors.Wrap(err, "failed to update member fault domains")
+	}
+
+	return changes, nil
+}
+
+// ReplaceHost reassigns all members resident on the old host to the new host, in
+// preparation for the replacement host's engines joining the system with the
+// members' ranks. The members on the old host must not be available, and the new
+// host must not already host any members. The ranks of the reassigned members are
+// returned.
+func (m *Membership) ReplaceHost(oldHost, newHost string, ctlPort int) ([]Rank, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	resolve := func(host string) (*net.TCPAddr, error) {
+		if !common.HasPort(host) {
+			host = net.JoinHostPort(host, strconv.Itoa(ctlPort))
+		}
+		addr, err := m.resolveTCP("tcp", host)
+		return addr, errors.Wrapf(err, "resolving host %q", host)
+	}
+
+	oldAddr, err := resolve(oldHost)
+	if err != nil {
+		return nil, err
+	}
+	newAddr, err := resolve(newHost)
+	if err != nil {
+		return nil, err
+	}
+	if common.CmpTCPAddr(oldAddr, newAddr) {
+		return nil, errors.Errorf("old and new hosts resolve to the same address %s", oldAddr)
+	}
+
+	hostRanks := m.getHostRanks(nil)
+	if len(hostRanks[newAddr.String()]) > 0 {
+		return nil, errors.Errorf("replacement host %s already has ranks %v", newHost,
+			hostRanks[newAddr.String()])
+	}
+	ranks := hostRanks[oldAddr.String()]
+	if len(ranks) == 0 {
+		return nil, errors.Errorf("no ranks found on host %s", oldHost)
+	}
+
+	var toReplace []*Member
+	for _, rank := range ranks {
+		cm, err := m.db.FindMemberByRank(rank)
+		if err != nil {
+			return nil, err
+		}
+		if cm.State&AvailableMemberFilter != 0 {
+			return nil, errors.Errorf("rank %d on host %s is %s, only unavailable ranks can be replaced",
+				rank, oldHost, cm.State)
+		}
+		if cm.State == MemberStateAdminExcluded {
+			return nil, errors.Errorf("rank %d on host %s is %s, clear the excluded state before replacing the host",
+				rank, oldHost, cm.State)
+		}
+		toReplace = append(toReplace, cm)
+	}
+
+	// Update (remove then add) each member with the new address, as the member
+	// store's update does not change member addresses.
+	for _, cm := range toReplace {
+		m.log.Debugf("replace-host: moving rank %d from %s to %s", cm.Rank, oldAddr, newAddr)
+
+		if err := m.db.RemoveMember(cm); err != nil {
+			return nil, errors.Wrapf(err, "removing rank %d in replace-host request", cm.Rank)
+		}
+		cm.Addr = newAddr
+		cm.AwaitingReplacement = true
+		if err := m.db.AddMember(cm); err != nil {
+			return nil, errors.Wrapf(err, "adding rank %d in replace-host request", cm.Rank)
+		}
+	}
+
+	return ranks, nil
+}
+
 // IsRankAdminExcluded checks whether a given rank is in the AdminExcluded State.
 func (m *Membership) IsRankAdminExcluded(rank Rank) bool {
 	cm, err := m.db.FindMemberByRank(rank)
@@ -742,6 +742,16 @@ func TestSystem_Membership_FindRankFromJoinRequest(t *testing.T) {
 	newUUID := uuid.New()
 	newMember := MockMember(t, 2, MemberStateJoined).WithFaultDomain(fd2)
 
+	replAddr := MockControlAddr(t, 4)
+	replMembers := make([]*Member, 3)
+	for i := range replMembers {
+		replMembers[i] = MockMember(t, uint32(i), MemberStateExcluded).WithFaultDomain(fd1)
+	}
+	for _, m := range replMembers[1:] {
+		m.Addr = replAddr
+		m.AwaitingReplacement = true
+	}
+
 	for name, tc := range map[string]struct {
 		curMembers []*Member
 		req        *JoinRequest
@@ -825,6 +835,30 @@ func TestSystem_Membership_FindRankFromJoinRequest(t *testing.T) {
 			},
 			expRank: curMember.Rank,
 		},
+		"success; member awaiting replacement": {
+			curMembers: replMembers,
+			req: &JoinRequest{
+				Rank:             NilRank,
+				UUID:             newUUID,
+				ControlAddr:      replAddr,
+				PrimaryFabricURI: "tcp://10.0.0.4:31416",
+				FabricContexts:   16,
+				FaultDomain:      fd2,
+			},
+			expRank: 1,
+		},
+		"member awaiting replacement on different host": {
+			curMembers: replMembers,
+			req: &JoinRequest{
+				Rank:             NilRank,
+				UUID:             newUUID,
+				ControlAddr:      newMember.Addr,
+				PrimaryFabricURI: "tcp://10.0.0.4:31416",
+				FabricContexts:   16,
+				FaultDomain:      fd2,
+			},
+			expErr: FaultJoinReplaceRankNotFound(4),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1035,6 +1069,40 @@ func TestSystem_Membership_Join(t *testing.T) {
 				MapVersion: expMapVer + 1,
 			},
 		},
+		"successful replace; member awaiting replacement": {
+			curMembers: []*Member{
+				func() *Member {
+					m := MockMember(t, 0, MemberStateExcluded).WithFaultDomain(fd1)
+					m.Addr = newMember.Addr
+					m.AwaitingReplacement = true
+					return m
+				}(),
+			},
+			req: &JoinRequest{
+				Replace:          true,
+				Rank:             0,
+				UUID:             newUUID,
+				ControlAddr:      newMember.Addr,
+				PrimaryFabricURI: "tcp://10.0.0.2:31416",
+				FabricContexts:   16,
+				FaultDomain:      fd2,
+				Incarnation:      7,
+			},
+			expResp: &JoinResponse{
+				Member: func() *Member {
+					m := MockMember(t, 0, MemberStateJoined).WithFaultDomain(fd1)
+					m.UUID = newUUID
+					m.Addr = newMember.Addr
+					m.PrimaryFabricURI = "tcp://10.0.0.2:31416"
+					m.PrimaryFabricContexts = 16
+					m.Incarnation = 7
+					return m
+				}(),
+				PrevState: MemberStateExcluded,
+				// Extra map increment because of remove and add operations.
+				MapVersion: 3,
+			},
+		},
 		// DAOS-15947 TODO: This should probably be refused as duplicate addresses/URIs
 		//                  rather than joining a new rank.
 		"rejoin identical member with new UUID and nil rank; replace not set": {
@@ -1246,6 +1314,262 @@ func TestSystem_Membership_MarkDead(t *testing.T) {
 	}
 }
 
+func TestSystem_Membership_UpdateFaultDomains(t *testing.T) {
+	mockFD := func(fdStr string) *FaultDomain {
+		return MustCreateFaultDomainFromString(fdStr)
+	}
+
+	for name, tc := range map[string]struct {
+		domains    map[Rank]*FaultDomain
+		attrs      map[string]string
+		dryRun     bool
+		expChanges []*FaultDomainChange
+		expDomains []*FaultDomain
+		expAttrs   map[string]string
+		expErr     error
+	}{
+		"unknown rank": {
+			domains: map[Rank]*FaultDomain{
+				42: mockFD("/rack1/node0"),
+			},
+			expErr: ErrMemberRankNotFound(42),
+		},
+		"inconsistent depth": {
+			domains: map[Rank]*FaultDomain{
+				1: mockFD("/pdu0/rack1/node1"),
+			},
+			expErr: errors.New("need 2"),
+		},
+		"unchanged": {
+			domains: map[Rank]*FaultDomain{
+				0: mockFD("/rack0/node0"),
+			},
+			expDomains: []*FaultDomain{
+				mockFD("/rack0/node0"),
+				mockFD("/rack0/node1"),
+				mockFD("/rack0/node2"),
+			},
+		},
+		"dry run": {
+			domains: map[Rank]*FaultDomain{
+				0: mockFD("/rack0/node0"),
+				2: mockFD("/rack1/node2"),
+			},
+			dryRun: true,
+			expChanges: []*FaultDomainChange{
+				{
+					Rank: 2,
+					Old:  mockFD("/rack0/node2"),
+					New:  mockFD("/rack1/node2"),
+				},
+			},
+			expDomains: []*FaultDomain{
+				mockFD("/rack0/node0"),
+				mockFD("/rack0/node1"),
+				mockFD("/rack0/node2"),
+			},
+		},
+		"unchanged with attrs": {
+			domains: map[Rank]*FaultDomain{
+				0: mockFD("/rack0/node0"),
+			},
+			attrs: map[string]string{"foo": "bar"},
+			expDomains: []*FaultDomain{
+				mockFD("/rack0/node0"),
+				mockFD("/rack0/node1"),
+				mockFD("/rack0/node2"),
+			},
+			expAttrs: map[string]string{"foo": "bar"},
+		},
+		"dry run with attrs": {
+			domains: map[Rank]*FaultDomain{
+				2: mockFD("/rack1/node2"),
+			},
+			attrs:  map[string]string{"foo": "bar"},
+			dryRun: true,
+			expChanges: []*FaultDomainChange{
+				{
+					Rank: 2,
+					Old:  mockFD("/rack0/node2"),
+					New:  mockFD("/rack1/node2"),
+				},
+			},
+			expDomains: []*FaultDomain{
+				mockFD("/rack0/node0"),
+				mockFD("/rack0/node1"),
+				mockFD("/rack0/node2"),
+			},
+			expAttrs: map[string]string{},
+		},
+		"success": {
+			domains: map[Rank]*FaultDomain{
+				1: mockFD("/rack1/node1"),
+				2: mockFD("/rack1/node2"),
+			},
+			attrs: map[string]string{"foo": "bar"},
+			expChanges: []*FaultDomainChange{
+				{
+					Rank: 1,
+					Old:  mockFD("/rack0/node1"),
+					New:  mockFD("/rack1/node1"),
+				},
+				{
+					Rank: 2,
+					Old:  mockFD("/rack0/node2"),
+					New:  mockFD("/rack1/node2"),
+				},
+			},
+			expDomains: []*FaultDomain{
+				mockFD("/rack0/node0"),
+				mockFD("/rack1/node1"),
+				mockFD("/rack1/node2"),
+			},
+			expAttrs: map[string]string{"foo": "bar"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)
+
+			var members []*Member
+			for i := uint32(0); i < 3; i++ {
+				members = append(members, MockMember(t, i, MemberStateJoined).
+					WithFaultDomain(mockFD(fmt.Sprintf("/rack0/node%d", i))))
+			}
+			db := raft.MockDatabase(t, log)
+			ms := MockMembership(t, log, db, MockResolveFn)
+			for _, m := range members {
+				if _, err := ms.Add(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotChanges, gotErr := ms.UpdateFaultDomains(tc.domains, tc.attrs, tc.dryRun)
+			CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreFields(FaultDomainChange{}, "Addr"),
+			}
+			if diff := cmp.Diff(tc.expChanges, gotChanges, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected changes (-want, +got):\n%s\n", diff)
+			}
+
+			for i, expFD := range tc.expDomains {
+				m, err := ms.Get(Rank(i))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(expFD, m.FaultDomain); diff != "" {
+					t.Fatalf("unexpected fault domain for rank %d (-want, +got):\n%s\n", i, diff)
+				}
+			}
+
+			gotAttrs, err := db.GetSystemAttrs(nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expAttrs, gotAttrs, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("unexpected system attributes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Membership_ReplaceHost(t *testing.T) {
+	newAddr := &net.TCPAddr{IP: net.ParseIP("127.0.0.4"), Port: 10001}
+
+	for name, tc := range map[string]struct {
+		oldHost  string
+		newHost  string
+		expRanks []Rank
+		expErr   error
+	}{
+		"old host doesn't resolve": {
+			oldHost: "127.0.0.5",
+			newHost: "foo-4",
+			expErr:  errors.New("bad lookup"),
+		},
+		"new host doesn't resolve": {
+			oldHost: "foo-1",
+			newHost: "foo-6",
+			expErr:  errors.New("bad lookup"),
+		},
+		"same host": {
+			oldHost: "foo-1",
+			newHost: "127.0.0.1",
+			expErr:  errors.New("same address"),
+		},
+		"new host has ranks": {
+			oldHost: "foo-1",
+			newHost: "foo-2",
+			expErr:  errors.New("already has ranks [2]"),
+		},
+		"no ranks on old host": {
+			oldHost: "foo-4",
+			newHost: "foo-5",
+			expErr:  errors.New("no ranks found"),
+		},
+		"old host ranks available": {
+			oldHost: "foo-2",
+			newHost: "foo-4",
+			expErr:  errors.New("only unavailable ranks"),
+		},
+		"old host ranks admin excluded": {
+			oldHost: "foo-3",
+			newHost: "foo-4",
+			expErr:  errors.New("clear the excluded state"),
+		},
+		"success": {
+			oldHost:  "foo-1",
+			newHost:  "foo-4",
+			expRanks: []Rank{1, 6},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)
+
+			ms := populateMembership(t, log,
+				MockMember(t, 1, MemberStateExcluded),
+				MockMember(t, 2, MemberStateJoined),
+				MockMember(t, 3, MemberStateAdminExcluded),
+				mockStoppedRankOnHost1(t, 6),
+			)
+
+			gotRanks, gotErr := ms.ReplaceHost(tc.oldHost, tc.newHost, 10001)
+			CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expRanks, gotRanks); diff != "" {
+				t.Fatalf("unexpected ranks (-want, +got):\n%s\n", diff)
+			}
+
+			for _, rank := range tc.expRanks {
+				m, err := ms.Get(rank)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if m.Addr.String() != newAddr.String() {
+					t.Fatalf("expected rank %d address %s, got %s", rank, newAddr, m.Addr)
+				}
+				if !m.AwaitingReplacement {
+					t.Fatalf("expected rank %d to be awaiting replacement", rank)
+				}
+			}
+
+			hostRanks := ms.HostRanks(nil)
+			if _, found := hostRanks[MockControlAddr(t, 1).String()]; found {
+				t.Fatalf("unexpected ranks remaining on old host: %v", hostRanks)
+			}
+		})
+	}
+}
+
 func TestSystem_Membership_CompressedFaultDomainTree(t *testing.T) {
 	testMemberWithFaultDomain := func(rank Rank, faultDomain *FaultDomain) *Member {
 		return &Member{
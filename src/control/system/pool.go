@@ -35,12 +35,17 @@ type (
 	// PoolService represents a pool service created to manage metadata
 	// for a DAOS Pool.
 	PoolService struct {
-		PoolUUID   uuid.UUID
-		PoolLabel  string
-		State      PoolServiceState
-		Replicas   []ranklist.Rank
-		Storage    *PoolServiceStorage
-		LastUpdate time.Time
+		PoolUUID       uuid.UUID
+		PoolLabel      string
+		Aliases        []string // Alternative labels by which the pool may be found
+		State          PoolServiceState
+		Replicas       []ranklist.Rank
+		Storage        *PoolServiceStorage
+		DestroyProtect bool
+		Owner          string // Pool owner user, as used by pool policies
+		OwnerGroup     string // Pool owner group, as used by pool policies
+		CreationTime   time.Time
+		LastUpdate     time.Time
 	}
 )
 
@@ -84,6 +89,20 @@ func (pss *PoolServiceStorage) CreationRanks() []ranklist.Rank {
 	return pss.creationRanks.Ranks()
 }
 
+// TotalBytes returns the total amount of storage allocated to the pool across
+// all tiers, calculated from the per-rank allocation made at creation time.
+func (pss *PoolServiceStorage) TotalBytes() uint64 {
+	if pss == nil {
+		return 0
+	}
+
+	var perRank uint64
+	for _, tierStorage := range pss.PerRankTierStorage {
+		perRank += tierStorage
+	}
+	return perRank * uint64(len(pss.CreationRanks()))
+}
+
 //// TotalSCM returns the total amount of SCM storage allocated to
 //// the pool, calculated from the current set of ranks multiplied
 //// by the per-rank SCM allocation made at creation time.
@@ -0,0 +1,174 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// PoolPlacementPolicy determines how the target ranks of a new pool are
+// selected from the available system members.
+type PoolPlacementPolicy string
+
+const (
+	// PoolPlacementDefault selects target ranks at random.
+	PoolPlacementDefault PoolPlacementPolicy = ""
+	// PoolPlacementSpread spreads target ranks across as many fault
+	// domains as possible, at every level of the fault domain hierarchy.
+	PoolPlacementSpread PoolPlacementPolicy = "spread"
+	// PoolPlacementPack packs target ranks onto as few fault domains as
+	// possible.
+	PoolPlacementPack PoolPlacementPolicy = "pack"
+)
+
+// ParsePoolPlacementPolicy returns the PoolPlacementPolicy matching the
+// supplied string.
+func ParsePoolPlacementPolicy(in string) (PoolPlacementPolicy, error) {
+	switch p := PoolPlacementPolicy(in); p {
+	case PoolPlacementDefault, PoolPlacementSpread, PoolPlacementPack:
+		return p, nil
+	default:
+		return PoolPlacementDefault, errors.Errorf("invalid pool placement policy %q (valid: %s, %s)",
+			in, PoolPlacementSpread, PoolPlacementPack)
+	}
+}
+
+// ExcludeMembersByFaultDomain returns the members whose fault domain string
+// does not match the supplied regular expression. An empty expression
+// excludes nothing.
+func ExcludeMembersByFaultDomain(members Members, exclude string) (Members, error) {
+	if exclude == "" {
+		return members, nil
+	}
+
+	re, err := regexp.Compile(exclude)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid fault domain exclusion pattern")
+	}
+
+	var included Members
+	for _, m := range members {
+		if !re.MatchString(m.FaultDomain.String()) {
+			included = append(included, m)
+		}
+	}
+
+	return included, nil
+}
+
+// OrderMembersByPlacement returns the members ordered so that the first N
+// members satisfy the placement policy for a pool spanning N ranks. Members
+// are returned in rank order for the default policy.
+func OrderMembersByPlacement(members Members, policy PoolPlacementPolicy) Members {
+	sorted := make(Members, len(members))
+	copy(sorted, members)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Rank < sorted[j].Rank })
+
+	switch policy {
+	case PoolPlacementSpread:
+		return spreadMembers(sorted, 0)
+	case PoolPlacementPack:
+		return packMembers(sorted)
+	default:
+		return sorted
+	}
+}
+
+// spreadMembers groups the members by their fault domain at the given depth
+// below the root and interleaves the groups, recursing into each group for
+// the deeper domains, so that consecutive members are as far apart as
+// possible.
+func spreadMembers(members Members, depth int) Members {
+	groups := make(map[string]Members)
+	var keys []string
+	deeper := false
+	for _, m := range members {
+		var key string
+		if m.FaultDomain.NumLevels() > depth {
+			key = m.FaultDomain.Domains[depth]
+			deeper = true
+		}
+		if _, exists := groups[key]; !exists {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], m)
+	}
+	if !deeper {
+		// Members at the bottom of the hierarchy are left in rank order.
+		return members
+	}
+	sort.Strings(keys)
+
+	ordered := make([]Members, len(keys))
+	for i, key := range keys {
+		ordered[i] = spreadMembers(groups[key], depth+1)
+	}
+
+	spread := make(Members, 0, len(members))
+	for i := 0; len(spread) < len(members); i++ {
+		for _, group := range ordered {
+			if i < len(group) {
+				spread = append(spread, group[i])
+			}
+		}
+	}
+
+	return spread
+}
+
+// packMembers groups the members by fault domain and orders the groups from
+// largest to smallest, so that consecutive members share fault domains.
+func packMembers(members Members) Members {
+	groups := make(map[string]Members)
+	var keys []string
+	for _, m := range members {
+		key := m.FaultDomain.String()
+		if _, exists := groups[key]; !exists {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], m)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(groups[keys[i]]) != len(groups[keys[j]]) {
+			return len(groups[keys[i]]) > len(groups[keys[j]])
+		}
+		return keys[i] < keys[j]
+	})
+
+	packed := make(Members, 0, len(members))
+	for _, key := range keys {
+		packed = append(packed, groups[key]...)
+	}
+
+	return packed
+}
+
+// MemberFaultDomains returns the sorted set of distinct fault domains of the
+// members with the given ranks.
+func MemberFaultDomains(members Members, ranks []ranklist.Rank) []string {
+	want := ranklist.RankSetFromRanks(ranks)
+	seen := make(map[string]struct{})
+	var domains []string
+	for _, m := range members {
+		if !want.Contains(m.Rank) {
+			continue
+		}
+		fd := m.FaultDomain.String()
+		if _, exists := seen[fd]; !exists {
+			seen[fd] = struct{}{}
+			domains = append(domains, fd)
+		}
+	}
+	sort.Strings(domains)
+
+	return domains
+}
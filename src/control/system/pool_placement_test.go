@@ -0,0 +1,172 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	. "github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func mockPlacementMembers(t *testing.T, domains ...string) Members {
+	t.Helper()
+
+	members := make(Members, len(domains))
+	for i, fd := range domains {
+		members[i] = MockMember(t, uint32(i), MemberStateJoined)
+		members[i].FaultDomain = MustCreateFaultDomainFromString(fd)
+	}
+	return members
+}
+
+func memberRanks(members Members) []Rank {
+	ranks := make([]Rank, len(members))
+	for i, m := range members {
+		ranks[i] = m.Rank
+	}
+	return ranks
+}
+
+func TestSystem_ParsePoolPlacementPolicy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in        string
+		expPolicy PoolPlacementPolicy
+		expErr    error
+	}{
+		"empty": {
+			expPolicy: PoolPlacementDefault,
+		},
+		"spread": {
+			in:        "spread",
+			expPolicy: PoolPlacementSpread,
+		},
+		"pack": {
+			in:        "pack",
+			expPolicy: PoolPlacementPack,
+		},
+		"invalid": {
+			in:     "scatter",
+			expErr: errors.New("invalid pool placement policy"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotPolicy, gotErr := ParsePoolPlacementPolicy(tc.in)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expPolicy, gotPolicy, "unexpected policy")
+		})
+	}
+}
+
+func TestSystem_OrderMembersByPlacement(t *testing.T) {
+	domains := []string{
+		"/rack0/host0", "/rack0/host0", "/rack0/host1",
+		"/rack1/host2", "/rack1/host2", "/rack1/host2",
+	}
+
+	for name, tc := range map[string]struct {
+		domains  []string
+		policy   PoolPlacementPolicy
+		expRanks []Rank
+	}{
+		"no members": {
+			policy:   PoolPlacementSpread,
+			expRanks: []Rank{},
+		},
+		"default": {
+			domains:  domains,
+			expRanks: []Rank{0, 1, 2, 3, 4, 5},
+		},
+		"spread": {
+			domains:  domains,
+			policy:   PoolPlacementSpread,
+			expRanks: []Rank{0, 3, 2, 4, 1, 5},
+		},
+		"spread; unbalanced tree": {
+			domains:  []string{"/rack0/host0", "/rack0/host0", "/rack1", "/"},
+			policy:   PoolPlacementSpread,
+			expRanks: []Rank{3, 0, 2, 1},
+		},
+		"pack": {
+			domains:  domains,
+			policy:   PoolPlacementPack,
+			expRanks: []Rank{3, 4, 5, 0, 1, 2},
+		},
+		"pack; equal sized domains": {
+			domains:  []string{"/host1", "/host0", "/host1", "/host0"},
+			policy:   PoolPlacementPack,
+			expRanks: []Rank{1, 3, 0, 2},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			members := mockPlacementMembers(t, tc.domains...)
+
+			gotRanks := memberRanks(OrderMembersByPlacement(members, tc.policy))
+			if diff := cmp.Diff(tc.expRanks, gotRanks); diff != "" {
+				t.Fatalf("unexpected ranks (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_ExcludeMembersByFaultDomain(t *testing.T) {
+	members := mockPlacementMembers(t, "/rack0/host0", "/rack0/host1", "/rack1/host2")
+
+	for name, tc := range map[string]struct {
+		exclude  string
+		expRanks []Rank
+		expErr   error
+	}{
+		"no exclusion": {
+			expRanks: []Rank{0, 1, 2},
+		},
+		"exclude rack": {
+			exclude:  "^/rack0/",
+			expRanks: []Rank{2},
+		},
+		"exclude hosts": {
+			exclude:  "host[02]$",
+			expRanks: []Rank{1},
+		},
+		"exclude all": {
+			exclude:  "rack",
+			expRanks: []Rank{},
+		},
+		"invalid pattern": {
+			exclude: "host(",
+			expErr:  errors.New("invalid fault domain exclusion pattern"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotMembers, gotErr := ExcludeMembersByFaultDomain(members, tc.exclude)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expRanks, memberRanks(gotMembers)); diff != "" {
+				t.Fatalf("unexpected ranks (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_MemberFaultDomains(t *testing.T) {
+	members := mockPlacementMembers(t, "/rack0/host0", "/rack0/host0", "/rack1/host2", "/rack0/host1")
+
+	gotDomains := MemberFaultDomains(members, []Rank{2, 0, 1})
+	expDomains := []string{"/rack0/host0", "/rack1/host2"}
+	if diff := cmp.Diff(expDomains, gotDomains); diff != "" {
+		t.Fatalf("unexpected fault domains (-want, +got):\n%s\n", diff)
+	}
+}
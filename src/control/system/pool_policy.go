@@ -0,0 +1,155 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// PoolPolicyType identifies whether a pool policy applies to the pools owned
+// by a user or to those owned by a group.
+type PoolPolicyType string
+
+const (
+	// PoolPolicyUser applies to the pools owned by a user.
+	PoolPolicyUser PoolPolicyType = "user"
+	// PoolPolicyGroup applies to the pools owned by a group.
+	PoolPolicyGroup PoolPolicyType = "group"
+)
+
+// ParsePoolPolicyType returns the PoolPolicyType matching the supplied string.
+func ParsePoolPolicyType(in string) (PoolPolicyType, error) {
+	switch t := PoolPolicyType(in); t {
+	case PoolPolicyUser, PoolPolicyGroup:
+		return t, nil
+	default:
+		return "", errors.Errorf("invalid pool policy type %q (valid: %s, %s)",
+			in, PoolPolicyUser, PoolPolicyGroup)
+	}
+}
+
+type (
+	// PoolPolicy defines the limits enforced by the MS when a pool is
+	// created for a user or group. Zero or empty limits are not enforced.
+	PoolPolicy struct {
+		Type              PoolPolicyType
+		Principal         string   // User or group name, e.g. "alice@"
+		MaxPools          uint32   // Maximum number of pools owned
+		MaxTotalBytes     uint64   // Maximum total capacity of the pools owned
+		AllowedProperties []string // Pool properties that may be set at creation
+	}
+
+	// PoolPolicyRequest describes a pool to be created for the purpose of
+	// checking it against the pool policies.
+	PoolPolicyRequest struct {
+		Owner      string   // Pool owner user, e.g. "alice@"
+		OwnerGroup string   // Pool owner group, e.g. "hpc@"
+		TotalBytes uint64   // Total capacity of the pool
+		Properties []string // Names of the properties set in the request
+	}
+)
+
+// PoolPolicyKey returns the key that identifies the policy for the supplied
+// type and principal.
+func PoolPolicyKey(typ PoolPolicyType, principal string) string {
+	return fmt.Sprintf("%s:%s", typ, principal)
+}
+
+// Key returns the key that identifies the policy.
+func (p *PoolPolicy) Key() string {
+	return PoolPolicyKey(p.Type, p.Principal)
+}
+
+func (p *PoolPolicy) String() string {
+	return fmt.Sprintf("%s %s", p.Type, p.Principal)
+}
+
+// Validate checks that the policy is well-formed.
+func (p *PoolPolicy) Validate() error {
+	if p == nil {
+		return errors.New("nil pool policy")
+	}
+	if _, err := ParsePoolPolicyType(string(p.Type)); err != nil {
+		return err
+	}
+	if !strings.HasSuffix(p.Principal, "@") || len(p.Principal) == 1 || strings.Contains(p.Principal, ":") {
+		return errors.Errorf("invalid pool policy principal %q", p.Principal)
+	}
+
+	allProps := daos.PoolProperties()
+	for _, name := range p.AllowedProperties {
+		if _, err := allProps.GetProperty(name); err != nil {
+			return errors.Wrap(err, "invalid allowed property")
+		}
+	}
+
+	return nil
+}
+
+// Applies returns true if the policy applies to pools owned by the supplied
+// user and group.
+func (p *PoolPolicy) Applies(owner, group string) bool {
+	switch p.Type {
+	case PoolPolicyUser:
+		return p.Principal == owner
+	case PoolPolicyGroup:
+		return p.Principal == group
+	default:
+		return false
+	}
+}
+
+// CheckPoolCreate checks the pool create request against the policy, taking
+// into account the existing pools in the system. An error describing the
+// first limit that the new pool would exceed is returned.
+func (p *PoolPolicy) CheckPoolCreate(pools []*PoolService, req *PoolPolicyRequest) error {
+	if req == nil {
+		return errors.New("nil pool policy request")
+	}
+	if !p.Applies(req.Owner, req.OwnerGroup) {
+		return nil
+	}
+
+	// The label is required for every pool and so is always allowed.
+	if len(p.AllowedProperties) > 0 {
+		for _, name := range req.Properties {
+			if name == "label" || slices.Contains(p.AllowedProperties, name) {
+				continue
+			}
+			return errors.Errorf("property %q may not be set on pools owned by %s (allowed: %s)",
+				name, p, strings.Join(p.AllowedProperties, ","))
+		}
+	}
+
+	var numPools uint32
+	var totalBytes uint64
+	for _, ps := range pools {
+		if ps.State == PoolServiceStateDestroying || !p.Applies(ps.Owner, ps.OwnerGroup) {
+			continue
+		}
+		numPools++
+		totalBytes += ps.Storage.TotalBytes()
+	}
+
+	if p.MaxPools > 0 && numPools >= p.MaxPools {
+		return errors.Errorf("%s already owns %d of a maximum of %d pools", p, numPools, p.MaxPools)
+	}
+	if p.MaxTotalBytes > 0 && totalBytes+req.TotalBytes > p.MaxTotalBytes {
+		return errors.Errorf("a %s pool would bring the capacity owned by %s to %s, over the limit of %s",
+			humanize.Bytes(req.TotalBytes), p, humanize.Bytes(totalBytes+req.TotalBytes),
+			humanize.Bytes(p.MaxTotalBytes))
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSystem_PoolPolicy_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policy *PoolPolicy
+		expErr error
+	}{
+		"nil": {
+			expErr: errors.New("nil pool policy"),
+		},
+		"bad type": {
+			policy: &PoolPolicy{Type: "owner", Principal: "alice@"},
+			expErr: errors.New("invalid pool policy type"),
+		},
+		"principal without domain separator": {
+			policy: &PoolPolicy{Type: PoolPolicyUser, Principal: "alice"},
+			expErr: errors.New("invalid pool policy principal"),
+		},
+		"empty principal name": {
+			policy: &PoolPolicy{Type: PoolPolicyGroup, Principal: "@"},
+			expErr: errors.New("invalid pool policy principal"),
+		},
+		"unknown allowed property": {
+			policy: &PoolPolicy{
+				Type:              PoolPolicyUser,
+				Principal:         "alice@",
+				AllowedProperties: []string{"reclaim", "quack"},
+			},
+			expErr: errors.New("invalid allowed property"),
+		},
+		"valid": {
+			policy: &PoolPolicy{
+				Type:              PoolPolicyGroup,
+				Principal:         "hpc@",
+				MaxPools:          4,
+				AllowedProperties: []string{"reclaim"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.policy.Validate())
+		})
+	}
+}
+
+func TestSystem_PoolPolicy_CheckPoolCreate(t *testing.T) {
+	mockPool := func(owner, group string, state PoolServiceState) *PoolService {
+		return &PoolService{
+			State:      state,
+			Owner:      owner,
+			OwnerGroup: group,
+			Storage: &PoolServiceStorage{
+				CreationRankStr:    "[0-1]",
+				PerRankTierStorage: []uint64{humanize.GByte, 9 * humanize.GByte},
+			},
+		}
+	}
+	pools := []*PoolService{
+		mockPool("alice@", "hpc@", PoolServiceStateReady),
+		mockPool("bob@", "hpc@", PoolServiceStateReady),
+		mockPool("alice@", "hpc@", PoolServiceStateDestroying),
+		mockPool("", "", PoolServiceStateReady),
+	}
+	aliceReq := &PoolPolicyRequest{
+		Owner:      "alice@",
+		OwnerGroup: "hpc@",
+		TotalBytes: 10 * humanize.GByte,
+		Properties: []string{"label", "reclaim"},
+	}
+
+	for name, tc := range map[string]struct {
+		policy *PoolPolicy
+		req    *PoolPolicyRequest
+		expErr error
+	}{
+		"nil request": {
+			policy: &PoolPolicy{Type: PoolPolicyUser, Principal: "alice@"},
+			expErr: errors.New("nil pool policy request"),
+		},
+		"other user": {
+			policy: &PoolPolicy{Type: PoolPolicyUser, Principal: "bob@", MaxPools: 1},
+			req:    aliceReq,
+		},
+		"user under pool limit": {
+			policy: &PoolPolicy{Type: PoolPolicyUser, Principal: "alice@", MaxPools: 2},
+			req:    aliceReq,
+		},
+		"user at pool limit": {
+			policy: &PoolPolicy{Type: PoolPolicyUser, Principal: "alice@", MaxPools: 1},
+			req:    aliceReq,
+			expErr: errors.New("user alice@ already owns 1 of a maximum of 1 pools"),
+		},
+		"group at pool limit": {
+			policy: &PoolPolicy{Type: PoolPolicyGroup, Principal: "hpc@", MaxPools: 2},
+			req:    aliceReq,
+			expErr: errors.New("group hpc@ already owns 2 of a maximum of 2 pools"),
+		},
+		"group within capacity limit": {
+			policy: &PoolPolicy{Type: PoolPolicyGroup, Principal: "hpc@", MaxTotalBytes: 50 * humanize.GByte},
+			req:    aliceReq,
+		},
+		"group over capacity limit": {
+			policy: &PoolPolicy{Type: PoolPolicyGroup, Principal: "hpc@", MaxTotalBytes: 49 * humanize.GByte},
+			req:    aliceReq,
+			expErr: errors.New("bring the capacity owned by group hpc@ to 50 GB, over the limit of 49 GB"),
+		},
+		"allowed properties": {
+			policy: &PoolPolicy{
+				Type:              PoolPolicyUser,
+				Principal:         "alice@",
+				AllowedProperties: []string{"reclaim"},
+			},
+			req: aliceReq,
+		},
+		"disallowed property": {
+			policy: &PoolPolicy{
+				Type:              PoolPolicyUser,
+				Principal:         "alice@",
+				AllowedProperties: []string{"ec_cell_sz", "space_rb"},
+			},
+			req:    aliceReq,
+			expErr: errors.New(`property "reclaim" may not be set on pools owned by user alice@ (allowed: ec_cell_sz,space_rb)`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.policy.CheckPoolCreate(pools, tc.req))
+		})
+	}
+}
@@ -27,8 +27,17 @@ func isReservedKey(key string) bool {
 
 // SetMgmtProperty updates the MS property for the supplied key/value.
 func SetMgmtProperty(db SysAttrSetter, key, value string) error {
-	key = mgmtPropPrefix + key
-	return db.SetSystemAttrs(map[string]string{key: value})
+	return db.SetSystemAttrs(MgmtPropertyAttrs(map[string]string{key: value}))
+}
+
+// MgmtPropertyAttrs returns the system attributes used to store the supplied MS
+// property key/value pairs, for updates that must be applied with other changes.
+func MgmtPropertyAttrs(props map[string]string) map[string]string {
+	attrs := make(map[string]string, len(props))
+	for k, v := range props {
+		attrs[mgmtPropPrefix+k] = v
+	}
+	return attrs
 }
 
 // GetMgmtProperty returns the MS property for the supplied key.
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -30,7 +32,15 @@ import (
 
 const (
 	// CurrentSchemaVersion indicates the current db schema version.
-	CurrentSchemaVersion = 0
+	//
+	// Schema versions:
+	//   0: Members, Pools, Checker and System tables.
+	//   1: Adds the Events and PoolPolicies tables.
+	//
+	// When the schema changes, the version must be incremented and a step
+	// added to dbData.migrateSchema() to upgrade snapshots written using the
+	// previous version.
+	CurrentSchemaVersion = 1
 )
 
 var (
@@ -77,6 +87,8 @@ type (
 		Pools         *PoolDatabase
 		Checker       *CheckerDatabase
 		System        *SystemDatabase
+		Events        *EventDatabase
+		PoolPolicies  *PoolPolicyDatabase
 		SchemaVersion uint
 	}
 
@@ -99,6 +111,7 @@ type (
 		shutdownCb         context.CancelFunc
 		shutdownErrCh      chan error
 		poolLocks          poolLockMap
+		systemLock         systemLockHolder
 
 		data *dbData // raft-backed system data
 	}
@@ -270,15 +283,49 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 			System: &SystemDatabase{
 				Attributes: make(map[string]string),
 			},
+			Events: &EventDatabase{},
+			PoolPolicies: &PoolPolicyDatabase{
+				Policies: make(map[string]*system.PoolPolicy),
+			},
 			SchemaVersion: CurrentSchemaVersion,
 		},
 	}
 	// NB: We may remove this once the locking stuff is solid.
 	db.poolLocks.log = log
+	db.systemLock.log = log
 
 	return db, nil
 }
 
+// migrateSchema upgrades data restored from a snapshot written using an older
+// schema version to the current version. Data written using a newer version
+// cannot be restored.
+func (d *dbData) migrateSchema() error {
+	if d.SchemaVersion > CurrentSchemaVersion {
+		return errors.Errorf("restored schema version %d > %d",
+			d.SchemaVersion, CurrentSchemaVersion)
+	}
+
+	for ; d.SchemaVersion < CurrentSchemaVersion; d.SchemaVersion++ {
+		switch d.SchemaVersion {
+		case 0:
+			// Version 1 added the Events and PoolPolicies tables, which
+			// start out empty.
+			if d.Events == nil {
+				d.Events = &EventDatabase{}
+			}
+			if d.PoolPolicies == nil {
+				d.PoolPolicies = &PoolPolicyDatabase{}
+			}
+			if d.PoolPolicies.Policies == nil {
+				d.PoolPolicies.Policies = make(map[string]*system.PoolPolicy)
+			}
+		}
+	}
+
+	return nil
+}
+
 // isReplica returns true if the supplied address matches
 // a known replica address.
 func (db *Database) isReplica(ctrlAddr *net.TCPAddr) bool {
@@ -822,6 +869,26 @@ func (db *Database) UpdateMember(m *system.Member) error {
 	return db.submitMemberUpdate(raftOpUpdateMember, &memberUpdate{Member: m})
 }
 
+// UpdateMembers updates a set of existing members in a single operation. The
+// supplied system attributes, if any, are updated in the same operation.
+func (db *Database) UpdateMembers(members []*system.Member, attrs map[string]string) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	db.log.Tracef("updating %d members", len(members))
+
+	for _, m := range members {
+		if _, err := db.FindMemberByUUID(m.UUID); err != nil {
+			return err
+		}
+	}
+
+	return db.submitMembersUpdate(members, attrs)
+}
+
 // FindMemberByRank searches the member database by rank. If no
 // member is found, an error is returned.
 func (db *Database) FindMemberByRank(rank ranklist.Rank) (*system.Member, error) {
@@ -888,6 +955,7 @@ func (db *Database) FaultDomainTree() *system.FaultDomainTree {
 func copyPoolService(in *system.PoolService) *system.PoolService {
 	out := new(system.PoolService)
 	*out = *in
+	out.Aliases = slices.Clone(in.Aliases)
 	return out
 }
 
@@ -946,6 +1014,24 @@ func (db *Database) FindPoolServiceByLabel(label string) (*system.PoolService, e
 	return nil, system.ErrPoolLabelNotFound(label)
 }
 
+// FindPoolServiceByAlias searches the pool database by alias label. If no
+// pool service has the given alias, an error is returned.
+func (db *Database) FindPoolServiceByAlias(alias string) (*system.PoolService, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	for _, p := range db.data.Pools.Uuids {
+		if slices.Contains(p.Aliases, alias) {
+			return copyPoolService(p), nil
+		}
+	}
+
+	return nil, system.ErrPoolLabelNotFound(alias)
+}
+
 // TakePoolLock attempts to take a lock on the pool with the given UUID,
 // if the supplied context does not already contain a valid lock for that
 // pool.
@@ -962,7 +1048,7 @@ func (db *Database) TakePoolLock(ctx context.Context, poolUUID uuid.UUID) (*Pool
 			return nil, err
 		}
 		// No lock in context, so create a new one.
-		return db.poolLocks.take(poolUUID)
+		return db.poolLocks.take(poolUUID, system.LockOwnerFromContext(ctx))
 	}
 
 	// Lock already exists in context, so verify that it's valid and for the same pool.
@@ -979,8 +1065,48 @@ func (db *Database) TakePoolLock(ctx context.Context, poolUUID uuid.UUID) (*Pool
 	return lock, nil
 }
 
+// TakeSystemLock attempts to take the lock on system-wide operations. The
+// lock owner is identified by the supplied context.
+func (db *Database) TakeSystemLock(ctx context.Context) (*SystemLock, error) {
+	if ctx == nil {
+		return nil, errors.New("nil context in TakeSystemLock()")
+	}
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
+	return db.systemLock.take(system.LockOwnerFromContext(ctx))
+}
+
+// OpLocks returns the operation locks currently held on the MS leader,
+// oldest first.
+func (db *Database) OpLocks() ([]*system.OpLock, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
+	locks := append(db.systemLock.list(), db.poolLocks.list()...)
+	sort.Slice(locks, func(i, j int) bool {
+		return locks[i].TakenAt.Before(locks[j].TakenAt)
+	})
+
+	return locks, nil
+}
+
+// PoolServiceCheck is run against all of the pool services in the database
+// before a pool service is added or updated. An error prevents the change.
+type PoolServiceCheck func(pools []*system.PoolService) error
+
 // AddPoolService creates an entry for a new pool service in the pool database.
 func (db *Database) AddPoolService(ctx context.Context, ps *system.PoolService) error {
+	return db.AddPoolServiceChecked(ctx, ps, nil)
+}
+
+// AddPoolServiceChecked creates an entry for a new pool service in the pool
+// database if the supplied check passes. The check and the addition are made
+// under the database lock, so that no other pool service can be added or
+// updated in between.
+func (db *Database) AddPoolServiceChecked(ctx context.Context, ps *system.PoolService, check PoolServiceCheck) error {
 	if err := db.CheckLeader(); err != nil {
 		return err
 	}
@@ -995,6 +1121,16 @@ func (db *Database) AddPoolService(ctx context.Context, ps *system.PoolService)
 		return errors.Errorf("pool %s already exists", p.PoolUUID)
 	}
 
+	if check != nil {
+		pools, err := db.PoolServiceList(true)
+		if err != nil {
+			return err
+		}
+		if err := check(pools); err != nil {
+			return err
+		}
+	}
+
 	if err := db.submitPoolUpdate(raftOpAddPoolService, ps); err != nil {
 		return err
 	}
@@ -1028,6 +1164,13 @@ func (db *Database) RemovePoolService(ctx context.Context, poolUUID uuid.UUID) e
 
 // UpdatePoolService updates an existing pool database entry.
 func (db *Database) UpdatePoolService(ctx context.Context, ps *system.PoolService) error {
+	return db.UpdatePoolServiceChecked(ctx, ps, nil)
+}
+
+// UpdatePoolServiceChecked updates an existing pool service entry in the pool
+// database if the supplied check passes. As with AddPoolServiceChecked, the
+// check and the update are made under the database lock.
+func (db *Database) UpdatePoolServiceChecked(ctx context.Context, ps *system.PoolService, check PoolServiceCheck) error {
 	if err := db.CheckLeader(); err != nil {
 		return err
 	}
@@ -1059,6 +1202,16 @@ func (db *Database) UpdatePoolService(ctx context.Context, ps *system.PoolServic
 		return nil
 	}
 
+	if check != nil {
+		pools, err := db.PoolServiceList(true)
+		if err != nil {
+			return err
+		}
+		if err := check(pools); err != nil {
+			return err
+		}
+	}
+
 	if err := db.submitPoolUpdate(raftOpUpdatePoolService, ps); err != nil {
 		return err
 	}
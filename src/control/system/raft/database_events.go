@@ -0,0 +1,209 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"slices"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+const (
+	// MaxEventRecords is the maximum number of RAS events retained in the
+	// system database. The oldest records are discarded to make room for
+	// new ones.
+	MaxEventRecords = 1024
+)
+
+type (
+	// EventRecord is a RAS event as recorded in the system database.
+	EventRecord struct {
+		Seq         uint64
+		ID          events.RASID
+		Type        events.RASTypeID
+		Severity    events.RASSeverityID
+		Timestamp   time.Time
+		Msg         string
+		Hostname    string
+		Rank        uint32
+		Incarnation uint64
+		HWID        string
+		PoolUUID    string
+		ContUUID    string
+		CtlOp       string
+	}
+
+	// EventDatabase is the bounded database of recent RAS events.
+	EventDatabase struct {
+		Records []*EventRecord
+		NextSeq uint64
+	}
+
+	// EventFilter specifies the criteria used to select records from
+	// the event database.
+	EventFilter struct {
+		IDs         []events.RASID       // Return events with these IDs (empty matches all)
+		AfterSeq    uint64               // Return events recorded after this sequence number
+		MinSeverity events.RASSeverityID // Return events at least this severe (unknown matches all)
+		Ranks       *ranklist.RankSet    // Return events for these ranks (empty matches all)
+		Since       time.Time            // Return events at or after this time (zero matches all)
+		Until       time.Time            // Return events at or before this time (zero matches all)
+		Offset      uint64               // Number of matching events to skip
+		Limit       uint64               // Maximum number of events to return (zero is unlimited)
+	}
+)
+
+// newEventRecord creates a record from the supplied RAS event.
+func newEventRecord(evt *events.RASEvent) *EventRecord {
+	ts, err := evt.GetTimestamp()
+	if err != nil {
+		ts = time.Now()
+	}
+
+	return &EventRecord{
+		ID:          evt.ID,
+		Type:        evt.Type,
+		Severity:    evt.Severity,
+		Timestamp:   ts,
+		Msg:         evt.Msg,
+		Hostname:    evt.Hostname,
+		Rank:        evt.Rank,
+		Incarnation: evt.Incarnation,
+		HWID:        evt.HWID,
+		PoolUUID:    evt.PoolUUID,
+		ContUUID:    evt.ContUUID,
+		CtlOp:       evt.CtlOp,
+	}
+}
+
+// Matches returns true if the record satisfies the filter criteria.
+// Offset and Limit are not considered.
+func (f *EventFilter) Matches(rec *EventRecord) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.IDs) > 0 && !slices.Contains(f.IDs, rec.ID) {
+		return false
+	}
+	if rec.Seq <= f.AfterSeq {
+		return false
+	}
+	// Lower severity IDs are more severe.
+	if f.MinSeverity != events.RASSeverityUnknown &&
+		(rec.Severity == events.RASSeverityUnknown || rec.Severity > f.MinSeverity) {
+		return false
+	}
+	if f.Ranks != nil && f.Ranks.Count() > 0 && !f.Ranks.Contains(ranklist.Rank(rec.Rank)) {
+		return false
+	}
+	if !f.Since.IsZero() && rec.Timestamp.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && rec.Timestamp.After(f.Until) {
+		return false
+	}
+
+	return true
+}
+
+func (edb *EventDatabase) addRecord(rec *EventRecord) {
+	edb.NextSeq++
+	rec.Seq = edb.NextSeq
+	edb.Records = append(edb.Records, rec)
+
+	if excess := len(edb.Records) - MaxEventRecords; excess > 0 {
+		// Copy the retained records so that the discarded ones can be
+		// garbage-collected.
+		edb.Records = append([]*EventRecord(nil), edb.Records[excess:]...)
+	}
+}
+
+// AddEvents records the given RAS events in the system database. The events
+// are replicated in a single update, so callers should batch events rather
+// than recording each one as it is received.
+func (db *Database) AddEvents(evts []*events.RASEvent) error {
+	if len(evts) == 0 {
+		return nil
+	}
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	recs := make([]*EventRecord, 0, len(evts))
+	for _, evt := range evts {
+		recs = append(recs, newEventRecord(evt))
+	}
+
+	return db.submitEventUpdate(raftOpAddEvents, recs)
+}
+
+// FilterEvents returns the recorded RAS events that match the supplied filter,
+// newest first, along with the total number of matching events before the
+// filter's offset and limit were applied.
+func (db *Database) FilterEvents(filter *EventFilter) ([]*EventRecord, uint64, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, 0, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	var offset, limit uint64
+	if filter != nil {
+		offset, limit = filter.Offset, filter.Limit
+	}
+
+	var total uint64
+	out := []*EventRecord{}
+	recs := db.data.Events.Records
+	for i := len(recs) - 1; i >= 0; i-- {
+		if !filter.Matches(recs[i]) {
+			continue
+		}
+		total++
+		if total <= offset || (limit > 0 && uint64(len(out)) >= limit) {
+			continue
+		}
+		rec := *recs[i]
+		out = append(out, &rec)
+	}
+
+	return out, total, nil
+}
+
+// LastEventSeq returns the sequence number of the most recently recorded RAS
+// event, which is retained even after the record itself has been discarded.
+func (db *Database) LastEventSeq() (uint64, error) {
+	if err := db.CheckReplica(); err != nil {
+		return 0, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	return db.data.Events.NextSeq, nil
+}
+
+// FirstEventSeq returns the sequence number of the oldest RAS event record
+// retained in the system database. Any events recorded before it have been
+// discarded. If no records are retained, the sequence number that will be
+// assigned to the next event is returned.
+func (db *Database) FirstEventSeq() (uint64, error) {
+	if err := db.CheckReplica(); err != nil {
+		return 0, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	if len(db.data.Events.Records) == 0 {
+		return db.data.Events.NextSeq + 1, nil
+	}
+	return db.data.Events.Records[0].Seq, nil
+}
@@ -0,0 +1,93 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// PoolPolicyDatabase contains the pool creation policies, keyed by policy
+// type and principal.
+type PoolPolicyDatabase struct {
+	Policies map[string]*system.PoolPolicy
+}
+
+func copyPoolPolicy(in *system.PoolPolicy) *system.PoolPolicy {
+	out := new(system.PoolPolicy)
+	*out = *in
+	out.AllowedProperties = slices.Clone(in.AllowedProperties)
+	return out
+}
+
+func (ppdb *PoolPolicyDatabase) setPolicy(p *system.PoolPolicy) {
+	if ppdb.Policies == nil {
+		ppdb.Policies = make(map[string]*system.PoolPolicy)
+	}
+	ppdb.Policies[p.Key()] = p
+}
+
+func (ppdb *PoolPolicyDatabase) removePolicy(p *system.PoolPolicy) {
+	delete(ppdb.Policies, p.Key())
+}
+
+// SetPoolPolicy adds or replaces the pool policy for the policy's type and
+// principal.
+func (db *Database) SetPoolPolicy(p *system.PoolPolicy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	return db.submitPoolPolicyUpdate(raftOpSetPoolPolicy, p)
+}
+
+// RemovePoolPolicy removes the pool policy for the supplied type and
+// principal.
+func (db *Database) RemovePoolPolicy(typ system.PoolPolicyType, principal string) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	key := system.PoolPolicyKey(typ, principal)
+	db.data.RLock()
+	_, found := db.data.PoolPolicies.Policies[key]
+	db.data.RUnlock()
+	if !found {
+		return system.ErrPoolPolicyNotFound(key)
+	}
+
+	return db.submitPoolPolicyUpdate(raftOpRemovePoolPolicy, &system.PoolPolicy{
+		Type:      typ,
+		Principal: principal,
+	})
+}
+
+// PoolPolicies returns a copy of the pool policies, ordered by key.
+func (db *Database) PoolPolicies() ([]*system.PoolPolicy, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	out := make([]*system.PoolPolicy, 0, len(db.data.PoolPolicies.Policies))
+	for _, p := range db.data.PoolPolicies.Policies {
+		out = append(out, copyPoolPolicy(p))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key() < out[j].Key() })
+
+	return out, nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -133,6 +134,8 @@ func (pdb *PoolDatabase) updateService(cur, new *system.PoolService) {
 		panic("PoolDatabase.updateService() called with non-member pointer")
 	}
 	cur.State = new.State
+	cur.DestroyProtect = new.DestroyProtect
+	cur.CreationTime = new.CreationTime
 	cur.LastUpdate = new.LastUpdate
 
 	// TODO: Update svc rank map
@@ -145,6 +148,7 @@ func (pdb *PoolDatabase) updateService(cur, new *system.PoolService) {
 	if cur.PoolLabel != "" {
 		pdb.Labels[cur.PoolLabel] = cur
 	}
+	cur.Aliases = new.Aliases
 }
 
 // removeService is responsible for removing a PoolService entry and
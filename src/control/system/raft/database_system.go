@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -13,3 +14,14 @@ type (
 		Attributes map[string]string
 	}
 )
+
+// updateAttributes sets the supplied attributes, deleting any with an empty value.
+func (sdb *SystemDatabase) updateAttributes(attrs map[string]string) {
+	for k, v := range attrs {
+		if v == "" {
+			delete(sdb.Attributes, k)
+			continue
+		}
+		sdb.Attributes[k] = v
+	}
+}
@@ -10,6 +10,7 @@ package raft
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
@@ -236,6 +237,8 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 	maxPools := 1024
 	maxAttrs := 4096
 	maxFindings := 512
+	maxEvents := 128
+	maxPolicies := 64
 
 	log, buf := logging.NewTestLogger(t.Name())
 	defer test.ShowBufferOnFailure(t, buf)
@@ -301,6 +304,42 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 		(*fsm)(db0).Apply(rl)
 	}
 
+	for i := 0; i < maxEvents; i++ {
+		rec := &EventRecord{
+			ID:        events.RASEngineDied,
+			Severity:  events.RASSeverityError,
+			Timestamp: time.Now(),
+			Msg:       fmt.Sprintf("event %d", i),
+			Rank:      uint32(i % maxRanks),
+		}
+		data, err := createRaftUpdate(raftOpAddEvents, []*EventRecord{rec})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rl := &raft.Log{
+			Data: data,
+		}
+		(*fsm)(db0).Apply(rl)
+	}
+
+	for i := 0; i < maxPolicies; i++ {
+		p := &system.PoolPolicy{
+			Type:              system.PoolPolicyUser,
+			Principal:         fmt.Sprintf("user%02d@", i),
+			MaxPools:          uint32(i),
+			MaxTotalBytes:     uint64(i) << 40,
+			AllowedProperties: []string{"reclaim"},
+		}
+		data, err := createRaftUpdate(raftOpSetPoolPolicy, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rl := &raft.Log{
+			Data: data,
+		}
+		(*fsm)(db0).Apply(rl)
+	}
+
 	attrs := make(map[string]string)
 	for i := 0; i < maxAttrs; i++ {
 		attrs[fmt.Sprintf("prop%04d", i)] = fmt.Sprintf("value%04d", i)
@@ -361,11 +400,49 @@ func TestSystem_Database_SnapshotRestoreBadVersion(t *testing.T) {
 	db1, cleanup1 := TestDatabase(t, log)
 	defer cleanup1()
 
-	wantErr := errors.Errorf("%d != %d", db0.data.SchemaVersion, CurrentSchemaVersion)
+	wantErr := errors.Errorf("%d > %d", db0.data.SchemaVersion, CurrentSchemaVersion)
 	gotErr := (*fsm)(db1).Restore(sink.Reader())
 	test.CmpErr(t, wantErr, gotErr)
 }
 
+func TestSystem_Database_SnapshotRestoreMigrate(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	// A version 0 snapshot predates the Events and PoolPolicies tables.
+	snapData, err := json.Marshal(map[string]interface{}{
+		"Version":       42,
+		"SchemaVersion": 0,
+		"Events":        nil,
+		"PoolPolicies":  nil,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink := &testSnapshotSink{}
+	if _, err := sink.Write(snapData); err != nil {
+		t.Fatal(err)
+	}
+
+	db, cleanup := TestDatabase(t, log)
+	defer cleanup()
+
+	if err := (*fsm)(db).Restore(sink.Reader()); err != nil {
+		t.Fatal(err)
+	}
+
+	test.AssertEqual(t, uint64(42), db.data.Version, "unexpected data version")
+	test.AssertEqual(t, uint(CurrentSchemaVersion), db.data.SchemaVersion, "unexpected schema version")
+	if db.data.Events == nil {
+		t.Fatal("events table not initialized by migration")
+	}
+	test.AssertEqual(t, 0, len(db.data.Events.Records), "unexpected event records")
+	test.AssertEqual(t, uint64(0), db.data.Events.NextSeq, "unexpected next event sequence")
+	if db.data.PoolPolicies == nil || db.data.PoolPolicies.Policies == nil {
+		t.Fatal("pool policies table not initialized by migration")
+	}
+}
+
 func TestSystem_Database_BadApply(t *testing.T) {
 	makePayload := func(t *testing.T, op raftOp, inner interface{}) []byte {
 		t.Helper()
@@ -591,6 +668,75 @@ func TestSystem_Database_memberRaftOps(t *testing.T) {
 	}
 }
 
+func TestSystem_Database_membersRaftUpdate(t *testing.T) {
+	ctx := test.Context(t)
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+
+	testMembers := make([]*Member, 0)
+	nextAddr := ctrlAddrGen(ctx, net.IPv4(127, 0, 0, 1), 4)
+	for i := 0; i < 3; i++ {
+		m := &Member{
+			Rank:        Rank(i),
+			UUID:        uuid.New(),
+			Addr:        <-nextAddr,
+			State:       MemberStateJoined,
+			FaultDomain: MustCreateFaultDomainFromString("/rack0"),
+		}
+		testMembers = append(testMembers, m)
+		raftUpdateTestMember(t, db, raftOpAddMember, m)
+	}
+	startMapVer := db.data.MapVersion
+
+	updated := make([]*Member, 0)
+	for _, m := range testMembers[:2] {
+		um := *m
+		um.FaultDomain = MustCreateFaultDomainFromString("/rack1")
+		updated = append(updated, &um)
+	}
+
+	data, err := createRaftUpdate(raftOpUpdateMembers, &membersUpdate{
+		Members: updated,
+		Attrs:   map[string]string{"foo": "bar"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	(*fsm)(db).Apply(&raft.Log{Data: data})
+
+	cmpOpts := []cmp.Option{
+		cmp.AllowUnexported(Member{}),
+	}
+	for _, expMember := range append(updated, testMembers[2]) {
+		m, ok := db.data.Members.Uuids[expMember.UUID]
+		if !ok {
+			t.Fatalf("member not found for UUID %s", expMember.UUID)
+		}
+		if diff := cmp.Diff(expMember, m, cmpOpts...); diff != "" {
+			t.Fatalf("member wrong in UUID DB (-want, +got):\n%s\n", diff)
+		}
+	}
+
+	expFDTree := NewFaultDomainTree(
+		MemberFaultDomain(updated[0]),
+		MemberFaultDomain(updated[1]),
+		MemberFaultDomain(testMembers[2]),
+	)
+	if diff := cmp.Diff(expFDTree, db.data.Members.FaultDomains, ignoreFaultDomainIDOption()); diff != "" {
+		t.Fatalf("wrong FaultDomainTree in DB (-want, +got):\n%s\n", diff)
+	}
+
+	if db.data.MapVersion != startMapVer+1 {
+		t.Fatalf("expected map version %d, got %d", startMapVer+1, db.data.MapVersion)
+	}
+
+	if diff := cmp.Diff(map[string]string{"foo": "bar"}, db.data.System.Attributes); diff != "" {
+		t.Fatalf("wrong system attributes in DB (-want, +got):\n%s\n", diff)
+	}
+}
+
 func TestSystem_Database_memberFaultDomain(t *testing.T) {
 	for name, tc := range map[string]struct {
 		rank        Rank
@@ -897,6 +1043,200 @@ func TestSystemDatabase_PoolServiceList(t *testing.T) {
 	}
 }
 
+func TestSystem_Database_AddPoolServiceChecked(t *testing.T) {
+	existing := &PoolService{
+		PoolUUID:  uuid.New(),
+		PoolLabel: "pool0001",
+		State:     system.PoolServiceStateCreating,
+		Replicas:  []Rank{1},
+	}
+
+	for name, tc := range map[string]struct {
+		check    PoolServiceCheck
+		expPools []string
+		expErr   error
+	}{
+		"no check": {
+			expPools: []string{"pool0001", "pool0002"},
+		},
+		"check passes": {
+			check: func(pools []*PoolService) error {
+				if len(pools) != 1 || pools[0].PoolUUID != existing.PoolUUID {
+					return errors.Errorf("unexpected pools: %+v", pools)
+				}
+				return nil
+			},
+			expPools: []string{"pool0001", "pool0002"},
+		},
+		"check fails": {
+			check: func([]*PoolService) error {
+				return errors.New("denied")
+			},
+			expPools: []string{"pool0001"},
+			expErr:   errors.New("denied"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			db := MockDatabase(t, log)
+			lock, err := db.TakePoolLock(ctx, existing.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := db.AddPoolService(lock.InContext(ctx), existing); err != nil {
+				t.Fatal(err)
+			}
+			lock.Release()
+
+			ps := &PoolService{
+				PoolUUID:  uuid.New(),
+				PoolLabel: "pool0002",
+				State:     system.PoolServiceStateCreating,
+				Replicas:  []Rank{1},
+			}
+			lock, err = db.TakePoolLock(ctx, ps.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer lock.Release()
+
+			gotErr := db.AddPoolServiceChecked(lock.InContext(ctx), ps, tc.check)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			pools, err := db.PoolServiceList(true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotPools []string
+			for _, p := range pools {
+				gotPools = append(gotPools, p.PoolLabel)
+			}
+			sort.Strings(gotPools)
+			if diff := cmp.Diff(tc.expPools, gotPools); diff != "" {
+				t.Fatalf("unexpected pools (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_UpdatePoolServiceChecked(t *testing.T) {
+	for name, tc := range map[string]struct {
+		check    PoolServiceCheck
+		expLabel string
+		expErr   error
+	}{
+		"no check": {
+			expLabel: "pool0002",
+		},
+		"check passes": {
+			check: func(pools []*PoolService) error {
+				if len(pools) != 1 || pools[0].PoolLabel != "pool0001" {
+					return errors.Errorf("unexpected pools: %+v", pools)
+				}
+				return nil
+			},
+			expLabel: "pool0002",
+		},
+		"check fails": {
+			check: func([]*PoolService) error {
+				return errors.New("denied")
+			},
+			expLabel: "pool0001",
+			expErr:   errors.New("denied"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ps := &PoolService{
+				PoolUUID:  uuid.New(),
+				PoolLabel: "pool0001",
+				State:     system.PoolServiceStateReady,
+				Replicas:  []Rank{1},
+			}
+
+			ctx := test.Context(t)
+			db := MockDatabase(t, log)
+			lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer lock.Release()
+			if err := db.AddPoolService(lock.InContext(ctx), ps); err != nil {
+				t.Fatal(err)
+			}
+
+			up := new(PoolService)
+			*up = *ps
+			up.PoolLabel = "pool0002"
+			gotErr := db.UpdatePoolServiceChecked(lock.InContext(ctx), up, tc.check)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			got, err := db.FindPoolServiceByUUID(ps.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expLabel, got.PoolLabel, "unexpected pool label")
+		})
+	}
+}
+
+func TestSystem_Database_FindPoolServiceByAlias(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx := test.Context(t)
+	db := MockDatabase(t, log)
+	ps := &PoolService{
+		PoolUUID:   uuid.New(),
+		PoolLabel:  "pool0001",
+		Aliases:    []string{"alias1"},
+		State:      system.PoolServiceStateReady,
+		Replicas:   []Rank{1},
+		LastUpdate: time.Now(),
+	}
+
+	lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lock.Release()
+	if err := db.AddPoolService(lock.InContext(ctx), ps); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := db.FindPoolServiceByAlias("alias1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, ps.PoolUUID, found.PoolUUID, "unexpected pool found")
+
+	// The returned pool service is a copy.
+	found.Aliases[0] = "modified"
+	if _, err := db.FindPoolServiceByAlias("alias1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Aliases are replaced by an update, and are not labels.
+	found.Aliases = []string{"alias2"}
+	if err := db.UpdatePoolService(lock.InContext(ctx), found); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.FindPoolServiceByAlias("alias1"); !system.IsPoolNotFound(err) {
+		t.Fatalf("expected pool not found error, got %v", err)
+	}
+	if _, err := db.FindPoolServiceByAlias("alias2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.FindPoolServiceByLabel("alias2"); !system.IsPoolNotFound(err) {
+		t.Fatalf("expected pool not found error, got %v", err)
+	}
+}
+
 func TestSystem_Database_GroupMap(t *testing.T) {
 	membersWithStates := func(states ...MemberState) []*Member {
 		members := make([]*Member, len(states))
@@ -1188,3 +1528,297 @@ func TestDatabase_TakePoolLock(t *testing.T) {
 		})
 	}
 }
+
+func TestSystem_Database_AddEvents(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	if err := db.AddEvents(nil); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint64(0), db.data.Events.NextSeq, "empty batch should not be recorded")
+
+	firstSeq, err := db.FirstEventSeq()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint64(1), firstSeq, "unexpected first event sequence with no records")
+
+	numEvents := MaxEventRecords + 10
+	batch := make([]*events.RASEvent, 0, 100)
+	for i := 0; i < numEvents; i++ {
+		batch = append(batch, events.NewGenericEvent(events.RASUnknownEvent,
+			events.RASSeverityNotice, fmt.Sprintf("event %d", i), ""))
+		if len(batch) == cap(batch) || i == numEvents-1 {
+			if err := db.AddEvents(batch); err != nil {
+				t.Fatal(err)
+			}
+			batch = batch[:0]
+		}
+	}
+
+	recs := db.data.Events.Records
+	test.AssertEqual(t, MaxEventRecords, len(recs), "unexpected number of records")
+	test.AssertEqual(t, uint64(numEvents), db.data.Events.NextSeq, "unexpected next sequence")
+	test.AssertEqual(t, uint64(11), recs[0].Seq, "oldest records not discarded")
+	test.AssertEqual(t, fmt.Sprintf("event %d", numEvents-1), recs[len(recs)-1].Msg,
+		"unexpected newest record")
+
+	lastSeq, err := db.LastEventSeq()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint64(numEvents), lastSeq, "unexpected last event sequence")
+
+	firstSeq, err = db.FirstEventSeq()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint64(11), firstSeq, "unexpected first event sequence")
+
+	notLeader := MockDatabase(t, log)
+	notLeader.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+		State: raft.Follower,
+	}, (*fsm)(notLeader)))
+	evt := events.NewGenericEvent(events.RASUnknownEvent, events.RASSeverityNotice, "foo", "")
+	if err := notLeader.AddEvents([]*events.RASEvent{evt}); !system.IsNotLeader(err) {
+		t.Fatalf("expected not leader error, got %v", err)
+	}
+}
+
+func TestSystem_Database_FilterEvents(t *testing.T) {
+	baseTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	records := []*EventRecord{
+		{Seq: 1, Severity: events.RASSeverityNotice, Rank: 0, Timestamp: baseTime},
+		{Seq: 2, Severity: events.RASSeverityError, Rank: 1, Timestamp: baseTime.Add(time.Minute)},
+		{Seq: 3, ID: events.RASPoolMembershipChanged, Severity: events.RASSeverityWarning, Rank: 2, Timestamp: baseTime.Add(2 * time.Minute)},
+		{Seq: 4, Severity: events.RASSeverityError, Rank: 2, Timestamp: baseTime.Add(3 * time.Minute)},
+		{Seq: 5, ID: events.RASPoolMembershipChanged, Severity: events.RASSeverityNotice, Rank: uint32(NilRank), Timestamp: baseTime.Add(4 * time.Minute)},
+	}
+
+	for name, tc := range map[string]struct {
+		filter   *EventFilter
+		expSeqs  []uint64
+		expTotal uint64
+	}{
+		"nil filter": {
+			expSeqs:  []uint64{5, 4, 3, 2, 1},
+			expTotal: 5,
+		},
+		"empty filter": {
+			filter:   &EventFilter{},
+			expSeqs:  []uint64{5, 4, 3, 2, 1},
+			expTotal: 5,
+		},
+		"error severity": {
+			filter:   &EventFilter{MinSeverity: events.RASSeverityError},
+			expSeqs:  []uint64{4, 2},
+			expTotal: 2,
+		},
+		"warning severity": {
+			filter:   &EventFilter{MinSeverity: events.RASSeverityWarning},
+			expSeqs:  []uint64{4, 3, 2},
+			expTotal: 3,
+		},
+		"ranks": {
+			filter:   &EventFilter{Ranks: MustCreateRankSet("[1-2]")},
+			expSeqs:  []uint64{4, 3, 2},
+			expTotal: 3,
+		},
+		"time range": {
+			filter: &EventFilter{
+				Since: baseTime.Add(time.Minute),
+				Until: baseTime.Add(3 * time.Minute),
+			},
+			expSeqs:  []uint64{4, 3, 2},
+			expTotal: 3,
+		},
+		"ids": {
+			filter:   &EventFilter{IDs: []events.RASID{events.RASPoolMembershipChanged}},
+			expSeqs:  []uint64{5, 3},
+			expTotal: 2,
+		},
+		"after sequence": {
+			filter:   &EventFilter{AfterSeq: 3},
+			expSeqs:  []uint64{5, 4},
+			expTotal: 2,
+		},
+		"ids after sequence": {
+			filter: &EventFilter{
+				IDs:      []events.RASID{events.RASPoolMembershipChanged},
+				AfterSeq: 3,
+			},
+			expSeqs:  []uint64{5},
+			expTotal: 1,
+		},
+		"limit": {
+			filter:   &EventFilter{Limit: 2},
+			expSeqs:  []uint64{5, 4},
+			expTotal: 5,
+		},
+		"offset and limit": {
+			filter:   &EventFilter{Offset: 2, Limit: 2},
+			expSeqs:  []uint64{3, 2},
+			expTotal: 5,
+		},
+		"offset past end": {
+			filter:   &EventFilter{Offset: 10},
+			expSeqs:  []uint64{},
+			expTotal: 5,
+		},
+		"combined filter and pagination": {
+			filter: &EventFilter{
+				MinSeverity: events.RASSeverityWarning,
+				Ranks:       MustCreateRankSet("2"),
+				Offset:      1,
+				Limit:       1,
+			},
+			expSeqs:  []uint64{3},
+			expTotal: 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			db.data.Events.Records = records
+
+			recs, total, err := db.FilterEvents(tc.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotSeqs := []uint64{}
+			for _, rec := range recs {
+				gotSeqs = append(gotSeqs, rec.Seq)
+			}
+			if diff := cmp.Diff(tc.expSeqs, gotSeqs); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expTotal, total, "unexpected total")
+		})
+	}
+}
+
+func TestDatabase_OpLocks(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	poolUUID := uuid.MustParse(test.MockUUID(1))
+	poolOwner := system.LockOwner{Operation: "PoolExtend", User: "admin@host1"}
+	sysOwner := system.LockOwner{Operation: "SystemStop", User: "admin@host2"}
+
+	poolLock, err := db.TakePoolLock(system.WithLockOwner(test.Context(t), poolOwner), poolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer poolLock.Release()
+
+	_, err = db.TakeSystemLock(nil)
+	test.CmpErr(t, errors.New("nil context"), err)
+
+	sysLock, err := db.TakeSystemLock(system.WithLockOwner(test.Context(t), sysOwner))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.TakePoolLock(test.Context(t), poolUUID)
+	test.CmpErr(t, errors.New("operation PoolExtend in progress"), err)
+	_, err = db.TakeSystemLock(test.Context(t))
+	test.CmpErr(t, errors.New("operation SystemStop in progress"), err)
+
+	locks, err := db.OpLocks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotOwners []system.LockOwner
+	for _, lock := range locks {
+		gotOwners = append(gotOwners, lock.Owner)
+	}
+	if diff := cmp.Diff([]system.LockOwner{poolOwner, sysOwner}, gotOwners); diff != "" {
+		t.Fatalf("unexpected lock owners (-want, +got):\n%s\n", diff)
+	}
+	test.AssertEqual(t, poolUUID, locks[0].PoolUUID, "unexpected pool UUID")
+	test.AssertEqual(t, uuid.Nil, locks[1].PoolUUID, "unexpected system lock pool UUID")
+
+	sysLock.Release()
+	locks, err = db.OpLocks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(locks), "system lock not released")
+}
+
+func TestSystem_Database_PoolPolicies(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+
+	userPolicy := &system.PoolPolicy{
+		Type:      system.PoolPolicyUser,
+		Principal: "alice@",
+		MaxPools:  2,
+	}
+	groupPolicy := &system.PoolPolicy{
+		Type:              system.PoolPolicyGroup,
+		Principal:         "alice@",
+		MaxTotalBytes:     1 << 40,
+		AllowedProperties: []string{"reclaim"},
+	}
+	for _, p := range []*system.PoolPolicy{userPolicy, groupPolicy} {
+		if err := db.SetPoolPolicy(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := db.SetPoolPolicy(&system.PoolPolicy{Type: system.PoolPolicyUser, Principal: "bob"})
+	test.CmpErr(t, errors.New("invalid pool policy principal"), err)
+	err = db.SetPoolPolicy(&system.PoolPolicy{
+		Type:              system.PoolPolicyUser,
+		Principal:         "bob@",
+		AllowedProperties: []string{"quack"},
+	})
+	test.CmpErr(t, errors.New("invalid allowed property"), err)
+
+	updated := *userPolicy
+	updated.MaxPools = 4
+	if err := db.SetPoolPolicy(&updated); err != nil {
+		t.Fatal(err)
+	}
+
+	policies, err := db.PoolPolicies()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*system.PoolPolicy{groupPolicy, &updated}, policies); diff != "" {
+		t.Fatalf("unexpected policies (-want, +got):\n%s\n", diff)
+	}
+
+	if err := db.RemovePoolPolicy(system.PoolPolicyGroup, "alice@"); err != nil {
+		t.Fatal(err)
+	}
+	err = db.RemovePoolPolicy(system.PoolPolicyGroup, "alice@")
+	if !system.IsErrPoolPolicyNotFound(err) {
+		t.Fatalf("expected pool policy not found error, got %v", err)
+	}
+
+	policies, err = db.PoolPolicies()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*system.PoolPolicy{&updated}, policies); diff != "" {
+		t.Fatalf("unexpected policies (-want, +got):\n%s\n", diff)
+	}
+
+	notLeader := MockDatabase(t, log)
+	notLeader.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+		State: raft.Follower,
+	}, (*fsm)(notLeader)))
+	if err := notLeader.SetPoolPolicy(userPolicy); !system.IsNotLeader(err) {
+		t.Fatalf("expected not leader error, got %v", err)
+	}
+}
@@ -28,6 +28,7 @@ type (
 	PoolLock struct {
 		id       uuid.UUID
 		poolUUID uuid.UUID
+		owner    system.LockOwner
 		takenAt  time.Time
 		refCount int32
 		relOnce  sync.Once
@@ -140,8 +141,8 @@ func (pl *PoolLock) Release() {
 
 // take returns a new pool lock for the supplied pool UUID
 // if the pool is not already locked, otherwise it returns
-// an error.
-func (plm *poolLockMap) take(poolUUID uuid.UUID) (*PoolLock, error) {
+// an error identifying the current owner of the lock.
+func (plm *poolLockMap) take(poolUUID uuid.UUID, owner system.LockOwner) (*PoolLock, error) {
 	if poolUUID == uuid.Nil {
 		return nil, errors.New("nil pool UUID")
 	}
@@ -154,19 +155,21 @@ func (plm *poolLockMap) take(poolUUID uuid.UUID) (*PoolLock, error) {
 	}
 
 	if lock, exists := plm.locks[poolUUID]; exists {
-		return nil, system.FaultPoolLocked(poolUUID, lock.id, lock.takenAt)
+		return nil, system.FaultPoolLocked(poolUUID, lock.id, lock.takenAt, lock.owner)
 	}
 
 	lock := &PoolLock{
 		id:       uuid.New(),
 		poolUUID: poolUUID,
+		owner:    owner,
 		takenAt:  time.Now(),
 		release:  func() { plm.release(poolUUID) },
 	}
 	lock.addRef()
 	plm.locks[poolUUID] = lock
 
-	plm.log.Debugf("%s: lock taken (id: %s)", dbgUuidStr(poolUUID), dbgUuidStr(lock.id))
+	plm.log.Debugf("%s: lock taken (id: %s, operation: %s)", dbgUuidStr(poolUUID), dbgUuidStr(lock.id),
+		owner.Operation)
 	return lock, nil
 }
 
@@ -179,6 +182,23 @@ func (plm *poolLockMap) release(poolUUID uuid.UUID) {
 	delete(plm.locks, poolUUID)
 }
 
+// list returns a description of each pool lock currently held.
+func (plm *poolLockMap) list() []*system.OpLock {
+	plm.RLock()
+	defer plm.RUnlock()
+
+	locks := make([]*system.OpLock, 0, len(plm.locks))
+	for _, pl := range plm.locks {
+		locks = append(locks, &system.OpLock{
+			PoolUUID: pl.poolUUID,
+			Owner:    pl.owner,
+			TakenAt:  pl.takenAt,
+		})
+	}
+
+	return locks
+}
+
 // checkLockCtx is a helper to extract the pool lock from the
 // supplied context before sending it to checkLock().
 func (plm *poolLockMap) checkLockCtx(ctx context.Context) error {
@@ -203,7 +223,7 @@ func (plm *poolLockMap) checkLock(lock *PoolLock) error {
 	// in take() should prevent this from ever happening.
 	if pl, exists := plm.locks[lock.poolUUID]; exists {
 		if lock.id != pl.id {
-			return system.FaultPoolLocked(lock.poolUUID, pl.id, pl.takenAt)
+			return system.FaultPoolLocked(lock.poolUUID, pl.id, pl.takenAt, pl.owner)
 		}
 	} else {
 		return errors.Errorf("pool %s: lock not found", lock.poolUUID)
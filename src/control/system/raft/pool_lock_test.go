@@ -20,6 +20,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 var (
@@ -207,7 +208,8 @@ func TestRaft_poolLockMap_take(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	uuid0 := uuid.MustParse(test.MockUUID(1))
 	uuid1 := uuid.MustParse(test.MockUUID(2))
-	lock0 := &PoolLock{id: uuid1, poolUUID: uuid0}
+	owner := system.LockOwner{Operation: "PoolExtend", User: "admin@host1"}
+	lock0 := &PoolLock{id: uuid1, poolUUID: uuid0, owner: owner}
 
 	for name, tc := range map[string]struct {
 		plm        *poolLockMap
@@ -218,11 +220,20 @@ func TestRaft_poolLockMap_take(t *testing.T) {
 		"already locked": {
 			plm: func() *poolLockMap {
 				plm := &poolLockMap{log: log}
-				plm.take(uuid0)
+				plm.take(uuid0, owner)
+				return plm
+			}(),
+			poolToLock: uuid0,
+			expErr:     errors.New("locked: operation PoolExtend in progress since"),
+		},
+		"already locked by unknown owner": {
+			plm: func() *poolLockMap {
+				plm := &poolLockMap{log: log}
+				plm.take(uuid0, system.LockOwner{})
 				return plm
 			}(),
 			poolToLock: uuid0,
-			expErr:     errors.New("locked"),
+			expErr:     errors.New("by user unknown"),
 		},
 		"lock taken successfully": {
 			poolToLock: uuid0,
@@ -235,13 +246,14 @@ func TestRaft_poolLockMap_take(t *testing.T) {
 			}
 			defer test.ShowBufferOnFailure(t, buf)
 
-			gotLock, err := tc.plm.take(tc.poolToLock)
+			gotLock, err := tc.plm.take(tc.poolToLock, owner)
 			test.CmpErr(t, tc.expErr, err)
 			if tc.expErr != nil {
 				return
 			}
 
 			test.AssertEqual(t, tc.expLock.poolUUID, gotLock.poolUUID, "unexpected lock")
+			test.AssertEqual(t, tc.expLock.owner, gotLock.owner, "unexpected lock owner")
 		})
 	}
 }
@@ -264,7 +276,7 @@ func TestRaft_poolLockMap_checkLock(t *testing.T) {
 		"locked, same id": {
 			plm: func() *poolLockMap {
 				plm := &poolLockMap{log: log}
-				plm.take(uuid0)
+				plm.take(uuid0, system.LockOwner{})
 				plm.locks[uuid0].id = lock0.id
 				return plm
 			}(),
@@ -273,7 +285,7 @@ func TestRaft_poolLockMap_checkLock(t *testing.T) {
 		"locked, different id": {
 			plm: func() *poolLockMap {
 				plm := &poolLockMap{log: log}
-				plm.take(uuid0)
+				plm.take(uuid0, system.LockOwner{})
 				plm.locks[uuid0].id = lock1.id
 				return plm
 			}(),
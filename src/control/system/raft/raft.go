@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -51,6 +52,10 @@ const (
 	raftOpUpdateCheckerFinding
 	raftOpRemoveCheckerFinding
 	raftOpClearCheckerFindings
+	raftOpUpdateMembers
+	raftOpAddEvents
+	raftOpSetPoolPolicy
+	raftOpRemovePoolPolicy
 
 	sysDBFile = "daos_system.db"
 )
@@ -73,6 +78,13 @@ type (
 		Member   *system.Member
 		NextRank bool
 	}
+
+	// membersUpdate provides a set of member updates to be applied in a single
+	// operation, along with any system attributes that must be updated with them.
+	membersUpdate struct {
+		Members []*system.Member
+		Attrs   map[string]string
+	}
 )
 
 func (ro raftOp) String() string {
@@ -90,6 +102,10 @@ func (ro raftOp) String() string {
 		"updateCheckerFinding",
 		"removeCheckerFinding",
 		"clearCheckerFindings",
+		"updateMembers",
+		"addEvents",
+		"setPoolPolicy",
+		"removePoolPolicy",
 	}[ro]
 }
 
@@ -458,6 +474,24 @@ func (db *Database) submitMemberUpdate(op raftOp, m *memberUpdate) error {
 	return db.submitRaftUpdate(data)
 }
 
+// submitMembersUpdate submits the given set of member and system attribute
+// updates to the raft service as a single operation.
+func (db *Database) submitMembersUpdate(members []*system.Member, attrs map[string]string) error {
+	now := time.Now()
+	for _, m := range members {
+		m.LastUpdate = now
+	}
+	data, err := createRaftUpdate(raftOpUpdateMembers, &membersUpdate{
+		Members: members,
+		Attrs:   attrs,
+	})
+	if err != nil {
+		return err
+	}
+	db.log.Debugf("%d members updated @ %s", len(members), common.FormatTime(now))
+	return db.submitRaftUpdate(data)
+}
+
 // submitPoolUpdate submits the given pool service update operation to
 // the raft service.
 func (db *Database) submitPoolUpdate(op raftOp, ps *system.PoolService) error {
@@ -489,6 +523,24 @@ func (db *Database) submitCheckerUpdate(op raftOp, f *checker.Finding) error {
 	return db.submitRaftUpdate(data)
 }
 
+// submitEventUpdate submits the given RAS event records update.
+func (db *Database) submitEventUpdate(op raftOp, recs []*EventRecord) error {
+	data, err := createRaftUpdate(op, recs)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// submitPoolPolicyUpdate submits the given pool policy update.
+func (db *Database) submitPoolPolicyUpdate(op raftOp, p *system.PoolPolicy) error {
+	data, err := createRaftUpdate(op, p)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
 // submitRaftUpdate submits the serialized operation to the raft service.
 func (db *Database) submitRaftUpdate(data []byte) error {
 	return db.raft.withReadLock(func(svc raftService) error {
@@ -542,12 +594,18 @@ func (f *fsm) Apply(l *raft.Log) interface{} {
 		f.data.applyMapVersionIncrement()
 	case raftOpAddMember, raftOpUpdateMember, raftOpRemoveMember:
 		f.data.applyMemberUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpUpdateMembers:
+		f.data.applyMembersUpdate(c.Data, f.EmergencyShutdown)
 	case raftOpAddPoolService, raftOpUpdatePoolService, raftOpRemovePoolService:
 		f.data.applyPoolUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	case raftOpUpdateSystemAttrs:
 		f.data.applySystemUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	case raftOpAddCheckerFinding, raftOpUpdateCheckerFinding, raftOpRemoveCheckerFinding, raftOpClearCheckerFindings:
 		f.data.applyCheckerUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpAddEvents:
+		f.data.applyEventUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpSetPoolPolicy, raftOpRemovePoolPolicy:
+		f.data.applyPoolPolicyUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	default:
 		f.EmergencyShutdown(errors.Errorf("unhandled Apply operation: %d", c.Op))
 		return nil
@@ -597,6 +655,29 @@ func (d *dbData) applyMemberUpdate(op raftOp, data []byte, panicFn func(error))
 	d.MapVersion++
 }
 
+// applyMembersUpdate is responsible for applying a multi-member update
+// operation to the database. The group map version is only incremented
+// once for the whole set of updates, and any system attributes in the
+// update are applied along with the members.
+func (d *dbData) applyMembersUpdate(data []byte, panicFn func(error)) {
+	mu := new(membersUpdate)
+	if err := json.Unmarshal(data, mu); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode members update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	for _, m := range mu.Members {
+		d.Members.updateMember(m)
+	}
+	if len(mu.Members) > 0 {
+		d.MapVersion++
+	}
+	d.System.updateAttributes(mu.Attrs)
+}
+
 // applyPoolUpdate is responsible for applying the pool service update
 // operation to the database.
 func (d *dbData) applyPoolUpdate(op raftOp, data []byte, panicFn func(error)) {
@@ -641,13 +722,7 @@ func (d *dbData) applySystemUpdate(op raftOp, data []byte, panicFn func(error))
 
 	switch op {
 	case raftOpUpdateSystemAttrs:
-		for k, v := range props {
-			if v == "" {
-				delete(d.System.Attributes, k)
-				continue
-			}
-			d.System.Attributes[k] = v
-		}
+		d.System.updateAttributes(props)
 	default:
 		panicFn(errors.Errorf("unhandled System Apply operation: %d", op))
 		return
@@ -696,6 +771,52 @@ func (d *dbData) applyCheckerUpdate(op raftOp, data []byte, panicFn func(error))
 	}
 }
 
+// applyEventUpdate is responsible for applying the RAS event records update
+// operation to the database.
+func (d *dbData) applyEventUpdate(op raftOp, data []byte, panicFn func(error)) {
+	var recs []*EventRecord
+	if err := json.Unmarshal(data, &recs); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode event records update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpAddEvents:
+		for _, rec := range recs {
+			d.Events.addRecord(rec)
+		}
+	default:
+		panicFn(errors.Errorf("unhandled Event Apply operation: %d", op))
+		return
+	}
+}
+
+// applyPoolPolicyUpdate is responsible for applying the pool policy update
+// operation to the database.
+func (d *dbData) applyPoolPolicyUpdate(op raftOp, data []byte, panicFn func(error)) {
+	p := new(system.PoolPolicy)
+	if err := json.Unmarshal(data, p); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode pool policy update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpSetPoolPolicy:
+		d.PoolPolicies.setPolicy(p)
+	case raftOpRemovePoolPolicy:
+		d.PoolPolicies.removePolicy(p)
+	default:
+		panicFn(errors.Errorf("unhandled Pool Policy Apply operation: %d", op))
+		return
+	}
+}
+
 // Snapshot is called to support log compaction, so that we don't have to keep
 // every log entry from the start of the system. Instead, the raft service periodically
 // creates a point-in-time snapshot which can be used to restore the current state, or
@@ -720,9 +841,8 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 		return err
 	}
 
-	if db.data.SchemaVersion != CurrentSchemaVersion {
-		return errors.Errorf("restored schema version %d != %d",
-			db.data.SchemaVersion, CurrentSchemaVersion)
+	if err := db.data.migrateSchema(); err != nil {
+		return err
 	}
 
 	f.data.Lock()
@@ -732,6 +852,8 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 	f.data.MapVersion = db.data.MapVersion
 	f.data.System = db.data.System
 	f.data.Checker = db.data.Checker
+	f.data.Events = db.data.Events
+	f.data.PoolPolicies = db.data.PoolPolicies
 	f.data.Version = db.data.Version
 	f.data.Unlock()
 	f.log.Debugf("db snapshot loaded (map version %d; data version %d)", db.data.MapVersion, db.data.Version)
@@ -8,9 +8,11 @@
 package raft
 
 import (
+	"bytes"
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
@@ -236,6 +238,32 @@ func Test_Raft_RecoverLocalReplica(t *testing.T) {
 	}
 }
 
+// migratedSnapshot returns the details expected of the given snapshot once it has
+// been restored, as the restored snapshot is written with the current database schema.
+func migratedSnapshot(t *testing.T, log logging.Logger, in *SnapshotDetails) *SnapshotDetails {
+	t.Helper()
+
+	data, err := readSnapshotData(in.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := MockDatabase(t, log)
+	if err := (*fsm)(db).Restore(io.NopCloser(bytes.NewReader(data))); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := (*fsm)(db).Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := *in
+	meta := *in.Metadata
+	meta.Size = int64(len(snap.(*fsmSnapshot).data))
+	out.Metadata = &meta
+	out.SchemaVersion = CurrentSchemaVersion
+	return &out
+}
+
 func Test_Raft_RestoreLocalReplica(t *testing.T) {
 	for name, tc := range map[string]struct {
 		setup  func(t *testing.T) (*DatabaseConfig, string)
@@ -292,6 +320,8 @@ func Test_Raft_RestoreLocalReplica(t *testing.T) {
 				t.Fatal(err)
 			}
 
+			expSnap := migratedSnapshot(t, log, preSnaps[0])
+
 			dbCfg.RaftDir = restoreDir
 			err = RestoreLocalReplica(log, dbCfg, preSnaps[0].Path)
 			test.CmpErr(t, tc.expErr, err)
@@ -311,7 +341,7 @@ func Test_Raft_RestoreLocalReplica(t *testing.T) {
 					return x.String() == y.String()
 				}),
 			}
-			if diff := cmp.Diff(preSnaps[0], postSnap, cmpOpts...); diff != "" {
+			if diff := cmp.Diff(expSnap, postSnap, cmpOpts...); diff != "" {
 				t.Fatalf("expected post-restore snapshot info to be the same (-want +got):\n%s", diff)
 			}
 		})
@@ -0,0 +1,96 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"sync"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// SystemLock represents a lock on system-wide operations,
+	// e.g. starting or stopping the system. Only one such
+	// operation may be in progress at a time. Unlike pool
+	// locks, system locks are not reentrant.
+	SystemLock struct {
+		owner   system.LockOwner
+		takenAt time.Time
+		relOnce sync.Once
+		release func()
+	}
+
+	// systemLockHolder holds the system lock while it is taken.
+	// NB: Like the pool lock map, this structure is not backed
+	// by raft, and is intended to be local to the current MS
+	// leader.
+	systemLockHolder struct {
+		sync.Mutex
+		lock *SystemLock
+		log  logging.DebugLogger
+	}
+)
+
+// Release releases the system lock. It is safe to call
+// Release() more than once.
+func (sl *SystemLock) Release() {
+	sl.relOnce.Do(sl.release)
+}
+
+// take returns the system lock if it is not already taken,
+// otherwise it returns an error identifying the current owner
+// of the lock.
+func (slh *systemLockHolder) take(owner system.LockOwner) (*SystemLock, error) {
+	slh.Lock()
+	defer slh.Unlock()
+
+	if slh.lock != nil {
+		return nil, system.FaultSystemLocked(slh.lock.takenAt, slh.lock.owner)
+	}
+
+	lock := &SystemLock{
+		owner:   owner,
+		takenAt: time.Now(),
+	}
+	lock.release = func() { slh.release(lock) }
+	slh.lock = lock
+
+	slh.log.Debugf("system lock taken (operation: %s)", owner.Operation)
+	return lock, nil
+}
+
+// release releases the system lock if it is still held by the
+// supplied lock.
+func (slh *systemLockHolder) release(lock *SystemLock) {
+	slh.Lock()
+	defer slh.Unlock()
+
+	if slh.lock != lock {
+		return
+	}
+	slh.log.Debugf("system lock released (operation: %s)", lock.owner.Operation)
+	slh.lock = nil
+}
+
+// list returns a description of the system lock, if it is held.
+func (slh *systemLockHolder) list() []*system.OpLock {
+	slh.Lock()
+	defer slh.Unlock()
+
+	if slh.lock == nil {
+		return nil
+	}
+
+	return []*system.OpLock{
+		{
+			Owner:   slh.lock.owner,
+			TakenAt: slh.lock.takenAt,
+		},
+	}
+}
@@ -0,0 +1,54 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestRaft_systemLockHolder(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	slh := &systemLockHolder{log: log}
+	stopOwner := system.LockOwner{Operation: "SystemStop", User: "admin@host1"}
+
+	lock, err := slh.take(stopOwner)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = slh.take(system.LockOwner{Operation: "SystemStart"})
+	test.CmpErr(t, errors.New("operation SystemStop in progress since"), err)
+	test.CmpErr(t, errors.New("by user admin@host1"), err)
+
+	locks := slh.list()
+	test.AssertEqual(t, 1, len(locks), "unexpected number of locks")
+	test.AssertEqual(t, stopOwner, locks[0].Owner, "unexpected lock owner")
+
+	lock.Release()
+	test.AssertEqual(t, 0, len(slh.list()), "lock not released")
+
+	newLock, err := slh.take(system.LockOwner{Operation: "SystemStart"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Releasing a stale lock must not release the new one.
+	lock.Release()
+	slh.release(lock)
+	test.AssertEqual(t, 1, len(slh.list()), "new lock released by stale lock")
+
+	newLock.Release()
+	test.AssertEqual(t, 0, len(slh.list()), "lock not released")
+}
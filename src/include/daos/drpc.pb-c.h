@@ -59,6 +59,33 @@ typedef enum _Drpc__Status {
   DRPC__STATUS__FAILED_MARSHAL = 7
     PROTOBUF_C__FORCE_ENUM_TO_BE_INT_SIZE(DRPC__STATUS)
 } Drpc__Status;
+/*
+ * ErrorClass classifies a failure reported by a dRPC call, so that the caller may decide whether
+ * to retry the call.
+ */
+typedef enum _Drpc__ErrorClass {
+  /*
+   * The call succeeded, or the failure was not classified.
+   */
+  DRPC__ERROR_CLASS__NONE = 0,
+  /*
+   * The failure is temporary, and the call may succeed if retried.
+   */
+  DRPC__ERROR_CLASS__TRANSIENT = 1,
+  /*
+   * The failure is due to the configuration, and retrying will not help until it is fixed.
+   */
+  DRPC__ERROR_CLASS__CONFIG = 2,
+  /*
+   * The caller could not be authenticated or is not authorized.
+   */
+  DRPC__ERROR_CLASS__AUTH = 3,
+  /*
+   * The failure is permanent, and the call should not be retried.
+   */
+  DRPC__ERROR_CLASS__FATAL = 4
+    PROTOBUF_C__FORCE_ENUM_TO_BE_INT_SIZE(DRPC__ERROR_CLASS)
+} Drpc__ErrorClass;
 
 /* --- messages --- */
 
@@ -108,10 +135,18 @@ struct  _Drpc__Response
    * Output payload produced by the method.
    */
   ProtobufCBinaryData body;
+  /*
+   * Classification of the failure reported by the status or body, if any.
+   */
+  Drpc__ErrorClass error_class;
+  /*
+   * Suggested delay before retrying a TRANSIENT failure, if known.
+   */
+  uint32_t retry_after_ms;
 };
 #define DRPC__RESPONSE__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&drpc__response__descriptor) \
-    , 0, DRPC__STATUS__SUCCESS, {0,NULL} }
+    , 0, DRPC__STATUS__SUCCESS, {0,NULL}, DRPC__ERROR_CLASS__NONE, 0 }
 
 
 /* Drpc__Call methods */
@@ -167,6 +202,7 @@ typedef void (*Drpc__Response_Closure)
 /* --- descriptors --- */
 
 extern const ProtobufCEnumDescriptor    drpc__status__descriptor;
+extern const ProtobufCEnumDescriptor    drpc__error_class__descriptor;
 extern const ProtobufCMessageDescriptor drpc__call__descriptor;
 extern const ProtobufCMessageDescriptor drpc__response__descriptor;
 
@@ -75,6 +75,14 @@ enum drpc_mgmt_method {
 	DRPC_METHOD_MGMT_CHK_PROP               = 245,
 	DRPC_METHOD_MGMT_CHK_ACT                = 246,
 	DRPC_METHOD_MGMT_SETUP_CLIENT_TELEM     = 247,
+	DRPC_METHOD_MGMT_CONT_CREATE            = 248,
+	DRPC_METHOD_MGMT_CONT_DESTROY           = 249,
+	DRPC_METHOD_MGMT_CONT_QUERY             = 250,
+	DRPC_METHOD_MGMT_POOL_REBALANCE         = 251,
+	DRPC_METHOD_MGMT_POOL_LIST_HANDLES      = 252,
+	DRPC_METHOD_MGMT_LIST_CLIENTS           = 253,
+	DRPC_METHOD_MGMT_QUERY_CLIENT_TELEM     = 254,
+	DRPC_METHOD_MGMT_FAULT_INJECT           = 255,
 
 	NUM_DRPC_MGMT_METHODS /* Must be last */
 };
@@ -63,7 +63,9 @@
 #define DAOS_PO_QUERY_PROP_REINT_MODE		(1ULL << (PROP_BIT_START + 24))
 #define DAOS_PO_QUERY_PROP_SVC_OPS_ENABLED      (1ULL << (PROP_BIT_START + 25))
 #define DAOS_PO_QUERY_PROP_SVC_OPS_ENTRY_AGE    (1ULL << (PROP_BIT_START + 26))
-#define DAOS_PO_QUERY_PROP_BIT_END              42
+#define DAOS_PO_QUERY_PROP_BW_LIMIT             (1ULL << (PROP_BIT_START + 27))
+#define DAOS_PO_QUERY_PROP_IOPS_LIMIT           (1ULL << (PROP_BIT_START + 28))
+#define DAOS_PO_QUERY_PROP_BIT_END              44
 
 #define DAOS_PO_QUERY_PROP_ALL                                                                     \
 	(DAOS_PO_QUERY_PROP_LABEL | DAOS_PO_QUERY_PROP_SPACE_RB | DAOS_PO_QUERY_PROP_SELF_HEAL |   \
@@ -77,15 +79,17 @@
 	 DAOS_PO_QUERY_PROP_OBJ_VERSION | DAOS_PO_QUERY_PROP_PERF_DOMAIN |                         \
 	 DAOS_PO_QUERY_PROP_CHECKPOINT_MODE | DAOS_PO_QUERY_PROP_CHECKPOINT_FREQ |                 \
 	 DAOS_PO_QUERY_PROP_CHECKPOINT_THRESH | DAOS_PO_QUERY_PROP_REINT_MODE |                    \
-	 DAOS_PO_QUERY_PROP_SVC_OPS_ENABLED | DAOS_PO_QUERY_PROP_SVC_OPS_ENTRY_AGE)
+	 DAOS_PO_QUERY_PROP_SVC_OPS_ENABLED | DAOS_PO_QUERY_PROP_SVC_OPS_ENTRY_AGE |               \
+	 DAOS_PO_QUERY_PROP_BW_LIMIT | DAOS_PO_QUERY_PROP_IOPS_LIMIT)
 
 /*
  * Version 1 corresponds to 2.2 (aggregation optimizations)
  * Version 2 corresponds to 2.4 (dynamic evtree, checksum scrubbing)
  * Version 3 corresponds to 2.6 (root embedded values, pool service operations tracking KVS)
  * Version 4 corresponds to 2.8 (SV gang allocation, server pool/cont hdls)
+ * Version 5 corresponds to 2.8 (pool handle connect times)
  */
-#define DAOS_POOL_GLOBAL_VERSION 4
+#define DAOS_POOL_GLOBAL_VERSION 5
 
 int dc_pool_init(void);
 void dc_pool_fini(void);
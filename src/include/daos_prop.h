@@ -140,6 +140,16 @@ enum daos_pool_props {
 	DAOS_PROP_PO_SVC_OPS_ENABLED,
 	/** Metadata duplicate operations SVC_OPS KVS max entry age (seconds), default 300 */
 	DAOS_PROP_PO_SVC_OPS_ENTRY_AGE,
+	/**
+	 * Maximum aggregate bandwidth of the pool in bytes per second, 0 (default) for no limit.
+	 * Advisory only: distributed to the engines as a QoS hint, but not enforced.
+	 */
+	DAOS_PROP_PO_BW_LIMIT,
+	/**
+	 * Maximum aggregate I/O operations per second of the pool, 0 (default) for no limit.
+	 * Advisory only: distributed to the engines as a QoS hint, but not enforced.
+	 */
+	DAOS_PROP_PO_IOPS_LIMIT,
 	DAOS_PROP_PO_MAX,
 };
 
@@ -260,6 +270,10 @@ enum {
 #define DAOS_PROP_PO_SVC_OPS_ENTRY_AGE_DEFAULT 300       /* 300 seconds */
 #define DAOS_PROP_PO_SVC_OPS_ENTRY_AGE_MIN     60        /* 60 seconds */
 #define DAOS_PROP_PO_SVC_OPS_ENTRY_AGE_MAX     600       /* 600 seconds */
+#define DAOS_PROP_PO_BW_LIMIT_DEFAULT          0         /* no limit */
+#define DAOS_PROP_PO_BW_LIMIT_MIN              (1 << 20) /* 1 MiB/s */
+#define DAOS_PROP_PO_IOPS_LIMIT_DEFAULT        0         /* no limit */
+#define DAOS_PROP_PO_IOPS_LIMIT_MIN            100       /* 100 IOPS */
 
 /** self healing strategy bits */
 #define DAOS_SELF_HEAL_AUTO_EXCLUDE	(1U << 0)
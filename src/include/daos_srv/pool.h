@@ -126,6 +126,9 @@ struct ds_pool {
 	uint32_t                 sp_checkpoint_freq;
 	uint32_t                 sp_checkpoint_thresh;
 	uint32_t		 sp_reint_mode;
+	/** Advisory QoS hints, not enforced: maximum aggregate bandwidth (bytes/s) and IOPS */
+	uint64_t                 sp_bw_limit;
+	uint64_t                 sp_iops_limit;
 };
 
 int ds_pool_lookup(const uuid_t uuid, struct ds_pool **pool);
@@ -329,6 +332,7 @@ int dsc_pool_svc_query_target(uuid_t pool_uuid, d_rank_list_t *ps_ranks, uint64_
 int ds_pool_prop_fetch(struct ds_pool *pool, unsigned int bit,
 		       daos_prop_t **prop_out);
 int dsc_pool_svc_upgrade(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline);
+int dsc_pool_svc_rebalance(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline);
 int ds_pool_failed_add(uuid_t uuid, int rc);
 void ds_pool_failed_remove(uuid_t uuid);
 int ds_pool_failed_lookup(uuid_t uuid);
@@ -391,6 +395,19 @@ int dsc_pool_svc_check_evict(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t de
 			     uuid_t *handles, size_t n_handles, uint32_t destroy, uint32_t force,
 			     char *machine, uint32_t *count);
 
+/** Open pool handle, as listed by dsc_pool_svc_list_hdls(). */
+struct ds_pool_hdl_info {
+	uuid_t	 phi_uuid;
+	char	*phi_machine;	/* NULL if not recorded (2.0 handle) */
+	char	*phi_user;	/* NULL if not recorded */
+	char	*phi_group;	/* NULL if not recorded */
+	uint64_t phi_connect_time; /* seconds since the Unix epoch, 0 if not recorded */
+};
+
+int dsc_pool_svc_list_hdls(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline,
+			   struct ds_pool_hdl_info **hdls, uint32_t *n_hdls);
+void ds_pool_hdl_info_free(struct ds_pool_hdl_info *hdls, uint32_t n_hdls);
+
 int ds_pool_target_status(struct ds_pool *pool, uint32_t id);
 int ds_pool_target_status_check(struct ds_pool *pool, uint32_t id,
 				uint8_t matched_status, struct pool_target **p_tgt);
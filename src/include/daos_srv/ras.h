@@ -63,7 +63,10 @@
 	X(RAS_SYSTEM_FABRIC_PROV_CHANGED, "system_fabric_provider_changed")                        \
 	X(RAS_ENGINE_JOIN_FAILED, "engine_join_failed")                                            \
 	X(RAS_DEVICE_LINK_SPEED_CHANGED, "device_link_speed_changed")                              \
-	X(RAS_DEVICE_LINK_WIDTH_CHANGED, "device_link_width_changed")
+	X(RAS_DEVICE_LINK_WIDTH_CHANGED, "device_link_width_changed")                              \
+	X(RAS_FABRIC_IFACE_DOWN, "fabric_interface_down")                                          \
+	X(RAS_POOL_MEMBERSHIP_CHANGED, "pool_membership_changed")                                  \
+	X(RAS_SYSTEM_MEMBERSHIP_CHANGED, "system_membership_changed")
 
 /** Define RAS event enum */
 typedef enum {
@@ -51,6 +51,22 @@ ds_sec_alloc_default_daos_cont_acl(void);
 int
 ds_sec_cred_get_origin(d_iov_t *cred, char **machine);
 
+/**
+ * Get the user and group principal names from a credential that has already
+ * been validated, e.g. one stored with a pool handle at connect time.
+ *
+ * \param[in]	cred		Validated security credential
+ * \param[out]	user		User principal name. Caller frees with D_FREE().
+ * \param[out]	group		Group principal name. Caller frees with D_FREE().
+ *
+ * \return	0		Success
+ *		-DER_INVAL	Invalid input
+ *		-DER_NOMEM	Out of memory
+ *		-DER_PROTO	Unexpected or corrupt credential
+ */
+int
+ds_sec_cred_get_principals(d_iov_t *cred, char **user, char **group);
+
 /**
  * Derive the pool security capabilities for the given user credential, using
  * the pool ownership information, pool ACL, and requested flags.
@@ -335,6 +351,15 @@ ds_sec_get_rebuild_cont_capabilities(void);
 uint64_t
 ds_sec_get_admin_cont_capabilities(void);
 
+/**
+ * Get the security capabilities for a pool handle that can perform
+ * administrative tasks.
+ *
+ * @return	Bits representing security capabilities
+ */
+uint64_t
+ds_sec_get_admin_pool_capabilities(void);
+
 /**
  * Return a positive integer if \a cred_x and \a cred_y are of the same user,
  * return 0 if they are not, or return an error.
@@ -227,6 +227,10 @@ fill_sys_info(Mgmt__GetAttachInfoResp *resp, struct dc_mgmt_sys_info *info)
 		info->provider, info->interface, info->domain,
 		info->crt_timeout, info->srv_srx_set, info->provider_idx);
 
+	if (hint->cpuset_cpus != NULL && strlen(hint->cpuset_cpus) > 0)
+		D_DEBUG(DB_MGMT, "GetAttachInfo recommended CPU binding: cpus %s, mems %s\n",
+			hint->cpuset_cpus, hint->cpuset_mems);
+
 	return 0;
 }
 
@@ -52,6 +52,231 @@ void   mgmt__cont_set_owner_req__free_unpacked
   assert(message->base.descriptor == &mgmt__cont_set_owner_req__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__cont_create_req__init
+                     (Mgmt__ContCreateReq         *message)
+{
+  static const Mgmt__ContCreateReq init_value = MGMT__CONT_CREATE_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_create_req__get_packed_size
+                     (const Mgmt__ContCreateReq *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_create_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_create_req__pack
+                     (const Mgmt__ContCreateReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_create_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_create_req__pack_to_buffer
+                     (const Mgmt__ContCreateReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_create_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContCreateReq *
+       mgmt__cont_create_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContCreateReq *)
+     protobuf_c_message_unpack (&mgmt__cont_create_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_create_req__free_unpacked
+                     (Mgmt__ContCreateReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_create_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_create_resp__init
+                     (Mgmt__ContCreateResp         *message)
+{
+  static const Mgmt__ContCreateResp init_value = MGMT__CONT_CREATE_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_create_resp__get_packed_size
+                     (const Mgmt__ContCreateResp *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_create_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_create_resp__pack
+                     (const Mgmt__ContCreateResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_create_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_create_resp__pack_to_buffer
+                     (const Mgmt__ContCreateResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_create_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContCreateResp *
+       mgmt__cont_create_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContCreateResp *)
+     protobuf_c_message_unpack (&mgmt__cont_create_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_create_resp__free_unpacked
+                     (Mgmt__ContCreateResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_create_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_destroy_req__init
+                     (Mgmt__ContDestroyReq         *message)
+{
+  static const Mgmt__ContDestroyReq init_value = MGMT__CONT_DESTROY_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_destroy_req__get_packed_size
+                     (const Mgmt__ContDestroyReq *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_destroy_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_destroy_req__pack
+                     (const Mgmt__ContDestroyReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_destroy_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_destroy_req__pack_to_buffer
+                     (const Mgmt__ContDestroyReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_destroy_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContDestroyReq *
+       mgmt__cont_destroy_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContDestroyReq *)
+     protobuf_c_message_unpack (&mgmt__cont_destroy_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_destroy_req__free_unpacked
+                     (Mgmt__ContDestroyReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_destroy_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_query_req__init
+                     (Mgmt__ContQueryReq         *message)
+{
+  static const Mgmt__ContQueryReq init_value = MGMT__CONT_QUERY_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_query_req__get_packed_size
+                     (const Mgmt__ContQueryReq *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_query_req__pack
+                     (const Mgmt__ContQueryReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_query_req__pack_to_buffer
+                     (const Mgmt__ContQueryReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContQueryReq *
+       mgmt__cont_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContQueryReq *)
+     protobuf_c_message_unpack (&mgmt__cont_query_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_query_req__free_unpacked
+                     (Mgmt__ContQueryReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_query_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__cont_query_resp__init
+                     (Mgmt__ContQueryResp         *message)
+{
+  static const Mgmt__ContQueryResp init_value = MGMT__CONT_QUERY_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__cont_query_resp__get_packed_size
+                     (const Mgmt__ContQueryResp *message)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__cont_query_resp__pack
+                     (const Mgmt__ContQueryResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__cont_query_resp__pack_to_buffer
+                     (const Mgmt__ContQueryResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__cont_query_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ContQueryResp *
+       mgmt__cont_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ContQueryResp *)
+     protobuf_c_message_unpack (&mgmt__cont_query_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__cont_query_resp__free_unpacked
+                     (Mgmt__ContQueryResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__cont_query_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 static const ProtobufCFieldDescriptor mgmt__cont_set_owner_req__field_descriptors[6] =
 {
   {
@@ -155,3 +380,453 @@ const ProtobufCMessageDescriptor mgmt__cont_set_owner_req__descriptor =
   (ProtobufCMessageInit) mgmt__cont_set_owner_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__cont_create_req__field_descriptors[6] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCreateReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "pool_id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCreateReq, pool_id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "label",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCreateReq, label),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "owner_user",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCreateReq, owner_user),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "owner_group",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCreateReq, owner_group),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    6,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ContCreateReq, n_svc_ranks),
+    offsetof(Mgmt__ContCreateReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_create_req__field_indices_by_name[] = {
+  2,   /* field[2] = label */
+  4,   /* field[4] = owner_group */
+  3,   /* field[3] = owner_user */
+  1,   /* field[1] = pool_id */
+  5,   /* field[5] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__cont_create_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 6 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_create_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContCreateReq",
+  "ContCreateReq",
+  "Mgmt__ContCreateReq",
+  "mgmt",
+  sizeof(Mgmt__ContCreateReq),
+  6,
+  mgmt__cont_create_req__field_descriptors,
+  mgmt__cont_create_req__field_indices_by_name,
+  1,  mgmt__cont_create_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_create_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_create_resp__field_descriptors[2] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCreateResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "cont_uuid",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContCreateResp, cont_uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_create_resp__field_indices_by_name[] = {
+  1,   /* field[1] = cont_uuid */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__cont_create_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_create_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContCreateResp",
+  "ContCreateResp",
+  "Mgmt__ContCreateResp",
+  "mgmt",
+  sizeof(Mgmt__ContCreateResp),
+  2,
+  mgmt__cont_create_resp__field_descriptors,
+  mgmt__cont_create_resp__field_indices_by_name,
+  1,  mgmt__cont_create_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_create_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_destroy_req__field_descriptors[5] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContDestroyReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "cont_id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContDestroyReq, cont_id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "pool_id",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContDestroyReq, pool_id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "force",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContDestroyReq, force),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    5,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ContDestroyReq, n_svc_ranks),
+    offsetof(Mgmt__ContDestroyReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_destroy_req__field_indices_by_name[] = {
+  1,   /* field[1] = cont_id */
+  3,   /* field[3] = force */
+  2,   /* field[2] = pool_id */
+  4,   /* field[4] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__cont_destroy_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_destroy_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContDestroyReq",
+  "ContDestroyReq",
+  "Mgmt__ContDestroyReq",
+  "mgmt",
+  sizeof(Mgmt__ContDestroyReq),
+  5,
+  mgmt__cont_destroy_req__field_descriptors,
+  mgmt__cont_destroy_req__field_indices_by_name,
+  1,  mgmt__cont_destroy_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_destroy_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_query_req__field_descriptors[4] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "cont_id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryReq, cont_id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "pool_id",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryReq, pool_id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    4,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ContQueryReq, n_svc_ranks),
+    offsetof(Mgmt__ContQueryReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_query_req__field_indices_by_name[] = {
+  1,   /* field[1] = cont_id */
+  2,   /* field[2] = pool_id */
+  3,   /* field[3] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__cont_query_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_query_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContQueryReq",
+  "ContQueryReq",
+  "Mgmt__ContQueryReq",
+  "mgmt",
+  sizeof(Mgmt__ContQueryReq),
+  4,
+  mgmt__cont_query_req__field_descriptors,
+  mgmt__cont_query_req__field_indices_by_name,
+  1,  mgmt__cont_query_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_query_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__cont_query_resp__field_descriptors[8] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "uuid",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "label",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, label),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "owner_user",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, owner_user),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "owner_group",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, owner_group),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "layout_type",
+    6,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, layout_type),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "num_handles",
+    7,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, num_handles),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "num_snapshots",
+    8,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContQueryResp, num_snapshots),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__cont_query_resp__field_indices_by_name[] = {
+  2,   /* field[2] = label */
+  5,   /* field[5] = layout_type */
+  6,   /* field[6] = num_handles */
+  7,   /* field[7] = num_snapshots */
+  4,   /* field[4] = owner_group */
+  3,   /* field[3] = owner_user */
+  0,   /* field[0] = status */
+  1,   /* field[1] = uuid */
+};
+static const ProtobufCIntRange mgmt__cont_query_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 8 }
+};
+const ProtobufCMessageDescriptor mgmt__cont_query_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ContQueryResp",
+  "ContQueryResp",
+  "Mgmt__ContQueryResp",
+  "mgmt",
+  sizeof(Mgmt__ContQueryResp),
+  8,
+  mgmt__cont_query_resp__field_descriptors,
+  mgmt__cont_query_resp__field_indices_by_name,
+  1,  mgmt__cont_query_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__cont_query_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
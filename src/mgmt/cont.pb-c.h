@@ -16,6 +16,11 @@ PROTOBUF_C__BEGIN_DECLS
 
 
 typedef struct _Mgmt__ContSetOwnerReq Mgmt__ContSetOwnerReq;
+typedef struct _Mgmt__ContCreateReq Mgmt__ContCreateReq;
+typedef struct _Mgmt__ContCreateResp Mgmt__ContCreateResp;
+typedef struct _Mgmt__ContDestroyReq Mgmt__ContDestroyReq;
+typedef struct _Mgmt__ContQueryReq Mgmt__ContQueryReq;
+typedef struct _Mgmt__ContQueryResp Mgmt__ContQueryResp;
 
 
 /* --- enums --- */
@@ -60,6 +65,169 @@ struct  _Mgmt__ContSetOwnerReq
     , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
 
 
+/*
+ * ContCreateReq creates a container in a pool.
+ */
+struct  _Mgmt__ContCreateReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * UUID or label of the pool to create the container in
+   */
+  char *pool_id;
+  /*
+   * Optional label for the container
+   */
+  char *label;
+  /*
+   * formatted user e.g. "bob@", or empty for the default
+   */
+  char *owner_user;
+  /*
+   * formatted group e.g. "builders@", or empty for the default
+   */
+  char *owner_group;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__CONT_CREATE_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_create_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
+
+
+/*
+ * ContCreateResp returns the UUID of the created container.
+ */
+struct  _Mgmt__ContCreateResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * UUID of the created container
+   */
+  char *cont_uuid;
+};
+#define MGMT__CONT_CREATE_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_create_resp__descriptor) \
+    , 0, (char *)protobuf_c_empty_string }
+
+
+/*
+ * ContDestroyReq destroys a container.
+ */
+struct  _Mgmt__ContDestroyReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * UUID or label of the container
+   */
+  char *cont_id;
+  /*
+   * UUID or label of the pool that the container is in
+   */
+  char *pool_id;
+  /*
+   * destroy the container even if it has open handles
+   */
+  protobuf_c_boolean force;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__CONT_DESTROY_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_destroy_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0,NULL }
+
+
+/*
+ * ContQueryReq queries the details of a container.
+ */
+struct  _Mgmt__ContQueryReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * UUID or label of the container
+   */
+  char *cont_id;
+  /*
+   * UUID or label of the pool that the container is in
+   */
+  char *pool_id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__CONT_QUERY_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_query_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
+
+
+/*
+ * ContQueryResp returns the details of a container.
+ */
+struct  _Mgmt__ContQueryResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * UUID of the container
+   */
+  char *uuid;
+  /*
+   * label of the container
+   */
+  char *label;
+  /*
+   * formatted owner user
+   */
+  char *owner_user;
+  /*
+   * formatted owner group
+   */
+  char *owner_group;
+  /*
+   * container layout type, e.g. "POSIX"
+   */
+  char *layout_type;
+  /*
+   * number of open container handles
+   */
+  uint32_t num_handles;
+  /*
+   * number of container snapshots
+   */
+  uint32_t num_snapshots;
+};
+#define MGMT__CONT_QUERY_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_query_resp__descriptor) \
+    , 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0 }
+
+
 /* Mgmt__ContSetOwnerReq methods */
 void   mgmt__cont_set_owner_req__init
                      (Mgmt__ContSetOwnerReq         *message);
@@ -79,11 +247,121 @@ Mgmt__ContSetOwnerReq *
 void   mgmt__cont_set_owner_req__free_unpacked
                      (Mgmt__ContSetOwnerReq *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__ContCreateReq methods */
+void   mgmt__cont_create_req__init
+                     (Mgmt__ContCreateReq         *message);
+size_t mgmt__cont_create_req__get_packed_size
+                     (const Mgmt__ContCreateReq   *message);
+size_t mgmt__cont_create_req__pack
+                     (const Mgmt__ContCreateReq   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_create_req__pack_to_buffer
+                     (const Mgmt__ContCreateReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContCreateReq *
+       mgmt__cont_create_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_create_req__free_unpacked
+                     (Mgmt__ContCreateReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContCreateResp methods */
+void   mgmt__cont_create_resp__init
+                     (Mgmt__ContCreateResp         *message);
+size_t mgmt__cont_create_resp__get_packed_size
+                     (const Mgmt__ContCreateResp   *message);
+size_t mgmt__cont_create_resp__pack
+                     (const Mgmt__ContCreateResp   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_create_resp__pack_to_buffer
+                     (const Mgmt__ContCreateResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContCreateResp *
+       mgmt__cont_create_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_create_resp__free_unpacked
+                     (Mgmt__ContCreateResp *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContDestroyReq methods */
+void   mgmt__cont_destroy_req__init
+                     (Mgmt__ContDestroyReq         *message);
+size_t mgmt__cont_destroy_req__get_packed_size
+                     (const Mgmt__ContDestroyReq   *message);
+size_t mgmt__cont_destroy_req__pack
+                     (const Mgmt__ContDestroyReq   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_destroy_req__pack_to_buffer
+                     (const Mgmt__ContDestroyReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContDestroyReq *
+       mgmt__cont_destroy_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_destroy_req__free_unpacked
+                     (Mgmt__ContDestroyReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContQueryReq methods */
+void   mgmt__cont_query_req__init
+                     (Mgmt__ContQueryReq         *message);
+size_t mgmt__cont_query_req__get_packed_size
+                     (const Mgmt__ContQueryReq   *message);
+size_t mgmt__cont_query_req__pack
+                     (const Mgmt__ContQueryReq   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_query_req__pack_to_buffer
+                     (const Mgmt__ContQueryReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContQueryReq *
+       mgmt__cont_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_query_req__free_unpacked
+                     (Mgmt__ContQueryReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ContQueryResp methods */
+void   mgmt__cont_query_resp__init
+                     (Mgmt__ContQueryResp         *message);
+size_t mgmt__cont_query_resp__get_packed_size
+                     (const Mgmt__ContQueryResp   *message);
+size_t mgmt__cont_query_resp__pack
+                     (const Mgmt__ContQueryResp   *message,
+                      uint8_t             *out);
+size_t mgmt__cont_query_resp__pack_to_buffer
+                     (const Mgmt__ContQueryResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ContQueryResp *
+       mgmt__cont_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__cont_query_resp__free_unpacked
+                     (Mgmt__ContQueryResp *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Mgmt__ContSetOwnerReq_Closure)
                  (const Mgmt__ContSetOwnerReq *message,
                   void *closure_data);
+typedef void (*Mgmt__ContCreateReq_Closure)
+                 (const Mgmt__ContCreateReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContCreateResp_Closure)
+                 (const Mgmt__ContCreateResp *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContDestroyReq_Closure)
+                 (const Mgmt__ContDestroyReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContQueryReq_Closure)
+                 (const Mgmt__ContQueryReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__ContQueryResp_Closure)
+                 (const Mgmt__ContQueryResp *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -91,6 +369,11 @@ typedef void (*Mgmt__ContSetOwnerReq_Closure)
 /* --- descriptors --- */
 
 extern const ProtobufCMessageDescriptor mgmt__cont_set_owner_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_create_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_create_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_destroy_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_query_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__cont_query_resp__descriptor;
 
 PROTOBUF_C__END_DECLS
 
@@ -62,6 +62,9 @@ ds_mgmt_drpc_pool_overwrite_acl(Drpc__Call *drpc_req,
 void
 ds_mgmt_drpc_pool_upgrade(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
+void
+ds_mgmt_drpc_pool_rebalance(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
+
 void
 ds_mgmt_drpc_pool_update_acl(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
@@ -98,9 +101,21 @@ ds_mgmt_drpc_set_up(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 void
 ds_mgmt_drpc_pool_list_cont(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
+void
+ds_mgmt_drpc_pool_list_handles(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
+
 void
 ds_mgmt_drpc_cont_set_owner(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
+void
+ds_mgmt_drpc_cont_create(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
+
+void
+ds_mgmt_drpc_cont_destroy(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
+
+void
+ds_mgmt_drpc_cont_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
+
 void
 ds_mgmt_drpc_group_update(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
@@ -119,4 +134,7 @@ ds_mgmt_drpc_check_prop(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 void
 ds_mgmt_drpc_check_act(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
 
+void
+ds_mgmt_drpc_fault_inject(Drpc__Call *drpc_req, Drpc__Response *drpc_resp);
+
 #endif /* __MGMT_DRPC_INTERNAL_H__ */
@@ -1324,6 +1324,231 @@ void   mgmt__pool_upgrade_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_upgrade_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__pool_rebalance_req__init
+                     (Mgmt__PoolRebalanceReq         *message)
+{
+  static const Mgmt__PoolRebalanceReq init_value = MGMT__POOL_REBALANCE_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_rebalance_req__get_packed_size
+                     (const Mgmt__PoolRebalanceReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_rebalance_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_rebalance_req__pack
+                     (const Mgmt__PoolRebalanceReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_rebalance_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_rebalance_req__pack_to_buffer
+                     (const Mgmt__PoolRebalanceReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_rebalance_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolRebalanceReq *
+       mgmt__pool_rebalance_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolRebalanceReq *)
+     protobuf_c_message_unpack (&mgmt__pool_rebalance_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_rebalance_req__free_unpacked
+                     (Mgmt__PoolRebalanceReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_rebalance_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_rebalance_resp__init
+                     (Mgmt__PoolRebalanceResp         *message)
+{
+  static const Mgmt__PoolRebalanceResp init_value = MGMT__POOL_REBALANCE_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_rebalance_resp__get_packed_size
+                     (const Mgmt__PoolRebalanceResp *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_rebalance_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_rebalance_resp__pack
+                     (const Mgmt__PoolRebalanceResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_rebalance_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_rebalance_resp__pack_to_buffer
+                     (const Mgmt__PoolRebalanceResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_rebalance_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolRebalanceResp *
+       mgmt__pool_rebalance_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolRebalanceResp *)
+     protobuf_c_message_unpack (&mgmt__pool_rebalance_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_rebalance_resp__free_unpacked
+                     (Mgmt__PoolRebalanceResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_rebalance_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_list_handles_req__init
+                     (Mgmt__PoolListHandlesReq         *message)
+{
+  static const Mgmt__PoolListHandlesReq init_value = MGMT__POOL_LIST_HANDLES_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_list_handles_req__get_packed_size
+                     (const Mgmt__PoolListHandlesReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_list_handles_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_list_handles_req__pack
+                     (const Mgmt__PoolListHandlesReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_list_handles_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_list_handles_req__pack_to_buffer
+                     (const Mgmt__PoolListHandlesReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_list_handles_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolListHandlesReq *
+       mgmt__pool_list_handles_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolListHandlesReq *)
+     protobuf_c_message_unpack (&mgmt__pool_list_handles_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_list_handles_req__free_unpacked
+                     (Mgmt__PoolListHandlesReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_list_handles_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_handle__init
+                     (Mgmt__PoolHandle         *message)
+{
+  static const Mgmt__PoolHandle init_value = MGMT__POOL_HANDLE__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_handle__get_packed_size
+                     (const Mgmt__PoolHandle *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_handle__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_handle__pack
+                     (const Mgmt__PoolHandle *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_handle__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_handle__pack_to_buffer
+                     (const Mgmt__PoolHandle *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_handle__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolHandle *
+       mgmt__pool_handle__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolHandle *)
+     protobuf_c_message_unpack (&mgmt__pool_handle__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_handle__free_unpacked
+                     (Mgmt__PoolHandle *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_handle__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_list_handles_resp__init
+                     (Mgmt__PoolListHandlesResp         *message)
+{
+  static const Mgmt__PoolListHandlesResp init_value = MGMT__POOL_LIST_HANDLES_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_list_handles_resp__get_packed_size
+                     (const Mgmt__PoolListHandlesResp *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_list_handles_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_list_handles_resp__pack
+                     (const Mgmt__PoolListHandlesResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_list_handles_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_list_handles_resp__pack_to_buffer
+                     (const Mgmt__PoolListHandlesResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_list_handles_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolListHandlesResp *
+       mgmt__pool_list_handles_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolListHandlesResp *)
+     protobuf_c_message_unpack (&mgmt__pool_list_handles_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_list_handles_resp__free_unpacked
+                     (Mgmt__PoolListHandlesResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_list_handles_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   mgmt__pool_query_target_req__init
                      (Mgmt__PoolQueryTargetReq         *message)
 {
@@ -2968,7 +3193,7 @@ const ProtobufCMessageDescriptor mgmt__list_cont_req__descriptor =
   (ProtobufCMessageInit) mgmt__list_cont_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__list_cont_resp__cont__field_descriptors[1] =
+static const ProtobufCFieldDescriptor mgmt__list_cont_resp__cont__field_descriptors[2] =
 {
   {
     "uuid",
@@ -2982,14 +3207,27 @@ static const ProtobufCFieldDescriptor mgmt__list_cont_resp__cont__field_descript
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "label",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListContResp__Cont, label),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__list_cont_resp__cont__field_indices_by_name[] = {
+  1,   /* field[1] = label */
   0,   /* field[0] = uuid */
 };
 static const ProtobufCIntRange mgmt__list_cont_resp__cont__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 1 }
+  { 0, 2 }
 };
 const ProtobufCMessageDescriptor mgmt__list_cont_resp__cont__descriptor =
 {
@@ -2999,7 +3237,7 @@ const ProtobufCMessageDescriptor mgmt__list_cont_resp__cont__descriptor =
   "Mgmt__ListContResp__Cont",
   "mgmt",
   sizeof(Mgmt__ListContResp__Cont),
-  1,
+  2,
   mgmt__list_cont_resp__cont__field_descriptors,
   mgmt__list_cont_resp__cont__field_indices_by_name,
   1,  mgmt__list_cont_resp__cont__number_ranges,
@@ -3267,7 +3505,7 @@ const ProtobufCEnumDescriptor mgmt__pool_rebuild_status__state__descriptor =
   mgmt__pool_rebuild_status__state__value_ranges,
   NULL,NULL,NULL,NULL   /* reserved[1234] */
 };
-static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descriptors[4] =
+static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descriptors[7] =
 {
   {
     "status",
@@ -3316,18 +3554,56 @@ static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descripto
     NULL,
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
+  },  {
+    "total_objects",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, total_objects),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "duration_sec",
+    6,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, duration_sec),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "version",
+    7,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, version),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
 static const unsigned mgmt__pool_rebuild_status__field_indices_by_name[] = {
+  5,   /* field[5] = duration_sec */
   2,   /* field[2] = objects */
   3,   /* field[3] = records */
   1,   /* field[1] = state */
   0,   /* field[0] = status */
+  4,   /* field[4] = total_objects */
+  6,   /* field[6] = version */
 };
 static const ProtobufCIntRange mgmt__pool_rebuild_status__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 4 }
+  { 0, 7 }
 };
 const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor =
 {
@@ -3337,7 +3613,7 @@ const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor =
   "Mgmt__PoolRebuildStatus",
   "mgmt",
   sizeof(Mgmt__PoolRebuildStatus),
-  4,
+  7,
   mgmt__pool_rebuild_status__field_descriptors,
   mgmt__pool_rebuild_status__field_indices_by_name,
   1,  mgmt__pool_rebuild_status__number_ranges,
@@ -4065,6 +4341,326 @@ const ProtobufCMessageDescriptor mgmt__pool_upgrade_resp__descriptor =
   (ProtobufCMessageInit) mgmt__pool_upgrade_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__pool_rebalance_req__field_descriptors[3] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebalanceReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebalanceReq, id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolRebalanceReq, n_svc_ranks),
+    offsetof(Mgmt__PoolRebalanceReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_rebalance_req__field_indices_by_name[] = {
+  1,   /* field[1] = id */
+  2,   /* field[2] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__pool_rebalance_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_rebalance_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolRebalanceReq",
+  "PoolRebalanceReq",
+  "Mgmt__PoolRebalanceReq",
+  "mgmt",
+  sizeof(Mgmt__PoolRebalanceReq),
+  3,
+  mgmt__pool_rebalance_req__field_descriptors,
+  mgmt__pool_rebalance_req__field_indices_by_name,
+  1,  mgmt__pool_rebalance_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_rebalance_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_rebalance_resp__field_descriptors[1] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebalanceResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_rebalance_resp__field_indices_by_name[] = {
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__pool_rebalance_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_rebalance_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolRebalanceResp",
+  "PoolRebalanceResp",
+  "Mgmt__PoolRebalanceResp",
+  "mgmt",
+  sizeof(Mgmt__PoolRebalanceResp),
+  1,
+  mgmt__pool_rebalance_resp__field_descriptors,
+  mgmt__pool_rebalance_resp__field_indices_by_name,
+  1,  mgmt__pool_rebalance_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_rebalance_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_list_handles_req__field_descriptors[3] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolListHandlesReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "id",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolListHandlesReq, id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svc_ranks",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolListHandlesReq, n_svc_ranks),
+    offsetof(Mgmt__PoolListHandlesReq, svc_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_list_handles_req__field_indices_by_name[] = {
+  1,   /* field[1] = id */
+  2,   /* field[2] = svc_ranks */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__pool_list_handles_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_list_handles_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolListHandlesReq",
+  "PoolListHandlesReq",
+  "Mgmt__PoolListHandlesReq",
+  "mgmt",
+  sizeof(Mgmt__PoolListHandlesReq),
+  3,
+  mgmt__pool_list_handles_req__field_descriptors,
+  mgmt__pool_list_handles_req__field_indices_by_name,
+  1,  mgmt__pool_list_handles_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_list_handles_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_handle__field_descriptors[5] =
+{
+  {
+    "uuid",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolHandle, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "machine",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolHandle, machine),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "user",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolHandle, user),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "group",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolHandle, group),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "connect_time",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolHandle, connect_time),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_handle__field_indices_by_name[] = {
+  4,   /* field[4] = connect_time */
+  3,   /* field[3] = group */
+  1,   /* field[1] = machine */
+  2,   /* field[2] = user */
+  0,   /* field[0] = uuid */
+};
+static const ProtobufCIntRange mgmt__pool_handle__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_handle__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolHandle",
+  "PoolHandle",
+  "Mgmt__PoolHandle",
+  "mgmt",
+  sizeof(Mgmt__PoolHandle),
+  5,
+  mgmt__pool_handle__field_descriptors,
+  mgmt__pool_handle__field_indices_by_name,
+  1,  mgmt__pool_handle__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_handle__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_list_handles_resp__field_descriptors[3] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolListHandlesResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "machines",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Mgmt__PoolListHandlesResp, n_machines),
+    offsetof(Mgmt__PoolListHandlesResp, machines),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "handles",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Mgmt__PoolListHandlesResp, n_handles),
+    offsetof(Mgmt__PoolListHandlesResp, handles),
+    &mgmt__pool_handle__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_list_handles_resp__field_indices_by_name[] = {
+  2,   /* field[2] = handles */
+  1,   /* field[1] = machines */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__pool_list_handles_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_list_handles_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolListHandlesResp",
+  "PoolListHandlesResp",
+  "Mgmt__PoolListHandlesResp",
+  "mgmt",
+  sizeof(Mgmt__PoolListHandlesResp),
+  3,
+  mgmt__pool_list_handles_resp__field_descriptors,
+  mgmt__pool_list_handles_resp__field_indices_by_name,
+  1,  mgmt__pool_list_handles_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_list_handles_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCFieldDescriptor mgmt__pool_query_target_req__field_descriptors[5] =
 {
   {
@@ -46,6 +46,11 @@ typedef struct _Mgmt__PoolGetPropReq Mgmt__PoolGetPropReq;
 typedef struct _Mgmt__PoolGetPropResp Mgmt__PoolGetPropResp;
 typedef struct _Mgmt__PoolUpgradeReq Mgmt__PoolUpgradeReq;
 typedef struct _Mgmt__PoolUpgradeResp Mgmt__PoolUpgradeResp;
+typedef struct _Mgmt__PoolRebalanceReq Mgmt__PoolRebalanceReq;
+typedef struct _Mgmt__PoolRebalanceResp Mgmt__PoolRebalanceResp;
+typedef struct _Mgmt__PoolListHandlesReq Mgmt__PoolListHandlesReq;
+typedef struct _Mgmt__PoolHandle Mgmt__PoolHandle;
+typedef struct _Mgmt__PoolListHandlesResp Mgmt__PoolListHandlesResp;
 typedef struct _Mgmt__PoolQueryTargetReq Mgmt__PoolQueryTargetReq;
 typedef struct _Mgmt__StorageTargetUsage Mgmt__StorageTargetUsage;
 typedef struct _Mgmt__PoolQueryTargetInfo Mgmt__PoolQueryTargetInfo;
@@ -706,10 +711,14 @@ struct  _Mgmt__ListContResp__Cont
    * uuid of container
    */
   char *uuid;
+  /*
+   * label of container
+   */
+  char *label;
 };
 #define MGMT__LIST_CONT_RESP__CONT__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__list_cont_resp__cont__descriptor) \
-    , (char *)protobuf_c_empty_string }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
 
 
 struct  _Mgmt__ListContResp
@@ -787,10 +796,22 @@ struct  _Mgmt__PoolRebuildStatus
   Mgmt__PoolRebuildStatus__State state;
   uint64_t objects;
   uint64_t records;
+  /*
+   * objects found to be rebuilt so far
+   */
+  uint64_t total_objects;
+  /*
+   * time spent rebuilding
+   */
+  uint32_t duration_sec;
+  /*
+   * pool map version being (or last) rebuilt
+   */
+  uint32_t version;
 };
 #define MGMT__POOL_REBUILD_STATUS__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_rebuild_status__descriptor) \
-    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0 }
+    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0, 0, 0, 0 }
 
 
 /*
@@ -1053,6 +1074,129 @@ struct  _Mgmt__PoolUpgradeResp
     , 0 }
 
 
+/*
+ * PoolRebalanceReq supplies pool parameters for a request to redistribute existing
+ * data across all of the pool's targets, e.g. after an extend with deferred rebuild.
+ */
+struct  _Mgmt__PoolRebalanceReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  char *id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__POOL_REBALANCE_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_rebalance_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
+
+
+/*
+ * PoolRebalanceResp returns resultant state of rebalance operation.
+ */
+struct  _Mgmt__PoolRebalanceResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+};
+#define MGMT__POOL_REBALANCE_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_rebalance_resp__descriptor) \
+    , 0 }
+
+
+/*
+ * PoolListHandlesReq supplies pool parameters for a request to list the open
+ * handles of a pool.
+ */
+struct  _Mgmt__PoolListHandlesReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * Pool label or UUID
+   */
+  char *id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+};
+#define MGMT__POOL_LIST_HANDLES_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_list_handles_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
+
+
+/*
+ * PoolHandle describes an open pool handle.
+ */
+struct  _Mgmt__PoolHandle
+{
+  ProtobufCMessage base;
+  /*
+   * Pool handle UUID
+   */
+  char *uuid;
+  /*
+   * Machine name of the client holding the handle
+   */
+  char *machine;
+  /*
+   * User that opened the handle
+   */
+  char *user;
+  /*
+   * Group of the user that opened the handle
+   */
+  char *group;
+  /*
+   * Time the handle was opened in seconds since the epoch (0 if unknown)
+   */
+  uint64_t connect_time;
+};
+#define MGMT__POOL_HANDLE__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_handle__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0 }
+
+
+/*
+ * PoolListHandlesResp returns the open handles of a pool.
+ */
+struct  _Mgmt__PoolListHandlesResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * Machine names of the clients holding open handles
+   */
+  size_t n_machines;
+  char **machines;
+  /*
+   * Open handles, if their details are available
+   */
+  size_t n_handles;
+  Mgmt__PoolHandle **handles;
+};
+#define MGMT__POOL_LIST_HANDLES_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_list_handles_resp__descriptor) \
+    , 0, 0,NULL, 0,NULL }
+
+
 /*
  * PoolQueryTargetReq represents a pool query target(s) request.
  */
@@ -1726,6 +1870,101 @@ Mgmt__PoolUpgradeResp *
 void   mgmt__pool_upgrade_resp__free_unpacked
                      (Mgmt__PoolUpgradeResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__PoolRebalanceReq methods */
+void   mgmt__pool_rebalance_req__init
+                     (Mgmt__PoolRebalanceReq         *message);
+size_t mgmt__pool_rebalance_req__get_packed_size
+                     (const Mgmt__PoolRebalanceReq   *message);
+size_t mgmt__pool_rebalance_req__pack
+                     (const Mgmt__PoolRebalanceReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_rebalance_req__pack_to_buffer
+                     (const Mgmt__PoolRebalanceReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolRebalanceReq *
+       mgmt__pool_rebalance_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_rebalance_req__free_unpacked
+                     (Mgmt__PoolRebalanceReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolRebalanceResp methods */
+void   mgmt__pool_rebalance_resp__init
+                     (Mgmt__PoolRebalanceResp         *message);
+size_t mgmt__pool_rebalance_resp__get_packed_size
+                     (const Mgmt__PoolRebalanceResp   *message);
+size_t mgmt__pool_rebalance_resp__pack
+                     (const Mgmt__PoolRebalanceResp   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_rebalance_resp__pack_to_buffer
+                     (const Mgmt__PoolRebalanceResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolRebalanceResp *
+       mgmt__pool_rebalance_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_rebalance_resp__free_unpacked
+                     (Mgmt__PoolRebalanceResp *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolListHandlesReq methods */
+void   mgmt__pool_list_handles_req__init
+                     (Mgmt__PoolListHandlesReq         *message);
+size_t mgmt__pool_list_handles_req__get_packed_size
+                     (const Mgmt__PoolListHandlesReq   *message);
+size_t mgmt__pool_list_handles_req__pack
+                     (const Mgmt__PoolListHandlesReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_list_handles_req__pack_to_buffer
+                     (const Mgmt__PoolListHandlesReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolListHandlesReq *
+       mgmt__pool_list_handles_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_list_handles_req__free_unpacked
+                     (Mgmt__PoolListHandlesReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolHandle methods */
+void   mgmt__pool_handle__init
+                     (Mgmt__PoolHandle         *message);
+size_t mgmt__pool_handle__get_packed_size
+                     (const Mgmt__PoolHandle   *message);
+size_t mgmt__pool_handle__pack
+                     (const Mgmt__PoolHandle   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_handle__pack_to_buffer
+                     (const Mgmt__PoolHandle   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolHandle *
+       mgmt__pool_handle__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_handle__free_unpacked
+                     (Mgmt__PoolHandle *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolListHandlesResp methods */
+void   mgmt__pool_list_handles_resp__init
+                     (Mgmt__PoolListHandlesResp         *message);
+size_t mgmt__pool_list_handles_resp__get_packed_size
+                     (const Mgmt__PoolListHandlesResp   *message);
+size_t mgmt__pool_list_handles_resp__pack
+                     (const Mgmt__PoolListHandlesResp   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_list_handles_resp__pack_to_buffer
+                     (const Mgmt__PoolListHandlesResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolListHandlesResp *
+       mgmt__pool_list_handles_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_list_handles_resp__free_unpacked
+                     (Mgmt__PoolListHandlesResp *message,
+                      ProtobufCAllocator *allocator);
 /* Mgmt__PoolQueryTargetReq methods */
 void   mgmt__pool_query_target_req__init
                      (Mgmt__PoolQueryTargetReq         *message);
@@ -1897,6 +2136,21 @@ typedef void (*Mgmt__PoolUpgradeReq_Closure)
 typedef void (*Mgmt__PoolUpgradeResp_Closure)
                  (const Mgmt__PoolUpgradeResp *message,
                   void *closure_data);
+typedef void (*Mgmt__PoolRebalanceReq_Closure)
+                 (const Mgmt__PoolRebalanceReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolRebalanceResp_Closure)
+                 (const Mgmt__PoolRebalanceResp *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolListHandlesReq_Closure)
+                 (const Mgmt__PoolListHandlesReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolHandle_Closure)
+                 (const Mgmt__PoolHandle *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolListHandlesResp_Closure)
+                 (const Mgmt__PoolListHandlesResp *message,
+                  void *closure_data);
 typedef void (*Mgmt__PoolQueryTargetReq_Closure)
                  (const Mgmt__PoolQueryTargetReq *message,
                   void *closure_data);
@@ -1949,6 +2203,11 @@ extern const ProtobufCMessageDescriptor mgmt__pool_get_prop_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_get_prop_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_upgrade_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_upgrade_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_rebalance_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_rebalance_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_list_handles_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_handle__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_list_handles_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_query_target_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__storage_target_usage__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_query_target_info__descriptor;
@@ -93,6 +93,9 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_POOL_UPGRADE:
 		ds_mgmt_drpc_pool_upgrade(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_POOL_REBALANCE:
+		ds_mgmt_drpc_pool_rebalance(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_POOL_EVICT:
 		ds_mgmt_drpc_pool_evict(drpc_req, drpc_resp);
 		break;
@@ -138,6 +141,9 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_LIST_CONTAINERS:
 		ds_mgmt_drpc_pool_list_cont(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_POOL_LIST_HANDLES:
+		ds_mgmt_drpc_pool_list_handles(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_POOL_SET_PROP:
 		ds_mgmt_drpc_pool_set_prop(drpc_req, drpc_resp);
 		break;
@@ -153,6 +159,15 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_CONT_SET_OWNER:
 		ds_mgmt_drpc_cont_set_owner(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_CONT_CREATE:
+		ds_mgmt_drpc_cont_create(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_CONT_DESTROY:
+		ds_mgmt_drpc_cont_destroy(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_CONT_QUERY:
+		ds_mgmt_drpc_cont_query(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_GROUP_UPDATE:
 		ds_mgmt_drpc_group_update(drpc_req, drpc_resp);
 		break;
@@ -174,6 +189,9 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_CHK_ACT:
 		ds_mgmt_drpc_check_act(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_FAULT_INJECT:
+		ds_mgmt_drpc_fault_inject(drpc_req, drpc_resp);
+		break;
 	default:
 		drpc_resp->status = DRPC__STATUS__UNKNOWN_METHOD;
 		D_ERROR("Unknown method\n");
@@ -191,62 +209,163 @@ static struct dss_drpc_handler mgmt_drpc_handlers[] = {
 	}
 };
 
-/**
- * Set parameter on all of server targets, for testing or other
- * purpose.
- */
-void
-ds_mgmt_params_set_hdlr(crt_rpc_t *rpc)
+/** Set a parameter on this engine. */
+static int
+mgmt_params_set_local(uint32_t key_id, uint64_t value, uint64_t value_extra)
+{
+	int rc;
+
+	rc = dss_parameters_set(key_id, value);
+	if (rc == 0 && key_id == DMG_KEY_FAIL_LOC)
+		rc = dss_parameters_set(DMG_KEY_FAIL_VALUE, value_extra);
+	if (rc)
+		D_ERROR("Set parameter failed key_id %d: rc %d\n", key_id, rc);
+
+	return rc;
+}
+
+/** Set a parameter on all of the engines in the system. */
+static int
+mgmt_params_set_all(uint32_t key_id, uint64_t value, uint64_t value_extra)
 {
-	struct mgmt_params_set_in	*ps_in;
 	crt_opcode_t			opc;
 	int				topo;
 	crt_rpc_t			*tc_req;
 	struct mgmt_tgt_params_set_in	*tc_in;
-	struct mgmt_params_set_out	*out;
 	int				rc;
 
-	ps_in = crt_req_get(rpc);
-	D_ASSERT(ps_in != NULL);
-	D_DEBUG(DB_MGMT, "ps_rank=%u, key_id=0x%x, value=0x%"PRIx64", extra=0x%"PRIx64"\n",
-		ps_in->ps_rank, ps_in->ps_key_id, ps_in->ps_value, ps_in->ps_value_extra);
-
-	if (ps_in->ps_rank != -1) {
-		/* Only set local parameter */
-		rc = dss_parameters_set(ps_in->ps_key_id, ps_in->ps_value);
-		if (rc == 0 && ps_in->ps_key_id == DMG_KEY_FAIL_LOC)
-			rc = dss_parameters_set(DMG_KEY_FAIL_VALUE,
-						ps_in->ps_value_extra);
-		if (rc)
-			D_ERROR("Set parameter failed key_id %d: rc %d\n",
-				ps_in->ps_key_id, rc);
-		D_GOTO(out, rc);
-	}
-
 	topo = crt_tree_topo(CRT_TREE_KNOMIAL, 32);
 	opc = DAOS_RPC_OPCODE(MGMT_TGT_PARAMS_SET, DAOS_MGMT_MODULE,
 			      DAOS_MGMT_VERSION);
 	rc = crt_corpc_req_create(dss_get_module_info()->dmi_ctx, NULL, NULL,
 				  opc, NULL, NULL, 0, topo, &tc_req);
 	if (rc)
-		D_GOTO(out, rc);
+		return rc;
 
 	tc_in = crt_req_get(tc_req);
 	D_ASSERT(tc_in != NULL);
 
-	tc_in->tps_key_id = ps_in->ps_key_id;
-	tc_in->tps_value = ps_in->ps_value;
-	tc_in->tps_value_extra = ps_in->ps_value_extra;
+	tc_in->tps_key_id = key_id;
+	tc_in->tps_value = value;
+	tc_in->tps_value_extra = value_extra;
 
 	rc = dss_rpc_send(tc_req);
 
 	crt_req_decref(tc_req);
+	return rc;
+}
+
+/** Set a parameter on another engine. */
+static int
+mgmt_params_set_remote(d_rank_t rank, uint32_t key_id, uint64_t value, uint64_t value_extra)
+{
+	crt_rpc_t			*req = NULL;
+	struct mgmt_params_set_in	*in;
+	struct mgmt_params_set_out	*out;
+	crt_endpoint_t			 ep;
+	crt_opcode_t			 opc;
+	int				 rc;
+
+	ep.ep_grp = NULL;
+	ep.ep_rank = rank;
+	ep.ep_tag = daos_rpc_tag(DAOS_REQ_MGMT, 0);
+	opc = DAOS_RPC_OPCODE(MGMT_PARAMS_SET, DAOS_MGMT_MODULE, DAOS_MGMT_VERSION);
+
+	rc = crt_req_create(dss_get_module_info()->dmi_ctx, &ep, opc, &req);
+	if (rc != 0)
+		goto out;
+
+	in = crt_req_get(req);
+	D_ASSERT(in != NULL);
+
+	in->ps_rank = rank;
+	in->ps_key_id = key_id;
+	in->ps_value = value;
+	in->ps_value_extra = value_extra;
+
+	rc = dss_rpc_send(req);
+	if (rc != 0)
+		goto out;
+
+	out = crt_reply_get(req);
+	rc = out->srv_rc;
+
 out:
+	if (req != NULL)
+		crt_req_decref(req);
+
+	return rc;
+}
+
+/**
+ * Set parameter on all of server targets, for testing or other
+ * purpose.
+ */
+void
+ds_mgmt_params_set_hdlr(crt_rpc_t *rpc)
+{
+	struct mgmt_params_set_in	*ps_in;
+	struct mgmt_params_set_out	*out;
+	int				rc;
+
+	ps_in = crt_req_get(rpc);
+	D_ASSERT(ps_in != NULL);
+	D_DEBUG(DB_MGMT, "ps_rank=%u, key_id=0x%x, value=0x%"PRIx64", extra=0x%"PRIx64"\n",
+		ps_in->ps_rank, ps_in->ps_key_id, ps_in->ps_value, ps_in->ps_value_extra);
+
+	if (ps_in->ps_rank != -1)
+		/* Only set local parameter */
+		rc = mgmt_params_set_local(ps_in->ps_key_id, ps_in->ps_value,
+					   ps_in->ps_value_extra);
+	else
+		rc = mgmt_params_set_all(ps_in->ps_key_id, ps_in->ps_value,
+					 ps_in->ps_value_extra);
+
 	out = crt_reply_get(rpc);
 	out->srv_rc = rc;
 	crt_reply_send(rpc);
 }
 
+/** Set a parameter on the given rank, or on all ranks if it is CRT_NO_RANK. */
+static int
+mgmt_params_set_rank(d_rank_t rank, uint32_t key_id, uint64_t value, uint64_t value_extra)
+{
+	if (rank == CRT_NO_RANK)
+		return mgmt_params_set_all(key_id, value, value_extra);
+	if (rank == dss_self_rank())
+		return mgmt_params_set_local(key_id, value, value_extra);
+	return mgmt_params_set_remote(rank, key_id, value, value_extra);
+}
+
+/**
+ * Set a fault injection point on the given engine rank, or on all of the
+ * engines in the system if the rank is CRT_NO_RANK. If fail_num is non-zero,
+ * it limits the number of times the fault is triggered in DAOS_FAIL_SOME mode.
+ */
+int
+ds_mgmt_fault_inject(d_rank_t rank, uint64_t fail_loc, uint64_t fail_value, uint64_t fail_num)
+{
+	int rc;
+
+	D_DEBUG(DB_MGMT, "rank=%u, fail_loc=0x%"PRIx64", value=%"PRIu64", num=%"PRIu64"\n",
+		rank, fail_loc, fail_value, fail_num);
+
+	/* The count must be in place before the fault is armed. */
+	if (fail_num != 0) {
+		rc = mgmt_params_set_rank(rank, DMG_KEY_FAIL_NUM, fail_num, 0);
+		if (rc != 0) {
+			DL_ERROR(rc, "failed to set fail_num on rank %u", rank);
+			return rc;
+		}
+	}
+
+	rc = mgmt_params_set_rank(rank, DMG_KEY_FAIL_LOC, fail_loc, fail_value);
+	if (rc != 0)
+		DL_ERROR(rc, "failed to set fail_loc on rank %u", rank);
+
+	return rc;
+}
+
 /**
  * Set parameter on all of server targets, for testing or other
  * purpose.
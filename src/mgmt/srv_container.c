@@ -66,3 +66,86 @@ out_prop:
 	daos_prop_free(prop);
 	return rc;
 }
+
+int
+ds_mgmt_cont_create(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *label,
+		    const char *user, const char *group, uuid_t cont_uuid)
+{
+	int          rc = 0;
+	daos_prop_t *prop;
+	uint32_t     prop_nr = 0;
+	uint32_t     i       = 0;
+	bool         lbl_set;
+	bool         user_set;
+	bool         grp_set;
+
+	D_DEBUG(DB_MGMT, DF_UUID ": Creating container '%s'\n", DP_UUID(pool_uuid),
+		label != NULL ? label : "");
+
+	lbl_set  = label != NULL && strnlen(label, DAOS_PROP_LABEL_MAX_LEN) > 0;
+	user_set = user != NULL && strnlen(user, DAOS_ACL_MAX_PRINCIPAL_LEN) > 0;
+	grp_set  = group != NULL && strnlen(group, DAOS_ACL_MAX_PRINCIPAL_LEN) > 0;
+
+	if (lbl_set)
+		prop_nr++;
+	if (user_set)
+		prop_nr++;
+	if (grp_set)
+		prop_nr++;
+
+	prop = daos_prop_alloc(prop_nr);
+	if (prop == NULL)
+		return -DER_NOMEM;
+
+	if (lbl_set) {
+		prop->dpp_entries[i].dpe_type = DAOS_PROP_CO_LABEL;
+		D_STRNDUP(prop->dpp_entries[i].dpe_str, label, DAOS_PROP_LABEL_MAX_LEN);
+		if (prop->dpp_entries[i].dpe_str == NULL)
+			D_GOTO(out_prop, rc = -DER_NOMEM);
+		i++;
+	}
+
+	if (user_set) {
+		prop->dpp_entries[i].dpe_type = DAOS_PROP_CO_OWNER;
+		D_STRNDUP(prop->dpp_entries[i].dpe_str, user, DAOS_ACL_MAX_PRINCIPAL_LEN);
+		if (prop->dpp_entries[i].dpe_str == NULL)
+			D_GOTO(out_prop, rc = -DER_NOMEM);
+		i++;
+	}
+
+	if (grp_set) {
+		prop->dpp_entries[i].dpe_type = DAOS_PROP_CO_OWNER_GROUP;
+		D_STRNDUP(prop->dpp_entries[i].dpe_str, group, DAOS_ACL_MAX_PRINCIPAL_LEN);
+		if (prop->dpp_entries[i].dpe_str == NULL)
+			D_GOTO(out_prop, rc = -DER_NOMEM);
+		i++;
+	}
+
+	if (!daos_prop_valid(prop, false /* pool */, true /* input */)) {
+		D_ERROR("invalid label or owner for new container\n");
+		D_GOTO(out_prop, rc = -DER_INVAL);
+	}
+
+	rc = ds_cont_svc_create(pool_uuid, svc_ranks, prop, cont_uuid);
+out_prop:
+	daos_prop_free(prop);
+	return rc;
+}
+
+int
+ds_mgmt_cont_destroy(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *cont_id, bool force)
+{
+	D_DEBUG(DB_MGMT, DF_UUID ": Destroying container '%s' (force=%d)\n", DP_UUID(pool_uuid),
+		cont_id, force);
+
+	return ds_cont_svc_destroy(pool_uuid, cont_id, svc_ranks, force);
+}
+
+int
+ds_mgmt_cont_query(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *cont_id,
+		   daos_cont_info_t *info, daos_prop_t **prop)
+{
+	D_DEBUG(DB_MGMT, DF_UUID ": Querying container '%s'\n", DP_UUID(pool_uuid), cont_id);
+
+	return ds_cont_svc_query(pool_uuid, cont_id, svc_ranks, info, prop);
+}
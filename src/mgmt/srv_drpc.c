@@ -1116,6 +1116,60 @@ out:
 	mgmt__pool_upgrade_req__free_unpacked(req, &alloc.alloc);
 }
 
+void
+ds_mgmt_drpc_pool_rebalance(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PoolRebalanceReq	*req = NULL;
+	Mgmt__PoolRebalanceResp	 resp = MGMT__POOL_REBALANCE_RESP__INIT;
+	uuid_t			 uuid;
+	d_rank_list_t		*svc_ranks = NULL;
+	uint8_t			*body;
+	size_t			 len;
+	int			 rc;
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__pool_rebalance_req__unpack(&alloc.alloc,
+					       drpc_req->body.len,
+					       drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		D_ERROR("Failed to unpack req (rebalance pool)\n");
+		return;
+	}
+
+	D_INFO("Received request to rebalance pool %s\n", req->id);
+
+	if (uuid_parse(req->id, uuid) != 0) {
+		rc = -DER_INVAL;
+		DL_ERROR(rc, "Pool UUID is invalid");
+		goto out;
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_pool_rebalance(uuid, svc_ranks);
+
+	d_rank_list_free(svc_ranks);
+
+out:
+	resp.status = rc;
+	len = mgmt__pool_rebalance_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__pool_rebalance_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__pool_rebalance_req__free_unpacked(req, &alloc.alloc);
+}
+
 void
 free_response_props(Mgmt__PoolProperty **props, size_t n_props)
 {
@@ -1676,6 +1730,8 @@ ds_mgmt_drpc_pool_list_cont(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 		if (resp.containers[i]->uuid == NULL)
 			D_GOTO(out_ranks, rc = -DER_NOMEM);
 		uuid_unparse(containers[i].pci_uuid, resp.containers[i]->uuid);
+		/* containers is freed after the response has been packed */
+		resp.containers[i]->label = containers[i].pci_label;
 	}
 
 out_ranks:
@@ -1708,6 +1764,117 @@ out:
 	D_FREE(containers);
 }
 
+void
+ds_mgmt_drpc_pool_list_handles(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc		alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PoolListHandlesReq	*req = NULL;
+	Mgmt__PoolListHandlesResp	 resp = MGMT__POOL_LIST_HANDLES_RESP__INIT;
+	uuid_t				 req_uuid;
+	d_rank_list_t			*svc_ranks;
+	uint8_t				*body;
+	size_t				 len;
+	struct ds_pool_hdl_info		*hdls = NULL;
+	uint32_t			 n_hdls = 0;
+	int				 i;
+	int				 rc = 0;
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__pool_list_handles_req__unpack(&alloc.alloc, drpc_req->body.len,
+						  drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		D_ERROR("Failed to unpack req (list pool handles)\n");
+		mgmt__pool_list_handles_req__free_unpacked(req, &alloc.alloc);
+		return;
+	}
+
+	D_INFO("Received request to list handles in DAOS pool %s\n", req->id);
+
+	if (uuid_parse(req->id, req_uuid) != 0) {
+		rc = -DER_INVAL;
+		DL_ERROR(rc, "Pool UUID is invalid");
+		goto out;
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_pool_list_handles(req_uuid, svc_ranks, &hdls, &n_hdls);
+	if (rc != 0) {
+		DL_ERROR(rc, "Failed to list handles in pool %s", req->id);
+		D_GOTO(out_ranks, rc);
+	}
+
+	D_DEBUG(DB_MGMT, "Found %u handles in DAOS pool %s\n", n_hdls, req->id);
+
+	if (n_hdls > 0) {
+		D_ALLOC_ARRAY(resp.handles, n_hdls);
+		if (resp.handles == NULL)
+			D_GOTO(out_ranks, rc = -DER_NOMEM);
+		D_ALLOC_ARRAY(resp.machines, n_hdls);
+		if (resp.machines == NULL)
+			D_GOTO(out_ranks, rc = -DER_NOMEM);
+	}
+
+	for (i = 0; i < n_hdls; i++) {
+		D_ALLOC_PTR(resp.handles[i]);
+		if (resp.handles[i] == NULL)
+			D_GOTO(out_ranks, rc = -DER_NOMEM);
+		resp.n_handles++;
+
+		mgmt__pool_handle__init(resp.handles[i]);
+
+		D_ALLOC(resp.handles[i]->uuid, DAOS_UUID_STR_SIZE);
+		if (resp.handles[i]->uuid == NULL)
+			D_GOTO(out_ranks, rc = -DER_NOMEM);
+		uuid_unparse(hdls[i].phi_uuid, resp.handles[i]->uuid);
+
+		/*
+		 * hdls is freed after the response has been packed. The details
+		 * are unknown for handles opened before they were recorded.
+		 */
+		if (hdls[i].phi_machine != NULL) {
+			resp.handles[i]->machine = hdls[i].phi_machine;
+			resp.machines[resp.n_machines++] = hdls[i].phi_machine;
+		}
+		if (hdls[i].phi_user != NULL)
+			resp.handles[i]->user = hdls[i].phi_user;
+		if (hdls[i].phi_group != NULL)
+			resp.handles[i]->group = hdls[i].phi_group;
+		resp.handles[i]->connect_time = hdls[i].phi_connect_time;
+	}
+
+out_ranks:
+	d_rank_list_free(svc_ranks);
+out:
+	resp.status = rc;
+	len = mgmt__pool_list_handles_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__pool_list_handles_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__pool_list_handles_req__free_unpacked(req, &alloc.alloc);
+
+	if (resp.handles) {
+		for (i = 0; i < resp.n_handles; i++) {
+			D_FREE(resp.handles[i]->uuid);
+			D_FREE(resp.handles[i]);
+		}
+		D_FREE(resp.handles);
+	}
+	D_FREE(resp.machines);
+
+	ds_pool_hdl_info_free(hdls, n_hdls);
+}
+
 static void
 storage_usage_stats_from_pool_space(Mgmt__StorageUsageStats *stats,
 				    struct daos_pool_space *space,
@@ -1727,10 +1894,13 @@ static void
 pool_rebuild_status_from_info(Mgmt__PoolRebuildStatus *rebuild,
 			      struct daos_rebuild_status *info)
 {
-	rebuild->status = info->rs_errno;
+	rebuild->status  = info->rs_errno;
+	rebuild->version = info->rs_version;
 	if (rebuild->status == 0) {
-		rebuild->objects = info->rs_obj_nr;
-		rebuild->records = info->rs_rec_nr;
+		rebuild->objects       = info->rs_obj_nr;
+		rebuild->records       = info->rs_rec_nr;
+		rebuild->total_objects = info->rs_toberb_obj_nr;
+		rebuild->duration_sec  = info->rs_seconds;
 
 		if (info->rs_version == 0)
 			rebuild->state = MGMT__POOL_REBUILD_STATUS__STATE__IDLE;
@@ -2547,6 +2717,199 @@ out:
 	mgmt__cont_set_owner_req__free_unpacked(req, &alloc.alloc);
 }
 
+void
+ds_mgmt_drpc_cont_create(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc     alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__ContCreateReq  *req   = NULL;
+	Mgmt__ContCreateResp  resp  = MGMT__CONT_CREATE_RESP__INIT;
+	uint8_t              *body;
+	size_t                len;
+	d_rank_list_t        *svc_ranks = NULL;
+	uuid_t                pool_uuid;
+	uuid_t                cont_uuid;
+	char                  cont_uuid_str[DAOS_UUID_STR_SIZE] = {0};
+	int                   rc                                = 0;
+
+	req = mgmt__cont_create_req__unpack(&alloc.alloc, drpc_req->body.len, drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (cont create)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to create container '%s' in pool '%s'\n", req->label,
+	       req->pool_id);
+
+	if (uuid_parse(req->pool_id, pool_uuid) != 0) {
+		DL_ERROR(-DER_INVAL, "Pool UUID is invalid");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_cont_create(pool_uuid, svc_ranks, req->label, req->owner_user,
+				 req->owner_group, cont_uuid);
+	if (rc != 0) {
+		D_ERROR("Container create failed: " DF_RC "\n", DP_RC(rc));
+	} else {
+		uuid_unparse(cont_uuid, cont_uuid_str);
+		resp.cont_uuid = cont_uuid_str;
+	}
+
+	d_rank_list_free(svc_ranks);
+
+out:
+	resp.status = rc;
+	len         = mgmt__cont_create_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__cont_create_resp__pack(&resp, body);
+		drpc_resp->body.len  = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__cont_create_req__free_unpacked(req, &alloc.alloc);
+}
+
+void
+ds_mgmt_drpc_cont_destroy(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc     alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__ContDestroyReq *req   = NULL;
+	Mgmt__DaosResp        resp  = MGMT__DAOS_RESP__INIT;
+	uint8_t              *body;
+	size_t                len;
+	d_rank_list_t        *svc_ranks = NULL;
+	uuid_t                pool_uuid;
+	int                   rc = 0;
+
+	req = mgmt__cont_destroy_req__unpack(&alloc.alloc, drpc_req->body.len, drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (cont destroy)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to destroy container '%s' in pool '%s'\n", req->cont_id,
+	       req->pool_id);
+
+	if (uuid_parse(req->pool_id, pool_uuid) != 0) {
+		DL_ERROR(-DER_INVAL, "Pool UUID is invalid");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_cont_destroy(pool_uuid, svc_ranks, req->cont_id, req->force);
+	if (rc != 0)
+		D_ERROR("Container destroy failed: " DF_RC "\n", DP_RC(rc));
+
+	d_rank_list_free(svc_ranks);
+
+out:
+	resp.status = rc;
+	len         = mgmt__daos_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__daos_resp__pack(&resp, body);
+		drpc_resp->body.len  = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__cont_destroy_req__free_unpacked(req, &alloc.alloc);
+}
+
+void
+ds_mgmt_drpc_cont_query(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc       alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__ContQueryReq     *req   = NULL;
+	Mgmt__ContQueryResp     resp  = MGMT__CONT_QUERY_RESP__INIT;
+	uint8_t                *body;
+	size_t                  len;
+	d_rank_list_t          *svc_ranks = NULL;
+	uuid_t                  pool_uuid;
+	daos_cont_info_t        info;
+	daos_prop_t            *prop = NULL;
+	struct daos_prop_entry *entry;
+	char                    cont_uuid_str[DAOS_UUID_STR_SIZE] = {0};
+	char                    layout_type[10]                   = {0};
+	int                     rc                                = 0;
+
+	req = mgmt__cont_query_req__unpack(&alloc.alloc, drpc_req->body.len, drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (cont query)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to query container '%s' in pool '%s'\n", req->cont_id,
+	       req->pool_id);
+
+	if (uuid_parse(req->pool_id, pool_uuid) != 0) {
+		DL_ERROR(-DER_INVAL, "Pool UUID is invalid");
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_cont_query(pool_uuid, svc_ranks, req->cont_id, &info, &prop);
+	d_rank_list_free(svc_ranks);
+	if (rc != 0) {
+		D_ERROR("Container query failed: " DF_RC "\n", DP_RC(rc));
+		D_GOTO(out, rc);
+	}
+
+	uuid_unparse(info.ci_uuid, cont_uuid_str);
+	resp.uuid          = cont_uuid_str;
+	resp.num_handles   = info.ci_nhandles;
+	resp.num_snapshots = info.ci_nsnapshots;
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_LABEL);
+	if (entry != NULL && entry->dpe_str != NULL)
+		resp.label = entry->dpe_str;
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_OWNER);
+	if (entry != NULL && entry->dpe_str != NULL)
+		resp.owner_user = entry->dpe_str;
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_OWNER_GROUP);
+	if (entry != NULL && entry->dpe_str != NULL)
+		resp.owner_group = entry->dpe_str;
+	entry = daos_prop_entry_get(prop, DAOS_PROP_CO_LAYOUT_TYPE);
+	if (entry != NULL) {
+		daos_unparse_ctype(entry->dpe_val, layout_type);
+		resp.layout_type = layout_type;
+	}
+
+out:
+	resp.status = rc;
+	len         = mgmt__cont_query_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__cont_query_resp__pack(&resp, body);
+		drpc_resp->body.len  = len;
+		drpc_resp->body.data = body;
+	}
+
+	daos_prop_free(prop);
+	mgmt__cont_query_req__free_unpacked(req, &alloc.alloc);
+}
+
 /*
  * NOTE: It is the control plane to choose the check leader and generate the rank list.
  *	 There are some requirements for the rank list:
@@ -3019,3 +3382,31 @@ ds_mgmt_drpc_check_act(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 
 	mgmt__check_act_req__free_unpacked(req, &alloc.alloc);
 }
+
+void
+ds_mgmt_drpc_fault_inject(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc		 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__FaultInjectEngineReq	*req = NULL;
+	Mgmt__DaosResp			 resp = MGMT__DAOS_RESP__INIT;
+	int				 rc;
+
+	req = mgmt__fault_inject_engine_req__unpack(&alloc.alloc, drpc_req->body.len,
+						    drpc_req->body.data);
+	if (alloc.oom || req == NULL) {
+		D_ERROR("Failed to unpack req (fault inject)\n");
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		return;
+	}
+
+	D_INFO("Received request to set fail_loc %#" PRIx64 " on rank %u\n", req->fail_loc,
+	       req->rank);
+
+	rc = ds_mgmt_fault_inject(req->rank, req->fail_loc, req->fail_value, req->fail_num);
+	if (rc != 0)
+		DL_ERROR(rc, "Failed to inject fault");
+
+	resp.status = rc;
+	pack_daos_response(&resp, drpc_resp);
+	mgmt__fault_inject_engine_req__free_unpacked(req, &alloc.alloc);
+}
@@ -54,6 +54,8 @@ void ds_mgmt_pool_find_hdlr(crt_rpc_t *rpc);
 void
      ds_mgmt_pool_list_hdlr(crt_rpc_t *rpc);
 void ds_mgmt_mark_hdlr(crt_rpc_t *rpc);
+int
+     ds_mgmt_fault_inject(d_rank_t rank, uint64_t fail_loc, uint64_t fail_value, uint64_t fail_num);
 void dss_bind_to_xstream_cpuset(int tgt_id);
 
 /** srv_system.c */
@@ -106,6 +108,7 @@ ds_mgmt_pool_set_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks, daos_prop_t *p
 int
     ds_mgmt_pool_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks, daos_prop_t *prop);
 int ds_mgmt_pool_upgrade(uuid_t pool_uuid, d_rank_list_t *svc_ranks);
+int ds_mgmt_pool_rebalance(uuid_t pool_uuid, d_rank_list_t *svc_ranks);
 int
 ds_mgmt_pool_get_acl(uuid_t pool_uuid, d_rank_list_t *svc_ranks, daos_prop_t **access_prop);
 int
@@ -120,6 +123,9 @@ int
 int ds_mgmt_pool_list_cont(uuid_t uuid, d_rank_list_t *svc_ranks,
 			   struct daos_pool_cont_info **containers,
 			   uint64_t *ncontainers);
+struct ds_pool_hdl_info;
+int ds_mgmt_pool_list_handles(uuid_t uuid, d_rank_list_t *svc_ranks,
+			      struct ds_pool_hdl_info **hdls, uint32_t *n_hdls);
 int
     ds_mgmt_pool_query(uuid_t pool_uuid, d_rank_list_t *svc_ranks, d_rank_list_t **enabled_ranks,
 		       d_rank_list_t **disabled_ranks, d_rank_list_t **dead_ranks,
@@ -133,6 +139,15 @@ int
 int
      ds_mgmt_cont_set_owner(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *cont_id,
 			    const char *user, const char *group);
+int
+     ds_mgmt_cont_create(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *label,
+			 const char *user, const char *group, uuid_t cont_uuid);
+int
+     ds_mgmt_cont_destroy(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *cont_id,
+			  bool force);
+int
+     ds_mgmt_cont_query(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *cont_id,
+			daos_cont_info_t *info, daos_prop_t **prop);
 
 /** srv_chk.c */
 int ds_mgmt_check_start(uint32_t rank_nr, d_rank_t *ranks, uint32_t policy_nr,
@@ -383,6 +383,16 @@ ds_mgmt_pool_list_cont(uuid_t uuid, d_rank_list_t *svc_ranks,
 	return ds_pool_svc_list_cont(uuid, svc_ranks, containers, ncontainers);
 }
 
+/* Get the list of open handles from the pool service for the specified pool */
+int
+ds_mgmt_pool_list_handles(uuid_t uuid, d_rank_list_t *svc_ranks, struct ds_pool_hdl_info **hdls,
+			  uint32_t *n_hdls)
+{
+	D_DEBUG(DB_MGMT, "Getting handle list for pool "DF_UUID"\n", DP_UUID(uuid));
+
+	return dsc_pool_svc_list_hdls(uuid, svc_ranks, mgmt_ps_call_deadline(), hdls, n_hdls);
+}
+
 /**
  * Calls into the pool svc to query a pool by UUID.
  *
@@ -625,6 +635,14 @@ int ds_mgmt_pool_upgrade(uuid_t pool_uuid, d_rank_list_t *svc_ranks)
 	return dsc_pool_svc_upgrade(pool_uuid, svc_ranks, mgmt_ps_call_deadline());
 }
 
+int ds_mgmt_pool_rebalance(uuid_t pool_uuid, d_rank_list_t *svc_ranks)
+{
+	D_DEBUG(DB_MGMT, "Rebalancing pool "DF_UUID"\n",
+		DP_UUID(pool_uuid));
+
+	return dsc_pool_svc_rebalance(pool_uuid, svc_ranks, mgmt_ps_call_deadline());
+}
+
 int
 ds_mgmt_pool_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks,
 		      daos_prop_t *prop)
@@ -874,6 +874,51 @@ void   mgmt__client_telemetry_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__client_telemetry_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__fault_inject_engine_req__init
+                     (Mgmt__FaultInjectEngineReq         *message)
+{
+  static const Mgmt__FaultInjectEngineReq init_value = MGMT__FAULT_INJECT_ENGINE_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__fault_inject_engine_req__get_packed_size
+                     (const Mgmt__FaultInjectEngineReq *message)
+{
+  assert(message->base.descriptor == &mgmt__fault_inject_engine_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__fault_inject_engine_req__pack
+                     (const Mgmt__FaultInjectEngineReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__fault_inject_engine_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__fault_inject_engine_req__pack_to_buffer
+                     (const Mgmt__FaultInjectEngineReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__fault_inject_engine_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__FaultInjectEngineReq *
+       mgmt__fault_inject_engine_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__FaultInjectEngineReq *)
+     protobuf_c_message_unpack (&mgmt__fault_inject_engine_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__fault_inject_engine_req__free_unpacked
+                     (Mgmt__FaultInjectEngineReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__fault_inject_engine_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 static const ProtobufCFieldDescriptor mgmt__daos_resp__field_descriptors[1] =
 {
   {
@@ -1507,7 +1552,7 @@ const ProtobufCMessageDescriptor mgmt__leader_query_resp__descriptor =
   (ProtobufCMessageInit) mgmt__leader_query_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__get_attach_info_req__field_descriptors[4] =
+static const ProtobufCFieldDescriptor mgmt__get_attach_info_req__field_descriptors[5] =
 {
   {
     "sys",
@@ -1557,8 +1602,21 @@ static const ProtobufCFieldDescriptor mgmt__get_attach_info_req__field_descripto
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "accept_encoding",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__GetAttachInfoReq, accept_encoding),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__get_attach_info_req__field_indices_by_name[] = {
+  4,   /* field[4] = accept_encoding */
   1,   /* field[1] = all_ranks */
   3,   /* field[3] = domain */
   2,   /* field[2] = interface */
@@ -1567,7 +1625,7 @@ static const unsigned mgmt__get_attach_info_req__field_indices_by_name[] = {
 static const ProtobufCIntRange mgmt__get_attach_info_req__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 4 }
+  { 0, 5 }
 };
 const ProtobufCMessageDescriptor mgmt__get_attach_info_req__descriptor =
 {
@@ -1577,14 +1635,14 @@ const ProtobufCMessageDescriptor mgmt__get_attach_info_req__descriptor =
   "Mgmt__GetAttachInfoReq",
   "mgmt",
   sizeof(Mgmt__GetAttachInfoReq),
-  4,
+  5,
   mgmt__get_attach_info_req__field_descriptors,
   mgmt__get_attach_info_req__field_indices_by_name,
   1,  mgmt__get_attach_info_req__number_ranges,
   (ProtobufCMessageInit) mgmt__get_attach_info_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__client_net_hint__field_descriptors[8] =
+static const ProtobufCFieldDescriptor mgmt__client_net_hint__field_descriptors[10] =
 {
   {
     "provider",
@@ -1682,8 +1740,34 @@ static const ProtobufCFieldDescriptor mgmt__client_net_hint__field_descriptors[8
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "cpuset_cpus",
+    10,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ClientNetHint, cpuset_cpus),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "cpuset_mems",
+    11,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ClientNetHint, cpuset_mems),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__client_net_hint__field_indices_by_name[] = {
+  8,   /* field[8] = cpuset_cpus */
+  9,   /* field[9] = cpuset_mems */
   3,   /* field[3] = crt_timeout */
   2,   /* field[2] = domain */
   6,   /* field[6] = env_vars */
@@ -1697,7 +1781,7 @@ static const ProtobufCIntRange mgmt__client_net_hint__number_ranges[2 + 1] =
 {
   { 1, 0 },
   { 5, 3 },
-  { 0, 8 }
+  { 0, 10 }
 };
 const ProtobufCMessageDescriptor mgmt__client_net_hint__descriptor =
 {
@@ -1707,7 +1791,7 @@ const ProtobufCMessageDescriptor mgmt__client_net_hint__descriptor =
   "Mgmt__ClientNetHint",
   "mgmt",
   sizeof(Mgmt__ClientNetHint),
-  8,
+  10,
   mgmt__client_net_hint__field_descriptors,
   mgmt__client_net_hint__field_indices_by_name,
   2,  mgmt__client_net_hint__number_ranges,
@@ -1996,7 +2080,7 @@ const ProtobufCMessageDescriptor mgmt__get_attach_info_resp__rank_uri__descripto
   (ProtobufCMessageInit) mgmt__get_attach_info_resp__rank_uri__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__get_attach_info_resp__field_descriptors[10] =
+static const ProtobufCFieldDescriptor mgmt__get_attach_info_resp__field_descriptors[12] =
 {
   {
     "status",
@@ -2118,6 +2202,30 @@ static const ProtobufCFieldDescriptor mgmt__get_attach_info_resp__field_descript
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "rank_uris_encoding",
+    11,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__GetAttachInfoResp, rank_uris_encoding),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "packed_rank_uris",
+    12,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BYTES,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__GetAttachInfoResp, packed_rank_uris),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__get_attach_info_resp__field_indices_by_name[] = {
   8,   /* field[8] = build_info */
@@ -2125,7 +2233,9 @@ static const unsigned mgmt__get_attach_info_resp__field_indices_by_name[] = {
   4,   /* field[4] = data_version */
   2,   /* field[2] = ms_ranks */
   9,   /* field[9] = numa_fabric_interfaces */
+  11,   /* field[11] = packed_rank_uris */
   1,   /* field[1] = rank_uris */
+  10,   /* field[10] = rank_uris_encoding */
   7,   /* field[7] = secondary_client_net_hints */
   6,   /* field[6] = secondary_rank_uris */
   0,   /* field[0] = status */
@@ -2134,7 +2244,7 @@ static const unsigned mgmt__get_attach_info_resp__field_indices_by_name[] = {
 static const ProtobufCIntRange mgmt__get_attach_info_resp__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 10 }
+  { 0, 12 }
 };
 const ProtobufCMessageDescriptor mgmt__get_attach_info_resp__descriptor =
 {
@@ -2144,7 +2254,7 @@ const ProtobufCMessageDescriptor mgmt__get_attach_info_resp__descriptor =
   "Mgmt__GetAttachInfoResp",
   "mgmt",
   sizeof(Mgmt__GetAttachInfoResp),
-  10,
+  12,
   mgmt__get_attach_info_resp__field_descriptors,
   mgmt__get_attach_info_resp__field_indices_by_name,
   1,  mgmt__get_attach_info_resp__number_ranges,
@@ -2470,3 +2580,93 @@ const ProtobufCMessageDescriptor mgmt__client_telemetry_resp__descriptor =
   (ProtobufCMessageInit) mgmt__client_telemetry_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__fault_inject_engine_req__field_descriptors[5] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__FaultInjectEngineReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "rank",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__FaultInjectEngineReq, rank),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "fail_loc",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__FaultInjectEngineReq, fail_loc),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "fail_value",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__FaultInjectEngineReq, fail_value),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "fail_num",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__FaultInjectEngineReq, fail_num),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__fault_inject_engine_req__field_indices_by_name[] = {
+  2,   /* field[2] = fail_loc */
+  4,   /* field[4] = fail_num */
+  3,   /* field[3] = fail_value */
+  1,   /* field[1] = rank */
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__fault_inject_engine_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mgmt__fault_inject_engine_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.FaultInjectEngineReq",
+  "FaultInjectEngineReq",
+  "Mgmt__FaultInjectEngineReq",
+  "mgmt",
+  sizeof(Mgmt__FaultInjectEngineReq),
+  5,
+  mgmt__fault_inject_engine_req__field_descriptors,
+  mgmt__fault_inject_engine_req__field_indices_by_name,
+  1,  mgmt__fault_inject_engine_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__fault_inject_engine_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
@@ -36,6 +36,7 @@ typedef struct _Mgmt__SetRankReq Mgmt__SetRankReq;
 typedef struct _Mgmt__PoolMonitorReq Mgmt__PoolMonitorReq;
 typedef struct _Mgmt__ClientTelemetryReq Mgmt__ClientTelemetryReq;
 typedef struct _Mgmt__ClientTelemetryResp Mgmt__ClientTelemetryResp;
+typedef struct _Mgmt__FaultInjectEngineReq Mgmt__FaultInjectEngineReq;
 
 
 /* --- enums --- */
@@ -257,10 +258,14 @@ struct  _Mgmt__GetAttachInfoReq
    * Preferred fabric domain.
    */
   char *domain;
+  /*
+   * Compression accepted for the rank URI table (e.g. "gzip").
+   */
+  char *accept_encoding;
 };
 #define MGMT__GET_ATTACH_INFO_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__get_attach_info_req__descriptor) \
-    , (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
+    , (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
 
 
 struct  _Mgmt__ClientNetHint
@@ -302,10 +307,18 @@ struct  _Mgmt__ClientNetHint
    * Provider index - anything > 0 is a secondary provider
    */
   uint32_t provider_idx;
+  /*
+   * Recommended client CPU binding (cpulist format)
+   */
+  char *cpuset_cpus;
+  /*
+   * Recommended client memory binding (NUMA node list)
+   */
+  char *cpuset_mems;
 };
 #define MGMT__CLIENT_NET_HINT__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__client_net_hint__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0, 0,NULL, 0 }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0, 0,NULL, 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
 
 
 struct  _Mgmt__FabricInterface
@@ -411,10 +424,18 @@ struct  _Mgmt__GetAttachInfoResp
    */
   size_t n_numa_fabric_interfaces;
   Mgmt__FabricInterfaces **numa_fabric_interfaces;
+  /*
+   * Compression of packed_rank_uris, if set
+   */
+  char *rank_uris_encoding;
+  /*
+   * Compressed rank_uris and secondary_rank_uris
+   */
+  ProtobufCBinaryData packed_rank_uris;
 };
 #define MGMT__GET_ATTACH_INFO_RESP__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__get_attach_info_resp__descriptor) \
-    , 0, 0,NULL, 0,NULL, NULL, 0, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, NULL, 0,NULL }
+    , 0, 0,NULL, 0,NULL, NULL, 0, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, NULL, 0,NULL, (char *)protobuf_c_empty_string, {0,NULL} }
 
 
 struct  _Mgmt__PrepShutdownReq
@@ -523,6 +544,39 @@ struct  _Mgmt__ClientTelemetryResp
     , 0, 0 }
 
 
+/*
+ * FaultInjectEngineReq sets a fault injection point (fail_loc) on DAOS I/O
+ * Engines. Only engines built with fault injection support act on it.
+ */
+struct  _Mgmt__FaultInjectEngineReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system name
+   */
+  char *sys;
+  /*
+   * Rank to inject the fault on, or NilRank for all ranks
+   */
+  uint32_t rank;
+  /*
+   * Fault injection location and frequency mode, or 0 to clear
+   */
+  uint64_t fail_loc;
+  /*
+   * Value passed to the fault injection point
+   */
+  uint64_t fail_value;
+  /*
+   * Number of times to trigger the fault in DAOS_FAIL_SOME mode
+   */
+  uint64_t fail_num;
+};
+#define MGMT__FAULT_INJECT_ENGINE_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__fault_inject_engine_req__descriptor) \
+    , (char *)protobuf_c_empty_string, 0, 0, 0, 0 }
+
+
 /* Mgmt__DaosResp methods */
 void   mgmt__daos_resp__init
                      (Mgmt__DaosResp         *message);
@@ -890,6 +944,25 @@ Mgmt__ClientTelemetryResp *
 void   mgmt__client_telemetry_resp__free_unpacked
                      (Mgmt__ClientTelemetryResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__FaultInjectEngineReq methods */
+void   mgmt__fault_inject_engine_req__init
+                     (Mgmt__FaultInjectEngineReq         *message);
+size_t mgmt__fault_inject_engine_req__get_packed_size
+                     (const Mgmt__FaultInjectEngineReq   *message);
+size_t mgmt__fault_inject_engine_req__pack
+                     (const Mgmt__FaultInjectEngineReq   *message,
+                      uint8_t             *out);
+size_t mgmt__fault_inject_engine_req__pack_to_buffer
+                     (const Mgmt__FaultInjectEngineReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__FaultInjectEngineReq *
+       mgmt__fault_inject_engine_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__fault_inject_engine_req__free_unpacked
+                     (Mgmt__FaultInjectEngineReq *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Mgmt__DaosResp_Closure)
@@ -955,6 +1028,9 @@ typedef void (*Mgmt__ClientTelemetryReq_Closure)
 typedef void (*Mgmt__ClientTelemetryResp_Closure)
                  (const Mgmt__ClientTelemetryResp *message,
                   void *closure_data);
+typedef void (*Mgmt__FaultInjectEngineReq_Closure)
+                 (const Mgmt__FaultInjectEngineReq *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -983,6 +1059,7 @@ extern const ProtobufCMessageDescriptor mgmt__set_rank_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_monitor_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__client_telemetry_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__client_telemetry_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__fault_inject_engine_req__descriptor;
 
 PROTOBUF_C__END_DECLS
 
@@ -9,6 +9,7 @@
  * Mocks for DAOS mgmt unit tests
  */
 
+#include <daos_srv/pool.h>
 #include "../svc.pb-c.h"
 #include "../srv_internal.h"
 #include "mocks.h"
@@ -275,6 +276,92 @@ void mock_ds_mgmt_pool_list_cont_teardown(void)
 	}
 }
 
+/*
+ * Mock ds_mgmt_pool_list_handles
+ */
+int			 ds_mgmt_pool_list_handles_return;
+struct ds_pool_hdl_info	*ds_mgmt_pool_list_handles_out;
+uint32_t		 ds_mgmt_pool_list_handles_n_out;
+
+int
+ds_mgmt_pool_list_handles(uuid_t uuid, d_rank_list_t *svc_ranks, struct ds_pool_hdl_info **hdls,
+			  uint32_t *n_hdls)
+{
+	uint32_t i;
+
+	if (hdls != NULL && n_hdls != NULL && ds_mgmt_pool_list_handles_out != NULL) {
+		*n_hdls = ds_mgmt_pool_list_handles_n_out;
+		D_ALLOC_ARRAY(*hdls, *n_hdls);
+		for (i = 0; i < *n_hdls; i++) {
+			struct ds_pool_hdl_info *src = &ds_mgmt_pool_list_handles_out[i];
+
+			uuid_copy((*hdls)[i].phi_uuid, src->phi_uuid);
+			(*hdls)[i].phi_connect_time = src->phi_connect_time;
+			if (src->phi_machine != NULL)
+				D_STRNDUP((*hdls)[i].phi_machine, src->phi_machine,
+					  strlen(src->phi_machine));
+			if (src->phi_user != NULL)
+				D_STRNDUP((*hdls)[i].phi_user, src->phi_user,
+					  strlen(src->phi_user));
+			if (src->phi_group != NULL)
+				D_STRNDUP((*hdls)[i].phi_group, src->phi_group,
+					  strlen(src->phi_group));
+		}
+	}
+
+	return ds_mgmt_pool_list_handles_return;
+}
+
+void
+ds_pool_hdl_info_free(struct ds_pool_hdl_info *hdls, uint32_t n_hdls)
+{
+	uint32_t i;
+
+	if (hdls == NULL)
+		return;
+
+	for (i = 0; i < n_hdls; i++) {
+		D_FREE(hdls[i].phi_machine);
+		D_FREE(hdls[i].phi_user);
+		D_FREE(hdls[i].phi_group);
+	}
+	D_FREE(hdls);
+}
+
+void
+mock_ds_mgmt_list_handles_gen_hdls(uint32_t nhdls)
+{
+	uint32_t i;
+
+	D_ALLOC_ARRAY(ds_mgmt_pool_list_handles_out, nhdls);
+	ds_mgmt_pool_list_handles_n_out = nhdls;
+	for (i = 0; i < nhdls; i++) {
+		uuid_generate(ds_mgmt_pool_list_handles_out[i].phi_uuid);
+		/* The first handle has no details, as if opened by an older engine. */
+		if (i == 0)
+			continue;
+		D_ASPRINTF(ds_mgmt_pool_list_handles_out[i].phi_machine, "host%u", i);
+		D_STRNDUP_S(ds_mgmt_pool_list_handles_out[i].phi_user, "user@");
+		D_STRNDUP_S(ds_mgmt_pool_list_handles_out[i].phi_group, "group@");
+		ds_mgmt_pool_list_handles_out[i].phi_connect_time = 1700000000 + i;
+	}
+}
+
+void
+mock_ds_mgmt_pool_list_handles_setup(void)
+{
+	ds_mgmt_pool_list_handles_return = 0;
+	ds_mgmt_pool_list_handles_n_out  = 0;
+	ds_mgmt_pool_list_handles_out    = NULL;
+}
+
+void
+mock_ds_mgmt_pool_list_handles_teardown(void)
+{
+	ds_pool_hdl_info_free(ds_mgmt_pool_list_handles_out, ds_mgmt_pool_list_handles_n_out);
+	ds_mgmt_pool_list_handles_out = NULL;
+}
+
 int              ds_mgmt_pool_query_return;
 uuid_t           ds_mgmt_pool_query_uuid;
 daos_pool_info_t ds_mgmt_pool_query_info_out;
@@ -441,6 +528,118 @@ void mock_ds_mgmt_cont_set_owner_teardown(void)
 	D_FREE(ds_mgmt_cont_set_owner_group);
 }
 
+int	ds_mgmt_cont_create_return;
+uuid_t	ds_mgmt_cont_create_pool;
+uuid_t	ds_mgmt_cont_create_cont;
+char   *ds_mgmt_cont_create_label;
+char   *ds_mgmt_cont_create_user;
+char   *ds_mgmt_cont_create_group;
+int
+ds_mgmt_cont_create(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *label,
+		    const char *user, const char *group, uuid_t cont_uuid)
+{
+	uuid_copy(ds_mgmt_cont_create_pool, pool_uuid);
+	if (label != NULL)
+		D_STRNDUP(ds_mgmt_cont_create_label, label, DAOS_PROP_LABEL_MAX_LEN);
+	if (user != NULL)
+		D_STRNDUP(ds_mgmt_cont_create_user, user, DAOS_ACL_MAX_PRINCIPAL_LEN);
+	if (group != NULL)
+		D_STRNDUP(ds_mgmt_cont_create_group, group, DAOS_ACL_MAX_PRINCIPAL_LEN);
+	uuid_copy(cont_uuid, ds_mgmt_cont_create_cont);
+
+	return ds_mgmt_cont_create_return;
+}
+
+void
+mock_ds_mgmt_cont_create_setup(void)
+{
+	ds_mgmt_cont_create_return = 0;
+
+	uuid_clear(ds_mgmt_cont_create_pool);
+	uuid_clear(ds_mgmt_cont_create_cont);
+	ds_mgmt_cont_create_label = NULL;
+	ds_mgmt_cont_create_user  = NULL;
+	ds_mgmt_cont_create_group = NULL;
+}
+
+void
+mock_ds_mgmt_cont_create_teardown(void)
+{
+	D_FREE(ds_mgmt_cont_create_label);
+	D_FREE(ds_mgmt_cont_create_user);
+	D_FREE(ds_mgmt_cont_create_group);
+}
+
+int	ds_mgmt_cont_destroy_return;
+uuid_t	ds_mgmt_cont_destroy_pool;
+char   *ds_mgmt_cont_destroy_cont;
+bool	ds_mgmt_cont_destroy_force;
+int
+ds_mgmt_cont_destroy(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *cont_id, bool force)
+{
+	uuid_copy(ds_mgmt_cont_destroy_pool, pool_uuid);
+	D_STRNDUP(ds_mgmt_cont_destroy_cont, cont_id, DAOS_PROP_LABEL_MAX_LEN);
+	ds_mgmt_cont_destroy_force = force;
+
+	return ds_mgmt_cont_destroy_return;
+}
+
+void
+mock_ds_mgmt_cont_destroy_setup(void)
+{
+	ds_mgmt_cont_destroy_return = 0;
+
+	uuid_clear(ds_mgmt_cont_destroy_pool);
+	ds_mgmt_cont_destroy_cont  = NULL;
+	ds_mgmt_cont_destroy_force = false;
+}
+
+void
+mock_ds_mgmt_cont_destroy_teardown(void)
+{
+	D_FREE(ds_mgmt_cont_destroy_cont);
+}
+
+int			ds_mgmt_cont_query_return;
+uuid_t			ds_mgmt_cont_query_pool;
+char		       *ds_mgmt_cont_query_cont;
+daos_cont_info_t	ds_mgmt_cont_query_info_out;
+daos_prop_t	       *ds_mgmt_cont_query_prop_out;
+int
+ds_mgmt_cont_query(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *cont_id,
+		   daos_cont_info_t *info, daos_prop_t **prop)
+{
+	uuid_copy(ds_mgmt_cont_query_pool, pool_uuid);
+	D_STRNDUP(ds_mgmt_cont_query_cont, cont_id, DAOS_PROP_LABEL_MAX_LEN);
+
+	/* caller frees the prop */
+	if (ds_mgmt_cont_query_return == 0) {
+		*info = ds_mgmt_cont_query_info_out;
+		if (ds_mgmt_cont_query_prop_out != NULL)
+			*prop = daos_prop_dup(ds_mgmt_cont_query_prop_out, false, false);
+	}
+
+	return ds_mgmt_cont_query_return;
+}
+
+void
+mock_ds_mgmt_cont_query_setup(void)
+{
+	ds_mgmt_cont_query_return = 0;
+
+	uuid_clear(ds_mgmt_cont_query_pool);
+	ds_mgmt_cont_query_cont = NULL;
+	memset(&ds_mgmt_cont_query_info_out, 0, sizeof(ds_mgmt_cont_query_info_out));
+	ds_mgmt_cont_query_prop_out = NULL;
+}
+
+void
+mock_ds_mgmt_cont_query_teardown(void)
+{
+	D_FREE(ds_mgmt_cont_query_cont);
+	daos_prop_free(ds_mgmt_cont_query_prop_out);
+}
+
 int     ds_mgmt_target_update_return;
 uuid_t  ds_mgmt_target_update_uuid;
 int
@@ -496,6 +695,35 @@ mock_ds_mgmt_pool_evict_setup(void)
 	uuid_clear(ds_mgmt_pool_evict_uuid);
 }
 
+/*
+ * Mock ds_mgmt_fault_inject
+ */
+int		ds_mgmt_fault_inject_return;
+d_rank_t	ds_mgmt_fault_inject_rank;
+uint64_t	ds_mgmt_fault_inject_loc;
+uint64_t	ds_mgmt_fault_inject_value;
+uint64_t	ds_mgmt_fault_inject_num;
+
+int
+ds_mgmt_fault_inject(d_rank_t rank, uint64_t fail_loc, uint64_t fail_value, uint64_t fail_num)
+{
+	ds_mgmt_fault_inject_rank = rank;
+	ds_mgmt_fault_inject_loc = fail_loc;
+	ds_mgmt_fault_inject_value = fail_value;
+	ds_mgmt_fault_inject_num = fail_num;
+	return ds_mgmt_fault_inject_return;
+}
+
+void
+mock_ds_mgmt_fault_inject_setup(void)
+{
+	ds_mgmt_fault_inject_return = 0;
+	ds_mgmt_fault_inject_rank = 0;
+	ds_mgmt_fault_inject_loc = 0;
+	ds_mgmt_fault_inject_value = 0;
+	ds_mgmt_fault_inject_num = 0;
+}
+
 /*
  * Stubs, to avoid linker errors
  * TODO: Implement mocks when there is a test that uses these
@@ -590,6 +818,23 @@ mock_ds_mgmt_pool_upgrade_setup(void)
 	uuid_clear(ds_mgmt_pool_upgrade_uuid);
 }
 
+int	ds_mgmt_pool_rebalance_return;
+uuid_t  ds_mgmt_pool_rebalance_uuid;
+
+int
+ds_mgmt_pool_rebalance(uuid_t pool_uuid, d_rank_list_t *svc_ranks)
+{
+	uuid_copy(ds_mgmt_pool_rebalance_uuid, pool_uuid);
+	return ds_mgmt_pool_rebalance_return;
+}
+
+void
+mock_ds_mgmt_pool_rebalance_setup(void)
+{
+	ds_mgmt_pool_rebalance_return = 0;
+	uuid_clear(ds_mgmt_pool_rebalance_uuid);
+}
+
 int	ds_mgmt_dev_manage_led_return;
 uuid_t  ds_mgmt_dev_manage_led_uuid;
 
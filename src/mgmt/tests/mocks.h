@@ -74,6 +74,17 @@ void mock_ds_mgmt_list_cont_gen_cont(size_t ncont);
 void mock_ds_mgmt_pool_list_cont_setup(void);
 void mock_ds_mgmt_pool_list_cont_teardown(void);
 
+/*
+ * Mock ds_mgmt_pool_list_handles
+ */
+extern int				 ds_mgmt_pool_list_handles_return;
+extern struct ds_pool_hdl_info		*ds_mgmt_pool_list_handles_out;
+extern uint32_t				 ds_mgmt_pool_list_handles_n_out;
+
+void mock_ds_mgmt_list_handles_gen_hdls(uint32_t nhdls);
+void mock_ds_mgmt_pool_list_handles_setup(void);
+void mock_ds_mgmt_pool_list_handles_teardown(void);
+
 /*
  * Mock ds_mgmt_pool_set_prop
  */
@@ -149,6 +160,39 @@ extern char                             *ds_mgmt_cont_set_owner_user;
 extern char                             *ds_mgmt_cont_set_owner_group;
 void mock_ds_mgmt_cont_set_owner_setup(void);
 void mock_ds_mgmt_cont_set_owner_teardown(void);
+
+/*
+ * Mock ds_mgmt_cont_create
+ */
+extern int	ds_mgmt_cont_create_return;
+extern uuid_t	ds_mgmt_cont_create_pool;
+extern uuid_t	ds_mgmt_cont_create_cont;
+extern char    *ds_mgmt_cont_create_label;
+extern char    *ds_mgmt_cont_create_user;
+extern char    *ds_mgmt_cont_create_group;
+void mock_ds_mgmt_cont_create_setup(void);
+void mock_ds_mgmt_cont_create_teardown(void);
+
+/*
+ * Mock ds_mgmt_cont_destroy
+ */
+extern int	ds_mgmt_cont_destroy_return;
+extern uuid_t	ds_mgmt_cont_destroy_pool;
+extern char    *ds_mgmt_cont_destroy_cont;
+extern bool	ds_mgmt_cont_destroy_force;
+void mock_ds_mgmt_cont_destroy_setup(void);
+void mock_ds_mgmt_cont_destroy_teardown(void);
+
+/*
+ * Mock ds_mgmt_cont_query
+ */
+extern int		ds_mgmt_cont_query_return;
+extern uuid_t		ds_mgmt_cont_query_pool;
+extern char	       *ds_mgmt_cont_query_cont;
+extern daos_cont_info_t	ds_mgmt_cont_query_info_out;
+extern daos_prop_t     *ds_mgmt_cont_query_prop_out;
+void mock_ds_mgmt_cont_query_setup(void);
+void mock_ds_mgmt_cont_query_teardown(void);
 void mock_ds_mgmt_pool_query_targets_gen_infos(uint32_t n_infos);
 
 /*
@@ -158,6 +202,13 @@ extern int	ds_mgmt_pool_upgrade_return;
 extern uuid_t	ds_mgmt_pool_upgrade_uuid;
 void mock_ds_mgmt_pool_upgrade_setup(void);
 
+/*
+ * Mock ds_mgmt_pool_rebalance
+ */
+extern int	ds_mgmt_pool_rebalance_return;
+extern uuid_t	ds_mgmt_pool_rebalance_uuid;
+void mock_ds_mgmt_pool_rebalance_setup(void);
+
 /*
  * Mock ds_mgmt_dev_manage_led
  */
@@ -180,5 +231,15 @@ extern int	ds_mgmt_dev_set_faulty_return;
 extern uuid_t	ds_mgmt_dev_set_faulty_uuid;
 void mock_ds_mgmt_dev_set_faulty_setup(void);
 
+/*
+ * Mock ds_mgmt_fault_inject
+ */
+extern int		ds_mgmt_fault_inject_return;
+extern d_rank_t		ds_mgmt_fault_inject_rank;
+extern uint64_t		ds_mgmt_fault_inject_loc;
+extern uint64_t		ds_mgmt_fault_inject_value;
+extern uint64_t		ds_mgmt_fault_inject_num;
+void mock_ds_mgmt_fault_inject_setup(void);
+
 
 #endif /* __MGMT_TESTS_MOCKS_H__ */
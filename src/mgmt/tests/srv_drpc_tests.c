@@ -18,6 +18,7 @@
 #include <daos/drpc.h>
 #include <daos_pool.h>
 #include <daos_security.h>
+#include <daos_srv/pool.h>
 #include <uuid/uuid.h>
 #include "../acl.pb-c.h"
 #include "../pool.pb-c.h"
@@ -109,6 +110,7 @@ test_mgmt_drpc_handlers_bad_call_payload(void **state)
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_get_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_overwrite_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_upgrade);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_rebalance);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_update_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_delete_acl);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_query);
@@ -120,7 +122,11 @@ test_mgmt_drpc_handlers_bad_call_payload(void **state)
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_dev_manage_led);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_dev_replace);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_list_cont);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_list_handles);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_set_owner);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_create);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_destroy);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_cont_query);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_upgrade);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_group_update);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_check_start);
@@ -128,6 +134,7 @@ test_mgmt_drpc_handlers_bad_call_payload(void **state)
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_check_query);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_check_prop);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_check_act);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_fault_inject);
 }
 
 static daos_prop_t *
@@ -802,6 +809,176 @@ test_drpc_pool_list_cont_with_containers(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * dRPC List Pool Handles setup/teardown
+ */
+
+static int
+drpc_list_handles_setup(void **state)
+{
+	mock_ds_mgmt_pool_list_handles_setup();
+
+	return 0;
+}
+
+static int
+drpc_list_handles_teardown(void **state)
+{
+	mock_ds_mgmt_pool_list_handles_teardown();
+
+	return 0;
+}
+
+/*
+ * dRPC List Pool Handles tests
+ */
+static void
+setup_list_handles_drpc_call(Drpc__Call *call, char *uuid)
+{
+	Mgmt__PoolListHandlesReq	 req = MGMT__POOL_LIST_HANDLES_REQ__INIT;
+	size_t				 len;
+	uint8_t				*body;
+
+	req.id = uuid;
+
+	len = mgmt__pool_list_handles_req__get_packed_size(&req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__pool_list_handles_req__pack(&req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_list_handles_resp_with_error(Drpc__Response *resp, int expected_err)
+{
+	Mgmt__PoolListHandlesResp *lh_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	lh_resp = mgmt__pool_list_handles_resp__unpack(NULL, resp->body.len, resp->body.data);
+	assert_non_null(lh_resp);
+
+	assert_int_equal(lh_resp->status, expected_err);
+
+	mgmt__pool_list_handles_resp__free_unpacked(lh_resp, NULL);
+}
+
+static void
+expect_drpc_list_handles_resp_with_handles(Drpc__Response *resp,
+					   struct ds_pool_hdl_info *exp_hdls,
+					   uint32_t exp_hdls_len)
+{
+	Mgmt__PoolListHandlesResp	*lh_resp = NULL;
+	size_t				 n_machines = 0;
+	uint32_t			 i;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	lh_resp = mgmt__pool_list_handles_resp__unpack(NULL, resp->body.len, resp->body.data);
+	assert_non_null(lh_resp);
+	assert_int_equal(lh_resp->status, 0);
+
+	assert_int_equal(lh_resp->n_handles, exp_hdls_len);
+
+	for (i = 0; i < exp_hdls_len; i++) {
+		Mgmt__PoolHandle	*hdl = lh_resp->handles[i];
+		char			 exp_uuid[DAOS_UUID_STR_SIZE];
+
+		uuid_unparse(exp_hdls[i].phi_uuid, exp_uuid);
+		assert_string_equal(hdl->uuid, exp_uuid);
+		assert_int_equal(hdl->connect_time, exp_hdls[i].phi_connect_time);
+
+		if (exp_hdls[i].phi_machine == NULL) {
+			assert_string_equal(hdl->machine, "");
+			assert_string_equal(hdl->user, "");
+			assert_string_equal(hdl->group, "");
+			continue;
+		}
+		assert_string_equal(hdl->machine, exp_hdls[i].phi_machine);
+		assert_string_equal(hdl->user, exp_hdls[i].phi_user);
+		assert_string_equal(hdl->group, exp_hdls[i].phi_group);
+
+		assert_true(n_machines < lh_resp->n_machines);
+		assert_string_equal(lh_resp->machines[n_machines], exp_hdls[i].phi_machine);
+		n_machines++;
+	}
+	assert_int_equal(lh_resp->n_machines, n_machines);
+
+	mgmt__pool_list_handles_resp__free_unpacked(lh_resp, NULL);
+}
+
+static void
+test_drpc_pool_list_handles_bad_uuid(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_list_handles_drpc_call(&call, "invalid UUID");
+
+	ds_mgmt_drpc_pool_list_handles(&call, &resp);
+
+	expect_drpc_list_handles_resp_with_error(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_list_handles_mgmt_svc_fails(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_list_handles_drpc_call(&call, TEST_UUID);
+	ds_mgmt_pool_list_handles_return = -DER_MISC;
+
+	ds_mgmt_drpc_pool_list_handles(&call, &resp);
+
+	expect_drpc_list_handles_resp_with_error(&resp, ds_mgmt_pool_list_handles_return);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_list_handles_no_handles(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_list_handles_drpc_call(&call, TEST_UUID);
+
+	ds_mgmt_drpc_pool_list_handles(&call, &resp);
+
+	expect_drpc_list_handles_resp_with_handles(&resp, NULL, 0);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_list_handles_with_handles(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+	const uint32_t	nhdls = 16;
+
+	setup_list_handles_drpc_call(&call, TEST_UUID);
+	mock_ds_mgmt_list_handles_gen_hdls(nhdls);
+
+	ds_mgmt_drpc_pool_list_handles(&call, &resp);
+
+	expect_drpc_list_handles_resp_with_handles(&resp, ds_mgmt_pool_list_handles_out, nhdls);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * dRPC Pool SetProp setup/teardown
  */
@@ -1335,8 +1512,10 @@ init_test_pool_info(daos_pool_info_t *pool_info)
 static void
 init_test_rebuild_status(struct daos_rebuild_status *rebuild)
 {
-	rebuild->rs_obj_nr = 101;
-	rebuild->rs_rec_nr = 102;
+	rebuild->rs_obj_nr        = 101;
+	rebuild->rs_rec_nr        = 102;
+	rebuild->rs_toberb_obj_nr = 103;
+	rebuild->rs_seconds       = 104;
 }
 
 static void
@@ -1363,6 +1542,9 @@ expect_rebuild_status(struct daos_rebuild_status *exp,
 	assert_int_equal(actual->status, exp->rs_errno);
 	assert_int_equal(actual->objects, exp->rs_obj_nr);
 	assert_int_equal(actual->records, exp->rs_rec_nr);
+	assert_int_equal(actual->total_objects, exp->rs_toberb_obj_nr);
+	assert_int_equal(actual->duration_sec, exp->rs_seconds);
+	assert_int_equal(actual->version, exp->rs_version);
 	assert_int_equal(actual->state, exp_state);
 }
 
@@ -1509,8 +1691,10 @@ test_drpc_pool_query_success_rebuild_err(void **state)
 	 * rebuild results returned to us shouldn't include the number of
 	 * objects/records if there's an error.
 	 */
-	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_obj_nr = 42;
-	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_rec_nr = 999;
+	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_obj_nr        = 42;
+	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_rec_nr        = 999;
+	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_toberb_obj_nr = 84;
+	ds_mgmt_pool_query_info_out.pi_rebuild_st.rs_seconds       = 5;
 
 	setup_pool_query_drpc_call(&call, TEST_UUID, 0);
 
@@ -2546,6 +2730,406 @@ test_drpc_cont_set_owner_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * dRPC cont create setup/teardown
+ */
+
+static int
+drpc_cont_create_setup(void **state)
+{
+	mock_ds_mgmt_cont_create_setup();
+
+	return 0;
+}
+
+static int
+drpc_cont_create_teardown(void **state)
+{
+	mock_ds_mgmt_cont_create_teardown();
+
+	return 0;
+}
+
+/*
+ * dRPC cont create tests
+ */
+
+static void
+setup_cont_create_drpc_call(Drpc__Call *call, Mgmt__ContCreateReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__cont_create_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__cont_create_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_cont_create_resp_with_status(Drpc__Response *resp, int expected_err,
+					 const char *expected_uuid)
+{
+	Mgmt__ContCreateResp *payload_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	payload_resp = mgmt__cont_create_resp__unpack(NULL, resp->body.len, resp->body.data);
+	assert_non_null(payload_resp);
+	assert_int_equal(payload_resp->status, expected_err);
+	assert_string_equal(payload_resp->cont_uuid, expected_uuid);
+
+	mgmt__cont_create_resp__free_unpacked(payload_resp, NULL);
+}
+
+static void
+test_drpc_cont_create_bad_pool_uuid(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContCreateReq	req = MGMT__CONT_CREATE_REQ__INIT;
+
+	req.pool_id     = "this isn't really a uuid";
+	req.label       = "cont_label";
+	req.owner_user  = "user@";
+	req.owner_group = "group@";
+
+	setup_cont_create_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_create(&call, &resp);
+
+	expect_drpc_cont_create_resp_with_status(&resp, -DER_INVAL, "");
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_create_failed(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContCreateReq	req = MGMT__CONT_CREATE_REQ__INIT;
+	int			exp_rc = -DER_EXIST;
+
+	req.pool_id     = "11111111-1111-1111-1111-111111111111";
+	req.label       = "cont_label";
+	req.owner_user  = "user@";
+	req.owner_group = "group@";
+
+	setup_cont_create_drpc_call(&call, &req);
+	ds_mgmt_cont_create_return = exp_rc;
+
+	ds_mgmt_drpc_cont_create(&call, &resp);
+
+	expect_drpc_cont_create_resp_with_status(&resp, exp_rc, "");
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_create_success(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContCreateReq	req = MGMT__CONT_CREATE_REQ__INIT;
+	char                   *cont_uuid_str = "22222222-2222-2222-2222-222222222222";
+	uuid_t                  pool_uuid;
+
+	req.pool_id     = "11111111-1111-1111-1111-111111111111";
+	req.label       = "cont_label";
+	req.owner_user  = "user@";
+	req.owner_group = "group@";
+
+	setup_cont_create_drpc_call(&call, &req);
+	assert_int_equal(uuid_parse(cont_uuid_str, ds_mgmt_cont_create_cont), 0);
+
+	ds_mgmt_drpc_cont_create(&call, &resp);
+
+	expect_drpc_cont_create_resp_with_status(&resp, 0, cont_uuid_str);
+
+	/* Verify mgmt_cont_create called with correct params */
+	assert_int_equal(uuid_parse(req.pool_id, pool_uuid), 0);
+	assert_int_equal(uuid_compare(pool_uuid, ds_mgmt_cont_create_pool), 0);
+	assert_string_equal(req.label, ds_mgmt_cont_create_label);
+	assert_string_equal(req.owner_user, ds_mgmt_cont_create_user);
+	assert_string_equal(req.owner_group, ds_mgmt_cont_create_group);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+/*
+ * dRPC cont destroy setup/teardown
+ */
+
+static int
+drpc_cont_destroy_setup(void **state)
+{
+	mock_ds_mgmt_cont_destroy_setup();
+
+	return 0;
+}
+
+static int
+drpc_cont_destroy_teardown(void **state)
+{
+	mock_ds_mgmt_cont_destroy_teardown();
+
+	return 0;
+}
+
+/*
+ * dRPC cont destroy tests
+ */
+
+static void
+setup_cont_destroy_drpc_call(Drpc__Call *call, Mgmt__ContDestroyReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__cont_destroy_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__cont_destroy_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+test_drpc_cont_destroy_bad_pool_uuid(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContDestroyReq	req = MGMT__CONT_DESTROY_REQ__INIT;
+
+	req.pool_id = "this isn't really a uuid";
+	req.cont_id = "11111111-1111-1111-1111-111111111111";
+
+	setup_cont_destroy_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_destroy(&call, &resp);
+
+	expect_drpc_cont_set_owner_resp_with_status(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_destroy_failed(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContDestroyReq	req = MGMT__CONT_DESTROY_REQ__INIT;
+	int			exp_rc = -DER_BUSY;
+
+	req.pool_id = "11111111-1111-1111-1111-111111111111";
+	req.cont_id = "22222222-2222-2222-2222-222222222222";
+
+	setup_cont_destroy_drpc_call(&call, &req);
+	ds_mgmt_cont_destroy_return = exp_rc;
+
+	ds_mgmt_drpc_cont_destroy(&call, &resp);
+
+	expect_drpc_cont_set_owner_resp_with_status(&resp, exp_rc);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_destroy_success(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContDestroyReq	req = MGMT__CONT_DESTROY_REQ__INIT;
+	uuid_t                  pool_uuid;
+
+	req.pool_id = "11111111-1111-1111-1111-111111111111";
+	req.cont_id = "cont_label";
+	req.force   = true;
+
+	setup_cont_destroy_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_destroy(&call, &resp);
+
+	expect_drpc_cont_set_owner_resp_with_status(&resp, 0);
+
+	/* Verify mgmt_cont_destroy called with correct params */
+	assert_int_equal(uuid_parse(req.pool_id, pool_uuid), 0);
+	assert_int_equal(uuid_compare(pool_uuid, ds_mgmt_cont_destroy_pool), 0);
+	assert_string_equal(req.cont_id, ds_mgmt_cont_destroy_cont);
+	assert_true(ds_mgmt_cont_destroy_force);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+/*
+ * dRPC cont query setup/teardown
+ */
+
+static int
+drpc_cont_query_setup(void **state)
+{
+	mock_ds_mgmt_cont_query_setup();
+
+	return 0;
+}
+
+static int
+drpc_cont_query_teardown(void **state)
+{
+	mock_ds_mgmt_cont_query_teardown();
+
+	return 0;
+}
+
+/*
+ * dRPC cont query tests
+ */
+
+static void
+setup_cont_query_drpc_call(Drpc__Call *call, Mgmt__ContQueryReq *req)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = mgmt__cont_query_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__cont_query_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static Mgmt__ContQueryResp *
+expect_drpc_cont_query_resp_with_status(Drpc__Response *resp, int expected_err)
+{
+	Mgmt__ContQueryResp *payload_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	payload_resp = mgmt__cont_query_resp__unpack(NULL, resp->body.len, resp->body.data);
+	assert_non_null(payload_resp);
+	assert_int_equal(payload_resp->status, expected_err);
+
+	return payload_resp;
+}
+
+static void
+test_drpc_cont_query_bad_pool_uuid(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContQueryReq	req = MGMT__CONT_QUERY_REQ__INIT;
+	Mgmt__ContQueryResp    *query_resp;
+
+	req.pool_id = "this isn't really a uuid";
+	req.cont_id = "11111111-1111-1111-1111-111111111111";
+
+	setup_cont_query_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_query(&call, &resp);
+
+	query_resp = expect_drpc_cont_query_resp_with_status(&resp, -DER_INVAL);
+	mgmt__cont_query_resp__free_unpacked(query_resp, NULL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_query_failed(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContQueryReq	req = MGMT__CONT_QUERY_REQ__INIT;
+	Mgmt__ContQueryResp    *query_resp;
+	int			exp_rc = -DER_NONEXIST;
+
+	req.pool_id = "11111111-1111-1111-1111-111111111111";
+	req.cont_id = "22222222-2222-2222-2222-222222222222";
+
+	setup_cont_query_drpc_call(&call, &req);
+	ds_mgmt_cont_query_return = exp_rc;
+
+	ds_mgmt_drpc_cont_query(&call, &resp);
+
+	query_resp = expect_drpc_cont_query_resp_with_status(&resp, exp_rc);
+	assert_string_equal(query_resp->uuid, "");
+	mgmt__cont_query_resp__free_unpacked(query_resp, NULL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_cont_query_success(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__ContQueryReq	req = MGMT__CONT_QUERY_REQ__INIT;
+	Mgmt__ContQueryResp    *query_resp;
+	char                   *cont_uuid_str = "22222222-2222-2222-2222-222222222222";
+	daos_prop_t            *prop;
+	uuid_t                  pool_uuid;
+
+	req.pool_id = "11111111-1111-1111-1111-111111111111";
+	req.cont_id = "cont_label";
+
+	prop = daos_prop_alloc(4);
+	assert_non_null(prop);
+	prop->dpp_entries[0].dpe_type = DAOS_PROP_CO_LABEL;
+	D_STRNDUP_S(prop->dpp_entries[0].dpe_str, "cont_label");
+	prop->dpp_entries[1].dpe_type = DAOS_PROP_CO_OWNER;
+	D_STRNDUP_S(prop->dpp_entries[1].dpe_str, "user@");
+	prop->dpp_entries[2].dpe_type = DAOS_PROP_CO_OWNER_GROUP;
+	D_STRNDUP_S(prop->dpp_entries[2].dpe_str, "group@");
+	prop->dpp_entries[3].dpe_type = DAOS_PROP_CO_LAYOUT_TYPE;
+	prop->dpp_entries[3].dpe_val  = DAOS_PROP_CO_LAYOUT_POSIX;
+	ds_mgmt_cont_query_prop_out = prop;
+
+	assert_int_equal(uuid_parse(cont_uuid_str, ds_mgmt_cont_query_info_out.ci_uuid), 0);
+	ds_mgmt_cont_query_info_out.ci_nhandles   = 3;
+	ds_mgmt_cont_query_info_out.ci_nsnapshots = 2;
+
+	setup_cont_query_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_cont_query(&call, &resp);
+
+	query_resp = expect_drpc_cont_query_resp_with_status(&resp, 0);
+	assert_string_equal(query_resp->uuid, cont_uuid_str);
+	assert_string_equal(query_resp->label, "cont_label");
+	assert_string_equal(query_resp->owner_user, "user@");
+	assert_string_equal(query_resp->owner_group, "group@");
+	assert_string_equal(query_resp->layout_type, "POSIX");
+	assert_int_equal(query_resp->num_handles, 3);
+	assert_int_equal(query_resp->num_snapshots, 2);
+	mgmt__cont_query_resp__free_unpacked(query_resp, NULL);
+
+	/* Verify mgmt_cont_query called with correct params */
+	assert_int_equal(uuid_parse(req.pool_id, pool_uuid), 0);
+	assert_int_equal(uuid_compare(pool_uuid, ds_mgmt_cont_query_pool), 0);
+	assert_string_equal(req.cont_id, ds_mgmt_cont_query_cont);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * Pool upgrade test setup
  */
@@ -2648,6 +3232,106 @@ test_drpc_pool_upgrade_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * Pool rebalance test setup
+ */
+static int
+drpc_rebalance_setup(void **state)
+{
+	mock_ds_mgmt_pool_rebalance_setup();
+	return 0;
+}
+
+/*
+ * dRPC pool rebalance tests
+ */
+static void
+setup_rebalance_drpc_call(Drpc__Call *call, char *uuid, char *sys_name)
+{
+	Mgmt__PoolRebalanceReq	 req = MGMT__POOL_REBALANCE_REQ__INIT;
+	size_t			 len;
+	uint8_t			*body;
+
+	req.id = uuid;
+	req.sys = sys_name;
+
+	len = mgmt__pool_rebalance_req__get_packed_size(&req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__pool_rebalance_req__pack(&req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_rebalance_resp_with_status(Drpc__Response *resp, int exp_status)
+{
+	Mgmt__PoolRebalanceResp	*pb_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	pb_resp = mgmt__pool_rebalance_resp__unpack(NULL, resp->body.len,
+						    resp->body.data);
+	assert_non_null(pb_resp);
+	assert_int_equal(pb_resp->status, exp_status);
+
+	mgmt__pool_rebalance_resp__free_unpacked(pb_resp, NULL);
+}
+
+static void
+test_drpc_pool_rebalance_bad_uuid(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_rebalance_drpc_call(&call, "BAD", "DaosSys");
+
+	ds_mgmt_drpc_pool_rebalance(&call, &resp);
+
+	expect_drpc_rebalance_resp_with_status(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_rebalance_mgmt_svc_fails(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_rebalance_drpc_call(&call, TEST_UUID, "DaosSys");
+	ds_mgmt_pool_rebalance_return = -DER_BUSY;
+
+	ds_mgmt_drpc_pool_rebalance(&call, &resp);
+	expect_drpc_rebalance_resp_with_status(&resp, -DER_BUSY);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_pool_rebalance_success(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+	uuid_t		exp_uuid;
+
+	setup_rebalance_drpc_call(&call, TEST_UUID, "DaosSys");
+	ds_mgmt_drpc_pool_rebalance(&call, &resp);
+
+	expect_drpc_rebalance_resp_with_status(&resp, 0);
+	if (uuid_parse(TEST_UUID, exp_uuid))
+		fail_msg("Couldn't parse UUID");
+	assert_int_equal(uuid_compare(exp_uuid, ds_mgmt_pool_rebalance_uuid), 0);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*/
  * LED manage test setup
  */
@@ -2999,6 +3683,96 @@ test_drpc_check_act_success(void **state)
 {
 }
 
+/*
+ * dRPC fault inject setup/tests
+ */
+
+static int
+drpc_fault_inject_setup(void **state)
+{
+	mock_ds_mgmt_fault_inject_setup();
+
+	return 0;
+}
+
+static void
+setup_fault_inject_drpc_call(Drpc__Call *call, Mgmt__FaultInjectEngineReq *req)
+{
+	size_t	 len;
+	uint8_t	*body;
+
+	len = mgmt__fault_inject_engine_req__get_packed_size(req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__fault_inject_engine_req__pack(req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_fault_inject_resp_with_status(Drpc__Response *resp, int expected_err)
+{
+	Mgmt__DaosResp *payload_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	payload_resp = mgmt__daos_resp__unpack(NULL, resp->body.len, resp->body.data);
+	assert_non_null(payload_resp);
+	assert_int_equal(payload_resp->status, expected_err);
+
+	mgmt__daos_resp__free_unpacked(payload_resp, NULL);
+}
+
+static void
+test_drpc_fault_inject_fails(void **state)
+{
+	Drpc__Call			call = DRPC__CALL__INIT;
+	Drpc__Response			resp = DRPC__RESPONSE__INIT;
+	Mgmt__FaultInjectEngineReq	req = MGMT__FAULT_INJECT_ENGINE_REQ__INIT;
+
+	req.rank = 1;
+	req.fail_loc = DAOS_FAIL_ONCE | 1;
+	setup_fault_inject_drpc_call(&call, &req);
+	ds_mgmt_fault_inject_return = -DER_MISC;
+
+	ds_mgmt_drpc_fault_inject(&call, &resp);
+
+	expect_drpc_fault_inject_resp_with_status(&resp, -DER_MISC);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_fault_inject_success(void **state)
+{
+	Drpc__Call			call = DRPC__CALL__INIT;
+	Drpc__Response			resp = DRPC__RESPONSE__INIT;
+	Mgmt__FaultInjectEngineReq	req = MGMT__FAULT_INJECT_ENGINE_REQ__INIT;
+
+	req.rank = CRT_NO_RANK;
+	req.fail_loc = DAOS_FAIL_SOME | 2;
+	req.fail_value = 5;
+	req.fail_num = 3;
+	setup_fault_inject_drpc_call(&call, &req);
+
+	ds_mgmt_drpc_fault_inject(&call, &resp);
+
+	expect_drpc_fault_inject_resp_with_status(&resp, 0);
+
+	/* Verify ds_mgmt_fault_inject called with correct params */
+	assert_int_equal(ds_mgmt_fault_inject_rank, req.rank);
+	assert_int_equal(ds_mgmt_fault_inject_loc, req.fail_loc);
+	assert_int_equal(ds_mgmt_fault_inject_value, req.fail_value);
+	assert_int_equal(ds_mgmt_fault_inject_num, req.fail_num);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 #define ACL_TEST(x)	cmocka_unit_test_setup_teardown(x, \
 						drpc_pool_acl_setup, \
 						drpc_pool_acl_teardown)
@@ -3007,6 +3781,10 @@ test_drpc_check_act_success(void **state)
 						drpc_list_cont_setup, \
 						drpc_list_cont_teardown)
 
+#define LIST_HANDLES_TEST(x) cmocka_unit_test_setup_teardown(x, \
+						drpc_list_handles_setup, \
+						drpc_list_handles_teardown)
+
 #define POOL_SET_PROP_TEST(x) cmocka_unit_test_setup_teardown(x, \
 						drpc_pool_set_prop_setup, \
 						drpc_pool_set_prop_teardown)
@@ -3041,6 +3819,9 @@ test_drpc_check_act_success(void **state)
 #define POOL_UPGRADE_TEST(x)	cmocka_unit_test_setup(x, \
 						drpc_upgrade_setup)
 
+#define POOL_REBALANCE_TEST(x)	cmocka_unit_test_setup(x, \
+						drpc_rebalance_setup)
+
 #define PING_RANK_TEST(x)	cmocka_unit_test(x)
 
 #define PREP_SHUTDOWN_TEST(x)	cmocka_unit_test(x)
@@ -3051,6 +3832,18 @@ test_drpc_check_act_success(void **state)
 						drpc_cont_set_owner_setup, \
 						drpc_cont_set_owner_teardown)
 
+#define CONT_CREATE_TEST(x)	cmocka_unit_test_setup_teardown(x, \
+						drpc_cont_create_setup, \
+						drpc_cont_create_teardown)
+
+#define CONT_DESTROY_TEST(x)	cmocka_unit_test_setup_teardown(x, \
+						drpc_cont_destroy_setup, \
+						drpc_cont_destroy_teardown)
+
+#define CONT_QUERY_TEST(x)	cmocka_unit_test_setup_teardown(x, \
+						drpc_cont_query_setup, \
+						drpc_cont_query_teardown)
+
 #define LED_MANAGE_TEST(x)	cmocka_unit_test_setup(x, drpc_dev_manage_led_setup)
 
 #define DEV_REPLACE_TEST(x)	cmocka_unit_test_setup(x, drpc_dev_replace_setup)
@@ -3067,6 +3860,8 @@ test_drpc_check_act_success(void **state)
 
 #define CHECK_ACT_TEST(x)	cmocka_unit_test(x)
 
+#define FAULT_INJECT_TEST(x)	cmocka_unit_test_setup(x, drpc_fault_inject_setup)
+
 
 int
 main(void)
@@ -3092,6 +3887,10 @@ main(void)
 	    LIST_CONT_TEST(test_drpc_pool_list_cont_mgmt_svc_fails),
 	    LIST_CONT_TEST(test_drpc_pool_list_cont_no_containers),
 	    LIST_CONT_TEST(test_drpc_pool_list_cont_with_containers),
+	    LIST_HANDLES_TEST(test_drpc_pool_list_handles_bad_uuid),
+	    LIST_HANDLES_TEST(test_drpc_pool_list_handles_mgmt_svc_fails),
+	    LIST_HANDLES_TEST(test_drpc_pool_list_handles_no_handles),
+	    LIST_HANDLES_TEST(test_drpc_pool_list_handles_with_handles),
 	    POOL_SET_PROP_TEST(test_drpc_pool_set_prop_invalid_value_type),
 	    POOL_SET_PROP_TEST(test_drpc_pool_set_prop_bad_uuid),
 	    POOL_SET_PROP_TEST(test_drpc_pool_set_prop_success),
@@ -3130,9 +3929,21 @@ main(void)
 	    CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_bad_pool_uuid),
 	    CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_failed),
 	    CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_success),
+	    CONT_CREATE_TEST(test_drpc_cont_create_bad_pool_uuid),
+	    CONT_CREATE_TEST(test_drpc_cont_create_failed),
+	    CONT_CREATE_TEST(test_drpc_cont_create_success),
+	    CONT_DESTROY_TEST(test_drpc_cont_destroy_bad_pool_uuid),
+	    CONT_DESTROY_TEST(test_drpc_cont_destroy_failed),
+	    CONT_DESTROY_TEST(test_drpc_cont_destroy_success),
+	    CONT_QUERY_TEST(test_drpc_cont_query_bad_pool_uuid),
+	    CONT_QUERY_TEST(test_drpc_cont_query_failed),
+	    CONT_QUERY_TEST(test_drpc_cont_query_success),
 	    POOL_UPGRADE_TEST(test_drpc_pool_upgrade_bad_uuid),
 	    POOL_UPGRADE_TEST(test_drpc_pool_upgrade_mgmt_svc_fails),
 	    POOL_UPGRADE_TEST(test_drpc_pool_upgrade_success),
+	    POOL_REBALANCE_TEST(test_drpc_pool_rebalance_bad_uuid),
+	    POOL_REBALANCE_TEST(test_drpc_pool_rebalance_mgmt_svc_fails),
+	    POOL_REBALANCE_TEST(test_drpc_pool_rebalance_success),
 	    LED_MANAGE_TEST(test_drpc_dev_manage_led_bad_tr_addr),
 	    LED_MANAGE_TEST(test_drpc_dev_manage_led_fails),
 	    LED_MANAGE_TEST(test_drpc_dev_manage_led_success),
@@ -3148,6 +3959,8 @@ main(void)
 	    CHECK_QUERY_TEST(test_drpc_check_query_success),
 	    CHECK_PROP_TEST(test_drpc_check_prop_success),
 	    CHECK_ACT_TEST(test_drpc_check_act_success),
+	    FAULT_INJECT_TEST(test_drpc_fault_inject_fails),
+	    FAULT_INJECT_TEST(test_drpc_fault_inject_success),
 	};
 
 	return cmocka_run_group_tests_name("mgmt_srv_drpc", tests, NULL, NULL);
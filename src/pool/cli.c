@@ -239,23 +239,27 @@ struct daos_module_key dc_pool_module_key = {
 int
 dc_pool_init(void)
 {
-	uint32_t		ver_array[2] = {DAOS_POOL_VERSION - 1, DAOS_POOL_VERSION};
+	uint32_t		ver_array[3] = {DAOS_POOL_VERSION - 2, DAOS_POOL_VERSION - 1,
+						DAOS_POOL_VERSION};
 	int			rc;
 
 	if (daos_client_metric)
 		daos_register_key(&dc_pool_module_key);
 
 	dc_pool_proto_version = 0;
-	rc = daos_rpc_proto_query(pool_proto_fmt_v6.cpf_base, ver_array, 2, &dc_pool_proto_version);
+	rc = daos_rpc_proto_query(pool_proto_fmt_v6.cpf_base, ver_array, 3, &dc_pool_proto_version);
 	if (rc)
 		return rc;
 
-	if (dc_pool_proto_version == DAOS_POOL_VERSION - 1) {
+	if (dc_pool_proto_version == DAOS_POOL_VERSION - 2) {
 		rc = daos_rpc_register(&pool_proto_fmt_v6, POOL_PROTO_CLI_COUNT, NULL,
 				       DAOS_POOL_MODULE);
-	} else if (dc_pool_proto_version == DAOS_POOL_VERSION) {
+	} else if (dc_pool_proto_version == DAOS_POOL_VERSION - 1) {
 		rc = daos_rpc_register(&pool_proto_fmt_v7, POOL_PROTO_CLI_COUNT, NULL,
 				       DAOS_POOL_MODULE);
+	} else if (dc_pool_proto_version == DAOS_POOL_VERSION) {
+		rc = daos_rpc_register(&pool_proto_fmt_v8, POOL_PROTO_CLI_COUNT, NULL,
+				       DAOS_POOL_MODULE);
 	} else {
 		D_ERROR("%d version pool RPC not supported.\n", dc_pool_proto_version);
 		rc = -DER_PROTO;
@@ -276,10 +280,12 @@ dc_pool_fini(void)
 {
 	int rc;
 
-	if (dc_pool_proto_version == DAOS_POOL_VERSION - 1) {
+	if (dc_pool_proto_version == DAOS_POOL_VERSION - 2) {
 		rc = daos_rpc_unregister(&pool_proto_fmt_v6);
-	} else if (dc_pool_proto_version == DAOS_POOL_VERSION) {
+	} else if (dc_pool_proto_version == DAOS_POOL_VERSION - 1) {
 		rc = daos_rpc_unregister(&pool_proto_fmt_v7);
+	} else if (dc_pool_proto_version == DAOS_POOL_VERSION) {
+		rc = daos_rpc_unregister(&pool_proto_fmt_v8);
 	} else {
 		rc = -DER_PROTO;
 		DL_ERROR(rc, "%d version pool RPC not supported", dc_pool_proto_version);
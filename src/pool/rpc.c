@@ -198,6 +198,18 @@ CRT_RPC_DEFINE(pool_query_info_v6, DAOS_ISEQ_POOL_QUERY_INFO, DAOS_OSEQ_POOL_QUE
 CRT_RPC_DEFINE(pool_query_info, DAOS_ISEQ_POOL_QUERY_INFO, DAOS_OSEQ_POOL_QUERY_INFO)
 CRT_RPC_DEFINE(pool_tgt_query_map, DAOS_ISEQ_POOL_TGT_QUERY_MAP, DAOS_OSEQ_POOL_TGT_QUERY_MAP)
 CRT_RPC_DEFINE(pool_tgt_discard, DAOS_ISEQ_POOL_TGT_DISCARD, DAOS_OSEQ_POOL_TGT_DISCARD)
+
+CRT_GEN_PROC_FUNC(pool_hdl_entry, DAOS_SEQ_POOL_HDL_ENTRY);
+
+static int
+crt_proc_struct_pool_hdl_entry(crt_proc_t proc, crt_proc_op_t proc_op,
+			       struct pool_hdl_entry *data)
+{
+	return crt_proc_pool_hdl_entry(proc, data);
+}
+
+CRT_RPC_DEFINE(pool_list_hdls, DAOS_ISEQ_POOL_LIST_HDLS, DAOS_OSEQ_POOL_LIST_HDLS)
+CRT_RPC_DEFINE(pool_rebalance, DAOS_ISEQ_POOL_REBALANCE, DAOS_OSEQ_POOL_REBALANCE)
 CRT_RPC_DEFINE(pool_tgt_warmup, DAOS_ISEQ_POOL_TGT_WARMUP, DAOS_OSEQ_POOL_TGT_WARMUP)
 
 /* Define for cont_rpcs[] array population below.
@@ -215,6 +227,9 @@ static struct crt_proto_rpc_format pool_proto_rpc_fmt_v6[] = {POOL_PROTO_CLI_RPC
 								  POOL_PROTO_SRV_RPC_LIST(6)};
 static struct crt_proto_rpc_format pool_proto_rpc_fmt_v7[] = {POOL_PROTO_CLI_RPC_LIST(7)
 								  POOL_PROTO_SRV_RPC_LIST(7)};
+static struct crt_proto_rpc_format pool_proto_rpc_fmt_v8[] = {POOL_PROTO_CLI_RPC_LIST(8)
+								  POOL_PROTO_SRV_RPC_LIST(8)
+								      POOL_PROTO_SRV_RPC_LIST_V8};
 
 #undef X
 
@@ -230,6 +245,12 @@ struct crt_proto_format pool_proto_fmt_v7 = {.cpf_name  = "pool",
 					     .cpf_prf   = pool_proto_rpc_fmt_v7,
 					     .cpf_base  = DAOS_RPC_OPCODE(0, DAOS_POOL_MODULE, 0)};
 
+struct crt_proto_format pool_proto_fmt_v8 = {.cpf_name  = "pool",
+					     .cpf_ver   = 8,
+					     .cpf_count = ARRAY_SIZE(pool_proto_rpc_fmt_v8),
+					     .cpf_prf   = pool_proto_rpc_fmt_v8,
+					     .cpf_base  = DAOS_RPC_OPCODE(0, DAOS_POOL_MODULE, 0)};
+
 uint64_t
 pool_query_bits(daos_pool_info_t *po_info, daos_prop_t *prop)
 {
@@ -334,6 +355,12 @@ pool_query_bits(daos_pool_info_t *po_info, daos_prop_t *prop)
 		case DAOS_PROP_PO_SVC_OPS_ENTRY_AGE:
 			bits |= DAOS_PO_QUERY_PROP_SVC_OPS_ENTRY_AGE;
 			break;
+		case DAOS_PROP_PO_BW_LIMIT:
+			bits |= DAOS_PO_QUERY_PROP_BW_LIMIT;
+			break;
+		case DAOS_PROP_PO_IOPS_LIMIT:
+			bits |= DAOS_PO_QUERY_PROP_IOPS_LIMIT;
+			break;
 		default:
 			D_ERROR("ignore bad dpt_type %d.\n", entry->dpe_type);
 			break;
@@ -29,7 +29,7 @@
  * These are for daos_rpc::dr_opc and DAOS_RPC_OPCODE(opc, ...) rather than
  * crt_req_create(..., opc, ...). See src/include/daos/rpc.h.
  */
-#define DAOS_POOL_VERSION              7
+#define DAOS_POOL_VERSION              8
 /* LIST of internal RPCS in form of:
  * OPCODE, flags, FMT, handler, corpc_hdlr,
  */
@@ -79,9 +79,17 @@
 	X(POOL_UPGRADE, 0, &CQF_pool_upgrade, ds_pool_upgrade_handler, NULL)                       \
 	X(POOL_TGT_DISCARD, 0, &CQF_pool_tgt_discard, ds_pool_tgt_discard_handler, NULL)
 
+/* Server RPCs added in protocol version 8, only registered on that version and later.
+ * They must stay at the end of the protocol, after POOL_PROTO_SRV_RPC_LIST.
+ */
+#define POOL_PROTO_SRV_RPC_LIST_V8                                                                 \
+	X(POOL_LIST_HDLS, 0, &CQF_pool_list_hdls, ds_pool_list_hdls_handler, NULL)                 \
+	X(POOL_REBALANCE, 0, &CQF_pool_rebalance, ds_pool_rebalance_handler, NULL)
+
 #define POOL_PROTO_RPC_LIST                                                                        \
 	POOL_PROTO_CLI_RPC_LIST(DAOS_POOL_VERSION)                                                 \
-	POOL_PROTO_SRV_RPC_LIST(DAOS_POOL_VERSION)
+	POOL_PROTO_SRV_RPC_LIST(DAOS_POOL_VERSION)                                                 \
+	POOL_PROTO_SRV_RPC_LIST_V8
 
 /* Define for RPC enum population below */
 #define X(a, b, c, d, e) a,
@@ -91,6 +99,7 @@ enum pool_operation {
 	POOL_PROTO_CLI_RPC_LIST(DAOS_POOL_VERSION) POOL_PROTO_CLI_COUNT,
 	POOL_PROTO_CLI_LAST = POOL_PROTO_CLI_COUNT - 1,
 	POOL_PROTO_SRV_RPC_LIST(DAOS_POOL_VERSION)
+	POOL_PROTO_SRV_RPC_LIST_V8
 };
 
 #undef X
@@ -99,6 +108,7 @@ char *dc_pool_op_str(enum pool_operation op);
 
 extern struct crt_proto_format pool_proto_fmt_v6;
 extern struct crt_proto_format pool_proto_fmt_v7;
+extern struct crt_proto_format pool_proto_fmt_v8;
 extern int dc_pool_proto_version;
 
 /* clang-format off */
@@ -902,6 +912,32 @@ CRT_RPC_DECLARE(pool_tgt_query_map, DAOS_ISEQ_POOL_TGT_QUERY_MAP, DAOS_OSEQ_POOL
 
 CRT_RPC_DECLARE(pool_tgt_discard, DAOS_ISEQ_POOL_TGT_DISCARD, DAOS_OSEQ_POOL_TGT_DISCARD)
 
+#define DAOS_SEQ_POOL_HDL_ENTRY		/* listed pool handle */	 \
+	((uuid_t)			(phe_uuid)		CRT_VAR) \
+	((d_string_t)			(phe_machine)		CRT_VAR) \
+	((d_string_t)			(phe_user)		CRT_VAR) \
+	((d_string_t)			(phe_group)		CRT_VAR) \
+	((uint64_t)			(phe_connect_time)	CRT_VAR)
+
+CRT_GEN_STRUCT(pool_hdl_entry, DAOS_SEQ_POOL_HDL_ENTRY);
+
+#define DAOS_ISEQ_POOL_LIST_HDLS	/* input fields */		 \
+	((struct pool_op_in)		(plhi_op)		CRT_VAR)
+
+#define DAOS_OSEQ_POOL_LIST_HDLS	/* output fields */		 \
+	((struct pool_op_out)		(plho_op)		CRT_VAR) \
+	((struct pool_hdl_entry)	(plho_hdls)		CRT_ARRAY)
+
+CRT_RPC_DECLARE(pool_list_hdls, DAOS_ISEQ_POOL_LIST_HDLS, DAOS_OSEQ_POOL_LIST_HDLS)
+
+#define DAOS_ISEQ_POOL_REBALANCE	/* input fields */		 \
+	((struct pool_op_in)		(pbi_op)		CRT_VAR)
+
+#define DAOS_OSEQ_POOL_REBALANCE	/* output fields */		 \
+	((struct pool_op_out)		(pbo_op)		CRT_VAR)
+
+CRT_RPC_DECLARE(pool_rebalance, DAOS_ISEQ_POOL_REBALANCE, DAOS_OSEQ_POOL_REBALANCE)
+
 /* clang-format on */
 
 static inline int
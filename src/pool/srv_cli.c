@@ -791,6 +791,118 @@ pool_get_prop_init(uuid_t pool_uuid, crt_rpc_t *rpc, void *varg)
 	return 0;
 }
 
+struct pool_list_hdls_arg {
+	struct ds_pool_hdl_info	**plha_hdls;
+	uint32_t		 *plha_n_hdls;
+};
+
+static int
+pool_list_hdls_consume(uuid_t pool_uuid, crt_rpc_t *rpc, void *varg)
+{
+	struct pool_list_hdls_arg	*arg = varg;
+	struct pool_list_hdls_out	*out = crt_reply_get(rpc);
+	struct pool_hdl_entry		*entries = out->plho_hdls.ca_arrays;
+	struct ds_pool_hdl_info		*hdls = NULL;
+	uint32_t			 n_hdls = out->plho_hdls.ca_count;
+	uint32_t			 i;
+	int				 rc = out->plho_op.po_rc;
+
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to list pool handles", DP_UUID(pool_uuid));
+		return rc;
+	}
+
+	if (n_hdls > 0) {
+		D_ALLOC_ARRAY(hdls, n_hdls);
+		if (hdls == NULL)
+			return -DER_NOMEM;
+	}
+
+	for (i = 0; i < n_hdls; i++) {
+		uuid_copy(hdls[i].phi_uuid, entries[i].phe_uuid);
+		hdls[i].phi_connect_time = entries[i].phe_connect_time;
+		if (entries[i].phe_machine != NULL) {
+			D_STRNDUP(hdls[i].phi_machine, entries[i].phe_machine, MAXHOSTNAMELEN);
+			if (hdls[i].phi_machine == NULL)
+				D_GOTO(out_free, rc = -DER_NOMEM);
+		}
+		if (entries[i].phe_user != NULL) {
+			D_STRNDUP(hdls[i].phi_user, entries[i].phe_user,
+				  DAOS_ACL_MAX_PRINCIPAL_LEN);
+			if (hdls[i].phi_user == NULL)
+				D_GOTO(out_free, rc = -DER_NOMEM);
+		}
+		if (entries[i].phe_group != NULL) {
+			D_STRNDUP(hdls[i].phi_group, entries[i].phe_group,
+				  DAOS_ACL_MAX_PRINCIPAL_LEN);
+			if (hdls[i].phi_group == NULL)
+				D_GOTO(out_free, rc = -DER_NOMEM);
+		}
+	}
+
+	D_DEBUG(DB_MGMT, DF_UUID ": listed %u pool handles\n", DP_UUID(pool_uuid), n_hdls);
+	*arg->plha_hdls   = hdls;
+	*arg->plha_n_hdls = n_hdls;
+	return 0;
+
+out_free:
+	ds_pool_hdl_info_free(hdls, n_hdls);
+	return rc;
+}
+
+static struct dsc_pool_svc_call_cbs pool_list_hdls_cbs = {
+	.pscc_op	= POOL_LIST_HDLS,
+	.pscc_init	= NULL,
+	.pscc_consume	= pool_list_hdls_consume,
+	.pscc_fini	= NULL
+};
+
+/**
+ * List the open handles on a pool.
+ *
+ * \param[in]	pool_uuid	UUID of the pool
+ * \param[in]	ranks		Pool service replicas
+ * \param[in]	deadline	Unix time deadline in milliseconds
+ * \param[out]	hdls		Open pool handles. Caller frees with ds_pool_hdl_info_free().
+ * \param[out]	n_hdls		Number of items in hdls
+ *
+ * \return	0		Success
+ *		Negative value	Error
+ */
+int
+dsc_pool_svc_list_hdls(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline,
+		       struct ds_pool_hdl_info **hdls, uint32_t *n_hdls)
+{
+	struct pool_list_hdls_arg arg = {
+		.plha_hdls	= hdls,
+		.plha_n_hdls	= n_hdls
+	};
+
+	if (hdls == NULL || n_hdls == NULL)
+		return -DER_INVAL;
+
+	*hdls   = NULL;
+	*n_hdls = 0;
+	D_DEBUG(DB_MGMT, DF_UUID ": Listing pool handles\n", DP_UUID(pool_uuid));
+	return dsc_pool_svc_call(pool_uuid, ranks, &pool_list_hdls_cbs, &arg, deadline);
+}
+
+void
+ds_pool_hdl_info_free(struct ds_pool_hdl_info *hdls, uint32_t n_hdls)
+{
+	uint32_t i;
+
+	if (hdls == NULL)
+		return;
+
+	for (i = 0; i < n_hdls; i++) {
+		D_FREE(hdls[i].phi_machine);
+		D_FREE(hdls[i].phi_user);
+		D_FREE(hdls[i].phi_group);
+	}
+	D_FREE(hdls);
+}
+
 static int
 pool_get_prop_consume(uuid_t pool_uuid, crt_rpc_t *rpc, void *varg)
 {
@@ -1229,3 +1341,40 @@ dsc_pool_svc_upgrade(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline)
 	D_DEBUG(DB_MGMT, DF_UUID ": Upgrading pool prop\n", DP_UUID(pool_uuid));
 	return dsc_pool_svc_call(pool_uuid, ranks, &pool_upgrade_cbs, NULL /* arg */, deadline);
 }
+
+static int
+pool_rebalance_consume(uuid_t pool_uuid, crt_rpc_t *rpc, void *varg)
+{
+	struct pool_rebalance_out *out = crt_reply_get(rpc);
+	int                        rc  = out->pbo_op.po_rc;
+
+	if (rc != 0)
+		DL_ERROR(rc, DF_UUID ": failed to rebalance pool", DP_UUID(pool_uuid));
+	return rc;
+}
+
+static struct dsc_pool_svc_call_cbs pool_rebalance_cbs = {
+	.pscc_op	= POOL_REBALANCE,
+	.pscc_init	= NULL,
+	.pscc_consume	= pool_rebalance_consume,
+	.pscc_fini	= NULL
+};
+
+/**
+ * Start redistributing the existing data of a pool onto the targets that are
+ * in the pool map but do not hold their share of the data yet.
+ *
+ * \param[in]	pool_uuid	UUID of the pool
+ * \param[in]	ranks		Pool service replicas
+ * \param[in]	deadline	Unix time deadline in milliseconds
+ *
+ * \return	0		Success
+ *		-DER_BUSY	A rebuild is already in progress
+ *		Negative value	Other error
+ */
+int
+dsc_pool_svc_rebalance(uuid_t pool_uuid, d_rank_list_t *ranks, uint64_t deadline)
+{
+	D_DEBUG(DB_MGMT, DF_UUID ": Rebalancing pool\n", DP_UUID(pool_uuid));
+	return dsc_pool_svc_call(pool_uuid, ranks, &pool_rebalance_cbs, NULL /* arg */, deadline);
+}
@@ -102,6 +102,8 @@ struct pool_iv_prop {
 	uint32_t	pip_reint_mode;
 	uint32_t         pip_svc_ops_enabled;
 	uint32_t         pip_svc_ops_entry_age;
+	uint64_t         pip_bw_limit;
+	uint64_t         pip_iops_limit;
 	char		pip_iv_buf[0];
 };
 
@@ -200,6 +202,8 @@ void
      ds_pool_query_info_handler(crt_rpc_t *rpc);
 void ds_pool_ranks_get_handler(crt_rpc_t *rpc);
 void ds_pool_upgrade_handler(crt_rpc_t *rpc);
+void ds_pool_list_hdls_handler(crt_rpc_t *rpc);
+void ds_pool_rebalance_handler(crt_rpc_t *rpc);
 
 /*
  * srv_target.c
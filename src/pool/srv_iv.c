@@ -225,6 +225,12 @@ pool_iv_prop_l2g(daos_prop_t *prop, struct pool_iv_prop *iv_prop)
 		case DAOS_PROP_PO_SVC_OPS_ENTRY_AGE:
 			iv_prop->pip_svc_ops_entry_age = prop_entry->dpe_val;
 			break;
+		case DAOS_PROP_PO_BW_LIMIT:
+			iv_prop->pip_bw_limit = prop_entry->dpe_val;
+			break;
+		case DAOS_PROP_PO_IOPS_LIMIT:
+			iv_prop->pip_iops_limit = prop_entry->dpe_val;
+			break;
 		default:
 			D_ASSERTF(0, "bad dpe_type %d\n", prop_entry->dpe_type);
 			break;
@@ -365,6 +371,12 @@ pool_iv_prop_g2l(struct pool_iv_prop *iv_prop, daos_prop_t *prop)
 		case DAOS_PROP_PO_SVC_OPS_ENTRY_AGE:
 			prop_entry->dpe_val = iv_prop->pip_svc_ops_entry_age;
 			break;
+		case DAOS_PROP_PO_BW_LIMIT:
+			prop_entry->dpe_val = iv_prop->pip_bw_limit;
+			break;
+		case DAOS_PROP_PO_IOPS_LIMIT:
+			prop_entry->dpe_val = iv_prop->pip_iops_limit;
+			break;
 		default:
 			D_ASSERTF(0, "bad dpe_type %d\n", prop_entry->dpe_type);
 			break;
@@ -56,6 +56,8 @@ RDB_STRING_KEY(ds_pool_prop_, checkpoint_mode);
 RDB_STRING_KEY(ds_pool_prop_, checkpoint_freq);
 RDB_STRING_KEY(ds_pool_prop_, checkpoint_thresh);
 RDB_STRING_KEY(ds_pool_prop_, reint_mode);
+RDB_STRING_KEY(ds_pool_prop_, bw_limit);
+RDB_STRING_KEY(ds_pool_prop_, iops_limit);
 
 /** default properties, should cover all optional pool properties */
 struct daos_prop_entry pool_prop_entries_default[DAOS_PROP_PO_NUM] = {
@@ -165,6 +167,14 @@ struct daos_prop_entry pool_prop_entries_default[DAOS_PROP_PO_NUM] = {
     {
 	.dpe_type = DAOS_PROP_PO_SVC_OPS_ENTRY_AGE,
 	.dpe_val  = DAOS_PROP_PO_SVC_OPS_ENTRY_AGE_DEFAULT,
+    },
+    {
+	.dpe_type = DAOS_PROP_PO_BW_LIMIT,
+	.dpe_val  = DAOS_PROP_PO_BW_LIMIT_DEFAULT,
+    },
+    {
+	.dpe_type = DAOS_PROP_PO_IOPS_LIMIT,
+	.dpe_val  = DAOS_PROP_PO_IOPS_LIMIT_DEFAULT,
     }};
 
 daos_prop_t pool_prop_default = {
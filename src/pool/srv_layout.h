@@ -37,6 +37,13 @@
  * because version is absent from pool_buf, it has to be stored separately in
  * ds_pool_prop_map_version.
  *
+ * The ds_pool_prop_bw_limit and ds_pool_prop_iops_limit properties store the
+ * maximum aggregate bandwidth (bytes per second) and I/O operations per second
+ * of the pool, 0 meaning no limit. Pools created before the properties were
+ * introduced lack them and have no limits. The limits are only advisory: they
+ * are distributed to the targets of the pool, but I/O is not throttled to
+ * enforce them.
+ *
  * IMPORTANT! Please add new keys to this KVS like this:
  *
  *   extern d_iov_t ds_pool_prop_new_key;	comment_on_value_type
@@ -87,13 +94,18 @@ extern d_iov_t ds_pool_prop_svc_ops_num;        /* uint32_t */
 extern d_iov_t ds_pool_prop_svc_ops_age;        /* uint32_t */
 extern d_iov_t ds_pool_prop_srv_handle;         /* uuid_t */
 extern d_iov_t ds_pool_prop_srv_cont_handle;    /* uuid_t */
+extern d_iov_t ds_pool_prop_bw_limit;           /* uint64_t */
+extern d_iov_t ds_pool_prop_iops_limit;         /* uint64_t */
 /* Please read the IMPORTANT notes above before adding new keys. */
 
 /*
  * Pool handle KVS (RDB_KVS_GENERIC)
  *
  * Each key is a pool handle UUID in uuid_t. Each value is a pool_hdl object
- * defined below.
+ * defined below. In pools of global version 5 or later, it is followed by the
+ * connect time of the handle in seconds since the Unix epoch (uint64_t) right
+ * after ph_cred. Handles written before the pool was upgraded to version 5 end
+ * with ph_cred.
  */
 struct pool_hdl {
 	uint64_t	ph_flags;
@@ -44,6 +44,7 @@
 #define DAOS_POOL_GLOBAL_VERSION_WITH_SVC_OPS_KVS 3
 #define DAOS_POOL_GLOBAL_VERSION_WITH_DATA_THRESH 3
 #define DAOS_POOL_GLOBAL_VERSION_WITH_SRV_HDLS    4
+#define DAOS_POOL_GLOBAL_VERSION_WITH_HDL_TIME    5
 
 #define PS_OPS_PER_SEC                            4096
 
@@ -54,7 +55,7 @@
 uint32_t
 ds_pool_get_vos_df_version(uint32_t pool_global_version)
 {
-	if (pool_global_version == 4)
+	if (pool_global_version == 5 || pool_global_version == 4)
 		return VOS_POOL_DF_2_8;
 	if (pool_global_version == 3)
 		return VOS_POOL_DF_2_6;
@@ -439,6 +440,8 @@ pool_prop_default_copy(daos_prop_t *prop_def, daos_prop_t *prop)
 		case DAOS_PROP_PO_CHECKPOINT_THRESH:
 		case DAOS_PROP_PO_CHECKPOINT_FREQ:
 		case DAOS_PROP_PO_REINT_MODE:
+		case DAOS_PROP_PO_BW_LIMIT:
+		case DAOS_PROP_PO_IOPS_LIMIT:
 			entry_def->dpe_val = entry->dpe_val;
 			break;
 		case DAOS_PROP_PO_ACL:
@@ -743,6 +746,14 @@ pool_prop_write(struct rdb_tx *tx, const rdb_path_t *kvs, daos_prop_t *prop)
 			if (rc)
 				return rc;
 			break;
+		case DAOS_PROP_PO_BW_LIMIT:
+			d_iov_set(&value, &entry->dpe_val, sizeof(entry->dpe_val));
+			rc = rdb_tx_update(tx, kvs, &ds_pool_prop_bw_limit, &value);
+			break;
+		case DAOS_PROP_PO_IOPS_LIMIT:
+			d_iov_set(&value, &entry->dpe_val, sizeof(entry->dpe_val));
+			rc = rdb_tx_update(tx, kvs, &ds_pool_prop_iops_limit, &value);
+			break;
 		default:
 			D_ERROR("bad dpe_type %d.\n", entry->dpe_type);
 			return -DER_INVAL;
@@ -3426,6 +3437,42 @@ pool_prop_read(struct rdb_tx *tx, const struct pool_svc *svc, uint64_t bits,
 		idx++;
 	}
 
+	if (bits & DAOS_PO_QUERY_PROP_BW_LIMIT) {
+		d_iov_set(&value, &val, sizeof(val));
+		rc = rdb_tx_lookup(tx, &svc->ps_root, &ds_pool_prop_bw_limit, &value);
+		/* Pools created before the property existed have no limit. */
+		if (rc == -DER_NONEXIST) {
+			rc  = 0;
+			val = DAOS_PROP_PO_BW_LIMIT_DEFAULT;
+		} else if (rc != 0) {
+			DL_ERROR(rc, DF_UUID ": failed to lookup DAOS_PROP_PO_BW_LIMIT",
+				 DP_UUID(svc->ps_uuid));
+			D_GOTO(out_prop, rc);
+		}
+		D_ASSERT(idx < nr);
+		prop->dpp_entries[idx].dpe_type = DAOS_PROP_PO_BW_LIMIT;
+		prop->dpp_entries[idx].dpe_val  = val;
+		idx++;
+	}
+
+	if (bits & DAOS_PO_QUERY_PROP_IOPS_LIMIT) {
+		d_iov_set(&value, &val, sizeof(val));
+		rc = rdb_tx_lookup(tx, &svc->ps_root, &ds_pool_prop_iops_limit, &value);
+		/* Pools created before the property existed have no limit. */
+		if (rc == -DER_NONEXIST) {
+			rc  = 0;
+			val = DAOS_PROP_PO_IOPS_LIMIT_DEFAULT;
+		} else if (rc != 0) {
+			DL_ERROR(rc, DF_UUID ": failed to lookup DAOS_PROP_PO_IOPS_LIMIT",
+				 DP_UUID(svc->ps_uuid));
+			D_GOTO(out_prop, rc);
+		}
+		D_ASSERT(idx < nr);
+		prop->dpp_entries[idx].dpe_type = DAOS_PROP_PO_IOPS_LIMIT;
+		prop->dpp_entries[idx].dpe_val  = val;
+		idx++;
+	}
+
 	*prop_out = prop;
 	return 0;
 
@@ -3450,6 +3497,7 @@ pool_op_is_write(crt_opcode_t opc)
 	case POOL_FILTER_CONT:
 	case POOL_PROP_GET:
 	case POOL_RANKS_GET:
+	case POOL_LIST_HDLS:
 	/* opcodes not handled by pool service */
 	case POOL_TGT_QUERY_MAP:
 	case POOL_TGT_DISCONNECT:
@@ -3906,6 +3954,38 @@ out:
 	crt_reply_send(rpc);
 }
 
+/* Size of a pool handle value, without the trailing connect time. */
+static inline size_t
+pool_hdl_size(struct pool_hdl *hdl)
+{
+	return sizeof(*hdl) + hdl->ph_cred_len;
+}
+
+/* Is \a val a pool handle value with or without the trailing connect time? */
+static bool
+pool_hdl_size_valid(d_iov_t *val)
+{
+	struct pool_hdl *hdl = val->iov_buf;
+
+	if (val->iov_len < sizeof(*hdl))
+		return false;
+	return val->iov_len == pool_hdl_size(hdl) ||
+	       val->iov_len == pool_hdl_size(hdl) + sizeof(uint64_t);
+}
+
+/* Connect time of the pool handle value \a val, or 0 if it was not recorded. */
+static uint64_t
+pool_hdl_connect_time(d_iov_t *val)
+{
+	struct pool_hdl *hdl = val->iov_buf;
+	uint64_t         connect_time;
+
+	if (val->iov_len != pool_hdl_size(hdl) + sizeof(connect_time))
+		return 0;
+	memcpy(&connect_time, &hdl->ph_cred[hdl->ph_cred_len], sizeof(connect_time));
+	return connect_time;
+}
+
 static int
 pool_connect_iv_dist(struct pool_svc *svc, uuid_t pool_hdl,
 		     uint64_t flags, uint64_t sec_capas, d_iov_t *cred,
@@ -3946,8 +4026,12 @@ bulk_cb(const struct crt_bulk_cb_info *cb_info)
 	return 0;
 }
 
-/* Currently we only maintain compatibility between 2 metadata layout versions */
-#define NUM_POOL_VERSIONS	2
+/*
+ * Currently we only maintain compatibility (and upgrades) between 2 metadata
+ * layout versions, counting versions 4 and 5, which differ only in the pool
+ * handle values, as one.
+ */
+#define NUM_POOL_VERSIONS	3
 
 static void
 pool_connect_handler(crt_rpc_t *rpc, int handler_version)
@@ -3962,6 +4046,7 @@ pool_connect_handler(crt_rpc_t *rpc, int handler_version)
 	d_iov_t				key;
 	d_iov_t				value;
 	struct pool_hdl		       *hdl = NULL;
+	uint64_t			connect_time;
 	uint32_t			nhandles;
 	int				skip_update = 0;
 	int				rc;
@@ -4226,7 +4311,7 @@ pool_connect_handler(crt_rpc_t *rpc, int handler_version)
 
 	/* handle did not exist so create it */
 	/* XXX may be can check pool version to avoid allocating too much ? */
-	D_ALLOC(hdl, sizeof(*hdl) + credp->iov_len);
+	D_ALLOC(hdl, sizeof(*hdl) + credp->iov_len + sizeof(connect_time));
 	if (hdl == NULL)
 		D_GOTO(out_map_version, rc = -DER_NOMEM);
 
@@ -4239,9 +4324,16 @@ pool_connect_handler(crt_rpc_t *rpc, int handler_version)
 
 	nhandles++;
 	d_iov_set(&key, in->pci_op.pi_hdl, sizeof(uuid_t));
-	d_iov_set(&value, hdl,
-		  svc->ps_global_version >= DAOS_POOL_GLOBAL_VERSION_WITH_HDL_CRED ?
-		  sizeof(struct pool_hdl) + hdl->ph_cred_len : sizeof(struct pool_hdl_v0));
+	if (svc->ps_global_version >= DAOS_POOL_GLOBAL_VERSION_WITH_HDL_TIME) {
+		/* Engines that predate version 5 expect the value to end with ph_cred. */
+		connect_time = d_hlc2unixnsec(d_hlc_get()) / NSEC_PER_SEC;
+		memcpy(&hdl->ph_cred[hdl->ph_cred_len], &connect_time, sizeof(connect_time));
+		d_iov_set(&value, hdl, pool_hdl_size(hdl) + sizeof(connect_time));
+	} else if (svc->ps_global_version >= DAOS_POOL_GLOBAL_VERSION_WITH_HDL_CRED) {
+		d_iov_set(&value, hdl, pool_hdl_size(hdl));
+	} else {
+		d_iov_set(&value, hdl, sizeof(struct pool_hdl_v0));
+	}
 	D_DEBUG(DB_MD, "writing a pool connect handle in db, size %zu, pool version %u\n",
 		value.iov_len, svc->ps_global_version);
 	rc = rdb_tx_update(&tx, &svc->ps_handles, &key, &value);
@@ -5199,6 +5291,8 @@ pool_query_handler(crt_rpc_t *rpc, int handler_version)
 			case DAOS_PROP_PO_SVC_OPS_ENABLED:
 			case DAOS_PROP_PO_SVC_OPS_ENTRY_AGE:
 			case DAOS_PROP_PO_DATA_THRESH:
+			case DAOS_PROP_PO_BW_LIMIT:
+			case DAOS_PROP_PO_IOPS_LIMIT:
 				if (entry->dpe_val != iv_entry->dpe_val) {
 					D_ERROR("type %d mismatch "DF_U64" - "
 						DF_U64".\n", entry->dpe_type,
@@ -6310,7 +6404,7 @@ ds_pool_upgrade_if_needed(uuid_t pool_uuid, struct rsvc_hint *po_hint, struct po
 				D_GOTO(out_upgrade, rc = 0);
 			} else if (upgrade_global_ver < DAOS_POOL_GLOBAL_VERSION &&
 				   dmg_upgrade_cmd) {
-				if (DAOS_POOL_GLOBAL_VERSION - upgrade_global_ver == 1)
+				if (DAOS_POOL_GLOBAL_VERSION - upgrade_global_ver < NUM_POOL_VERSIONS)
 					D_GOTO(out_upgrade, rc = 0);
 				D_ERROR(DF_UUID ": upgrading pool %u -> %u\n is unsupported"
 						" please upgrade pool to %u firstly\n",
@@ -7763,14 +7857,14 @@ evict_iter_cb(daos_handle_t ih, d_iov_t *key, d_iov_t *val, void *varg)
 	} else {
 		struct pool_hdl *hdl = val->iov_buf;
 
-		if (val->iov_len != sizeof(struct pool_hdl) + hdl->ph_cred_len ||
+		if (!pool_hdl_size_valid(val) ||
 		    arg->eia_pool_svc->ps_global_version < DAOS_POOL_GLOBAL_VERSION_WITH_HDL_CRED) {
 			D_ERROR("invalid value size: "DF_U64" for pool version %u, expected %zu\n",
 				val->iov_len, arg->eia_pool_svc->ps_global_version,
 				arg->eia_pool_svc->ps_global_version <
 				DAOS_POOL_GLOBAL_VERSION_WITH_HDL_CRED ?
 				sizeof(struct pool_hdl_v0) :
-				sizeof(struct pool_hdl) + hdl->ph_cred_len);
+				pool_hdl_size(hdl));
 			return -DER_IO;
 		}
 	}
@@ -8035,6 +8129,218 @@ out:
 	crt_reply_send(rpc);
 }
 
+struct list_hdls_iter_arg {
+	struct pool_hdl_entry	*lhia_hdls;
+	uint32_t		 lhia_hdls_cap;
+	uint32_t		 lhia_n_hdls;
+	struct pool_svc		*lhia_pool_svc;
+};
+
+static void
+pool_hdl_entries_free(struct pool_hdl_entry *hdls, uint32_t n_hdls)
+{
+	uint32_t i;
+
+	if (hdls == NULL)
+		return;
+
+	for (i = 0; i < n_hdls; i++) {
+		D_FREE(hdls[i].phe_machine);
+		D_FREE(hdls[i].phe_user);
+		D_FREE(hdls[i].phe_group);
+	}
+	D_FREE(hdls);
+}
+
+static int
+list_hdls_iter_cb(daos_handle_t ih, d_iov_t *key, d_iov_t *val, void *varg)
+{
+	struct list_hdls_iter_arg	*arg = varg;
+	struct pool_hdl			*hdl = val->iov_buf;
+	struct pool_hdl_entry		*entry;
+	d_iov_t				 cred;
+	int				 rc;
+
+	if (key->iov_len != sizeof(uuid_t)) {
+		D_ERROR("invalid key size: "DF_U64"\n", key->iov_len);
+		return -DER_IO;
+	}
+	if (val->iov_len != sizeof(struct pool_hdl_v0) && !pool_hdl_size_valid(val)) {
+		D_ERROR("invalid value size: "DF_U64" for pool version %u\n", val->iov_len,
+			arg->lhia_pool_svc->ps_global_version);
+		return -DER_IO;
+	}
+
+	if (arg->lhia_n_hdls == arg->lhia_hdls_cap) {
+		struct pool_hdl_entry	*hdls_tmp;
+		uint32_t		 cap_tmp = arg->lhia_hdls_cap * 2;
+
+		D_REALLOC_ARRAY(hdls_tmp, arg->lhia_hdls, arg->lhia_hdls_cap, cap_tmp);
+		if (hdls_tmp == NULL)
+			return -DER_NOMEM;
+		arg->lhia_hdls = hdls_tmp;
+		arg->lhia_hdls_cap = cap_tmp;
+	}
+
+	entry = &arg->lhia_hdls[arg->lhia_n_hdls];
+	uuid_copy(entry->phe_uuid, key->iov_buf);
+	arg->lhia_n_hdls++;
+
+	/* Old/2.0 pool handles record neither the machine nor the credential. */
+	if (val->iov_len == sizeof(struct pool_hdl_v0))
+		return 0;
+
+	D_STRNDUP(entry->phe_machine, hdl->ph_machine, sizeof(hdl->ph_machine) - 1);
+	if (entry->phe_machine == NULL)
+		return -DER_NOMEM;
+	entry->phe_connect_time = pool_hdl_connect_time(val);
+
+	d_iov_set(&cred, hdl->ph_cred, hdl->ph_cred_len);
+	rc = ds_sec_cred_get_principals(&cred, &entry->phe_user, &entry->phe_group);
+	if (rc == -DER_NOMEM)
+		return rc;
+	else if (rc != 0)
+		DL_WARN(rc, DF_UUID ": unable to get principals of handle " DF_UUID,
+			DP_UUID(arg->lhia_pool_svc->ps_uuid), DP_UUID(entry->phe_uuid));
+	return 0;
+}
+
+/*
+ * CaRT RPC handler run in PS leader to list the open pool handles, along with
+ * the machine and principals that opened each of them.
+ */
+void
+ds_pool_list_hdls_handler(crt_rpc_t *rpc)
+{
+	struct pool_list_hdls_in	*in = crt_req_get(rpc);
+	struct pool_list_hdls_out	*out = crt_reply_get(rpc);
+	struct list_hdls_iter_arg	 arg = {0};
+	struct pool_svc			*svc;
+	struct rdb_tx			 tx;
+	int				 rc;
+
+	D_DEBUG(DB_MD, DF_UUID ": processing rpc: %p\n", DP_UUID(in->plhi_op.pi_uuid), rpc);
+
+	rc = pool_svc_lookup_leader(in->plhi_op.pi_uuid, &svc, &out->plho_op.po_hint);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	/* This is a server to server RPC only */
+	if (daos_rpc_from_client(rpc))
+		D_GOTO(out_svc, rc = -DER_INVAL);
+
+	arg.lhia_hdls_cap = 4;
+	D_ALLOC_ARRAY(arg.lhia_hdls, arg.lhia_hdls_cap);
+	if (arg.lhia_hdls == NULL)
+		D_GOTO(out_svc, rc = -DER_NOMEM);
+	arg.lhia_pool_svc = svc;
+
+	rc = rdb_tx_begin(svc->ps_rsvc.s_db, svc->ps_rsvc.s_term, &tx);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	ABT_rwlock_rdlock(svc->ps_lock);
+	rc = rdb_tx_iterate(&tx, &svc->ps_handles, false /* backward */, list_hdls_iter_cb, &arg);
+	ABT_rwlock_unlock(svc->ps_lock);
+	rdb_tx_end(&tx);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	D_DEBUG(DB_MD, DF_UUID ": %u open handles\n", DP_UUID(in->plhi_op.pi_uuid),
+		arg.lhia_n_hdls);
+	out->plho_hdls.ca_arrays = arg.lhia_hdls;
+	out->plho_hdls.ca_count  = arg.lhia_n_hdls;
+
+out_svc:
+	ds_rsvc_set_hint(&svc->ps_rsvc, &out->plho_op.po_hint);
+	pool_svc_put_leader(svc);
+out:
+	out->plho_op.po_rc = rc;
+	D_DEBUG(DB_MD, DF_UUID ": replying rpc: %p " DF_RC "\n", DP_UUID(in->plhi_op.pi_uuid), rpc,
+		DP_RC(rc));
+	crt_reply_send(rpc);
+	pool_hdl_entries_free(arg.lhia_hdls, arg.lhia_n_hdls);
+}
+
+/*
+ * CaRT RPC handler run in PS leader to start redistributing the existing pool
+ * data onto the targets that are in the pool map but not yet holding data,
+ * e.g. after a pool extend while self-healing was disabled.
+ */
+void
+ds_pool_rebalance_handler(crt_rpc_t *rpc)
+{
+	struct pool_rebalance_in	*in = crt_req_get(rpc);
+	struct pool_rebalance_out	*out = crt_reply_get(rpc);
+	struct pool_svc			*svc;
+	struct pool_target		*tgts = NULL;
+	unsigned int			 tgt_cnt = 0;
+	struct pool_target_id_list	 tgt_list = {0};
+	struct daos_rebuild_status	 rs;
+	uint32_t			 map_version;
+	unsigned int			 i;
+	int				 rc;
+
+	D_DEBUG(DB_MD, DF_UUID ": processing rpc: %p\n", DP_UUID(in->pbi_op.pi_uuid), rpc);
+
+	rc = pool_svc_lookup_leader(in->pbi_op.pi_uuid, &svc, &out->pbo_op.po_hint);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	/* This is a server to server RPC only */
+	if (daos_rpc_from_client(rpc))
+		D_GOTO(out_svc, rc = -DER_INVAL);
+
+	rc = ds_rebuild_query(svc->ps_uuid, &rs);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+	if (rs.rs_state == DRS_IN_PROGRESS) {
+		D_ERROR(DF_UUID ": rebuild in progress, cannot rebalance\n",
+			DP_UUID(svc->ps_uuid));
+		D_GOTO(out_svc, rc = -DER_BUSY);
+	}
+
+	ABT_rwlock_rdlock(svc->ps_pool->sp_lock);
+	map_version = pool_map_get_version(svc->ps_pool->sp_map);
+	rc = pool_map_find_up_tgts(svc->ps_pool->sp_map, &tgts, &tgt_cnt);
+	ABT_rwlock_unlock(svc->ps_pool->sp_lock);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	if (tgt_cnt == 0) {
+		D_INFO(DF_UUID ": no targets waiting for data, nothing to rebalance\n",
+		       DP_UUID(svc->ps_uuid));
+		D_GOTO(out_svc, rc = 0);
+	}
+
+	for (i = 0; i < tgt_cnt; i++) {
+		struct pool_target_id tgt_id = {.pti_id = tgts[i].ta_comp.co_id};
+
+		rc = pool_target_id_list_append(&tgt_list, &tgt_id);
+		if (rc != 0)
+			D_GOTO(out_list, rc);
+	}
+
+	D_INFO(DF_UUID ": rebalancing onto %u targets, map version %u\n", DP_UUID(svc->ps_uuid),
+	       tgt_cnt, map_version);
+	rc = ds_rebuild_schedule(svc->ps_pool, map_version, d_hlc_get(), 0, &tgt_list,
+				 RB_OP_REBUILD, 0 /* delay_sec */);
+	if (rc != 0)
+		DL_ERROR(rc, DF_UUID ": failed to schedule rebalance", DP_UUID(svc->ps_uuid));
+
+out_list:
+	pool_target_id_list_free(&tgt_list);
+out_svc:
+	D_FREE(tgts);
+	ds_rsvc_set_hint(&svc->ps_rsvc, &out->pbo_op.po_hint);
+	pool_svc_put_leader(svc);
+out:
+	out->pbo_op.po_rc = rc;
+	D_DEBUG(DB_MD, DF_UUID ": replying rpc: %p " DF_RC "\n", DP_UUID(in->pbi_op.pi_uuid), rpc,
+		DP_RC(rc));
+	crt_reply_send(rpc);
+}
+
 /*
  * Transfer list of pool ranks to "remote_bulk". If the remote bulk buffer
  * is too small, then return -DER_TRUNC. RPC response will contain the number
@@ -2238,6 +2238,14 @@ ds_pool_tgt_prop_update(struct ds_pool *pool, struct pool_iv_prop *iv_prop)
 	pool->sp_scrub_thresh = iv_prop->pip_scrub_thresh;
 	pool->sp_reint_mode = iv_prop->pip_reint_mode;
 
+	if (pool->sp_bw_limit != iv_prop->pip_bw_limit ||
+	    pool->sp_iops_limit != iv_prop->pip_iops_limit) {
+		D_INFO(DF_UUID ": advisory QoS limits updated: bw " DF_U64 " bytes/s, iops " DF_U64 "\n",
+		       DP_UUID(pool->sp_uuid), iv_prop->pip_bw_limit, iv_prop->pip_iops_limit);
+		pool->sp_bw_limit   = iv_prop->pip_bw_limit;
+		pool->sp_iops_limit = iv_prop->pip_iops_limit;
+	}
+
 	arg.uvp_pool                     = pool;
 	arg.uvp_checkpoint_props_changed = false;
 
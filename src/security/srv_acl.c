@@ -779,6 +779,15 @@ ds_sec_get_admin_cont_capabilities(void)
 	return CONT_CAPAS_ALL;
 }
 
+uint64_t
+ds_sec_get_admin_pool_capabilities(void)
+{
+	/*
+	 * Internally generated admin pool handles can do everything.
+	 */
+	return POOL_CAPAS_ALL;
+}
+
 int
 ds_sec_creds_are_same_user(d_iov_t *cred_x, d_iov_t *cred_y)
 {
@@ -824,3 +833,48 @@ out_token_x:
 out:
 	return rc;
 }
+
+int
+ds_sec_cred_get_principals(d_iov_t *cred, char **user, char **group)
+{
+	struct drpc_alloc	alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Auth__Token		*token;
+	Auth__Sys		*authsys;
+	char			*utmp = NULL;
+	char			*gtmp = NULL;
+	int			rc;
+
+	if (cred == NULL || cred->iov_buf == NULL || user == NULL || group == NULL) {
+		D_ERROR("NULL input\n");
+		return -DER_INVAL;
+	}
+
+	rc = unpack_token_from_cred(cred, &token);
+	if (rc != -DER_SUCCESS)
+		return rc;
+
+	/* A credential without a token carries no principals. */
+	if (token == NULL)
+		return -DER_INVAL;
+
+	rc = get_auth_sys_payload(token, &authsys);
+	if (rc != 0)
+		goto out_token;
+
+	D_STRNDUP(utmp, authsys->user, DAOS_ACL_MAX_PRINCIPAL_LEN);
+	D_STRNDUP(gtmp, authsys->group, DAOS_ACL_MAX_PRINCIPAL_LEN);
+	if (utmp == NULL || gtmp == NULL) {
+		D_FREE(utmp);
+		D_FREE(gtmp);
+		D_GOTO(out_authsys, rc = -DER_NOMEM);
+	}
+
+	*user  = utmp;
+	*group = gtmp;
+
+out_authsys:
+	auth__sys__free_unpacked(authsys, &alloc.alloc);
+out_token:
+	auth__token__free_unpacked(token, &alloc.alloc);
+	return rc;
+}
@@ -2061,6 +2061,13 @@ test_get_admin_cont_capas(void **state)
 			 CONT_CAPAS_ALL);
 }
 
+static void
+test_get_admin_pool_capas(void **state)
+{
+	assert_int_equal(ds_sec_get_admin_pool_capabilities(),
+			 POOL_CAPAS_ALL);
+}
+
 static void
 test_origin_null_cred(void **state)
 {
@@ -2226,6 +2233,7 @@ main(void)
 		cmocka_unit_test(test_cont_can_evict_all),
 		cmocka_unit_test(test_get_rebuild_cont_capas),
 		cmocka_unit_test(test_get_admin_cont_capas),
+		cmocka_unit_test(test_get_admin_pool_capas),
 		ACL_UTEST(test_origin_null_cred),
 		ACL_UTEST(test_origin_null_machine_ptr),
 		ACL_UTEST(test_origin_empty_cred),
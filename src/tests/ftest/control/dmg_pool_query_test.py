@@ -91,9 +91,9 @@ class DmgPoolQueryTest(ControlTestBase, IorTestBase):
                     "total": self.params.get("total", path="/run/exp_vals/nvme/*")
                 }
             ],
-            "pool_layout_ver": 4,
+            "pool_layout_ver": 5,
             "query_mask": self.params.get("query_mask", path="/run/exp_vals/*"),
-            "upgrade_layout_ver": 4,
+            "upgrade_layout_ver": 5,
             "usage": [
                 {
                     "tier_name": "SCM",
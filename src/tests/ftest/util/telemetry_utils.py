@@ -134,11 +134,14 @@ class TelemetryUtils():
         "engine_pool_vos_aggregation_akey_skipped",
         "engine_pool_vos_aggregation_csum_errors",
         "engine_pool_vos_aggregation_deleted_ev",
+        "engine_pool_vos_aggregation_deleted_size",
         "engine_pool_vos_aggregation_deleted_sv",
         "engine_pool_vos_aggregation_dkey_deleted",
         "engine_pool_vos_aggregation_dkey_scanned",
         "engine_pool_vos_aggregation_dkey_skipped",
         *_gen_stats_metrics("engine_pool_vos_aggregation_epr_duration"),
+        "engine_pool_vos_aggregation_lag",
+        "engine_pool_vos_aggregation_last_run",
         "engine_pool_vos_aggregation_merged_recs",
         "engine_pool_vos_aggregation_merged_size",
         "engine_pool_vos_aggregation_obj_deleted",
@@ -268,8 +268,12 @@ agg_del_sv(daos_handle_t ih, struct vos_agg_param *agg_param,
 	}
 
 	*acts |= VOS_ITER_CB_DELETE;
-	if (vam && vam->vam_del_sv && !agg_param->ap_discard)
-		d_tm_inc_counter(vam->vam_del_sv, 1);
+	if (vam && !agg_param->ap_discard) {
+		if (vam->vam_del_sv)
+			d_tm_inc_counter(vam->vam_del_sv, 1);
+		if (vam->vam_del_size)
+			d_tm_inc_counter(vam->vam_del_size, entry->ie_rsize);
+	}
 	credits_consume(&agg_param->ap_credits, AGG_OP_DEL);
 
 	return rc;
@@ -680,8 +684,13 @@ delete_evt_entry(struct vos_agg_param *agg_param, struct vos_obj_iter *oiter,
 		return rc;
 	}
 
-	if (vam && vam->vam_del_ev && !agg_param->ap_discard)
-		d_tm_inc_counter(vam->vam_del_ev, 1);
+	if (vam && !agg_param->ap_discard) {
+		if (vam->vam_del_ev)
+			d_tm_inc_counter(vam->vam_del_ev, 1);
+		if (vam->vam_del_size)
+			d_tm_inc_counter(vam->vam_del_size,
+					 entry->ie_rsize * entry->ie_orig_recx.rx_nr);
+	}
 	credits_consume(&agg_param->ap_credits, AGG_OP_DEL);
 
 	return rc;
@@ -2614,6 +2623,12 @@ aggregate_exit(struct vos_container *cont, int agg_mode)
 		break;
 	case AGG_MODE_AGGREGATE:
 		D_ASSERT(cont->vc_in_aggregation);
+		if (vam && vam->vam_lag)
+			d_tm_set_gauge(vam->vam_lag,
+				       d_hlc_age2sec(cont->vc_epr_aggregation.epr_hi));
+		if (vam && vam->vam_last_run)
+			d_tm_record_timestamp(vam->vam_last_run);
+
 		cont->vc_in_aggregation = 0;
 		cont->vc_epr_aggregation.epr_lo = 0;
 		cont->vc_epr_aggregation.epr_hi = 0;
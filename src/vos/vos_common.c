@@ -898,6 +898,24 @@ vos_metrics_alloc(const char *path, int tgt_id)
 	if (rc)
 		DL_WARN(rc, "Failed to create 'fail_count' telemetry");
 
+	/* VOS aggregation total deleted size */
+	rc = d_tm_add_metric(&vam->vam_del_size, D_TM_COUNTER, "total deleted size", "bytes",
+			     "%s/%s/deleted_size/tgt_%u", path, VOS_AGG_DIR, tgt_id);
+	if (rc)
+		DL_WARN(rc, "Failed to create 'deleted_size' telemetry");
+
+	/* VOS aggregation lag behind the current time */
+	rc = d_tm_add_metric(&vam->vam_lag, D_TM_GAUGE, "age of last aggregated epoch", "s",
+			     "%s/%s/lag/tgt_%u", path, VOS_AGG_DIR, tgt_id);
+	if (rc)
+		DL_WARN(rc, "Failed to create 'lag' telemetry");
+
+	/* VOS aggregation last run */
+	rc = d_tm_add_metric(&vam->vam_last_run, D_TM_TIMESTAMP, "last aggregation run", NULL,
+			     "%s/%s/last_run/tgt_%u", path, VOS_AGG_DIR, tgt_id);
+	if (rc)
+		DL_WARN(rc, "Failed to create 'last_run' telemetry");
+
 	/* Metrics related to VOS checkpointing */
 	vos_chkpt_metrics_init(&vp_metrics->vp_chkpt_metrics, path, tgt_id);
 
@@ -1905,6 +1905,7 @@ vos_gc_pool(daos_handle_t poh, int credits, int (*yield_func)(void *arg),
 	struct d_tm_node_t      *duration = NULL;
 	struct d_tm_node_t      *tight    = NULL;
 	struct d_tm_node_t      *slack    = NULL;
+	struct d_tm_node_t      *last_run = NULL;
 	struct vos_pool		*pool = vos_hdl2pool(poh);
 	struct vos_tls		*tls  = vos_tls_get(pool->vp_sysdb);
 	struct vos_gc_param	 param;
@@ -1934,6 +1935,7 @@ vos_gc_pool(daos_handle_t poh, int credits, int (*yield_func)(void *arg),
 		duration = pool->vp_metrics->vp_gc_metrics.vgm_duration;
 		slack    = pool->vp_metrics->vp_gc_metrics.vgm_slack_cnt;
 		tight    = pool->vp_metrics->vp_gc_metrics.vgm_tight_cnt;
+		last_run = pool->vp_metrics->vp_gc_metrics.vgm_last_run;
 	}
 
 	while (1) {
@@ -1977,6 +1979,7 @@ vos_gc_pool(daos_handle_t poh, int credits, int (*yield_func)(void *arg),
 
 	if (total != 0) /* did something */
 		D_DEBUG(DB_TRACE, "GC consumed %d credits\n", total);
+	d_tm_record_timestamp(last_run);
 
 	D_ASSERT(tls->vtl_gc_running > 0);
 	tls->vtl_gc_running--;
@@ -2108,4 +2111,10 @@ vos_gc_metrics_init(struct vos_gc_metrics *vgm, const char *path, int tgt_id)
 			     "%s/%s/tight_cnt/tgt_%u", path, VOS_GC_DIR, tgt_id);
 	if (rc)
 		D_WARN("Failed to create 'tight_cnt' telemetry: " DF_RC "\n", DP_RC(rc));
+
+	/* GC last run */
+	rc = d_tm_add_metric(&vgm->vgm_last_run, D_TM_TIMESTAMP, "GC last run", NULL,
+			     "%s/%s/last_run/tgt_%u", path, VOS_GC_DIR, tgt_id);
+	if (rc)
+		D_WARN("Failed to create 'last_run' telemetry: " DF_RC "\n", DP_RC(rc));
 }
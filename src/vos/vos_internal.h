@@ -198,6 +198,9 @@ struct vos_agg_metrics {
 	struct d_tm_node_t	*vam_fail_count;	/* Aggregation failed */
 	struct d_tm_node_t      *vam_agg_blocked;       /* Aggregation waiting for discard */
 	struct d_tm_node_t      *vam_discard_blocked;   /* Discard waiting for aggregation */
+	struct d_tm_node_t      *vam_del_size;          /* Total size of deleted records */
+	struct d_tm_node_t      *vam_lag;               /* Age of last aggregated epoch */
+	struct d_tm_node_t      *vam_last_run;          /* End of last aggregation */
 };
 
 struct vos_gc_metrics {
@@ -210,6 +213,7 @@ struct vos_gc_metrics {
 	struct d_tm_node_t *vgm_sv_del;    /* SV records reclaimed */
 	struct d_tm_node_t *vgm_slack_cnt; /* Slack mode count */
 	struct d_tm_node_t *vgm_tight_cnt; /* Tight mode count */
+	struct d_tm_node_t *vgm_last_run;  /* End of last gc run */
 };
 
 /*
@@ -23,7 +23,7 @@
 
 Name:          daos
 Version:       2.7.101
-Release:       8%{?relval}%{?dist}
+Release:       9%{?relval}%{?dist}
 Summary:       DAOS Storage Engine
 
 License:       BSD-2-Clause-Patent
@@ -516,6 +516,9 @@ getent passwd daos_agent >/dev/null || useradd -s /sbin/nologin -r -g daos_agent
 %{_bindir}/cart_ctl
 %{_bindir}/self_test
 %{_bindir}/daos_agent
+# set daos_agent_helper to be setuid root in order to perform hardware discovery
+# on behalf of an unprivileged daos_agent
+%attr(4750,root,daos_agent) %{_bindir}/daos_agent_helper
 %{_bindir}/dfuse
 %{_bindir}/daos
 %{_libdir}/libdaos_cmd_hdlrs.so
@@ -637,6 +640,9 @@ getent passwd daos_agent >/dev/null || useradd -s /sbin/nologin -r -g daos_agent
 # No files in a shim package
 
 %changelog
+* Fri Oct 16 2026 agent <agent@local> 2.7.101-9
+- Add daos_agent_helper privileged helper
+
 * Fri Mar 21 2025  Cedric Koch-Hofer <cedric.koch-hofer@intel.com> 2.7.101-8
 - Add support of the libasan
 